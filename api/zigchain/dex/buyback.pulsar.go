@@ -0,0 +1,1866 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package dex
+
+import (
+	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_BuybackConfig_4_list)(nil)
+
+type _BuybackConfig_4_list struct {
+	list *[]string
+}
+
+func (x *_BuybackConfig_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BuybackConfig_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_BuybackConfig_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BuybackConfig_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BuybackConfig_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message BuybackConfig at list field SourceModules as it is not of Message kind"))
+}
+
+func (x *_BuybackConfig_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BuybackConfig_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_BuybackConfig_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_BuybackConfig                   protoreflect.MessageDescriptor
+	fd_BuybackConfig_enabled           protoreflect.FieldDescriptor
+	fd_BuybackConfig_interval_blocks   protoreflect.FieldDescriptor
+	fd_BuybackConfig_max_slippage      protoreflect.FieldDescriptor
+	fd_BuybackConfig_source_modules    protoreflect.FieldDescriptor
+	fd_BuybackConfig_destination       protoreflect.FieldDescriptor
+	fd_BuybackConfig_max_swaps_per_run protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zigchain_dex_buyback_proto_init()
+	md_BuybackConfig = File_zigchain_dex_buyback_proto.Messages().ByName("BuybackConfig")
+	fd_BuybackConfig_enabled = md_BuybackConfig.Fields().ByName("enabled")
+	fd_BuybackConfig_interval_blocks = md_BuybackConfig.Fields().ByName("interval_blocks")
+	fd_BuybackConfig_max_slippage = md_BuybackConfig.Fields().ByName("max_slippage")
+	fd_BuybackConfig_source_modules = md_BuybackConfig.Fields().ByName("source_modules")
+	fd_BuybackConfig_destination = md_BuybackConfig.Fields().ByName("destination")
+	fd_BuybackConfig_max_swaps_per_run = md_BuybackConfig.Fields().ByName("max_swaps_per_run")
+}
+
+var _ protoreflect.Message = (*fastReflection_BuybackConfig)(nil)
+
+type fastReflection_BuybackConfig BuybackConfig
+
+func (x *BuybackConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BuybackConfig)(x)
+}
+
+func (x *BuybackConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_dex_buyback_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BuybackConfig_messageType fastReflection_BuybackConfig_messageType
+var _ protoreflect.MessageType = fastReflection_BuybackConfig_messageType{}
+
+type fastReflection_BuybackConfig_messageType struct{}
+
+func (x fastReflection_BuybackConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BuybackConfig)(nil)
+}
+func (x fastReflection_BuybackConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_BuybackConfig)
+}
+func (x fastReflection_BuybackConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuybackConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BuybackConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuybackConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BuybackConfig) Type() protoreflect.MessageType {
+	return _fastReflection_BuybackConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BuybackConfig) New() protoreflect.Message {
+	return new(fastReflection_BuybackConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BuybackConfig) Interface() protoreflect.ProtoMessage {
+	return (*BuybackConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BuybackConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_BuybackConfig_enabled, value) {
+			return
+		}
+	}
+	if x.IntervalBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IntervalBlocks)
+		if !f(fd_BuybackConfig_interval_blocks, value) {
+			return
+		}
+	}
+	if x.MaxSlippage != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxSlippage)
+		if !f(fd_BuybackConfig_max_slippage, value) {
+			return
+		}
+	}
+	if len(x.SourceModules) != 0 {
+		value := protoreflect.ValueOfList(&_BuybackConfig_4_list{list: &x.SourceModules})
+		if !f(fd_BuybackConfig_source_modules, value) {
+			return
+		}
+	}
+	if x.Destination != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Destination))
+		if !f(fd_BuybackConfig_destination, value) {
+			return
+		}
+	}
+	if x.MaxSwapsPerRun != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxSwapsPerRun)
+		if !f(fd_BuybackConfig_max_swaps_per_run, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BuybackConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zigchain.dex.BuybackConfig.enabled":
+		return x.Enabled != false
+	case "zigchain.dex.BuybackConfig.interval_blocks":
+		return x.IntervalBlocks != uint64(0)
+	case "zigchain.dex.BuybackConfig.max_slippage":
+		return x.MaxSlippage != uint32(0)
+	case "zigchain.dex.BuybackConfig.source_modules":
+		return len(x.SourceModules) != 0
+	case "zigchain.dex.BuybackConfig.destination":
+		return x.Destination != 0
+	case "zigchain.dex.BuybackConfig.max_swaps_per_run":
+		return x.MaxSwapsPerRun != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.BuybackConfig"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.BuybackConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuybackConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zigchain.dex.BuybackConfig.enabled":
+		x.Enabled = false
+	case "zigchain.dex.BuybackConfig.interval_blocks":
+		x.IntervalBlocks = uint64(0)
+	case "zigchain.dex.BuybackConfig.max_slippage":
+		x.MaxSlippage = uint32(0)
+	case "zigchain.dex.BuybackConfig.source_modules":
+		x.SourceModules = nil
+	case "zigchain.dex.BuybackConfig.destination":
+		x.Destination = 0
+	case "zigchain.dex.BuybackConfig.max_swaps_per_run":
+		x.MaxSwapsPerRun = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.BuybackConfig"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.BuybackConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BuybackConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zigchain.dex.BuybackConfig.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	case "zigchain.dex.BuybackConfig.interval_blocks":
+		value := x.IntervalBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "zigchain.dex.BuybackConfig.max_slippage":
+		value := x.MaxSlippage
+		return protoreflect.ValueOfUint32(value)
+	case "zigchain.dex.BuybackConfig.source_modules":
+		if len(x.SourceModules) == 0 {
+			return protoreflect.ValueOfList(&_BuybackConfig_4_list{})
+		}
+		listValue := &_BuybackConfig_4_list{list: &x.SourceModules}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.dex.BuybackConfig.destination":
+		value := x.Destination
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "zigchain.dex.BuybackConfig.max_swaps_per_run":
+		value := x.MaxSwapsPerRun
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.BuybackConfig"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.BuybackConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuybackConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zigchain.dex.BuybackConfig.enabled":
+		x.Enabled = value.Bool()
+	case "zigchain.dex.BuybackConfig.interval_blocks":
+		x.IntervalBlocks = value.Uint()
+	case "zigchain.dex.BuybackConfig.max_slippage":
+		x.MaxSlippage = uint32(value.Uint())
+	case "zigchain.dex.BuybackConfig.source_modules":
+		lv := value.List()
+		clv := lv.(*_BuybackConfig_4_list)
+		x.SourceModules = *clv.list
+	case "zigchain.dex.BuybackConfig.destination":
+		x.Destination = (BuybackDestination)(value.Enum())
+	case "zigchain.dex.BuybackConfig.max_swaps_per_run":
+		x.MaxSwapsPerRun = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.BuybackConfig"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.BuybackConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuybackConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.BuybackConfig.source_modules":
+		if x.SourceModules == nil {
+			x.SourceModules = []string{}
+		}
+		value := &_BuybackConfig_4_list{list: &x.SourceModules}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.dex.BuybackConfig.enabled":
+		panic(fmt.Errorf("field enabled of message zigchain.dex.BuybackConfig is not mutable"))
+	case "zigchain.dex.BuybackConfig.interval_blocks":
+		panic(fmt.Errorf("field interval_blocks of message zigchain.dex.BuybackConfig is not mutable"))
+	case "zigchain.dex.BuybackConfig.max_slippage":
+		panic(fmt.Errorf("field max_slippage of message zigchain.dex.BuybackConfig is not mutable"))
+	case "zigchain.dex.BuybackConfig.destination":
+		panic(fmt.Errorf("field destination of message zigchain.dex.BuybackConfig is not mutable"))
+	case "zigchain.dex.BuybackConfig.max_swaps_per_run":
+		panic(fmt.Errorf("field max_swaps_per_run of message zigchain.dex.BuybackConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.BuybackConfig"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.BuybackConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BuybackConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.BuybackConfig.enabled":
+		return protoreflect.ValueOfBool(false)
+	case "zigchain.dex.BuybackConfig.interval_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zigchain.dex.BuybackConfig.max_slippage":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "zigchain.dex.BuybackConfig.source_modules":
+		list := []string{}
+		return protoreflect.ValueOfList(&_BuybackConfig_4_list{list: &list})
+	case "zigchain.dex.BuybackConfig.destination":
+		return protoreflect.ValueOfEnum(0)
+	case "zigchain.dex.BuybackConfig.max_swaps_per_run":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.BuybackConfig"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.BuybackConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BuybackConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.dex.BuybackConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BuybackConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuybackConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BuybackConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BuybackConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BuybackConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Enabled {
+			n += 2
+		}
+		if x.IntervalBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.IntervalBlocks))
+		}
+		if x.MaxSlippage != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxSlippage))
+		}
+		if len(x.SourceModules) > 0 {
+			for _, s := range x.SourceModules {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Destination != 0 {
+			n += 1 + runtime.Sov(uint64(x.Destination))
+		}
+		if x.MaxSwapsPerRun != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxSwapsPerRun))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BuybackConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxSwapsPerRun != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSwapsPerRun))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Destination != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Destination))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.SourceModules) > 0 {
+			for iNdEx := len(x.SourceModules) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.SourceModules[iNdEx])
+				copy(dAtA[i:], x.SourceModules[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SourceModules[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.MaxSlippage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSlippage))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.IntervalBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IntervalBlocks))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BuybackConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuybackConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuybackConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+				}
+				x.IntervalBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IntervalBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+				}
+				x.MaxSlippage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSlippage |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceModules", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SourceModules = append(x.SourceModules, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				}
+				x.Destination = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Destination |= BuybackDestination(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSwapsPerRun", wireType)
+				}
+				x.MaxSwapsPerRun = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSwapsPerRun |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_BuybackStats_1_list)(nil)
+
+type _BuybackStats_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_BuybackStats_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BuybackStats_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BuybackStats_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BuybackStats_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BuybackStats_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BuybackStats_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BuybackStats_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BuybackStats_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_BuybackStats                         protoreflect.MessageDescriptor
+	fd_BuybackStats_total_sold              protoreflect.FieldDescriptor
+	fd_BuybackStats_total_burned            protoreflect.FieldDescriptor
+	fd_BuybackStats_total_to_community_pool protoreflect.FieldDescriptor
+	fd_BuybackStats_run_count               protoreflect.FieldDescriptor
+	fd_BuybackStats_last_run_height         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zigchain_dex_buyback_proto_init()
+	md_BuybackStats = File_zigchain_dex_buyback_proto.Messages().ByName("BuybackStats")
+	fd_BuybackStats_total_sold = md_BuybackStats.Fields().ByName("total_sold")
+	fd_BuybackStats_total_burned = md_BuybackStats.Fields().ByName("total_burned")
+	fd_BuybackStats_total_to_community_pool = md_BuybackStats.Fields().ByName("total_to_community_pool")
+	fd_BuybackStats_run_count = md_BuybackStats.Fields().ByName("run_count")
+	fd_BuybackStats_last_run_height = md_BuybackStats.Fields().ByName("last_run_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_BuybackStats)(nil)
+
+type fastReflection_BuybackStats BuybackStats
+
+func (x *BuybackStats) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BuybackStats)(x)
+}
+
+func (x *BuybackStats) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_dex_buyback_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BuybackStats_messageType fastReflection_BuybackStats_messageType
+var _ protoreflect.MessageType = fastReflection_BuybackStats_messageType{}
+
+type fastReflection_BuybackStats_messageType struct{}
+
+func (x fastReflection_BuybackStats_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BuybackStats)(nil)
+}
+func (x fastReflection_BuybackStats_messageType) New() protoreflect.Message {
+	return new(fastReflection_BuybackStats)
+}
+func (x fastReflection_BuybackStats_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuybackStats
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BuybackStats) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuybackStats
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BuybackStats) Type() protoreflect.MessageType {
+	return _fastReflection_BuybackStats_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BuybackStats) New() protoreflect.Message {
+	return new(fastReflection_BuybackStats)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BuybackStats) Interface() protoreflect.ProtoMessage {
+	return (*BuybackStats)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BuybackStats) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.TotalSold) != 0 {
+		value := protoreflect.ValueOfList(&_BuybackStats_1_list{list: &x.TotalSold})
+		if !f(fd_BuybackStats_total_sold, value) {
+			return
+		}
+	}
+	if x.TotalBurned != nil {
+		value := protoreflect.ValueOfMessage(x.TotalBurned.ProtoReflect())
+		if !f(fd_BuybackStats_total_burned, value) {
+			return
+		}
+	}
+	if x.TotalToCommunityPool != nil {
+		value := protoreflect.ValueOfMessage(x.TotalToCommunityPool.ProtoReflect())
+		if !f(fd_BuybackStats_total_to_community_pool, value) {
+			return
+		}
+	}
+	if x.RunCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RunCount)
+		if !f(fd_BuybackStats_run_count, value) {
+			return
+		}
+	}
+	if x.LastRunHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastRunHeight)
+		if !f(fd_BuybackStats_last_run_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BuybackStats) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zigchain.dex.BuybackStats.total_sold":
+		return len(x.TotalSold) != 0
+	case "zigchain.dex.BuybackStats.total_burned":
+		return x.TotalBurned != nil
+	case "zigchain.dex.BuybackStats.total_to_community_pool":
+		return x.TotalToCommunityPool != nil
+	case "zigchain.dex.BuybackStats.run_count":
+		return x.RunCount != uint64(0)
+	case "zigchain.dex.BuybackStats.last_run_height":
+		return x.LastRunHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.BuybackStats"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.BuybackStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuybackStats) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zigchain.dex.BuybackStats.total_sold":
+		x.TotalSold = nil
+	case "zigchain.dex.BuybackStats.total_burned":
+		x.TotalBurned = nil
+	case "zigchain.dex.BuybackStats.total_to_community_pool":
+		x.TotalToCommunityPool = nil
+	case "zigchain.dex.BuybackStats.run_count":
+		x.RunCount = uint64(0)
+	case "zigchain.dex.BuybackStats.last_run_height":
+		x.LastRunHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.BuybackStats"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.BuybackStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BuybackStats) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zigchain.dex.BuybackStats.total_sold":
+		if len(x.TotalSold) == 0 {
+			return protoreflect.ValueOfList(&_BuybackStats_1_list{})
+		}
+		listValue := &_BuybackStats_1_list{list: &x.TotalSold}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.dex.BuybackStats.total_burned":
+		value := x.TotalBurned
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zigchain.dex.BuybackStats.total_to_community_pool":
+		value := x.TotalToCommunityPool
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zigchain.dex.BuybackStats.run_count":
+		value := x.RunCount
+		return protoreflect.ValueOfUint64(value)
+	case "zigchain.dex.BuybackStats.last_run_height":
+		value := x.LastRunHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.BuybackStats"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.BuybackStats does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuybackStats) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zigchain.dex.BuybackStats.total_sold":
+		lv := value.List()
+		clv := lv.(*_BuybackStats_1_list)
+		x.TotalSold = *clv.list
+	case "zigchain.dex.BuybackStats.total_burned":
+		x.TotalBurned = value.Message().Interface().(*v1beta1.Coin)
+	case "zigchain.dex.BuybackStats.total_to_community_pool":
+		x.TotalToCommunityPool = value.Message().Interface().(*v1beta1.Coin)
+	case "zigchain.dex.BuybackStats.run_count":
+		x.RunCount = value.Uint()
+	case "zigchain.dex.BuybackStats.last_run_height":
+		x.LastRunHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.BuybackStats"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.BuybackStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuybackStats) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.BuybackStats.total_sold":
+		if x.TotalSold == nil {
+			x.TotalSold = []*v1beta1.Coin{}
+		}
+		value := &_BuybackStats_1_list{list: &x.TotalSold}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.dex.BuybackStats.total_burned":
+		if x.TotalBurned == nil {
+			x.TotalBurned = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.TotalBurned.ProtoReflect())
+	case "zigchain.dex.BuybackStats.total_to_community_pool":
+		if x.TotalToCommunityPool == nil {
+			x.TotalToCommunityPool = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.TotalToCommunityPool.ProtoReflect())
+	case "zigchain.dex.BuybackStats.run_count":
+		panic(fmt.Errorf("field run_count of message zigchain.dex.BuybackStats is not mutable"))
+	case "zigchain.dex.BuybackStats.last_run_height":
+		panic(fmt.Errorf("field last_run_height of message zigchain.dex.BuybackStats is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.BuybackStats"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.BuybackStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BuybackStats) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.BuybackStats.total_sold":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_BuybackStats_1_list{list: &list})
+	case "zigchain.dex.BuybackStats.total_burned":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zigchain.dex.BuybackStats.total_to_community_pool":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zigchain.dex.BuybackStats.run_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zigchain.dex.BuybackStats.last_run_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.BuybackStats"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.BuybackStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BuybackStats) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.dex.BuybackStats", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BuybackStats) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuybackStats) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BuybackStats) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BuybackStats) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BuybackStats)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.TotalSold) > 0 {
+			for _, e := range x.TotalSold {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TotalBurned != nil {
+			l = options.Size(x.TotalBurned)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TotalToCommunityPool != nil {
+			l = options.Size(x.TotalToCommunityPool)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RunCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.RunCount))
+		}
+		if x.LastRunHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastRunHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BuybackStats)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastRunHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastRunHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.RunCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RunCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.TotalToCommunityPool != nil {
+			encoded, err := options.Marshal(x.TotalToCommunityPool)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.TotalBurned != nil {
+			encoded, err := options.Marshal(x.TotalBurned)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.TotalSold) > 0 {
+			for iNdEx := len(x.TotalSold) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TotalSold[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BuybackStats)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuybackStats: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuybackStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalSold", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalSold = append(x.TotalSold, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalSold[len(x.TotalSold)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TotalBurned == nil {
+					x.TotalBurned = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalBurned); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalToCommunityPool", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TotalToCommunityPool == nil {
+					x.TotalToCommunityPool = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalToCommunityPool); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RunCount", wireType)
+				}
+				x.RunCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RunCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastRunHeight", wireType)
+				}
+				x.LastRunHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastRunHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: zigchain/dex/buyback.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BuybackDestination selects what happens to the uzig bought back
+type BuybackDestination int32
+
+const (
+	// BUYBACK_DESTINATION_BURN burns the bought uzig
+	BuybackDestination_BUYBACK_DESTINATION_BURN BuybackDestination = 0
+	// BUYBACK_DESTINATION_COMMUNITY_POOL sends the bought uzig to the community
+	// pool
+	BuybackDestination_BUYBACK_DESTINATION_COMMUNITY_POOL BuybackDestination = 1
+)
+
+// Enum value maps for BuybackDestination.
+var (
+	BuybackDestination_name = map[int32]string{
+		0: "BUYBACK_DESTINATION_BURN",
+		1: "BUYBACK_DESTINATION_COMMUNITY_POOL",
+	}
+	BuybackDestination_value = map[string]int32{
+		"BUYBACK_DESTINATION_BURN":           0,
+		"BUYBACK_DESTINATION_COMMUNITY_POOL": 1,
+	}
+)
+
+func (x BuybackDestination) Enum() *BuybackDestination {
+	p := new(BuybackDestination)
+	*p = x
+	return p
+}
+
+func (x BuybackDestination) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BuybackDestination) Descriptor() protoreflect.EnumDescriptor {
+	return file_zigchain_dex_buyback_proto_enumTypes[0].Descriptor()
+}
+
+func (BuybackDestination) Type() protoreflect.EnumType {
+	return &file_zigchain_dex_buyback_proto_enumTypes[0]
+}
+
+func (x BuybackDestination) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BuybackDestination.Descriptor instead.
+func (BuybackDestination) EnumDescriptor() ([]byte, []int) {
+	return file_zigchain_dex_buyback_proto_rawDescGZIP(), []int{0}
+}
+
+// BuybackConfig holds the governable settings of the periodic buyback job,
+// which swaps the non-uzig balances of the source module accounts into uzig
+// through the dex pools
+type BuybackConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// enabled switches the buyback job on
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// interval_blocks is the number of blocks between two buyback runs
+	IntervalBlocks uint64 `protobuf:"varint,2,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// max_slippage is the maximum price impact of a single swap in basis
+	// points, larger balances are sold in parts over several runs
+	MaxSlippage uint32 `protobuf:"varint,3,opt,name=max_slippage,json=maxSlippage,proto3" json:"max_slippage,omitempty"`
+	// source_modules are the fee collecting module accounts whose balances are
+	// sold, only the fee_collector account is allowed
+	SourceModules []string `protobuf:"bytes,4,rep,name=source_modules,json=sourceModules,proto3" json:"source_modules,omitempty"`
+	// destination selects whether the bought uzig is burned or sent to the
+	// community pool
+	Destination BuybackDestination `protobuf:"varint,5,opt,name=destination,proto3,enum=zigchain.dex.BuybackDestination" json:"destination,omitempty"`
+	// max_swaps_per_run bounds the number of swaps executed in a single run
+	MaxSwapsPerRun uint32 `protobuf:"varint,6,opt,name=max_swaps_per_run,json=maxSwapsPerRun,proto3" json:"max_swaps_per_run,omitempty"`
+}
+
+func (x *BuybackConfig) Reset() {
+	*x = BuybackConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_dex_buyback_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuybackConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuybackConfig) ProtoMessage() {}
+
+// Deprecated: Use BuybackConfig.ProtoReflect.Descriptor instead.
+func (*BuybackConfig) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_buyback_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BuybackConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *BuybackConfig) GetIntervalBlocks() uint64 {
+	if x != nil {
+		return x.IntervalBlocks
+	}
+	return 0
+}
+
+func (x *BuybackConfig) GetMaxSlippage() uint32 {
+	if x != nil {
+		return x.MaxSlippage
+	}
+	return 0
+}
+
+func (x *BuybackConfig) GetSourceModules() []string {
+	if x != nil {
+		return x.SourceModules
+	}
+	return nil
+}
+
+func (x *BuybackConfig) GetDestination() BuybackDestination {
+	if x != nil {
+		return x.Destination
+	}
+	return BuybackDestination_BUYBACK_DESTINATION_BURN
+}
+
+func (x *BuybackConfig) GetMaxSwapsPerRun() uint32 {
+	if x != nil {
+		return x.MaxSwapsPerRun
+	}
+	return 0
+}
+
+// BuybackStats holds the cumulative totals of the buyback job
+type BuybackStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// total_sold is the cumulative amount of fee tokens sold
+	TotalSold []*v1beta1.Coin `protobuf:"bytes,1,rep,name=total_sold,json=totalSold,proto3" json:"total_sold,omitempty"`
+	// total_burned is the cumulative amount of uzig bought back and burned
+	TotalBurned *v1beta1.Coin `protobuf:"bytes,2,opt,name=total_burned,json=totalBurned,proto3" json:"total_burned,omitempty"`
+	// total_to_community_pool is the cumulative amount of uzig bought back and
+	// sent to the community pool
+	TotalToCommunityPool *v1beta1.Coin `protobuf:"bytes,3,opt,name=total_to_community_pool,json=totalToCommunityPool,proto3" json:"total_to_community_pool,omitempty"`
+	// run_count is the number of runs that bought back uzig
+	RunCount uint64 `protobuf:"varint,4,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
+	// last_run_height is the block height of the last run that bought back uzig
+	LastRunHeight int64 `protobuf:"varint,5,opt,name=last_run_height,json=lastRunHeight,proto3" json:"last_run_height,omitempty"`
+}
+
+func (x *BuybackStats) Reset() {
+	*x = BuybackStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_dex_buyback_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuybackStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuybackStats) ProtoMessage() {}
+
+// Deprecated: Use BuybackStats.ProtoReflect.Descriptor instead.
+func (*BuybackStats) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_buyback_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BuybackStats) GetTotalSold() []*v1beta1.Coin {
+	if x != nil {
+		return x.TotalSold
+	}
+	return nil
+}
+
+func (x *BuybackStats) GetTotalBurned() *v1beta1.Coin {
+	if x != nil {
+		return x.TotalBurned
+	}
+	return nil
+}
+
+func (x *BuybackStats) GetTotalToCommunityPool() *v1beta1.Coin {
+	if x != nil {
+		return x.TotalToCommunityPool
+	}
+	return nil
+}
+
+func (x *BuybackStats) GetRunCount() uint64 {
+	if x != nil {
+		return x.RunCount
+	}
+	return 0
+}
+
+func (x *BuybackStats) GetLastRunHeight() int64 {
+	if x != nil {
+		return x.LastRunHeight
+	}
+	return 0
+}
+
+var File_zigchain_dex_buyback_proto protoreflect.FileDescriptor
+
+var file_zigchain_dex_buyback_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x62,
+	0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x7a, 0x69,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e,
+	0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67,
+	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x8b, 0x02, 0x0a, 0x0d, 0x42, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x6d, 0x61, 0x78, 0x53, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x42, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x42, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x73, 0x50, 0x65, 0x72, 0x52, 0x75,
+	0x6e, 0x22, 0xea, 0x02, 0x0a, 0x0c, 0x42, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x6f, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x6f, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53,
+	0x6f, 0x6c, 0x64, 0x12, 0x47, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x5b, 0x0a, 0x17,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6e,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x75,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72,
+	0x75, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x2a, 0x60,
+	0x0a, 0x12, 0x42, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x55, 0x59, 0x42, 0x41, 0x43, 0x4b, 0x5f,
+	0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x52, 0x4e,
+	0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x42, 0x55, 0x59, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x44, 0x45,
+	0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e,
+	0x49, 0x54, 0x59, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x10, 0x01, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00,
+	0x42, 0x90, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x64, 0x65, 0x78, 0x42, 0x0c, 0x42, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x64, 0x65, 0x78, 0xa2, 0x02, 0x03, 0x5a, 0x44, 0x58, 0xaa, 0x02, 0x0c, 0x5a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x78, 0xca, 0x02, 0x0c, 0x5a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x44, 0x65, 0x78, 0xe2, 0x02, 0x18, 0x5a, 0x69, 0x67, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5c, 0x44, 0x65, 0x78, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a,
+	0x44, 0x65, 0x78, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_zigchain_dex_buyback_proto_rawDescOnce sync.Once
+	file_zigchain_dex_buyback_proto_rawDescData = file_zigchain_dex_buyback_proto_rawDesc
+)
+
+func file_zigchain_dex_buyback_proto_rawDescGZIP() []byte {
+	file_zigchain_dex_buyback_proto_rawDescOnce.Do(func() {
+		file_zigchain_dex_buyback_proto_rawDescData = protoimpl.X.CompressGZIP(file_zigchain_dex_buyback_proto_rawDescData)
+	})
+	return file_zigchain_dex_buyback_proto_rawDescData
+}
+
+var file_zigchain_dex_buyback_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_zigchain_dex_buyback_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_zigchain_dex_buyback_proto_goTypes = []interface{}{
+	(BuybackDestination)(0), // 0: zigchain.dex.BuybackDestination
+	(*BuybackConfig)(nil),   // 1: zigchain.dex.BuybackConfig
+	(*BuybackStats)(nil),    // 2: zigchain.dex.BuybackStats
+	(*v1beta1.Coin)(nil),    // 3: cosmos.base.v1beta1.Coin
+}
+var file_zigchain_dex_buyback_proto_depIdxs = []int32{
+	0, // 0: zigchain.dex.BuybackConfig.destination:type_name -> zigchain.dex.BuybackDestination
+	3, // 1: zigchain.dex.BuybackStats.total_sold:type_name -> cosmos.base.v1beta1.Coin
+	3, // 2: zigchain.dex.BuybackStats.total_burned:type_name -> cosmos.base.v1beta1.Coin
+	3, // 3: zigchain.dex.BuybackStats.total_to_community_pool:type_name -> cosmos.base.v1beta1.Coin
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_zigchain_dex_buyback_proto_init() }
+func file_zigchain_dex_buyback_proto_init() {
+	if File_zigchain_dex_buyback_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_zigchain_dex_buyback_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuybackConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zigchain_dex_buyback_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuybackStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_zigchain_dex_buyback_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_zigchain_dex_buyback_proto_goTypes,
+		DependencyIndexes: file_zigchain_dex_buyback_proto_depIdxs,
+		EnumInfos:         file_zigchain_dex_buyback_proto_enumTypes,
+		MessageInfos:      file_zigchain_dex_buyback_proto_msgTypes,
+	}.Build()
+	File_zigchain_dex_buyback_proto = out.File
+	file_zigchain_dex_buyback_proto_rawDesc = nil
+	file_zigchain_dex_buyback_proto_goTypes = nil
+	file_zigchain_dex_buyback_proto_depIdxs = nil
+}
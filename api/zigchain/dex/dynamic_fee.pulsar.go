@@ -0,0 +1,1462 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package dex
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_DynamicFeeConfig                protoreflect.MessageDescriptor
+	fd_DynamicFeeConfig_pool_id        protoreflect.FieldDescriptor
+	fd_DynamicFeeConfig_enabled        protoreflect.FieldDescriptor
+	fd_DynamicFeeConfig_min_fee        protoreflect.FieldDescriptor
+	fd_DynamicFeeConfig_max_fee        protoreflect.FieldDescriptor
+	fd_DynamicFeeConfig_volatility_cap protoreflect.FieldDescriptor
+	fd_DynamicFeeConfig_decay_window   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zigchain_dex_dynamic_fee_proto_init()
+	md_DynamicFeeConfig = File_zigchain_dex_dynamic_fee_proto.Messages().ByName("DynamicFeeConfig")
+	fd_DynamicFeeConfig_pool_id = md_DynamicFeeConfig.Fields().ByName("pool_id")
+	fd_DynamicFeeConfig_enabled = md_DynamicFeeConfig.Fields().ByName("enabled")
+	fd_DynamicFeeConfig_min_fee = md_DynamicFeeConfig.Fields().ByName("min_fee")
+	fd_DynamicFeeConfig_max_fee = md_DynamicFeeConfig.Fields().ByName("max_fee")
+	fd_DynamicFeeConfig_volatility_cap = md_DynamicFeeConfig.Fields().ByName("volatility_cap")
+	fd_DynamicFeeConfig_decay_window = md_DynamicFeeConfig.Fields().ByName("decay_window")
+}
+
+var _ protoreflect.Message = (*fastReflection_DynamicFeeConfig)(nil)
+
+type fastReflection_DynamicFeeConfig DynamicFeeConfig
+
+func (x *DynamicFeeConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DynamicFeeConfig)(x)
+}
+
+func (x *DynamicFeeConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_dex_dynamic_fee_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DynamicFeeConfig_messageType fastReflection_DynamicFeeConfig_messageType
+var _ protoreflect.MessageType = fastReflection_DynamicFeeConfig_messageType{}
+
+type fastReflection_DynamicFeeConfig_messageType struct{}
+
+func (x fastReflection_DynamicFeeConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DynamicFeeConfig)(nil)
+}
+func (x fastReflection_DynamicFeeConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_DynamicFeeConfig)
+}
+func (x fastReflection_DynamicFeeConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DynamicFeeConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DynamicFeeConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_DynamicFeeConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DynamicFeeConfig) Type() protoreflect.MessageType {
+	return _fastReflection_DynamicFeeConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DynamicFeeConfig) New() protoreflect.Message {
+	return new(fastReflection_DynamicFeeConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DynamicFeeConfig) Interface() protoreflect.ProtoMessage {
+	return (*DynamicFeeConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DynamicFeeConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PoolId != "" {
+		value := protoreflect.ValueOfString(x.PoolId)
+		if !f(fd_DynamicFeeConfig_pool_id, value) {
+			return
+		}
+	}
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_DynamicFeeConfig_enabled, value) {
+			return
+		}
+	}
+	if x.MinFee != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MinFee)
+		if !f(fd_DynamicFeeConfig_min_fee, value) {
+			return
+		}
+	}
+	if x.MaxFee != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxFee)
+		if !f(fd_DynamicFeeConfig_max_fee, value) {
+			return
+		}
+	}
+	if x.VolatilityCap != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.VolatilityCap)
+		if !f(fd_DynamicFeeConfig_volatility_cap, value) {
+			return
+		}
+	}
+	if x.DecayWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DecayWindow)
+		if !f(fd_DynamicFeeConfig_decay_window, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DynamicFeeConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zigchain.dex.DynamicFeeConfig.pool_id":
+		return x.PoolId != ""
+	case "zigchain.dex.DynamicFeeConfig.enabled":
+		return x.Enabled != false
+	case "zigchain.dex.DynamicFeeConfig.min_fee":
+		return x.MinFee != uint32(0)
+	case "zigchain.dex.DynamicFeeConfig.max_fee":
+		return x.MaxFee != uint32(0)
+	case "zigchain.dex.DynamicFeeConfig.volatility_cap":
+		return x.VolatilityCap != uint32(0)
+	case "zigchain.dex.DynamicFeeConfig.decay_window":
+		return x.DecayWindow != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.DynamicFeeConfig"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.DynamicFeeConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DynamicFeeConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zigchain.dex.DynamicFeeConfig.pool_id":
+		x.PoolId = ""
+	case "zigchain.dex.DynamicFeeConfig.enabled":
+		x.Enabled = false
+	case "zigchain.dex.DynamicFeeConfig.min_fee":
+		x.MinFee = uint32(0)
+	case "zigchain.dex.DynamicFeeConfig.max_fee":
+		x.MaxFee = uint32(0)
+	case "zigchain.dex.DynamicFeeConfig.volatility_cap":
+		x.VolatilityCap = uint32(0)
+	case "zigchain.dex.DynamicFeeConfig.decay_window":
+		x.DecayWindow = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.DynamicFeeConfig"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.DynamicFeeConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DynamicFeeConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zigchain.dex.DynamicFeeConfig.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfString(value)
+	case "zigchain.dex.DynamicFeeConfig.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	case "zigchain.dex.DynamicFeeConfig.min_fee":
+		value := x.MinFee
+		return protoreflect.ValueOfUint32(value)
+	case "zigchain.dex.DynamicFeeConfig.max_fee":
+		value := x.MaxFee
+		return protoreflect.ValueOfUint32(value)
+	case "zigchain.dex.DynamicFeeConfig.volatility_cap":
+		value := x.VolatilityCap
+		return protoreflect.ValueOfUint32(value)
+	case "zigchain.dex.DynamicFeeConfig.decay_window":
+		value := x.DecayWindow
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.DynamicFeeConfig"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.DynamicFeeConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DynamicFeeConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zigchain.dex.DynamicFeeConfig.pool_id":
+		x.PoolId = value.Interface().(string)
+	case "zigchain.dex.DynamicFeeConfig.enabled":
+		x.Enabled = value.Bool()
+	case "zigchain.dex.DynamicFeeConfig.min_fee":
+		x.MinFee = uint32(value.Uint())
+	case "zigchain.dex.DynamicFeeConfig.max_fee":
+		x.MaxFee = uint32(value.Uint())
+	case "zigchain.dex.DynamicFeeConfig.volatility_cap":
+		x.VolatilityCap = uint32(value.Uint())
+	case "zigchain.dex.DynamicFeeConfig.decay_window":
+		x.DecayWindow = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.DynamicFeeConfig"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.DynamicFeeConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DynamicFeeConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.DynamicFeeConfig.pool_id":
+		panic(fmt.Errorf("field pool_id of message zigchain.dex.DynamicFeeConfig is not mutable"))
+	case "zigchain.dex.DynamicFeeConfig.enabled":
+		panic(fmt.Errorf("field enabled of message zigchain.dex.DynamicFeeConfig is not mutable"))
+	case "zigchain.dex.DynamicFeeConfig.min_fee":
+		panic(fmt.Errorf("field min_fee of message zigchain.dex.DynamicFeeConfig is not mutable"))
+	case "zigchain.dex.DynamicFeeConfig.max_fee":
+		panic(fmt.Errorf("field max_fee of message zigchain.dex.DynamicFeeConfig is not mutable"))
+	case "zigchain.dex.DynamicFeeConfig.volatility_cap":
+		panic(fmt.Errorf("field volatility_cap of message zigchain.dex.DynamicFeeConfig is not mutable"))
+	case "zigchain.dex.DynamicFeeConfig.decay_window":
+		panic(fmt.Errorf("field decay_window of message zigchain.dex.DynamicFeeConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.DynamicFeeConfig"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.DynamicFeeConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DynamicFeeConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.DynamicFeeConfig.pool_id":
+		return protoreflect.ValueOfString("")
+	case "zigchain.dex.DynamicFeeConfig.enabled":
+		return protoreflect.ValueOfBool(false)
+	case "zigchain.dex.DynamicFeeConfig.min_fee":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "zigchain.dex.DynamicFeeConfig.max_fee":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "zigchain.dex.DynamicFeeConfig.volatility_cap":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "zigchain.dex.DynamicFeeConfig.decay_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.DynamicFeeConfig"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.DynamicFeeConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DynamicFeeConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.dex.DynamicFeeConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DynamicFeeConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DynamicFeeConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DynamicFeeConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DynamicFeeConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DynamicFeeConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PoolId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Enabled {
+			n += 2
+		}
+		if x.MinFee != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinFee))
+		}
+		if x.MaxFee != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxFee))
+		}
+		if x.VolatilityCap != 0 {
+			n += 1 + runtime.Sov(uint64(x.VolatilityCap))
+		}
+		if x.DecayWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.DecayWindow))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DynamicFeeConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DecayWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DecayWindow))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.VolatilityCap != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VolatilityCap))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MaxFee != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxFee))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MinFee != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinFee))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.PoolId) > 0 {
+			i -= len(x.PoolId)
+			copy(dAtA[i:], x.PoolId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PoolId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DynamicFeeConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DynamicFeeConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DynamicFeeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PoolId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+				}
+				x.MinFee = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinFee |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+				}
+				x.MaxFee = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxFee |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VolatilityCap", wireType)
+				}
+				x.VolatilityCap = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VolatilityCap |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecayWindow", wireType)
+				}
+				x.DecayWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DecayWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PoolVolatility             protoreflect.MessageDescriptor
+	fd_PoolVolatility_pool_id     protoreflect.FieldDescriptor
+	fd_PoolVolatility_volatility  protoreflect.FieldDescriptor
+	fd_PoolVolatility_last_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zigchain_dex_dynamic_fee_proto_init()
+	md_PoolVolatility = File_zigchain_dex_dynamic_fee_proto.Messages().ByName("PoolVolatility")
+	fd_PoolVolatility_pool_id = md_PoolVolatility.Fields().ByName("pool_id")
+	fd_PoolVolatility_volatility = md_PoolVolatility.Fields().ByName("volatility")
+	fd_PoolVolatility_last_height = md_PoolVolatility.Fields().ByName("last_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_PoolVolatility)(nil)
+
+type fastReflection_PoolVolatility PoolVolatility
+
+func (x *PoolVolatility) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PoolVolatility)(x)
+}
+
+func (x *PoolVolatility) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_dex_dynamic_fee_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PoolVolatility_messageType fastReflection_PoolVolatility_messageType
+var _ protoreflect.MessageType = fastReflection_PoolVolatility_messageType{}
+
+type fastReflection_PoolVolatility_messageType struct{}
+
+func (x fastReflection_PoolVolatility_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PoolVolatility)(nil)
+}
+func (x fastReflection_PoolVolatility_messageType) New() protoreflect.Message {
+	return new(fastReflection_PoolVolatility)
+}
+func (x fastReflection_PoolVolatility_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PoolVolatility
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PoolVolatility) Descriptor() protoreflect.MessageDescriptor {
+	return md_PoolVolatility
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PoolVolatility) Type() protoreflect.MessageType {
+	return _fastReflection_PoolVolatility_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PoolVolatility) New() protoreflect.Message {
+	return new(fastReflection_PoolVolatility)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PoolVolatility) Interface() protoreflect.ProtoMessage {
+	return (*PoolVolatility)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PoolVolatility) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PoolId != "" {
+		value := protoreflect.ValueOfString(x.PoolId)
+		if !f(fd_PoolVolatility_pool_id, value) {
+			return
+		}
+	}
+	if x.Volatility != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Volatility)
+		if !f(fd_PoolVolatility_volatility, value) {
+			return
+		}
+	}
+	if x.LastHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastHeight)
+		if !f(fd_PoolVolatility_last_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PoolVolatility) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zigchain.dex.PoolVolatility.pool_id":
+		return x.PoolId != ""
+	case "zigchain.dex.PoolVolatility.volatility":
+		return x.Volatility != uint64(0)
+	case "zigchain.dex.PoolVolatility.last_height":
+		return x.LastHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.PoolVolatility"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.PoolVolatility does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolVolatility) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zigchain.dex.PoolVolatility.pool_id":
+		x.PoolId = ""
+	case "zigchain.dex.PoolVolatility.volatility":
+		x.Volatility = uint64(0)
+	case "zigchain.dex.PoolVolatility.last_height":
+		x.LastHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.PoolVolatility"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.PoolVolatility does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PoolVolatility) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zigchain.dex.PoolVolatility.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfString(value)
+	case "zigchain.dex.PoolVolatility.volatility":
+		value := x.Volatility
+		return protoreflect.ValueOfUint64(value)
+	case "zigchain.dex.PoolVolatility.last_height":
+		value := x.LastHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.PoolVolatility"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.PoolVolatility does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolVolatility) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zigchain.dex.PoolVolatility.pool_id":
+		x.PoolId = value.Interface().(string)
+	case "zigchain.dex.PoolVolatility.volatility":
+		x.Volatility = value.Uint()
+	case "zigchain.dex.PoolVolatility.last_height":
+		x.LastHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.PoolVolatility"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.PoolVolatility does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolVolatility) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.PoolVolatility.pool_id":
+		panic(fmt.Errorf("field pool_id of message zigchain.dex.PoolVolatility is not mutable"))
+	case "zigchain.dex.PoolVolatility.volatility":
+		panic(fmt.Errorf("field volatility of message zigchain.dex.PoolVolatility is not mutable"))
+	case "zigchain.dex.PoolVolatility.last_height":
+		panic(fmt.Errorf("field last_height of message zigchain.dex.PoolVolatility is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.PoolVolatility"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.PoolVolatility does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PoolVolatility) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.PoolVolatility.pool_id":
+		return protoreflect.ValueOfString("")
+	case "zigchain.dex.PoolVolatility.volatility":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zigchain.dex.PoolVolatility.last_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.PoolVolatility"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.PoolVolatility does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PoolVolatility) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.dex.PoolVolatility", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PoolVolatility) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolVolatility) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PoolVolatility) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PoolVolatility) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PoolVolatility)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PoolId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Volatility != 0 {
+			n += 1 + runtime.Sov(uint64(x.Volatility))
+		}
+		if x.LastHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PoolVolatility)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Volatility != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Volatility))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.PoolId) > 0 {
+			i -= len(x.PoolId)
+			copy(dAtA[i:], x.PoolId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PoolId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PoolVolatility)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PoolVolatility: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PoolVolatility: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PoolId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
+				}
+				x.Volatility = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Volatility |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+				}
+				x.LastHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: zigchain/dex/dynamic_fee.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DynamicFeeConfig holds the governable dynamic fee settings of a pool, when
+// enabled the swap fee scales between min_fee and max_fee based on the recent
+// price movement of the pool
+type DynamicFeeConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PoolId string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// enabled switches the pool from its static fee to the dynamic fee
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// min_fee is the fee floor, applied when the pool is calm (per 100,000)
+	MinFee uint32 `protobuf:"varint,3,opt,name=min_fee,json=minFee,proto3" json:"min_fee,omitempty"`
+	// max_fee is the fee ceiling, applied when the volatility reaches
+	// volatility_cap (per 100,000)
+	MaxFee uint32 `protobuf:"varint,4,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
+	// volatility_cap is the accumulated price movement (in basis points) at
+	// which the fee reaches max_fee
+	VolatilityCap uint32 `protobuf:"varint,5,opt,name=volatility_cap,json=volatilityCap,proto3" json:"volatility_cap,omitempty"`
+	// decay_window is the number of blocks over which the tracked volatility
+	// decays linearly back to zero
+	DecayWindow uint64 `protobuf:"varint,6,opt,name=decay_window,json=decayWindow,proto3" json:"decay_window,omitempty"`
+}
+
+func (x *DynamicFeeConfig) Reset() {
+	*x = DynamicFeeConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_dex_dynamic_fee_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DynamicFeeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicFeeConfig) ProtoMessage() {}
+
+// Deprecated: Use DynamicFeeConfig.ProtoReflect.Descriptor instead.
+func (*DynamicFeeConfig) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_dynamic_fee_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DynamicFeeConfig) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+func (x *DynamicFeeConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *DynamicFeeConfig) GetMinFee() uint32 {
+	if x != nil {
+		return x.MinFee
+	}
+	return 0
+}
+
+func (x *DynamicFeeConfig) GetMaxFee() uint32 {
+	if x != nil {
+		return x.MaxFee
+	}
+	return 0
+}
+
+func (x *DynamicFeeConfig) GetVolatilityCap() uint32 {
+	if x != nil {
+		return x.VolatilityCap
+	}
+	return 0
+}
+
+func (x *DynamicFeeConfig) GetDecayWindow() uint64 {
+	if x != nil {
+		return x.DecayWindow
+	}
+	return 0
+}
+
+// PoolVolatility tracks the recent price movement of a pool
+type PoolVolatility struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PoolId string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// volatility is the accumulated price movement in basis points, as of
+	// last_height
+	Volatility uint64 `protobuf:"varint,2,opt,name=volatility,proto3" json:"volatility,omitempty"`
+	// last_height is the block height of the last volatility update
+	LastHeight int64 `protobuf:"varint,3,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+}
+
+func (x *PoolVolatility) Reset() {
+	*x = PoolVolatility{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_dex_dynamic_fee_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolVolatility) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolVolatility) ProtoMessage() {}
+
+// Deprecated: Use PoolVolatility.ProtoReflect.Descriptor instead.
+func (*PoolVolatility) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_dynamic_fee_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PoolVolatility) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+func (x *PoolVolatility) GetVolatility() uint64 {
+	if x != nil {
+		return x.Volatility
+	}
+	return 0
+}
+
+func (x *PoolVolatility) GetLastHeight() int64 {
+	if x != nil {
+		return x.LastHeight
+	}
+	return 0
+}
+
+var File_zigchain_dex_dynamic_fee_proto protoreflect.FileDescriptor
+
+var file_zigchain_dex_dynamic_fee_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x64,
+	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x66, 0x65, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0c, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x22, 0xc1,
+	0x01, 0x0a, 0x10, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x61, 0x70, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x65, 0x63, 0x61, 0x79, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x65, 0x63, 0x61, 0x79, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x22, 0x6a, 0x0a, 0x0e, 0x50, 0x6f, 0x6f, 0x6c, 0x56, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x93,
+	0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x64, 0x65, 0x78, 0x42, 0x0f, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x46, 0x65, 0x65, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x64, 0x65, 0x78, 0xa2, 0x02, 0x03, 0x5a, 0x44, 0x58, 0xaa, 0x02, 0x0c, 0x5a, 0x69,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x78, 0xca, 0x02, 0x0c, 0x5a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x44, 0x65, 0x78, 0xe2, 0x02, 0x18, 0x5a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x44, 0x65, 0x78, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a,
+	0x3a, 0x44, 0x65, 0x78, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_zigchain_dex_dynamic_fee_proto_rawDescOnce sync.Once
+	file_zigchain_dex_dynamic_fee_proto_rawDescData = file_zigchain_dex_dynamic_fee_proto_rawDesc
+)
+
+func file_zigchain_dex_dynamic_fee_proto_rawDescGZIP() []byte {
+	file_zigchain_dex_dynamic_fee_proto_rawDescOnce.Do(func() {
+		file_zigchain_dex_dynamic_fee_proto_rawDescData = protoimpl.X.CompressGZIP(file_zigchain_dex_dynamic_fee_proto_rawDescData)
+	})
+	return file_zigchain_dex_dynamic_fee_proto_rawDescData
+}
+
+var file_zigchain_dex_dynamic_fee_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_zigchain_dex_dynamic_fee_proto_goTypes = []interface{}{
+	(*DynamicFeeConfig)(nil), // 0: zigchain.dex.DynamicFeeConfig
+	(*PoolVolatility)(nil),   // 1: zigchain.dex.PoolVolatility
+}
+var file_zigchain_dex_dynamic_fee_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_zigchain_dex_dynamic_fee_proto_init() }
+func file_zigchain_dex_dynamic_fee_proto_init() {
+	if File_zigchain_dex_dynamic_fee_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_zigchain_dex_dynamic_fee_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DynamicFeeConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zigchain_dex_dynamic_fee_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolVolatility); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_zigchain_dex_dynamic_fee_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_zigchain_dex_dynamic_fee_proto_goTypes,
+		DependencyIndexes: file_zigchain_dex_dynamic_fee_proto_depIdxs,
+		MessageInfos:      file_zigchain_dex_dynamic_fee_proto_msgTypes,
+	}.Build()
+	File_zigchain_dex_dynamic_fee_proto = out.File
+	file_zigchain_dex_dynamic_fee_proto_rawDesc = nil
+	file_zigchain_dex_dynamic_fee_proto_goTypes = nil
+	file_zigchain_dex_dynamic_fee_proto_depIdxs = nil
+}
@@ -116,12 +116,118 @@ func (x *_GenesisState_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*DynamicFeeConfig
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DynamicFeeConfig)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DynamicFeeConfig)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(DynamicFeeConfig)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(DynamicFeeConfig)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*PoolVolatility
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoolVolatility)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PoolVolatility)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(PoolVolatility)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(PoolVolatility)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                protoreflect.MessageDescriptor
-	fd_GenesisState_params         protoreflect.FieldDescriptor
-	fd_GenesisState_pool_list      protoreflect.FieldDescriptor
-	fd_GenesisState_pools_meta     protoreflect.FieldDescriptor
-	fd_GenesisState_pool_uids_list protoreflect.FieldDescriptor
+	md_GenesisState                         protoreflect.MessageDescriptor
+	fd_GenesisState_params                  protoreflect.FieldDescriptor
+	fd_GenesisState_pool_list               protoreflect.FieldDescriptor
+	fd_GenesisState_pools_meta              protoreflect.FieldDescriptor
+	fd_GenesisState_pool_uids_list          protoreflect.FieldDescriptor
+	fd_GenesisState_dynamic_fee_config_list protoreflect.FieldDescriptor
+	fd_GenesisState_pool_volatility_list    protoreflect.FieldDescriptor
+	fd_GenesisState_buyback_config          protoreflect.FieldDescriptor
+	fd_GenesisState_buyback_stats           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -131,6 +237,10 @@ func init() {
 	fd_GenesisState_pool_list = md_GenesisState.Fields().ByName("pool_list")
 	fd_GenesisState_pools_meta = md_GenesisState.Fields().ByName("pools_meta")
 	fd_GenesisState_pool_uids_list = md_GenesisState.Fields().ByName("pool_uids_list")
+	fd_GenesisState_dynamic_fee_config_list = md_GenesisState.Fields().ByName("dynamic_fee_config_list")
+	fd_GenesisState_pool_volatility_list = md_GenesisState.Fields().ByName("pool_volatility_list")
+	fd_GenesisState_buyback_config = md_GenesisState.Fields().ByName("buyback_config")
+	fd_GenesisState_buyback_stats = md_GenesisState.Fields().ByName("buyback_stats")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -222,6 +332,30 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.DynamicFeeConfigList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.DynamicFeeConfigList})
+		if !f(fd_GenesisState_dynamic_fee_config_list, value) {
+			return
+		}
+	}
+	if len(x.PoolVolatilityList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.PoolVolatilityList})
+		if !f(fd_GenesisState_pool_volatility_list, value) {
+			return
+		}
+	}
+	if x.BuybackConfig != nil {
+		value := protoreflect.ValueOfMessage(x.BuybackConfig.ProtoReflect())
+		if !f(fd_GenesisState_buyback_config, value) {
+			return
+		}
+	}
+	if x.BuybackStats != nil {
+		value := protoreflect.ValueOfMessage(x.BuybackStats.ProtoReflect())
+		if !f(fd_GenesisState_buyback_stats, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -245,6 +379,14 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.PoolsMeta != nil
 	case "zigchain.dex.GenesisState.pool_uids_list":
 		return len(x.PoolUidsList) != 0
+	case "zigchain.dex.GenesisState.dynamic_fee_config_list":
+		return len(x.DynamicFeeConfigList) != 0
+	case "zigchain.dex.GenesisState.pool_volatility_list":
+		return len(x.PoolVolatilityList) != 0
+	case "zigchain.dex.GenesisState.buyback_config":
+		return x.BuybackConfig != nil
+	case "zigchain.dex.GenesisState.buyback_stats":
+		return x.BuybackStats != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.GenesisState"))
@@ -269,6 +411,14 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.PoolsMeta = nil
 	case "zigchain.dex.GenesisState.pool_uids_list":
 		x.PoolUidsList = nil
+	case "zigchain.dex.GenesisState.dynamic_fee_config_list":
+		x.DynamicFeeConfigList = nil
+	case "zigchain.dex.GenesisState.pool_volatility_list":
+		x.PoolVolatilityList = nil
+	case "zigchain.dex.GenesisState.buyback_config":
+		x.BuybackConfig = nil
+	case "zigchain.dex.GenesisState.buyback_stats":
+		x.BuybackStats = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.GenesisState"))
@@ -303,6 +453,24 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_4_list{list: &x.PoolUidsList}
 		return protoreflect.ValueOfList(listValue)
+	case "zigchain.dex.GenesisState.dynamic_fee_config_list":
+		if len(x.DynamicFeeConfigList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.DynamicFeeConfigList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.dex.GenesisState.pool_volatility_list":
+		if len(x.PoolVolatilityList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.PoolVolatilityList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.dex.GenesisState.buyback_config":
+		value := x.BuybackConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zigchain.dex.GenesisState.buyback_stats":
+		value := x.BuybackStats
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.GenesisState"))
@@ -335,6 +503,18 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_4_list)
 		x.PoolUidsList = *clv.list
+	case "zigchain.dex.GenesisState.dynamic_fee_config_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.DynamicFeeConfigList = *clv.list
+	case "zigchain.dex.GenesisState.pool_volatility_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.PoolVolatilityList = *clv.list
+	case "zigchain.dex.GenesisState.buyback_config":
+		x.BuybackConfig = value.Message().Interface().(*BuybackConfig)
+	case "zigchain.dex.GenesisState.buyback_stats":
+		x.BuybackStats = value.Message().Interface().(*BuybackStats)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.GenesisState"))
@@ -377,6 +557,28 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_4_list{list: &x.PoolUidsList}
 		return protoreflect.ValueOfList(value)
+	case "zigchain.dex.GenesisState.dynamic_fee_config_list":
+		if x.DynamicFeeConfigList == nil {
+			x.DynamicFeeConfigList = []*DynamicFeeConfig{}
+		}
+		value := &_GenesisState_5_list{list: &x.DynamicFeeConfigList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.dex.GenesisState.pool_volatility_list":
+		if x.PoolVolatilityList == nil {
+			x.PoolVolatilityList = []*PoolVolatility{}
+		}
+		value := &_GenesisState_6_list{list: &x.PoolVolatilityList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.dex.GenesisState.buyback_config":
+		if x.BuybackConfig == nil {
+			x.BuybackConfig = new(BuybackConfig)
+		}
+		return protoreflect.ValueOfMessage(x.BuybackConfig.ProtoReflect())
+	case "zigchain.dex.GenesisState.buyback_stats":
+		if x.BuybackStats == nil {
+			x.BuybackStats = new(BuybackStats)
+		}
+		return protoreflect.ValueOfMessage(x.BuybackStats.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.GenesisState"))
@@ -402,6 +604,18 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "zigchain.dex.GenesisState.pool_uids_list":
 		list := []*PoolUids{}
 		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "zigchain.dex.GenesisState.dynamic_fee_config_list":
+		list := []*DynamicFeeConfig{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "zigchain.dex.GenesisState.pool_volatility_list":
+		list := []*PoolVolatility{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "zigchain.dex.GenesisState.buyback_config":
+		m := new(BuybackConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zigchain.dex.GenesisState.buyback_stats":
+		m := new(BuybackStats)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.GenesisState"))
@@ -491,6 +705,26 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.DynamicFeeConfigList) > 0 {
+			for _, e := range x.DynamicFeeConfigList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PoolVolatilityList) > 0 {
+			for _, e := range x.PoolVolatilityList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BuybackConfig != nil {
+			l = options.Size(x.BuybackConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BuybackStats != nil {
+			l = options.Size(x.BuybackStats)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -520,6 +754,66 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.BuybackStats != nil {
+			encoded, err := options.Marshal(x.BuybackStats)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.BuybackConfig != nil {
+			encoded, err := options.Marshal(x.BuybackConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.PoolVolatilityList) > 0 {
+			for iNdEx := len(x.PoolVolatilityList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PoolVolatilityList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.DynamicFeeConfigList) > 0 {
+			for iNdEx := len(x.DynamicFeeConfigList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DynamicFeeConfigList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
 		if len(x.PoolUidsList) > 0 {
 			for iNdEx := len(x.PoolUidsList) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.PoolUidsList[iNdEx])
@@ -769,6 +1063,146 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DynamicFeeConfigList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DynamicFeeConfigList = append(x.DynamicFeeConfigList, &DynamicFeeConfig{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DynamicFeeConfigList[len(x.DynamicFeeConfigList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolVolatilityList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PoolVolatilityList = append(x.PoolVolatilityList, &PoolVolatility{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PoolVolatilityList[len(x.PoolVolatilityList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BuybackConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BuybackConfig == nil {
+					x.BuybackConfig = &BuybackConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BuybackConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BuybackStats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BuybackStats == nil {
+					x.BuybackStats = &BuybackStats{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BuybackStats); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -824,10 +1258,14 @@ type GenesisState struct {
 	unknownFields protoimpl.UnknownFields
 
 	// params defines all the parameters of the module.
-	Params       *Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	PoolList     []*Pool     `protobuf:"bytes,2,rep,name=pool_list,json=poolList,proto3" json:"pool_list,omitempty"`
-	PoolsMeta    *PoolsMeta  `protobuf:"bytes,3,opt,name=pools_meta,json=poolsMeta,proto3" json:"pools_meta,omitempty"`
-	PoolUidsList []*PoolUids `protobuf:"bytes,4,rep,name=pool_uids_list,json=poolUidsList,proto3" json:"pool_uids_list,omitempty"`
+	Params               *Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	PoolList             []*Pool             `protobuf:"bytes,2,rep,name=pool_list,json=poolList,proto3" json:"pool_list,omitempty"`
+	PoolsMeta            *PoolsMeta          `protobuf:"bytes,3,opt,name=pools_meta,json=poolsMeta,proto3" json:"pools_meta,omitempty"`
+	PoolUidsList         []*PoolUids         `protobuf:"bytes,4,rep,name=pool_uids_list,json=poolUidsList,proto3" json:"pool_uids_list,omitempty"`
+	DynamicFeeConfigList []*DynamicFeeConfig `protobuf:"bytes,5,rep,name=dynamic_fee_config_list,json=dynamicFeeConfigList,proto3" json:"dynamic_fee_config_list,omitempty"`
+	PoolVolatilityList   []*PoolVolatility   `protobuf:"bytes,6,rep,name=pool_volatility_list,json=poolVolatilityList,proto3" json:"pool_volatility_list,omitempty"`
+	BuybackConfig        *BuybackConfig      `protobuf:"bytes,7,opt,name=buyback_config,json=buybackConfig,proto3" json:"buyback_config,omitempty"`
+	BuybackStats         *BuybackStats       `protobuf:"bytes,8,opt,name=buyback_stats,json=buybackStats,proto3" json:"buyback_stats,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -878,6 +1316,34 @@ func (x *GenesisState) GetPoolUidsList() []*PoolUids {
 	return nil
 }
 
+func (x *GenesisState) GetDynamicFeeConfigList() []*DynamicFeeConfig {
+	if x != nil {
+		return x.DynamicFeeConfigList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPoolVolatilityList() []*PoolVolatility {
+	if x != nil {
+		return x.PoolVolatilityList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBuybackConfig() *BuybackConfig {
+	if x != nil {
+		return x.BuybackConfig
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBuybackStats() *BuybackStats {
+	if x != nil {
+		return x.BuybackStats
+	}
+	return nil
+}
+
 var File_zigchain_dex_genesis_proto protoreflect.FileDescriptor
 
 var file_zigchain_dex_genesis_proto_rawDesc = []byte{
@@ -886,39 +1352,64 @@ var file_zigchain_dex_genesis_proto_rawDesc = []byte{
 	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e,
 	0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67,
 	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65,
-	0x78, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
-	0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x6f, 0x6f,
-	0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x61,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x69, 0x64, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfa, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2e, 0x64, 0x65, 0x78, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f,
-	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x35,
-	0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78,
-	0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x70, 0x6f, 0x6f,
-	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x5f, 0x6d,
-	0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a, 0x69, 0x67, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x4d, 0x65,
-	0x74, 0x61, 0x52, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x42, 0x0a,
-	0x0e, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x69, 0x64, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2e, 0x64, 0x65, 0x78, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x73, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x0c, 0x70, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x90, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x64, 0x65, 0x78, 0xa2, 0x02, 0x03, 0x5a, 0x44, 0x58, 0xaa, 0x02, 0x0c, 0x5a, 0x69,
-	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x78, 0xca, 0x02, 0x0c, 0x5a, 0x69, 0x67,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x44, 0x65, 0x78, 0xe2, 0x02, 0x18, 0x5a, 0x69, 0x67, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x44, 0x65, 0x78, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a,
-	0x3a, 0x44, 0x65, 0x78, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65,
+	0x78, 0x2f, 0x62, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x64, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x66, 0x65, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x19, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x7a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65,
+	0x78, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78,
+	0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x69, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xc8, 0x04, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78,
+	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x50, 0x6f, 0x6f,
+	0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x36, 0x0a, 0x0a, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x64, 0x65, 0x78, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x09,
+	0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x42, 0x0a, 0x0e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x75, 0x69, 0x64, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78,
+	0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0c, 0x70, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5b, 0x0a,
+	0x17, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x44, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x46, 0x65, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x14, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x56, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x70, 0x6f,
+	0x6f, 0x6c, 0x56, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x4d, 0x0a, 0x0e, 0x62, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x42, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0d, 0x62, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x4a, 0x0a, 0x0d, 0x62, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x42, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x62,
+	0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x90, 0x01, 0x0a, 0x10,
+	0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78,
+	0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0xa2,
+	0x02, 0x03, 0x5a, 0x44, 0x58, 0xaa, 0x02, 0x0c, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x44, 0x65, 0x78, 0xca, 0x02, 0x0c, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c,
+	0x44, 0x65, 0x78, 0xe2, 0x02, 0x18, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x44,
+	0x65, 0x78, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x0d, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x44, 0x65, 0x78, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -935,22 +1426,30 @@ func file_zigchain_dex_genesis_proto_rawDescGZIP() []byte {
 
 var file_zigchain_dex_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_zigchain_dex_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: zigchain.dex.GenesisState
-	(*Params)(nil),       // 1: zigchain.dex.Params
-	(*Pool)(nil),         // 2: zigchain.dex.Pool
-	(*PoolsMeta)(nil),    // 3: zigchain.dex.PoolsMeta
-	(*PoolUids)(nil),     // 4: zigchain.dex.PoolUids
+	(*GenesisState)(nil),     // 0: zigchain.dex.GenesisState
+	(*Params)(nil),           // 1: zigchain.dex.Params
+	(*Pool)(nil),             // 2: zigchain.dex.Pool
+	(*PoolsMeta)(nil),        // 3: zigchain.dex.PoolsMeta
+	(*PoolUids)(nil),         // 4: zigchain.dex.PoolUids
+	(*DynamicFeeConfig)(nil), // 5: zigchain.dex.DynamicFeeConfig
+	(*PoolVolatility)(nil),   // 6: zigchain.dex.PoolVolatility
+	(*BuybackConfig)(nil),    // 7: zigchain.dex.BuybackConfig
+	(*BuybackStats)(nil),     // 8: zigchain.dex.BuybackStats
 }
 var file_zigchain_dex_genesis_proto_depIdxs = []int32{
 	1, // 0: zigchain.dex.GenesisState.params:type_name -> zigchain.dex.Params
 	2, // 1: zigchain.dex.GenesisState.pool_list:type_name -> zigchain.dex.Pool
 	3, // 2: zigchain.dex.GenesisState.pools_meta:type_name -> zigchain.dex.PoolsMeta
 	4, // 3: zigchain.dex.GenesisState.pool_uids_list:type_name -> zigchain.dex.PoolUids
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	5, // 4: zigchain.dex.GenesisState.dynamic_fee_config_list:type_name -> zigchain.dex.DynamicFeeConfig
+	6, // 5: zigchain.dex.GenesisState.pool_volatility_list:type_name -> zigchain.dex.PoolVolatility
+	7, // 6: zigchain.dex.GenesisState.buyback_config:type_name -> zigchain.dex.BuybackConfig
+	8, // 7: zigchain.dex.GenesisState.buyback_stats:type_name -> zigchain.dex.BuybackStats
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_zigchain_dex_genesis_proto_init() }
@@ -958,6 +1457,8 @@ func file_zigchain_dex_genesis_proto_init() {
 	if File_zigchain_dex_genesis_proto != nil {
 		return
 	}
+	file_zigchain_dex_buyback_proto_init()
+	file_zigchain_dex_dynamic_fee_proto_init()
 	file_zigchain_dex_params_proto_init()
 	file_zigchain_dex_pool_proto_init()
 	file_zigchain_dex_pools_meta_proto_init()
@@ -3,6 +3,7 @@ package dex
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -17,32 +18,675 @@ import (
 var (
 	md_Params                        protoreflect.MessageDescriptor
 	fd_Params_new_pool_fee_pct       protoreflect.FieldDescriptor
-	fd_Params_creation_fee           protoreflect.FieldDescriptor
 	fd_Params_beneficiary            protoreflect.FieldDescriptor
 	fd_Params_minimal_liquidity_lock protoreflect.FieldDescriptor
 	fd_Params_max_slippage           protoreflect.FieldDescriptor
+	fd_Params_creation_fee           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zigchain_dex_params_proto_init()
+	md_Params = File_zigchain_dex_params_proto.Messages().ByName("Params")
+	fd_Params_new_pool_fee_pct = md_Params.Fields().ByName("new_pool_fee_pct")
+	fd_Params_beneficiary = md_Params.Fields().ByName("beneficiary")
+	fd_Params_minimal_liquidity_lock = md_Params.Fields().ByName("minimal_liquidity_lock")
+	fd_Params_max_slippage = md_Params.Fields().ByName("max_slippage")
+	fd_Params_creation_fee = md_Params.Fields().ByName("creation_fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_dex_params_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.NewPoolFeePct != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.NewPoolFeePct)
+		if !f(fd_Params_new_pool_fee_pct, value) {
+			return
+		}
+	}
+	if x.Beneficiary != "" {
+		value := protoreflect.ValueOfString(x.Beneficiary)
+		if !f(fd_Params_beneficiary, value) {
+			return
+		}
+	}
+	if x.MinimalLiquidityLock != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MinimalLiquidityLock)
+		if !f(fd_Params_minimal_liquidity_lock, value) {
+			return
+		}
+	}
+	if x.MaxSlippage != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxSlippage)
+		if !f(fd_Params_max_slippage, value) {
+			return
+		}
+	}
+	if x.CreationFee != nil {
+		value := protoreflect.ValueOfMessage(x.CreationFee.ProtoReflect())
+		if !f(fd_Params_creation_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zigchain.dex.Params.new_pool_fee_pct":
+		return x.NewPoolFeePct != uint32(0)
+	case "zigchain.dex.Params.beneficiary":
+		return x.Beneficiary != ""
+	case "zigchain.dex.Params.minimal_liquidity_lock":
+		return x.MinimalLiquidityLock != uint32(0)
+	case "zigchain.dex.Params.max_slippage":
+		return x.MaxSlippage != uint32(0)
+	case "zigchain.dex.Params.creation_fee":
+		return x.CreationFee != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.Params"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zigchain.dex.Params.new_pool_fee_pct":
+		x.NewPoolFeePct = uint32(0)
+	case "zigchain.dex.Params.beneficiary":
+		x.Beneficiary = ""
+	case "zigchain.dex.Params.minimal_liquidity_lock":
+		x.MinimalLiquidityLock = uint32(0)
+	case "zigchain.dex.Params.max_slippage":
+		x.MaxSlippage = uint32(0)
+	case "zigchain.dex.Params.creation_fee":
+		x.CreationFee = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.Params"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zigchain.dex.Params.new_pool_fee_pct":
+		value := x.NewPoolFeePct
+		return protoreflect.ValueOfUint32(value)
+	case "zigchain.dex.Params.beneficiary":
+		value := x.Beneficiary
+		return protoreflect.ValueOfString(value)
+	case "zigchain.dex.Params.minimal_liquidity_lock":
+		value := x.MinimalLiquidityLock
+		return protoreflect.ValueOfUint32(value)
+	case "zigchain.dex.Params.max_slippage":
+		value := x.MaxSlippage
+		return protoreflect.ValueOfUint32(value)
+	case "zigchain.dex.Params.creation_fee":
+		value := x.CreationFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.Params"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zigchain.dex.Params.new_pool_fee_pct":
+		x.NewPoolFeePct = uint32(value.Uint())
+	case "zigchain.dex.Params.beneficiary":
+		x.Beneficiary = value.Interface().(string)
+	case "zigchain.dex.Params.minimal_liquidity_lock":
+		x.MinimalLiquidityLock = uint32(value.Uint())
+	case "zigchain.dex.Params.max_slippage":
+		x.MaxSlippage = uint32(value.Uint())
+	case "zigchain.dex.Params.creation_fee":
+		x.CreationFee = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.Params"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.Params.creation_fee":
+		if x.CreationFee == nil {
+			x.CreationFee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.CreationFee.ProtoReflect())
+	case "zigchain.dex.Params.new_pool_fee_pct":
+		panic(fmt.Errorf("field new_pool_fee_pct of message zigchain.dex.Params is not mutable"))
+	case "zigchain.dex.Params.beneficiary":
+		panic(fmt.Errorf("field beneficiary of message zigchain.dex.Params is not mutable"))
+	case "zigchain.dex.Params.minimal_liquidity_lock":
+		panic(fmt.Errorf("field minimal_liquidity_lock of message zigchain.dex.Params is not mutable"))
+	case "zigchain.dex.Params.max_slippage":
+		panic(fmt.Errorf("field max_slippage of message zigchain.dex.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.Params"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.Params.new_pool_fee_pct":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "zigchain.dex.Params.beneficiary":
+		return protoreflect.ValueOfString("")
+	case "zigchain.dex.Params.minimal_liquidity_lock":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "zigchain.dex.Params.max_slippage":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "zigchain.dex.Params.creation_fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.Params"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.dex.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.NewPoolFeePct != 0 {
+			n += 1 + runtime.Sov(uint64(x.NewPoolFeePct))
+		}
+		l = len(x.Beneficiary)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinimalLiquidityLock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinimalLiquidityLock))
+		}
+		if x.MaxSlippage != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxSlippage))
+		}
+		if x.CreationFee != nil {
+			l = options.Size(x.CreationFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CreationFee != nil {
+			encoded, err := options.Marshal(x.CreationFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MaxSlippage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSlippage))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MinimalLiquidityLock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinimalLiquidityLock))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Beneficiary) > 0 {
+			i -= len(x.Beneficiary)
+			copy(dAtA[i:], x.Beneficiary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Beneficiary)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.NewPoolFeePct != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewPoolFeePct))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewPoolFeePct", wireType)
+				}
+				x.NewPoolFeePct = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NewPoolFeePct |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Beneficiary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinimalLiquidityLock", wireType)
+				}
+				x.MinimalLiquidityLock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinimalLiquidityLock |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+				}
+				x.MaxSlippage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSlippage |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreationFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CreationFee == nil {
+					x.CreationFee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreationFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_LegacyParams                        protoreflect.MessageDescriptor
+	fd_LegacyParams_new_pool_fee_pct       protoreflect.FieldDescriptor
+	fd_LegacyParams_creation_fee           protoreflect.FieldDescriptor
+	fd_LegacyParams_beneficiary            protoreflect.FieldDescriptor
+	fd_LegacyParams_minimal_liquidity_lock protoreflect.FieldDescriptor
+	fd_LegacyParams_max_slippage           protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_zigchain_dex_params_proto_init()
-	md_Params = File_zigchain_dex_params_proto.Messages().ByName("Params")
-	fd_Params_new_pool_fee_pct = md_Params.Fields().ByName("new_pool_fee_pct")
-	fd_Params_creation_fee = md_Params.Fields().ByName("creation_fee")
-	fd_Params_beneficiary = md_Params.Fields().ByName("beneficiary")
-	fd_Params_minimal_liquidity_lock = md_Params.Fields().ByName("minimal_liquidity_lock")
-	fd_Params_max_slippage = md_Params.Fields().ByName("max_slippage")
+	md_LegacyParams = File_zigchain_dex_params_proto.Messages().ByName("LegacyParams")
+	fd_LegacyParams_new_pool_fee_pct = md_LegacyParams.Fields().ByName("new_pool_fee_pct")
+	fd_LegacyParams_creation_fee = md_LegacyParams.Fields().ByName("creation_fee")
+	fd_LegacyParams_beneficiary = md_LegacyParams.Fields().ByName("beneficiary")
+	fd_LegacyParams_minimal_liquidity_lock = md_LegacyParams.Fields().ByName("minimal_liquidity_lock")
+	fd_LegacyParams_max_slippage = md_LegacyParams.Fields().ByName("max_slippage")
 }
 
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
+var _ protoreflect.Message = (*fastReflection_LegacyParams)(nil)
 
-type fastReflection_Params Params
+type fastReflection_LegacyParams LegacyParams
 
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
+func (x *LegacyParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LegacyParams)(x)
 }
 
-func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_zigchain_dex_params_proto_msgTypes[0]
+func (x *LegacyParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_dex_params_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -53,43 +697,43 @@ func (x *Params) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_LegacyParams_messageType fastReflection_LegacyParams_messageType
+var _ protoreflect.MessageType = fastReflection_LegacyParams_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_LegacyParams_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_LegacyParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LegacyParams)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_LegacyParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_LegacyParams)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_LegacyParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LegacyParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_LegacyParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_LegacyParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_LegacyParams) Type() protoreflect.MessageType {
+	return _fastReflection_LegacyParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_LegacyParams) New() protoreflect.Message {
+	return new(fastReflection_LegacyParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_LegacyParams) Interface() protoreflect.ProtoMessage {
+	return (*LegacyParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -97,34 +741,34 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_LegacyParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.NewPoolFeePct != uint32(0) {
 		value := protoreflect.ValueOfUint32(x.NewPoolFeePct)
-		if !f(fd_Params_new_pool_fee_pct, value) {
+		if !f(fd_LegacyParams_new_pool_fee_pct, value) {
 			return
 		}
 	}
 	if x.CreationFee != uint32(0) {
 		value := protoreflect.ValueOfUint32(x.CreationFee)
-		if !f(fd_Params_creation_fee, value) {
+		if !f(fd_LegacyParams_creation_fee, value) {
 			return
 		}
 	}
 	if x.Beneficiary != "" {
 		value := protoreflect.ValueOfString(x.Beneficiary)
-		if !f(fd_Params_beneficiary, value) {
+		if !f(fd_LegacyParams_beneficiary, value) {
 			return
 		}
 	}
 	if x.MinimalLiquidityLock != uint32(0) {
 		value := protoreflect.ValueOfUint32(x.MinimalLiquidityLock)
-		if !f(fd_Params_minimal_liquidity_lock, value) {
+		if !f(fd_LegacyParams_minimal_liquidity_lock, value) {
 			return
 		}
 	}
 	if x.MaxSlippage != uint32(0) {
 		value := protoreflect.ValueOfUint32(x.MaxSlippage)
-		if !f(fd_Params_max_slippage, value) {
+		if !f(fd_LegacyParams_max_slippage, value) {
 			return
 		}
 	}
@@ -141,23 +785,23 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_LegacyParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "zigchain.dex.Params.new_pool_fee_pct":
+	case "zigchain.dex.LegacyParams.new_pool_fee_pct":
 		return x.NewPoolFeePct != uint32(0)
-	case "zigchain.dex.Params.creation_fee":
+	case "zigchain.dex.LegacyParams.creation_fee":
 		return x.CreationFee != uint32(0)
-	case "zigchain.dex.Params.beneficiary":
+	case "zigchain.dex.LegacyParams.beneficiary":
 		return x.Beneficiary != ""
-	case "zigchain.dex.Params.minimal_liquidity_lock":
+	case "zigchain.dex.LegacyParams.minimal_liquidity_lock":
 		return x.MinimalLiquidityLock != uint32(0)
-	case "zigchain.dex.Params.max_slippage":
+	case "zigchain.dex.LegacyParams.max_slippage":
 		return x.MaxSlippage != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.LegacyParams"))
 		}
-		panic(fmt.Errorf("message zigchain.dex.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message zigchain.dex.LegacyParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -167,23 +811,23 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_LegacyParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "zigchain.dex.Params.new_pool_fee_pct":
+	case "zigchain.dex.LegacyParams.new_pool_fee_pct":
 		x.NewPoolFeePct = uint32(0)
-	case "zigchain.dex.Params.creation_fee":
+	case "zigchain.dex.LegacyParams.creation_fee":
 		x.CreationFee = uint32(0)
-	case "zigchain.dex.Params.beneficiary":
+	case "zigchain.dex.LegacyParams.beneficiary":
 		x.Beneficiary = ""
-	case "zigchain.dex.Params.minimal_liquidity_lock":
+	case "zigchain.dex.LegacyParams.minimal_liquidity_lock":
 		x.MinimalLiquidityLock = uint32(0)
-	case "zigchain.dex.Params.max_slippage":
+	case "zigchain.dex.LegacyParams.max_slippage":
 		x.MaxSlippage = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.LegacyParams"))
 		}
-		panic(fmt.Errorf("message zigchain.dex.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message zigchain.dex.LegacyParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -193,28 +837,28 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LegacyParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "zigchain.dex.Params.new_pool_fee_pct":
+	case "zigchain.dex.LegacyParams.new_pool_fee_pct":
 		value := x.NewPoolFeePct
 		return protoreflect.ValueOfUint32(value)
-	case "zigchain.dex.Params.creation_fee":
+	case "zigchain.dex.LegacyParams.creation_fee":
 		value := x.CreationFee
 		return protoreflect.ValueOfUint32(value)
-	case "zigchain.dex.Params.beneficiary":
+	case "zigchain.dex.LegacyParams.beneficiary":
 		value := x.Beneficiary
 		return protoreflect.ValueOfString(value)
-	case "zigchain.dex.Params.minimal_liquidity_lock":
+	case "zigchain.dex.LegacyParams.minimal_liquidity_lock":
 		value := x.MinimalLiquidityLock
 		return protoreflect.ValueOfUint32(value)
-	case "zigchain.dex.Params.max_slippage":
+	case "zigchain.dex.LegacyParams.max_slippage":
 		value := x.MaxSlippage
 		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.LegacyParams"))
 		}
-		panic(fmt.Errorf("message zigchain.dex.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message zigchain.dex.LegacyParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -228,23 +872,23 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_LegacyParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "zigchain.dex.Params.new_pool_fee_pct":
+	case "zigchain.dex.LegacyParams.new_pool_fee_pct":
 		x.NewPoolFeePct = uint32(value.Uint())
-	case "zigchain.dex.Params.creation_fee":
+	case "zigchain.dex.LegacyParams.creation_fee":
 		x.CreationFee = uint32(value.Uint())
-	case "zigchain.dex.Params.beneficiary":
+	case "zigchain.dex.LegacyParams.beneficiary":
 		x.Beneficiary = value.Interface().(string)
-	case "zigchain.dex.Params.minimal_liquidity_lock":
+	case "zigchain.dex.LegacyParams.minimal_liquidity_lock":
 		x.MinimalLiquidityLock = uint32(value.Uint())
-	case "zigchain.dex.Params.max_slippage":
+	case "zigchain.dex.LegacyParams.max_slippage":
 		x.MaxSlippage = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.LegacyParams"))
 		}
-		panic(fmt.Errorf("message zigchain.dex.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message zigchain.dex.LegacyParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -258,56 +902,56 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LegacyParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "zigchain.dex.Params.new_pool_fee_pct":
-		panic(fmt.Errorf("field new_pool_fee_pct of message zigchain.dex.Params is not mutable"))
-	case "zigchain.dex.Params.creation_fee":
-		panic(fmt.Errorf("field creation_fee of message zigchain.dex.Params is not mutable"))
-	case "zigchain.dex.Params.beneficiary":
-		panic(fmt.Errorf("field beneficiary of message zigchain.dex.Params is not mutable"))
-	case "zigchain.dex.Params.minimal_liquidity_lock":
-		panic(fmt.Errorf("field minimal_liquidity_lock of message zigchain.dex.Params is not mutable"))
-	case "zigchain.dex.Params.max_slippage":
-		panic(fmt.Errorf("field max_slippage of message zigchain.dex.Params is not mutable"))
+	case "zigchain.dex.LegacyParams.new_pool_fee_pct":
+		panic(fmt.Errorf("field new_pool_fee_pct of message zigchain.dex.LegacyParams is not mutable"))
+	case "zigchain.dex.LegacyParams.creation_fee":
+		panic(fmt.Errorf("field creation_fee of message zigchain.dex.LegacyParams is not mutable"))
+	case "zigchain.dex.LegacyParams.beneficiary":
+		panic(fmt.Errorf("field beneficiary of message zigchain.dex.LegacyParams is not mutable"))
+	case "zigchain.dex.LegacyParams.minimal_liquidity_lock":
+		panic(fmt.Errorf("field minimal_liquidity_lock of message zigchain.dex.LegacyParams is not mutable"))
+	case "zigchain.dex.LegacyParams.max_slippage":
+		panic(fmt.Errorf("field max_slippage of message zigchain.dex.LegacyParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.LegacyParams"))
 		}
-		panic(fmt.Errorf("message zigchain.dex.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message zigchain.dex.LegacyParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LegacyParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "zigchain.dex.Params.new_pool_fee_pct":
+	case "zigchain.dex.LegacyParams.new_pool_fee_pct":
 		return protoreflect.ValueOfUint32(uint32(0))
-	case "zigchain.dex.Params.creation_fee":
+	case "zigchain.dex.LegacyParams.creation_fee":
 		return protoreflect.ValueOfUint32(uint32(0))
-	case "zigchain.dex.Params.beneficiary":
+	case "zigchain.dex.LegacyParams.beneficiary":
 		return protoreflect.ValueOfString("")
-	case "zigchain.dex.Params.minimal_liquidity_lock":
+	case "zigchain.dex.LegacyParams.minimal_liquidity_lock":
 		return protoreflect.ValueOfUint32(uint32(0))
-	case "zigchain.dex.Params.max_slippage":
+	case "zigchain.dex.LegacyParams.max_slippage":
 		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.LegacyParams"))
 		}
-		panic(fmt.Errorf("message zigchain.dex.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message zigchain.dex.LegacyParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_LegacyParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in zigchain.dex.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.dex.LegacyParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -315,7 +959,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_LegacyParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -326,7 +970,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_LegacyParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -338,7 +982,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_LegacyParams) IsValid() bool {
 	return x != nil
 }
 
@@ -348,9 +992,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_LegacyParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*LegacyParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -388,7 +1032,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*LegacyParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -445,7 +1089,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*LegacyParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -477,10 +1121,10 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LegacyParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LegacyParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -647,8 +1291,6 @@ type Params struct {
 
 	// newPoolFeePct is the percentage of the fee default on new pool
 	NewPoolFeePct uint32 `protobuf:"varint,1,opt,name=new_pool_fee_pct,json=newPoolFeePct,proto3" json:"new_pool_fee_pct,omitempty"`
-	// creationFee is the fee to create a new pool
-	CreationFee uint32 `protobuf:"varint,2,opt,name=creation_fee,json=creationFee,proto3" json:"creation_fee,omitempty"`
 	// beneficiary is the address that receives the fee to create a new factory
 	Beneficiary string `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
 	// minimalLiquidityLock is the minimum amount of LP tokens that are locked in
@@ -657,6 +1299,8 @@ type Params struct {
 	// maxSlippage is the maximum allowed slippage percentage for liquidity
 	// deposits (in basis points, 1 = 0.01%)
 	MaxSlippage uint32 `protobuf:"varint,5,opt,name=max_slippage,json=maxSlippage,proto3" json:"max_slippage,omitempty"`
+	// creationFee is the fee to create a new pool
+	CreationFee *v1beta1.Coin `protobuf:"bytes,6,opt,name=creation_fee,json=creationFee,proto3" json:"creation_fee,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -686,28 +1330,97 @@ func (x *Params) GetNewPoolFeePct() uint32 {
 	return 0
 }
 
-func (x *Params) GetCreationFee() uint32 {
+func (x *Params) GetBeneficiary() string {
+	if x != nil {
+		return x.Beneficiary
+	}
+	return ""
+}
+
+func (x *Params) GetMinimalLiquidityLock() uint32 {
+	if x != nil {
+		return x.MinimalLiquidityLock
+	}
+	return 0
+}
+
+func (x *Params) GetMaxSlippage() uint32 {
+	if x != nil {
+		return x.MaxSlippage
+	}
+	return 0
+}
+
+func (x *Params) GetCreationFee() *v1beta1.Coin {
+	if x != nil {
+		return x.CreationFee
+	}
+	return nil
+}
+
+// LegacyParams defines the parameters for the module before the creation fee
+// was migrated to a coin, used by the v3 migration only
+type LegacyParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NewPoolFeePct        uint32 `protobuf:"varint,1,opt,name=new_pool_fee_pct,json=newPoolFeePct,proto3" json:"new_pool_fee_pct,omitempty"`
+	CreationFee          uint32 `protobuf:"varint,2,opt,name=creation_fee,json=creationFee,proto3" json:"creation_fee,omitempty"`
+	Beneficiary          string `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	MinimalLiquidityLock uint32 `protobuf:"varint,4,opt,name=minimal_liquidity_lock,json=minimalLiquidityLock,proto3" json:"minimal_liquidity_lock,omitempty"`
+	MaxSlippage          uint32 `protobuf:"varint,5,opt,name=max_slippage,json=maxSlippage,proto3" json:"max_slippage,omitempty"`
+}
+
+func (x *LegacyParams) Reset() {
+	*x = LegacyParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_dex_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LegacyParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegacyParams) ProtoMessage() {}
+
+// Deprecated: Use LegacyParams.ProtoReflect.Descriptor instead.
+func (*LegacyParams) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LegacyParams) GetNewPoolFeePct() uint32 {
+	if x != nil {
+		return x.NewPoolFeePct
+	}
+	return 0
+}
+
+func (x *LegacyParams) GetCreationFee() uint32 {
 	if x != nil {
 		return x.CreationFee
 	}
 	return 0
 }
 
-func (x *Params) GetBeneficiary() string {
+func (x *LegacyParams) GetBeneficiary() string {
 	if x != nil {
 		return x.Beneficiary
 	}
 	return ""
 }
 
-func (x *Params) GetMinimalLiquidityLock() uint32 {
+func (x *LegacyParams) GetMinimalLiquidityLock() uint32 {
 	if x != nil {
 		return x.MinimalLiquidityLock
 	}
 	return 0
 }
 
-func (x *Params) GetMaxSlippage() uint32 {
+func (x *LegacyParams) GetMaxSlippage() uint32 {
 	if x != nil {
 		return x.MaxSlippage
 	}
@@ -722,31 +1435,50 @@ var file_zigchain_dex_params_proto_rawDesc = []byte{
 	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
 	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f,
 	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xef, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x27, 0x0a,
+	0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x9b, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x27, 0x0a,
 	0x10, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x63,
 	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x50, 0x6f, 0x6f, 0x6c,
-	0x46, 0x65, 0x65, 0x50, 0x63, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e,
-	0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x16, 0x6d,
-	0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
-	0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6d, 0x69, 0x6e,
-	0x69, 0x6d, 0x61, 0x6c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x4c, 0x6f, 0x63,
-	0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x53, 0x6c, 0x69, 0x70,
-	0x70, 0x61, 0x67, 0x65, 0x3a, 0x1e, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x15, 0x7a,
-	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x42, 0x8f, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x69, 0x67,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0xa2, 0x02, 0x03, 0x5a, 0x44, 0x58, 0xaa, 0x02, 0x0c,
-	0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x78, 0xca, 0x02, 0x0c, 0x5a,
-	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x44, 0x65, 0x78, 0xe2, 0x02, 0x18, 0x5a, 0x69,
-	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x44, 0x65, 0x78, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x3a, 0x3a, 0x44, 0x65, 0x78, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x46, 0x65, 0x65, 0x50, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69,
+	0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e,
+	0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x69,
+	0x6d, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61,
+	0x6c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x53, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x47, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x3a, 0x1e, 0xe8, 0xa0, 0x1f, 0x01,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x15, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f,
+	0x64, 0x65, 0x78, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03,
+	0x22, 0xd5, 0x01, 0x0a, 0x0c, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x27, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x70, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x65, 0x77,
+	0x50, 0x6f, 0x6f, 0x6c, 0x46, 0x65, 0x65, 0x50, 0x63, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x12,
+	0x34, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x14, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6c, 0x69,
+	0x70, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78,
+	0x53, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x42, 0x8f, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d,
+	0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x42, 0x0b, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1d, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a,
+	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0xa2, 0x02, 0x03, 0x5a, 0x44,
+	0x58, 0xaa, 0x02, 0x0c, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x78,
+	0xca, 0x02, 0x0c, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x44, 0x65, 0x78, 0xe2,
+	0x02, 0x18, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x44, 0x65, 0x78, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x5a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x44, 0x65, 0x78, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -761,16 +1493,19 @@ func file_zigchain_dex_params_proto_rawDescGZIP() []byte {
 	return file_zigchain_dex_params_proto_rawDescData
 }
 
-var file_zigchain_dex_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_zigchain_dex_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_zigchain_dex_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: zigchain.dex.Params
+	(*Params)(nil),       // 0: zigchain.dex.Params
+	(*LegacyParams)(nil), // 1: zigchain.dex.LegacyParams
+	(*v1beta1.Coin)(nil), // 2: cosmos.base.v1beta1.Coin
 }
 var file_zigchain_dex_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: zigchain.dex.Params.creation_fee:type_name -> cosmos.base.v1beta1.Coin
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_zigchain_dex_params_proto_init() }
@@ -791,6 +1526,18 @@ func file_zigchain_dex_params_proto_init() {
 				return nil
 			}
 		}
+		file_zigchain_dex_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LegacyParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -798,7 +1545,7 @@ func file_zigchain_dex_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_zigchain_dex_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
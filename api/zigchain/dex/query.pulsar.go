@@ -6386,6 +6386,7 @@ var (
 	md_QuerySwapInRequest         protoreflect.MessageDescriptor
 	fd_QuerySwapInRequest_pool_id protoreflect.FieldDescriptor
 	fd_QuerySwapInRequest_coin_in protoreflect.FieldDescriptor
+	fd_QuerySwapInRequest_address protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -6393,6 +6394,7 @@ func init() {
 	md_QuerySwapInRequest = File_zigchain_dex_query_proto.Messages().ByName("QuerySwapInRequest")
 	fd_QuerySwapInRequest_pool_id = md_QuerySwapInRequest.Fields().ByName("pool_id")
 	fd_QuerySwapInRequest_coin_in = md_QuerySwapInRequest.Fields().ByName("coin_in")
+	fd_QuerySwapInRequest_address = md_QuerySwapInRequest.Fields().ByName("address")
 }
 
 var _ protoreflect.Message = (*fastReflection_QuerySwapInRequest)(nil)
@@ -6472,6 +6474,12 @@ func (x *fastReflection_QuerySwapInRequest) Range(f func(protoreflect.FieldDescr
 			return
 		}
 	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QuerySwapInRequest_address, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -6491,6 +6499,8 @@ func (x *fastReflection_QuerySwapInRequest) Has(fd protoreflect.FieldDescriptor)
 		return x.PoolId != ""
 	case "zigchain.dex.QuerySwapInRequest.coin_in":
 		return x.CoinIn != ""
+	case "zigchain.dex.QuerySwapInRequest.address":
+		return x.Address != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapInRequest"))
@@ -6511,6 +6521,8 @@ func (x *fastReflection_QuerySwapInRequest) Clear(fd protoreflect.FieldDescripto
 		x.PoolId = ""
 	case "zigchain.dex.QuerySwapInRequest.coin_in":
 		x.CoinIn = ""
+	case "zigchain.dex.QuerySwapInRequest.address":
+		x.Address = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapInRequest"))
@@ -6533,6 +6545,9 @@ func (x *fastReflection_QuerySwapInRequest) Get(descriptor protoreflect.FieldDes
 	case "zigchain.dex.QuerySwapInRequest.coin_in":
 		value := x.CoinIn
 		return protoreflect.ValueOfString(value)
+	case "zigchain.dex.QuerySwapInRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapInRequest"))
@@ -6557,6 +6572,8 @@ func (x *fastReflection_QuerySwapInRequest) Set(fd protoreflect.FieldDescriptor,
 		x.PoolId = value.Interface().(string)
 	case "zigchain.dex.QuerySwapInRequest.coin_in":
 		x.CoinIn = value.Interface().(string)
+	case "zigchain.dex.QuerySwapInRequest.address":
+		x.Address = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapInRequest"))
@@ -6581,6 +6598,8 @@ func (x *fastReflection_QuerySwapInRequest) Mutable(fd protoreflect.FieldDescrip
 		panic(fmt.Errorf("field pool_id of message zigchain.dex.QuerySwapInRequest is not mutable"))
 	case "zigchain.dex.QuerySwapInRequest.coin_in":
 		panic(fmt.Errorf("field coin_in of message zigchain.dex.QuerySwapInRequest is not mutable"))
+	case "zigchain.dex.QuerySwapInRequest.address":
+		panic(fmt.Errorf("field address of message zigchain.dex.QuerySwapInRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapInRequest"))
@@ -6598,6 +6617,8 @@ func (x *fastReflection_QuerySwapInRequest) NewField(fd protoreflect.FieldDescri
 		return protoreflect.ValueOfString("")
 	case "zigchain.dex.QuerySwapInRequest.coin_in":
 		return protoreflect.ValueOfString("")
+	case "zigchain.dex.QuerySwapInRequest.address":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapInRequest"))
@@ -6675,6 +6696,10 @@ func (x *fastReflection_QuerySwapInRequest) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6704,6 +6729,13 @@ func (x *fastReflection_QuerySwapInRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.CoinIn) > 0 {
 			i -= len(x.CoinIn)
 			copy(dAtA[i:], x.CoinIn)
@@ -6831,6 +6863,38 @@ func (x *fastReflection_QuerySwapInRequest) ProtoMethods() *protoiface.Methods {
 				}
 				x.CoinIn = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6867,9 +6931,12 @@ func (x *fastReflection_QuerySwapInRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QuerySwapInResponse          protoreflect.MessageDescriptor
-	fd_QuerySwapInResponse_coin_out protoreflect.FieldDescriptor
-	fd_QuerySwapInResponse_fee      protoreflect.FieldDescriptor
+	md_QuerySwapInResponse              protoreflect.MessageDescriptor
+	fd_QuerySwapInResponse_coin_out     protoreflect.FieldDescriptor
+	fd_QuerySwapInResponse_fee          protoreflect.FieldDescriptor
+	fd_QuerySwapInResponse_fee_rate     protoreflect.FieldDescriptor
+	fd_QuerySwapInResponse_incoming_tax protoreflect.FieldDescriptor
+	fd_QuerySwapInResponse_outgoing_tax protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -6877,6 +6944,9 @@ func init() {
 	md_QuerySwapInResponse = File_zigchain_dex_query_proto.Messages().ByName("QuerySwapInResponse")
 	fd_QuerySwapInResponse_coin_out = md_QuerySwapInResponse.Fields().ByName("coin_out")
 	fd_QuerySwapInResponse_fee = md_QuerySwapInResponse.Fields().ByName("fee")
+	fd_QuerySwapInResponse_fee_rate = md_QuerySwapInResponse.Fields().ByName("fee_rate")
+	fd_QuerySwapInResponse_incoming_tax = md_QuerySwapInResponse.Fields().ByName("incoming_tax")
+	fd_QuerySwapInResponse_outgoing_tax = md_QuerySwapInResponse.Fields().ByName("outgoing_tax")
 }
 
 var _ protoreflect.Message = (*fastReflection_QuerySwapInResponse)(nil)
@@ -6956,6 +7026,24 @@ func (x *fastReflection_QuerySwapInResponse) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.FeeRate != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.FeeRate)
+		if !f(fd_QuerySwapInResponse_fee_rate, value) {
+			return
+		}
+	}
+	if x.IncomingTax != nil {
+		value := protoreflect.ValueOfMessage(x.IncomingTax.ProtoReflect())
+		if !f(fd_QuerySwapInResponse_incoming_tax, value) {
+			return
+		}
+	}
+	if x.OutgoingTax != nil {
+		value := protoreflect.ValueOfMessage(x.OutgoingTax.ProtoReflect())
+		if !f(fd_QuerySwapInResponse_outgoing_tax, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -6975,6 +7063,12 @@ func (x *fastReflection_QuerySwapInResponse) Has(fd protoreflect.FieldDescriptor
 		return x.CoinOut != nil
 	case "zigchain.dex.QuerySwapInResponse.fee":
 		return x.Fee != nil
+	case "zigchain.dex.QuerySwapInResponse.fee_rate":
+		return x.FeeRate != uint32(0)
+	case "zigchain.dex.QuerySwapInResponse.incoming_tax":
+		return x.IncomingTax != nil
+	case "zigchain.dex.QuerySwapInResponse.outgoing_tax":
+		return x.OutgoingTax != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapInResponse"))
@@ -6995,6 +7089,12 @@ func (x *fastReflection_QuerySwapInResponse) Clear(fd protoreflect.FieldDescript
 		x.CoinOut = nil
 	case "zigchain.dex.QuerySwapInResponse.fee":
 		x.Fee = nil
+	case "zigchain.dex.QuerySwapInResponse.fee_rate":
+		x.FeeRate = uint32(0)
+	case "zigchain.dex.QuerySwapInResponse.incoming_tax":
+		x.IncomingTax = nil
+	case "zigchain.dex.QuerySwapInResponse.outgoing_tax":
+		x.OutgoingTax = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapInResponse"))
@@ -7017,6 +7117,15 @@ func (x *fastReflection_QuerySwapInResponse) Get(descriptor protoreflect.FieldDe
 	case "zigchain.dex.QuerySwapInResponse.fee":
 		value := x.Fee
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zigchain.dex.QuerySwapInResponse.fee_rate":
+		value := x.FeeRate
+		return protoreflect.ValueOfUint32(value)
+	case "zigchain.dex.QuerySwapInResponse.incoming_tax":
+		value := x.IncomingTax
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zigchain.dex.QuerySwapInResponse.outgoing_tax":
+		value := x.OutgoingTax
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapInResponse"))
@@ -7041,6 +7150,12 @@ func (x *fastReflection_QuerySwapInResponse) Set(fd protoreflect.FieldDescriptor
 		x.CoinOut = value.Message().Interface().(*v1beta1.Coin)
 	case "zigchain.dex.QuerySwapInResponse.fee":
 		x.Fee = value.Message().Interface().(*v1beta1.Coin)
+	case "zigchain.dex.QuerySwapInResponse.fee_rate":
+		x.FeeRate = uint32(value.Uint())
+	case "zigchain.dex.QuerySwapInResponse.incoming_tax":
+		x.IncomingTax = value.Message().Interface().(*v1beta1.Coin)
+	case "zigchain.dex.QuerySwapInResponse.outgoing_tax":
+		x.OutgoingTax = value.Message().Interface().(*v1beta1.Coin)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapInResponse"))
@@ -7071,6 +7186,18 @@ func (x *fastReflection_QuerySwapInResponse) Mutable(fd protoreflect.FieldDescri
 			x.Fee = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+	case "zigchain.dex.QuerySwapInResponse.incoming_tax":
+		if x.IncomingTax == nil {
+			x.IncomingTax = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.IncomingTax.ProtoReflect())
+	case "zigchain.dex.QuerySwapInResponse.outgoing_tax":
+		if x.OutgoingTax == nil {
+			x.OutgoingTax = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.OutgoingTax.ProtoReflect())
+	case "zigchain.dex.QuerySwapInResponse.fee_rate":
+		panic(fmt.Errorf("field fee_rate of message zigchain.dex.QuerySwapInResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapInResponse"))
@@ -7090,6 +7217,14 @@ func (x *fastReflection_QuerySwapInResponse) NewField(fd protoreflect.FieldDescr
 	case "zigchain.dex.QuerySwapInResponse.fee":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zigchain.dex.QuerySwapInResponse.fee_rate":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "zigchain.dex.QuerySwapInResponse.incoming_tax":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zigchain.dex.QuerySwapInResponse.outgoing_tax":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapInResponse"))
@@ -7167,6 +7302,17 @@ func (x *fastReflection_QuerySwapInResponse) ProtoMethods() *protoiface.Methods
 			l = options.Size(x.Fee)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.FeeRate != 0 {
+			n += 1 + runtime.Sov(uint64(x.FeeRate))
+		}
+		if x.IncomingTax != nil {
+			l = options.Size(x.IncomingTax)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OutgoingTax != nil {
+			l = options.Size(x.OutgoingTax)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7196,6 +7342,39 @@ func (x *fastReflection_QuerySwapInResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.OutgoingTax != nil {
+			encoded, err := options.Marshal(x.OutgoingTax)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.IncomingTax != nil {
+			encoded, err := options.Marshal(x.IncomingTax)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.FeeRate != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FeeRate))
+			i--
+			dAtA[i] = 0x18
+		}
 		if x.Fee != nil {
 			encoded, err := options.Marshal(x.Fee)
 			if err != nil {
@@ -7345,6 +7524,97 @@ func (x *fastReflection_QuerySwapInResponse) ProtoMethods() *protoiface.Methods
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+				}
+				x.FeeRate = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FeeRate |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncomingTax", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.IncomingTax == nil {
+					x.IncomingTax = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IncomingTax); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutgoingTax", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.OutgoingTax == nil {
+					x.OutgoingTax = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OutgoingTax); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7384,6 +7654,7 @@ var (
 	md_QuerySwapOutRequest          protoreflect.MessageDescriptor
 	fd_QuerySwapOutRequest_pool_id  protoreflect.FieldDescriptor
 	fd_QuerySwapOutRequest_coin_out protoreflect.FieldDescriptor
+	fd_QuerySwapOutRequest_address  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -7391,6 +7662,7 @@ func init() {
 	md_QuerySwapOutRequest = File_zigchain_dex_query_proto.Messages().ByName("QuerySwapOutRequest")
 	fd_QuerySwapOutRequest_pool_id = md_QuerySwapOutRequest.Fields().ByName("pool_id")
 	fd_QuerySwapOutRequest_coin_out = md_QuerySwapOutRequest.Fields().ByName("coin_out")
+	fd_QuerySwapOutRequest_address = md_QuerySwapOutRequest.Fields().ByName("address")
 }
 
 var _ protoreflect.Message = (*fastReflection_QuerySwapOutRequest)(nil)
@@ -7470,6 +7742,12 @@ func (x *fastReflection_QuerySwapOutRequest) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QuerySwapOutRequest_address, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -7489,6 +7767,8 @@ func (x *fastReflection_QuerySwapOutRequest) Has(fd protoreflect.FieldDescriptor
 		return x.PoolId != ""
 	case "zigchain.dex.QuerySwapOutRequest.coin_out":
 		return x.CoinOut != ""
+	case "zigchain.dex.QuerySwapOutRequest.address":
+		return x.Address != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapOutRequest"))
@@ -7509,6 +7789,8 @@ func (x *fastReflection_QuerySwapOutRequest) Clear(fd protoreflect.FieldDescript
 		x.PoolId = ""
 	case "zigchain.dex.QuerySwapOutRequest.coin_out":
 		x.CoinOut = ""
+	case "zigchain.dex.QuerySwapOutRequest.address":
+		x.Address = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapOutRequest"))
@@ -7531,6 +7813,9 @@ func (x *fastReflection_QuerySwapOutRequest) Get(descriptor protoreflect.FieldDe
 	case "zigchain.dex.QuerySwapOutRequest.coin_out":
 		value := x.CoinOut
 		return protoreflect.ValueOfString(value)
+	case "zigchain.dex.QuerySwapOutRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapOutRequest"))
@@ -7555,6 +7840,8 @@ func (x *fastReflection_QuerySwapOutRequest) Set(fd protoreflect.FieldDescriptor
 		x.PoolId = value.Interface().(string)
 	case "zigchain.dex.QuerySwapOutRequest.coin_out":
 		x.CoinOut = value.Interface().(string)
+	case "zigchain.dex.QuerySwapOutRequest.address":
+		x.Address = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapOutRequest"))
@@ -7579,6 +7866,8 @@ func (x *fastReflection_QuerySwapOutRequest) Mutable(fd protoreflect.FieldDescri
 		panic(fmt.Errorf("field pool_id of message zigchain.dex.QuerySwapOutRequest is not mutable"))
 	case "zigchain.dex.QuerySwapOutRequest.coin_out":
 		panic(fmt.Errorf("field coin_out of message zigchain.dex.QuerySwapOutRequest is not mutable"))
+	case "zigchain.dex.QuerySwapOutRequest.address":
+		panic(fmt.Errorf("field address of message zigchain.dex.QuerySwapOutRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapOutRequest"))
@@ -7596,6 +7885,8 @@ func (x *fastReflection_QuerySwapOutRequest) NewField(fd protoreflect.FieldDescr
 		return protoreflect.ValueOfString("")
 	case "zigchain.dex.QuerySwapOutRequest.coin_out":
 		return protoreflect.ValueOfString("")
+	case "zigchain.dex.QuerySwapOutRequest.address":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapOutRequest"))
@@ -7673,6 +7964,10 @@ func (x *fastReflection_QuerySwapOutRequest) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7702,6 +7997,13 @@ func (x *fastReflection_QuerySwapOutRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.CoinOut) > 0 {
 			i -= len(x.CoinOut)
 			copy(dAtA[i:], x.CoinOut)
@@ -7829,6 +8131,38 @@ func (x *fastReflection_QuerySwapOutRequest) ProtoMethods() *protoiface.Methods
 				}
 				x.CoinOut = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7865,9 +8199,12 @@ func (x *fastReflection_QuerySwapOutRequest) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QuerySwapOutResponse         protoreflect.MessageDescriptor
-	fd_QuerySwapOutResponse_coin_in protoreflect.FieldDescriptor
-	fd_QuerySwapOutResponse_fee     protoreflect.FieldDescriptor
+	md_QuerySwapOutResponse              protoreflect.MessageDescriptor
+	fd_QuerySwapOutResponse_coin_in      protoreflect.FieldDescriptor
+	fd_QuerySwapOutResponse_fee          protoreflect.FieldDescriptor
+	fd_QuerySwapOutResponse_fee_rate     protoreflect.FieldDescriptor
+	fd_QuerySwapOutResponse_incoming_tax protoreflect.FieldDescriptor
+	fd_QuerySwapOutResponse_outgoing_tax protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -7875,6 +8212,9 @@ func init() {
 	md_QuerySwapOutResponse = File_zigchain_dex_query_proto.Messages().ByName("QuerySwapOutResponse")
 	fd_QuerySwapOutResponse_coin_in = md_QuerySwapOutResponse.Fields().ByName("coin_in")
 	fd_QuerySwapOutResponse_fee = md_QuerySwapOutResponse.Fields().ByName("fee")
+	fd_QuerySwapOutResponse_fee_rate = md_QuerySwapOutResponse.Fields().ByName("fee_rate")
+	fd_QuerySwapOutResponse_incoming_tax = md_QuerySwapOutResponse.Fields().ByName("incoming_tax")
+	fd_QuerySwapOutResponse_outgoing_tax = md_QuerySwapOutResponse.Fields().ByName("outgoing_tax")
 }
 
 var _ protoreflect.Message = (*fastReflection_QuerySwapOutResponse)(nil)
@@ -7954,6 +8294,24 @@ func (x *fastReflection_QuerySwapOutResponse) Range(f func(protoreflect.FieldDes
 			return
 		}
 	}
+	if x.FeeRate != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.FeeRate)
+		if !f(fd_QuerySwapOutResponse_fee_rate, value) {
+			return
+		}
+	}
+	if x.IncomingTax != nil {
+		value := protoreflect.ValueOfMessage(x.IncomingTax.ProtoReflect())
+		if !f(fd_QuerySwapOutResponse_incoming_tax, value) {
+			return
+		}
+	}
+	if x.OutgoingTax != nil {
+		value := protoreflect.ValueOfMessage(x.OutgoingTax.ProtoReflect())
+		if !f(fd_QuerySwapOutResponse_outgoing_tax, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -7973,6 +8331,12 @@ func (x *fastReflection_QuerySwapOutResponse) Has(fd protoreflect.FieldDescripto
 		return x.CoinIn != nil
 	case "zigchain.dex.QuerySwapOutResponse.fee":
 		return x.Fee != nil
+	case "zigchain.dex.QuerySwapOutResponse.fee_rate":
+		return x.FeeRate != uint32(0)
+	case "zigchain.dex.QuerySwapOutResponse.incoming_tax":
+		return x.IncomingTax != nil
+	case "zigchain.dex.QuerySwapOutResponse.outgoing_tax":
+		return x.OutgoingTax != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapOutResponse"))
@@ -7993,6 +8357,12 @@ func (x *fastReflection_QuerySwapOutResponse) Clear(fd protoreflect.FieldDescrip
 		x.CoinIn = nil
 	case "zigchain.dex.QuerySwapOutResponse.fee":
 		x.Fee = nil
+	case "zigchain.dex.QuerySwapOutResponse.fee_rate":
+		x.FeeRate = uint32(0)
+	case "zigchain.dex.QuerySwapOutResponse.incoming_tax":
+		x.IncomingTax = nil
+	case "zigchain.dex.QuerySwapOutResponse.outgoing_tax":
+		x.OutgoingTax = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapOutResponse"))
@@ -8015,6 +8385,15 @@ func (x *fastReflection_QuerySwapOutResponse) Get(descriptor protoreflect.FieldD
 	case "zigchain.dex.QuerySwapOutResponse.fee":
 		value := x.Fee
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zigchain.dex.QuerySwapOutResponse.fee_rate":
+		value := x.FeeRate
+		return protoreflect.ValueOfUint32(value)
+	case "zigchain.dex.QuerySwapOutResponse.incoming_tax":
+		value := x.IncomingTax
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zigchain.dex.QuerySwapOutResponse.outgoing_tax":
+		value := x.OutgoingTax
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapOutResponse"))
@@ -8039,6 +8418,12 @@ func (x *fastReflection_QuerySwapOutResponse) Set(fd protoreflect.FieldDescripto
 		x.CoinIn = value.Message().Interface().(*v1beta1.Coin)
 	case "zigchain.dex.QuerySwapOutResponse.fee":
 		x.Fee = value.Message().Interface().(*v1beta1.Coin)
+	case "zigchain.dex.QuerySwapOutResponse.fee_rate":
+		x.FeeRate = uint32(value.Uint())
+	case "zigchain.dex.QuerySwapOutResponse.incoming_tax":
+		x.IncomingTax = value.Message().Interface().(*v1beta1.Coin)
+	case "zigchain.dex.QuerySwapOutResponse.outgoing_tax":
+		x.OutgoingTax = value.Message().Interface().(*v1beta1.Coin)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapOutResponse"))
@@ -8069,6 +8454,18 @@ func (x *fastReflection_QuerySwapOutResponse) Mutable(fd protoreflect.FieldDescr
 			x.Fee = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+	case "zigchain.dex.QuerySwapOutResponse.incoming_tax":
+		if x.IncomingTax == nil {
+			x.IncomingTax = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.IncomingTax.ProtoReflect())
+	case "zigchain.dex.QuerySwapOutResponse.outgoing_tax":
+		if x.OutgoingTax == nil {
+			x.OutgoingTax = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.OutgoingTax.ProtoReflect())
+	case "zigchain.dex.QuerySwapOutResponse.fee_rate":
+		panic(fmt.Errorf("field fee_rate of message zigchain.dex.QuerySwapOutResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapOutResponse"))
@@ -8088,6 +8485,14 @@ func (x *fastReflection_QuerySwapOutResponse) NewField(fd protoreflect.FieldDesc
 	case "zigchain.dex.QuerySwapOutResponse.fee":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zigchain.dex.QuerySwapOutResponse.fee_rate":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "zigchain.dex.QuerySwapOutResponse.incoming_tax":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zigchain.dex.QuerySwapOutResponse.outgoing_tax":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QuerySwapOutResponse"))
@@ -8165,6 +8570,17 @@ func (x *fastReflection_QuerySwapOutResponse) ProtoMethods() *protoiface.Methods
 			l = options.Size(x.Fee)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.FeeRate != 0 {
+			n += 1 + runtime.Sov(uint64(x.FeeRate))
+		}
+		if x.IncomingTax != nil {
+			l = options.Size(x.IncomingTax)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OutgoingTax != nil {
+			l = options.Size(x.OutgoingTax)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8194,6 +8610,39 @@ func (x *fastReflection_QuerySwapOutResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.OutgoingTax != nil {
+			encoded, err := options.Marshal(x.OutgoingTax)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.IncomingTax != nil {
+			encoded, err := options.Marshal(x.IncomingTax)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.FeeRate != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FeeRate))
+			i--
+			dAtA[i] = 0x18
+		}
 		if x.Fee != nil {
 			encoded, err := options.Marshal(x.Fee)
 			if err != nil {
@@ -8343,752 +8792,5258 @@ func (x *fastReflection_QuerySwapOutResponse) ProtoMethods() *protoiface.Methods
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+				}
+				x.FeeRate = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FeeRate |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncomingTax", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.IncomingTax == nil {
+					x.IncomingTax = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IncomingTax); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutgoingTax", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.OutgoingTax == nil {
+					x.OutgoingTax = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OutgoingTax); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetDynamicFeeRequest         protoreflect.MessageDescriptor
+	fd_QueryGetDynamicFeeRequest_pool_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zigchain_dex_query_proto_init()
+	md_QueryGetDynamicFeeRequest = File_zigchain_dex_query_proto.Messages().ByName("QueryGetDynamicFeeRequest")
+	fd_QueryGetDynamicFeeRequest_pool_id = md_QueryGetDynamicFeeRequest.Fields().ByName("pool_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetDynamicFeeRequest)(nil)
+
+type fastReflection_QueryGetDynamicFeeRequest QueryGetDynamicFeeRequest
+
+func (x *QueryGetDynamicFeeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetDynamicFeeRequest)(x)
+}
+
+func (x *QueryGetDynamicFeeRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_dex_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetDynamicFeeRequest_messageType fastReflection_QueryGetDynamicFeeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetDynamicFeeRequest_messageType{}
+
+type fastReflection_QueryGetDynamicFeeRequest_messageType struct{}
+
+func (x fastReflection_QueryGetDynamicFeeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetDynamicFeeRequest)(nil)
+}
+func (x fastReflection_QueryGetDynamicFeeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDynamicFeeRequest)
+}
+func (x fastReflection_QueryGetDynamicFeeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDynamicFeeRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetDynamicFeeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDynamicFeeRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetDynamicFeeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetDynamicFeeRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetDynamicFeeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDynamicFeeRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetDynamicFeeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetDynamicFeeRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetDynamicFeeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PoolId != "" {
+		value := protoreflect.ValueOfString(x.PoolId)
+		if !f(fd_QueryGetDynamicFeeRequest_pool_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetDynamicFeeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryGetDynamicFeeRequest.pool_id":
+		return x.PoolId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetDynamicFeeRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetDynamicFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetDynamicFeeRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryGetDynamicFeeRequest.pool_id":
+		x.PoolId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetDynamicFeeRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetDynamicFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetDynamicFeeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zigchain.dex.QueryGetDynamicFeeRequest.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetDynamicFeeRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetDynamicFeeRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetDynamicFeeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryGetDynamicFeeRequest.pool_id":
+		x.PoolId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetDynamicFeeRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetDynamicFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetDynamicFeeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryGetDynamicFeeRequest.pool_id":
+		panic(fmt.Errorf("field pool_id of message zigchain.dex.QueryGetDynamicFeeRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetDynamicFeeRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetDynamicFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetDynamicFeeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryGetDynamicFeeRequest.pool_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetDynamicFeeRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetDynamicFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetDynamicFeeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.dex.QueryGetDynamicFeeRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetDynamicFeeRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetDynamicFeeRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetDynamicFeeRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetDynamicFeeRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetDynamicFeeRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PoolId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetDynamicFeeRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PoolId) > 0 {
+			i -= len(x.PoolId)
+			copy(dAtA[i:], x.PoolId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PoolId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetDynamicFeeRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDynamicFeeRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDynamicFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PoolId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetDynamicFeeResponse               protoreflect.MessageDescriptor
+	fd_QueryGetDynamicFeeResponse_config        protoreflect.FieldDescriptor
+	fd_QueryGetDynamicFeeResponse_volatility    protoreflect.FieldDescriptor
+	fd_QueryGetDynamicFeeResponse_effective_fee protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zigchain_dex_query_proto_init()
+	md_QueryGetDynamicFeeResponse = File_zigchain_dex_query_proto.Messages().ByName("QueryGetDynamicFeeResponse")
+	fd_QueryGetDynamicFeeResponse_config = md_QueryGetDynamicFeeResponse.Fields().ByName("config")
+	fd_QueryGetDynamicFeeResponse_volatility = md_QueryGetDynamicFeeResponse.Fields().ByName("volatility")
+	fd_QueryGetDynamicFeeResponse_effective_fee = md_QueryGetDynamicFeeResponse.Fields().ByName("effective_fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetDynamicFeeResponse)(nil)
+
+type fastReflection_QueryGetDynamicFeeResponse QueryGetDynamicFeeResponse
+
+func (x *QueryGetDynamicFeeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetDynamicFeeResponse)(x)
+}
+
+func (x *QueryGetDynamicFeeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_dex_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetDynamicFeeResponse_messageType fastReflection_QueryGetDynamicFeeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetDynamicFeeResponse_messageType{}
+
+type fastReflection_QueryGetDynamicFeeResponse_messageType struct{}
+
+func (x fastReflection_QueryGetDynamicFeeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetDynamicFeeResponse)(nil)
+}
+func (x fastReflection_QueryGetDynamicFeeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDynamicFeeResponse)
+}
+func (x fastReflection_QueryGetDynamicFeeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDynamicFeeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetDynamicFeeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetDynamicFeeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetDynamicFeeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetDynamicFeeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetDynamicFeeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetDynamicFeeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetDynamicFeeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetDynamicFeeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetDynamicFeeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Config != nil {
+		value := protoreflect.ValueOfMessage(x.Config.ProtoReflect())
+		if !f(fd_QueryGetDynamicFeeResponse_config, value) {
+			return
+		}
+	}
+	if x.Volatility != nil {
+		value := protoreflect.ValueOfMessage(x.Volatility.ProtoReflect())
+		if !f(fd_QueryGetDynamicFeeResponse_volatility, value) {
+			return
+		}
+	}
+	if x.EffectiveFee != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.EffectiveFee)
+		if !f(fd_QueryGetDynamicFeeResponse_effective_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetDynamicFeeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryGetDynamicFeeResponse.config":
+		return x.Config != nil
+	case "zigchain.dex.QueryGetDynamicFeeResponse.volatility":
+		return x.Volatility != nil
+	case "zigchain.dex.QueryGetDynamicFeeResponse.effective_fee":
+		return x.EffectiveFee != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetDynamicFeeResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetDynamicFeeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetDynamicFeeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryGetDynamicFeeResponse.config":
+		x.Config = nil
+	case "zigchain.dex.QueryGetDynamicFeeResponse.volatility":
+		x.Volatility = nil
+	case "zigchain.dex.QueryGetDynamicFeeResponse.effective_fee":
+		x.EffectiveFee = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetDynamicFeeResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetDynamicFeeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetDynamicFeeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zigchain.dex.QueryGetDynamicFeeResponse.config":
+		value := x.Config
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zigchain.dex.QueryGetDynamicFeeResponse.volatility":
+		value := x.Volatility
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zigchain.dex.QueryGetDynamicFeeResponse.effective_fee":
+		value := x.EffectiveFee
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetDynamicFeeResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetDynamicFeeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetDynamicFeeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryGetDynamicFeeResponse.config":
+		x.Config = value.Message().Interface().(*DynamicFeeConfig)
+	case "zigchain.dex.QueryGetDynamicFeeResponse.volatility":
+		x.Volatility = value.Message().Interface().(*PoolVolatility)
+	case "zigchain.dex.QueryGetDynamicFeeResponse.effective_fee":
+		x.EffectiveFee = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetDynamicFeeResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetDynamicFeeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetDynamicFeeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryGetDynamicFeeResponse.config":
+		if x.Config == nil {
+			x.Config = new(DynamicFeeConfig)
+		}
+		return protoreflect.ValueOfMessage(x.Config.ProtoReflect())
+	case "zigchain.dex.QueryGetDynamicFeeResponse.volatility":
+		if x.Volatility == nil {
+			x.Volatility = new(PoolVolatility)
+		}
+		return protoreflect.ValueOfMessage(x.Volatility.ProtoReflect())
+	case "zigchain.dex.QueryGetDynamicFeeResponse.effective_fee":
+		panic(fmt.Errorf("field effective_fee of message zigchain.dex.QueryGetDynamicFeeResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetDynamicFeeResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetDynamicFeeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetDynamicFeeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryGetDynamicFeeResponse.config":
+		m := new(DynamicFeeConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zigchain.dex.QueryGetDynamicFeeResponse.volatility":
+		m := new(PoolVolatility)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zigchain.dex.QueryGetDynamicFeeResponse.effective_fee":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetDynamicFeeResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetDynamicFeeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetDynamicFeeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.dex.QueryGetDynamicFeeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetDynamicFeeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetDynamicFeeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetDynamicFeeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetDynamicFeeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetDynamicFeeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Config != nil {
+			l = options.Size(x.Config)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Volatility != nil {
+			l = options.Size(x.Volatility)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EffectiveFee != 0 {
+			n += 1 + runtime.Sov(uint64(x.EffectiveFee))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetDynamicFeeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EffectiveFee != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EffectiveFee))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Volatility != nil {
+			encoded, err := options.Marshal(x.Volatility)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Config != nil {
+			encoded, err := options.Marshal(x.Config)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetDynamicFeeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDynamicFeeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetDynamicFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Config == nil {
+					x.Config = &DynamicFeeConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Config); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Volatility == nil {
+					x.Volatility = &PoolVolatility{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Volatility); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveFee", wireType)
+				}
+				x.EffectiveFee = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EffectiveFee |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetBuybackInfoRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_zigchain_dex_query_proto_init()
+	md_QueryGetBuybackInfoRequest = File_zigchain_dex_query_proto.Messages().ByName("QueryGetBuybackInfoRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetBuybackInfoRequest)(nil)
+
+type fastReflection_QueryGetBuybackInfoRequest QueryGetBuybackInfoRequest
+
+func (x *QueryGetBuybackInfoRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetBuybackInfoRequest)(x)
+}
+
+func (x *QueryGetBuybackInfoRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_dex_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetBuybackInfoRequest_messageType fastReflection_QueryGetBuybackInfoRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetBuybackInfoRequest_messageType{}
+
+type fastReflection_QueryGetBuybackInfoRequest_messageType struct{}
+
+func (x fastReflection_QueryGetBuybackInfoRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetBuybackInfoRequest)(nil)
+}
+func (x fastReflection_QueryGetBuybackInfoRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetBuybackInfoRequest)
+}
+func (x fastReflection_QueryGetBuybackInfoRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetBuybackInfoRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetBuybackInfoRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetBuybackInfoRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetBuybackInfoRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetBuybackInfoRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetBuybackInfoRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetBuybackInfoRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetBuybackInfoRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetBuybackInfoRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetBuybackInfoRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetBuybackInfoRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetBuybackInfoRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetBuybackInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetBuybackInfoRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetBuybackInfoRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetBuybackInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetBuybackInfoRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetBuybackInfoRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetBuybackInfoRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetBuybackInfoRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetBuybackInfoRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetBuybackInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetBuybackInfoRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetBuybackInfoRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetBuybackInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetBuybackInfoRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetBuybackInfoRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetBuybackInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetBuybackInfoRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.dex.QueryGetBuybackInfoRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetBuybackInfoRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetBuybackInfoRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetBuybackInfoRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetBuybackInfoRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetBuybackInfoRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetBuybackInfoRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetBuybackInfoRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetBuybackInfoRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetBuybackInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetBuybackInfoResponse        protoreflect.MessageDescriptor
+	fd_QueryGetBuybackInfoResponse_config protoreflect.FieldDescriptor
+	fd_QueryGetBuybackInfoResponse_stats  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zigchain_dex_query_proto_init()
+	md_QueryGetBuybackInfoResponse = File_zigchain_dex_query_proto.Messages().ByName("QueryGetBuybackInfoResponse")
+	fd_QueryGetBuybackInfoResponse_config = md_QueryGetBuybackInfoResponse.Fields().ByName("config")
+	fd_QueryGetBuybackInfoResponse_stats = md_QueryGetBuybackInfoResponse.Fields().ByName("stats")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetBuybackInfoResponse)(nil)
+
+type fastReflection_QueryGetBuybackInfoResponse QueryGetBuybackInfoResponse
+
+func (x *QueryGetBuybackInfoResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetBuybackInfoResponse)(x)
+}
+
+func (x *QueryGetBuybackInfoResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_dex_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetBuybackInfoResponse_messageType fastReflection_QueryGetBuybackInfoResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetBuybackInfoResponse_messageType{}
+
+type fastReflection_QueryGetBuybackInfoResponse_messageType struct{}
+
+func (x fastReflection_QueryGetBuybackInfoResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetBuybackInfoResponse)(nil)
+}
+func (x fastReflection_QueryGetBuybackInfoResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetBuybackInfoResponse)
+}
+func (x fastReflection_QueryGetBuybackInfoResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetBuybackInfoResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetBuybackInfoResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetBuybackInfoResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetBuybackInfoResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetBuybackInfoResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetBuybackInfoResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetBuybackInfoResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetBuybackInfoResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetBuybackInfoResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetBuybackInfoResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Config != nil {
+		value := protoreflect.ValueOfMessage(x.Config.ProtoReflect())
+		if !f(fd_QueryGetBuybackInfoResponse_config, value) {
+			return
+		}
+	}
+	if x.Stats != nil {
+		value := protoreflect.ValueOfMessage(x.Stats.ProtoReflect())
+		if !f(fd_QueryGetBuybackInfoResponse_stats, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetBuybackInfoResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryGetBuybackInfoResponse.config":
+		return x.Config != nil
+	case "zigchain.dex.QueryGetBuybackInfoResponse.stats":
+		return x.Stats != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetBuybackInfoResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetBuybackInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetBuybackInfoResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryGetBuybackInfoResponse.config":
+		x.Config = nil
+	case "zigchain.dex.QueryGetBuybackInfoResponse.stats":
+		x.Stats = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetBuybackInfoResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetBuybackInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetBuybackInfoResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zigchain.dex.QueryGetBuybackInfoResponse.config":
+		value := x.Config
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zigchain.dex.QueryGetBuybackInfoResponse.stats":
+		value := x.Stats
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetBuybackInfoResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetBuybackInfoResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetBuybackInfoResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryGetBuybackInfoResponse.config":
+		x.Config = value.Message().Interface().(*BuybackConfig)
+	case "zigchain.dex.QueryGetBuybackInfoResponse.stats":
+		x.Stats = value.Message().Interface().(*BuybackStats)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetBuybackInfoResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetBuybackInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetBuybackInfoResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryGetBuybackInfoResponse.config":
+		if x.Config == nil {
+			x.Config = new(BuybackConfig)
+		}
+		return protoreflect.ValueOfMessage(x.Config.ProtoReflect())
+	case "zigchain.dex.QueryGetBuybackInfoResponse.stats":
+		if x.Stats == nil {
+			x.Stats = new(BuybackStats)
+		}
+		return protoreflect.ValueOfMessage(x.Stats.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetBuybackInfoResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetBuybackInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetBuybackInfoResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryGetBuybackInfoResponse.config":
+		m := new(BuybackConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zigchain.dex.QueryGetBuybackInfoResponse.stats":
+		m := new(BuybackStats)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryGetBuybackInfoResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryGetBuybackInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetBuybackInfoResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.dex.QueryGetBuybackInfoResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetBuybackInfoResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetBuybackInfoResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetBuybackInfoResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetBuybackInfoResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetBuybackInfoResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Config != nil {
+			l = options.Size(x.Config)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Stats != nil {
+			l = options.Size(x.Stats)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetBuybackInfoResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Stats != nil {
+			encoded, err := options.Marshal(x.Stats)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Config != nil {
+			encoded, err := options.Marshal(x.Config)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetBuybackInfoResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetBuybackInfoResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetBuybackInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Config == nil {
+					x.Config = &BuybackConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Config); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Stats == nil {
+					x.Stats = &BuybackStats{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stats); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryPoolsByDenomRequest            protoreflect.MessageDescriptor
+	fd_QueryPoolsByDenomRequest_denom      protoreflect.FieldDescriptor
+	fd_QueryPoolsByDenomRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zigchain_dex_query_proto_init()
+	md_QueryPoolsByDenomRequest = File_zigchain_dex_query_proto.Messages().ByName("QueryPoolsByDenomRequest")
+	fd_QueryPoolsByDenomRequest_denom = md_QueryPoolsByDenomRequest.Fields().ByName("denom")
+	fd_QueryPoolsByDenomRequest_pagination = md_QueryPoolsByDenomRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPoolsByDenomRequest)(nil)
+
+type fastReflection_QueryPoolsByDenomRequest QueryPoolsByDenomRequest
+
+func (x *QueryPoolsByDenomRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolsByDenomRequest)(x)
+}
+
+func (x *QueryPoolsByDenomRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_dex_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPoolsByDenomRequest_messageType fastReflection_QueryPoolsByDenomRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolsByDenomRequest_messageType{}
+
+type fastReflection_QueryPoolsByDenomRequest_messageType struct{}
+
+func (x fastReflection_QueryPoolsByDenomRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolsByDenomRequest)(nil)
+}
+func (x fastReflection_QueryPoolsByDenomRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolsByDenomRequest)
+}
+func (x fastReflection_QueryPoolsByDenomRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolsByDenomRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPoolsByDenomRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolsByDenomRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPoolsByDenomRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolsByDenomRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPoolsByDenomRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolsByDenomRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPoolsByDenomRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolsByDenomRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPoolsByDenomRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_QueryPoolsByDenomRequest_denom, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryPoolsByDenomRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPoolsByDenomRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByDenomRequest.denom":
+		return x.Denom != ""
+	case "zigchain.dex.QueryPoolsByDenomRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByDenomRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByDenomRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolsByDenomRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByDenomRequest.denom":
+		x.Denom = ""
+	case "zigchain.dex.QueryPoolsByDenomRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByDenomRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByDenomRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPoolsByDenomRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zigchain.dex.QueryPoolsByDenomRequest.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "zigchain.dex.QueryPoolsByDenomRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByDenomRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByDenomRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolsByDenomRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByDenomRequest.denom":
+		x.Denom = value.Interface().(string)
+	case "zigchain.dex.QueryPoolsByDenomRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByDenomRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByDenomRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolsByDenomRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByDenomRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta11.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "zigchain.dex.QueryPoolsByDenomRequest.denom":
+		panic(fmt.Errorf("field denom of message zigchain.dex.QueryPoolsByDenomRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByDenomRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByDenomRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPoolsByDenomRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByDenomRequest.denom":
+		return protoreflect.ValueOfString("")
+	case "zigchain.dex.QueryPoolsByDenomRequest.pagination":
+		m := new(v1beta11.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByDenomRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByDenomRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPoolsByDenomRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.dex.QueryPoolsByDenomRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPoolsByDenomRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolsByDenomRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPoolsByDenomRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPoolsByDenomRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPoolsByDenomRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolsByDenomRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolsByDenomRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolsByDenomRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta11.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryPoolsByDenomResponse_1_list)(nil)
+
+type _QueryPoolsByDenomResponse_1_list struct {
+	list *[]*Pool
+}
+
+func (x *_QueryPoolsByDenomResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryPoolsByDenomResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryPoolsByDenomResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Pool)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryPoolsByDenomResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Pool)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryPoolsByDenomResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Pool)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPoolsByDenomResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryPoolsByDenomResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Pool)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPoolsByDenomResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryPoolsByDenomResponse            protoreflect.MessageDescriptor
+	fd_QueryPoolsByDenomResponse_pool       protoreflect.FieldDescriptor
+	fd_QueryPoolsByDenomResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zigchain_dex_query_proto_init()
+	md_QueryPoolsByDenomResponse = File_zigchain_dex_query_proto.Messages().ByName("QueryPoolsByDenomResponse")
+	fd_QueryPoolsByDenomResponse_pool = md_QueryPoolsByDenomResponse.Fields().ByName("pool")
+	fd_QueryPoolsByDenomResponse_pagination = md_QueryPoolsByDenomResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPoolsByDenomResponse)(nil)
+
+type fastReflection_QueryPoolsByDenomResponse QueryPoolsByDenomResponse
+
+func (x *QueryPoolsByDenomResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolsByDenomResponse)(x)
+}
+
+func (x *QueryPoolsByDenomResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_dex_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPoolsByDenomResponse_messageType fastReflection_QueryPoolsByDenomResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolsByDenomResponse_messageType{}
+
+type fastReflection_QueryPoolsByDenomResponse_messageType struct{}
+
+func (x fastReflection_QueryPoolsByDenomResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolsByDenomResponse)(nil)
+}
+func (x fastReflection_QueryPoolsByDenomResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolsByDenomResponse)
+}
+func (x fastReflection_QueryPoolsByDenomResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolsByDenomResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPoolsByDenomResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolsByDenomResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPoolsByDenomResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolsByDenomResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPoolsByDenomResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolsByDenomResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPoolsByDenomResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolsByDenomResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPoolsByDenomResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Pool) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPoolsByDenomResponse_1_list{list: &x.Pool})
+		if !f(fd_QueryPoolsByDenomResponse_pool, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryPoolsByDenomResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPoolsByDenomResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByDenomResponse.pool":
+		return len(x.Pool) != 0
+	case "zigchain.dex.QueryPoolsByDenomResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByDenomResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByDenomResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolsByDenomResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByDenomResponse.pool":
+		x.Pool = nil
+	case "zigchain.dex.QueryPoolsByDenomResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByDenomResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByDenomResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPoolsByDenomResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zigchain.dex.QueryPoolsByDenomResponse.pool":
+		if len(x.Pool) == 0 {
+			return protoreflect.ValueOfList(&_QueryPoolsByDenomResponse_1_list{})
+		}
+		listValue := &_QueryPoolsByDenomResponse_1_list{list: &x.Pool}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.dex.QueryPoolsByDenomResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByDenomResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByDenomResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolsByDenomResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByDenomResponse.pool":
+		lv := value.List()
+		clv := lv.(*_QueryPoolsByDenomResponse_1_list)
+		x.Pool = *clv.list
+	case "zigchain.dex.QueryPoolsByDenomResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByDenomResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByDenomResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolsByDenomResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByDenomResponse.pool":
+		if x.Pool == nil {
+			x.Pool = []*Pool{}
+		}
+		value := &_QueryPoolsByDenomResponse_1_list{list: &x.Pool}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.dex.QueryPoolsByDenomResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta11.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByDenomResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByDenomResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPoolsByDenomResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByDenomResponse.pool":
+		list := []*Pool{}
+		return protoreflect.ValueOfList(&_QueryPoolsByDenomResponse_1_list{list: &list})
+	case "zigchain.dex.QueryPoolsByDenomResponse.pagination":
+		m := new(v1beta11.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByDenomResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByDenomResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPoolsByDenomResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.dex.QueryPoolsByDenomResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPoolsByDenomResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolsByDenomResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPoolsByDenomResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPoolsByDenomResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPoolsByDenomResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Pool) > 0 {
+			for _, e := range x.Pool {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolsByDenomResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Pool) > 0 {
+			for iNdEx := len(x.Pool) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Pool[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolsByDenomResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolsByDenomResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pool = append(x.Pool, &Pool{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pool[len(x.Pool)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta11.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryPoolsByCreatorRequest            protoreflect.MessageDescriptor
+	fd_QueryPoolsByCreatorRequest_creator    protoreflect.FieldDescriptor
+	fd_QueryPoolsByCreatorRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zigchain_dex_query_proto_init()
+	md_QueryPoolsByCreatorRequest = File_zigchain_dex_query_proto.Messages().ByName("QueryPoolsByCreatorRequest")
+	fd_QueryPoolsByCreatorRequest_creator = md_QueryPoolsByCreatorRequest.Fields().ByName("creator")
+	fd_QueryPoolsByCreatorRequest_pagination = md_QueryPoolsByCreatorRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPoolsByCreatorRequest)(nil)
+
+type fastReflection_QueryPoolsByCreatorRequest QueryPoolsByCreatorRequest
+
+func (x *QueryPoolsByCreatorRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolsByCreatorRequest)(x)
+}
+
+func (x *QueryPoolsByCreatorRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_dex_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPoolsByCreatorRequest_messageType fastReflection_QueryPoolsByCreatorRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolsByCreatorRequest_messageType{}
+
+type fastReflection_QueryPoolsByCreatorRequest_messageType struct{}
+
+func (x fastReflection_QueryPoolsByCreatorRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolsByCreatorRequest)(nil)
+}
+func (x fastReflection_QueryPoolsByCreatorRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolsByCreatorRequest)
+}
+func (x fastReflection_QueryPoolsByCreatorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolsByCreatorRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPoolsByCreatorRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolsByCreatorRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPoolsByCreatorRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolsByCreatorRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPoolsByCreatorRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolsByCreatorRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPoolsByCreatorRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolsByCreatorRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPoolsByCreatorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_QueryPoolsByCreatorRequest_creator, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryPoolsByCreatorRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPoolsByCreatorRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByCreatorRequest.creator":
+		return x.Creator != ""
+	case "zigchain.dex.QueryPoolsByCreatorRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByCreatorRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByCreatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolsByCreatorRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByCreatorRequest.creator":
+		x.Creator = ""
+	case "zigchain.dex.QueryPoolsByCreatorRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByCreatorRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByCreatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPoolsByCreatorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zigchain.dex.QueryPoolsByCreatorRequest.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "zigchain.dex.QueryPoolsByCreatorRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByCreatorRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByCreatorRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolsByCreatorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByCreatorRequest.creator":
+		x.Creator = value.Interface().(string)
+	case "zigchain.dex.QueryPoolsByCreatorRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByCreatorRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByCreatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolsByCreatorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByCreatorRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta11.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "zigchain.dex.QueryPoolsByCreatorRequest.creator":
+		panic(fmt.Errorf("field creator of message zigchain.dex.QueryPoolsByCreatorRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByCreatorRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByCreatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPoolsByCreatorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByCreatorRequest.creator":
+		return protoreflect.ValueOfString("")
+	case "zigchain.dex.QueryPoolsByCreatorRequest.pagination":
+		m := new(v1beta11.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByCreatorRequest"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByCreatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPoolsByCreatorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.dex.QueryPoolsByCreatorRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPoolsByCreatorRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolsByCreatorRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPoolsByCreatorRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPoolsByCreatorRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPoolsByCreatorRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolsByCreatorRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolsByCreatorRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolsByCreatorRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolsByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta11.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryPoolsByCreatorResponse_1_list)(nil)
+
+type _QueryPoolsByCreatorResponse_1_list struct {
+	list *[]*Pool
+}
+
+func (x *_QueryPoolsByCreatorResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryPoolsByCreatorResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryPoolsByCreatorResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Pool)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryPoolsByCreatorResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Pool)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryPoolsByCreatorResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Pool)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPoolsByCreatorResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryPoolsByCreatorResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Pool)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPoolsByCreatorResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryPoolsByCreatorResponse            protoreflect.MessageDescriptor
+	fd_QueryPoolsByCreatorResponse_pool       protoreflect.FieldDescriptor
+	fd_QueryPoolsByCreatorResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zigchain_dex_query_proto_init()
+	md_QueryPoolsByCreatorResponse = File_zigchain_dex_query_proto.Messages().ByName("QueryPoolsByCreatorResponse")
+	fd_QueryPoolsByCreatorResponse_pool = md_QueryPoolsByCreatorResponse.Fields().ByName("pool")
+	fd_QueryPoolsByCreatorResponse_pagination = md_QueryPoolsByCreatorResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPoolsByCreatorResponse)(nil)
+
+type fastReflection_QueryPoolsByCreatorResponse QueryPoolsByCreatorResponse
+
+func (x *QueryPoolsByCreatorResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolsByCreatorResponse)(x)
+}
+
+func (x *QueryPoolsByCreatorResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_dex_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPoolsByCreatorResponse_messageType fastReflection_QueryPoolsByCreatorResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolsByCreatorResponse_messageType{}
+
+type fastReflection_QueryPoolsByCreatorResponse_messageType struct{}
+
+func (x fastReflection_QueryPoolsByCreatorResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolsByCreatorResponse)(nil)
+}
+func (x fastReflection_QueryPoolsByCreatorResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolsByCreatorResponse)
+}
+func (x fastReflection_QueryPoolsByCreatorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolsByCreatorResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPoolsByCreatorResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolsByCreatorResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPoolsByCreatorResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolsByCreatorResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPoolsByCreatorResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolsByCreatorResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPoolsByCreatorResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolsByCreatorResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPoolsByCreatorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Pool) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPoolsByCreatorResponse_1_list{list: &x.Pool})
+		if !f(fd_QueryPoolsByCreatorResponse_pool, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryPoolsByCreatorResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPoolsByCreatorResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByCreatorResponse.pool":
+		return len(x.Pool) != 0
+	case "zigchain.dex.QueryPoolsByCreatorResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByCreatorResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByCreatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolsByCreatorResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByCreatorResponse.pool":
+		x.Pool = nil
+	case "zigchain.dex.QueryPoolsByCreatorResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByCreatorResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByCreatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPoolsByCreatorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zigchain.dex.QueryPoolsByCreatorResponse.pool":
+		if len(x.Pool) == 0 {
+			return protoreflect.ValueOfList(&_QueryPoolsByCreatorResponse_1_list{})
+		}
+		listValue := &_QueryPoolsByCreatorResponse_1_list{list: &x.Pool}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.dex.QueryPoolsByCreatorResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByCreatorResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByCreatorResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolsByCreatorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByCreatorResponse.pool":
+		lv := value.List()
+		clv := lv.(*_QueryPoolsByCreatorResponse_1_list)
+		x.Pool = *clv.list
+	case "zigchain.dex.QueryPoolsByCreatorResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByCreatorResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByCreatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolsByCreatorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByCreatorResponse.pool":
+		if x.Pool == nil {
+			x.Pool = []*Pool{}
+		}
+		value := &_QueryPoolsByCreatorResponse_1_list{list: &x.Pool}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.dex.QueryPoolsByCreatorResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta11.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByCreatorResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByCreatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPoolsByCreatorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.dex.QueryPoolsByCreatorResponse.pool":
+		list := []*Pool{}
+		return protoreflect.ValueOfList(&_QueryPoolsByCreatorResponse_1_list{list: &list})
+	case "zigchain.dex.QueryPoolsByCreatorResponse.pagination":
+		m := new(v1beta11.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.dex.QueryPoolsByCreatorResponse"))
+		}
+		panic(fmt.Errorf("message zigchain.dex.QueryPoolsByCreatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPoolsByCreatorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.dex.QueryPoolsByCreatorResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPoolsByCreatorResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolsByCreatorResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPoolsByCreatorResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPoolsByCreatorResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPoolsByCreatorResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Pool) > 0 {
+			for _, e := range x.Pool {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolsByCreatorResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Pool) > 0 {
+			for iNdEx := len(x.Pool) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Pool[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolsByCreatorResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolsByCreatorResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolsByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pool = append(x.Pool, &Pool{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pool[len(x.Pool)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta11.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
 		}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: zigchain/dex/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_dex_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params holds all the parameters of this module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_dex_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// QueryGetPoolRequest gets a specific pool by its ID.
+type QueryGetPoolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PoolId string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (x *QueryGetPoolRequest) Reset() {
+	*x = QueryGetPoolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_dex_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetPoolRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetPoolRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetPoolRequest) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryGetPoolRequest) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+// QueryGetPoolResponse is the response type for the Query/GetPool RPC method.
+type QueryGetPoolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pool *Pool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+}
+
+func (x *QueryGetPoolResponse) Reset() {
+	*x = QueryGetPoolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_dex_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetPoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetPoolResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGetPoolResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetPoolResponse) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryGetPoolResponse) GetPool() *Pool {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+// QueryGetPoolRequest gets a specific pool by its ID.
+type QueryGetPoolBalancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PoolId string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (x *QueryGetPoolBalancesRequest) Reset() {
+	*x = QueryGetPoolBalancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_dex_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetPoolBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetPoolBalancesRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetPoolBalancesRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetPoolBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryGetPoolBalancesRequest) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+// QueryGetPoolResponse is the response type for the Query/GetPool RPC method.
+type QueryGetPoolBalancesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pool     *Pool           `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	Balances []*v1beta1.Coin `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+}
+
+func (x *QueryGetPoolBalancesResponse) Reset() {
+	*x = QueryGetPoolBalancesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_dex_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetPoolBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetPoolBalancesResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGetPoolBalancesResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetPoolBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryGetPoolBalancesResponse) GetPool() *Pool {
+	if x != nil {
+		return x.Pool
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return nil
+}
+
+func (x *QueryGetPoolBalancesResponse) GetBalances() []*v1beta1.Coin {
+	if x != nil {
+		return x.Balances
 	}
+	return nil
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: zigchain/dex/query.proto
+// QueryAllPoolRequest is the request type for the Query/AllPool RPC method.
+type QueryAllPoolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+	Pagination *v1beta11.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+func (x *QueryAllPoolRequest) Reset() {
+	*x = QueryAllPoolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_dex_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllPoolRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAllPoolRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllPoolRequest) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryAllPoolRequest) GetPagination() *v1beta11.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryAllPoolResponse is the response type for the Query/AllPool RPC method.
+type QueryAllPoolResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Pool       []*Pool                `protobuf:"bytes,1,rep,name=pool,proto3" json:"pool,omitempty"`
+	Pagination *v1beta11.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
+func (x *QueryAllPoolResponse) Reset() {
+	*x = QueryAllPoolResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zigchain_dex_query_proto_msgTypes[0]
+		mi := &file_zigchain_dex_query_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsRequest) String() string {
+func (x *QueryAllPoolResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsRequest) ProtoMessage() {}
+func (*QueryAllPoolResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use QueryAllPoolResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllPoolResponse) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{7}
 }
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
+func (x *QueryAllPoolResponse) GetPool() []*Pool {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+func (x *QueryAllPoolResponse) GetPagination() *v1beta11.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryGetPoolsMetaRequest gets a specific pool by its ID.
+type QueryGetPoolsMetaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	// params holds all the parameters of this module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+func (x *QueryGetPoolsMetaRequest) Reset() {
+	*x = QueryGetPoolsMetaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_dex_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
+func (x *QueryGetPoolsMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetPoolsMetaRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetPoolsMetaRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetPoolsMetaRequest) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{8}
+}
+
+// QueryGetPoolsMetaResponse is the response type for the Query/GetPool RPC
+// method.
+type QueryGetPoolsMetaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PoolsMeta *PoolsMeta `protobuf:"bytes,1,opt,name=pools_meta,json=poolsMeta,proto3" json:"pools_meta,omitempty"`
+}
+
+func (x *QueryGetPoolsMetaResponse) Reset() {
+	*x = QueryGetPoolsMetaResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zigchain_dex_query_proto_msgTypes[1]
+		mi := &file_zigchain_dex_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
+func (x *QueryGetPoolsMetaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
+func (*QueryGetPoolsMetaResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryGetPoolsMetaResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetPoolsMetaResponse) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QueryGetPoolsMetaResponse) GetPoolsMeta() *PoolsMeta {
 	if x != nil {
-		return x.Params
+		return x.PoolsMeta
 	}
 	return nil
 }
 
-// QueryGetPoolRequest gets a specific pool by its ID.
-type QueryGetPoolRequest struct {
+// QueryGetPoolUidRequest gets a specific pool based on base and quote.
+type QueryGetPoolUidRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PoolId string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Base  string `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quote string `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
 }
 
-func (x *QueryGetPoolRequest) Reset() {
-	*x = QueryGetPoolRequest{}
+func (x *QueryGetPoolUidRequest) Reset() {
+	*x = QueryGetPoolUidRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zigchain_dex_query_proto_msgTypes[2]
+		mi := &file_zigchain_dex_query_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetPoolRequest) String() string {
+func (x *QueryGetPoolUidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetPoolUidRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGetPoolUidRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetPoolUidRequest) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryGetPoolUidRequest) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *QueryGetPoolUidRequest) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+// QueryGetPoolUidResponse is the response type for the Query/GetPool RPC
+// method.
+type QueryGetPoolUidResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PoolUids *PoolUids `protobuf:"bytes,1,opt,name=pool_uids,json=poolUids,proto3" json:"pool_uids,omitempty"`
+}
+
+func (x *QueryGetPoolUidResponse) Reset() {
+	*x = QueryGetPoolUidResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_dex_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGetPoolUidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGetPoolUidResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGetPoolUidResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetPoolUidResponse) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryGetPoolUidResponse) GetPoolUids() *PoolUids {
+	if x != nil {
+		return x.PoolUids
+	}
+	return nil
+}
+
+// QueryAllPoolUidsRequest is the request type for the Query/AllPool RPC method.
+type QueryAllPoolUidsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta11.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllPoolUidsRequest) Reset() {
+	*x = QueryAllPoolUidsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_dex_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllPoolUidsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetPoolRequest) ProtoMessage() {}
+func (*QueryAllPoolUidsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryGetPoolRequest.ProtoReflect.Descriptor instead.
-func (*QueryGetPoolRequest) Descriptor() ([]byte, []int) {
-	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryAllPoolUidsRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllPoolUidsRequest) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *QueryGetPoolRequest) GetPoolId() string {
+func (x *QueryAllPoolUidsRequest) GetPagination() *v1beta11.PageRequest {
 	if x != nil {
-		return x.PoolId
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryGetPoolResponse is the response type for the Query/GetPool RPC method.
-type QueryGetPoolResponse struct {
+// QueryAllPoolUidsResponse is the response type for the Query/AllPool RPC
+// method.
+type QueryAllPoolUidsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pool *Pool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	PoolUids   []*PoolUids            `protobuf:"bytes,1,rep,name=pool_uids,json=poolUids,proto3" json:"pool_uids,omitempty"`
+	Pagination *v1beta11.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryGetPoolResponse) Reset() {
-	*x = QueryGetPoolResponse{}
+func (x *QueryAllPoolUidsResponse) Reset() {
+	*x = QueryAllPoolUidsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zigchain_dex_query_proto_msgTypes[3]
+		mi := &file_zigchain_dex_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetPoolResponse) String() string {
+func (x *QueryAllPoolUidsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetPoolResponse) ProtoMessage() {}
+func (*QueryAllPoolUidsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryGetPoolResponse.ProtoReflect.Descriptor instead.
-func (*QueryGetPoolResponse) Descriptor() ([]byte, []int) {
-	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryAllPoolUidsResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllPoolUidsResponse) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *QueryGetPoolResponse) GetPool() *Pool {
+func (x *QueryAllPoolUidsResponse) GetPoolUids() []*PoolUids {
 	if x != nil {
-		return x.Pool
+		return x.PoolUids
 	}
 	return nil
 }
 
-// QueryGetPoolRequest gets a specific pool by its ID.
-type QueryGetPoolBalancesRequest struct {
+func (x *QueryAllPoolUidsResponse) GetPagination() *v1beta11.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QuerySwapInRequest gets a outgoing price from specific pool and incoming
+// token.
+type QuerySwapInRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	PoolId string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	CoinIn string `protobuf:"bytes,2,opt,name=coin_in,json=coinIn,proto3" json:"coin_in,omitempty"`
+	// address is the trader the transfer taxes are quoted for, optional
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *QueryGetPoolBalancesRequest) Reset() {
-	*x = QueryGetPoolBalancesRequest{}
+func (x *QuerySwapInRequest) Reset() {
+	*x = QuerySwapInRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zigchain_dex_query_proto_msgTypes[4]
+		mi := &file_zigchain_dex_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetPoolBalancesRequest) String() string {
+func (x *QuerySwapInRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetPoolBalancesRequest) ProtoMessage() {}
+func (*QuerySwapInRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryGetPoolBalancesRequest.ProtoReflect.Descriptor instead.
-func (*QueryGetPoolBalancesRequest) Descriptor() ([]byte, []int) {
-	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QuerySwapInRequest.ProtoReflect.Descriptor instead.
+func (*QuerySwapInRequest) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *QueryGetPoolBalancesRequest) GetPoolId() string {
+func (x *QuerySwapInRequest) GetPoolId() string {
 	if x != nil {
 		return x.PoolId
 	}
 	return ""
 }
 
-// QueryGetPoolResponse is the response type for the Query/GetPool RPC method.
-type QueryGetPoolBalancesResponse struct {
+func (x *QuerySwapInRequest) GetCoinIn() string {
+	if x != nil {
+		return x.CoinIn
+	}
+	return ""
+}
+
+func (x *QuerySwapInRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// QuerySwapInResponse returns amount of tokens given back given pool id and
+// incoming.
+type QuerySwapInResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pool     *Pool           `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
-	Balances []*v1beta1.Coin `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+	CoinOut *v1beta1.Coin `protobuf:"bytes,1,opt,name=coin_out,json=coinOut,proto3" json:"coin_out,omitempty"`
+	Fee     *v1beta1.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	// fee_rate is the effective fee rate applied to the swap (per 100,000)
+	FeeRate uint32 `protobuf:"varint,3,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	// incoming_tax is the transfer tax paid on top of coin_in, set when it is
+	// taxed
+	IncomingTax *v1beta1.Coin `protobuf:"bytes,4,opt,name=incoming_tax,json=incomingTax,proto3" json:"incoming_tax,omitempty"`
+	// outgoing_tax is the transfer tax already taken out of coin_out, set when
+	// it is taxed
+	OutgoingTax *v1beta1.Coin `protobuf:"bytes,5,opt,name=outgoing_tax,json=outgoingTax,proto3" json:"outgoing_tax,omitempty"`
 }
 
-func (x *QueryGetPoolBalancesResponse) Reset() {
-	*x = QueryGetPoolBalancesResponse{}
+func (x *QuerySwapInResponse) Reset() {
+	*x = QuerySwapInResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zigchain_dex_query_proto_msgTypes[5]
+		mi := &file_zigchain_dex_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetPoolBalancesResponse) String() string {
+func (x *QuerySwapInResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetPoolBalancesResponse) ProtoMessage() {}
+func (*QuerySwapInResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryGetPoolBalancesResponse.ProtoReflect.Descriptor instead.
-func (*QueryGetPoolBalancesResponse) Descriptor() ([]byte, []int) {
-	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QuerySwapInResponse.ProtoReflect.Descriptor instead.
+func (*QuerySwapInResponse) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QueryGetPoolBalancesResponse) GetPool() *Pool {
+func (x *QuerySwapInResponse) GetCoinOut() *v1beta1.Coin {
 	if x != nil {
-		return x.Pool
+		return x.CoinOut
 	}
 	return nil
 }
 
-func (x *QueryGetPoolBalancesResponse) GetBalances() []*v1beta1.Coin {
+func (x *QuerySwapInResponse) GetFee() *v1beta1.Coin {
 	if x != nil {
-		return x.Balances
+		return x.Fee
 	}
 	return nil
 }
 
-// QueryAllPoolRequest is the request type for the Query/AllPool RPC method.
-type QueryAllPoolRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Pagination *v1beta11.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-}
-
-func (x *QueryAllPoolRequest) Reset() {
-	*x = QueryAllPoolRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_zigchain_dex_query_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QuerySwapInResponse) GetFeeRate() uint32 {
+	if x != nil {
+		return x.FeeRate
 	}
+	return 0
 }
 
-func (x *QueryAllPoolRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*QueryAllPoolRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryAllPoolRequest.ProtoReflect.Descriptor instead.
-func (*QueryAllPoolRequest) Descriptor() ([]byte, []int) {
-	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{6}
+func (x *QuerySwapInResponse) GetIncomingTax() *v1beta1.Coin {
+	if x != nil {
+		return x.IncomingTax
+	}
+	return nil
 }
 
-func (x *QueryAllPoolRequest) GetPagination() *v1beta11.PageRequest {
+func (x *QuerySwapInResponse) GetOutgoingTax() *v1beta1.Coin {
 	if x != nil {
-		return x.Pagination
+		return x.OutgoingTax
 	}
 	return nil
 }
 
-// QueryAllPoolResponse is the response type for the Query/AllPool RPC method.
-type QueryAllPoolResponse struct {
+// QuerySwapOutRequest gets a incoming price from specific pool and outgoing
+// token.
+type QuerySwapOutRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pool       []*Pool                `protobuf:"bytes,1,rep,name=pool,proto3" json:"pool,omitempty"`
-	Pagination *v1beta11.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	PoolId  string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	CoinOut string `protobuf:"bytes,2,opt,name=coin_out,json=coinOut,proto3" json:"coin_out,omitempty"`
+	// address is the trader the transfer taxes are quoted for, optional
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *QueryAllPoolResponse) Reset() {
-	*x = QueryAllPoolResponse{}
+func (x *QuerySwapOutRequest) Reset() {
+	*x = QuerySwapOutRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zigchain_dex_query_proto_msgTypes[7]
+		mi := &file_zigchain_dex_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllPoolResponse) String() string {
+func (x *QuerySwapOutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllPoolResponse) ProtoMessage() {}
+func (*QuerySwapOutRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryAllPoolResponse.ProtoReflect.Descriptor instead.
-func (*QueryAllPoolResponse) Descriptor() ([]byte, []int) {
-	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QuerySwapOutRequest.ProtoReflect.Descriptor instead.
+func (*QuerySwapOutRequest) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QueryAllPoolResponse) GetPool() []*Pool {
+func (x *QuerySwapOutRequest) GetPoolId() string {
 	if x != nil {
-		return x.Pool
+		return x.PoolId
 	}
-	return nil
+	return ""
 }
 
-func (x *QueryAllPoolResponse) GetPagination() *v1beta11.PageResponse {
+func (x *QuerySwapOutRequest) GetCoinOut() string {
 	if x != nil {
-		return x.Pagination
+		return x.CoinOut
 	}
-	return nil
+	return ""
 }
 
-// QueryGetPoolsMetaRequest gets a specific pool by its ID.
-type QueryGetPoolsMetaRequest struct {
+func (x *QuerySwapOutRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// QuerySwapInResponse returns amount of tokens given back given pool id and
+// incoming.
+type QuerySwapOutResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	CoinIn *v1beta1.Coin `protobuf:"bytes,1,opt,name=coin_in,json=coinIn,proto3" json:"coin_in,omitempty"`
+	Fee    *v1beta1.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	// fee_rate is the effective fee rate applied to the swap (per 100,000)
+	FeeRate uint32 `protobuf:"varint,3,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	// incoming_tax is the transfer tax paid on top of coin_in, set when it is
+	// taxed
+	IncomingTax *v1beta1.Coin `protobuf:"bytes,4,opt,name=incoming_tax,json=incomingTax,proto3" json:"incoming_tax,omitempty"`
+	// outgoing_tax is the transfer tax added to coin_out and taken out of it,
+	// set when it is taxed
+	OutgoingTax *v1beta1.Coin `protobuf:"bytes,5,opt,name=outgoing_tax,json=outgoingTax,proto3" json:"outgoing_tax,omitempty"`
 }
 
-func (x *QueryGetPoolsMetaRequest) Reset() {
-	*x = QueryGetPoolsMetaRequest{}
+func (x *QuerySwapOutResponse) Reset() {
+	*x = QuerySwapOutResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zigchain_dex_query_proto_msgTypes[8]
+		mi := &file_zigchain_dex_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetPoolsMetaRequest) String() string {
+func (x *QuerySwapOutResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetPoolsMetaRequest) ProtoMessage() {}
+func (*QuerySwapOutResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryGetPoolsMetaRequest.ProtoReflect.Descriptor instead.
-func (*QueryGetPoolsMetaRequest) Descriptor() ([]byte, []int) {
-	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use QuerySwapOutResponse.ProtoReflect.Descriptor instead.
+func (*QuerySwapOutResponse) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{17}
 }
 
-// QueryGetPoolsMetaResponse is the response type for the Query/GetPool RPC
-// method.
-type QueryGetPoolsMetaResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PoolsMeta *PoolsMeta `protobuf:"bytes,1,opt,name=pools_meta,json=poolsMeta,proto3" json:"pools_meta,omitempty"`
+func (x *QuerySwapOutResponse) GetCoinIn() *v1beta1.Coin {
+	if x != nil {
+		return x.CoinIn
+	}
+	return nil
 }
 
-func (x *QueryGetPoolsMetaResponse) Reset() {
-	*x = QueryGetPoolsMetaResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_zigchain_dex_query_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QuerySwapOutResponse) GetFee() *v1beta1.Coin {
+	if x != nil {
+		return x.Fee
 	}
+	return nil
 }
 
-func (x *QueryGetPoolsMetaResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QuerySwapOutResponse) GetFeeRate() uint32 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
 }
 
-func (*QueryGetPoolsMetaResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryGetPoolsMetaResponse.ProtoReflect.Descriptor instead.
-func (*QueryGetPoolsMetaResponse) Descriptor() ([]byte, []int) {
-	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{9}
+func (x *QuerySwapOutResponse) GetIncomingTax() *v1beta1.Coin {
+	if x != nil {
+		return x.IncomingTax
+	}
+	return nil
 }
 
-func (x *QueryGetPoolsMetaResponse) GetPoolsMeta() *PoolsMeta {
+func (x *QuerySwapOutResponse) GetOutgoingTax() *v1beta1.Coin {
 	if x != nil {
-		return x.PoolsMeta
+		return x.OutgoingTax
 	}
 	return nil
 }
 
-// QueryGetPoolUidRequest gets a specific pool based on base and quote.
-type QueryGetPoolUidRequest struct {
+// QueryGetDynamicFeeRequest gets the dynamic fee state of a specific pool.
+type QueryGetDynamicFeeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Base  string `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Quote string `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	PoolId string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func (x *QueryGetPoolUidRequest) Reset() {
-	*x = QueryGetPoolUidRequest{}
+func (x *QueryGetDynamicFeeRequest) Reset() {
+	*x = QueryGetDynamicFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zigchain_dex_query_proto_msgTypes[10]
+		mi := &file_zigchain_dex_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetPoolUidRequest) String() string {
+func (x *QueryGetDynamicFeeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetPoolUidRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryGetPoolUidRequest.ProtoReflect.Descriptor instead.
-func (*QueryGetPoolUidRequest) Descriptor() ([]byte, []int) {
-	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{10}
-}
+func (*QueryGetDynamicFeeRequest) ProtoMessage() {}
 
-func (x *QueryGetPoolUidRequest) GetBase() string {
-	if x != nil {
-		return x.Base
-	}
-	return ""
+// Deprecated: Use QueryGetDynamicFeeRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetDynamicFeeRequest) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryGetPoolUidRequest) GetQuote() string {
+func (x *QueryGetDynamicFeeRequest) GetPoolId() string {
 	if x != nil {
-		return x.Quote
+		return x.PoolId
 	}
 	return ""
 }
 
-// QueryGetPoolUidResponse is the response type for the Query/GetPool RPC
-// method.
-type QueryGetPoolUidResponse struct {
+// QueryGetDynamicFeeResponse is the response type for the Query/GetDynamicFee
+// RPC method.
+type QueryGetDynamicFeeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PoolUids *PoolUids `protobuf:"bytes,1,opt,name=pool_uids,json=poolUids,proto3" json:"pool_uids,omitempty"`
+	// config is the dynamic fee configuration, empty if never set
+	Config *DynamicFeeConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// volatility is the tracked volatility, decayed to the current height
+	Volatility *PoolVolatility `protobuf:"bytes,2,opt,name=volatility,proto3" json:"volatility,omitempty"`
+	// effective_fee is the fee that applies to a swap at the current height
+	// (per 100,000)
+	EffectiveFee uint32 `protobuf:"varint,3,opt,name=effective_fee,json=effectiveFee,proto3" json:"effective_fee,omitempty"`
 }
 
-func (x *QueryGetPoolUidResponse) Reset() {
-	*x = QueryGetPoolUidResponse{}
+func (x *QueryGetDynamicFeeResponse) Reset() {
+	*x = QueryGetDynamicFeeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zigchain_dex_query_proto_msgTypes[11]
+		mi := &file_zigchain_dex_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGetPoolUidResponse) String() string {
+func (x *QueryGetDynamicFeeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGetPoolUidResponse) ProtoMessage() {}
+func (*QueryGetDynamicFeeResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryGetPoolUidResponse.ProtoReflect.Descriptor instead.
-func (*QueryGetPoolUidResponse) Descriptor() ([]byte, []int) {
-	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use QueryGetDynamicFeeResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetDynamicFeeResponse) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *QueryGetPoolUidResponse) GetPoolUids() *PoolUids {
+func (x *QueryGetDynamicFeeResponse) GetConfig() *DynamicFeeConfig {
 	if x != nil {
-		return x.PoolUids
+		return x.Config
 	}
 	return nil
 }
 
-// QueryAllPoolUidsRequest is the request type for the Query/AllPool RPC method.
-type QueryAllPoolUidsRequest struct {
+func (x *QueryGetDynamicFeeResponse) GetVolatility() *PoolVolatility {
+	if x != nil {
+		return x.Volatility
+	}
+	return nil
+}
+
+func (x *QueryGetDynamicFeeResponse) GetEffectiveFee() uint32 {
+	if x != nil {
+		return x.EffectiveFee
+	}
+	return 0
+}
+
+// QueryGetBuybackInfoRequest gets the buyback configuration and totals.
+type QueryGetBuybackInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Pagination *v1beta11.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryAllPoolUidsRequest) Reset() {
-	*x = QueryAllPoolUidsRequest{}
+func (x *QueryGetBuybackInfoRequest) Reset() {
+	*x = QueryGetBuybackInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zigchain_dex_query_proto_msgTypes[12]
+		mi := &file_zigchain_dex_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllPoolUidsRequest) String() string {
+func (x *QueryGetBuybackInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllPoolUidsRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryAllPoolUidsRequest.ProtoReflect.Descriptor instead.
-func (*QueryAllPoolUidsRequest) Descriptor() ([]byte, []int) {
-	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{12}
-}
+func (*QueryGetBuybackInfoRequest) ProtoMessage() {}
 
-func (x *QueryAllPoolUidsRequest) GetPagination() *v1beta11.PageRequest {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
+// Deprecated: Use QueryGetBuybackInfoRequest.ProtoReflect.Descriptor instead.
+func (*QueryGetBuybackInfoRequest) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{20}
 }
 
-// QueryAllPoolUidsResponse is the response type for the Query/AllPool RPC
-// method.
-type QueryAllPoolUidsResponse struct {
+// QueryGetBuybackInfoResponse is the response type for the Query/GetBuybackInfo
+// RPC method.
+type QueryGetBuybackInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PoolUids   []*PoolUids            `protobuf:"bytes,1,rep,name=pool_uids,json=poolUids,proto3" json:"pool_uids,omitempty"`
-	Pagination *v1beta11.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Config *BuybackConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Stats  *BuybackStats  `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
 }
 
-func (x *QueryAllPoolUidsResponse) Reset() {
-	*x = QueryAllPoolUidsResponse{}
+func (x *QueryGetBuybackInfoResponse) Reset() {
+	*x = QueryGetBuybackInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zigchain_dex_query_proto_msgTypes[13]
+		mi := &file_zigchain_dex_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllPoolUidsResponse) String() string {
+func (x *QueryGetBuybackInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllPoolUidsResponse) ProtoMessage() {}
+func (*QueryGetBuybackInfoResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryAllPoolUidsResponse.ProtoReflect.Descriptor instead.
-func (*QueryAllPoolUidsResponse) Descriptor() ([]byte, []int) {
-	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use QueryGetBuybackInfoResponse.ProtoReflect.Descriptor instead.
+func (*QueryGetBuybackInfoResponse) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryAllPoolUidsResponse) GetPoolUids() []*PoolUids {
+func (x *QueryGetBuybackInfoResponse) GetConfig() *BuybackConfig {
 	if x != nil {
-		return x.PoolUids
+		return x.Config
 	}
 	return nil
 }
 
-func (x *QueryAllPoolUidsResponse) GetPagination() *v1beta11.PageResponse {
+func (x *QueryGetBuybackInfoResponse) GetStats() *BuybackStats {
 	if x != nil {
-		return x.Pagination
+		return x.Stats
 	}
 	return nil
 }
 
-// QuerySwapInRequest gets a outgoing price from specific pool and incoming
-// token.
-type QuerySwapInRequest struct {
+// QueryPoolsByDenomRequest lists the pools having a denom as base or quote.
+type QueryPoolsByDenomRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PoolId string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	CoinIn string `protobuf:"bytes,2,opt,name=coin_in,json=coinIn,proto3" json:"coin_in,omitempty"`
+	Denom      string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *v1beta11.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QuerySwapInRequest) Reset() {
-	*x = QuerySwapInRequest{}
+func (x *QueryPoolsByDenomRequest) Reset() {
+	*x = QueryPoolsByDenomRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zigchain_dex_query_proto_msgTypes[14]
+		mi := &file_zigchain_dex_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySwapInRequest) String() string {
+func (x *QueryPoolsByDenomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySwapInRequest) ProtoMessage() {}
+func (*QueryPoolsByDenomRequest) ProtoMessage() {}
 
-// Deprecated: Use QuerySwapInRequest.ProtoReflect.Descriptor instead.
-func (*QuerySwapInRequest) Descriptor() ([]byte, []int) {
-	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use QueryPoolsByDenomRequest.ProtoReflect.Descriptor instead.
+func (*QueryPoolsByDenomRequest) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *QuerySwapInRequest) GetPoolId() string {
+func (x *QueryPoolsByDenomRequest) GetDenom() string {
 	if x != nil {
-		return x.PoolId
+		return x.Denom
 	}
 	return ""
 }
 
-func (x *QuerySwapInRequest) GetCoinIn() string {
+func (x *QueryPoolsByDenomRequest) GetPagination() *v1beta11.PageRequest {
 	if x != nil {
-		return x.CoinIn
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QuerySwapInResponse returns amount of tokens given back given pool id and
-// incoming.
-type QuerySwapInResponse struct {
+// QueryPoolsByDenomResponse is the response type for the
+// Query/ListPoolsByDenom RPC method.
+type QueryPoolsByDenomResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CoinOut *v1beta1.Coin `protobuf:"bytes,1,opt,name=coin_out,json=coinOut,proto3" json:"coin_out,omitempty"`
-	Fee     *v1beta1.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	Pool       []*Pool                `protobuf:"bytes,1,rep,name=pool,proto3" json:"pool,omitempty"`
+	Pagination *v1beta11.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QuerySwapInResponse) Reset() {
-	*x = QuerySwapInResponse{}
+func (x *QueryPoolsByDenomResponse) Reset() {
+	*x = QueryPoolsByDenomResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zigchain_dex_query_proto_msgTypes[15]
+		mi := &file_zigchain_dex_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySwapInResponse) String() string {
+func (x *QueryPoolsByDenomResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySwapInResponse) ProtoMessage() {}
+func (*QueryPoolsByDenomResponse) ProtoMessage() {}
 
-// Deprecated: Use QuerySwapInResponse.ProtoReflect.Descriptor instead.
-func (*QuerySwapInResponse) Descriptor() ([]byte, []int) {
-	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use QueryPoolsByDenomResponse.ProtoReflect.Descriptor instead.
+func (*QueryPoolsByDenomResponse) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QuerySwapInResponse) GetCoinOut() *v1beta1.Coin {
+func (x *QueryPoolsByDenomResponse) GetPool() []*Pool {
 	if x != nil {
-		return x.CoinOut
+		return x.Pool
 	}
 	return nil
 }
 
-func (x *QuerySwapInResponse) GetFee() *v1beta1.Coin {
+func (x *QueryPoolsByDenomResponse) GetPagination() *v1beta11.PageResponse {
 	if x != nil {
-		return x.Fee
+		return x.Pagination
 	}
 	return nil
 }
 
-// QuerySwapOutRequest gets a incoming price from specific pool and outgoing
-// token.
-type QuerySwapOutRequest struct {
+// QueryPoolsByCreatorRequest lists the pools created by an address.
+type QueryPoolsByCreatorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PoolId  string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	CoinOut string `protobuf:"bytes,2,opt,name=coin_out,json=coinOut,proto3" json:"coin_out,omitempty"`
+	Creator    string                `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Pagination *v1beta11.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QuerySwapOutRequest) Reset() {
-	*x = QuerySwapOutRequest{}
+func (x *QueryPoolsByCreatorRequest) Reset() {
+	*x = QueryPoolsByCreatorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zigchain_dex_query_proto_msgTypes[16]
+		mi := &file_zigchain_dex_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySwapOutRequest) String() string {
+func (x *QueryPoolsByCreatorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySwapOutRequest) ProtoMessage() {}
+func (*QueryPoolsByCreatorRequest) ProtoMessage() {}
 
-// Deprecated: Use QuerySwapOutRequest.ProtoReflect.Descriptor instead.
-func (*QuerySwapOutRequest) Descriptor() ([]byte, []int) {
-	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use QueryPoolsByCreatorRequest.ProtoReflect.Descriptor instead.
+func (*QueryPoolsByCreatorRequest) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *QuerySwapOutRequest) GetPoolId() string {
+func (x *QueryPoolsByCreatorRequest) GetCreator() string {
 	if x != nil {
-		return x.PoolId
+		return x.Creator
 	}
 	return ""
 }
 
-func (x *QuerySwapOutRequest) GetCoinOut() string {
+func (x *QueryPoolsByCreatorRequest) GetPagination() *v1beta11.PageRequest {
 	if x != nil {
-		return x.CoinOut
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QuerySwapInResponse returns amount of tokens given back given pool id and
-// incoming.
-type QuerySwapOutResponse struct {
+// QueryPoolsByCreatorResponse is the response type for the
+// Query/ListPoolsByCreator RPC method.
+type QueryPoolsByCreatorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CoinIn *v1beta1.Coin `protobuf:"bytes,1,opt,name=coin_in,json=coinIn,proto3" json:"coin_in,omitempty"`
-	Fee    *v1beta1.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	Pool       []*Pool                `protobuf:"bytes,1,rep,name=pool,proto3" json:"pool,omitempty"`
+	Pagination *v1beta11.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QuerySwapOutResponse) Reset() {
-	*x = QuerySwapOutResponse{}
+func (x *QueryPoolsByCreatorResponse) Reset() {
+	*x = QueryPoolsByCreatorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zigchain_dex_query_proto_msgTypes[17]
+		mi := &file_zigchain_dex_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySwapOutResponse) String() string {
+func (x *QueryPoolsByCreatorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySwapOutResponse) ProtoMessage() {}
+func (*QueryPoolsByCreatorResponse) ProtoMessage() {}
 
-// Deprecated: Use QuerySwapOutResponse.ProtoReflect.Descriptor instead.
-func (*QuerySwapOutResponse) Descriptor() ([]byte, []int) {
-	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use QueryPoolsByCreatorResponse.ProtoReflect.Descriptor instead.
+func (*QueryPoolsByCreatorResponse) Descriptor() ([]byte, []int) {
+	return file_zigchain_dex_query_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QuerySwapOutResponse) GetCoinIn() *v1beta1.Coin {
+func (x *QueryPoolsByCreatorResponse) GetPool() []*Pool {
 	if x != nil {
-		return x.CoinIn
+		return x.Pool
 	}
 	return nil
 }
 
-func (x *QuerySwapOutResponse) GetFee() *v1beta1.Coin {
+func (x *QueryPoolsByCreatorResponse) GetPagination() *v1beta11.PageResponse {
 	if x != nil {
-		return x.Fee
+		return x.Pagination
 	}
 	return nil
 }
@@ -9105,199 +14060,324 @@ var file_zigchain_dex_query_proto_rawDesc = []byte{
 	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65,
 	0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x7a, 0x69, 0x67,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1d, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x6f,
-	0x6f, 0x6c, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
-	0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x6f, 0x6f,
-	0x6c, 0x5f, 0x75, 0x69, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x4e, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x7a, 0x69, 0x67, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
-	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x22, 0x2e, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f,
-	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c,
-	0x49, 0x64, 0x22, 0x44, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x70, 0x6f,
-	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x22, 0x36, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64,
-	0x22, 0x89, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f,
-	0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2c, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x50,
-	0x6f, 0x6f, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12,
-	0x3b, 0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x13,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x7a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x62, 0x75, 0x79, 0x62, 0x61, 0x63,
+	0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x66, 0x65,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78,
+	0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x7a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x5f,
+	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x7a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x69,
+	0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f,
+	0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e,
+	0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x64, 0x65, 0x78, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x2e,
+	0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x22, 0x44,
+	0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x64, 0x65, 0x78, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04,
+	0x70, 0x6f, 0x6f, 0x6c, 0x22, 0x36, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x22, 0x89, 0x01, 0x0a,
+	0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x7a, 0x69,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x3b, 0x0a, 0x08, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x50, 0x6f,
+	0x6f, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x47,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x59, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x6f, 0x6c, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x64, 0x65, 0x78, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x42,
+	0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x69,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x6f,
+	0x74, 0x65, 0x22, 0x54, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x6f, 0x6c, 0x55, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e,
+	0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08,
+	0x70, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x73, 0x22, 0x61, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
 	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x01, 0x0a, 0x14,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65,
-	0x78, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x70, 0x6f,
-	0x6f, 0x6c, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x0a, 0x18, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x4d, 0x65, 0x74, 0x61,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x59, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x5f, 0x6d, 0x65,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x4d, 0x65, 0x74,
-	0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x4d, 0x65,
-	0x74, 0x61, 0x22, 0x42, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x6f, 0x6c, 0x55, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x22, 0x54, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
-	0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x39, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x69, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
-	0x64, 0x65, 0x78, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x73, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x73, 0x22, 0x61, 0x0a, 0x17,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9e, 0x01, 0x0a, 0x18,
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x9e, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6f, 0x6c,
-	0x55, 0x69, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09,
-	0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x50,
-	0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x70,
-	0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x46, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12,
-	0x17, 0x0a, 0x07, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e, 0x49, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x13, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3a, 0x0a, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x31, 0x0a, 0x03,
-	0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22,
-	0x49, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12,
-	0x19, 0x0a, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6f, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x14, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x18, 0x01,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x75, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x7a, 0x69,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x55,
+	0x69, 0x64, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x55,
+	0x69, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x12,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63,
+	0x6f, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f,
+	0x69, 0x6e, 0x49, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa7,
+	0x02, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x6f,
+	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x69, 0x6e, 0x4f,
+	0x75, 0x74, 0x12, 0x31, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x42, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x78,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x54, 0x61, 0x78, 0x12, 0x42, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67,
+	0x5f, 0x74, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x0b, 0x6f, 0x75, 0x74,
+	0x67, 0x6f, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x78, 0x22, 0x63, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x69, 0x6e,
+	0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x69, 0x6e,
+	0x4f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa6, 0x02,
+	0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e, 0x49, 0x6e,
+	0x12, 0x31, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03,
+	0x66, 0x65, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x42,
+	0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x78, 0x18, 0x04,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
 	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x69, 0x6e, 0x49, 0x6e, 0x12, 0x31, 0x0a,
-	0x03, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x66, 0x65, 0x65,
-	0x32, 0x90, 0x09, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x6b, 0x0a, 0x06, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x20, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
-	0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x16, 0x12, 0x14, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78,
-	0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x76, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x6f, 0x6c, 0x12, 0x21, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65,
-	0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1e, 0x12, 0x1c, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78,
-	0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12,
-	0x97, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64,
-	0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x54,
+	0x61, 0x78, 0x12, 0x42, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x67, 0x6f,
+	0x69, 0x6e, 0x67, 0x54, 0x61, 0x78, 0x22, 0x34, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x65, 0x74, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x22, 0xc3, 0x01, 0x0a,
+	0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
+	0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x7a, 0x69,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d,
+	0x69, 0x63, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x42, 0x0a, 0x0a, 0x76, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x50, 0x6f, 0x6f,
+	0x6c, 0x56, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a,
+	0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46,
+	0x65, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x42, 0x75,
+	0x79, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x90, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x42, 0x75, 0x79,
+	0x62, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e,
+	0x42, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x36, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x7a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x42, 0x75, 0x79, 0x62, 0x61, 0x63,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x22, 0x78, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c,
+	0x73, 0x42, 0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x92, 0x01,
+	0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x42, 0x79, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x70,
+	0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x7a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x7e, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73,
+	0x42, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x94, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c,
+	0x73, 0x42, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e,
+	0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c,
+	0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xdb, 0x0d, 0x0a, 0x05, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x6b, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x20, 0x2e,
+	0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x7a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x76, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x21, 0x2e, 0x7a, 0x69,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
 	0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x27, 0x12, 0x25, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65,
-	0x78, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f,
-	0x7b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6d, 0x0a, 0x08, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x21, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6f,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68,
+	0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x7a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b,
+	0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x97, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x7a,
+	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x6f, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x7a, 0x69,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2d,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69,
+	0x64, 0x7d, 0x12, 0x6d, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x21,
+	0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f,
+	0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x12, 0x81, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x4d, 0x65,
+	0x74, 0x61, 0x12, 0x26, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65,
+	0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x4d,
+	0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x7a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x7a, 0x69,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73,
+	0x5f, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x89, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f,
+	0x6c, 0x55, 0x69, 0x64, 0x12, 0x24, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c,
+	0x55, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x7a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x7a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x69,
+	0x64, 0x73, 0x2f, 0x7b, 0x62, 0x61, 0x73, 0x65, 0x7d, 0x2f, 0x7b, 0x71, 0x75, 0x6f, 0x74, 0x65,
+	0x7d, 0x12, 0x7e, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64,
+	0x73, 0x12, 0x25, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68,
 	0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c,
-	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x64, 0x65, 0x78, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x81, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x26, 0x2e, 0x7a, 0x69, 0x67, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x27, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x4d, 0x65,
-	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1a, 0x12, 0x18, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65,
-	0x78, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x89, 0x01, 0x0a,
-	0x0a, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x12, 0x24, 0x2e, 0x7a, 0x69,
+	0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x69, 0x64,
+	0x73, 0x12, 0x80, 0x01, 0x0a, 0x06, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x12, 0x20, 0x2e, 0x7a,
+	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x7a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x6e,
+	0x2f, 0x7b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x63, 0x6f, 0x69, 0x6e,
+	0x5f, 0x69, 0x6e, 0x7d, 0x12, 0x85, 0x01, 0x0a, 0x07, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74,
+	0x12, 0x21, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64,
+	0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12,
+	0x2b, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x73,
+	0x77, 0x61, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x2f, 0x7b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x7b, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x7d, 0x12, 0x8f, 0x01, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x46, 0x65, 0x65, 0x12, 0x27,
+	0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x46, 0x65, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x44,
+	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x7a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
+	0x5f, 0x66, 0x65, 0x65, 0x2f, 0x7b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x84,
+	0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x28, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x42, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x7a, 0x69,
 	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28,
-	0x12, 0x26, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f,
-	0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x69, 0x64, 0x73, 0x2f, 0x7b, 0x62, 0x61, 0x73, 0x65, 0x7d,
-	0x2f, 0x7b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x7d, 0x12, 0x7e, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
-	0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x73, 0x12, 0x25, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c,
-	0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x26, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x69, 0x64, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12,
-	0x17, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70,
-	0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x69, 0x64, 0x73, 0x12, 0x80, 0x01, 0x0a, 0x06, 0x53, 0x77, 0x61,
-	0x70, 0x49, 0x6e, 0x12, 0x20, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64,
-	0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b,
-	0x12, 0x29, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f,
-	0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x2f, 0x7b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x7b, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x7d, 0x12, 0x85, 0x01, 0x0a, 0x07,
-	0x53, 0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x12, 0x21, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70,
-	0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x7a, 0x69, 0x67,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
-	0x77, 0x61, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x2f, 0x7b,
-	0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x6f,
-	0x75, 0x74, 0x7d, 0x42, 0x8e, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x69, 0x67, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x64, 0x65, 0x78, 0xa2, 0x02, 0x03, 0x5a, 0x44, 0x58, 0xaa, 0x02, 0x0c, 0x5a, 0x69,
-	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x78, 0xca, 0x02, 0x0c, 0x5a, 0x69, 0x67,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x44, 0x65, 0x78, 0xe2, 0x02, 0x18, 0x5a, 0x69, 0x67, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x44, 0x65, 0x78, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a,
-	0x3a, 0x44, 0x65, 0x78, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x47, 0x65, 0x74, 0x42, 0x75, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15,
+	0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x62, 0x75,
+	0x79, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x91, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f,
+	0x6f, 0x6c, 0x73, 0x42, 0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x26, 0x2e, 0x7a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x6f, 0x6f, 0x6c, 0x73, 0x42, 0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65,
+	0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x42, 0x79, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64,
+	0x65, 0x78, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x2f, 0x7b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x7d, 0x12, 0x9b, 0x01, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x42, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x28, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x42, 0x79, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x7a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x6f, 0x6f, 0x6c, 0x73, 0x42, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f,
+	0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0x2f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x42, 0x8e, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e,
+	0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x64, 0x65, 0x78, 0x42, 0x0a, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1d, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x78, 0xa2, 0x02, 0x03, 0x5a, 0x44, 0x58, 0xaa,
+	0x02, 0x0c, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x78, 0xca, 0x02,
+	0x0c, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x44, 0x65, 0x78, 0xe2, 0x02, 0x18,
+	0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x44, 0x65, 0x78, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x5a, 0x69, 0x67, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x44, 0x65, 0x78, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -9312,7 +14392,7 @@ func file_zigchain_dex_query_proto_rawDescGZIP() []byte {
 	return file_zigchain_dex_query_proto_rawDescData
 }
 
-var file_zigchain_dex_query_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_zigchain_dex_query_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_zigchain_dex_query_proto_goTypes = []interface{}{
 	(*QueryParamsRequest)(nil),           // 0: zigchain.dex.QueryParamsRequest
 	(*QueryParamsResponse)(nil),          // 1: zigchain.dex.QueryParamsResponse
@@ -9332,54 +14412,88 @@ var file_zigchain_dex_query_proto_goTypes = []interface{}{
 	(*QuerySwapInResponse)(nil),          // 15: zigchain.dex.QuerySwapInResponse
 	(*QuerySwapOutRequest)(nil),          // 16: zigchain.dex.QuerySwapOutRequest
 	(*QuerySwapOutResponse)(nil),         // 17: zigchain.dex.QuerySwapOutResponse
-	(*Params)(nil),                       // 18: zigchain.dex.Params
-	(*Pool)(nil),                         // 19: zigchain.dex.Pool
-	(*v1beta1.Coin)(nil),                 // 20: cosmos.base.v1beta1.Coin
-	(*v1beta11.PageRequest)(nil),         // 21: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta11.PageResponse)(nil),        // 22: cosmos.base.query.v1beta1.PageResponse
-	(*PoolsMeta)(nil),                    // 23: zigchain.dex.PoolsMeta
-	(*PoolUids)(nil),                     // 24: zigchain.dex.PoolUids
+	(*QueryGetDynamicFeeRequest)(nil),    // 18: zigchain.dex.QueryGetDynamicFeeRequest
+	(*QueryGetDynamicFeeResponse)(nil),   // 19: zigchain.dex.QueryGetDynamicFeeResponse
+	(*QueryGetBuybackInfoRequest)(nil),   // 20: zigchain.dex.QueryGetBuybackInfoRequest
+	(*QueryGetBuybackInfoResponse)(nil),  // 21: zigchain.dex.QueryGetBuybackInfoResponse
+	(*QueryPoolsByDenomRequest)(nil),     // 22: zigchain.dex.QueryPoolsByDenomRequest
+	(*QueryPoolsByDenomResponse)(nil),    // 23: zigchain.dex.QueryPoolsByDenomResponse
+	(*QueryPoolsByCreatorRequest)(nil),   // 24: zigchain.dex.QueryPoolsByCreatorRequest
+	(*QueryPoolsByCreatorResponse)(nil),  // 25: zigchain.dex.QueryPoolsByCreatorResponse
+	(*Params)(nil),                       // 26: zigchain.dex.Params
+	(*Pool)(nil),                         // 27: zigchain.dex.Pool
+	(*v1beta1.Coin)(nil),                 // 28: cosmos.base.v1beta1.Coin
+	(*v1beta11.PageRequest)(nil),         // 29: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta11.PageResponse)(nil),        // 30: cosmos.base.query.v1beta1.PageResponse
+	(*PoolsMeta)(nil),                    // 31: zigchain.dex.PoolsMeta
+	(*PoolUids)(nil),                     // 32: zigchain.dex.PoolUids
+	(*DynamicFeeConfig)(nil),             // 33: zigchain.dex.DynamicFeeConfig
+	(*PoolVolatility)(nil),               // 34: zigchain.dex.PoolVolatility
+	(*BuybackConfig)(nil),                // 35: zigchain.dex.BuybackConfig
+	(*BuybackStats)(nil),                 // 36: zigchain.dex.BuybackStats
 }
 var file_zigchain_dex_query_proto_depIdxs = []int32{
-	18, // 0: zigchain.dex.QueryParamsResponse.params:type_name -> zigchain.dex.Params
-	19, // 1: zigchain.dex.QueryGetPoolResponse.pool:type_name -> zigchain.dex.Pool
-	19, // 2: zigchain.dex.QueryGetPoolBalancesResponse.pool:type_name -> zigchain.dex.Pool
-	20, // 3: zigchain.dex.QueryGetPoolBalancesResponse.balances:type_name -> cosmos.base.v1beta1.Coin
-	21, // 4: zigchain.dex.QueryAllPoolRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	19, // 5: zigchain.dex.QueryAllPoolResponse.pool:type_name -> zigchain.dex.Pool
-	22, // 6: zigchain.dex.QueryAllPoolResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	23, // 7: zigchain.dex.QueryGetPoolsMetaResponse.pools_meta:type_name -> zigchain.dex.PoolsMeta
-	24, // 8: zigchain.dex.QueryGetPoolUidResponse.pool_uids:type_name -> zigchain.dex.PoolUids
-	21, // 9: zigchain.dex.QueryAllPoolUidsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	24, // 10: zigchain.dex.QueryAllPoolUidsResponse.pool_uids:type_name -> zigchain.dex.PoolUids
-	22, // 11: zigchain.dex.QueryAllPoolUidsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	20, // 12: zigchain.dex.QuerySwapInResponse.coin_out:type_name -> cosmos.base.v1beta1.Coin
-	20, // 13: zigchain.dex.QuerySwapInResponse.fee:type_name -> cosmos.base.v1beta1.Coin
-	20, // 14: zigchain.dex.QuerySwapOutResponse.coin_in:type_name -> cosmos.base.v1beta1.Coin
-	20, // 15: zigchain.dex.QuerySwapOutResponse.fee:type_name -> cosmos.base.v1beta1.Coin
-	0,  // 16: zigchain.dex.Query.Params:input_type -> zigchain.dex.QueryParamsRequest
-	2,  // 17: zigchain.dex.Query.GetPool:input_type -> zigchain.dex.QueryGetPoolRequest
-	4,  // 18: zigchain.dex.Query.GetPoolBalances:input_type -> zigchain.dex.QueryGetPoolBalancesRequest
-	6,  // 19: zigchain.dex.Query.ListPool:input_type -> zigchain.dex.QueryAllPoolRequest
-	8,  // 20: zigchain.dex.Query.GetPoolsMeta:input_type -> zigchain.dex.QueryGetPoolsMetaRequest
-	10, // 21: zigchain.dex.Query.GetPoolUid:input_type -> zigchain.dex.QueryGetPoolUidRequest
-	12, // 22: zigchain.dex.Query.ListPoolUids:input_type -> zigchain.dex.QueryAllPoolUidsRequest
-	14, // 23: zigchain.dex.Query.SwapIn:input_type -> zigchain.dex.QuerySwapInRequest
-	16, // 24: zigchain.dex.Query.SwapOut:input_type -> zigchain.dex.QuerySwapOutRequest
-	1,  // 25: zigchain.dex.Query.Params:output_type -> zigchain.dex.QueryParamsResponse
-	3,  // 26: zigchain.dex.Query.GetPool:output_type -> zigchain.dex.QueryGetPoolResponse
-	5,  // 27: zigchain.dex.Query.GetPoolBalances:output_type -> zigchain.dex.QueryGetPoolBalancesResponse
-	7,  // 28: zigchain.dex.Query.ListPool:output_type -> zigchain.dex.QueryAllPoolResponse
-	9,  // 29: zigchain.dex.Query.GetPoolsMeta:output_type -> zigchain.dex.QueryGetPoolsMetaResponse
-	11, // 30: zigchain.dex.Query.GetPoolUid:output_type -> zigchain.dex.QueryGetPoolUidResponse
-	13, // 31: zigchain.dex.Query.ListPoolUids:output_type -> zigchain.dex.QueryAllPoolUidsResponse
-	15, // 32: zigchain.dex.Query.SwapIn:output_type -> zigchain.dex.QuerySwapInResponse
-	17, // 33: zigchain.dex.Query.SwapOut:output_type -> zigchain.dex.QuerySwapOutResponse
-	25, // [25:34] is the sub-list for method output_type
-	16, // [16:25] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	26, // 0: zigchain.dex.QueryParamsResponse.params:type_name -> zigchain.dex.Params
+	27, // 1: zigchain.dex.QueryGetPoolResponse.pool:type_name -> zigchain.dex.Pool
+	27, // 2: zigchain.dex.QueryGetPoolBalancesResponse.pool:type_name -> zigchain.dex.Pool
+	28, // 3: zigchain.dex.QueryGetPoolBalancesResponse.balances:type_name -> cosmos.base.v1beta1.Coin
+	29, // 4: zigchain.dex.QueryAllPoolRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	27, // 5: zigchain.dex.QueryAllPoolResponse.pool:type_name -> zigchain.dex.Pool
+	30, // 6: zigchain.dex.QueryAllPoolResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	31, // 7: zigchain.dex.QueryGetPoolsMetaResponse.pools_meta:type_name -> zigchain.dex.PoolsMeta
+	32, // 8: zigchain.dex.QueryGetPoolUidResponse.pool_uids:type_name -> zigchain.dex.PoolUids
+	29, // 9: zigchain.dex.QueryAllPoolUidsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	32, // 10: zigchain.dex.QueryAllPoolUidsResponse.pool_uids:type_name -> zigchain.dex.PoolUids
+	30, // 11: zigchain.dex.QueryAllPoolUidsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	28, // 12: zigchain.dex.QuerySwapInResponse.coin_out:type_name -> cosmos.base.v1beta1.Coin
+	28, // 13: zigchain.dex.QuerySwapInResponse.fee:type_name -> cosmos.base.v1beta1.Coin
+	28, // 14: zigchain.dex.QuerySwapInResponse.incoming_tax:type_name -> cosmos.base.v1beta1.Coin
+	28, // 15: zigchain.dex.QuerySwapInResponse.outgoing_tax:type_name -> cosmos.base.v1beta1.Coin
+	28, // 16: zigchain.dex.QuerySwapOutResponse.coin_in:type_name -> cosmos.base.v1beta1.Coin
+	28, // 17: zigchain.dex.QuerySwapOutResponse.fee:type_name -> cosmos.base.v1beta1.Coin
+	28, // 18: zigchain.dex.QuerySwapOutResponse.incoming_tax:type_name -> cosmos.base.v1beta1.Coin
+	28, // 19: zigchain.dex.QuerySwapOutResponse.outgoing_tax:type_name -> cosmos.base.v1beta1.Coin
+	33, // 20: zigchain.dex.QueryGetDynamicFeeResponse.config:type_name -> zigchain.dex.DynamicFeeConfig
+	34, // 21: zigchain.dex.QueryGetDynamicFeeResponse.volatility:type_name -> zigchain.dex.PoolVolatility
+	35, // 22: zigchain.dex.QueryGetBuybackInfoResponse.config:type_name -> zigchain.dex.BuybackConfig
+	36, // 23: zigchain.dex.QueryGetBuybackInfoResponse.stats:type_name -> zigchain.dex.BuybackStats
+	29, // 24: zigchain.dex.QueryPoolsByDenomRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	27, // 25: zigchain.dex.QueryPoolsByDenomResponse.pool:type_name -> zigchain.dex.Pool
+	30, // 26: zigchain.dex.QueryPoolsByDenomResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	29, // 27: zigchain.dex.QueryPoolsByCreatorRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	27, // 28: zigchain.dex.QueryPoolsByCreatorResponse.pool:type_name -> zigchain.dex.Pool
+	30, // 29: zigchain.dex.QueryPoolsByCreatorResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	0,  // 30: zigchain.dex.Query.Params:input_type -> zigchain.dex.QueryParamsRequest
+	2,  // 31: zigchain.dex.Query.GetPool:input_type -> zigchain.dex.QueryGetPoolRequest
+	4,  // 32: zigchain.dex.Query.GetPoolBalances:input_type -> zigchain.dex.QueryGetPoolBalancesRequest
+	6,  // 33: zigchain.dex.Query.ListPool:input_type -> zigchain.dex.QueryAllPoolRequest
+	8,  // 34: zigchain.dex.Query.GetPoolsMeta:input_type -> zigchain.dex.QueryGetPoolsMetaRequest
+	10, // 35: zigchain.dex.Query.GetPoolUid:input_type -> zigchain.dex.QueryGetPoolUidRequest
+	12, // 36: zigchain.dex.Query.ListPoolUids:input_type -> zigchain.dex.QueryAllPoolUidsRequest
+	14, // 37: zigchain.dex.Query.SwapIn:input_type -> zigchain.dex.QuerySwapInRequest
+	16, // 38: zigchain.dex.Query.SwapOut:input_type -> zigchain.dex.QuerySwapOutRequest
+	18, // 39: zigchain.dex.Query.GetDynamicFee:input_type -> zigchain.dex.QueryGetDynamicFeeRequest
+	20, // 40: zigchain.dex.Query.GetBuybackInfo:input_type -> zigchain.dex.QueryGetBuybackInfoRequest
+	22, // 41: zigchain.dex.Query.ListPoolsByDenom:input_type -> zigchain.dex.QueryPoolsByDenomRequest
+	24, // 42: zigchain.dex.Query.ListPoolsByCreator:input_type -> zigchain.dex.QueryPoolsByCreatorRequest
+	1,  // 43: zigchain.dex.Query.Params:output_type -> zigchain.dex.QueryParamsResponse
+	3,  // 44: zigchain.dex.Query.GetPool:output_type -> zigchain.dex.QueryGetPoolResponse
+	5,  // 45: zigchain.dex.Query.GetPoolBalances:output_type -> zigchain.dex.QueryGetPoolBalancesResponse
+	7,  // 46: zigchain.dex.Query.ListPool:output_type -> zigchain.dex.QueryAllPoolResponse
+	9,  // 47: zigchain.dex.Query.GetPoolsMeta:output_type -> zigchain.dex.QueryGetPoolsMetaResponse
+	11, // 48: zigchain.dex.Query.GetPoolUid:output_type -> zigchain.dex.QueryGetPoolUidResponse
+	13, // 49: zigchain.dex.Query.ListPoolUids:output_type -> zigchain.dex.QueryAllPoolUidsResponse
+	15, // 50: zigchain.dex.Query.SwapIn:output_type -> zigchain.dex.QuerySwapInResponse
+	17, // 51: zigchain.dex.Query.SwapOut:output_type -> zigchain.dex.QuerySwapOutResponse
+	19, // 52: zigchain.dex.Query.GetDynamicFee:output_type -> zigchain.dex.QueryGetDynamicFeeResponse
+	21, // 53: zigchain.dex.Query.GetBuybackInfo:output_type -> zigchain.dex.QueryGetBuybackInfoResponse
+	23, // 54: zigchain.dex.Query.ListPoolsByDenom:output_type -> zigchain.dex.QueryPoolsByDenomResponse
+	25, // 55: zigchain.dex.Query.ListPoolsByCreator:output_type -> zigchain.dex.QueryPoolsByCreatorResponse
+	43, // [43:56] is the sub-list for method output_type
+	30, // [30:43] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_zigchain_dex_query_proto_init() }
@@ -9387,6 +14501,8 @@ func file_zigchain_dex_query_proto_init() {
 	if File_zigchain_dex_query_proto != nil {
 		return
 	}
+	file_zigchain_dex_buyback_proto_init()
+	file_zigchain_dex_dynamic_fee_proto_init()
 	file_zigchain_dex_params_proto_init()
 	file_zigchain_dex_pool_proto_init()
 	file_zigchain_dex_pools_meta_proto_init()
@@ -9608,6 +14724,102 @@ func file_zigchain_dex_query_proto_init() {
 				return nil
 			}
 		}
+		file_zigchain_dex_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetDynamicFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zigchain_dex_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetDynamicFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zigchain_dex_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetBuybackInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zigchain_dex_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetBuybackInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zigchain_dex_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPoolsByDenomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zigchain_dex_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPoolsByDenomResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zigchain_dex_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPoolsByCreatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zigchain_dex_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPoolsByCreatorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -9615,7 +14827,7 @@ func file_zigchain_dex_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_zigchain_dex_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,15 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName          = "/zigchain.dex.Query/Params"
-	Query_GetPool_FullMethodName         = "/zigchain.dex.Query/GetPool"
-	Query_GetPoolBalances_FullMethodName = "/zigchain.dex.Query/GetPoolBalances"
-	Query_ListPool_FullMethodName        = "/zigchain.dex.Query/ListPool"
-	Query_GetPoolsMeta_FullMethodName    = "/zigchain.dex.Query/GetPoolsMeta"
-	Query_GetPoolUid_FullMethodName      = "/zigchain.dex.Query/GetPoolUid"
-	Query_ListPoolUids_FullMethodName    = "/zigchain.dex.Query/ListPoolUids"
-	Query_SwapIn_FullMethodName          = "/zigchain.dex.Query/SwapIn"
-	Query_SwapOut_FullMethodName         = "/zigchain.dex.Query/SwapOut"
+	Query_Params_FullMethodName             = "/zigchain.dex.Query/Params"
+	Query_GetPool_FullMethodName            = "/zigchain.dex.Query/GetPool"
+	Query_GetPoolBalances_FullMethodName    = "/zigchain.dex.Query/GetPoolBalances"
+	Query_ListPool_FullMethodName           = "/zigchain.dex.Query/ListPool"
+	Query_GetPoolsMeta_FullMethodName       = "/zigchain.dex.Query/GetPoolsMeta"
+	Query_GetPoolUid_FullMethodName         = "/zigchain.dex.Query/GetPoolUid"
+	Query_ListPoolUids_FullMethodName       = "/zigchain.dex.Query/ListPoolUids"
+	Query_SwapIn_FullMethodName             = "/zigchain.dex.Query/SwapIn"
+	Query_SwapOut_FullMethodName            = "/zigchain.dex.Query/SwapOut"
+	Query_GetDynamicFee_FullMethodName      = "/zigchain.dex.Query/GetDynamicFee"
+	Query_GetBuybackInfo_FullMethodName     = "/zigchain.dex.Query/GetBuybackInfo"
+	Query_ListPoolsByDenom_FullMethodName   = "/zigchain.dex.Query/ListPoolsByDenom"
+	Query_ListPoolsByCreator_FullMethodName = "/zigchain.dex.Query/ListPoolsByCreator"
 )
 
 // QueryClient is the client API for Query service.
@@ -54,6 +58,14 @@ type QueryClient interface {
 	SwapIn(ctx context.Context, in *QuerySwapInRequest, opts ...grpc.CallOption) (*QuerySwapInResponse, error)
 	// Queries a list of SwapIn items.
 	SwapOut(ctx context.Context, in *QuerySwapOutRequest, opts ...grpc.CallOption) (*QuerySwapOutResponse, error)
+	// Queries the dynamic fee configuration and state of a pool.
+	GetDynamicFee(ctx context.Context, in *QueryGetDynamicFeeRequest, opts ...grpc.CallOption) (*QueryGetDynamicFeeResponse, error)
+	// Queries the buyback configuration and cumulative totals.
+	GetBuybackInfo(ctx context.Context, in *QueryGetBuybackInfoRequest, opts ...grpc.CallOption) (*QueryGetBuybackInfoResponse, error)
+	// Queries a list of Pool items trading a denom.
+	ListPoolsByDenom(ctx context.Context, in *QueryPoolsByDenomRequest, opts ...grpc.CallOption) (*QueryPoolsByDenomResponse, error)
+	// Queries a list of Pool items created by an address.
+	ListPoolsByCreator(ctx context.Context, in *QueryPoolsByCreatorRequest, opts ...grpc.CallOption) (*QueryPoolsByCreatorResponse, error)
 }
 
 type queryClient struct {
@@ -154,6 +166,46 @@ func (c *queryClient) SwapOut(ctx context.Context, in *QuerySwapOutRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) GetDynamicFee(ctx context.Context, in *QueryGetDynamicFeeRequest, opts ...grpc.CallOption) (*QueryGetDynamicFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGetDynamicFeeResponse)
+	err := c.cc.Invoke(ctx, Query_GetDynamicFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetBuybackInfo(ctx context.Context, in *QueryGetBuybackInfoRequest, opts ...grpc.CallOption) (*QueryGetBuybackInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGetBuybackInfoResponse)
+	err := c.cc.Invoke(ctx, Query_GetBuybackInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPoolsByDenom(ctx context.Context, in *QueryPoolsByDenomRequest, opts ...grpc.CallOption) (*QueryPoolsByDenomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPoolsByDenomResponse)
+	err := c.cc.Invoke(ctx, Query_ListPoolsByDenom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPoolsByCreator(ctx context.Context, in *QueryPoolsByCreatorRequest, opts ...grpc.CallOption) (*QueryPoolsByCreatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPoolsByCreatorResponse)
+	err := c.cc.Invoke(ctx, Query_ListPoolsByCreator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -178,6 +230,14 @@ type QueryServer interface {
 	SwapIn(context.Context, *QuerySwapInRequest) (*QuerySwapInResponse, error)
 	// Queries a list of SwapIn items.
 	SwapOut(context.Context, *QuerySwapOutRequest) (*QuerySwapOutResponse, error)
+	// Queries the dynamic fee configuration and state of a pool.
+	GetDynamicFee(context.Context, *QueryGetDynamicFeeRequest) (*QueryGetDynamicFeeResponse, error)
+	// Queries the buyback configuration and cumulative totals.
+	GetBuybackInfo(context.Context, *QueryGetBuybackInfoRequest) (*QueryGetBuybackInfoResponse, error)
+	// Queries a list of Pool items trading a denom.
+	ListPoolsByDenom(context.Context, *QueryPoolsByDenomRequest) (*QueryPoolsByDenomResponse, error)
+	// Queries a list of Pool items created by an address.
+	ListPoolsByCreator(context.Context, *QueryPoolsByCreatorRequest) (*QueryPoolsByCreatorResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -215,6 +275,18 @@ func (UnimplementedQueryServer) SwapIn(context.Context, *QuerySwapInRequest) (*Q
 func (UnimplementedQueryServer) SwapOut(context.Context, *QuerySwapOutRequest) (*QuerySwapOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapOut not implemented")
 }
+func (UnimplementedQueryServer) GetDynamicFee(context.Context, *QueryGetDynamicFeeRequest) (*QueryGetDynamicFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDynamicFee not implemented")
+}
+func (UnimplementedQueryServer) GetBuybackInfo(context.Context, *QueryGetBuybackInfoRequest) (*QueryGetBuybackInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuybackInfo not implemented")
+}
+func (UnimplementedQueryServer) ListPoolsByDenom(context.Context, *QueryPoolsByDenomRequest) (*QueryPoolsByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPoolsByDenom not implemented")
+}
+func (UnimplementedQueryServer) ListPoolsByCreator(context.Context, *QueryPoolsByCreatorRequest) (*QueryPoolsByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPoolsByCreator not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -398,6 +470,78 @@ func _Query_SwapOut_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetDynamicFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDynamicFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDynamicFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetDynamicFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDynamicFee(ctx, req.(*QueryGetDynamicFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetBuybackInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBuybackInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetBuybackInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetBuybackInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBuybackInfo(ctx, req.(*QueryGetBuybackInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPoolsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPoolsByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListPoolsByDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPoolsByDenom(ctx, req.(*QueryPoolsByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPoolsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPoolsByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListPoolsByCreator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPoolsByCreator(ctx, req.(*QueryPoolsByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -441,6 +585,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SwapOut",
 			Handler:    _Query_SwapOut_Handler,
 		},
+		{
+			MethodName: "GetDynamicFee",
+			Handler:    _Query_GetDynamicFee_Handler,
+		},
+		{
+			MethodName: "GetBuybackInfo",
+			Handler:    _Query_GetBuybackInfo_Handler,
+		},
+		{
+			MethodName: "ListPoolsByDenom",
+			Handler:    _Query_ListPoolsByDenom_Handler,
+		},
+		{
+			MethodName: "ListPoolsByCreator",
+			Handler:    _Query_ListPoolsByCreator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zigchain/dex/query.proto",
@@ -19,12 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_UpdateParams_FullMethodName    = "/zigchain.dex.Msg/UpdateParams"
-	Msg_CreatePool_FullMethodName      = "/zigchain.dex.Msg/CreatePool"
-	Msg_SwapExactIn_FullMethodName     = "/zigchain.dex.Msg/SwapExactIn"
-	Msg_SwapExactOut_FullMethodName    = "/zigchain.dex.Msg/SwapExactOut"
-	Msg_AddLiquidity_FullMethodName    = "/zigchain.dex.Msg/AddLiquidity"
-	Msg_RemoveLiquidity_FullMethodName = "/zigchain.dex.Msg/RemoveLiquidity"
+	Msg_UpdateParams_FullMethodName           = "/zigchain.dex.Msg/UpdateParams"
+	Msg_CreatePool_FullMethodName             = "/zigchain.dex.Msg/CreatePool"
+	Msg_SwapExactIn_FullMethodName            = "/zigchain.dex.Msg/SwapExactIn"
+	Msg_SwapExactOut_FullMethodName           = "/zigchain.dex.Msg/SwapExactOut"
+	Msg_AddLiquidity_FullMethodName           = "/zigchain.dex.Msg/AddLiquidity"
+	Msg_RemoveLiquidity_FullMethodName        = "/zigchain.dex.Msg/RemoveLiquidity"
+	Msg_UpdateDynamicFeeConfig_FullMethodName = "/zigchain.dex.Msg/UpdateDynamicFeeConfig"
+	Msg_UpdateBuybackConfig_FullMethodName    = "/zigchain.dex.Msg/UpdateBuybackConfig"
 )
 
 // MsgClient is the client API for Msg service.
@@ -46,6 +48,13 @@ type MsgClient interface {
 	AddLiquidity(ctx context.Context, in *MsgAddLiquidity, opts ...grpc.CallOption) (*MsgAddLiquidityResponse, error)
 	// RemoveLiquidity removes liquidity from the pool, from the lptoken send in
 	RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity, opts ...grpc.CallOption) (*MsgRemoveLiquidityResponse, error)
+	// UpdateDynamicFeeConfig defines a (governance) operation for setting the
+	// dynamic fee configuration of a pool
+	UpdateDynamicFeeConfig(ctx context.Context, in *MsgUpdateDynamicFeeConfig, opts ...grpc.CallOption) (*MsgUpdateDynamicFeeConfigResponse, error)
+	// UpdateBuybackConfig defines a (governance) operation for setting the
+	// buyback-and-burn configuration. The authority defaults to the x/gov module
+	// account.
+	UpdateBuybackConfig(ctx context.Context, in *MsgUpdateBuybackConfig, opts ...grpc.CallOption) (*MsgUpdateBuybackConfigResponse, error)
 }
 
 type msgClient struct {
@@ -116,6 +125,26 @@ func (c *msgClient) RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity,
 	return out, nil
 }
 
+func (c *msgClient) UpdateDynamicFeeConfig(ctx context.Context, in *MsgUpdateDynamicFeeConfig, opts ...grpc.CallOption) (*MsgUpdateDynamicFeeConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateDynamicFeeConfigResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateDynamicFeeConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBuybackConfig(ctx context.Context, in *MsgUpdateBuybackConfig, opts ...grpc.CallOption) (*MsgUpdateBuybackConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateBuybackConfigResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateBuybackConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -135,6 +164,13 @@ type MsgServer interface {
 	AddLiquidity(context.Context, *MsgAddLiquidity) (*MsgAddLiquidityResponse, error)
 	// RemoveLiquidity removes liquidity from the pool, from the lptoken send in
 	RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error)
+	// UpdateDynamicFeeConfig defines a (governance) operation for setting the
+	// dynamic fee configuration of a pool
+	UpdateDynamicFeeConfig(context.Context, *MsgUpdateDynamicFeeConfig) (*MsgUpdateDynamicFeeConfigResponse, error)
+	// UpdateBuybackConfig defines a (governance) operation for setting the
+	// buyback-and-burn configuration. The authority defaults to the x/gov module
+	// account.
+	UpdateBuybackConfig(context.Context, *MsgUpdateBuybackConfig) (*MsgUpdateBuybackConfigResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -163,6 +199,12 @@ func (UnimplementedMsgServer) AddLiquidity(context.Context, *MsgAddLiquidity) (*
 func (UnimplementedMsgServer) RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveLiquidity not implemented")
 }
+func (UnimplementedMsgServer) UpdateDynamicFeeConfig(context.Context, *MsgUpdateDynamicFeeConfig) (*MsgUpdateDynamicFeeConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDynamicFeeConfig not implemented")
+}
+func (UnimplementedMsgServer) UpdateBuybackConfig(context.Context, *MsgUpdateBuybackConfig) (*MsgUpdateBuybackConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBuybackConfig not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -292,6 +334,42 @@ func _Msg_RemoveLiquidity_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateDynamicFeeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDynamicFeeConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDynamicFeeConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateDynamicFeeConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDynamicFeeConfig(ctx, req.(*MsgUpdateDynamicFeeConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBuybackConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBuybackConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBuybackConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateBuybackConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBuybackConfig(ctx, req.(*MsgUpdateBuybackConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -323,6 +401,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveLiquidity",
 			Handler:    _Msg_RemoveLiquidity_Handler,
 		},
+		{
+			MethodName: "UpdateDynamicFeeConfig",
+			Handler:    _Msg_UpdateDynamicFeeConfig_Handler,
+		},
+		{
+			MethodName: "UpdateBuybackConfig",
+			Handler:    _Msg_UpdateBuybackConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zigchain/dex/tx.proto",
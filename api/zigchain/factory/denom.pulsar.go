@@ -22,6 +22,7 @@ var (
 	fd_Denom_minting_cap            protoreflect.FieldDescriptor
 	fd_Denom_minted                 protoreflect.FieldDescriptor
 	fd_Denom_can_change_minting_cap protoreflect.FieldDescriptor
+	fd_Denom_can_force_transfer     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -32,6 +33,7 @@ func init() {
 	fd_Denom_minting_cap = md_Denom.Fields().ByName("minting_cap")
 	fd_Denom_minted = md_Denom.Fields().ByName("minted")
 	fd_Denom_can_change_minting_cap = md_Denom.Fields().ByName("can_change_minting_cap")
+	fd_Denom_can_force_transfer = md_Denom.Fields().ByName("can_force_transfer")
 }
 
 var _ protoreflect.Message = (*fastReflection_Denom)(nil)
@@ -129,6 +131,12 @@ func (x *fastReflection_Denom) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
+	if x.CanForceTransfer != false {
+		value := protoreflect.ValueOfBool(x.CanForceTransfer)
+		if !f(fd_Denom_can_force_transfer, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -154,6 +162,8 @@ func (x *fastReflection_Denom) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Minted != ""
 	case "zigchain.factory.Denom.can_change_minting_cap":
 		return x.CanChangeMintingCap != false
+	case "zigchain.factory.Denom.can_force_transfer":
+		return x.CanForceTransfer != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.Denom"))
@@ -180,6 +190,8 @@ func (x *fastReflection_Denom) Clear(fd protoreflect.FieldDescriptor) {
 		x.Minted = ""
 	case "zigchain.factory.Denom.can_change_minting_cap":
 		x.CanChangeMintingCap = false
+	case "zigchain.factory.Denom.can_force_transfer":
+		x.CanForceTransfer = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.Denom"))
@@ -211,6 +223,9 @@ func (x *fastReflection_Denom) Get(descriptor protoreflect.FieldDescriptor) prot
 	case "zigchain.factory.Denom.can_change_minting_cap":
 		value := x.CanChangeMintingCap
 		return protoreflect.ValueOfBool(value)
+	case "zigchain.factory.Denom.can_force_transfer":
+		value := x.CanForceTransfer
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.Denom"))
@@ -241,6 +256,8 @@ func (x *fastReflection_Denom) Set(fd protoreflect.FieldDescriptor, value protor
 		x.Minted = value.Interface().(string)
 	case "zigchain.factory.Denom.can_change_minting_cap":
 		x.CanChangeMintingCap = value.Bool()
+	case "zigchain.factory.Denom.can_force_transfer":
+		x.CanForceTransfer = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.Denom"))
@@ -271,6 +288,8 @@ func (x *fastReflection_Denom) Mutable(fd protoreflect.FieldDescriptor) protoref
 		panic(fmt.Errorf("field minted of message zigchain.factory.Denom is not mutable"))
 	case "zigchain.factory.Denom.can_change_minting_cap":
 		panic(fmt.Errorf("field can_change_minting_cap of message zigchain.factory.Denom is not mutable"))
+	case "zigchain.factory.Denom.can_force_transfer":
+		panic(fmt.Errorf("field can_force_transfer of message zigchain.factory.Denom is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.Denom"))
@@ -294,6 +313,8 @@ func (x *fastReflection_Denom) NewField(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfString("")
 	case "zigchain.factory.Denom.can_change_minting_cap":
 		return protoreflect.ValueOfBool(false)
+	case "zigchain.factory.Denom.can_force_transfer":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.Denom"))
@@ -382,6 +403,9 @@ func (x *fastReflection_Denom) ProtoMethods() *protoiface.Methods {
 		if x.CanChangeMintingCap {
 			n += 2
 		}
+		if x.CanForceTransfer {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -411,6 +435,16 @@ func (x *fastReflection_Denom) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.CanForceTransfer {
+			i--
+			if x.CanForceTransfer {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
 		if x.CanChangeMintingCap {
 			i--
 			if x.CanChangeMintingCap {
@@ -646,6 +680,26 @@ func (x *fastReflection_Denom) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.CanChangeMintingCap = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CanForceTransfer", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.CanForceTransfer = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1357,6 +1411,7 @@ var (
 	fd_DenomResponse_can_change_minting_cap protoreflect.FieldDescriptor
 	fd_DenomResponse_total_burned           protoreflect.FieldDescriptor
 	fd_DenomResponse_total_supply           protoreflect.FieldDescriptor
+	fd_DenomResponse_can_force_transfer     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1370,6 +1425,7 @@ func init() {
 	fd_DenomResponse_can_change_minting_cap = md_DenomResponse.Fields().ByName("can_change_minting_cap")
 	fd_DenomResponse_total_burned = md_DenomResponse.Fields().ByName("total_burned")
 	fd_DenomResponse_total_supply = md_DenomResponse.Fields().ByName("total_supply")
+	fd_DenomResponse_can_force_transfer = md_DenomResponse.Fields().ByName("can_force_transfer")
 }
 
 var _ protoreflect.Message = (*fastReflection_DenomResponse)(nil)
@@ -1485,6 +1541,12 @@ func (x *fastReflection_DenomResponse) Range(f func(protoreflect.FieldDescriptor
 			return
 		}
 	}
+	if x.CanForceTransfer != false {
+		value := protoreflect.ValueOfBool(x.CanForceTransfer)
+		if !f(fd_DenomResponse_can_force_transfer, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1516,6 +1578,8 @@ func (x *fastReflection_DenomResponse) Has(fd protoreflect.FieldDescriptor) bool
 		return x.TotalBurned != ""
 	case "zigchain.factory.DenomResponse.total_supply":
 		return x.TotalSupply != ""
+	case "zigchain.factory.DenomResponse.can_force_transfer":
+		return x.CanForceTransfer != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.DenomResponse"))
@@ -1548,6 +1612,8 @@ func (x *fastReflection_DenomResponse) Clear(fd protoreflect.FieldDescriptor) {
 		x.TotalBurned = ""
 	case "zigchain.factory.DenomResponse.total_supply":
 		x.TotalSupply = ""
+	case "zigchain.factory.DenomResponse.can_force_transfer":
+		x.CanForceTransfer = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.DenomResponse"))
@@ -1588,6 +1654,9 @@ func (x *fastReflection_DenomResponse) Get(descriptor protoreflect.FieldDescript
 	case "zigchain.factory.DenomResponse.total_supply":
 		value := x.TotalSupply
 		return protoreflect.ValueOfString(value)
+	case "zigchain.factory.DenomResponse.can_force_transfer":
+		value := x.CanForceTransfer
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.DenomResponse"))
@@ -1624,6 +1693,8 @@ func (x *fastReflection_DenomResponse) Set(fd protoreflect.FieldDescriptor, valu
 		x.TotalBurned = value.Interface().(string)
 	case "zigchain.factory.DenomResponse.total_supply":
 		x.TotalSupply = value.Interface().(string)
+	case "zigchain.factory.DenomResponse.can_force_transfer":
+		x.CanForceTransfer = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.DenomResponse"))
@@ -1660,6 +1731,8 @@ func (x *fastReflection_DenomResponse) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field total_burned of message zigchain.factory.DenomResponse is not mutable"))
 	case "zigchain.factory.DenomResponse.total_supply":
 		panic(fmt.Errorf("field total_supply of message zigchain.factory.DenomResponse is not mutable"))
+	case "zigchain.factory.DenomResponse.can_force_transfer":
+		panic(fmt.Errorf("field can_force_transfer of message zigchain.factory.DenomResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.DenomResponse"))
@@ -1689,6 +1762,8 @@ func (x *fastReflection_DenomResponse) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfString("")
 	case "zigchain.factory.DenomResponse.total_supply":
 		return protoreflect.ValueOfString("")
+	case "zigchain.factory.DenomResponse.can_force_transfer":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.DenomResponse"))
@@ -1789,6 +1864,9 @@ func (x *fastReflection_DenomResponse) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.CanForceTransfer {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1818,6 +1896,16 @@ func (x *fastReflection_DenomResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.CanForceTransfer {
+			i--
+			if x.CanForceTransfer {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
+		}
 		if len(x.TotalSupply) > 0 {
 			i -= len(x.TotalSupply)
 			copy(dAtA[i:], x.TotalSupply)
@@ -2170,6 +2258,26 @@ func (x *fastReflection_DenomResponse) ProtoMethods() *protoiface.Methods {
 				}
 				x.TotalSupply = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CanForceTransfer", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.CanForceTransfer = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2231,6 +2339,9 @@ type Denom struct {
 	MintingCap          string `protobuf:"bytes,3,opt,name=minting_cap,json=mintingCap,proto3" json:"minting_cap,omitempty"`
 	Minted              string `protobuf:"bytes,4,opt,name=minted,proto3" json:"minted,omitempty"`
 	CanChangeMintingCap bool   `protobuf:"varint,5,opt,name=can_change_minting_cap,json=canChangeMintingCap,proto3" json:"can_change_minting_cap,omitempty"`
+	// can_force_transfer lets the bank admin move the denom from any holder, it
+	// is set at creation and can only be turned off
+	CanForceTransfer bool `protobuf:"varint,6,opt,name=can_force_transfer,json=canForceTransfer,proto3" json:"can_force_transfer,omitempty"`
 }
 
 func (x *Denom) Reset() {
@@ -2288,6 +2399,13 @@ func (x *Denom) GetCanChangeMintingCap() bool {
 	return false
 }
 
+func (x *Denom) GetCanForceTransfer() bool {
+	if x != nil {
+		return x.CanForceTransfer
+	}
+	return false
+}
+
 type LegacyDenom struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2368,6 +2486,7 @@ type DenomResponse struct {
 	CanChangeMintingCap bool   `protobuf:"varint,6,opt,name=can_change_minting_cap,json=canChangeMintingCap,proto3" json:"can_change_minting_cap,omitempty"`
 	TotalBurned         string `protobuf:"bytes,7,opt,name=total_burned,json=totalBurned,proto3" json:"total_burned,omitempty"`
 	TotalSupply         string `protobuf:"bytes,8,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	CanForceTransfer    bool   `protobuf:"varint,9,opt,name=can_force_transfer,json=canForceTransfer,proto3" json:"can_force_transfer,omitempty"`
 }
 
 func (x *DenomResponse) Reset() {
@@ -2446,6 +2565,13 @@ func (x *DenomResponse) GetTotalSupply() string {
 	return ""
 }
 
+func (x *DenomResponse) GetCanForceTransfer() bool {
+	if x != nil {
+		return x.CanForceTransfer
+	}
+	return false
+}
+
 var File_zigchain_factory_denom_proto protoreflect.FileDescriptor
 
 var file_zigchain_factory_denom_proto_rawDesc = []byte{
@@ -2456,7 +2582,7 @@ var file_zigchain_factory_denom_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8d, 0x02, 0x0a, 0x05, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x18,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbb, 0x02, 0x0a, 0x05, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x18,
 	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f,
 	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x53,
@@ -2473,69 +2599,75 @@ var file_zigchain_factory_denom_proto_rawDesc = []byte{
 	0x33, 0x0a, 0x16, 0x63, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x69,
 	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
 	0x13, 0x63, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e,
-	0x67, 0x43, 0x61, 0x70, 0x22, 0x8b, 0x02, 0x0a, 0x0b, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
-	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14,
-	0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
-	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x50, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
-	0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78,
-	0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x4a, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
-	0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x55, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x74,
-	0x65, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d,
-	0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
-	0x63, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70,
-	0x6c, 0x79, 0x22, 0xa1, 0x04, 0x0a, 0x0d, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14,
-	0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
-	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x53, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f,
-	0x63, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xc8, 0xde, 0x1f, 0x00, 0xda,
-	0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0b, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6d,
-	0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x70, 0x12, 0x51, 0x0a, 0x0a, 0x6d, 0x61, 0x78,
-	0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xc8,
-	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
-	0x0b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x55, 0x0a, 0x0c,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x32, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69,
-	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x55, 0x49, 0x6e,
-	0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x69, 0x6e,
-	0x74, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x63, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x13, 0x63, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x69,
-	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x70, 0x12, 0x55, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0xd2, 0xb4,
-	0x2d, 0x0b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12,
-	0x55, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63,
+	0x67, 0x43, 0x61, 0x70, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x61, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x63, 0x61, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x22, 0x8b, 0x02, 0x0a, 0x0b, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x50, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
 	0x2e, 0x55, 0x69, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x55, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x42, 0xa6, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x7a,
-	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x42,
-	0x0a, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x21, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0xa2, 0x02, 0x03, 0x5a, 0x46, 0x58, 0xaa, 0x02, 0x10, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0xca, 0x02, 0x10, 0x5a, 0x69, 0x67, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0xe2, 0x02, 0x1c, 0x5a,
-	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x5a, 0x69,
-	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x55, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x79, 0x12, 0x4a, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x55, 0x69, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x55,
+	0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64,
+	0x12, 0x2e, 0x0a, 0x12, 0x63, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x78,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x63, 0x61,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79,
+	0x22, 0xcf, 0x04, 0x0a, 0x0d, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x53, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6d, 0x69, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x70, 0x12, 0x51, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x55, 0x0a, 0x0c, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x32, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74,
+	0xd2, 0xb4, 0x2d, 0x0b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x74, 0x65,
+	0x64, 0x12, 0x33, 0x0a, 0x16, 0x63, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x13, 0x63, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x69, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x43, 0x61, 0x70, 0x12, 0x55, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x55, 0x0a,
+	0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x32, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55,
+	0x69, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x55, 0x49,
+	0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x61, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x63, 0x61, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x42, 0xa6, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x0a, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0xa2, 0x02, 0x03, 0x5a,
+	0x46, 0x58, 0xaa, 0x02, 0x10, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0xca, 0x02, 0x10, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0xe2, 0x02, 0x1c, 0x5a, 0x69, 0x67, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x3a, 0x3a, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -0,0 +1,1492 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package factory
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_DenomAdminProposal                protoreflect.MessageDescriptor
+	fd_DenomAdminProposal_denom          protoreflect.FieldDescriptor
+	fd_DenomAdminProposal_bank_admin     protoreflect.FieldDescriptor
+	fd_DenomAdminProposal_metadata_admin protoreflect.FieldDescriptor
+	fd_DenomAdminProposal_claimable_time protoreflect.FieldDescriptor
+	fd_DenomAdminProposal_expiry_time    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zigchain_factory_denom_admin_proposal_proto_init()
+	md_DenomAdminProposal = File_zigchain_factory_denom_admin_proposal_proto.Messages().ByName("DenomAdminProposal")
+	fd_DenomAdminProposal_denom = md_DenomAdminProposal.Fields().ByName("denom")
+	fd_DenomAdminProposal_bank_admin = md_DenomAdminProposal.Fields().ByName("bank_admin")
+	fd_DenomAdminProposal_metadata_admin = md_DenomAdminProposal.Fields().ByName("metadata_admin")
+	fd_DenomAdminProposal_claimable_time = md_DenomAdminProposal.Fields().ByName("claimable_time")
+	fd_DenomAdminProposal_expiry_time = md_DenomAdminProposal.Fields().ByName("expiry_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_DenomAdminProposal)(nil)
+
+type fastReflection_DenomAdminProposal DenomAdminProposal
+
+func (x *DenomAdminProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DenomAdminProposal)(x)
+}
+
+func (x *DenomAdminProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_factory_denom_admin_proposal_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DenomAdminProposal_messageType fastReflection_DenomAdminProposal_messageType
+var _ protoreflect.MessageType = fastReflection_DenomAdminProposal_messageType{}
+
+type fastReflection_DenomAdminProposal_messageType struct{}
+
+func (x fastReflection_DenomAdminProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DenomAdminProposal)(nil)
+}
+func (x fastReflection_DenomAdminProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_DenomAdminProposal)
+}
+func (x fastReflection_DenomAdminProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomAdminProposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DenomAdminProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomAdminProposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DenomAdminProposal) Type() protoreflect.MessageType {
+	return _fastReflection_DenomAdminProposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DenomAdminProposal) New() protoreflect.Message {
+	return new(fastReflection_DenomAdminProposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DenomAdminProposal) Interface() protoreflect.ProtoMessage {
+	return (*DenomAdminProposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DenomAdminProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_DenomAdminProposal_denom, value) {
+			return
+		}
+	}
+	if x.BankAdmin != "" {
+		value := protoreflect.ValueOfString(x.BankAdmin)
+		if !f(fd_DenomAdminProposal_bank_admin, value) {
+			return
+		}
+	}
+	if x.MetadataAdmin != "" {
+		value := protoreflect.ValueOfString(x.MetadataAdmin)
+		if !f(fd_DenomAdminProposal_metadata_admin, value) {
+			return
+		}
+	}
+	if x.ClaimableTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ClaimableTime)
+		if !f(fd_DenomAdminProposal_claimable_time, value) {
+			return
+		}
+	}
+	if x.ExpiryTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiryTime)
+		if !f(fd_DenomAdminProposal_expiry_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DenomAdminProposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zigchain.factory.DenomAdminProposal.denom":
+		return x.Denom != ""
+	case "zigchain.factory.DenomAdminProposal.bank_admin":
+		return x.BankAdmin != ""
+	case "zigchain.factory.DenomAdminProposal.metadata_admin":
+		return x.MetadataAdmin != ""
+	case "zigchain.factory.DenomAdminProposal.claimable_time":
+		return x.ClaimableTime != int64(0)
+	case "zigchain.factory.DenomAdminProposal.expiry_time":
+		return x.ExpiryTime != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.DenomAdminProposal"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.DenomAdminProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomAdminProposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zigchain.factory.DenomAdminProposal.denom":
+		x.Denom = ""
+	case "zigchain.factory.DenomAdminProposal.bank_admin":
+		x.BankAdmin = ""
+	case "zigchain.factory.DenomAdminProposal.metadata_admin":
+		x.MetadataAdmin = ""
+	case "zigchain.factory.DenomAdminProposal.claimable_time":
+		x.ClaimableTime = int64(0)
+	case "zigchain.factory.DenomAdminProposal.expiry_time":
+		x.ExpiryTime = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.DenomAdminProposal"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.DenomAdminProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DenomAdminProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zigchain.factory.DenomAdminProposal.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "zigchain.factory.DenomAdminProposal.bank_admin":
+		value := x.BankAdmin
+		return protoreflect.ValueOfString(value)
+	case "zigchain.factory.DenomAdminProposal.metadata_admin":
+		value := x.MetadataAdmin
+		return protoreflect.ValueOfString(value)
+	case "zigchain.factory.DenomAdminProposal.claimable_time":
+		value := x.ClaimableTime
+		return protoreflect.ValueOfInt64(value)
+	case "zigchain.factory.DenomAdminProposal.expiry_time":
+		value := x.ExpiryTime
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.DenomAdminProposal"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.DenomAdminProposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomAdminProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zigchain.factory.DenomAdminProposal.denom":
+		x.Denom = value.Interface().(string)
+	case "zigchain.factory.DenomAdminProposal.bank_admin":
+		x.BankAdmin = value.Interface().(string)
+	case "zigchain.factory.DenomAdminProposal.metadata_admin":
+		x.MetadataAdmin = value.Interface().(string)
+	case "zigchain.factory.DenomAdminProposal.claimable_time":
+		x.ClaimableTime = value.Int()
+	case "zigchain.factory.DenomAdminProposal.expiry_time":
+		x.ExpiryTime = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.DenomAdminProposal"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.DenomAdminProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomAdminProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.factory.DenomAdminProposal.denom":
+		panic(fmt.Errorf("field denom of message zigchain.factory.DenomAdminProposal is not mutable"))
+	case "zigchain.factory.DenomAdminProposal.bank_admin":
+		panic(fmt.Errorf("field bank_admin of message zigchain.factory.DenomAdminProposal is not mutable"))
+	case "zigchain.factory.DenomAdminProposal.metadata_admin":
+		panic(fmt.Errorf("field metadata_admin of message zigchain.factory.DenomAdminProposal is not mutable"))
+	case "zigchain.factory.DenomAdminProposal.claimable_time":
+		panic(fmt.Errorf("field claimable_time of message zigchain.factory.DenomAdminProposal is not mutable"))
+	case "zigchain.factory.DenomAdminProposal.expiry_time":
+		panic(fmt.Errorf("field expiry_time of message zigchain.factory.DenomAdminProposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.DenomAdminProposal"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.DenomAdminProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DenomAdminProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.factory.DenomAdminProposal.denom":
+		return protoreflect.ValueOfString("")
+	case "zigchain.factory.DenomAdminProposal.bank_admin":
+		return protoreflect.ValueOfString("")
+	case "zigchain.factory.DenomAdminProposal.metadata_admin":
+		return protoreflect.ValueOfString("")
+	case "zigchain.factory.DenomAdminProposal.claimable_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "zigchain.factory.DenomAdminProposal.expiry_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.DenomAdminProposal"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.DenomAdminProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DenomAdminProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.factory.DenomAdminProposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DenomAdminProposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomAdminProposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DenomAdminProposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DenomAdminProposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DenomAdminProposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BankAdmin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MetadataAdmin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ClaimableTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ClaimableTime))
+		}
+		if x.ExpiryTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryTime))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DenomAdminProposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExpiryTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryTime))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ClaimableTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ClaimableTime))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.MetadataAdmin) > 0 {
+			i -= len(x.MetadataAdmin)
+			copy(dAtA[i:], x.MetadataAdmin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MetadataAdmin)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BankAdmin) > 0 {
+			i -= len(x.BankAdmin)
+			copy(dAtA[i:], x.BankAdmin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankAdmin)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DenomAdminProposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomAdminProposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomAdminProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankAdmin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BankAdmin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MetadataAdmin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MetadataAdmin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClaimableTime", wireType)
+				}
+				x.ClaimableTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ClaimableTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+				}
+				x.ExpiryTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DenomAdminTimelock               protoreflect.MessageDescriptor
+	fd_DenomAdminTimelock_denom         protoreflect.FieldDescriptor
+	fd_DenomAdminTimelock_delay         protoreflect.FieldDescriptor
+	fd_DenomAdminTimelock_pending_delay protoreflect.FieldDescriptor
+	fd_DenomAdminTimelock_pending_time  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zigchain_factory_denom_admin_proposal_proto_init()
+	md_DenomAdminTimelock = File_zigchain_factory_denom_admin_proposal_proto.Messages().ByName("DenomAdminTimelock")
+	fd_DenomAdminTimelock_denom = md_DenomAdminTimelock.Fields().ByName("denom")
+	fd_DenomAdminTimelock_delay = md_DenomAdminTimelock.Fields().ByName("delay")
+	fd_DenomAdminTimelock_pending_delay = md_DenomAdminTimelock.Fields().ByName("pending_delay")
+	fd_DenomAdminTimelock_pending_time = md_DenomAdminTimelock.Fields().ByName("pending_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_DenomAdminTimelock)(nil)
+
+type fastReflection_DenomAdminTimelock DenomAdminTimelock
+
+func (x *DenomAdminTimelock) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DenomAdminTimelock)(x)
+}
+
+func (x *DenomAdminTimelock) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_factory_denom_admin_proposal_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DenomAdminTimelock_messageType fastReflection_DenomAdminTimelock_messageType
+var _ protoreflect.MessageType = fastReflection_DenomAdminTimelock_messageType{}
+
+type fastReflection_DenomAdminTimelock_messageType struct{}
+
+func (x fastReflection_DenomAdminTimelock_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DenomAdminTimelock)(nil)
+}
+func (x fastReflection_DenomAdminTimelock_messageType) New() protoreflect.Message {
+	return new(fastReflection_DenomAdminTimelock)
+}
+func (x fastReflection_DenomAdminTimelock_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomAdminTimelock
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DenomAdminTimelock) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomAdminTimelock
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DenomAdminTimelock) Type() protoreflect.MessageType {
+	return _fastReflection_DenomAdminTimelock_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DenomAdminTimelock) New() protoreflect.Message {
+	return new(fastReflection_DenomAdminTimelock)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DenomAdminTimelock) Interface() protoreflect.ProtoMessage {
+	return (*DenomAdminTimelock)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DenomAdminTimelock) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_DenomAdminTimelock_denom, value) {
+			return
+		}
+	}
+	if x.Delay != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Delay)
+		if !f(fd_DenomAdminTimelock_delay, value) {
+			return
+		}
+	}
+	if x.PendingDelay != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PendingDelay)
+		if !f(fd_DenomAdminTimelock_pending_delay, value) {
+			return
+		}
+	}
+	if x.PendingTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PendingTime)
+		if !f(fd_DenomAdminTimelock_pending_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DenomAdminTimelock) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zigchain.factory.DenomAdminTimelock.denom":
+		return x.Denom != ""
+	case "zigchain.factory.DenomAdminTimelock.delay":
+		return x.Delay != uint64(0)
+	case "zigchain.factory.DenomAdminTimelock.pending_delay":
+		return x.PendingDelay != uint64(0)
+	case "zigchain.factory.DenomAdminTimelock.pending_time":
+		return x.PendingTime != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.DenomAdminTimelock"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.DenomAdminTimelock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomAdminTimelock) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zigchain.factory.DenomAdminTimelock.denom":
+		x.Denom = ""
+	case "zigchain.factory.DenomAdminTimelock.delay":
+		x.Delay = uint64(0)
+	case "zigchain.factory.DenomAdminTimelock.pending_delay":
+		x.PendingDelay = uint64(0)
+	case "zigchain.factory.DenomAdminTimelock.pending_time":
+		x.PendingTime = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.DenomAdminTimelock"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.DenomAdminTimelock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DenomAdminTimelock) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zigchain.factory.DenomAdminTimelock.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "zigchain.factory.DenomAdminTimelock.delay":
+		value := x.Delay
+		return protoreflect.ValueOfUint64(value)
+	case "zigchain.factory.DenomAdminTimelock.pending_delay":
+		value := x.PendingDelay
+		return protoreflect.ValueOfUint64(value)
+	case "zigchain.factory.DenomAdminTimelock.pending_time":
+		value := x.PendingTime
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.DenomAdminTimelock"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.DenomAdminTimelock does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomAdminTimelock) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zigchain.factory.DenomAdminTimelock.denom":
+		x.Denom = value.Interface().(string)
+	case "zigchain.factory.DenomAdminTimelock.delay":
+		x.Delay = value.Uint()
+	case "zigchain.factory.DenomAdminTimelock.pending_delay":
+		x.PendingDelay = value.Uint()
+	case "zigchain.factory.DenomAdminTimelock.pending_time":
+		x.PendingTime = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.DenomAdminTimelock"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.DenomAdminTimelock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomAdminTimelock) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.factory.DenomAdminTimelock.denom":
+		panic(fmt.Errorf("field denom of message zigchain.factory.DenomAdminTimelock is not mutable"))
+	case "zigchain.factory.DenomAdminTimelock.delay":
+		panic(fmt.Errorf("field delay of message zigchain.factory.DenomAdminTimelock is not mutable"))
+	case "zigchain.factory.DenomAdminTimelock.pending_delay":
+		panic(fmt.Errorf("field pending_delay of message zigchain.factory.DenomAdminTimelock is not mutable"))
+	case "zigchain.factory.DenomAdminTimelock.pending_time":
+		panic(fmt.Errorf("field pending_time of message zigchain.factory.DenomAdminTimelock is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.DenomAdminTimelock"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.DenomAdminTimelock does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DenomAdminTimelock) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.factory.DenomAdminTimelock.denom":
+		return protoreflect.ValueOfString("")
+	case "zigchain.factory.DenomAdminTimelock.delay":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zigchain.factory.DenomAdminTimelock.pending_delay":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zigchain.factory.DenomAdminTimelock.pending_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.DenomAdminTimelock"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.DenomAdminTimelock does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DenomAdminTimelock) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.factory.DenomAdminTimelock", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DenomAdminTimelock) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomAdminTimelock) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DenomAdminTimelock) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DenomAdminTimelock) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DenomAdminTimelock)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Delay != 0 {
+			n += 1 + runtime.Sov(uint64(x.Delay))
+		}
+		if x.PendingDelay != 0 {
+			n += 1 + runtime.Sov(uint64(x.PendingDelay))
+		}
+		if x.PendingTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.PendingTime))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DenomAdminTimelock)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PendingTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PendingTime))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.PendingDelay != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PendingDelay))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Delay != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Delay))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DenomAdminTimelock)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomAdminTimelock: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomAdminTimelock: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+				}
+				x.Delay = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Delay |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingDelay", wireType)
+				}
+				x.PendingDelay = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PendingDelay |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingTime", wireType)
+				}
+				x.PendingTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PendingTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: zigchain/factory/denom_admin_proposal.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DenomAdminProposal is a pending transfer of the admins of a denom, its first
+// fields match DenomAuth so the proposals stored before the timelock decode
+// as claimable proposals without expiry
+type DenomAdminProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	BankAdmin     string `protobuf:"bytes,2,opt,name=bank_admin,json=bankAdmin,proto3" json:"bank_admin,omitempty"`
+	MetadataAdmin string `protobuf:"bytes,3,opt,name=metadata_admin,json=metadataAdmin,proto3" json:"metadata_admin,omitempty"`
+	// claimable_time is the unix time (in seconds) from which the proposed bank
+	// admin can claim the proposal
+	ClaimableTime int64 `protobuf:"varint,4,opt,name=claimable_time,json=claimableTime,proto3" json:"claimable_time,omitempty"`
+	// expiry_time is the unix time (in seconds) from which the proposal can no
+	// longer be claimed, 0 if it does not expire
+	ExpiryTime int64 `protobuf:"varint,5,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+}
+
+func (x *DenomAdminProposal) Reset() {
+	*x = DenomAdminProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_factory_denom_admin_proposal_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenomAdminProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenomAdminProposal) ProtoMessage() {}
+
+// Deprecated: Use DenomAdminProposal.ProtoReflect.Descriptor instead.
+func (*DenomAdminProposal) Descriptor() ([]byte, []int) {
+	return file_zigchain_factory_denom_admin_proposal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DenomAdminProposal) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *DenomAdminProposal) GetBankAdmin() string {
+	if x != nil {
+		return x.BankAdmin
+	}
+	return ""
+}
+
+func (x *DenomAdminProposal) GetMetadataAdmin() string {
+	if x != nil {
+		return x.MetadataAdmin
+	}
+	return ""
+}
+
+func (x *DenomAdminProposal) GetClaimableTime() int64 {
+	if x != nil {
+		return x.ClaimableTime
+	}
+	return 0
+}
+
+func (x *DenomAdminProposal) GetExpiryTime() int64 {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return 0
+}
+
+// DenomAdminTimelock is the delay between the proposal of new admins of a
+// denom and their claim, a shorter delay set by the bank admin is kept as
+// pending until pending_time
+type DenomAdminTimelock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// delay is the number of seconds a proposal waits before it can be claimed
+	Delay uint64 `protobuf:"varint,2,opt,name=delay,proto3" json:"delay,omitempty"`
+	// pending_delay replaces delay once the block time reaches pending_time
+	PendingDelay uint64 `protobuf:"varint,3,opt,name=pending_delay,json=pendingDelay,proto3" json:"pending_delay,omitempty"`
+	// pending_time is the unix time (in seconds) at which pending_delay takes
+	// effect, 0 without pending delay
+	PendingTime int64 `protobuf:"varint,4,opt,name=pending_time,json=pendingTime,proto3" json:"pending_time,omitempty"`
+}
+
+func (x *DenomAdminTimelock) Reset() {
+	*x = DenomAdminTimelock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_factory_denom_admin_proposal_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenomAdminTimelock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenomAdminTimelock) ProtoMessage() {}
+
+// Deprecated: Use DenomAdminTimelock.ProtoReflect.Descriptor instead.
+func (*DenomAdminTimelock) Descriptor() ([]byte, []int) {
+	return file_zigchain_factory_denom_admin_proposal_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DenomAdminTimelock) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *DenomAdminTimelock) GetDelay() uint64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+func (x *DenomAdminTimelock) GetPendingDelay() uint64 {
+	if x != nil {
+		return x.PendingDelay
+	}
+	return 0
+}
+
+func (x *DenomAdminTimelock) GetPendingTime() int64 {
+	if x != nil {
+		return x.PendingTime
+	}
+	return 0
+}
+
+var File_zigchain_factory_denom_admin_proposal_proto protoreflect.FileDescriptor
+
+var file_zigchain_factory_denom_admin_proposal_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x7a,
+	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x22,
+	0xb8, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x12, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x23, 0x0a,
+	0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x54, 0x69, 0x6d, 0x65, 0x42, 0xb3, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x69,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x17,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0xa2, 0x02, 0x03, 0x5a,
+	0x46, 0x58, 0xaa, 0x02, 0x10, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0xca, 0x02, 0x10, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0xe2, 0x02, 0x1c, 0x5a, 0x69, 0x67, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x3a, 0x3a, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_zigchain_factory_denom_admin_proposal_proto_rawDescOnce sync.Once
+	file_zigchain_factory_denom_admin_proposal_proto_rawDescData = file_zigchain_factory_denom_admin_proposal_proto_rawDesc
+)
+
+func file_zigchain_factory_denom_admin_proposal_proto_rawDescGZIP() []byte {
+	file_zigchain_factory_denom_admin_proposal_proto_rawDescOnce.Do(func() {
+		file_zigchain_factory_denom_admin_proposal_proto_rawDescData = protoimpl.X.CompressGZIP(file_zigchain_factory_denom_admin_proposal_proto_rawDescData)
+	})
+	return file_zigchain_factory_denom_admin_proposal_proto_rawDescData
+}
+
+var file_zigchain_factory_denom_admin_proposal_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_zigchain_factory_denom_admin_proposal_proto_goTypes = []interface{}{
+	(*DenomAdminProposal)(nil), // 0: zigchain.factory.DenomAdminProposal
+	(*DenomAdminTimelock)(nil), // 1: zigchain.factory.DenomAdminTimelock
+}
+var file_zigchain_factory_denom_admin_proposal_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_zigchain_factory_denom_admin_proposal_proto_init() }
+func file_zigchain_factory_denom_admin_proposal_proto_init() {
+	if File_zigchain_factory_denom_admin_proposal_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_zigchain_factory_denom_admin_proposal_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenomAdminProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zigchain_factory_denom_admin_proposal_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenomAdminTimelock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_zigchain_factory_denom_admin_proposal_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_zigchain_factory_denom_admin_proposal_proto_goTypes,
+		DependencyIndexes: file_zigchain_factory_denom_admin_proposal_proto_depIdxs,
+		MessageInfos:      file_zigchain_factory_denom_admin_proposal_proto_msgTypes,
+	}.Build()
+	File_zigchain_factory_denom_admin_proposal_proto = out.File
+	file_zigchain_factory_denom_admin_proposal_proto_rawDesc = nil
+	file_zigchain_factory_denom_admin_proposal_proto_goTypes = nil
+	file_zigchain_factory_denom_admin_proposal_proto_depIdxs = nil
+}
@@ -116,479 +116,3589 @@ func (x *_GenesisState_3_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_GenesisState                 protoreflect.MessageDescriptor
-	fd_GenesisState_params          protoreflect.FieldDescriptor
-	fd_GenesisState_denom_list      protoreflect.FieldDescriptor
-	fd_GenesisState_denom_auth_list protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
 
-func init() {
-	file_zigchain_factory_genesis_proto_init()
-	md_GenesisState = File_zigchain_factory_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
-	fd_GenesisState_denom_list = md_GenesisState.Fields().ByName("denom_list")
-	fd_GenesisState_denom_auth_list = md_GenesisState.Fields().ByName("denom_auth_list")
+type _GenesisState_4_list struct {
+	list *[]*BeforeSendHook
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BeforeSendHook)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_zigchain_factory_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BeforeSendHook)
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(BeforeSendHook)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(BeforeSendHook)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*FrozenAccount
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FrozenAccount)
+	(*x.list)[i] = concreteValue
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FrozenAccount)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_GenesisState_params, value) {
-			return
-		}
-	}
-	if len(x.DenomList) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.DenomList})
-		if !f(fd_GenesisState_denom_list, value) {
-			return
-		}
-	}
-	if len(x.DenomAuthList) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.DenomAuthList})
-		if !f(fd_GenesisState_denom_auth_list, value) {
-			return
-		}
-	}
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(FrozenAccount)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "zigchain.factory.GenesisState.params":
-		return x.Params != nil
-	case "zigchain.factory.GenesisState.denom_list":
-		return len(x.DenomList) != 0
-	case "zigchain.factory.GenesisState.denom_auth_list":
-		return len(x.DenomAuthList) != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.GenesisState"))
-		}
-		panic(fmt.Errorf("message zigchain.factory.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "zigchain.factory.GenesisState.params":
-		x.Params = nil
-	case "zigchain.factory.GenesisState.denom_list":
-		x.DenomList = nil
-	case "zigchain.factory.GenesisState.denom_auth_list":
-		x.DenomAuthList = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.GenesisState"))
-		}
-		panic(fmt.Errorf("message zigchain.factory.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(FrozenAccount)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "zigchain.factory.GenesisState.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "zigchain.factory.GenesisState.denom_list":
-		if len(x.DenomList) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_2_list{})
-		}
-		listValue := &_GenesisState_2_list{list: &x.DenomList}
-		return protoreflect.ValueOfList(listValue)
-	case "zigchain.factory.GenesisState.denom_auth_list":
-		if len(x.DenomAuthList) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_3_list{})
-		}
-		listValue := &_GenesisState_3_list{list: &x.DenomAuthList}
-		return protoreflect.ValueOfList(listValue)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.GenesisState"))
-		}
-		panic(fmt.Errorf("message zigchain.factory.GenesisState does not contain field %s", descriptor.FullName()))
-	}
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "zigchain.factory.GenesisState.params":
-		x.Params = value.Message().Interface().(*Params)
-	case "zigchain.factory.GenesisState.denom_list":
-		lv := value.List()
-		clv := lv.(*_GenesisState_2_list)
-		x.DenomList = *clv.list
-	case "zigchain.factory.GenesisState.denom_auth_list":
-		lv := value.List()
-		clv := lv.(*_GenesisState_3_list)
-		x.DenomAuthList = *clv.list
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.GenesisState"))
-		}
-		panic(fmt.Errorf("message zigchain.factory.GenesisState does not contain field %s", fd.FullName()))
-	}
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]string
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "zigchain.factory.GenesisState.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "zigchain.factory.GenesisState.denom_list":
-		if x.DenomList == nil {
-			x.DenomList = []*Denom{}
-		}
-		value := &_GenesisState_2_list{list: &x.DenomList}
-		return protoreflect.ValueOfList(value)
-	case "zigchain.factory.GenesisState.denom_auth_list":
-		if x.DenomAuthList == nil {
-			x.DenomAuthList = []*DenomAuth{}
-		}
-		value := &_GenesisState_3_list{list: &x.DenomAuthList}
-		return protoreflect.ValueOfList(value)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.GenesisState"))
-		}
-		panic(fmt.Errorf("message zigchain.factory.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "zigchain.factory.GenesisState.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "zigchain.factory.GenesisState.denom_list":
-		list := []*Denom{}
-		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
-	case "zigchain.factory.GenesisState.denom_auth_list":
-		list := []*DenomAuth{}
-		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.GenesisState"))
-		}
-		panic(fmt.Errorf("message zigchain.factory.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field GlobalFrozenDenoms as it is not of Message kind"))
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*Minter
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in zigchain.factory.GenesisState", d.FullName()))
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Minter)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Minter)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(Minter)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	panic("unreachable")
+	*x.list = (*x.list)[:n]
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(Minter)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*MintRateLimit
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.DenomList) > 0 {
-			for _, e := range x.DenomList {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.DenomAuthList) > 0 {
-			for _, e := range x.DenomAuthList {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.DenomAuthList) > 0 {
-			for iNdEx := len(x.DenomAuthList) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.DenomAuthList[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintRateLimit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintRateLimit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(MintRateLimit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(MintRateLimit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*MintRateBucket
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintRateBucket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintRateBucket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(MintRateBucket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(MintRateBucket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*VestingGrant
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingGrant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingGrant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(VestingGrant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(VestingGrant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*Airdrop
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Airdrop)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Airdrop)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(Airdrop)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(Airdrop)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*AirdropClaim
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AirdropClaim)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AirdropClaim)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(AirdropClaim)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(AirdropClaim)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*Snapshot
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Snapshot)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Snapshot)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(Snapshot)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(Snapshot)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*SnapshotBalance
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SnapshotBalance)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SnapshotBalance)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(SnapshotBalance)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(SnapshotBalance)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_17_list)(nil)
+
+type _GenesisState_17_list struct {
+	list *[]*RewardPool
+}
+
+func (x *_GenesisState_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RewardPool)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RewardPool)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_17_list) AppendMutable() protoreflect.Value {
+	v := new(RewardPool)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_17_list) NewElement() protoreflect.Value {
+	v := new(RewardPool)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_18_list)(nil)
+
+type _GenesisState_18_list struct {
+	list *[]*HolderReward
+}
+
+func (x *_GenesisState_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*HolderReward)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*HolderReward)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_18_list) AppendMutable() protoreflect.Value {
+	v := new(HolderReward)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_18_list) NewElement() protoreflect.Value {
+	v := new(HolderReward)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_19_list)(nil)
+
+type _GenesisState_19_list struct {
+	list *[]*ModuleAccountHolder
+}
+
+func (x *_GenesisState_19_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleAccountHolder)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleAccountHolder)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_19_list) AppendMutable() protoreflect.Value {
+	v := new(ModuleAccountHolder)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_19_list) NewElement() protoreflect.Value {
+	v := new(ModuleAccountHolder)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_20_list)(nil)
+
+type _GenesisState_20_list struct {
+	list *[]*CW20Bridge
+}
+
+func (x *_GenesisState_20_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_20_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_20_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CW20Bridge)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_20_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CW20Bridge)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_20_list) AppendMutable() protoreflect.Value {
+	v := new(CW20Bridge)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_20_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_20_list) NewElement() protoreflect.Value {
+	v := new(CW20Bridge)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_20_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_21_list)(nil)
+
+type _GenesisState_21_list struct {
+	list *[]*DenomRole
+}
+
+func (x *_GenesisState_21_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_21_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomRole)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_21_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomRole)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_21_list) AppendMutable() protoreflect.Value {
+	v := new(DenomRole)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_21_list) NewElement() protoreflect.Value {
+	v := new(DenomRole)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_22_list)(nil)
+
+type _GenesisState_22_list struct {
+	list *[]*DenomAdminProposal
+}
+
+func (x *_GenesisState_22_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_22_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomAdminProposal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_22_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomAdminProposal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_22_list) AppendMutable() protoreflect.Value {
+	v := new(DenomAdminProposal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_22_list) NewElement() protoreflect.Value {
+	v := new(DenomAdminProposal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_23_list)(nil)
+
+type _GenesisState_23_list struct {
+	list *[]*DenomAdminTimelock
+}
+
+func (x *_GenesisState_23_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_23_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_23_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomAdminTimelock)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_23_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomAdminTimelock)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_23_list) AppendMutable() protoreflect.Value {
+	v := new(DenomAdminTimelock)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_23_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_23_list) NewElement() protoreflect.Value {
+	v := new(DenomAdminTimelock)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_23_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_24_list)(nil)
+
+type _GenesisState_24_list struct {
+	list *[]*VerifiedDenom
+}
+
+func (x *_GenesisState_24_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_24_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_24_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerifiedDenom)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_24_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerifiedDenom)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_24_list) AppendMutable() protoreflect.Value {
+	v := new(VerifiedDenom)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_24_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_24_list) NewElement() protoreflect.Value {
+	v := new(VerifiedDenom)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_24_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_25_list)(nil)
+
+type _GenesisState_25_list struct {
+	list *[]*TransferTax
+}
+
+func (x *_GenesisState_25_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_25_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TransferTax)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_25_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TransferTax)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_25_list) AppendMutable() protoreflect.Value {
+	v := new(TransferTax)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_25_list) NewElement() protoreflect.Value {
+	v := new(TransferTax)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_26_list)(nil)
+
+type _GenesisState_26_list struct {
+	list *[]*TransferTaxExemption
+}
+
+func (x *_GenesisState_26_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_26_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TransferTaxExemption)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_26_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TransferTaxExemption)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_26_list) AppendMutable() protoreflect.Value {
+	v := new(TransferTaxExemption)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_26_list) NewElement() protoreflect.Value {
+	v := new(TransferTaxExemption)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_27_list)(nil)
+
+type _GenesisState_27_list struct {
+	list *[]*TokenLaunch
+}
+
+func (x *_GenesisState_27_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_27_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_27_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TokenLaunch)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_27_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TokenLaunch)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_27_list) AppendMutable() protoreflect.Value {
+	v := new(TokenLaunch)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_27_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_27_list) NewElement() protoreflect.Value {
+	v := new(TokenLaunch)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_27_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                            protoreflect.MessageDescriptor
+	fd_GenesisState_params                     protoreflect.FieldDescriptor
+	fd_GenesisState_denom_list                 protoreflect.FieldDescriptor
+	fd_GenesisState_denom_auth_list            protoreflect.FieldDescriptor
+	fd_GenesisState_before_send_hook_list      protoreflect.FieldDescriptor
+	fd_GenesisState_frozen_account_list        protoreflect.FieldDescriptor
+	fd_GenesisState_global_frozen_denoms       protoreflect.FieldDescriptor
+	fd_GenesisState_minter_list                protoreflect.FieldDescriptor
+	fd_GenesisState_mint_rate_limit_list       protoreflect.FieldDescriptor
+	fd_GenesisState_mint_rate_bucket_list      protoreflect.FieldDescriptor
+	fd_GenesisState_vesting_grant_list         protoreflect.FieldDescriptor
+	fd_GenesisState_vesting_grant_count        protoreflect.FieldDescriptor
+	fd_GenesisState_airdrop_list               protoreflect.FieldDescriptor
+	fd_GenesisState_airdrop_claim_list         protoreflect.FieldDescriptor
+	fd_GenesisState_airdrop_count              protoreflect.FieldDescriptor
+	fd_GenesisState_snapshot_list              protoreflect.FieldDescriptor
+	fd_GenesisState_snapshot_balance_list      protoreflect.FieldDescriptor
+	fd_GenesisState_reward_pool_list           protoreflect.FieldDescriptor
+	fd_GenesisState_holder_reward_list         protoreflect.FieldDescriptor
+	fd_GenesisState_module_account_holder_list protoreflect.FieldDescriptor
+	fd_GenesisState_cw20_bridge_list           protoreflect.FieldDescriptor
+	fd_GenesisState_denom_role_list            protoreflect.FieldDescriptor
+	fd_GenesisState_denom_admin_proposal_list  protoreflect.FieldDescriptor
+	fd_GenesisState_denom_admin_timelock_list  protoreflect.FieldDescriptor
+	fd_GenesisState_verified_denom_list        protoreflect.FieldDescriptor
+	fd_GenesisState_transfer_tax_list          protoreflect.FieldDescriptor
+	fd_GenesisState_tax_exemption_list         protoreflect.FieldDescriptor
+	fd_GenesisState_token_launch_list          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zigchain_factory_genesis_proto_init()
+	md_GenesisState = File_zigchain_factory_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_denom_list = md_GenesisState.Fields().ByName("denom_list")
+	fd_GenesisState_denom_auth_list = md_GenesisState.Fields().ByName("denom_auth_list")
+	fd_GenesisState_before_send_hook_list = md_GenesisState.Fields().ByName("before_send_hook_list")
+	fd_GenesisState_frozen_account_list = md_GenesisState.Fields().ByName("frozen_account_list")
+	fd_GenesisState_global_frozen_denoms = md_GenesisState.Fields().ByName("global_frozen_denoms")
+	fd_GenesisState_minter_list = md_GenesisState.Fields().ByName("minter_list")
+	fd_GenesisState_mint_rate_limit_list = md_GenesisState.Fields().ByName("mint_rate_limit_list")
+	fd_GenesisState_mint_rate_bucket_list = md_GenesisState.Fields().ByName("mint_rate_bucket_list")
+	fd_GenesisState_vesting_grant_list = md_GenesisState.Fields().ByName("vesting_grant_list")
+	fd_GenesisState_vesting_grant_count = md_GenesisState.Fields().ByName("vesting_grant_count")
+	fd_GenesisState_airdrop_list = md_GenesisState.Fields().ByName("airdrop_list")
+	fd_GenesisState_airdrop_claim_list = md_GenesisState.Fields().ByName("airdrop_claim_list")
+	fd_GenesisState_airdrop_count = md_GenesisState.Fields().ByName("airdrop_count")
+	fd_GenesisState_snapshot_list = md_GenesisState.Fields().ByName("snapshot_list")
+	fd_GenesisState_snapshot_balance_list = md_GenesisState.Fields().ByName("snapshot_balance_list")
+	fd_GenesisState_reward_pool_list = md_GenesisState.Fields().ByName("reward_pool_list")
+	fd_GenesisState_holder_reward_list = md_GenesisState.Fields().ByName("holder_reward_list")
+	fd_GenesisState_module_account_holder_list = md_GenesisState.Fields().ByName("module_account_holder_list")
+	fd_GenesisState_cw20_bridge_list = md_GenesisState.Fields().ByName("cw20_bridge_list")
+	fd_GenesisState_denom_role_list = md_GenesisState.Fields().ByName("denom_role_list")
+	fd_GenesisState_denom_admin_proposal_list = md_GenesisState.Fields().ByName("denom_admin_proposal_list")
+	fd_GenesisState_denom_admin_timelock_list = md_GenesisState.Fields().ByName("denom_admin_timelock_list")
+	fd_GenesisState_verified_denom_list = md_GenesisState.Fields().ByName("verified_denom_list")
+	fd_GenesisState_transfer_tax_list = md_GenesisState.Fields().ByName("transfer_tax_list")
+	fd_GenesisState_tax_exemption_list = md_GenesisState.Fields().ByName("tax_exemption_list")
+	fd_GenesisState_token_launch_list = md_GenesisState.Fields().ByName("token_launch_list")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_factory_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.DenomList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.DenomList})
+		if !f(fd_GenesisState_denom_list, value) {
+			return
+		}
+	}
+	if len(x.DenomAuthList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.DenomAuthList})
+		if !f(fd_GenesisState_denom_auth_list, value) {
+			return
+		}
+	}
+	if len(x.BeforeSendHookList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.BeforeSendHookList})
+		if !f(fd_GenesisState_before_send_hook_list, value) {
+			return
+		}
+	}
+	if len(x.FrozenAccountList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.FrozenAccountList})
+		if !f(fd_GenesisState_frozen_account_list, value) {
+			return
+		}
+	}
+	if len(x.GlobalFrozenDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.GlobalFrozenDenoms})
+		if !f(fd_GenesisState_global_frozen_denoms, value) {
+			return
+		}
+	}
+	if len(x.MinterList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.MinterList})
+		if !f(fd_GenesisState_minter_list, value) {
+			return
+		}
+	}
+	if len(x.MintRateLimitList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.MintRateLimitList})
+		if !f(fd_GenesisState_mint_rate_limit_list, value) {
+			return
+		}
+	}
+	if len(x.MintRateBucketList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.MintRateBucketList})
+		if !f(fd_GenesisState_mint_rate_bucket_list, value) {
+			return
+		}
+	}
+	if len(x.VestingGrantList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.VestingGrantList})
+		if !f(fd_GenesisState_vesting_grant_list, value) {
+			return
+		}
+	}
+	if x.VestingGrantCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VestingGrantCount)
+		if !f(fd_GenesisState_vesting_grant_count, value) {
+			return
+		}
+	}
+	if len(x.AirdropList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.AirdropList})
+		if !f(fd_GenesisState_airdrop_list, value) {
+			return
+		}
+	}
+	if len(x.AirdropClaimList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.AirdropClaimList})
+		if !f(fd_GenesisState_airdrop_claim_list, value) {
+			return
+		}
+	}
+	if x.AirdropCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AirdropCount)
+		if !f(fd_GenesisState_airdrop_count, value) {
+			return
+		}
+	}
+	if len(x.SnapshotList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.SnapshotList})
+		if !f(fd_GenesisState_snapshot_list, value) {
+			return
+		}
+	}
+	if len(x.SnapshotBalanceList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.SnapshotBalanceList})
+		if !f(fd_GenesisState_snapshot_balance_list, value) {
+			return
+		}
+	}
+	if len(x.RewardPoolList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.RewardPoolList})
+		if !f(fd_GenesisState_reward_pool_list, value) {
+			return
+		}
+	}
+	if len(x.HolderRewardList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_18_list{list: &x.HolderRewardList})
+		if !f(fd_GenesisState_holder_reward_list, value) {
+			return
+		}
+	}
+	if len(x.ModuleAccountHolderList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_19_list{list: &x.ModuleAccountHolderList})
+		if !f(fd_GenesisState_module_account_holder_list, value) {
+			return
+		}
+	}
+	if len(x.Cw20BridgeList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_20_list{list: &x.Cw20BridgeList})
+		if !f(fd_GenesisState_cw20_bridge_list, value) {
+			return
+		}
+	}
+	if len(x.DenomRoleList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_21_list{list: &x.DenomRoleList})
+		if !f(fd_GenesisState_denom_role_list, value) {
+			return
+		}
+	}
+	if len(x.DenomAdminProposalList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_22_list{list: &x.DenomAdminProposalList})
+		if !f(fd_GenesisState_denom_admin_proposal_list, value) {
+			return
+		}
+	}
+	if len(x.DenomAdminTimelockList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_23_list{list: &x.DenomAdminTimelockList})
+		if !f(fd_GenesisState_denom_admin_timelock_list, value) {
+			return
+		}
+	}
+	if len(x.VerifiedDenomList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_24_list{list: &x.VerifiedDenomList})
+		if !f(fd_GenesisState_verified_denom_list, value) {
+			return
+		}
+	}
+	if len(x.TransferTaxList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_25_list{list: &x.TransferTaxList})
+		if !f(fd_GenesisState_transfer_tax_list, value) {
+			return
+		}
+	}
+	if len(x.TaxExemptionList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_26_list{list: &x.TaxExemptionList})
+		if !f(fd_GenesisState_tax_exemption_list, value) {
+			return
+		}
+	}
+	if len(x.TokenLaunchList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_27_list{list: &x.TokenLaunchList})
+		if !f(fd_GenesisState_token_launch_list, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zigchain.factory.GenesisState.params":
+		return x.Params != nil
+	case "zigchain.factory.GenesisState.denom_list":
+		return len(x.DenomList) != 0
+	case "zigchain.factory.GenesisState.denom_auth_list":
+		return len(x.DenomAuthList) != 0
+	case "zigchain.factory.GenesisState.before_send_hook_list":
+		return len(x.BeforeSendHookList) != 0
+	case "zigchain.factory.GenesisState.frozen_account_list":
+		return len(x.FrozenAccountList) != 0
+	case "zigchain.factory.GenesisState.global_frozen_denoms":
+		return len(x.GlobalFrozenDenoms) != 0
+	case "zigchain.factory.GenesisState.minter_list":
+		return len(x.MinterList) != 0
+	case "zigchain.factory.GenesisState.mint_rate_limit_list":
+		return len(x.MintRateLimitList) != 0
+	case "zigchain.factory.GenesisState.mint_rate_bucket_list":
+		return len(x.MintRateBucketList) != 0
+	case "zigchain.factory.GenesisState.vesting_grant_list":
+		return len(x.VestingGrantList) != 0
+	case "zigchain.factory.GenesisState.vesting_grant_count":
+		return x.VestingGrantCount != uint64(0)
+	case "zigchain.factory.GenesisState.airdrop_list":
+		return len(x.AirdropList) != 0
+	case "zigchain.factory.GenesisState.airdrop_claim_list":
+		return len(x.AirdropClaimList) != 0
+	case "zigchain.factory.GenesisState.airdrop_count":
+		return x.AirdropCount != uint64(0)
+	case "zigchain.factory.GenesisState.snapshot_list":
+		return len(x.SnapshotList) != 0
+	case "zigchain.factory.GenesisState.snapshot_balance_list":
+		return len(x.SnapshotBalanceList) != 0
+	case "zigchain.factory.GenesisState.reward_pool_list":
+		return len(x.RewardPoolList) != 0
+	case "zigchain.factory.GenesisState.holder_reward_list":
+		return len(x.HolderRewardList) != 0
+	case "zigchain.factory.GenesisState.module_account_holder_list":
+		return len(x.ModuleAccountHolderList) != 0
+	case "zigchain.factory.GenesisState.cw20_bridge_list":
+		return len(x.Cw20BridgeList) != 0
+	case "zigchain.factory.GenesisState.denom_role_list":
+		return len(x.DenomRoleList) != 0
+	case "zigchain.factory.GenesisState.denom_admin_proposal_list":
+		return len(x.DenomAdminProposalList) != 0
+	case "zigchain.factory.GenesisState.denom_admin_timelock_list":
+		return len(x.DenomAdminTimelockList) != 0
+	case "zigchain.factory.GenesisState.verified_denom_list":
+		return len(x.VerifiedDenomList) != 0
+	case "zigchain.factory.GenesisState.transfer_tax_list":
+		return len(x.TransferTaxList) != 0
+	case "zigchain.factory.GenesisState.tax_exemption_list":
+		return len(x.TaxExemptionList) != 0
+	case "zigchain.factory.GenesisState.token_launch_list":
+		return len(x.TokenLaunchList) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.GenesisState"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zigchain.factory.GenesisState.params":
+		x.Params = nil
+	case "zigchain.factory.GenesisState.denom_list":
+		x.DenomList = nil
+	case "zigchain.factory.GenesisState.denom_auth_list":
+		x.DenomAuthList = nil
+	case "zigchain.factory.GenesisState.before_send_hook_list":
+		x.BeforeSendHookList = nil
+	case "zigchain.factory.GenesisState.frozen_account_list":
+		x.FrozenAccountList = nil
+	case "zigchain.factory.GenesisState.global_frozen_denoms":
+		x.GlobalFrozenDenoms = nil
+	case "zigchain.factory.GenesisState.minter_list":
+		x.MinterList = nil
+	case "zigchain.factory.GenesisState.mint_rate_limit_list":
+		x.MintRateLimitList = nil
+	case "zigchain.factory.GenesisState.mint_rate_bucket_list":
+		x.MintRateBucketList = nil
+	case "zigchain.factory.GenesisState.vesting_grant_list":
+		x.VestingGrantList = nil
+	case "zigchain.factory.GenesisState.vesting_grant_count":
+		x.VestingGrantCount = uint64(0)
+	case "zigchain.factory.GenesisState.airdrop_list":
+		x.AirdropList = nil
+	case "zigchain.factory.GenesisState.airdrop_claim_list":
+		x.AirdropClaimList = nil
+	case "zigchain.factory.GenesisState.airdrop_count":
+		x.AirdropCount = uint64(0)
+	case "zigchain.factory.GenesisState.snapshot_list":
+		x.SnapshotList = nil
+	case "zigchain.factory.GenesisState.snapshot_balance_list":
+		x.SnapshotBalanceList = nil
+	case "zigchain.factory.GenesisState.reward_pool_list":
+		x.RewardPoolList = nil
+	case "zigchain.factory.GenesisState.holder_reward_list":
+		x.HolderRewardList = nil
+	case "zigchain.factory.GenesisState.module_account_holder_list":
+		x.ModuleAccountHolderList = nil
+	case "zigchain.factory.GenesisState.cw20_bridge_list":
+		x.Cw20BridgeList = nil
+	case "zigchain.factory.GenesisState.denom_role_list":
+		x.DenomRoleList = nil
+	case "zigchain.factory.GenesisState.denom_admin_proposal_list":
+		x.DenomAdminProposalList = nil
+	case "zigchain.factory.GenesisState.denom_admin_timelock_list":
+		x.DenomAdminTimelockList = nil
+	case "zigchain.factory.GenesisState.verified_denom_list":
+		x.VerifiedDenomList = nil
+	case "zigchain.factory.GenesisState.transfer_tax_list":
+		x.TransferTaxList = nil
+	case "zigchain.factory.GenesisState.tax_exemption_list":
+		x.TaxExemptionList = nil
+	case "zigchain.factory.GenesisState.token_launch_list":
+		x.TokenLaunchList = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.GenesisState"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zigchain.factory.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zigchain.factory.GenesisState.denom_list":
+		if len(x.DenomList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.DenomList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.denom_auth_list":
+		if len(x.DenomAuthList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.DenomAuthList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.before_send_hook_list":
+		if len(x.BeforeSendHookList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.BeforeSendHookList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.frozen_account_list":
+		if len(x.FrozenAccountList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.FrozenAccountList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.global_frozen_denoms":
+		if len(x.GlobalFrozenDenoms) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.GlobalFrozenDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.minter_list":
+		if len(x.MinterList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.MinterList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.mint_rate_limit_list":
+		if len(x.MintRateLimitList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.MintRateLimitList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.mint_rate_bucket_list":
+		if len(x.MintRateBucketList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.MintRateBucketList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.vesting_grant_list":
+		if len(x.VestingGrantList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.VestingGrantList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.vesting_grant_count":
+		value := x.VestingGrantCount
+		return protoreflect.ValueOfUint64(value)
+	case "zigchain.factory.GenesisState.airdrop_list":
+		if len(x.AirdropList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.AirdropList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.airdrop_claim_list":
+		if len(x.AirdropClaimList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.AirdropClaimList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.airdrop_count":
+		value := x.AirdropCount
+		return protoreflect.ValueOfUint64(value)
+	case "zigchain.factory.GenesisState.snapshot_list":
+		if len(x.SnapshotList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.SnapshotList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.snapshot_balance_list":
+		if len(x.SnapshotBalanceList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.SnapshotBalanceList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.reward_pool_list":
+		if len(x.RewardPoolList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+		}
+		listValue := &_GenesisState_17_list{list: &x.RewardPoolList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.holder_reward_list":
+		if len(x.HolderRewardList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_18_list{})
+		}
+		listValue := &_GenesisState_18_list{list: &x.HolderRewardList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.module_account_holder_list":
+		if len(x.ModuleAccountHolderList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_19_list{})
+		}
+		listValue := &_GenesisState_19_list{list: &x.ModuleAccountHolderList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.cw20_bridge_list":
+		if len(x.Cw20BridgeList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_20_list{})
+		}
+		listValue := &_GenesisState_20_list{list: &x.Cw20BridgeList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.denom_role_list":
+		if len(x.DenomRoleList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_21_list{})
+		}
+		listValue := &_GenesisState_21_list{list: &x.DenomRoleList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.denom_admin_proposal_list":
+		if len(x.DenomAdminProposalList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_22_list{})
+		}
+		listValue := &_GenesisState_22_list{list: &x.DenomAdminProposalList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.denom_admin_timelock_list":
+		if len(x.DenomAdminTimelockList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_23_list{})
+		}
+		listValue := &_GenesisState_23_list{list: &x.DenomAdminTimelockList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.verified_denom_list":
+		if len(x.VerifiedDenomList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_24_list{})
+		}
+		listValue := &_GenesisState_24_list{list: &x.VerifiedDenomList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.transfer_tax_list":
+		if len(x.TransferTaxList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_25_list{})
+		}
+		listValue := &_GenesisState_25_list{list: &x.TransferTaxList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.tax_exemption_list":
+		if len(x.TaxExemptionList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_26_list{})
+		}
+		listValue := &_GenesisState_26_list{list: &x.TaxExemptionList}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.GenesisState.token_launch_list":
+		if len(x.TokenLaunchList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_27_list{})
+		}
+		listValue := &_GenesisState_27_list{list: &x.TokenLaunchList}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.GenesisState"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zigchain.factory.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "zigchain.factory.GenesisState.denom_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.DenomList = *clv.list
+	case "zigchain.factory.GenesisState.denom_auth_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.DenomAuthList = *clv.list
+	case "zigchain.factory.GenesisState.before_send_hook_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.BeforeSendHookList = *clv.list
+	case "zigchain.factory.GenesisState.frozen_account_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.FrozenAccountList = *clv.list
+	case "zigchain.factory.GenesisState.global_frozen_denoms":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.GlobalFrozenDenoms = *clv.list
+	case "zigchain.factory.GenesisState.minter_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.MinterList = *clv.list
+	case "zigchain.factory.GenesisState.mint_rate_limit_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.MintRateLimitList = *clv.list
+	case "zigchain.factory.GenesisState.mint_rate_bucket_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.MintRateBucketList = *clv.list
+	case "zigchain.factory.GenesisState.vesting_grant_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.VestingGrantList = *clv.list
+	case "zigchain.factory.GenesisState.vesting_grant_count":
+		x.VestingGrantCount = value.Uint()
+	case "zigchain.factory.GenesisState.airdrop_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.AirdropList = *clv.list
+	case "zigchain.factory.GenesisState.airdrop_claim_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.AirdropClaimList = *clv.list
+	case "zigchain.factory.GenesisState.airdrop_count":
+		x.AirdropCount = value.Uint()
+	case "zigchain.factory.GenesisState.snapshot_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.SnapshotList = *clv.list
+	case "zigchain.factory.GenesisState.snapshot_balance_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.SnapshotBalanceList = *clv.list
+	case "zigchain.factory.GenesisState.reward_pool_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_17_list)
+		x.RewardPoolList = *clv.list
+	case "zigchain.factory.GenesisState.holder_reward_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_18_list)
+		x.HolderRewardList = *clv.list
+	case "zigchain.factory.GenesisState.module_account_holder_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_19_list)
+		x.ModuleAccountHolderList = *clv.list
+	case "zigchain.factory.GenesisState.cw20_bridge_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_20_list)
+		x.Cw20BridgeList = *clv.list
+	case "zigchain.factory.GenesisState.denom_role_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_21_list)
+		x.DenomRoleList = *clv.list
+	case "zigchain.factory.GenesisState.denom_admin_proposal_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_22_list)
+		x.DenomAdminProposalList = *clv.list
+	case "zigchain.factory.GenesisState.denom_admin_timelock_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_23_list)
+		x.DenomAdminTimelockList = *clv.list
+	case "zigchain.factory.GenesisState.verified_denom_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_24_list)
+		x.VerifiedDenomList = *clv.list
+	case "zigchain.factory.GenesisState.transfer_tax_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_25_list)
+		x.TransferTaxList = *clv.list
+	case "zigchain.factory.GenesisState.tax_exemption_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_26_list)
+		x.TaxExemptionList = *clv.list
+	case "zigchain.factory.GenesisState.token_launch_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_27_list)
+		x.TokenLaunchList = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.GenesisState"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.factory.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "zigchain.factory.GenesisState.denom_list":
+		if x.DenomList == nil {
+			x.DenomList = []*Denom{}
+		}
+		value := &_GenesisState_2_list{list: &x.DenomList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.denom_auth_list":
+		if x.DenomAuthList == nil {
+			x.DenomAuthList = []*DenomAuth{}
+		}
+		value := &_GenesisState_3_list{list: &x.DenomAuthList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.before_send_hook_list":
+		if x.BeforeSendHookList == nil {
+			x.BeforeSendHookList = []*BeforeSendHook{}
+		}
+		value := &_GenesisState_4_list{list: &x.BeforeSendHookList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.frozen_account_list":
+		if x.FrozenAccountList == nil {
+			x.FrozenAccountList = []*FrozenAccount{}
+		}
+		value := &_GenesisState_5_list{list: &x.FrozenAccountList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.global_frozen_denoms":
+		if x.GlobalFrozenDenoms == nil {
+			x.GlobalFrozenDenoms = []string{}
+		}
+		value := &_GenesisState_6_list{list: &x.GlobalFrozenDenoms}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.minter_list":
+		if x.MinterList == nil {
+			x.MinterList = []*Minter{}
+		}
+		value := &_GenesisState_7_list{list: &x.MinterList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.mint_rate_limit_list":
+		if x.MintRateLimitList == nil {
+			x.MintRateLimitList = []*MintRateLimit{}
+		}
+		value := &_GenesisState_8_list{list: &x.MintRateLimitList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.mint_rate_bucket_list":
+		if x.MintRateBucketList == nil {
+			x.MintRateBucketList = []*MintRateBucket{}
+		}
+		value := &_GenesisState_9_list{list: &x.MintRateBucketList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.vesting_grant_list":
+		if x.VestingGrantList == nil {
+			x.VestingGrantList = []*VestingGrant{}
+		}
+		value := &_GenesisState_10_list{list: &x.VestingGrantList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.airdrop_list":
+		if x.AirdropList == nil {
+			x.AirdropList = []*Airdrop{}
+		}
+		value := &_GenesisState_12_list{list: &x.AirdropList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.airdrop_claim_list":
+		if x.AirdropClaimList == nil {
+			x.AirdropClaimList = []*AirdropClaim{}
+		}
+		value := &_GenesisState_13_list{list: &x.AirdropClaimList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.snapshot_list":
+		if x.SnapshotList == nil {
+			x.SnapshotList = []*Snapshot{}
+		}
+		value := &_GenesisState_15_list{list: &x.SnapshotList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.snapshot_balance_list":
+		if x.SnapshotBalanceList == nil {
+			x.SnapshotBalanceList = []*SnapshotBalance{}
+		}
+		value := &_GenesisState_16_list{list: &x.SnapshotBalanceList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.reward_pool_list":
+		if x.RewardPoolList == nil {
+			x.RewardPoolList = []*RewardPool{}
+		}
+		value := &_GenesisState_17_list{list: &x.RewardPoolList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.holder_reward_list":
+		if x.HolderRewardList == nil {
+			x.HolderRewardList = []*HolderReward{}
+		}
+		value := &_GenesisState_18_list{list: &x.HolderRewardList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.module_account_holder_list":
+		if x.ModuleAccountHolderList == nil {
+			x.ModuleAccountHolderList = []*ModuleAccountHolder{}
+		}
+		value := &_GenesisState_19_list{list: &x.ModuleAccountHolderList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.cw20_bridge_list":
+		if x.Cw20BridgeList == nil {
+			x.Cw20BridgeList = []*CW20Bridge{}
+		}
+		value := &_GenesisState_20_list{list: &x.Cw20BridgeList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.denom_role_list":
+		if x.DenomRoleList == nil {
+			x.DenomRoleList = []*DenomRole{}
+		}
+		value := &_GenesisState_21_list{list: &x.DenomRoleList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.denom_admin_proposal_list":
+		if x.DenomAdminProposalList == nil {
+			x.DenomAdminProposalList = []*DenomAdminProposal{}
+		}
+		value := &_GenesisState_22_list{list: &x.DenomAdminProposalList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.denom_admin_timelock_list":
+		if x.DenomAdminTimelockList == nil {
+			x.DenomAdminTimelockList = []*DenomAdminTimelock{}
+		}
+		value := &_GenesisState_23_list{list: &x.DenomAdminTimelockList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.verified_denom_list":
+		if x.VerifiedDenomList == nil {
+			x.VerifiedDenomList = []*VerifiedDenom{}
+		}
+		value := &_GenesisState_24_list{list: &x.VerifiedDenomList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.transfer_tax_list":
+		if x.TransferTaxList == nil {
+			x.TransferTaxList = []*TransferTax{}
+		}
+		value := &_GenesisState_25_list{list: &x.TransferTaxList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.tax_exemption_list":
+		if x.TaxExemptionList == nil {
+			x.TaxExemptionList = []*TransferTaxExemption{}
+		}
+		value := &_GenesisState_26_list{list: &x.TaxExemptionList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.token_launch_list":
+		if x.TokenLaunchList == nil {
+			x.TokenLaunchList = []*TokenLaunch{}
+		}
+		value := &_GenesisState_27_list{list: &x.TokenLaunchList}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.GenesisState.vesting_grant_count":
+		panic(fmt.Errorf("field vesting_grant_count of message zigchain.factory.GenesisState is not mutable"))
+	case "zigchain.factory.GenesisState.airdrop_count":
+		panic(fmt.Errorf("field airdrop_count of message zigchain.factory.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.GenesisState"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.factory.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zigchain.factory.GenesisState.denom_list":
+		list := []*Denom{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "zigchain.factory.GenesisState.denom_auth_list":
+		list := []*DenomAuth{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "zigchain.factory.GenesisState.before_send_hook_list":
+		list := []*BeforeSendHook{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "zigchain.factory.GenesisState.frozen_account_list":
+		list := []*FrozenAccount{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "zigchain.factory.GenesisState.global_frozen_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "zigchain.factory.GenesisState.minter_list":
+		list := []*Minter{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "zigchain.factory.GenesisState.mint_rate_limit_list":
+		list := []*MintRateLimit{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "zigchain.factory.GenesisState.mint_rate_bucket_list":
+		list := []*MintRateBucket{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "zigchain.factory.GenesisState.vesting_grant_list":
+		list := []*VestingGrant{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "zigchain.factory.GenesisState.vesting_grant_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zigchain.factory.GenesisState.airdrop_list":
+		list := []*Airdrop{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "zigchain.factory.GenesisState.airdrop_claim_list":
+		list := []*AirdropClaim{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "zigchain.factory.GenesisState.airdrop_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zigchain.factory.GenesisState.snapshot_list":
+		list := []*Snapshot{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "zigchain.factory.GenesisState.snapshot_balance_list":
+		list := []*SnapshotBalance{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	case "zigchain.factory.GenesisState.reward_pool_list":
+		list := []*RewardPool{}
+		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
+	case "zigchain.factory.GenesisState.holder_reward_list":
+		list := []*HolderReward{}
+		return protoreflect.ValueOfList(&_GenesisState_18_list{list: &list})
+	case "zigchain.factory.GenesisState.module_account_holder_list":
+		list := []*ModuleAccountHolder{}
+		return protoreflect.ValueOfList(&_GenesisState_19_list{list: &list})
+	case "zigchain.factory.GenesisState.cw20_bridge_list":
+		list := []*CW20Bridge{}
+		return protoreflect.ValueOfList(&_GenesisState_20_list{list: &list})
+	case "zigchain.factory.GenesisState.denom_role_list":
+		list := []*DenomRole{}
+		return protoreflect.ValueOfList(&_GenesisState_21_list{list: &list})
+	case "zigchain.factory.GenesisState.denom_admin_proposal_list":
+		list := []*DenomAdminProposal{}
+		return protoreflect.ValueOfList(&_GenesisState_22_list{list: &list})
+	case "zigchain.factory.GenesisState.denom_admin_timelock_list":
+		list := []*DenomAdminTimelock{}
+		return protoreflect.ValueOfList(&_GenesisState_23_list{list: &list})
+	case "zigchain.factory.GenesisState.verified_denom_list":
+		list := []*VerifiedDenom{}
+		return protoreflect.ValueOfList(&_GenesisState_24_list{list: &list})
+	case "zigchain.factory.GenesisState.transfer_tax_list":
+		list := []*TransferTax{}
+		return protoreflect.ValueOfList(&_GenesisState_25_list{list: &list})
+	case "zigchain.factory.GenesisState.tax_exemption_list":
+		list := []*TransferTaxExemption{}
+		return protoreflect.ValueOfList(&_GenesisState_26_list{list: &list})
+	case "zigchain.factory.GenesisState.token_launch_list":
+		list := []*TokenLaunch{}
+		return protoreflect.ValueOfList(&_GenesisState_27_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.GenesisState"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.factory.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.DenomList) > 0 {
+			for _, e := range x.DenomList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DenomAuthList) > 0 {
+			for _, e := range x.DenomAuthList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BeforeSendHookList) > 0 {
+			for _, e := range x.BeforeSendHookList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FrozenAccountList) > 0 {
+			for _, e := range x.FrozenAccountList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.GlobalFrozenDenoms) > 0 {
+			for _, s := range x.GlobalFrozenDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MinterList) > 0 {
+			for _, e := range x.MinterList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MintRateLimitList) > 0 {
+			for _, e := range x.MintRateLimitList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MintRateBucketList) > 0 {
+			for _, e := range x.MintRateBucketList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VestingGrantList) > 0 {
+			for _, e := range x.VestingGrantList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.VestingGrantCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.VestingGrantCount))
+		}
+		if len(x.AirdropList) > 0 {
+			for _, e := range x.AirdropList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AirdropClaimList) > 0 {
+			for _, e := range x.AirdropClaimList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AirdropCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AirdropCount))
+		}
+		if len(x.SnapshotList) > 0 {
+			for _, e := range x.SnapshotList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SnapshotBalanceList) > 0 {
+			for _, e := range x.SnapshotBalanceList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RewardPoolList) > 0 {
+			for _, e := range x.RewardPoolList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.HolderRewardList) > 0 {
+			for _, e := range x.HolderRewardList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ModuleAccountHolderList) > 0 {
+			for _, e := range x.ModuleAccountHolderList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Cw20BridgeList) > 0 {
+			for _, e := range x.Cw20BridgeList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DenomRoleList) > 0 {
+			for _, e := range x.DenomRoleList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DenomAdminProposalList) > 0 {
+			for _, e := range x.DenomAdminProposalList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DenomAdminTimelockList) > 0 {
+			for _, e := range x.DenomAdminTimelockList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VerifiedDenomList) > 0 {
+			for _, e := range x.VerifiedDenomList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TransferTaxList) > 0 {
+			for _, e := range x.TransferTaxList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TaxExemptionList) > 0 {
+			for _, e := range x.TaxExemptionList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TokenLaunchList) > 0 {
+			for _, e := range x.TokenLaunchList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TokenLaunchList) > 0 {
+			for iNdEx := len(x.TokenLaunchList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TokenLaunchList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xda
+			}
+		}
+		if len(x.TaxExemptionList) > 0 {
+			for iNdEx := len(x.TaxExemptionList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TaxExemptionList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xd2
+			}
+		}
+		if len(x.TransferTaxList) > 0 {
+			for iNdEx := len(x.TransferTaxList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TransferTaxList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xca
+			}
+		}
+		if len(x.VerifiedDenomList) > 0 {
+			for iNdEx := len(x.VerifiedDenomList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VerifiedDenomList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xc2
+			}
+		}
+		if len(x.DenomAdminTimelockList) > 0 {
+			for iNdEx := len(x.DenomAdminTimelockList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomAdminTimelockList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xba
+			}
+		}
+		if len(x.DenomAdminProposalList) > 0 {
+			for iNdEx := len(x.DenomAdminProposalList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomAdminProposalList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xb2
+			}
+		}
+		if len(x.DenomRoleList) > 0 {
+			for iNdEx := len(x.DenomRoleList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomRoleList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xaa
+			}
+		}
+		if len(x.Cw20BridgeList) > 0 {
+			for iNdEx := len(x.Cw20BridgeList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Cw20BridgeList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xa2
+			}
+		}
+		if len(x.ModuleAccountHolderList) > 0 {
+			for iNdEx := len(x.ModuleAccountHolderList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ModuleAccountHolderList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if len(x.HolderRewardList) > 0 {
+			for iNdEx := len(x.HolderRewardList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.HolderRewardList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.RewardPoolList) > 0 {
+			for iNdEx := len(x.RewardPoolList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RewardPoolList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if len(x.SnapshotBalanceList) > 0 {
+			for iNdEx := len(x.SnapshotBalanceList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SnapshotBalanceList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.SnapshotList) > 0 {
+			for iNdEx := len(x.SnapshotList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SnapshotList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if x.AirdropCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AirdropCount))
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.AirdropClaimList) > 0 {
+			for iNdEx := len(x.AirdropClaimList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AirdropClaimList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.AirdropList) > 0 {
+			for iNdEx := len(x.AirdropList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AirdropList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.VestingGrantCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VestingGrantCount))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.VestingGrantList) > 0 {
+			for iNdEx := len(x.VestingGrantList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VestingGrantList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.MintRateBucketList) > 0 {
+			for iNdEx := len(x.MintRateBucketList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MintRateBucketList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.MintRateLimitList) > 0 {
+			for iNdEx := len(x.MintRateLimitList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MintRateLimitList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.MinterList) > 0 {
+			for iNdEx := len(x.MinterList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinterList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.GlobalFrozenDenoms) > 0 {
+			for iNdEx := len(x.GlobalFrozenDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.GlobalFrozenDenoms[iNdEx])
+				copy(dAtA[i:], x.GlobalFrozenDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GlobalFrozenDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.FrozenAccountList) > 0 {
+			for iNdEx := len(x.FrozenAccountList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FrozenAccountList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.BeforeSendHookList) > 0 {
+			for iNdEx := len(x.BeforeSendHookList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BeforeSendHookList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.DenomAuthList) > 0 {
+			for iNdEx := len(x.DenomAuthList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomAuthList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.DenomList) > 0 {
+			for iNdEx := len(x.DenomList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomList = append(x.DenomList, &Denom{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomList[len(x.DenomList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomAuthList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomAuthList = append(x.DenomAuthList, &DenomAuth{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomAuthList[len(x.DenomAuthList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BeforeSendHookList = append(x.BeforeSendHookList, &BeforeSendHook{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BeforeSendHookList[len(x.BeforeSendHookList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FrozenAccountList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FrozenAccountList = append(x.FrozenAccountList, &FrozenAccount{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FrozenAccountList[len(x.FrozenAccountList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GlobalFrozenDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GlobalFrozenDenoms = append(x.GlobalFrozenDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinterList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinterList = append(x.MinterList, &Minter{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinterList[len(x.MinterList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintRateLimitList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintRateLimitList = append(x.MintRateLimitList, &MintRateLimit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintRateLimitList[len(x.MintRateLimitList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintRateBucketList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintRateBucketList = append(x.MintRateBucketList, &MintRateBucket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintRateBucketList[len(x.MintRateBucketList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingGrantList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VestingGrantList = append(x.VestingGrantList, &VestingGrant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VestingGrantList[len(x.VestingGrantList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingGrantCount", wireType)
+				}
+				x.VestingGrantCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VestingGrantCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AirdropList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AirdropList = append(x.AirdropList, &Airdrop{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AirdropList[len(x.AirdropList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AirdropClaimList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AirdropClaimList = append(x.AirdropClaimList, &AirdropClaim{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AirdropClaimList[len(x.AirdropClaimList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AirdropCount", wireType)
+				}
+				x.AirdropCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AirdropCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SnapshotList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SnapshotList = append(x.SnapshotList, &Snapshot{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SnapshotList[len(x.SnapshotList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SnapshotBalanceList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SnapshotBalanceList = append(x.SnapshotBalanceList, &SnapshotBalance{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SnapshotBalanceList[len(x.SnapshotBalanceList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardPoolList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardPoolList = append(x.RewardPoolList, &RewardPool{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardPoolList[len(x.RewardPoolList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HolderRewardList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HolderRewardList = append(x.HolderRewardList, &HolderReward{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HolderRewardList[len(x.HolderRewardList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleAccountHolderList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleAccountHolderList = append(x.ModuleAccountHolderList, &ModuleAccountHolder{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ModuleAccountHolderList[len(x.ModuleAccountHolderList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cw20BridgeList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Cw20BridgeList = append(x.Cw20BridgeList, &CW20Bridge{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Cw20BridgeList[len(x.Cw20BridgeList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomRoleList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomRoleList = append(x.DenomRoleList, &DenomRole{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomRoleList[len(x.DenomRoleList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomAdminProposalList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomAdminProposalList = append(x.DenomAdminProposalList, &DenomAdminProposal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomAdminProposalList[len(x.DenomAdminProposalList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomAdminTimelockList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if len(x.DenomList) > 0 {
-			for iNdEx := len(x.DenomList) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.DenomList[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.DenomAdminTimelockList = append(x.DenomAdminTimelockList, &DenomAdminTimelock{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomAdminTimelockList[len(x.DenomAdminTimelockList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 24:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifiedDenomList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -615,16 +3725,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				x.VerifiedDenomList = append(x.VerifiedDenomList, &VerifiedDenom{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VerifiedDenomList[len(x.VerifiedDenomList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				iNdEx = postIndex
+			case 25:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferTaxList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TransferTaxList = append(x.TransferTaxList, &TransferTax{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TransferTaxList[len(x.TransferTaxList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 26:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaxExemptionList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -651,14 +3793,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DenomList = append(x.DenomList, &Denom{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomList[len(x.DenomList)-1]); err != nil {
+				x.TaxExemptionList = append(x.TaxExemptionList, &TransferTaxExemption{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TaxExemptionList[len(x.TaxExemptionList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 27:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomAuthList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenLaunchList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -685,8 +3827,8 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DenomAuthList = append(x.DenomAuthList, &DenomAuth{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomAuthList[len(x.DenomAuthList)-1]); err != nil {
+				x.TokenLaunchList = append(x.TokenLaunchList, &TokenLaunch{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TokenLaunchList[len(x.TokenLaunchList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -745,9 +3887,33 @@ type GenesisState struct {
 	unknownFields protoimpl.UnknownFields
 
 	// params defines all the parameters of the module.
-	Params        *Params      `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	DenomList     []*Denom     `protobuf:"bytes,2,rep,name=denom_list,json=denomList,proto3" json:"denom_list,omitempty"`
-	DenomAuthList []*DenomAuth `protobuf:"bytes,3,rep,name=denom_auth_list,json=denomAuthList,proto3" json:"denom_auth_list,omitempty"`
+	Params                  *Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	DenomList               []*Denom                `protobuf:"bytes,2,rep,name=denom_list,json=denomList,proto3" json:"denom_list,omitempty"`
+	DenomAuthList           []*DenomAuth            `protobuf:"bytes,3,rep,name=denom_auth_list,json=denomAuthList,proto3" json:"denom_auth_list,omitempty"`
+	BeforeSendHookList      []*BeforeSendHook       `protobuf:"bytes,4,rep,name=before_send_hook_list,json=beforeSendHookList,proto3" json:"before_send_hook_list,omitempty"`
+	FrozenAccountList       []*FrozenAccount        `protobuf:"bytes,5,rep,name=frozen_account_list,json=frozenAccountList,proto3" json:"frozen_account_list,omitempty"`
+	GlobalFrozenDenoms      []string                `protobuf:"bytes,6,rep,name=global_frozen_denoms,json=globalFrozenDenoms,proto3" json:"global_frozen_denoms,omitempty"`
+	MinterList              []*Minter               `protobuf:"bytes,7,rep,name=minter_list,json=minterList,proto3" json:"minter_list,omitempty"`
+	MintRateLimitList       []*MintRateLimit        `protobuf:"bytes,8,rep,name=mint_rate_limit_list,json=mintRateLimitList,proto3" json:"mint_rate_limit_list,omitempty"`
+	MintRateBucketList      []*MintRateBucket       `protobuf:"bytes,9,rep,name=mint_rate_bucket_list,json=mintRateBucketList,proto3" json:"mint_rate_bucket_list,omitempty"`
+	VestingGrantList        []*VestingGrant         `protobuf:"bytes,10,rep,name=vesting_grant_list,json=vestingGrantList,proto3" json:"vesting_grant_list,omitempty"`
+	VestingGrantCount       uint64                  `protobuf:"varint,11,opt,name=vesting_grant_count,json=vestingGrantCount,proto3" json:"vesting_grant_count,omitempty"`
+	AirdropList             []*Airdrop              `protobuf:"bytes,12,rep,name=airdrop_list,json=airdropList,proto3" json:"airdrop_list,omitempty"`
+	AirdropClaimList        []*AirdropClaim         `protobuf:"bytes,13,rep,name=airdrop_claim_list,json=airdropClaimList,proto3" json:"airdrop_claim_list,omitempty"`
+	AirdropCount            uint64                  `protobuf:"varint,14,opt,name=airdrop_count,json=airdropCount,proto3" json:"airdrop_count,omitempty"`
+	SnapshotList            []*Snapshot             `protobuf:"bytes,15,rep,name=snapshot_list,json=snapshotList,proto3" json:"snapshot_list,omitempty"`
+	SnapshotBalanceList     []*SnapshotBalance      `protobuf:"bytes,16,rep,name=snapshot_balance_list,json=snapshotBalanceList,proto3" json:"snapshot_balance_list,omitempty"`
+	RewardPoolList          []*RewardPool           `protobuf:"bytes,17,rep,name=reward_pool_list,json=rewardPoolList,proto3" json:"reward_pool_list,omitempty"`
+	HolderRewardList        []*HolderReward         `protobuf:"bytes,18,rep,name=holder_reward_list,json=holderRewardList,proto3" json:"holder_reward_list,omitempty"`
+	ModuleAccountHolderList []*ModuleAccountHolder  `protobuf:"bytes,19,rep,name=module_account_holder_list,json=moduleAccountHolderList,proto3" json:"module_account_holder_list,omitempty"`
+	Cw20BridgeList          []*CW20Bridge           `protobuf:"bytes,20,rep,name=cw20_bridge_list,json=cw20BridgeList,proto3" json:"cw20_bridge_list,omitempty"`
+	DenomRoleList           []*DenomRole            `protobuf:"bytes,21,rep,name=denom_role_list,json=denomRoleList,proto3" json:"denom_role_list,omitempty"`
+	DenomAdminProposalList  []*DenomAdminProposal   `protobuf:"bytes,22,rep,name=denom_admin_proposal_list,json=denomAdminProposalList,proto3" json:"denom_admin_proposal_list,omitempty"`
+	DenomAdminTimelockList  []*DenomAdminTimelock   `protobuf:"bytes,23,rep,name=denom_admin_timelock_list,json=denomAdminTimelockList,proto3" json:"denom_admin_timelock_list,omitempty"`
+	VerifiedDenomList       []*VerifiedDenom        `protobuf:"bytes,24,rep,name=verified_denom_list,json=verifiedDenomList,proto3" json:"verified_denom_list,omitempty"`
+	TransferTaxList         []*TransferTax          `protobuf:"bytes,25,rep,name=transfer_tax_list,json=transferTaxList,proto3" json:"transfer_tax_list,omitempty"`
+	TaxExemptionList        []*TransferTaxExemption `protobuf:"bytes,26,rep,name=tax_exemption_list,json=taxExemptionList,proto3" json:"tax_exemption_list,omitempty"`
+	TokenLaunchList         []*TokenLaunch          `protobuf:"bytes,27,rep,name=token_launch_list,json=tokenLaunchList,proto3" json:"token_launch_list,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -791,6 +3957,174 @@ func (x *GenesisState) GetDenomAuthList() []*DenomAuth {
 	return nil
 }
 
+func (x *GenesisState) GetBeforeSendHookList() []*BeforeSendHook {
+	if x != nil {
+		return x.BeforeSendHookList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFrozenAccountList() []*FrozenAccount {
+	if x != nil {
+		return x.FrozenAccountList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetGlobalFrozenDenoms() []string {
+	if x != nil {
+		return x.GlobalFrozenDenoms
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMinterList() []*Minter {
+	if x != nil {
+		return x.MinterList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMintRateLimitList() []*MintRateLimit {
+	if x != nil {
+		return x.MintRateLimitList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMintRateBucketList() []*MintRateBucket {
+	if x != nil {
+		return x.MintRateBucketList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetVestingGrantList() []*VestingGrant {
+	if x != nil {
+		return x.VestingGrantList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetVestingGrantCount() uint64 {
+	if x != nil {
+		return x.VestingGrantCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetAirdropList() []*Airdrop {
+	if x != nil {
+		return x.AirdropList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAirdropClaimList() []*AirdropClaim {
+	if x != nil {
+		return x.AirdropClaimList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAirdropCount() uint64 {
+	if x != nil {
+		return x.AirdropCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetSnapshotList() []*Snapshot {
+	if x != nil {
+		return x.SnapshotList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSnapshotBalanceList() []*SnapshotBalance {
+	if x != nil {
+		return x.SnapshotBalanceList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRewardPoolList() []*RewardPool {
+	if x != nil {
+		return x.RewardPoolList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetHolderRewardList() []*HolderReward {
+	if x != nil {
+		return x.HolderRewardList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetModuleAccountHolderList() []*ModuleAccountHolder {
+	if x != nil {
+		return x.ModuleAccountHolderList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCw20BridgeList() []*CW20Bridge {
+	if x != nil {
+		return x.Cw20BridgeList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDenomRoleList() []*DenomRole {
+	if x != nil {
+		return x.DenomRoleList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDenomAdminProposalList() []*DenomAdminProposal {
+	if x != nil {
+		return x.DenomAdminProposalList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDenomAdminTimelockList() []*DenomAdminTimelock {
+	if x != nil {
+		return x.DenomAdminTimelockList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetVerifiedDenomList() []*VerifiedDenom {
+	if x != nil {
+		return x.VerifiedDenomList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTransferTaxList() []*TransferTax {
+	if x != nil {
+		return x.TransferTaxList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTaxExemptionList() []*TransferTaxExemption {
+	if x != nil {
+		return x.TaxExemptionList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTokenLaunchList() []*TokenLaunch {
+	if x != nil {
+		return x.TokenLaunchList
+	}
+	return nil
+}
+
 var File_zigchain_factory_genesis_proto protoreflect.FileDescriptor
 
 var file_zigchain_factory_genesis_proto_rawDesc = []byte{
@@ -805,32 +4139,184 @@ var file_zigchain_factory_genesis_proto_rawDesc = []byte{
 	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x64, 0x65, 0x6e,
 	0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61,
 	0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd4, 0x01, 0x0a, 0x0c,
-	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x06,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x7a,
+	0x5f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x7a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x7a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x6d, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x7a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x6d, 0x69, 0x6e,
+	0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2f, 0x61, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x63, 0x77, 0x32, 0x30, 0x5f,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x7a, 0x69,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x2b, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x7a, 0x69,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74,
+	0x61, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x6c, 0x61, 0x75, 0x6e, 0x63,
+	0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x10, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x7a,
 	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0d, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x59,
+	0x0a, 0x15, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x48, 0x6f, 0x6f, 0x6b, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x6e,
+	0x64, 0x48, 0x6f, 0x6f, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x13, 0x66, 0x72, 0x6f,
+	0x7a, 0x65, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x66,
+	0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x30, 0x0a, 0x14, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x6f, 0x7a, 0x65,
+	0x6e, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12,
+	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x74, 0x52, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x59, 0x0a, 0x15, 0x6d,
+	0x69, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x7a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4d, 0x69,
+	0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x12, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x76, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0c, 0x61, 0x69,
+	0x72, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x41, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0b, 0x61, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x52,
+	0x0a, 0x12, 0x61, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x7a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x41, 0x69,
+	0x72, 0x64, 0x72, 0x6f, 0x70, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x10, 0x61, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x69, 0x72, 0x64, 0x72,
+	0x6f, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5b,
+	0x0a, 0x15, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x10, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50,
+	0x6f, 0x6f, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x12, 0x68, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x68, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x68, 0x0a,
+	0x1a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x13, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x17,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x10, 0x63, 0x77, 0x32, 0x30, 0x5f,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x14, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x43, 0x57, 0x32, 0x30, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x63, 0x77, 0x32, 0x30, 0x42, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x72,
+	0x6f, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0d, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x65, 0x0a, 0x19, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x16, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x16, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x65, 0x0a, 0x19, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x7a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x55,
+	0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x7a, 0x69,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x5f, 0x74, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x61, 0x78, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54,
+	0x61, 0x78, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x12, 0x74, 0x61, 0x78, 0x5f, 0x65, 0x78,
+	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x1a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x61,
+	0x78, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x10, 0x74, 0x61, 0x78, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x4f, 0x0a, 0x11, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6c, 0x61, 0x75, 0x6e,
+	0x63, 0x68, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
 	0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x64, 0x65,
-	0x6e, 0x6f, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x0d, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x69,
-	0x73, 0x74, 0x42, 0xa8, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x0c, 0x47, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x21, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x69,
-	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0xa2, 0x02,
-	0x03, 0x5a, 0x46, 0x58, 0xaa, 0x02, 0x10, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
-	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0xca, 0x02, 0x10, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x5c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0xe2, 0x02, 0x1c, 0x5a, 0x69, 0x67,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x5a, 0x69, 0x67, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0xa8, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x0c, 0x47, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x21, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a,
+	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0xa2,
+	0x02, 0x03, 0x5a, 0x46, 0x58, 0xaa, 0x02, 0x10, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0xca, 0x02, 0x10, 0x5a, 0x69, 0x67, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0xe2, 0x02, 0x1c, 0x5a, 0x69,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x5a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -847,20 +4333,62 @@ func file_zigchain_factory_genesis_proto_rawDescGZIP() []byte {
 
 var file_zigchain_factory_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_zigchain_factory_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: zigchain.factory.GenesisState
-	(*Params)(nil),       // 1: zigchain.factory.Params
-	(*Denom)(nil),        // 2: zigchain.factory.Denom
-	(*DenomAuth)(nil),    // 3: zigchain.factory.DenomAuth
+	(*GenesisState)(nil),         // 0: zigchain.factory.GenesisState
+	(*Params)(nil),               // 1: zigchain.factory.Params
+	(*Denom)(nil),                // 2: zigchain.factory.Denom
+	(*DenomAuth)(nil),            // 3: zigchain.factory.DenomAuth
+	(*BeforeSendHook)(nil),       // 4: zigchain.factory.BeforeSendHook
+	(*FrozenAccount)(nil),        // 5: zigchain.factory.FrozenAccount
+	(*Minter)(nil),               // 6: zigchain.factory.Minter
+	(*MintRateLimit)(nil),        // 7: zigchain.factory.MintRateLimit
+	(*MintRateBucket)(nil),       // 8: zigchain.factory.MintRateBucket
+	(*VestingGrant)(nil),         // 9: zigchain.factory.VestingGrant
+	(*Airdrop)(nil),              // 10: zigchain.factory.Airdrop
+	(*AirdropClaim)(nil),         // 11: zigchain.factory.AirdropClaim
+	(*Snapshot)(nil),             // 12: zigchain.factory.Snapshot
+	(*SnapshotBalance)(nil),      // 13: zigchain.factory.SnapshotBalance
+	(*RewardPool)(nil),           // 14: zigchain.factory.RewardPool
+	(*HolderReward)(nil),         // 15: zigchain.factory.HolderReward
+	(*ModuleAccountHolder)(nil),  // 16: zigchain.factory.ModuleAccountHolder
+	(*CW20Bridge)(nil),           // 17: zigchain.factory.CW20Bridge
+	(*DenomRole)(nil),            // 18: zigchain.factory.DenomRole
+	(*DenomAdminProposal)(nil),   // 19: zigchain.factory.DenomAdminProposal
+	(*DenomAdminTimelock)(nil),   // 20: zigchain.factory.DenomAdminTimelock
+	(*VerifiedDenom)(nil),        // 21: zigchain.factory.VerifiedDenom
+	(*TransferTax)(nil),          // 22: zigchain.factory.TransferTax
+	(*TransferTaxExemption)(nil), // 23: zigchain.factory.TransferTaxExemption
+	(*TokenLaunch)(nil),          // 24: zigchain.factory.TokenLaunch
 }
 var file_zigchain_factory_genesis_proto_depIdxs = []int32{
-	1, // 0: zigchain.factory.GenesisState.params:type_name -> zigchain.factory.Params
-	2, // 1: zigchain.factory.GenesisState.denom_list:type_name -> zigchain.factory.Denom
-	3, // 2: zigchain.factory.GenesisState.denom_auth_list:type_name -> zigchain.factory.DenomAuth
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	1,  // 0: zigchain.factory.GenesisState.params:type_name -> zigchain.factory.Params
+	2,  // 1: zigchain.factory.GenesisState.denom_list:type_name -> zigchain.factory.Denom
+	3,  // 2: zigchain.factory.GenesisState.denom_auth_list:type_name -> zigchain.factory.DenomAuth
+	4,  // 3: zigchain.factory.GenesisState.before_send_hook_list:type_name -> zigchain.factory.BeforeSendHook
+	5,  // 4: zigchain.factory.GenesisState.frozen_account_list:type_name -> zigchain.factory.FrozenAccount
+	6,  // 5: zigchain.factory.GenesisState.minter_list:type_name -> zigchain.factory.Minter
+	7,  // 6: zigchain.factory.GenesisState.mint_rate_limit_list:type_name -> zigchain.factory.MintRateLimit
+	8,  // 7: zigchain.factory.GenesisState.mint_rate_bucket_list:type_name -> zigchain.factory.MintRateBucket
+	9,  // 8: zigchain.factory.GenesisState.vesting_grant_list:type_name -> zigchain.factory.VestingGrant
+	10, // 9: zigchain.factory.GenesisState.airdrop_list:type_name -> zigchain.factory.Airdrop
+	11, // 10: zigchain.factory.GenesisState.airdrop_claim_list:type_name -> zigchain.factory.AirdropClaim
+	12, // 11: zigchain.factory.GenesisState.snapshot_list:type_name -> zigchain.factory.Snapshot
+	13, // 12: zigchain.factory.GenesisState.snapshot_balance_list:type_name -> zigchain.factory.SnapshotBalance
+	14, // 13: zigchain.factory.GenesisState.reward_pool_list:type_name -> zigchain.factory.RewardPool
+	15, // 14: zigchain.factory.GenesisState.holder_reward_list:type_name -> zigchain.factory.HolderReward
+	16, // 15: zigchain.factory.GenesisState.module_account_holder_list:type_name -> zigchain.factory.ModuleAccountHolder
+	17, // 16: zigchain.factory.GenesisState.cw20_bridge_list:type_name -> zigchain.factory.CW20Bridge
+	18, // 17: zigchain.factory.GenesisState.denom_role_list:type_name -> zigchain.factory.DenomRole
+	19, // 18: zigchain.factory.GenesisState.denom_admin_proposal_list:type_name -> zigchain.factory.DenomAdminProposal
+	20, // 19: zigchain.factory.GenesisState.denom_admin_timelock_list:type_name -> zigchain.factory.DenomAdminTimelock
+	21, // 20: zigchain.factory.GenesisState.verified_denom_list:type_name -> zigchain.factory.VerifiedDenom
+	22, // 21: zigchain.factory.GenesisState.transfer_tax_list:type_name -> zigchain.factory.TransferTax
+	23, // 22: zigchain.factory.GenesisState.tax_exemption_list:type_name -> zigchain.factory.TransferTaxExemption
+	24, // 23: zigchain.factory.GenesisState.token_launch_list:type_name -> zigchain.factory.TokenLaunch
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_zigchain_factory_genesis_proto_init() }
@@ -871,6 +4399,20 @@ func file_zigchain_factory_genesis_proto_init() {
 	file_zigchain_factory_params_proto_init()
 	file_zigchain_factory_denom_proto_init()
 	file_zigchain_factory_denom_auth_proto_init()
+	file_zigchain_factory_before_send_hook_proto_init()
+	file_zigchain_factory_frozen_account_proto_init()
+	file_zigchain_factory_minter_proto_init()
+	file_zigchain_factory_mint_rate_limit_proto_init()
+	file_zigchain_factory_vesting_proto_init()
+	file_zigchain_factory_airdrop_proto_init()
+	file_zigchain_factory_snapshot_proto_init()
+	file_zigchain_factory_distribution_proto_init()
+	file_zigchain_factory_cw20_bridge_proto_init()
+	file_zigchain_factory_denom_role_proto_init()
+	file_zigchain_factory_denom_admin_proposal_proto_init()
+	file_zigchain_factory_verified_denom_proto_init()
+	file_zigchain_factory_transfer_tax_proto_init()
+	file_zigchain_factory_launch_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_zigchain_factory_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -3,7 +3,9 @@ package factory
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -14,31 +16,900 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_6_list)(nil)
+
+type _Params_6_list struct {
+	list *[]string
+}
+
+func (x *_Params_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field VerifiedDenomCurators as it is not of Message kind"))
+}
+
+func (x *_Params_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                               protoreflect.MessageDescriptor
+	fd_Params_beneficiary                   protoreflect.FieldDescriptor
+	fd_Params_create_fee                    protoreflect.FieldDescriptor
+	fd_Params_distribute_to_module_accounts protoreflect.FieldDescriptor
+	fd_Params_verified_denom_curators       protoreflect.FieldDescriptor
+	fd_Params_max_transfer_tax_rate         protoreflect.FieldDescriptor
+	fd_Params_launch_virtual_reserve        protoreflect.FieldDescriptor
+	fd_Params_launch_graduation_market_cap  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zigchain_factory_params_proto_init()
+	md_Params = File_zigchain_factory_params_proto.Messages().ByName("Params")
+	fd_Params_beneficiary = md_Params.Fields().ByName("beneficiary")
+	fd_Params_create_fee = md_Params.Fields().ByName("create_fee")
+	fd_Params_distribute_to_module_accounts = md_Params.Fields().ByName("distribute_to_module_accounts")
+	fd_Params_verified_denom_curators = md_Params.Fields().ByName("verified_denom_curators")
+	fd_Params_max_transfer_tax_rate = md_Params.Fields().ByName("max_transfer_tax_rate")
+	fd_Params_launch_virtual_reserve = md_Params.Fields().ByName("launch_virtual_reserve")
+	fd_Params_launch_graduation_market_cap = md_Params.Fields().ByName("launch_graduation_market_cap")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_factory_params_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Beneficiary != "" {
+		value := protoreflect.ValueOfString(x.Beneficiary)
+		if !f(fd_Params_beneficiary, value) {
+			return
+		}
+	}
+	if x.CreateFee != nil {
+		value := protoreflect.ValueOfMessage(x.CreateFee.ProtoReflect())
+		if !f(fd_Params_create_fee, value) {
+			return
+		}
+	}
+	if x.DistributeToModuleAccounts != false {
+		value := protoreflect.ValueOfBool(x.DistributeToModuleAccounts)
+		if !f(fd_Params_distribute_to_module_accounts, value) {
+			return
+		}
+	}
+	if len(x.VerifiedDenomCurators) != 0 {
+		value := protoreflect.ValueOfList(&_Params_6_list{list: &x.VerifiedDenomCurators})
+		if !f(fd_Params_verified_denom_curators, value) {
+			return
+		}
+	}
+	if x.MaxTransferTaxRate != "" {
+		value := protoreflect.ValueOfString(x.MaxTransferTaxRate)
+		if !f(fd_Params_max_transfer_tax_rate, value) {
+			return
+		}
+	}
+	if x.LaunchVirtualReserve != "" {
+		value := protoreflect.ValueOfString(x.LaunchVirtualReserve)
+		if !f(fd_Params_launch_virtual_reserve, value) {
+			return
+		}
+	}
+	if x.LaunchGraduationMarketCap != "" {
+		value := protoreflect.ValueOfString(x.LaunchGraduationMarketCap)
+		if !f(fd_Params_launch_graduation_market_cap, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zigchain.factory.Params.beneficiary":
+		return x.Beneficiary != ""
+	case "zigchain.factory.Params.create_fee":
+		return x.CreateFee != nil
+	case "zigchain.factory.Params.distribute_to_module_accounts":
+		return x.DistributeToModuleAccounts != false
+	case "zigchain.factory.Params.verified_denom_curators":
+		return len(x.VerifiedDenomCurators) != 0
+	case "zigchain.factory.Params.max_transfer_tax_rate":
+		return x.MaxTransferTaxRate != ""
+	case "zigchain.factory.Params.launch_virtual_reserve":
+		return x.LaunchVirtualReserve != ""
+	case "zigchain.factory.Params.launch_graduation_market_cap":
+		return x.LaunchGraduationMarketCap != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.Params"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zigchain.factory.Params.beneficiary":
+		x.Beneficiary = ""
+	case "zigchain.factory.Params.create_fee":
+		x.CreateFee = nil
+	case "zigchain.factory.Params.distribute_to_module_accounts":
+		x.DistributeToModuleAccounts = false
+	case "zigchain.factory.Params.verified_denom_curators":
+		x.VerifiedDenomCurators = nil
+	case "zigchain.factory.Params.max_transfer_tax_rate":
+		x.MaxTransferTaxRate = ""
+	case "zigchain.factory.Params.launch_virtual_reserve":
+		x.LaunchVirtualReserve = ""
+	case "zigchain.factory.Params.launch_graduation_market_cap":
+		x.LaunchGraduationMarketCap = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.Params"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zigchain.factory.Params.beneficiary":
+		value := x.Beneficiary
+		return protoreflect.ValueOfString(value)
+	case "zigchain.factory.Params.create_fee":
+		value := x.CreateFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zigchain.factory.Params.distribute_to_module_accounts":
+		value := x.DistributeToModuleAccounts
+		return protoreflect.ValueOfBool(value)
+	case "zigchain.factory.Params.verified_denom_curators":
+		if len(x.VerifiedDenomCurators) == 0 {
+			return protoreflect.ValueOfList(&_Params_6_list{})
+		}
+		listValue := &_Params_6_list{list: &x.VerifiedDenomCurators}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.factory.Params.max_transfer_tax_rate":
+		value := x.MaxTransferTaxRate
+		return protoreflect.ValueOfString(value)
+	case "zigchain.factory.Params.launch_virtual_reserve":
+		value := x.LaunchVirtualReserve
+		return protoreflect.ValueOfString(value)
+	case "zigchain.factory.Params.launch_graduation_market_cap":
+		value := x.LaunchGraduationMarketCap
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.Params"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zigchain.factory.Params.beneficiary":
+		x.Beneficiary = value.Interface().(string)
+	case "zigchain.factory.Params.create_fee":
+		x.CreateFee = value.Message().Interface().(*v1beta1.Coin)
+	case "zigchain.factory.Params.distribute_to_module_accounts":
+		x.DistributeToModuleAccounts = value.Bool()
+	case "zigchain.factory.Params.verified_denom_curators":
+		lv := value.List()
+		clv := lv.(*_Params_6_list)
+		x.VerifiedDenomCurators = *clv.list
+	case "zigchain.factory.Params.max_transfer_tax_rate":
+		x.MaxTransferTaxRate = value.Interface().(string)
+	case "zigchain.factory.Params.launch_virtual_reserve":
+		x.LaunchVirtualReserve = value.Interface().(string)
+	case "zigchain.factory.Params.launch_graduation_market_cap":
+		x.LaunchGraduationMarketCap = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.Params"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.factory.Params.create_fee":
+		if x.CreateFee == nil {
+			x.CreateFee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.CreateFee.ProtoReflect())
+	case "zigchain.factory.Params.verified_denom_curators":
+		if x.VerifiedDenomCurators == nil {
+			x.VerifiedDenomCurators = []string{}
+		}
+		value := &_Params_6_list{list: &x.VerifiedDenomCurators}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.factory.Params.beneficiary":
+		panic(fmt.Errorf("field beneficiary of message zigchain.factory.Params is not mutable"))
+	case "zigchain.factory.Params.distribute_to_module_accounts":
+		panic(fmt.Errorf("field distribute_to_module_accounts of message zigchain.factory.Params is not mutable"))
+	case "zigchain.factory.Params.max_transfer_tax_rate":
+		panic(fmt.Errorf("field max_transfer_tax_rate of message zigchain.factory.Params is not mutable"))
+	case "zigchain.factory.Params.launch_virtual_reserve":
+		panic(fmt.Errorf("field launch_virtual_reserve of message zigchain.factory.Params is not mutable"))
+	case "zigchain.factory.Params.launch_graduation_market_cap":
+		panic(fmt.Errorf("field launch_graduation_market_cap of message zigchain.factory.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.Params"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.factory.Params.beneficiary":
+		return protoreflect.ValueOfString("")
+	case "zigchain.factory.Params.create_fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zigchain.factory.Params.distribute_to_module_accounts":
+		return protoreflect.ValueOfBool(false)
+	case "zigchain.factory.Params.verified_denom_curators":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_6_list{list: &list})
+	case "zigchain.factory.Params.max_transfer_tax_rate":
+		return protoreflect.ValueOfString("")
+	case "zigchain.factory.Params.launch_virtual_reserve":
+		return protoreflect.ValueOfString("")
+	case "zigchain.factory.Params.launch_graduation_market_cap":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.Params"))
+		}
+		panic(fmt.Errorf("message zigchain.factory.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.factory.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Beneficiary)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreateFee != nil {
+			l = options.Size(x.CreateFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DistributeToModuleAccounts {
+			n += 2
+		}
+		if len(x.VerifiedDenomCurators) > 0 {
+			for _, s := range x.VerifiedDenomCurators {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.MaxTransferTaxRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LaunchVirtualReserve)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LaunchGraduationMarketCap)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.LaunchGraduationMarketCap) > 0 {
+			i -= len(x.LaunchGraduationMarketCap)
+			copy(dAtA[i:], x.LaunchGraduationMarketCap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LaunchGraduationMarketCap)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.LaunchVirtualReserve) > 0 {
+			i -= len(x.LaunchVirtualReserve)
+			copy(dAtA[i:], x.LaunchVirtualReserve)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LaunchVirtualReserve)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.MaxTransferTaxRate) > 0 {
+			i -= len(x.MaxTransferTaxRate)
+			copy(dAtA[i:], x.MaxTransferTaxRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxTransferTaxRate)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.VerifiedDenomCurators) > 0 {
+			for iNdEx := len(x.VerifiedDenomCurators) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.VerifiedDenomCurators[iNdEx])
+				copy(dAtA[i:], x.VerifiedDenomCurators[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerifiedDenomCurators[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.DistributeToModuleAccounts {
+			i--
+			if x.DistributeToModuleAccounts {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.CreateFee != nil {
+			encoded, err := options.Marshal(x.CreateFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Beneficiary) > 0 {
+			i -= len(x.Beneficiary)
+			copy(dAtA[i:], x.Beneficiary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Beneficiary)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Beneficiary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreateFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CreateFee == nil {
+					x.CreateFee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreateFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DistributeToModuleAccounts", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DistributeToModuleAccounts = bool(v != 0)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifiedDenomCurators", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerifiedDenomCurators = append(x.VerifiedDenomCurators, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTransferTaxRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxTransferTaxRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LaunchVirtualReserve", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LaunchVirtualReserve = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LaunchGraduationMarketCap", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LaunchGraduationMarketCap = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
-	md_Params                   protoreflect.MessageDescriptor
-	fd_Params_create_fee_denom  protoreflect.FieldDescriptor
-	fd_Params_create_fee_amount protoreflect.FieldDescriptor
-	fd_Params_beneficiary       protoreflect.FieldDescriptor
+	md_LegacyParams                   protoreflect.MessageDescriptor
+	fd_LegacyParams_create_fee_denom  protoreflect.FieldDescriptor
+	fd_LegacyParams_create_fee_amount protoreflect.FieldDescriptor
+	fd_LegacyParams_beneficiary       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_zigchain_factory_params_proto_init()
-	md_Params = File_zigchain_factory_params_proto.Messages().ByName("Params")
-	fd_Params_create_fee_denom = md_Params.Fields().ByName("create_fee_denom")
-	fd_Params_create_fee_amount = md_Params.Fields().ByName("create_fee_amount")
-	fd_Params_beneficiary = md_Params.Fields().ByName("beneficiary")
+	md_LegacyParams = File_zigchain_factory_params_proto.Messages().ByName("LegacyParams")
+	fd_LegacyParams_create_fee_denom = md_LegacyParams.Fields().ByName("create_fee_denom")
+	fd_LegacyParams_create_fee_amount = md_LegacyParams.Fields().ByName("create_fee_amount")
+	fd_LegacyParams_beneficiary = md_LegacyParams.Fields().ByName("beneficiary")
 }
 
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
+var _ protoreflect.Message = (*fastReflection_LegacyParams)(nil)
 
-type fastReflection_Params Params
+type fastReflection_LegacyParams LegacyParams
 
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
+func (x *LegacyParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LegacyParams)(x)
 }
 
-func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_zigchain_factory_params_proto_msgTypes[0]
+func (x *LegacyParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_factory_params_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -49,43 +920,43 @@ func (x *Params) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_LegacyParams_messageType fastReflection_LegacyParams_messageType
+var _ protoreflect.MessageType = fastReflection_LegacyParams_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_LegacyParams_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_LegacyParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LegacyParams)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_LegacyParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_LegacyParams)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_LegacyParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LegacyParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_LegacyParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_LegacyParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_LegacyParams) Type() protoreflect.MessageType {
+	return _fastReflection_LegacyParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_LegacyParams) New() protoreflect.Message {
+	return new(fastReflection_LegacyParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_LegacyParams) Interface() protoreflect.ProtoMessage {
+	return (*LegacyParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -93,22 +964,22 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_LegacyParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.CreateFeeDenom != "" {
 		value := protoreflect.ValueOfString(x.CreateFeeDenom)
-		if !f(fd_Params_create_fee_denom, value) {
+		if !f(fd_LegacyParams_create_fee_denom, value) {
 			return
 		}
 	}
 	if x.CreateFeeAmount != uint32(0) {
 		value := protoreflect.ValueOfUint32(x.CreateFeeAmount)
-		if !f(fd_Params_create_fee_amount, value) {
+		if !f(fd_LegacyParams_create_fee_amount, value) {
 			return
 		}
 	}
 	if x.Beneficiary != "" {
 		value := protoreflect.ValueOfString(x.Beneficiary)
-		if !f(fd_Params_beneficiary, value) {
+		if !f(fd_LegacyParams_beneficiary, value) {
 			return
 		}
 	}
@@ -125,19 +996,19 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_LegacyParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "zigchain.factory.Params.create_fee_denom":
+	case "zigchain.factory.LegacyParams.create_fee_denom":
 		return x.CreateFeeDenom != ""
-	case "zigchain.factory.Params.create_fee_amount":
+	case "zigchain.factory.LegacyParams.create_fee_amount":
 		return x.CreateFeeAmount != uint32(0)
-	case "zigchain.factory.Params.beneficiary":
+	case "zigchain.factory.LegacyParams.beneficiary":
 		return x.Beneficiary != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.LegacyParams"))
 		}
-		panic(fmt.Errorf("message zigchain.factory.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message zigchain.factory.LegacyParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -147,19 +1018,19 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_LegacyParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "zigchain.factory.Params.create_fee_denom":
+	case "zigchain.factory.LegacyParams.create_fee_denom":
 		x.CreateFeeDenom = ""
-	case "zigchain.factory.Params.create_fee_amount":
+	case "zigchain.factory.LegacyParams.create_fee_amount":
 		x.CreateFeeAmount = uint32(0)
-	case "zigchain.factory.Params.beneficiary":
+	case "zigchain.factory.LegacyParams.beneficiary":
 		x.Beneficiary = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.LegacyParams"))
 		}
-		panic(fmt.Errorf("message zigchain.factory.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message zigchain.factory.LegacyParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -169,22 +1040,22 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LegacyParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "zigchain.factory.Params.create_fee_denom":
+	case "zigchain.factory.LegacyParams.create_fee_denom":
 		value := x.CreateFeeDenom
 		return protoreflect.ValueOfString(value)
-	case "zigchain.factory.Params.create_fee_amount":
+	case "zigchain.factory.LegacyParams.create_fee_amount":
 		value := x.CreateFeeAmount
 		return protoreflect.ValueOfUint32(value)
-	case "zigchain.factory.Params.beneficiary":
+	case "zigchain.factory.LegacyParams.beneficiary":
 		value := x.Beneficiary
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.LegacyParams"))
 		}
-		panic(fmt.Errorf("message zigchain.factory.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message zigchain.factory.LegacyParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -198,19 +1069,19 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_LegacyParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "zigchain.factory.Params.create_fee_denom":
+	case "zigchain.factory.LegacyParams.create_fee_denom":
 		x.CreateFeeDenom = value.Interface().(string)
-	case "zigchain.factory.Params.create_fee_amount":
+	case "zigchain.factory.LegacyParams.create_fee_amount":
 		x.CreateFeeAmount = uint32(value.Uint())
-	case "zigchain.factory.Params.beneficiary":
+	case "zigchain.factory.LegacyParams.beneficiary":
 		x.Beneficiary = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.LegacyParams"))
 		}
-		panic(fmt.Errorf("message zigchain.factory.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message zigchain.factory.LegacyParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -224,48 +1095,48 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LegacyParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "zigchain.factory.Params.create_fee_denom":
-		panic(fmt.Errorf("field create_fee_denom of message zigchain.factory.Params is not mutable"))
-	case "zigchain.factory.Params.create_fee_amount":
-		panic(fmt.Errorf("field create_fee_amount of message zigchain.factory.Params is not mutable"))
-	case "zigchain.factory.Params.beneficiary":
-		panic(fmt.Errorf("field beneficiary of message zigchain.factory.Params is not mutable"))
+	case "zigchain.factory.LegacyParams.create_fee_denom":
+		panic(fmt.Errorf("field create_fee_denom of message zigchain.factory.LegacyParams is not mutable"))
+	case "zigchain.factory.LegacyParams.create_fee_amount":
+		panic(fmt.Errorf("field create_fee_amount of message zigchain.factory.LegacyParams is not mutable"))
+	case "zigchain.factory.LegacyParams.beneficiary":
+		panic(fmt.Errorf("field beneficiary of message zigchain.factory.LegacyParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.LegacyParams"))
 		}
-		panic(fmt.Errorf("message zigchain.factory.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message zigchain.factory.LegacyParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LegacyParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "zigchain.factory.Params.create_fee_denom":
+	case "zigchain.factory.LegacyParams.create_fee_denom":
 		return protoreflect.ValueOfString("")
-	case "zigchain.factory.Params.create_fee_amount":
+	case "zigchain.factory.LegacyParams.create_fee_amount":
 		return protoreflect.ValueOfUint32(uint32(0))
-	case "zigchain.factory.Params.beneficiary":
+	case "zigchain.factory.LegacyParams.beneficiary":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.factory.LegacyParams"))
 		}
-		panic(fmt.Errorf("message zigchain.factory.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message zigchain.factory.LegacyParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_LegacyParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in zigchain.factory.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.factory.LegacyParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -273,7 +1144,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_LegacyParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -284,7 +1155,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_LegacyParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -296,7 +1167,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_LegacyParams) IsValid() bool {
 	return x != nil
 }
 
@@ -306,9 +1177,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_LegacyParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*LegacyParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -341,7 +1212,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*LegacyParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -390,7 +1261,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*LegacyParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -422,10 +1293,10 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LegacyParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LegacyParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -565,12 +1436,26 @@ type Params struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// createFeeDenom is the denomination of the fee to create a new factory
-	CreateFeeDenom string `protobuf:"bytes,1,opt,name=create_fee_denom,json=createFeeDenom,proto3" json:"create_fee_denom,omitempty"`
-	// createFeeAmount is the amount of the fee to create a new factory
-	CreateFeeAmount uint32 `protobuf:"varint,2,opt,name=create_fee_amount,json=createFeeAmount,proto3" json:"create_fee_amount,omitempty"`
 	// beneficiary is the address that receives the fee to create a new factory
 	Beneficiary string `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// createFee is the fee to create a new factory
+	CreateFee *v1beta1.Coin `protobuf:"bytes,4,opt,name=create_fee,json=createFee,proto3" json:"create_fee,omitempty"`
+	// distribute_to_module_accounts includes the balances of the module
+	// accounts, such as the dex pools, in the reward distributions. They are
+	// left out by default.
+	DistributeToModuleAccounts bool `protobuf:"varint,5,opt,name=distribute_to_module_accounts,json=distributeToModuleAccounts,proto3" json:"distribute_to_module_accounts,omitempty"`
+	// verified_denom_curators are the addresses appointed by governance to
+	// verify and unverify denoms in the verified denom registry, besides the
+	// authority
+	VerifiedDenomCurators []string `protobuf:"bytes,6,rep,name=verified_denom_curators,json=verifiedDenomCurators,proto3" json:"verified_denom_curators,omitempty"`
+	// max_transfer_tax_rate caps the transfer tax rate the denom admins can set
+	MaxTransferTaxRate string `protobuf:"bytes,7,opt,name=max_transfer_tax_rate,json=maxTransferTaxRate,proto3" json:"max_transfer_tax_rate,omitempty"`
+	// launch_virtual_reserve is the virtual uzig reserve of the bonding curves
+	// of the new launches
+	LaunchVirtualReserve string `protobuf:"bytes,8,opt,name=launch_virtual_reserve,json=launchVirtualReserve,proto3" json:"launch_virtual_reserve,omitempty"`
+	// launch_graduation_market_cap is the market cap in uzig at which the new
+	// launches graduate into a dex pool
+	LaunchGraduationMarketCap string `protobuf:"bytes,9,opt,name=launch_graduation_market_cap,json=launchGraduationMarketCap,proto3" json:"launch_graduation_market_cap,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -593,21 +1478,102 @@ func (*Params) Descriptor() ([]byte, []int) {
 	return file_zigchain_factory_params_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Params) GetCreateFeeDenom() string {
+func (x *Params) GetBeneficiary() string {
+	if x != nil {
+		return x.Beneficiary
+	}
+	return ""
+}
+
+func (x *Params) GetCreateFee() *v1beta1.Coin {
+	if x != nil {
+		return x.CreateFee
+	}
+	return nil
+}
+
+func (x *Params) GetDistributeToModuleAccounts() bool {
+	if x != nil {
+		return x.DistributeToModuleAccounts
+	}
+	return false
+}
+
+func (x *Params) GetVerifiedDenomCurators() []string {
+	if x != nil {
+		return x.VerifiedDenomCurators
+	}
+	return nil
+}
+
+func (x *Params) GetMaxTransferTaxRate() string {
+	if x != nil {
+		return x.MaxTransferTaxRate
+	}
+	return ""
+}
+
+func (x *Params) GetLaunchVirtualReserve() string {
+	if x != nil {
+		return x.LaunchVirtualReserve
+	}
+	return ""
+}
+
+func (x *Params) GetLaunchGraduationMarketCap() string {
+	if x != nil {
+		return x.LaunchGraduationMarketCap
+	}
+	return ""
+}
+
+// LegacyParams defines the parameters for the module before the create fee
+// was migrated to a coin, used by the v3 migration only
+type LegacyParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreateFeeDenom  string `protobuf:"bytes,1,opt,name=create_fee_denom,json=createFeeDenom,proto3" json:"create_fee_denom,omitempty"`
+	CreateFeeAmount uint32 `protobuf:"varint,2,opt,name=create_fee_amount,json=createFeeAmount,proto3" json:"create_fee_amount,omitempty"`
+	Beneficiary     string `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+}
+
+func (x *LegacyParams) Reset() {
+	*x = LegacyParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_factory_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LegacyParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegacyParams) ProtoMessage() {}
+
+// Deprecated: Use LegacyParams.ProtoReflect.Descriptor instead.
+func (*LegacyParams) Descriptor() ([]byte, []int) {
+	return file_zigchain_factory_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LegacyParams) GetCreateFeeDenom() string {
 	if x != nil {
 		return x.CreateFeeDenom
 	}
 	return ""
 }
 
-func (x *Params) GetCreateFeeAmount() uint32 {
+func (x *LegacyParams) GetCreateFeeAmount() uint32 {
 	if x != nil {
 		return x.CreateFeeAmount
 	}
 	return 0
 }
 
-func (x *Params) GetBeneficiary() string {
+func (x *LegacyParams) GetBeneficiary() string {
 	if x != nil {
 		return x.Beneficiary
 	}
@@ -622,29 +1588,70 @@ var file_zigchain_factory_params_proto_rawDesc = []byte{
 	0x10, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
 	0x79, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x01, 0x0a, 0x06, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
-	0x66, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
-	0x2a, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x46, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62,
-	0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x3a, 0x22, 0xe8,
-	0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x78, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x42, 0xa7, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x69, 0x67, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0xa2, 0x02, 0x03, 0x5a,
-	0x46, 0x58, 0xaa, 0x02, 0x10, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x46, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0xca, 0x02, 0x10, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x5c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0xe2, 0x02, 0x1c, 0x5a, 0x69, 0x67, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5c, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x3a, 0x3a, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61,
+	0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfa, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61,
+	0x72, 0x79, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x12, 0x41, 0x0a, 0x1d, 0x64, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x17, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x63, 0x75, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x15, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x43, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x69, 0x0a, 0x15,
+	0x6d, 0x61, 0x78, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x78,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x54, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x66, 0x0a, 0x16, 0x6c, 0x61, 0x75, 0x6e, 0x63,
+	0x68, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x6c, 0x61, 0x75, 0x6e, 0x63,
+	0x68, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12,
+	0x71, 0x0a, 0x1c, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x19, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x47,
+	0x72, 0x61, 0x64, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43,
+	0x61, 0x70, 0x3a, 0x22, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x7a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02,
+	0x10, 0x03, 0x22, 0x86, 0x01, 0x0a, 0x0c, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x2a, 0x0a,
+	0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x46, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e,
+	0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x42, 0xa7, 0x01, 0x0a, 0x14,
+	0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0xa2, 0x02, 0x03, 0x5a, 0x46, 0x58, 0xaa, 0x02, 0x10, 0x5a,
+	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0xca,
+	0x02, 0x10, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0xe2, 0x02, 0x1c, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x11, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -659,16 +1666,19 @@ func file_zigchain_factory_params_proto_rawDescGZIP() []byte {
 	return file_zigchain_factory_params_proto_rawDescData
 }
 
-var file_zigchain_factory_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_zigchain_factory_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_zigchain_factory_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: zigchain.factory.Params
+	(*Params)(nil),       // 0: zigchain.factory.Params
+	(*LegacyParams)(nil), // 1: zigchain.factory.LegacyParams
+	(*v1beta1.Coin)(nil), // 2: cosmos.base.v1beta1.Coin
 }
 var file_zigchain_factory_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: zigchain.factory.Params.create_fee:type_name -> cosmos.base.v1beta1.Coin
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_zigchain_factory_params_proto_init() }
@@ -689,6 +1699,18 @@ func file_zigchain_factory_params_proto_init() {
 				return nil
 			}
 		}
+		file_zigchain_factory_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LegacyParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -696,7 +1718,7 @@ func file_zigchain_factory_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_zigchain_factory_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
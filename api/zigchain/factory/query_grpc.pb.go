@@ -19,12 +19,42 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName        = "/zigchain.factory.Query/Params"
-	Query_Denom_FullMethodName         = "/zigchain.factory.Query/Denom"
-	Query_DenomAll_FullMethodName      = "/zigchain.factory.Query/DenomAll"
-	Query_DenomsByAdmin_FullMethodName = "/zigchain.factory.Query/DenomsByAdmin"
-	Query_DenomAuth_FullMethodName     = "/zigchain.factory.Query/DenomAuth"
-	Query_ListDenomAuth_FullMethodName = "/zigchain.factory.Query/ListDenomAuth"
+	Query_Params_FullMethodName                = "/zigchain.factory.Query/Params"
+	Query_Denom_FullMethodName                 = "/zigchain.factory.Query/Denom"
+	Query_DenomAll_FullMethodName              = "/zigchain.factory.Query/DenomAll"
+	Query_DenomsByAdmin_FullMethodName         = "/zigchain.factory.Query/DenomsByAdmin"
+	Query_DenomAuth_FullMethodName             = "/zigchain.factory.Query/DenomAuth"
+	Query_ListDenomAuth_FullMethodName         = "/zigchain.factory.Query/ListDenomAuth"
+	Query_BeforeSendHook_FullMethodName        = "/zigchain.factory.Query/BeforeSendHook"
+	Query_FrozenAccounts_FullMethodName        = "/zigchain.factory.Query/FrozenAccounts"
+	Query_IsFrozen_FullMethodName              = "/zigchain.factory.Query/IsFrozen"
+	Query_Minters_FullMethodName               = "/zigchain.factory.Query/Minters"
+	Query_Minter_FullMethodName                = "/zigchain.factory.Query/Minter"
+	Query_MintRateLimit_FullMethodName         = "/zigchain.factory.Query/MintRateLimit"
+	Query_VestingGrants_FullMethodName         = "/zigchain.factory.Query/VestingGrants"
+	Query_VestingGrant_FullMethodName          = "/zigchain.factory.Query/VestingGrant"
+	Query_OutstandingVesting_FullMethodName    = "/zigchain.factory.Query/OutstandingVesting"
+	Query_Airdrops_FullMethodName              = "/zigchain.factory.Query/Airdrops"
+	Query_Airdrop_FullMethodName               = "/zigchain.factory.Query/Airdrop"
+	Query_AirdropClaimed_FullMethodName        = "/zigchain.factory.Query/AirdropClaimed"
+	Query_Snapshots_FullMethodName             = "/zigchain.factory.Query/Snapshots"
+	Query_BalanceAtSnapshot_FullMethodName     = "/zigchain.factory.Query/BalanceAtSnapshot"
+	Query_SupplyAtSnapshot_FullMethodName      = "/zigchain.factory.Query/SupplyAtSnapshot"
+	Query_RewardPools_FullMethodName           = "/zigchain.factory.Query/RewardPools"
+	Query_PendingRewards_FullMethodName        = "/zigchain.factory.Query/PendingRewards"
+	Query_CW20Bridges_FullMethodName           = "/zigchain.factory.Query/CW20Bridges"
+	Query_CW20Bridge_FullMethodName            = "/zigchain.factory.Query/CW20Bridge"
+	Query_CW20BridgeByDenom_FullMethodName     = "/zigchain.factory.Query/CW20BridgeByDenom"
+	Query_DenomRoles_FullMethodName            = "/zigchain.factory.Query/DenomRoles"
+	Query_DenomAdminProposals_FullMethodName   = "/zigchain.factory.Query/DenomAdminProposals"
+	Query_DenomAdminProposal_FullMethodName    = "/zigchain.factory.Query/DenomAdminProposal"
+	Query_DenomAdminTimelock_FullMethodName    = "/zigchain.factory.Query/DenomAdminTimelock"
+	Query_VerifiedDenoms_FullMethodName        = "/zigchain.factory.Query/VerifiedDenoms"
+	Query_VerifiedDenomByTicker_FullMethodName = "/zigchain.factory.Query/VerifiedDenomByTicker"
+	Query_TransferTax_FullMethodName           = "/zigchain.factory.Query/TransferTax"
+	Query_TransferTaxExemptions_FullMethodName = "/zigchain.factory.Query/TransferTaxExemptions"
+	Query_TokenLaunches_FullMethodName         = "/zigchain.factory.Query/TokenLaunches"
+	Query_TokenLaunch_FullMethodName           = "/zigchain.factory.Query/TokenLaunch"
 )
 
 // QueryClient is the client API for Query service.
@@ -46,6 +76,70 @@ type QueryClient interface {
 	// QueryListDenomAuth queries a list of DenomAuth items.
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	ListDenomAuth(ctx context.Context, in *QueryAllDenomAuthRequest, opts ...grpc.CallOption) (*QueryAllDenomAuthResponse, error)
+	// QueryBeforeSendHook queries the before send hook of a denom.
+	BeforeSendHook(ctx context.Context, in *QueryBeforeSendHookRequest, opts ...grpc.CallOption) (*QueryBeforeSendHookResponse, error)
+	// FrozenAccounts lists the frozen accounts of a denom
+	FrozenAccounts(ctx context.Context, in *QueryFrozenAccountsRequest, opts ...grpc.CallOption) (*QueryFrozenAccountsResponse, error)
+	// IsFrozen tells if an account can not send nor receive a denom
+	IsFrozen(ctx context.Context, in *QueryIsFrozenRequest, opts ...grpc.CallOption) (*QueryIsFrozenResponse, error)
+	// Minters lists the minters of a denom with their remaining allowance
+	Minters(ctx context.Context, in *QueryMintersRequest, opts ...grpc.CallOption) (*QueryMintersResponse, error)
+	// Minter returns the remaining allowance of a minter of a denom
+	Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error)
+	// MintRateLimit returns the mint rate limit of a denom with the amount that
+	// can still be minted in the current window
+	MintRateLimit(ctx context.Context, in *QueryMintRateLimitRequest, opts ...grpc.CallOption) (*QueryMintRateLimitResponse, error)
+	// VestingGrants lists the vesting grants of a denom
+	VestingGrants(ctx context.Context, in *QueryVestingGrantsRequest, opts ...grpc.CallOption) (*QueryVestingGrantsResponse, error)
+	// VestingGrant returns a vesting grant of a denom with its vested amount
+	VestingGrant(ctx context.Context, in *QueryVestingGrantRequest, opts ...grpc.CallOption) (*QueryVestingGrantResponse, error)
+	// OutstandingVesting returns the amount of a denom that has not vested yet
+	// across all its grants
+	OutstandingVesting(ctx context.Context, in *QueryOutstandingVestingRequest, opts ...grpc.CallOption) (*QueryOutstandingVestingResponse, error)
+	// Airdrops lists the open airdrops
+	Airdrops(ctx context.Context, in *QueryAirdropsRequest, opts ...grpc.CallOption) (*QueryAirdropsResponse, error)
+	// Airdrop returns an open airdrop
+	Airdrop(ctx context.Context, in *QueryAirdropRequest, opts ...grpc.CallOption) (*QueryAirdropResponse, error)
+	// AirdropClaimed returns whether an address has claimed an airdrop
+	AirdropClaimed(ctx context.Context, in *QueryAirdropClaimedRequest, opts ...grpc.CallOption) (*QueryAirdropClaimedResponse, error)
+	// Snapshots lists the snapshots of a denom
+	Snapshots(ctx context.Context, in *QuerySnapshotsRequest, opts ...grpc.CallOption) (*QuerySnapshotsResponse, error)
+	// BalanceAtSnapshot returns the balance of an account at a snapshot
+	BalanceAtSnapshot(ctx context.Context, in *QueryBalanceAtSnapshotRequest, opts ...grpc.CallOption) (*QueryBalanceAtSnapshotResponse, error)
+	// SupplyAtSnapshot returns the supply of a denom at a snapshot
+	SupplyAtSnapshot(ctx context.Context, in *QuerySupplyAtSnapshotRequest, opts ...grpc.CallOption) (*QuerySupplyAtSnapshotResponse, error)
+	// RewardPools lists the reward pools of a denom
+	RewardPools(ctx context.Context, in *QueryRewardPoolsRequest, opts ...grpc.CallOption) (*QueryRewardPoolsResponse, error)
+	// PendingRewards returns the rewards a holder of a denom can claim
+	PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error)
+	// CW20Bridges lists the registered CW20 bridges
+	CW20Bridges(ctx context.Context, in *QueryCW20BridgesRequest, opts ...grpc.CallOption) (*QueryCW20BridgesResponse, error)
+	// CW20Bridge returns the bridge of a CW20 contract
+	CW20Bridge(ctx context.Context, in *QueryCW20BridgeRequest, opts ...grpc.CallOption) (*QueryCW20BridgeResponse, error)
+	// CW20BridgeByDenom returns the bridge of a factory denom
+	CW20BridgeByDenom(ctx context.Context, in *QueryCW20BridgeByDenomRequest, opts ...grpc.CallOption) (*QueryCW20BridgeResponse, error)
+	// DenomRoles lists the holders of each role of a denom
+	DenomRoles(ctx context.Context, in *QueryDenomRolesRequest, opts ...grpc.CallOption) (*QueryDenomRolesResponse, error)
+	// DenomAdminProposals lists the pending admin proposals
+	DenomAdminProposals(ctx context.Context, in *QueryDenomAdminProposalsRequest, opts ...grpc.CallOption) (*QueryDenomAdminProposalsResponse, error)
+	// DenomAdminProposal returns the pending admin proposal of a denom
+	DenomAdminProposal(ctx context.Context, in *QueryDenomAdminProposalRequest, opts ...grpc.CallOption) (*QueryDenomAdminProposalResponse, error)
+	// DenomAdminTimelock returns the admin timelock of a denom
+	DenomAdminTimelock(ctx context.Context, in *QueryDenomAdminTimelockRequest, opts ...grpc.CallOption) (*QueryDenomAdminTimelockResponse, error)
+	// VerifiedDenoms returns the denoms of the verified denom registry
+	VerifiedDenoms(ctx context.Context, in *QueryVerifiedDenomsRequest, opts ...grpc.CallOption) (*QueryVerifiedDenomsResponse, error)
+	// VerifiedDenomByTicker returns the verified denom of a ticker
+	VerifiedDenomByTicker(ctx context.Context, in *QueryVerifiedDenomByTickerRequest, opts ...grpc.CallOption) (*QueryVerifiedDenomResponse, error)
+	// TransferTax returns the transfer tax of a denom
+	TransferTax(ctx context.Context, in *QueryTransferTaxRequest, opts ...grpc.CallOption) (*QueryTransferTaxResponse, error)
+	// TransferTaxExemptions returns the addresses exempted from the transfer tax
+	// of a denom
+	TransferTaxExemptions(ctx context.Context, in *QueryTransferTaxExemptionsRequest, opts ...grpc.CallOption) (*QueryTransferTaxExemptionsResponse, error)
+	// TokenLaunches returns the launches of the launchpad
+	TokenLaunches(ctx context.Context, in *QueryTokenLaunchesRequest, opts ...grpc.CallOption) (*QueryTokenLaunchesResponse, error)
+	// TokenLaunch returns the launch of a denom with its current price and
+	// market cap
+	TokenLaunch(ctx context.Context, in *QueryTokenLaunchRequest, opts ...grpc.CallOption) (*QueryTokenLaunchResponse, error)
 }
 
 type queryClient struct {
@@ -116,6 +210,306 @@ func (c *queryClient) ListDenomAuth(ctx context.Context, in *QueryAllDenomAuthRe
 	return out, nil
 }
 
+func (c *queryClient) BeforeSendHook(ctx context.Context, in *QueryBeforeSendHookRequest, opts ...grpc.CallOption) (*QueryBeforeSendHookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBeforeSendHookResponse)
+	err := c.cc.Invoke(ctx, Query_BeforeSendHook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenAccounts(ctx context.Context, in *QueryFrozenAccountsRequest, opts ...grpc.CallOption) (*QueryFrozenAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFrozenAccountsResponse)
+	err := c.cc.Invoke(ctx, Query_FrozenAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IsFrozen(ctx context.Context, in *QueryIsFrozenRequest, opts ...grpc.CallOption) (*QueryIsFrozenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIsFrozenResponse)
+	err := c.cc.Invoke(ctx, Query_IsFrozen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Minters(ctx context.Context, in *QueryMintersRequest, opts ...grpc.CallOption) (*QueryMintersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMintersResponse)
+	err := c.cc.Invoke(ctx, Query_Minters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMinterResponse)
+	err := c.cc.Invoke(ctx, Query_Minter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintRateLimit(ctx context.Context, in *QueryMintRateLimitRequest, opts ...grpc.CallOption) (*QueryMintRateLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMintRateLimitResponse)
+	err := c.cc.Invoke(ctx, Query_MintRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingGrants(ctx context.Context, in *QueryVestingGrantsRequest, opts ...grpc.CallOption) (*QueryVestingGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryVestingGrantsResponse)
+	err := c.cc.Invoke(ctx, Query_VestingGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingGrant(ctx context.Context, in *QueryVestingGrantRequest, opts ...grpc.CallOption) (*QueryVestingGrantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryVestingGrantResponse)
+	err := c.cc.Invoke(ctx, Query_VestingGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OutstandingVesting(ctx context.Context, in *QueryOutstandingVestingRequest, opts ...grpc.CallOption) (*QueryOutstandingVestingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryOutstandingVestingResponse)
+	err := c.cc.Invoke(ctx, Query_OutstandingVesting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Airdrops(ctx context.Context, in *QueryAirdropsRequest, opts ...grpc.CallOption) (*QueryAirdropsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAirdropsResponse)
+	err := c.cc.Invoke(ctx, Query_Airdrops_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Airdrop(ctx context.Context, in *QueryAirdropRequest, opts ...grpc.CallOption) (*QueryAirdropResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAirdropResponse)
+	err := c.cc.Invoke(ctx, Query_Airdrop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AirdropClaimed(ctx context.Context, in *QueryAirdropClaimedRequest, opts ...grpc.CallOption) (*QueryAirdropClaimedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAirdropClaimedResponse)
+	err := c.cc.Invoke(ctx, Query_AirdropClaimed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Snapshots(ctx context.Context, in *QuerySnapshotsRequest, opts ...grpc.CallOption) (*QuerySnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySnapshotsResponse)
+	err := c.cc.Invoke(ctx, Query_Snapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BalanceAtSnapshot(ctx context.Context, in *QueryBalanceAtSnapshotRequest, opts ...grpc.CallOption) (*QueryBalanceAtSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBalanceAtSnapshotResponse)
+	err := c.cc.Invoke(ctx, Query_BalanceAtSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyAtSnapshot(ctx context.Context, in *QuerySupplyAtSnapshotRequest, opts ...grpc.CallOption) (*QuerySupplyAtSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySupplyAtSnapshotResponse)
+	err := c.cc.Invoke(ctx, Query_SupplyAtSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardPools(ctx context.Context, in *QueryRewardPoolsRequest, opts ...grpc.CallOption) (*QueryRewardPoolsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRewardPoolsResponse)
+	err := c.cc.Invoke(ctx, Query_RewardPools_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CW20Bridges(ctx context.Context, in *QueryCW20BridgesRequest, opts ...grpc.CallOption) (*QueryCW20BridgesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryCW20BridgesResponse)
+	err := c.cc.Invoke(ctx, Query_CW20Bridges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CW20Bridge(ctx context.Context, in *QueryCW20BridgeRequest, opts ...grpc.CallOption) (*QueryCW20BridgeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryCW20BridgeResponse)
+	err := c.cc.Invoke(ctx, Query_CW20Bridge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CW20BridgeByDenom(ctx context.Context, in *QueryCW20BridgeByDenomRequest, opts ...grpc.CallOption) (*QueryCW20BridgeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryCW20BridgeResponse)
+	err := c.cc.Invoke(ctx, Query_CW20BridgeByDenom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomRoles(ctx context.Context, in *QueryDenomRolesRequest, opts ...grpc.CallOption) (*QueryDenomRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDenomRolesResponse)
+	err := c.cc.Invoke(ctx, Query_DenomRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomAdminProposals(ctx context.Context, in *QueryDenomAdminProposalsRequest, opts ...grpc.CallOption) (*QueryDenomAdminProposalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDenomAdminProposalsResponse)
+	err := c.cc.Invoke(ctx, Query_DenomAdminProposals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomAdminProposal(ctx context.Context, in *QueryDenomAdminProposalRequest, opts ...grpc.CallOption) (*QueryDenomAdminProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDenomAdminProposalResponse)
+	err := c.cc.Invoke(ctx, Query_DenomAdminProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomAdminTimelock(ctx context.Context, in *QueryDenomAdminTimelockRequest, opts ...grpc.CallOption) (*QueryDenomAdminTimelockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDenomAdminTimelockResponse)
+	err := c.cc.Invoke(ctx, Query_DenomAdminTimelock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifiedDenoms(ctx context.Context, in *QueryVerifiedDenomsRequest, opts ...grpc.CallOption) (*QueryVerifiedDenomsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryVerifiedDenomsResponse)
+	err := c.cc.Invoke(ctx, Query_VerifiedDenoms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifiedDenomByTicker(ctx context.Context, in *QueryVerifiedDenomByTickerRequest, opts ...grpc.CallOption) (*QueryVerifiedDenomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryVerifiedDenomResponse)
+	err := c.cc.Invoke(ctx, Query_VerifiedDenomByTicker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferTax(ctx context.Context, in *QueryTransferTaxRequest, opts ...grpc.CallOption) (*QueryTransferTaxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTransferTaxResponse)
+	err := c.cc.Invoke(ctx, Query_TransferTax_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferTaxExemptions(ctx context.Context, in *QueryTransferTaxExemptionsRequest, opts ...grpc.CallOption) (*QueryTransferTaxExemptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTransferTaxExemptionsResponse)
+	err := c.cc.Invoke(ctx, Query_TransferTaxExemptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenLaunches(ctx context.Context, in *QueryTokenLaunchesRequest, opts ...grpc.CallOption) (*QueryTokenLaunchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTokenLaunchesResponse)
+	err := c.cc.Invoke(ctx, Query_TokenLaunches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenLaunch(ctx context.Context, in *QueryTokenLaunchRequest, opts ...grpc.CallOption) (*QueryTokenLaunchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTokenLaunchResponse)
+	err := c.cc.Invoke(ctx, Query_TokenLaunch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -135,6 +529,70 @@ type QueryServer interface {
 	// QueryListDenomAuth queries a list of DenomAuth items.
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	ListDenomAuth(context.Context, *QueryAllDenomAuthRequest) (*QueryAllDenomAuthResponse, error)
+	// QueryBeforeSendHook queries the before send hook of a denom.
+	BeforeSendHook(context.Context, *QueryBeforeSendHookRequest) (*QueryBeforeSendHookResponse, error)
+	// FrozenAccounts lists the frozen accounts of a denom
+	FrozenAccounts(context.Context, *QueryFrozenAccountsRequest) (*QueryFrozenAccountsResponse, error)
+	// IsFrozen tells if an account can not send nor receive a denom
+	IsFrozen(context.Context, *QueryIsFrozenRequest) (*QueryIsFrozenResponse, error)
+	// Minters lists the minters of a denom with their remaining allowance
+	Minters(context.Context, *QueryMintersRequest) (*QueryMintersResponse, error)
+	// Minter returns the remaining allowance of a minter of a denom
+	Minter(context.Context, *QueryMinterRequest) (*QueryMinterResponse, error)
+	// MintRateLimit returns the mint rate limit of a denom with the amount that
+	// can still be minted in the current window
+	MintRateLimit(context.Context, *QueryMintRateLimitRequest) (*QueryMintRateLimitResponse, error)
+	// VestingGrants lists the vesting grants of a denom
+	VestingGrants(context.Context, *QueryVestingGrantsRequest) (*QueryVestingGrantsResponse, error)
+	// VestingGrant returns a vesting grant of a denom with its vested amount
+	VestingGrant(context.Context, *QueryVestingGrantRequest) (*QueryVestingGrantResponse, error)
+	// OutstandingVesting returns the amount of a denom that has not vested yet
+	// across all its grants
+	OutstandingVesting(context.Context, *QueryOutstandingVestingRequest) (*QueryOutstandingVestingResponse, error)
+	// Airdrops lists the open airdrops
+	Airdrops(context.Context, *QueryAirdropsRequest) (*QueryAirdropsResponse, error)
+	// Airdrop returns an open airdrop
+	Airdrop(context.Context, *QueryAirdropRequest) (*QueryAirdropResponse, error)
+	// AirdropClaimed returns whether an address has claimed an airdrop
+	AirdropClaimed(context.Context, *QueryAirdropClaimedRequest) (*QueryAirdropClaimedResponse, error)
+	// Snapshots lists the snapshots of a denom
+	Snapshots(context.Context, *QuerySnapshotsRequest) (*QuerySnapshotsResponse, error)
+	// BalanceAtSnapshot returns the balance of an account at a snapshot
+	BalanceAtSnapshot(context.Context, *QueryBalanceAtSnapshotRequest) (*QueryBalanceAtSnapshotResponse, error)
+	// SupplyAtSnapshot returns the supply of a denom at a snapshot
+	SupplyAtSnapshot(context.Context, *QuerySupplyAtSnapshotRequest) (*QuerySupplyAtSnapshotResponse, error)
+	// RewardPools lists the reward pools of a denom
+	RewardPools(context.Context, *QueryRewardPoolsRequest) (*QueryRewardPoolsResponse, error)
+	// PendingRewards returns the rewards a holder of a denom can claim
+	PendingRewards(context.Context, *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error)
+	// CW20Bridges lists the registered CW20 bridges
+	CW20Bridges(context.Context, *QueryCW20BridgesRequest) (*QueryCW20BridgesResponse, error)
+	// CW20Bridge returns the bridge of a CW20 contract
+	CW20Bridge(context.Context, *QueryCW20BridgeRequest) (*QueryCW20BridgeResponse, error)
+	// CW20BridgeByDenom returns the bridge of a factory denom
+	CW20BridgeByDenom(context.Context, *QueryCW20BridgeByDenomRequest) (*QueryCW20BridgeResponse, error)
+	// DenomRoles lists the holders of each role of a denom
+	DenomRoles(context.Context, *QueryDenomRolesRequest) (*QueryDenomRolesResponse, error)
+	// DenomAdminProposals lists the pending admin proposals
+	DenomAdminProposals(context.Context, *QueryDenomAdminProposalsRequest) (*QueryDenomAdminProposalsResponse, error)
+	// DenomAdminProposal returns the pending admin proposal of a denom
+	DenomAdminProposal(context.Context, *QueryDenomAdminProposalRequest) (*QueryDenomAdminProposalResponse, error)
+	// DenomAdminTimelock returns the admin timelock of a denom
+	DenomAdminTimelock(context.Context, *QueryDenomAdminTimelockRequest) (*QueryDenomAdminTimelockResponse, error)
+	// VerifiedDenoms returns the denoms of the verified denom registry
+	VerifiedDenoms(context.Context, *QueryVerifiedDenomsRequest) (*QueryVerifiedDenomsResponse, error)
+	// VerifiedDenomByTicker returns the verified denom of a ticker
+	VerifiedDenomByTicker(context.Context, *QueryVerifiedDenomByTickerRequest) (*QueryVerifiedDenomResponse, error)
+	// TransferTax returns the transfer tax of a denom
+	TransferTax(context.Context, *QueryTransferTaxRequest) (*QueryTransferTaxResponse, error)
+	// TransferTaxExemptions returns the addresses exempted from the transfer tax
+	// of a denom
+	TransferTaxExemptions(context.Context, *QueryTransferTaxExemptionsRequest) (*QueryTransferTaxExemptionsResponse, error)
+	// TokenLaunches returns the launches of the launchpad
+	TokenLaunches(context.Context, *QueryTokenLaunchesRequest) (*QueryTokenLaunchesResponse, error)
+	// TokenLaunch returns the launch of a denom with its current price and
+	// market cap
+	TokenLaunch(context.Context, *QueryTokenLaunchRequest) (*QueryTokenLaunchResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -163,6 +621,96 @@ func (UnimplementedQueryServer) DenomAuth(context.Context, *QueryGetDenomAuthReq
 func (UnimplementedQueryServer) ListDenomAuth(context.Context, *QueryAllDenomAuthRequest) (*QueryAllDenomAuthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDenomAuth not implemented")
 }
+func (UnimplementedQueryServer) BeforeSendHook(context.Context, *QueryBeforeSendHookRequest) (*QueryBeforeSendHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeforeSendHook not implemented")
+}
+func (UnimplementedQueryServer) FrozenAccounts(context.Context, *QueryFrozenAccountsRequest) (*QueryFrozenAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenAccounts not implemented")
+}
+func (UnimplementedQueryServer) IsFrozen(context.Context, *QueryIsFrozenRequest) (*QueryIsFrozenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsFrozen not implemented")
+}
+func (UnimplementedQueryServer) Minters(context.Context, *QueryMintersRequest) (*QueryMintersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Minters not implemented")
+}
+func (UnimplementedQueryServer) Minter(context.Context, *QueryMinterRequest) (*QueryMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Minter not implemented")
+}
+func (UnimplementedQueryServer) MintRateLimit(context.Context, *QueryMintRateLimitRequest) (*QueryMintRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintRateLimit not implemented")
+}
+func (UnimplementedQueryServer) VestingGrants(context.Context, *QueryVestingGrantsRequest) (*QueryVestingGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingGrants not implemented")
+}
+func (UnimplementedQueryServer) VestingGrant(context.Context, *QueryVestingGrantRequest) (*QueryVestingGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingGrant not implemented")
+}
+func (UnimplementedQueryServer) OutstandingVesting(context.Context, *QueryOutstandingVestingRequest) (*QueryOutstandingVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutstandingVesting not implemented")
+}
+func (UnimplementedQueryServer) Airdrops(context.Context, *QueryAirdropsRequest) (*QueryAirdropsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Airdrops not implemented")
+}
+func (UnimplementedQueryServer) Airdrop(context.Context, *QueryAirdropRequest) (*QueryAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Airdrop not implemented")
+}
+func (UnimplementedQueryServer) AirdropClaimed(context.Context, *QueryAirdropClaimedRequest) (*QueryAirdropClaimedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AirdropClaimed not implemented")
+}
+func (UnimplementedQueryServer) Snapshots(context.Context, *QuerySnapshotsRequest) (*QuerySnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Snapshots not implemented")
+}
+func (UnimplementedQueryServer) BalanceAtSnapshot(context.Context, *QueryBalanceAtSnapshotRequest) (*QueryBalanceAtSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceAtSnapshot not implemented")
+}
+func (UnimplementedQueryServer) SupplyAtSnapshot(context.Context, *QuerySupplyAtSnapshotRequest) (*QuerySupplyAtSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyAtSnapshot not implemented")
+}
+func (UnimplementedQueryServer) RewardPools(context.Context, *QueryRewardPoolsRequest) (*QueryRewardPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPools not implemented")
+}
+func (UnimplementedQueryServer) PendingRewards(context.Context, *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRewards not implemented")
+}
+func (UnimplementedQueryServer) CW20Bridges(context.Context, *QueryCW20BridgesRequest) (*QueryCW20BridgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CW20Bridges not implemented")
+}
+func (UnimplementedQueryServer) CW20Bridge(context.Context, *QueryCW20BridgeRequest) (*QueryCW20BridgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CW20Bridge not implemented")
+}
+func (UnimplementedQueryServer) CW20BridgeByDenom(context.Context, *QueryCW20BridgeByDenomRequest) (*QueryCW20BridgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CW20BridgeByDenom not implemented")
+}
+func (UnimplementedQueryServer) DenomRoles(context.Context, *QueryDenomRolesRequest) (*QueryDenomRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomRoles not implemented")
+}
+func (UnimplementedQueryServer) DenomAdminProposals(context.Context, *QueryDenomAdminProposalsRequest) (*QueryDenomAdminProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomAdminProposals not implemented")
+}
+func (UnimplementedQueryServer) DenomAdminProposal(context.Context, *QueryDenomAdminProposalRequest) (*QueryDenomAdminProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomAdminProposal not implemented")
+}
+func (UnimplementedQueryServer) DenomAdminTimelock(context.Context, *QueryDenomAdminTimelockRequest) (*QueryDenomAdminTimelockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomAdminTimelock not implemented")
+}
+func (UnimplementedQueryServer) VerifiedDenoms(context.Context, *QueryVerifiedDenomsRequest) (*QueryVerifiedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifiedDenoms not implemented")
+}
+func (UnimplementedQueryServer) VerifiedDenomByTicker(context.Context, *QueryVerifiedDenomByTickerRequest) (*QueryVerifiedDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifiedDenomByTicker not implemented")
+}
+func (UnimplementedQueryServer) TransferTax(context.Context, *QueryTransferTaxRequest) (*QueryTransferTaxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTax not implemented")
+}
+func (UnimplementedQueryServer) TransferTaxExemptions(context.Context, *QueryTransferTaxExemptionsRequest) (*QueryTransferTaxExemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTaxExemptions not implemented")
+}
+func (UnimplementedQueryServer) TokenLaunches(context.Context, *QueryTokenLaunchesRequest) (*QueryTokenLaunchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenLaunches not implemented")
+}
+func (UnimplementedQueryServer) TokenLaunch(context.Context, *QueryTokenLaunchRequest) (*QueryTokenLaunchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenLaunch not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -292,22 +840,562 @@ func _Query_ListDenomAuth_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Query_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "zigchain.factory.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Denom",
-			Handler:    _Query_Denom_Handler,
-		},
-		{
+func _Query_BeforeSendHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBeforeSendHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BeforeSendHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BeforeSendHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BeforeSendHook(ctx, req.(*QueryBeforeSendHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FrozenAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenAccounts(ctx, req.(*QueryFrozenAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsFrozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsFrozenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsFrozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IsFrozen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsFrozen(ctx, req.(*QueryIsFrozenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Minters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Minters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Minters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Minters(ctx, req.(*QueryMintersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Minter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Minter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Minter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Minter(ctx, req.(*QueryMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintRateLimit(ctx, req.(*QueryMintRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VestingGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingGrants(ctx, req.(*QueryVestingGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VestingGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingGrant(ctx, req.(*QueryVestingGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutstandingVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutstandingVestingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutstandingVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OutstandingVesting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutstandingVesting(ctx, req.(*QueryOutstandingVestingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Airdrops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAirdropsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Airdrops(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Airdrops_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Airdrops(ctx, req.(*QueryAirdropsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Airdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAirdropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Airdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Airdrop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Airdrop(ctx, req.(*QueryAirdropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AirdropClaimed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAirdropClaimedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AirdropClaimed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AirdropClaimed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AirdropClaimed(ctx, req.(*QueryAirdropClaimedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Snapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Snapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Snapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Snapshots(ctx, req.(*QuerySnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BalanceAtSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceAtSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalanceAtSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BalanceAtSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalanceAtSnapshot(ctx, req.(*QueryBalanceAtSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyAtSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyAtSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyAtSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SupplyAtSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyAtSnapshot(ctx, req.(*QuerySupplyAtSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RewardPools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPools(ctx, req.(*QueryRewardPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRewards(ctx, req.(*QueryPendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CW20Bridges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCW20BridgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CW20Bridges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CW20Bridges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CW20Bridges(ctx, req.(*QueryCW20BridgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CW20Bridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCW20BridgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CW20Bridge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CW20Bridge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CW20Bridge(ctx, req.(*QueryCW20BridgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CW20BridgeByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCW20BridgeByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CW20BridgeByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CW20BridgeByDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CW20BridgeByDenom(ctx, req.(*QueryCW20BridgeByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomRoles(ctx, req.(*QueryDenomRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomAdminProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomAdminProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomAdminProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomAdminProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomAdminProposals(ctx, req.(*QueryDenomAdminProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomAdminProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomAdminProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomAdminProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomAdminProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomAdminProposal(ctx, req.(*QueryDenomAdminProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomAdminTimelock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomAdminTimelockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomAdminTimelock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomAdminTimelock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomAdminTimelock(ctx, req.(*QueryDenomAdminTimelockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifiedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifiedDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifiedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VerifiedDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifiedDenoms(ctx, req.(*QueryVerifiedDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifiedDenomByTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifiedDenomByTickerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifiedDenomByTicker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VerifiedDenomByTicker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifiedDenomByTicker(ctx, req.(*QueryVerifiedDenomByTickerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferTax_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferTaxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferTax(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransferTax_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferTax(ctx, req.(*QueryTransferTaxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferTaxExemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferTaxExemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferTaxExemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransferTaxExemptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferTaxExemptions(ctx, req.(*QueryTransferTaxExemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenLaunches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenLaunchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenLaunches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenLaunches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenLaunches(ctx, req.(*QueryTokenLaunchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenLaunch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenLaunchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenLaunch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenLaunch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenLaunch(ctx, req.(*QueryTokenLaunchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "zigchain.factory.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Denom",
+			Handler:    _Query_Denom_Handler,
+		},
+		{
 			MethodName: "DenomAll",
 			Handler:    _Query_DenomAll_Handler,
 		},
@@ -323,6 +1411,126 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDenomAuth",
 			Handler:    _Query_ListDenomAuth_Handler,
 		},
+		{
+			MethodName: "BeforeSendHook",
+			Handler:    _Query_BeforeSendHook_Handler,
+		},
+		{
+			MethodName: "FrozenAccounts",
+			Handler:    _Query_FrozenAccounts_Handler,
+		},
+		{
+			MethodName: "IsFrozen",
+			Handler:    _Query_IsFrozen_Handler,
+		},
+		{
+			MethodName: "Minters",
+			Handler:    _Query_Minters_Handler,
+		},
+		{
+			MethodName: "Minter",
+			Handler:    _Query_Minter_Handler,
+		},
+		{
+			MethodName: "MintRateLimit",
+			Handler:    _Query_MintRateLimit_Handler,
+		},
+		{
+			MethodName: "VestingGrants",
+			Handler:    _Query_VestingGrants_Handler,
+		},
+		{
+			MethodName: "VestingGrant",
+			Handler:    _Query_VestingGrant_Handler,
+		},
+		{
+			MethodName: "OutstandingVesting",
+			Handler:    _Query_OutstandingVesting_Handler,
+		},
+		{
+			MethodName: "Airdrops",
+			Handler:    _Query_Airdrops_Handler,
+		},
+		{
+			MethodName: "Airdrop",
+			Handler:    _Query_Airdrop_Handler,
+		},
+		{
+			MethodName: "AirdropClaimed",
+			Handler:    _Query_AirdropClaimed_Handler,
+		},
+		{
+			MethodName: "Snapshots",
+			Handler:    _Query_Snapshots_Handler,
+		},
+		{
+			MethodName: "BalanceAtSnapshot",
+			Handler:    _Query_BalanceAtSnapshot_Handler,
+		},
+		{
+			MethodName: "SupplyAtSnapshot",
+			Handler:    _Query_SupplyAtSnapshot_Handler,
+		},
+		{
+			MethodName: "RewardPools",
+			Handler:    _Query_RewardPools_Handler,
+		},
+		{
+			MethodName: "PendingRewards",
+			Handler:    _Query_PendingRewards_Handler,
+		},
+		{
+			MethodName: "CW20Bridges",
+			Handler:    _Query_CW20Bridges_Handler,
+		},
+		{
+			MethodName: "CW20Bridge",
+			Handler:    _Query_CW20Bridge_Handler,
+		},
+		{
+			MethodName: "CW20BridgeByDenom",
+			Handler:    _Query_CW20BridgeByDenom_Handler,
+		},
+		{
+			MethodName: "DenomRoles",
+			Handler:    _Query_DenomRoles_Handler,
+		},
+		{
+			MethodName: "DenomAdminProposals",
+			Handler:    _Query_DenomAdminProposals_Handler,
+		},
+		{
+			MethodName: "DenomAdminProposal",
+			Handler:    _Query_DenomAdminProposal_Handler,
+		},
+		{
+			MethodName: "DenomAdminTimelock",
+			Handler:    _Query_DenomAdminTimelock_Handler,
+		},
+		{
+			MethodName: "VerifiedDenoms",
+			Handler:    _Query_VerifiedDenoms_Handler,
+		},
+		{
+			MethodName: "VerifiedDenomByTicker",
+			Handler:    _Query_VerifiedDenomByTicker_Handler,
+		},
+		{
+			MethodName: "TransferTax",
+			Handler:    _Query_TransferTax_Handler,
+		},
+		{
+			MethodName: "TransferTaxExemptions",
+			Handler:    _Query_TransferTaxExemptions_Handler,
+		},
+		{
+			MethodName: "TokenLaunches",
+			Handler:    _Query_TokenLaunches_Handler,
+		},
+		{
+			MethodName: "TokenLaunch",
+			Handler:    _Query_TokenLaunch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zigchain/factory/query.proto",
@@ -21,18 +21,49 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_UpdateParams_FullMethodName            = "/zigchain.factory.Msg/UpdateParams"
-	Msg_CreateDenom_FullMethodName             = "/zigchain.factory.Msg/CreateDenom"
-	Msg_SetDenomMetadata_FullMethodName        = "/zigchain.factory.Msg/SetDenomMetadata"
-	Msg_UpdateDenomURI_FullMethodName          = "/zigchain.factory.Msg/UpdateDenomURI"
-	Msg_UpdateDenomMintingCap_FullMethodName   = "/zigchain.factory.Msg/UpdateDenomMintingCap"
-	Msg_UpdateDenomMetadataAuth_FullMethodName = "/zigchain.factory.Msg/UpdateDenomMetadataAuth"
-	Msg_MintAndSendTokens_FullMethodName       = "/zigchain.factory.Msg/MintAndSendTokens"
-	Msg_BurnTokens_FullMethodName              = "/zigchain.factory.Msg/BurnTokens"
-	Msg_WithdrawModuleFees_FullMethodName      = "/zigchain.factory.Msg/WithdrawModuleFees"
-	Msg_ProposeDenomAdmin_FullMethodName       = "/zigchain.factory.Msg/ProposeDenomAdmin"
-	Msg_ClaimDenomAdmin_FullMethodName         = "/zigchain.factory.Msg/ClaimDenomAdmin"
-	Msg_DisableDenomAdmin_FullMethodName       = "/zigchain.factory.Msg/DisableDenomAdmin"
+	Msg_UpdateParams_FullMethodName             = "/zigchain.factory.Msg/UpdateParams"
+	Msg_CreateDenom_FullMethodName              = "/zigchain.factory.Msg/CreateDenom"
+	Msg_SetDenomMetadata_FullMethodName         = "/zigchain.factory.Msg/SetDenomMetadata"
+	Msg_UpdateDenomURI_FullMethodName           = "/zigchain.factory.Msg/UpdateDenomURI"
+	Msg_UpdateDenomMintingCap_FullMethodName    = "/zigchain.factory.Msg/UpdateDenomMintingCap"
+	Msg_UpdateDenomMetadataAuth_FullMethodName  = "/zigchain.factory.Msg/UpdateDenomMetadataAuth"
+	Msg_MintAndSendTokens_FullMethodName        = "/zigchain.factory.Msg/MintAndSendTokens"
+	Msg_BurnTokens_FullMethodName               = "/zigchain.factory.Msg/BurnTokens"
+	Msg_WithdrawModuleFees_FullMethodName       = "/zigchain.factory.Msg/WithdrawModuleFees"
+	Msg_ProposeDenomAdmin_FullMethodName        = "/zigchain.factory.Msg/ProposeDenomAdmin"
+	Msg_ClaimDenomAdmin_FullMethodName          = "/zigchain.factory.Msg/ClaimDenomAdmin"
+	Msg_DisableDenomAdmin_FullMethodName        = "/zigchain.factory.Msg/DisableDenomAdmin"
+	Msg_SetBeforeSendHook_FullMethodName        = "/zigchain.factory.Msg/SetBeforeSendHook"
+	Msg_FreezeAccount_FullMethodName            = "/zigchain.factory.Msg/FreezeAccount"
+	Msg_UnfreezeAccount_FullMethodName          = "/zigchain.factory.Msg/UnfreezeAccount"
+	Msg_SetDenomGlobalFreeze_FullMethodName     = "/zigchain.factory.Msg/SetDenomGlobalFreeze"
+	Msg_ForceTransfer_FullMethodName            = "/zigchain.factory.Msg/ForceTransfer"
+	Msg_DisableForceTransfer_FullMethodName     = "/zigchain.factory.Msg/DisableForceTransfer"
+	Msg_SetMinterAllowance_FullMethodName       = "/zigchain.factory.Msg/SetMinterAllowance"
+	Msg_RemoveMinter_FullMethodName             = "/zigchain.factory.Msg/RemoveMinter"
+	Msg_SetMintRateLimit_FullMethodName         = "/zigchain.factory.Msg/SetMintRateLimit"
+	Msg_MintVested_FullMethodName               = "/zigchain.factory.Msg/MintVested"
+	Msg_ClaimVested_FullMethodName              = "/zigchain.factory.Msg/ClaimVested"
+	Msg_MintAndSendBatch_FullMethodName         = "/zigchain.factory.Msg/MintAndSendBatch"
+	Msg_CreateAirdrop_FullMethodName            = "/zigchain.factory.Msg/CreateAirdrop"
+	Msg_ClaimAirdrop_FullMethodName             = "/zigchain.factory.Msg/ClaimAirdrop"
+	Msg_TakeSnapshot_FullMethodName             = "/zigchain.factory.Msg/TakeSnapshot"
+	Msg_DistributeRewards_FullMethodName        = "/zigchain.factory.Msg/DistributeRewards"
+	Msg_ClaimRewards_FullMethodName             = "/zigchain.factory.Msg/ClaimRewards"
+	Msg_RegisterCW20Bridge_FullMethodName       = "/zigchain.factory.Msg/RegisterCW20Bridge"
+	Msg_ConvertCW20ToCoin_FullMethodName        = "/zigchain.factory.Msg/ConvertCW20ToCoin"
+	Msg_ConvertCoinToCW20_FullMethodName        = "/zigchain.factory.Msg/ConvertCoinToCW20"
+	Msg_GrantDenomRole_FullMethodName           = "/zigchain.factory.Msg/GrantDenomRole"
+	Msg_RevokeDenomRole_FullMethodName          = "/zigchain.factory.Msg/RevokeDenomRole"
+	Msg_CancelDenomAdminProposal_FullMethodName = "/zigchain.factory.Msg/CancelDenomAdminProposal"
+	Msg_SetDenomAdminTimelock_FullMethodName    = "/zigchain.factory.Msg/SetDenomAdminTimelock"
+	Msg_VerifyDenom_FullMethodName              = "/zigchain.factory.Msg/VerifyDenom"
+	Msg_UnverifyDenom_FullMethodName            = "/zigchain.factory.Msg/UnverifyDenom"
+	Msg_SetTransferTax_FullMethodName           = "/zigchain.factory.Msg/SetTransferTax"
+	Msg_SetTransferTaxExemption_FullMethodName  = "/zigchain.factory.Msg/SetTransferTaxExemption"
+	Msg_LaunchToken_FullMethodName              = "/zigchain.factory.Msg/LaunchToken"
+	Msg_BuyLaunchToken_FullMethodName           = "/zigchain.factory.Msg/BuyLaunchToken"
+	Msg_SellLaunchToken_FullMethodName          = "/zigchain.factory.Msg/SellLaunchToken"
 )
 
 // MsgClient is the client API for Msg service.
@@ -69,6 +100,96 @@ type MsgClient interface {
 	ClaimDenomAdmin(ctx context.Context, in *MsgClaimDenomAdmin, opts ...grpc.CallOption) (*MsgClaimDenomAdminResponse, error)
 	// DisableDenomAdmin represents a message to disable the denom admin role
 	DisableDenomAdmin(ctx context.Context, in *MsgDisableDenomAdmin, opts ...grpc.CallOption) (*MsgDisableDenomAdminResponse, error)
+	// SetBeforeSendHook sets or removes the CosmWasm contract called before
+	// every bank transfer of a denom, only bank admin can do it.
+	SetBeforeSendHook(ctx context.Context, in *MsgSetBeforeSendHook, opts ...grpc.CallOption) (*MsgSetBeforeSendHookResponse, error)
+	// FreezeAccount stops an account from sending and receiving a denom, only
+	// bank admin can do it.
+	FreezeAccount(ctx context.Context, in *MsgFreezeAccount, opts ...grpc.CallOption) (*MsgFreezeAccountResponse, error)
+	// UnfreezeAccount lifts the freeze of an account for a denom, only bank
+	// admin can do it.
+	UnfreezeAccount(ctx context.Context, in *MsgUnfreezeAccount, opts ...grpc.CallOption) (*MsgUnfreezeAccountResponse, error)
+	// SetDenomGlobalFreeze freezes or unfreezes all the transfers of a denom,
+	// only bank admin can do it.
+	SetDenomGlobalFreeze(ctx context.Context, in *MsgSetDenomGlobalFreeze, opts ...grpc.CallOption) (*MsgSetDenomGlobalFreezeResponse, error)
+	// ForceTransfer moves a denom from any holder to another address, only bank
+	// admin can do it and only for denoms created with can_force_transfer.
+	ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error)
+	// DisableForceTransfer turns off the force transfers of a denom for good,
+	// only bank admin can do it.
+	DisableForceTransfer(ctx context.Context, in *MsgDisableForceTransfer, opts ...grpc.CallOption) (*MsgDisableForceTransferResponse, error)
+	// SetMinterAllowance adds a minter to a denom or sets its remaining
+	// allowance, only bank admin can do it.
+	SetMinterAllowance(ctx context.Context, in *MsgSetMinterAllowance, opts ...grpc.CallOption) (*MsgSetMinterAllowanceResponse, error)
+	// RemoveMinter revokes a minter of a denom, only bank admin can do it.
+	RemoveMinter(ctx context.Context, in *MsgRemoveMinter, opts ...grpc.CallOption) (*MsgRemoveMinterResponse, error)
+	// SetMintRateLimit sets the rolling window mint rate limit of a denom, a
+	// looser limit only takes effect after the raise delay of the current one
+	SetMintRateLimit(ctx context.Context, in *MsgSetMintRateLimit, opts ...grpc.CallOption) (*MsgSetMintRateLimitResponse, error)
+	// MintVested mints a denom on a vesting schedule, into a new vesting
+	// account or into the vesting escrow for an existing account
+	MintVested(ctx context.Context, in *MsgMintVested, opts ...grpc.CallOption) (*MsgMintVestedResponse, error)
+	// ClaimVested sends the vested amount of an escrowed grant to its recipient
+	ClaimVested(ctx context.Context, in *MsgClaimVested, opts ...grpc.CallOption) (*MsgClaimVestedResponse, error)
+	// MintAndSendBatch mints a denom to many recipients with a single minting
+	// cap check
+	MintAndSendBatch(ctx context.Context, in *MsgMintAndSendBatch, opts ...grpc.CallOption) (*MsgMintAndSendBatchResponse, error)
+	// CreateAirdrop mints a denom into the airdrop escrow, to be claimed with
+	// Merkle proofs until the expiry
+	CreateAirdrop(ctx context.Context, in *MsgCreateAirdrop, opts ...grpc.CallOption) (*MsgCreateAirdropResponse, error)
+	// ClaimAirdrop claims the amount of the signer in an airdrop with a Merkle
+	// proof
+	ClaimAirdrop(ctx context.Context, in *MsgClaimAirdrop, opts ...grpc.CallOption) (*MsgClaimAirdropResponse, error)
+	// TakeSnapshot records the supply of a denom and starts recording the
+	// balances of its holders as of now
+	TakeSnapshot(ctx context.Context, in *MsgTakeSnapshot, opts ...grpc.CallOption) (*MsgTakeSnapshotResponse, error)
+	// DistributeRewards escrows a reward coin to be shared pro-rata by the
+	// holders of a denom
+	DistributeRewards(ctx context.Context, in *MsgDistributeRewards, opts ...grpc.CallOption) (*MsgDistributeRewardsResponse, error)
+	// ClaimRewards claims the rewards of the signer as a holder of a denom
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	// RegisterCW20Bridge creates the factory denom of a CW20 contract, gated by
+	// the module authority
+	RegisterCW20Bridge(ctx context.Context, in *MsgRegisterCW20Bridge, opts ...grpc.CallOption) (*MsgRegisterCW20BridgeResponse, error)
+	// ConvertCW20ToCoin locks CW20 tokens in the CW20 escrow and mints their
+	// factory denom
+	ConvertCW20ToCoin(ctx context.Context, in *MsgConvertCW20ToCoin, opts ...grpc.CallOption) (*MsgConvertCW20ToCoinResponse, error)
+	// ConvertCoinToCW20 burns the factory denom of a CW20 contract and releases
+	// the CW20 tokens from the CW20 escrow
+	ConvertCoinToCW20(ctx context.Context, in *MsgConvertCoinToCW20, opts ...grpc.CallOption) (*MsgConvertCoinToCW20Response, error)
+	// GrantDenomRole grants a role of a denom to an address, gated by the denom
+	// admin
+	GrantDenomRole(ctx context.Context, in *MsgGrantDenomRole, opts ...grpc.CallOption) (*MsgGrantDenomRoleResponse, error)
+	// RevokeDenomRole revokes a role of a denom from an address, gated by the
+	// denom admin or the holder renouncing it
+	RevokeDenomRole(ctx context.Context, in *MsgRevokeDenomRole, opts ...grpc.CallOption) (*MsgRevokeDenomRoleResponse, error)
+	// CancelDenomAdminProposal cancels the pending admin proposal of a denom,
+	// only bank admin can do it.
+	CancelDenomAdminProposal(ctx context.Context, in *MsgCancelDenomAdminProposal, opts ...grpc.CallOption) (*MsgCancelDenomAdminProposalResponse, error)
+	// SetDenomAdminTimelock sets the delay between the proposal of new admins of
+	// a denom and their claim, a shorter delay only takes effect once the
+	// current delay has passed.
+	SetDenomAdminTimelock(ctx context.Context, in *MsgSetDenomAdminTimelock, opts ...grpc.CallOption) (*MsgSetDenomAdminTimelockResponse, error)
+	// VerifyDenom adds a denom to the verified denom registry or updates its
+	// entry, only the authority and the verified denom curators can do it.
+	VerifyDenom(ctx context.Context, in *MsgVerifyDenom, opts ...grpc.CallOption) (*MsgVerifyDenomResponse, error)
+	// UnverifyDenom removes a denom from the verified denom registry, only the
+	// authority and the verified denom curators can do it.
+	UnverifyDenom(ctx context.Context, in *MsgUnverifyDenom, opts ...grpc.CallOption) (*MsgUnverifyDenomResponse, error)
+	// SetTransferTax sets the rate and the recipient of the transfer tax of a
+	// denom, a zero rate removes the tax. Only bank admin can do it.
+	SetTransferTax(ctx context.Context, in *MsgSetTransferTax, opts ...grpc.CallOption) (*MsgSetTransferTaxResponse, error)
+	// SetTransferTaxExemption exempts an address from the transfer tax of a
+	// denom or lifts its exemption, only bank admin can do it.
+	SetTransferTaxExemption(ctx context.Context, in *MsgSetTransferTaxExemption, opts ...grpc.CallOption) (*MsgSetTransferTaxExemptionResponse, error)
+	// LaunchToken creates a denom with a fixed supply and sells it against uzig
+	// on a bonding curve until it graduates into a dex pool
+	LaunchToken(ctx context.Context, in *MsgLaunchToken, opts ...grpc.CallOption) (*MsgLaunchTokenResponse, error)
+	// BuyLaunchToken buys the tokens of a launch on its bonding curve, the buy
+	// reaching the graduation market cap seeds the dex pool of the launch
+	BuyLaunchToken(ctx context.Context, in *MsgBuyLaunchToken, opts ...grpc.CallOption) (*MsgBuyLaunchTokenResponse, error)
+	// SellLaunchToken sells the tokens of a launch back to its bonding curve
+	SellLaunchToken(ctx context.Context, in *MsgSellLaunchToken, opts ...grpc.CallOption) (*MsgSellLaunchTokenResponse, error)
 }
 
 type msgClient struct {
@@ -199,6 +320,316 @@ func (c *msgClient) DisableDenomAdmin(ctx context.Context, in *MsgDisableDenomAd
 	return out, nil
 }
 
+func (c *msgClient) SetBeforeSendHook(ctx context.Context, in *MsgSetBeforeSendHook, opts ...grpc.CallOption) (*MsgSetBeforeSendHookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetBeforeSendHookResponse)
+	err := c.cc.Invoke(ctx, Msg_SetBeforeSendHook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeAccount(ctx context.Context, in *MsgFreezeAccount, opts ...grpc.CallOption) (*MsgFreezeAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgFreezeAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_FreezeAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeAccount(ctx context.Context, in *MsgUnfreezeAccount, opts ...grpc.CallOption) (*MsgUnfreezeAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnfreezeAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_UnfreezeAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomGlobalFreeze(ctx context.Context, in *MsgSetDenomGlobalFreeze, opts ...grpc.CallOption) (*MsgSetDenomGlobalFreezeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetDenomGlobalFreezeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomGlobalFreeze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgForceTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_ForceTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisableForceTransfer(ctx context.Context, in *MsgDisableForceTransfer, opts ...grpc.CallOption) (*MsgDisableForceTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDisableForceTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_DisableForceTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMinterAllowance(ctx context.Context, in *MsgSetMinterAllowance, opts ...grpc.CallOption) (*MsgSetMinterAllowanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetMinterAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMinterAllowance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveMinter(ctx context.Context, in *MsgRemoveMinter, opts ...grpc.CallOption) (*MsgRemoveMinterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveMinterResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveMinter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMintRateLimit(ctx context.Context, in *MsgSetMintRateLimit, opts ...grpc.CallOption) (*MsgSetMintRateLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetMintRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMintRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MintVested(ctx context.Context, in *MsgMintVested, opts ...grpc.CallOption) (*MsgMintVestedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgMintVestedResponse)
+	err := c.cc.Invoke(ctx, Msg_MintVested_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimVested(ctx context.Context, in *MsgClaimVested, opts ...grpc.CallOption) (*MsgClaimVestedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgClaimVestedResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimVested_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MintAndSendBatch(ctx context.Context, in *MsgMintAndSendBatch, opts ...grpc.CallOption) (*MsgMintAndSendBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgMintAndSendBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_MintAndSendBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateAirdrop(ctx context.Context, in *MsgCreateAirdrop, opts ...grpc.CallOption) (*MsgCreateAirdropResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCreateAirdropResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateAirdrop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimAirdrop(ctx context.Context, in *MsgClaimAirdrop, opts ...grpc.CallOption) (*MsgClaimAirdropResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgClaimAirdropResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimAirdrop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TakeSnapshot(ctx context.Context, in *MsgTakeSnapshot, opts ...grpc.CallOption) (*MsgTakeSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgTakeSnapshotResponse)
+	err := c.cc.Invoke(ctx, Msg_TakeSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DistributeRewards(ctx context.Context, in *MsgDistributeRewards, opts ...grpc.CallOption) (*MsgDistributeRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDistributeRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_DistributeRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterCW20Bridge(ctx context.Context, in *MsgRegisterCW20Bridge, opts ...grpc.CallOption) (*MsgRegisterCW20BridgeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRegisterCW20BridgeResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterCW20Bridge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConvertCW20ToCoin(ctx context.Context, in *MsgConvertCW20ToCoin, opts ...grpc.CallOption) (*MsgConvertCW20ToCoinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgConvertCW20ToCoinResponse)
+	err := c.cc.Invoke(ctx, Msg_ConvertCW20ToCoin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConvertCoinToCW20(ctx context.Context, in *MsgConvertCoinToCW20, opts ...grpc.CallOption) (*MsgConvertCoinToCW20Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgConvertCoinToCW20Response)
+	err := c.cc.Invoke(ctx, Msg_ConvertCoinToCW20_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantDenomRole(ctx context.Context, in *MsgGrantDenomRole, opts ...grpc.CallOption) (*MsgGrantDenomRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgGrantDenomRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantDenomRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeDenomRole(ctx context.Context, in *MsgRevokeDenomRole, opts ...grpc.CallOption) (*MsgRevokeDenomRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevokeDenomRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeDenomRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDenomAdminProposal(ctx context.Context, in *MsgCancelDenomAdminProposal, opts ...grpc.CallOption) (*MsgCancelDenomAdminProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelDenomAdminProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelDenomAdminProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomAdminTimelock(ctx context.Context, in *MsgSetDenomAdminTimelock, opts ...grpc.CallOption) (*MsgSetDenomAdminTimelockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetDenomAdminTimelockResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomAdminTimelock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VerifyDenom(ctx context.Context, in *MsgVerifyDenom, opts ...grpc.CallOption) (*MsgVerifyDenomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgVerifyDenomResponse)
+	err := c.cc.Invoke(ctx, Msg_VerifyDenom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnverifyDenom(ctx context.Context, in *MsgUnverifyDenom, opts ...grpc.CallOption) (*MsgUnverifyDenomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnverifyDenomResponse)
+	err := c.cc.Invoke(ctx, Msg_UnverifyDenom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTransferTax(ctx context.Context, in *MsgSetTransferTax, opts ...grpc.CallOption) (*MsgSetTransferTaxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetTransferTaxResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTransferTax_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTransferTaxExemption(ctx context.Context, in *MsgSetTransferTaxExemption, opts ...grpc.CallOption) (*MsgSetTransferTaxExemptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetTransferTaxExemptionResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTransferTaxExemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LaunchToken(ctx context.Context, in *MsgLaunchToken, opts ...grpc.CallOption) (*MsgLaunchTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgLaunchTokenResponse)
+	err := c.cc.Invoke(ctx, Msg_LaunchToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BuyLaunchToken(ctx context.Context, in *MsgBuyLaunchToken, opts ...grpc.CallOption) (*MsgBuyLaunchTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBuyLaunchTokenResponse)
+	err := c.cc.Invoke(ctx, Msg_BuyLaunchToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SellLaunchToken(ctx context.Context, in *MsgSellLaunchToken, opts ...grpc.CallOption) (*MsgSellLaunchTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSellLaunchTokenResponse)
+	err := c.cc.Invoke(ctx, Msg_SellLaunchToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -233,6 +664,96 @@ type MsgServer interface {
 	ClaimDenomAdmin(context.Context, *MsgClaimDenomAdmin) (*MsgClaimDenomAdminResponse, error)
 	// DisableDenomAdmin represents a message to disable the denom admin role
 	DisableDenomAdmin(context.Context, *MsgDisableDenomAdmin) (*MsgDisableDenomAdminResponse, error)
+	// SetBeforeSendHook sets or removes the CosmWasm contract called before
+	// every bank transfer of a denom, only bank admin can do it.
+	SetBeforeSendHook(context.Context, *MsgSetBeforeSendHook) (*MsgSetBeforeSendHookResponse, error)
+	// FreezeAccount stops an account from sending and receiving a denom, only
+	// bank admin can do it.
+	FreezeAccount(context.Context, *MsgFreezeAccount) (*MsgFreezeAccountResponse, error)
+	// UnfreezeAccount lifts the freeze of an account for a denom, only bank
+	// admin can do it.
+	UnfreezeAccount(context.Context, *MsgUnfreezeAccount) (*MsgUnfreezeAccountResponse, error)
+	// SetDenomGlobalFreeze freezes or unfreezes all the transfers of a denom,
+	// only bank admin can do it.
+	SetDenomGlobalFreeze(context.Context, *MsgSetDenomGlobalFreeze) (*MsgSetDenomGlobalFreezeResponse, error)
+	// ForceTransfer moves a denom from any holder to another address, only bank
+	// admin can do it and only for denoms created with can_force_transfer.
+	ForceTransfer(context.Context, *MsgForceTransfer) (*MsgForceTransferResponse, error)
+	// DisableForceTransfer turns off the force transfers of a denom for good,
+	// only bank admin can do it.
+	DisableForceTransfer(context.Context, *MsgDisableForceTransfer) (*MsgDisableForceTransferResponse, error)
+	// SetMinterAllowance adds a minter to a denom or sets its remaining
+	// allowance, only bank admin can do it.
+	SetMinterAllowance(context.Context, *MsgSetMinterAllowance) (*MsgSetMinterAllowanceResponse, error)
+	// RemoveMinter revokes a minter of a denom, only bank admin can do it.
+	RemoveMinter(context.Context, *MsgRemoveMinter) (*MsgRemoveMinterResponse, error)
+	// SetMintRateLimit sets the rolling window mint rate limit of a denom, a
+	// looser limit only takes effect after the raise delay of the current one
+	SetMintRateLimit(context.Context, *MsgSetMintRateLimit) (*MsgSetMintRateLimitResponse, error)
+	// MintVested mints a denom on a vesting schedule, into a new vesting
+	// account or into the vesting escrow for an existing account
+	MintVested(context.Context, *MsgMintVested) (*MsgMintVestedResponse, error)
+	// ClaimVested sends the vested amount of an escrowed grant to its recipient
+	ClaimVested(context.Context, *MsgClaimVested) (*MsgClaimVestedResponse, error)
+	// MintAndSendBatch mints a denom to many recipients with a single minting
+	// cap check
+	MintAndSendBatch(context.Context, *MsgMintAndSendBatch) (*MsgMintAndSendBatchResponse, error)
+	// CreateAirdrop mints a denom into the airdrop escrow, to be claimed with
+	// Merkle proofs until the expiry
+	CreateAirdrop(context.Context, *MsgCreateAirdrop) (*MsgCreateAirdropResponse, error)
+	// ClaimAirdrop claims the amount of the signer in an airdrop with a Merkle
+	// proof
+	ClaimAirdrop(context.Context, *MsgClaimAirdrop) (*MsgClaimAirdropResponse, error)
+	// TakeSnapshot records the supply of a denom and starts recording the
+	// balances of its holders as of now
+	TakeSnapshot(context.Context, *MsgTakeSnapshot) (*MsgTakeSnapshotResponse, error)
+	// DistributeRewards escrows a reward coin to be shared pro-rata by the
+	// holders of a denom
+	DistributeRewards(context.Context, *MsgDistributeRewards) (*MsgDistributeRewardsResponse, error)
+	// ClaimRewards claims the rewards of the signer as a holder of a denom
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	// RegisterCW20Bridge creates the factory denom of a CW20 contract, gated by
+	// the module authority
+	RegisterCW20Bridge(context.Context, *MsgRegisterCW20Bridge) (*MsgRegisterCW20BridgeResponse, error)
+	// ConvertCW20ToCoin locks CW20 tokens in the CW20 escrow and mints their
+	// factory denom
+	ConvertCW20ToCoin(context.Context, *MsgConvertCW20ToCoin) (*MsgConvertCW20ToCoinResponse, error)
+	// ConvertCoinToCW20 burns the factory denom of a CW20 contract and releases
+	// the CW20 tokens from the CW20 escrow
+	ConvertCoinToCW20(context.Context, *MsgConvertCoinToCW20) (*MsgConvertCoinToCW20Response, error)
+	// GrantDenomRole grants a role of a denom to an address, gated by the denom
+	// admin
+	GrantDenomRole(context.Context, *MsgGrantDenomRole) (*MsgGrantDenomRoleResponse, error)
+	// RevokeDenomRole revokes a role of a denom from an address, gated by the
+	// denom admin or the holder renouncing it
+	RevokeDenomRole(context.Context, *MsgRevokeDenomRole) (*MsgRevokeDenomRoleResponse, error)
+	// CancelDenomAdminProposal cancels the pending admin proposal of a denom,
+	// only bank admin can do it.
+	CancelDenomAdminProposal(context.Context, *MsgCancelDenomAdminProposal) (*MsgCancelDenomAdminProposalResponse, error)
+	// SetDenomAdminTimelock sets the delay between the proposal of new admins of
+	// a denom and their claim, a shorter delay only takes effect once the
+	// current delay has passed.
+	SetDenomAdminTimelock(context.Context, *MsgSetDenomAdminTimelock) (*MsgSetDenomAdminTimelockResponse, error)
+	// VerifyDenom adds a denom to the verified denom registry or updates its
+	// entry, only the authority and the verified denom curators can do it.
+	VerifyDenom(context.Context, *MsgVerifyDenom) (*MsgVerifyDenomResponse, error)
+	// UnverifyDenom removes a denom from the verified denom registry, only the
+	// authority and the verified denom curators can do it.
+	UnverifyDenom(context.Context, *MsgUnverifyDenom) (*MsgUnverifyDenomResponse, error)
+	// SetTransferTax sets the rate and the recipient of the transfer tax of a
+	// denom, a zero rate removes the tax. Only bank admin can do it.
+	SetTransferTax(context.Context, *MsgSetTransferTax) (*MsgSetTransferTaxResponse, error)
+	// SetTransferTaxExemption exempts an address from the transfer tax of a
+	// denom or lifts its exemption, only bank admin can do it.
+	SetTransferTaxExemption(context.Context, *MsgSetTransferTaxExemption) (*MsgSetTransferTaxExemptionResponse, error)
+	// LaunchToken creates a denom with a fixed supply and sells it against uzig
+	// on a bonding curve until it graduates into a dex pool
+	LaunchToken(context.Context, *MsgLaunchToken) (*MsgLaunchTokenResponse, error)
+	// BuyLaunchToken buys the tokens of a launch on its bonding curve, the buy
+	// reaching the graduation market cap seeds the dex pool of the launch
+	BuyLaunchToken(context.Context, *MsgBuyLaunchToken) (*MsgBuyLaunchTokenResponse, error)
+	// SellLaunchToken sells the tokens of a launch back to its bonding curve
+	SellLaunchToken(context.Context, *MsgSellLaunchToken) (*MsgSellLaunchTokenResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -279,6 +800,99 @@ func (UnimplementedMsgServer) ClaimDenomAdmin(context.Context, *MsgClaimDenomAdm
 func (UnimplementedMsgServer) DisableDenomAdmin(context.Context, *MsgDisableDenomAdmin) (*MsgDisableDenomAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisableDenomAdmin not implemented")
 }
+func (UnimplementedMsgServer) SetBeforeSendHook(context.Context, *MsgSetBeforeSendHook) (*MsgSetBeforeSendHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBeforeSendHook not implemented")
+}
+func (UnimplementedMsgServer) FreezeAccount(context.Context, *MsgFreezeAccount) (*MsgFreezeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeAccount not implemented")
+}
+func (UnimplementedMsgServer) UnfreezeAccount(context.Context, *MsgUnfreezeAccount) (*MsgUnfreezeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeAccount not implemented")
+}
+func (UnimplementedMsgServer) SetDenomGlobalFreeze(context.Context, *MsgSetDenomGlobalFreeze) (*MsgSetDenomGlobalFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomGlobalFreeze not implemented")
+}
+func (UnimplementedMsgServer) ForceTransfer(context.Context, *MsgForceTransfer) (*MsgForceTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceTransfer not implemented")
+}
+func (UnimplementedMsgServer) DisableForceTransfer(context.Context, *MsgDisableForceTransfer) (*MsgDisableForceTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableForceTransfer not implemented")
+}
+func (UnimplementedMsgServer) SetMinterAllowance(context.Context, *MsgSetMinterAllowance) (*MsgSetMinterAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMinterAllowance not implemented")
+}
+func (UnimplementedMsgServer) RemoveMinter(context.Context, *MsgRemoveMinter) (*MsgRemoveMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMinter not implemented")
+}
+func (UnimplementedMsgServer) SetMintRateLimit(context.Context, *MsgSetMintRateLimit) (*MsgSetMintRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMintRateLimit not implemented")
+}
+func (UnimplementedMsgServer) MintVested(context.Context, *MsgMintVested) (*MsgMintVestedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintVested not implemented")
+}
+func (UnimplementedMsgServer) ClaimVested(context.Context, *MsgClaimVested) (*MsgClaimVestedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimVested not implemented")
+}
+func (UnimplementedMsgServer) MintAndSendBatch(context.Context, *MsgMintAndSendBatch) (*MsgMintAndSendBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintAndSendBatch not implemented")
+}
+func (UnimplementedMsgServer) CreateAirdrop(context.Context, *MsgCreateAirdrop) (*MsgCreateAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAirdrop not implemented")
+}
+func (UnimplementedMsgServer) ClaimAirdrop(context.Context, *MsgClaimAirdrop) (*MsgClaimAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAirdrop not implemented")
+}
+func (UnimplementedMsgServer) TakeSnapshot(context.Context, *MsgTakeSnapshot) (*MsgTakeSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakeSnapshot not implemented")
+}
+func (UnimplementedMsgServer) DistributeRewards(context.Context, *MsgDistributeRewards) (*MsgDistributeRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributeRewards not implemented")
+}
+func (UnimplementedMsgServer) ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (UnimplementedMsgServer) RegisterCW20Bridge(context.Context, *MsgRegisterCW20Bridge) (*MsgRegisterCW20BridgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCW20Bridge not implemented")
+}
+func (UnimplementedMsgServer) ConvertCW20ToCoin(context.Context, *MsgConvertCW20ToCoin) (*MsgConvertCW20ToCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCW20ToCoin not implemented")
+}
+func (UnimplementedMsgServer) ConvertCoinToCW20(context.Context, *MsgConvertCoinToCW20) (*MsgConvertCoinToCW20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoinToCW20 not implemented")
+}
+func (UnimplementedMsgServer) GrantDenomRole(context.Context, *MsgGrantDenomRole) (*MsgGrantDenomRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantDenomRole not implemented")
+}
+func (UnimplementedMsgServer) RevokeDenomRole(context.Context, *MsgRevokeDenomRole) (*MsgRevokeDenomRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeDenomRole not implemented")
+}
+func (UnimplementedMsgServer) CancelDenomAdminProposal(context.Context, *MsgCancelDenomAdminProposal) (*MsgCancelDenomAdminProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDenomAdminProposal not implemented")
+}
+func (UnimplementedMsgServer) SetDenomAdminTimelock(context.Context, *MsgSetDenomAdminTimelock) (*MsgSetDenomAdminTimelockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomAdminTimelock not implemented")
+}
+func (UnimplementedMsgServer) VerifyDenom(context.Context, *MsgVerifyDenom) (*MsgVerifyDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDenom not implemented")
+}
+func (UnimplementedMsgServer) UnverifyDenom(context.Context, *MsgUnverifyDenom) (*MsgUnverifyDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnverifyDenom not implemented")
+}
+func (UnimplementedMsgServer) SetTransferTax(context.Context, *MsgSetTransferTax) (*MsgSetTransferTaxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferTax not implemented")
+}
+func (UnimplementedMsgServer) SetTransferTaxExemption(context.Context, *MsgSetTransferTaxExemption) (*MsgSetTransferTaxExemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferTaxExemption not implemented")
+}
+func (UnimplementedMsgServer) LaunchToken(context.Context, *MsgLaunchToken) (*MsgLaunchTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LaunchToken not implemented")
+}
+func (UnimplementedMsgServer) BuyLaunchToken(context.Context, *MsgBuyLaunchToken) (*MsgBuyLaunchTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyLaunchToken not implemented")
+}
+func (UnimplementedMsgServer) SellLaunchToken(context.Context, *MsgSellLaunchToken) (*MsgSellLaunchTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellLaunchToken not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -516,12 +1130,570 @@ func _Msg_DisableDenomAdmin_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Msg_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "zigchain.factory.Msg",
-	HandlerType: (*MsgServer)(nil),
+func _Msg_SetBeforeSendHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBeforeSendHook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBeforeSendHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetBeforeSendHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBeforeSendHook(ctx, req.(*MsgSetBeforeSendHook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FreezeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeAccount(ctx, req.(*MsgFreezeAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnfreezeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeAccount(ctx, req.(*MsgUnfreezeAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomGlobalFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomGlobalFreeze)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomGlobalFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomGlobalFreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomGlobalFreeze(ctx, req.(*MsgSetDenomGlobalFreeze))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ForceTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceTransfer(ctx, req.(*MsgForceTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableForceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableForceTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableForceTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DisableForceTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableForceTransfer(ctx, req.(*MsgDisableForceTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMinterAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMinterAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMinterAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMinterAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMinterAllowance(ctx, req.(*MsgSetMinterAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMinter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveMinter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMinter(ctx, req.(*MsgRemoveMinter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMintRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMintRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMintRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMintRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMintRateLimit(ctx, req.(*MsgSetMintRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MintVested_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintVested)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintVested(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MintVested_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintVested(ctx, req.(*MsgMintVested))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimVested_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimVested)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimVested(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimVested_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimVested(ctx, req.(*MsgClaimVested))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MintAndSendBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintAndSendBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintAndSendBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MintAndSendBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintAndSendBatch(ctx, req.(*MsgMintAndSendBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAirdrop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAirdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateAirdrop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAirdrop(ctx, req.(*MsgCreateAirdrop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAirdrop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAirdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimAirdrop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAirdrop(ctx, req.(*MsgClaimAirdrop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TakeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTakeSnapshot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TakeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TakeSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TakeSnapshot(ctx, req.(*MsgTakeSnapshot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DistributeRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDistributeRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DistributeRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DistributeRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DistributeRewards(ctx, req.(*MsgDistributeRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterCW20Bridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCW20Bridge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCW20Bridge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterCW20Bridge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCW20Bridge(ctx, req.(*MsgRegisterCW20Bridge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertCW20ToCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCW20ToCoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCW20ToCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ConvertCW20ToCoin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCW20ToCoin(ctx, req.(*MsgConvertCW20ToCoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertCoinToCW20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCoinToCW20)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCoinToCW20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ConvertCoinToCW20_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCoinToCW20(ctx, req.(*MsgConvertCoinToCW20))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantDenomRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantDenomRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantDenomRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantDenomRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantDenomRole(ctx, req.(*MsgGrantDenomRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeDenomRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeDenomRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeDenomRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeDenomRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeDenomRole(ctx, req.(*MsgRevokeDenomRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDenomAdminProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDenomAdminProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDenomAdminProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelDenomAdminProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDenomAdminProposal(ctx, req.(*MsgCancelDenomAdminProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomAdminTimelock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomAdminTimelock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomAdminTimelock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomAdminTimelock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomAdminTimelock(ctx, req.(*MsgSetDenomAdminTimelock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VerifyDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVerifyDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VerifyDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VerifyDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VerifyDenom(ctx, req.(*MsgVerifyDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnverifyDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnverifyDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnverifyDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnverifyDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnverifyDenom(ctx, req.(*MsgUnverifyDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTransferTax_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTransferTax)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTransferTax(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTransferTax_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTransferTax(ctx, req.(*MsgSetTransferTax))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTransferTaxExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTransferTaxExemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTransferTaxExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTransferTaxExemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTransferTaxExemption(ctx, req.(*MsgSetTransferTaxExemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LaunchToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLaunchToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LaunchToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LaunchToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LaunchToken(ctx, req.(*MsgLaunchToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BuyLaunchToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuyLaunchToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BuyLaunchToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BuyLaunchToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BuyLaunchToken(ctx, req.(*MsgBuyLaunchToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SellLaunchToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSellLaunchToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SellLaunchToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SellLaunchToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SellLaunchToken(ctx, req.(*MsgSellLaunchToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "zigchain.factory.Msg",
+	HandlerType: (*MsgServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "UpdateParams",
@@ -571,6 +1743,130 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisableDenomAdmin",
 			Handler:    _Msg_DisableDenomAdmin_Handler,
 		},
+		{
+			MethodName: "SetBeforeSendHook",
+			Handler:    _Msg_SetBeforeSendHook_Handler,
+		},
+		{
+			MethodName: "FreezeAccount",
+			Handler:    _Msg_FreezeAccount_Handler,
+		},
+		{
+			MethodName: "UnfreezeAccount",
+			Handler:    _Msg_UnfreezeAccount_Handler,
+		},
+		{
+			MethodName: "SetDenomGlobalFreeze",
+			Handler:    _Msg_SetDenomGlobalFreeze_Handler,
+		},
+		{
+			MethodName: "ForceTransfer",
+			Handler:    _Msg_ForceTransfer_Handler,
+		},
+		{
+			MethodName: "DisableForceTransfer",
+			Handler:    _Msg_DisableForceTransfer_Handler,
+		},
+		{
+			MethodName: "SetMinterAllowance",
+			Handler:    _Msg_SetMinterAllowance_Handler,
+		},
+		{
+			MethodName: "RemoveMinter",
+			Handler:    _Msg_RemoveMinter_Handler,
+		},
+		{
+			MethodName: "SetMintRateLimit",
+			Handler:    _Msg_SetMintRateLimit_Handler,
+		},
+		{
+			MethodName: "MintVested",
+			Handler:    _Msg_MintVested_Handler,
+		},
+		{
+			MethodName: "ClaimVested",
+			Handler:    _Msg_ClaimVested_Handler,
+		},
+		{
+			MethodName: "MintAndSendBatch",
+			Handler:    _Msg_MintAndSendBatch_Handler,
+		},
+		{
+			MethodName: "CreateAirdrop",
+			Handler:    _Msg_CreateAirdrop_Handler,
+		},
+		{
+			MethodName: "ClaimAirdrop",
+			Handler:    _Msg_ClaimAirdrop_Handler,
+		},
+		{
+			MethodName: "TakeSnapshot",
+			Handler:    _Msg_TakeSnapshot_Handler,
+		},
+		{
+			MethodName: "DistributeRewards",
+			Handler:    _Msg_DistributeRewards_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "RegisterCW20Bridge",
+			Handler:    _Msg_RegisterCW20Bridge_Handler,
+		},
+		{
+			MethodName: "ConvertCW20ToCoin",
+			Handler:    _Msg_ConvertCW20ToCoin_Handler,
+		},
+		{
+			MethodName: "ConvertCoinToCW20",
+			Handler:    _Msg_ConvertCoinToCW20_Handler,
+		},
+		{
+			MethodName: "GrantDenomRole",
+			Handler:    _Msg_GrantDenomRole_Handler,
+		},
+		{
+			MethodName: "RevokeDenomRole",
+			Handler:    _Msg_RevokeDenomRole_Handler,
+		},
+		{
+			MethodName: "CancelDenomAdminProposal",
+			Handler:    _Msg_CancelDenomAdminProposal_Handler,
+		},
+		{
+			MethodName: "SetDenomAdminTimelock",
+			Handler:    _Msg_SetDenomAdminTimelock_Handler,
+		},
+		{
+			MethodName: "VerifyDenom",
+			Handler:    _Msg_VerifyDenom_Handler,
+		},
+		{
+			MethodName: "UnverifyDenom",
+			Handler:    _Msg_UnverifyDenom_Handler,
+		},
+		{
+			MethodName: "SetTransferTax",
+			Handler:    _Msg_SetTransferTax_Handler,
+		},
+		{
+			MethodName: "SetTransferTaxExemption",
+			Handler:    _Msg_SetTransferTaxExemption_Handler,
+		},
+		{
+			MethodName: "LaunchToken",
+			Handler:    _Msg_LaunchToken_Handler,
+		},
+		{
+			MethodName: "BuyLaunchToken",
+			Handler:    _Msg_BuyLaunchToken_Handler,
+		},
+		{
+			MethodName: "SellLaunchToken",
+			Handler:    _Msg_SellLaunchToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zigchain/factory/tx.proto",
@@ -0,0 +1,1389 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package tokenwrapper
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_BridgeRoute                        protoreflect.MessageDescriptor
+	fd_BridgeRoute_native_port            protoreflect.FieldDescriptor
+	fd_BridgeRoute_native_channel         protoreflect.FieldDescriptor
+	fd_BridgeRoute_native_client_id       protoreflect.FieldDescriptor
+	fd_BridgeRoute_counterparty_client_id protoreflect.FieldDescriptor
+	fd_BridgeRoute_counterparty_port      protoreflect.FieldDescriptor
+	fd_BridgeRoute_counterparty_channel   protoreflect.FieldDescriptor
+	fd_BridgeRoute_denom                  protoreflect.FieldDescriptor
+	fd_BridgeRoute_native_denom           protoreflect.FieldDescriptor
+	fd_BridgeRoute_decimal_difference     protoreflect.FieldDescriptor
+	fd_BridgeRoute_enabled                protoreflect.FieldDescriptor
+	fd_BridgeRoute_total_transferred_in   protoreflect.FieldDescriptor
+	fd_BridgeRoute_total_transferred_out  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zigchain_tokenwrapper_bridge_route_proto_init()
+	md_BridgeRoute = File_zigchain_tokenwrapper_bridge_route_proto.Messages().ByName("BridgeRoute")
+	fd_BridgeRoute_native_port = md_BridgeRoute.Fields().ByName("native_port")
+	fd_BridgeRoute_native_channel = md_BridgeRoute.Fields().ByName("native_channel")
+	fd_BridgeRoute_native_client_id = md_BridgeRoute.Fields().ByName("native_client_id")
+	fd_BridgeRoute_counterparty_client_id = md_BridgeRoute.Fields().ByName("counterparty_client_id")
+	fd_BridgeRoute_counterparty_port = md_BridgeRoute.Fields().ByName("counterparty_port")
+	fd_BridgeRoute_counterparty_channel = md_BridgeRoute.Fields().ByName("counterparty_channel")
+	fd_BridgeRoute_denom = md_BridgeRoute.Fields().ByName("denom")
+	fd_BridgeRoute_native_denom = md_BridgeRoute.Fields().ByName("native_denom")
+	fd_BridgeRoute_decimal_difference = md_BridgeRoute.Fields().ByName("decimal_difference")
+	fd_BridgeRoute_enabled = md_BridgeRoute.Fields().ByName("enabled")
+	fd_BridgeRoute_total_transferred_in = md_BridgeRoute.Fields().ByName("total_transferred_in")
+	fd_BridgeRoute_total_transferred_out = md_BridgeRoute.Fields().ByName("total_transferred_out")
+}
+
+var _ protoreflect.Message = (*fastReflection_BridgeRoute)(nil)
+
+type fastReflection_BridgeRoute BridgeRoute
+
+func (x *BridgeRoute) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BridgeRoute)(x)
+}
+
+func (x *BridgeRoute) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_tokenwrapper_bridge_route_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BridgeRoute_messageType fastReflection_BridgeRoute_messageType
+var _ protoreflect.MessageType = fastReflection_BridgeRoute_messageType{}
+
+type fastReflection_BridgeRoute_messageType struct{}
+
+func (x fastReflection_BridgeRoute_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BridgeRoute)(nil)
+}
+func (x fastReflection_BridgeRoute_messageType) New() protoreflect.Message {
+	return new(fastReflection_BridgeRoute)
+}
+func (x fastReflection_BridgeRoute_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeRoute
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BridgeRoute) Descriptor() protoreflect.MessageDescriptor {
+	return md_BridgeRoute
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BridgeRoute) Type() protoreflect.MessageType {
+	return _fastReflection_BridgeRoute_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BridgeRoute) New() protoreflect.Message {
+	return new(fastReflection_BridgeRoute)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BridgeRoute) Interface() protoreflect.ProtoMessage {
+	return (*BridgeRoute)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BridgeRoute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.NativePort != "" {
+		value := protoreflect.ValueOfString(x.NativePort)
+		if !f(fd_BridgeRoute_native_port, value) {
+			return
+		}
+	}
+	if x.NativeChannel != "" {
+		value := protoreflect.ValueOfString(x.NativeChannel)
+		if !f(fd_BridgeRoute_native_channel, value) {
+			return
+		}
+	}
+	if x.NativeClientId != "" {
+		value := protoreflect.ValueOfString(x.NativeClientId)
+		if !f(fd_BridgeRoute_native_client_id, value) {
+			return
+		}
+	}
+	if x.CounterpartyClientId != "" {
+		value := protoreflect.ValueOfString(x.CounterpartyClientId)
+		if !f(fd_BridgeRoute_counterparty_client_id, value) {
+			return
+		}
+	}
+	if x.CounterpartyPort != "" {
+		value := protoreflect.ValueOfString(x.CounterpartyPort)
+		if !f(fd_BridgeRoute_counterparty_port, value) {
+			return
+		}
+	}
+	if x.CounterpartyChannel != "" {
+		value := protoreflect.ValueOfString(x.CounterpartyChannel)
+		if !f(fd_BridgeRoute_counterparty_channel, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_BridgeRoute_denom, value) {
+			return
+		}
+	}
+	if x.NativeDenom != "" {
+		value := protoreflect.ValueOfString(x.NativeDenom)
+		if !f(fd_BridgeRoute_native_denom, value) {
+			return
+		}
+	}
+	if x.DecimalDifference != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DecimalDifference)
+		if !f(fd_BridgeRoute_decimal_difference, value) {
+			return
+		}
+	}
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_BridgeRoute_enabled, value) {
+			return
+		}
+	}
+	if x.TotalTransferredIn != "" {
+		value := protoreflect.ValueOfString(x.TotalTransferredIn)
+		if !f(fd_BridgeRoute_total_transferred_in, value) {
+			return
+		}
+	}
+	if x.TotalTransferredOut != "" {
+		value := protoreflect.ValueOfString(x.TotalTransferredOut)
+		if !f(fd_BridgeRoute_total_transferred_out, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BridgeRoute) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zigchain.tokenwrapper.BridgeRoute.native_port":
+		return x.NativePort != ""
+	case "zigchain.tokenwrapper.BridgeRoute.native_channel":
+		return x.NativeChannel != ""
+	case "zigchain.tokenwrapper.BridgeRoute.native_client_id":
+		return x.NativeClientId != ""
+	case "zigchain.tokenwrapper.BridgeRoute.counterparty_client_id":
+		return x.CounterpartyClientId != ""
+	case "zigchain.tokenwrapper.BridgeRoute.counterparty_port":
+		return x.CounterpartyPort != ""
+	case "zigchain.tokenwrapper.BridgeRoute.counterparty_channel":
+		return x.CounterpartyChannel != ""
+	case "zigchain.tokenwrapper.BridgeRoute.denom":
+		return x.Denom != ""
+	case "zigchain.tokenwrapper.BridgeRoute.native_denom":
+		return x.NativeDenom != ""
+	case "zigchain.tokenwrapper.BridgeRoute.decimal_difference":
+		return x.DecimalDifference != uint32(0)
+	case "zigchain.tokenwrapper.BridgeRoute.enabled":
+		return x.Enabled != false
+	case "zigchain.tokenwrapper.BridgeRoute.total_transferred_in":
+		return x.TotalTransferredIn != ""
+	case "zigchain.tokenwrapper.BridgeRoute.total_transferred_out":
+		return x.TotalTransferredOut != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.BridgeRoute"))
+		}
+		panic(fmt.Errorf("message zigchain.tokenwrapper.BridgeRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeRoute) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zigchain.tokenwrapper.BridgeRoute.native_port":
+		x.NativePort = ""
+	case "zigchain.tokenwrapper.BridgeRoute.native_channel":
+		x.NativeChannel = ""
+	case "zigchain.tokenwrapper.BridgeRoute.native_client_id":
+		x.NativeClientId = ""
+	case "zigchain.tokenwrapper.BridgeRoute.counterparty_client_id":
+		x.CounterpartyClientId = ""
+	case "zigchain.tokenwrapper.BridgeRoute.counterparty_port":
+		x.CounterpartyPort = ""
+	case "zigchain.tokenwrapper.BridgeRoute.counterparty_channel":
+		x.CounterpartyChannel = ""
+	case "zigchain.tokenwrapper.BridgeRoute.denom":
+		x.Denom = ""
+	case "zigchain.tokenwrapper.BridgeRoute.native_denom":
+		x.NativeDenom = ""
+	case "zigchain.tokenwrapper.BridgeRoute.decimal_difference":
+		x.DecimalDifference = uint32(0)
+	case "zigchain.tokenwrapper.BridgeRoute.enabled":
+		x.Enabled = false
+	case "zigchain.tokenwrapper.BridgeRoute.total_transferred_in":
+		x.TotalTransferredIn = ""
+	case "zigchain.tokenwrapper.BridgeRoute.total_transferred_out":
+		x.TotalTransferredOut = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.BridgeRoute"))
+		}
+		panic(fmt.Errorf("message zigchain.tokenwrapper.BridgeRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BridgeRoute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zigchain.tokenwrapper.BridgeRoute.native_port":
+		value := x.NativePort
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.BridgeRoute.native_channel":
+		value := x.NativeChannel
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.BridgeRoute.native_client_id":
+		value := x.NativeClientId
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.BridgeRoute.counterparty_client_id":
+		value := x.CounterpartyClientId
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.BridgeRoute.counterparty_port":
+		value := x.CounterpartyPort
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.BridgeRoute.counterparty_channel":
+		value := x.CounterpartyChannel
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.BridgeRoute.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.BridgeRoute.native_denom":
+		value := x.NativeDenom
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.BridgeRoute.decimal_difference":
+		value := x.DecimalDifference
+		return protoreflect.ValueOfUint32(value)
+	case "zigchain.tokenwrapper.BridgeRoute.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	case "zigchain.tokenwrapper.BridgeRoute.total_transferred_in":
+		value := x.TotalTransferredIn
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.BridgeRoute.total_transferred_out":
+		value := x.TotalTransferredOut
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.BridgeRoute"))
+		}
+		panic(fmt.Errorf("message zigchain.tokenwrapper.BridgeRoute does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeRoute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zigchain.tokenwrapper.BridgeRoute.native_port":
+		x.NativePort = value.Interface().(string)
+	case "zigchain.tokenwrapper.BridgeRoute.native_channel":
+		x.NativeChannel = value.Interface().(string)
+	case "zigchain.tokenwrapper.BridgeRoute.native_client_id":
+		x.NativeClientId = value.Interface().(string)
+	case "zigchain.tokenwrapper.BridgeRoute.counterparty_client_id":
+		x.CounterpartyClientId = value.Interface().(string)
+	case "zigchain.tokenwrapper.BridgeRoute.counterparty_port":
+		x.CounterpartyPort = value.Interface().(string)
+	case "zigchain.tokenwrapper.BridgeRoute.counterparty_channel":
+		x.CounterpartyChannel = value.Interface().(string)
+	case "zigchain.tokenwrapper.BridgeRoute.denom":
+		x.Denom = value.Interface().(string)
+	case "zigchain.tokenwrapper.BridgeRoute.native_denom":
+		x.NativeDenom = value.Interface().(string)
+	case "zigchain.tokenwrapper.BridgeRoute.decimal_difference":
+		x.DecimalDifference = uint32(value.Uint())
+	case "zigchain.tokenwrapper.BridgeRoute.enabled":
+		x.Enabled = value.Bool()
+	case "zigchain.tokenwrapper.BridgeRoute.total_transferred_in":
+		x.TotalTransferredIn = value.Interface().(string)
+	case "zigchain.tokenwrapper.BridgeRoute.total_transferred_out":
+		x.TotalTransferredOut = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.BridgeRoute"))
+		}
+		panic(fmt.Errorf("message zigchain.tokenwrapper.BridgeRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeRoute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.tokenwrapper.BridgeRoute.native_port":
+		panic(fmt.Errorf("field native_port of message zigchain.tokenwrapper.BridgeRoute is not mutable"))
+	case "zigchain.tokenwrapper.BridgeRoute.native_channel":
+		panic(fmt.Errorf("field native_channel of message zigchain.tokenwrapper.BridgeRoute is not mutable"))
+	case "zigchain.tokenwrapper.BridgeRoute.native_client_id":
+		panic(fmt.Errorf("field native_client_id of message zigchain.tokenwrapper.BridgeRoute is not mutable"))
+	case "zigchain.tokenwrapper.BridgeRoute.counterparty_client_id":
+		panic(fmt.Errorf("field counterparty_client_id of message zigchain.tokenwrapper.BridgeRoute is not mutable"))
+	case "zigchain.tokenwrapper.BridgeRoute.counterparty_port":
+		panic(fmt.Errorf("field counterparty_port of message zigchain.tokenwrapper.BridgeRoute is not mutable"))
+	case "zigchain.tokenwrapper.BridgeRoute.counterparty_channel":
+		panic(fmt.Errorf("field counterparty_channel of message zigchain.tokenwrapper.BridgeRoute is not mutable"))
+	case "zigchain.tokenwrapper.BridgeRoute.denom":
+		panic(fmt.Errorf("field denom of message zigchain.tokenwrapper.BridgeRoute is not mutable"))
+	case "zigchain.tokenwrapper.BridgeRoute.native_denom":
+		panic(fmt.Errorf("field native_denom of message zigchain.tokenwrapper.BridgeRoute is not mutable"))
+	case "zigchain.tokenwrapper.BridgeRoute.decimal_difference":
+		panic(fmt.Errorf("field decimal_difference of message zigchain.tokenwrapper.BridgeRoute is not mutable"))
+	case "zigchain.tokenwrapper.BridgeRoute.enabled":
+		panic(fmt.Errorf("field enabled of message zigchain.tokenwrapper.BridgeRoute is not mutable"))
+	case "zigchain.tokenwrapper.BridgeRoute.total_transferred_in":
+		panic(fmt.Errorf("field total_transferred_in of message zigchain.tokenwrapper.BridgeRoute is not mutable"))
+	case "zigchain.tokenwrapper.BridgeRoute.total_transferred_out":
+		panic(fmt.Errorf("field total_transferred_out of message zigchain.tokenwrapper.BridgeRoute is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.BridgeRoute"))
+		}
+		panic(fmt.Errorf("message zigchain.tokenwrapper.BridgeRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BridgeRoute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.tokenwrapper.BridgeRoute.native_port":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.BridgeRoute.native_channel":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.BridgeRoute.native_client_id":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.BridgeRoute.counterparty_client_id":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.BridgeRoute.counterparty_port":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.BridgeRoute.counterparty_channel":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.BridgeRoute.denom":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.BridgeRoute.native_denom":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.BridgeRoute.decimal_difference":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "zigchain.tokenwrapper.BridgeRoute.enabled":
+		return protoreflect.ValueOfBool(false)
+	case "zigchain.tokenwrapper.BridgeRoute.total_transferred_in":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.BridgeRoute.total_transferred_out":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.BridgeRoute"))
+		}
+		panic(fmt.Errorf("message zigchain.tokenwrapper.BridgeRoute does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BridgeRoute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.tokenwrapper.BridgeRoute", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BridgeRoute) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BridgeRoute) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BridgeRoute) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BridgeRoute) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BridgeRoute)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.NativePort)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NativeChannel)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NativeClientId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CounterpartyClientId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CounterpartyPort)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CounterpartyChannel)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NativeDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DecimalDifference != 0 {
+			n += 1 + runtime.Sov(uint64(x.DecimalDifference))
+		}
+		if x.Enabled {
+			n += 2
+		}
+		l = len(x.TotalTransferredIn)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TotalTransferredOut)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BridgeRoute)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TotalTransferredOut) > 0 {
+			i -= len(x.TotalTransferredOut)
+			copy(dAtA[i:], x.TotalTransferredOut)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalTransferredOut)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.TotalTransferredIn) > 0 {
+			i -= len(x.TotalTransferredIn)
+			copy(dAtA[i:], x.TotalTransferredIn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalTransferredIn)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.DecimalDifference != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DecimalDifference))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.NativeDenom) > 0 {
+			i -= len(x.NativeDenom)
+			copy(dAtA[i:], x.NativeDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NativeDenom)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.CounterpartyChannel) > 0 {
+			i -= len(x.CounterpartyChannel)
+			copy(dAtA[i:], x.CounterpartyChannel)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CounterpartyChannel)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.CounterpartyPort) > 0 {
+			i -= len(x.CounterpartyPort)
+			copy(dAtA[i:], x.CounterpartyPort)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CounterpartyPort)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.CounterpartyClientId) > 0 {
+			i -= len(x.CounterpartyClientId)
+			copy(dAtA[i:], x.CounterpartyClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CounterpartyClientId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.NativeClientId) > 0 {
+			i -= len(x.NativeClientId)
+			copy(dAtA[i:], x.NativeClientId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NativeClientId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.NativeChannel) > 0 {
+			i -= len(x.NativeChannel)
+			copy(dAtA[i:], x.NativeChannel)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NativeChannel)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.NativePort) > 0 {
+			i -= len(x.NativePort)
+			copy(dAtA[i:], x.NativePort)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NativePort)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BridgeRoute)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeRoute: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BridgeRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NativePort", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NativePort = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NativeChannel", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NativeChannel = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NativeClientId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NativeClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CounterpartyClientId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CounterpartyClientId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPort", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CounterpartyPort = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannel", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CounterpartyChannel = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NativeDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecimalDifference", wireType)
+				}
+				x.DecimalDifference = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DecimalDifference |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalTransferredIn", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalTransferredIn = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalTransferredOut", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalTransferredOut = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: zigchain/tokenwrapper/bridge_route.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BridgeRoute defines the IBC settings of a channel the module wraps a native
+// denom over, keyed by its native port and channel
+type BridgeRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// native_port defines the port ID of the source chain
+	NativePort string `protobuf:"bytes,1,opt,name=native_port,json=nativePort,proto3" json:"native_port,omitempty"`
+	// native_channel defines the channel ID of the source chain
+	NativeChannel string `protobuf:"bytes,2,opt,name=native_channel,json=nativeChannel,proto3" json:"native_channel,omitempty"`
+	// native_client_id defines the client ID of the source chain
+	NativeClientId string `protobuf:"bytes,3,opt,name=native_client_id,json=nativeClientId,proto3" json:"native_client_id,omitempty"`
+	// counterparty_client_id defines the client ID of the destination chain
+	CounterpartyClientId string `protobuf:"bytes,4,opt,name=counterparty_client_id,json=counterpartyClientId,proto3" json:"counterparty_client_id,omitempty"`
+	// counterparty_port defines the port ID of the destination chain
+	CounterpartyPort string `protobuf:"bytes,5,opt,name=counterparty_port,json=counterpartyPort,proto3" json:"counterparty_port,omitempty"`
+	// counterparty_channel defines the channel ID of the destination chain
+	CounterpartyChannel string `protobuf:"bytes,6,opt,name=counterparty_channel,json=counterpartyChannel,proto3" json:"counterparty_channel,omitempty"`
+	// denom defines the denomination of the token on the counterparty chain
+	Denom string `protobuf:"bytes,7,opt,name=denom,proto3" json:"denom,omitempty"`
+	// native_denom defines the denomination the IBC vouchers of the route are
+	// wrapped into (e.g. uzig)
+	NativeDenom string `protobuf:"bytes,8,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty"`
+	// decimal difference between the counterparty token (e.g. 18 decimals) and
+	// the native denom (e.g. 6 decimals)
+	DecimalDifference uint32 `protobuf:"varint,9,opt,name=decimal_difference,json=decimalDifference,proto3" json:"decimal_difference,omitempty"`
+	// enabled defines whether the route wraps its transfers
+	Enabled bool `protobuf:"varint,10,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// total_transferred_in defines the total amount of native tokens transferred
+	// in over the route
+	TotalTransferredIn string `protobuf:"bytes,11,opt,name=total_transferred_in,json=totalTransferredIn,proto3" json:"total_transferred_in,omitempty"`
+	// total_transferred_out defines the total amount of native tokens
+	// transferred out over the route
+	TotalTransferredOut string `protobuf:"bytes,12,opt,name=total_transferred_out,json=totalTransferredOut,proto3" json:"total_transferred_out,omitempty"`
+}
+
+func (x *BridgeRoute) Reset() {
+	*x = BridgeRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_tokenwrapper_bridge_route_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BridgeRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeRoute) ProtoMessage() {}
+
+// Deprecated: Use BridgeRoute.ProtoReflect.Descriptor instead.
+func (*BridgeRoute) Descriptor() ([]byte, []int) {
+	return file_zigchain_tokenwrapper_bridge_route_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BridgeRoute) GetNativePort() string {
+	if x != nil {
+		return x.NativePort
+	}
+	return ""
+}
+
+func (x *BridgeRoute) GetNativeChannel() string {
+	if x != nil {
+		return x.NativeChannel
+	}
+	return ""
+}
+
+func (x *BridgeRoute) GetNativeClientId() string {
+	if x != nil {
+		return x.NativeClientId
+	}
+	return ""
+}
+
+func (x *BridgeRoute) GetCounterpartyClientId() string {
+	if x != nil {
+		return x.CounterpartyClientId
+	}
+	return ""
+}
+
+func (x *BridgeRoute) GetCounterpartyPort() string {
+	if x != nil {
+		return x.CounterpartyPort
+	}
+	return ""
+}
+
+func (x *BridgeRoute) GetCounterpartyChannel() string {
+	if x != nil {
+		return x.CounterpartyChannel
+	}
+	return ""
+}
+
+func (x *BridgeRoute) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *BridgeRoute) GetNativeDenom() string {
+	if x != nil {
+		return x.NativeDenom
+	}
+	return ""
+}
+
+func (x *BridgeRoute) GetDecimalDifference() uint32 {
+	if x != nil {
+		return x.DecimalDifference
+	}
+	return 0
+}
+
+func (x *BridgeRoute) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *BridgeRoute) GetTotalTransferredIn() string {
+	if x != nil {
+		return x.TotalTransferredIn
+	}
+	return ""
+}
+
+func (x *BridgeRoute) GetTotalTransferredOut() string {
+	if x != nil {
+		return x.TotalTransferredOut
+	}
+	return ""
+}
+
+var File_zigchain_tokenwrapper_bridge_route_proto protoreflect.FileDescriptor
+
+var file_zigchain_tokenwrapper_bridge_route_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x7a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbb, 0x04, 0x0a, 0x0b, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x28, 0x0a, 0x10, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x31, 0x0a, 0x14,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x44, 0x69, 0x66,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x4f, 0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x12,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x49, 0x6e, 0x12, 0x51, 0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0x52, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72,
+	0x65, 0x64, 0x4f, 0x75, 0x74, 0x42, 0xca, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x69,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x72, 0x42, 0x10, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0xa2,
+	0x02, 0x03, 0x5a, 0x54, 0x58, 0xaa, 0x02, 0x15, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0xca, 0x02, 0x15,
+	0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0xe2, 0x02, 0x21, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x5a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_zigchain_tokenwrapper_bridge_route_proto_rawDescOnce sync.Once
+	file_zigchain_tokenwrapper_bridge_route_proto_rawDescData = file_zigchain_tokenwrapper_bridge_route_proto_rawDesc
+)
+
+func file_zigchain_tokenwrapper_bridge_route_proto_rawDescGZIP() []byte {
+	file_zigchain_tokenwrapper_bridge_route_proto_rawDescOnce.Do(func() {
+		file_zigchain_tokenwrapper_bridge_route_proto_rawDescData = protoimpl.X.CompressGZIP(file_zigchain_tokenwrapper_bridge_route_proto_rawDescData)
+	})
+	return file_zigchain_tokenwrapper_bridge_route_proto_rawDescData
+}
+
+var file_zigchain_tokenwrapper_bridge_route_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_zigchain_tokenwrapper_bridge_route_proto_goTypes = []interface{}{
+	(*BridgeRoute)(nil), // 0: zigchain.tokenwrapper.BridgeRoute
+}
+var file_zigchain_tokenwrapper_bridge_route_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_zigchain_tokenwrapper_bridge_route_proto_init() }
+func file_zigchain_tokenwrapper_bridge_route_proto_init() {
+	if File_zigchain_tokenwrapper_bridge_route_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_zigchain_tokenwrapper_bridge_route_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_zigchain_tokenwrapper_bridge_route_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_zigchain_tokenwrapper_bridge_route_proto_goTypes,
+		DependencyIndexes: file_zigchain_tokenwrapper_bridge_route_proto_depIdxs,
+		MessageInfos:      file_zigchain_tokenwrapper_bridge_route_proto_msgTypes,
+	}.Build()
+	File_zigchain_tokenwrapper_bridge_route_proto = out.File
+	file_zigchain_tokenwrapper_bridge_route_proto_rawDesc = nil
+	file_zigchain_tokenwrapper_bridge_route_proto_goTypes = nil
+	file_zigchain_tokenwrapper_bridge_route_proto_depIdxs = nil
+}
@@ -542,6 +542,312 @@ func (x *_GenesisState_6_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*BridgeRoute
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BridgeRoute)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BridgeRoute)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(BridgeRoute)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(BridgeRoute)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_17_list)(nil)
+
+type _GenesisState_17_list struct {
+	list *[]*VolumeCap
+}
+
+func (x *_GenesisState_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VolumeCap)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VolumeCap)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_17_list) AppendMutable() protoreflect.Value {
+	v := new(VolumeCap)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_17_list) NewElement() protoreflect.Value {
+	v := new(VolumeCap)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_18_list)(nil)
+
+type _GenesisState_18_list struct {
+	list *[]*VolumeBucket
+}
+
+func (x *_GenesisState_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VolumeBucket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VolumeBucket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_18_list) AppendMutable() protoreflect.Value {
+	v := new(VolumeBucket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_18_list) NewElement() protoreflect.Value {
+	v := new(VolumeBucket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_19_list)(nil)
+
+type _GenesisState_19_list struct {
+	list *[]*PendingUnwrap
+}
+
+func (x *_GenesisState_19_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingUnwrap)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingUnwrap)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_19_list) AppendMutable() protoreflect.Value {
+	v := new(PendingUnwrap)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_19_list) NewElement() protoreflect.Value {
+	v := new(PendingUnwrap)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_21_list)(nil)
+
+type _GenesisState_21_list struct {
+	list *[]*TransferRecord
+}
+
+func (x *_GenesisState_21_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_21_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TransferRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_21_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TransferRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_21_list) AppendMutable() protoreflect.Value {
+	v := new(TransferRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_21_list) NewElement() protoreflect.Value {
+	v := new(TransferRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_25_list)(nil)
+
+type _GenesisState_25_list struct {
+	list *[]*PendingBridgeFee
+}
+
+func (x *_GenesisState_25_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_25_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingBridgeFee)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_25_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingBridgeFee)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_25_list) AppendMutable() protoreflect.Value {
+	v := new(PendingBridgeFee)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_25_list) NewElement() protoreflect.Value {
+	v := new(PendingBridgeFee)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                           protoreflect.MessageDescriptor
 	fd_GenesisState_params                    protoreflect.FieldDescriptor
@@ -551,14 +857,16 @@ var (
 	fd_GenesisState_proposed_operator_address protoreflect.FieldDescriptor
 	fd_GenesisState_pauser_addresses          protoreflect.FieldDescriptor
 	fd_GenesisState_enabled                   protoreflect.FieldDescriptor
-	fd_GenesisState_native_client_id          protoreflect.FieldDescriptor
-	fd_GenesisState_native_port               protoreflect.FieldDescriptor
-	fd_GenesisState_native_channel            protoreflect.FieldDescriptor
-	fd_GenesisState_denom                     protoreflect.FieldDescriptor
-	fd_GenesisState_decimal_difference        protoreflect.FieldDescriptor
-	fd_GenesisState_counterparty_client_id    protoreflect.FieldDescriptor
-	fd_GenesisState_counterparty_port         protoreflect.FieldDescriptor
-	fd_GenesisState_counterparty_channel      protoreflect.FieldDescriptor
+	fd_GenesisState_routes                    protoreflect.FieldDescriptor
+	fd_GenesisState_volume_caps               protoreflect.FieldDescriptor
+	fd_GenesisState_volume_buckets            protoreflect.FieldDescriptor
+	fd_GenesisState_pending_unwraps           protoreflect.FieldDescriptor
+	fd_GenesisState_next_pending_unwrap_id    protoreflect.FieldDescriptor
+	fd_GenesisState_transfer_records          protoreflect.FieldDescriptor
+	fd_GenesisState_next_transfer_record_id   protoreflect.FieldDescriptor
+	fd_GenesisState_total_bridge_fees_in      protoreflect.FieldDescriptor
+	fd_GenesisState_total_bridge_fees_out     protoreflect.FieldDescriptor
+	fd_GenesisState_pending_bridge_fees       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -571,14 +879,16 @@ func init() {
 	fd_GenesisState_proposed_operator_address = md_GenesisState.Fields().ByName("proposed_operator_address")
 	fd_GenesisState_pauser_addresses = md_GenesisState.Fields().ByName("pauser_addresses")
 	fd_GenesisState_enabled = md_GenesisState.Fields().ByName("enabled")
-	fd_GenesisState_native_client_id = md_GenesisState.Fields().ByName("native_client_id")
-	fd_GenesisState_native_port = md_GenesisState.Fields().ByName("native_port")
-	fd_GenesisState_native_channel = md_GenesisState.Fields().ByName("native_channel")
-	fd_GenesisState_denom = md_GenesisState.Fields().ByName("denom")
-	fd_GenesisState_decimal_difference = md_GenesisState.Fields().ByName("decimal_difference")
-	fd_GenesisState_counterparty_client_id = md_GenesisState.Fields().ByName("counterparty_client_id")
-	fd_GenesisState_counterparty_port = md_GenesisState.Fields().ByName("counterparty_port")
-	fd_GenesisState_counterparty_channel = md_GenesisState.Fields().ByName("counterparty_channel")
+	fd_GenesisState_routes = md_GenesisState.Fields().ByName("routes")
+	fd_GenesisState_volume_caps = md_GenesisState.Fields().ByName("volume_caps")
+	fd_GenesisState_volume_buckets = md_GenesisState.Fields().ByName("volume_buckets")
+	fd_GenesisState_pending_unwraps = md_GenesisState.Fields().ByName("pending_unwraps")
+	fd_GenesisState_next_pending_unwrap_id = md_GenesisState.Fields().ByName("next_pending_unwrap_id")
+	fd_GenesisState_transfer_records = md_GenesisState.Fields().ByName("transfer_records")
+	fd_GenesisState_next_transfer_record_id = md_GenesisState.Fields().ByName("next_transfer_record_id")
+	fd_GenesisState_total_bridge_fees_in = md_GenesisState.Fields().ByName("total_bridge_fees_in")
+	fd_GenesisState_total_bridge_fees_out = md_GenesisState.Fields().ByName("total_bridge_fees_out")
+	fd_GenesisState_pending_bridge_fees = md_GenesisState.Fields().ByName("pending_bridge_fees")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -688,51 +998,63 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
-	if x.NativeClientId != "" {
-		value := protoreflect.ValueOfString(x.NativeClientId)
-		if !f(fd_GenesisState_native_client_id, value) {
+	if len(x.Routes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.Routes})
+		if !f(fd_GenesisState_routes, value) {
+			return
+		}
+	}
+	if len(x.VolumeCaps) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.VolumeCaps})
+		if !f(fd_GenesisState_volume_caps, value) {
+			return
+		}
+	}
+	if len(x.VolumeBuckets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_18_list{list: &x.VolumeBuckets})
+		if !f(fd_GenesisState_volume_buckets, value) {
 			return
 		}
 	}
-	if x.NativePort != "" {
-		value := protoreflect.ValueOfString(x.NativePort)
-		if !f(fd_GenesisState_native_port, value) {
+	if len(x.PendingUnwraps) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_19_list{list: &x.PendingUnwraps})
+		if !f(fd_GenesisState_pending_unwraps, value) {
 			return
 		}
 	}
-	if x.NativeChannel != "" {
-		value := protoreflect.ValueOfString(x.NativeChannel)
-		if !f(fd_GenesisState_native_channel, value) {
+	if x.NextPendingUnwrapId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextPendingUnwrapId)
+		if !f(fd_GenesisState_next_pending_unwrap_id, value) {
 			return
 		}
 	}
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_GenesisState_denom, value) {
+	if len(x.TransferRecords) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_21_list{list: &x.TransferRecords})
+		if !f(fd_GenesisState_transfer_records, value) {
 			return
 		}
 	}
-	if x.DecimalDifference != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.DecimalDifference)
-		if !f(fd_GenesisState_decimal_difference, value) {
+	if x.NextTransferRecordId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextTransferRecordId)
+		if !f(fd_GenesisState_next_transfer_record_id, value) {
 			return
 		}
 	}
-	if x.CounterpartyClientId != "" {
-		value := protoreflect.ValueOfString(x.CounterpartyClientId)
-		if !f(fd_GenesisState_counterparty_client_id, value) {
+	if x.TotalBridgeFeesIn != "" {
+		value := protoreflect.ValueOfString(x.TotalBridgeFeesIn)
+		if !f(fd_GenesisState_total_bridge_fees_in, value) {
 			return
 		}
 	}
-	if x.CounterpartyPort != "" {
-		value := protoreflect.ValueOfString(x.CounterpartyPort)
-		if !f(fd_GenesisState_counterparty_port, value) {
+	if x.TotalBridgeFeesOut != "" {
+		value := protoreflect.ValueOfString(x.TotalBridgeFeesOut)
+		if !f(fd_GenesisState_total_bridge_fees_out, value) {
 			return
 		}
 	}
-	if x.CounterpartyChannel != "" {
-		value := protoreflect.ValueOfString(x.CounterpartyChannel)
-		if !f(fd_GenesisState_counterparty_channel, value) {
+	if len(x.PendingBridgeFees) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_25_list{list: &x.PendingBridgeFees})
+		if !f(fd_GenesisState_pending_bridge_fees, value) {
 			return
 		}
 	}
@@ -765,22 +1087,26 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.PauserAddresses) != 0
 	case "zigchain.tokenwrapper.GenesisState.enabled":
 		return x.Enabled != false
-	case "zigchain.tokenwrapper.GenesisState.native_client_id":
-		return x.NativeClientId != ""
-	case "zigchain.tokenwrapper.GenesisState.native_port":
-		return x.NativePort != ""
-	case "zigchain.tokenwrapper.GenesisState.native_channel":
-		return x.NativeChannel != ""
-	case "zigchain.tokenwrapper.GenesisState.denom":
-		return x.Denom != ""
-	case "zigchain.tokenwrapper.GenesisState.decimal_difference":
-		return x.DecimalDifference != uint32(0)
-	case "zigchain.tokenwrapper.GenesisState.counterparty_client_id":
-		return x.CounterpartyClientId != ""
-	case "zigchain.tokenwrapper.GenesisState.counterparty_port":
-		return x.CounterpartyPort != ""
-	case "zigchain.tokenwrapper.GenesisState.counterparty_channel":
-		return x.CounterpartyChannel != ""
+	case "zigchain.tokenwrapper.GenesisState.routes":
+		return len(x.Routes) != 0
+	case "zigchain.tokenwrapper.GenesisState.volume_caps":
+		return len(x.VolumeCaps) != 0
+	case "zigchain.tokenwrapper.GenesisState.volume_buckets":
+		return len(x.VolumeBuckets) != 0
+	case "zigchain.tokenwrapper.GenesisState.pending_unwraps":
+		return len(x.PendingUnwraps) != 0
+	case "zigchain.tokenwrapper.GenesisState.next_pending_unwrap_id":
+		return x.NextPendingUnwrapId != uint64(0)
+	case "zigchain.tokenwrapper.GenesisState.transfer_records":
+		return len(x.TransferRecords) != 0
+	case "zigchain.tokenwrapper.GenesisState.next_transfer_record_id":
+		return x.NextTransferRecordId != uint64(0)
+	case "zigchain.tokenwrapper.GenesisState.total_bridge_fees_in":
+		return x.TotalBridgeFeesIn != ""
+	case "zigchain.tokenwrapper.GenesisState.total_bridge_fees_out":
+		return x.TotalBridgeFeesOut != ""
+	case "zigchain.tokenwrapper.GenesisState.pending_bridge_fees":
+		return len(x.PendingBridgeFees) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.GenesisState"))
@@ -811,22 +1137,26 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.PauserAddresses = nil
 	case "zigchain.tokenwrapper.GenesisState.enabled":
 		x.Enabled = false
-	case "zigchain.tokenwrapper.GenesisState.native_client_id":
-		x.NativeClientId = ""
-	case "zigchain.tokenwrapper.GenesisState.native_port":
-		x.NativePort = ""
-	case "zigchain.tokenwrapper.GenesisState.native_channel":
-		x.NativeChannel = ""
-	case "zigchain.tokenwrapper.GenesisState.denom":
-		x.Denom = ""
-	case "zigchain.tokenwrapper.GenesisState.decimal_difference":
-		x.DecimalDifference = uint32(0)
-	case "zigchain.tokenwrapper.GenesisState.counterparty_client_id":
-		x.CounterpartyClientId = ""
-	case "zigchain.tokenwrapper.GenesisState.counterparty_port":
-		x.CounterpartyPort = ""
-	case "zigchain.tokenwrapper.GenesisState.counterparty_channel":
-		x.CounterpartyChannel = ""
+	case "zigchain.tokenwrapper.GenesisState.routes":
+		x.Routes = nil
+	case "zigchain.tokenwrapper.GenesisState.volume_caps":
+		x.VolumeCaps = nil
+	case "zigchain.tokenwrapper.GenesisState.volume_buckets":
+		x.VolumeBuckets = nil
+	case "zigchain.tokenwrapper.GenesisState.pending_unwraps":
+		x.PendingUnwraps = nil
+	case "zigchain.tokenwrapper.GenesisState.next_pending_unwrap_id":
+		x.NextPendingUnwrapId = uint64(0)
+	case "zigchain.tokenwrapper.GenesisState.transfer_records":
+		x.TransferRecords = nil
+	case "zigchain.tokenwrapper.GenesisState.next_transfer_record_id":
+		x.NextTransferRecordId = uint64(0)
+	case "zigchain.tokenwrapper.GenesisState.total_bridge_fees_in":
+		x.TotalBridgeFeesIn = ""
+	case "zigchain.tokenwrapper.GenesisState.total_bridge_fees_out":
+		x.TotalBridgeFeesOut = ""
+	case "zigchain.tokenwrapper.GenesisState.pending_bridge_fees":
+		x.PendingBridgeFees = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.GenesisState"))
@@ -867,30 +1197,54 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "zigchain.tokenwrapper.GenesisState.enabled":
 		value := x.Enabled
 		return protoreflect.ValueOfBool(value)
-	case "zigchain.tokenwrapper.GenesisState.native_client_id":
-		value := x.NativeClientId
-		return protoreflect.ValueOfString(value)
-	case "zigchain.tokenwrapper.GenesisState.native_port":
-		value := x.NativePort
-		return protoreflect.ValueOfString(value)
-	case "zigchain.tokenwrapper.GenesisState.native_channel":
-		value := x.NativeChannel
-		return protoreflect.ValueOfString(value)
-	case "zigchain.tokenwrapper.GenesisState.denom":
-		value := x.Denom
-		return protoreflect.ValueOfString(value)
-	case "zigchain.tokenwrapper.GenesisState.decimal_difference":
-		value := x.DecimalDifference
-		return protoreflect.ValueOfUint32(value)
-	case "zigchain.tokenwrapper.GenesisState.counterparty_client_id":
-		value := x.CounterpartyClientId
-		return protoreflect.ValueOfString(value)
-	case "zigchain.tokenwrapper.GenesisState.counterparty_port":
-		value := x.CounterpartyPort
+	case "zigchain.tokenwrapper.GenesisState.routes":
+		if len(x.Routes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.Routes}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.tokenwrapper.GenesisState.volume_caps":
+		if len(x.VolumeCaps) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+		}
+		listValue := &_GenesisState_17_list{list: &x.VolumeCaps}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.tokenwrapper.GenesisState.volume_buckets":
+		if len(x.VolumeBuckets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_18_list{})
+		}
+		listValue := &_GenesisState_18_list{list: &x.VolumeBuckets}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.tokenwrapper.GenesisState.pending_unwraps":
+		if len(x.PendingUnwraps) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_19_list{})
+		}
+		listValue := &_GenesisState_19_list{list: &x.PendingUnwraps}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.tokenwrapper.GenesisState.next_pending_unwrap_id":
+		value := x.NextPendingUnwrapId
+		return protoreflect.ValueOfUint64(value)
+	case "zigchain.tokenwrapper.GenesisState.transfer_records":
+		if len(x.TransferRecords) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_21_list{})
+		}
+		listValue := &_GenesisState_21_list{list: &x.TransferRecords}
+		return protoreflect.ValueOfList(listValue)
+	case "zigchain.tokenwrapper.GenesisState.next_transfer_record_id":
+		value := x.NextTransferRecordId
+		return protoreflect.ValueOfUint64(value)
+	case "zigchain.tokenwrapper.GenesisState.total_bridge_fees_in":
+		value := x.TotalBridgeFeesIn
 		return protoreflect.ValueOfString(value)
-	case "zigchain.tokenwrapper.GenesisState.counterparty_channel":
-		value := x.CounterpartyChannel
+	case "zigchain.tokenwrapper.GenesisState.total_bridge_fees_out":
+		value := x.TotalBridgeFeesOut
 		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.GenesisState.pending_bridge_fees":
+		if len(x.PendingBridgeFees) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_25_list{})
+		}
+		listValue := &_GenesisState_25_list{list: &x.PendingBridgeFees}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.GenesisState"))
@@ -927,22 +1281,38 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.PauserAddresses = *clv.list
 	case "zigchain.tokenwrapper.GenesisState.enabled":
 		x.Enabled = value.Bool()
-	case "zigchain.tokenwrapper.GenesisState.native_client_id":
-		x.NativeClientId = value.Interface().(string)
-	case "zigchain.tokenwrapper.GenesisState.native_port":
-		x.NativePort = value.Interface().(string)
-	case "zigchain.tokenwrapper.GenesisState.native_channel":
-		x.NativeChannel = value.Interface().(string)
-	case "zigchain.tokenwrapper.GenesisState.denom":
-		x.Denom = value.Interface().(string)
-	case "zigchain.tokenwrapper.GenesisState.decimal_difference":
-		x.DecimalDifference = uint32(value.Uint())
-	case "zigchain.tokenwrapper.GenesisState.counterparty_client_id":
-		x.CounterpartyClientId = value.Interface().(string)
-	case "zigchain.tokenwrapper.GenesisState.counterparty_port":
-		x.CounterpartyPort = value.Interface().(string)
-	case "zigchain.tokenwrapper.GenesisState.counterparty_channel":
-		x.CounterpartyChannel = value.Interface().(string)
+	case "zigchain.tokenwrapper.GenesisState.routes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.Routes = *clv.list
+	case "zigchain.tokenwrapper.GenesisState.volume_caps":
+		lv := value.List()
+		clv := lv.(*_GenesisState_17_list)
+		x.VolumeCaps = *clv.list
+	case "zigchain.tokenwrapper.GenesisState.volume_buckets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_18_list)
+		x.VolumeBuckets = *clv.list
+	case "zigchain.tokenwrapper.GenesisState.pending_unwraps":
+		lv := value.List()
+		clv := lv.(*_GenesisState_19_list)
+		x.PendingUnwraps = *clv.list
+	case "zigchain.tokenwrapper.GenesisState.next_pending_unwrap_id":
+		x.NextPendingUnwrapId = value.Uint()
+	case "zigchain.tokenwrapper.GenesisState.transfer_records":
+		lv := value.List()
+		clv := lv.(*_GenesisState_21_list)
+		x.TransferRecords = *clv.list
+	case "zigchain.tokenwrapper.GenesisState.next_transfer_record_id":
+		x.NextTransferRecordId = value.Uint()
+	case "zigchain.tokenwrapper.GenesisState.total_bridge_fees_in":
+		x.TotalBridgeFeesIn = value.Interface().(string)
+	case "zigchain.tokenwrapper.GenesisState.total_bridge_fees_out":
+		x.TotalBridgeFeesOut = value.Interface().(string)
+	case "zigchain.tokenwrapper.GenesisState.pending_bridge_fees":
+		lv := value.List()
+		clv := lv.(*_GenesisState_25_list)
+		x.PendingBridgeFees = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.GenesisState"))
@@ -974,6 +1344,42 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_6_list{list: &x.PauserAddresses}
 		return protoreflect.ValueOfList(value)
+	case "zigchain.tokenwrapper.GenesisState.routes":
+		if x.Routes == nil {
+			x.Routes = []*BridgeRoute{}
+		}
+		value := &_GenesisState_16_list{list: &x.Routes}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.tokenwrapper.GenesisState.volume_caps":
+		if x.VolumeCaps == nil {
+			x.VolumeCaps = []*VolumeCap{}
+		}
+		value := &_GenesisState_17_list{list: &x.VolumeCaps}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.tokenwrapper.GenesisState.volume_buckets":
+		if x.VolumeBuckets == nil {
+			x.VolumeBuckets = []*VolumeBucket{}
+		}
+		value := &_GenesisState_18_list{list: &x.VolumeBuckets}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.tokenwrapper.GenesisState.pending_unwraps":
+		if x.PendingUnwraps == nil {
+			x.PendingUnwraps = []*PendingUnwrap{}
+		}
+		value := &_GenesisState_19_list{list: &x.PendingUnwraps}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.tokenwrapper.GenesisState.transfer_records":
+		if x.TransferRecords == nil {
+			x.TransferRecords = []*TransferRecord{}
+		}
+		value := &_GenesisState_21_list{list: &x.TransferRecords}
+		return protoreflect.ValueOfList(value)
+	case "zigchain.tokenwrapper.GenesisState.pending_bridge_fees":
+		if x.PendingBridgeFees == nil {
+			x.PendingBridgeFees = []*PendingBridgeFee{}
+		}
+		value := &_GenesisState_25_list{list: &x.PendingBridgeFees}
+		return protoreflect.ValueOfList(value)
 	case "zigchain.tokenwrapper.GenesisState.total_transferred_in":
 		panic(fmt.Errorf("field total_transferred_in of message zigchain.tokenwrapper.GenesisState is not mutable"))
 	case "zigchain.tokenwrapper.GenesisState.total_transferred_out":
@@ -984,22 +1390,14 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		panic(fmt.Errorf("field proposed_operator_address of message zigchain.tokenwrapper.GenesisState is not mutable"))
 	case "zigchain.tokenwrapper.GenesisState.enabled":
 		panic(fmt.Errorf("field enabled of message zigchain.tokenwrapper.GenesisState is not mutable"))
-	case "zigchain.tokenwrapper.GenesisState.native_client_id":
-		panic(fmt.Errorf("field native_client_id of message zigchain.tokenwrapper.GenesisState is not mutable"))
-	case "zigchain.tokenwrapper.GenesisState.native_port":
-		panic(fmt.Errorf("field native_port of message zigchain.tokenwrapper.GenesisState is not mutable"))
-	case "zigchain.tokenwrapper.GenesisState.native_channel":
-		panic(fmt.Errorf("field native_channel of message zigchain.tokenwrapper.GenesisState is not mutable"))
-	case "zigchain.tokenwrapper.GenesisState.denom":
-		panic(fmt.Errorf("field denom of message zigchain.tokenwrapper.GenesisState is not mutable"))
-	case "zigchain.tokenwrapper.GenesisState.decimal_difference":
-		panic(fmt.Errorf("field decimal_difference of message zigchain.tokenwrapper.GenesisState is not mutable"))
-	case "zigchain.tokenwrapper.GenesisState.counterparty_client_id":
-		panic(fmt.Errorf("field counterparty_client_id of message zigchain.tokenwrapper.GenesisState is not mutable"))
-	case "zigchain.tokenwrapper.GenesisState.counterparty_port":
-		panic(fmt.Errorf("field counterparty_port of message zigchain.tokenwrapper.GenesisState is not mutable"))
-	case "zigchain.tokenwrapper.GenesisState.counterparty_channel":
-		panic(fmt.Errorf("field counterparty_channel of message zigchain.tokenwrapper.GenesisState is not mutable"))
+	case "zigchain.tokenwrapper.GenesisState.next_pending_unwrap_id":
+		panic(fmt.Errorf("field next_pending_unwrap_id of message zigchain.tokenwrapper.GenesisState is not mutable"))
+	case "zigchain.tokenwrapper.GenesisState.next_transfer_record_id":
+		panic(fmt.Errorf("field next_transfer_record_id of message zigchain.tokenwrapper.GenesisState is not mutable"))
+	case "zigchain.tokenwrapper.GenesisState.total_bridge_fees_in":
+		panic(fmt.Errorf("field total_bridge_fees_in of message zigchain.tokenwrapper.GenesisState is not mutable"))
+	case "zigchain.tokenwrapper.GenesisState.total_bridge_fees_out":
+		panic(fmt.Errorf("field total_bridge_fees_out of message zigchain.tokenwrapper.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.GenesisState"))
@@ -1029,22 +1427,32 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
 	case "zigchain.tokenwrapper.GenesisState.enabled":
 		return protoreflect.ValueOfBool(false)
-	case "zigchain.tokenwrapper.GenesisState.native_client_id":
-		return protoreflect.ValueOfString("")
-	case "zigchain.tokenwrapper.GenesisState.native_port":
-		return protoreflect.ValueOfString("")
-	case "zigchain.tokenwrapper.GenesisState.native_channel":
-		return protoreflect.ValueOfString("")
-	case "zigchain.tokenwrapper.GenesisState.denom":
-		return protoreflect.ValueOfString("")
-	case "zigchain.tokenwrapper.GenesisState.decimal_difference":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "zigchain.tokenwrapper.GenesisState.counterparty_client_id":
-		return protoreflect.ValueOfString("")
-	case "zigchain.tokenwrapper.GenesisState.counterparty_port":
+	case "zigchain.tokenwrapper.GenesisState.routes":
+		list := []*BridgeRoute{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	case "zigchain.tokenwrapper.GenesisState.volume_caps":
+		list := []*VolumeCap{}
+		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
+	case "zigchain.tokenwrapper.GenesisState.volume_buckets":
+		list := []*VolumeBucket{}
+		return protoreflect.ValueOfList(&_GenesisState_18_list{list: &list})
+	case "zigchain.tokenwrapper.GenesisState.pending_unwraps":
+		list := []*PendingUnwrap{}
+		return protoreflect.ValueOfList(&_GenesisState_19_list{list: &list})
+	case "zigchain.tokenwrapper.GenesisState.next_pending_unwrap_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zigchain.tokenwrapper.GenesisState.transfer_records":
+		list := []*TransferRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_21_list{list: &list})
+	case "zigchain.tokenwrapper.GenesisState.next_transfer_record_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zigchain.tokenwrapper.GenesisState.total_bridge_fees_in":
 		return protoreflect.ValueOfString("")
-	case "zigchain.tokenwrapper.GenesisState.counterparty_channel":
+	case "zigchain.tokenwrapper.GenesisState.total_bridge_fees_out":
 		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.GenesisState.pending_bridge_fees":
+		list := []*PendingBridgeFee{}
+		return protoreflect.ValueOfList(&_GenesisState_25_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.GenesisState"))
@@ -1143,36 +1551,55 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if x.Enabled {
 			n += 2
 		}
-		l = len(x.NativeClientId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Routes) > 0 {
+			for _, e := range x.Routes {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.NativePort)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.VolumeCaps) > 0 {
+			for _, e := range x.VolumeCaps {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.NativeChannel)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.VolumeBuckets) > 0 {
+			for _, e := range x.VolumeBuckets {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.Denom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.PendingUnwraps) > 0 {
+			for _, e := range x.PendingUnwraps {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if x.DecimalDifference != 0 {
-			n += 1 + runtime.Sov(uint64(x.DecimalDifference))
+		if x.NextPendingUnwrapId != 0 {
+			n += 2 + runtime.Sov(uint64(x.NextPendingUnwrapId))
 		}
-		l = len(x.CounterpartyClientId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.TransferRecords) > 0 {
+			for _, e := range x.TransferRecords {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.CounterpartyPort)
+		if x.NextTransferRecordId != 0 {
+			n += 2 + runtime.Sov(uint64(x.NextTransferRecordId))
+		}
+		l = len(x.TotalBridgeFeesIn)
 		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+			n += 2 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.CounterpartyChannel)
+		l = len(x.TotalBridgeFeesOut)
 		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PendingBridgeFees) > 0 {
+			for _, e := range x.PendingBridgeFees {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1203,59 +1630,145 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.CounterpartyChannel) > 0 {
-			i -= len(x.CounterpartyChannel)
-			copy(dAtA[i:], x.CounterpartyChannel)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CounterpartyChannel)))
-			i--
-			dAtA[i] = 0x7a
+		if len(x.PendingBridgeFees) > 0 {
+			for iNdEx := len(x.PendingBridgeFees) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingBridgeFees[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xca
+			}
 		}
-		if len(x.CounterpartyPort) > 0 {
-			i -= len(x.CounterpartyPort)
-			copy(dAtA[i:], x.CounterpartyPort)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CounterpartyPort)))
+		if len(x.TotalBridgeFeesOut) > 0 {
+			i -= len(x.TotalBridgeFeesOut)
+			copy(dAtA[i:], x.TotalBridgeFeesOut)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalBridgeFeesOut)))
 			i--
-			dAtA[i] = 0x72
-		}
-		if len(x.CounterpartyClientId) > 0 {
-			i -= len(x.CounterpartyClientId)
-			copy(dAtA[i:], x.CounterpartyClientId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CounterpartyClientId)))
+			dAtA[i] = 0x1
 			i--
-			dAtA[i] = 0x6a
+			dAtA[i] = 0xc2
 		}
-		if x.DecimalDifference != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DecimalDifference))
+		if len(x.TotalBridgeFeesIn) > 0 {
+			i -= len(x.TotalBridgeFeesIn)
+			copy(dAtA[i:], x.TotalBridgeFeesIn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalBridgeFeesIn)))
 			i--
-			dAtA[i] = 0x60
-		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			dAtA[i] = 0x1
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0xba
 		}
-		if len(x.NativeChannel) > 0 {
-			i -= len(x.NativeChannel)
-			copy(dAtA[i:], x.NativeChannel)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NativeChannel)))
+		if x.NextTransferRecordId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextTransferRecordId))
 			i--
-			dAtA[i] = 0x52
-		}
-		if len(x.NativePort) > 0 {
-			i -= len(x.NativePort)
-			copy(dAtA[i:], x.NativePort)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NativePort)))
+			dAtA[i] = 0x1
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0xb0
 		}
-		if len(x.NativeClientId) > 0 {
-			i -= len(x.NativeClientId)
-			copy(dAtA[i:], x.NativeClientId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NativeClientId)))
+		if len(x.TransferRecords) > 0 {
+			for iNdEx := len(x.TransferRecords) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TransferRecords[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xaa
+			}
+		}
+		if x.NextPendingUnwrapId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextPendingUnwrapId))
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if len(x.PendingUnwraps) > 0 {
+			for iNdEx := len(x.PendingUnwraps) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingUnwraps[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if len(x.VolumeBuckets) > 0 {
+			for iNdEx := len(x.VolumeBuckets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VolumeBuckets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.VolumeCaps) > 0 {
+			for iNdEx := len(x.VolumeCaps) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VolumeCaps[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if len(x.Routes) > 0 {
+			for iNdEx := len(x.Routes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Routes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
 		}
 		if x.Enabled {
 			i--
@@ -1583,11 +2096,11 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.Enabled = bool(v != 0)
-			case 8:
+			case 16:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NativeClientId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1597,29 +2110,31 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NativeClientId = string(dAtA[iNdEx:postIndex])
+				x.Routes = append(x.Routes, &BridgeRoute{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Routes[len(x.Routes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 9:
+			case 17:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NativePort", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VolumeCaps", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1629,29 +2144,31 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NativePort = string(dAtA[iNdEx:postIndex])
+				x.VolumeCaps = append(x.VolumeCaps, &VolumeCap{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VolumeCaps[len(x.VolumeCaps)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 10:
+			case 18:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NativeChannel", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VolumeBuckets", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1661,29 +2178,31 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NativeChannel = string(dAtA[iNdEx:postIndex])
+				x.VolumeBuckets = append(x.VolumeBuckets, &VolumeBucket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VolumeBuckets[len(x.VolumeBuckets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 11:
+			case 19:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingUnwraps", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1693,29 +2212,31 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
+				x.PendingUnwraps = append(x.PendingUnwraps, &PendingUnwrap{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingUnwraps[len(x.PendingUnwraps)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 12:
+			case 20:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecimalDifference", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextPendingUnwrapId", wireType)
 				}
-				x.DecimalDifference = 0
+				x.NextPendingUnwrapId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1725,16 +2246,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.DecimalDifference |= uint32(b&0x7F) << shift
+					x.NextPendingUnwrapId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 13:
+			case 21:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CounterpartyClientId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferRecords", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1744,27 +2265,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CounterpartyClientId = string(dAtA[iNdEx:postIndex])
+				x.TransferRecords = append(x.TransferRecords, &TransferRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TransferRecords[len(x.TransferRecords)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 14:
+			case 22:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextTransferRecordId", wireType)
+				}
+				x.NextTransferRecordId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextTransferRecordId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 23:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPort", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalBridgeFeesIn", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1792,11 +2334,11 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CounterpartyPort = string(dAtA[iNdEx:postIndex])
+				x.TotalBridgeFeesIn = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 15:
+			case 24:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannel", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalBridgeFeesOut", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1824,7 +2366,41 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CounterpartyChannel = string(dAtA[iNdEx:postIndex])
+				x.TotalBridgeFeesOut = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 25:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingBridgeFees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingBridgeFees = append(x.PendingBridgeFees, &PendingBridgeFee{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingBridgeFees[len(x.PendingBridgeFees)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1934,23 +2510,30 @@ type GenesisState struct {
 	PauserAddresses []string `protobuf:"bytes,6,rep,name=pauser_addresses,json=pauserAddresses,proto3" json:"pauser_addresses,omitempty"`
 	// enabled defines whether the token wrapper functionality is enabled
 	Enabled bool `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	// native_client_id defines the client ID of the source chain
-	NativeClientId string `protobuf:"bytes,8,opt,name=native_client_id,json=nativeClientId,proto3" json:"native_client_id,omitempty"`
-	// native_port defines the port ID of the source chain
-	NativePort string `protobuf:"bytes,9,opt,name=native_port,json=nativePort,proto3" json:"native_port,omitempty"`
-	// native_channel defines the channel ID of the source chain
-	NativeChannel string `protobuf:"bytes,10,opt,name=native_channel,json=nativeChannel,proto3" json:"native_channel,omitempty"`
-	// denom defines the denomination of the token
-	Denom string `protobuf:"bytes,11,opt,name=denom,proto3" json:"denom,omitempty"`
-	// decimal difference between Axelar ZIG (18 decimals) and ZIGChain native ZIG
-	// (6 decimals)
-	DecimalDifference uint32 `protobuf:"varint,12,opt,name=decimal_difference,json=decimalDifference,proto3" json:"decimal_difference,omitempty"`
-	// counterparty_client_id defines the client ID of the destination chain
-	CounterpartyClientId string `protobuf:"bytes,13,opt,name=counterparty_client_id,json=counterpartyClientId,proto3" json:"counterparty_client_id,omitempty"`
-	// counterparty_port defines the port ID of the destination chain
-	CounterpartyPort string `protobuf:"bytes,14,opt,name=counterparty_port,json=counterpartyPort,proto3" json:"counterparty_port,omitempty"`
-	// counterparty_channel defines the channel ID of the destination chain
-	CounterpartyChannel string `protobuf:"bytes,15,opt,name=counterparty_channel,json=counterpartyChannel,proto3" json:"counterparty_channel,omitempty"`
+	// routes defines the bridge routes the module wraps transfers over
+	Routes []*BridgeRoute `protobuf:"bytes,16,rep,name=routes,proto3" json:"routes,omitempty"`
+	// volume_caps defines the volume caps of the bridge routes
+	VolumeCaps []*VolumeCap `protobuf:"bytes,17,rep,name=volume_caps,json=volumeCaps,proto3" json:"volume_caps,omitempty"`
+	// volume_buckets defines the buckets of the rolling windows of the volume
+	// caps
+	VolumeBuckets []*VolumeBucket `protobuf:"bytes,18,rep,name=volume_buckets,json=volumeBuckets,proto3" json:"volume_buckets,omitempty"`
+	// pending_unwraps defines the queue of pending unwraps
+	PendingUnwraps []*PendingUnwrap `protobuf:"bytes,19,rep,name=pending_unwraps,json=pendingUnwraps,proto3" json:"pending_unwraps,omitempty"`
+	// next_pending_unwrap_id defines the id of the next pending unwrap
+	NextPendingUnwrapId uint64 `protobuf:"varint,20,opt,name=next_pending_unwrap_id,json=nextPendingUnwrapId,proto3" json:"next_pending_unwrap_id,omitempty"`
+	// transfer_records defines the ledger of the wrapped transfers
+	TransferRecords []*TransferRecord `protobuf:"bytes,21,rep,name=transfer_records,json=transferRecords,proto3" json:"transfer_records,omitempty"`
+	// next_transfer_record_id defines the id of the next transfer record
+	NextTransferRecordId uint64 `protobuf:"varint,22,opt,name=next_transfer_record_id,json=nextTransferRecordId,proto3" json:"next_transfer_record_id,omitempty"`
+	// total_bridge_fees_in defines the total bridge fees paid on the incoming
+	// transfers
+	TotalBridgeFeesIn string `protobuf:"bytes,23,opt,name=total_bridge_fees_in,json=totalBridgeFeesIn,proto3" json:"total_bridge_fees_in,omitempty"`
+	// total_bridge_fees_out defines the total bridge fees paid on the outgoing
+	// transfers once acknowledged
+	TotalBridgeFeesOut string `protobuf:"bytes,24,opt,name=total_bridge_fees_out,json=totalBridgeFeesOut,proto3" json:"total_bridge_fees_out,omitempty"`
+	// pending_bridge_fees defines the bridge fees escrowed for the outgoing
+	// transfers waiting for their acknowledgement
+	PendingBridgeFees []*PendingBridgeFee `protobuf:"bytes,25,rep,name=pending_bridge_fees,json=pendingBridgeFees,proto3" json:"pending_bridge_fees,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -2022,62 +2605,76 @@ func (x *GenesisState) GetEnabled() bool {
 	return false
 }
 
-func (x *GenesisState) GetNativeClientId() string {
+func (x *GenesisState) GetRoutes() []*BridgeRoute {
 	if x != nil {
-		return x.NativeClientId
+		return x.Routes
 	}
-	return ""
+	return nil
 }
 
-func (x *GenesisState) GetNativePort() string {
+func (x *GenesisState) GetVolumeCaps() []*VolumeCap {
 	if x != nil {
-		return x.NativePort
+		return x.VolumeCaps
 	}
-	return ""
+	return nil
 }
 
-func (x *GenesisState) GetNativeChannel() string {
+func (x *GenesisState) GetVolumeBuckets() []*VolumeBucket {
 	if x != nil {
-		return x.NativeChannel
+		return x.VolumeBuckets
 	}
-	return ""
+	return nil
 }
 
-func (x *GenesisState) GetDenom() string {
+func (x *GenesisState) GetPendingUnwraps() []*PendingUnwrap {
 	if x != nil {
-		return x.Denom
+		return x.PendingUnwraps
 	}
-	return ""
+	return nil
 }
 
-func (x *GenesisState) GetDecimalDifference() uint32 {
+func (x *GenesisState) GetNextPendingUnwrapId() uint64 {
 	if x != nil {
-		return x.DecimalDifference
+		return x.NextPendingUnwrapId
 	}
 	return 0
 }
 
-func (x *GenesisState) GetCounterpartyClientId() string {
+func (x *GenesisState) GetTransferRecords() []*TransferRecord {
 	if x != nil {
-		return x.CounterpartyClientId
+		return x.TransferRecords
 	}
-	return ""
+	return nil
+}
+
+func (x *GenesisState) GetNextTransferRecordId() uint64 {
+	if x != nil {
+		return x.NextTransferRecordId
+	}
+	return 0
 }
 
-func (x *GenesisState) GetCounterpartyPort() string {
+func (x *GenesisState) GetTotalBridgeFeesIn() string {
 	if x != nil {
-		return x.CounterpartyPort
+		return x.TotalBridgeFeesIn
 	}
 	return ""
 }
 
-func (x *GenesisState) GetCounterpartyChannel() string {
+func (x *GenesisState) GetTotalBridgeFeesOut() string {
 	if x != nil {
-		return x.CounterpartyChannel
+		return x.TotalBridgeFeesOut
 	}
 	return ""
 }
 
+func (x *GenesisState) GetPendingBridgeFees() []*PendingBridgeFee {
+	if x != nil {
+		return x.PendingBridgeFees
+	}
+	return nil
+}
+
 var File_zigchain_tokenwrapper_genesis_proto protoreflect.FileDescriptor
 
 var file_zigchain_tokenwrapper_genesis_proto_rawDesc = []byte{
@@ -2091,78 +2688,127 @@ var file_zigchain_tokenwrapper_genesis_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x22, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
 	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
-	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x49, 0x0a, 0x0f, 0x50, 0x61, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22,
-	0xbb, 0x06, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x40, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
-	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x4f, 0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52,
-	0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65,
-	0x64, 0x49, 0x6e, 0x12, 0x51, 0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
-	0x74, 0x52, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x72, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x43, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x54, 0x0a, 0x19, 0x70,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2f, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26,
+	0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x2f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x63, 0x61, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2f, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x2b, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x26, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x66, 0x65,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x49, 0x0a, 0x0f, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x22, 0xf6, 0x0a, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4f, 0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x64, 0x49, 0x6e, 0x12, 0x51, 0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0x52, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x43, 0x0a, 0x10, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x54,
+	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x17, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18,
 	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x17, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x65, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x43, 0x0a, 0x10, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x12, 0x28, 0x0a, 0x10, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x61, 0x74, 0x69,
-	0x76, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61,
-	0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6e,
-	0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65, 0x63, 0x69,
-	0x6d, 0x61, 0x6c, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x44, 0x69, 0x66,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
-	0x70, 0x61, 0x72, 0x74, 0x79, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a,
-	0x11, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x70, 0x6f,
-	0x72, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
-	0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
-	0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0xc6, 0x01,
-	0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x42, 0x0c, 0x47, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x69,
-	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70,
-	0x70, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x5a, 0x54, 0x58, 0xaa, 0x02, 0x15, 0x5a, 0x69, 0x67, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x72, 0xca, 0x02, 0x15, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0xe2, 0x02, 0x21, 0x5a, 0x69, 0x67, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x72, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16,
-	0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x77,
-	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x10, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f,
+	0x63, 0x61, 0x70, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x7a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x61, 0x70, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x61, 0x70, 0x73, 0x12, 0x50,
+	0x0a, 0x0e, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2e, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0d, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x12, 0x53, 0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x77, 0x72,
+	0x61, 0x70, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x7a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e,
+	0x77, 0x72, 0x61, 0x70, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x55, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x49, 0x64, 0x12, 0x56, 0x0a, 0x10, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x15,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x14, 0x6e, 0x65, 0x78, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x14, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x69,
+	0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x46, 0x65, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x50, 0x0a, 0x15, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6f,
+	0x75, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x46, 0x65, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x5d, 0x0a, 0x13, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x66, 0x65,
+	0x65, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x46, 0x65,
+	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x46, 0x65, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x10,
+	0x52, 0x10, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x52, 0x0b, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x0e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x12, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x5f,
+	0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x16, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x52, 0x11, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x14, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0xc6, 0x01, 0x0a, 0x19,
+	0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0xa2, 0x02, 0x03, 0x5a, 0x54, 0x58, 0xaa, 0x02, 0x15, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0xca,
+	0x02, 0x15, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0xe2, 0x02, 0x21, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x5a, 0x69,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2179,17 +2825,29 @@ func file_zigchain_tokenwrapper_genesis_proto_rawDescGZIP() []byte {
 
 var file_zigchain_tokenwrapper_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_zigchain_tokenwrapper_genesis_proto_goTypes = []interface{}{
-	(*PauserAddresses)(nil), // 0: zigchain.tokenwrapper.PauserAddresses
-	(*GenesisState)(nil),    // 1: zigchain.tokenwrapper.GenesisState
-	(*Params)(nil),          // 2: zigchain.tokenwrapper.Params
+	(*PauserAddresses)(nil),  // 0: zigchain.tokenwrapper.PauserAddresses
+	(*GenesisState)(nil),     // 1: zigchain.tokenwrapper.GenesisState
+	(*Params)(nil),           // 2: zigchain.tokenwrapper.Params
+	(*BridgeRoute)(nil),      // 3: zigchain.tokenwrapper.BridgeRoute
+	(*VolumeCap)(nil),        // 4: zigchain.tokenwrapper.VolumeCap
+	(*VolumeBucket)(nil),     // 5: zigchain.tokenwrapper.VolumeBucket
+	(*PendingUnwrap)(nil),    // 6: zigchain.tokenwrapper.PendingUnwrap
+	(*TransferRecord)(nil),   // 7: zigchain.tokenwrapper.TransferRecord
+	(*PendingBridgeFee)(nil), // 8: zigchain.tokenwrapper.PendingBridgeFee
 }
 var file_zigchain_tokenwrapper_genesis_proto_depIdxs = []int32{
 	2, // 0: zigchain.tokenwrapper.GenesisState.params:type_name -> zigchain.tokenwrapper.Params
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 1: zigchain.tokenwrapper.GenesisState.routes:type_name -> zigchain.tokenwrapper.BridgeRoute
+	4, // 2: zigchain.tokenwrapper.GenesisState.volume_caps:type_name -> zigchain.tokenwrapper.VolumeCap
+	5, // 3: zigchain.tokenwrapper.GenesisState.volume_buckets:type_name -> zigchain.tokenwrapper.VolumeBucket
+	6, // 4: zigchain.tokenwrapper.GenesisState.pending_unwraps:type_name -> zigchain.tokenwrapper.PendingUnwrap
+	7, // 5: zigchain.tokenwrapper.GenesisState.transfer_records:type_name -> zigchain.tokenwrapper.TransferRecord
+	8, // 6: zigchain.tokenwrapper.GenesisState.pending_bridge_fees:type_name -> zigchain.tokenwrapper.PendingBridgeFee
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_zigchain_tokenwrapper_genesis_proto_init() }
@@ -2198,6 +2856,11 @@ func file_zigchain_tokenwrapper_genesis_proto_init() {
 		return
 	}
 	file_zigchain_tokenwrapper_params_proto_init()
+	file_zigchain_tokenwrapper_bridge_route_proto_init()
+	file_zigchain_tokenwrapper_volume_cap_proto_init()
+	file_zigchain_tokenwrapper_pending_unwrap_proto_init()
+	file_zigchain_tokenwrapper_transfer_record_proto_init()
+	file_zigchain_tokenwrapper_bridge_fee_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_zigchain_tokenwrapper_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PauserAddresses); i {
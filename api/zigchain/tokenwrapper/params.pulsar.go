@@ -4,6 +4,7 @@ package tokenwrapper
 import (
 	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -15,12 +16,28 @@ import (
 )
 
 var (
-	md_Params protoreflect.MessageDescriptor
+	md_Params                           protoreflect.MessageDescriptor
+	fd_Params_max_inbound_volume_cap    protoreflect.FieldDescriptor
+	fd_Params_max_outbound_volume_cap   protoreflect.FieldDescriptor
+	fd_Params_min_volume_cap_window     protoreflect.FieldDescriptor
+	fd_Params_pending_unwrap_gas_budget protoreflect.FieldDescriptor
+	fd_Params_transfer_record_retention protoreflect.FieldDescriptor
+	fd_Params_bridge_fee_bps            protoreflect.FieldDescriptor
+	fd_Params_bridge_fee_min            protoreflect.FieldDescriptor
+	fd_Params_bridge_fee_recipient      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_zigchain_tokenwrapper_params_proto_init()
 	md_Params = File_zigchain_tokenwrapper_params_proto.Messages().ByName("Params")
+	fd_Params_max_inbound_volume_cap = md_Params.Fields().ByName("max_inbound_volume_cap")
+	fd_Params_max_outbound_volume_cap = md_Params.Fields().ByName("max_outbound_volume_cap")
+	fd_Params_min_volume_cap_window = md_Params.Fields().ByName("min_volume_cap_window")
+	fd_Params_pending_unwrap_gas_budget = md_Params.Fields().ByName("pending_unwrap_gas_budget")
+	fd_Params_transfer_record_retention = md_Params.Fields().ByName("transfer_record_retention")
+	fd_Params_bridge_fee_bps = md_Params.Fields().ByName("bridge_fee_bps")
+	fd_Params_bridge_fee_min = md_Params.Fields().ByName("bridge_fee_min")
+	fd_Params_bridge_fee_recipient = md_Params.Fields().ByName("bridge_fee_recipient")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -88,6 +105,54 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxInboundVolumeCap != "" {
+		value := protoreflect.ValueOfString(x.MaxInboundVolumeCap)
+		if !f(fd_Params_max_inbound_volume_cap, value) {
+			return
+		}
+	}
+	if x.MaxOutboundVolumeCap != "" {
+		value := protoreflect.ValueOfString(x.MaxOutboundVolumeCap)
+		if !f(fd_Params_max_outbound_volume_cap, value) {
+			return
+		}
+	}
+	if x.MinVolumeCapWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinVolumeCapWindow)
+		if !f(fd_Params_min_volume_cap_window, value) {
+			return
+		}
+	}
+	if x.PendingUnwrapGasBudget != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PendingUnwrapGasBudget)
+		if !f(fd_Params_pending_unwrap_gas_budget, value) {
+			return
+		}
+	}
+	if x.TransferRecordRetention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TransferRecordRetention)
+		if !f(fd_Params_transfer_record_retention, value) {
+			return
+		}
+	}
+	if x.BridgeFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.BridgeFeeBps)
+		if !f(fd_Params_bridge_fee_bps, value) {
+			return
+		}
+	}
+	if x.BridgeFeeMin != "" {
+		value := protoreflect.ValueOfString(x.BridgeFeeMin)
+		if !f(fd_Params_bridge_fee_min, value) {
+			return
+		}
+	}
+	if x.BridgeFeeRecipient != "" {
+		value := protoreflect.ValueOfString(x.BridgeFeeRecipient)
+		if !f(fd_Params_bridge_fee_recipient, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -103,6 +168,22 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "zigchain.tokenwrapper.Params.max_inbound_volume_cap":
+		return x.MaxInboundVolumeCap != ""
+	case "zigchain.tokenwrapper.Params.max_outbound_volume_cap":
+		return x.MaxOutboundVolumeCap != ""
+	case "zigchain.tokenwrapper.Params.min_volume_cap_window":
+		return x.MinVolumeCapWindow != uint64(0)
+	case "zigchain.tokenwrapper.Params.pending_unwrap_gas_budget":
+		return x.PendingUnwrapGasBudget != uint64(0)
+	case "zigchain.tokenwrapper.Params.transfer_record_retention":
+		return x.TransferRecordRetention != uint64(0)
+	case "zigchain.tokenwrapper.Params.bridge_fee_bps":
+		return x.BridgeFeeBps != uint32(0)
+	case "zigchain.tokenwrapper.Params.bridge_fee_min":
+		return x.BridgeFeeMin != ""
+	case "zigchain.tokenwrapper.Params.bridge_fee_recipient":
+		return x.BridgeFeeRecipient != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.Params"))
@@ -119,6 +200,22 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "zigchain.tokenwrapper.Params.max_inbound_volume_cap":
+		x.MaxInboundVolumeCap = ""
+	case "zigchain.tokenwrapper.Params.max_outbound_volume_cap":
+		x.MaxOutboundVolumeCap = ""
+	case "zigchain.tokenwrapper.Params.min_volume_cap_window":
+		x.MinVolumeCapWindow = uint64(0)
+	case "zigchain.tokenwrapper.Params.pending_unwrap_gas_budget":
+		x.PendingUnwrapGasBudget = uint64(0)
+	case "zigchain.tokenwrapper.Params.transfer_record_retention":
+		x.TransferRecordRetention = uint64(0)
+	case "zigchain.tokenwrapper.Params.bridge_fee_bps":
+		x.BridgeFeeBps = uint32(0)
+	case "zigchain.tokenwrapper.Params.bridge_fee_min":
+		x.BridgeFeeMin = ""
+	case "zigchain.tokenwrapper.Params.bridge_fee_recipient":
+		x.BridgeFeeRecipient = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.Params"))
@@ -135,6 +232,30 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "zigchain.tokenwrapper.Params.max_inbound_volume_cap":
+		value := x.MaxInboundVolumeCap
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.Params.max_outbound_volume_cap":
+		value := x.MaxOutboundVolumeCap
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.Params.min_volume_cap_window":
+		value := x.MinVolumeCapWindow
+		return protoreflect.ValueOfUint64(value)
+	case "zigchain.tokenwrapper.Params.pending_unwrap_gas_budget":
+		value := x.PendingUnwrapGasBudget
+		return protoreflect.ValueOfUint64(value)
+	case "zigchain.tokenwrapper.Params.transfer_record_retention":
+		value := x.TransferRecordRetention
+		return protoreflect.ValueOfUint64(value)
+	case "zigchain.tokenwrapper.Params.bridge_fee_bps":
+		value := x.BridgeFeeBps
+		return protoreflect.ValueOfUint32(value)
+	case "zigchain.tokenwrapper.Params.bridge_fee_min":
+		value := x.BridgeFeeMin
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.Params.bridge_fee_recipient":
+		value := x.BridgeFeeRecipient
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.Params"))
@@ -155,6 +276,22 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "zigchain.tokenwrapper.Params.max_inbound_volume_cap":
+		x.MaxInboundVolumeCap = value.Interface().(string)
+	case "zigchain.tokenwrapper.Params.max_outbound_volume_cap":
+		x.MaxOutboundVolumeCap = value.Interface().(string)
+	case "zigchain.tokenwrapper.Params.min_volume_cap_window":
+		x.MinVolumeCapWindow = value.Uint()
+	case "zigchain.tokenwrapper.Params.pending_unwrap_gas_budget":
+		x.PendingUnwrapGasBudget = value.Uint()
+	case "zigchain.tokenwrapper.Params.transfer_record_retention":
+		x.TransferRecordRetention = value.Uint()
+	case "zigchain.tokenwrapper.Params.bridge_fee_bps":
+		x.BridgeFeeBps = uint32(value.Uint())
+	case "zigchain.tokenwrapper.Params.bridge_fee_min":
+		x.BridgeFeeMin = value.Interface().(string)
+	case "zigchain.tokenwrapper.Params.bridge_fee_recipient":
+		x.BridgeFeeRecipient = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.Params"))
@@ -175,6 +312,22 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "zigchain.tokenwrapper.Params.max_inbound_volume_cap":
+		panic(fmt.Errorf("field max_inbound_volume_cap of message zigchain.tokenwrapper.Params is not mutable"))
+	case "zigchain.tokenwrapper.Params.max_outbound_volume_cap":
+		panic(fmt.Errorf("field max_outbound_volume_cap of message zigchain.tokenwrapper.Params is not mutable"))
+	case "zigchain.tokenwrapper.Params.min_volume_cap_window":
+		panic(fmt.Errorf("field min_volume_cap_window of message zigchain.tokenwrapper.Params is not mutable"))
+	case "zigchain.tokenwrapper.Params.pending_unwrap_gas_budget":
+		panic(fmt.Errorf("field pending_unwrap_gas_budget of message zigchain.tokenwrapper.Params is not mutable"))
+	case "zigchain.tokenwrapper.Params.transfer_record_retention":
+		panic(fmt.Errorf("field transfer_record_retention of message zigchain.tokenwrapper.Params is not mutable"))
+	case "zigchain.tokenwrapper.Params.bridge_fee_bps":
+		panic(fmt.Errorf("field bridge_fee_bps of message zigchain.tokenwrapper.Params is not mutable"))
+	case "zigchain.tokenwrapper.Params.bridge_fee_min":
+		panic(fmt.Errorf("field bridge_fee_min of message zigchain.tokenwrapper.Params is not mutable"))
+	case "zigchain.tokenwrapper.Params.bridge_fee_recipient":
+		panic(fmt.Errorf("field bridge_fee_recipient of message zigchain.tokenwrapper.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.Params"))
@@ -188,6 +341,22 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "zigchain.tokenwrapper.Params.max_inbound_volume_cap":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.Params.max_outbound_volume_cap":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.Params.min_volume_cap_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zigchain.tokenwrapper.Params.pending_unwrap_gas_budget":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zigchain.tokenwrapper.Params.transfer_record_retention":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zigchain.tokenwrapper.Params.bridge_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "zigchain.tokenwrapper.Params.bridge_fee_min":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.Params.bridge_fee_recipient":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.Params"))
@@ -257,6 +426,34 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		l = len(x.MaxInboundVolumeCap)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxOutboundVolumeCap)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinVolumeCapWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinVolumeCapWindow))
+		}
+		if x.PendingUnwrapGasBudget != 0 {
+			n += 1 + runtime.Sov(uint64(x.PendingUnwrapGasBudget))
+		}
+		if x.TransferRecordRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.TransferRecordRetention))
+		}
+		if x.BridgeFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.BridgeFeeBps))
+		}
+		l = len(x.BridgeFeeMin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BridgeFeeRecipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -286,6 +483,54 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.BridgeFeeRecipient) > 0 {
+			i -= len(x.BridgeFeeRecipient)
+			copy(dAtA[i:], x.BridgeFeeRecipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BridgeFeeRecipient)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.BridgeFeeMin) > 0 {
+			i -= len(x.BridgeFeeMin)
+			copy(dAtA[i:], x.BridgeFeeMin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BridgeFeeMin)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.BridgeFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BridgeFeeBps))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.TransferRecordRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TransferRecordRetention))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.PendingUnwrapGasBudget != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PendingUnwrapGasBudget))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MinVolumeCapWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinVolumeCapWindow))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.MaxOutboundVolumeCap) > 0 {
+			i -= len(x.MaxOutboundVolumeCap)
+			copy(dAtA[i:], x.MaxOutboundVolumeCap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxOutboundVolumeCap)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MaxInboundVolumeCap) > 0 {
+			i -= len(x.MaxInboundVolumeCap)
+			copy(dAtA[i:], x.MaxInboundVolumeCap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxInboundVolumeCap)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -335,6 +580,210 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxInboundVolumeCap", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxInboundVolumeCap = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxOutboundVolumeCap", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxOutboundVolumeCap = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinVolumeCapWindow", wireType)
+				}
+				x.MinVolumeCapWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinVolumeCapWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingUnwrapGasBudget", wireType)
+				}
+				x.PendingUnwrapGasBudget = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PendingUnwrapGasBudget |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferRecordRetention", wireType)
+				}
+				x.TransferRecordRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TransferRecordRetention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeFeeBps", wireType)
+				}
+				x.BridgeFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BridgeFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeFeeMin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BridgeFeeMin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BridgeFeeRecipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BridgeFeeRecipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -388,6 +837,31 @@ type Params struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// max_inbound_volume_cap bounds the inbound volume caps the operator can set
+	// on the bridge routes, zero means no bound
+	MaxInboundVolumeCap string `protobuf:"bytes,1,opt,name=max_inbound_volume_cap,json=maxInboundVolumeCap,proto3" json:"max_inbound_volume_cap,omitempty"`
+	// max_outbound_volume_cap bounds the outbound volume caps the operator can
+	// set on the bridge routes, zero means no bound
+	MaxOutboundVolumeCap string `protobuf:"bytes,2,opt,name=max_outbound_volume_cap,json=maxOutboundVolumeCap,proto3" json:"max_outbound_volume_cap,omitempty"`
+	// min_volume_cap_window is the shortest window, in seconds, of the volume
+	// caps the operator can set
+	MinVolumeCapWindow uint64 `protobuf:"varint,3,opt,name=min_volume_cap_window,json=minVolumeCapWindow,proto3" json:"min_volume_cap_window,omitempty"`
+	// pending_unwrap_gas_budget is the gas the begin blocker can spend per block
+	// on converting the pending unwraps, zero disables the automatic conversion
+	PendingUnwrapGasBudget uint64 `protobuf:"varint,4,opt,name=pending_unwrap_gas_budget,json=pendingUnwrapGasBudget,proto3" json:"pending_unwrap_gas_budget,omitempty"`
+	// transfer_record_retention is the number of blocks the transfer records
+	// are kept in the ledger before being pruned, zero keeps them forever
+	TransferRecordRetention uint64 `protobuf:"varint,5,opt,name=transfer_record_retention,json=transferRecordRetention,proto3" json:"transfer_record_retention,omitempty"`
+	// bridge_fee_bps is the fee, in basis points of the native amount, charged
+	// on the transfers wrapped in both directions
+	BridgeFeeBps uint32 `protobuf:"varint,6,opt,name=bridge_fee_bps,json=bridgeFeeBps,proto3" json:"bridge_fee_bps,omitempty"`
+	// bridge_fee_min is the minimum fee in native tokens charged on the
+	// transfers wrapped in both directions, zero with a zero bridge_fee_bps
+	// disables the fee
+	BridgeFeeMin string `protobuf:"bytes,7,opt,name=bridge_fee_min,json=bridgeFeeMin,proto3" json:"bridge_fee_min,omitempty"`
+	// bridge_fee_recipient is the address the bridge fees accrue to
+	BridgeFeeRecipient string `protobuf:"bytes,8,opt,name=bridge_fee_recipient,json=bridgeFeeRecipient,proto3" json:"bridge_fee_recipient,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -410,6 +884,62 @@ func (*Params) Descriptor() ([]byte, []int) {
 	return file_zigchain_tokenwrapper_params_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *Params) GetMaxInboundVolumeCap() string {
+	if x != nil {
+		return x.MaxInboundVolumeCap
+	}
+	return ""
+}
+
+func (x *Params) GetMaxOutboundVolumeCap() string {
+	if x != nil {
+		return x.MaxOutboundVolumeCap
+	}
+	return ""
+}
+
+func (x *Params) GetMinVolumeCapWindow() uint64 {
+	if x != nil {
+		return x.MinVolumeCapWindow
+	}
+	return 0
+}
+
+func (x *Params) GetPendingUnwrapGasBudget() uint64 {
+	if x != nil {
+		return x.PendingUnwrapGasBudget
+	}
+	return 0
+}
+
+func (x *Params) GetTransferRecordRetention() uint64 {
+	if x != nil {
+		return x.TransferRecordRetention
+	}
+	return 0
+}
+
+func (x *Params) GetBridgeFeeBps() uint32 {
+	if x != nil {
+		return x.BridgeFeeBps
+	}
+	return 0
+}
+
+func (x *Params) GetBridgeFeeMin() string {
+	if x != nil {
+		return x.BridgeFeeMin
+	}
+	return ""
+}
+
+func (x *Params) GetBridgeFeeRecipient() string {
+	if x != nil {
+		return x.BridgeFeeRecipient
+	}
+	return ""
+}
+
 var File_zigchain_tokenwrapper_params_proto protoreflect.FileDescriptor
 
 var file_zigchain_tokenwrapper_params_proto_rawDesc = []byte{
@@ -419,23 +949,58 @@ var file_zigchain_tokenwrapper_params_proto_rawDesc = []byte{
 	0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x1a, 0x11, 0x61, 0x6d, 0x69,
 	0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
 	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x31, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x27,
-	0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x78, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
-	0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc5, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e,
-	0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72,
-	0x61, 0x70, 0x70, 0x65, 0x72, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x5a,
-	0x54, 0x58, 0xaa, 0x02, 0x15, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0xca, 0x02, 0x15, 0x5a, 0x69, 0x67,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70,
-	0x65, 0x72, 0xe2, 0x02, 0x21, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x3a, 0x3a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xcb, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x57, 0x0a, 0x16, 0x6d, 0x61,
+	0x78, 0x5f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x5f, 0x63, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13,
+	0x6d, 0x61, 0x78, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x43, 0x61, 0x70, 0x12, 0x59, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x75, 0x74, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x4f, 0x75, 0x74,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x61, 0x70, 0x12, 0x31,
+	0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x63, 0x61, 0x70,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d,
+	0x69, 0x6e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x61, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x77,
+	0x72, 0x61, 0x70, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x77,
+	0x72, 0x61, 0x70, 0x47, 0x61, 0x73, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x19,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
+	0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x17, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x46, 0x65, 0x65, 0x42, 0x70, 0x73, 0x12, 0x48,
+	0x0a, 0x0e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x69, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x46, 0x65, 0x65, 0x4d, 0x69, 0x6e, 0x12, 0x4a, 0x0a, 0x14, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x12, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x3a, 0x27, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x7a,
+	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc5, 0x01,
+	0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x42, 0x0b, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0xa2, 0x02, 0x03, 0x5a, 0x54, 0x58, 0xaa, 0x02, 0x15, 0x5a, 0x69, 0x67, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0xca, 0x02, 0x15, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0xe2, 0x02, 0x21, 0x5a, 0x69, 0x67, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x5a,
+	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
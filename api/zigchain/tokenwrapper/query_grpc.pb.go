@@ -19,9 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName         = "/zigchain.tokenwrapper.Query/Params"
-	Query_ModuleInfo_FullMethodName     = "/zigchain.tokenwrapper.Query/ModuleInfo"
-	Query_TotalTransfers_FullMethodName = "/zigchain.tokenwrapper.Query/TotalTransfers"
+	Query_Params_FullMethodName                   = "/zigchain.tokenwrapper.Query/Params"
+	Query_ModuleInfo_FullMethodName               = "/zigchain.tokenwrapper.Query/ModuleInfo"
+	Query_TotalTransfers_FullMethodName           = "/zigchain.tokenwrapper.Query/TotalTransfers"
+	Query_BridgeRoutes_FullMethodName             = "/zigchain.tokenwrapper.Query/BridgeRoutes"
+	Query_BridgeRoute_FullMethodName              = "/zigchain.tokenwrapper.Query/BridgeRoute"
+	Query_VolumeCapacity_FullMethodName           = "/zigchain.tokenwrapper.Query/VolumeCapacity"
+	Query_PendingUnwraps_FullMethodName           = "/zigchain.tokenwrapper.Query/PendingUnwraps"
+	Query_PendingUnwrapsByAddress_FullMethodName  = "/zigchain.tokenwrapper.Query/PendingUnwrapsByAddress"
+	Query_BridgeFees_FullMethodName               = "/zigchain.tokenwrapper.Query/BridgeFees"
+	Query_TransferRecords_FullMethodName          = "/zigchain.tokenwrapper.Query/TransferRecords"
+	Query_TransferRecordsByAddress_FullMethodName = "/zigchain.tokenwrapper.Query/TransferRecordsByAddress"
+	Query_TransferRecordsByStatus_FullMethodName  = "/zigchain.tokenwrapper.Query/TransferRecordsByStatus"
 )
 
 // QueryClient is the client API for Query service.
@@ -36,6 +45,24 @@ type QueryClient interface {
 	ModuleInfo(ctx context.Context, in *QueryModuleInfoRequest, opts ...grpc.CallOption) (*QueryModuleInfoResponse, error)
 	// Queries the total amount of ZIG tokens transferred in and out
 	TotalTransfers(ctx context.Context, in *QueryTotalTransfersRequest, opts ...grpc.CallOption) (*QueryTotalTransfersResponse, error)
+	// Queries the bridge routes the module wraps transfers over
+	BridgeRoutes(ctx context.Context, in *QueryBridgeRoutesRequest, opts ...grpc.CallOption) (*QueryBridgeRoutesResponse, error)
+	// Queries the bridge route of a native port and channel
+	BridgeRoute(ctx context.Context, in *QueryBridgeRouteRequest, opts ...grpc.CallOption) (*QueryBridgeRouteResponse, error)
+	// Queries the volume cap of a bridge route and its remaining capacity
+	VolumeCapacity(ctx context.Context, in *QueryVolumeCapacityRequest, opts ...grpc.CallOption) (*QueryVolumeCapacityResponse, error)
+	// Queries the pending unwraps in the order they are converted
+	PendingUnwraps(ctx context.Context, in *QueryPendingUnwrapsRequest, opts ...grpc.CallOption) (*QueryPendingUnwrapsResponse, error)
+	// Queries the pending unwraps of an address
+	PendingUnwrapsByAddress(ctx context.Context, in *QueryPendingUnwrapsByAddressRequest, opts ...grpc.CallOption) (*QueryPendingUnwrapsByAddressResponse, error)
+	// Queries the bridge fee settings and the total bridge fees paid
+	BridgeFees(ctx context.Context, in *QueryBridgeFeesRequest, opts ...grpc.CallOption) (*QueryBridgeFeesResponse, error)
+	// Queries the transfer records of the ledger in the order they were written
+	TransferRecords(ctx context.Context, in *QueryTransferRecordsRequest, opts ...grpc.CallOption) (*QueryTransferRecordsResponse, error)
+	// Queries the transfer records sent or received by an address
+	TransferRecordsByAddress(ctx context.Context, in *QueryTransferRecordsByAddressRequest, opts ...grpc.CallOption) (*QueryTransferRecordsByAddressResponse, error)
+	// Queries the transfer records of a status
+	TransferRecordsByStatus(ctx context.Context, in *QueryTransferRecordsByStatusRequest, opts ...grpc.CallOption) (*QueryTransferRecordsByStatusResponse, error)
 }
 
 type queryClient struct {
@@ -76,6 +103,96 @@ func (c *queryClient) TotalTransfers(ctx context.Context, in *QueryTotalTransfer
 	return out, nil
 }
 
+func (c *queryClient) BridgeRoutes(ctx context.Context, in *QueryBridgeRoutesRequest, opts ...grpc.CallOption) (*QueryBridgeRoutesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBridgeRoutesResponse)
+	err := c.cc.Invoke(ctx, Query_BridgeRoutes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeRoute(ctx context.Context, in *QueryBridgeRouteRequest, opts ...grpc.CallOption) (*QueryBridgeRouteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBridgeRouteResponse)
+	err := c.cc.Invoke(ctx, Query_BridgeRoute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VolumeCapacity(ctx context.Context, in *QueryVolumeCapacityRequest, opts ...grpc.CallOption) (*QueryVolumeCapacityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryVolumeCapacityResponse)
+	err := c.cc.Invoke(ctx, Query_VolumeCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingUnwraps(ctx context.Context, in *QueryPendingUnwrapsRequest, opts ...grpc.CallOption) (*QueryPendingUnwrapsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPendingUnwrapsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingUnwraps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingUnwrapsByAddress(ctx context.Context, in *QueryPendingUnwrapsByAddressRequest, opts ...grpc.CallOption) (*QueryPendingUnwrapsByAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPendingUnwrapsByAddressResponse)
+	err := c.cc.Invoke(ctx, Query_PendingUnwrapsByAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeFees(ctx context.Context, in *QueryBridgeFeesRequest, opts ...grpc.CallOption) (*QueryBridgeFeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBridgeFeesResponse)
+	err := c.cc.Invoke(ctx, Query_BridgeFees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferRecords(ctx context.Context, in *QueryTransferRecordsRequest, opts ...grpc.CallOption) (*QueryTransferRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTransferRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_TransferRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferRecordsByAddress(ctx context.Context, in *QueryTransferRecordsByAddressRequest, opts ...grpc.CallOption) (*QueryTransferRecordsByAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTransferRecordsByAddressResponse)
+	err := c.cc.Invoke(ctx, Query_TransferRecordsByAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferRecordsByStatus(ctx context.Context, in *QueryTransferRecordsByStatusRequest, opts ...grpc.CallOption) (*QueryTransferRecordsByStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTransferRecordsByStatusResponse)
+	err := c.cc.Invoke(ctx, Query_TransferRecordsByStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -88,6 +205,24 @@ type QueryServer interface {
 	ModuleInfo(context.Context, *QueryModuleInfoRequest) (*QueryModuleInfoResponse, error)
 	// Queries the total amount of ZIG tokens transferred in and out
 	TotalTransfers(context.Context, *QueryTotalTransfersRequest) (*QueryTotalTransfersResponse, error)
+	// Queries the bridge routes the module wraps transfers over
+	BridgeRoutes(context.Context, *QueryBridgeRoutesRequest) (*QueryBridgeRoutesResponse, error)
+	// Queries the bridge route of a native port and channel
+	BridgeRoute(context.Context, *QueryBridgeRouteRequest) (*QueryBridgeRouteResponse, error)
+	// Queries the volume cap of a bridge route and its remaining capacity
+	VolumeCapacity(context.Context, *QueryVolumeCapacityRequest) (*QueryVolumeCapacityResponse, error)
+	// Queries the pending unwraps in the order they are converted
+	PendingUnwraps(context.Context, *QueryPendingUnwrapsRequest) (*QueryPendingUnwrapsResponse, error)
+	// Queries the pending unwraps of an address
+	PendingUnwrapsByAddress(context.Context, *QueryPendingUnwrapsByAddressRequest) (*QueryPendingUnwrapsByAddressResponse, error)
+	// Queries the bridge fee settings and the total bridge fees paid
+	BridgeFees(context.Context, *QueryBridgeFeesRequest) (*QueryBridgeFeesResponse, error)
+	// Queries the transfer records of the ledger in the order they were written
+	TransferRecords(context.Context, *QueryTransferRecordsRequest) (*QueryTransferRecordsResponse, error)
+	// Queries the transfer records sent or received by an address
+	TransferRecordsByAddress(context.Context, *QueryTransferRecordsByAddressRequest) (*QueryTransferRecordsByAddressResponse, error)
+	// Queries the transfer records of a status
+	TransferRecordsByStatus(context.Context, *QueryTransferRecordsByStatusRequest) (*QueryTransferRecordsByStatusResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -107,6 +242,33 @@ func (UnimplementedQueryServer) ModuleInfo(context.Context, *QueryModuleInfoRequ
 func (UnimplementedQueryServer) TotalTransfers(context.Context, *QueryTotalTransfersRequest) (*QueryTotalTransfersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalTransfers not implemented")
 }
+func (UnimplementedQueryServer) BridgeRoutes(context.Context, *QueryBridgeRoutesRequest) (*QueryBridgeRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeRoutes not implemented")
+}
+func (UnimplementedQueryServer) BridgeRoute(context.Context, *QueryBridgeRouteRequest) (*QueryBridgeRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeRoute not implemented")
+}
+func (UnimplementedQueryServer) VolumeCapacity(context.Context, *QueryVolumeCapacityRequest) (*QueryVolumeCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeCapacity not implemented")
+}
+func (UnimplementedQueryServer) PendingUnwraps(context.Context, *QueryPendingUnwrapsRequest) (*QueryPendingUnwrapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingUnwraps not implemented")
+}
+func (UnimplementedQueryServer) PendingUnwrapsByAddress(context.Context, *QueryPendingUnwrapsByAddressRequest) (*QueryPendingUnwrapsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingUnwrapsByAddress not implemented")
+}
+func (UnimplementedQueryServer) BridgeFees(context.Context, *QueryBridgeFeesRequest) (*QueryBridgeFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeFees not implemented")
+}
+func (UnimplementedQueryServer) TransferRecords(context.Context, *QueryTransferRecordsRequest) (*QueryTransferRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRecords not implemented")
+}
+func (UnimplementedQueryServer) TransferRecordsByAddress(context.Context, *QueryTransferRecordsByAddressRequest) (*QueryTransferRecordsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRecordsByAddress not implemented")
+}
+func (UnimplementedQueryServer) TransferRecordsByStatus(context.Context, *QueryTransferRecordsByStatusRequest) (*QueryTransferRecordsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRecordsByStatus not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -182,6 +344,168 @@ func _Query_TotalTransfers_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BridgeRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BridgeRoutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeRoutes(ctx, req.(*QueryBridgeRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BridgeRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeRoute(ctx, req.(*QueryBridgeRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VolumeCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVolumeCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VolumeCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VolumeCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VolumeCapacity(ctx, req.(*QueryVolumeCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingUnwraps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingUnwrapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingUnwraps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingUnwraps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingUnwraps(ctx, req.(*QueryPendingUnwrapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingUnwrapsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingUnwrapsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingUnwrapsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingUnwrapsByAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingUnwrapsByAddress(ctx, req.(*QueryPendingUnwrapsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BridgeFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeFees(ctx, req.(*QueryBridgeFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransferRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferRecords(ctx, req.(*QueryTransferRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferRecordsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferRecordsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferRecordsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransferRecordsByAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferRecordsByAddress(ctx, req.(*QueryTransferRecordsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferRecordsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferRecordsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferRecordsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransferRecordsByStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferRecordsByStatus(ctx, req.(*QueryTransferRecordsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +525,42 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TotalTransfers",
 			Handler:    _Query_TotalTransfers_Handler,
 		},
+		{
+			MethodName: "BridgeRoutes",
+			Handler:    _Query_BridgeRoutes_Handler,
+		},
+		{
+			MethodName: "BridgeRoute",
+			Handler:    _Query_BridgeRoute_Handler,
+		},
+		{
+			MethodName: "VolumeCapacity",
+			Handler:    _Query_VolumeCapacity_Handler,
+		},
+		{
+			MethodName: "PendingUnwraps",
+			Handler:    _Query_PendingUnwraps_Handler,
+		},
+		{
+			MethodName: "PendingUnwrapsByAddress",
+			Handler:    _Query_PendingUnwrapsByAddress_Handler,
+		},
+		{
+			MethodName: "BridgeFees",
+			Handler:    _Query_BridgeFees_Handler,
+		},
+		{
+			MethodName: "TransferRecords",
+			Handler:    _Query_TransferRecords_Handler,
+		},
+		{
+			MethodName: "TransferRecordsByAddress",
+			Handler:    _Query_TransferRecordsByAddress_Handler,
+		},
+		{
+			MethodName: "TransferRecordsByStatus",
+			Handler:    _Query_TransferRecordsByStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zigchain/tokenwrapper/query.proto",
@@ -0,0 +1,1555 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package tokenwrapper
+
+import (
+	_ "cosmossdk.io/api/amino"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_TransferRecord                protoreflect.MessageDescriptor
+	fd_TransferRecord_id             protoreflect.FieldDescriptor
+	fd_TransferRecord_native_port    protoreflect.FieldDescriptor
+	fd_TransferRecord_native_channel protoreflect.FieldDescriptor
+	fd_TransferRecord_sequence       protoreflect.FieldDescriptor
+	fd_TransferRecord_inbound        protoreflect.FieldDescriptor
+	fd_TransferRecord_sender         protoreflect.FieldDescriptor
+	fd_TransferRecord_receiver       protoreflect.FieldDescriptor
+	fd_TransferRecord_ibc_amount     protoreflect.FieldDescriptor
+	fd_TransferRecord_native_amount  protoreflect.FieldDescriptor
+	fd_TransferRecord_status         protoreflect.FieldDescriptor
+	fd_TransferRecord_reason         protoreflect.FieldDescriptor
+	fd_TransferRecord_height         protoreflect.FieldDescriptor
+	fd_TransferRecord_updated_height protoreflect.FieldDescriptor
+	fd_TransferRecord_fee            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zigchain_tokenwrapper_transfer_record_proto_init()
+	md_TransferRecord = File_zigchain_tokenwrapper_transfer_record_proto.Messages().ByName("TransferRecord")
+	fd_TransferRecord_id = md_TransferRecord.Fields().ByName("id")
+	fd_TransferRecord_native_port = md_TransferRecord.Fields().ByName("native_port")
+	fd_TransferRecord_native_channel = md_TransferRecord.Fields().ByName("native_channel")
+	fd_TransferRecord_sequence = md_TransferRecord.Fields().ByName("sequence")
+	fd_TransferRecord_inbound = md_TransferRecord.Fields().ByName("inbound")
+	fd_TransferRecord_sender = md_TransferRecord.Fields().ByName("sender")
+	fd_TransferRecord_receiver = md_TransferRecord.Fields().ByName("receiver")
+	fd_TransferRecord_ibc_amount = md_TransferRecord.Fields().ByName("ibc_amount")
+	fd_TransferRecord_native_amount = md_TransferRecord.Fields().ByName("native_amount")
+	fd_TransferRecord_status = md_TransferRecord.Fields().ByName("status")
+	fd_TransferRecord_reason = md_TransferRecord.Fields().ByName("reason")
+	fd_TransferRecord_height = md_TransferRecord.Fields().ByName("height")
+	fd_TransferRecord_updated_height = md_TransferRecord.Fields().ByName("updated_height")
+	fd_TransferRecord_fee = md_TransferRecord.Fields().ByName("fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_TransferRecord)(nil)
+
+type fastReflection_TransferRecord TransferRecord
+
+func (x *TransferRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TransferRecord)(x)
+}
+
+func (x *TransferRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_zigchain_tokenwrapper_transfer_record_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TransferRecord_messageType fastReflection_TransferRecord_messageType
+var _ protoreflect.MessageType = fastReflection_TransferRecord_messageType{}
+
+type fastReflection_TransferRecord_messageType struct{}
+
+func (x fastReflection_TransferRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TransferRecord)(nil)
+}
+func (x fastReflection_TransferRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_TransferRecord)
+}
+func (x fastReflection_TransferRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TransferRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TransferRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_TransferRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TransferRecord) Type() protoreflect.MessageType {
+	return _fastReflection_TransferRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TransferRecord) New() protoreflect.Message {
+	return new(fastReflection_TransferRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TransferRecord) Interface() protoreflect.ProtoMessage {
+	return (*TransferRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TransferRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_TransferRecord_id, value) {
+			return
+		}
+	}
+	if x.NativePort != "" {
+		value := protoreflect.ValueOfString(x.NativePort)
+		if !f(fd_TransferRecord_native_port, value) {
+			return
+		}
+	}
+	if x.NativeChannel != "" {
+		value := protoreflect.ValueOfString(x.NativeChannel)
+		if !f(fd_TransferRecord_native_channel, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_TransferRecord_sequence, value) {
+			return
+		}
+	}
+	if x.Inbound != false {
+		value := protoreflect.ValueOfBool(x.Inbound)
+		if !f(fd_TransferRecord_inbound, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_TransferRecord_sender, value) {
+			return
+		}
+	}
+	if x.Receiver != "" {
+		value := protoreflect.ValueOfString(x.Receiver)
+		if !f(fd_TransferRecord_receiver, value) {
+			return
+		}
+	}
+	if x.IbcAmount != "" {
+		value := protoreflect.ValueOfString(x.IbcAmount)
+		if !f(fd_TransferRecord_ibc_amount, value) {
+			return
+		}
+	}
+	if x.NativeAmount != "" {
+		value := protoreflect.ValueOfString(x.NativeAmount)
+		if !f(fd_TransferRecord_native_amount, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_TransferRecord_status, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_TransferRecord_reason, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_TransferRecord_height, value) {
+			return
+		}
+	}
+	if x.UpdatedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UpdatedHeight)
+		if !f(fd_TransferRecord_updated_height, value) {
+			return
+		}
+	}
+	if x.Fee != "" {
+		value := protoreflect.ValueOfString(x.Fee)
+		if !f(fd_TransferRecord_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TransferRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zigchain.tokenwrapper.TransferRecord.id":
+		return x.Id != uint64(0)
+	case "zigchain.tokenwrapper.TransferRecord.native_port":
+		return x.NativePort != ""
+	case "zigchain.tokenwrapper.TransferRecord.native_channel":
+		return x.NativeChannel != ""
+	case "zigchain.tokenwrapper.TransferRecord.sequence":
+		return x.Sequence != uint64(0)
+	case "zigchain.tokenwrapper.TransferRecord.inbound":
+		return x.Inbound != false
+	case "zigchain.tokenwrapper.TransferRecord.sender":
+		return x.Sender != ""
+	case "zigchain.tokenwrapper.TransferRecord.receiver":
+		return x.Receiver != ""
+	case "zigchain.tokenwrapper.TransferRecord.ibc_amount":
+		return x.IbcAmount != ""
+	case "zigchain.tokenwrapper.TransferRecord.native_amount":
+		return x.NativeAmount != ""
+	case "zigchain.tokenwrapper.TransferRecord.status":
+		return x.Status != 0
+	case "zigchain.tokenwrapper.TransferRecord.reason":
+		return x.Reason != ""
+	case "zigchain.tokenwrapper.TransferRecord.height":
+		return x.Height != int64(0)
+	case "zigchain.tokenwrapper.TransferRecord.updated_height":
+		return x.UpdatedHeight != int64(0)
+	case "zigchain.tokenwrapper.TransferRecord.fee":
+		return x.Fee != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.TransferRecord"))
+		}
+		panic(fmt.Errorf("message zigchain.tokenwrapper.TransferRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zigchain.tokenwrapper.TransferRecord.id":
+		x.Id = uint64(0)
+	case "zigchain.tokenwrapper.TransferRecord.native_port":
+		x.NativePort = ""
+	case "zigchain.tokenwrapper.TransferRecord.native_channel":
+		x.NativeChannel = ""
+	case "zigchain.tokenwrapper.TransferRecord.sequence":
+		x.Sequence = uint64(0)
+	case "zigchain.tokenwrapper.TransferRecord.inbound":
+		x.Inbound = false
+	case "zigchain.tokenwrapper.TransferRecord.sender":
+		x.Sender = ""
+	case "zigchain.tokenwrapper.TransferRecord.receiver":
+		x.Receiver = ""
+	case "zigchain.tokenwrapper.TransferRecord.ibc_amount":
+		x.IbcAmount = ""
+	case "zigchain.tokenwrapper.TransferRecord.native_amount":
+		x.NativeAmount = ""
+	case "zigchain.tokenwrapper.TransferRecord.status":
+		x.Status = 0
+	case "zigchain.tokenwrapper.TransferRecord.reason":
+		x.Reason = ""
+	case "zigchain.tokenwrapper.TransferRecord.height":
+		x.Height = int64(0)
+	case "zigchain.tokenwrapper.TransferRecord.updated_height":
+		x.UpdatedHeight = int64(0)
+	case "zigchain.tokenwrapper.TransferRecord.fee":
+		x.Fee = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.TransferRecord"))
+		}
+		panic(fmt.Errorf("message zigchain.tokenwrapper.TransferRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TransferRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zigchain.tokenwrapper.TransferRecord.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "zigchain.tokenwrapper.TransferRecord.native_port":
+		value := x.NativePort
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.TransferRecord.native_channel":
+		value := x.NativeChannel
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.TransferRecord.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "zigchain.tokenwrapper.TransferRecord.inbound":
+		value := x.Inbound
+		return protoreflect.ValueOfBool(value)
+	case "zigchain.tokenwrapper.TransferRecord.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.TransferRecord.receiver":
+		value := x.Receiver
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.TransferRecord.ibc_amount":
+		value := x.IbcAmount
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.TransferRecord.native_amount":
+		value := x.NativeAmount
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.TransferRecord.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "zigchain.tokenwrapper.TransferRecord.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "zigchain.tokenwrapper.TransferRecord.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "zigchain.tokenwrapper.TransferRecord.updated_height":
+		value := x.UpdatedHeight
+		return protoreflect.ValueOfInt64(value)
+	case "zigchain.tokenwrapper.TransferRecord.fee":
+		value := x.Fee
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.TransferRecord"))
+		}
+		panic(fmt.Errorf("message zigchain.tokenwrapper.TransferRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zigchain.tokenwrapper.TransferRecord.id":
+		x.Id = value.Uint()
+	case "zigchain.tokenwrapper.TransferRecord.native_port":
+		x.NativePort = value.Interface().(string)
+	case "zigchain.tokenwrapper.TransferRecord.native_channel":
+		x.NativeChannel = value.Interface().(string)
+	case "zigchain.tokenwrapper.TransferRecord.sequence":
+		x.Sequence = value.Uint()
+	case "zigchain.tokenwrapper.TransferRecord.inbound":
+		x.Inbound = value.Bool()
+	case "zigchain.tokenwrapper.TransferRecord.sender":
+		x.Sender = value.Interface().(string)
+	case "zigchain.tokenwrapper.TransferRecord.receiver":
+		x.Receiver = value.Interface().(string)
+	case "zigchain.tokenwrapper.TransferRecord.ibc_amount":
+		x.IbcAmount = value.Interface().(string)
+	case "zigchain.tokenwrapper.TransferRecord.native_amount":
+		x.NativeAmount = value.Interface().(string)
+	case "zigchain.tokenwrapper.TransferRecord.status":
+		x.Status = (TransferStatus)(value.Enum())
+	case "zigchain.tokenwrapper.TransferRecord.reason":
+		x.Reason = value.Interface().(string)
+	case "zigchain.tokenwrapper.TransferRecord.height":
+		x.Height = value.Int()
+	case "zigchain.tokenwrapper.TransferRecord.updated_height":
+		x.UpdatedHeight = value.Int()
+	case "zigchain.tokenwrapper.TransferRecord.fee":
+		x.Fee = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.TransferRecord"))
+		}
+		panic(fmt.Errorf("message zigchain.tokenwrapper.TransferRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.tokenwrapper.TransferRecord.id":
+		panic(fmt.Errorf("field id of message zigchain.tokenwrapper.TransferRecord is not mutable"))
+	case "zigchain.tokenwrapper.TransferRecord.native_port":
+		panic(fmt.Errorf("field native_port of message zigchain.tokenwrapper.TransferRecord is not mutable"))
+	case "zigchain.tokenwrapper.TransferRecord.native_channel":
+		panic(fmt.Errorf("field native_channel of message zigchain.tokenwrapper.TransferRecord is not mutable"))
+	case "zigchain.tokenwrapper.TransferRecord.sequence":
+		panic(fmt.Errorf("field sequence of message zigchain.tokenwrapper.TransferRecord is not mutable"))
+	case "zigchain.tokenwrapper.TransferRecord.inbound":
+		panic(fmt.Errorf("field inbound of message zigchain.tokenwrapper.TransferRecord is not mutable"))
+	case "zigchain.tokenwrapper.TransferRecord.sender":
+		panic(fmt.Errorf("field sender of message zigchain.tokenwrapper.TransferRecord is not mutable"))
+	case "zigchain.tokenwrapper.TransferRecord.receiver":
+		panic(fmt.Errorf("field receiver of message zigchain.tokenwrapper.TransferRecord is not mutable"))
+	case "zigchain.tokenwrapper.TransferRecord.ibc_amount":
+		panic(fmt.Errorf("field ibc_amount of message zigchain.tokenwrapper.TransferRecord is not mutable"))
+	case "zigchain.tokenwrapper.TransferRecord.native_amount":
+		panic(fmt.Errorf("field native_amount of message zigchain.tokenwrapper.TransferRecord is not mutable"))
+	case "zigchain.tokenwrapper.TransferRecord.status":
+		panic(fmt.Errorf("field status of message zigchain.tokenwrapper.TransferRecord is not mutable"))
+	case "zigchain.tokenwrapper.TransferRecord.reason":
+		panic(fmt.Errorf("field reason of message zigchain.tokenwrapper.TransferRecord is not mutable"))
+	case "zigchain.tokenwrapper.TransferRecord.height":
+		panic(fmt.Errorf("field height of message zigchain.tokenwrapper.TransferRecord is not mutable"))
+	case "zigchain.tokenwrapper.TransferRecord.updated_height":
+		panic(fmt.Errorf("field updated_height of message zigchain.tokenwrapper.TransferRecord is not mutable"))
+	case "zigchain.tokenwrapper.TransferRecord.fee":
+		panic(fmt.Errorf("field fee of message zigchain.tokenwrapper.TransferRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.TransferRecord"))
+		}
+		panic(fmt.Errorf("message zigchain.tokenwrapper.TransferRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TransferRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zigchain.tokenwrapper.TransferRecord.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zigchain.tokenwrapper.TransferRecord.native_port":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.TransferRecord.native_channel":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.TransferRecord.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zigchain.tokenwrapper.TransferRecord.inbound":
+		return protoreflect.ValueOfBool(false)
+	case "zigchain.tokenwrapper.TransferRecord.sender":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.TransferRecord.receiver":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.TransferRecord.ibc_amount":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.TransferRecord.native_amount":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.TransferRecord.status":
+		return protoreflect.ValueOfEnum(0)
+	case "zigchain.tokenwrapper.TransferRecord.reason":
+		return protoreflect.ValueOfString("")
+	case "zigchain.tokenwrapper.TransferRecord.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "zigchain.tokenwrapper.TransferRecord.updated_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "zigchain.tokenwrapper.TransferRecord.fee":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zigchain.tokenwrapper.TransferRecord"))
+		}
+		panic(fmt.Errorf("message zigchain.tokenwrapper.TransferRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TransferRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zigchain.tokenwrapper.TransferRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TransferRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TransferRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TransferRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TransferRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.NativePort)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NativeChannel)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if x.Inbound {
+			n += 2
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Receiver)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.IbcAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NativeAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.UpdatedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.UpdatedHeight))
+		}
+		l = len(x.Fee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TransferRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Fee) > 0 {
+			i -= len(x.Fee)
+			copy(dAtA[i:], x.Fee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Fee)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.UpdatedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UpdatedHeight))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.NativeAmount) > 0 {
+			i -= len(x.NativeAmount)
+			copy(dAtA[i:], x.NativeAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NativeAmount)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.IbcAmount) > 0 {
+			i -= len(x.IbcAmount)
+			copy(dAtA[i:], x.IbcAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IbcAmount)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Receiver) > 0 {
+			i -= len(x.Receiver)
+			copy(dAtA[i:], x.Receiver)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Receiver)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Inbound {
+			i--
+			if x.Inbound {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.NativeChannel) > 0 {
+			i -= len(x.NativeChannel)
+			copy(dAtA[i:], x.NativeChannel)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NativeChannel)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.NativePort) > 0 {
+			i -= len(x.NativePort)
+			copy(dAtA[i:], x.NativePort)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NativePort)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TransferRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransferRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NativePort", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NativePort = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NativeChannel", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NativeChannel = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Inbound", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Inbound = bool(v != 0)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Receiver = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IbcAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NativeAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NativeAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= TransferStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+				}
+				x.UpdatedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UpdatedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: zigchain/tokenwrapper/transfer_record.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TransferStatus is the status of a transfer wrapped over a bridge route
+type TransferStatus int32
+
+const (
+	// TRANSFER_STATUS_UNSPECIFIED is never stored
+	TransferStatus_TRANSFER_STATUS_UNSPECIFIED TransferStatus = 0
+	// TRANSFER_STATUS_PENDING is an outgoing transfer waiting for its
+	// acknowledgement
+	TransferStatus_TRANSFER_STATUS_PENDING TransferStatus = 1
+	// TRANSFER_STATUS_ACKED is an outgoing transfer acknowledged by the
+	// counterparty chain or an incoming transfer converted into native tokens
+	TransferStatus_TRANSFER_STATUS_ACKED TransferStatus = 2
+	// TRANSFER_STATUS_REFUNDED is an outgoing transfer refunded on an error
+	// acknowledgement
+	TransferStatus_TRANSFER_STATUS_REFUNDED TransferStatus = 3
+	// TRANSFER_STATUS_TIMED_OUT is an outgoing transfer refunded on a timeout
+	TransferStatus_TRANSFER_STATUS_TIMED_OUT TransferStatus = 4
+	// TRANSFER_STATUS_SKIPPED is an incoming transfer whose IBC vouchers were
+	// not converted, the reason of the record says why
+	TransferStatus_TRANSFER_STATUS_SKIPPED TransferStatus = 5
+)
+
+// Enum value maps for TransferStatus.
+var (
+	TransferStatus_name = map[int32]string{
+		0: "TRANSFER_STATUS_UNSPECIFIED",
+		1: "TRANSFER_STATUS_PENDING",
+		2: "TRANSFER_STATUS_ACKED",
+		3: "TRANSFER_STATUS_REFUNDED",
+		4: "TRANSFER_STATUS_TIMED_OUT",
+		5: "TRANSFER_STATUS_SKIPPED",
+	}
+	TransferStatus_value = map[string]int32{
+		"TRANSFER_STATUS_UNSPECIFIED": 0,
+		"TRANSFER_STATUS_PENDING":     1,
+		"TRANSFER_STATUS_ACKED":       2,
+		"TRANSFER_STATUS_REFUNDED":    3,
+		"TRANSFER_STATUS_TIMED_OUT":   4,
+		"TRANSFER_STATUS_SKIPPED":     5,
+	}
+)
+
+func (x TransferStatus) Enum() *TransferStatus {
+	p := new(TransferStatus)
+	*p = x
+	return p
+}
+
+func (x TransferStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_zigchain_tokenwrapper_transfer_record_proto_enumTypes[0].Descriptor()
+}
+
+func (TransferStatus) Type() protoreflect.EnumType {
+	return &file_zigchain_tokenwrapper_transfer_record_proto_enumTypes[0]
+}
+
+func (x TransferStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferStatus.Descriptor instead.
+func (TransferStatus) EnumDescriptor() ([]byte, []int) {
+	return file_zigchain_tokenwrapper_transfer_record_proto_rawDescGZIP(), []int{0}
+}
+
+// TransferRecord is the ledger entry of a packet wrapped over a bridge route
+type TransferRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id defines the position of the record in the ledger
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// native_port defines the native port of the bridge route
+	NativePort string `protobuf:"bytes,2,opt,name=native_port,json=nativePort,proto3" json:"native_port,omitempty"`
+	// native_channel defines the native channel of the bridge route
+	NativeChannel string `protobuf:"bytes,3,opt,name=native_channel,json=nativeChannel,proto3" json:"native_channel,omitempty"`
+	// sequence defines the sequence of the packet on the native channel
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// inbound is true for a received packet, false for a sent packet
+	Inbound bool `protobuf:"varint,5,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	// sender defines the sender of the packet
+	Sender string `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+	// receiver defines the receiver of the packet
+	Receiver string `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// ibc_amount defines the amount of IBC vouchers of the packet
+	IbcAmount string `protobuf:"bytes,8,opt,name=ibc_amount,json=ibcAmount,proto3" json:"ibc_amount,omitempty"`
+	// native_amount defines the amount of native tokens locked or unlocked,
+	// zero for an incoming transfer skipped before its conversion
+	NativeAmount string `protobuf:"bytes,9,opt,name=native_amount,json=nativeAmount,proto3" json:"native_amount,omitempty"`
+	// status defines the status of the transfer
+	Status TransferStatus `protobuf:"varint,10,opt,name=status,proto3,enum=zigchain.tokenwrapper.TransferStatus" json:"status,omitempty"`
+	// reason defines why the transfer was skipped or could not be refunded
+	Reason string `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+	// height defines the block height at which the packet was sent or received
+	Height int64 `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty"`
+	// updated_height defines the block height of the last status change
+	UpdatedHeight int64 `protobuf:"varint,13,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+	// fee defines the bridge fee in native tokens charged on the transfer, on
+	// top of the native amount
+	Fee string `protobuf:"bytes,14,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (x *TransferRecord) Reset() {
+	*x = TransferRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zigchain_tokenwrapper_transfer_record_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferRecord) ProtoMessage() {}
+
+// Deprecated: Use TransferRecord.ProtoReflect.Descriptor instead.
+func (*TransferRecord) Descriptor() ([]byte, []int) {
+	return file_zigchain_tokenwrapper_transfer_record_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TransferRecord) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransferRecord) GetNativePort() string {
+	if x != nil {
+		return x.NativePort
+	}
+	return ""
+}
+
+func (x *TransferRecord) GetNativeChannel() string {
+	if x != nil {
+		return x.NativeChannel
+	}
+	return ""
+}
+
+func (x *TransferRecord) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *TransferRecord) GetInbound() bool {
+	if x != nil {
+		return x.Inbound
+	}
+	return false
+}
+
+func (x *TransferRecord) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *TransferRecord) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *TransferRecord) GetIbcAmount() string {
+	if x != nil {
+		return x.IbcAmount
+	}
+	return ""
+}
+
+func (x *TransferRecord) GetNativeAmount() string {
+	if x != nil {
+		return x.NativeAmount
+	}
+	return ""
+}
+
+func (x *TransferRecord) GetStatus() TransferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransferStatus_TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (x *TransferRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TransferRecord) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *TransferRecord) GetUpdatedHeight() int64 {
+	if x != nil {
+		return x.UpdatedHeight
+	}
+	return 0
+}
+
+func (x *TransferRecord) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+var File_zigchain_tokenwrapper_transfer_record_proto protoreflect.FileDescriptor
+
+var file_zigchain_tokenwrapper_transfer_record_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x7a,
+	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaa, 0x04,
+	0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x72, 0x12, 0x41, 0x0a, 0x0a, 0x69, 0x62, 0x63, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x69, 0x62, 0x63, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x0d, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0c, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
+	0x2e, 0x7a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x34, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x03, 0x66, 0x65, 0x65, 0x2a, 0xc9, 0x01, 0x0a, 0x0e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a,
+	0x1b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b,
+	0x0a, 0x17, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x54,
+	0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41,
+	0x43, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x5f, 0x4f, 0x55,
+	0x54, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x05,
+	0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xcd, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x7a,
+	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x42, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x69,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x5a, 0x54, 0x58, 0xaa, 0x02, 0x15, 0x5a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0xca, 0x02, 0x15, 0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0xe2, 0x02, 0x21, 0x5a, 0x69, 0x67, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16,
+	0x5a, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_zigchain_tokenwrapper_transfer_record_proto_rawDescOnce sync.Once
+	file_zigchain_tokenwrapper_transfer_record_proto_rawDescData = file_zigchain_tokenwrapper_transfer_record_proto_rawDesc
+)
+
+func file_zigchain_tokenwrapper_transfer_record_proto_rawDescGZIP() []byte {
+	file_zigchain_tokenwrapper_transfer_record_proto_rawDescOnce.Do(func() {
+		file_zigchain_tokenwrapper_transfer_record_proto_rawDescData = protoimpl.X.CompressGZIP(file_zigchain_tokenwrapper_transfer_record_proto_rawDescData)
+	})
+	return file_zigchain_tokenwrapper_transfer_record_proto_rawDescData
+}
+
+var file_zigchain_tokenwrapper_transfer_record_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_zigchain_tokenwrapper_transfer_record_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_zigchain_tokenwrapper_transfer_record_proto_goTypes = []interface{}{
+	(TransferStatus)(0),    // 0: zigchain.tokenwrapper.TransferStatus
+	(*TransferRecord)(nil), // 1: zigchain.tokenwrapper.TransferRecord
+}
+var file_zigchain_tokenwrapper_transfer_record_proto_depIdxs = []int32{
+	0, // 0: zigchain.tokenwrapper.TransferRecord.status:type_name -> zigchain.tokenwrapper.TransferStatus
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_zigchain_tokenwrapper_transfer_record_proto_init() }
+func file_zigchain_tokenwrapper_transfer_record_proto_init() {
+	if File_zigchain_tokenwrapper_transfer_record_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_zigchain_tokenwrapper_transfer_record_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_zigchain_tokenwrapper_transfer_record_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_zigchain_tokenwrapper_transfer_record_proto_goTypes,
+		DependencyIndexes: file_zigchain_tokenwrapper_transfer_record_proto_depIdxs,
+		EnumInfos:         file_zigchain_tokenwrapper_transfer_record_proto_enumTypes,
+		MessageInfos:      file_zigchain_tokenwrapper_transfer_record_proto_msgTypes,
+	}.Build()
+	File_zigchain_tokenwrapper_transfer_record_proto = out.File
+	file_zigchain_tokenwrapper_transfer_record_proto_rawDesc = nil
+	file_zigchain_tokenwrapper_transfer_record_proto_goTypes = nil
+	file_zigchain_tokenwrapper_transfer_record_proto_depIdxs = nil
+}
@@ -30,6 +30,8 @@ const (
 	Msg_ProposeOperatorAddress_FullMethodName   = "/zigchain.tokenwrapper.Msg/ProposeOperatorAddress"
 	Msg_ClaimOperatorAddress_FullMethodName     = "/zigchain.tokenwrapper.Msg/ClaimOperatorAddress"
 	Msg_RecoverZig_FullMethodName               = "/zigchain.tokenwrapper.Msg/RecoverZig"
+	Msg_SetBridgeRouteEnabled_FullMethodName    = "/zigchain.tokenwrapper.Msg/SetBridgeRouteEnabled"
+	Msg_SetVolumeCap_FullMethodName             = "/zigchain.tokenwrapper.Msg/SetVolumeCap"
 )
 
 // MsgClient is the client API for Msg service.
@@ -51,6 +53,8 @@ type MsgClient interface {
 	ProposeOperatorAddress(ctx context.Context, in *MsgProposeOperatorAddress, opts ...grpc.CallOption) (*MsgProposeOperatorAddressResponse, error)
 	ClaimOperatorAddress(ctx context.Context, in *MsgClaimOperatorAddress, opts ...grpc.CallOption) (*MsgClaimOperatorAddressResponse, error)
 	RecoverZig(ctx context.Context, in *MsgRecoverZig, opts ...grpc.CallOption) (*MsgRecoverZigResponse, error)
+	SetBridgeRouteEnabled(ctx context.Context, in *MsgSetBridgeRouteEnabled, opts ...grpc.CallOption) (*MsgSetBridgeRouteEnabledResponse, error)
+	SetVolumeCap(ctx context.Context, in *MsgSetVolumeCap, opts ...grpc.CallOption) (*MsgSetVolumeCapResponse, error)
 }
 
 type msgClient struct {
@@ -171,6 +175,26 @@ func (c *msgClient) RecoverZig(ctx context.Context, in *MsgRecoverZig, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) SetBridgeRouteEnabled(ctx context.Context, in *MsgSetBridgeRouteEnabled, opts ...grpc.CallOption) (*MsgSetBridgeRouteEnabledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetBridgeRouteEnabledResponse)
+	err := c.cc.Invoke(ctx, Msg_SetBridgeRouteEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetVolumeCap(ctx context.Context, in *MsgSetVolumeCap, opts ...grpc.CallOption) (*MsgSetVolumeCapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetVolumeCapResponse)
+	err := c.cc.Invoke(ctx, Msg_SetVolumeCap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -190,6 +214,8 @@ type MsgServer interface {
 	ProposeOperatorAddress(context.Context, *MsgProposeOperatorAddress) (*MsgProposeOperatorAddressResponse, error)
 	ClaimOperatorAddress(context.Context, *MsgClaimOperatorAddress) (*MsgClaimOperatorAddressResponse, error)
 	RecoverZig(context.Context, *MsgRecoverZig) (*MsgRecoverZigResponse, error)
+	SetBridgeRouteEnabled(context.Context, *MsgSetBridgeRouteEnabled) (*MsgSetBridgeRouteEnabledResponse, error)
+	SetVolumeCap(context.Context, *MsgSetVolumeCap) (*MsgSetVolumeCapResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -233,6 +259,12 @@ func (UnimplementedMsgServer) ClaimOperatorAddress(context.Context, *MsgClaimOpe
 func (UnimplementedMsgServer) RecoverZig(context.Context, *MsgRecoverZig) (*MsgRecoverZigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecoverZig not implemented")
 }
+func (UnimplementedMsgServer) SetBridgeRouteEnabled(context.Context, *MsgSetBridgeRouteEnabled) (*MsgSetBridgeRouteEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBridgeRouteEnabled not implemented")
+}
+func (UnimplementedMsgServer) SetVolumeCap(context.Context, *MsgSetVolumeCap) (*MsgSetVolumeCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVolumeCap not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -452,6 +484,42 @@ func _Msg_RecoverZig_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetBridgeRouteEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBridgeRouteEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBridgeRouteEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetBridgeRouteEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBridgeRouteEnabled(ctx, req.(*MsgSetBridgeRouteEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetVolumeCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetVolumeCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetVolumeCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetVolumeCap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetVolumeCap(ctx, req.(*MsgSetVolumeCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -503,6 +571,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RecoverZig",
 			Handler:    _Msg_RecoverZig_Handler,
 		},
+		{
+			MethodName: "SetBridgeRouteEnabled",
+			Handler:    _Msg_SetBridgeRouteEnabled_Handler,
+		},
+		{
+			MethodName: "SetVolumeCap",
+			Handler:    _Msg_SetVolumeCap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zigchain/tokenwrapper/tx.proto",
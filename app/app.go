@@ -243,6 +243,19 @@ func New(
 	// build app
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
+	// enforce the freezes of the factory denoms on every bank transfer
+	app.BankKeeper.AppendSendRestriction(app.FactoryKeeper.FreezeRestriction)
+	// copy the balances of the factory denoms on their first change after a snapshot
+	app.BankKeeper.AppendSendRestriction(app.FactoryKeeper.SnapshotRestriction)
+	// settle the rewards of the factory denom holders before their balances change
+	app.BankKeeper.AppendSendRestriction(app.FactoryKeeper.DistributionRestriction)
+	// collect the transfer taxes of the factory denoms, the dex takes them out of the swaps leaving its pools
+	app.BankKeeper.AppendSendRestriction(app.FactoryKeeper.TransferTaxRestriction)
+	app.DexKeeper.SetTransferTaxKeeper(app.FactoryKeeper)
+	// keep the denoms of the open launches out of the dex until their launch seeds its own pool
+	app.BankKeeper.AppendSendRestriction(app.FactoryKeeper.LaunchRestriction)
+	app.FactoryKeeper.SetDexKeeper(app.DexKeeper)
+
 	// Register legacy modules
 	if err := app.registerIBCModules(appOpts); err != nil {
 		return nil, err
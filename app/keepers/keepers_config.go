@@ -196,6 +196,11 @@ var (
 		{Account: ibctransfertypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: icatypes.ModuleName},
 		{Account: factorymoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner, authtypes.Staking}},
+		{Account: factorymoduletypes.VestingEscrowName},
+		{Account: factorymoduletypes.AirdropEscrowName},
+		{Account: factorymoduletypes.DistributionEscrowName},
+		{Account: factorymoduletypes.CW20EscrowName},
+		{Account: factorymoduletypes.LaunchpadEscrowName},
 		{Account: wasmtypes.ModuleName, Permissions: []string{authtypes.Burner}},
 		{Account: dexmoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner, authtypes.Staking}},
 		{Account: tokenwrappermoduletypes.ModuleName, Permissions: []string{authtypes.Burner}},
@@ -211,6 +216,11 @@ var (
 		stakingtypes.NotBondedPoolName,
 		nft.ModuleName,
 		tokenwrappermoduletypes.ModuleName,
+		factorymoduletypes.VestingEscrowName,
+		factorymoduletypes.AirdropEscrowName,
+		factorymoduletypes.DistributionEscrowName,
+		factorymoduletypes.CW20EscrowName,
+		factorymoduletypes.LaunchpadEscrowName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
 	}
@@ -9,12 +9,14 @@ import (
 	"zigchain/app/upgrades"
 	v1 "zigchain/app/upgrades/v1"
 	v2 "zigchain/app/upgrades/v2"
+	v3 "zigchain/app/upgrades/v3"
 )
 
 var (
 	Upgrades = []upgrades.Upgrade{
 		v1.Upgrade,
 		v2.Upgrade,
+		v3.Upgrade,
 	}
 	Forks = []upgrades.Fork{}
 )
@@ -0,0 +1,34 @@
+package v3 //nolint:revive
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"zigchain/app/keepers"
+)
+
+// CreateUpgradeHandler returns an upgrade handler for ZIGChain v3.
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(c context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx := sdk.UnwrapSDKContext(c)
+		ctx.Logger().Info("Starting module migrations...")
+
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return vm, errorsmod.Wrapf(err, "running module migrations")
+		}
+
+		ctx.Logger().Info(fmt.Sprintf("Upgrade %s complete", UpgradeName))
+		return vm, nil
+	}
+}
@@ -91,6 +91,12 @@ func (app *App) registerWasmModules(
 		wasmOpts...,
 	)
 
+	// Factory denoms can call a CosmWasm contract before every transfer
+	app.FactoryKeeper.SetContractKeeper(&app.WasmKeeper)
+	// CW20 bridges lock and release CW20 tokens on behalf of the converting accounts
+	app.FactoryKeeper.SetCW20Keepers(wasmkeeper.NewDefaultPermissionKeeper(&app.WasmKeeper), &app.WasmKeeper)
+	app.BankKeeper.AppendSendRestriction(app.FactoryKeeper.BeforeSendRestriction)
+
 	// register IBC modules
 	if err := app.RegisterModules(
 		wasm.NewAppModule(
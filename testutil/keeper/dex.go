@@ -55,6 +55,7 @@ func DexKeeper(
 		bankKeeper,
 		mintKeeper,
 		accountKeeper,
+		nil,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -93,8 +94,9 @@ func DexKeeperWithBank(t testing.TB, mintKeeper types.MintKeeper) (keeper.Keeper
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 
 	var maccPerms = map[string][]string{
-		minttypes.ModuleName: {authtypes.Minter},
-		types.ModuleName:     {authtypes.Minter, authtypes.Burner},
+		minttypes.ModuleName:       {authtypes.Minter},
+		types.ModuleName:           {authtypes.Minter, authtypes.Burner},
+		authtypes.FeeCollectorName: nil,
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -124,6 +126,7 @@ func DexKeeperWithBank(t testing.TB, mintKeeper types.MintKeeper) (keeper.Keeper
 		bankKeeper,
 		mintKeeper,
 		accountKeeper,
+		nil,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
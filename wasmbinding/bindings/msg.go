@@ -36,6 +36,16 @@ type ZMsg struct {
 	// Contracts can burn tokens from the signer's account.
 	BurnTokens *BurnTokens `json:"burn_tokens,omitempty"`
 
+	// Contracts can set the CosmWasm contract called before every transfer
+	// of a denom they are the bank admin of.
+	SetBeforeSendHook *SetBeforeSendHook `json:"set_before_send_hook,omitempty"`
+
+	// Contracts can lock CW20 tokens they hold and receive the bridged factory denom.
+	ConvertCW20ToCoin *ConvertCW20ToCoin `json:"convert_cw20_to_coin,omitempty"`
+
+	// Contracts can burn a bridged factory denom and receive its CW20 tokens back.
+	ConvertCoinToCW20 *ConvertCoinToCW20 `json:"convert_coin_to_cw20,omitempty"`
+
 	// CreatePool creates a new pool.
 	CreatePool *CreatePool `json:"create_pool,omitempty"`
 
@@ -59,6 +69,7 @@ type CreateDenom struct {
 	CanChangeMintingCap bool            `json:"can_change_minting_cap"`
 	URI                 string          `json:"uri"`
 	URIHash             string          `json:"uri_hash"`
+	CanForceTransfer    bool            `json:"can_force_transfer"`
 }
 
 // SetDenomMetadata sets the metadata of a denom.
@@ -109,6 +120,24 @@ type BurnTokens struct {
 	Token sdk.Coin `json:"token"`
 }
 
+// SetBeforeSendHook sets the before send hook contract of a denom,
+// an empty contract address removes it.
+type SetBeforeSendHook struct {
+	Denom           string `json:"denom"`
+	ContractAddress string `json:"contract_address"`
+}
+
+// ConvertCW20ToCoin locks CW20 tokens of a bridged contract and mints its factory denom.
+type ConvertCW20ToCoin struct {
+	ContractAddress string          `json:"contract_address"`
+	Amount          cosmosmath.Uint `json:"amount"`
+}
+
+// ConvertCoinToCW20 burns a bridged factory denom and releases its CW20 tokens.
+type ConvertCoinToCW20 struct {
+	Coin sdk.Coin `json:"coin"`
+}
+
 // DEX STUFF
 
 // CreatePool creates a new pool.
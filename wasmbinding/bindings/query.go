@@ -15,6 +15,9 @@ type ZQuery struct {
 
 	// returns swap information based on the current pool state and incoming token.
 	SwapIn *SwapIn `json:"swap_in,omitempty"`
+
+	// returns the verified denom registry entry of a denom or a ticker.
+	VerifiedDenom *VerifiedDenom `json:"verified_denom,omitempty"`
 }
 
 // Denom is a query message option to get the full denom info based on denom name.
@@ -31,6 +34,23 @@ type DenomResponse struct {
 	Creator             string          `json:"creator"`
 	BankAdmin           string          `json:"bank_admin"`
 	MetadataAdmin       string          `json:"metadata_admin"`
+	Verified            bool            `json:"verified"`
+}
+
+// VerifiedDenom is a query message option to get the verified denom registry entry
+// of a denom, or of a ticker when the denom is empty.
+type VerifiedDenom struct {
+	Denom  string `json:"denom,omitempty"`
+	Ticker string `json:"ticker,omitempty"`
+}
+
+// VerifiedDenomResponse is the response to the VerifiedDenom query.
+type VerifiedDenomResponse struct {
+	Verified bool   `json:"verified"`
+	Denom    string `json:"denom"`
+	Ticker   string `json:"ticker"`
+	URIHash  string `json:"uri_hash"`
+	Decimals uint32 `json:"decimals"`
 }
 
 // Pool is a query message option to get the full pool info based on pool ID.
@@ -52,12 +72,18 @@ type PoolResponse struct {
 type SwapIn struct {
 	PoolID string   `json:"pool_id"`
 	CoinIn sdk.Coin `json:"coin_in"`
+	// Address is the trader the transfer taxes are quoted for, optional
+	Address string `json:"address,omitempty"`
 }
 
 // SwapInResponse is the response to the SwapIn query.
 type SwapInResponse struct {
 	CoinOut sdk.Coin `json:"coin_out"`
 	Fee     sdk.Coin `json:"fee"`
+	// IncomingTax is the transfer tax paid on top of the incoming token, when it is taxed
+	IncomingTax *sdk.Coin `json:"incoming_tax,omitempty"`
+	// OutgoingTax is the transfer tax already taken out of CoinOut, when it is taxed
+	OutgoingTax *sdk.Coin `json:"outgoing_tax,omitempty"`
 }
 
 // SwapOut is a query message option to get the swap out info based on pool ID and outgoing token.
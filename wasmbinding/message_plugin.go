@@ -108,6 +108,15 @@ func (m *CustomMessenger) DispatchMsg(
 		case contractMsg.BurnTokens != nil:
 
 			return m.burnTokens(ctx, contractAddr, contractMsg.BurnTokens)
+		case contractMsg.SetBeforeSendHook != nil:
+
+			return m.setBeforeSendHook(ctx, contractAddr, contractMsg.SetBeforeSendHook)
+		case contractMsg.ConvertCW20ToCoin != nil:
+
+			return m.convertCW20ToCoin(ctx, contractAddr, contractMsg.ConvertCW20ToCoin)
+		case contractMsg.ConvertCoinToCW20 != nil:
+
+			return m.convertCoinToCW20(ctx, contractAddr, contractMsg.ConvertCoinToCW20)
 		case contractMsg.CreatePool != nil:
 
 			return m.createPool(ctx, contractAddr, contractMsg.CreatePool)
@@ -172,6 +181,7 @@ func PerformCreateDenom(
 		createDenom.URI,
 		createDenom.URIHash,
 	)
+	msgCreateDenom.CanForceTransfer = createDenom.CanForceTransfer
 
 	if err := msgCreateDenom.ValidateBasic(); err != nil {
 		return errorsmod.Wrap(err, "failed validating MsgCreateDenom")
@@ -516,6 +526,137 @@ func PerformBurnTokens(
 	return nil
 }
 
+// setBeforeSendHook sets or removes the before send hook contract of a denom, only bank admin can do it
+func (m *CustomMessenger) setBeforeSendHook(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	setBeforeSendHook *bindings.SetBeforeSendHook,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	err := PerformSetBeforeSendHook(m.tokenFactory, ctx, contractAddr, setBeforeSendHook)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform set before send hook")
+	}
+	return nil, nil, nil, nil
+}
+
+func PerformSetBeforeSendHook(
+	f *factorykeeper.Keeper,
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	setBeforeSendHook *bindings.SetBeforeSendHook,
+) error {
+	if setBeforeSendHook == nil {
+		return wasmvmtypes.InvalidRequest{Err: "set before send hook null set before send hook"}
+	}
+
+	msgSetBeforeSendHook := factorytypes.NewMsgSetBeforeSendHook(
+		contractAddr.String(),
+		setBeforeSendHook.Denom,
+		setBeforeSendHook.ContractAddress,
+	)
+	if err := msgSetBeforeSendHook.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "failed validating MsgSetBeforeSendHook")
+	}
+
+	msgServer := factorykeeper.NewMsgServerImpl(*f)
+
+	_, err := msgServer.SetBeforeSendHook(
+		ctx,
+		msgSetBeforeSendHook,
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "setting before send hook")
+	}
+	return nil
+}
+
+// convertCW20ToCoin locks CW20 tokens of the contract and mints the bridged denom to it
+func (m *CustomMessenger) convertCW20ToCoin(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	convertCW20ToCoin *bindings.ConvertCW20ToCoin,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	err := PerformConvertCW20ToCoin(m.tokenFactory, ctx, contractAddr, convertCW20ToCoin)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform convert cw20 to coin")
+	}
+	return nil, nil, nil, nil
+}
+
+func PerformConvertCW20ToCoin(
+	f *factorykeeper.Keeper,
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	convertCW20ToCoin *bindings.ConvertCW20ToCoin,
+) error {
+	if convertCW20ToCoin == nil {
+		return wasmvmtypes.InvalidRequest{Err: "convert cw20 to coin null convert cw20 to coin"}
+	}
+
+	msgConvertCW20ToCoin := factorytypes.NewMsgConvertCW20ToCoin(
+		contractAddr.String(),
+		convertCW20ToCoin.ContractAddress,
+		convertCW20ToCoin.Amount,
+	)
+	if err := msgConvertCW20ToCoin.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "failed validating MsgConvertCW20ToCoin")
+	}
+
+	msgServer := factorykeeper.NewMsgServerImpl(*f)
+
+	_, err := msgServer.ConvertCW20ToCoin(
+		ctx,
+		msgConvertCW20ToCoin,
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "converting cw20 to coin")
+	}
+	return nil
+}
+
+// convertCoinToCW20 burns the bridged denom of the contract and releases the CW20 tokens to it
+func (m *CustomMessenger) convertCoinToCW20(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	convertCoinToCW20 *bindings.ConvertCoinToCW20,
+) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	err := PerformConvertCoinToCW20(m.tokenFactory, ctx, contractAddr, convertCoinToCW20)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform convert coin to cw20")
+	}
+	return nil, nil, nil, nil
+}
+
+func PerformConvertCoinToCW20(
+	f *factorykeeper.Keeper,
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	convertCoinToCW20 *bindings.ConvertCoinToCW20,
+) error {
+	if convertCoinToCW20 == nil {
+		return wasmvmtypes.InvalidRequest{Err: "convert coin to cw20 null convert coin to cw20"}
+	}
+
+	msgConvertCoinToCW20 := factorytypes.NewMsgConvertCoinToCW20(
+		contractAddr.String(),
+		convertCoinToCW20.Coin,
+	)
+	if err := msgConvertCoinToCW20.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "failed validating MsgConvertCoinToCW20")
+	}
+
+	msgServer := factorykeeper.NewMsgServerImpl(*f)
+
+	_, err := msgServer.ConvertCoinToCW20(
+		ctx,
+		msgConvertCoinToCW20,
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "converting coin to cw20")
+	}
+	return nil
+}
+
 // createPool creates a new pool
 func (m *CustomMessenger) createPool(
 	ctx sdk.Context,
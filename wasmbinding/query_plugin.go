@@ -115,6 +115,8 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 				MetadataAdmin:       denomAuth.MetadataAdmin,
 			}
 
+			_, res.Verified = qp.factoryKeeper.GetVerifiedDenom(ctx, denom)
+
 			bz, err := json.Marshal(res)
 			if err != nil {
 				return nil, errorsmod.Wrap(err, "denom query response")
@@ -122,6 +124,37 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.VerifiedDenom != nil:
+			query := contractQuery.VerifiedDenom
+
+			var verified factoryTypes.VerifiedDenom
+			var found bool
+			if query.Denom != "" {
+				verified, found = qp.factoryKeeper.GetVerifiedDenom(ctx, query.Denom)
+			} else {
+				verified, found = qp.factoryKeeper.GetVerifiedDenomByTicker(ctx, query.Ticker)
+			}
+
+			// an unverified denom is not an error, contracts check the verified flag
+			res := bindings.VerifiedDenomResponse{
+				Verified: found,
+				Denom:    verified.Denom,
+				Ticker:   verified.Ticker,
+				URIHash:  verified.UriHash,
+				Decimals: verified.Decimals,
+			}
+			if !found {
+				res.Denom = query.Denom
+				res.Ticker = query.Ticker
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "verified denom query response")
+			}
+
+			return bz, nil
+
 		case contractQuery.Pool != nil:
 			poolID := contractQuery.Pool.PoolID
 
@@ -171,7 +204,18 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 					)
 			}
 
-			coinOut, feeCoin, err := dexkeeper.CalculateSwapAmount(&kPool, coinIn)
+			var trader sdk.AccAddress
+			if contractQuery.SwapIn.Address != "" {
+				address, err := sdk.AccAddressFromBech32(contractQuery.SwapIn.Address)
+				if err != nil {
+					return nil, errorsmod.Wrap(err, "swap in query address")
+				}
+				trader = address
+			}
+
+			feeRate := qp.dexKeeper.GetEffectiveFee(ctx, kPool)
+
+			coinOut, feeCoin, err := dexkeeper.CalculateSwapAmountWithFee(&kPool, coinIn, feeRate)
 
 			if err != nil {
 				return nil, err
@@ -182,6 +226,16 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 				Fee:     feeCoin,
 			}
 
+			// the transfer taxes are paid on top of the incoming token and taken out of the outgoing token
+			poolAddress := dextypes.GetPoolAddress(kPool.PoolId)
+			if incomingTax := qp.dexKeeper.IncomingTransferTax(ctx, trader, poolAddress, coinIn); incomingTax.IsPositive() {
+				res.IncomingTax = &incomingTax
+			}
+			if outgoingTax, _ := qp.dexKeeper.OutgoingTransferTax(ctx, poolAddress, trader, coinOut); outgoingTax.IsPositive() {
+				res.CoinOut = coinOut.Sub(outgoingTax)
+				res.OutgoingTax = &outgoingTax
+			}
+
 			bz, err := json.Marshal(res)
 			if err != nil {
 				return nil, errorsmod.Wrap(err, "swap in query response")
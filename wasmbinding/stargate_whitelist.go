@@ -69,6 +69,8 @@ func init() {
 
 	// tokenfactory
 	setWhitelistedQuery("/zigchain.factory.v1beta1.Query/Params", &factorytypes.QueryParamsResponse{})
+	setWhitelistedQuery("/zigchain.factory.Query/VerifiedDenoms", &factorytypes.QueryVerifiedDenomsResponse{})
+	setWhitelistedQuery("/zigchain.factory.Query/VerifiedDenomByTicker", &factorytypes.QueryVerifiedDenomResponse{})
 	// Does not include denoms_from_creator, TBD if this is the index we want contracts to use instead of admin
 
 }
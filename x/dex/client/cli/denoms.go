@@ -0,0 +1,156 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// displayAmountRegex matches human-readable amounts like 10.5ZIG or 100 uzig
+var displayAmountRegex = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)\s*([a-zA-Z][a-zA-Z0-9/:._-]*)$`)
+
+// ResolvedDenom is a denom resolved from bank metadata, together with the unit the user referred to
+type ResolvedDenom struct {
+	// Base is the base denom, as used on chain
+	Base string
+	// Unit is the denom unit the user referred to
+	Unit string
+	// Exponent is the exponent of Unit relative to Base
+	Exponent uint32
+	// Display is the display unit of the denom, used to print amounts
+	Display string
+	// DisplayExponent is the exponent of Display relative to Base
+	DisplayExponent uint32
+}
+
+// ParseDisplayAmount splits a human-readable amount like 10.5ZIG into its amount and denom
+func ParseDisplayAmount(input string) (math.LegacyDec, string, error) {
+	matches := displayAmountRegex.FindStringSubmatch(strings.TrimSpace(input))
+	if matches == nil {
+		return math.LegacyDec{}, "", fmt.Errorf("invalid amount %q, expected format like 10.5ZIG", input)
+	}
+
+	amount, err := math.LegacyNewDecFromStr(matches[1])
+	if err != nil {
+		return math.LegacyDec{}, "", fmt.Errorf("invalid amount %q: %w", input, err)
+	}
+
+	if !amount.IsPositive() {
+		return math.LegacyDec{}, "", fmt.Errorf("amount must be positive: %s", input)
+	}
+
+	return amount, matches[2], nil
+}
+
+// ToBaseAmount scales a display amount to the base unit, failing if it has more decimals than the unit allows
+func ToBaseAmount(amount math.LegacyDec, exponent uint32) (math.Int, error) {
+	scaled := amount.Mul(math.LegacyNewDecFromInt(math.NewIntWithDecimal(1, int(exponent))))
+	if !scaled.IsInteger() {
+		return math.Int{}, fmt.Errorf("amount %s has more than %d decimals", amount.String(), exponent)
+	}
+
+	return scaled.TruncateInt(), nil
+}
+
+// FromBaseAmount scales a base amount to the given exponent
+func FromBaseAmount(amount math.Int, exponent uint32) math.LegacyDec {
+	return math.LegacyNewDecFromInt(amount).Quo(math.LegacyNewDecFromInt(math.NewIntWithDecimal(1, int(exponent))))
+}
+
+// ResolveDenom resolves a base denom, denom unit, alias, display or symbol to its base denom
+// using the bank metadata exponents; denoms without metadata are returned as base denoms
+func ResolveDenom(ctx context.Context, clientCtx client.Context, input string) (ResolvedDenom, error) {
+	bankClient := banktypes.NewQueryClient(clientCtx)
+
+	// exact base denom, the most common case for factory denoms and IBC vouchers
+	res, err := bankClient.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: input})
+	if err == nil {
+		if resolved, ok := matchMetadata(res.Metadata, input); ok {
+			return resolved, nil
+		}
+	}
+
+	var matches []ResolvedDenom
+	var nextKey []byte
+	for {
+		allRes, err := bankClient.DenomsMetadata(ctx, &banktypes.QueryDenomsMetadataRequest{
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return ResolvedDenom{}, err
+		}
+
+		for _, metadata := range allRes.Metadatas {
+			if resolved, ok := matchMetadata(metadata, input); ok {
+				matches = append(matches, resolved)
+			}
+		}
+
+		if allRes.Pagination == nil || len(allRes.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = allRes.Pagination.NextKey
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		if err := sdk.ValidateDenom(input); err != nil {
+			return ResolvedDenom{}, fmt.Errorf("unknown denom %q: %w", input, err)
+		}
+		return ResolvedDenom{Base: input, Unit: input, Display: input}, nil
+	default:
+		bases := make([]string, 0, len(matches))
+		for _, match := range matches {
+			bases = append(bases, match.Base)
+		}
+		return ResolvedDenom{}, fmt.Errorf("denom %q is ambiguous, use one of the base denoms: %s", input, strings.Join(bases, ", "))
+	}
+}
+
+// matchMetadata checks if the input refers to the denom described by the metadata
+func matchMetadata(metadata banktypes.Metadata, input string) (ResolvedDenom, bool) {
+	resolved := ResolvedDenom{
+		Base:    metadata.Base,
+		Display: metadata.Base,
+	}
+
+	found := false
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			resolved.Display = unit.Denom
+			resolved.DisplayExponent = unit.Exponent
+		}
+
+		if found {
+			continue
+		}
+
+		if strings.EqualFold(unit.Denom, input) {
+			resolved.Unit, resolved.Exponent, found = unit.Denom, unit.Exponent, true
+			continue
+		}
+
+		for _, alias := range unit.Aliases {
+			if strings.EqualFold(alias, input) {
+				resolved.Unit, resolved.Exponent, found = unit.Denom, unit.Exponent, true
+				break
+			}
+		}
+	}
+
+	// symbol refers to the display unit
+	if !found && metadata.Symbol != "" && strings.EqualFold(metadata.Symbol, input) {
+		resolved.Unit, resolved.Exponent, found = resolved.Display, resolved.DisplayExponent, true
+	}
+
+	return resolved, found
+}
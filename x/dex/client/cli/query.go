@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"zigchain/x/dex/types"
+)
+
+// GetQueryCmd returns the query commands for this module.
+// These commands enrich the AutoCLI query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQuote())
+
+	return cmd
+}
+
+// CmdQuote returns a live swap quote for a human-readable amount
+func CmdQuote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote [amount] [target-denom]",
+		Short: "Quotes a swap using human-readable amounts, resolving denoms via bank metadata",
+		Long: "Quotes a swap of the given amount into the target denom. The amount and target denom can use " +
+			"any denom unit, alias or symbol from the bank metadata (e.g. 10.5ZIG), the pool is found automatically.",
+		Example: "  zigchaind query dex quote 10.5ZIG USDC --chain-id zigchain\n" +
+			"  zigchaind query dex quote 1000000uzig coin.zig1ajg7jku4crf46lcskykwvkjrwfj7zan98az4k2.usdt --chain-id zigchain",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			quote, err := GetSwapQuote(cmd.Context(), clientCtx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.Marshal(quote)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
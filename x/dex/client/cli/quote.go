@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/dex/types"
+)
+
+// SwapQuote is a live quote of a swap, with amounts both in base units and in display units
+type SwapQuote struct {
+	PoolId         string   `json:"pool_id"`
+	CoinIn         sdk.Coin `json:"coin_in"`
+	CoinOut        sdk.Coin `json:"coin_out"`
+	Fee            sdk.Coin `json:"fee"`
+	FeeRate        uint32   `json:"fee_rate"`
+	DisplayIn      string   `json:"display_in"`
+	DisplayOut     string   `json:"display_out"`
+	SpotPrice      string   `json:"spot_price"`
+	ExecutionPrice string   `json:"execution_price"`
+	// PriceImpact is the relative difference between spot and execution price, in percent, fee included
+	PriceImpact string `json:"price_impact"`
+
+	in  ResolvedDenom
+	out ResolvedDenom
+}
+
+// GetSwapQuote resolves the human-readable amount and target denom, finds the pool and queries a live quote
+func GetSwapQuote(ctx context.Context, clientCtx client.Context, amountArg string, targetArg string) (SwapQuote, error) {
+	amount, inputDenom, err := ParseDisplayAmount(amountArg)
+	if err != nil {
+		return SwapQuote{}, err
+	}
+
+	in, err := ResolveDenom(ctx, clientCtx, inputDenom)
+	if err != nil {
+		return SwapQuote{}, err
+	}
+
+	out, err := ResolveDenom(ctx, clientCtx, targetArg)
+	if err != nil {
+		return SwapQuote{}, err
+	}
+
+	if in.Base == out.Base {
+		return SwapQuote{}, fmt.Errorf("cannot swap %s to itself", in.Base)
+	}
+
+	baseAmount, err := ToBaseAmount(amount, in.Exponent)
+	if err != nil {
+		return SwapQuote{}, err
+	}
+	coinIn := sdk.NewCoin(in.Base, baseAmount)
+
+	queryClient := types.NewQueryClient(clientCtx)
+
+	poolUid, err := queryClient.GetPoolUid(ctx, &types.QueryGetPoolUidRequest{Base: in.Base, Quote: out.Base})
+	if err != nil {
+		return SwapQuote{}, fmt.Errorf("no pool found for %s and %s: %w", in.Base, out.Base, err)
+	}
+	poolId := poolUid.PoolUids.PoolId
+
+	poolRes, err := queryClient.GetPool(ctx, &types.QueryGetPoolRequest{PoolId: poolId})
+	if err != nil {
+		return SwapQuote{}, err
+	}
+
+	swapRes, err := queryClient.SwapIn(ctx, &types.QuerySwapInRequest{PoolId: poolId, CoinIn: coinIn.String()})
+	if err != nil {
+		return SwapQuote{}, err
+	}
+
+	reserveIn := sdk.Coins(poolRes.Pool.Coins).AmountOf(in.Base)
+	reserveOut := sdk.Coins(poolRes.Pool.Coins).AmountOf(out.Base)
+	if !reserveIn.IsPositive() || !reserveOut.IsPositive() {
+		return SwapQuote{}, fmt.Errorf("pool %s has no liquidity", poolId)
+	}
+
+	// prices are in display units of the target denom per display unit of the incoming denom
+	spotPrice := displayPrice(reserveOut, out.DisplayExponent, reserveIn, in.DisplayExponent)
+	executionPrice := displayPrice(swapRes.CoinOut.Amount, out.DisplayExponent, coinIn.Amount, in.DisplayExponent)
+	priceImpact := math.LegacyOneDec().Sub(executionPrice.Quo(spotPrice)).MulInt64(100)
+
+	return SwapQuote{
+		PoolId:         poolId,
+		CoinIn:         coinIn,
+		CoinOut:        swapRes.CoinOut,
+		Fee:            swapRes.Fee,
+		FeeRate:        swapRes.FeeRate,
+		DisplayIn:      formatDisplayAmount(coinIn.Amount, in),
+		DisplayOut:     formatDisplayAmount(swapRes.CoinOut.Amount, out),
+		SpotPrice:      trimDec(spotPrice),
+		ExecutionPrice: trimDec(executionPrice),
+		PriceImpact:    trimDec(priceImpact),
+		in:             in,
+		out:            out,
+	}, nil
+}
+
+// displayPrice returns the price of amountIn expressed in amountOut, both scaled to their display units
+func displayPrice(amountOut math.Int, exponentOut uint32, amountIn math.Int, exponentIn uint32) math.LegacyDec {
+	return FromBaseAmount(amountOut, exponentOut).Quo(FromBaseAmount(amountIn, exponentIn))
+}
+
+// formatDisplayAmount prints a base amount in the display unit of the denom
+func formatDisplayAmount(amount math.Int, denom ResolvedDenom) string {
+	return trimDec(FromBaseAmount(amount, denom.DisplayExponent)) + denom.Display
+}
+
+// trimDec prints a decimal without trailing zeros
+func trimDec(dec math.LegacyDec) string {
+	s := dec.String()
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return s
+}
@@ -23,6 +23,7 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdSmartSwap())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
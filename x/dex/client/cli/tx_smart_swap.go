@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/dex/types"
+)
+
+const (
+	// FlagSlippage is the maximum accepted slippage of a smart swap
+	FlagSlippage = "slippage"
+	// FlagReceiver is the optional receiver of a smart swap
+	FlagReceiver = "receiver"
+
+	// DefaultSlippage is the default maximum accepted slippage of a smart swap
+	DefaultSlippage = "0.5%"
+)
+
+// CmdSmartSwap swaps a human-readable amount into a target denom, protecting the swap with a slippage limit
+func CmdSmartSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "smart-swap [amount] [target-denom] --slippage (optional) --receiver (optional)",
+		Short: "Swap tokens using human-readable amounts, with automatic pool lookup and slippage protection",
+		Long: "Swaps the given amount into the target denom. The amount and target denom can use any denom unit, " +
+			"alias or symbol from the bank metadata (e.g. 10.5ZIG). The pool is found automatically and the minimum " +
+			"outgoing amount is computed from a live quote and the slippage, the quote and its price impact are " +
+			"printed before signing.",
+		Example: "  zigchaind tx dex smart-swap 10.5ZIG USDC --slippage 0.5% --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3\n" +
+			"  zigchaind tx dex smart-swap 10.5ZIG USDC --receiver zig1abc --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			slippageArg, err := cmd.Flags().GetString(FlagSlippage)
+			if err != nil {
+				return err
+			}
+
+			slippage, err := ParseSlippage(slippageArg)
+			if err != nil {
+				return err
+			}
+
+			receiver, err := cmd.Flags().GetString(FlagReceiver)
+			if err != nil {
+				return err
+			}
+
+			quote, err := GetSwapQuote(cmd.Context(), clientCtx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			outgoingMin := sdk.NewCoin(quote.CoinOut.Denom, ApplySlippage(quote.CoinOut.Amount, slippage))
+			if !outgoingMin.IsPositive() {
+				return fmt.Errorf("swap output %s is too small for a %s%% slippage limit", quote.CoinOut, trimDec(slippage))
+			}
+
+			msg := types.NewMsgSwapExactIn(
+				clientCtx.GetFromAddress().String(),
+				quote.CoinIn,
+				quote.PoolId,
+				receiver,
+				&outgoingMin,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			printSwapConfirmation(cmd, quote, slippage, outgoingMin)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSlippage, DefaultSlippage, "Maximum accepted slippage in percent (e.g. 0.5%)")
+	cmd.Flags().StringP(FlagReceiver, "r", "", "Address of the receiver (zig1...)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ParseSlippage parses a slippage percentage like 0.5% or 0.5
+func ParseSlippage(input string) (math.LegacyDec, error) {
+	slippage, err := math.LegacyNewDecFromStr(strings.TrimSuffix(strings.TrimSpace(input), "%"))
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid slippage %q, expected a percentage like 0.5%%", input)
+	}
+
+	if slippage.IsNegative() || slippage.GTE(math.LegacyNewDec(100)) {
+		return math.LegacyDec{}, fmt.Errorf("slippage must be between 0%% and 100%%: %s", input)
+	}
+
+	return slippage, nil
+}
+
+// ApplySlippage returns the minimum amount accepted for the quoted amount and slippage percentage
+func ApplySlippage(amount math.Int, slippage math.LegacyDec) math.Int {
+	return math.LegacyNewDecFromInt(amount).
+		Mul(math.LegacyNewDec(100).Sub(slippage)).
+		QuoInt64(100).
+		TruncateInt()
+}
+
+// printSwapConfirmation prints the quote before signing, the tx confirmation prompt follows unless --yes is set
+func printSwapConfirmation(cmd *cobra.Command, quote SwapQuote, slippage math.LegacyDec, outgoingMin sdk.Coin) {
+	out := cmd.ErrOrStderr()
+	_, _ = fmt.Fprintf(out, "Smart swap on pool %s\n", quote.PoolId)
+	_, _ = fmt.Fprintf(out, "  you pay:          %s (%s)\n", quote.DisplayIn, quote.CoinIn)
+	_, _ = fmt.Fprintf(out, "  you receive:      ~%s (%s)\n", quote.DisplayOut, quote.CoinOut)
+	_, _ = fmt.Fprintf(out, "  minimum received: %s (%s%% slippage)\n", formatDisplayAmount(outgoingMin.Amount, quote.out), trimDec(slippage))
+	_, _ = fmt.Fprintf(out, "  fee:              %s (%s%%)\n", quote.Fee, trimDec(math.LegacyNewDec(int64(quote.FeeRate)).QuoInt64(1000)))
+	_, _ = fmt.Fprintf(out, "  price:            1%s = %s%s\n", quote.in.Display, quote.ExecutionPrice, quote.out.Display)
+	_, _ = fmt.Fprintf(out, "  price impact:     %s%%\n", quote.PriceImpact)
+}
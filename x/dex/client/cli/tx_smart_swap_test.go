@@ -0,0 +1,40 @@
+package cli_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"zigchain/x/dex/client/cli"
+)
+
+func TestParseDisplayAmount(t *testing.T) {
+	amount, denom, err := cli.ParseDisplayAmount("10.5ZIG")
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("10.5"), amount)
+	require.Equal(t, "ZIG", denom)
+
+	baseAmount, err := cli.ToBaseAmount(amount, 6)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(10_500_000), baseAmount)
+
+	// more decimals than the unit allows
+	_, err = cli.ToBaseAmount(math.LegacyMustNewDecFromStr("0.0000001"), 6)
+	require.Error(t, err)
+
+	_, _, err = cli.ParseDisplayAmount("ZIG")
+	require.Error(t, err)
+}
+
+func TestParseSlippage(t *testing.T) {
+	slippage, err := cli.ParseSlippage("0.5%")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(995), cli.ApplySlippage(math.NewInt(1000), slippage))
+
+	_, err = cli.ParseSlippage("100%")
+	require.Error(t, err)
+
+	_, err = cli.ParseSlippage("abc")
+	require.Error(t, err)
+}
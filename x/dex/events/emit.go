@@ -1,6 +1,9 @@
 package events
 
 import (
+	"strconv"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"zigchain/x/dex/types"
@@ -159,3 +162,64 @@ func newRemoveLiquidityEvent(
 		sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
 	)
 }
+
+func EmitDynamicFeeConfigUpdatedEvent(ctx sdk.Context, config *types.DynamicFeeConfig) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newDynamicFeeConfigUpdatedEvent(config),
+	})
+}
+
+func newDynamicFeeConfigUpdatedEvent(config *types.DynamicFeeConfig) sdk.Event {
+	return sdk.NewEvent(
+		types.EventDynamicFeeConfigUpdated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, config.PoolId),
+		sdk.NewAttribute(types.AttributeKeyDynamicFeeEnabled, strconv.FormatBool(config.Enabled)),
+		sdk.NewAttribute(types.AttributeKeyMinFee, strconv.FormatUint(uint64(config.MinFee), 10)),
+		sdk.NewAttribute(types.AttributeKeyMaxFee, strconv.FormatUint(uint64(config.MaxFee), 10)),
+		sdk.NewAttribute(types.AttributeKeyVolatilityCap, strconv.FormatUint(uint64(config.VolatilityCap), 10)),
+		sdk.NewAttribute(types.AttributeKeyDecayWindow, strconv.FormatUint(config.DecayWindow, 10)),
+	)
+}
+
+func EmitBuybackConfigUpdatedEvent(ctx sdk.Context, config *types.BuybackConfig) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newBuybackConfigUpdatedEvent(config),
+	})
+}
+
+func newBuybackConfigUpdatedEvent(config *types.BuybackConfig) sdk.Event {
+	return sdk.NewEvent(
+		types.EventBuybackConfigUpdated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyBuybackEnabled, strconv.FormatBool(config.Enabled)),
+		sdk.NewAttribute(types.AttributeKeyIntervalBlocks, strconv.FormatUint(config.IntervalBlocks, 10)),
+		sdk.NewAttribute(types.AttributeKeyMaxSlippage, strconv.FormatUint(uint64(config.MaxSlippage), 10)),
+		sdk.NewAttribute(types.AttributeKeySourceModules, strings.Join(config.SourceModules, ",")),
+		sdk.NewAttribute(types.AttributeKeyDestination, config.Destination.String()),
+		sdk.NewAttribute(types.AttributeKeyMaxSwapsPerRun, strconv.FormatUint(uint64(config.MaxSwapsPerRun), 10)),
+	)
+}
+
+func EmitBuybackExecutedEvent(
+	ctx sdk.Context,
+	destination types.BuybackDestination,
+	sold sdk.Coins,
+	bought sdk.Coin,
+	swapCount uint32,
+) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newBuybackExecutedEvent(destination, sold, bought, swapCount),
+	})
+}
+
+func newBuybackExecutedEvent(destination types.BuybackDestination, sold sdk.Coins, bought sdk.Coin, swapCount uint32) sdk.Event {
+	return sdk.NewEvent(
+		types.EventBuybackExecuted,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyTokensSold, sold.String()),
+		sdk.NewAttribute(types.AttributeKeyAmountBought, bought.String()),
+		sdk.NewAttribute(types.AttributeKeyDestination, destination.String()),
+		sdk.NewAttribute(types.AttributeKeyBuybackSwapCount, strconv.FormatUint(uint64(swapCount), 10)),
+	)
+}
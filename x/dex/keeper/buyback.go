@@ -0,0 +1,248 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zigchain/x/dex/events"
+	"zigchain/x/dex/types"
+	"zigchain/zutils/constants"
+)
+
+// SetBuybackConfig set the buyback config in the store
+func (k Keeper) SetBuybackConfig(ctx context.Context, config types.BuybackConfig) {
+	if err := k.BuybackConfig.Set(ctx, config); err != nil {
+		panic(err)
+	}
+}
+
+// GetBuybackConfig returns the buyback config, or the default (disabled) config if none was set
+func (k Keeper) GetBuybackConfig(ctx context.Context) types.BuybackConfig {
+	val, err := k.BuybackConfig.Get(ctx)
+	if err != nil {
+		return types.DefaultBuybackConfig()
+	}
+
+	return val
+}
+
+// SetBuybackStats set the buyback totals in the store
+func (k Keeper) SetBuybackStats(ctx context.Context, stats types.BuybackStats) {
+	if err := k.BuybackStats.Set(ctx, stats); err != nil {
+		panic(err)
+	}
+}
+
+// GetBuybackStats returns the buyback totals, or empty totals if the job never ran
+func (k Keeper) GetBuybackStats(ctx context.Context) types.BuybackStats {
+	val, err := k.BuybackStats.Get(ctx)
+	if err != nil {
+		return types.DefaultBuybackStats()
+	}
+
+	return val
+}
+
+// GetBuybackPool returns the pool trading the given denom against uzig
+func (k Keeper) GetBuybackPool(ctx context.Context, denom string) (pool types.Pool, found bool) {
+
+	// pool uids are ordered by base and quote, so both orders are checked
+	for _, poolUid := range []string{
+		denom + types.PoolUidSeparator + constants.BondDenom,
+		constants.BondDenom + types.PoolUidSeparator + denom,
+	} {
+		uid, found := k.GetPoolUids(ctx, poolUid)
+		if !found {
+			continue
+		}
+		return k.GetPool(ctx, uid.PoolId)
+	}
+
+	return pool, false
+}
+
+// BuybackEndBlocker runs the buyback job when it is enabled and the interval has elapsed.
+// A failing run is logged and discarded, so it can never halt the chain.
+func (k Keeper) BuybackEndBlocker(ctx sdk.Context) {
+	config := k.GetBuybackConfig(ctx)
+
+	// #nosec G115 -- block height is checked to be positive first
+	if !config.Enabled || ctx.BlockHeight() <= 0 || uint64(ctx.BlockHeight())%config.IntervalBlocks != 0 {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.RunBuyback(cacheCtx, config); err != nil {
+		k.Logger().Error("buyback run failed", "height", ctx.BlockHeight(), "error", err)
+		return
+	}
+	write()
+}
+
+// RunBuyback sells the non-uzig fee balances of the source modules through the uzig pools, then burns the
+// bought uzig or sends it to the community pool. Balances that would move a pool by more than the
+// max slippage are sold in parts, the rest is left for the next runs.
+func (k Keeper) RunBuyback(ctx sdk.Context, config types.BuybackConfig) error {
+	// the bought uzig is collected in the dex module account, which is created if it does not exist yet
+	dexAddress := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress()
+
+	sold := sdk.NewCoins()
+	bought := sdkmath.ZeroInt()
+	var swapCount uint32
+
+	for _, moduleName := range config.SourceModules {
+		sourceAddress := k.accountKeeper.GetModuleAddress(moduleName)
+		if sourceAddress == nil {
+			k.Logger().Error("buyback source module account does not exist", "module", moduleName)
+			continue
+		}
+
+		for _, balance := range k.bankKeeper.GetAllBalances(ctx, sourceAddress) {
+			if swapCount >= config.MaxSwapsPerRun {
+				break
+			}
+
+			if balance.Denom == constants.BondDenom || !balance.IsPositive() {
+				continue
+			}
+
+			pool, found := k.GetBuybackPool(ctx, balance.Denom)
+			if !found {
+				continue
+			}
+
+			// every swap is isolated, so a failing pool does not block the other balances
+			swapCtx, write := ctx.CacheContext()
+			incoming, outgoing, err := k.buybackSwap(swapCtx, sourceAddress, dexAddress, pool, balance, config.MaxSlippage)
+			if err != nil {
+				k.Logger().Info("buyback swap skipped", "pool_id", pool.PoolId, "denom", balance.Denom, "error", err)
+				continue
+			}
+			write()
+
+			sold = sold.Add(incoming)
+			bought = bought.Add(outgoing.Amount)
+			swapCount++
+		}
+	}
+
+	if !bought.IsPositive() {
+		return nil
+	}
+
+	boughtCoin := sdk.NewCoin(constants.BondDenom, bought)
+	stats := k.GetBuybackStats(ctx)
+
+	switch config.Destination {
+	case types.BUYBACK_DESTINATION_COMMUNITY_POOL:
+		if err := k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(boughtCoin), dexAddress); err != nil {
+			return errorsmod.Wrapf(err, "failed to send %s to the community pool", boughtCoin)
+		}
+		stats.TotalToCommunityPool = stats.TotalToCommunityPool.Add(boughtCoin)
+	default:
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(boughtCoin)); err != nil {
+			return errorsmod.Wrapf(err, "failed to burn %s", boughtCoin)
+		}
+		stats.TotalBurned = stats.TotalBurned.Add(boughtCoin)
+	}
+
+	stats.TotalSold = stats.TotalSold.Add(sold...)
+	stats.RunCount++
+	stats.LastRunHeight = ctx.BlockHeight()
+	k.SetBuybackStats(ctx, stats)
+
+	events.EmitBuybackExecutedEvent(ctx, config.Destination, sold, boughtCoin, swapCount)
+
+	return nil
+}
+
+// buybackSwap sells as much of the balance as the max slippage allows into the pool, the bought uzig
+// is credited to the dex module account
+func (k Keeper) buybackSwap(
+	ctx sdk.Context,
+	sourceAddress sdk.AccAddress,
+	dexAddress sdk.AccAddress,
+	pool types.Pool,
+	balance sdk.Coin,
+	maxSlippage uint32,
+) (incoming sdk.Coin, outgoing sdk.Coin, err error) {
+
+	var fromCoin, toCoin int
+	switch balance.Denom {
+	case pool.Coins[0].Denom:
+		fromCoin, toCoin = 0, 1
+	case pool.Coins[1].Denom:
+		fromCoin, toCoin = 1, 0
+	default:
+		return incoming, outgoing, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"pool %s does not trade %s",
+			pool.PoolId,
+			balance.Denom,
+		)
+	}
+
+	reserveIn := pool.Coins[fromCoin].Amount
+	reserveOut := pool.Coins[toCoin].Amount
+	if !reserveIn.IsPositive() || !reserveOut.IsPositive() {
+		return incoming, outgoing, errorsmod.Wrapf(types.ErrPoolNotFound, "pool %s has no liquidity", pool.PoolId)
+	}
+
+	// for a constant product pool the price impact of selling x is x / (reserveIn + x),
+	// so it stays within the slippage s as long as x <= reserveIn * s / (1 - s)
+	slippage := sdkmath.NewIntFromUint64(uint64(maxSlippage))
+	basisPoints := sdkmath.NewInt(types.BasisPoints)
+	maxIncoming := reserveIn.Mul(slippage).Quo(basisPoints.Sub(slippage))
+
+	incoming = sdk.NewCoin(balance.Denom, sdkmath.MinInt(balance.Amount, maxIncoming))
+	if !incoming.IsPositive() {
+		return incoming, outgoing, errorsmod.Wrapf(
+			types.ErrBuybackSlippageExceeded,
+			"pool %s is too shallow to sell %s",
+			pool.PoolId,
+			balance,
+		)
+	}
+
+	feeRate := k.GetEffectiveFee(ctx, pool)
+	outgoing, fee, err := CalculateSwapAmountWithFee(&pool, incoming, feeRate)
+	if err != nil {
+		return incoming, outgoing, err
+	}
+
+	// guard against the price moving more than the slippage, compared to the spot price after fee
+	spotOutgoing := incoming.Amount.Sub(fee.Amount).Mul(reserveOut).Quo(reserveIn)
+	minOutgoing := spotOutgoing.Mul(basisPoints.Sub(slippage)).Quo(basisPoints)
+	if outgoing.Amount.LT(minOutgoing) {
+		return incoming, outgoing, errorsmod.Wrapf(
+			types.ErrBuybackSlippageExceeded,
+			"selling %s in pool %s returns %s, less than the minimum %s",
+			incoming,
+			pool.PoolId,
+			outgoing,
+			minOutgoing,
+		)
+	}
+
+	priceBefore := types.GetPoolPrice(pool)
+	pool.Coins[fromCoin] = pool.Coins[fromCoin].Add(incoming)
+	pool.Coins[toCoin] = pool.Coins[toCoin].Sub(outgoing)
+	k.SetPool(ctx, pool)
+	k.UpdatePoolVolatility(ctx, pool, priceBefore)
+
+	if err := k.SendFromAddressToPool(ctx, sourceAddress, pool.PoolId, sdk.NewCoins(incoming)); err != nil {
+		return incoming, outgoing, err
+	}
+
+	if err := k.SendFromPoolToAddress(ctx, pool.PoolId, dexAddress, sdk.NewCoins(outgoing)); err != nil {
+		return incoming, outgoing, err
+	}
+
+	events.EmitSwapEvent(ctx, sourceAddress, dexAddress, &pool, &incoming, &outgoing, &fee)
+
+	return incoming, outgoing, nil
+}
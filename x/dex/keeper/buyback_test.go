@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "zigchain/testutil/keeper"
+	"zigchain/x/dex/keeper"
+	"zigchain/x/dex/types"
+	"zigchain/zutils/constants"
+)
+
+// fundBuybackSource mints coins to the fee collector, used as buyback source
+func fundBuybackSource(t *testing.T, ctx sdk.Context, bankKeeper bankkeeper.BaseKeeper, coins sdk.Coins) {
+	t.Helper()
+
+	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, coins))
+}
+
+// setupBuybackPool creates an abc/uzig pool with the given reserves and funds the fee collector, used as buyback source
+func setupBuybackPool(
+	t *testing.T,
+	reserve int64,
+	sourceBalance int64,
+) (keeper.Keeper, sdk.Context, bankkeeper.BaseKeeper, types.Pool) {
+	t.Helper()
+
+	k, ctx, bankKeeper := keepertest.DexKeeperWithBank(t, nil)
+
+	poolID := k.GetAndSetNextPoolIDString(ctx)
+	poolAddress, err := k.CreatePoolAccount(ctx, poolID)
+	require.NoError(t, err)
+
+	pool := types.Pool{
+		PoolId:  poolID,
+		Creator: poolAddress.String(),
+		Fee:     500,
+		Formula: "constant_product",
+		LpToken: sdk.NewInt64Coin(poolID, reserve),
+		Coins: []sdk.Coin{
+			sdk.NewInt64Coin("abc", reserve),
+			sdk.NewInt64Coin(constants.BondDenom, reserve),
+		},
+		Address: poolAddress.String(),
+	}
+	k.SetPool(ctx, pool)
+	k.SetPoolUids(ctx, types.PoolUids{
+		PoolUid: types.GetPoolUidString(pool),
+		PoolId:  poolID,
+	})
+
+	poolCoins := sdk.NewCoins(pool.Coins...)
+	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, poolCoins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, poolAddress, poolCoins))
+
+	fundBuybackSource(t, ctx, bankKeeper, sdk.NewCoins(sdk.NewInt64Coin("abc", sourceBalance)))
+
+	return k, ctx, bankKeeper, pool
+}
+
+func buybackTestConfig() types.BuybackConfig {
+	config := types.DefaultBuybackConfig()
+	config.Enabled = true
+	config.IntervalBlocks = 10
+	config.SourceModules = []string{authtypes.FeeCollectorName}
+	return config
+}
+
+// Positive test cases
+
+func TestBuybackConfig_GetSet(t *testing.T) {
+	// Test case: default config until one is set, then the stored one
+
+	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
+	require.Equal(t, types.DefaultBuybackConfig(), k.GetBuybackConfig(ctx))
+
+	config := buybackTestConfig()
+	k.SetBuybackConfig(ctx, config)
+	require.Equal(t, config, k.GetBuybackConfig(ctx))
+}
+
+func TestBuybackStats_GetSet(t *testing.T) {
+	// Test case: empty totals until the job runs, then the stored ones
+
+	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
+	require.Equal(t, types.DefaultBuybackStats(), k.GetBuybackStats(ctx))
+
+	stats := types.DefaultBuybackStats()
+	stats.TotalSold = sdk.NewCoins(sdk.NewInt64Coin("abc", 10))
+	stats.TotalBurned = sdk.NewInt64Coin(constants.BondDenom, 9)
+	stats.RunCount = 1
+	stats.LastRunHeight = 10
+	k.SetBuybackStats(ctx, stats)
+	require.Equal(t, stats, k.GetBuybackStats(ctx))
+}
+
+func TestRunBuyback_Burn(t *testing.T) {
+	// Test case: the whole source balance is sold and the bought uzig is burned
+
+	k, ctx, bankKeeper, pool := setupBuybackPool(t, 1_000_000, 1_000)
+	ctx = ctx.WithBlockHeight(10)
+	sourceAddress := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	supplyBefore := bankKeeper.GetSupply(ctx, constants.BondDenom)
+
+	require.NoError(t, k.RunBuyback(ctx, buybackTestConfig()))
+
+	require.True(t, bankKeeper.GetBalance(ctx, sourceAddress, "abc").IsZero())
+
+	updated, found := k.GetPool(ctx, pool.PoolId)
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewInt(1_001_000), updated.Coins[0].Amount)
+	bought := pool.Coins[1].Amount.Sub(updated.Coins[1].Amount)
+	require.True(t, bought.IsPositive())
+
+	// nothing is left in the dex module account, the supply went down by the bought amount
+	dexAddress := authtypes.NewModuleAddress(types.ModuleName)
+	require.True(t, bankKeeper.GetBalance(ctx, dexAddress, constants.BondDenom).IsZero())
+	require.Equal(t, supplyBefore.Amount.Sub(bought), bankKeeper.GetSupply(ctx, constants.BondDenom).Amount)
+
+	stats := k.GetBuybackStats(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("abc", 1_000)), stats.TotalSold)
+	require.Equal(t, sdk.NewCoin(constants.BondDenom, bought), stats.TotalBurned)
+	require.True(t, stats.TotalToCommunityPool.IsZero())
+	require.Equal(t, uint64(1), stats.RunCount)
+	require.Equal(t, int64(10), stats.LastRunHeight)
+}
+
+func TestRunBuyback_SlippageCap(t *testing.T) {
+	// Test case: only the part of the balance within the max slippage is sold, the rest waits for the next run
+
+	k, ctx, bankKeeper, pool := setupBuybackPool(t, 1_000_000, 100_000)
+	sourceAddress := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	config := buybackTestConfig()
+
+	require.NoError(t, k.RunBuyback(ctx, config))
+
+	// 1_000_000 * 100 / (10_000 - 100)
+	sold := sdkmath.NewInt(10_101)
+	require.Equal(t, sdkmath.NewInt(100_000).Sub(sold), bankKeeper.GetBalance(ctx, sourceAddress, "abc").Amount)
+
+	updated, found := k.GetPool(ctx, pool.PoolId)
+	require.True(t, found)
+	require.Equal(t, pool.Coins[0].Amount.Add(sold), updated.Coins[0].Amount)
+
+	// at least the spot value of the sold amount after fee, minus the max slippage: 10_051 * 99%
+	bought := pool.Coins[1].Amount.Sub(updated.Coins[1].Amount)
+	require.True(t, bought.GTE(sdkmath.NewInt(9_950)))
+}
+
+func TestRunBuyback_MaxSwapsPerRun(t *testing.T) {
+	// Test case: a run stops after the configured number of swaps
+
+	k, ctx, bankKeeper, _ := setupBuybackPool(t, 1_000_000, 1_000)
+	sourceAddress := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	config := buybackTestConfig()
+	config.MaxSwapsPerRun = 1
+
+	// a second denom sorted before abc, without a uzig pool, does not count as a swap
+	fundBuybackSource(t, ctx, bankKeeper, sdk.NewCoins(sdk.NewInt64Coin("aaa", 1_000)))
+
+	require.NoError(t, k.RunBuyback(ctx, config))
+	require.True(t, bankKeeper.GetBalance(ctx, sourceAddress, "abc").IsZero())
+	require.Equal(t, sdkmath.NewInt(1_000), bankKeeper.GetBalance(ctx, sourceAddress, "aaa").Amount)
+	require.Equal(t, uint64(1), k.GetBuybackStats(ctx).RunCount)
+}
+
+func TestBuybackEndBlocker_Interval(t *testing.T) {
+	// Test case: the job only runs when enabled and on the configured interval
+
+	k, ctx, bankKeeper, _ := setupBuybackPool(t, 1_000_000, 1_000)
+	sourceAddress := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	// disabled by default
+	k.BuybackEndBlocker(ctx.WithBlockHeight(100))
+	require.Equal(t, sdkmath.NewInt(1_000), bankKeeper.GetBalance(ctx, sourceAddress, "abc").Amount)
+
+	k.SetBuybackConfig(ctx, buybackTestConfig())
+
+	// not on the interval
+	k.BuybackEndBlocker(ctx.WithBlockHeight(15))
+	require.Equal(t, sdkmath.NewInt(1_000), bankKeeper.GetBalance(ctx, sourceAddress, "abc").Amount)
+
+	k.BuybackEndBlocker(ctx.WithBlockHeight(20))
+	require.True(t, bankKeeper.GetBalance(ctx, sourceAddress, "abc").IsZero())
+	require.Equal(t, int64(20), k.GetBuybackStats(ctx).LastRunHeight)
+}
+
+// Negative test cases
+
+func TestRunBuyback_NoPool(t *testing.T) {
+	// Test case: balances without a uzig pool are left untouched and no run is recorded
+
+	k, ctx, bankKeeper := keepertest.DexKeeperWithBank(t, nil)
+	sourceAddress := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	fundBuybackSource(t, ctx, bankKeeper, sdk.NewCoins(sdk.NewInt64Coin("abc", 1_000)))
+
+	require.NoError(t, k.RunBuyback(ctx, buybackTestConfig()))
+	require.Equal(t, sdkmath.NewInt(1_000), bankKeeper.GetBalance(ctx, sourceAddress, "abc").Amount)
+	require.Equal(t, types.DefaultBuybackStats(), k.GetBuybackStats(ctx))
+}
+
+func TestRunBuyback_UnknownSourceModule(t *testing.T) {
+	// Test case: a source module without a module account is skipped
+
+	k, ctx, _, _ := setupBuybackPool(t, 1_000_000, 1_000)
+
+	config := buybackTestConfig()
+	config.SourceModules = []string{"unknown"}
+
+	require.NoError(t, k.RunBuyback(ctx, config))
+	require.Equal(t, types.DefaultBuybackStats(), k.GetBuybackStats(ctx))
+}
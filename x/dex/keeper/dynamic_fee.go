@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/dex/types"
+)
+
+// SetDynamicFeeConfig set a specific dynamicFeeConfig in the store from its pool id
+func (k Keeper) SetDynamicFeeConfig(ctx context.Context, config types.DynamicFeeConfig) {
+	if err := k.DynamicFeeConfigs.Set(ctx, config.PoolId, config); err != nil {
+		panic(err)
+	}
+}
+
+// GetDynamicFeeConfig returns a dynamicFeeConfig from its pool id
+func (k Keeper) GetDynamicFeeConfig(
+	ctx context.Context,
+	poolIDString string,
+
+) (val types.DynamicFeeConfig, found bool) {
+	val, err := k.DynamicFeeConfigs.Get(ctx, poolIDString)
+	if err != nil {
+		return val, false
+	}
+
+	return val, true
+}
+
+// GetAllDynamicFeeConfig returns all dynamicFeeConfig
+func (k Keeper) GetAllDynamicFeeConfig(ctx context.Context) (list []types.DynamicFeeConfig) {
+	iterator, err := k.DynamicFeeConfigs.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// SetPoolVolatility set a specific poolVolatility in the store from its pool id
+func (k Keeper) SetPoolVolatility(ctx context.Context, volatility types.PoolVolatility) {
+	if err := k.PoolVolatilities.Set(ctx, volatility.PoolId, volatility); err != nil {
+		panic(err)
+	}
+}
+
+// GetPoolVolatility returns a poolVolatility from its pool id
+func (k Keeper) GetPoolVolatility(
+	ctx context.Context,
+	poolIDString string,
+
+) (val types.PoolVolatility, found bool) {
+	val, err := k.PoolVolatilities.Get(ctx, poolIDString)
+	if err != nil {
+		return val, false
+	}
+
+	return val, true
+}
+
+// GetAllPoolVolatility returns all poolVolatility
+func (k Keeper) GetAllPoolVolatility(ctx context.Context) (list []types.PoolVolatility) {
+	iterator, err := k.PoolVolatilities.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// GetCurrentPoolVolatility returns the pool volatility decayed to the current block height
+func (k Keeper) GetCurrentPoolVolatility(ctx context.Context, pool types.Pool) types.PoolVolatility {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	config, found := k.GetDynamicFeeConfig(ctx, pool.PoolId)
+	if !found {
+		config = types.DefaultDynamicFeeConfig(pool)
+	}
+
+	volatility, found := k.GetPoolVolatility(ctx, pool.PoolId)
+	if !found {
+		return types.PoolVolatility{
+			PoolId:     pool.PoolId,
+			LastHeight: sdkCtx.BlockHeight(),
+		}
+	}
+
+	volatility.Volatility = volatility.DecayedVolatility(sdkCtx.BlockHeight(), config.DecayWindow)
+	volatility.LastHeight = sdkCtx.BlockHeight()
+
+	return volatility
+}
+
+// GetEffectiveFee returns the fee (per 100,000) that applies to a swap on the pool at the
+// current block height, the static pool fee is returned unless dynamic fee is enabled
+func (k Keeper) GetEffectiveFee(ctx context.Context, pool types.Pool) uint32 {
+
+	config, found := k.GetDynamicFeeConfig(ctx, pool.PoolId)
+	if !found || !config.Enabled {
+		return pool.Fee
+	}
+
+	volatility := k.GetCurrentPoolVolatility(ctx, pool)
+
+	return config.EffectiveFee(volatility.Volatility)
+}
+
+// UpdatePoolVolatility records the price movement caused by a swap on the pool,
+// priceBefore is the spot price of the pool before the swap
+func (k Keeper) UpdatePoolVolatility(ctx context.Context, pool types.Pool, priceBefore sdkmath.LegacyDec) {
+
+	volatility := k.GetCurrentPoolVolatility(ctx, pool)
+
+	movement := types.GetPriceMovement(priceBefore, types.GetPoolPrice(pool))
+
+	// saturate instead of overflowing, the fee is capped anyway
+	if movement > math.MaxUint64-volatility.Volatility {
+		volatility.Volatility = math.MaxUint64
+	} else {
+		volatility.Volatility += movement
+	}
+
+	k.SetPoolVolatility(ctx, volatility)
+}
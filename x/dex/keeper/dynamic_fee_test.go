@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "zigchain/testutil/keeper"
+	"zigchain/testutil/nullify"
+	"zigchain/testutil/sample"
+	"zigchain/x/dex/keeper"
+	"zigchain/x/dex/testutil/common"
+	"zigchain/x/dex/types"
+)
+
+func dynamicFeeTestConfig(poolId string) types.DynamicFeeConfig {
+	return types.DynamicFeeConfig{
+		PoolId:        poolId,
+		Enabled:       true,
+		MinFee:        100,
+		MaxFee:        1100,
+		VolatilityCap: 1000,
+		DecayWindow:   100,
+	}
+}
+
+// Positive test cases
+
+func TestDynamicFeeConfigGet(t *testing.T) {
+	// Test case: set and get dynamic fee config
+
+	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
+	item := dynamicFeeTestConfig("zp1")
+	k.SetDynamicFeeConfig(ctx, item)
+
+	rst, found := k.GetDynamicFeeConfig(ctx, "zp1")
+	require.True(t, found)
+	require.Equal(t,
+		nullify.Fill(&item),
+		nullify.Fill(&rst),
+	)
+	require.Len(t, k.GetAllDynamicFeeConfig(ctx), 1)
+}
+
+func TestPoolVolatilityGet(t *testing.T) {
+	// Test case: set and get pool volatility
+
+	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
+	item := types.PoolVolatility{PoolId: "zp1", Volatility: 50, LastHeight: 10}
+	k.SetPoolVolatility(ctx, item)
+
+	rst, found := k.GetPoolVolatility(ctx, "zp1")
+	require.True(t, found)
+	require.Equal(t, item, rst)
+	require.Len(t, k.GetAllPoolVolatility(ctx), 1)
+}
+
+func TestGetEffectiveFee_StaticWithoutConfig(t *testing.T) {
+	// Test case: without dynamic fee config the static pool fee applies
+
+	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
+	pool := types.Pool{PoolId: "zp1", Fee: 500}
+
+	require.Equal(t, uint32(500), k.GetEffectiveFee(ctx, pool))
+
+	// disabled config falls back to the static fee as well
+	config := dynamicFeeTestConfig("zp1")
+	config.Enabled = false
+	k.SetDynamicFeeConfig(ctx, config)
+	require.Equal(t, uint32(500), k.GetEffectiveFee(ctx, pool))
+}
+
+func TestGetEffectiveFee_ScalesWithVolatility(t *testing.T) {
+	// Test case: effective fee scales between floor and ceiling and decays over blocks
+
+	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(100)
+	pool := types.Pool{PoolId: "zp1", Fee: 500}
+	k.SetDynamicFeeConfig(sdkCtx, dynamicFeeTestConfig("zp1"))
+
+	// calm pool pays the floor
+	require.Equal(t, uint32(100), k.GetEffectiveFee(sdkCtx, pool))
+
+	// half of the volatility cap is half way between floor and ceiling
+	k.SetPoolVolatility(sdkCtx, types.PoolVolatility{PoolId: "zp1", Volatility: 500, LastHeight: 100})
+	require.Equal(t, uint32(600), k.GetEffectiveFee(sdkCtx, pool))
+
+	// half of the decay window halves the volatility
+	require.Equal(t, uint32(350), k.GetEffectiveFee(sdkCtx.WithBlockHeight(150), pool))
+
+	// volatility is fully decayed after the decay window
+	require.Equal(t, uint32(100), k.GetEffectiveFee(sdkCtx.WithBlockHeight(200), pool))
+
+	// volatility above the cap pays the ceiling
+	k.SetPoolVolatility(sdkCtx, types.PoolVolatility{PoolId: "zp1", Volatility: 5000, LastHeight: 100})
+	require.Equal(t, uint32(1100), k.GetEffectiveFee(sdkCtx, pool))
+}
+
+func TestDynamicFee_SwapUpdatesVolatilityAndQuotes(t *testing.T) {
+	// Test case: swaps record price movement and quotes return the fee that applies
+
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	server, dexKeeper, ctx, pool, _, _ := common.ServerDexKeeperWithRealBank(
+		t,
+		signer,
+		sample.Coin("abc", 500_000_000),
+		sample.Coin("usdt", 1_000_000_000),
+		sample.Coin("uzig", 100_000_000),
+		sample.Coin("zp1", 707_106_781),
+	)
+
+	config := dynamicFeeTestConfig(pool.PoolId)
+	_, err := server.UpdateDynamicFeeConfig(ctx, &types.MsgUpdateDynamicFeeConfig{
+		Authority: dexKeeper.GetAuthority(),
+		Config:    config,
+	})
+	require.NoError(t, err)
+
+	queryServer := keeper.NewQueryServerImpl(dexKeeper)
+
+	// calm pool quotes the floor fee
+	quote, err := queryServer.SwapIn(ctx, &types.QuerySwapInRequest{PoolId: pool.PoolId, CoinIn: "10000000abc"})
+	require.NoError(t, err)
+	require.Equal(t, config.MinFee, quote.FeeRate)
+
+	resp, err := server.SwapExactIn(ctx, &types.MsgSwapExactIn{
+		Signer:   signer.String(),
+		Incoming: sample.Coin("abc", 10_000_000),
+		PoolId:   pool.PoolId,
+	})
+	require.NoError(t, err)
+	// the fee charged is the quoted one
+	require.Equal(t, quote.Fee, resp.Fee)
+	require.Equal(t, quote.CoinOut, resp.Outgoing)
+
+	// a 2% swap moves the price by roughly 4%
+	volatility, found := dexKeeper.GetPoolVolatility(ctx, pool.PoolId)
+	require.True(t, found)
+	require.Greater(t, volatility.Volatility, uint64(300))
+
+	// next quote reflects the raised fee
+	quoteAfter, err := queryServer.SwapOut(ctx, &types.QuerySwapOutRequest{PoolId: pool.PoolId, CoinOut: "1000000abc"})
+	require.NoError(t, err)
+	require.Greater(t, quoteAfter.FeeRate, config.MinFee)
+	require.Equal(t, dexKeeper.GetEffectiveFee(ctx, dexPool(t, dexKeeper, ctx, pool.PoolId)), quoteAfter.FeeRate)
+
+	dynamicFee, err := queryServer.GetDynamicFee(ctx, &types.QueryGetDynamicFeeRequest{PoolId: pool.PoolId})
+	require.NoError(t, err)
+	require.Equal(t, config, dynamicFee.Config)
+	require.Equal(t, quoteAfter.FeeRate, dynamicFee.EffectiveFee)
+}
+
+func dexPool(t *testing.T, k keeper.Keeper, ctx sdk.Context, poolId string) types.Pool {
+	pool, found := k.GetPool(ctx, poolId)
+	require.True(t, found)
+	return pool
+}
+
+// Negative test cases
+
+func TestGetDynamicFeeConfig_NotFound(t *testing.T) {
+	// Test case: get dynamic fee config not found
+
+	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
+
+	_, found := k.GetDynamicFeeConfig(ctx, "zp1")
+	require.False(t, found)
+}
@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"context"
+)
+
+// SetLegacyStoreValue sets a raw value in a legacy prefix store, used to exercise the migrations
+func (k Keeper) SetLegacyStoreValue(ctx context.Context, keyPrefix string, key []byte, value []byte) {
+	k.legacyStore(ctx, keyPrefix).Set(key, value)
+}
+
+// GetLegacyStoreValue returns a raw value of a legacy prefix store, nil when the key is not set
+func (k Keeper) GetLegacyStoreValue(ctx context.Context, keyPrefix string, key []byte) []byte {
+	return k.legacyStore(ctx, keyPrefix).Get(key)
+}
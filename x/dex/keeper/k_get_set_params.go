@@ -3,9 +3,12 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"zigchain/x/dex/types"
+	"zigchain/zutils/constants"
 )
 
 // GetParams get all parameters as types.Params
@@ -20,6 +23,11 @@ func (k Keeper) GetParams(ctx context.Context) (params types.Params) {
 	return params
 }
 
+// GetCreationFee returns the fee charged to create a pool
+func (k Keeper) GetCreationFee(ctx context.Context) sdk.Coin {
+	return k.GetParams(ctx).CreationFee
+}
+
 // SetParams set the params
 func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
@@ -32,11 +40,49 @@ func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
 	return nil
 }
 
+// GetLegacyParams get the params stored in the pre-v3 layout, where the creation fee is an uzig uint32
+func (k Keeper) GetLegacyParams(ctx context.Context) (params types.LegacyParams) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetLegacyParams set the params in the pre-v3 layout
+func (k Keeper) SetLegacyParams(ctx context.Context, params types.LegacyParams) error {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// V2Migration runs on the pre-v3 params layout so the uint32 creation fee survives until V3Migration
 func (k Keeper) V2Migration(ctx context.Context) error {
-	params := k.GetParams(ctx)
+	params := k.GetLegacyParams(ctx)
 	params.MinimalLiquidityLock = 0
 	params.MaxSlippage = types.DefaultMaxSlippage
-	// #nosec G104 -- migration logic, error handling not critical
-	k.SetParams(ctx, params)
-	return nil
+	return k.SetLegacyParams(ctx, params)
+}
+
+// V3Migration converts the uint32 uzig creation fee into an sdk.Coin
+func (k Keeper) V3Migration(ctx context.Context) error {
+	legacyParams := k.GetLegacyParams(ctx)
+
+	params := types.Params{
+		NewPoolFeePct:        legacyParams.NewPoolFeePct,
+		CreationFee:          sdk.NewCoin(constants.BondDenom, math.NewIntFromUint64(uint64(legacyParams.CreationFee))),
+		Beneficiary:          legacyParams.Beneficiary,
+		MinimalLiquidityLock: legacyParams.MinimalLiquidityLock,
+		MaxSlippage:          legacyParams.MaxSlippage,
+	}
+
+	return k.SetParams(ctx, params)
 }
@@ -1,11 +1,16 @@
 package keeper_test
 
 import (
+	"math"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/stretchr/testify/require"
 
 	keepertest "zigchain/testutil/keeper"
+	"zigchain/testutil/sample"
 	"zigchain/x/dex/types"
 )
 
@@ -30,7 +35,7 @@ func TestGetParams_EmptyStore(t *testing.T) {
 	// Verify we get the expected default params that the keeper initializes with
 	expectedDefaultParams := types.Params{
 		NewPoolFeePct:        500,
-		CreationFee:          100000000,
+		CreationFee:          sdk.NewInt64Coin("uzig", 100000000),
 		Beneficiary:          "",
 		MinimalLiquidityLock: 1000,
 		MaxSlippage:          0,
@@ -43,7 +48,8 @@ func TestSetParams_NilParams(t *testing.T) {
 	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
 
 	// Create zero-value params (effectively "nil" in terms of content)
-	params := types.Params{}
+	// the creation fee amount is zero rather than nil, as that is what the store round trip yields
+	params := types.Params{CreationFee: sdk.Coin{Amount: sdkmath.ZeroInt()}}
 
 	err := k.SetParams(ctx, params)
 	require.NoError(t, err)
@@ -64,6 +70,7 @@ func TestGetParams_AfterMultipleSets(t *testing.T) {
 
 	// Update params with new values
 	params2 := types.Params{
+		CreationFee:          sdk.NewInt64Coin("uzig", 0),
 		MinimalLiquidityLock: 1000,
 		MaxSlippage:          500,
 	}
@@ -72,6 +79,7 @@ func TestGetParams_AfterMultipleSets(t *testing.T) {
 
 	// Update again
 	params3 := types.Params{
+		CreationFee:          sdk.NewInt64Coin("uzig", 0),
 		MinimalLiquidityLock: 2000,
 		MaxSlippage:          1000,
 	}
@@ -91,7 +99,7 @@ func TestGetParams_AfterParamsDeleted(t *testing.T) {
 	require.Equal(t, initialParams, k.GetParams(ctx))
 
 	// Now "delete" by setting zero-value params (simulates deletion for coverage)
-	zeroParams := types.Params{}
+	zeroParams := types.Params{CreationFee: sdk.Coin{Amount: sdkmath.ZeroInt()}}
 	require.NoError(t, k.SetParams(ctx, zeroParams))
 
 	// Get should return the zero values
@@ -103,22 +111,22 @@ func TestV2Migration(t *testing.T) {
 	// Test case: V2 migration updates parameters correctly
 	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
 
-	// Set initial params with non-default values
-	initialParams := types.Params{
+	// Set initial params with non-default values in the pre-v3 layout
+	initialParams := types.LegacyParams{
 		NewPoolFeePct:        500,
 		CreationFee:          100000000,
 		Beneficiary:          "",
 		MinimalLiquidityLock: 1000, // Non-zero value
 		MaxSlippage:          2000, // Non-default value
 	}
-	require.NoError(t, k.SetParams(ctx, initialParams))
+	require.NoError(t, k.SetLegacyParams(ctx, initialParams))
 
 	// Execute migration
 	err := k.V2Migration(ctx)
 	require.NoError(t, err)
 
 	// Verify params were updated correctly
-	updatedParams := k.GetParams(ctx)
+	updatedParams := k.GetLegacyParams(ctx)
 	require.Equal(t, uint32(0), updatedParams.MinimalLiquidityLock)
 	require.Equal(t, types.DefaultMaxSlippage, updatedParams.MaxSlippage)
 
@@ -132,11 +140,11 @@ func TestV2Migration_FromEmptyStore(t *testing.T) {
 	// Test case: V2 migration works when starting from empty store
 	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
 
-	// Clear any existing params to simulate empty store
-	require.NoError(t, k.SetParams(ctx, types.Params{}))
+	// Clear any existing params to start from empty
+	require.NoError(t, k.SetLegacyParams(ctx, types.LegacyParams{}))
 
-	// Store is empty initially, GetParams returns zero values
-	initialParams := k.GetParams(ctx)
+	// Store is empty initially, GetLegacyParams returns zero values
+	initialParams := k.GetLegacyParams(ctx)
 	require.Equal(t, uint32(0), initialParams.MinimalLiquidityLock)
 	require.Equal(t, uint32(0), initialParams.MaxSlippage)
 
@@ -145,7 +153,7 @@ func TestV2Migration_FromEmptyStore(t *testing.T) {
 	require.NoError(t, err)
 
 	// Verify params were set to expected values
-	updatedParams := k.GetParams(ctx)
+	updatedParams := k.GetLegacyParams(ctx)
 	require.Equal(t, uint32(0), updatedParams.MinimalLiquidityLock)
 	require.Equal(t, types.DefaultMaxSlippage, updatedParams.MaxSlippage)
 }
@@ -155,61 +163,118 @@ func TestV2Migration_AlreadyAtTargetValues(t *testing.T) {
 	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
 
 	// Set params to exactly what migration would set
-	initialParams := types.Params{
+	initialParams := types.LegacyParams{
 		NewPoolFeePct:        500,
 		CreationFee:          100000000,
 		Beneficiary:          "",
 		MinimalLiquidityLock: 0,
 		MaxSlippage:          types.DefaultMaxSlippage,
 	}
-	require.NoError(t, k.SetParams(ctx, initialParams))
+	require.NoError(t, k.SetLegacyParams(ctx, initialParams))
 
 	// Execute migration
 	err := k.V2Migration(ctx)
 	require.NoError(t, err)
 
 	// Verify params remain unchanged
-	updatedParams := k.GetParams(ctx)
+	updatedParams := k.GetLegacyParams(ctx)
 	require.Equal(t, uint32(0), updatedParams.MinimalLiquidityLock)
 	require.Equal(t, types.DefaultMaxSlippage, updatedParams.MaxSlippage)
 	require.Equal(t, initialParams, updatedParams) // The entire struct should be identical
 }
 
-func TestParams_CompleteWorkflow(t *testing.T) {
-	// Test case: complete workflow of setting, getting, and migrating params
+func TestV3Migration(t *testing.T) {
+	// Test case: V3 migration converts the uint32 creation fee into an uzig coin
+	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
+
+	beneficiary := sample.AccAddress()
+	legacyParams := types.LegacyParams{
+		NewPoolFeePct:        700,
+		CreationFee:          250000000,
+		Beneficiary:          beneficiary,
+		MinimalLiquidityLock: 2000,
+		MaxSlippage:          300,
+	}
+	require.NoError(t, k.SetLegacyParams(ctx, legacyParams))
+
+	// Execute migration
+	require.NoError(t, k.V3Migration(ctx))
+
+	// Verify params were converted and remain valid
+	migrated := k.GetParams(ctx)
+	require.Equal(t, types.Params{
+		NewPoolFeePct:        700,
+		CreationFee:          sdk.NewInt64Coin("uzig", 250000000),
+		Beneficiary:          beneficiary,
+		MinimalLiquidityLock: 2000,
+		MaxSlippage:          300,
+	}, migrated)
+	require.NoError(t, migrated.Validate())
+}
 
+func TestV3Migration_MaxUint32CreationFee(t *testing.T) {
+	// Test case: V3 migration keeps the full uint32 range of the legacy creation fee
 	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
 
-	// 1. Start with default params (as initialized by DexKeeper)
-	initial := k.GetParams(ctx)
-	defaultParams := types.DefaultParams()
-	require.Equal(t, defaultParams, initial)
+	require.NoError(t, k.SetLegacyParams(ctx, types.LegacyParams{
+		CreationFee:          math.MaxUint32,
+		MinimalLiquidityLock: 1000,
+	}))
+
+	require.NoError(t, k.V3Migration(ctx))
 
-	// 2. Update to custom params
-	customParams := types.Params{
+	migrated := k.GetParams(ctx)
+	require.Equal(t, sdk.NewInt64Coin("uzig", math.MaxUint32), migrated.CreationFee)
+}
+
+func TestV3Migration_ZeroCreationFee(t *testing.T) {
+	// Test case: V3 migration of a disabled (zero) creation fee yields a valid zero coin
+	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
+
+	require.NoError(t, k.SetLegacyParams(ctx, types.LegacyParams{
+		NewPoolFeePct:        500,
+		MinimalLiquidityLock: 1000,
+	}))
+
+	require.NoError(t, k.V3Migration(ctx))
+
+	migrated := k.GetParams(ctx)
+	require.True(t, migrated.CreationFee.IsZero())
+	require.Equal(t, "uzig", migrated.CreationFee.Denom)
+	require.NoError(t, migrated.Validate())
+}
+
+func TestParams_CompleteWorkflow(t *testing.T) {
+	// Test case: complete workflow of setting legacy params and migrating them to the current layout
+
+	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
+
+	// 1. Start from custom params in the pre-v3 layout
+	customParams := types.LegacyParams{
 		NewPoolFeePct:        1000,
 		CreationFee:          200000000,
 		Beneficiary:          "test-beneficiary",
 		MinimalLiquidityLock: 5000,
 		MaxSlippage:          100,
 	}
-	require.NoError(t, k.SetParams(ctx, customParams))
+	require.NoError(t, k.SetLegacyParams(ctx, customParams))
 
-	// 3. Verify retrieval
-	retrieved := k.GetParams(ctx)
+	// 2. Verify retrieval
+	retrieved := k.GetLegacyParams(ctx)
 	require.Equal(t, customParams, retrieved)
 
-	// 4. Run migration
+	// 3. Run migrations in order
 	require.NoError(t, k.V2Migration(ctx))
+	require.NoError(t, k.V3Migration(ctx))
 
-	// 5. Verify migration changes - only specific fields should change
+	// 4. Verify migration changes - only specific fields should change
 	migrated := k.GetParams(ctx)
 	require.Equal(t, uint32(0), migrated.MinimalLiquidityLock)
 	require.Equal(t, types.DefaultMaxSlippage, migrated.MaxSlippage)
 
-	// Other fields should remain unchanged
+	// Other fields should be carried over
 	require.Equal(t, customParams.NewPoolFeePct, migrated.NewPoolFeePct)
-	require.Equal(t, customParams.CreationFee, migrated.CreationFee)
+	require.Equal(t, sdk.NewInt64Coin("uzig", int64(customParams.CreationFee)), migrated.CreationFee)
 	require.Equal(t, customParams.Beneficiary, migrated.Beneficiary)
 }
 
@@ -217,22 +282,30 @@ func TestParams_CompleteWorkflow_2(t *testing.T) {
 	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
 
 	// Clear any existing params to start from empty
-	require.NoError(t, k.SetParams(ctx, types.Params{}))
+	require.NoError(t, k.SetLegacyParams(ctx, types.LegacyParams{}))
 
 	// 1. Start with empty params
-	initial := k.GetParams(ctx)
-	require.Equal(t, types.Params{}, initial)
+	initial := k.GetLegacyParams(ctx)
+	require.Equal(t, types.LegacyParams{}, initial)
 
-	// 2. Set default params
+	// 2. Set default params in the pre-v3 layout
 	defaultParams := types.DefaultParams()
-	require.NoError(t, k.SetParams(ctx, defaultParams))
+	legacyDefaultParams := types.LegacyParams{
+		NewPoolFeePct:        defaultParams.NewPoolFeePct,
+		CreationFee:          uint32(defaultParams.CreationFee.Amount.Uint64()),
+		Beneficiary:          defaultParams.Beneficiary,
+		MinimalLiquidityLock: defaultParams.MinimalLiquidityLock,
+		MaxSlippage:          defaultParams.MaxSlippage,
+	}
+	require.NoError(t, k.SetLegacyParams(ctx, legacyDefaultParams))
 
 	// 3. Verify retrieval
-	retrieved := k.GetParams(ctx)
-	require.Equal(t, defaultParams, retrieved)
+	retrieved := k.GetLegacyParams(ctx)
+	require.Equal(t, legacyDefaultParams, retrieved)
 
-	// 4. Run migration
+	// 4. Run migrations
 	require.NoError(t, k.V2Migration(ctx))
+	require.NoError(t, k.V3Migration(ctx))
 
 	// 5. Verify migration changes
 	migrated := k.GetParams(ctx)
@@ -262,7 +335,7 @@ func TestSetParams_ErrorHandling(t *testing.T) {
 	// Test 3: Params with extreme values should not error
 	extremeParams := types.Params{
 		NewPoolFeePct:        ^uint32(0), // max uint32
-		CreationFee:          ^uint32(0),
+		CreationFee:          sdk.NewInt64Coin("uzig", math.MaxInt64),
 		Beneficiary:          "very-long-beneficiary-address-string-that-is-extremely-long",
 		MinimalLiquidityLock: ^uint32(0),
 		MaxSlippage:          ^uint32(0),
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"zigchain/x/dex/types"
+	"zigchain/zutils/migrations"
+)
+
+// V4Migration moves the pools, poolUids and poolsMeta from the legacy prefix stores to collections.
+// Setting the pools through the collection builds their creator and denom indexes.
+func (k Keeper) V4Migration(ctx context.Context) error {
+	if err := migrations.MigrateLegacyStore(k.legacyStore(ctx, types.PoolKeyPrefix), k.cdc, func(pool types.Pool) error {
+		return k.Pools.Set(ctx, pool.PoolId, pool)
+	}); err != nil {
+		return err
+	}
+
+	if err := migrations.MigrateLegacyStore(k.legacyStore(ctx, types.PoolUidsKeyPrefix), k.cdc, func(poolUids types.PoolUids) error {
+		return k.PoolUids.Set(ctx, poolUids.PoolUid, poolUids)
+	}); err != nil {
+		return err
+	}
+
+	return migrations.MigrateLegacyStore(k.legacyStore(ctx, types.PoolsMetaKey), k.cdc, func(poolsMeta types.PoolsMeta) error {
+		return k.PoolsMeta.Set(ctx, poolsMeta)
+	})
+}
+
+// legacyStore returns a legacy prefix store of the module store
+func (k Keeper) legacyStore(ctx context.Context, keyPrefix string) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.KeyPrefix(keyPrefix))
+}
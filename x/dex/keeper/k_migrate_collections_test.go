@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "zigchain/testutil/keeper"
+	"zigchain/testutil/nullify"
+	"zigchain/testutil/sample"
+	"zigchain/x/dex/keeper"
+	"zigchain/x/dex/types"
+)
+
+func TestV4Migration(t *testing.T) {
+	// Test case: V4 migration moves the legacy stores to collections and builds the pool indexes
+
+	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
+	qs := keeper.NewQueryServerImpl(k)
+
+	creator := sample.AccAddress()
+	pools := []types.Pool{
+		{PoolId: "zp1", Creator: creator, Coins: sdk.NewCoins(sample.Coin("abc", 100), sample.Coin("uzig", 100))},
+		{PoolId: "zp2", Creator: sample.AccAddress(), Coins: sdk.NewCoins(sample.Coin("abc", 100), sample.Coin("usdt", 100))},
+	}
+	poolUids := types.PoolUids{PoolUid: "abc/uzig", PoolId: "zp1"}
+	poolsMeta := types.PoolsMeta{NextPoolId: 3}
+
+	// Write the pre-v4 layout
+	for _, pool := range pools {
+		bz, err := pool.Marshal()
+		require.NoError(t, err)
+		k.SetLegacyStoreValue(ctx, types.PoolKeyPrefix, types.PoolKey(pool.PoolId), bz)
+	}
+	bz, err := poolUids.Marshal()
+	require.NoError(t, err)
+	k.SetLegacyStoreValue(ctx, types.PoolUidsKeyPrefix, types.PoolUidsKey(poolUids.PoolUid), bz)
+	bz, err = poolsMeta.Marshal()
+	require.NoError(t, err)
+	k.SetLegacyStoreValue(ctx, types.PoolsMetaKey, []byte{0}, bz)
+
+	require.NoError(t, k.V4Migration(ctx))
+
+	require.ElementsMatch(t, nullify.Fill(pools), nullify.Fill(k.GetAllPool(ctx)))
+	require.Equal(t, []types.PoolUids{poolUids}, k.GetAllPoolUids(ctx))
+	require.Equal(t, uint64(3), k.GetNextPoolID(ctx))
+
+	byDenom, err := qs.ListPoolsByDenom(ctx, &types.QueryPoolsByDenomRequest{Denom: "abc"})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(pools), nullify.Fill(byDenom.Pool))
+
+	byCreator, err := qs.ListPoolsByCreator(ctx, &types.QueryPoolsByCreatorRequest{Creator: creator})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.Pool{pools[0]}), nullify.Fill(byCreator.Pool))
+
+	// The legacy entries are gone
+	for _, pool := range pools {
+		require.Nil(t, k.GetLegacyStoreValue(ctx, types.PoolKeyPrefix, types.PoolKey(pool.PoolId)))
+	}
+	require.Nil(t, k.GetLegacyStoreValue(ctx, types.PoolUidsKeyPrefix, types.PoolUidsKey(poolUids.PoolUid)))
+	require.Nil(t, k.GetLegacyStoreValue(ctx, types.PoolsMetaKey, []byte{0}))
+
+	// Running it again is a no-op
+	require.NoError(t, k.V4Migration(ctx))
+	require.ElementsMatch(t, nullify.Fill(pools), nullify.Fill(k.GetAllPool(ctx)))
+	require.Equal(t, uint64(3), k.GetNextPoolID(ctx))
+}
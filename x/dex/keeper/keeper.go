@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -24,6 +25,24 @@ type (
 		bankKeeper    types.BankKeeper
 		mintKeeper    types.MintKeeper
 		accountKeeper types.AccountKeeper
+		distrKeeper   types.DistributionKeeper
+
+		// taxes is shared by every copy of the keeper, as the factory keeper is set after the app is built
+		taxes *transferTaxes
+
+		Schema            collections.Schema
+		Pools             *collections.IndexedMap[string, types.Pool, PoolIndexes]
+		PoolUids          collections.Map[string, types.PoolUids]
+		PoolsMeta         collections.Item[types.PoolsMeta]
+		DynamicFeeConfigs collections.Map[string, types.DynamicFeeConfig]
+		PoolVolatilities  collections.Map[string, types.PoolVolatility]
+		BuybackConfig     collections.Item[types.BuybackConfig]
+		BuybackStats      collections.Item[types.BuybackStats]
+	}
+
+	// transferTaxes holds the keeper of the transfer taxes taken out of the swaps leaving the pools
+	transferTaxes struct {
+		transferTaxKeeper types.TransferTaxKeeper
 	}
 )
 
@@ -36,12 +55,15 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	mintKeeper types.MintKeeper,
 	accountKeeper types.AccountKeeper,
+	distrKeeper types.DistributionKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
 	}
 
-	return Keeper{
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
 		cdc:          cdc,
 		storeService: storeService,
 		authority:    authority,
@@ -50,7 +72,65 @@ func NewKeeper(
 		bankKeeper:    bankKeeper,
 		mintKeeper:    mintKeeper,
 		accountKeeper: accountKeeper,
+		distrKeeper:   distrKeeper,
+		taxes:         &transferTaxes{},
+
+		Pools: collections.NewIndexedMap(
+			sb,
+			types.PoolsPrefix,
+			"pools",
+			collections.StringKey,
+			codec.CollValue[types.Pool](cdc),
+			NewPoolIndexes(sb),
+		),
+		PoolUids: collections.NewMap(
+			sb,
+			types.PoolUidsPrefix,
+			"pool_uids",
+			collections.StringKey,
+			codec.CollValue[types.PoolUids](cdc),
+		),
+		PoolsMeta: collections.NewItem(
+			sb,
+			types.PoolsMetaPrefix,
+			"pools_meta",
+			codec.CollValue[types.PoolsMeta](cdc),
+		),
+		DynamicFeeConfigs: collections.NewMap(
+			sb,
+			types.DynamicFeeConfigsPrefix,
+			"dynamic_fee_configs",
+			collections.StringKey,
+			codec.CollValue[types.DynamicFeeConfig](cdc),
+		),
+		PoolVolatilities: collections.NewMap(
+			sb,
+			types.PoolVolatilitiesPrefix,
+			"pool_volatilities",
+			collections.StringKey,
+			codec.CollValue[types.PoolVolatility](cdc),
+		),
+		BuybackConfig: collections.NewItem(
+			sb,
+			types.BuybackConfigPrefix,
+			"buyback_config",
+			codec.CollValue[types.BuybackConfig](cdc),
+		),
+		BuybackStats: collections.NewItem(
+			sb,
+			types.BuybackStatsPrefix,
+			"buyback_stats",
+			codec.CollValue[types.BuybackStats](cdc),
+		),
 	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
 }
 
 // GetAuthority returns the module's authority.
@@ -62,3 +142,9 @@ func (k Keeper) GetAuthority() string {
 func (k Keeper) Logger() log.Logger {
 	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// SetTransferTaxKeeper sets the keeper of the transfer taxes of the denoms swapped by the pools,
+// until it is set the swaps are not taxed
+func (k Keeper) SetTransferTaxKeeper(transferTaxKeeper types.TransferTaxKeeper) {
+	k.taxes.transferTaxKeeper = transferTaxKeeper
+}
@@ -24,25 +24,52 @@ func (k msgServer) CreatePool(
 ) (
 	*types.MsgCreatePoolResponse,
 	error,
+) {
+	poolIDString, userShares, err := k.CreatePoolWithCoins(goCtx, msg.Creator, msg.Base, msg.Quote, msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePoolResponse{
+		PoolId:  poolIDString,
+		Base:    msg.Base,
+		Quote:   msg.Quote,
+		LpToken: userShares,
+	}, nil
+}
+
+// CreatePoolWithCoins creates a pool with the base and quote coins of the creator, charging the creation fee,
+// and sends the LP tokens to the receiver, or to the creator when empty. It returns the pool id and the LP tokens.
+// The launchpad of the factory module seeds the pools of the graduated launches with it.
+func (k Keeper) CreatePoolWithCoins(
+	goCtx context.Context,
+	creator string,
+	base sdk.Coin,
+	quote sdk.Coin,
+	receiverAddress string,
+) (
+	string,
+	sdk.Coin,
+	error,
 ) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// Get the sender address we will need it to transfer funds around
-	sender, err := sdk.AccAddressFromBech32(msg.Creator)
+	sender, err := sdk.AccAddressFromBech32(creator)
 	if err != nil {
-		return nil,
+		return "", sdk.Coin{},
 			errorsmod.Wrapf(
 				sdkerrors.ErrInvalidAddress,
 				"Invalid address: %s",
-				msg.Creator,
+				creator,
 			)
 	}
 
 	// Using coins will ensure sorting, also check for duplicates and future prof for multi coin pools
-	poolUid, found := k.GetPoolUidsFromCoins(ctx, sdk.NewCoins(msg.Base, msg.Quote))
+	poolUid, found := k.GetPoolUidsFromCoins(ctx, sdk.NewCoins(base, quote))
 
 	if found {
-		return nil,
+		return "", sdk.Coin{},
 			errorsmod.Wrapf(
 				sdkerrors.ErrInvalidRequest,
 				"Pool with id: %s already exists with uid: %s",
@@ -54,48 +81,48 @@ func (k msgServer) CreatePool(
 
 	// Check if the sender has enough base tokens to create the pool (comparing to what was in the message)
 	// Double check as it will check again on send - check error message and maybe remove
-	if !k.bankKeeper.HasBalance(ctx, sender, msg.Base) {
-		return nil,
+	if !k.bankKeeper.HasBalance(ctx, sender, base) {
+		return "", sdk.Coin{},
 			errorsmod.Wrapf(
 				sdkerrors.ErrInsufficientFunds,
 				"Signer wallet does not have %s tokens",
-				msg.Base.String(),
+				base.String(),
 			)
 	}
 
 	// Check if the sender has enough quote tokens to create the pool (comparing to what was in the message)
 	// Double check as it will check again on send - check error message and maybe remove
-	if !k.bankKeeper.HasBalance(ctx, sender, msg.Quote) {
-		return nil,
+	if !k.bankKeeper.HasBalance(ctx, sender, quote) {
+		return "", sdk.Coin{},
 			errorsmod.Wrapf(
 				sdkerrors.ErrInsufficientFunds,
 				"Signer wallet does not have %s tokens",
-				msg.Quote.String(),
+				quote.String(),
 			)
 	}
 
 	params := k.GetParams(ctx)
 
-	if params.CreationFee > 0 {
-		if !k.bankKeeper.HasBalance(ctx, sender, sdk.NewCoin(constants.BondDenom, cosmosmath.NewInt(int64(params.CreationFee)))) {
-			return nil,
+	if params.CreationFee.IsPositive() {
+		if !k.bankKeeper.HasBalance(ctx, sender, params.CreationFee) {
+			return "", sdk.Coin{},
 				errorsmod.Wrapf(
 					sdkerrors.ErrInsufficientFunds,
 					"Signer wallet does not have %s tokens",
-					sdk.NewCoin(constants.BondDenom, cosmosmath.NewInt(int64(params.CreationFee))).String(),
+					params.CreationFee.String(),
 				)
 		}
 	}
 
 	// if the creation fee is greater than 0, we will charge the sender
-	if params.CreationFee > 0 {
+	if params.CreationFee.IsPositive() {
 
 		// beneficiary receives the fee if set, otherwise it is burned
 		if params.Beneficiary != "" {
-			fee := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, cosmosmath.NewInt(int64(params.CreationFee))))
+			fee := sdk.NewCoins(params.CreationFee)
 			beneficiary, err := sdk.AccAddressFromBech32(params.Beneficiary)
 			if err != nil {
-				return nil,
+				return "", sdk.Coin{},
 					errorsmod.Wrapf(
 						sdkerrors.ErrInvalidAddress,
 						"Invalid address: %s",
@@ -112,7 +139,7 @@ func (k msgServer) CreatePool(
 			)
 
 			if err != nil {
-				return nil,
+				return "", sdk.Coin{},
 					errorsmod.Wrapf(
 						sdkerrors.ErrInsufficientFunds,
 						"Error while sending coins %s from account: %s to beneficiary: %s",
@@ -127,15 +154,15 @@ func (k msgServer) CreatePool(
 				ctx,
 				sender,
 				types.ModuleName,
-				sdk.NewCoins(sdk.NewCoin(constants.BondDenom, cosmosmath.NewInt(int64(params.CreationFee)))),
+				sdk.NewCoins(params.CreationFee),
 			)
 
 			if err != nil {
-				return nil,
+				return "", sdk.Coin{},
 					errorsmod.Wrapf(
 						sdkerrors.ErrInsufficientFunds,
 						"Error while sending coins %s from account: %s to module: %s",
-						sdk.NewCoin(constants.BondDenom, cosmosmath.NewInt(int64(params.CreationFee))).String(),
+						params.CreationFee.String(),
 						sender,
 						types.ModuleName,
 					)
@@ -144,20 +171,16 @@ func (k msgServer) CreatePool(
 			err = k.bankKeeper.BurnCoins(
 				ctx,
 				types.ModuleName,
-				sdk.NewCoins(
-					sdk.NewCoin(constants.BondDenom,
-						cosmosmath.NewInt(int64(params.CreationFee)),
-					),
-				),
+				sdk.NewCoins(params.CreationFee),
 			)
 			if err != nil {
 				msg := fmt.Sprintf(
 					"CreatePool: BurnCoins Failed in burning %s coins from module %s",
-					sdk.NewCoin(constants.BondDenom, cosmosmath.NewInt(int64(params.CreationFee))).String(),
+					params.CreationFee.String(),
 					types.ModuleName,
 				)
 				log.Error(msg)
-				return nil,
+				return "", sdk.Coin{},
 					errorsmod.Wrapf(
 						sdkerrors.ErrInsufficientFunds,
 						"%s", msg,
@@ -181,7 +204,7 @@ func (k msgServer) CreatePool(
 
 	// now that we have that, we can report an error from above if the pool already exists
 	if isFound {
-		return nil,
+		return "", sdk.Coin{},
 			errorsmod.Wrapf(
 				sdkerrors.ErrInvalidRequest,
 				"Pool with id: %s already exists",
@@ -194,16 +217,16 @@ func (k msgServer) CreatePool(
 	poolAddress, err = k.CreatePoolAccount(ctx, poolIDString)
 
 	if err != nil {
-		return nil, err
+		return "", sdk.Coin{}, err
 	}
 
 	// If in the request, the receiver is sent to receive the LP tokens,
 	// we will use that address, otherwise we will use the sender address
 	var receiver sdk.AccAddress
-	if msg.Receiver != "" {
-		receiver, err = sdk.AccAddressFromBech32(msg.Receiver)
+	if receiverAddress != "" {
+		receiver, err = sdk.AccAddressFromBech32(receiverAddress)
 		if err != nil {
-			return nil, err
+			return "", sdk.Coin{}, err
 		}
 	} else {
 		receiver = sender
@@ -214,25 +237,25 @@ func (k msgServer) CreatePool(
 		ctx,
 		sender,
 		poolIDString,
-		sdk.NewCoins(msg.Base, msg.Quote),
+		sdk.NewCoins(base, quote),
 	)
 
 	// Check if there was an error while transferring the coins
 	if err != nil {
-		return nil,
+		return "", sdk.Coin{},
 			errorsmod.Wrapf(
 				err,
 				"CreatePool: SendFromAddressToPool error while sending coins %s and %s",
-				msg.Base.String(),
-				msg.Quote.String(),
+				base.String(),
+				quote.String(),
 			)
 	}
 
 	// Let's create the LP token for the pool
-	lpAmount, userShares, err := k.initialLiquidityShares(ctx, poolIDString, msg.Base, msg.Quote)
+	lpAmount, userShares, err := k.initialLiquidityShares(ctx, poolIDString, base, quote)
 
 	if err != nil {
-		return nil, err
+		return "", sdk.Coin{}, err
 	}
 
 	// Mint the total LP tokens to the module account
@@ -243,7 +266,7 @@ func (k msgServer) CreatePool(
 	)
 
 	if err != nil {
-		return nil,
+		return "", sdk.Coin{},
 			errorsmod.Wrapf(
 				sdkerrors.ErrInsufficientFunds,
 				"Error in minting coins",
@@ -265,7 +288,7 @@ func (k msgServer) CreatePool(
 			receiver,
 		)
 		log.Error(msg)
-		return nil,
+		return "", sdk.Coin{},
 			errorsmod.Wrapf(
 				sdkerrors.ErrInsufficientFunds,
 				"%s", msg,
@@ -274,12 +297,12 @@ func (k msgServer) CreatePool(
 
 	// Generate the pool object
 	var pool = types.Pool{
-		Creator: msg.Creator,
+		Creator: creator,
 		PoolId:  poolIDString,
 		LpToken: lpAmount,
 		Fee:     params.NewPoolFeePct, // e.g. 0.5%
 		Formula: types.FormulaConstantProduct,
-		Coins:   sdk.NewCoins(msg.Base, msg.Quote),
+		Coins:   sdk.NewCoins(base, quote),
 		Address: poolAddress.String(),
 	}
 
@@ -294,12 +317,7 @@ func (k msgServer) CreatePool(
 
 	events.EmitPoolCreateEvent(ctx, sender, &pool, receiver)
 
-	return &types.MsgCreatePoolResponse{
-		PoolId:  poolIDString,
-		Base:    msg.Base,
-		Quote:   msg.Quote,
-		LpToken: userShares,
-	}, nil
+	return poolIDString, userShares, nil
 }
 
 // integerSqrt computes the integer square root of an cosmos.Int value deterministically.
@@ -317,7 +335,7 @@ func integerSqrt(value cosmosmath.Int) cosmosmath.Int {
 
 // initialLiquidityShares calculates the number of shares to mint based on the added liquidity.
 // It also implements a minimal liquidity lock to prevent share inflation attacks.
-func (k msgServer) initialLiquidityShares(ctx sdk.Context, poolId string, base sdk.Coin, quote sdk.Coin) (sdk.Coin, sdk.Coin, error) {
+func (k Keeper) initialLiquidityShares(ctx sdk.Context, poolId string, base sdk.Coin, quote sdk.Coin) (sdk.Coin, sdk.Coin, error) {
 	// Ensure the amounts are non-negative
 	if !base.Amount.IsPositive() || !quote.Amount.IsPositive() {
 		return sdk.Coin{}, sdk.Coin{}, types.ErrNonPositiveAmounts
@@ -2682,7 +2682,7 @@ func TestPoolMsgServerCreate_InvalidBeneficiaryAddress(t *testing.T) {
 	// update params to set an invalid beneficiary
 	params := types.DefaultParams()
 	params.Beneficiary = "bad_address"
-	params.CreationFee = 100000000 // Ensure CreationFee > 0 to trigger the beneficiary check
+	params.CreationFee = sdk.NewInt64Coin("uzig", 100000000) // Ensure CreationFee > 0 to trigger the beneficiary check
 	require.NoError(t, k.SetParams(ctx, params))
 
 	// code will check if the signer has the required balance of abc
@@ -2760,7 +2760,7 @@ func TestPoolMsgServerCreate_PoolIdAlreadyExists(t *testing.T) {
 	// set the params
 	params := types.DefaultParams()
 	params.MinimalLiquidityLock = 10
-	params.CreationFee = 100000000 // Ensure >0 to check fee
+	params.CreationFee = sdk.NewInt64Coin("uzig", 100000000) // Ensure >0 to check fee
 	k.SetParams(ctx, params)
 
 	// Assuming the initial pool count is 0, the next ID is 1, poolIDString = "zp1"
@@ -2865,7 +2865,7 @@ func TestPoolMsgServerCreate_CreatePoolAccountError_AccountAlreadyExists(t *test
 	// set the params
 	params := types.DefaultParams()
 	params.MinimalLiquidityLock = 10
-	params.CreationFee = 100000000 // Ensure >0 to check fee
+	params.CreationFee = sdk.NewInt64Coin("uzig", 100000000) // Ensure >0 to check fee
 	k.SetParams(ctx, params)
 
 	// get access to message server
@@ -2951,7 +2951,7 @@ func TestPoolMsgServerCreate_NonPositiveBaseAmount(t *testing.T) {
 	// set the params
 	params := types.DefaultParams()
 	params.MinimalLiquidityLock = 10
-	params.CreationFee = 100000000
+	params.CreationFee = sdk.NewInt64Coin("uzig", 100000000)
 	k.SetParams(ctx, params)
 
 	// create a sample signer address
@@ -2996,7 +2996,7 @@ func TestPoolMsgServerCreate_NonPositiveQuoteAmount(t *testing.T) {
 	// set the params
 	params := types.DefaultParams()
 	params.MinimalLiquidityLock = 10
-	params.CreationFee = 100000000
+	params.CreationFee = sdk.NewInt64Coin("uzig", 100000000)
 	k.SetParams(ctx, params)
 
 	// create a sample signer address
@@ -3065,7 +3065,7 @@ func TestPoolMsgServerCreate_InsufficientLiquidityLock(t *testing.T) {
 	// set the params
 	params := types.DefaultParams()
 	params.MinimalLiquidityLock = 10
-	params.CreationFee = 100000000
+	params.CreationFee = sdk.NewInt64Coin("uzig", 100000000)
 	k.SetParams(ctx, params)
 
 	// get access to message server
@@ -83,17 +83,39 @@ func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLi
 		receiver = signer
 	}
 
+	// the transfer taxes of the pool denoms are taken out of the amounts leaving the pool, as in the swaps
+	poolAddress := types.GetPoolAddress(poolIDString)
+	coinsReceived := make(sdk.Coins, len(coinsOut))
+	outgoingTaxes := make([]sdk.Coin, len(coinsOut))
+	for i, coin := range coinsOut {
+		tax, taxRecipient := k.OutgoingTransferTax(ctx, poolAddress, receiver, coin)
+		coinsReceived[i] = coin.Sub(tax)
+		outgoingTaxes[i] = tax
+
+		if !tax.IsPositive() {
+			continue
+		}
+
+		if err = k.SendFromPoolToAddress(ctx, poolIDString, taxRecipient, sdk.NewCoins(tax)); err != nil {
+			return nil, errorsmod.Wrapf(
+				err,
+				"RemoveLiquidity: Failed to send transfer tax %s",
+				tax.String(),
+			)
+		}
+	}
+
 	if err = k.SendFromPoolToAddress(
 		ctx,
 		poolIDString,
 		receiver,
-		coinsOut,
+		coinsReceived,
 	); err != nil {
 		return nil, errorsmod.Wrapf(
 			err,
 			"RemoveLiquidity: Failed to send coins %s and %s",
-			coinsOut[0].String(),
-			coinsOut[1].String(),
+			coinsReceived[0].String(),
+			coinsReceived[1].String(),
 		)
 	}
 
@@ -113,13 +135,20 @@ func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLi
 
 	k.SetPool(ctx, pool)
 
-	events.EmitRemoveLiquidityEvent(ctx, signer, &pool, &msg.Lptoken, &coinsOut, receiver)
+	events.EmitRemoveLiquidityEvent(ctx, signer, &pool, &msg.Lptoken, &coinsReceived, receiver)
 
-	return &types.MsgRemoveLiquidityResponse{
-		Base:  coinsOut[0],
-		Quote: coinsOut[1],
-	}, nil
+	res := &types.MsgRemoveLiquidityResponse{
+		Base:  coinsReceived[0],
+		Quote: coinsReceived[1],
+	}
+	if outgoingTaxes[0].IsPositive() {
+		res.BaseTax = &outgoingTaxes[0]
+	}
+	if outgoingTaxes[1].IsPositive() {
+		res.QuoteTax = &outgoingTaxes[1]
+	}
 
+	return res, nil
 }
 
 // CoinsToRemove calculates the amount of base and quote tokens to return based on the removed shares.
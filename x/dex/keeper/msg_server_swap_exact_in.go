@@ -41,17 +41,25 @@ func (k msgServer) SwapExactIn(goCtx context.Context, msg *types.MsgSwapExactIn)
 		receiver = sender
 	}
 
-	// Check if the sender has enough balances on incoming denom to satisfy the incoming amount
-	if !k.bankKeeper.HasBalance(ctx, sender, msg.Incoming) {
+	poolIDString := msg.PoolId
+	poolAddress := types.GetPoolAddress(poolIDString)
+
+	// Check if the sender has enough balances on incoming denom to satisfy the incoming amount,
+	// and the transfer tax paid on top of it
+	required := msg.Incoming
+	incomingTax := k.IncomingTransferTax(ctx, sender, poolAddress, msg.Incoming)
+	if incomingTax.IsPositive() {
+		required = msg.Incoming.Add(incomingTax)
+	}
+	if !k.bankKeeper.HasBalance(ctx, sender, required) {
 		return nil, errorsmod.Wrapf(
 			sdkerrors.ErrInsufficientFunds,
 			"Sender (%s) does not have enough balance for incoming amount (%s)",
 			sender.String(),
-			msg.Incoming.String(),
+			required.String(),
 		)
 	}
 
-	poolIDString := msg.PoolId
 	pool, found := k.GetPool(ctx, poolIDString)
 	if !found {
 		return nil, errorsmod.Wrapf(
@@ -93,11 +101,21 @@ func (k msgServer) SwapExactIn(goCtx context.Context, msg *types.MsgSwapExactIn)
 		)
 	}
 
-	outCoin, fee, err := CalculateSwapAmount(&pool, msg.Incoming)
+	// fee rate can be dynamic, so it is resolved before the pool balances change
+	feeRate := k.GetEffectiveFee(ctx, pool)
+
+	grossOutCoin, fee, err := CalculateSwapAmountWithFee(&pool, msg.Incoming, feeRate)
 	if err != nil {
 		return nil, err
 	}
 
+	// the transfer tax of the outgoing denom is taken out of the amount leaving the pool
+	outCoin := grossOutCoin
+	outgoingTax, taxRecipient := k.OutgoingTransferTax(ctx, poolAddress, receiver, grossOutCoin)
+	if outgoingTax.IsPositive() {
+		outCoin = grossOutCoin.Sub(outgoingTax)
+	}
+
 	// Check if the swap amount is less than the minimum outgoing amount
 	if msg.OutgoingMin != nil {
 
@@ -131,9 +149,11 @@ func (k msgServer) SwapExactIn(goCtx context.Context, msg *types.MsgSwapExactIn)
 	}
 
 	// Update liquidity pool balances
+	priceBefore := types.GetPoolPrice(pool)
 	pool.Coins[fromCoin] = pool.Coins[fromCoin].AddAmount(msg.Incoming.Amount)
-	pool.Coins[toCoin] = pool.Coins[toCoin].SubAmount(outCoin.Amount)
+	pool.Coins[toCoin] = pool.Coins[toCoin].SubAmount(grossOutCoin.Amount)
 	k.SetPool(ctx, pool)
+	k.UpdatePoolVolatility(ctx, pool, priceBefore)
 
 	// Deduct base tokens from sender
 	err = k.SendFromAddressToPool(
@@ -151,12 +171,14 @@ func (k msgServer) SwapExactIn(goCtx context.Context, msg *types.MsgSwapExactIn)
 			)
 	}
 
-	// Credit quote tokens to sender
-	err = k.SendFromPoolToAddress(
+	// Credit quote tokens to sender, and the transfer tax to its recipient
+	err = k.SendFromPoolToAddressWithTax(
 		ctx,
 		poolIDString,
 		receiver,
-		sdk.NewCoins(outCoin))
+		outCoin,
+		outgoingTax,
+		taxRecipient)
 	if err != nil {
 		return nil,
 			errorsmod.Wrapf(
@@ -169,17 +191,31 @@ func (k msgServer) SwapExactIn(goCtx context.Context, msg *types.MsgSwapExactIn)
 	events.EmitSwapEvent(ctx, sender, receiver, &pool, &msg.Incoming, &outCoin, &fee)
 
 	// Return response
-	return &types.MsgSwapExactInResponse{
+	res := &types.MsgSwapExactInResponse{
 		PoolId:      pool.PoolId,
 		Incoming:    msg.Incoming,
 		Outgoing:    outCoin,
 		Fee:         fee,
 		Receiver:    receiver.String(),
 		OutgoingMin: msg.OutgoingMin,
-	}, nil
+	}
+	if incomingTax.IsPositive() {
+		res.IncomingTax = &incomingTax
+	}
+	if outgoingTax.IsPositive() {
+		res.OutgoingTax = &outgoingTax
+	}
+
+	return res, nil
 }
 
+// CalculateSwapAmount calculates the outgoing amount and fee of a swap using the static pool fee
 func CalculateSwapAmount(pool *types.Pool, incoming sdk.Coin) (out sdk.Coin, feeCoin sdk.Coin, err error) {
+	return CalculateSwapAmountWithFee(pool, incoming, pool.Fee)
+}
+
+// CalculateSwapAmountWithFee calculates the outgoing amount and fee of a swap using the given fee rate (per 100,000)
+func CalculateSwapAmountWithFee(pool *types.Pool, incoming sdk.Coin, feeRate uint32) (out sdk.Coin, feeCoin sdk.Coin, err error) {
 
 	var fromCoin, toCoin int32
 
@@ -203,7 +239,7 @@ func CalculateSwapAmount(pool *types.Pool, incoming sdk.Coin) (out sdk.Coin, fee
 	K := pool.Coins[fromCoin].Amount.Mul(pool.Coins[toCoin].Amount)
 
 	// create math int from pool setting, so we can do safe math
-	feeRatePerHundredThousand := math.NewInt(int64(pool.Fee))
+	feeRatePerHundredThousand := math.NewInt(int64(feeRate))
 
 	// create math int from pool setting, so we can do safe math
 	scalingFactor := math.NewInt(constants.PoolFeeScalingFactor)
@@ -69,7 +69,35 @@ func (k msgServer) SwapExactOut(goCtx context.Context, msg *types.MsgSwapExactOu
 		)
 	}
 
-	inCoin, fee, err := CalculateSwapExactOutAmount(&pool, msg.Outgoing)
+	// Update user balances
+	sender, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	var receiver sdk.AccAddress
+	if msg.Receiver != "" {
+		receiver, err = sdk.AccAddressFromBech32(msg.Receiver)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		receiver = sender
+	}
+
+	// the pool pays out the transfer tax of the outgoing denom on top of the outgoing amount,
+	// so the receiver gets the whole amount once the tax is taken out
+	poolAddress := types.GetPoolAddress(poolIDString)
+	grossOutgoing := msg.Outgoing
+	outgoingTax, taxRecipient := k.GrossOutgoingTransferTax(ctx, poolAddress, receiver, msg.Outgoing)
+	if outgoingTax.IsPositive() {
+		grossOutgoing = msg.Outgoing.Add(outgoingTax)
+	}
+
+	// fee rate can be dynamic, so it is resolved before the pool balances change
+	feeRate := k.GetEffectiveFee(ctx, pool)
+
+	inCoin, fee, err := CalculateSwapExactOutAmountWithFee(&pool, grossOutgoing, feeRate)
 	if err != nil {
 		return nil, err
 	}
@@ -107,15 +135,11 @@ func (k msgServer) SwapExactOut(goCtx context.Context, msg *types.MsgSwapExactOu
 	}
 
 	// Update liquidity pool balances
+	priceBefore := types.GetPoolPrice(pool)
 	pool.Coins[fromCoin] = pool.Coins[fromCoin].AddAmount(inCoin.Amount)
-	pool.Coins[toCoin] = pool.Coins[toCoin].SubAmount(msg.Outgoing.Amount)
+	pool.Coins[toCoin] = pool.Coins[toCoin].SubAmount(grossOutgoing.Amount)
 	k.SetPool(ctx, pool)
-
-	// Update user balances
-	sender, err := sdk.AccAddressFromBech32(msg.Signer)
-	if err != nil {
-		return nil, err
-	}
+	k.UpdatePoolVolatility(ctx, pool, priceBefore)
 
 	// Deduct base tokens from sender
 	err = k.SendFromAddressToPool(
@@ -132,22 +156,14 @@ func (k msgServer) SwapExactOut(goCtx context.Context, msg *types.MsgSwapExactOu
 			)
 	}
 
-	var receiver sdk.AccAddress
-	if msg.Receiver != "" {
-		receiver, err = sdk.AccAddressFromBech32(msg.Receiver)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		receiver = sender
-	}
-
-	// Credit quote tokens to sender
-	err = k.SendFromPoolToAddress(
+	// Credit quote tokens to sender, and the transfer tax to its recipient
+	err = k.SendFromPoolToAddressWithTax(
 		ctx,
 		poolIDString,
 		receiver,
-		sdk.NewCoins(msg.Outgoing))
+		msg.Outgoing,
+		outgoingTax,
+		taxRecipient)
 	if err != nil {
 		return nil,
 			errorsmod.Wrapf(
@@ -160,17 +176,31 @@ func (k msgServer) SwapExactOut(goCtx context.Context, msg *types.MsgSwapExactOu
 	events.EmitSwapExactOutEvent(ctx, sender, receiver, &pool, &inCoin, &msg.Outgoing, &fee)
 
 	// Return response
-	return &types.MsgSwapExactOutResponse{
+	res := &types.MsgSwapExactOutResponse{
 		PoolId:      pool.PoolId,
 		Incoming:    inCoin,
 		Outgoing:    msg.Outgoing,
 		Fee:         fee,
 		Receiver:    receiver.String(),
 		IncomingMax: msg.IncomingMax,
-	}, nil
+	}
+	if incomingTax := k.IncomingTransferTax(ctx, sender, poolAddress, inCoin); incomingTax.IsPositive() {
+		res.IncomingTax = &incomingTax
+	}
+	if outgoingTax.IsPositive() {
+		res.OutgoingTax = &outgoingTax
+	}
+
+	return res, nil
 }
 
+// CalculateSwapExactOutAmount calculates the incoming amount and fee of a swap using the static pool fee
 func CalculateSwapExactOutAmount(pool *types.Pool, outgoingQuote sdk.Coin) (incomingBaseCoin sdk.Coin, feeBaseCoin sdk.Coin, err error) {
+	return CalculateSwapExactOutAmountWithFee(pool, outgoingQuote, pool.Fee)
+}
+
+// CalculateSwapExactOutAmountWithFee calculates the incoming amount and fee of a swap using the given fee rate (per 100,000)
+func CalculateSwapExactOutAmountWithFee(pool *types.Pool, outgoingQuote sdk.Coin, feeRate uint32) (incomingBaseCoin sdk.Coin, feeBaseCoin sdk.Coin, err error) {
 
 	var baseCoin, quoteCoin int32
 
@@ -194,7 +224,7 @@ func CalculateSwapExactOutAmount(pool *types.Pool, outgoingQuote sdk.Coin) (inco
 	K := pool.Coins[baseCoin].Amount.Mul(pool.Coins[quoteCoin].Amount)
 
 	// create math int from pool setting, so we can do safe math
-	feeRatePerHundredThousand := math.NewInt(int64(pool.Fee))
+	feeRatePerHundredThousand := math.NewInt(int64(feeRate))
 
 	// create math int from pool setting, so we can do safe math
 	scalingFactor := math.NewInt(constants.PoolFeeScalingFactor)
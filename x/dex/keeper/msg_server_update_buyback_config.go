@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/dex/events"
+	"zigchain/x/dex/types"
+)
+
+func (k msgServer) UpdateBuybackConfig(goCtx context.Context, msg *types.MsgUpdateBuybackConfig) (*types.MsgUpdateBuybackConfigResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.Config.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetBuybackConfig(ctx, msg.Config)
+
+	events.EmitBuybackConfigUpdatedEvent(ctx, &msg.Config)
+
+	return &types.MsgUpdateBuybackConfigResponse{}, nil
+}
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"zigchain/testutil/sample"
+	"zigchain/x/dex/types"
+)
+
+// Positive test cases
+
+func TestMsgUpdateBuybackConfig_Valid(t *testing.T) {
+	// Test case: governance sets the buyback config
+
+	k, ms, ctx := setupMsgServer(t)
+
+	config := types.DefaultBuybackConfig()
+	config.Enabled = true
+	config.Destination = types.BUYBACK_DESTINATION_COMMUNITY_POOL
+
+	_, err := ms.UpdateBuybackConfig(ctx, &types.MsgUpdateBuybackConfig{
+		Authority: k.GetAuthority(),
+		Config:    config,
+	})
+	require.NoError(t, err)
+	require.Equal(t, config, k.GetBuybackConfig(ctx))
+}
+
+// Negative test cases
+
+func TestMsgUpdateBuybackConfig_InvalidAuthority(t *testing.T) {
+	// Test case: only governance can set the buyback config
+
+	k, ms, ctx := setupMsgServer(t)
+
+	config := types.DefaultBuybackConfig()
+	config.Enabled = true
+
+	_, err := ms.UpdateBuybackConfig(ctx, &types.MsgUpdateBuybackConfig{
+		Authority: sample.AccAddress(),
+		Config:    config,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	require.Equal(t, types.DefaultBuybackConfig(), k.GetBuybackConfig(ctx))
+}
+
+func TestMsgUpdateBuybackConfig_InvalidConfig(t *testing.T) {
+	// Test case: the dex module account cannot be a buyback source
+
+	k, ms, ctx := setupMsgServer(t)
+
+	config := types.DefaultBuybackConfig()
+	config.SourceModules = []string{types.ModuleName}
+
+	_, err := ms.UpdateBuybackConfig(ctx, &types.MsgUpdateBuybackConfig{
+		Authority: k.GetAuthority(),
+		Config:    config,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidBuybackConfig)
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/dex/events"
+	"zigchain/x/dex/types"
+)
+
+func (k msgServer) UpdateDynamicFeeConfig(goCtx context.Context, msg *types.MsgUpdateDynamicFeeConfig) (*types.MsgUpdateDynamicFeeConfigResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.Config.Validate(); err != nil {
+		return nil, err
+	}
+
+	pool, found := k.GetPool(ctx, msg.Config.PoolId)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrPoolNotFound,
+			"Liquidity pool (%s) can not be found",
+			msg.Config.PoolId,
+		)
+	}
+
+	// start tracking from the current state, so the new config applies to the decayed volatility
+	k.SetPoolVolatility(ctx, k.GetCurrentPoolVolatility(ctx, pool))
+	k.SetDynamicFeeConfig(ctx, msg.Config)
+
+	events.EmitDynamicFeeConfigUpdatedEvent(ctx, &msg.Config)
+
+	return &types.MsgUpdateDynamicFeeConfigResponse{}, nil
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"zigchain/testutil/sample"
+	"zigchain/x/dex/types"
+)
+
+// Positive test cases
+
+func TestMsgUpdateDynamicFeeConfig_Valid(t *testing.T) {
+	// Test case: governance sets the dynamic fee config of an existing pool
+
+	k, ms, ctx := setupMsgServer(t)
+	k.SetPool(ctx, types.Pool{PoolId: "zp1", Fee: 500})
+
+	config := dynamicFeeTestConfig("zp1")
+	_, err := ms.UpdateDynamicFeeConfig(ctx, &types.MsgUpdateDynamicFeeConfig{
+		Authority: k.GetAuthority(),
+		Config:    config,
+	})
+	require.NoError(t, err)
+
+	stored, found := k.GetDynamicFeeConfig(ctx, "zp1")
+	require.True(t, found)
+	require.Equal(t, config, stored)
+
+	_, found = k.GetPoolVolatility(ctx, "zp1")
+	require.True(t, found)
+}
+
+// Negative test cases
+
+func TestMsgUpdateDynamicFeeConfig_InvalidAuthority(t *testing.T) {
+	// Test case: only governance can set the dynamic fee config
+
+	k, ms, ctx := setupMsgServer(t)
+	k.SetPool(ctx, types.Pool{PoolId: "zp1", Fee: 500})
+
+	_, err := ms.UpdateDynamicFeeConfig(ctx, &types.MsgUpdateDynamicFeeConfig{
+		Authority: sample.AccAddress(),
+		Config:    dynamicFeeTestConfig("zp1"),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+}
+
+func TestMsgUpdateDynamicFeeConfig_PoolNotFound(t *testing.T) {
+	// Test case: dynamic fee config can only be set for existing pools
+
+	k, ms, ctx := setupMsgServer(t)
+
+	_, err := ms.UpdateDynamicFeeConfig(ctx, &types.MsgUpdateDynamicFeeConfig{
+		Authority: k.GetAuthority(),
+		Config:    dynamicFeeTestConfig("zp1"),
+	})
+	require.ErrorIs(t, err, types.ErrPoolNotFound)
+}
+
+func TestMsgUpdateDynamicFeeConfig_InvalidConfig(t *testing.T) {
+	// Test case: floor above ceiling is rejected
+
+	k, ms, ctx := setupMsgServer(t)
+	k.SetPool(ctx, types.Pool{PoolId: "zp1", Fee: 500})
+
+	config := dynamicFeeTestConfig("zp1")
+	config.MinFee = config.MaxFee + 1
+
+	_, err := ms.UpdateDynamicFeeConfig(ctx, &types.MsgUpdateDynamicFeeConfig{
+		Authority: k.GetAuthority(),
+		Config:    config,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidDynamicFeeConfig)
+}
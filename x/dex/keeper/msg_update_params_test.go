@@ -56,7 +56,7 @@ func TestMsgUpdateParams_ValidUpdate(t *testing.T) {
 
 	customParams := types.Params{
 		NewPoolFeePct: 600,
-		CreationFee:   200_000_000,
+		CreationFee:   sdk.NewInt64Coin("uzig", 200_000_000),
 	}
 
 	msg := types.MsgUpdateParams{
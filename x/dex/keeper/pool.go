@@ -7,9 +7,6 @@ import (
 	"reflect"
 	"strconv"
 
-	"cosmossdk.io/store/prefix"
-	storetypes "cosmossdk.io/store/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -23,12 +20,9 @@ import (
 
 // SetPool set a specific pool in the store from its index
 func (k Keeper) SetPool(ctx context.Context, pool types.Pool) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PoolKeyPrefix))
-	b := k.cdc.MustMarshal(&pool)
-	store.Set(types.PoolKey(
-		pool.PoolId,
-	), b)
+	if err := k.Pools.Set(ctx, pool.PoolId, pool); err != nil {
+		panic(err)
+	}
 }
 
 // GetPool returns a pool from its index
@@ -37,37 +31,24 @@ func (k Keeper) GetPool(
 	poolIDString string,
 
 ) (val types.Pool, found bool) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PoolKeyPrefix))
-
-	b := store.Get(types.PoolKey(
-		poolIDString,
-	))
-	if b == nil {
+	val, err := k.Pools.Get(ctx, poolIDString)
+	if err != nil {
 		return val, false
 	}
 
-	k.cdc.MustUnmarshal(b, &val)
 	return val, true
 }
 
 // GetAllPool returns all pool
 func (k Keeper) GetAllPool(ctx context.Context) (list []types.Pool) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PoolKeyPrefix))
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
-
-	defer func(iterator storetypes.Iterator) {
-		err := iterator.Close()
-		if err != nil {
-			k.logger.Error("failed to close iterator", "error", err)
-		}
-	}(iterator)
+	iterator, err := k.Pools.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
 
-	for ; iterator.Valid(); iterator.Next() {
-		var val types.Pool
-		k.cdc.MustUnmarshal(iterator.Value(), &val)
-		list = append(list, val)
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
 	}
 
 	return
@@ -242,13 +223,17 @@ func (k Keeper) SendFromAddressToPool(ctx sdk.Context, sender sdk.AccAddress, po
 				"SendFromAddressToPool: coins cannot be zero",
 			)
 		}
-		// Check if the sender has enough coins
-		if !k.bankKeeper.HasBalance(ctx, sender, coin) {
+		// Check if the sender has enough coins, including the transfer tax paid on top of them
+		required := coin
+		if tax := k.IncomingTransferTax(ctx, sender, poolAddress, coin); tax.IsPositive() {
+			required = coin.Add(tax)
+		}
+		if !k.bankKeeper.HasBalance(ctx, sender, required) {
 			return errorsmod.Wrapf(
 				errors.ErrInsufficientFunds,
 				"SendFromAddressToPool: Insufficient funds in sender %s to send %s coins to poolID %s (address: %s)",
 				sender.String(),
-				coin.String(),
+				required.String(),
 				poolIDString,
 				poolAddress,
 			)
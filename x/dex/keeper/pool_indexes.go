@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+
+	"zigchain/x/dex/types"
+	zindexes "zigchain/zutils/indexes"
+)
+
+// PoolIndexes are the secondary indexes of the pools collection,
+// both are MultiValue indexes so the queries can paginate over them
+type PoolIndexes struct {
+	// Creator indexes the pools by the address that created them
+	Creator *zindexes.MultiValue[string, string, types.Pool]
+
+	// Denom indexes the pools by both their base and quote denoms
+	Denom *zindexes.MultiValue[string, string, types.Pool]
+}
+
+// IndexesList returns the indexes maintained by the pools collection
+func (i PoolIndexes) IndexesList() []collections.Index[string, types.Pool] {
+	return []collections.Index[string, types.Pool]{i.Creator, i.Denom}
+}
+
+// NewPoolIndexes creates the secondary indexes of the pools collection
+func NewPoolIndexes(sb *collections.SchemaBuilder) PoolIndexes {
+	return PoolIndexes{
+		Creator: zindexes.NewMultiValue(
+			sb,
+			types.PoolsByCreatorPrefix,
+			"pools_by_creator",
+			collections.StringKey,
+			collections.StringKey,
+			func(_ string, pool types.Pool) ([]string, error) {
+				return []string{pool.Creator}, nil
+			},
+		),
+		Denom: zindexes.NewMultiValue(
+			sb,
+			types.PoolsByDenomPrefix,
+			"pools_by_denom",
+			collections.StringKey,
+			collections.StringKey,
+			func(_ string, pool types.Pool) ([]string, error) {
+				denoms := make([]string, 0, len(pool.Coins))
+				for _, coin := range pool.Coins {
+					if coin.Denom != "" {
+						denoms = append(denoms, coin.Denom)
+					}
+				}
+				return denoms, nil
+			},
+		),
+	}
+}
@@ -6,20 +6,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"zigchain/x/dex/types"
-
-	"cosmossdk.io/store/prefix"
-	storetypes "cosmossdk.io/store/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
 )
 
 // SetPoolUids set a specific poolUids in the store from its index
 func (k Keeper) SetPoolUids(ctx context.Context, poolUids types.PoolUids) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PoolUidsKeyPrefix))
-	b := k.cdc.MustMarshal(&poolUids)
-	store.Set(types.PoolUidsKey(
-		poolUids.PoolUid,
-	), b)
+	if err := k.PoolUids.Set(ctx, poolUids.PoolUid, poolUids); err != nil {
+		panic(err)
+	}
 }
 
 // SetPoolUidFromPool proxy method that sets unique poolUids from a pool
@@ -55,17 +48,11 @@ func (k Keeper) GetPoolUids(
 	poolUid string,
 
 ) (val types.PoolUids, found bool) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PoolUidsKeyPrefix))
-
-	b := store.Get(types.PoolUidsKey(
-		poolUid,
-	))
-	if b == nil {
+	val, err := k.PoolUids.Get(ctx, poolUid)
+	if err != nil {
 		return val, false
 	}
 
-	k.cdc.MustUnmarshal(b, &val)
 	return val, true
 }
 
@@ -82,30 +69,21 @@ func (k Keeper) RemovePoolUids(
 	poolUid string,
 
 ) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PoolUidsKeyPrefix))
-	store.Delete(types.PoolUidsKey(
-		poolUid,
-	))
+	if err := k.PoolUids.Remove(ctx, poolUid); err != nil {
+		panic(err)
+	}
 }
 
 // GetAllPoolUids returns all poolUids
 func (k Keeper) GetAllPoolUids(ctx context.Context) (list []types.PoolUids) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PoolUidsKeyPrefix))
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
-
-	defer func(iterator storetypes.Iterator) {
-		err := iterator.Close()
-		if err != nil {
-			k.logger.Error("failed to close iterator", "error", err)
-		}
-	}(iterator)
-
-	for ; iterator.Valid(); iterator.Next() {
-		var val types.PoolUids
-		k.cdc.MustUnmarshal(iterator.Value(), &val)
-		list = append(list, val)
+	iterator, err := k.PoolUids.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
 	}
 
 	return
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zigchain/x/dex/types"
+)
+
+func (s queryServer) GetBuybackInfo(ctx context.Context, req *types.QueryGetBuybackInfoRequest) (*types.QueryGetBuybackInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QueryGetBuybackInfoResponse{
+		Config: s.k.GetBuybackConfig(ctx),
+		Stats:  s.k.GetBuybackStats(ctx),
+	}, nil
+}
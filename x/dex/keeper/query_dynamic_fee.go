@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zigchain/x/dex/types"
+	"zigchain/zutils/validators"
+)
+
+func (s queryServer) GetDynamicFee(ctx context.Context, req *types.QueryGetDynamicFeeRequest) (*types.QueryGetDynamicFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := validators.CheckPoolId(req.PoolId); err != nil {
+		return nil, err
+	}
+
+	pool, found := s.k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil,
+			errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"Liquidity pool (%s) can not be found",
+				req.PoolId,
+			)
+	}
+
+	config, found := s.k.GetDynamicFeeConfig(ctx, req.PoolId)
+	if !found {
+		config = types.DefaultDynamicFeeConfig(pool)
+	}
+
+	return &types.QueryGetDynamicFeeResponse{
+		Config:       config,
+		Volatility:   s.k.GetCurrentPoolVolatility(ctx, pool),
+		EffectiveFee: s.k.GetEffectiveFee(ctx, pool),
+	}, nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -35,7 +36,7 @@ func TestQueryParams_ValidRequest(t *testing.T) {
 
 	params := types.Params{
 		NewPoolFeePct: 600,
-		CreationFee:   200_000_000,
+		CreationFee:   sdk.NewInt64Coin("uzig", 200_000_000),
 	}
 	require.NoError(t, k.SetParams(ctx, params))
 
@@ -3,13 +3,14 @@ package keeper
 import (
 	"context"
 
-	"cosmossdk.io/store/prefix"
-	"github.com/cosmos/cosmos-sdk/runtime"
+	"cosmossdk.io/collections"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"zigchain/x/dex/types"
+	"zigchain/zutils/pagination"
+	"zigchain/zutils/validators"
 )
 
 func (s queryServer) ListPool(ctx context.Context, req *types.QueryAllPoolRequest) (*types.QueryAllPoolResponse, error) {
@@ -17,20 +18,14 @@ func (s queryServer) ListPool(ctx context.Context, req *types.QueryAllPoolReques
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var pools []types.Pool
-
-	store := runtime.KVStoreAdapter(s.k.storeService.OpenKVStore(ctx))
-	poolStore := prefix.NewStore(store, types.KeyPrefix(types.PoolKeyPrefix))
-
-	pageRes, err := query.Paginate(poolStore, req.Pagination, func(key []byte, value []byte) error {
-		var pool types.Pool
-		if err := s.k.cdc.Unmarshal(value, &pool); err != nil {
-			return err
-		}
-
-		pools = append(pools, pool)
-		return nil
-	})
+	pools, pageRes, err := pagination.CollectionPaginate(
+		ctx,
+		s.k.Pools,
+		req.Pagination,
+		func(_ string, pool types.Pool) (types.Pool, error) {
+			return pool, nil
+		},
+	)
 
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -78,3 +73,55 @@ func (s queryServer) GetPoolBalances(ctx context.Context, req *types.QueryGetPoo
 		Balances: balances,
 	}, nil
 }
+
+func (s queryServer) ListPoolsByDenom(ctx context.Context, req *types.QueryPoolsByDenomRequest) (*types.QueryPoolsByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := validators.CheckDenomString(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pools, pageRes, err := pagination.CollectionPaginate(
+		ctx,
+		s.k.Pools.Indexes.Denom,
+		req.Pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (types.Pool, error) {
+			return s.k.Pools.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Denom),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolsByDenomResponse{Pool: pools, Pagination: pageRes}, nil
+}
+
+func (s queryServer) ListPoolsByCreator(ctx context.Context, req *types.QueryPoolsByCreatorRequest) (*types.QueryPoolsByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := validators.AddressCheck("creator", req.Creator); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pools, pageRes, err := pagination.CollectionPaginate(
+		ctx,
+		s.k.Pools.Indexes.Creator,
+		req.Pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (types.Pool, error) {
+			return s.k.Pools.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Creator),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolsByCreatorResponse{Pool: pools, Pagination: pageRes}, nil
+}
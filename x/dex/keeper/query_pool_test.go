@@ -593,6 +593,94 @@ func TestQueryPool_GetPoolBalances(t *testing.T) {
 	require.ElementsMatch(t, sdk.NewCoins(createPoolBase, createPoolQuote), response.Balances)
 }
 
+func TestQueryPool_ListPoolsByDenom(t *testing.T) {
+	// Test case: list the pools holding a denom through the denom index
+
+	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
+	qs := keeper.NewQueryServerImpl(k)
+
+	creator := sample.AccAddress()
+	pools := []types.Pool{
+		{PoolId: "zp1", Creator: creator, Coins: sdk.NewCoins(sample.Coin("abc", 100), sample.Coin("uzig", 100))},
+		{PoolId: "zp2", Creator: creator, Coins: sdk.NewCoins(sample.Coin("abc", 100), sample.Coin("usdt", 100))},
+		{PoolId: "zp3", Creator: sample.AccAddress(), Coins: sdk.NewCoins(sample.Coin("usdt", 100), sample.Coin("uzig", 100))},
+	}
+	for _, pool := range pools {
+		k.SetPool(ctx, pool)
+	}
+
+	resp, err := qs.ListPoolsByDenom(ctx, &types.QueryPoolsByDenomRequest{Denom: "abc"})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.Pool{pools[0], pools[1]}), nullify.Fill(resp.Pool))
+
+	// paginated by key
+	resp, err = qs.ListPoolsByDenom(ctx, &types.QueryPoolsByDenomRequest{
+		Denom:      "uzig",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.Pool{pools[0]}), nullify.Fill(resp.Pool))
+	require.Equal(t, uint64(2), resp.Pagination.Total)
+
+	resp, err = qs.ListPoolsByDenom(ctx, &types.QueryPoolsByDenomRequest{
+		Denom:      "uzig",
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.Pool{pools[2]}), nullify.Fill(resp.Pool))
+	require.Nil(t, resp.Pagination.NextKey)
+
+	// the index follows the pool coins
+	pools[0].Coins = sdk.NewCoins(sample.Coin("usdt", 100), sample.Coin("uzig", 100))
+	k.SetPool(ctx, pools[0])
+
+	resp, err = qs.ListPoolsByDenom(ctx, &types.QueryPoolsByDenomRequest{Denom: "abc"})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.Pool{pools[1]}), nullify.Fill(resp.Pool))
+
+	resp, err = qs.ListPoolsByDenom(ctx, &types.QueryPoolsByDenomRequest{Denom: "unknown"})
+	require.NoError(t, err)
+	require.Empty(t, resp.Pool)
+}
+
+func TestQueryPool_ListPoolsByCreator(t *testing.T) {
+	// Test case: list the pools created by an address through the creator index
+
+	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
+	qs := keeper.NewQueryServerImpl(k)
+
+	creator := sample.AccAddress()
+	pools := []types.Pool{
+		{PoolId: "zp1", Creator: creator},
+		{PoolId: "zp2", Creator: sample.AccAddress()},
+		{PoolId: "zp3", Creator: creator},
+	}
+	for _, pool := range pools {
+		k.SetPool(ctx, pool)
+	}
+
+	resp, err := qs.ListPoolsByCreator(ctx, &types.QueryPoolsByCreatorRequest{
+		Creator:    creator,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.Pool{pools[0], pools[2]}), nullify.Fill(resp.Pool))
+	require.Equal(t, uint64(2), resp.Pagination.Total)
+
+	// an offset past the last pool still reports the total
+	resp, err = qs.ListPoolsByCreator(ctx, &types.QueryPoolsByCreatorRequest{
+		Creator:    creator,
+		Pagination: &query.PageRequest{Offset: 5, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Pool)
+	require.Equal(t, uint64(2), resp.Pagination.Total)
+
+	resp, err = qs.ListPoolsByCreator(ctx, &types.QueryPoolsByCreatorRequest{Creator: sample.AccAddress()})
+	require.NoError(t, err)
+	require.Empty(t, resp.Pool)
+}
+
 // Negative test cases
 
 func TestQueryPool_GetPool_InvalidRequest_Nil(t *testing.T) {
@@ -684,3 +772,29 @@ func TestQueryPool_ListPool_PaginateError(t *testing.T) {
 	require.Equal(t, codes.Internal, status.Code(err))
 	require.Contains(t, err.Error(), "either offset or key is expected, got both")
 }
+
+func TestQueryPool_ListPoolsByDenom_InvalidRequest(t *testing.T) {
+	// Test case: try to list pools by denom with a nil request or an invalid denom
+
+	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
+	qs := keeper.NewQueryServerImpl(k)
+
+	_, err := qs.ListPoolsByDenom(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = qs.ListPoolsByDenom(ctx, &types.QueryPoolsByDenomRequest{Denom: "a"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryPool_ListPoolsByCreator_InvalidRequest(t *testing.T) {
+	// Test case: try to list pools by creator with a nil request or an invalid address
+
+	k, ctx := keepertest.DexKeeper(t, nil, nil, nil)
+	qs := keeper.NewQueryServerImpl(k)
+
+	_, err := qs.ListPoolsByCreator(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = qs.ListPoolsByCreator(ctx, &types.QueryPoolsByCreatorRequest{Creator: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -8,10 +8,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"zigchain/x/dex/types"
+	"zigchain/zutils/pagination"
 
-	"cosmossdk.io/store/prefix"
-	"github.com/cosmos/cosmos-sdk/runtime"
-	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -21,20 +19,14 @@ func (s queryServer) ListPoolUids(ctx context.Context, req *types.QueryAllPoolUi
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var poolUidss []types.PoolUids
-
-	store := runtime.KVStoreAdapter(s.k.storeService.OpenKVStore(ctx))
-	poolUidsStore := prefix.NewStore(store, types.KeyPrefix(types.PoolUidsKeyPrefix))
-
-	pageRes, err := query.Paginate(poolUidsStore, req.Pagination, func(key []byte, value []byte) error {
-		var poolUids types.PoolUids
-		if err := s.k.cdc.Unmarshal(value, &poolUids); err != nil {
-			return err
-		}
-
-		poolUidss = append(poolUidss, poolUids)
-		return nil
-	})
+	poolUidss, pageRes, err := pagination.CollectionPaginate(
+		ctx,
+		s.k.PoolUids,
+		req.Pagination,
+		func(_ string, poolUids types.PoolUids) (types.PoolUids, error) {
+			return poolUids, nil
+		},
+	)
 
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
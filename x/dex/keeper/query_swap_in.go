@@ -55,14 +55,34 @@ func (s queryServer) SwapIn(goCtx context.Context, req *types.QuerySwapInRequest
 			)
 	}
 
-	coinOut, feeCoin, err := CalculateSwapAmount(&pool, coinIn)
+	trader, err := parseTrader(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	feeRate := s.k.GetEffectiveFee(ctx, pool)
+
+	coinOut, feeCoin, err := CalculateSwapAmountWithFee(&pool, coinIn, feeRate)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.QuerySwapInResponse{
+	res := &types.QuerySwapInResponse{
 		CoinOut: coinOut,
 		Fee:     feeCoin,
-	}, nil
+		FeeRate: feeRate,
+	}
+
+	// the transfer taxes of the trader are paid on top of the incoming coin and taken out of the outgoing coin
+	poolAddress := types.GetPoolAddress(pool.PoolId)
+	if incomingTax := s.k.IncomingTransferTax(ctx, trader, poolAddress, coinIn); incomingTax.IsPositive() {
+		res.IncomingTax = &incomingTax
+	}
+	if outgoingTax, _ := s.k.OutgoingTransferTax(ctx, poolAddress, trader, coinOut); outgoingTax.IsPositive() {
+		res.CoinOut = coinOut.Sub(outgoingTax)
+		res.OutgoingTax = &outgoingTax
+	}
+
+	return res, nil
 }
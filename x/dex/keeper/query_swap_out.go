@@ -55,14 +55,35 @@ func (s queryServer) SwapOut(goCtx context.Context, req *types.QuerySwapOutReque
 			)
 	}
 
-	coinIn, feeCoin, err := CalculateSwapExactOutAmount(&pool, coinOut)
+	trader, err := parseTrader(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// the pool pays out the transfer tax of the outgoing coin on top of it, so the trader gets the whole coin
+	poolAddress := types.GetPoolAddress(pool.PoolId)
+	outgoingTax, _ := s.k.GrossOutgoingTransferTax(ctx, poolAddress, trader, coinOut)
+
+	feeRate := s.k.GetEffectiveFee(ctx, pool)
+
+	coinIn, feeCoin, err := CalculateSwapExactOutAmountWithFee(&pool, coinOut.Add(outgoingTax), feeRate)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.QuerySwapOutResponse{
-		CoinIn: coinIn,
-		Fee:    feeCoin,
-	}, nil
+	res := &types.QuerySwapOutResponse{
+		CoinIn:  coinIn,
+		Fee:     feeCoin,
+		FeeRate: feeRate,
+	}
+
+	if incomingTax := s.k.IncomingTransferTax(ctx, trader, poolAddress, coinIn); incomingTax.IsPositive() {
+		res.IncomingTax = &incomingTax
+	}
+	if outgoingTax.IsPositive() {
+		res.OutgoingTax = &outgoingTax
+	}
+
+	return res, nil
 }
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// parseTrader parses the optional address of the trader a swap is quoted for
+func parseTrader(address string) (sdk.AccAddress, error) {
+	if address == "" {
+		return sdk.AccAddress{}, nil
+	}
+
+	trader, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address (%s): %s", address, err)
+	}
+
+	return trader, nil
+}
+
+// transferTaxRate returns the transfer tax rate of a denom moved between two addresses and the
+// tax recipient, the rate is zero when the transfer is not taxed
+func (k Keeper) transferTaxRate(ctx context.Context, denom string, fromAddr, toAddr sdk.AccAddress) (math.LegacyDec, sdk.AccAddress) {
+	if k.taxes.transferTaxKeeper == nil {
+		return math.LegacyZeroDec(), nil
+	}
+
+	rate, recipient, taxed := k.taxes.transferTaxKeeper.TransferTaxRate(ctx, denom, fromAddr, toAddr)
+	if !taxed {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return rate, recipient
+}
+
+// IncomingTransferTax returns the transfer tax the sender pays on top of a coin sent to a pool,
+// it is collected by the bank send restriction of the factory
+func (k Keeper) IncomingTransferTax(ctx context.Context, sender sdk.AccAddress, poolAddress sdk.AccAddress, coin sdk.Coin) sdk.Coin {
+	rate, _ := k.transferTaxRate(ctx, coin.Denom, sender, poolAddress)
+
+	return sdk.NewCoin(coin.Denom, rate.MulInt(coin.Amount).TruncateInt())
+}
+
+// OutgoingTransferTax returns the transfer tax taken out of a coin leaving a pool and its recipient,
+// the pools are module accounts so the bank send restriction of the factory does not tax them
+func (k Keeper) OutgoingTransferTax(
+	ctx context.Context,
+	poolAddress sdk.AccAddress,
+	receiver sdk.AccAddress,
+	coin sdk.Coin,
+) (sdk.Coin, sdk.AccAddress) {
+	rate, recipient := k.transferTaxRate(ctx, coin.Denom, poolAddress, receiver)
+
+	return sdk.NewCoin(coin.Denom, rate.MulInt(coin.Amount).TruncateInt()), recipient
+}
+
+// GrossOutgoingTransferTax returns the transfer tax to add to a coin leaving a pool so the receiver
+// gets the whole coin once the tax is taken out, rounded up in favour of the tax recipient
+func (k Keeper) GrossOutgoingTransferTax(
+	ctx context.Context,
+	poolAddress sdk.AccAddress,
+	receiver sdk.AccAddress,
+	coin sdk.Coin,
+) (sdk.Coin, sdk.AccAddress) {
+	rate, recipient := k.transferTaxRate(ctx, coin.Denom, poolAddress, receiver)
+	if rate.IsZero() {
+		return sdk.NewCoin(coin.Denom, math.ZeroInt()), recipient
+	}
+
+	// gross = net / (1 - rate), so the tax = gross - net
+	gross := math.LegacyNewDecFromInt(coin.Amount).Quo(math.LegacyOneDec().Sub(rate)).Ceil().TruncateInt()
+
+	return sdk.NewCoin(coin.Denom, gross.Sub(coin.Amount)), recipient
+}
+
+// SendFromPoolToAddressWithTax sends a coin leaving a pool to the receiver and the transfer tax taken
+// out of it to the tax recipient, the tax is only sent when positive
+func (k Keeper) SendFromPoolToAddressWithTax(
+	ctx sdk.Context,
+	poolIDString string,
+	receiver sdk.AccAddress,
+	coin sdk.Coin,
+	tax sdk.Coin,
+	taxRecipient sdk.AccAddress,
+) error {
+	if tax.IsPositive() {
+		if err := k.SendFromPoolToAddress(ctx, poolIDString, taxRecipient, sdk.NewCoins(tax)); err != nil {
+			return err
+		}
+	}
+
+	return k.SendFromPoolToAddress(ctx, poolIDString, receiver, sdk.NewCoins(coin))
+}
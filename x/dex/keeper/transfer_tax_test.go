@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"zigchain/testutil/sample"
+	"zigchain/x/dex/keeper"
+	"zigchain/x/dex/testutil"
+	"zigchain/x/dex/types"
+	"zigchain/zutils/constants"
+)
+
+// setTransferTaxOnPoolPayouts taxes the abc coins leaving the pool at 10%, paid to the treasury
+func setTransferTaxOnPoolPayouts(
+	t *testing.T,
+	k keeper.Keeper,
+	pool types.Pool,
+	treasury sdk.AccAddress,
+) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	taxKeeper := testutil.NewMockTransferTaxKeeper(ctrl)
+	taxKeeper.EXPECT().
+		TransferTaxRate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, denom string, fromAddr, _ sdk.AccAddress) (sdkmath.LegacyDec, sdk.AccAddress, bool) {
+			if denom != "abc" || fromAddr.String() != pool.Address {
+				return sdkmath.LegacyZeroDec(), nil, false
+			}
+			return sdkmath.LegacyNewDecWithPrec(1, 1), treasury, true
+		}).
+		AnyTimes()
+
+	k.SetTransferTaxKeeper(taxKeeper)
+}
+
+// Positive test cases
+
+func TestTransferTax_SwapExactIn(t *testing.T) {
+	// Test case: the outgoing tax is taken out of the pool payout and sent to the treasury
+
+	k, ctx, bankKeeper, pool := setupBuybackPool(t, 1_000_000, 0)
+	treasury := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	setTransferTaxOnPoolPayouts(t, k, pool, treasury)
+
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	incoming := sdk.NewInt64Coin(constants.BondDenom, 10_000)
+	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(incoming)))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, signer, sdk.NewCoins(incoming)))
+
+	quote, err := keeper.NewQueryServerImpl(k).SwapIn(ctx, &types.QuerySwapInRequest{
+		PoolId:  pool.PoolId,
+		CoinIn:  incoming.String(),
+		Address: signer.String(),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, quote.OutgoingTax)
+	require.Nil(t, quote.IncomingTax)
+
+	resp, err := keeper.NewMsgServerImpl(k).SwapExactIn(ctx, &types.MsgSwapExactIn{
+		Signer:   signer.String(),
+		Incoming: incoming,
+		PoolId:   pool.PoolId,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp.OutgoingTax)
+	require.Nil(t, resp.IncomingTax)
+
+	// the quote matches the swap
+	require.Equal(t, quote.CoinOut, resp.Outgoing)
+	require.Equal(t, *quote.OutgoingTax, *resp.OutgoingTax)
+
+	// the tax is 10% of the gross payout, the receiver gets the rest
+	gross := resp.Outgoing.Amount.Add(resp.OutgoingTax.Amount)
+	require.Equal(t, gross.QuoRaw(10), resp.OutgoingTax.Amount)
+	require.Equal(t, resp.Outgoing, bankKeeper.GetBalance(ctx, signer, "abc"))
+	require.Equal(t, *resp.OutgoingTax, bankKeeper.GetBalance(ctx, treasury, "abc"))
+
+	// the pool is debited the gross payout
+	stored, found := k.GetPool(ctx, pool.PoolId)
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewInt(1_000_000).Sub(gross), stored.Coins[0].Amount)
+}
+
+func TestTransferTax_SwapExactOut(t *testing.T) {
+	// Test case: the receiver gets exactly the outgoing coin, the pool pays the tax on top
+
+	k, ctx, bankKeeper, pool := setupBuybackPool(t, 1_000_000, 0)
+	treasury := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	setTransferTaxOnPoolPayouts(t, k, pool, treasury)
+
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	balance := sdk.NewInt64Coin(constants.BondDenom, 100_000)
+	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(balance)))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, signer, sdk.NewCoins(balance)))
+
+	outgoing := sdk.NewInt64Coin("abc", 9_000)
+
+	quote, err := keeper.NewQueryServerImpl(k).SwapOut(ctx, &types.QuerySwapOutRequest{
+		PoolId:  pool.PoolId,
+		CoinOut: outgoing.String(),
+		Address: signer.String(),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, quote.OutgoingTax)
+
+	resp, err := keeper.NewMsgServerImpl(k).SwapExactOut(ctx, &types.MsgSwapExactOut{
+		Signer:   signer.String(),
+		Outgoing: outgoing,
+		PoolId:   pool.PoolId,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp.OutgoingTax)
+
+	// the quote matches the swap
+	require.Equal(t, *quote.OutgoingTax, *resp.OutgoingTax)
+
+	// 9000 net at 10% is 10000 gross
+	require.Equal(t, sdk.NewInt64Coin("abc", 1_000), *resp.OutgoingTax)
+	require.Equal(t, outgoing, bankKeeper.GetBalance(ctx, signer, "abc"))
+	require.Equal(t, *resp.OutgoingTax, bankKeeper.GetBalance(ctx, treasury, "abc"))
+
+	stored, found := k.GetPool(ctx, pool.PoolId)
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewInt(1_000_000-10_000), stored.Coins[0].Amount)
+}
+
+func TestTransferTax_RemoveLiquidity(t *testing.T) {
+	// Test case: the tax is taken out of the liquidity leaving the pool, as in the swaps
+
+	k, ctx, bankKeeper, pool := setupBuybackPool(t, 1_000_000, 0)
+	treasury := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	setTransferTaxOnPoolPayouts(t, k, pool, treasury)
+
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	lpToken := sdk.NewInt64Coin(pool.PoolId, 100_000)
+	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(lpToken)))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, signer, sdk.NewCoins(lpToken)))
+
+	resp, err := keeper.NewMsgServerImpl(k).RemoveLiquidity(ctx, &types.MsgRemoveLiquidity{
+		Creator: signer.String(),
+		Lptoken: lpToken,
+	})
+	require.NoError(t, err)
+
+	// 10% of the 100000 abc share, the bond denom is not taxed
+	require.Equal(t, sdk.NewInt64Coin("abc", 90_000), resp.Base)
+	require.Equal(t, sdk.NewInt64Coin("abc", 10_000), *resp.BaseTax)
+	require.Equal(t, sdk.NewInt64Coin(constants.BondDenom, 100_000), resp.Quote)
+	require.Nil(t, resp.QuoteTax)
+
+	require.Equal(t, resp.Base, bankKeeper.GetBalance(ctx, signer, "abc"))
+	require.Equal(t, *resp.BaseTax, bankKeeper.GetBalance(ctx, treasury, "abc"))
+
+	// the pool is debited the whole share
+	stored, found := k.GetPool(ctx, pool.PoolId)
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewInt(900_000), stored.Coins[0].Amount)
+	require.Equal(t, sdk.NewInt64Coin("abc", 900_000), bankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(pool.Address), "abc"))
+}
+
+func TestTransferTax_NoTaxKeeper(t *testing.T) {
+	// Test case: without a transfer tax keeper the quotes carry no tax
+
+	k, ctx, _, pool := setupBuybackPool(t, 1_000_000, 0)
+
+	quote, err := keeper.NewQueryServerImpl(k).SwapIn(ctx, &types.QuerySwapInRequest{
+		PoolId: pool.PoolId,
+		CoinIn: sdk.NewInt64Coin(constants.BondDenom, 10_000).String(),
+	})
+	require.NoError(t, err)
+	require.Nil(t, quote.IncomingTax)
+	require.Nil(t, quote.OutgoingTax)
+}
+
+// Negative test cases
+
+func TestTransferTax_SwapIn_InvalidAddress(t *testing.T) {
+	// Test case: the quote fails for an invalid trader address
+
+	k, ctx, _, pool := setupBuybackPool(t, 1_000_000, 0)
+
+	_, err := keeper.NewQueryServerImpl(k).SwapIn(ctx, &types.QuerySwapInRequest{
+		PoolId:  pool.PoolId,
+		CoinIn:  sdk.NewInt64Coin(constants.BondDenom, 10_000).String(),
+		Address: "invalid",
+	})
+	require.ErrorContains(t, err, "invalid address")
+}
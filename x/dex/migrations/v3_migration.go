@@ -0,0 +1,9 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (m Migrator) V3Migration(ctx sdk.Context) error {
+	return m.keeper.V3Migration(ctx)
+}
@@ -0,0 +1,9 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (m Migrator) V4Migration(ctx sdk.Context) error {
+	return m.keeper.V4Migration(ctx)
+}
@@ -10,7 +10,8 @@ import (
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Query: &autocliv1.ServiceCommandDescriptor{
-			Service: modulev1.Query_ServiceDesc.ServiceName,
+			Service:              modulev1.Query_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // merges the hand-written quote command
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod: "Params",
@@ -24,6 +25,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "List all pools",
 					Example:   "  zigchaind query dex list-pool --chain-id zigchain",
 				},
+				{
+					RpcMethod: "ListPoolsByDenom",
+					Use:       "list-pools-by-denom [denom]",
+					Short:     "List the pools having a denom as base or quote",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind query dex list-pools-by-denom uzig --chain-id zigchain",
+				},
+				{
+					RpcMethod: "ListPoolsByCreator",
+					Use:       "list-pools-by-creator [creator]",
+					Short:     "List the pools created by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "creator"},
+					},
+					Example: "  zigchaind query dex list-pools-by-creator zig1ajg7jku4crf46lcskykwvkjrwfj7zan98az4k2 --chain-id zigchain",
+				},
 				{
 					RpcMethod: "GetPool",
 					Use:       "get-pool [pool-id]",
@@ -89,6 +108,23 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					Example: "  zigchaind query dex swap-out zp1 100coin.zig1ajg7jku4crf46lcskykwvkjrwfj7zan98az4k2.abc --chain-id zigchain",
 				},
+				{
+					RpcMethod: "GetDynamicFee",
+					Use:       "get-dynamic-fee [pool-id]",
+					Short:     "Gets the dynamic fee configuration, tracked volatility and effective fee of a pool",
+					Alias:     []string{"show-dynamic-fee", "dynamic-fee"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "pool_id"},
+					},
+					Example: "  zigchaind query dex get-dynamic-fee zp1 --chain-id zigchain",
+				},
+				{
+					RpcMethod: "GetBuybackInfo",
+					Use:       "get-buyback-info",
+					Short:     "Gets the buyback configuration and the totals sold, burned and sent to the community pool",
+					Alias:     []string{"show-buyback-info", "buyback-info"},
+					Example:   "  zigchaind query dex get-buyback-info --chain-id zigchain",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -100,6 +136,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "UpdateDynamicFeeConfig",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "UpdateBuybackConfig",
+					Skip:      true, // skipped because authority gated
+				},
 				{
 					RpcMethod: "CreatePool",
 					Use:       "create-pool [base] [quote] --receiver (optional)",
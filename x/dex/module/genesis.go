@@ -21,6 +21,17 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.PoolUidsList {
 		k.SetPoolUids(ctx, elem)
 	}
+	// Set all the dynamicFeeConfig
+	for _, elem := range genState.DynamicFeeConfigList {
+		k.SetDynamicFeeConfig(ctx, elem)
+	}
+	// Set all the poolVolatility
+	for _, elem := range genState.PoolVolatilityList {
+		k.SetPoolVolatility(ctx, elem)
+	}
+	// Set the buyback config and totals
+	k.SetBuybackConfig(ctx, genState.BuybackConfig)
+	k.SetBuybackStats(ctx, genState.BuybackStats)
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -39,6 +50,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.PoolsMeta = &poolsMeta
 	}
 	genesis.PoolUidsList = k.GetAllPoolUids(ctx)
+	genesis.DynamicFeeConfigList = k.GetAllDynamicFeeConfig(ctx)
+	genesis.PoolVolatilityList = k.GetAllPoolVolatility(ctx)
+	genesis.BuybackConfig = k.GetBuybackConfig(ctx)
+	genesis.BuybackStats = k.GetBuybackStats(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -5,6 +5,7 @@ import (
 	// nosem: math-random-used
 	"math/rand" // checked: we use math/rand to generate random numbers predictably
 	// so we can reproduce the same results in tests
+	"zigchain/zutils/constants"
 	ztests "zigchain/zutils/tests"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -100,9 +101,7 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 			// #nosec G115
 			NewPoolFeePct: uint32(simState.Rand.Int31n(50_000)),
 			// CreationFee is a random number between 0 and 1_000_000 of the native token
-			// cast to uint32 is safe, because the random number is always positive
-			// #nosec G115
-			CreationFee: uint32(simState.Rand.Int31n(1_000_000)),
+			CreationFee: sdk.NewInt64Coin(constants.BondDenom, simState.Rand.Int63n(1_000_000)),
 			// Beneficiary
 			Beneficiary: "",
 		},
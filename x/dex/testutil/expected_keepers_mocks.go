@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -283,6 +284,84 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), arg0, arg1)
 }
 
+// MockDistributionKeeper is a mock of DistributionKeeper interface.
+type MockDistributionKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDistributionKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockDistributionKeeperMockRecorder is the mock recorder for MockDistributionKeeper.
+type MockDistributionKeeperMockRecorder struct {
+	mock *MockDistributionKeeper
+}
+
+// NewMockDistributionKeeper creates a new mock instance.
+func NewMockDistributionKeeper(ctrl *gomock.Controller) *MockDistributionKeeper {
+	mock := &MockDistributionKeeper{ctrl: ctrl}
+	mock.recorder = &MockDistributionKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDistributionKeeper) EXPECT() *MockDistributionKeeperMockRecorder {
+	return m.recorder
+}
+
+// FundCommunityPool mocks base method.
+func (m *MockDistributionKeeper) FundCommunityPool(ctx context.Context, amount types.Coins, sender types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FundCommunityPool", ctx, amount, sender)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FundCommunityPool indicates an expected call of FundCommunityPool.
+func (mr *MockDistributionKeeperMockRecorder) FundCommunityPool(ctx, amount, sender any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FundCommunityPool", reflect.TypeOf((*MockDistributionKeeper)(nil).FundCommunityPool), ctx, amount, sender)
+}
+
+// MockTransferTaxKeeper is a mock of TransferTaxKeeper interface.
+type MockTransferTaxKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferTaxKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockTransferTaxKeeperMockRecorder is the mock recorder for MockTransferTaxKeeper.
+type MockTransferTaxKeeperMockRecorder struct {
+	mock *MockTransferTaxKeeper
+}
+
+// NewMockTransferTaxKeeper creates a new mock instance.
+func NewMockTransferTaxKeeper(ctrl *gomock.Controller) *MockTransferTaxKeeper {
+	mock := &MockTransferTaxKeeper{ctrl: ctrl}
+	mock.recorder = &MockTransferTaxKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferTaxKeeper) EXPECT() *MockTransferTaxKeeperMockRecorder {
+	return m.recorder
+}
+
+// TransferTaxRate mocks base method.
+func (m *MockTransferTaxKeeper) TransferTaxRate(ctx context.Context, denom string, fromAddr, toAddr types.AccAddress) (math.LegacyDec, types.AccAddress, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferTaxRate", ctx, denom, fromAddr, toAddr)
+	ret0, _ := ret[0].(math.LegacyDec)
+	ret1, _ := ret[1].(types.AccAddress)
+	ret2, _ := ret[2].(bool)
+	return ret0, ret1, ret2
+}
+
+// TransferTaxRate indicates an expected call of TransferTaxRate.
+func (mr *MockTransferTaxKeeperMockRecorder) TransferTaxRate(ctx, denom, fromAddr, toAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferTaxRate", reflect.TypeOf((*MockTransferTaxKeeper)(nil).TransferTaxRate), ctx, denom, fromAddr, toAddr)
+}
+
 // MockParamSubspace is a mock of ParamSubspace interface.
 type MockParamSubspace struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"zigchain/zutils/constants"
+)
+
+const (
+	// DefaultBuybackIntervalBlocks is the number of blocks between two buyback runs
+	DefaultBuybackIntervalBlocks uint64 = 100
+
+	// DefaultBuybackMaxSlippage is the maximum price impact of a buyback swap (1%)
+	DefaultBuybackMaxSlippage uint32 = 100
+
+	// DefaultBuybackMaxSwapsPerRun bounds the number of swaps of a single buyback run
+	DefaultBuybackMaxSwapsPerRun uint32 = 10
+)
+
+// BuybackSourceModules are the module accounts the buyback can sell from, they only hold collected fees.
+// Module accounts also holding user funds or token supply, such as the factory or dex ones, can not be sources.
+var BuybackSourceModules = []string{authtypes.FeeCollectorName}
+
+// DefaultBuybackConfig returns the buyback config used until governance sets one, the job is disabled
+func DefaultBuybackConfig() BuybackConfig {
+	return BuybackConfig{
+		Enabled:        false,
+		IntervalBlocks: DefaultBuybackIntervalBlocks,
+		MaxSlippage:    DefaultBuybackMaxSlippage,
+		SourceModules:  []string{authtypes.FeeCollectorName},
+		Destination:    BUYBACK_DESTINATION_BURN,
+		MaxSwapsPerRun: DefaultBuybackMaxSwapsPerRun,
+	}
+}
+
+// DefaultBuybackStats returns empty buyback totals
+func DefaultBuybackStats() BuybackStats {
+	return BuybackStats{
+		TotalSold:            sdk.Coins{},
+		TotalBurned:          sdk.NewInt64Coin(constants.BondDenom, 0),
+		TotalToCommunityPool: sdk.NewInt64Coin(constants.BondDenom, 0),
+	}
+}
+
+// Validate checks if a buyback config is valid
+func (c BuybackConfig) Validate() error {
+
+	if c.IntervalBlocks == 0 {
+		return errorsmod.Wrap(ErrInvalidBuybackConfig, "interval_blocks cannot be zero")
+	}
+
+	if c.MaxSlippage == 0 || c.MaxSlippage >= BasisPoints {
+		return errorsmod.Wrapf(
+			ErrInvalidBuybackConfig,
+			"max_slippage (%d) has to be between 1 and %d basis points",
+			c.MaxSlippage,
+			BasisPoints-1,
+		)
+	}
+
+	if c.MaxSwapsPerRun == 0 {
+		return errorsmod.Wrap(ErrInvalidBuybackConfig, "max_swaps_per_run cannot be zero")
+	}
+
+	if _, ok := BuybackDestination_name[int32(c.Destination)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidBuybackConfig, "unknown destination (%d)", c.Destination)
+	}
+
+	seen := make(map[string]struct{}, len(c.SourceModules))
+	for _, moduleName := range c.SourceModules {
+		if moduleName == "" {
+			return errorsmod.Wrap(ErrInvalidBuybackConfig, "source module cannot be empty")
+		}
+		if !slices.Contains(BuybackSourceModules, moduleName) {
+			return errorsmod.Wrapf(
+				ErrInvalidBuybackConfig,
+				"%s module account cannot be a source module, allowed: %v",
+				moduleName,
+				BuybackSourceModules,
+			)
+		}
+		if _, ok := seen[moduleName]; ok {
+			return errorsmod.Wrapf(ErrInvalidBuybackConfig, "duplicated source module (%s)", moduleName)
+		}
+		seen[moduleName] = struct{}{}
+	}
+
+	return nil
+}
+
+// Validate checks if the buyback totals are valid
+func (s BuybackStats) Validate() error {
+
+	if !s.TotalSold.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidBuybackConfig, "invalid total_sold (%s)", s.TotalSold)
+	}
+
+	for _, total := range []sdk.Coin{s.TotalBurned, s.TotalToCommunityPool} {
+		if total.Denom != constants.BondDenom || total.Amount.IsNil() || total.IsNegative() {
+			return errorsmod.Wrapf(
+				ErrInvalidBuybackConfig,
+				"invalid buyback total (%s), has to be a non-negative %s amount",
+				total,
+				constants.BondDenom,
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,937 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zigchain/dex/buyback.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BuybackDestination selects what happens to the uzig bought back
+type BuybackDestination int32
+
+const (
+	// BUYBACK_DESTINATION_BURN burns the bought uzig
+	BUYBACK_DESTINATION_BURN BuybackDestination = 0
+	// BUYBACK_DESTINATION_COMMUNITY_POOL sends the bought uzig to the community
+	// pool
+	BUYBACK_DESTINATION_COMMUNITY_POOL BuybackDestination = 1
+)
+
+var BuybackDestination_name = map[int32]string{
+	0: "BUYBACK_DESTINATION_BURN",
+	1: "BUYBACK_DESTINATION_COMMUNITY_POOL",
+}
+
+var BuybackDestination_value = map[string]int32{
+	"BUYBACK_DESTINATION_BURN":           0,
+	"BUYBACK_DESTINATION_COMMUNITY_POOL": 1,
+}
+
+func (x BuybackDestination) String() string {
+	return proto.EnumName(BuybackDestination_name, int32(x))
+}
+
+func (BuybackDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6b3565032592406d, []int{0}
+}
+
+// BuybackConfig holds the governable settings of the periodic buyback job,
+// which swaps the non-uzig balances of the source module accounts into uzig
+// through the dex pools
+type BuybackConfig struct {
+	// enabled switches the buyback job on
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// interval_blocks is the number of blocks between two buyback runs
+	IntervalBlocks uint64 `protobuf:"varint,2,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// max_slippage is the maximum price impact of a single swap in basis
+	// points, larger balances are sold in parts over several runs
+	MaxSlippage uint32 `protobuf:"varint,3,opt,name=max_slippage,json=maxSlippage,proto3" json:"max_slippage,omitempty"`
+	// source_modules are the fee collecting module accounts whose balances are
+	// sold, only the fee_collector account is allowed
+	SourceModules []string `protobuf:"bytes,4,rep,name=source_modules,json=sourceModules,proto3" json:"source_modules,omitempty"`
+	// destination selects whether the bought uzig is burned or sent to the
+	// community pool
+	Destination BuybackDestination `protobuf:"varint,5,opt,name=destination,proto3,enum=zigchain.dex.BuybackDestination" json:"destination,omitempty"`
+	// max_swaps_per_run bounds the number of swaps executed in a single run
+	MaxSwapsPerRun uint32 `protobuf:"varint,6,opt,name=max_swaps_per_run,json=maxSwapsPerRun,proto3" json:"max_swaps_per_run,omitempty"`
+}
+
+func (m *BuybackConfig) Reset()         { *m = BuybackConfig{} }
+func (m *BuybackConfig) String() string { return proto.CompactTextString(m) }
+func (*BuybackConfig) ProtoMessage()    {}
+func (*BuybackConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3565032592406d, []int{0}
+}
+func (m *BuybackConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuybackConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuybackConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BuybackConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuybackConfig.Merge(m, src)
+}
+func (m *BuybackConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuybackConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuybackConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuybackConfig proto.InternalMessageInfo
+
+func (m *BuybackConfig) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *BuybackConfig) GetIntervalBlocks() uint64 {
+	if m != nil {
+		return m.IntervalBlocks
+	}
+	return 0
+}
+
+func (m *BuybackConfig) GetMaxSlippage() uint32 {
+	if m != nil {
+		return m.MaxSlippage
+	}
+	return 0
+}
+
+func (m *BuybackConfig) GetSourceModules() []string {
+	if m != nil {
+		return m.SourceModules
+	}
+	return nil
+}
+
+func (m *BuybackConfig) GetDestination() BuybackDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return BUYBACK_DESTINATION_BURN
+}
+
+func (m *BuybackConfig) GetMaxSwapsPerRun() uint32 {
+	if m != nil {
+		return m.MaxSwapsPerRun
+	}
+	return 0
+}
+
+// BuybackStats holds the cumulative totals of the buyback job
+type BuybackStats struct {
+	// total_sold is the cumulative amount of fee tokens sold
+	TotalSold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total_sold,json=totalSold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_sold"`
+	// total_burned is the cumulative amount of uzig bought back and burned
+	TotalBurned types.Coin `protobuf:"bytes,2,opt,name=total_burned,json=totalBurned,proto3" json:"total_burned"`
+	// total_to_community_pool is the cumulative amount of uzig bought back and
+	// sent to the community pool
+	TotalToCommunityPool types.Coin `protobuf:"bytes,3,opt,name=total_to_community_pool,json=totalToCommunityPool,proto3" json:"total_to_community_pool"`
+	// run_count is the number of runs that bought back uzig
+	RunCount uint64 `protobuf:"varint,4,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
+	// last_run_height is the block height of the last run that bought back uzig
+	LastRunHeight int64 `protobuf:"varint,5,opt,name=last_run_height,json=lastRunHeight,proto3" json:"last_run_height,omitempty"`
+}
+
+func (m *BuybackStats) Reset()         { *m = BuybackStats{} }
+func (m *BuybackStats) String() string { return proto.CompactTextString(m) }
+func (*BuybackStats) ProtoMessage()    {}
+func (*BuybackStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b3565032592406d, []int{1}
+}
+func (m *BuybackStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuybackStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuybackStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BuybackStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuybackStats.Merge(m, src)
+}
+func (m *BuybackStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuybackStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuybackStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuybackStats proto.InternalMessageInfo
+
+func (m *BuybackStats) GetTotalSold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalSold
+	}
+	return nil
+}
+
+func (m *BuybackStats) GetTotalBurned() types.Coin {
+	if m != nil {
+		return m.TotalBurned
+	}
+	return types.Coin{}
+}
+
+func (m *BuybackStats) GetTotalToCommunityPool() types.Coin {
+	if m != nil {
+		return m.TotalToCommunityPool
+	}
+	return types.Coin{}
+}
+
+func (m *BuybackStats) GetRunCount() uint64 {
+	if m != nil {
+		return m.RunCount
+	}
+	return 0
+}
+
+func (m *BuybackStats) GetLastRunHeight() int64 {
+	if m != nil {
+		return m.LastRunHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("zigchain.dex.BuybackDestination", BuybackDestination_name, BuybackDestination_value)
+	proto.RegisterType((*BuybackConfig)(nil), "zigchain.dex.BuybackConfig")
+	proto.RegisterType((*BuybackStats)(nil), "zigchain.dex.BuybackStats")
+}
+
+func init() { proto.RegisterFile("zigchain/dex/buyback.proto", fileDescriptor_6b3565032592406d) }
+
+var fileDescriptor_6b3565032592406d = []byte{
+	// 594 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x9b, 0x50, 0x9a, 0xcd, 0x4f, 0xdb, 0x55, 0x25, 0x4c, 0x40, 0x6e, 0xa8, 0x44, 0x31,
+	0x95, 0xb0, 0xd5, 0x22, 0x1e, 0xa0, 0x4e, 0x11, 0x54, 0xd0, 0xa4, 0x72, 0xd2, 0x43, 0xe1, 0x60,
+	0xd6, 0xf6, 0xe2, 0xac, 0x6a, 0xef, 0x58, 0xde, 0x75, 0x49, 0x79, 0x02, 0x24, 0x2e, 0xbc, 0x03,
+	0x17, 0xc4, 0x89, 0xc7, 0xe8, 0xb1, 0x47, 0x4e, 0x80, 0xda, 0x03, 0x12, 0x4f, 0x81, 0xbc, 0x4e,
+	0xa0, 0x12, 0x08, 0x89, 0x8b, 0xbd, 0xfb, 0xcd, 0xcc, 0xf7, 0x8d, 0xbe, 0xd9, 0x41, 0x9d, 0xd7,
+	0x2c, 0x0a, 0xc6, 0x84, 0x71, 0x3b, 0xa4, 0x13, 0xdb, 0xcf, 0x4f, 0x7c, 0x12, 0x1c, 0x59, 0x69,
+	0x06, 0x12, 0x70, 0x73, 0x16, 0xb3, 0x42, 0x3a, 0xe9, 0x2c, 0x93, 0x84, 0x71, 0xb0, 0xd5, 0xb7,
+	0x4c, 0xe8, 0x18, 0x01, 0x88, 0x04, 0x84, 0xed, 0x13, 0x41, 0xed, 0xe3, 0x4d, 0x9f, 0x4a, 0xb2,
+	0x69, 0x07, 0xc0, 0xf8, 0x34, 0xbe, 0x12, 0x41, 0x04, 0xea, 0x68, 0x17, 0xa7, 0x12, 0x5d, 0x7b,
+	0x3b, 0x87, 0x5a, 0x4e, 0x29, 0xd4, 0x03, 0xfe, 0x92, 0x45, 0x58, 0x47, 0x57, 0x29, 0x27, 0x7e,
+	0x4c, 0x43, 0x5d, 0xeb, 0x6a, 0xe6, 0x82, 0x3b, 0xbb, 0xe2, 0x3b, 0x68, 0x91, 0x71, 0x49, 0xb3,
+	0x63, 0x12, 0x7b, 0x7e, 0x0c, 0xc1, 0x91, 0xd0, 0xe7, 0xba, 0x9a, 0x59, 0x73, 0xdb, 0x33, 0xd8,
+	0x51, 0x28, 0xbe, 0x85, 0x9a, 0x09, 0x99, 0x78, 0x22, 0x66, 0x69, 0x4a, 0x22, 0xaa, 0x57, 0xbb,
+	0x9a, 0xd9, 0x72, 0x1b, 0x09, 0x99, 0x0c, 0xa7, 0x10, 0xbe, 0x8d, 0xda, 0x02, 0xf2, 0x2c, 0xa0,
+	0x5e, 0x02, 0x61, 0x1e, 0x53, 0xa1, 0xd7, 0xba, 0x55, 0xb3, 0xee, 0xb6, 0x4a, 0x74, 0xaf, 0x04,
+	0xb1, 0x83, 0x1a, 0x21, 0x15, 0x92, 0x71, 0x22, 0x19, 0x70, 0xfd, 0x4a, 0x57, 0x33, 0xdb, 0x5b,
+	0x5d, 0xeb, 0xb2, 0x17, 0xd6, 0xb4, 0xfd, 0x9d, 0xdf, 0x79, 0xee, 0xe5, 0x22, 0x7c, 0x17, 0x2d,
+	0xab, 0x6e, 0x5e, 0x91, 0x54, 0x78, 0x29, 0xcd, 0xbc, 0x2c, 0xe7, 0xfa, 0xbc, 0x6a, 0xa9, 0x5d,
+	0xb4, 0x54, 0xe0, 0xfb, 0x34, 0x73, 0x73, 0xbe, 0xf6, 0x63, 0x0e, 0x35, 0xa7, 0x74, 0x43, 0x49,
+	0xa4, 0xc0, 0x80, 0x90, 0x04, 0x49, 0x62, 0x4f, 0x40, 0x5c, 0xf8, 0x51, 0x35, 0x1b, 0x5b, 0xd7,
+	0xad, 0xd2, 0x69, 0xab, 0x70, 0xda, 0x9a, 0x3a, 0x6d, 0xf5, 0x80, 0x71, 0xe7, 0xc1, 0xe9, 0x97,
+	0xd5, 0xca, 0xc7, 0xaf, 0xab, 0x66, 0xc4, 0xe4, 0x38, 0xf7, 0xad, 0x00, 0x12, 0x7b, 0x3a, 0x96,
+	0xf2, 0x77, 0x4f, 0x84, 0x47, 0xb6, 0x3c, 0x49, 0xa9, 0x50, 0x05, 0xe2, 0xc3, 0xf7, 0x4f, 0x1b,
+	0x9a, 0x5b, 0x57, 0x1a, 0x43, 0x88, 0x43, 0xfc, 0x08, 0x35, 0x4b, 0x41, 0x3f, 0xcf, 0x38, 0x0d,
+	0x95, 0xc1, 0xff, 0x94, 0xac, 0x17, 0x92, 0x25, 0x4d, 0x43, 0x55, 0x3a, 0xaa, 0x10, 0x3f, 0x47,
+	0xd7, 0x4a, 0x22, 0x09, 0x5e, 0x00, 0x49, 0x92, 0x73, 0x26, 0x4f, 0xbc, 0x14, 0x20, 0xd6, 0xab,
+	0xff, 0xc1, 0xb9, 0xa2, 0x48, 0x46, 0xd0, 0x9b, 0x51, 0xec, 0x03, 0xc4, 0xf8, 0x06, 0xaa, 0x67,
+	0x39, 0xf7, 0x02, 0xc8, 0xb9, 0xd4, 0x6b, 0xea, 0x0d, 0x2c, 0x64, 0x39, 0xef, 0x15, 0x77, 0xbc,
+	0x8e, 0x16, 0x63, 0x22, 0x64, 0x61, 0xb3, 0x37, 0xa6, 0x2c, 0x1a, 0x4b, 0x35, 0xb7, 0xaa, 0xdb,
+	0x2a, 0x60, 0x37, 0xe7, 0x8f, 0x15, 0xb8, 0xf1, 0x02, 0xe1, 0x3f, 0x47, 0x87, 0x6f, 0x22, 0xdd,
+	0x39, 0x38, 0x74, 0xb6, 0x7b, 0x4f, 0xbc, 0x9d, 0x87, 0xc3, 0xd1, 0x6e, 0x7f, 0x7b, 0xb4, 0x3b,
+	0xe8, 0x7b, 0xce, 0x81, 0xdb, 0x5f, 0xaa, 0xe0, 0x75, 0xb4, 0xf6, 0xb7, 0x68, 0x6f, 0xb0, 0xb7,
+	0x77, 0xd0, 0xdf, 0x1d, 0x1d, 0x7a, 0xfb, 0x83, 0xc1, 0xd3, 0x25, 0xad, 0x53, 0x7b, 0xf3, 0xde,
+	0xa8, 0x38, 0xd6, 0xe9, 0xb9, 0xa1, 0x9d, 0x9d, 0x1b, 0xda, 0xb7, 0x73, 0x43, 0x7b, 0x77, 0x61,
+	0x54, 0xce, 0x2e, 0x8c, 0xca, 0xe7, 0x0b, 0xa3, 0xf2, 0x6c, 0xe5, 0xd7, 0xa6, 0x4d, 0xd4, 0xae,
+	0xa9, 0x91, 0xf8, 0xf3, 0x6a, 0x27, 0xee, 0xff, 0x1c, 0x00, 0xa1, 0x1a, 0x8c, 0x66, 0x88, 0x03,
+	0x00, 0x00,
+}
+
+func (m *BuybackConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuybackConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuybackConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSwapsPerRun != 0 {
+		i = encodeVarintBuyback(dAtA, i, uint64(m.MaxSwapsPerRun))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Destination != 0 {
+		i = encodeVarintBuyback(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SourceModules) > 0 {
+		for iNdEx := len(m.SourceModules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SourceModules[iNdEx])
+			copy(dAtA[i:], m.SourceModules[iNdEx])
+			i = encodeVarintBuyback(dAtA, i, uint64(len(m.SourceModules[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxSlippage != 0 {
+		i = encodeVarintBuyback(dAtA, i, uint64(m.MaxSlippage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintBuyback(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuybackStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuybackStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuybackStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastRunHeight != 0 {
+		i = encodeVarintBuyback(dAtA, i, uint64(m.LastRunHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RunCount != 0 {
+		i = encodeVarintBuyback(dAtA, i, uint64(m.RunCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.TotalToCommunityPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBuyback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TotalBurned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBuyback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TotalSold) > 0 {
+		for iNdEx := len(m.TotalSold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalSold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBuyback(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBuyback(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBuyback(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BuybackConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovBuyback(uint64(m.IntervalBlocks))
+	}
+	if m.MaxSlippage != 0 {
+		n += 1 + sovBuyback(uint64(m.MaxSlippage))
+	}
+	if len(m.SourceModules) > 0 {
+		for _, s := range m.SourceModules {
+			l = len(s)
+			n += 1 + l + sovBuyback(uint64(l))
+		}
+	}
+	if m.Destination != 0 {
+		n += 1 + sovBuyback(uint64(m.Destination))
+	}
+	if m.MaxSwapsPerRun != 0 {
+		n += 1 + sovBuyback(uint64(m.MaxSwapsPerRun))
+	}
+	return n
+}
+
+func (m *BuybackStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalSold) > 0 {
+		for _, e := range m.TotalSold {
+			l = e.Size()
+			n += 1 + l + sovBuyback(uint64(l))
+		}
+	}
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovBuyback(uint64(l))
+	l = m.TotalToCommunityPool.Size()
+	n += 1 + l + sovBuyback(uint64(l))
+	if m.RunCount != 0 {
+		n += 1 + sovBuyback(uint64(m.RunCount))
+	}
+	if m.LastRunHeight != 0 {
+		n += 1 + sovBuyback(uint64(m.LastRunHeight))
+	}
+	return n
+}
+
+func sovBuyback(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBuyback(x uint64) (n int) {
+	return sovBuyback(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BuybackConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBuyback
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuybackConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuybackConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+			}
+			m.IntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			m.MaxSlippage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSlippage |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceModules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceModules = append(m.SourceModules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= BuybackDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapsPerRun", wireType)
+			}
+			m.MaxSwapsPerRun = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSwapsPerRun |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBuyback(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuybackStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBuyback
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuybackStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuybackStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSold = append(m.TotalSold, types.Coin{})
+			if err := m.TotalSold[len(m.TotalSold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalToCommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalToCommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunCount", wireType)
+			}
+			m.RunCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRunHeight", wireType)
+			}
+			m.LastRunHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRunHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBuyback(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBuyback
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBuyback(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBuyback
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBuyback
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBuyback
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBuyback
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBuyback
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBuyback        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBuyback          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBuyback = fmt.Errorf("proto: unexpected end of group")
+)
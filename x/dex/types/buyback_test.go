@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"zigchain/x/dex/types"
+)
+
+func TestBuybackConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *types.BuybackConfig)
+		err    bool
+	}{
+		{name: "valid", modify: func(c *types.BuybackConfig) {}},
+		{name: "no source modules", modify: func(c *types.BuybackConfig) { c.SourceModules = nil }},
+		{name: "zero interval", modify: func(c *types.BuybackConfig) { c.IntervalBlocks = 0 }, err: true},
+		{name: "zero max slippage", modify: func(c *types.BuybackConfig) { c.MaxSlippage = 0 }, err: true},
+		{name: "max slippage too large", modify: func(c *types.BuybackConfig) { c.MaxSlippage = types.BasisPoints }, err: true},
+		{name: "zero max swaps", modify: func(c *types.BuybackConfig) { c.MaxSwapsPerRun = 0 }, err: true},
+		{name: "unknown destination", modify: func(c *types.BuybackConfig) { c.Destination = 5 }, err: true},
+		{name: "empty source module", modify: func(c *types.BuybackConfig) { c.SourceModules = []string{""} }, err: true},
+		{name: "dex source module", modify: func(c *types.BuybackConfig) { c.SourceModules = []string{types.ModuleName} }, err: true},
+		{name: "factory source module", modify: func(c *types.BuybackConfig) { c.SourceModules = []string{"factory"} }, err: true},
+		{name: "duplicated source module", modify: func(c *types.BuybackConfig) {
+			c.SourceModules = []string{authtypes.FeeCollectorName, authtypes.FeeCollectorName}
+		}, err: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config := types.DefaultBuybackConfig()
+			tc.modify(&config)
+			err := config.Validate()
+			if tc.err {
+				require.ErrorIs(t, err, types.ErrInvalidBuybackConfig)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBuybackStats_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(s *types.BuybackStats)
+		err    bool
+	}{
+		{name: "valid", modify: func(s *types.BuybackStats) {}},
+		{name: "wrong burned denom", modify: func(s *types.BuybackStats) { s.TotalBurned = sdk.NewInt64Coin("abc", 1) }, err: true},
+		{name: "nil community pool amount", modify: func(s *types.BuybackStats) { s.TotalToCommunityPool.Amount = math.Int{} }, err: true},
+		{name: "invalid total sold", modify: func(s *types.BuybackStats) { s.TotalSold = sdk.Coins{sdk.Coin{Denom: "abc", Amount: math.NewInt(-1)}} }, err: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stats := types.DefaultBuybackStats()
+			tc.modify(&stats)
+			err := stats.Validate()
+			if tc.err {
+				require.ErrorIs(t, err, types.ErrInvalidBuybackConfig)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
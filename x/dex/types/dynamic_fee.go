@@ -0,0 +1,118 @@
+package types
+
+import (
+	stdmath "math"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"zigchain/zutils/validators"
+)
+
+// DefaultDynamicFeeDecayWindow is the decay window used when no dynamic fee config is set
+const DefaultDynamicFeeDecayWindow uint64 = 600
+
+// Validate checks if a dynamic fee config is valid
+func (c DynamicFeeConfig) Validate() error {
+
+	if err := validators.CheckPoolId(c.PoolId); err != nil {
+		return err
+	}
+
+	if err := validateNewPoolFeePct(c.MinFee); err != nil {
+		return errorsmod.Wrap(err, "min_fee")
+	}
+
+	if err := validateNewPoolFeePct(c.MaxFee); err != nil {
+		return errorsmod.Wrap(err, "max_fee")
+	}
+
+	if c.MinFee > c.MaxFee {
+		return errorsmod.Wrapf(
+			ErrInvalidDynamicFeeConfig,
+			"min_fee (%d) cannot be greater than max_fee (%d)",
+			c.MinFee,
+			c.MaxFee,
+		)
+	}
+
+	if c.VolatilityCap == 0 {
+		return errorsmod.Wrap(ErrInvalidDynamicFeeConfig, "volatility_cap cannot be zero")
+	}
+
+	if c.DecayWindow == 0 {
+		return errorsmod.Wrap(ErrInvalidDynamicFeeConfig, "decay_window cannot be zero")
+	}
+
+	return nil
+}
+
+// DecayedVolatility returns the tracked volatility decayed linearly to the given height
+func (v PoolVolatility) DecayedVolatility(height int64, decayWindow uint64) uint64 {
+
+	if v.Volatility == 0 || height <= v.LastHeight {
+		return v.Volatility
+	}
+
+	// #nosec G115 -- height is always greater than last height here
+	elapsed := uint64(height - v.LastHeight)
+	if elapsed >= decayWindow {
+		return 0
+	}
+
+	return math.NewIntFromUint64(v.Volatility).
+		Mul(math.NewIntFromUint64(decayWindow - elapsed)).
+		Quo(math.NewIntFromUint64(decayWindow)).
+		Uint64()
+}
+
+// EffectiveFee returns the fee (per 100,000) for the given volatility, scaled
+// linearly between MinFee and MaxFee and capped at VolatilityCap
+func (c DynamicFeeConfig) EffectiveFee(volatility uint64) uint32 {
+
+	if volatility >= uint64(c.VolatilityCap) {
+		return c.MaxFee
+	}
+
+	// #nosec G115 -- result is bounded by MaxFee - MinFee as volatility < VolatilityCap
+	spread := uint32(uint64(c.MaxFee-c.MinFee) * volatility / uint64(c.VolatilityCap))
+
+	return c.MinFee + spread
+}
+
+// GetPoolPrice returns the spot price of the pool, expressed as the amount of
+// the second coin per unit of the first coin
+func GetPoolPrice(pool Pool) math.LegacyDec {
+	if len(pool.Coins) < 2 || !pool.Coins[0].Amount.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+
+	return pool.Coins[1].Amount.ToLegacyDec().Quo(pool.Coins[0].Amount.ToLegacyDec())
+}
+
+// GetPriceMovement returns the relative price movement between two prices, in basis points
+func GetPriceMovement(before math.LegacyDec, after math.LegacyDec) uint64 {
+	if !before.IsPositive() {
+		return 0
+	}
+
+	movement := after.Sub(before).Abs().MulInt64(BasisPoints).Quo(before).TruncateInt()
+	if !movement.IsUint64() {
+		return stdmath.MaxUint64
+	}
+
+	return movement.Uint64()
+}
+
+// DefaultDynamicFeeConfig returns a disabled dynamic fee config for the pool, using
+// the static fee as both floor and ceiling
+func DefaultDynamicFeeConfig(pool Pool) DynamicFeeConfig {
+	return DynamicFeeConfig{
+		PoolId:        pool.PoolId,
+		Enabled:       false,
+		MinFee:        pool.Fee,
+		MaxFee:        pool.Fee,
+		VolatilityCap: BasisPoints,
+		DecayWindow:   DefaultDynamicFeeDecayWindow,
+	}
+}
@@ -0,0 +1,759 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zigchain/dex/dynamic_fee.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DynamicFeeConfig holds the governable dynamic fee settings of a pool, when
+// enabled the swap fee scales between min_fee and max_fee based on the recent
+// price movement of the pool
+type DynamicFeeConfig struct {
+	PoolId string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// enabled switches the pool from its static fee to the dynamic fee
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// min_fee is the fee floor, applied when the pool is calm (per 100,000)
+	MinFee uint32 `protobuf:"varint,3,opt,name=min_fee,json=minFee,proto3" json:"min_fee,omitempty"`
+	// max_fee is the fee ceiling, applied when the volatility reaches
+	// volatility_cap (per 100,000)
+	MaxFee uint32 `protobuf:"varint,4,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
+	// volatility_cap is the accumulated price movement (in basis points) at
+	// which the fee reaches max_fee
+	VolatilityCap uint32 `protobuf:"varint,5,opt,name=volatility_cap,json=volatilityCap,proto3" json:"volatility_cap,omitempty"`
+	// decay_window is the number of blocks over which the tracked volatility
+	// decays linearly back to zero
+	DecayWindow uint64 `protobuf:"varint,6,opt,name=decay_window,json=decayWindow,proto3" json:"decay_window,omitempty"`
+}
+
+func (m *DynamicFeeConfig) Reset()         { *m = DynamicFeeConfig{} }
+func (m *DynamicFeeConfig) String() string { return proto.CompactTextString(m) }
+func (*DynamicFeeConfig) ProtoMessage()    {}
+func (*DynamicFeeConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaad9bed44c26722, []int{0}
+}
+func (m *DynamicFeeConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicFeeConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicFeeConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicFeeConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicFeeConfig.Merge(m, src)
+}
+func (m *DynamicFeeConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicFeeConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicFeeConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicFeeConfig proto.InternalMessageInfo
+
+func (m *DynamicFeeConfig) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *DynamicFeeConfig) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *DynamicFeeConfig) GetMinFee() uint32 {
+	if m != nil {
+		return m.MinFee
+	}
+	return 0
+}
+
+func (m *DynamicFeeConfig) GetMaxFee() uint32 {
+	if m != nil {
+		return m.MaxFee
+	}
+	return 0
+}
+
+func (m *DynamicFeeConfig) GetVolatilityCap() uint32 {
+	if m != nil {
+		return m.VolatilityCap
+	}
+	return 0
+}
+
+func (m *DynamicFeeConfig) GetDecayWindow() uint64 {
+	if m != nil {
+		return m.DecayWindow
+	}
+	return 0
+}
+
+// PoolVolatility tracks the recent price movement of a pool
+type PoolVolatility struct {
+	PoolId string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// volatility is the accumulated price movement in basis points, as of
+	// last_height
+	Volatility uint64 `protobuf:"varint,2,opt,name=volatility,proto3" json:"volatility,omitempty"`
+	// last_height is the block height of the last volatility update
+	LastHeight int64 `protobuf:"varint,3,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+}
+
+func (m *PoolVolatility) Reset()         { *m = PoolVolatility{} }
+func (m *PoolVolatility) String() string { return proto.CompactTextString(m) }
+func (*PoolVolatility) ProtoMessage()    {}
+func (*PoolVolatility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaad9bed44c26722, []int{1}
+}
+func (m *PoolVolatility) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolVolatility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolVolatility.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolVolatility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolatility.Merge(m, src)
+}
+func (m *PoolVolatility) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolVolatility) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolatility.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolVolatility proto.InternalMessageInfo
+
+func (m *PoolVolatility) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *PoolVolatility) GetVolatility() uint64 {
+	if m != nil {
+		return m.Volatility
+	}
+	return 0
+}
+
+func (m *PoolVolatility) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DynamicFeeConfig)(nil), "zigchain.dex.DynamicFeeConfig")
+	proto.RegisterType((*PoolVolatility)(nil), "zigchain.dex.PoolVolatility")
+}
+
+func init() { proto.RegisterFile("zigchain/dex/dynamic_fee.proto", fileDescriptor_eaad9bed44c26722) }
+
+var fileDescriptor_eaad9bed44c26722 = []byte{
+	// 297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xbd, 0x4e, 0xf3, 0x30,
+	0x14, 0x86, 0xeb, 0xaf, 0xfd, 0x52, 0x70, 0x7f, 0x84, 0x22, 0x24, 0x3c, 0x99, 0x50, 0x09, 0x29,
+	0x53, 0x3a, 0x70, 0x07, 0x14, 0x55, 0xb0, 0x21, 0x0f, 0x20, 0xb1, 0x44, 0x6e, 0x7c, 0x9a, 0x18,
+	0x39, 0x76, 0x44, 0x2d, 0x9a, 0x70, 0x15, 0xdc, 0x12, 0x1b, 0x63, 0x47, 0x46, 0x94, 0xdc, 0x08,
+	0x8a, 0xa1, 0x2d, 0x0b, 0xe3, 0x79, 0x1e, 0x1f, 0x1f, 0xbd, 0x2f, 0xa6, 0x2f, 0x32, 0x4d, 0x32,
+	0x2e, 0xf5, 0x54, 0x40, 0x39, 0x15, 0x95, 0xe6, 0xb9, 0x4c, 0xe2, 0x25, 0x40, 0x54, 0x3c, 0x19,
+	0x6b, 0xfc, 0xe1, 0xd6, 0x47, 0x02, 0xca, 0xc9, 0x1b, 0xc2, 0x47, 0x57, 0xdf, 0x6f, 0xe6, 0x00,
+	0x33, 0xa3, 0x97, 0x32, 0xf5, 0x4f, 0x70, 0xbf, 0x30, 0x46, 0xc5, 0x52, 0x10, 0x14, 0xa0, 0xf0,
+	0x90, 0x79, 0xed, 0x78, 0x23, 0x7c, 0x82, 0xfb, 0xa0, 0xf9, 0x42, 0x81, 0x20, 0xff, 0x02, 0x14,
+	0x1e, 0xb0, 0xed, 0xd8, 0xae, 0xe4, 0x52, 0xb7, 0x67, 0x48, 0x37, 0x40, 0xe1, 0x88, 0x79, 0xb9,
+	0xd4, 0x73, 0x00, 0x27, 0x78, 0xe9, 0x44, 0xef, 0x47, 0xf0, 0xb2, 0x15, 0xe7, 0x78, 0xfc, 0x6c,
+	0x14, 0xb7, 0x52, 0x49, 0x5b, 0xc5, 0x09, 0x2f, 0xc8, 0x7f, 0xe7, 0x47, 0x7b, 0x3a, 0xe3, 0x85,
+	0x7f, 0x86, 0x87, 0x02, 0x12, 0x5e, 0xc5, 0x6b, 0xa9, 0x85, 0x59, 0x13, 0x2f, 0x40, 0x61, 0x8f,
+	0x0d, 0x1c, 0xbb, 0x77, 0x68, 0xf2, 0x88, 0xc7, 0xb7, 0xc6, 0xa8, 0xbb, 0xdd, 0xde, 0xdf, 0x01,
+	0x28, 0xc6, 0xfb, 0xef, 0x5d, 0x86, 0x1e, 0xfb, 0x45, 0xfc, 0x53, 0x3c, 0x50, 0x7c, 0x65, 0xe3,
+	0x0c, 0x64, 0x9a, 0x59, 0x17, 0xa5, 0xcb, 0x70, 0x8b, 0xae, 0x1d, 0xb9, 0x8c, 0xde, 0x6b, 0x8a,
+	0x36, 0x35, 0x45, 0x9f, 0x35, 0x45, 0xaf, 0x0d, 0xed, 0x6c, 0x1a, 0xda, 0xf9, 0x68, 0x68, 0xe7,
+	0xe1, 0x78, 0xd7, 0x7b, 0xe9, 0x9a, 0xb7, 0x55, 0x01, 0xab, 0x85, 0xe7, 0x4a, 0xbf, 0xf8, 0x1a,
+	0x00, 0x80, 0x22, 0xc3, 0xb3, 0x96, 0x01, 0x00, 0x00,
+}
+
+func (m *DynamicFeeConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicFeeConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicFeeConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecayWindow != 0 {
+		i = encodeVarintDynamicFee(dAtA, i, uint64(m.DecayWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.VolatilityCap != 0 {
+		i = encodeVarintDynamicFee(dAtA, i, uint64(m.VolatilityCap))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxFee != 0 {
+		i = encodeVarintDynamicFee(dAtA, i, uint64(m.MaxFee))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinFee != 0 {
+		i = encodeVarintDynamicFee(dAtA, i, uint64(m.MinFee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintDynamicFee(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolVolatility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolVolatility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolVolatility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		i = encodeVarintDynamicFee(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Volatility != 0 {
+		i = encodeVarintDynamicFee(dAtA, i, uint64(m.Volatility))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintDynamicFee(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDynamicFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDynamicFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DynamicFeeConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovDynamicFee(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.MinFee != 0 {
+		n += 1 + sovDynamicFee(uint64(m.MinFee))
+	}
+	if m.MaxFee != 0 {
+		n += 1 + sovDynamicFee(uint64(m.MaxFee))
+	}
+	if m.VolatilityCap != 0 {
+		n += 1 + sovDynamicFee(uint64(m.VolatilityCap))
+	}
+	if m.DecayWindow != 0 {
+		n += 1 + sovDynamicFee(uint64(m.DecayWindow))
+	}
+	return n
+}
+
+func (m *PoolVolatility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovDynamicFee(uint64(l))
+	}
+	if m.Volatility != 0 {
+		n += 1 + sovDynamicFee(uint64(m.Volatility))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovDynamicFee(uint64(m.LastHeight))
+	}
+	return n
+}
+
+func sovDynamicFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDynamicFee(x uint64) (n int) {
+	return sovDynamicFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DynamicFeeConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDynamicFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicFeeConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicFeeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			m.MinFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinFee |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			m.MaxFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFee |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityCap", wireType)
+			}
+			m.VolatilityCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VolatilityCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayWindow", wireType)
+			}
+			m.DecayWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDynamicFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolVolatility) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDynamicFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolVolatility: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolVolatility: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
+			}
+			m.Volatility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volatility |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDynamicFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDynamicFee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDynamicFee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDynamicFee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDynamicFee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDynamicFee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDynamicFee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDynamicFee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDynamicFee = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"zigchain/x/dex/types"
+)
+
+func TestDynamicFeeConfig_Validate(t *testing.T) {
+	valid := types.DynamicFeeConfig{
+		PoolId:        "zp1",
+		Enabled:       true,
+		MinFee:        100,
+		MaxFee:        1000,
+		VolatilityCap: 500,
+		DecayWindow:   10,
+	}
+
+	tests := []struct {
+		name   string
+		modify func(c *types.DynamicFeeConfig)
+		err    bool
+	}{
+		{name: "valid", modify: func(c *types.DynamicFeeConfig) {}},
+		{name: "invalid pool id", modify: func(c *types.DynamicFeeConfig) { c.PoolId = "abc" }, err: true},
+		{name: "min above max", modify: func(c *types.DynamicFeeConfig) { c.MinFee = 2000 }, err: true},
+		{name: "max too large", modify: func(c *types.DynamicFeeConfig) { c.MaxFee = 100_000 }, err: true},
+		{name: "zero volatility cap", modify: func(c *types.DynamicFeeConfig) { c.VolatilityCap = 0 }, err: true},
+		{name: "zero decay window", modify: func(c *types.DynamicFeeConfig) { c.DecayWindow = 0 }, err: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config := valid
+			tc.modify(&config)
+			err := config.Validate()
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGetPriceMovement(t *testing.T) {
+	require.Equal(t, uint64(1000), types.GetPriceMovement(math.LegacyNewDec(2), math.LegacyMustNewDecFromStr("2.2")))
+	require.Equal(t, uint64(1000), types.GetPriceMovement(math.LegacyNewDec(2), math.LegacyMustNewDecFromStr("1.8")))
+	require.Equal(t, uint64(0), types.GetPriceMovement(math.LegacyZeroDec(), math.LegacyNewDec(1)))
+}
@@ -18,4 +18,7 @@ var (
 	ErrNonPositiveAmounts        = sdkerrors.Register(ModuleName, 1507, "on initial pool creation amounts must be positive")
 	ErrInsufficientLiquidityLock = sdkerrors.Register(ModuleName, 1508, "on initial pool creation lpAmount must be greater than minimal lock")
 	ErrInvalidPoolAddress        = sdkerrors.Register(ModuleName, 1509, "pool address does not match expected module address")
+	ErrInvalidDynamicFeeConfig   = sdkerrors.Register(ModuleName, 1510, "invalid dynamic fee config")
+	ErrInvalidBuybackConfig      = sdkerrors.Register(ModuleName, 1511, "invalid buyback config")
+	ErrBuybackSlippageExceeded   = sdkerrors.Register(ModuleName, 1512, "buyback swap exceeds max slippage")
 )
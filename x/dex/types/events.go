@@ -6,6 +6,10 @@ const (
 	EventTokenSwapped     = "token_swapped"
 	EventPoolCreated      = "pool_created"
 
+	EventDynamicFeeConfigUpdated = "dynamic_fee_config_updated"
+	EventBuybackConfigUpdated    = "buyback_config_updated"
+	EventBuybackExecuted         = "buyback_executed"
+
 	AttributeValueCategory    = ModuleName
 	AttributeKeyPoolId        = "pool_id"
 	AttributeKeySwapFee       = "swap_fee"
@@ -17,4 +21,20 @@ const (
 	AttributeKeyPoolState     = "pool_snapshot"
 	AttributeKeyReceiver      = "receiver"
 	AttributeKeyPoolAddress   = "pool_address"
+
+	AttributeKeyDynamicFeeEnabled = "enabled"
+	AttributeKeyMinFee            = "min_fee"
+	AttributeKeyMaxFee            = "max_fee"
+	AttributeKeyVolatilityCap     = "volatility_cap"
+	AttributeKeyDecayWindow       = "decay_window"
+
+	AttributeKeyBuybackEnabled   = "enabled"
+	AttributeKeyIntervalBlocks   = "interval_blocks"
+	AttributeKeyMaxSlippage      = "max_slippage"
+	AttributeKeySourceModules    = "source_modules"
+	AttributeKeyDestination      = "destination"
+	AttributeKeyMaxSwapsPerRun   = "max_swaps_per_run"
+	AttributeKeyTokensSold       = "tokens_sold"
+	AttributeKeyAmountBought     = "amount_bought"
+	AttributeKeyBuybackSwapCount = "swap_count"
 )
@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -38,6 +39,17 @@ type BankKeeper interface {
 	SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
+// DistributionKeeper defines the expected interface for the Distribution module.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// TransferTaxKeeper defines the expected interface of the keeper taxing the transfers of the factory denoms.
+type TransferTaxKeeper interface {
+	// TransferTaxRate returns the tax rate of a denom and its recipient when its transfers from and to the addresses are taxed
+	TransferTaxRate(ctx context.Context, denom string, fromAddr, toAddr sdk.AccAddress) (math.LegacyDec, sdk.AccAddress, bool)
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
@@ -17,6 +17,11 @@ func DefaultGenesis() *GenesisState {
 		PoolList:     []Pool{},
 		PoolsMeta:    nil,
 		PoolUidsList: []PoolUids{},
+
+		DynamicFeeConfigList: []DynamicFeeConfig{},
+		PoolVolatilityList:   []PoolVolatility{},
+		BuybackConfig:        DefaultBuybackConfig(),
+		BuybackStats:         DefaultBuybackStats(),
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -91,6 +96,48 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("missing PoolId '%s' from PoolUidsList in PoolList", poolUids.PoolId)
 		}
 	}
+
+	// Check for duplicated index in dynamicFeeConfig and that every config belongs to a pool
+	dynamicFeeConfigIndexMap := make(map[string]struct{})
+
+	for _, config := range gs.DynamicFeeConfigList {
+		index := config.PoolId
+		if _, ok := dynamicFeeConfigIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for dynamicFeeConfig")
+		}
+		dynamicFeeConfigIndexMap[index] = struct{}{}
+
+		if err := config.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := poolIndexMap[string(PoolKey(config.PoolId))]; !exists {
+			return fmt.Errorf("missing PoolId '%s' from DynamicFeeConfigList in PoolList", config.PoolId)
+		}
+	}
+
+	// Check for duplicated index in poolVolatility and that every entry belongs to a pool
+	poolVolatilityIndexMap := make(map[string]struct{})
+
+	for _, volatility := range gs.PoolVolatilityList {
+		index := volatility.PoolId
+		if _, ok := poolVolatilityIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for poolVolatility")
+		}
+		poolVolatilityIndexMap[index] = struct{}{}
+
+		if _, exists := poolIndexMap[string(PoolKey(volatility.PoolId))]; !exists {
+			return fmt.Errorf("missing PoolId '%s' from PoolVolatilityList in PoolList", volatility.PoolId)
+		}
+	}
+
+	if err := gs.BuybackConfig.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.BuybackStats.Validate(); err != nil {
+		return err
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
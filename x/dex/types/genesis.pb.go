@@ -27,10 +27,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the dex module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params       Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PoolList     []Pool     `protobuf:"bytes,2,rep,name=pool_list,json=poolList,proto3" json:"pool_list"`
-	PoolsMeta    *PoolsMeta `protobuf:"bytes,3,opt,name=pools_meta,json=poolsMeta,proto3" json:"pools_meta,omitempty"`
-	PoolUidsList []PoolUids `protobuf:"bytes,4,rep,name=pool_uids_list,json=poolUidsList,proto3" json:"pool_uids_list"`
+	Params               Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PoolList             []Pool             `protobuf:"bytes,2,rep,name=pool_list,json=poolList,proto3" json:"pool_list"`
+	PoolsMeta            *PoolsMeta         `protobuf:"bytes,3,opt,name=pools_meta,json=poolsMeta,proto3" json:"pools_meta,omitempty"`
+	PoolUidsList         []PoolUids         `protobuf:"bytes,4,rep,name=pool_uids_list,json=poolUidsList,proto3" json:"pool_uids_list"`
+	DynamicFeeConfigList []DynamicFeeConfig `protobuf:"bytes,5,rep,name=dynamic_fee_config_list,json=dynamicFeeConfigList,proto3" json:"dynamic_fee_config_list"`
+	PoolVolatilityList   []PoolVolatility   `protobuf:"bytes,6,rep,name=pool_volatility_list,json=poolVolatilityList,proto3" json:"pool_volatility_list"`
+	BuybackConfig        BuybackConfig      `protobuf:"bytes,7,opt,name=buyback_config,json=buybackConfig,proto3" json:"buyback_config"`
+	BuybackStats         BuybackStats       `protobuf:"bytes,8,opt,name=buyback_stats,json=buybackStats,proto3" json:"buyback_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -94,6 +98,34 @@ func (m *GenesisState) GetPoolUidsList() []PoolUids {
 	return nil
 }
 
+func (m *GenesisState) GetDynamicFeeConfigList() []DynamicFeeConfig {
+	if m != nil {
+		return m.DynamicFeeConfigList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolVolatilityList() []PoolVolatility {
+	if m != nil {
+		return m.PoolVolatilityList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBuybackConfig() BuybackConfig {
+	if m != nil {
+		return m.BuybackConfig
+	}
+	return BuybackConfig{}
+}
+
+func (m *GenesisState) GetBuybackStats() BuybackStats {
+	if m != nil {
+		return m.BuybackStats
+	}
+	return BuybackStats{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zigchain.dex.GenesisState")
 }
@@ -101,26 +133,35 @@ func init() {
 func init() { proto.RegisterFile("zigchain/dex/genesis.proto", fileDescriptor_097bf9062916f75f) }
 
 var fileDescriptor_097bf9062916f75f = []byte{
-	// 303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xaa, 0xca, 0x4c, 0x4f,
-	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x4f, 0x49, 0xad, 0xd0, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0xc9, 0xe9, 0xa5, 0xa4, 0x56, 0x48, 0x09,
-	0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x02, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c,
-	0x30, 0x53, 0x1f, 0xc4, 0x82, 0x8a, 0x4a, 0xa2, 0x18, 0x59, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x35,
-	0x51, 0x4a, 0x1c, 0x55, 0x2a, 0x3f, 0x3f, 0x07, 0x2a, 0x21, 0x8b, 0x21, 0x51, 0x1c, 0x9f, 0x9b,
-	0x5a, 0x92, 0x08, 0x95, 0x96, 0xc1, 0x90, 0x8e, 0x2f, 0xcd, 0x4c, 0x81, 0x9a, 0xaa, 0xf4, 0x8b,
-	0x91, 0x8b, 0xc7, 0x1d, 0xe2, 0xf2, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x73, 0x2e, 0x36, 0x88,
-	0xb5, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x22, 0x7a, 0xc8, 0x3e, 0xd1, 0x0b, 0x00, 0xcb,
-	0x39, 0x71, 0x9e, 0xb8, 0x27, 0xcf, 0xb0, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xa8, 0x72, 0x21,
-	0x53, 0x2e, 0x4e, 0xb0, 0xe1, 0x39, 0x99, 0xc5, 0x25, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46,
-	0x42, 0x68, 0x7a, 0xf3, 0xf3, 0x73, 0x9c, 0x58, 0x40, 0x3a, 0x83, 0x38, 0x40, 0x4a, 0x7d, 0x32,
-	0x8b, 0x4b, 0x84, 0xcc, 0xb8, 0xb8, 0x10, 0x4e, 0x96, 0x60, 0x06, 0xdb, 0x29, 0x8e, 0xa9, 0xaf,
-	0xd8, 0x37, 0xb5, 0x24, 0x31, 0x08, 0x6c, 0x03, 0x98, 0x29, 0xe4, 0xc4, 0xc5, 0x07, 0xf7, 0x0b,
-	0xc4, 0x4e, 0x16, 0xb0, 0x9d, 0x62, 0x98, 0x7a, 0x43, 0x33, 0x53, 0x8a, 0xa1, 0xf6, 0xf2, 0x14,
-	0x40, 0xf9, 0x20, 0xbb, 0x9d, 0xf4, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1,
-	0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21,
-	0x4a, 0x04, 0x1e, 0x68, 0x15, 0xe0, 0x60, 0x2b, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x87,
-	0x99, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x3b, 0xcd, 0x23, 0x33, 0xf9, 0x01, 0x00, 0x00,
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x6e, 0xd4, 0x30,
+	0x10, 0xc6, 0x37, 0x74, 0x59, 0xba, 0x6e, 0xa8, 0x84, 0x15, 0xb1, 0x21, 0x14, 0x53, 0x71, 0xaa,
+	0x38, 0x24, 0x52, 0x11, 0x70, 0x0f, 0x08, 0x24, 0x44, 0x25, 0xb4, 0xfc, 0x39, 0xc0, 0x21, 0x72,
+	0x12, 0x37, 0x58, 0x24, 0x71, 0x54, 0xbb, 0x68, 0xc3, 0x53, 0xf0, 0x18, 0x1c, 0x79, 0x8c, 0x3d,
+	0xf6, 0xc8, 0x09, 0xa1, 0xdd, 0x03, 0xaf, 0x81, 0x32, 0xf6, 0x2e, 0x71, 0xd3, 0x4b, 0xe4, 0xcc,
+	0xf7, 0xcd, 0x6f, 0xc6, 0x9e, 0x41, 0xc1, 0x37, 0x5e, 0x64, 0x9f, 0x29, 0xaf, 0xa3, 0x9c, 0x2d,
+	0xa2, 0x82, 0xd5, 0x4c, 0x72, 0x19, 0x36, 0x67, 0x42, 0x09, 0xec, 0x6e, 0xb4, 0x30, 0x67, 0x8b,
+	0xe0, 0x16, 0xad, 0x78, 0x2d, 0x22, 0xf8, 0x6a, 0x43, 0xe0, 0x15, 0xa2, 0x10, 0x70, 0x8c, 0xba,
+	0x93, 0x89, 0xda, 0xc8, 0xf4, 0xbc, 0x4d, 0x69, 0xf6, 0xc5, 0x68, 0xc4, 0xd2, 0xf2, 0xb6, 0xa6,
+	0x15, 0xcf, 0x92, 0x53, 0xc6, 0x8c, 0x7e, 0xc7, 0xd2, 0x1b, 0x7a, 0x46, 0x2b, 0xd3, 0x4d, 0x30,
+	0xb3, 0x25, 0x21, 0x4a, 0x23, 0xdc, 0x1b, 0x08, 0x32, 0xa9, 0x98, 0xa2, 0x46, 0x3e, 0x18, 0xc8,
+	0xc9, 0x39, 0xcf, 0x0d, 0xf5, 0xc1, 0x72, 0x8c, 0xdc, 0x97, 0xfa, 0xd6, 0x6f, 0x15, 0x55, 0x0c,
+	0x3f, 0x45, 0x13, 0x5d, 0xd6, 0x77, 0x0e, 0x9d, 0xa3, 0xbd, 0x63, 0x2f, 0xec, 0xbf, 0x42, 0xf8,
+	0x06, 0xb4, 0x78, 0xba, 0xfc, 0x7d, 0x7f, 0xf4, 0xe3, 0xef, 0xcf, 0x87, 0xce, 0xdc, 0xd8, 0xf1,
+	0x63, 0x34, 0x05, 0x78, 0xc9, 0xa5, 0xf2, 0xaf, 0x1d, 0xee, 0x1c, 0xed, 0x1d, 0xe3, 0x4b, 0xb9,
+	0x42, 0x94, 0xf1, 0xb8, 0xcb, 0x9c, 0xef, 0x76, 0xd6, 0xd7, 0x5c, 0x2a, 0xfc, 0x04, 0xa1, 0xff,
+	0x2d, 0xfb, 0x3b, 0x50, 0x73, 0x36, 0xcc, 0x93, 0x27, 0x4c, 0xd1, 0xf9, 0xb4, 0xd9, 0x1c, 0x71,
+	0x8c, 0xf6, 0xb7, 0x77, 0xd1, 0x35, 0xc7, 0x50, 0xf3, 0xf6, 0x30, 0xf7, 0x3d, 0xcf, 0xa5, 0xa9,
+	0xeb, 0x36, 0xe6, 0x1f, 0x6a, 0x7f, 0x42, 0xb3, 0xde, 0x08, 0x92, 0x4c, 0xd4, 0xa7, 0xbc, 0xd0,
+	0xb0, 0xeb, 0x00, 0x23, 0x36, 0xec, 0xb9, 0x36, 0xbf, 0x60, 0xec, 0x19, 0x58, 0x0d, 0xd4, 0xcb,
+	0x2f, 0xc5, 0x01, 0xfe, 0x0e, 0x79, 0xd0, 0xe0, 0x57, 0x51, 0x52, 0xc5, 0x4b, 0xae, 0x5a, 0x4d,
+	0x9e, 0x00, 0xf9, 0x60, 0xd8, 0xe6, 0x87, 0xad, 0xd1, 0x70, 0x71, 0x63, 0x45, 0x81, 0x7a, 0x82,
+	0xf6, 0xcd, 0x46, 0x99, 0x76, 0xfd, 0x1b, 0xf0, 0x64, 0x77, 0x6d, 0x5e, 0xac, 0x3d, 0xa6, 0xcd,
+	0xde, 0xb4, 0x6e, 0xa6, 0x7d, 0x05, 0xbf, 0x42, 0x9b, 0x40, 0x22, 0x15, 0x55, 0xd2, 0xdf, 0x05,
+	0x5a, 0x70, 0x25, 0xad, 0x5b, 0x10, 0x6b, 0xf4, 0x6e, 0xda, 0x17, 0xc2, 0xe5, 0x8a, 0x38, 0x17,
+	0x2b, 0xe2, 0xfc, 0x59, 0x11, 0xe7, 0xfb, 0x9a, 0x8c, 0x2e, 0xd6, 0x64, 0xf4, 0x6b, 0x4d, 0x46,
+	0x1f, 0xbd, 0xed, 0x0a, 0x2e, 0x60, 0x09, 0x55, 0xdb, 0x30, 0x99, 0x4e, 0x60, 0x03, 0x1f, 0xfd,
+	0x1b, 0x00, 0xd4, 0x0c, 0x20, 0x6c, 0x83, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -143,6 +184,54 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.BuybackStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.BuybackConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.PoolVolatilityList) > 0 {
+		for iNdEx := len(m.PoolVolatilityList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolVolatilityList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DynamicFeeConfigList) > 0 {
+		for iNdEx := len(m.DynamicFeeConfigList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DynamicFeeConfigList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.PoolUidsList) > 0 {
 		for iNdEx := len(m.PoolUidsList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -231,6 +320,22 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DynamicFeeConfigList) > 0 {
+		for _, e := range m.DynamicFeeConfigList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolVolatilityList) > 0 {
+		for _, e := range m.PoolVolatilityList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.BuybackConfig.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BuybackStats.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -406,6 +511,140 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFeeConfigList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DynamicFeeConfigList = append(m.DynamicFeeConfigList, DynamicFeeConfig{})
+			if err := m.DynamicFeeConfigList[len(m.DynamicFeeConfigList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolatilityList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolVolatilityList = append(m.PoolVolatilityList, PoolVolatility{})
+			if err := m.PoolVolatilityList[len(m.PoolVolatilityList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuybackConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BuybackConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuybackStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BuybackStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -52,10 +52,12 @@ func TestGenesisState_Validate_Positive(t *testing.T) {
 	}
 
 	validGenesis := types.GenesisState{
-		PoolList:     validPoolList,
-		PoolUidsList: validPoolUidList,
-		PoolsMeta:    &types.PoolsMeta{NextPoolId: 2},
-		Params:       types.DefaultParams(),
+		PoolList:      validPoolList,
+		PoolUidsList:  validPoolUidList,
+		PoolsMeta:     &types.PoolsMeta{NextPoolId: 2},
+		Params:        types.DefaultParams(),
+		BuybackConfig: types.DefaultBuybackConfig(),
+		BuybackStats:  types.DefaultBuybackStats(),
 	}
 
 	err := validGenesis.Validate()
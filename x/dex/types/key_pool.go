@@ -5,7 +5,7 @@ import "encoding/binary"
 var _ binary.ByteOrder
 
 const (
-	// PoolKeyPrefix is the prefix to retrieve all Pool
+	// PoolKeyPrefix is the prefix to retrieve all Pool in the legacy store layout, only used by the v4 migration
 	PoolKeyPrefix = "Pool/value/"
 )
 
@@ -5,7 +5,7 @@ import "encoding/binary"
 var _ binary.ByteOrder
 
 const (
-	// PoolUidsKeyPrefix is the prefix to retrieve all PoolUids
+	// PoolUidsKeyPrefix is the prefix to retrieve all PoolUids in the legacy store layout, only used by the v4 migration
 	PoolUidsKeyPrefix = "PoolUids/value/"
 
 	// PoolUidSeparator is the separator between the two denoms in the poolUid
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"cosmossdk.io/collections"
 	"github.com/cometbft/cometbft/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -30,6 +31,27 @@ var (
 	ParamsKey = []byte("p_dex")
 )
 
+var (
+	// PoolsPrefix is the prefix of the pools collection
+	PoolsPrefix = collections.NewPrefix(1)
+	// PoolsByCreatorPrefix is the prefix of the pools index by creator
+	PoolsByCreatorPrefix = collections.NewPrefix(2)
+	// PoolsByDenomPrefix is the prefix of the pools index by denom
+	PoolsByDenomPrefix = collections.NewPrefix(3)
+	// PoolUidsPrefix is the prefix of the poolUids collection
+	PoolUidsPrefix = collections.NewPrefix(4)
+	// PoolsMetaPrefix is the prefix of the poolsMeta item
+	PoolsMetaPrefix = collections.NewPrefix(5)
+	// DynamicFeeConfigsPrefix is the prefix of the dynamic fee configs collection
+	DynamicFeeConfigsPrefix = collections.NewPrefix(6)
+	// PoolVolatilitiesPrefix is the prefix of the pool volatilities collection
+	PoolVolatilitiesPrefix = collections.NewPrefix(7)
+	// BuybackConfigPrefix is the prefix of the buyback config item
+	BuybackConfigPrefix = collections.NewPrefix(8)
+	// BuybackStatsPrefix is the prefix of the buyback stats item
+	BuybackStatsPrefix = collections.NewPrefix(9)
+)
+
 var (
 	// PortKey defines the key to store the port ID in store
 	PortKey = KeyPrefix("dex-port-")
@@ -40,5 +62,6 @@ func KeyPrefix(p string) []byte {
 }
 
 const (
+	// PoolsMetaKey is the store key of the PoolsMeta in the legacy store layout, only used by the v4 migration
 	PoolsMetaKey = "PoolsMeta/value/"
 )
@@ -0,0 +1,28 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgUpdateBuybackConfig{}
+
+func NewMsgUpdateBuybackConfig(authority string, config BuybackConfig) *MsgUpdateBuybackConfig {
+	return &MsgUpdateBuybackConfig{
+		Authority: authority,
+		Config:    config,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateBuybackConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := m.Config.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgUpdateDynamicFeeConfig{}
+
+func NewMsgUpdateDynamicFeeConfig(authority string, config DynamicFeeConfig) *MsgUpdateDynamicFeeConfig {
+	return &MsgUpdateDynamicFeeConfig{
+		Authority: authority,
+		Config:    config,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateDynamicFeeConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := m.Config.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
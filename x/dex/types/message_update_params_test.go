@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"zigchain/testutil/sample"
@@ -14,7 +15,7 @@ var msgUpdateParamsSample = MsgUpdateParams{
 	Authority: sample.AccAddress(),
 	Params: Params{
 		NewPoolFeePct:        uint32(600),
-		CreationFee:          uint32(200000000),
+		CreationFee:          sdk.NewInt64Coin("uzig", 200000000),
 		MinimalLiquidityLock: 1000,
 	},
 }
@@ -44,7 +45,7 @@ func TestMsgUpdateParams_ValidateBasic_InvalidAuthority(t *testing.T) {
 		Authority: "invalid_address",
 		Params: Params{
 			NewPoolFeePct: uint32(600),
-			CreationFee:   uint32(200000000),
+			CreationFee:   sdk.NewInt64Coin("uzig", 200000000),
 		},
 	}
 
@@ -65,7 +66,7 @@ func TestMsgUpdateParams_ValidateBasic_InvalidParams(t *testing.T) {
 		Authority: sample.AccAddress(),
 		Params: Params{
 			NewPoolFeePct:        constants.PoolFeeScalingFactor, // Invalid: too large
-			CreationFee:          uint32(200000000),
+			CreationFee:          sdk.NewInt64Coin("uzig", 200000000),
 			MinimalLiquidityLock: 1000,
 		},
 	}
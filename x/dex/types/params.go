@@ -5,21 +5,22 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"zigchain/zutils/constants"
 )
 
 // DefaultCreationFee represents the CreationFee default value.
-//var DefaultCreationFee = uint32(100 * math.Pow(10, constants.BondDenomDecimals))
+var DefaultCreationFee = sdk.NewInt64Coin(constants.BondDenom, DefaultCreationFeeAmount)
 
 const (
 	// DefaultNewPoolFeePct represents the NewPoolFeePct default value.
 	DefaultNewPoolFeePct uint32 = 500
 
-	// DefaultCreationFee Deterministic computation using integer arithmetic
+	// DefaultCreationFeeAmount Deterministic computation using integer arithmetic
 	// ZIG: 100 * 1_000_000 = 100_000_000 uzig (100 ZIG, ZIG to an uzig conversion factor is 1_000_000)
-	DefaultCreationFee uint32 = 100 * 1_000_000
+	DefaultCreationFeeAmount int64 = 100 * 1_000_000
 
 	// DefaultBeneficiary represents the Beneficiary default value.
 	DefaultBeneficiary = ""
@@ -44,7 +45,7 @@ const (
 // NewParams creates a new Params instance.
 func NewParams(
 	newPoolFeePct uint32,
-	creationFee uint32,
+	creationFee sdk.Coin,
 	beneficiary string,
 	minimalLiquidityLock uint32,
 ) Params {
@@ -124,9 +125,23 @@ func validateMaxSlippage(maxSlippage uint32) error {
 }
 
 // validateCreationFee validates the CreationFee parameter.
-func validateCreationFee(creationFee uint32) error {
-	_ = creationFee
-	// No validation needed for uint32 - it can never be negative or exceed MaxUint32
+func validateCreationFee(creationFee sdk.Coin) error {
+	if validators.CheckDenomString(creationFee.Denom) != nil {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidCoins,
+			"invalid creation fee denom: %s",
+			creationFee.Denom,
+		)
+	}
+
+	if creationFee.Amount.IsNil() || creationFee.Amount.IsNegative() {
+		return errorsmod.Wrapf(
+			ErrorInvalidAmount,
+			"invalid creation fee amount: %s, cannot be nil or negative",
+			creationFee.Amount,
+		)
+	}
+
 	return nil
 }
 
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -28,8 +29,6 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// newPoolFeePct is the percentage of the fee default on new pool
 	NewPoolFeePct uint32 `protobuf:"varint,1,opt,name=new_pool_fee_pct,json=newPoolFeePct,proto3" json:"new_pool_fee_pct,omitempty"`
-	// creationFee is the fee to create a new pool
-	CreationFee uint32 `protobuf:"varint,2,opt,name=creation_fee,json=creationFee,proto3" json:"creation_fee,omitempty"`
 	// beneficiary is the address that receives the fee to create a new factory
 	Beneficiary string `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
 	// minimalLiquidityLock is the minimum amount of LP tokens that are locked in
@@ -38,6 +37,8 @@ type Params struct {
 	// maxSlippage is the maximum allowed slippage percentage for liquidity
 	// deposits (in basis points, 1 = 0.01%)
 	MaxSlippage uint32 `protobuf:"varint,5,opt,name=max_slippage,json=maxSlippage,proto3" json:"max_slippage,omitempty"`
+	// creationFee is the fee to create a new pool
+	CreationFee types.Coin `protobuf:"bytes,6,opt,name=creation_fee,json=creationFee,proto3" json:"creation_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,28 +81,106 @@ func (m *Params) GetNewPoolFeePct() uint32 {
 	return 0
 }
 
-func (m *Params) GetCreationFee() uint32 {
+func (m *Params) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *Params) GetMinimalLiquidityLock() uint32 {
+	if m != nil {
+		return m.MinimalLiquidityLock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSlippage() uint32 {
+	if m != nil {
+		return m.MaxSlippage
+	}
+	return 0
+}
+
+func (m *Params) GetCreationFee() types.Coin {
 	if m != nil {
 		return m.CreationFee
 	}
+	return types.Coin{}
+}
+
+// LegacyParams defines the parameters for the module before the creation fee
+// was migrated to a coin, used by the v3 migration only
+type LegacyParams struct {
+	NewPoolFeePct        uint32 `protobuf:"varint,1,opt,name=new_pool_fee_pct,json=newPoolFeePct,proto3" json:"new_pool_fee_pct,omitempty"`
+	CreationFee          uint32 `protobuf:"varint,2,opt,name=creation_fee,json=creationFee,proto3" json:"creation_fee,omitempty"`
+	Beneficiary          string `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	MinimalLiquidityLock uint32 `protobuf:"varint,4,opt,name=minimal_liquidity_lock,json=minimalLiquidityLock,proto3" json:"minimal_liquidity_lock,omitempty"`
+	MaxSlippage          uint32 `protobuf:"varint,5,opt,name=max_slippage,json=maxSlippage,proto3" json:"max_slippage,omitempty"`
+}
+
+func (m *LegacyParams) Reset()         { *m = LegacyParams{} }
+func (m *LegacyParams) String() string { return proto.CompactTextString(m) }
+func (*LegacyParams) ProtoMessage()    {}
+func (*LegacyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_244560bdd7b0edb1, []int{1}
+}
+func (m *LegacyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LegacyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LegacyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LegacyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LegacyParams.Merge(m, src)
+}
+func (m *LegacyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *LegacyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_LegacyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LegacyParams proto.InternalMessageInfo
+
+func (m *LegacyParams) GetNewPoolFeePct() uint32 {
+	if m != nil {
+		return m.NewPoolFeePct
+	}
 	return 0
 }
 
-func (m *Params) GetBeneficiary() string {
+func (m *LegacyParams) GetCreationFee() uint32 {
+	if m != nil {
+		return m.CreationFee
+	}
+	return 0
+}
+
+func (m *LegacyParams) GetBeneficiary() string {
 	if m != nil {
 		return m.Beneficiary
 	}
 	return ""
 }
 
-func (m *Params) GetMinimalLiquidityLock() uint32 {
+func (m *LegacyParams) GetMinimalLiquidityLock() uint32 {
 	if m != nil {
 		return m.MinimalLiquidityLock
 	}
 	return 0
 }
 
-func (m *Params) GetMaxSlippage() uint32 {
+func (m *LegacyParams) GetMaxSlippage() uint32 {
 	if m != nil {
 		return m.MaxSlippage
 	}
@@ -110,32 +189,38 @@ func (m *Params) GetMaxSlippage() uint32 {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "zigchain.dex.Params")
+	proto.RegisterType((*LegacyParams)(nil), "zigchain.dex.LegacyParams")
 }
 
 func init() { proto.RegisterFile("zigchain/dex/params.proto", fileDescriptor_244560bdd7b0edb1) }
 
 var fileDescriptor_244560bdd7b0edb1 = []byte{
-	// 306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xac, 0xca, 0x4c, 0x4f,
-	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x4f, 0x49, 0xad, 0xd0, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0x49, 0xe9, 0xa5, 0xa4, 0x56, 0x48, 0x09, 0x26,
-	0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x02, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30,
-	0x53, 0x1f, 0xc4, 0x82, 0x88, 0x2a, 0xbd, 0x67, 0xe4, 0x62, 0x0b, 0x00, 0x9b, 0x23, 0xa4, 0xce,
-	0x25, 0x90, 0x97, 0x5a, 0x1e, 0x5f, 0x90, 0x9f, 0x9f, 0x13, 0x9f, 0x96, 0x9a, 0x1a, 0x5f, 0x90,
-	0x5c, 0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x1b, 0xc4, 0x9b, 0x97, 0x5a, 0x1e, 0x90, 0x9f, 0x9f,
-	0xe3, 0x96, 0x9a, 0x1a, 0x90, 0x5c, 0x22, 0xa4, 0xc8, 0xc5, 0x93, 0x5c, 0x94, 0x9a, 0x58, 0x92,
-	0x99, 0x9f, 0x07, 0x52, 0x28, 0xc1, 0x04, 0x56, 0xc4, 0x0d, 0x13, 0x73, 0x4b, 0x4d, 0x15, 0x52,
-	0xe0, 0xe2, 0x4e, 0x4a, 0xcd, 0x4b, 0x4d, 0xcb, 0x4c, 0xce, 0x4c, 0x2c, 0xaa, 0x94, 0x60, 0x56,
-	0x60, 0xd4, 0xe0, 0x0c, 0x42, 0x16, 0x12, 0x32, 0xe1, 0x12, 0xcb, 0xcd, 0xcc, 0xcb, 0xcc, 0x4d,
-	0xcc, 0x89, 0xcf, 0xc9, 0x2c, 0x2c, 0xcd, 0x4c, 0xc9, 0x2c, 0xa9, 0x8c, 0xcf, 0xc9, 0x4f, 0xce,
-	0x96, 0x60, 0x01, 0x1b, 0x27, 0x02, 0x95, 0xf5, 0x81, 0x49, 0xfa, 0xe4, 0x27, 0x67, 0x83, 0xac,
-	0xce, 0x4d, 0xac, 0x88, 0x2f, 0xce, 0xc9, 0x2c, 0x28, 0x48, 0x4c, 0x4f, 0x95, 0x60, 0x85, 0x58,
-	0x9d, 0x9b, 0x58, 0x11, 0x0c, 0x15, 0xb2, 0x92, 0x7b, 0xb1, 0x40, 0x9e, 0xb1, 0xeb, 0xf9, 0x06,
-	0x2d, 0x51, 0x78, 0x60, 0x55, 0x80, 0x83, 0x0b, 0xe2, 0x4d, 0x27, 0xbd, 0x13, 0x8f, 0xe4, 0x18,
-	0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5,
-	0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x12, 0x41, 0xd3, 0x50, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4,
-	0x06, 0x0e, 0x28, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xad, 0x8c, 0xc9, 0xd3, 0x7c, 0x01,
-	0x00, 0x00,
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x92, 0x31, 0x8f, 0xd3, 0x40,
+	0x10, 0x85, 0xbd, 0xb9, 0x23, 0xe2, 0xd6, 0x3e, 0xe9, 0xb0, 0x02, 0xf2, 0x5d, 0xb1, 0x71, 0xd2,
+	0x10, 0xa5, 0xb0, 0x15, 0xa0, 0xa2, 0x0c, 0x52, 0x90, 0x50, 0x8a, 0x28, 0x74, 0x34, 0xd6, 0x7a,
+	0x33, 0x31, 0xab, 0xd8, 0x3b, 0xc6, 0x36, 0xc4, 0xe6, 0x27, 0x50, 0xd1, 0xd3, 0x50, 0x52, 0xe6,
+	0x67, 0xa4, 0x4c, 0x83, 0x44, 0x85, 0x50, 0x52, 0x84, 0x9f, 0x81, 0x62, 0x3b, 0x28, 0x4a, 0x47,
+	0x45, 0x63, 0x8d, 0xde, 0x1b, 0xef, 0xbe, 0xf7, 0x69, 0xe9, 0xed, 0x47, 0x19, 0x88, 0xb7, 0x5c,
+	0x2a, 0x77, 0x06, 0xb9, 0x1b, 0xf3, 0x84, 0x47, 0xa9, 0x13, 0x27, 0x98, 0xa1, 0x69, 0x1c, 0x2d,
+	0x67, 0x06, 0xf9, 0xdd, 0x03, 0x1e, 0x49, 0x85, 0x6e, 0xf9, 0xad, 0x16, 0xee, 0x5a, 0x01, 0x06,
+	0x58, 0x8e, 0xee, 0x61, 0xaa, 0x55, 0x26, 0x30, 0x8d, 0x30, 0x75, 0x7d, 0x9e, 0x82, 0xfb, 0x61,
+	0xe0, 0x43, 0xc6, 0x07, 0xae, 0x40, 0xa9, 0x2a, 0xbf, 0xfb, 0xa5, 0x41, 0x9b, 0x93, 0xf2, 0x1e,
+	0xf3, 0x31, 0xbd, 0x51, 0xb0, 0xf4, 0x62, 0xc4, 0xd0, 0x9b, 0x03, 0x78, 0xb1, 0xc8, 0x2c, 0x62,
+	0x93, 0xde, 0xf5, 0xf4, 0x5a, 0xc1, 0x72, 0x82, 0x18, 0x8e, 0x00, 0x26, 0x22, 0x33, 0x6d, 0xaa,
+	0xfb, 0xa0, 0x60, 0x2e, 0x85, 0xe4, 0x49, 0x61, 0x5d, 0xd8, 0xa4, 0x77, 0x35, 0x3d, 0x95, 0xcc,
+	0x67, 0xf4, 0x51, 0x24, 0x95, 0x8c, 0x78, 0xe8, 0x85, 0xf2, 0xdd, 0x7b, 0x39, 0x93, 0x59, 0xe1,
+	0x85, 0x28, 0x16, 0xd6, 0x65, 0x79, 0x60, 0xab, 0x76, 0xc7, 0x47, 0x73, 0x8c, 0x62, 0x61, 0x76,
+	0xa8, 0x11, 0xf1, 0xdc, 0x4b, 0x43, 0x19, 0xc7, 0x3c, 0x00, 0xeb, 0x5e, 0xb9, 0xab, 0x47, 0x3c,
+	0x7f, 0x5d, 0x4b, 0xe6, 0x4b, 0x6a, 0x88, 0x04, 0x78, 0x26, 0x51, 0x1d, 0x32, 0x5a, 0x4d, 0x9b,
+	0xf4, 0xf4, 0x27, 0xb7, 0x4e, 0xd5, 0xd2, 0x39, 0xb4, 0x74, 0xea, 0x96, 0xce, 0x0b, 0x94, 0x6a,
+	0x78, 0xb5, 0xfe, 0xd9, 0xd6, 0xbe, 0xed, 0x57, 0x7d, 0x32, 0xd5, 0x8f, 0x7f, 0x8e, 0x00, 0x9e,
+	0xb3, 0xdf, 0x5f, 0xdb, 0xe4, 0xd3, 0x7e, 0xd5, 0x7f, 0xf8, 0x17, 0x79, 0x5e, 0x42, 0xaf, 0x60,
+	0xbc, 0xba, 0xbc, 0xdf, 0xb8, 0xb9, 0xe8, 0x7e, 0x27, 0xd4, 0x18, 0x43, 0xc0, 0x45, 0xf1, 0xaf,
+	0x8c, 0x3a, 0x67, 0x41, 0x1b, 0x55, 0x97, 0x93, 0x08, 0xff, 0x11, 0xe3, 0xd0, 0x59, 0x6f, 0x19,
+	0xd9, 0x6c, 0x19, 0xf9, 0xb5, 0x65, 0xe4, 0xf3, 0x8e, 0x69, 0x9b, 0x1d, 0xd3, 0x7e, 0xec, 0x98,
+	0xf6, 0xa6, 0x75, 0x86, 0x23, 0x2b, 0x62, 0x48, 0xfd, 0x66, 0xf9, 0x58, 0x9e, 0xfe, 0x19, 0x00,
+	0x16, 0x87, 0xaf, 0x20, 0xa0, 0x02, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -160,9 +245,6 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.NewPoolFeePct != that1.NewPoolFeePct {
 		return false
 	}
-	if this.CreationFee != that1.CreationFee {
-		return false
-	}
 	if this.Beneficiary != that1.Beneficiary {
 		return false
 	}
@@ -172,6 +254,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.MaxSlippage != that1.MaxSlippage {
 		return false
 	}
+	if !this.CreationFee.Equal(&that1.CreationFee) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -190,6 +275,61 @@ func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 }
 
 func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CreationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MaxSlippage != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSlippage))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinimalLiquidityLock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinimalLiquidityLock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NewPoolFeePct != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.NewPoolFeePct))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LegacyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LegacyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LegacyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -236,6 +376,30 @@ func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	return base
 }
 func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewPoolFeePct != 0 {
+		n += 1 + sovParams(uint64(m.NewPoolFeePct))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MinimalLiquidityLock != 0 {
+		n += 1 + sovParams(uint64(m.MinimalLiquidityLock))
+	}
+	if m.MaxSlippage != 0 {
+		n += 1 + sovParams(uint64(m.MaxSlippage))
+	}
+	l = m.CreationFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *LegacyParams) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -295,6 +459,178 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPoolFeePct", wireType)
+			}
+			m.NewPoolFeePct = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewPoolFeePct |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimalLiquidityLock", wireType)
+			}
+			m.MinimalLiquidityLock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinimalLiquidityLock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			m.MaxSlippage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSlippage |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LegacyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LegacyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LegacyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NewPoolFeePct", wireType)
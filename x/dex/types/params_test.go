@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"zigchain/testutil/sample"
 	"zigchain/x/dex/types"
@@ -18,7 +19,7 @@ import (
 func TestNewParams(t *testing.T) {
 	// Test case: create new params with valid values
 	newPoolFeePct := uint32(500)
-	creationFee := uint32(100000000)
+	creationFee := sdk.NewInt64Coin("uzig", 100000000)
 	beneficiary := sample.AccAddress()
 	minimalLiquidityLock := uint32(1000)
 
@@ -42,7 +43,7 @@ func TestDefaultParams(t *testing.T) {
 
 func TestParams_Validate_Valid(t *testing.T) {
 	// Test case: validate params with valid values
-	params := types.NewParams(500, 100000000, "", 1000)
+	params := types.NewParams(500, types.DefaultCreationFee, "", 1000)
 	// Assuming Params has MaxSlippage field, set it to a valid value
 	params.MaxSlippage = 100
 
@@ -53,7 +54,7 @@ func TestParams_Validate_Valid(t *testing.T) {
 func TestParams_Validate_ValidWithBeneficiary(t *testing.T) {
 	// Test case: validate params with valid beneficiary address
 	beneficiary := sample.AccAddress()
-	params := types.NewParams(500, 100000000, beneficiary, 1000)
+	params := types.NewParams(500, types.DefaultCreationFee, beneficiary, 1000)
 	params.MaxSlippage = 100
 
 	err := params.Validate()
@@ -64,7 +65,7 @@ func TestParams_Validate_ValidWithBeneficiary(t *testing.T) {
 
 func TestParams_Validate_InvalidNewPoolFeePct(t *testing.T) {
 	// Test case: validate params with invalid new pool fee pct
-	params := types.NewParams(constants.PoolFeeScalingFactor, 100000000, "", 1000)
+	params := types.NewParams(constants.PoolFeeScalingFactor, types.DefaultCreationFee, "", 1000)
 	params.MaxSlippage = 100
 
 	err := params.Validate()
@@ -74,7 +75,7 @@ func TestParams_Validate_InvalidNewPoolFeePct(t *testing.T) {
 
 func TestParams_Validate_InvalidBeneficiary(t *testing.T) {
 	// Test case: validate params with invalid beneficiary address
-	params := types.NewParams(500, 100000000, "invalid_address", 1000)
+	params := types.NewParams(500, types.DefaultCreationFee, "invalid_address", 1000)
 	params.MaxSlippage = 100
 
 	err := params.Validate()
@@ -84,7 +85,7 @@ func TestParams_Validate_InvalidBeneficiary(t *testing.T) {
 
 func TestParams_Validate_InvalidMinimalLiquidityLock(t *testing.T) {
 	// Test case: validate params with invalid minimal liquidity lock
-	params := types.NewParams(500, 100000000, "", 0)
+	params := types.NewParams(500, types.DefaultCreationFee, "", 0)
 	params.MaxSlippage = 100
 
 	err := params.Validate()
@@ -94,7 +95,7 @@ func TestParams_Validate_InvalidMinimalLiquidityLock(t *testing.T) {
 
 func TestParams_Validate_InvalidMaxSlippage(t *testing.T) {
 	// Test case: validate params with invalid max slippage
-	params := types.NewParams(500, 100000000, "", 1000)
+	params := types.NewParams(500, types.DefaultCreationFee, "", 1000)
 	params.MaxSlippage = 10001
 
 	err := params.Validate()
@@ -104,168 +105,77 @@ func TestParams_Validate_InvalidMaxSlippage(t *testing.T) {
 
 func TestParams_Validate_CreationFee_Zero(t *testing.T) {
 	// Test case: validate params with zero creation fee
-	params := types.NewParams(500, uint32(0), "", 1000)
+	params := types.NewParams(500, sdk.NewInt64Coin("uzig", 0), "", 1000)
 	params.MaxSlippage = 100
 
 	err := params.Validate()
 	require.NoError(t, err, "Zero creation fee should be valid")
 }
 
-func TestParams_Validate_CreationFee_MaxUint32(t *testing.T) {
-	// Test case: validate params with maximum uint32 value
-	params := types.NewParams(500, uint32(4294967295), "", 1000)
+func TestParams_Validate_CreationFee_NonNativeDenom(t *testing.T) {
+	// Test case: validate params with a creation fee in a non-native denom
+	params := types.NewParams(500, sdk.NewInt64Coin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", 1000), "", 1000)
 	params.MaxSlippage = 100
 
 	err := params.Validate()
-	require.NoError(t, err, "Maximum uint32 creation fee should be valid")
+	require.NoError(t, err, "Non-native creation fee denom should be valid")
 }
 
 func TestParams_Validate_CreationFee_EdgeCases(t *testing.T) {
 	// Test case: validate params with various edge case values for creation fee
 	testCases := []struct {
 		name     string
-		amount   uint32
+		amount   sdkmath.Int
 		expected bool
 	}{
-		{"zero", 0, true},
-		{"one", 1, true},
-		{"small_value", 100, true},
-		{"large_value", 1000000, true},
-		{"max_uint32", 4294967295, true},
+		{"zero", sdkmath.ZeroInt(), true},
+		{"one", sdkmath.OneInt(), true},
+		{"small_value", sdkmath.NewInt(100), true},
+		{"large_value", sdkmath.NewInt(1000000), true},
+		{"above_max_uint32", sdkmath.NewInt(4294967296), true},
+		{"negative", sdkmath.NewInt(-1), false},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			params := types.NewParams(500, tc.amount, "", 1000)
+			params := types.NewParams(500, sdk.Coin{Denom: "uzig", Amount: tc.amount}, "", 1000)
 			params.MaxSlippage = 100
 			err := params.Validate()
 
 			if tc.expected {
-				require.NoError(t, err, "Creation fee %d should be valid", tc.amount)
+				require.NoError(t, err, "Creation fee %s should be valid", tc.amount)
 			} else {
-				require.Error(t, err, "Creation fee %d should be invalid", tc.amount)
+				require.Error(t, err, "Creation fee %s should be invalid", tc.amount)
+				require.Contains(t, err.Error(), "invalid creation fee amount")
 			}
 		})
 	}
 }
 
-func TestParams_Validate_CreationFee_NegativeValue(t *testing.T) {
-	// Test case: ensure that if somehow a negative value gets into CreationFee,
-	// the validation will fail appropriately
-	// Note: Since uint32 cannot hold negative values, this test ensures the validation
-	// function handles edge cases correctly and doesn't panic
-
-	// Test with zero value (closest to "negative" for uint32)
-	params := types.NewParams(500, uint32(0), "", 1000)
+func TestParams_Validate_CreationFee_NilAmount(t *testing.T) {
+	// Test case: validate params with a nil creation fee amount
+	params := types.NewParams(500, sdk.Coin{Denom: "uzig"}, "", 1000)
 	params.MaxSlippage = 100
 
-	// This should not panic and should pass validation
 	require.NotPanics(t, func() {
 		err := params.Validate()
-		require.NoError(t, err, "Zero creation fee should be valid")
-	}, "Validation should not panic with zero creation fee")
+		require.Error(t, err, "Nil creation fee amount should be invalid")
+		require.Contains(t, err.Error(), "invalid creation fee amount")
+	}, "Validation should not panic with nil creation fee amount")
 }
 
-func TestParams_Validate_CreationFee_ActualNegativeCast(t *testing.T) {
-	// Test case: cast a negative value to uint32 and see what happens
-	// This tests the actual behavior when a negative value is cast to uint32
+func TestParams_Validate_CreationFee_InvalidDenom(t *testing.T) {
+	// Test case: validate params with invalid creation fee denoms
+	invalidDenoms := []string{"", "1uzig", "u", "uzig!"}
 
-	// Cast negative values to uint32
-	negativeValues := []int{-1, -100, -1000, -1000000}
-
-	for _, negVal := range negativeValues {
-		t.Run(fmt.Sprintf("negative_%d", negVal), func(t *testing.T) {
-			// Cast negative value to uint32
-			castValue := uint32(negVal)
-
-			// Create params with the cast value
-			params := types.NewParams(500, castValue, "", 1000)
+	for _, denom := range invalidDenoms {
+		t.Run(fmt.Sprintf("denom_%q", denom), func(t *testing.T) {
+			params := types.NewParams(500, sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(1000)}, "", 1000)
 			params.MaxSlippage = 100
 
-			// Test that validation doesn't panic
-			require.NotPanics(t, func() {
-				err := params.Validate()
-				// Since uint32 wraps around, negative values become very large positive values
-				// The validation should still pass as uint32 values are always valid
-				require.NoError(t, err, "Cast negative value %d to uint32 should be valid", negVal)
-			}, "Validation should not panic with cast negative value %d", negVal)
-
-			// Log the actual cast value for verification
-			t.Logf("Negative value %d cast to uint32 becomes: %d", negVal, castValue)
-		})
-	}
-}
-
-func TestParams_Validate_CreationFee_WhyNoNegativeCheck(t *testing.T) {
-	// Test case: demonstrate why the validation function doesn't need to check for negative values
-	// This shows the current behavior and explains the design decision
-
-	t.Run("uint32_cannot_be_negative", func(t *testing.T) {
-		// Demonstrate that uint32 cannot hold negative values
-		// var u uint32 = 0  // This would be unused
-
-		// This would cause a compilation error if we tried to assign a negative value directly
-		// u = -1  // This line would not compile
-
-		// But we can cast negative values, which wraps around
-		negVal := -1
-		castValue := uint32(negVal)
-		require.Equal(t, uint32(4294967295), castValue, "Casting -1 to uint32 should wrap around to max value")
-
-		// The validation function doesn't need to check for "negative" values because:
-		// 1. uint32 cannot be negative by design
-		// 2. Casting negative values results in very large positive values
-		// 3. All uint32 values are technically valid for this field
-
-		params := types.NewParams(500, castValue, "", 1000)
-		params.MaxSlippage = 100
-		err := params.Validate()
-		require.NoError(t, err, "Even wrapped-around negative values should be valid")
-	})
-
-	t.Run("current_validation_logic", func(t *testing.T) {
-		// Show what the current validation function actually does
-		params := types.NewParams(500, 100000000, "", 1000)
-		params.MaxSlippage = 100
-
-		// The current validateCreationFee function just returns nil
-		// because uint32 values can never be negative or exceed MaxUint32
-		err := params.Validate()
-		require.NoError(t, err, "Current validation should pass for any uint32 value")
-
-		// This is the correct behavior because:
-		// - uint32 is the correct type for this field
-		// - All uint32 values are valid
-		// - No additional validation is needed
-	})
-}
-
-func TestParams_Validate_CreationFee_Robustness(t *testing.T) {
-	// Test case: ensure the validation function is robust and handles edge cases
-	// without panicking, even if somehow invalid data gets through
-
-	testCases := []struct {
-		name        string
-		amount      uint32
-		description string
-	}{
-		{"zero", 0, "Zero value should be handled gracefully"},
-		{"one", 1, "Minimum positive value should be handled gracefully"},
-		{"max_uint32", 4294967295, "Maximum uint32 value should be handled gracefully"},
-		{"large_but_reasonable", 1000000, "Large but reasonable value should be handled gracefully"},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			params := types.NewParams(500, tc.amount, "", 1000)
-			params.MaxSlippage = 100
-
-			// Ensure validation doesn't panic and handles the value appropriately
-			require.NotPanics(t, func() {
-				err := params.Validate()
-				// All uint32 values should be considered valid since they can't be negative
-				require.NoError(t, err, tc.description)
-			}, "Validation should not panic with creation fee %d", tc.amount)
+			err := params.Validate()
+			require.Error(t, err, "Creation fee denom %q should be invalid", denom)
+			require.Contains(t, err.Error(), "invalid creation fee denom")
 		})
 	}
 }
@@ -287,7 +197,7 @@ func TestParams_Validate_NewPoolFeePct_EdgeCases(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			params := types.NewParams(tc.value, 100000000, "", 1000)
+			params := types.NewParams(tc.value, types.DefaultCreationFee, "", 1000)
 			params.MaxSlippage = 100
 			err := params.Validate()
 
@@ -317,7 +227,7 @@ func TestParams_Validate_MinimalLiquidityLock_EdgeCases(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			params := types.NewParams(500, 100000000, "", tc.value)
+			params := types.NewParams(500, types.DefaultCreationFee, "", tc.value)
 			params.MaxSlippage = 100
 			err := params.Validate()
 
@@ -348,7 +258,7 @@ func TestParams_Validate_MaxSlippage_EdgeCases(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			params := types.NewParams(500, 100000000, "", 1000)
+			params := types.NewParams(500, types.DefaultCreationFee, "", 1000)
 			params.MaxSlippage = tc.value
 			err := params.Validate()
 
@@ -364,7 +274,7 @@ func TestParams_Validate_MaxSlippage_EdgeCases(t *testing.T) {
 
 func TestParams_Validate_MultipleValidationErrors(t *testing.T) {
 	// Test case: validate params with multiple validation errors
-	params := types.NewParams(constants.PoolFeeScalingFactor, 100000000, "invalid_address", 0)
+	params := types.NewParams(constants.PoolFeeScalingFactor, types.DefaultCreationFee, "invalid_address", 0)
 	params.MaxSlippage = 10001
 
 	err := params.Validate()
@@ -687,6 +687,8 @@ func (m *QueryAllPoolUidsResponse) GetPagination() *query.PageResponse {
 type QuerySwapInRequest struct {
 	PoolId string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	CoinIn string `protobuf:"bytes,2,opt,name=coin_in,json=coinIn,proto3" json:"coin_in,omitempty"`
+	// address is the trader the transfer taxes are quoted for, optional
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
 func (m *QuerySwapInRequest) Reset()         { *m = QuerySwapInRequest{} }
@@ -736,11 +738,26 @@ func (m *QuerySwapInRequest) GetCoinIn() string {
 	return ""
 }
 
+func (m *QuerySwapInRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // QuerySwapInResponse returns amount of tokens given back given pool id and
 // incoming.
 type QuerySwapInResponse struct {
 	CoinOut types.Coin `protobuf:"bytes,1,opt,name=coin_out,json=coinOut,proto3" json:"coin_out"`
 	Fee     types.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
+	// fee_rate is the effective fee rate applied to the swap (per 100,000)
+	FeeRate uint32 `protobuf:"varint,3,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	// incoming_tax is the transfer tax paid on top of coin_in, set when it is
+	// taxed
+	IncomingTax *types.Coin `protobuf:"bytes,4,opt,name=incoming_tax,json=incomingTax,proto3" json:"incoming_tax,omitempty"`
+	// outgoing_tax is the transfer tax already taken out of coin_out, set when
+	// it is taxed
+	OutgoingTax *types.Coin `protobuf:"bytes,5,opt,name=outgoing_tax,json=outgoingTax,proto3" json:"outgoing_tax,omitempty"`
 }
 
 func (m *QuerySwapInResponse) Reset()         { *m = QuerySwapInResponse{} }
@@ -790,11 +807,34 @@ func (m *QuerySwapInResponse) GetFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *QuerySwapInResponse) GetFeeRate() uint32 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+func (m *QuerySwapInResponse) GetIncomingTax() *types.Coin {
+	if m != nil {
+		return m.IncomingTax
+	}
+	return nil
+}
+
+func (m *QuerySwapInResponse) GetOutgoingTax() *types.Coin {
+	if m != nil {
+		return m.OutgoingTax
+	}
+	return nil
+}
+
 // QuerySwapOutRequest gets a incoming price from specific pool and outgoing
 // token.
 type QuerySwapOutRequest struct {
 	PoolId  string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	CoinOut string `protobuf:"bytes,2,opt,name=coin_out,json=coinOut,proto3" json:"coin_out,omitempty"`
+	// address is the trader the transfer taxes are quoted for, optional
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
 func (m *QuerySwapOutRequest) Reset()         { *m = QuerySwapOutRequest{} }
@@ -844,11 +884,26 @@ func (m *QuerySwapOutRequest) GetCoinOut() string {
 	return ""
 }
 
+func (m *QuerySwapOutRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // QuerySwapInResponse returns amount of tokens given back given pool id and
 // incoming.
 type QuerySwapOutResponse struct {
 	CoinIn types.Coin `protobuf:"bytes,1,opt,name=coin_in,json=coinIn,proto3" json:"coin_in"`
 	Fee    types.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
+	// fee_rate is the effective fee rate applied to the swap (per 100,000)
+	FeeRate uint32 `protobuf:"varint,3,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	// incoming_tax is the transfer tax paid on top of coin_in, set when it is
+	// taxed
+	IncomingTax *types.Coin `protobuf:"bytes,4,opt,name=incoming_tax,json=incomingTax,proto3" json:"incoming_tax,omitempty"`
+	// outgoing_tax is the transfer tax added to coin_out and taken out of it,
+	// set when it is taxed
+	OutgoingTax *types.Coin `protobuf:"bytes,5,opt,name=outgoing_tax,json=outgoingTax,proto3" json:"outgoing_tax,omitempty"`
 }
 
 func (m *QuerySwapOutResponse) Reset()         { *m = QuerySwapOutResponse{} }
@@ -898,1396 +953,3604 @@ func (m *QuerySwapOutResponse) GetFee() types.Coin {
 	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "zigchain.dex.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "zigchain.dex.QueryParamsResponse")
-	proto.RegisterType((*QueryGetPoolRequest)(nil), "zigchain.dex.QueryGetPoolRequest")
-	proto.RegisterType((*QueryGetPoolResponse)(nil), "zigchain.dex.QueryGetPoolResponse")
-	proto.RegisterType((*QueryGetPoolBalancesRequest)(nil), "zigchain.dex.QueryGetPoolBalancesRequest")
-	proto.RegisterType((*QueryGetPoolBalancesResponse)(nil), "zigchain.dex.QueryGetPoolBalancesResponse")
-	proto.RegisterType((*QueryAllPoolRequest)(nil), "zigchain.dex.QueryAllPoolRequest")
-	proto.RegisterType((*QueryAllPoolResponse)(nil), "zigchain.dex.QueryAllPoolResponse")
-	proto.RegisterType((*QueryGetPoolsMetaRequest)(nil), "zigchain.dex.QueryGetPoolsMetaRequest")
-	proto.RegisterType((*QueryGetPoolsMetaResponse)(nil), "zigchain.dex.QueryGetPoolsMetaResponse")
-	proto.RegisterType((*QueryGetPoolUidRequest)(nil), "zigchain.dex.QueryGetPoolUidRequest")
-	proto.RegisterType((*QueryGetPoolUidResponse)(nil), "zigchain.dex.QueryGetPoolUidResponse")
-	proto.RegisterType((*QueryAllPoolUidsRequest)(nil), "zigchain.dex.QueryAllPoolUidsRequest")
-	proto.RegisterType((*QueryAllPoolUidsResponse)(nil), "zigchain.dex.QueryAllPoolUidsResponse")
-	proto.RegisterType((*QuerySwapInRequest)(nil), "zigchain.dex.QuerySwapInRequest")
-	proto.RegisterType((*QuerySwapInResponse)(nil), "zigchain.dex.QuerySwapInResponse")
-	proto.RegisterType((*QuerySwapOutRequest)(nil), "zigchain.dex.QuerySwapOutRequest")
-	proto.RegisterType((*QuerySwapOutResponse)(nil), "zigchain.dex.QuerySwapOutResponse")
+func (m *QuerySwapOutResponse) GetFeeRate() uint32 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("zigchain/dex/query.proto", fileDescriptor_8ab3a46272fb5b72) }
-
-var fileDescriptor_8ab3a46272fb5b72 = []byte{
-	// 980 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0xc7, 0xe3, 0x24, 0xdd, 0x64, 0x5f, 0x23, 0x21, 0x1e, 0xab, 0xec, 0xae, 0x09, 0xdb, 0x30,
-	0x6a, 0x7e, 0x34, 0x25, 0x1e, 0x25, 0x95, 0xf8, 0x7d, 0x61, 0x41, 0xad, 0x22, 0x01, 0x0d, 0x0b,
-	0x3d, 0x80, 0x84, 0xa2, 0x49, 0x76, 0x58, 0x2c, 0x76, 0x3d, 0x4e, 0x6c, 0xb7, 0x29, 0xd1, 0x22,
-	0x7e, 0x1e, 0x7a, 0x40, 0xaa, 0xc4, 0x81, 0x1b, 0x67, 0x8e, 0xfc, 0x19, 0x3d, 0x56, 0xe2, 0xc2,
-	0x09, 0xa1, 0x04, 0x89, 0x7f, 0x03, 0x79, 0xfc, 0xbc, 0x6b, 0xaf, 0xed, 0x7a, 0x55, 0xf5, 0xb2,
-	0x5a, 0x7b, 0xde, 0xbc, 0xef, 0xe7, 0xbd, 0x99, 0xf7, 0x9e, 0xa1, 0xf1, 0xb5, 0xdd, 0x3b, 0xfa,
-	0x52, 0xd8, 0x0e, 0xef, 0xca, 0x53, 0x7e, 0x1c, 0xc8, 0x93, 0xfb, 0x96, 0x7b, 0xa2, 0x7c, 0x85,
-	0x4b, 0xf1, 0x8a, 0xd5, 0x95, 0xa7, 0xe6, 0xf3, 0x62, 0x60, 0x3b, 0x8a, 0xeb, 0xdf, 0xc8, 0xc0,
-	0xac, 0xf5, 0x54, 0x4f, 0xe9, 0xbf, 0x3c, 0xfc, 0x47, 0x6f, 0x57, 0x7a, 0x4a, 0xf5, 0xfa, 0x92,
-	0x0b, 0xd7, 0xe6, 0xc2, 0x71, 0x94, 0x2f, 0x7c, 0x5b, 0x39, 0x1e, 0xad, 0x6e, 0x1d, 0x29, 0x6f,
-	0xa0, 0x3c, 0x7e, 0x28, 0x3c, 0x19, 0xa9, 0xf1, 0xbb, 0x3b, 0x87, 0xd2, 0x17, 0x3b, 0xdc, 0x15,
-	0x3d, 0xdb, 0xd1, 0xc6, 0x64, 0xdb, 0x4c, 0xa1, 0xb9, 0xe2, 0x44, 0x0c, 0x62, 0x37, 0xf5, 0xf4,
-	0x92, 0x52, 0x7d, 0x5a, 0x78, 0x29, 0xb3, 0xe0, 0x1d, 0x0c, 0xa4, 0x2f, 0x62, 0xb8, 0xcc, 0xf2,
-	0x41, 0x60, 0x77, 0x63, 0xaf, 0xad, 0x24, 0x5c, 0x8c, 0x75, 0xa4, 0x6c, 0x02, 0x62, 0x35, 0xc0,
-	0x8f, 0x42, 0xe4, 0x7d, 0x8d, 0xd2, 0x91, 0xc7, 0x81, 0xf4, 0x7c, 0xf6, 0x21, 0xbc, 0x90, 0x7a,
-	0xeb, 0xb9, 0xca, 0xf1, 0x24, 0xbe, 0x06, 0x95, 0x08, 0xb9, 0x61, 0xac, 0x1a, 0x9b, 0x97, 0x77,
-	0x6b, 0x56, 0x32, 0x9f, 0x56, 0x64, 0xdd, 0xae, 0x3e, 0xfa, 0xfb, 0xca, 0xcc, 0xef, 0xff, 0xfd,
-	0xb1, 0x65, 0x74, 0xc8, 0x9c, 0x59, 0xe4, 0xef, 0x96, 0xf4, 0xf7, 0x95, 0xea, 0x93, 0x0c, 0xd6,
-	0x61, 0x41, 0xf3, 0xda, 0x5d, 0xed, 0xb0, 0xda, 0xa9, 0x84, 0x8f, 0x7b, 0x5d, 0xf6, 0x1e, 0xd4,
-	0xd2, 0xf6, 0x04, 0xf0, 0x0a, 0xcc, 0x87, 0x16, 0x24, 0x8f, 0x13, 0xf2, 0x4a, 0xf5, 0xdb, 0xf3,
-	0xa1, 0x78, 0x47, 0x5b, 0xb1, 0x57, 0xe1, 0xc5, 0xa4, 0x97, 0xb6, 0xe8, 0x0b, 0xe7, 0x48, 0x7a,
-	0xa5, 0xea, 0x0f, 0x0c, 0x58, 0xc9, 0xdf, 0xf8, 0x34, 0x18, 0xf8, 0x16, 0x2c, 0x1e, 0x92, 0x87,
-	0xc6, 0xec, 0xea, 0xdc, 0xe6, 0xe5, 0xdd, 0xa6, 0x15, 0x9d, 0x8a, 0x15, 0x9e, 0x8a, 0x45, 0xa7,
-	0x62, 0xbd, 0xab, 0x6c, 0x87, 0x36, 0x8e, 0x36, 0xb0, 0xcf, 0x29, 0x73, 0xef, 0xf4, 0xfb, 0xc9,
-	0xcc, 0xdd, 0x04, 0x18, 0xdf, 0x2d, 0xe2, 0x58, 0x4f, 0x79, 0x8d, 0xae, 0x7d, 0xec, 0x7b, 0x5f,
-	0xf4, 0x24, 0xed, 0xed, 0x24, 0x76, 0xb2, 0x9f, 0x0d, 0xca, 0xf4, 0xc8, 0x7f, 0x26, 0xc4, 0xb9,
-	0x29, 0x42, 0xbc, 0x95, 0xc2, 0x99, 0xd5, 0x38, 0x1b, 0xa5, 0x38, 0x91, 0x54, 0x8a, 0xc7, 0x84,
-	0x46, 0x32, 0xf3, 0xde, 0x07, 0xd2, 0x17, 0xf1, 0xa5, 0xfc, 0x14, 0x9a, 0x39, 0x6b, 0xc4, 0xfb,
-	0x36, 0xc0, 0xb8, 0x32, 0x28, 0x21, 0xf5, 0x2c, 0xb5, 0xde, 0x44, 0xe8, 0x55, 0x37, 0x7e, 0xc1,
-	0xda, 0xb0, 0x9c, 0x74, 0x7d, 0xc7, 0xee, 0xc6, 0x89, 0x46, 0x98, 0x0f, 0xf9, 0xe9, 0x86, 0xe8,
-	0xff, 0x58, 0x83, 0x4b, 0xc7, 0x81, 0xf2, 0xa5, 0x0e, 0xb4, 0xda, 0x89, 0x1e, 0xd8, 0x27, 0x50,
-	0xcf, 0xf8, 0x20, 0xb8, 0x37, 0xa0, 0x3a, 0xaa, 0x4b, 0x62, 0x5b, 0xce, 0xb2, 0xdd, 0xb1, 0xbb,
-	0x5e, 0x7c, 0xfe, 0x2e, 0x3d, 0x33, 0x41, 0x5e, 0xe9, 0x7c, 0xc2, 0x77, 0xcf, 0xfa, 0x0e, 0xfc,
-	0x66, 0x50, 0xd2, 0x53, 0x1a, 0xf9, 0xe8, 0x73, 0xd3, 0xa3, 0x3f, 0xbb, 0x4b, 0x71, 0x93, 0x7a,
-	0xd4, 0xc7, 0xf7, 0x84, 0xbb, 0xe7, 0x94, 0x95, 0x6f, 0xb8, 0x10, 0x36, 0xb8, 0x03, 0xdb, 0xa1,
-	0x03, 0xaa, 0x84, 0x8f, 0x7b, 0x0e, 0xfb, 0xd1, 0xa0, 0x62, 0x8a, 0x1d, 0x51, 0x8c, 0x6f, 0xc2,
-	0xa2, 0xde, 0xa0, 0x02, 0x9f, 0xd2, 0x58, 0x5a, 0xa0, 0x5a, 0xe1, 0x76, 0xe0, 0xe3, 0x0e, 0xcc,
-	0x7d, 0x21, 0x25, 0x45, 0x57, 0xba, 0x2d, 0xb4, 0x65, 0x7b, 0x09, 0x8a, 0xdb, 0x81, 0x5f, 0x1a,
-	0x4f, 0x33, 0x81, 0x17, 0x05, 0x14, 0xab, 0xb3, 0x1f, 0xe2, 0xf2, 0x1d, 0xf9, 0xa2, 0x90, 0x5e,
-	0x1f, 0xe7, 0x60, 0xca, 0x88, 0x28, 0x49, 0x4f, 0x11, 0xd0, 0xee, 0xc3, 0x2a, 0x5c, 0xd2, 0x14,
-	0xf8, 0x15, 0x54, 0xa2, 0x21, 0x80, 0xab, 0xe9, 0x4b, 0x92, 0x9d, 0x31, 0xe6, 0xcb, 0x4f, 0xb0,
-	0x88, 0xa2, 0x60, 0x2b, 0xdf, 0xff, 0xf9, 0xef, 0x2f, 0xb3, 0xcb, 0x58, 0xe3, 0x39, 0x63, 0x13,
-	0xef, 0xc2, 0x02, 0xd5, 0x1a, 0xe6, 0xf9, 0x4a, 0xcf, 0x1a, 0x93, 0x3d, 0xc9, 0x84, 0xf4, 0xae,
-	0x6a, 0xbd, 0x16, 0xae, 0xf0, 0xcc, 0x4c, 0xe5, 0x67, 0x74, 0x38, 0x43, 0xfc, 0xd5, 0x80, 0xe7,
-	0x26, 0x26, 0x03, 0x5e, 0x2b, 0xf6, 0x3e, 0x31, 0x76, 0xcc, 0xad, 0x69, 0x4c, 0x09, 0x68, 0x5b,
-	0x03, 0x6d, 0xe0, 0x5a, 0x16, 0x68, 0x3b, 0x1e, 0x11, 0x09, 0xb2, 0x01, 0x2c, 0xbe, 0x6f, 0x7b,
-	0xc5, 0x29, 0x49, 0x0f, 0x91, 0xdc, 0x94, 0x4c, 0xcc, 0x01, 0x66, 0x6a, 0x82, 0x1a, 0x62, 0x96,
-	0x00, 0xbf, 0x33, 0x60, 0x29, 0xd9, 0x8c, 0x71, 0xbd, 0x38, 0xb4, 0x64, 0x27, 0x37, 0x37, 0x4a,
-	0xed, 0x48, 0x7d, 0x55, 0xab, 0x9b, 0xd8, 0xe0, 0x05, 0xdf, 0x40, 0xf8, 0xc0, 0x00, 0x18, 0x77,
-	0x5c, 0xbc, 0x5a, 0xec, 0x79, 0xdc, 0xd4, 0xcd, 0xb5, 0x12, 0x2b, 0x52, 0xb7, 0xb4, 0xfa, 0x26,
-	0xae, 0xf3, 0xfc, 0x4f, 0x2c, 0x7e, 0x16, 0x96, 0xc5, 0x90, 0x9f, 0xe9, 0x01, 0x30, 0xc4, 0x6f,
-	0x60, 0x29, 0x4e, 0xbf, 0x6e, 0x80, 0x6b, 0xc5, 0xf9, 0x4d, 0xf4, 0x71, 0x73, 0xbd, 0xcc, 0x8c,
-	0x70, 0xae, 0x68, 0x9c, 0x26, 0xd6, 0x0b, 0x70, 0xf0, 0x5b, 0x03, 0x2a, 0x51, 0x6b, 0xcb, 0x2d,
-	0xbf, 0x54, 0xfb, 0xcc, 0x2d, 0xbf, 0x74, 0x5f, 0x64, 0x3b, 0x5a, 0xf0, 0x3a, 0x5e, 0x4b, 0x0b,
-	0x7a, 0xf7, 0x84, 0x7b, 0x60, 0x3b, 0xe3, 0x7b, 0xc7, 0xcf, 0xa8, 0xd7, 0x0c, 0xf1, 0x27, 0x03,
-	0x16, 0xa8, 0x17, 0x61, 0x91, 0xc2, 0xb8, 0xe7, 0xe5, 0xde, 0xc0, 0x89, 0x56, 0xc6, 0x6e, 0x68,
-	0x8a, 0x6d, 0xbc, 0x9e, 0x43, 0xa1, 0x02, 0x3f, 0x83, 0xa1, 0x02, 0x7f, 0xd8, 0xb6, 0x1e, 0x9d,
-	0xb7, 0x8c, 0xc7, 0xe7, 0x2d, 0xe3, 0x9f, 0xf3, 0x96, 0xf1, 0xf0, 0xa2, 0x35, 0xf3, 0xf8, 0xa2,
-	0x35, 0xf3, 0xd7, 0x45, 0x6b, 0xe6, 0xb3, 0xda, 0xc8, 0xcb, 0xa9, 0xf6, 0xe3, 0xdf, 0x77, 0xa5,
-	0x77, 0x58, 0xd1, 0x5f, 0xc3, 0x37, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x32, 0xcd, 0x5e, 0xc0,
-	0x3b, 0x0c, 0x00, 0x00,
+func (m *QuerySwapOutResponse) GetIncomingTax() *types.Coin {
+	if m != nil {
+		return m.IncomingTax
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of Pool items.
-	GetPool(ctx context.Context, in *QueryGetPoolRequest, opts ...grpc.CallOption) (*QueryGetPoolResponse, error)
-	// Queries a list of Pool items.
-	GetPoolBalances(ctx context.Context, in *QueryGetPoolBalancesRequest, opts ...grpc.CallOption) (*QueryGetPoolBalancesResponse, error)
-	// Queries a list of Pool items.
-	ListPool(ctx context.Context, in *QueryAllPoolRequest, opts ...grpc.CallOption) (*QueryAllPoolResponse, error)
-	// Queries a PoolsMeta by index.
-	GetPoolsMeta(ctx context.Context, in *QueryGetPoolsMetaRequest, opts ...grpc.CallOption) (*QueryGetPoolsMetaResponse, error)
-	// Queries a list of PoolUids items.
-	GetPoolUid(ctx context.Context, in *QueryGetPoolUidRequest, opts ...grpc.CallOption) (*QueryGetPoolUidResponse, error)
-	// Queries a list of PoolUids items.
-	ListPoolUids(ctx context.Context, in *QueryAllPoolUidsRequest, opts ...grpc.CallOption) (*QueryAllPoolUidsResponse, error)
-	// Queries a list of SwapIn items.
-	SwapIn(ctx context.Context, in *QuerySwapInRequest, opts ...grpc.CallOption) (*QuerySwapInResponse, error)
-	// Queries a list of SwapIn items.
-	SwapOut(ctx context.Context, in *QuerySwapOutRequest, opts ...grpc.CallOption) (*QuerySwapOutResponse, error)
+func (m *QuerySwapOutResponse) GetOutgoingTax() *types.Coin {
+	if m != nil {
+		return m.OutgoingTax
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryGetDynamicFeeRequest gets the dynamic fee state of a specific pool.
+type QueryGetDynamicFeeRequest struct {
+	PoolId string `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetDynamicFeeRequest) Reset()         { *m = QueryGetDynamicFeeRequest{} }
+func (m *QueryGetDynamicFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDynamicFeeRequest) ProtoMessage()    {}
+func (*QueryGetDynamicFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ab3a46272fb5b72, []int{18}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetDynamicFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetPool(ctx context.Context, in *QueryGetPoolRequest, opts ...grpc.CallOption) (*QueryGetPoolResponse, error) {
-	out := new(QueryGetPoolResponse)
-	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/GetPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetDynamicFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDynamicFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) GetPoolBalances(ctx context.Context, in *QueryGetPoolBalancesRequest, opts ...grpc.CallOption) (*QueryGetPoolBalancesResponse, error) {
-	out := new(QueryGetPoolBalancesResponse)
-	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/GetPoolBalances", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetDynamicFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDynamicFeeRequest.Merge(m, src)
+}
+func (m *QueryGetDynamicFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDynamicFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDynamicFeeRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListPool(ctx context.Context, in *QueryAllPoolRequest, opts ...grpc.CallOption) (*QueryAllPoolResponse, error) {
-	out := new(QueryAllPoolResponse)
-	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/ListPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetDynamicFeeRequest proto.InternalMessageInfo
+
+func (m *QueryGetDynamicFeeRequest) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetPoolsMeta(ctx context.Context, in *QueryGetPoolsMetaRequest, opts ...grpc.CallOption) (*QueryGetPoolsMetaResponse, error) {
-	out := new(QueryGetPoolsMetaResponse)
-	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/GetPoolsMeta", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetDynamicFeeResponse is the response type for the Query/GetDynamicFee
+// RPC method.
+type QueryGetDynamicFeeResponse struct {
+	// config is the dynamic fee configuration, empty if never set
+	Config DynamicFeeConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+	// volatility is the tracked volatility, decayed to the current height
+	Volatility PoolVolatility `protobuf:"bytes,2,opt,name=volatility,proto3" json:"volatility"`
+	// effective_fee is the fee that applies to a swap at the current height
+	// (per 100,000)
+	EffectiveFee uint32 `protobuf:"varint,3,opt,name=effective_fee,json=effectiveFee,proto3" json:"effective_fee,omitempty"`
 }
 
-func (c *queryClient) GetPoolUid(ctx context.Context, in *QueryGetPoolUidRequest, opts ...grpc.CallOption) (*QueryGetPoolUidResponse, error) {
-	out := new(QueryGetPoolUidResponse)
-	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/GetPoolUid", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetDynamicFeeResponse) Reset()         { *m = QueryGetDynamicFeeResponse{} }
+func (m *QueryGetDynamicFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDynamicFeeResponse) ProtoMessage()    {}
+func (*QueryGetDynamicFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ab3a46272fb5b72, []int{19}
+}
+func (m *QueryGetDynamicFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDynamicFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDynamicFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetDynamicFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDynamicFeeResponse.Merge(m, src)
+}
+func (m *QueryGetDynamicFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDynamicFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDynamicFeeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListPoolUids(ctx context.Context, in *QueryAllPoolUidsRequest, opts ...grpc.CallOption) (*QueryAllPoolUidsResponse, error) {
-	out := new(QueryAllPoolUidsResponse)
-	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/ListPoolUids", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetDynamicFeeResponse proto.InternalMessageInfo
+
+func (m *QueryGetDynamicFeeResponse) GetConfig() DynamicFeeConfig {
+	if m != nil {
+		return m.Config
 	}
-	return out, nil
+	return DynamicFeeConfig{}
 }
 
-func (c *queryClient) SwapIn(ctx context.Context, in *QuerySwapInRequest, opts ...grpc.CallOption) (*QuerySwapInResponse, error) {
-	out := new(QuerySwapInResponse)
-	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/SwapIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetDynamicFeeResponse) GetVolatility() PoolVolatility {
+	if m != nil {
+		return m.Volatility
 	}
-	return out, nil
+	return PoolVolatility{}
 }
 
-func (c *queryClient) SwapOut(ctx context.Context, in *QuerySwapOutRequest, opts ...grpc.CallOption) (*QuerySwapOutResponse, error) {
-	out := new(QuerySwapOutResponse)
-	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/SwapOut", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetDynamicFeeResponse) GetEffectiveFee() uint32 {
+	if m != nil {
+		return m.EffectiveFee
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of Pool items.
-	GetPool(context.Context, *QueryGetPoolRequest) (*QueryGetPoolResponse, error)
-	// Queries a list of Pool items.
-	GetPoolBalances(context.Context, *QueryGetPoolBalancesRequest) (*QueryGetPoolBalancesResponse, error)
-	// Queries a list of Pool items.
-	ListPool(context.Context, *QueryAllPoolRequest) (*QueryAllPoolResponse, error)
-	// Queries a PoolsMeta by index.
-	GetPoolsMeta(context.Context, *QueryGetPoolsMetaRequest) (*QueryGetPoolsMetaResponse, error)
-	// Queries a list of PoolUids items.
-	GetPoolUid(context.Context, *QueryGetPoolUidRequest) (*QueryGetPoolUidResponse, error)
-	// Queries a list of PoolUids items.
-	ListPoolUids(context.Context, *QueryAllPoolUidsRequest) (*QueryAllPoolUidsResponse, error)
-	// Queries a list of SwapIn items.
-	SwapIn(context.Context, *QuerySwapInRequest) (*QuerySwapInResponse, error)
-	// Queries a list of SwapIn items.
-	SwapOut(context.Context, *QuerySwapOutRequest) (*QuerySwapOutResponse, error)
+// QueryGetBuybackInfoRequest gets the buyback configuration and totals.
+type QueryGetBuybackInfoRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryGetBuybackInfoRequest) Reset()         { *m = QueryGetBuybackInfoRequest{} }
+func (m *QueryGetBuybackInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBuybackInfoRequest) ProtoMessage()    {}
+func (*QueryGetBuybackInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ab3a46272fb5b72, []int{20}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGetBuybackInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetPool(ctx context.Context, req *QueryGetPoolRequest) (*QueryGetPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPool not implemented")
+func (m *QueryGetBuybackInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBuybackInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetPoolBalances(ctx context.Context, req *QueryGetPoolBalancesRequest) (*QueryGetPoolBalancesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPoolBalances not implemented")
+func (m *QueryGetBuybackInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBuybackInfoRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ListPool(ctx context.Context, req *QueryAllPoolRequest) (*QueryAllPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPool not implemented")
+func (m *QueryGetBuybackInfoRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetPoolsMeta(ctx context.Context, req *QueryGetPoolsMetaRequest) (*QueryGetPoolsMetaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPoolsMeta not implemented")
+func (m *QueryGetBuybackInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBuybackInfoRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetPoolUid(ctx context.Context, req *QueryGetPoolUidRequest) (*QueryGetPoolUidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPoolUid not implemented")
+
+var xxx_messageInfo_QueryGetBuybackInfoRequest proto.InternalMessageInfo
+
+// QueryGetBuybackInfoResponse is the response type for the Query/GetBuybackInfo
+// RPC method.
+type QueryGetBuybackInfoResponse struct {
+	Config BuybackConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+	Stats  BuybackStats  `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats"`
 }
-func (*UnimplementedQueryServer) ListPoolUids(ctx context.Context, req *QueryAllPoolUidsRequest) (*QueryAllPoolUidsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPoolUids not implemented")
+
+func (m *QueryGetBuybackInfoResponse) Reset()         { *m = QueryGetBuybackInfoResponse{} }
+func (m *QueryGetBuybackInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBuybackInfoResponse) ProtoMessage()    {}
+func (*QueryGetBuybackInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ab3a46272fb5b72, []int{21}
 }
-func (*UnimplementedQueryServer) SwapIn(ctx context.Context, req *QuerySwapInRequest) (*QuerySwapInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SwapIn not implemented")
+func (m *QueryGetBuybackInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) SwapOut(ctx context.Context, req *QuerySwapOutRequest) (*QuerySwapOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SwapOut not implemented")
+func (m *QueryGetBuybackInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBuybackInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryGetBuybackInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBuybackInfoResponse.Merge(m, src)
+}
+func (m *QueryGetBuybackInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBuybackInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBuybackInfoResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zigchain.dex.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryGetBuybackInfoResponse proto.InternalMessageInfo
 
-func _Query_GetPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zigchain.dex.Query/GetPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPool(ctx, req.(*QueryGetPoolRequest))
+func (m *QueryGetBuybackInfoResponse) GetConfig() BuybackConfig {
+	if m != nil {
+		return m.Config
 	}
-	return interceptor(ctx, in, info, handler)
+	return BuybackConfig{}
 }
 
-func _Query_GetPoolBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPoolBalancesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPoolBalances(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zigchain.dex.Query/GetPoolBalances",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPoolBalances(ctx, req.(*QueryGetPoolBalancesRequest))
+func (m *QueryGetBuybackInfoResponse) GetStats() BuybackStats {
+	if m != nil {
+		return m.Stats
 	}
-	return interceptor(ctx, in, info, handler)
+	return BuybackStats{}
 }
 
-func _Query_ListPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zigchain.dex.Query/ListPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListPool(ctx, req.(*QueryAllPoolRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryPoolsByDenomRequest lists the pools having a denom as base or quote.
+type QueryPoolsByDenomRequest struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_GetPoolsMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPoolsMetaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPoolsMeta(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zigchain.dex.Query/GetPoolsMeta",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPoolsMeta(ctx, req.(*QueryGetPoolsMetaRequest))
+func (m *QueryPoolsByDenomRequest) Reset()         { *m = QueryPoolsByDenomRequest{} }
+func (m *QueryPoolsByDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByDenomRequest) ProtoMessage()    {}
+func (*QueryPoolsByDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ab3a46272fb5b72, []int{22}
+}
+func (m *QueryPoolsByDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsByDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsByDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPoolsByDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByDenomRequest.Merge(m, src)
+}
+func (m *QueryPoolsByDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsByDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByDenomRequest.DiscardUnknown(m)
 }
 
-func _Query_GetPoolUid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPoolUidRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPoolUid(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zigchain.dex.Query/GetPoolUid",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPoolUid(ctx, req.(*QueryGetPoolUidRequest))
+var xxx_messageInfo_QueryPoolsByDenomRequest proto.InternalMessageInfo
+
+func (m *QueryPoolsByDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ListPoolUids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPoolUidsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListPoolUids(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zigchain.dex.Query/ListPoolUids",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListPoolUids(ctx, req.(*QueryAllPoolUidsRequest))
+func (m *QueryPoolsByDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_SwapIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySwapInRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SwapIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zigchain.dex.Query/SwapIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SwapIn(ctx, req.(*QuerySwapInRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryPoolsByDenomResponse is the response type for the
+// Query/ListPoolsByDenom RPC method.
+type QueryPoolsByDenomResponse struct {
+	Pool       []Pool              `protobuf:"bytes,1,rep,name=pool,proto3" json:"pool"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_SwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySwapOutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SwapOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zigchain.dex.Query/SwapOut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SwapOut(ctx, req.(*QuerySwapOutRequest))
+func (m *QueryPoolsByDenomResponse) Reset()         { *m = QueryPoolsByDenomResponse{} }
+func (m *QueryPoolsByDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByDenomResponse) ProtoMessage()    {}
+func (*QueryPoolsByDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ab3a46272fb5b72, []int{23}
+}
+func (m *QueryPoolsByDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsByDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsByDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "zigchain.dex.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetPool",
-			Handler:    _Query_GetPool_Handler,
-		},
-		{
-			MethodName: "GetPoolBalances",
-			Handler:    _Query_GetPoolBalances_Handler,
-		},
-		{
-			MethodName: "ListPool",
-			Handler:    _Query_ListPool_Handler,
-		},
-		{
-			MethodName: "GetPoolsMeta",
-			Handler:    _Query_GetPoolsMeta_Handler,
-		},
-		{
-			MethodName: "GetPoolUid",
-			Handler:    _Query_GetPoolUid_Handler,
-		},
-		{
-			MethodName: "ListPoolUids",
-			Handler:    _Query_ListPoolUids_Handler,
-		},
-		{
-			MethodName: "SwapIn",
-			Handler:    _Query_SwapIn_Handler,
-		},
-		{
-			MethodName: "SwapOut",
-			Handler:    _Query_SwapOut_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "zigchain/dex/query.proto",
+func (m *QueryPoolsByDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByDenomResponse.Merge(m, src)
+}
+func (m *QueryPoolsByDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsByDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByDenomResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPoolsByDenomResponse proto.InternalMessageInfo
+
+func (m *QueryPoolsByDenomResponse) GetPool() []Pool {
+	if m != nil {
+		return m.Pool
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPoolsByDenomResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryPoolsByCreatorRequest lists the pools created by an address.
+type QueryPoolsByCreatorRequest struct {
+	Creator    string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPoolsByCreatorRequest) Reset()         { *m = QueryPoolsByCreatorRequest{} }
+func (m *QueryPoolsByCreatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByCreatorRequest) ProtoMessage()    {}
+func (*QueryPoolsByCreatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ab3a46272fb5b72, []int{24}
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPoolsByCreatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPoolsByCreatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsByCreatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPoolsByCreatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByCreatorRequest.Merge(m, src)
 }
-
-func (m *QueryGetPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPoolsByCreatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsByCreatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByCreatorRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PoolId) > 0 {
-		i -= len(m.PoolId)
-		copy(dAtA[i:], m.PoolId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryPoolsByCreatorRequest proto.InternalMessageInfo
+
+func (m *QueryPoolsByCreatorRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPoolsByCreatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPoolsByCreatorResponse is the response type for the
+// Query/ListPoolsByCreator RPC method.
+type QueryPoolsByCreatorResponse struct {
+	Pool       []Pool              `protobuf:"bytes,1,rep,name=pool,proto3" json:"pool"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPoolsByCreatorResponse) Reset()         { *m = QueryPoolsByCreatorResponse{} }
+func (m *QueryPoolsByCreatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByCreatorResponse) ProtoMessage()    {}
+func (*QueryPoolsByCreatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ab3a46272fb5b72, []int{25}
+}
+func (m *QueryPoolsByCreatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsByCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsByCreatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPoolsByCreatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByCreatorResponse.Merge(m, src)
+}
+func (m *QueryPoolsByCreatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsByCreatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByCreatorResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPoolBalancesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPoolsByCreatorResponse proto.InternalMessageInfo
+
+func (m *QueryPoolsByCreatorResponse) GetPool() []Pool {
+	if m != nil {
+		return m.Pool
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetPoolBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPoolsByCreatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetPoolBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PoolId) > 0 {
-		i -= len(m.PoolId)
-		copy(dAtA[i:], m.PoolId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "zigchain.dex.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "zigchain.dex.QueryParamsResponse")
+	proto.RegisterType((*QueryGetPoolRequest)(nil), "zigchain.dex.QueryGetPoolRequest")
+	proto.RegisterType((*QueryGetPoolResponse)(nil), "zigchain.dex.QueryGetPoolResponse")
+	proto.RegisterType((*QueryGetPoolBalancesRequest)(nil), "zigchain.dex.QueryGetPoolBalancesRequest")
+	proto.RegisterType((*QueryGetPoolBalancesResponse)(nil), "zigchain.dex.QueryGetPoolBalancesResponse")
+	proto.RegisterType((*QueryAllPoolRequest)(nil), "zigchain.dex.QueryAllPoolRequest")
+	proto.RegisterType((*QueryAllPoolResponse)(nil), "zigchain.dex.QueryAllPoolResponse")
+	proto.RegisterType((*QueryGetPoolsMetaRequest)(nil), "zigchain.dex.QueryGetPoolsMetaRequest")
+	proto.RegisterType((*QueryGetPoolsMetaResponse)(nil), "zigchain.dex.QueryGetPoolsMetaResponse")
+	proto.RegisterType((*QueryGetPoolUidRequest)(nil), "zigchain.dex.QueryGetPoolUidRequest")
+	proto.RegisterType((*QueryGetPoolUidResponse)(nil), "zigchain.dex.QueryGetPoolUidResponse")
+	proto.RegisterType((*QueryAllPoolUidsRequest)(nil), "zigchain.dex.QueryAllPoolUidsRequest")
+	proto.RegisterType((*QueryAllPoolUidsResponse)(nil), "zigchain.dex.QueryAllPoolUidsResponse")
+	proto.RegisterType((*QuerySwapInRequest)(nil), "zigchain.dex.QuerySwapInRequest")
+	proto.RegisterType((*QuerySwapInResponse)(nil), "zigchain.dex.QuerySwapInResponse")
+	proto.RegisterType((*QuerySwapOutRequest)(nil), "zigchain.dex.QuerySwapOutRequest")
+	proto.RegisterType((*QuerySwapOutResponse)(nil), "zigchain.dex.QuerySwapOutResponse")
+	proto.RegisterType((*QueryGetDynamicFeeRequest)(nil), "zigchain.dex.QueryGetDynamicFeeRequest")
+	proto.RegisterType((*QueryGetDynamicFeeResponse)(nil), "zigchain.dex.QueryGetDynamicFeeResponse")
+	proto.RegisterType((*QueryGetBuybackInfoRequest)(nil), "zigchain.dex.QueryGetBuybackInfoRequest")
+	proto.RegisterType((*QueryGetBuybackInfoResponse)(nil), "zigchain.dex.QueryGetBuybackInfoResponse")
+	proto.RegisterType((*QueryPoolsByDenomRequest)(nil), "zigchain.dex.QueryPoolsByDenomRequest")
+	proto.RegisterType((*QueryPoolsByDenomResponse)(nil), "zigchain.dex.QueryPoolsByDenomResponse")
+	proto.RegisterType((*QueryPoolsByCreatorRequest)(nil), "zigchain.dex.QueryPoolsByCreatorRequest")
+	proto.RegisterType((*QueryPoolsByCreatorResponse)(nil), "zigchain.dex.QueryPoolsByCreatorResponse")
 }
 
-func (m *QueryGetPoolBalancesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("zigchain/dex/query.proto", fileDescriptor_8ab3a46272fb5b72) }
+
+var fileDescriptor_8ab3a46272fb5b72 = []byte{
+	// 1416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcb, 0x6f, 0x1b, 0xd5,
+	0x17, 0xce, 0x38, 0x89, 0x93, 0x9c, 0x26, 0xbf, 0x1f, 0xdc, 0x9a, 0xda, 0x9e, 0xa6, 0x6e, 0x3a,
+	0x6d, 0x9e, 0x6d, 0x3d, 0xa4, 0x45, 0xe5, 0xb9, 0xc1, 0xad, 0x5a, 0x45, 0x02, 0x5a, 0xdc, 0x16,
+	0x09, 0x24, 0x64, 0xae, 0xed, 0x6b, 0x33, 0xaa, 0x3d, 0xd7, 0xf5, 0x5c, 0xa7, 0x36, 0x96, 0x2b,
+	0x40, 0xb0, 0xe8, 0x02, 0x51, 0x1e, 0x12, 0x0b, 0x24, 0x96, 0xc0, 0x92, 0xff, 0x81, 0x4d, 0x97,
+	0x95, 0xd8, 0x20, 0x21, 0x21, 0xd4, 0x22, 0xf1, 0x6f, 0xa0, 0xb9, 0x73, 0xae, 0x3d, 0x93, 0x99,
+	0x89, 0xad, 0x2a, 0x52, 0x25, 0x36, 0xc9, 0xdc, 0xb9, 0xdf, 0x39, 0xe7, 0x3b, 0xe7, 0xdc, 0xc7,
+	0x37, 0x86, 0xcc, 0x47, 0x56, 0xbd, 0xf2, 0x21, 0xb5, 0x6c, 0xb3, 0xca, 0xba, 0xe6, 0xed, 0x0e,
+	0x6b, 0xf7, 0xf2, 0xad, 0x36, 0x17, 0x9c, 0x2c, 0xaa, 0x99, 0x7c, 0x95, 0x75, 0xf5, 0x67, 0x69,
+	0xd3, 0xb2, 0xb9, 0x29, 0xff, 0x7a, 0x00, 0x3d, 0x55, 0xe7, 0x75, 0x2e, 0x1f, 0x4d, 0xf7, 0x09,
+	0xdf, 0x2e, 0xd7, 0x39, 0xaf, 0x37, 0x98, 0x49, 0x5b, 0x96, 0x49, 0x6d, 0x9b, 0x0b, 0x2a, 0x2c,
+	0x6e, 0x3b, 0x38, 0xbb, 0x55, 0xe1, 0x4e, 0x93, 0x3b, 0x66, 0x99, 0x3a, 0xcc, 0x8b, 0x66, 0xee,
+	0x6e, 0x97, 0x99, 0xa0, 0xdb, 0x66, 0x8b, 0xd6, 0x2d, 0x5b, 0x82, 0x11, 0xab, 0x07, 0xa8, 0x95,
+	0x3b, 0xbd, 0x32, 0xad, 0xdc, 0xc2, 0xb9, 0x5c, 0x60, 0xae, 0xda, 0xb3, 0x69, 0xd3, 0xaa, 0x94,
+	0x6a, 0x8c, 0xe1, 0x7c, 0x36, 0x30, 0xdf, 0xa2, 0x6d, 0xda, 0x54, 0x14, 0xd2, 0xc1, 0x29, 0xce,
+	0x1b, 0x38, 0x71, 0x2c, 0x34, 0xe1, 0x94, 0x9a, 0x4c, 0x50, 0x95, 0x58, 0x68, 0xba, 0xd4, 0xb1,
+	0xaa, 0xca, 0x6b, 0xce, 0x9f, 0x98, 0x4a, 0xa9, 0xc2, 0x2d, 0x4c, 0xc6, 0x48, 0x01, 0x79, 0xdb,
+	0x4d, 0xf7, 0x9a, 0xa4, 0x52, 0x64, 0xb7, 0x3b, 0xcc, 0x11, 0xc6, 0x5b, 0x70, 0x38, 0xf0, 0xd6,
+	0x69, 0x71, 0xdb, 0x61, 0xe4, 0x45, 0x48, 0x7a, 0x94, 0x33, 0xda, 0x8a, 0xb6, 0x71, 0xe8, 0x5c,
+	0x2a, 0xef, 0xef, 0x45, 0xde, 0x43, 0x17, 0x16, 0x1e, 0xfc, 0x79, 0x7c, 0xea, 0xe7, 0x7f, 0x7e,
+	0xd9, 0xd2, 0x8a, 0x08, 0x37, 0xf2, 0xe8, 0xef, 0x0a, 0x13, 0xd7, 0x38, 0x6f, 0x60, 0x18, 0x92,
+	0x86, 0x39, 0xc9, 0xd7, 0xaa, 0x4a, 0x87, 0x0b, 0xc5, 0xa4, 0x3b, 0xdc, 0xa9, 0x1a, 0x97, 0x20,
+	0x15, 0xc4, 0x23, 0x81, 0x33, 0x30, 0xe3, 0x22, 0x30, 0x3c, 0xd9, 0x13, 0x9e, 0xf3, 0x46, 0x61,
+	0xc6, 0x0d, 0x5e, 0x94, 0x28, 0xe3, 0x02, 0x1c, 0xf5, 0x7b, 0x29, 0xd0, 0x06, 0xb5, 0x2b, 0xcc,
+	0x19, 0x1b, 0xfd, 0x9e, 0x06, 0xcb, 0xd1, 0x86, 0x4f, 0x42, 0x83, 0xbc, 0x0a, 0xf3, 0x65, 0xf4,
+	0x90, 0x49, 0xac, 0x4c, 0x6f, 0x1c, 0x3a, 0x97, 0xcd, 0x7b, 0x5d, 0xc9, 0xbb, 0x5d, 0xc9, 0x63,
+	0x57, 0xf2, 0x17, 0xb9, 0x65, 0xa3, 0xe1, 0xd0, 0xc0, 0x78, 0x1f, 0x2b, 0xf7, 0x7a, 0xa3, 0xe1,
+	0xaf, 0xdc, 0x65, 0x80, 0xd1, 0xba, 0x44, 0x1e, 0x6b, 0x01, 0xaf, 0xde, 0x96, 0x51, 0xbe, 0xaf,
+	0xd1, 0x3a, 0x43, 0xdb, 0xa2, 0xcf, 0xd2, 0xf8, 0x42, 0x83, 0x54, 0xd0, 0x7f, 0x28, 0xc5, 0xe9,
+	0x09, 0x52, 0xbc, 0x12, 0xa0, 0x93, 0x90, 0x74, 0xd6, 0xc7, 0xd2, 0xf1, 0x42, 0x05, 0xf8, 0xe8,
+	0x90, 0xf1, 0x57, 0xde, 0x79, 0x93, 0x09, 0xaa, 0x16, 0xe5, 0xbb, 0x90, 0x8d, 0x98, 0x43, 0xbe,
+	0xaf, 0x01, 0x8c, 0x76, 0x06, 0x16, 0x24, 0x1d, 0x66, 0x2d, 0x8d, 0x90, 0xfa, 0x42, 0x4b, 0xbd,
+	0x30, 0x0a, 0x70, 0xc4, 0xef, 0xfa, 0xa6, 0x55, 0x55, 0x85, 0x26, 0x30, 0xe3, 0xf2, 0xc7, 0x15,
+	0x22, 0x9f, 0x49, 0x0a, 0x66, 0x6f, 0x77, 0xb8, 0x60, 0x32, 0xd1, 0x85, 0xa2, 0x37, 0x30, 0x6e,
+	0x40, 0x3a, 0xe4, 0x03, 0xc9, 0xbd, 0x0c, 0x0b, 0xc3, 0x7d, 0x89, 0xdc, 0x8e, 0x84, 0xb9, 0xdd,
+	0xb4, 0xaa, 0x8e, 0xea, 0x7f, 0x0b, 0xc7, 0x06, 0x85, 0xb4, 0xbf, 0x3f, 0xee, 0xbb, 0x83, 0x5e,
+	0x03, 0x3f, 0x68, 0x90, 0x09, 0xc7, 0x88, 0xa6, 0x3e, 0x3d, 0x39, 0xf5, 0x83, 0x5b, 0x14, 0x1f,
+	0xe0, 0x19, 0x75, 0xfd, 0x0e, 0x6d, 0xed, 0xd8, 0xe3, 0xb6, 0xaf, 0x3b, 0xe1, 0x1e, 0x70, 0x25,
+	0xcb, 0xc6, 0x06, 0x25, 0xdd, 0xe1, 0x8e, 0x4d, 0x32, 0x30, 0x47, 0xab, 0xd5, 0x36, 0x73, 0x9c,
+	0xcc, 0xb4, 0x9c, 0x50, 0x43, 0xe3, 0xa7, 0x04, 0x1c, 0x0e, 0x84, 0xc0, 0xec, 0x5f, 0x81, 0x79,
+	0xe9, 0x8a, 0x77, 0x04, 0x16, 0x78, 0xec, 0xd6, 0x95, 0xb1, 0xaf, 0x76, 0x04, 0xd9, 0x86, 0xe9,
+	0x1a, 0x63, 0x99, 0xc4, 0x64, 0x66, 0x2e, 0x96, 0x64, 0x61, 0xbe, 0xc6, 0x58, 0xa9, 0x4d, 0x05,
+	0x93, 0x0c, 0x97, 0x8a, 0x73, 0x35, 0xc6, 0x8a, 0x54, 0x30, 0x52, 0x80, 0x45, 0xcb, 0xae, 0xf0,
+	0xa6, 0x65, 0xd7, 0x4b, 0x82, 0x76, 0x33, 0x33, 0x93, 0xb8, 0xd5, 0x8a, 0x87, 0x94, 0xd1, 0x0d,
+	0xda, 0x75, 0x7d, 0xf0, 0x8e, 0xa8, 0x73, 0xe5, 0x63, 0x76, 0x42, 0x1f, 0xca, 0xe8, 0x06, 0xed,
+	0x1a, 0x15, 0x5f, 0xa1, 0xae, 0x76, 0xc4, 0xd8, 0x66, 0x64, 0x7d, 0x15, 0xf4, 0xba, 0x31, 0x2c,
+	0x50, 0x7c, 0x3b, 0x7e, 0x4c, 0x40, 0x2a, 0x18, 0x05, 0xfb, 0xf1, 0xd2, 0xa8, 0xb5, 0x13, 0xb6,
+	0x43, 0xf5, 0xfe, 0xbf, 0xd9, 0x8d, 0x17, 0x46, 0x47, 0xe2, 0x25, 0x4f, 0x6b, 0x5c, 0x66, 0x6c,
+	0xec, 0xfd, 0xf6, 0xab, 0x06, 0x7a, 0x94, 0xd9, 0xf0, 0x28, 0x4d, 0x56, 0xb8, 0x5d, 0xb3, 0xea,
+	0x58, 0xe3, 0x5c, 0x70, 0xbf, 0x8f, 0x2c, 0x2e, 0x4a, 0xd4, 0xa8, 0xd0, 0xee, 0x88, 0x14, 0x00,
+	0x76, 0x79, 0x83, 0x0a, 0xab, 0x61, 0x89, 0x1e, 0xd6, 0x7b, 0x39, 0x7c, 0x62, 0xbc, 0x33, 0xc4,
+	0xa0, 0xbd, 0xcf, 0x8a, 0x9c, 0x84, 0x25, 0x56, 0xab, 0xb1, 0x8a, 0xb0, 0x76, 0x99, 0x2b, 0x9e,
+	0xb0, 0xfc, 0x8b, 0xc3, 0x97, 0x97, 0x19, 0x33, 0x96, 0x47, 0x49, 0x14, 0x3c, 0x0d, 0xb6, 0x63,
+	0xd7, 0xb8, 0xba, 0x2c, 0xee, 0x6b, 0x70, 0x34, 0x72, 0x7a, 0x78, 0xae, 0x05, 0x93, 0x3c, 0x1a,
+	0xa4, 0x88, 0x26, 0x91, 0x19, 0x5e, 0x80, 0x59, 0x47, 0x50, 0xe1, 0x60, 0x72, 0x7a, 0xa4, 0xe5,
+	0x75, 0x17, 0x81, 0x86, 0x1e, 0xdc, 0xe8, 0xe2, 0x31, 0x2b, 0xef, 0xa1, 0x42, 0xef, 0x12, 0xb3,
+	0x79, 0x53, 0xf5, 0x2a, 0x05, 0xb3, 0x55, 0x77, 0x8c, 0x9d, 0xf2, 0x06, 0x7b, 0x4e, 0xf8, 0xc4,
+	0x13, 0x9f, 0xf0, 0x5f, 0x6b, 0x90, 0x8d, 0x08, 0xfd, 0x74, 0xaf, 0xfa, 0xbb, 0xd8, 0x3f, 0xe4,
+	0x74, 0xb1, 0xcd, 0xa8, 0xe0, 0x6d, 0x55, 0x90, 0x0c, 0xcc, 0x55, 0xbc, 0x37, 0x58, 0x12, 0x35,
+	0x3c, 0xb0, 0xa2, 0x7c, 0xab, 0x56, 0xc8, 0x5e, 0x02, 0x4f, 0xb5, 0x2c, 0xe7, 0xfe, 0x58, 0x82,
+	0x59, 0x49, 0x8b, 0xdc, 0x82, 0xa4, 0xa7, 0xa8, 0xc9, 0x4a, 0x30, 0x78, 0x58, 0xb0, 0xeb, 0x27,
+	0xf6, 0x41, 0x78, 0x41, 0x8c, 0xe5, 0x4f, 0x7f, 0xfb, 0xfb, 0x9b, 0xc4, 0x11, 0x92, 0x32, 0x23,
+	0xbe, 0x41, 0xc8, 0x2e, 0xcc, 0xa1, 0x70, 0x21, 0x51, 0xbe, 0x82, 0xc2, 0x5d, 0x37, 0xf6, 0x83,
+	0x60, 0xbc, 0x53, 0x32, 0x5e, 0x8e, 0x2c, 0x9b, 0xa1, 0x0f, 0x14, 0xb3, 0x8f, 0x07, 0xd3, 0x80,
+	0x7c, 0xa7, 0xc1, 0xff, 0xf7, 0xc8, 0x6c, 0xb2, 0x19, 0xef, 0x7d, 0x8f, 0x86, 0xd7, 0xb7, 0x26,
+	0x81, 0x22, 0xa1, 0xb3, 0x92, 0xd0, 0x3a, 0x59, 0x0d, 0x13, 0x3a, 0xab, 0xf4, 0xb6, 0x8f, 0x59,
+	0x13, 0xe6, 0xdf, 0xb0, 0x9c, 0xf8, 0x92, 0x04, 0x15, 0xb9, 0x6e, 0xec, 0x07, 0x41, 0x06, 0xba,
+	0x64, 0x90, 0x22, 0x24, 0xcc, 0x80, 0x7c, 0xa2, 0xc1, 0xa2, 0x5f, 0xd9, 0x92, 0xb5, 0xf8, 0xd4,
+	0xfc, 0xb2, 0x58, 0x5f, 0x1f, 0x8b, 0xc3, 0xe8, 0x2b, 0x32, 0xba, 0x4e, 0x32, 0x66, 0xcc, 0x07,
+	0x25, 0xb9, 0xa7, 0x01, 0x8c, 0xe4, 0x2b, 0x39, 0x15, 0xef, 0x79, 0xa4, 0x90, 0xf5, 0xd5, 0x31,
+	0x28, 0x8c, 0x9e, 0x97, 0xd1, 0x37, 0xc8, 0x9a, 0x19, 0xfd, 0xbd, 0x6a, 0xf6, 0xdd, 0x5d, 0x32,
+	0x30, 0xfb, 0x52, 0x4d, 0x0f, 0xc8, 0x5d, 0x58, 0x54, 0xe5, 0x97, 0x6a, 0x72, 0x35, 0xbe, 0xbe,
+	0x3e, 0x51, 0xac, 0xaf, 0x8d, 0x83, 0x21, 0x9d, 0xe3, 0x92, 0x4e, 0x96, 0xa4, 0x63, 0xe8, 0x90,
+	0x8f, 0x35, 0x48, 0x7a, 0x6a, 0x30, 0x72, 0xfb, 0x05, 0xb4, 0xa8, 0x7e, 0x62, 0x1f, 0x04, 0x06,
+	0xdc, 0x96, 0x01, 0x4f, 0x93, 0xcd, 0x60, 0x40, 0xe7, 0x0e, 0x6d, 0x95, 0x2c, 0x7b, 0xb4, 0xee,
+	0xcc, 0x3e, 0x2a, 0x9c, 0x01, 0xf9, 0x5c, 0x83, 0x39, 0x54, 0x40, 0x24, 0x2e, 0xc2, 0x48, 0x83,
+	0xe9, 0xc6, 0x7e, 0x10, 0x64, 0x71, 0x5e, 0xb2, 0x38, 0x4b, 0x4e, 0x47, 0xb0, 0xe0, 0x1d, 0x11,
+	0xa2, 0xc1, 0x3b, 0x62, 0x40, 0xbe, 0xd4, 0x60, 0x29, 0x20, 0x15, 0x48, 0xcc, 0x9a, 0x0b, 0x69,
+	0x10, 0x7d, 0x63, 0x3c, 0x10, 0x99, 0x9d, 0x96, 0xcc, 0x56, 0xc9, 0x49, 0x33, 0xee, 0x27, 0x14,
+	0xdf, 0xde, 0xfc, 0x4c, 0x83, 0xff, 0x05, 0x2f, 0x76, 0x12, 0x13, 0x29, 0x2c, 0x0d, 0xf4, 0xcd,
+	0x09, 0x90, 0x48, 0xea, 0x98, 0x24, 0x95, 0x26, 0xcf, 0x99, 0x51, 0xbf, 0xf9, 0x90, 0xaf, 0x34,
+	0x78, 0x46, 0x2d, 0x52, 0x75, 0xad, 0x46, 0xee, 0xdb, 0x88, 0x2b, 0x5f, 0x5f, 0x1f, 0x8b, 0x43,
+	0x12, 0x67, 0x24, 0x89, 0x35, 0x72, 0x2a, 0x6a, 0xdf, 0x96, 0x7b, 0x25, 0xa9, 0x15, 0xcc, 0xbe,
+	0xfc, 0x37, 0x20, 0xdf, 0x6b, 0x40, 0x7c, 0x9c, 0xf0, 0x56, 0x8b, 0x2c, 0x4f, 0xe4, 0xcd, 0xab,
+	0x6f, 0x4e, 0x80, 0x44, 0x66, 0xcf, 0x4b, 0x66, 0x5b, 0x64, 0x23, 0x86, 0x19, 0x5e, 0xd9, 0x66,
+	0x1f, 0x1f, 0x06, 0x85, 0xfc, 0x83, 0x47, 0x39, 0xed, 0xe1, 0xa3, 0x9c, 0xf6, 0xd7, 0xa3, 0x9c,
+	0x76, 0xff, 0x71, 0x6e, 0xea, 0xe1, 0xe3, 0xdc, 0xd4, 0xef, 0x8f, 0x73, 0x53, 0xef, 0xa5, 0x86,
+	0x2e, 0xba, 0xd2, 0x89, 0xe8, 0xb5, 0x98, 0x53, 0x4e, 0xca, 0x5f, 0xa9, 0xce, 0xff, 0x3b, 0x00,
+	0x61, 0x20, 0x0b, 0x81, 0x0f, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of Pool items.
+	GetPool(ctx context.Context, in *QueryGetPoolRequest, opts ...grpc.CallOption) (*QueryGetPoolResponse, error)
+	// Queries a list of Pool items.
+	GetPoolBalances(ctx context.Context, in *QueryGetPoolBalancesRequest, opts ...grpc.CallOption) (*QueryGetPoolBalancesResponse, error)
+	// Queries a list of Pool items.
+	ListPool(ctx context.Context, in *QueryAllPoolRequest, opts ...grpc.CallOption) (*QueryAllPoolResponse, error)
+	// Queries a PoolsMeta by index.
+	GetPoolsMeta(ctx context.Context, in *QueryGetPoolsMetaRequest, opts ...grpc.CallOption) (*QueryGetPoolsMetaResponse, error)
+	// Queries a list of PoolUids items.
+	GetPoolUid(ctx context.Context, in *QueryGetPoolUidRequest, opts ...grpc.CallOption) (*QueryGetPoolUidResponse, error)
+	// Queries a list of PoolUids items.
+	ListPoolUids(ctx context.Context, in *QueryAllPoolUidsRequest, opts ...grpc.CallOption) (*QueryAllPoolUidsResponse, error)
+	// Queries a list of SwapIn items.
+	SwapIn(ctx context.Context, in *QuerySwapInRequest, opts ...grpc.CallOption) (*QuerySwapInResponse, error)
+	// Queries a list of SwapIn items.
+	SwapOut(ctx context.Context, in *QuerySwapOutRequest, opts ...grpc.CallOption) (*QuerySwapOutResponse, error)
+	// Queries the dynamic fee configuration and state of a pool.
+	GetDynamicFee(ctx context.Context, in *QueryGetDynamicFeeRequest, opts ...grpc.CallOption) (*QueryGetDynamicFeeResponse, error)
+	// Queries the buyback configuration and cumulative totals.
+	GetBuybackInfo(ctx context.Context, in *QueryGetBuybackInfoRequest, opts ...grpc.CallOption) (*QueryGetBuybackInfoResponse, error)
+	// Queries a list of Pool items trading a denom.
+	ListPoolsByDenom(ctx context.Context, in *QueryPoolsByDenomRequest, opts ...grpc.CallOption) (*QueryPoolsByDenomResponse, error)
+	// Queries a list of Pool items created by an address.
+	ListPoolsByCreator(ctx context.Context, in *QueryPoolsByCreatorRequest, opts ...grpc.CallOption) (*QueryPoolsByCreatorResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetPoolBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetPool(ctx context.Context, in *QueryGetPoolRequest, opts ...grpc.CallOption) (*QueryGetPoolResponse, error) {
+	out := new(QueryGetPoolResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/GetPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetPoolBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Balances) > 0 {
-		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetPoolBalances(ctx context.Context, in *QueryGetPoolBalancesRequest, opts ...grpc.CallOption) (*QueryGetPoolBalancesResponse, error) {
+	out := new(QueryGetPoolBalancesResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/GetPoolBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListPool(ctx context.Context, in *QueryAllPoolRequest, opts ...grpc.CallOption) (*QueryAllPoolResponse, error) {
+	out := new(QueryAllPoolResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/ListPool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryAllPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetPoolsMeta(ctx context.Context, in *QueryGetPoolsMetaRequest, opts ...grpc.CallOption) (*QueryGetPoolsMetaResponse, error) {
+	out := new(QueryGetPoolsMetaResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/GetPoolsMeta", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pool) > 0 {
-		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetPoolsMetaRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetPoolUid(ctx context.Context, in *QueryGetPoolUidRequest, opts ...grpc.CallOption) (*QueryGetPoolUidResponse, error) {
+	out := new(QueryGetPoolUidResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/GetPoolUid", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetPoolsMetaRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetPoolsMetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetPoolsMetaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListPoolUids(ctx context.Context, in *QueryAllPoolUidsRequest, opts ...grpc.CallOption) (*QueryAllPoolUidsResponse, error) {
+	out := new(QueryAllPoolUidsResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/ListPoolUids", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetPoolsMetaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetPoolsMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PoolsMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) SwapIn(ctx context.Context, in *QuerySwapInRequest, opts ...grpc.CallOption) (*QuerySwapInResponse, error) {
+	out := new(QuerySwapInResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/SwapIn", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetPoolUidRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SwapOut(ctx context.Context, in *QuerySwapOutRequest, opts ...grpc.CallOption) (*QuerySwapOutResponse, error) {
+	out := new(QuerySwapOutResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/SwapOut", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetPoolUidRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetPoolUidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Quote) > 0 {
-		i -= len(m.Quote)
-		copy(dAtA[i:], m.Quote)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quote)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Base) > 0 {
-		i -= len(m.Base)
-		copy(dAtA[i:], m.Base)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Base)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetDynamicFee(ctx context.Context, in *QueryGetDynamicFeeRequest, opts ...grpc.CallOption) (*QueryGetDynamicFeeResponse, error) {
+	out := new(QueryGetDynamicFeeResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/GetDynamicFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetPoolUidResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetBuybackInfo(ctx context.Context, in *QueryGetBuybackInfoRequest, opts ...grpc.CallOption) (*QueryGetBuybackInfoResponse, error) {
+	out := new(QueryGetBuybackInfoResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/GetBuybackInfo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetPoolUidResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetPoolUidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PoolUids.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ListPoolsByDenom(ctx context.Context, in *QueryPoolsByDenomRequest, opts ...grpc.CallOption) (*QueryPoolsByDenomResponse, error) {
+	out := new(QueryPoolsByDenomResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/ListPoolsByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllPoolUidsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListPoolsByCreator(ctx context.Context, in *QueryPoolsByCreatorRequest, opts ...grpc.CallOption) (*QueryPoolsByCreatorResponse, error) {
+	out := new(QueryPoolsByCreatorResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.dex.Query/ListPoolsByCreator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllPoolUidsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of Pool items.
+	GetPool(context.Context, *QueryGetPoolRequest) (*QueryGetPoolResponse, error)
+	// Queries a list of Pool items.
+	GetPoolBalances(context.Context, *QueryGetPoolBalancesRequest) (*QueryGetPoolBalancesResponse, error)
+	// Queries a list of Pool items.
+	ListPool(context.Context, *QueryAllPoolRequest) (*QueryAllPoolResponse, error)
+	// Queries a PoolsMeta by index.
+	GetPoolsMeta(context.Context, *QueryGetPoolsMetaRequest) (*QueryGetPoolsMetaResponse, error)
+	// Queries a list of PoolUids items.
+	GetPoolUid(context.Context, *QueryGetPoolUidRequest) (*QueryGetPoolUidResponse, error)
+	// Queries a list of PoolUids items.
+	ListPoolUids(context.Context, *QueryAllPoolUidsRequest) (*QueryAllPoolUidsResponse, error)
+	// Queries a list of SwapIn items.
+	SwapIn(context.Context, *QuerySwapInRequest) (*QuerySwapInResponse, error)
+	// Queries a list of SwapIn items.
+	SwapOut(context.Context, *QuerySwapOutRequest) (*QuerySwapOutResponse, error)
+	// Queries the dynamic fee configuration and state of a pool.
+	GetDynamicFee(context.Context, *QueryGetDynamicFeeRequest) (*QueryGetDynamicFeeResponse, error)
+	// Queries the buyback configuration and cumulative totals.
+	GetBuybackInfo(context.Context, *QueryGetBuybackInfoRequest) (*QueryGetBuybackInfoResponse, error)
+	// Queries a list of Pool items trading a denom.
+	ListPoolsByDenom(context.Context, *QueryPoolsByDenomRequest) (*QueryPoolsByDenomResponse, error)
+	// Queries a list of Pool items created by an address.
+	ListPoolsByCreator(context.Context, *QueryPoolsByCreatorRequest) (*QueryPoolsByCreatorResponse, error)
 }
 
-func (m *QueryAllPoolUidsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryAllPoolUidsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryAllPoolUidsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetPool(ctx context.Context, req *QueryGetPoolRequest) (*QueryGetPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPool not implemented")
 }
-
-func (m *QueryAllPoolUidsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PoolUids) > 0 {
-		for iNdEx := len(m.PoolUids) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PoolUids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetPoolBalances(ctx context.Context, req *QueryGetPoolBalancesRequest) (*QueryGetPoolBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoolBalances not implemented")
 }
-
-func (m *QuerySwapInRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ListPool(ctx context.Context, req *QueryAllPoolRequest) (*QueryAllPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPool not implemented")
+}
+func (*UnimplementedQueryServer) GetPoolsMeta(ctx context.Context, req *QueryGetPoolsMetaRequest) (*QueryGetPoolsMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoolsMeta not implemented")
+}
+func (*UnimplementedQueryServer) GetPoolUid(ctx context.Context, req *QueryGetPoolUidRequest) (*QueryGetPoolUidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoolUid not implemented")
+}
+func (*UnimplementedQueryServer) ListPoolUids(ctx context.Context, req *QueryAllPoolUidsRequest) (*QueryAllPoolUidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPoolUids not implemented")
+}
+func (*UnimplementedQueryServer) SwapIn(ctx context.Context, req *QuerySwapInRequest) (*QuerySwapInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapIn not implemented")
+}
+func (*UnimplementedQueryServer) SwapOut(ctx context.Context, req *QuerySwapOutRequest) (*QuerySwapOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapOut not implemented")
+}
+func (*UnimplementedQueryServer) GetDynamicFee(ctx context.Context, req *QueryGetDynamicFeeRequest) (*QueryGetDynamicFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDynamicFee not implemented")
+}
+func (*UnimplementedQueryServer) GetBuybackInfo(ctx context.Context, req *QueryGetBuybackInfoRequest) (*QueryGetBuybackInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuybackInfo not implemented")
+}
+func (*UnimplementedQueryServer) ListPoolsByDenom(ctx context.Context, req *QueryPoolsByDenomRequest) (*QueryPoolsByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPoolsByDenom not implemented")
+}
+func (*UnimplementedQueryServer) ListPoolsByCreator(ctx context.Context, req *QueryPoolsByCreatorRequest) (*QueryPoolsByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPoolsByCreator not implemented")
 }
 
-func (m *QuerySwapInRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QuerySwapInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CoinIn) > 0 {
-		i -= len(m.CoinIn)
-		copy(dAtA[i:], m.CoinIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CoinIn)))
-		i--
-		dAtA[i] = 0x12
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.PoolId) > 0 {
-		i -= len(m.PoolId)
-		copy(dAtA[i:], m.PoolId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.dex.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapInResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPoolRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySwapInResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GetPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.dex.Query/GetPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPool(ctx, req.(*QueryGetPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_GetPoolBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPoolBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.CoinOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).GetPoolBalances(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.dex.Query/GetPoolBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPoolBalances(ctx, req.(*QueryGetPoolBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapOutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ListPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPoolRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ListPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.dex.Query/ListPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPool(ctx, req.(*QueryAllPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapOutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetPoolsMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPoolsMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPoolsMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.dex.Query/GetPoolsMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPoolsMeta(ctx, req.(*QueryGetPoolsMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CoinOut) > 0 {
-		i -= len(m.CoinOut)
-		copy(dAtA[i:], m.CoinOut)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CoinOut)))
-		i--
-		dAtA[i] = 0x12
+func _Query_GetPoolUid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPoolUidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.PoolId) > 0 {
-		i -= len(m.PoolId)
-		copy(dAtA[i:], m.PoolId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GetPoolUid(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.dex.Query/GetPoolUid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPoolUid(ctx, req.(*QueryGetPoolUidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapOutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ListPoolUids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPoolUidsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ListPoolUids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.dex.Query/ListPoolUids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPoolUids(ctx, req.(*QueryAllPoolUidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySwapOutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySwapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.CoinIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_SwapIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if interceptor == nil {
+		return srv.(QueryServer).SwapIn(ctx, in)
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.dex.Query/SwapIn",
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapIn(ctx, req.(*QuerySwapInRequest))
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PoolId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func _Query_SwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *QueryGetPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).SwapOut(ctx, in)
 	}
-	var l int
-	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryGetPoolBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.dex.Query/SwapOut",
 	}
-	var l int
-	_ = l
-	l = len(m.PoolId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapOut(ctx, req.(*QuerySwapOutRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPoolBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetDynamicFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDynamicFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDynamicFee(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryAllPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.dex.Query/GetDynamicFee",
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDynamicFee(ctx, req.(*QueryGetDynamicFeeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetBuybackInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBuybackInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Pool) > 0 {
-		for _, e := range m.Pool {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetBuybackInfo(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.dex.Query/GetBuybackInfo",
 	}
-	return n
-}
-
-func (m *QueryGetPoolsMetaRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBuybackInfo(ctx, req.(*QueryGetBuybackInfoRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPoolsMetaResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListPoolsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.PoolsMeta.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryGetPoolUidRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).ListPoolsByDenom(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Base)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.dex.Query/ListPoolsByDenom",
 	}
-	l = len(m.Quote)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPoolsByDenom(ctx, req.(*QueryPoolsByDenomRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPoolUidResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListPoolsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.PoolUids.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryAllPoolUidsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).ListPoolsByCreator(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.dex.Query/ListPoolsByCreator",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPoolsByCreator(ctx, req.(*QueryPoolsByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllPoolUidsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PoolUids) > 0 {
-		for _, e := range m.PoolUids {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "zigchain.dex.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetPool",
+			Handler:    _Query_GetPool_Handler,
+		},
+		{
+			MethodName: "GetPoolBalances",
+			Handler:    _Query_GetPoolBalances_Handler,
+		},
+		{
+			MethodName: "ListPool",
+			Handler:    _Query_ListPool_Handler,
+		},
+		{
+			MethodName: "GetPoolsMeta",
+			Handler:    _Query_GetPoolsMeta_Handler,
+		},
+		{
+			MethodName: "GetPoolUid",
+			Handler:    _Query_GetPoolUid_Handler,
+		},
+		{
+			MethodName: "ListPoolUids",
+			Handler:    _Query_ListPoolUids_Handler,
+		},
+		{
+			MethodName: "SwapIn",
+			Handler:    _Query_SwapIn_Handler,
+		},
+		{
+			MethodName: "SwapOut",
+			Handler:    _Query_SwapOut_Handler,
+		},
+		{
+			MethodName: "GetDynamicFee",
+			Handler:    _Query_GetDynamicFee_Handler,
+		},
+		{
+			MethodName: "GetBuybackInfo",
+			Handler:    _Query_GetBuybackInfo_Handler,
+		},
+		{
+			MethodName: "ListPoolsByDenom",
+			Handler:    _Query_ListPoolsByDenom_Handler,
+		},
+		{
+			MethodName: "ListPoolsByCreator",
+			Handler:    _Query_ListPoolsByCreator_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "zigchain/dex/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPoolBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPoolBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPoolBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPoolBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPoolBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPoolBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pool) > 0 {
+		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPoolsMetaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPoolsMetaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPoolsMetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPoolsMetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPoolsMetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPoolsMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PoolsMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPoolUidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPoolUidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPoolUidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPoolUidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPoolUidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPoolUidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PoolUids.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPoolUidsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPoolUidsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPoolUidsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPoolUidsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPoolUidsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPoolUidsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PoolUids) > 0 {
+		for iNdEx := len(m.PoolUids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolUids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CoinIn) > 0 {
+		i -= len(m.CoinIn)
+		copy(dAtA[i:], m.CoinIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CoinIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutgoingTax != nil {
+		{
+			size, err := m.OutgoingTax.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.IncomingTax != nil {
+		{
+			size, err := m.IncomingTax.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FeeRate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FeeRate))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CoinOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CoinOut) > 0 {
+		i -= len(m.CoinOut)
+		copy(dAtA[i:], m.CoinOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CoinOut)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutgoingTax != nil {
+		{
+			size, err := m.OutgoingTax.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.IncomingTax != nil {
+		{
+			size, err := m.IncomingTax.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FeeRate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FeeRate))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CoinIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDynamicFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDynamicFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDynamicFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDynamicFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDynamicFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDynamicFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveFee != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EffectiveFee))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Volatility.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBuybackInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBuybackInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBuybackInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBuybackInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBuybackInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBuybackInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsByDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pool) > 0 {
+		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsByCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsByCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pool) > 0 {
+		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPoolBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPoolBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for _, e := range m.Pool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPoolsMetaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetPoolsMetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PoolsMeta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPoolUidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPoolUidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PoolUids.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPoolUidsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPoolUidsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolUids) > 0 {
+		for _, e := range m.PoolUids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CoinIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoinOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.FeeRate != 0 {
+		n += 1 + sovQuery(uint64(m.FeeRate))
+	}
+	if m.IncomingTax != nil {
+		l = m.IncomingTax.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OutgoingTax != nil {
+		l = m.OutgoingTax.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CoinOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoinIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.FeeRate != 0 {
+		n += 1 + sovQuery(uint64(m.FeeRate))
+	}
+	if m.IncomingTax != nil {
+		l = m.IncomingTax.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OutgoingTax != nil {
+		l = m.OutgoingTax.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDynamicFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDynamicFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Volatility.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EffectiveFee != 0 {
+		n += 1 + sovQuery(uint64(m.EffectiveFee))
+	}
+	return n
+}
+
+func (m *QueryGetBuybackInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetBuybackInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for _, e := range m.Pool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsByCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for _, e := range m.Pool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPoolBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPoolBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPoolBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPoolBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPoolBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPoolBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = append(m.Pool, Pool{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPoolsMetaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPoolsMetaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPoolsMetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPoolsMetaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPoolsMetaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPoolsMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolsMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolsMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QuerySwapInRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PoolId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.CoinIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QuerySwapInResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetPoolUidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPoolUidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPoolUidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.CoinOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Fee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *QuerySwapOutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PoolId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.CoinOut)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QuerySwapOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetPoolUidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPoolUidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPoolUidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolUids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolUids.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.CoinIn.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Fee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllPoolUidsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2310,12 +4573,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllPoolUidsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllPoolUidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2337,7 +4636,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllPoolUidsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2360,15 +4659,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllPoolUidsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllPoolUidsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolUids", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2395,7 +4694,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolUids = append(m.PoolUids, PoolUids{})
+			if err := m.PoolUids[len(m.PoolUids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2420,7 +4756,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapInRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2443,10 +4779,10 @@ func (m *QueryGetPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapInRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2481,6 +4817,70 @@ func (m *QueryGetPoolRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PoolId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2502,7 +4902,7 @@ func (m *QueryGetPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2525,15 +4925,15 @@ func (m *QueryGetPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2560,65 +4960,103 @@ func (m *QueryGetPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetPoolBalancesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			m.FeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRate |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncomingTax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.IncomingTax == nil {
+				m.IncomingTax = &types.Coin{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPoolBalancesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPoolBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.IncomingTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTax", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2628,23 +5066,27 @@ func (m *QueryGetPoolBalancesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolId = string(dAtA[iNdEx:postIndex])
+			if m.OutgoingTax == nil {
+				m.OutgoingTax = &types.Coin{}
+			}
+			if err := m.OutgoingTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2667,7 +5109,7 @@ func (m *QueryGetPoolBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPoolBalancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapOutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2690,17 +5132,17 @@ func (m *QueryGetPoolBalancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPoolBalancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapOutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPoolBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2710,30 +5152,29 @@ func (m *QueryGetPoolBalancesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PoolId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2743,81 +5184,29 @@ func (m *QueryGetPoolBalancesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, types.Coin{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CoinOut = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllPoolRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPoolRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2827,27 +5216,23 @@ func (m *QueryAllPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2870,7 +5255,7 @@ func (m *QueryAllPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySwapOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2893,15 +5278,15 @@ func (m *QueryAllPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySwapOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySwapOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2928,14 +5313,13 @@ func (m *QueryAllPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pool = append(m.Pool, Pool{})
-			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2962,116 +5346,68 @@ func (m *QueryAllPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetPoolsMetaRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPoolsMetaRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPoolsMetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.FeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRate |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncomingTax", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetPoolsMetaResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.IncomingTax == nil {
+				m.IncomingTax = &types.Coin{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPoolsMetaResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPoolsMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.IncomingTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolsMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTax", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3098,7 +5434,10 @@ func (m *QueryGetPoolsMetaResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolsMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OutgoingTax == nil {
+				m.OutgoingTax = &types.Coin{}
+			}
+			if err := m.OutgoingTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3123,7 +5462,7 @@ func (m *QueryGetPoolsMetaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPoolUidRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDynamicFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3146,47 +5485,15 @@ func (m *QueryGetPoolUidRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPoolUidRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDynamicFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPoolUidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDynamicFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Base = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3214,7 +5521,7 @@ func (m *QueryGetPoolUidRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quote = string(dAtA[iNdEx:postIndex])
+			m.PoolId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3237,7 +5544,7 @@ func (m *QueryGetPoolUidRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPoolUidResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDynamicFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3260,15 +5567,15 @@ func (m *QueryGetPoolUidResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPoolUidResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDynamicFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPoolUidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDynamicFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolUids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3295,10 +5602,62 @@ func (m *QueryGetPoolUidResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolUids.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFee", wireType)
+			}
+			m.EffectiveFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveFee |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3320,7 +5679,7 @@ func (m *QueryGetPoolUidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPoolUidsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBuybackInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3343,48 +5702,12 @@ func (m *QueryAllPoolUidsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPoolUidsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBuybackInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPoolUidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBuybackInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3406,7 +5729,7 @@ func (m *QueryAllPoolUidsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPoolUidsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBuybackInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3429,15 +5752,15 @@ func (m *QueryAllPoolUidsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPoolUidsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBuybackInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPoolUidsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBuybackInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolUids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3464,14 +5787,13 @@ func (m *QueryAllPoolUidsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolUids = append(m.PoolUids, PoolUids{})
-			if err := m.PoolUids[len(m.PoolUids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3498,10 +5820,7 @@ func (m *QueryAllPoolUidsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3526,7 +5845,7 @@ func (m *QueryAllPoolUidsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapInRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsByDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3549,15 +5868,15 @@ func (m *QuerySwapInRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapInRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsByDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3585,13 +5904,13 @@ func (m *QuerySwapInRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolId = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3601,23 +5920,27 @@ func (m *QuerySwapInRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CoinIn = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3640,7 +5963,7 @@ func (m *QuerySwapInRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapInResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsByDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3663,15 +5986,15 @@ func (m *QuerySwapInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsByDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3698,13 +6021,14 @@ func (m *QuerySwapInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pool = append(m.Pool, Pool{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3731,7 +6055,10 @@ func (m *QuerySwapInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3756,7 +6083,7 @@ func (m *QuerySwapInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapOutRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsByCreatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3779,15 +6106,15 @@ func (m *QuerySwapOutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapOutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsByCreatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3815,13 +6142,13 @@ func (m *QuerySwapOutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolId = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3831,23 +6158,27 @@ func (m *QuerySwapOutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CoinOut = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3870,7 +6201,7 @@ func (m *QuerySwapOutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySwapOutResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolsByCreatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3893,15 +6224,15 @@ func (m *QuerySwapOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySwapOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolsByCreatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySwapOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolsByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3928,13 +6259,14 @@ func (m *QuerySwapOutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pool = append(m.Pool, Pool{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3961,7 +6293,10 @@ func (m *QuerySwapOutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
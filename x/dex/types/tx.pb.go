@@ -355,11 +355,18 @@ type MsgSwapExactInResponse struct {
 	// outgoing is the amount of outgoing token user received
 	Outgoing types.Coin `protobuf:"bytes,3,opt,name=outgoing,proto3" json:"outgoing"`
 	// fee is the amount of fee taken from the incoming token
-	Fee      types.Coin `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee"`
-	Receiver string     `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Fee types.Coin `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee"`
+	//
+	Receiver string `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	// outgoing_min is the minimum amount of outgoing token to receive, copied
 	// from request
 	OutgoingMin *types.Coin `protobuf:"bytes,6,opt,name=outgoing_min,json=outgoingMin,proto3" json:"outgoing_min,omitempty"`
+	// incoming_tax is the transfer tax of the incoming token paid on top of it,
+	// set when the incoming token is taxed
+	IncomingTax *types.Coin `protobuf:"bytes,7,opt,name=incoming_tax,json=incomingTax,proto3" json:"incoming_tax,omitempty"`
+	// outgoing_tax is the transfer tax taken out of the outgoing token, set when
+	// the outgoing token is taxed
+	OutgoingTax *types.Coin `protobuf:"bytes,8,opt,name=outgoing_tax,json=outgoingTax,proto3" json:"outgoing_tax,omitempty"`
 }
 
 func (m *MsgSwapExactInResponse) Reset()         { *m = MsgSwapExactInResponse{} }
@@ -437,6 +444,20 @@ func (m *MsgSwapExactInResponse) GetOutgoingMin() *types.Coin {
 	return nil
 }
 
+func (m *MsgSwapExactInResponse) GetIncomingTax() *types.Coin {
+	if m != nil {
+		return m.IncomingTax
+	}
+	return nil
+}
+
+func (m *MsgSwapExactInResponse) GetOutgoingTax() *types.Coin {
+	if m != nil {
+		return m.OutgoingTax
+	}
+	return nil
+}
+
 // MsgSwap swaps tokens from one to another
 type MsgSwapExactOut struct {
 	Signer   string     `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
@@ -534,6 +555,12 @@ type MsgSwapExactOutResponse struct {
 	// incoming_max is the maximum amount of incoming token to pay, or swap will
 	// fail noinspection ProtoFieldName
 	IncomingMax *types.Coin `protobuf:"bytes,6,opt,name=incoming_max,json=incomingMax,proto3" json:"incoming_max,omitempty"`
+	// incoming_tax is the transfer tax of the incoming token paid on top of it,
+	// set when the incoming token is taxed
+	IncomingTax *types.Coin `protobuf:"bytes,7,opt,name=incoming_tax,json=incomingTax,proto3" json:"incoming_tax,omitempty"`
+	// outgoing_tax is the transfer tax added to the outgoing token and taken out
+	// of it, set when the outgoing token is taxed
+	OutgoingTax *types.Coin `protobuf:"bytes,8,opt,name=outgoing_tax,json=outgoingTax,proto3" json:"outgoing_tax,omitempty"`
 }
 
 func (m *MsgSwapExactOutResponse) Reset()         { *m = MsgSwapExactOutResponse{} }
@@ -611,6 +638,20 @@ func (m *MsgSwapExactOutResponse) GetIncomingMax() *types.Coin {
 	return nil
 }
 
+func (m *MsgSwapExactOutResponse) GetIncomingTax() *types.Coin {
+	if m != nil {
+		return m.IncomingTax
+	}
+	return nil
+}
+
+func (m *MsgSwapExactOutResponse) GetOutgoingTax() *types.Coin {
+	if m != nil {
+		return m.OutgoingTax
+	}
+	return nil
+}
+
 // MsgAddLiquidity adds liquidity to the pool, from the base and quote tokens
 // send in
 type MsgAddLiquidity struct {
@@ -825,6 +866,12 @@ func (m *MsgRemoveLiquidity) GetReceiver() string {
 type MsgRemoveLiquidityResponse struct {
 	Base  types.Coin `protobuf:"bytes,1,opt,name=base,proto3" json:"base"`
 	Quote types.Coin `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote"`
+	// base_tax is the transfer tax taken out of the base token, set when the
+	// base token is taxed
+	BaseTax *types.Coin `protobuf:"bytes,3,opt,name=base_tax,json=baseTax,proto3" json:"base_tax,omitempty"`
+	// quote_tax is the transfer tax taken out of the quote token, set when the
+	// quote token is taxed
+	QuoteTax *types.Coin `protobuf:"bytes,4,opt,name=quote_tax,json=quoteTax,proto3" json:"quote_tax,omitempty"`
 }
 
 func (m *MsgRemoveLiquidityResponse) Reset()         { *m = MsgRemoveLiquidityResponse{} }
@@ -874,6 +921,208 @@ func (m *MsgRemoveLiquidityResponse) GetQuote() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgRemoveLiquidityResponse) GetBaseTax() *types.Coin {
+	if m != nil {
+		return m.BaseTax
+	}
+	return nil
+}
+
+func (m *MsgRemoveLiquidityResponse) GetQuoteTax() *types.Coin {
+	if m != nil {
+		return m.QuoteTax
+	}
+	return nil
+}
+
+// MsgUpdateDynamicFeeConfig sets the dynamic fee configuration of a pool
+type MsgUpdateDynamicFeeConfig struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// config is the dynamic fee configuration, pool_id selects the pool
+	Config DynamicFeeConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+}
+
+func (m *MsgUpdateDynamicFeeConfig) Reset()         { *m = MsgUpdateDynamicFeeConfig{} }
+func (m *MsgUpdateDynamicFeeConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDynamicFeeConfig) ProtoMessage()    {}
+func (*MsgUpdateDynamicFeeConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa65719e3e8b3b41, []int{12}
+}
+func (m *MsgUpdateDynamicFeeConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDynamicFeeConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDynamicFeeConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDynamicFeeConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDynamicFeeConfig.Merge(m, src)
+}
+func (m *MsgUpdateDynamicFeeConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDynamicFeeConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDynamicFeeConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDynamicFeeConfig proto.InternalMessageInfo
+
+func (m *MsgUpdateDynamicFeeConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateDynamicFeeConfig) GetConfig() DynamicFeeConfig {
+	if m != nil {
+		return m.Config
+	}
+	return DynamicFeeConfig{}
+}
+
+// MsgUpdateDynamicFeeConfigResponse defines the response structure for executing
+// MsgUpdateDynamicFeeConfig message.
+type MsgUpdateDynamicFeeConfigResponse struct {
+}
+
+func (m *MsgUpdateDynamicFeeConfigResponse) Reset()         { *m = MsgUpdateDynamicFeeConfigResponse{} }
+func (m *MsgUpdateDynamicFeeConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDynamicFeeConfigResponse) ProtoMessage()    {}
+func (*MsgUpdateDynamicFeeConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa65719e3e8b3b41, []int{13}
+}
+func (m *MsgUpdateDynamicFeeConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDynamicFeeConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDynamicFeeConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDynamicFeeConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDynamicFeeConfigResponse.Merge(m, src)
+}
+func (m *MsgUpdateDynamicFeeConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDynamicFeeConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDynamicFeeConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDynamicFeeConfigResponse proto.InternalMessageInfo
+
+// MsgUpdateBuybackConfig sets the buyback-and-burn configuration
+type MsgUpdateBuybackConfig struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// config is the buyback configuration
+	Config BuybackConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+}
+
+func (m *MsgUpdateBuybackConfig) Reset()         { *m = MsgUpdateBuybackConfig{} }
+func (m *MsgUpdateBuybackConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBuybackConfig) ProtoMessage()    {}
+func (*MsgUpdateBuybackConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa65719e3e8b3b41, []int{14}
+}
+func (m *MsgUpdateBuybackConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBuybackConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBuybackConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBuybackConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBuybackConfig.Merge(m, src)
+}
+func (m *MsgUpdateBuybackConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBuybackConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBuybackConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBuybackConfig proto.InternalMessageInfo
+
+func (m *MsgUpdateBuybackConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateBuybackConfig) GetConfig() BuybackConfig {
+	if m != nil {
+		return m.Config
+	}
+	return BuybackConfig{}
+}
+
+// MsgUpdateBuybackConfigResponse defines the response structure for executing
+// MsgUpdateBuybackConfig message.
+type MsgUpdateBuybackConfigResponse struct {
+}
+
+func (m *MsgUpdateBuybackConfigResponse) Reset()         { *m = MsgUpdateBuybackConfigResponse{} }
+func (m *MsgUpdateBuybackConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBuybackConfigResponse) ProtoMessage()    {}
+func (*MsgUpdateBuybackConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa65719e3e8b3b41, []int{15}
+}
+func (m *MsgUpdateBuybackConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBuybackConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBuybackConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBuybackConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBuybackConfigResponse.Merge(m, src)
+}
+func (m *MsgUpdateBuybackConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBuybackConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBuybackConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBuybackConfigResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "zigchain.dex.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "zigchain.dex.MsgUpdateParamsResponse")
@@ -887,69 +1136,86 @@ func init() {
 	proto.RegisterType((*MsgAddLiquidityResponse)(nil), "zigchain.dex.MsgAddLiquidityResponse")
 	proto.RegisterType((*MsgRemoveLiquidity)(nil), "zigchain.dex.MsgRemoveLiquidity")
 	proto.RegisterType((*MsgRemoveLiquidityResponse)(nil), "zigchain.dex.MsgRemoveLiquidityResponse")
+	proto.RegisterType((*MsgUpdateDynamicFeeConfig)(nil), "zigchain.dex.MsgUpdateDynamicFeeConfig")
+	proto.RegisterType((*MsgUpdateDynamicFeeConfigResponse)(nil), "zigchain.dex.MsgUpdateDynamicFeeConfigResponse")
+	proto.RegisterType((*MsgUpdateBuybackConfig)(nil), "zigchain.dex.MsgUpdateBuybackConfig")
+	proto.RegisterType((*MsgUpdateBuybackConfigResponse)(nil), "zigchain.dex.MsgUpdateBuybackConfigResponse")
 }
 
 func init() { proto.RegisterFile("zigchain/dex/tx.proto", fileDescriptor_aa65719e3e8b3b41) }
 
 var fileDescriptor_aa65719e3e8b3b41 = []byte{
-	// 902 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xcd, 0x8f, 0xdb, 0x44,
-	0x14, 0xdf, 0xc9, 0xd7, 0xee, 0xbe, 0xa4, 0xad, 0xb0, 0xd2, 0xae, 0xd7, 0x50, 0x13, 0x19, 0x90,
-	0x56, 0x2b, 0x61, 0x2b, 0xad, 0x00, 0xb1, 0xbd, 0xd0, 0x54, 0x20, 0x55, 0x22, 0x94, 0xa6, 0xe1,
-	0x82, 0x84, 0x22, 0xaf, 0x3d, 0xb8, 0x23, 0x62, 0x8f, 0xeb, 0x19, 0x87, 0x94, 0x13, 0xe2, 0x84,
-	0x38, 0x55, 0xe2, 0x3f, 0xe0, 0xc4, 0x71, 0x0f, 0x70, 0xa5, 0xd7, 0x1e, 0x2b, 0x2e, 0x20, 0x0e,
-	0x08, 0xed, 0x1e, 0xf6, 0x00, 0x7f, 0x04, 0x1a, 0x7f, 0xad, 0x9d, 0x64, 0xb3, 0x4e, 0x90, 0xb8,
-	0xf4, 0xb2, 0x9b, 0x37, 0xef, 0x63, 0xde, 0xef, 0xf7, 0x5e, 0xde, 0xbc, 0xc0, 0xd5, 0xaf, 0x88,
-	0x63, 0x3d, 0x34, 0x89, 0x67, 0xd8, 0x78, 0x6a, 0xf0, 0xa9, 0xee, 0x07, 0x94, 0x53, 0xa9, 0x95,
-	0x1e, 0xeb, 0x36, 0x9e, 0x2a, 0x2f, 0x99, 0x2e, 0xf1, 0xa8, 0x11, 0xfd, 0x8d, 0x0d, 0x14, 0xd5,
-	0xa2, 0xcc, 0xa5, 0xcc, 0x38, 0x34, 0x19, 0x36, 0x26, 0xdd, 0x43, 0xcc, 0xcd, 0xae, 0x61, 0x51,
-	0xe2, 0x25, 0xfa, 0x9d, 0x44, 0xef, 0x32, 0xc7, 0x98, 0x74, 0xc5, 0xbf, 0x44, 0xb1, 0x1b, 0x2b,
-	0x46, 0x91, 0x64, 0xc4, 0x42, 0xa2, 0x6a, 0x3b, 0xd4, 0xa1, 0xf1, 0xb9, 0xf8, 0x94, 0x3a, 0x14,
-	0x32, 0xf4, 0xcd, 0xc0, 0x74, 0x13, 0x07, 0xed, 0x67, 0x04, 0x57, 0xfa, 0xcc, 0xf9, 0xc4, 0xb7,
-	0x4d, 0x8e, 0x3f, 0x8e, 0x34, 0xd2, 0xdb, 0xb0, 0x6d, 0x86, 0xfc, 0x21, 0x0d, 0x08, 0x7f, 0x2c,
-	0xa3, 0x0e, 0xda, 0xdb, 0xee, 0xc9, 0xbf, 0xfe, 0xf4, 0x66, 0x3b, 0xb9, 0xe9, 0xb6, 0x6d, 0x07,
-	0x98, 0xb1, 0x07, 0x3c, 0x20, 0x9e, 0x33, 0x38, 0x33, 0x95, 0xde, 0x81, 0x46, 0x1c, 0x5b, 0xae,
-	0x74, 0xd0, 0x5e, 0xf3, 0x46, 0x5b, 0xcf, 0x53, 0xa0, 0xc7, 0xd1, 0x7b, 0xdb, 0xcf, 0xfe, 0x7c,
-	0x75, 0xe3, 0xc7, 0xd3, 0xa3, 0x7d, 0x34, 0x48, 0xcc, 0x0f, 0xba, 0xdf, 0x9c, 0x1e, 0xed, 0x9f,
-	0x05, 0xfa, 0xee, 0xf4, 0x68, 0x5f, 0xcd, 0x52, 0x9e, 0x46, 0x49, 0xcf, 0xe4, 0xa8, 0xed, 0xc2,
-	0xce, 0xcc, 0xd1, 0x00, 0x33, 0x9f, 0x7a, 0x0c, 0x6b, 0x4f, 0x11, 0x5c, 0xea, 0x33, 0xe7, 0x4e,
-	0x80, 0x85, 0x8e, 0xd2, 0xb1, 0x24, 0xc3, 0xa6, 0x25, 0x24, 0x1a, 0xc4, 0x70, 0x06, 0xa9, 0x28,
-	0xdd, 0x84, 0x9a, 0xa0, 0x3f, 0x49, 0x78, 0x57, 0x4f, 0x20, 0x8a, 0x33, 0x3d, 0x29, 0x89, 0x7e,
-	0x87, 0x12, 0xaf, 0x57, 0x13, 0x59, 0x0f, 0x22, 0x63, 0xe9, 0x2d, 0xa8, 0x3f, 0x0a, 0x29, 0xc7,
-	0x72, 0xb5, 0x9c, 0x57, 0x6c, 0x2d, 0x29, 0xb0, 0x15, 0x60, 0x0b, 0x93, 0x09, 0x0e, 0xe4, 0x5a,
-	0x94, 0x46, 0x26, 0x1f, 0xb4, 0x04, 0x03, 0x69, 0x56, 0xda, 0x6f, 0x08, 0xae, 0x16, 0x10, 0xa4,
-	0xd8, 0xa4, 0x1d, 0xd8, 0xf4, 0x29, 0x1d, 0x8f, 0x88, 0x9d, 0x20, 0x69, 0x08, 0xf1, 0xae, 0xfd,
-	0xbf, 0x02, 0x79, 0x17, 0x36, 0xc7, 0xfe, 0x90, 0x7e, 0x81, 0xbd, 0x08, 0x47, 0x09, 0xc7, 0xd4,
-	0x5e, 0xfb, 0x1b, 0xc1, 0xe5, 0x3e, 0x73, 0x1e, 0x7c, 0x69, 0xfa, 0xef, 0x4f, 0x4d, 0x8b, 0xdf,
-	0xf5, 0xa4, 0x6b, 0xd0, 0x60, 0xc4, 0xf1, 0x70, 0x5a, 0x9b, 0x44, 0x92, 0x6e, 0xc1, 0x16, 0xf1,
-	0x2c, 0xea, 0x12, 0xcf, 0x29, 0x8b, 0x2a, 0x73, 0xc8, 0xf3, 0x54, 0x2d, 0xf0, 0xb4, 0xa4, 0x08,
-	0x52, 0x0f, 0x5a, 0x34, 0xe4, 0x0e, 0x25, 0x9e, 0x33, 0x72, 0x89, 0x27, 0xd7, 0xcb, 0xdc, 0x8a,
-	0x06, 0xcd, 0xd4, 0xa9, 0x4f, 0xbc, 0x83, 0xa6, 0x28, 0x64, 0x02, 0x41, 0xfb, 0xa5, 0x02, 0xd7,
-	0x8a, 0x68, 0x2f, 0x2e, 0xe4, 0x7f, 0x82, 0x7d, 0x0b, 0xb6, 0xd2, 0x64, 0xca, 0xd6, 0x34, 0x73,
-	0x90, 0xba, 0x50, 0xfd, 0x1c, 0xe3, 0xb2, 0x25, 0x15, 0xb6, 0x05, 0x36, 0xeb, 0x17, 0xb0, 0xd9,
-	0x58, 0x9d, 0x4d, 0xed, 0x9f, 0x78, 0x3a, 0x65, 0x04, 0xde, 0x0b, 0xf9, 0xb2, 0x7e, 0xc9, 0xb0,
-	0x57, 0x56, 0xc5, 0xbe, 0x6e, 0xbf, 0xa4, 0xcc, 0x8f, 0x5c, 0x73, 0x5a, 0xba, 0x5f, 0x52, 0xa7,
-	0xbe, 0x39, 0x2d, 0xf6, 0xcb, 0xd3, 0x4a, 0x34, 0xd5, 0xf2, 0x70, 0x5f, 0xac, 0x86, 0x29, 0xd0,
-	0xd9, 0x58, 0x9d, 0x4e, 0xed, 0x8f, 0xb8, 0x61, 0x6e, 0xdb, 0xf6, 0x87, 0xe4, 0x51, 0x48, 0x6c,
-	0xf1, 0x2c, 0x9d, 0x3f, 0xfd, 0x73, 0x9c, 0x56, 0x16, 0x4e, 0xd3, 0xea, 0x5a, 0xd3, 0xb4, 0xb6,
-	0xf6, 0xb3, 0x50, 0x5f, 0xfa, 0x2c, 0xfc, 0x10, 0xb7, 0x47, 0x1e, 0x5c, 0xd6, 0x1e, 0xd1, 0x4c,
-	0xe6, 0xd1, 0x4c, 0x46, 0xa5, 0x67, 0x72, 0x64, 0x2f, 0xbd, 0x07, 0x4d, 0xd3, 0xe2, 0xa1, 0x39,
-	0x1e, 0xad, 0xf2, 0x82, 0x40, 0xec, 0xd3, 0x13, 0xc8, 0x7b, 0xd0, 0x4a, 0x22, 0xac, 0xf4, 0x9c,
-	0x24, 0xd7, 0xde, 0x8f, 0x68, 0xf8, 0x00, 0x2e, 0x07, 0x98, 0x87, 0x81, 0x87, 0xed, 0x91, 0x58,
-	0x82, 0x98, 0x5c, 0xeb, 0x54, 0xcb, 0x44, 0xb9, 0x94, 0xba, 0x89, 0x33, 0xa6, 0x7d, 0x8f, 0x40,
-	0xea, 0x33, 0x67, 0x80, 0x5d, 0x3a, 0xc1, 0x65, 0x9a, 0x20, 0xc7, 0x5c, 0x65, 0x45, 0xe6, 0xf2,
-	0xa5, 0xab, 0x2e, 0x2d, 0xdd, 0xb7, 0x08, 0x94, 0xf9, 0xac, 0xb2, 0xea, 0xa5, 0xfd, 0x86, 0xd6,
-	0xea, 0xb7, 0xca, 0x2a, 0xfd, 0x76, 0xe3, 0x49, 0x0d, 0xaa, 0x7d, 0xe6, 0x48, 0x43, 0x68, 0x15,
-	0xb6, 0xbe, 0xeb, 0xc5, 0x6d, 0x6d, 0x66, 0xbb, 0x52, 0xde, 0x58, 0xaa, 0xce, 0x90, 0x7c, 0x04,
-	0x90, 0x5b, 0xbc, 0x5e, 0x9e, 0x73, 0x3a, 0x53, 0x2a, 0xaf, 0x2d, 0x51, 0x66, 0xf1, 0xee, 0x43,
-	0x33, 0xbf, 0x2c, 0xbc, 0x32, 0xe7, 0x93, 0xd3, 0x2a, 0xaf, 0x2f, 0xd3, 0x66, 0x21, 0x87, 0xd0,
-	0x2a, 0x3c, 0x28, 0xd7, 0xcf, 0xf7, 0xba, 0x17, 0xf2, 0x05, 0xc0, 0x17, 0xce, 0xe7, 0x21, 0xb4,
-	0x0a, 0x53, 0x67, 0x3e, 0x6a, 0x5e, 0xbd, 0x20, 0xea, 0xc2, 0xaf, 0xf5, 0x67, 0x70, 0x65, 0xb6,
-	0x93, 0x3b, 0x73, 0x9e, 0x33, 0x16, 0xca, 0xde, 0x45, 0x16, 0x69, 0x78, 0xa5, 0xfe, 0xb5, 0xd8,
-	0xc3, 0x7b, 0xfa, 0xb3, 0x63, 0x15, 0x3d, 0x3f, 0x56, 0xd1, 0x5f, 0xc7, 0x2a, 0x7a, 0x72, 0xa2,
-	0x6e, 0x3c, 0x3f, 0x51, 0x37, 0x7e, 0x3f, 0x51, 0x37, 0x3e, 0x6d, 0xcf, 0xac, 0xe1, 0xfc, 0xb1,
-	0x8f, 0xd9, 0x61, 0x23, 0xfa, 0xed, 0x70, 0xf3, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x90, 0xbd,
-	0xd8, 0x03, 0xfa, 0x0c, 0x00, 0x00,
+	// 1107 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x7a, 0xfd, 0x95, 0x67, 0xb7, 0x15, 0x4b, 0x1a, 0x6f, 0xb6, 0xd4, 0x98, 0x6d, 0x23,
+	0xa2, 0x08, 0x6c, 0xb9, 0x15, 0x5f, 0x0e, 0x42, 0xc4, 0x81, 0x4a, 0x95, 0x30, 0xa5, 0xae, 0xb9,
+	0x20, 0x21, 0x6b, 0xbc, 0x3b, 0xd9, 0x8e, 0x1a, 0xef, 0xb8, 0xbb, 0xeb, 0xb0, 0xe1, 0x84, 0x7a,
+	0xe4, 0x84, 0xc4, 0x7f, 0xc0, 0x89, 0x63, 0x0e, 0x70, 0xe6, 0x06, 0x15, 0xa7, 0x0a, 0x0e, 0x20,
+	0x0e, 0x08, 0x25, 0x87, 0x1c, 0x40, 0xe2, 0x5f, 0x40, 0xb3, 0x5f, 0xd9, 0x59, 0xc7, 0xce, 0xda,
+	0x95, 0x10, 0x12, 0x97, 0x24, 0x33, 0xbf, 0xf7, 0xde, 0xbc, 0xdf, 0x7b, 0x6f, 0xf6, 0xbd, 0x09,
+	0x5c, 0xfe, 0x94, 0x18, 0xda, 0x7d, 0x44, 0xcc, 0x86, 0x8e, 0xdd, 0x86, 0xe3, 0xd6, 0x47, 0x16,
+	0x75, 0xa8, 0x54, 0x0e, 0xb7, 0xeb, 0x3a, 0x76, 0x95, 0x67, 0xd0, 0x90, 0x98, 0xb4, 0xe1, 0xfd,
+	0xf4, 0x05, 0x94, 0xaa, 0x46, 0xed, 0x21, 0xb5, 0x1b, 0x03, 0x64, 0xe3, 0xc6, 0x7e, 0x73, 0x80,
+	0x1d, 0xd4, 0x6c, 0x68, 0x94, 0x98, 0x01, 0x5e, 0x09, 0xf0, 0xa1, 0x6d, 0x34, 0xf6, 0x9b, 0xec,
+	0x57, 0x00, 0xac, 0xf9, 0x40, 0xdf, 0x5b, 0x35, 0xfc, 0x45, 0x00, 0xad, 0x18, 0xd4, 0xa0, 0xfe,
+	0x3e, 0xfb, 0x2b, 0xd8, 0x55, 0x38, 0x0f, 0x07, 0xe3, 0x83, 0x01, 0xd2, 0x1e, 0x84, 0x5e, 0x70,
+	0x98, 0x7e, 0x60, 0xa2, 0x21, 0xd1, 0xfa, 0xbb, 0x18, 0x87, 0x87, 0x71, 0xf8, 0x08, 0x59, 0x68,
+	0x18, 0x1c, 0xa6, 0x7e, 0x2b, 0xc0, 0xa5, 0x8e, 0x6d, 0x7c, 0x38, 0xd2, 0x91, 0x83, 0x3f, 0xf0,
+	0x10, 0xe9, 0x55, 0x58, 0x46, 0x63, 0xe7, 0x3e, 0xb5, 0x88, 0x73, 0x20, 0x0b, 0x35, 0x61, 0x63,
+	0xb9, 0x2d, 0xff, 0xf4, 0xcd, 0xcb, 0x2b, 0x81, 0x97, 0xdb, 0xba, 0x6e, 0x61, 0xdb, 0xbe, 0xe7,
+	0x58, 0xc4, 0x34, 0xba, 0xa7, 0xa2, 0xd2, 0x6b, 0x90, 0xf7, 0x6d, 0xcb, 0x99, 0x9a, 0xb0, 0x51,
+	0xba, 0xb1, 0x52, 0x8f, 0x87, 0xaf, 0xee, 0x5b, 0x6f, 0x2f, 0x3f, 0xfe, 0xfd, 0xf9, 0xa5, 0xaf,
+	0x4f, 0x0e, 0x37, 0x85, 0x6e, 0x20, 0xde, 0x6a, 0x3e, 0x3a, 0x39, 0xdc, 0x3c, 0x35, 0xf4, 0xf9,
+	0xc9, 0xe1, 0xe6, 0x29, 0x25, 0xd7, 0x73, 0x3a, 0xe1, 0xa3, 0xba, 0x06, 0x95, 0xc4, 0x56, 0x17,
+	0xdb, 0x23, 0x6a, 0xda, 0x58, 0xfd, 0x4e, 0x80, 0x0b, 0x1d, 0xdb, 0xd8, 0xb1, 0x30, 0xc3, 0x28,
+	0xdd, 0x93, 0x64, 0x28, 0x68, 0x6c, 0x45, 0x2d, 0x9f, 0x4e, 0x37, 0x5c, 0x4a, 0x37, 0x21, 0xcb,
+	0x52, 0x17, 0x38, 0xbc, 0x56, 0x0f, 0x28, 0xb2, 0xbd, 0x7a, 0x90, 0xce, 0xfa, 0x0e, 0x25, 0x66,
+	0x3b, 0xcb, 0xbc, 0xee, 0x7a, 0xc2, 0xd2, 0x2b, 0x90, 0x7b, 0x38, 0xa6, 0x0e, 0x96, 0xc5, 0x74,
+	0x5a, 0xbe, 0xb4, 0xa4, 0x40, 0xd1, 0xc2, 0x1a, 0x26, 0xfb, 0xd8, 0x92, 0xb3, 0x9e, 0x1b, 0xd1,
+	0xba, 0x55, 0x66, 0x11, 0x08, 0xbd, 0x52, 0x7f, 0x11, 0xe0, 0x32, 0xc7, 0x20, 0xe4, 0x26, 0x55,
+	0xa0, 0x30, 0xa2, 0x74, 0xaf, 0x4f, 0xf4, 0x80, 0x49, 0x9e, 0x2d, 0x6f, 0xeb, 0xff, 0x2a, 0x91,
+	0x37, 0xa0, 0xb0, 0x37, 0xea, 0xd1, 0x07, 0xd8, 0x94, 0xb3, 0xe9, 0x14, 0x43, 0x79, 0xf5, 0x4f,
+	0x01, 0x2e, 0x76, 0x6c, 0xe3, 0xde, 0x27, 0x68, 0xf4, 0xae, 0x8b, 0x34, 0xe7, 0xb6, 0x29, 0xad,
+	0x42, 0xde, 0x26, 0x86, 0x89, 0xc3, 0xdc, 0x04, 0x2b, 0x69, 0x0b, 0x8a, 0xc4, 0xd4, 0xe8, 0x90,
+	0x98, 0x46, 0x5a, 0x56, 0x91, 0x42, 0x3c, 0x4e, 0x22, 0x17, 0xa7, 0x19, 0x49, 0x90, 0xda, 0x50,
+	0xa6, 0x63, 0xc7, 0xa0, 0xc4, 0x34, 0xfa, 0x43, 0x62, 0xca, 0xb9, 0x34, 0xa7, 0x0a, 0xdd, 0x52,
+	0xa8, 0xd4, 0x21, 0x66, 0xab, 0xc4, 0x12, 0x19, 0x50, 0x50, 0xbf, 0x17, 0x61, 0x95, 0x67, 0x7b,
+	0x7e, 0x22, 0x9f, 0x8a, 0xf6, 0x16, 0x14, 0x43, 0x67, 0xd2, 0xe6, 0x34, 0x52, 0x90, 0x9a, 0x20,
+	0xee, 0x62, 0x9c, 0x36, 0xa5, 0x4c, 0x96, 0x8b, 0x66, 0xee, 0x9c, 0x68, 0xe6, 0xe7, 0x8f, 0x26,
+	0xb3, 0x11, 0x72, 0xeb, 0x3b, 0xc8, 0x95, 0x0b, 0x29, 0x6d, 0x84, 0x4a, 0x3d, 0xe4, 0x72, 0x7e,
+	0x30, 0x1b, 0xc5, 0x39, 0xfd, 0xe8, 0x21, 0x57, 0xfd, 0xcb, 0xff, 0x4a, 0x46, 0x89, 0xbc, 0x33,
+	0x76, 0x66, 0xd5, 0x6d, 0x94, 0x83, 0xcc, 0xbc, 0x39, 0x58, 0xb4, 0x6e, 0xa3, 0x28, 0x0d, 0x91,
+	0x9b, 0xba, 0x6e, 0x43, 0xa5, 0x0e, 0x72, 0xf9, 0xba, 0xfd, 0x41, 0x84, 0x4a, 0x82, 0xee, 0xff,
+	0xab, 0x70, 0xb9, 0x70, 0xe6, 0xe7, 0x0f, 0xe7, 0x7f, 0xa6, 0x70, 0x7f, 0xf3, 0x0b, 0x77, 0x5b,
+	0xd7, 0xdf, 0x23, 0x0f, 0xc7, 0x44, 0x67, 0x6d, 0x7a, 0x7a, 0x37, 0x8c, 0xe5, 0x36, 0x73, 0x66,
+	0x77, 0x11, 0x17, 0xea, 0x2e, 0xd9, 0x85, 0xdb, 0x64, 0x6e, 0x66, 0x9b, 0xfc, 0x2a, 0x03, 0x95,
+	0x04, 0xb9, 0xa8, 0x4c, 0xbd, 0x1e, 0xe5, 0x78, 0x3d, 0x4a, 0x48, 0xdd, 0xa3, 0x3c, 0x79, 0xe9,
+	0x6d, 0x28, 0x21, 0xcd, 0x19, 0xa3, 0xbd, 0xfe, 0x3c, 0x1d, 0x15, 0x7c, 0x9d, 0x36, 0x63, 0xde,
+	0x86, 0x72, 0x60, 0x61, 0xae, 0xf6, 0x1a, 0x1c, 0x7b, 0xd7, 0x0b, 0xc3, 0x2d, 0xb8, 0x68, 0x61,
+	0x67, 0x6c, 0x99, 0x58, 0xef, 0xb3, 0x81, 0xd2, 0x96, 0xb3, 0x35, 0x31, 0x8d, 0x95, 0x0b, 0xa1,
+	0x1a, 0xdb, 0xb3, 0xd5, 0x2f, 0x05, 0x90, 0x3a, 0xb6, 0xd1, 0xc5, 0x43, 0xba, 0x8f, 0xd3, 0x14,
+	0x41, 0x2c, 0x72, 0x99, 0x39, 0x23, 0x17, 0x4f, 0x9d, 0x38, 0x33, 0x75, 0x8f, 0x32, 0xa0, 0x4c,
+	0x7a, 0x15, 0x65, 0x2f, 0xac, 0x37, 0x61, 0xa1, 0x7a, 0xcb, 0xcc, 0x55, 0x6f, 0x2d, 0x28, 0x32,
+	0x09, 0xef, 0x8a, 0x89, 0xe9, 0xae, 0x58, 0x81, 0x01, 0xec, 0x8a, 0xbe, 0x09, 0xcb, 0x9e, 0x11,
+	0x4f, 0x39, 0x9b, 0x4e, 0xb9, 0xe8, 0x69, 0xb0, 0xcb, 0xf9, 0xb3, 0x00, 0x6b, 0xd1, 0x10, 0xfb,
+	0x8e, 0x3f, 0xb5, 0xdf, 0xc2, 0x78, 0x87, 0x9a, 0xbb, 0xc4, 0x58, 0x78, 0x0a, 0xdf, 0x86, 0xbc,
+	0xe6, 0x59, 0x08, 0xe2, 0x50, 0xe5, 0xa7, 0xf0, 0xe4, 0x39, 0xdc, 0x3c, 0xee, 0x2b, 0xb6, 0xb6,
+	0x26, 0xe7, 0xf1, 0x8d, 0x69, 0xf3, 0x78, 0xd2, 0x9e, 0x7a, 0x0d, 0x5e, 0x98, 0x0a, 0x46, 0x33,
+	0xfa, 0x8f, 0x02, 0xac, 0x46, 0x52, 0x6d, 0xff, 0x31, 0xf3, 0x94, 0xbc, 0xdf, 0x4a, 0xf0, 0xbe,
+	0xc2, 0xf3, 0xe6, 0x0e, 0x39, 0x8b, 0xf4, 0xeb, 0x93, 0xa4, 0xd7, 0xa7, 0x91, 0xe6, 0x8c, 0xa9,
+	0x35, 0xa8, 0x9e, 0x8d, 0x84, 0x74, 0x6f, 0xfc, 0x9d, 0x03, 0xb1, 0x63, 0x1b, 0x52, 0x0f, 0xca,
+	0xdc, 0x4b, 0xeb, 0x2a, 0xef, 0x63, 0xe2, 0x45, 0xa3, 0xac, 0xcf, 0x84, 0xa3, 0xdb, 0xf2, 0x3e,
+	0x40, 0xec, 0xb1, 0x73, 0x65, 0x42, 0xe9, 0x14, 0x54, 0xae, 0xcd, 0x00, 0x23, 0x7b, 0x77, 0xa1,
+	0x14, 0x1f, 0xd0, 0x9f, 0x9b, 0xd0, 0x89, 0xa1, 0xca, 0xf5, 0x59, 0x68, 0x64, 0xb2, 0x07, 0x65,
+	0x6e, 0x78, 0xba, 0x3a, 0x5d, 0xeb, 0xce, 0xd8, 0x51, 0xd6, 0x67, 0xc2, 0x71, 0xab, 0x5c, 0x67,
+	0x9b, 0xb4, 0x1a, 0x87, 0x95, 0xf5, 0x99, 0x70, 0x64, 0xf5, 0x63, 0xb8, 0x94, 0xfc, 0x5a, 0xd6,
+	0x26, 0x34, 0x13, 0x12, 0xca, 0xc6, 0x79, 0x12, 0x91, 0x79, 0x0b, 0x56, 0xa7, 0xdc, 0xf8, 0x17,
+	0xa7, 0xa4, 0x3b, 0x29, 0xa8, 0x34, 0x52, 0x0a, 0x46, 0x67, 0x12, 0x78, 0xf6, 0xac, 0xab, 0x76,
+	0x7d, 0x8a, 0x1d, 0x4e, 0x4a, 0x79, 0x29, 0x8d, 0x54, 0x78, 0x94, 0x92, 0xfb, 0x8c, 0xdd, 0xaa,
+	0x76, 0xfd, 0xf1, 0x51, 0x55, 0x78, 0x72, 0x54, 0x15, 0xfe, 0x38, 0xaa, 0x0a, 0x5f, 0x1c, 0x57,
+	0x97, 0x9e, 0x1c, 0x57, 0x97, 0x7e, 0x3d, 0xae, 0x2e, 0x7d, 0xb4, 0x92, 0xb8, 0x54, 0xce, 0xc1,
+	0x08, 0xdb, 0x83, 0xbc, 0xf7, 0xef, 0x88, 0x9b, 0xff, 0x0c, 0x00, 0xbb, 0x64, 0xd9, 0x64, 0x89,
+	0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -977,6 +1243,13 @@ type MsgClient interface {
 	AddLiquidity(ctx context.Context, in *MsgAddLiquidity, opts ...grpc.CallOption) (*MsgAddLiquidityResponse, error)
 	// RemoveLiquidity removes liquidity from the pool, from the lptoken send in
 	RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity, opts ...grpc.CallOption) (*MsgRemoveLiquidityResponse, error)
+	// UpdateDynamicFeeConfig defines a (governance) operation for setting the
+	// dynamic fee configuration of a pool
+	UpdateDynamicFeeConfig(ctx context.Context, in *MsgUpdateDynamicFeeConfig, opts ...grpc.CallOption) (*MsgUpdateDynamicFeeConfigResponse, error)
+	// UpdateBuybackConfig defines a (governance) operation for setting the
+	// buyback-and-burn configuration. The authority defaults to the x/gov module
+	// account.
+	UpdateBuybackConfig(ctx context.Context, in *MsgUpdateBuybackConfig, opts ...grpc.CallOption) (*MsgUpdateBuybackConfigResponse, error)
 }
 
 type msgClient struct {
@@ -1041,6 +1314,24 @@ func (c *msgClient) RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity,
 	return out, nil
 }
 
+func (c *msgClient) UpdateDynamicFeeConfig(ctx context.Context, in *MsgUpdateDynamicFeeConfig, opts ...grpc.CallOption) (*MsgUpdateDynamicFeeConfigResponse, error) {
+	out := new(MsgUpdateDynamicFeeConfigResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.dex.Msg/UpdateDynamicFeeConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBuybackConfig(ctx context.Context, in *MsgUpdateBuybackConfig, opts ...grpc.CallOption) (*MsgUpdateBuybackConfigResponse, error) {
+	out := new(MsgUpdateBuybackConfigResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.dex.Msg/UpdateBuybackConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -1056,6 +1347,13 @@ type MsgServer interface {
 	AddLiquidity(context.Context, *MsgAddLiquidity) (*MsgAddLiquidityResponse, error)
 	// RemoveLiquidity removes liquidity from the pool, from the lptoken send in
 	RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error)
+	// UpdateDynamicFeeConfig defines a (governance) operation for setting the
+	// dynamic fee configuration of a pool
+	UpdateDynamicFeeConfig(context.Context, *MsgUpdateDynamicFeeConfig) (*MsgUpdateDynamicFeeConfigResponse, error)
+	// UpdateBuybackConfig defines a (governance) operation for setting the
+	// buyback-and-burn configuration. The authority defaults to the x/gov module
+	// account.
+	UpdateBuybackConfig(context.Context, *MsgUpdateBuybackConfig) (*MsgUpdateBuybackConfigResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1080,6 +1378,12 @@ func (*UnimplementedMsgServer) AddLiquidity(ctx context.Context, req *MsgAddLiqu
 func (*UnimplementedMsgServer) RemoveLiquidity(ctx context.Context, req *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveLiquidity not implemented")
 }
+func (*UnimplementedMsgServer) UpdateDynamicFeeConfig(ctx context.Context, req *MsgUpdateDynamicFeeConfig) (*MsgUpdateDynamicFeeConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDynamicFeeConfig not implemented")
+}
+func (*UnimplementedMsgServer) UpdateBuybackConfig(ctx context.Context, req *MsgUpdateBuybackConfig) (*MsgUpdateBuybackConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBuybackConfig not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1193,6 +1497,42 @@ func _Msg_RemoveLiquidity_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateDynamicFeeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDynamicFeeConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDynamicFeeConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.dex.Msg/UpdateDynamicFeeConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDynamicFeeConfig(ctx, req.(*MsgUpdateDynamicFeeConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBuybackConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBuybackConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBuybackConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.dex.Msg/UpdateBuybackConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBuybackConfig(ctx, req.(*MsgUpdateBuybackConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zigchain.dex.Msg",
@@ -1222,6 +1562,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveLiquidity",
 			Handler:    _Msg_RemoveLiquidity_Handler,
 		},
+		{
+			MethodName: "UpdateDynamicFeeConfig",
+			Handler:    _Msg_UpdateDynamicFeeConfig_Handler,
+		},
+		{
+			MethodName: "UpdateBuybackConfig",
+			Handler:    _Msg_UpdateBuybackConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zigchain/dex/tx.proto",
@@ -1493,6 +1841,30 @@ func (m *MsgSwapExactInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.OutgoingTax != nil {
+		{
+			size, err := m.OutgoingTax.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.IncomingTax != nil {
+		{
+			size, err := m.IncomingTax.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.OutgoingMin != nil {
 		{
 			size, err := m.OutgoingMin.MarshalToSizedBuffer(dAtA[:i])
@@ -1638,6 +2010,30 @@ func (m *MsgSwapExactOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.OutgoingTax != nil {
+		{
+			size, err := m.OutgoingTax.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.IncomingTax != nil {
+		{
+			size, err := m.IncomingTax.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.IncomingMax != nil {
 		{
 			size, err := m.IncomingMax.MarshalToSizedBuffer(dAtA[:i])
@@ -1895,6 +2291,30 @@ func (m *MsgRemoveLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.QuoteTax != nil {
+		{
+			size, err := m.QuoteTax.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BaseTax != nil {
+		{
+			size, err := m.BaseTax.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Quote.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1918,15 +2338,141 @@ func (m *MsgRemoveLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateDynamicFeeConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDynamicFeeConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDynamicFeeConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDynamicFeeConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDynamicFeeConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDynamicFeeConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBuybackConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBuybackConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBuybackConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBuybackConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBuybackConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBuybackConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *MsgUpdateParams) Size() (n int) {
@@ -2044,6 +2590,14 @@ func (m *MsgSwapExactInResponse) Size() (n int) {
 		l = m.OutgoingMin.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.IncomingTax != nil {
+		l = m.IncomingTax.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OutgoingTax != nil {
+		l = m.OutgoingTax.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2098,6 +2652,14 @@ func (m *MsgSwapExactOutResponse) Size() (n int) {
 		l = m.IncomingMax.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.IncomingTax != nil {
+		l = m.IncomingTax.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OutgoingTax != nil {
+		l = m.OutgoingTax.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2176,6 +2738,62 @@ func (m *MsgRemoveLiquidityResponse) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.Quote.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.BaseTax != nil {
+		l = m.BaseTax.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.QuoteTax != nil {
+		l = m.QuoteTax.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDynamicFeeConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateDynamicFeeConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateBuybackConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateBuybackConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	return n
 }
 
@@ -3154,6 +3772,78 @@ func (m *MsgSwapExactInResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncomingTax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IncomingTax == nil {
+				m.IncomingTax = &types.Coin{}
+			}
+			if err := m.IncomingTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutgoingTax == nil {
+				m.OutgoingTax = &types.Coin{}
+			}
+			if err := m.OutgoingTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3618,17 +4308,89 @@ func (m *MsgSwapExactOutResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncomingTax", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IncomingTax == nil {
+				m.IncomingTax = &types.Coin{}
+			}
+			if err := m.IncomingTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutgoingTax == nil {
+				m.OutgoingTax = &types.Coin{}
+			}
+			if err := m.OutgoingTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -4276,6 +5038,408 @@ func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseTax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseTax == nil {
+				m.BaseTax = &types.Coin{}
+			}
+			if err := m.BaseTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteTax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuoteTax == nil {
+				m.QuoteTax = &types.Coin{}
+			}
+			if err := m.QuoteTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDynamicFeeConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDynamicFeeConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDynamicFeeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDynamicFeeConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDynamicFeeConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDynamicFeeConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBuybackConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBuybackConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBuybackConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBuybackConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBuybackConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBuybackConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2,7 +2,9 @@ package events
 
 import (
 	"strconv"
+	"strings"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -24,6 +26,7 @@ func newDenomCreateEvent(denom *types.Denom, feeAmount sdk.Coin) sdk.Event {
 		sdk.NewAttribute(types.AttributeKeyMintingCap, denom.MintingCap.String()),
 		sdk.NewAttribute(types.AttributeKeyTotalMinted, denom.Minted.String()),
 		sdk.NewAttribute(types.AttributeKeyCanChangeMintingCap, strconv.FormatBool(denom.CanChangeMintingCap)),
+		sdk.NewAttribute(types.AttributeKeyCanForceTransfer, strconv.FormatBool(denom.CanForceTransfer)),
 		sdk.NewAttribute(types.AttributeKeyCreator, denom.Creator),
 		sdk.NewAttribute(types.AttributeKeyFee, feeAmount.String()),
 	)
@@ -153,23 +156,57 @@ func newDenomAuthUpdatedEvent(signer string, denomAuth *types.DenomAuth) sdk.Eve
 	)
 }
 
-func EmitDenomAuthProposed(ctx sdk.Context, signer string, denomAuth *types.DenomAuth) {
+func EmitDenomAuthProposed(ctx sdk.Context, signer string, proposal *types.DenomAdminProposal) {
 	ctx.EventManager().EmitEvents(sdk.Events{
-		newDenomAuthProposedEvent(signer, denomAuth),
+		newDenomAuthProposedEvent(signer, proposal),
 	})
 }
 
-func newDenomAuthProposedEvent(signer string, denomAuth *types.DenomAuth) sdk.Event {
+func newDenomAuthProposedEvent(signer string, proposal *types.DenomAdminProposal) sdk.Event {
 	return sdk.NewEvent(
 		types.EventDenomAuthProposed,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		sdk.NewAttribute(types.AttributeKeySigner, signer),
-		sdk.NewAttribute(types.AttributeKeyDenom, denomAuth.Denom),
-		sdk.NewAttribute(types.AttributeKeyBankAdmin, denomAuth.BankAdmin),
-		sdk.NewAttribute(types.AttributeKeyMetadataAdmin, denomAuth.MetadataAdmin),
+		sdk.NewAttribute(types.AttributeKeyDenom, proposal.Denom),
+		sdk.NewAttribute(types.AttributeKeyBankAdmin, proposal.BankAdmin),
+		sdk.NewAttribute(types.AttributeKeyMetadataAdmin, proposal.MetadataAdmin),
+		sdk.NewAttribute(types.AttributeKeyClaimableTime, strconv.FormatInt(proposal.ClaimableTime, 10)),
+		sdk.NewAttribute(types.AttributeKeyExpiryTime, strconv.FormatInt(proposal.ExpiryTime, 10)),
 	)
 }
 
+func EmitAdminProposalCancelled(ctx sdk.Context, signer string, proposal types.DenomAdminProposal) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventAdminProposalCancelled,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySigner, signer),
+			sdk.NewAttribute(types.AttributeKeyDenom, proposal.Denom),
+			sdk.NewAttribute(types.AttributeKeyBankAdmin, proposal.BankAdmin),
+		),
+	})
+}
+
+func EmitAdminTimelockSet(ctx sdk.Context, signer string, timelock types.DenomAdminTimelock) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySigner, signer),
+		sdk.NewAttribute(types.AttributeKeyDenom, timelock.Denom),
+		sdk.NewAttribute(types.AttributeKeyDelay, strconv.FormatUint(timelock.Delay, 10)),
+	}
+
+	if timelock.PendingTime != 0 {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeKeyPendingDelay, strconv.FormatUint(timelock.PendingDelay, 10)),
+			sdk.NewAttribute(types.AttributeKeyPendingTime, strconv.FormatInt(timelock.PendingTime, 10)),
+		)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventAdminTimelockSet, attributes...),
+	})
+}
+
 func EmitDenomAuthClaimed(ctx sdk.Context, signer string, denomAuth *types.DenomAuth) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		newDenomAuthClaimedEvent(signer, denomAuth),
@@ -203,3 +240,517 @@ func newDenomAuthDisabledEvent(signer string, denomAuth *types.DenomAuth) sdk.Ev
 		sdk.NewAttribute(types.AttributeKeyMetadataAdmin, denomAuth.MetadataAdmin),
 	)
 }
+
+func EmitBeforeSendHookSet(ctx sdk.Context, signer string, denom string, contractAddress string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newBeforeSendHookSetEvent(signer, denom, contractAddress),
+	})
+}
+
+func newBeforeSendHookSetEvent(signer string, denom string, contractAddress string) sdk.Event {
+	return sdk.NewEvent(
+		types.EventBeforeSendHookSet,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySigner, signer),
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddress),
+	)
+}
+
+func EmitAccountFrozen(ctx sdk.Context, signer string, denom string, address string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newAccountFreezeEvent(types.EventAccountFrozen, signer, denom, address),
+	})
+}
+
+func EmitAccountUnfrozen(ctx sdk.Context, signer string, denom string, address string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newAccountFreezeEvent(types.EventAccountUnfrozen, signer, denom, address),
+	})
+}
+
+func newAccountFreezeEvent(eventType string, signer string, denom string, address string) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySigner, signer),
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyAddress, address),
+	)
+}
+
+func EmitDenomGlobalFreezeSet(ctx sdk.Context, signer string, denom string, frozen bool) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newDenomGlobalFreezeSetEvent(signer, denom, frozen),
+	})
+}
+
+func newDenomGlobalFreezeSetEvent(signer string, denom string, frozen bool) sdk.Event {
+	return sdk.NewEvent(
+		types.EventDenomGlobalFreezeSet,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySigner, signer),
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyFrozen, strconv.FormatBool(frozen)),
+	)
+}
+
+func EmitForceTransfer(ctx sdk.Context, signer string, token sdk.Coin, fromAddress string, toAddress string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newForceTransferEvent(signer, token, fromAddress, toAddress),
+	})
+}
+
+func newForceTransferEvent(signer string, token sdk.Coin, fromAddress string, toAddress string) sdk.Event {
+	return sdk.NewEvent(
+		types.EventForceTransfer,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySigner, signer),
+		sdk.NewAttribute(types.AttributeKeyDenom, token.Denom),
+		sdk.NewAttribute(types.AttributeKeyAmount, token.String()),
+		sdk.NewAttribute(types.AttributeKeyFromAddress, fromAddress),
+		sdk.NewAttribute(types.AttributeKeyToAddress, toAddress),
+	)
+}
+
+func EmitForceTransferDisabled(ctx sdk.Context, signer string, denom string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newForceTransferDisabledEvent(signer, denom),
+	})
+}
+
+func newForceTransferDisabledEvent(signer string, denom string) sdk.Event {
+	return sdk.NewEvent(
+		types.EventForceTransferDisabled,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySigner, signer),
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyCanForceTransfer, strconv.FormatBool(false)),
+	)
+}
+
+func EmitMinterAllowanceSet(ctx sdk.Context, signer string, minter types.Minter) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newMinterAllowanceSetEvent(signer, minter),
+	})
+}
+
+func newMinterAllowanceSetEvent(signer string, minter types.Minter) sdk.Event {
+	return sdk.NewEvent(
+		types.EventMinterAllowanceSet,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySigner, signer),
+		sdk.NewAttribute(types.AttributeKeyDenom, minter.Denom),
+		sdk.NewAttribute(types.AttributeKeyMinter, minter.Address),
+		sdk.NewAttribute(types.AttributeKeyAllowance, minter.Allowance.String()),
+	)
+}
+
+func EmitMinterRemoved(ctx sdk.Context, signer string, denom string, minter string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newMinterRemovedEvent(signer, denom, minter),
+	})
+}
+
+func newMinterRemovedEvent(signer string, denom string, minter string) sdk.Event {
+	return sdk.NewEvent(
+		types.EventMinterRemoved,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySigner, signer),
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyMinter, minter),
+	)
+}
+
+func EmitMintRateLimitSet(ctx sdk.Context, signer string, rateLimit types.MintRateLimit) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newMintRateLimitSetEvent(signer, rateLimit),
+	})
+}
+
+func newMintRateLimitSetEvent(signer string, rateLimit types.MintRateLimit) sdk.Event {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySigner, signer),
+		sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Denom),
+		sdk.NewAttribute(types.AttributeKeyLimit, rateLimit.Config.Limit.String()),
+		sdk.NewAttribute(types.AttributeKeyWindow, strconv.FormatUint(rateLimit.Config.Window, 10)),
+		sdk.NewAttribute(types.AttributeKeyRaiseDelay, strconv.FormatUint(rateLimit.Config.RaiseDelay, 10)),
+	}
+
+	if rateLimit.PendingConfig != nil {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeKeyPendingLimit, rateLimit.PendingConfig.Limit.String()),
+			sdk.NewAttribute(types.AttributeKeyPendingWindow, strconv.FormatUint(rateLimit.PendingConfig.Window, 10)),
+			sdk.NewAttribute(types.AttributeKeyPendingRaiseDelay, strconv.FormatUint(rateLimit.PendingConfig.RaiseDelay, 10)),
+			sdk.NewAttribute(types.AttributeKeyPendingTime, strconv.FormatInt(rateLimit.PendingTime, 10)),
+		)
+	}
+
+	return sdk.NewEvent(types.EventMintRateLimitSet, attributes...)
+}
+
+func EmitVestedMinted(ctx sdk.Context, signer string, grant types.VestingGrant) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newVestedMintedEvent(signer, grant),
+	})
+}
+
+func newVestedMintedEvent(signer string, grant types.VestingGrant) sdk.Event {
+	return sdk.NewEvent(
+		types.EventVestedMinted,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySigner, signer),
+		sdk.NewAttribute(types.AttributeKeyDenom, grant.Denom),
+		sdk.NewAttribute(types.AttributeKeyGrantID, strconv.FormatUint(grant.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyRecipient, grant.Recipient),
+		sdk.NewAttribute(types.AttributeKeyAmount, grant.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyEscrow, strconv.FormatBool(grant.Escrow)),
+		sdk.NewAttribute(types.AttributeKeyStartTime, strconv.FormatInt(grant.Schedule.StartTime, 10)),
+		sdk.NewAttribute(types.AttributeKeyEndTime, strconv.FormatInt(grant.Schedule.EndTime, 10)),
+	)
+}
+
+func EmitVestedClaimed(ctx sdk.Context, grant types.VestingGrant, claimed sdk.Coin) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newVestedClaimedEvent(grant, claimed),
+	})
+}
+
+func newVestedClaimedEvent(grant types.VestingGrant, claimed sdk.Coin) sdk.Event {
+	return sdk.NewEvent(
+		types.EventVestedClaimed,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyDenom, grant.Denom),
+		sdk.NewAttribute(types.AttributeKeyGrantID, strconv.FormatUint(grant.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyRecipient, grant.Recipient),
+		sdk.NewAttribute(types.AttributeKeyAmount, claimed.String()),
+	)
+}
+
+func EmitDenomBatchMinted(
+	ctx sdk.Context,
+	signer string,
+	token sdk.Coin,
+	outputs []types.MintOutput,
+	totalMinted sdk.Coin,
+	totalSupply sdk.Coin,
+) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newDenomBatchMintedEvent(signer, token, outputs, totalMinted, totalSupply),
+	})
+}
+
+// newDenomBatchMintedEvent packs the recipients of the batch in a single attribute of
+// comma separated recipient:amount pairs
+func newDenomBatchMintedEvent(
+	signer string,
+	token sdk.Coin,
+	outputs []types.MintOutput,
+	totalMinted sdk.Coin,
+	totalSupply sdk.Coin,
+) sdk.Event {
+	packed := make([]string, len(outputs))
+	for i, output := range outputs {
+		packed[i] = output.Recipient + ":" + output.Amount.String()
+	}
+
+	return sdk.NewEvent(
+		types.EventDenomBatchMinted,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySigner, signer),
+		sdk.NewAttribute(types.AttributeKeyDenom, token.Denom),
+		sdk.NewAttribute(types.AttributeKeyAmount, token.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipientCount, strconv.Itoa(len(outputs))),
+		sdk.NewAttribute(types.AttributeKeyOutputs, strings.Join(packed, ",")),
+		sdk.NewAttribute(types.AttributeKeyTotalMinted, totalMinted.String()),
+		sdk.NewAttribute(types.AttributeKeyTotalSupply, totalSupply.String()),
+	)
+}
+
+func EmitAirdropCreated(ctx sdk.Context, airdrop types.Airdrop) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newAirdropCreatedEvent(airdrop),
+	})
+}
+
+func newAirdropCreatedEvent(airdrop types.Airdrop) sdk.Event {
+	return sdk.NewEvent(
+		types.EventAirdropCreated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyCreator, airdrop.Creator),
+		sdk.NewAttribute(types.AttributeKeyDenom, airdrop.Denom),
+		sdk.NewAttribute(types.AttributeKeyAirdropID, strconv.FormatUint(airdrop.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyAmount, airdrop.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyMerkleRoot, airdrop.MerkleRoot),
+		sdk.NewAttribute(types.AttributeKeyExpiryTime, strconv.FormatInt(airdrop.ExpiryTime, 10)),
+	)
+}
+
+func EmitAirdropClaimed(ctx sdk.Context, airdrop types.Airdrop, address string, claimed sdk.Coin) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newAirdropClaimedEvent(airdrop, address, claimed),
+	})
+}
+
+func newAirdropClaimedEvent(airdrop types.Airdrop, address string, claimed sdk.Coin) sdk.Event {
+	return sdk.NewEvent(
+		types.EventAirdropClaimed,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyAirdropID, strconv.FormatUint(airdrop.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyAddress, address),
+		sdk.NewAttribute(types.AttributeKeyAmount, claimed.String()),
+	)
+}
+
+func EmitAirdropClosed(ctx sdk.Context, airdrop types.Airdrop, unclaimed sdk.Coins, returned bool) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newAirdropClosedEvent(airdrop, unclaimed, returned),
+	})
+}
+
+func newAirdropClosedEvent(airdrop types.Airdrop, unclaimed sdk.Coins, returned bool) sdk.Event {
+	returnedAmount, burnedAmount := sdk.NewCoins(), unclaimed
+	if returned {
+		returnedAmount, burnedAmount = unclaimed, sdk.NewCoins()
+	}
+
+	return sdk.NewEvent(
+		types.EventAirdropClosed,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyAirdropID, strconv.FormatUint(airdrop.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyCreator, airdrop.Creator),
+		sdk.NewAttribute(types.AttributeKeyReturned, returnedAmount.String()),
+		sdk.NewAttribute(types.AttributeKeyBurned, burnedAmount.String()),
+	)
+}
+
+func EmitSnapshotTaken(ctx sdk.Context, signer string, snapshot types.Snapshot) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newSnapshotTakenEvent(signer, snapshot),
+	})
+}
+
+func newSnapshotTakenEvent(signer string, snapshot types.Snapshot) sdk.Event {
+	return sdk.NewEvent(
+		types.EventSnapshotTaken,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySigner, signer),
+		sdk.NewAttribute(types.AttributeKeyDenom, snapshot.Denom),
+		sdk.NewAttribute(types.AttributeKeySnapshotID, strconv.FormatUint(snapshot.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(snapshot.Height, 10)),
+		sdk.NewAttribute(types.AttributeKeySupply, snapshot.Supply.String()),
+	)
+}
+
+func EmitRewardsDistributed(ctx sdk.Context, signer string, pool types.RewardPool, reward sdk.Coin, eligibleSupply math.Uint) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newRewardsDistributedEvent(signer, pool, reward, eligibleSupply),
+	})
+}
+
+func newRewardsDistributedEvent(signer string, pool types.RewardPool, reward sdk.Coin, eligibleSupply math.Uint) sdk.Event {
+	return sdk.NewEvent(
+		types.EventRewardsDistributed,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySigner, signer),
+		sdk.NewAttribute(types.AttributeKeyDenom, pool.Denom),
+		sdk.NewAttribute(types.AttributeKeyReward, reward.String()),
+		sdk.NewAttribute(types.AttributeKeyEligibleSupply, eligibleSupply.String()),
+		sdk.NewAttribute(types.AttributeKeyRewardPerToken, pool.RewardPerToken.String()),
+	)
+}
+
+func EmitRewardsClaimed(ctx sdk.Context, signer string, denom string, claimed sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newRewardsClaimedEvent(signer, denom, claimed),
+	})
+}
+
+func newRewardsClaimedEvent(signer string, denom string, claimed sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		types.EventRewardsClaimed,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySigner, signer),
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyAmount, claimed.String()),
+	)
+}
+
+func EmitCW20BridgeRegistered(ctx sdk.Context, bridge types.CW20Bridge) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newCW20BridgeRegisteredEvent(bridge),
+	})
+}
+
+func newCW20BridgeRegisteredEvent(bridge types.CW20Bridge) sdk.Event {
+	return sdk.NewEvent(
+		types.EventCW20BridgeRegistered,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyContractAddress, bridge.ContractAddress),
+		sdk.NewAttribute(types.AttributeKeyDenom, bridge.Denom),
+	)
+}
+
+func EmitCW20ConvertedToCoin(ctx sdk.Context, signer string, bridge types.CW20Bridge, coin sdk.Coin) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newCW20ConversionEvent(types.EventCW20ConvertedToCoin, signer, bridge, coin),
+	})
+}
+
+func EmitCoinConvertedToCW20(ctx sdk.Context, signer string, bridge types.CW20Bridge, coin sdk.Coin) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newCW20ConversionEvent(types.EventCoinConvertedToCW20, signer, bridge, coin),
+	})
+}
+
+func newCW20ConversionEvent(eventType string, signer string, bridge types.CW20Bridge, coin sdk.Coin) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySigner, signer),
+		sdk.NewAttribute(types.AttributeKeyContractAddress, bridge.ContractAddress),
+		sdk.NewAttribute(types.AttributeKeyAmount, coin.String()),
+		sdk.NewAttribute(types.AttributeKeyEscrowed, bridge.Escrowed.String()),
+	)
+}
+
+func EmitDenomRoleGranted(ctx sdk.Context, signer string, role types.DenomRole) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newDenomRoleEvent(types.EventDenomRoleGranted, signer, role),
+	})
+}
+
+func EmitDenomRoleRevoked(ctx sdk.Context, signer string, role types.DenomRole) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newDenomRoleEvent(types.EventDenomRoleRevoked, signer, role),
+	})
+}
+
+func newDenomRoleEvent(eventType string, signer string, role types.DenomRole) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySigner, signer),
+		sdk.NewAttribute(types.AttributeKeyDenom, role.Denom),
+		sdk.NewAttribute(types.AttributeKeyRole, role.Role),
+		sdk.NewAttribute(types.AttributeKeyAddress, role.Address),
+	)
+}
+
+func EmitDenomVerified(ctx sdk.Context, signer string, verified types.VerifiedDenom) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventDenomVerified,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySigner, signer),
+			sdk.NewAttribute(types.AttributeKeyDenom, verified.Denom),
+			sdk.NewAttribute(types.AttributeKeyTicker, verified.Ticker),
+			sdk.NewAttribute(types.AttributeKeyDenomURIHash, verified.UriHash),
+			sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(uint64(verified.Decimals), 10)),
+		),
+	})
+}
+
+func EmitDenomUnverified(ctx sdk.Context, signer string, verified types.VerifiedDenom) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventDenomUnverified,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySigner, signer),
+			sdk.NewAttribute(types.AttributeKeyDenom, verified.Denom),
+			sdk.NewAttribute(types.AttributeKeyTicker, verified.Ticker),
+		),
+	})
+}
+
+func EmitTransferTaxSet(ctx sdk.Context, signer string, tax types.TransferTax) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTransferTaxSet,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySigner, signer),
+			sdk.NewAttribute(types.AttributeKeyDenom, tax.Denom),
+			sdk.NewAttribute(types.AttributeKeyRate, tax.Rate.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, tax.Recipient),
+		),
+	})
+}
+
+func EmitTransferTaxExemptionSet(ctx sdk.Context, signer string, denom string, address string, exempt bool) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTaxExemptionSet,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySigner, signer),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyAddress, address),
+			sdk.NewAttribute(types.AttributeKeyExempt, strconv.FormatBool(exempt)),
+		),
+	})
+}
+
+func EmitTransferTaxPaid(ctx sdk.Context, fromAddress string, toAddress string, recipient string, tax sdk.Coin) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTransferTaxPaid,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyFromAddress, fromAddress),
+			sdk.NewAttribute(types.AttributeKeyToAddress, toAddress),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(types.AttributeKeyTax, tax.String()),
+		),
+	})
+}
+
+func EmitTokenLaunched(ctx sdk.Context, launch types.TokenLaunch) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTokenLaunched,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyCreator, launch.Creator),
+			sdk.NewAttribute(types.AttributeKeyDenom, launch.Denom),
+			sdk.NewAttribute(types.AttributeKeySupply, launch.Supply.String()),
+			sdk.NewAttribute(types.AttributeKeyCurveSupply, launch.CurveSupply.String()),
+			sdk.NewAttribute(types.AttributeKeyMarketCap, launch.GraduationMarketCap.String()),
+		),
+	})
+}
+
+func EmitLaunchTokenBought(ctx sdk.Context, launch types.TokenLaunch, signer string, incoming sdk.Coin, outgoing sdk.Coin) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newLaunchTradeEvent(types.EventLaunchTokenBought, launch, signer, incoming, outgoing),
+	})
+}
+
+func EmitLaunchTokenSold(ctx sdk.Context, launch types.TokenLaunch, signer string, incoming sdk.Coin, outgoing sdk.Coin) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newLaunchTradeEvent(types.EventLaunchTokenSold, launch, signer, incoming, outgoing),
+	})
+}
+
+func newLaunchTradeEvent(eventType string, launch types.TokenLaunch, signer string, incoming sdk.Coin, outgoing sdk.Coin) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySigner, signer),
+		sdk.NewAttribute(types.AttributeKeyDenom, launch.Denom),
+		sdk.NewAttribute(types.AttributeKeyIncoming, incoming.String()),
+		sdk.NewAttribute(types.AttributeKeyOutgoing, outgoing.String()),
+		sdk.NewAttribute(types.AttributeKeyRaised, launch.Raised.String()),
+		sdk.NewAttribute(types.AttributeKeyMarketCap, launch.MarketCap().String()),
+	)
+}
+
+func EmitTokenLaunchGraduated(ctx sdk.Context, launch types.TokenLaunch, base sdk.Coin, quote sdk.Coin, lpToken sdk.Coin) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventLaunchGraduated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyDenom, launch.Denom),
+			sdk.NewAttribute(types.AttributeKeyPoolID, launch.PoolId),
+			sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoins(base, quote).String()),
+			sdk.NewAttribute(types.AttributeKeyLPToken, lpToken.String()),
+		),
+	})
+}
@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+// SetAirdrop set a specific airdrop in the store from its id, along with its expiry index
+func (k Keeper) SetAirdrop(ctx context.Context, airdrop types.Airdrop) {
+	if err := k.OpenAirdrops.Set(ctx, airdrop.Id, airdrop); err != nil {
+		panic(err)
+	}
+
+	if err := k.AirdropsByExpiry.Set(ctx, collections.Join(airdrop.ExpiryTime, airdrop.Id)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAirdrop returns an airdrop from its id
+func (k Keeper) GetAirdrop(ctx context.Context, id uint64) (val types.Airdrop, found bool) {
+	val, err := k.OpenAirdrops.Get(ctx, id)
+	if err != nil {
+		return val, false
+	}
+
+	return val, true
+}
+
+// DeleteAirdrop removes an airdrop with its expiry index, its claims are queued for pruning
+// as an airdrop can have too many of them to clear in a single block
+func (k Keeper) DeleteAirdrop(ctx context.Context, airdrop types.Airdrop) {
+	if err := k.OpenAirdrops.Remove(ctx, airdrop.Id); err != nil {
+		panic(err)
+	}
+
+	if err := k.AirdropsByExpiry.Remove(ctx, collections.Join(airdrop.ExpiryTime, airdrop.Id)); err != nil {
+		panic(err)
+	}
+
+	if err := k.ClosedAirdrops.Set(ctx, airdrop.Id); err != nil {
+		panic(err)
+	}
+}
+
+// PruneClosedAirdropClaims removes the claims of the closed airdrops in id order, at most
+// MaxAirdropClaimsPrunedPerBlock per block, the queue of closed airdrops being the cursor
+func (k Keeper) PruneClosedAirdropClaims(ctx context.Context) {
+	closedIterator, err := k.ClosedAirdrops.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	// the queue is read up to the same limit, a closed airdrop can have no claims at all
+	var closed []uint64
+	for ; closedIterator.Valid() && len(closed) < types.MaxAirdropClaimsPrunedPerBlock; closedIterator.Next() {
+		id, err := closedIterator.Key()
+		if err != nil {
+			panic(err)
+		}
+		closed = append(closed, id)
+	}
+	closedIterator.Close()
+
+	pruned := 0
+	for _, id := range closed {
+		iterator, err := k.AirdropClaims.Iterate(ctx, collections.NewPrefixedPairRange[uint64, string](id))
+		if err != nil {
+			panic(err)
+		}
+
+		var claims []collections.Pair[uint64, string]
+		for ; iterator.Valid() && pruned+len(claims) < types.MaxAirdropClaimsPrunedPerBlock; iterator.Next() {
+			key, err := iterator.Key()
+			if err != nil {
+				panic(err)
+			}
+			claims = append(claims, key)
+		}
+		// claims left after the limit keep the airdrop in the queue for the next block
+		done := !iterator.Valid()
+		iterator.Close()
+
+		for _, key := range claims {
+			if err := k.AirdropClaims.Remove(ctx, key); err != nil {
+				panic(err)
+			}
+		}
+		pruned += len(claims)
+
+		if !done {
+			return
+		}
+
+		if err := k.ClosedAirdrops.Remove(ctx, id); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// GetAllAirdrop returns all airdrop
+func (k Keeper) GetAllAirdrop(ctx context.Context) (list []types.Airdrop) {
+	iterator, err := k.OpenAirdrops.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// SetAirdropClaimed records that an address has claimed an airdrop
+func (k Keeper) SetAirdropClaimed(ctx context.Context, airdropID uint64, address string) {
+	if err := k.AirdropClaims.Set(ctx, collections.Join(airdropID, address)); err != nil {
+		panic(err)
+	}
+}
+
+// IsAirdropClaimed returns true if an address has claimed an airdrop
+func (k Keeper) IsAirdropClaimed(ctx context.Context, airdropID uint64, address string) bool {
+	claimed, err := k.AirdropClaims.Has(ctx, collections.Join(airdropID, address))
+	if err != nil {
+		return false
+	}
+
+	return claimed
+}
+
+// GetAllAirdropClaim returns all airdropClaim of the open airdrops, the claims of the closed
+// airdrops are being pruned
+func (k Keeper) GetAllAirdropClaim(ctx context.Context) (list []types.AirdropClaim) {
+	iterator, err := k.AirdropClaims.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	keys, err := iterator.Keys()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, key := range keys {
+		if closed, err := k.ClosedAirdrops.Has(ctx, key.K1()); err == nil && closed {
+			continue
+		}
+		list = append(list, types.AirdropClaim{AirdropId: key.K1(), Address: key.K2()})
+	}
+
+	return
+}
+
+// GetAirdropCount returns the number of airdrops created, the id of the next airdrop
+func (k Keeper) GetAirdropCount(ctx context.Context) uint64 {
+	count, err := k.AirdropSeq.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return count
+}
+
+// SetAirdropCount sets the number of airdrops created
+func (k Keeper) SetAirdropCount(ctx context.Context, count uint64) {
+	if err := k.AirdropSeq.Set(ctx, count); err != nil {
+		panic(err)
+	}
+}
+
+// AirdropEndBlocker closes the airdrops expired at the block time and prunes the claims of the closed airdrops
+func (k Keeper) AirdropEndBlocker(ctx sdk.Context) {
+	now := ctx.BlockTime().Unix()
+
+	// every airdrop with an expiry time up to now, whatever its id
+	expiredRange := new(collections.Range[collections.Pair[int64, uint64]]).EndInclusive(collections.Join(now, ^uint64(0)))
+
+	iterator, err := k.AirdropsByExpiry.Iterate(ctx, expiredRange)
+	if err != nil {
+		panic(err)
+	}
+
+	expired, err := iterator.Keys()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, key := range expired {
+		airdrop, found := k.GetAirdrop(ctx, key.K2())
+		if !found {
+			continue
+		}
+
+		k.closeAirdrop(ctx, airdrop)
+	}
+
+	k.PruneClosedAirdropClaims(ctx)
+}
+
+// closeAirdrop returns the unclaimed amount of an expired airdrop to its creator, or burns it, and
+// removes the airdrop. When the creator can not receive the amount, e.g. it is frozen, it is burned.
+func (k Keeper) closeAirdrop(ctx sdk.Context, airdrop types.Airdrop) {
+	remaining := sdk.NewCoins(sdk.NewCoin(airdrop.Denom, math.NewIntFromBigInt(airdrop.Remaining().BigInt())))
+
+	returned := false
+	if !airdrop.BurnUnclaimed && !remaining.IsZero() {
+		returnCtx, write := ctx.CacheContext()
+		creator := sdk.MustAccAddressFromBech32(airdrop.Creator)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(returnCtx, types.AirdropEscrowName, creator, remaining); err != nil {
+			k.Logger().Error("airdrop return failed, burning the unclaimed amount", "airdrop", airdrop.Id, "error", err)
+		} else {
+			write()
+			returned = true
+		}
+	}
+
+	if !returned && !remaining.IsZero() {
+		burnCtx, write := ctx.CacheContext()
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(burnCtx, types.AirdropEscrowName, types.ModuleName, remaining); err != nil {
+			k.Logger().Error("airdrop burn failed", "airdrop", airdrop.Id, "error", err)
+			return
+		}
+		if err := k.bankKeeper.BurnCoins(burnCtx, types.ModuleName, remaining); err != nil {
+			k.Logger().Error("airdrop burn failed", "airdrop", airdrop.Id, "error", err)
+			return
+		}
+		write()
+	}
+
+	k.DeleteAirdrop(ctx, airdrop)
+
+	events.EmitAirdropClosed(ctx, airdrop, remaining, returned)
+}
@@ -9,7 +9,10 @@ import (
 	"zigchain/x/factory/types"
 )
 
-func (k Keeper) Auth(ctx context.Context, denom string, adminType string, signer string) error {
+// Auth checks the signer holds a role of a denom. The admin, the bank admin of the denom auth,
+// implicitly holds every role, the other roles are granted by the admin.
+// Once the admin is disabled only the metadata editors keep their role.
+func (k Keeper) Auth(ctx context.Context, denom string, role string, signer string) error {
 	// Check if the value exists
 	denomAuth, isFound := k.GetDenomAuth(
 		ctx,
@@ -23,62 +26,61 @@ func (k Keeper) Auth(ctx context.Context, denom string, adminType string, signer
 		)
 	}
 
-	switch adminType {
-	case "bank":
+	if err := types.ValidateRole(role); err != nil {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"Auth: invalid role for denom (%s) : %s",
+			denom,
+			err,
+		)
+	}
+
+	// Check if the role is locked with the bank admin disabled
+	if denomAuth.BankAdmin == "" && types.RoleLockedWithAdmin(role) {
+		return errorsmod.Wrapf(
+			types.ErrDenomLocked,
+			"Auth: bank admin disabled (%s)",
+			denom,
+		)
+	}
 
-		// Check if bank admin is disabled
-		if denomAuth.BankAdmin == "" {
-			return errorsmod.Wrapf(
-				types.ErrDenomLocked,
-				"Auth: bank admin disabled (%s)",
-				denom,
-			)
-		}
-		// Checks if the msg admin is the same as the current admin
-		if signer != denomAuth.BankAdmin {
-			return errorsmod.Wrapf(
-				sdkerrors.ErrUnauthorized,
-				"Auth: incorrect admin for denom (%s) only the current bank admin (%s) can perform this action "+
-					"(attempted with: %s)",
-				denom,
-				denomAuth.BankAdmin,
-				signer,
-			)
-		}
+	// The bank admin holds every role
+	if signer == denomAuth.BankAdmin {
 		return nil
-	case "metadata":
-		// First check if the signer is the bank admin
-		if signer == denomAuth.BankAdmin {
-			return nil
-		}
+	}
 
-		// If not bank admin, then check metadata admin permissions
-		if denomAuth.MetadataAdmin == "" {
-			return errorsmod.Wrapf(
-				types.ErrDenomLocked,
-				"Auth: metadata admin disabled (%s)",
-				denom,
-			)
-		}
+	if role == types.RoleAdmin {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"Auth: incorrect admin for denom (%s) only the current bank admin (%s) can perform this action "+
+				"(attempted with: %s)",
+			denom,
+			denomAuth.BankAdmin,
+			signer,
+		)
+	}
 
-		// Checks if the msg admin is the same as the current metadata admin
-		if signer != denomAuth.MetadataAdmin {
-			return errorsmod.Wrapf(
-				sdkerrors.ErrUnauthorized,
-				"Auth: incorrect admin for denom (%s) only the current admins bank (%s) or metadata (%s) can update the denom metadata",
-				denom,
-				denomAuth.BankAdmin,
-				denomAuth.MetadataAdmin,
-			)
-		}
+	if k.HasDenomRole(ctx, denom, role, signer) {
 		return nil
-	default:
+	}
+
+	// Without a metadata admin nor another metadata editor the metadata is locked
+	if role == types.RoleMetadataEditor && denomAuth.MetadataAdmin == "" &&
+		len(k.GetDenomRoleHolders(ctx, denom, role)) == 0 {
 		return errorsmod.Wrapf(
-			sdkerrors.ErrInvalidRequest,
-			"Auth: invalid admin type (%s), for denom (%s), only 'bank' or 'metadata' are allowed",
-			adminType,
+			types.ErrDenomLocked,
+			"Auth: metadata admin disabled (%s)",
 			denom,
 		)
 	}
 
+	return errorsmod.Wrapf(
+		sdkerrors.ErrUnauthorized,
+		"Auth: incorrect admin for denom (%s) only the current bank admin (%s) or a holder of the %s role "+
+			"can perform this action (attempted with: %s)",
+		denom,
+		denomAuth.BankAdmin,
+		role,
+		signer,
+	)
 }
@@ -34,7 +34,7 @@ func TestAuthBankAdmin_Positive(t *testing.T) {
 
 	factoryKeeper, ctx, denomAuth := setupAuthTest(t)
 
-	err := factoryKeeper.Auth(ctx, denomAuth.Denom, "bank", denomAuth.BankAdmin)
+	err := factoryKeeper.Auth(ctx, denomAuth.Denom, types.RoleAdmin, denomAuth.BankAdmin)
 	require.NoError(t, err)
 }
 
@@ -44,7 +44,7 @@ func TestAuthMetadataAdmin_Positive(t *testing.T) {
 	factoryKeeper, ctx, denomAuth := setupAuthTest(t)
 
 	// Test successful metadata admin auth
-	err := factoryKeeper.Auth(ctx, denomAuth.Denom, "metadata", denomAuth.MetadataAdmin)
+	err := factoryKeeper.Auth(ctx, denomAuth.Denom, types.RoleMetadataEditor, denomAuth.MetadataAdmin)
 	require.NoError(t, err)
 }
 
@@ -55,7 +55,7 @@ func TestAuthBankAdmin_DenomUnauthorized(t *testing.T) {
 
 	factoryKeeper, ctx, denomAuth := setupAuthTest(t)
 
-	err := factoryKeeper.Auth(ctx, denomAuth.Denom, "bank", "unauthorized-address")
+	err := factoryKeeper.Auth(ctx, denomAuth.Denom, types.RoleAdmin, "unauthorized-address")
 	require.Error(t, err)
 	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
 }
@@ -67,7 +67,7 @@ func TestAuthBankAdmin_DenomLocked(t *testing.T) {
 
 	denomAuth.BankAdmin = ""
 	factoryKeeper.SetDenomAuth(ctx, denomAuth)
-	err := factoryKeeper.Auth(ctx, denomAuth.Denom, "bank", "any-address")
+	err := factoryKeeper.Auth(ctx, denomAuth.Denom, types.RoleAdmin, "any-address")
 	require.Error(t, err)
 	require.ErrorIs(t, err, types.ErrDenomLocked)
 }
@@ -78,15 +78,15 @@ func TestAuthMetadataAdmin(t *testing.T) {
 	factoryKeeper, ctx, denomAuth := setupAuthTest(t)
 
 	// Test successful metadata admin auth
-	err := factoryKeeper.Auth(ctx, denomAuth.Denom, "metadata", denomAuth.MetadataAdmin)
+	err := factoryKeeper.Auth(ctx, denomAuth.Denom, types.RoleMetadataEditor, denomAuth.MetadataAdmin)
 	require.NoError(t, err)
 
 	// Test successful bank admin auth for metadata operations
-	err = factoryKeeper.Auth(ctx, denomAuth.Denom, "metadata", denomAuth.BankAdmin)
+	err = factoryKeeper.Auth(ctx, denomAuth.Denom, types.RoleMetadataEditor, denomAuth.BankAdmin)
 	require.NoError(t, err)
 
 	// Test unauthorized metadata admin
-	err = factoryKeeper.Auth(ctx, denomAuth.Denom, "metadata", "unauthorized-address")
+	err = factoryKeeper.Auth(ctx, denomAuth.Denom, types.RoleMetadataEditor, "unauthorized-address")
 	require.Error(t, err)
 	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
 
@@ -95,11 +95,11 @@ func TestAuthMetadataAdmin(t *testing.T) {
 	factoryKeeper.SetDenomAuth(ctx, denomAuth)
 
 	// Bank admin should still have access
-	err = factoryKeeper.Auth(ctx, denomAuth.Denom, "metadata", denomAuth.BankAdmin)
+	err = factoryKeeper.Auth(ctx, denomAuth.Denom, types.RoleMetadataEditor, denomAuth.BankAdmin)
 	require.NoError(t, err)
 
 	// Other addresses should be denied
-	err = factoryKeeper.Auth(ctx, denomAuth.Denom, "metadata", "any-address")
+	err = factoryKeeper.Auth(ctx, denomAuth.Denom, types.RoleMetadataEditor, "any-address")
 	require.Error(t, err)
 	require.ErrorIs(t, err, types.ErrDenomLocked)
 }
@@ -109,7 +109,7 @@ func TestAuthInvalidCases_DenomAuthNotFound(t *testing.T) {
 
 	factoryKeeper, ctx, _ := setupAuthTest(t)
 
-	err := factoryKeeper.Auth(ctx, "non-existent-denom", "bank", "any-address")
+	err := factoryKeeper.Auth(ctx, "non-existent-denom", types.RoleAdmin, "any-address")
 	require.Error(t, err)
 	require.ErrorIs(t, err, types.ErrDenomAuthNotFound)
 }
@@ -129,7 +129,7 @@ func TestAuthInvalidCases_EmptyDenom(t *testing.T) {
 
 	factoryKeeper, ctx, _ := setupAuthTest(t)
 
-	err := factoryKeeper.Auth(ctx, "", "bank", "any-address")
+	err := factoryKeeper.Auth(ctx, "", types.RoleAdmin, "any-address")
 	require.Error(t, err)
 	require.ErrorIs(t, err, types.ErrDenomAuthNotFound)
 }
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"zigchain/x/factory/types"
+)
+
+// beforeSendHookContextKey marks the context of a running hook contract with the denoms whose hooks
+// are running, the transfers of these denoms made by the contract do not call their hooks again
+type beforeSendHookContextKey struct{}
+
+// runningBeforeSendHooks returns the denoms whose hook contracts are running in the context
+func runningBeforeSendHooks(ctx sdk.Context) map[string]struct{} {
+	denoms, _ := ctx.Value(beforeSendHookContextKey{}).(map[string]struct{})
+	return denoms
+}
+
+var _ banktypes.SendRestrictionFn = Keeper{}.BeforeSendRestriction
+
+// SetBeforeSendHook set a specific beforeSendHook in the store from its index
+func (k Keeper) SetBeforeSendHook(ctx context.Context, hook types.BeforeSendHook) {
+	if err := k.BeforeSendHooks.Set(ctx, hook.Denom, hook); err != nil {
+		panic(err)
+	}
+}
+
+// GetBeforeSendHook returns a beforeSendHook from its index
+func (k Keeper) GetBeforeSendHook(
+	ctx context.Context,
+	denom string,
+) (val types.BeforeSendHook, found bool) {
+	val, err := k.BeforeSendHooks.Get(ctx, denom)
+	if err != nil {
+		return val, false
+	}
+
+	return val, true
+}
+
+// DeleteBeforeSendHook deletes the beforeSendHook of a denom
+func (k Keeper) DeleteBeforeSendHook(ctx context.Context, denom string) {
+	if err := k.BeforeSendHooks.Remove(ctx, denom); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllBeforeSendHook returns all beforeSendHook
+func (k Keeper) GetAllBeforeSendHook(ctx context.Context) (list []types.BeforeSendHook) {
+	iterator, err := k.BeforeSendHooks.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// BeforeSendRestriction is the bank send restriction calling the before send hook contract
+// of every factory denom in the transfer, a rejection by any contract fails the transfer.
+// The transfers from or to other module accounts, such as the dex pool swaps, are sent to the contracts.
+// The force transfers, the transfer tax payments and the mints and burns going through the factory
+// module account are not, so a broken contract can not block them.
+func (k Keeper) BeforeSendRestriction(
+	ctx context.Context,
+	fromAddr sdk.AccAddress,
+	toAddr sdk.AccAddress,
+	amt sdk.Coins,
+) (sdk.AccAddress, error) {
+	if k.wasm.contractKeeper == nil {
+		return toAddr, nil
+	}
+
+	if isForceTransfer(ctx) || isTransferTax(ctx) {
+		return toAddr, nil
+	}
+
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	if fromAddr.Equals(moduleAddr) || toAddr.Equals(moduleAddr) {
+		return toAddr, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	running := runningBeforeSendHooks(sdkCtx)
+
+	for _, coin := range amt {
+		// only factory denoms can have a hook, skip the store lookup for the others
+		if !isFactoryDenom(coin.Denom) {
+			continue
+		}
+
+		// the transfers of a denom made by its own hook contract do not call the hook again
+		if _, ok := running[coin.Denom]; ok {
+			continue
+		}
+
+		hook, found := k.GetBeforeSendHook(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+
+		if err := k.callBeforeSendHook(sdkCtx, hook, fromAddr, toAddr, coin); err != nil {
+			return toAddr, err
+		}
+	}
+
+	return toAddr, nil
+}
+
+// callBeforeSendHook calls the hook contract through sudo with at most types.BeforeSendHookGasLimit gas,
+// the gas used is charged to the transfer and the contract state changes are only kept on success.
+// A contract running out of gas rejects the transfer, the hook fails closed.
+func (k Keeper) callBeforeSendHook(
+	ctx sdk.Context,
+	hook types.BeforeSendHook,
+	fromAddr sdk.AccAddress,
+	toAddr sdk.AccAddress,
+	coin sdk.Coin,
+) (err error) {
+	contractAddr, err := sdk.AccAddressFromBech32(hook.ContractAddress)
+	if err != nil {
+		return errorsmod.Wrapf(
+			types.ErrInvalidContractAddress,
+			"before send hook of denom (%s) : %s",
+			hook.Denom,
+			err,
+		)
+	}
+
+	msg, err := json.Marshal(types.BeforeSendSudoMsg{
+		BeforeSend: types.BeforeSendMsg{
+			From:   fromAddr.String(),
+			To:     toAddr.String(),
+			Amount: coin,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// the running denoms are copied, a nested hook call must not mark the denoms of its caller
+	running := map[string]struct{}{hook.Denom: {}}
+	for denom := range runningBeforeSendHooks(ctx) {
+		running[denom] = struct{}{}
+	}
+
+	gasMeter := storetypes.NewGasMeter(types.BeforeSendHookGasLimit)
+	hookCtx, write := ctx.
+		WithGasMeter(gasMeter).
+		WithValue(beforeSendHookContextKey{}, running).
+		CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(
+				types.ErrBeforeSendHookRejected,
+				"denom (%s) contract (%s) : out of gas, limit %d",
+				hook.Denom,
+				hook.ContractAddress,
+				types.BeforeSendHookGasLimit,
+			)
+		}
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "before send hook")
+	}()
+
+	if _, err := k.wasm.contractKeeper.Sudo(hookCtx, contractAddr, msg); err != nil {
+		return errorsmod.Wrapf(
+			types.ErrBeforeSendHookRejected,
+			"denom (%s) contract (%s) : %s",
+			hook.Denom,
+			hook.ContractAddress,
+			err,
+		)
+	}
+
+	write()
+
+	return nil
+}
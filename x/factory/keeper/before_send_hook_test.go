@@ -0,0 +1,315 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "zigchain/testutil/keeper"
+	"zigchain/testutil/sample"
+	"zigchain/x/factory/keeper"
+	"zigchain/x/factory/types"
+)
+
+// fakeContractKeeper records the sudo calls of the before send hooks
+type fakeContractKeeper struct {
+	contracts map[string]bool
+	calls     []types.BeforeSendSudoMsg
+	sudo      func(ctx context.Context) error
+}
+
+func (f *fakeContractKeeper) HasContractInfo(_ context.Context, addr sdk.AccAddress) bool {
+	return f.contracts[addr.String()]
+}
+
+func (f *fakeContractKeeper) Sudo(ctx context.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	var sudoMsg types.BeforeSendSudoMsg
+	if err := json.Unmarshal(msg, &sudoMsg); err != nil {
+		return nil, err
+	}
+	f.calls = append(f.calls, sudoMsg)
+
+	if f.sudo != nil {
+		return nil, f.sudo(ctx)
+	}
+
+	return nil, nil
+}
+
+func setupBeforeSendHookTest(t *testing.T) (keeper.Keeper, sdk.Context, *fakeContractKeeper, types.DenomAuth, string) {
+	factoryKeeper, ctx := keepertest.FactoryKeeper(t, nil, nil)
+
+	contract := sample.AccAddress()
+	contractKeeper := &fakeContractKeeper{contracts: map[string]bool{contract: true}}
+	factoryKeeper.SetContractKeeper(contractKeeper)
+
+	denomAuth := types.DenomAuth{
+		Denom:         "coin." + sample.AccAddress() + ".abc",
+		BankAdmin:     sample.AccAddress(),
+		MetadataAdmin: sample.AccAddress(),
+	}
+	factoryKeeper.SetDenomAuth(ctx, denomAuth)
+
+	return factoryKeeper, ctx, contractKeeper, denomAuth, contract
+}
+
+// Positive test cases
+
+func TestMsgServer_SetBeforeSendHook_Positive(t *testing.T) {
+	// Test case: the bank admin sets and then removes the hook of a denom
+
+	factoryKeeper, ctx, _, denomAuth, contract := setupBeforeSendHookTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	resp, err := server.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(denomAuth.BankAdmin, denomAuth.Denom, contract))
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgSetBeforeSendHookResponse{Denom: denomAuth.Denom, ContractAddress: contract}, resp)
+
+	hook, found := factoryKeeper.GetBeforeSendHook(ctx, denomAuth.Denom)
+	require.True(t, found)
+	require.Equal(t, types.BeforeSendHook{Denom: denomAuth.Denom, ContractAddress: contract}, hook)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventBeforeSendHookSet, events[len(events)-1].Type)
+
+	_, err = server.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(denomAuth.BankAdmin, denomAuth.Denom, ""))
+	require.NoError(t, err)
+
+	_, found = factoryKeeper.GetBeforeSendHook(ctx, denomAuth.Denom)
+	require.False(t, found)
+}
+
+func TestBeforeSendRestriction_CallsHook(t *testing.T) {
+	// Test case: a transfer of a denom with a hook calls the contract with the transfer details
+
+	factoryKeeper, ctx, contractKeeper, denomAuth, contract := setupBeforeSendHookTest(t)
+	factoryKeeper.SetBeforeSendHook(ctx, types.BeforeSendHook{Denom: denomAuth.Denom, ContractAddress: contract})
+
+	from := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	to := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	coin := sdk.NewCoin(denomAuth.Denom, math.NewInt(100))
+
+	newTo, err := factoryKeeper.BeforeSendRestriction(ctx, from, to, sdk.NewCoins(coin, sdk.NewCoin("uzig", math.NewInt(5))))
+	require.NoError(t, err)
+	require.Equal(t, to, newTo)
+
+	require.Len(t, contractKeeper.calls, 1)
+	require.Equal(t, types.BeforeSendMsg{From: from.String(), To: to.String(), Amount: coin}, contractKeeper.calls[0].BeforeSend)
+}
+
+func TestBeforeSendRestriction_NoHook(t *testing.T) {
+	// Test case: transfers of denoms without a hook do not call any contract
+
+	factoryKeeper, ctx, contractKeeper, denomAuth, _ := setupBeforeSendHookTest(t)
+
+	from := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	to := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	_, err := factoryKeeper.BeforeSendRestriction(ctx, from, to, sdk.NewCoins(
+		sdk.NewCoin(denomAuth.Denom, math.NewInt(100)),
+		sdk.NewCoin("uzig", math.NewInt(5)),
+	))
+	require.NoError(t, err)
+	require.Empty(t, contractKeeper.calls)
+}
+
+func TestBeforeSendRestriction_Reentrancy(t *testing.T) {
+	// Test case: transfers made by the hook contract do not call the hook again
+
+	factoryKeeper, ctx, contractKeeper, denomAuth, contract := setupBeforeSendHookTest(t)
+	factoryKeeper.SetBeforeSendHook(ctx, types.BeforeSendHook{Denom: denomAuth.Denom, ContractAddress: contract})
+
+	from := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	to := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	amt := sdk.NewCoins(sdk.NewCoin(denomAuth.Denom, math.NewInt(100)))
+
+	contractKeeper.sudo = func(ctx context.Context) error {
+		_, err := factoryKeeper.BeforeSendRestriction(ctx, to, from, amt)
+		return err
+	}
+
+	_, err := factoryKeeper.BeforeSendRestriction(ctx, from, to, amt)
+	require.NoError(t, err)
+	require.Len(t, contractKeeper.calls, 1)
+}
+
+func TestBeforeSendRestriction_ReentrancyOtherDenom(t *testing.T) {
+	// Test case: transfers of another denom made by a hook contract still call the hook of that denom
+
+	factoryKeeper, ctx, contractKeeper, denomAuth, contract := setupBeforeSendHookTest(t)
+	otherDenom := "coin." + sample.AccAddress() + ".def"
+	factoryKeeper.SetBeforeSendHook(ctx, types.BeforeSendHook{Denom: denomAuth.Denom, ContractAddress: contract})
+	factoryKeeper.SetBeforeSendHook(ctx, types.BeforeSendHook{Denom: otherDenom, ContractAddress: contract})
+
+	from := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	to := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	contractKeeper.sudo = func(ctx context.Context) error {
+		if len(contractKeeper.calls) > 1 {
+			return nil
+		}
+		_, err := factoryKeeper.BeforeSendRestriction(ctx, to, from, sdk.NewCoins(sdk.NewCoin(otherDenom, math.NewInt(1))))
+		return err
+	}
+
+	_, err := factoryKeeper.BeforeSendRestriction(ctx, from, to, sdk.NewCoins(sdk.NewCoin(denomAuth.Denom, math.NewInt(100))))
+	require.NoError(t, err)
+	require.Len(t, contractKeeper.calls, 2)
+	require.Equal(t, otherDenom, contractKeeper.calls[1].BeforeSend.Amount.Denom)
+}
+
+func TestQueryBeforeSendHook_Positive(t *testing.T) {
+	// Test case: query the hook of a denom
+
+	factoryKeeper, ctx, _, denomAuth, contract := setupBeforeSendHookTest(t)
+	hook := types.BeforeSendHook{Denom: denomAuth.Denom, ContractAddress: contract}
+	factoryKeeper.SetBeforeSendHook(ctx, hook)
+
+	resp, err := factoryKeeper.BeforeSendHook(ctx, &types.QueryBeforeSendHookRequest{Denom: denomAuth.Denom})
+	require.NoError(t, err)
+	require.Equal(t, hook, resp.BeforeSendHook)
+}
+
+// Negative test cases
+
+func TestMsgServer_SetBeforeSendHook_Unauthorized(t *testing.T) {
+	// Test case: only the bank admin can set the hook
+
+	factoryKeeper, ctx, _, denomAuth, contract := setupBeforeSendHookTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	_, err := server.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(denomAuth.MetadataAdmin, denomAuth.Denom, contract))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, found := factoryKeeper.GetBeforeSendHook(ctx, denomAuth.Denom)
+	require.False(t, found)
+}
+
+func TestMsgServer_SetBeforeSendHook_ContractNotFound(t *testing.T) {
+	// Test case: the contract must exist
+
+	factoryKeeper, ctx, _, denomAuth, _ := setupBeforeSendHookTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	_, err := server.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(denomAuth.BankAdmin, denomAuth.Denom, sample.AccAddress()))
+	require.ErrorIs(t, err, types.ErrContractNotFound)
+}
+
+func TestMsgServer_SetBeforeSendHook_NoContractKeeper(t *testing.T) {
+	// Test case: a hook can not be set without CosmWasm
+
+	factoryKeeper, ctx := keepertest.FactoryKeeper(t, nil, nil)
+	denomAuth := types.DenomAuth{
+		Denom:     "coin." + sample.AccAddress() + ".abc",
+		BankAdmin: sample.AccAddress(),
+	}
+	factoryKeeper.SetDenomAuth(ctx, denomAuth)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	_, err := server.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(denomAuth.BankAdmin, denomAuth.Denom, sample.AccAddress()))
+	require.ErrorIs(t, err, types.ErrContractNotFound)
+}
+
+func TestBeforeSendRestriction_Rejected(t *testing.T) {
+	// Test case: a contract error fails the transfer and discards the contract state changes
+
+	factoryKeeper, ctx, contractKeeper, denomAuth, contract := setupBeforeSendHookTest(t)
+	factoryKeeper.SetBeforeSendHook(ctx, types.BeforeSendHook{Denom: denomAuth.Denom, ContractAddress: contract})
+
+	otherDenom := "coin." + sample.AccAddress() + ".def"
+	contractKeeper.sudo = func(ctx context.Context) error {
+		factoryKeeper.SetBeforeSendHook(ctx, types.BeforeSendHook{Denom: otherDenom, ContractAddress: contract})
+		return errors.New("transfer not allowed")
+	}
+
+	from := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	to := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	_, err := factoryKeeper.BeforeSendRestriction(ctx, from, to, sdk.NewCoins(sdk.NewCoin(denomAuth.Denom, math.NewInt(100))))
+	require.ErrorIs(t, err, types.ErrBeforeSendHookRejected)
+	require.ErrorContains(t, err, "transfer not allowed")
+
+	_, found := factoryKeeper.GetBeforeSendHook(ctx, otherDenom)
+	require.False(t, found)
+}
+
+func TestBeforeSendRestriction_OutOfGas(t *testing.T) {
+	// Test case: a contract using more than the gas limit rejects the transfer, its state changes
+	// are discarded and the limit is charged
+
+	factoryKeeper, ctx, contractKeeper, denomAuth, contract := setupBeforeSendHookTest(t)
+	factoryKeeper.SetBeforeSendHook(ctx, types.BeforeSendHook{Denom: denomAuth.Denom, ContractAddress: contract})
+
+	otherDenom := "coin." + sample.AccAddress() + ".def"
+	contractKeeper.sudo = func(ctx context.Context) error {
+		factoryKeeper.SetBeforeSendHook(ctx, types.BeforeSendHook{Denom: otherDenom, ContractAddress: contract})
+		sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(types.BeforeSendHookGasLimit+1, "loop")
+		return nil
+	}
+
+	from := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	to := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	gasBefore := ctx.GasMeter().GasConsumed()
+
+	_, err := factoryKeeper.BeforeSendRestriction(ctx, from, to, sdk.NewCoins(sdk.NewCoin(denomAuth.Denom, math.NewInt(100))))
+	require.ErrorIs(t, err, types.ErrBeforeSendHookRejected)
+	require.ErrorContains(t, err, "out of gas")
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed()-gasBefore, types.BeforeSendHookGasLimit)
+
+	_, found := factoryKeeper.GetBeforeSendHook(ctx, otherDenom)
+	require.False(t, found)
+}
+
+func TestBeforeSendRestriction_ModuleAccount(t *testing.T) {
+	// Test case: transfers from or to a module account, such as a dex pool swap, call the hook,
+	// the mints and burns going through the factory module account do not
+
+	factoryKeeper, ctx, contractKeeper, denomAuth, contract := setupBeforeSendHookTest(t)
+	factoryKeeper.SetBeforeSendHook(ctx, types.BeforeSendHook{Denom: denomAuth.Denom, ContractAddress: contract})
+	contractKeeper.sudo = func(context.Context) error {
+		return errors.New("transfer not allowed")
+	}
+
+	user := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	pool := authtypes.NewModuleAddress("pool")
+	factoryModule := authtypes.NewModuleAddress(types.ModuleName)
+	amt := sdk.NewCoins(sdk.NewCoin(denomAuth.Denom, math.NewInt(100)))
+
+	_, err := factoryKeeper.BeforeSendRestriction(ctx, pool, user, amt)
+	require.ErrorIs(t, err, types.ErrBeforeSendHookRejected)
+
+	_, err = factoryKeeper.BeforeSendRestriction(ctx, user, pool, amt)
+	require.ErrorIs(t, err, types.ErrBeforeSendHookRejected)
+	require.Len(t, contractKeeper.calls, 2)
+
+	_, err = factoryKeeper.BeforeSendRestriction(ctx, factoryModule, user, amt)
+	require.NoError(t, err)
+
+	_, err = factoryKeeper.BeforeSendRestriction(ctx, user, factoryModule, amt)
+	require.NoError(t, err)
+	require.Len(t, contractKeeper.calls, 2)
+}
+
+func TestQueryBeforeSendHook_Invalid(t *testing.T) {
+	// Test case: query with invalid requests
+
+	factoryKeeper, ctx, _, denomAuth, _ := setupBeforeSendHookTest(t)
+
+	_, err := factoryKeeper.BeforeSendHook(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = factoryKeeper.BeforeSendHook(ctx, &types.QueryBeforeSendHookRequest{Denom: "!"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = factoryKeeper.BeforeSendHook(ctx, &types.QueryBeforeSendHookRequest{Denom: denomAuth.Denom})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/types"
+)
+
+// SetCW20Bridge set a specific cw20Bridge in the store from its index
+func (k Keeper) SetCW20Bridge(ctx context.Context, bridge types.CW20Bridge) {
+	if err := k.CW20BridgeStore.Set(ctx, bridge.ContractAddress, bridge); err != nil {
+		panic(err)
+	}
+}
+
+// GetCW20Bridge returns the cw20Bridge of a contract
+func (k Keeper) GetCW20Bridge(ctx context.Context, contractAddress string) (val types.CW20Bridge, found bool) {
+	val, err := k.CW20BridgeStore.Get(ctx, contractAddress)
+	if err != nil {
+		return val, false
+	}
+
+	return val, true
+}
+
+// GetCW20BridgeByDenom returns the cw20Bridge of a bridged denom
+func (k Keeper) GetCW20BridgeByDenom(ctx context.Context, denom string) (val types.CW20Bridge, found bool) {
+	contractAddress, err := k.CW20BridgeStore.Indexes.Denom.MatchExact(ctx, denom)
+	if err != nil {
+		return val, false
+	}
+
+	return k.GetCW20Bridge(ctx, contractAddress)
+}
+
+// GetAllCW20Bridge returns all cw20Bridge
+func (k Keeper) GetAllCW20Bridge(ctx context.Context) (list []types.CW20Bridge) {
+	iterator, err := k.CW20BridgeStore.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// GetCW20EscrowAddress returns the address of the module account holding the bridged CW20 tokens
+func (k Keeper) GetCW20EscrowAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.CW20EscrowName)
+}
+
+// queryCW20 sends a smart query to a CW20 contract
+func (k Keeper) queryCW20(ctx context.Context, contractAddress string, query types.CW20QueryMsg, response any) error {
+	if k.wasm.contractViewKeeper == nil {
+		return errorsmod.Wrap(types.ErrInvalidCW20, "cw20 contracts can not be queried")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidContractAddress, "(%s) : %s", contractAddress, err)
+	}
+
+	req, err := json.Marshal(query)
+	if err != nil {
+		return err
+	}
+
+	res, err := k.wasm.contractViewKeeper.QuerySmart(ctx, contractAddr, req)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidCW20, "query of contract (%s) : %s", contractAddress, err)
+	}
+
+	if err := json.Unmarshal(res, response); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidCW20, "response of contract (%s) : %s", contractAddress, err)
+	}
+
+	return nil
+}
+
+// GetCW20TokenInfo returns the token info of a CW20 contract
+func (k Keeper) GetCW20TokenInfo(ctx context.Context, contractAddress string) (types.CW20TokenInfoResponse, error) {
+	var info types.CW20TokenInfoResponse
+	err := k.queryCW20(ctx, contractAddress, types.CW20QueryMsg{TokenInfo: &struct{}{}}, &info)
+
+	return info, err
+}
+
+// GetCW20Balance returns the CW20 balance of an address
+func (k Keeper) GetCW20Balance(ctx context.Context, contractAddress string, address sdk.AccAddress) (math.Uint, error) {
+	var balance types.CW20BalanceResponse
+	err := k.queryCW20(ctx, contractAddress, types.CW20QueryMsg{Balance: &types.CW20BalanceQuery{Address: address.String()}}, &balance)
+	if err != nil {
+		return math.ZeroUint(), err
+	}
+
+	if balance.Balance.IsNil() {
+		return math.ZeroUint(), nil
+	}
+
+	return balance.Balance, nil
+}
+
+// transferCW20 transfers CW20 tokens of the caller to the recipient, executing the contract as the caller
+func (k Keeper) transferCW20(
+	ctx context.Context,
+	contractAddress string,
+	caller sdk.AccAddress,
+	recipient sdk.AccAddress,
+	amount math.Uint,
+) error {
+	if k.wasm.contractOpsKeeper == nil {
+		return errorsmod.Wrap(types.ErrInvalidCW20, "cw20 contracts can not be executed")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidContractAddress, "(%s) : %s", contractAddress, err)
+	}
+
+	msg, err := json.Marshal(types.CW20ExecuteMsg{
+		Transfer: &types.CW20Transfer{
+			Recipient: recipient.String(),
+			Amount:    amount,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.wasm.contractOpsKeeper.Execute(sdk.UnwrapSDKContext(ctx), contractAddr, caller, msg, nil); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidCW20, "transfer of contract (%s) : %s", contractAddress, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+
+	"zigchain/x/factory/types"
+)
+
+// CW20BridgeIndexes are the secondary indexes of the CW20 bridges collection
+type CW20BridgeIndexes struct {
+	// Denom indexes the contract of each bridged denom
+	Denom *indexes.Unique[string, string, types.CW20Bridge]
+}
+
+// IndexesList returns the indexes maintained by the CW20 bridges collection
+func (i CW20BridgeIndexes) IndexesList() []collections.Index[string, types.CW20Bridge] {
+	return []collections.Index[string, types.CW20Bridge]{i.Denom}
+}
+
+// NewCW20BridgeIndexes creates the secondary indexes of the CW20 bridges collection
+func NewCW20BridgeIndexes(sb *collections.SchemaBuilder) CW20BridgeIndexes {
+	return CW20BridgeIndexes{
+		Denom: indexes.NewUnique(
+			sb,
+			types.CW20BridgesByDenomPrefix,
+			"cw20_bridges_by_denom",
+			collections.StringKey,
+			collections.StringKey,
+			func(_ string, bridge types.CW20Bridge) (string, error) {
+				return bridge.Denom, nil
+			},
+		),
+	}
+}
@@ -0,0 +1,286 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	keepertest "zigchain/testutil/keeper"
+	"zigchain/testutil/sample"
+	"zigchain/x/factory/keeper"
+	"zigchain/x/factory/testutil"
+	"zigchain/x/factory/types"
+)
+
+// fakeCW20 is a CW20 contract holding its balances in memory
+type fakeCW20 struct {
+	contract string
+	balances map[string]cosmosmath.Uint
+	// fee is taken from the amount of every transfer
+	fee cosmosmath.Uint
+}
+
+func (f *fakeCW20) Execute(_ sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, _ sdk.Coins) ([]byte, error) {
+	if contractAddress.String() != f.contract {
+		return nil, errors.New("no such contract")
+	}
+
+	var executeMsg types.CW20ExecuteMsg
+	if err := json.Unmarshal(msg, &executeMsg); err != nil {
+		return nil, err
+	}
+
+	balance, found := f.balances[caller.String()]
+	if !found || balance.LT(executeMsg.Transfer.Amount) {
+		return nil, errors.New("insufficient funds")
+	}
+
+	received := executeMsg.Transfer.Amount
+	if !f.fee.IsNil() {
+		received = received.Sub(f.fee)
+	}
+
+	f.balances[caller.String()] = balance.Sub(executeMsg.Transfer.Amount)
+	f.balances[executeMsg.Transfer.Recipient] = f.balance(executeMsg.Transfer.Recipient).Add(received)
+
+	return nil, nil
+}
+
+func (f *fakeCW20) QuerySmart(_ context.Context, contractAddress sdk.AccAddress, req []byte) ([]byte, error) {
+	if contractAddress.String() != f.contract {
+		return nil, errors.New("no such contract")
+	}
+
+	var queryMsg types.CW20QueryMsg
+	if err := json.Unmarshal(req, &queryMsg); err != nil {
+		return nil, err
+	}
+
+	if queryMsg.Balance != nil {
+		return json.Marshal(types.CW20BalanceResponse{Balance: f.balance(queryMsg.Balance.Address)})
+	}
+
+	return json.Marshal(types.CW20TokenInfoResponse{Name: "Token", Symbol: "TKN", Decimals: 6, TotalSupply: cosmosmath.NewUint(1000)})
+}
+
+func (f *fakeCW20) balance(address string) cosmosmath.Uint {
+	if balance, found := f.balances[address]; found {
+		return balance
+	}
+	return cosmosmath.ZeroUint()
+}
+
+func setupCW20BridgeTest(t *testing.T) (keeper.Keeper, sdk.Context, *testutil.MockBankKeeper, *fakeCW20, types.CW20Bridge) {
+	ctrl := gomock.NewController(t)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+
+	factoryKeeper, ctx := keepertest.FactoryKeeper(t, bankKeeper, accountKeeper)
+
+	accountKeeper.EXPECT().
+		GetModuleAddress(types.CW20EscrowName).
+		Return(authtypes.NewModuleAddress(types.CW20EscrowName)).
+		AnyTimes()
+
+	cw20 := &fakeCW20{contract: sample.AccAddress(), balances: make(map[string]cosmosmath.Uint)}
+	factoryKeeper.SetCW20Keepers(cw20, cw20)
+
+	var metadata banktypes.Metadata
+	bankKeeper.EXPECT().
+		SetDenomMetaData(gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, m banktypes.Metadata) { metadata = m }).
+		Times(1)
+
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+	resp, err := server.RegisterCW20Bridge(ctx, types.NewMsgRegisterCW20Bridge(factoryKeeper.GetAuthority(), cw20.contract, "tkn"))
+	require.NoError(t, err)
+	require.Equal(t, "TKN", metadata.Display)
+	require.Equal(t, uint32(6), metadata.DenomUnits[1].Exponent)
+
+	return factoryKeeper, ctx, bankKeeper, cw20, resp.Bridge
+}
+
+// Positive test cases
+
+func TestMsgServer_RegisterCW20Bridge(t *testing.T) {
+	// Test case: governance registers a CW20 contract, the module creates its denom without bank admin
+
+	factoryKeeper, ctx, _, cw20, bridge := setupCW20BridgeTest(t)
+
+	require.Equal(t, types.CW20Bridge{
+		ContractAddress: cw20.contract,
+		Denom:           "coin." + types.CW20BridgeCreator() + ".tkn",
+		Escrowed:        cosmosmath.ZeroUint(),
+	}, bridge)
+
+	denom, found := factoryKeeper.GetDenom(ctx, bridge.Denom)
+	require.True(t, found)
+	require.Equal(t, types.CW20MaxSupply, denom.MintingCap)
+	require.False(t, denom.CanChangeMintingCap)
+
+	denomAuth, found := factoryKeeper.GetDenomAuth(ctx, bridge.Denom)
+	require.True(t, found)
+	require.Empty(t, denomAuth.BankAdmin)
+
+	byDenom, err := factoryKeeper.CW20BridgeByDenom(ctx, &types.QueryCW20BridgeByDenomRequest{Denom: bridge.Denom})
+	require.NoError(t, err)
+	require.Equal(t, bridge, byDenom.Bridge)
+
+	all, err := factoryKeeper.CW20Bridges(ctx, &types.QueryCW20BridgesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.CW20Bridge{bridge}, all.Bridges)
+}
+
+func TestMsgServer_ConvertCW20RoundTrip(t *testing.T) {
+	// Test case: CW20 tokens are locked for the denom and released when it is converted back,
+	// the minted amount stays a lifetime total and the invariant holds all along
+
+	factoryKeeper, ctx, bankKeeper, cw20, bridge := setupCW20BridgeTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+	invariant := keeper.CW20EscrowCoversMintedSupplyInvariant(factoryKeeper)
+
+	holder := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	cw20.balances[holder.String()] = cosmosmath.NewUint(1000)
+
+	supply := cosmosmath.ZeroInt()
+	bankKeeper.EXPECT().
+		GetSupply(gomock.Any(), bridge.Denom).
+		DoAndReturn(func(_ context.Context, denom string) sdk.Coin { return sdk.NewCoin(denom, supply) }).
+		AnyTimes()
+
+	coins := sdk.NewCoins(sdk.NewCoin(bridge.Denom, cosmosmath.NewInt(600)))
+	bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, coins).Return(nil).Times(1)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, holder, coins).Return(nil).Times(1)
+
+	resp, err := server.ConvertCW20ToCoin(ctx, types.NewMsgConvertCW20ToCoin(holder.String(), cw20.contract, cosmosmath.NewUint(600)))
+	require.NoError(t, err)
+	require.Equal(t, coins[0], resp.Coin)
+	supply = supply.AddRaw(600)
+
+	escrow := authtypes.NewModuleAddress(types.CW20EscrowName).String()
+	require.Equal(t, cosmosmath.NewUint(400), cw20.balances[holder.String()])
+	require.Equal(t, cosmosmath.NewUint(600), cw20.balances[escrow])
+
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+
+	back := sdk.NewCoin(bridge.Denom, cosmosmath.NewInt(250))
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), holder, types.ModuleName, sdk.NewCoins(back)).Return(nil).Times(1)
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(back)).Return(nil).Times(1)
+
+	backResp, err := server.ConvertCoinToCW20(ctx, types.NewMsgConvertCoinToCW20(holder.String(), back))
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgConvertCoinToCW20Response{ContractAddress: cw20.contract, Amount: cosmosmath.NewUint(250)}, backResp)
+	supply = supply.SubRaw(250)
+
+	require.Equal(t, cosmosmath.NewUint(650), cw20.balances[holder.String()])
+	require.Equal(t, cosmosmath.NewUint(350), cw20.balances[escrow])
+
+	bridge, found := factoryKeeper.GetCW20Bridge(ctx, cw20.contract)
+	require.True(t, found)
+	require.Equal(t, cosmosmath.NewUint(350), bridge.Escrowed)
+
+	denom, found := factoryKeeper.GetDenom(ctx, bridge.Denom)
+	require.True(t, found)
+	require.Equal(t, cosmosmath.NewUint(600), denom.Minted)
+
+	msg, broken = invariant(ctx)
+	require.False(t, broken, msg)
+
+	// a bank supply above the escrowed amount breaks the invariant
+	supply = supply.AddRaw(1)
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+	supply = supply.SubRaw(1)
+
+	// an escrowed amount above the minted amount breaks the invariant
+	bridge.Escrowed = cosmosmath.NewUint(601)
+	factoryKeeper.SetCW20Bridge(ctx, bridge)
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+}
+
+// Negative test cases
+
+func TestMsgServer_RegisterCW20Bridge_Invalid(t *testing.T) {
+	// Test case: only the authority registers a CW20 contract once, and it must answer the token info
+
+	factoryKeeper, ctx, _, cw20, _ := setupCW20BridgeTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	_, err := server.RegisterCW20Bridge(ctx, types.NewMsgRegisterCW20Bridge(sample.AccAddress(), cw20.contract, "other"))
+	require.ErrorIs(t, err, types.ErrInvalidSignerAccount)
+
+	_, err = server.RegisterCW20Bridge(ctx, types.NewMsgRegisterCW20Bridge(factoryKeeper.GetAuthority(), cw20.contract, "other"))
+	require.ErrorIs(t, err, types.ErrCW20BridgeExists)
+
+	_, err = server.RegisterCW20Bridge(ctx, types.NewMsgRegisterCW20Bridge(factoryKeeper.GetAuthority(), sample.AccAddress(), "other"))
+	require.ErrorIs(t, err, types.ErrInvalidCW20)
+}
+
+func TestMsgServer_ConvertCW20_Invalid(t *testing.T) {
+	// Test case: conversions of unknown contracts or denoms, or above the balances, fail
+
+	factoryKeeper, ctx, _, cw20, bridge := setupCW20BridgeTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+	holder := sample.AccAddress()
+
+	_, err := server.ConvertCW20ToCoin(ctx, types.NewMsgConvertCW20ToCoin(holder, sample.AccAddress(), cosmosmath.NewUint(1)))
+	require.ErrorIs(t, err, types.ErrCW20BridgeNotFound)
+
+	_, err = server.ConvertCW20ToCoin(ctx, types.NewMsgConvertCW20ToCoin(holder, cw20.contract, cosmosmath.NewUint(1)))
+	require.ErrorIs(t, err, types.ErrInvalidCW20)
+
+	_, err = server.ConvertCoinToCW20(ctx, types.NewMsgConvertCoinToCW20(holder, sdk.NewCoin("coin."+holder+".abc", cosmosmath.NewInt(1))))
+	require.ErrorIs(t, err, types.ErrCW20BridgeNotFound)
+
+	_, err = server.ConvertCoinToCW20(ctx, types.NewMsgConvertCoinToCW20(holder, sdk.NewCoin(bridge.Denom, cosmosmath.NewInt(1))))
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+}
+
+func TestMsgServer_ConvertCW20ToCoin_TransferFee(t *testing.T) {
+	// Test case: a contract moving less than the converted amount to the escrow mints nothing
+
+	factoryKeeper, ctx, _, cw20, bridge := setupCW20BridgeTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	holder := sample.AccAddress()
+	cw20.balances[holder] = cosmosmath.NewUint(1000)
+	cw20.fee = cosmosmath.NewUint(1)
+
+	_, err := server.ConvertCW20ToCoin(ctx, types.NewMsgConvertCW20ToCoin(holder, cw20.contract, cosmosmath.NewUint(100)))
+	require.ErrorIs(t, err, types.ErrInvalidCW20)
+	require.Contains(t, err.Error(), "escrow balance")
+
+	denom, found := factoryKeeper.GetDenom(ctx, bridge.Denom)
+	require.True(t, found)
+	require.True(t, denom.Minted.IsZero())
+}
+
+func TestMsgServer_ConvertCW20ToCoin_MintingCap(t *testing.T) {
+	// Test case: a conversion over the minting cap of the bridged denom fails before the tokens are locked
+
+	factoryKeeper, ctx, _, cw20, bridge := setupCW20BridgeTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	denom, found := factoryKeeper.GetDenom(ctx, bridge.Denom)
+	require.True(t, found)
+	denom.Minted = denom.MintingCap
+	factoryKeeper.SetDenom(ctx, denom)
+
+	holder := sample.AccAddress()
+	cw20.balances[holder] = cosmosmath.NewUint(1000)
+
+	_, err := server.ConvertCW20ToCoin(ctx, types.NewMsgConvertCW20ToCoin(holder, cw20.contract, cosmosmath.NewUint(1)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Equal(t, cosmosmath.NewUint(1000), cw20.balances[holder])
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"zigchain/testutil/sample"
+	"zigchain/x/factory/keeper"
+	"zigchain/x/factory/types"
+)
+
+// Positive test cases
+
+func TestMsgServer_DenomAdminTimelock_Positive(t *testing.T) {
+	// Test case: a proposal made under a timelock can only be claimed once the delay has passed,
+	// and the queries list it until it is claimed
+
+	factoryKeeper, ctx, _, denom, denomAuth := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+
+	_, err := server.SetDenomAdminTimelock(ctx, types.NewMsgSetDenomAdminTimelock(denomAuth.BankAdmin, denom.Denom, 3600))
+	require.NoError(t, err)
+
+	newAdmin := sample.AccAddress()
+	proposeMsg := types.NewMsgProposeDenomAdmin(denomAuth.BankAdmin, denom.Denom, newAdmin, newAdmin)
+	proposeMsg.Expiry = 600
+	resp, err := server.ProposeDenomAdmin(ctx, proposeMsg)
+	require.NoError(t, err)
+	require.Equal(t, int64(1_003_600), resp.ClaimableTime)
+	require.Equal(t, int64(1_004_200), resp.ExpiryTime)
+
+	proposals, err := factoryKeeper.DenomAdminProposals(ctx, &types.QueryDenomAdminProposalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.DenomAdminProposal{{
+		Denom:         denom.Denom,
+		BankAdmin:     newAdmin,
+		MetadataAdmin: newAdmin,
+		ClaimableTime: 1_003_600,
+		ExpiryTime:    1_004_200,
+	}}, proposals.Proposals)
+
+	_, err = server.ClaimDenomAdmin(ctx, &types.MsgClaimDenomAdmin{Signer: newAdmin, Denom: denom.Denom})
+	require.ErrorIs(t, err, types.ErrAdminProposalTimelocked)
+
+	ctx = ctx.WithBlockTime(time.Unix(1_003_600, 0))
+	_, err = server.ClaimDenomAdmin(ctx, &types.MsgClaimDenomAdmin{Signer: newAdmin, Denom: denom.Denom})
+	require.NoError(t, err)
+
+	got, found := factoryKeeper.GetDenomAuth(ctx, denom.Denom)
+	require.True(t, found)
+	require.Equal(t, newAdmin, got.BankAdmin)
+
+	_, err = factoryKeeper.DenomAdminProposal(ctx, &types.QueryDenomAdminProposalRequest{Denom: denom.Denom})
+	require.Error(t, err)
+}
+
+func TestMsgServer_SetDenomAdminTimelock_Shorter(t *testing.T) {
+	// Test case: a shorter delay only takes effect once the current delay has passed,
+	// a longer delay takes effect right away
+
+	factoryKeeper, ctx, _, denom, denomAuth := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+
+	_, err := server.SetDenomAdminTimelock(ctx, types.NewMsgSetDenomAdminTimelock(denomAuth.BankAdmin, denom.Denom, 3600))
+	require.NoError(t, err)
+
+	resp, err := server.SetDenomAdminTimelock(ctx, types.NewMsgSetDenomAdminTimelock(denomAuth.BankAdmin, denom.Denom, 0))
+	require.NoError(t, err)
+	require.Equal(t, types.DenomAdminTimelock{
+		Denom:        denom.Denom,
+		Delay:        3600,
+		PendingDelay: 0,
+		PendingTime:  1_003_600,
+	}, resp.Timelock)
+
+	query, err := factoryKeeper.DenomAdminTimelock(ctx, &types.QueryDenomAdminTimelockRequest{Denom: denom.Denom})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3600), query.Timelock.Delay)
+
+	ctx = ctx.WithBlockTime(time.Unix(1_003_600, 0))
+	query, err = factoryKeeper.DenomAdminTimelock(ctx, &types.QueryDenomAdminTimelockRequest{Denom: denom.Denom})
+	require.NoError(t, err)
+	require.Equal(t, types.DenomAdminTimelock{Denom: denom.Denom}, query.Timelock)
+
+	resp, err = server.SetDenomAdminTimelock(ctx, types.NewMsgSetDenomAdminTimelock(denomAuth.BankAdmin, denom.Denom, 7200))
+	require.NoError(t, err)
+	require.Equal(t, types.DenomAdminTimelock{Denom: denom.Denom, Delay: 7200}, resp.Timelock)
+}
+
+func TestMsgServer_CancelDenomAdminProposal(t *testing.T) {
+	// Test case: the admin cancels a pending proposal, which can not be claimed anymore
+
+	factoryKeeper, ctx, _, denom, denomAuth := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	newAdmin := sample.AccAddress()
+	_, err := server.ProposeDenomAdmin(ctx, types.NewMsgProposeDenomAdmin(denomAuth.BankAdmin, denom.Denom, newAdmin, newAdmin))
+	require.NoError(t, err)
+
+	resp, err := server.CancelDenomAdminProposal(ctx, types.NewMsgCancelDenomAdminProposal(denomAuth.BankAdmin, denom.Denom))
+	require.NoError(t, err)
+	require.Equal(t, denom.Denom, resp.Denom)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventAdminProposalCancelled, events[len(events)-1].Type)
+
+	_, found := factoryKeeper.GetProposedDenomAuth(ctx, denom.Denom)
+	require.False(t, found)
+
+	_, err = server.ClaimDenomAdmin(ctx, &types.MsgClaimDenomAdmin{Signer: newAdmin, Denom: denom.Denom})
+	require.ErrorIs(t, err, types.ErrNoAdminProposal)
+}
+
+// Negative test cases
+
+func TestMsgServer_DenomAdminProposal_Invalid(t *testing.T) {
+	// Test case: an expired proposal can not be claimed, only the admin cancels a proposal
+	// or sets the timelock, and a missing proposal can not be cancelled
+
+	factoryKeeper, ctx, _, denom, denomAuth := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+
+	_, err := server.CancelDenomAdminProposal(ctx, types.NewMsgCancelDenomAdminProposal(denomAuth.BankAdmin, denom.Denom))
+	require.ErrorIs(t, err, types.ErrNoAdminProposal)
+
+	newAdmin := sample.AccAddress()
+	proposeMsg := types.NewMsgProposeDenomAdmin(denomAuth.BankAdmin, denom.Denom, newAdmin, newAdmin)
+	proposeMsg.Expiry = 600
+	_, err = server.ProposeDenomAdmin(ctx, proposeMsg)
+	require.NoError(t, err)
+
+	_, err = server.CancelDenomAdminProposal(ctx, types.NewMsgCancelDenomAdminProposal(newAdmin, denom.Denom))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = server.SetDenomAdminTimelock(ctx, types.NewMsgSetDenomAdminTimelock(newAdmin, denom.Denom, 3600))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	ctx = ctx.WithBlockTime(time.Unix(1_000_600, 0))
+	_, err = server.ClaimDenomAdmin(ctx, &types.MsgClaimDenomAdmin{Signer: newAdmin, Denom: denom.Denom})
+	require.ErrorIs(t, err, types.ErrAdminProposalExpired)
+
+	got, found := factoryKeeper.GetDenomAuth(ctx, denom.Denom)
+	require.True(t, found)
+	require.Equal(t, denomAuth.BankAdmin, got.BankAdmin)
+}
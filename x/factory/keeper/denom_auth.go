@@ -5,18 +5,23 @@ import (
 	"zigchain/x/factory/types"
 
 	errorsmod "cosmossdk.io/errors"
-	"cosmossdk.io/store/prefix"
-	storetypes "cosmossdk.io/store/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
 )
 
-// SetDenomAuth set a specific denomAuth in the store from its index
+// SetDenomAuth set a specific denomAuth in the store from its index,
+// the metadata admin holds the metadata editor role and passes it on to the next metadata admin
 func (k Keeper) SetDenomAuth(ctx context.Context, denomAuth types.DenomAuth) {
-	// Store the original denom auth
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	denomStore := prefix.NewStore(store, types.KeyPrefix(types.DenomAuthKeyPrefix))
-	bz := k.cdc.MustMarshal(&denomAuth)
-	denomStore.Set(types.DenomAuthKey(denomAuth.Denom), bz)
+	previous, found := k.GetDenomAuth(ctx, denomAuth.Denom)
+	if found && previous.MetadataAdmin != "" && previous.MetadataAdmin != denomAuth.MetadataAdmin {
+		k.RemoveDenomRole(ctx, denomAuth.Denom, types.RoleMetadataEditor, previous.MetadataAdmin)
+	}
+	if denomAuth.MetadataAdmin != "" {
+		k.SetDenomRole(ctx, denomAuth.Denom, types.RoleMetadataEditor, denomAuth.MetadataAdmin)
+	}
+
+	// Setting through the collection keeps the admin index up to date
+	if err := k.DenomAuths.Set(ctx, denomAuth.Denom, denomAuth); err != nil {
+		panic(err)
+	}
 }
 
 // GetDenomAuth returns a denomAuth from its index
@@ -24,18 +29,11 @@ func (k Keeper) GetDenomAuth(
 	ctx context.Context,
 	denom string,
 ) (val types.DenomAuth, found bool) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DenomAuthKeyPrefix))
-
-	b := store.Get(types.DenomAuthKey(
-		denom,
-	))
-	if b == nil {
+	val, err := k.DenomAuths.Get(ctx, denom)
+	if err != nil {
 		return val, false
 	}
 
-	k.cdc.MustUnmarshal(b, &val)
-
 	if val.Denom == "" {
 		return val, false
 	}
@@ -43,62 +41,92 @@ func (k Keeper) GetDenomAuth(
 }
 
 // SetProposedDenomAuth sets the proposed denom auth
-func (k Keeper) SetProposedDenomAuth(ctx context.Context, denomAuth types.DenomAuth) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProposedDenomAuthKeyPrefix))
-	b := k.cdc.MustMarshal(&denomAuth)
-	store.Set(types.DenomAuthKey(
-		denomAuth.Denom,
-	), b)
+func (k Keeper) SetProposedDenomAuth(ctx context.Context, proposal types.DenomAdminProposal) {
+	if err := k.ProposedDenomAuths.Set(ctx, proposal.Denom, proposal); err != nil {
+		panic(err)
+	}
 }
 
 // DeleteProposedDenomAuth deletes the proposed denom auth
 func (k Keeper) DeleteProposedDenomAuth(ctx context.Context, denom string) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProposedDenomAuthKeyPrefix))
-	store.Delete(types.DenomAuthKey(denom))
+	if err := k.ProposedDenomAuths.Remove(ctx, denom); err != nil {
+		panic(err)
+	}
 }
 
 // GetProposedDenomAuth returns the proposed denom auth
 func (k Keeper) GetProposedDenomAuth(
 	ctx context.Context,
 	denom string,
-) (val types.DenomAuth, found bool) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProposedDenomAuthKeyPrefix))
+) (val types.DenomAdminProposal, found bool) {
+	val, err := k.ProposedDenomAuths.Get(ctx, denom)
+	if err != nil {
+		return val, false
+	}
 
-	b := store.Get(types.DenomAuthKey(
-		denom,
-	))
-	if b == nil {
+	if val.Denom == "" {
 		return val, false
 	}
+	return val, true
+}
 
-	k.cdc.MustUnmarshal(b, &val)
+// GetAllProposedDenomAuth returns all the pending denomAdminProposal
+func (k Keeper) GetAllProposedDenomAuth(ctx context.Context) (list []types.DenomAdminProposal) {
+	iterator, err := k.ProposedDenomAuths.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
 
-	if val.Denom == "" {
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// SetDenomAdminTimelock set the admin timelock of a denom
+func (k Keeper) SetDenomAdminTimelock(ctx context.Context, timelock types.DenomAdminTimelock) {
+	if err := k.AdminTimelocks.Set(ctx, timelock.Denom, timelock); err != nil {
+		panic(err)
+	}
+}
+
+// GetDenomAdminTimelock returns the admin timelock of a denom
+func (k Keeper) GetDenomAdminTimelock(ctx context.Context, denom string) (val types.DenomAdminTimelock, found bool) {
+	val, err := k.AdminTimelocks.Get(ctx, denom)
+	if err != nil {
 		return val, false
 	}
+
 	return val, true
 }
 
+// GetAllDenomAdminTimelock returns all denomAdminTimelock
+func (k Keeper) GetAllDenomAdminTimelock(ctx context.Context) (list []types.DenomAdminTimelock) {
+	iterator, err := k.AdminTimelocks.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
 // GetAllDenomAuth returns all denomAuth
 func (k Keeper) GetAllDenomAuth(ctx context.Context) (list []types.DenomAuth) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DenomAuthKeyPrefix))
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
-
-	defer func(iterator storetypes.Iterator) {
-		err := iterator.Close()
-		if err != nil {
-			k.Logger().Error("Error closing iterator", "error", err)
-		}
-	}(iterator)
+	iterator, err := k.DenomAuths.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
 
-	for ; iterator.Valid(); iterator.Next() {
-		var val types.DenomAuth
-		k.cdc.MustUnmarshal(iterator.Value(), &val)
-		list = append(list, val)
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
 	}
 
 	return
@@ -126,46 +154,3 @@ func (k Keeper) DisableDenomAuth(ctx context.Context, denom string) error {
 
 	return nil
 }
-
-// AddDenomToAdminDenomAuthList adds a denom to an admin's index
-func (k Keeper) AddDenomToAdminDenomAuthList(ctx context.Context, admin string, denom string) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.AdminDenomAuthListKey(admin))
-
-	store.Set(types.DenomAuthNameKey(denom), []byte{})
-}
-
-// RemoveDenomFromAdminDenomAuthList removes a denom from an admin's index
-func (k Keeper) RemoveDenomFromAdminDenomAuthList(ctx context.Context, admin string, denom string) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.AdminDenomAuthListKey(admin))
-
-	store.Delete(types.DenomAuthNameKey(denom))
-}
-
-// MigrateAdminDenomAuthList creates the admin index for existing denom auth entries
-func (k Keeper) MigrateAdminDenomAuthList(ctx context.Context) error {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DenomAuthKeyPrefix))
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
-
-	defer func(iterator storetypes.Iterator) {
-		err := iterator.Close()
-		if err != nil {
-			k.Logger().Error("Error closing iterator", "error", err)
-		}
-	}(iterator)
-
-	for ; iterator.Valid(); iterator.Next() {
-		var denomAuth types.DenomAuth
-		if err := k.cdc.Unmarshal(iterator.Value(), &denomAuth); err != nil {
-			return err
-		}
-
-		// Add both admins to admin index
-		k.AddDenomToAdminDenomAuthList(ctx, denomAuth.BankAdmin, denomAuth.Denom)
-		k.AddDenomToAdminDenomAuthList(ctx, denomAuth.MetadataAdmin, denomAuth.Denom)
-	}
-
-	return nil
-}
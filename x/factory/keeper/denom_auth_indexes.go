@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+
+	"zigchain/x/factory/types"
+	zindexes "zigchain/zutils/indexes"
+)
+
+// DenomAuthIndexes are the secondary indexes of the denom auths collection
+type DenomAuthIndexes struct {
+	// Admin indexes the denoms by both their bank and metadata admins
+	Admin *zindexes.MultiValue[string, string, types.DenomAuth]
+}
+
+// IndexesList returns the indexes maintained by the denom auths collection
+func (i DenomAuthIndexes) IndexesList() []collections.Index[string, types.DenomAuth] {
+	return []collections.Index[string, types.DenomAuth]{i.Admin}
+}
+
+// NewDenomAuthIndexes creates the secondary indexes of the denom auths collection
+func NewDenomAuthIndexes(sb *collections.SchemaBuilder) DenomAuthIndexes {
+	return DenomAuthIndexes{
+		Admin: zindexes.NewMultiValue(
+			sb,
+			types.DenomAuthsByAdminPrefix,
+			"denom_auths_by_admin",
+			collections.StringKey,
+			collections.StringKey,
+			func(_ string, denomAuth types.DenomAuth) ([]string, error) {
+				admins := make([]string, 0, 2)
+				for _, admin := range []string{denomAuth.BankAdmin, denomAuth.MetadataAdmin} {
+					// a disabled admin role is not indexed
+					if admin != "" {
+						admins = append(admins, admin)
+					}
+				}
+				return admins, nil
+			},
+		),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	errorsmod "cosmossdk.io/errors"
+	cosmosmath "cosmossdk.io/math"
 
 	keepertest "zigchain/testutil/keeper"
 	"zigchain/testutil/nullify"
@@ -59,212 +60,66 @@ func TestDenomAuthGetAll(t *testing.T) {
 	)
 }
 
-func TestMigrateAdminDenomAuthList(t *testing.T) {
-	// Test cases for MigrateAdminDenomAuthList
-
-	t.Run("successful migration with multiple denom auth entries", func(t *testing.T) {
-		factoryKeeper, ctx := keepertest.FactoryKeeper(t, nil, nil)
-
-		// Create test denom auth entries
-		denomAuths := []types.DenomAuth{
-			{
-				Denom:         "denom1",
-				BankAdmin:     "bankadmin1",
-				MetadataAdmin: "metadataadmin1",
-			},
-			{
-				Denom:         "denom2",
-				BankAdmin:     "bankadmin2",
-				MetadataAdmin: "metadataadmin2",
-			},
-			{
-				Denom:         "denom3",
-				BankAdmin:     "bankadmin3",
-				MetadataAdmin: "metadataadmin3",
-			},
-		}
-
-		// Store denom auths directly
-		for _, da := range denomAuths {
-			factoryKeeper.SetDenomAuth(ctx, da)
-		}
-
-		// Execute migration
-		err := factoryKeeper.MigrateAdminDenomAuthList(ctx)
-		require.NoError(t, err)
-
-		// Verify that both admins for each denom are added to their respective admin lists
-		for _, da := range denomAuths {
-			// Verify the original denom auth data is still intact
-			stored, found := factoryKeeper.GetDenomAuth(ctx, da.Denom)
-			require.True(t, found)
-			require.Equal(t, da.Denom, stored.Denom)
-			require.Equal(t, da.BankAdmin, stored.BankAdmin)
-			require.Equal(t, da.MetadataAdmin, stored.MetadataAdmin)
-
-			// Test that we can remove from the admin list (proving it was added)
-			factoryKeeper.RemoveDenomFromAdminDenomAuthList(ctx, da.BankAdmin, da.Denom)
-			factoryKeeper.RemoveDenomFromAdminDenomAuthList(ctx, da.MetadataAdmin, da.Denom)
-		}
-	})
-
-	t.Run("successful migration with empty denom auth list", func(t *testing.T) {
-		factoryKeeper, ctx := keepertest.FactoryKeeper(t, nil, nil)
-
-		// No denom auths stored
-
-		// Execute migration - should not error with empty list
-		err := factoryKeeper.MigrateAdminDenomAuthList(ctx)
-		require.NoError(t, err)
+func TestDenomAuthAdminIndex(t *testing.T) {
+	// Test case: the admin index follows the bank and metadata admins of a denomAuth
 
-		// Verify GetAllDenomAuth returns empty
-		allDenomAuths := factoryKeeper.GetAllDenomAuth(ctx)
-		require.Empty(t, allDenomAuths)
-	})
-
-	t.Run("migration with denom auth having same admin for both roles", func(t *testing.T) {
-		factoryKeeper, ctx := keepertest.FactoryKeeper(t, nil, nil)
-
-		// Create denom auth with the same admin for both roles
-		denomAuth := types.DenomAuth{
-			Denom:         "sharedadmin",
-			BankAdmin:     "sharedadmin",
-			MetadataAdmin: "sharedadmin",
-		}
-
-		factoryKeeper.SetDenomAuth(ctx, denomAuth)
+	factoryKeeper, ctx := keepertest.FactoryKeeper(t, nil, nil)
 
-		// Execute migration
-		err := factoryKeeper.MigrateAdminDenomAuthList(ctx)
+	denomsByAdmin := func(admin string) []string {
+		resp, err := factoryKeeper.DenomsByAdmin(ctx, &types.QueryDenomByAdminRequest{Admin: admin})
 		require.NoError(t, err)
+		return resp.Denoms
+	}
 
-		// Verify the denom auth data is intact
-		stored, found := factoryKeeper.GetDenomAuth(ctx, "sharedadmin")
-		require.True(t, found)
-		require.Equal(t, denomAuth, stored)
-
-		// Test removal works (proving it was added to the admin list)
-		factoryKeeper.RemoveDenomFromAdminDenomAuthList(ctx, "sharedadmin", "sharedadmin")
+	factoryKeeper.SetDenomAuth(ctx, types.DenomAuth{
+		Denom:         "denom1",
+		BankAdmin:     "admin1",
+		MetadataAdmin: "admin2",
 	})
-
-	t.Run("migration with empty admin fields", func(t *testing.T) {
-		factoryKeeper, ctx := keepertest.FactoryKeeper(t, nil, nil)
-
-		// Create denom auth with empty admin fields
-		denomAuths := []types.DenomAuth{
-			{
-				Denom:         "denom1",
-				BankAdmin:     "", // Empty bank admin
-				MetadataAdmin: "metadataadmin1",
-			},
-			{
-				Denom:         "denom2",
-				BankAdmin:     "bankadmin2",
-				MetadataAdmin: "", // Empty metadata admin
-			},
-		}
-
-		for _, da := range denomAuths {
-			factoryKeeper.SetDenomAuth(ctx, da)
-		}
-
-		// Execute migration - should not panic with empty admin fields
-		err := factoryKeeper.MigrateAdminDenomAuthList(ctx)
-		require.NoError(t, err)
-
-		// Verify data is intact
-		for _, da := range denomAuths {
-			stored, found := factoryKeeper.GetDenomAuth(ctx, da.Denom)
-			require.True(t, found)
-			require.Equal(t, da, stored)
-
-			// Test removal for non-empty admins
-			if da.BankAdmin != "" {
-				factoryKeeper.RemoveDenomFromAdminDenomAuthList(ctx, da.BankAdmin, da.Denom)
-			}
-			if da.MetadataAdmin != "" {
-				factoryKeeper.RemoveDenomFromAdminDenomAuthList(ctx, da.MetadataAdmin, da.Denom)
-			}
-		}
+	factoryKeeper.SetDenomAuth(ctx, types.DenomAuth{
+		Denom:         "denom2",
+		BankAdmin:     "admin1",
+		MetadataAdmin: "admin1",
 	})
 
-	t.Run("verify migration can be called multiple times safely", func(t *testing.T) {
-		factoryKeeper, ctx := keepertest.FactoryKeeper(t, nil, nil)
-
-		// Create test denom auth
-		denomAuth := types.DenomAuth{
-			Denom:         "testdenom",
-			BankAdmin:     "bankadmin",
-			MetadataAdmin: "metadataadmin",
-		}
-
-		factoryKeeper.SetDenomAuth(ctx, denomAuth)
-
-		// Execute migration first time
-		err := factoryKeeper.MigrateAdminDenomAuthList(ctx)
-		require.NoError(t, err)
-
-		// Execute migration second time
-		err = factoryKeeper.MigrateAdminDenomAuthList(ctx)
-		require.NoError(t, err)
-
-		// Verify data is still correct
-		stored, found := factoryKeeper.GetDenomAuth(ctx, "testdenom")
-		require.True(t, found)
-		require.Equal(t, denomAuth, stored)
+	require.Equal(t, []string{"denom1", "denom2"}, denomsByAdmin("admin1"))
+	require.Equal(t, []string{"denom1"}, denomsByAdmin("admin2"))
 
-		// Clean up
-		factoryKeeper.RemoveDenomFromAdminDenomAuthList(ctx, "bankadmin", "testdenom")
-		factoryKeeper.RemoveDenomFromAdminDenomAuthList(ctx, "metadataadmin", "testdenom")
-	})
-
-	t.Run("migration with special characters in denom and admin", func(t *testing.T) {
-		factoryKeeper, ctx := keepertest.FactoryKeeper(t, nil, nil)
-
-		// Test with various edge cases
-		testCases := []types.DenomAuth{
-			{
-				Denom:         "denom-with-dash",
-				BankAdmin:     "admin-with-dash",
-				MetadataAdmin: "metadata-with-dash",
-			},
-			{
-				Denom:         "denom_with_underscore",
-				BankAdmin:     "admin_with_underscore",
-				MetadataAdmin: "metadata_with_underscore",
-			},
-			{
-				Denom:         "denom.with.dots",
-				BankAdmin:     "admin.with.dots",
-				MetadataAdmin: "metadata.with.dots",
-			},
-		}
-
-		for _, tc := range testCases {
-			factoryKeeper.SetDenomAuth(ctx, tc)
-		}
-
-		// Execute migration
-		err := factoryKeeper.MigrateAdminDenomAuthList(ctx)
-		require.NoError(t, err)
-
-		// Verify all data is intact
-		for _, tc := range testCases {
-			stored, found := factoryKeeper.GetDenomAuth(ctx, tc.Denom)
-			require.True(t, found)
-			require.Equal(t, tc, stored)
-
-			// Test removal works
-			factoryKeeper.RemoveDenomFromAdminDenomAuthList(ctx, tc.BankAdmin, tc.Denom)
-			factoryKeeper.RemoveDenomFromAdminDenomAuthList(ctx, tc.MetadataAdmin, tc.Denom)
-		}
+	// Changing the metadata admin moves the reference
+	factoryKeeper.SetDenomAuth(ctx, types.DenomAuth{
+		Denom:         "denom1",
+		BankAdmin:     "admin1",
+		MetadataAdmin: "admin3",
 	})
+	require.Empty(t, denomsByAdmin("admin2"))
+	require.Equal(t, []string{"denom1"}, denomsByAdmin("admin3"))
+
+	// Disabling the bank admin keeps the metadata admin reference only
+	require.NoError(t, factoryKeeper.DisableDenomAuth(ctx, "denom1"))
+	require.Equal(t, []string{"denom2"}, denomsByAdmin("admin1"))
+	require.Equal(t, []string{"denom1"}, denomsByAdmin("admin3"))
+	require.Empty(t, denomsByAdmin(""))
 }
 
-func TestMigrateAdminDenomAuthListIntegration(t *testing.T) {
+func TestV4Migration(t *testing.T) {
+	// Test case: V4 migration moves the legacy stores to collections and builds the admin index
+
 	factoryKeeper, ctx := keepertest.FactoryKeeper(t, nil, nil)
 
-	// Setup: create multiple denom auths with overlapping admins
+	denoms := []types.Denom{
+		{
+			Creator:    "creator1",
+			Denom:      "denom1",
+			MintingCap: cosmosmath.NewUint(1000),
+			Minted:     cosmosmath.NewUint(10),
+		},
+		{
+			Creator:    "creator2",
+			Denom:      "denom2",
+			MintingCap: cosmosmath.NewUint(2000),
+			Minted:     cosmosmath.NewUint(20),
+		},
+	}
 	denomAuths := []types.DenomAuth{
 		{
 			Denom:         "denom1",
@@ -273,45 +128,56 @@ func TestMigrateAdminDenomAuthListIntegration(t *testing.T) {
 		},
 		{
 			Denom:         "denom2",
-			BankAdmin:     "admin1", // Same bank admin as denom1
-			MetadataAdmin: "admin3",
-		},
-		{
-			Denom:         "denom3",
-			BankAdmin:     "admin4",
-			MetadataAdmin: "admin2", // Same metadata admin as denom1
+			BankAdmin:     "",
+			MetadataAdmin: "admin1",
 		},
 	}
-
-	// Store all denom auths
-	for _, da := range denomAuths {
-		factoryKeeper.SetDenomAuth(ctx, da)
+	proposed := types.DenomAuth{
+		Denom:         "denom1",
+		BankAdmin:     "admin3",
+		MetadataAdmin: "admin3",
 	}
 
-	// Execute migration
-	err := factoryKeeper.MigrateAdminDenomAuthList(ctx)
+	// Write the pre-v4 layout
+	for _, denom := range denoms {
+		bz, err := denom.Marshal()
+		require.NoError(t, err)
+		factoryKeeper.SetLegacyStoreValue(ctx, types.DenomKeyPrefix, types.DenomKey(denom.Denom), bz)
+	}
+	for _, denomAuth := range denomAuths {
+		bz, err := denomAuth.Marshal()
+		require.NoError(t, err)
+		factoryKeeper.SetLegacyStoreValue(ctx, types.DenomAuthKeyPrefix, types.DenomAuthKey(denomAuth.Denom), bz)
+		factoryKeeper.SetLegacyStoreValue(ctx, "", append(types.AdminDenomAuthListKey(denomAuth.MetadataAdmin), types.DenomAuthNameKey(denomAuth.Denom)...), []byte{})
+	}
+	bz, err := proposed.Marshal()
 	require.NoError(t, err)
+	factoryKeeper.SetLegacyStoreValue(ctx, types.ProposedDenomAuthKeyPrefix, types.DenomAuthKey(proposed.Denom), bz)
 
-	// Verify we can retrieve all denom auths after migration
-	for _, da := range denomAuths {
-		stored, found := factoryKeeper.GetDenomAuth(ctx, da.Denom)
-		require.True(t, found, "Should find denom auth for %s", da.Denom)
-		require.Equal(t, da, stored, "Stored denom auth should match original for %s", da.Denom)
-	}
+	require.NoError(t, factoryKeeper.V4Migration(ctx))
 
-	// Verify migration is idempotent by running it again
-	err = factoryKeeper.MigrateAdminDenomAuthList(ctx)
-	require.NoError(t, err)
+	require.ElementsMatch(t, denoms, factoryKeeper.GetAllDenom(ctx))
+	require.ElementsMatch(t, denomAuths, factoryKeeper.GetAllDenomAuth(ctx))
+
+	gotProposed, found := factoryKeeper.GetProposedDenomAuth(ctx, proposed.Denom)
+	require.True(t, found)
+	require.Equal(t, types.DenomAdminProposal{Denom: proposed.Denom, BankAdmin: proposed.BankAdmin, MetadataAdmin: proposed.MetadataAdmin}, gotProposed)
 
-	// Final verification that all data remains correct
-	allDenomAuths := factoryKeeper.GetAllDenomAuth(ctx)
-	require.Len(t, allDenomAuths, 3, "Should have exactly 3 denom auths after migration")
+	resp, err := factoryKeeper.DenomsByAdmin(ctx, &types.QueryDenomByAdminRequest{Admin: "admin1"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"denom1", "denom2"}, resp.Denoms)
 
-	// Clean up admin lists
-	for _, da := range denomAuths {
-		factoryKeeper.RemoveDenomFromAdminDenomAuthList(ctx, da.BankAdmin, da.Denom)
-		factoryKeeper.RemoveDenomFromAdminDenomAuthList(ctx, da.MetadataAdmin, da.Denom)
+	// The legacy entries are gone
+	for _, denomAuth := range denomAuths {
+		require.Nil(t, factoryKeeper.GetLegacyStoreValue(ctx, types.DenomKeyPrefix, types.DenomKey(denomAuth.Denom)))
+		require.Nil(t, factoryKeeper.GetLegacyStoreValue(ctx, types.DenomAuthKeyPrefix, types.DenomAuthKey(denomAuth.Denom)))
+		require.Nil(t, factoryKeeper.GetLegacyStoreValue(ctx, "", append(types.AdminDenomAuthListKey(denomAuth.MetadataAdmin), types.DenomAuthNameKey(denomAuth.Denom)...)))
 	}
+	require.Nil(t, factoryKeeper.GetLegacyStoreValue(ctx, types.ProposedDenomAuthKeyPrefix, types.DenomAuthKey(proposed.Denom)))
+
+	// Running it again is a no-op
+	require.NoError(t, factoryKeeper.V4Migration(ctx))
+	require.ElementsMatch(t, denomAuths, factoryKeeper.GetAllDenomAuth(ctx))
 }
 
 // Negative test cases
@@ -379,8 +245,8 @@ func TestProposedDenomAuthGetEmptyDenom(t *testing.T) {
 
 	factoryKeeper, ctx := keepertest.FactoryKeeper(t, nil, nil)
 
-	// Create a DenomAuth with an empty Denom
-	denomAuth := types.DenomAuth{
+	// Create a DenomAdminProposal with an empty Denom
+	denomAuth := types.DenomAdminProposal{
 		Denom: "", // Explicitly set empty Denom
 	}
 
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"zigchain/x/factory/types"
+)
+
+// SetDenomRole grants a role of a denom to an address
+func (k Keeper) SetDenomRole(ctx context.Context, denom string, role string, address string) {
+	if err := k.DenomRoleGrants.Set(ctx, collections.Join3(denom, role, address)); err != nil {
+		panic(err)
+	}
+}
+
+// RemoveDenomRole revokes a role of a denom from an address
+func (k Keeper) RemoveDenomRole(ctx context.Context, denom string, role string, address string) {
+	if err := k.DenomRoleGrants.Remove(ctx, collections.Join3(denom, role, address)); err != nil {
+		panic(err)
+	}
+}
+
+// HasDenomRole tells if a role of a denom was granted to an address,
+// it does not account for the admin implicitly holding every role
+func (k Keeper) HasDenomRole(ctx context.Context, denom string, role string, address string) bool {
+	has, err := k.DenomRoleGrants.Has(ctx, collections.Join3(denom, role, address))
+	if err != nil {
+		return false
+	}
+
+	return has
+}
+
+// GetDenomRoleHolders returns the addresses a role of a denom was granted to
+func (k Keeper) GetDenomRoleHolders(ctx context.Context, denom string, role string) (list []string) {
+	iterator, err := k.DenomRoleGrants.Iterate(
+		ctx,
+		collections.NewSuperPrefixedTripleRange[string, string, string](denom, role),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keys, err := iterator.Keys()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, key := range keys {
+		list = append(list, key.K3())
+	}
+
+	return
+}
+
+// GetAllDenomRole returns all the granted denomRole
+func (k Keeper) GetAllDenomRole(ctx context.Context) (list []types.DenomRole) {
+	iterator, err := k.DenomRoleGrants.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	keys, err := iterator.Keys()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, key := range keys {
+		list = append(list, types.DenomRole{Denom: key.K1(), Role: key.K2(), Address: key.K3()})
+	}
+
+	return
+}
@@ -0,0 +1,251 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"zigchain/testutil/sample"
+	"zigchain/x/factory/keeper"
+	"zigchain/x/factory/types"
+)
+
+// Positive test cases
+
+func TestMsgServer_DenomRoles_Positive(t *testing.T) {
+	// Test case: the admin grants roles and registers a minter, each holder performs the action of
+	// its role only, the query lists the holders and a revoked holder loses its role
+
+	factoryKeeper, ctx, bankKeeper, denom, denomAuth := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	minter := sample.AccAddress()
+	pauser := sample.AccAddress()
+	capManager := sample.AccAddress()
+
+	for role, address := range map[string]string{
+		types.RolePauser:     pauser,
+		types.RoleCapManager: capManager,
+	} {
+		_, err := server.GrantDenomRole(ctx, types.NewMsgGrantDenomRole(denomAuth.BankAdmin, denom.Denom, role, address))
+		require.NoError(t, err)
+	}
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventDenomRoleGranted, events[len(events)-1].Type)
+
+	// the minter mints within its allowance
+	_, err := server.SetMinterAllowance(ctx, types.NewMsgSetMinterAllowance(denomAuth.BankAdmin, denom.Denom, minter, cosmosmath.NewUint(150)))
+	require.NoError(t, err)
+
+	recipient := sample.AccAddress()
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(100))
+	expectMint(bankKeeper, token, recipient)
+	_, err = server.MintAndSendTokens(ctx, types.NewMsgMintAndSendTokens(minter, token, recipient))
+	require.NoError(t, err)
+
+	got, found := factoryKeeper.GetMinter(ctx, denom.Denom, minter)
+	require.True(t, found)
+	require.Equal(t, cosmosmath.NewUint(50), got.Allowance)
+
+	// the pauser freezes the denom but can not change the minting cap
+	_, err = server.SetDenomGlobalFreeze(ctx, types.NewMsgSetDenomGlobalFreeze(pauser, denom.Denom, true))
+	require.NoError(t, err)
+	require.True(t, factoryKeeper.IsDenomGlobalFrozen(ctx, denom.Denom))
+
+	_, err = server.UpdateDenomMintingCap(ctx, types.NewMsgUpdateDenomMintingCap(pauser, denom.Denom, cosmosmath.NewUint(2000), true))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the cap manager changes the minting cap but can not grant roles
+	_, err = server.UpdateDenomMintingCap(ctx, types.NewMsgUpdateDenomMintingCap(capManager, denom.Denom, cosmosmath.NewUint(2000), true))
+	require.NoError(t, err)
+
+	_, err = server.GrantDenomRole(ctx, types.NewMsgGrantDenomRole(capManager, denom.Denom, types.RolePauser, capManager))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	resp, err := factoryKeeper.DenomRoles(ctx, &types.QueryDenomRolesRequest{Denom: denom.Denom})
+	require.NoError(t, err)
+	require.Equal(t, []types.DenomRoleHolders{
+		{Role: types.RoleAdmin, Addresses: []string{denomAuth.BankAdmin}},
+		{Role: types.RoleMinter, Addresses: []string{minter}},
+		{Role: types.RoleBurner},
+		{Role: types.RolePauser, Addresses: []string{pauser}},
+		{Role: types.RoleMetadataEditor, Addresses: []string{denomAuth.MetadataAdmin}},
+		{Role: types.RoleCapManager, Addresses: []string{capManager}},
+	}, resp.Roles)
+
+	// the removed minter can not mint anymore
+	_, err = server.RemoveMinter(ctx, types.NewMsgRemoveMinter(denomAuth.BankAdmin, denom.Denom, minter))
+	require.NoError(t, err)
+
+	_, err = server.MintAndSendTokens(ctx, types.NewMsgMintAndSendTokens(minter, token, recipient))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the pauser renounces its own role
+	_, err = server.RevokeDenomRole(ctx, types.NewMsgRevokeDenomRole(pauser, denom.Denom, types.RolePauser, pauser))
+	require.NoError(t, err)
+	require.False(t, factoryKeeper.HasDenomRole(ctx, denom.Denom, types.RolePauser, pauser))
+}
+
+func TestMsgServer_BurnTokens_BurnerRole(t *testing.T) {
+	// Test case: any holder burns its own tokens without a role, only the holders of the burner
+	// role burn a factory denom from another account, the freezes do not apply to it
+
+	factoryKeeper, ctx, bankKeeper, accountKeeper, denom, denomAuth := setupForceTransferTest(t, true)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	holder := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	burner := sample.AccAddress()
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(10))
+
+	bankKeeper.EXPECT().HasBalance(gomock.Any(), holder, token).Return(true).Times(2)
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(token)).Return(nil).Times(2)
+
+	// the holder burns its own tokens
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), holder, types.ModuleName, sdk.NewCoins(token)).Return(nil).Times(1)
+	_, err := server.BurnTokens(ctx, types.NewMsgBurnTokens(holder.String(), token))
+	require.NoError(t, err)
+
+	// another account needs the burner role
+	burnFrom := types.NewMsgBurnTokens(burner, token)
+	burnFrom.FromAddress = holder.String()
+	_, err = server.BurnTokens(ctx, burnFrom)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = server.GrantDenomRole(ctx, types.NewMsgGrantDenomRole(denomAuth.BankAdmin, denom.Denom, types.RoleBurner, burner))
+	require.NoError(t, err)
+
+	factoryKeeper.SetFrozenAccount(ctx, denom.Denom, holder.String())
+	accountKeeper.EXPECT().
+		GetAccount(gomock.Any(), holder).
+		Return(authtypes.NewBaseAccountWithAddress(holder)).
+		Times(1)
+	bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), holder, types.ModuleName, sdk.NewCoins(token)).
+		DoAndReturn(func(ctx context.Context, fromAddr sdk.AccAddress, _ string, amt sdk.Coins) error {
+			_, err := factoryKeeper.FreezeRestriction(ctx, fromAddr, sdk.MustAccAddressFromBech32(sample.AccAddress()), amt)
+			return err
+		}).
+		Times(1)
+
+	_, err = server.BurnTokens(ctx, burnFrom)
+	require.NoError(t, err)
+}
+
+func TestMsgServer_RevokeDenomRole_MetadataAdmin(t *testing.T) {
+	// Test case: the metadata admin holds the metadata editor role,
+	// revoking the role also clears the metadata admin
+
+	factoryKeeper, ctx, _, denom, denomAuth := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	metadataAdmin := sample.AccAddress()
+	_, err := server.UpdateDenomMetadataAuth(ctx, types.NewMsgUpdateDenomMetadataAuth(denomAuth.BankAdmin, denom.Denom, metadataAdmin))
+	require.NoError(t, err)
+
+	require.False(t, factoryKeeper.HasDenomRole(ctx, denom.Denom, types.RoleMetadataEditor, denomAuth.MetadataAdmin))
+	require.True(t, factoryKeeper.HasDenomRole(ctx, denom.Denom, types.RoleMetadataEditor, metadataAdmin))
+
+	_, err = server.RevokeDenomRole(ctx, types.NewMsgRevokeDenomRole(denomAuth.BankAdmin, denom.Denom, types.RoleMetadataEditor, metadataAdmin))
+	require.NoError(t, err)
+
+	got, found := factoryKeeper.GetDenomAuth(ctx, denom.Denom)
+	require.True(t, found)
+	require.Empty(t, got.MetadataAdmin)
+	require.Empty(t, factoryKeeper.GetDenomRoleHolders(ctx, denom.Denom, types.RoleMetadataEditor))
+}
+
+func TestV5Migration(t *testing.T) {
+	// Test case: the metadata admins of the existing denom auths are granted the metadata editor role
+
+	factoryKeeper, ctx, _, denom, denomAuth := setupMinterTest(t)
+	factoryKeeper.RemoveDenomRole(ctx, denom.Denom, types.RoleMetadataEditor, denomAuth.MetadataAdmin)
+
+	locked := types.DenomAuth{Denom: "coin." + sample.AccAddress() + ".locked"}
+	require.NoError(t, factoryKeeper.DenomAuths.Set(ctx, locked.Denom, locked))
+
+	require.NoError(t, factoryKeeper.V5Migration(ctx))
+
+	require.Equal(t, []types.DenomRole{
+		{Denom: denom.Denom, Role: types.RoleMetadataEditor, Address: denomAuth.MetadataAdmin},
+	}, factoryKeeper.GetAllDenomRole(ctx))
+}
+
+// Negative test cases
+
+func TestMsgServer_DenomRoles_Invalid(t *testing.T) {
+	// Test case: the admin and minter roles can not be granted, only held roles are revoked
+	// and the granted roles stop working once the admin is disabled
+
+	factoryKeeper, ctx, _, denom, denomAuth := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	holder := sample.AccAddress()
+
+	_, err := server.GrantDenomRole(ctx, types.NewMsgGrantDenomRole(denomAuth.BankAdmin, denom.Denom, types.RoleAdmin, holder))
+	require.ErrorIs(t, err, types.ErrInvalidRole)
+
+	// the minters are registered with their allowance instead
+	_, err = server.GrantDenomRole(ctx, types.NewMsgGrantDenomRole(denomAuth.BankAdmin, denom.Denom, types.RoleMinter, holder))
+	require.ErrorIs(t, err, types.ErrInvalidRole)
+
+	_, err = server.RevokeDenomRole(ctx, types.NewMsgRevokeDenomRole(denomAuth.BankAdmin, denom.Denom, types.RolePauser, holder))
+	require.ErrorIs(t, err, types.ErrInvalidRole)
+
+	_, err = server.RevokeDenomRole(ctx, types.NewMsgRevokeDenomRole(sample.AccAddress(), denom.Denom, types.RolePauser, holder))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = server.GrantDenomRole(ctx, types.NewMsgGrantDenomRole(denomAuth.BankAdmin, denom.Denom, types.RolePauser, holder))
+	require.NoError(t, err)
+
+	_, err = server.DisableDenomAdmin(ctx, &types.MsgDisableDenomAdmin{Signer: denomAuth.BankAdmin, Denom: denom.Denom})
+	require.NoError(t, err)
+
+	_, err = server.SetDenomGlobalFreeze(ctx, types.NewMsgSetDenomGlobalFreeze(holder, denom.Denom, true))
+	require.ErrorIs(t, err, types.ErrDenomLocked)
+}
+
+func TestMsgServer_BurnTokens_BurnFrom_Invalid(t *testing.T) {
+	// Test case: a burner can not burn from another account when the denom did not opt in to force
+	// transfers, from a module account, or once the admin is disabled
+
+	factoryKeeper, ctx, _, accountKeeper, denom, denomAuth := setupForceTransferTest(t, false)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	burner := sample.AccAddress()
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(10))
+
+	_, err := server.GrantDenomRole(ctx, types.NewMsgGrantDenomRole(denomAuth.BankAdmin, denom.Denom, types.RoleBurner, burner))
+	require.NoError(t, err)
+
+	burnFrom := types.NewMsgBurnTokens(burner, token)
+	burnFrom.FromAddress = sample.AccAddress()
+	_, err = server.BurnTokens(ctx, burnFrom)
+	require.ErrorIs(t, err, types.ErrForceTransferDisabled)
+
+	denom.CanForceTransfer = true
+	factoryKeeper.SetDenom(ctx, denom)
+
+	poolAccount := authtypes.NewEmptyModuleAccount("zp1")
+	accountKeeper.EXPECT().
+		GetAccount(gomock.Any(), poolAccount.GetAddress()).
+		Return(poolAccount).
+		Times(1)
+
+	burnFrom.FromAddress = poolAccount.GetAddress().String()
+	_, err = server.BurnTokens(ctx, burnFrom)
+	require.ErrorIs(t, err, types.ErrForceTransferModuleAccount)
+
+	_, err = server.DisableDenomAdmin(ctx, &types.MsgDisableDenomAdmin{Signer: denomAuth.BankAdmin, Denom: denom.Denom})
+	require.NoError(t, err)
+
+	burnFrom.FromAddress = sample.AccAddress()
+	_, err = server.BurnTokens(ctx, burnFrom)
+	require.ErrorIs(t, err, types.ErrDenomLocked)
+}
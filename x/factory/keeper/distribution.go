@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"zigchain/x/factory/types"
+)
+
+var _ banktypes.SendRestrictionFn = Keeper{}.DistributionRestriction
+
+// SetRewardPool set a specific rewardPool in the store from its index
+func (k Keeper) SetRewardPool(ctx context.Context, pool types.RewardPool) {
+	if err := k.DenomRewardPools.Set(ctx, collections.Join(pool.Denom, pool.RewardDenom), pool); err != nil {
+		panic(err)
+	}
+}
+
+// GetRewardPool returns a rewardPool from its index
+func (k Keeper) GetRewardPool(ctx context.Context, denom string, rewardDenom string) (val types.RewardPool, found bool) {
+	val, err := k.DenomRewardPools.Get(ctx, collections.Join(denom, rewardDenom))
+	if err != nil {
+		return val, false
+	}
+
+	return val, true
+}
+
+// GetDenomRewardPools returns the reward pools of a denom
+func (k Keeper) GetDenomRewardPools(ctx context.Context, denom string) (list []types.RewardPool) {
+	iterator, err := k.DenomRewardPools.Iterate(ctx, collections.NewPrefixedPairRange[string, string](denom))
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// GetAllRewardPool returns all rewardPool
+func (k Keeper) GetAllRewardPool(ctx context.Context) (list []types.RewardPool) {
+	iterator, err := k.DenomRewardPools.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// SetHolderReward set the reward of a holder in a reward denom
+func (k Keeper) SetHolderReward(ctx context.Context, reward types.HolderReward) {
+	key := collections.Join3(reward.Denom, reward.Address, reward.RewardDenom)
+	if err := k.HolderRewards.Set(ctx, key, reward); err != nil {
+		panic(err)
+	}
+}
+
+// GetHolderReward returns the reward of a holder in a reward denom. A holder without any is
+// one whose balance did not change since the pool was created, when nothing was paid yet.
+func (k Keeper) GetHolderReward(ctx context.Context, denom string, address string, rewardDenom string) types.HolderReward {
+	reward, err := k.HolderRewards.Get(ctx, collections.Join3(denom, address, rewardDenom))
+	if err != nil {
+		return types.HolderReward{
+			Denom:              denom,
+			RewardDenom:        rewardDenom,
+			Address:            address,
+			RewardPerTokenPaid: math.LegacyZeroDec(),
+			Pending:            math.ZeroUint(),
+		}
+	}
+
+	return reward
+}
+
+// GetAllHolderReward returns all holderReward
+func (k Keeper) GetAllHolderReward(ctx context.Context) (list []types.HolderReward) {
+	iterator, err := k.HolderRewards.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// SetModuleAccountHolder records a module account that has held a denom
+func (k Keeper) SetModuleAccountHolder(ctx context.Context, denom string, address string) {
+	if err := k.ModuleHolders.Set(ctx, collections.Join(denom, address)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllModuleAccountHolder returns all moduleAccountHolder
+func (k Keeper) GetAllModuleAccountHolder(ctx context.Context) (list []types.ModuleAccountHolder) {
+	iterator, err := k.ModuleHolders.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	keys, err := iterator.Keys()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, key := range keys {
+		list = append(list, types.ModuleAccountHolder{Denom: key.K1(), Address: key.K2()})
+	}
+
+	return
+}
+
+// recordModuleAccountHolder records the address as a holder of the denom if it is a module
+// account other than the factory one
+func (k Keeper) recordModuleAccountHolder(ctx context.Context, denom string, address sdk.AccAddress) {
+	if address.Equals(authtypes.NewModuleAddress(types.ModuleName)) {
+		return
+	}
+
+	if _, ok := k.accountKeeper.GetAccount(ctx, address).(sdk.ModuleAccountI); ok {
+		k.SetModuleAccountHolder(ctx, denom, address.String())
+	}
+}
+
+// isDistributionExcluded tells if an address is left out of the distributions of a denom. The
+// factory module account always is, as it only holds factory denoms while minting or burning
+// them, and the other module accounts are unless the params distribute to them.
+func (k Keeper) isDistributionExcluded(ctx context.Context, address sdk.AccAddress) bool {
+	if address.Equals(authtypes.NewModuleAddress(types.ModuleName)) {
+		return true
+	}
+
+	if _, ok := k.accountKeeper.GetAccount(ctx, address).(sdk.ModuleAccountI); !ok {
+		return false
+	}
+
+	return !k.GetParams(ctx).DistributeToModuleAccounts
+}
+
+// EligibleSupply returns the supply of a denom the rewards are shared by, which leaves out the
+// balances of the module accounts known to hold the denom unless the params distribute to them
+func (k Keeper) EligibleSupply(ctx context.Context, denom string) math.Uint {
+	eligible := k.bankKeeper.GetSupply(ctx, denom).Amount
+
+	if !k.GetParams(ctx).DistributeToModuleAccounts {
+		iterator, err := k.ModuleHolders.Iterate(ctx, collections.NewPrefixedPairRange[string, string](denom))
+		if err != nil {
+			panic(err)
+		}
+
+		holders, err := iterator.Keys()
+		if err != nil {
+			panic(err)
+		}
+
+		for _, holder := range holders {
+			address := sdk.MustAccAddressFromBech32(holder.K2())
+			eligible = eligible.Sub(k.bankKeeper.GetBalance(ctx, address, denom).Amount)
+		}
+	}
+
+	if eligible.IsNegative() {
+		return math.ZeroUint()
+	}
+
+	return math.NewUintFromBigInt(eligible.BigInt())
+}
+
+// settleHolderRewards adds the rewards earned by the current balance of a holder since they
+// were last settled to its pending rewards, before the balance changes
+func (k Keeper) settleHolderRewards(ctx context.Context, denom string, address sdk.AccAddress) {
+	if k.isDistributionExcluded(ctx, address) {
+		return
+	}
+
+	pools := k.GetDenomRewardPools(ctx, denom)
+	if len(pools) == 0 {
+		return
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, address, denom).Amount
+
+	for _, pool := range pools {
+		reward := k.GetHolderReward(ctx, denom, address.String(), pool.RewardDenom)
+		reward.Pending = reward.Earned(pool, balance)
+		reward.RewardPerTokenPaid = pool.RewardPerToken
+		k.SetHolderReward(ctx, reward)
+	}
+}
+
+// checkpointModuleHolders settles the rewards of the module accounts holding the denoms with the
+// current params and moves them to the current reward per token, before the params change
+// whether the module accounts take part in the distributions
+func (k Keeper) checkpointModuleHolders(ctx context.Context) {
+	distributing := k.GetParams(ctx).DistributeToModuleAccounts
+
+	for _, holder := range k.GetAllModuleAccountHolder(ctx) {
+		address := sdk.MustAccAddressFromBech32(holder.Address)
+		balance := k.bankKeeper.GetBalance(ctx, address, holder.Denom).Amount
+
+		for _, pool := range k.GetDenomRewardPools(ctx, holder.Denom) {
+			reward := k.GetHolderReward(ctx, holder.Denom, holder.Address, pool.RewardDenom)
+			if distributing {
+				reward.Pending = reward.Earned(pool, balance)
+			}
+			reward.RewardPerTokenPaid = pool.RewardPerToken
+			k.SetHolderReward(ctx, reward)
+		}
+	}
+}
+
+// GetPendingRewards returns the rewards a holder of a denom can claim
+func (k Keeper) GetPendingRewards(ctx context.Context, denom string, address sdk.AccAddress) sdk.Coins {
+	excluded := k.isDistributionExcluded(ctx, address)
+	balance := k.bankKeeper.GetBalance(ctx, address, denom).Amount
+
+	rewards := sdk.NewCoins()
+	for _, pool := range k.GetDenomRewardPools(ctx, denom) {
+		reward := k.GetHolderReward(ctx, denom, address.String(), pool.RewardDenom)
+
+		pending := reward.Pending
+		if !excluded {
+			pending = reward.Earned(pool, balance)
+		}
+
+		rewards = rewards.Add(sdk.NewCoin(pool.RewardDenom, math.NewIntFromBigInt(pending.BigInt())))
+	}
+
+	return rewards
+}
+
+// DistributionRestriction is the bank send restriction settling the rewards of the sender and
+// the recipient of a factory denom before their balances change. It never rejects a transfer.
+// It also records the module accounts holding the denom, which are left out of the eligible
+// supply of the distributions.
+func (k Keeper) DistributionRestriction(
+	ctx context.Context,
+	fromAddr sdk.AccAddress,
+	toAddr sdk.AccAddress,
+	amt sdk.Coins,
+) (sdk.AccAddress, error) {
+	for _, coin := range amt {
+		// only factory denoms have distributions, skip the store lookups for the others
+		if !isFactoryDenom(coin.Denom) {
+			continue
+		}
+
+		for _, address := range []sdk.AccAddress{fromAddr, toAddr} {
+			k.recordModuleAccountHolder(ctx, coin.Denom, address)
+			k.settleHolderRewards(ctx, coin.Denom, address)
+		}
+	}
+
+	return toAddr, nil
+}
@@ -0,0 +1,247 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"zigchain/testutil/sample"
+	"zigchain/x/factory/keeper"
+	"zigchain/x/factory/testutil"
+	"zigchain/x/factory/types"
+)
+
+// distributionTest holds the balances of the denom served by the bank keeper mock
+type distributionTest struct {
+	factoryKeeper keeper.Keeper
+	ctx           sdk.Context
+	bankKeeper    *testutil.MockBankKeeper
+	server        types.MsgServer
+	denom         types.Denom
+	balances      map[string]int64
+	pool          sdk.AccAddress
+}
+
+func setupDistributionTest(t *testing.T) *distributionTest {
+	factoryKeeper, ctx, bankKeeper, accountKeeper, denom, _ := setupMintVestedTest(t)
+
+	test := &distributionTest{
+		factoryKeeper: factoryKeeper,
+		ctx:           ctx,
+		bankKeeper:    bankKeeper,
+		server:        keeper.NewMsgServerImpl(factoryKeeper),
+		denom:         denom,
+		balances:      make(map[string]int64),
+		pool:          authtypes.NewModuleAddress("pool1"),
+	}
+
+	// the dex pool is a module account
+	accountKeeper.EXPECT().
+		GetAccount(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, address sdk.AccAddress) sdk.AccountI {
+			if address.Equals(test.pool) {
+				return authtypes.NewEmptyModuleAccount("pool1")
+			}
+			return nil
+		}).
+		AnyTimes()
+
+	bankKeeper.EXPECT().
+		GetBalance(gomock.Any(), gomock.Any(), denom.Denom).
+		DoAndReturn(func(_ sdk.Context, address sdk.AccAddress, _ string) sdk.Coin {
+			return sdk.NewCoin(denom.Denom, cosmosmath.NewInt(test.balances[address.String()]))
+		}).
+		AnyTimes()
+
+	bankKeeper.EXPECT().
+		GetSupply(gomock.Any(), denom.Denom).
+		DoAndReturn(func(_ sdk.Context, _ string) sdk.Coin {
+			supply := int64(0)
+			for _, balance := range test.balances {
+				supply += balance
+			}
+			return sdk.NewCoin(denom.Denom, cosmosmath.NewInt(supply))
+		}).
+		AnyTimes()
+
+	return test
+}
+
+// transfer moves the denom between two accounts through the distribution restriction
+func (d *distributionTest) transfer(t *testing.T, from sdk.AccAddress, to sdk.AccAddress, amount int64) {
+	coins := sdk.NewCoins(sdk.NewCoin(d.denom.Denom, cosmosmath.NewInt(amount)))
+	_, err := d.factoryKeeper.DistributionRestriction(d.ctx, from, to, coins)
+	require.NoError(t, err)
+
+	d.balances[from.String()] -= amount
+	d.balances[to.String()] += amount
+}
+
+func (d *distributionTest) distribute(t *testing.T, signer string, reward sdk.Coin) *types.MsgDistributeRewardsResponse {
+	d.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), sdk.MustAccAddressFromBech32(signer), types.DistributionEscrowName, sdk.NewCoins(reward)).
+		Return(nil).
+		Times(1)
+
+	resp, err := d.server.DistributeRewards(d.ctx, types.NewMsgDistributeRewards(signer, d.denom.Denom, reward))
+	require.NoError(t, err)
+
+	return resp
+}
+
+// Positive test cases
+
+func TestDistribution_ProRataAcrossTransfers(t *testing.T) {
+	// Test case: the holders share the rewards by their balances between transfers, the dex pool is left out
+
+	d := setupDistributionTest(t)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	d.balances[alice.String()] = 200
+	d.balances[bob.String()] = 300
+
+	// alice provides liquidity, the pool is recorded as a module account holder
+	d.transfer(t, alice, d.pool, 100)
+	require.Equal(t, []types.ModuleAccountHolder{{Denom: d.denom.Denom, Address: d.pool.String()}}, d.factoryKeeper.GetAllModuleAccountHolder(d.ctx))
+
+	// the admin opens the reward denom
+	resp := d.distribute(t, d.denom.Creator, sdk.NewCoin("uzig", cosmosmath.NewInt(1000)))
+	require.Equal(t, cosmosmath.NewUint(400), resp.EligibleSupply)
+	require.Equal(t, cosmosmath.LegacyMustNewDecFromStr("2.5"), resp.Pool.RewardPerToken)
+
+	events := d.ctx.EventManager().Events()
+	require.Equal(t, types.EventRewardsDistributed, events[len(events)-1].Type)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uzig", cosmosmath.NewInt(250))), d.factoryKeeper.GetPendingRewards(d.ctx, d.denom.Denom, alice))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uzig", cosmosmath.NewInt(750))), d.factoryKeeper.GetPendingRewards(d.ctx, d.denom.Denom, bob))
+	require.True(t, d.factoryKeeper.GetPendingRewards(d.ctx, d.denom.Denom, d.pool).IsZero())
+
+	// alice sends everything to bob, the next reward from anyone only goes to bob
+	d.transfer(t, alice, bob, 100)
+	d.distribute(t, sample.AccAddress(), sdk.NewCoin("uzig", cosmosmath.NewInt(400)))
+
+	query, err := d.factoryKeeper.PendingRewards(d.ctx, &types.QueryPendingRewardsRequest{Denom: d.denom.Denom, Address: bob.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uzig", cosmosmath.NewInt(1150))), query.Rewards)
+
+	for address, amount := range map[string]int64{alice.String(): 250, bob.String(): 1150} {
+		claimed := sdk.NewCoins(sdk.NewCoin("uzig", cosmosmath.NewInt(amount)))
+		d.bankKeeper.EXPECT().
+			SendCoinsFromModuleToAccount(gomock.Any(), types.DistributionEscrowName, sdk.MustAccAddressFromBech32(address), claimed).
+			Return(nil).
+			Times(1)
+
+		claim, err := d.server.ClaimRewards(d.ctx, types.NewMsgClaimRewards(address, d.denom.Denom))
+		require.NoError(t, err)
+		require.Equal(t, claimed, claim.Claimed)
+	}
+
+	pool, found := d.factoryKeeper.GetRewardPool(d.ctx, d.denom.Denom, "uzig")
+	require.True(t, found)
+	require.Equal(t, cosmosmath.NewUint(1400), pool.Distributed)
+	require.Equal(t, cosmosmath.NewUint(1400), pool.Claimed)
+
+	// a second claim has nothing left
+	_, err = d.server.ClaimRewards(d.ctx, types.NewMsgClaimRewards(bob.String(), d.denom.Denom))
+	require.ErrorIs(t, err, types.ErrNothingToClaim)
+}
+
+func TestDistribution_DistributeToModuleAccounts(t *testing.T) {
+	// Test case: the params can include the module accounts in the distributions
+
+	d := setupDistributionTest(t)
+
+	params := d.factoryKeeper.GetParams(d.ctx)
+	params.DistributeToModuleAccounts = true
+	require.NoError(t, d.factoryKeeper.SetParams(d.ctx, params))
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	d.balances[alice.String()] = 300
+	d.transfer(t, alice, d.pool, 100)
+
+	resp := d.distribute(t, d.denom.Creator, sdk.NewCoin("uzig", cosmosmath.NewInt(300)))
+	require.Equal(t, cosmosmath.NewUint(300), resp.EligibleSupply)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uzig", cosmosmath.NewInt(100))), d.factoryKeeper.GetPendingRewards(d.ctx, d.denom.Denom, d.pool))
+}
+
+func TestMsgServer_UpdateParams_DistributeToModuleAccounts(t *testing.T) {
+	// Test case: switching the module accounts in or out of the distributions only affects the
+	// rewards distributed from then on
+
+	d := setupDistributionTest(t)
+	d.bankKeeper.EXPECT().HasSupply(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	updateParams := func(distributeToModuleAccounts bool) {
+		params := d.factoryKeeper.GetParams(d.ctx)
+		params.DistributeToModuleAccounts = distributeToModuleAccounts
+		_, err := d.server.UpdateParams(d.ctx, &types.MsgUpdateParams{Authority: d.factoryKeeper.GetAuthority(), Params: params})
+		require.NoError(t, err)
+	}
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	d.balances[alice.String()] = 300
+	d.transfer(t, alice, d.pool, 100)
+
+	// the pool is left out of the first distribution
+	d.distribute(t, d.denom.Creator, sdk.NewCoin("uzig", cosmosmath.NewInt(200)))
+
+	// the pool earns from the distributions made once it is included
+	updateParams(true)
+	require.True(t, d.factoryKeeper.GetPendingRewards(d.ctx, d.denom.Denom, d.pool).IsZero())
+
+	d.distribute(t, d.denom.Creator, sdk.NewCoin("uzig", cosmosmath.NewInt(300)))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uzig", cosmosmath.NewInt(100))), d.factoryKeeper.GetPendingRewards(d.ctx, d.denom.Denom, d.pool))
+
+	// the pool keeps what it earned once it is left out again
+	updateParams(false)
+	d.distribute(t, d.denom.Creator, sdk.NewCoin("uzig", cosmosmath.NewInt(200)))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uzig", cosmosmath.NewInt(100))), d.factoryKeeper.GetPendingRewards(d.ctx, d.denom.Denom, d.pool))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uzig", cosmosmath.NewInt(600))), d.factoryKeeper.GetPendingRewards(d.ctx, d.denom.Denom, alice))
+}
+
+// Negative test cases
+
+func TestMsgServer_DistributeRewards_Invalid(t *testing.T) {
+	// Test case: new reward denoms from other signers than the admin, distributions without eligible
+	// supply, too small or over the reward denoms limit fail
+
+	d := setupDistributionTest(t)
+	signer := d.denom.Creator
+	reward := sdk.NewCoin("uzig", cosmosmath.NewInt(10))
+
+	d.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	_, err := d.server.DistributeRewards(d.ctx, types.NewMsgDistributeRewards(sample.AccAddress(), d.denom.Denom, reward))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = d.server.DistributeRewards(d.ctx, types.NewMsgDistributeRewards(signer, d.denom.Denom, reward))
+	require.ErrorIs(t, err, types.ErrNoEligibleSupply)
+
+	// a single unit over this supply is below the accumulator precision
+	d.balances[sample.AccAddress()] = 9_000_000_000_000_000_000
+	_, err = d.server.DistributeRewards(d.ctx, types.NewMsgDistributeRewards(signer, d.denom.Denom, sdk.NewCoin("uzig", cosmosmath.OneInt())))
+	require.ErrorIs(t, err, types.ErrInvalidDistribution)
+	require.Contains(t, err.Error(), "too small")
+
+	for i := 0; i < types.MaxRewardDenoms; i++ {
+		d.factoryKeeper.SetRewardPool(d.ctx, types.RewardPool{
+			Denom:          d.denom.Denom,
+			RewardDenom:    "reward" + string(rune('a'+i)),
+			RewardPerToken: cosmosmath.LegacyZeroDec(),
+			Distributed:    cosmosmath.ZeroUint(),
+			Claimed:        cosmosmath.ZeroUint(),
+		})
+	}
+	_, err = d.server.DistributeRewards(d.ctx, types.NewMsgDistributeRewards(signer, d.denom.Denom, sdk.NewCoin("uzig", cosmosmath.NewInt(1000))))
+	require.ErrorIs(t, err, types.ErrInvalidDistribution)
+	require.Contains(t, err.Error(), "maximum")
+
+	_, err = d.server.DistributeRewards(d.ctx, types.NewMsgDistributeRewards(signer, "coin."+signer+".missing", reward))
+	require.ErrorIs(t, err, types.ErrDenomDoesNotExist)
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"zigchain/x/factory/types"
+)
+
+var _ banktypes.SendRestrictionFn = Keeper{}.FreezeRestriction
+
+// isFactoryDenom tells if a denom was created by the factory module
+func isFactoryDenom(denom string) bool {
+	return strings.HasPrefix(denom, types.ModuleDenomPrefix+types.FactoryDenomDelimiterChar)
+}
+
+// SetFrozenAccount freezes an account for a denom
+func (k Keeper) SetFrozenAccount(ctx context.Context, denom string, address string) {
+	if err := k.Freezes.Set(ctx, collections.Join(denom, address)); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteFrozenAccount lifts the freeze of an account for a denom
+func (k Keeper) DeleteFrozenAccount(ctx context.Context, denom string, address string) {
+	if err := k.Freezes.Remove(ctx, collections.Join(denom, address)); err != nil {
+		panic(err)
+	}
+}
+
+// IsAccountFrozen tells if an account is frozen for a denom
+func (k Keeper) IsAccountFrozen(ctx context.Context, denom string, address string) bool {
+	frozen, err := k.Freezes.Has(ctx, collections.Join(denom, address))
+	if err != nil {
+		return false
+	}
+
+	return frozen
+}
+
+// GetAllFrozenAccount returns all frozenAccount
+func (k Keeper) GetAllFrozenAccount(ctx context.Context) (list []types.FrozenAccount) {
+	iterator, err := k.Freezes.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	keys, err := iterator.Keys()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, key := range keys {
+		list = append(list, types.FrozenAccount{Denom: key.K1(), Address: key.K2()})
+	}
+
+	return
+}
+
+// SetDenomGlobalFrozen freezes or unfreezes all the transfers of a denom
+func (k Keeper) SetDenomGlobalFrozen(ctx context.Context, denom string, frozen bool) {
+	var err error
+	if frozen {
+		err = k.GlobalFreezes.Set(ctx, denom)
+	} else {
+		err = k.GlobalFreezes.Remove(ctx, denom)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+// IsDenomGlobalFrozen tells if all the transfers of a denom are frozen
+func (k Keeper) IsDenomGlobalFrozen(ctx context.Context, denom string) bool {
+	frozen, err := k.GlobalFreezes.Has(ctx, denom)
+	if err != nil {
+		return false
+	}
+
+	return frozen
+}
+
+// GetAllGlobalFrozenDenom returns all the globally frozen denoms
+func (k Keeper) GetAllGlobalFrozenDenom(ctx context.Context) (list []string) {
+	iterator, err := k.GlobalFreezes.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Keys()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// FreezeRestriction is the bank send restriction enforcing the freezes of the factory denoms.
+// A frozen account can not send nor receive the denom. A globally frozen denom can only be
+// minted by the factory module, moved to and from its bank admin or force transferred. The
+// transfer tax payments follow a transfer the freezes were already checked for.
+func (k Keeper) FreezeRestriction(
+	ctx context.Context,
+	fromAddr sdk.AccAddress,
+	toAddr sdk.AccAddress,
+	amt sdk.Coins,
+) (sdk.AccAddress, error) {
+	if isForceTransfer(ctx) || isTransferTax(ctx) {
+		return toAddr, nil
+	}
+
+	for _, coin := range amt {
+		// only factory denoms can be frozen, skip the store lookups for the others
+		if !isFactoryDenom(coin.Denom) {
+			continue
+		}
+
+		if k.IsDenomGlobalFrozen(ctx, coin.Denom) && !k.isGlobalFreezeExempt(ctx, coin.Denom, fromAddr, toAddr) {
+			return toAddr, errorsmod.Wrapf(
+				types.ErrDenomGlobalFrozen,
+				"denom (%s)",
+				coin.Denom,
+			)
+		}
+
+		if k.IsAccountFrozen(ctx, coin.Denom, fromAddr.String()) {
+			return toAddr, errorsmod.Wrapf(
+				types.ErrAccountFrozen,
+				"sender (%s) denom (%s)",
+				fromAddr,
+				coin.Denom,
+			)
+		}
+
+		if k.IsAccountFrozen(ctx, coin.Denom, toAddr.String()) {
+			return toAddr, errorsmod.Wrapf(
+				types.ErrAccountFrozen,
+				"recipient (%s) denom (%s)",
+				toAddr,
+				coin.Denom,
+			)
+		}
+	}
+
+	return toAddr, nil
+}
+
+// isGlobalFreezeExempt tells if a transfer of a globally frozen denom is allowed,
+// the mints sent by the factory module and the transfers of the bank admin are
+func (k Keeper) isGlobalFreezeExempt(ctx context.Context, denom string, fromAddr, toAddr sdk.AccAddress) bool {
+	if fromAddr.Equals(authtypes.NewModuleAddress(types.ModuleName)) {
+		return true
+	}
+
+	denomAuth, found := k.GetDenomAuth(ctx, denom)
+	if !found || denomAuth.BankAdmin == "" {
+		return false
+	}
+
+	return denomAuth.BankAdmin == fromAddr.String() || denomAuth.BankAdmin == toAddr.String()
+}
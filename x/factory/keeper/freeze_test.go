@@ -0,0 +1,232 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "zigchain/testutil/keeper"
+	"zigchain/testutil/sample"
+	"zigchain/x/factory/keeper"
+	"zigchain/x/factory/testutil"
+	"zigchain/x/factory/types"
+)
+
+func setupFreezeTest(t *testing.T) (keeper.Keeper, sdk.Context, *testutil.MockAccountKeeper, types.DenomAuth) {
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+
+	factoryKeeper, ctx := keepertest.FactoryKeeper(t, nil, accountKeeper)
+
+	denomAuth := types.DenomAuth{
+		Denom:         "coin." + sample.AccAddress() + ".abc",
+		BankAdmin:     sample.AccAddress(),
+		MetadataAdmin: sample.AccAddress(),
+	}
+	factoryKeeper.SetDenomAuth(ctx, denomAuth)
+
+	return factoryKeeper, ctx, accountKeeper, denomAuth
+}
+
+// Positive test cases
+
+func TestMsgServer_FreezeAccount_Positive(t *testing.T) {
+	// Test case: the bank admin freezes and then unfreezes an account
+
+	factoryKeeper, ctx, accountKeeper, denomAuth := setupFreezeTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	account := sample.AccAddress()
+	accountKeeper.EXPECT().
+		GetAccount(gomock.Any(), sdk.MustAccAddressFromBech32(account)).
+		Return(authtypes.NewBaseAccountWithAddress(sdk.MustAccAddressFromBech32(account))).
+		Times(1)
+
+	resp, err := server.FreezeAccount(ctx, types.NewMsgFreezeAccount(denomAuth.BankAdmin, denomAuth.Denom, account))
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgFreezeAccountResponse{Denom: denomAuth.Denom, Address: account}, resp)
+	require.True(t, factoryKeeper.IsAccountFrozen(ctx, denomAuth.Denom, account))
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventAccountFrozen, events[len(events)-1].Type)
+
+	_, err = server.UnfreezeAccount(ctx, types.NewMsgUnfreezeAccount(denomAuth.BankAdmin, denomAuth.Denom, account))
+	require.NoError(t, err)
+	require.False(t, factoryKeeper.IsAccountFrozen(ctx, denomAuth.Denom, account))
+}
+
+func TestMsgServer_SetDenomGlobalFreeze_Positive(t *testing.T) {
+	// Test case: the bank admin freezes and then unfreezes a denom
+
+	factoryKeeper, ctx, _, denomAuth := setupFreezeTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	resp, err := server.SetDenomGlobalFreeze(ctx, types.NewMsgSetDenomGlobalFreeze(denomAuth.BankAdmin, denomAuth.Denom, true))
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgSetDenomGlobalFreezeResponse{Denom: denomAuth.Denom, Frozen: true}, resp)
+	require.True(t, factoryKeeper.IsDenomGlobalFrozen(ctx, denomAuth.Denom))
+
+	_, err = server.SetDenomGlobalFreeze(ctx, types.NewMsgSetDenomGlobalFreeze(denomAuth.BankAdmin, denomAuth.Denom, false))
+	require.NoError(t, err)
+	require.False(t, factoryKeeper.IsDenomGlobalFrozen(ctx, denomAuth.Denom))
+}
+
+func TestFreezeRestriction_FrozenAccount(t *testing.T) {
+	// Test case: a frozen account can neither send nor receive the denom, other denoms are not affected
+
+	factoryKeeper, ctx, _, denomAuth := setupFreezeTest(t)
+
+	frozen := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	other := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	factoryKeeper.SetFrozenAccount(ctx, denomAuth.Denom, frozen.String())
+
+	amt := sdk.NewCoins(sdk.NewCoin(denomAuth.Denom, math.NewInt(100)))
+
+	_, err := factoryKeeper.FreezeRestriction(ctx, frozen, other, amt)
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+	require.ErrorContains(t, err, "sender")
+
+	_, err = factoryKeeper.FreezeRestriction(ctx, other, frozen, amt)
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+	require.ErrorContains(t, err, "recipient")
+
+	otherDenom := sdk.NewCoins(
+		sdk.NewCoin("uzig", math.NewInt(100)),
+		sdk.NewCoin("coin."+sample.AccAddress()+".def", math.NewInt(100)),
+	)
+	to, err := factoryKeeper.FreezeRestriction(ctx, frozen, other, otherDenom)
+	require.NoError(t, err)
+	require.Equal(t, other, to)
+}
+
+func TestFreezeRestriction_GlobalFreeze(t *testing.T) {
+	// Test case: a globally frozen denom can only be minted or moved to and from the bank admin
+
+	factoryKeeper, ctx, _, denomAuth := setupFreezeTest(t)
+	factoryKeeper.SetDenomGlobalFrozen(ctx, denomAuth.Denom, true)
+
+	holder := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	other := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bankAdmin := sdk.MustAccAddressFromBech32(denomAuth.BankAdmin)
+	amt := sdk.NewCoins(sdk.NewCoin(denomAuth.Denom, math.NewInt(100)))
+
+	_, err := factoryKeeper.FreezeRestriction(ctx, holder, other, amt)
+	require.ErrorIs(t, err, types.ErrDenomGlobalFrozen)
+
+	_, err = factoryKeeper.FreezeRestriction(ctx, holder, bankAdmin, amt)
+	require.NoError(t, err)
+
+	_, err = factoryKeeper.FreezeRestriction(ctx, bankAdmin, holder, amt)
+	require.NoError(t, err)
+
+	_, err = factoryKeeper.FreezeRestriction(ctx, authtypes.NewModuleAddress(types.ModuleName), holder, amt)
+	require.NoError(t, err)
+
+	// the freeze of an account still applies to the transfers of the bank admin
+	factoryKeeper.SetFrozenAccount(ctx, denomAuth.Denom, holder.String())
+	_, err = factoryKeeper.FreezeRestriction(ctx, bankAdmin, holder, amt)
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+}
+
+func TestQueryFrozenAccounts_Positive(t *testing.T) {
+	// Test case: list the frozen accounts of a denom and check a single account
+
+	factoryKeeper, ctx, _, denomAuth := setupFreezeTest(t)
+
+	accounts := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	for _, account := range accounts {
+		factoryKeeper.SetFrozenAccount(ctx, denomAuth.Denom, account)
+	}
+	// an account frozen for another denom is not listed
+	factoryKeeper.SetFrozenAccount(ctx, "coin."+sample.AccAddress()+".def", sample.AccAddress())
+	factoryKeeper.SetDenomGlobalFrozen(ctx, denomAuth.Denom, true)
+
+	resp, err := factoryKeeper.FrozenAccounts(ctx, &types.QueryFrozenAccountsRequest{
+		Denom:      denomAuth.Denom,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, accounts, resp.Addresses)
+	require.True(t, resp.GlobalFrozen)
+	require.Equal(t, uint64(len(accounts)), resp.Pagination.Total)
+
+	isFrozen, err := factoryKeeper.IsFrozen(ctx, &types.QueryIsFrozenRequest{Denom: denomAuth.Denom, Address: accounts[0]})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryIsFrozenResponse{Frozen: true, AccountFrozen: true, GlobalFrozen: true}, isFrozen)
+
+	factoryKeeper.SetDenomGlobalFrozen(ctx, denomAuth.Denom, false)
+	isFrozen, err = factoryKeeper.IsFrozen(ctx, &types.QueryIsFrozenRequest{Denom: denomAuth.Denom, Address: sample.AccAddress()})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryIsFrozenResponse{}, isFrozen)
+}
+
+// Negative test cases
+
+func TestMsgServer_FreezeAccount_ModuleAccount(t *testing.T) {
+	// Test case: module accounts such as the dex pools can not be frozen
+
+	factoryKeeper, ctx, accountKeeper, denomAuth := setupFreezeTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	poolAccount := authtypes.NewEmptyModuleAccount("zp1")
+	accountKeeper.EXPECT().
+		GetAccount(gomock.Any(), poolAccount.GetAddress()).
+		Return(poolAccount).
+		Times(1)
+
+	_, err := server.FreezeAccount(ctx, types.NewMsgFreezeAccount(denomAuth.BankAdmin, denomAuth.Denom, poolAccount.GetAddress().String()))
+	require.ErrorIs(t, err, types.ErrCannotFreezeModuleAccount)
+	require.False(t, factoryKeeper.IsAccountFrozen(ctx, denomAuth.Denom, poolAccount.GetAddress().String()))
+}
+
+func TestMsgServer_FreezeAccount_Unauthorized(t *testing.T) {
+	// Test case: only the bank admin can freeze accounts or the denom
+
+	factoryKeeper, ctx, _, denomAuth := setupFreezeTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	_, err := server.FreezeAccount(ctx, types.NewMsgFreezeAccount(denomAuth.MetadataAdmin, denomAuth.Denom, sample.AccAddress()))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = server.UnfreezeAccount(ctx, types.NewMsgUnfreezeAccount(denomAuth.MetadataAdmin, denomAuth.Denom, sample.AccAddress()))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = server.SetDenomGlobalFreeze(ctx, types.NewMsgSetDenomGlobalFreeze(denomAuth.MetadataAdmin, denomAuth.Denom, true))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestMsgServer_UnfreezeAccount_NotFrozen(t *testing.T) {
+	// Test case: an account that is not frozen can not be unfrozen
+
+	factoryKeeper, ctx, _, denomAuth := setupFreezeTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	_, err := server.UnfreezeAccount(ctx, types.NewMsgUnfreezeAccount(denomAuth.BankAdmin, denomAuth.Denom, sample.AccAddress()))
+	require.ErrorIs(t, err, types.ErrAccountNotFrozen)
+}
+
+func TestQueryFrozenAccounts_Invalid(t *testing.T) {
+	// Test case: query with invalid requests
+
+	factoryKeeper, ctx, _, denomAuth := setupFreezeTest(t)
+
+	_, err := factoryKeeper.FrozenAccounts(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = factoryKeeper.FrozenAccounts(ctx, &types.QueryFrozenAccountsRequest{Denom: "!"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = factoryKeeper.IsFrozen(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = factoryKeeper.IsFrozen(ctx, &types.QueryIsFrozenRequest{Denom: denomAuth.Denom, Address: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
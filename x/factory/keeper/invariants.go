@@ -20,6 +20,11 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		"factory-supply-gte-bank-supply",
 		FactorySupplyGTEBankSupplyInvariant(k),
 	)
+	ir.RegisterRoute(
+		types.ModuleName,
+		"cw20-escrow-covers-minted-supply",
+		CW20EscrowCoversMintedSupplyInvariant(k),
+	)
 }
 
 // SupplyMoreThenMintingCapInvariant checks that total supply is less than or equal to minting cap
@@ -77,3 +82,30 @@ func FactorySupplyGTEBankSupplyInvariant(k Keeper) sdk.Invariant {
 		return "", false
 	}
 }
+
+// CW20EscrowCoversMintedSupplyInvariant checks that the CW20 tokens escrowed for each bridged denom do
+// not exceed its minted amount and cover its bank supply. The CW20 balances
+// of the escrow are not queried, the contracts are checked on each conversion instead.
+func CW20EscrowCoversMintedSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, bridge := range k.GetAllCW20Bridge(ctx) {
+			denom, found := k.GetDenom(ctx, bridge.Denom)
+			if !found {
+				return fmt.Sprintf("missing denom %s of the cw20 bridge of %s", bridge.Denom, bridge.ContractAddress), true
+			}
+
+			// the minted amount is a lifetime total, conversions back to CW20 only lower the escrow
+			if bridge.Escrowed.GT(denom.Minted) {
+				return fmt.Sprintf("escrowed amount %s of denom %s is greater than the minted amount %s", bridge.Escrowed, bridge.Denom, denom.Minted), true
+			}
+
+			// burning the denom without converting it back leaves its tokens in the escrow
+			bankSupply := k.bankKeeper.GetSupply(ctx, bridge.Denom)
+			if cosmosmath.NewUintFromBigInt(bankSupply.Amount.BigInt()).GT(bridge.Escrowed) {
+				return fmt.Sprintf("bank supply %s of denom %s is greater than the escrowed amount %s", bankSupply.Amount, bridge.Denom, bridge.Escrowed), true
+			}
+		}
+
+		return "", false
+	}
+}
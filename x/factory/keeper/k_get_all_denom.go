@@ -14,27 +14,20 @@ import (
 
 // GetAllDenom returns all denom
 func (k Keeper) GetAllDenom(ctx context.Context) (list []types.Denom) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DenomKeyPrefix))
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
-
-	defer func(iterator storetypes.Iterator) {
-		err := iterator.Close()
-		if err != nil {
-			k.Logger().Error("Error closing iterator", "error", err)
-		}
-	}(iterator)
+	iterator, err := k.Denoms.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
 
-	for ; iterator.Valid(); iterator.Next() {
-		var val types.Denom
-		k.cdc.MustUnmarshal(iterator.Value(), &val)
-		list = append(list, val)
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
 	}
 
 	return
 }
 
-// GetAllLegacyDenom returns all legacy denoms
+// GetAllLegacyDenom returns all legacy denoms from the legacy store layout
 func (k Keeper) GetAllLegacyDenom(ctx context.Context) (list []types.LegacyDenom) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DenomKeyPrefix))
@@ -5,9 +5,6 @@ import (
 	"context"
 
 	"zigchain/x/factory/types"
-
-	"cosmossdk.io/store/prefix"
-	"github.com/cosmos/cosmos-sdk/runtime"
 )
 
 // GetDenom returns a denom from its index
@@ -16,17 +13,10 @@ func (k Keeper) GetDenom(
 	denom string,
 
 ) (val types.Denom, found bool) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DenomKeyPrefix))
-
-	b := store.Get(types.DenomKey(
-		denom,
-	))
-	if b == nil {
-
+	val, err := k.Denoms.Get(ctx, denom)
+	if err != nil {
 		return val, false
 	}
 
-	k.cdc.MustUnmarshal(b, &val)
 	return val, true
 }
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"zigchain/x/factory/types"
 )
@@ -31,3 +33,44 @@ func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
 
 	return nil
 }
+
+// GetLegacyParams get the params stored in the pre-v3 layout, where the create fee is a denom and uint32 amount pair
+func (k Keeper) GetLegacyParams(ctx context.Context) (params types.LegacyParams) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetLegacyParams set the params in the pre-v3 layout
+func (k Keeper) SetLegacyParams(ctx context.Context, params types.LegacyParams) error {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// V3Migration merges the create fee denom and uint32 amount into a single sdk.Coin
+func (k Keeper) V3Migration(ctx context.Context) error {
+	legacyParams := k.GetLegacyParams(ctx)
+
+	createFeeDenom := legacyParams.CreateFeeDenom
+	if createFeeDenom == "" {
+		createFeeDenom = types.DefaultCreateFeeDenom
+	}
+
+	params := types.Params{
+		CreateFee:   sdk.NewCoin(createFeeDenom, math.NewIntFromUint64(uint64(legacyParams.CreateFeeAmount))),
+		Beneficiary: legacyParams.Beneficiary,
+	}
+
+	return k.SetParams(ctx, params)
+}
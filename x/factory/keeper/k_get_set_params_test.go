@@ -3,9 +3,12 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	keepertest "zigchain/testutil/keeper"
+	"zigchain/testutil/sample"
 	"zigchain/x/factory/types"
 )
 
@@ -31,10 +34,55 @@ func TestGetParams_Defaults(t *testing.T) {
 
 	// Get params and compare to expected struct
 	expected := types.Params{
-		CreateFeeDenom:  "uzig",
-		CreateFeeAmount: 1000,
-		Beneficiary:     "",
+		CreateFee:                 sdk.NewInt64Coin("uzig", 1000),
+		Beneficiary:               "",
+		MaxTransferTaxRate:        types.DefaultMaxTransferTaxRate,
+		LaunchVirtualReserve:      types.DefaultLaunchVirtualReserve,
+		LaunchGraduationMarketCap: types.DefaultLaunchGraduationMarketCap,
 	}
 
 	require.Equal(t, expected, k.GetParams(ctx))
 }
+
+func TestV3Migration(t *testing.T) {
+	// Test case: V3 migration merges the create fee denom and amount into a coin
+
+	k, ctx := keepertest.FactoryKeeper(t, nil, nil)
+
+	beneficiary := sample.AccAddress()
+	require.NoError(t, k.SetLegacyParams(ctx, types.LegacyParams{
+		CreateFeeDenom:  "uatom",
+		CreateFeeAmount: 5000,
+		Beneficiary:     beneficiary,
+	}))
+
+	require.NoError(t, k.V3Migration(ctx))
+
+	migrated := k.GetParams(ctx)
+	require.Equal(t, types.Params{
+		CreateFee:   sdk.NewInt64Coin("uatom", 5000),
+		Beneficiary: beneficiary,
+		// the max transfer tax rate is left unset, which allows no transfer tax
+		MaxTransferTaxRate: math.LegacyZeroDec(),
+		// the launchpad params are left unset, which disables the launchpad
+		LaunchVirtualReserve:      math.ZeroInt(),
+		LaunchGraduationMarketCap: math.ZeroInt(),
+	}, migrated)
+	require.NoError(t, migrated.Validate())
+}
+
+func TestV3Migration_EmptyDenom(t *testing.T) {
+	// Test case: V3 migration falls back to the default denom when none was stored
+
+	k, ctx := keepertest.FactoryKeeper(t, nil, nil)
+
+	require.NoError(t, k.SetLegacyParams(ctx, types.LegacyParams{
+		CreateFeeAmount: 1000,
+	}))
+
+	require.NoError(t, k.V3Migration(ctx))
+
+	migrated := k.GetParams(ctx)
+	require.Equal(t, sdk.NewInt64Coin(types.DefaultCreateFeeDenom, 1000), migrated.CreateFee)
+	require.NoError(t, migrated.Validate())
+}
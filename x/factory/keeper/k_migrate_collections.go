@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"zigchain/x/factory/types"
+	"zigchain/zutils/migrations"
+)
+
+// V4Migration moves the denoms, denom auths and proposed denom auths from the legacy prefix stores
+// to collections. Setting the denom auths through the collection builds their admin index, so the
+// legacy admin denom auth list is dropped.
+func (k Keeper) V4Migration(ctx context.Context) error {
+	if err := migrations.MigrateLegacyStore(k.legacyStore(ctx, types.DenomKeyPrefix), k.cdc, func(denom types.Denom) error {
+		return k.Denoms.Set(ctx, denom.Denom, denom)
+	}); err != nil {
+		return err
+	}
+
+	if err := migrations.MigrateLegacyStore(k.legacyStore(ctx, types.DenomAuthKeyPrefix), k.cdc, func(denomAuth types.DenomAuth) error {
+		return k.DenomAuths.Set(ctx, denomAuth.Denom, denomAuth)
+	}); err != nil {
+		return err
+	}
+
+	if err := migrations.MigrateLegacyStore(k.legacyStore(ctx, types.ProposedDenomAuthKeyPrefix), k.cdc, func(denomAuth types.DenomAuth) error {
+		return k.ProposedDenomAuths.Set(ctx, denomAuth.Denom, types.DenomAdminProposal{
+			Denom:         denomAuth.Denom,
+			BankAdmin:     denomAuth.BankAdmin,
+			MetadataAdmin: denomAuth.MetadataAdmin,
+		})
+	}); err != nil {
+		return err
+	}
+
+	return migrations.DeleteLegacyStore(k.legacyStore(ctx, types.AdminDenomAuthKeyPrefix))
+}
+
+// legacyStore returns a legacy prefix store of the module store
+func (k Keeper) legacyStore(ctx context.Context, keyPrefix string) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.KeyPrefix(keyPrefix))
+}
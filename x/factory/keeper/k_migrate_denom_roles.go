@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"zigchain/x/factory/types"
+)
+
+// V5Migration maps the denom auths into the denom roles. The bank admin becomes the admin holding
+// every role without being stored, the metadata admin is granted the metadata editor role.
+func (k Keeper) V5Migration(ctx context.Context) error {
+	for _, denomAuth := range k.GetAllDenomAuth(ctx) {
+		if denomAuth.MetadataAdmin == "" {
+			continue
+		}
+
+		if err := k.DenomRoleGrants.Set(ctx, collections.Join3(denomAuth.Denom, types.RoleMetadataEditor, denomAuth.MetadataAdmin)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -15,17 +15,29 @@ func (k Keeper) SetDenom(
 	ctx context.Context,
 	denom types.Denom,
 ) {
+	if err := k.Denoms.Set(ctx, denom.Denom, denom); err != nil {
+		panic(err)
+	}
+}
+
+// SetLegacyDenom sets a denom in the legacy store layout, used to exercise the migrations
+func (k Keeper) SetLegacyDenom(ctx context.Context, legacyDenom types.LegacyDenom) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DenomKeyPrefix))
-	b := k.cdc.MustMarshal(&denom)
+	b := k.cdc.MustMarshal(&legacyDenom)
 	store.Set(types.DenomKey(
-		denom.Denom,
+		legacyDenom.Denom,
 	), b)
 }
 
 // Migrate legacy denom to new denom structure
+// The denoms are rewritten in the legacy store layout, the v4 migration moves them to collections
+// and builds the admin index.
 func (k Keeper) V2Migration(ctx context.Context) error {
 	// Migrate legacy denoms to new denom structure
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DenomKeyPrefix))
+
 	legacyDenoms := k.GetAllLegacyDenom(ctx)
 	for _, legacyDenom := range legacyDenoms {
 		denom := types.Denom{
@@ -35,12 +47,8 @@ func (k Keeper) V2Migration(ctx context.Context) error {
 			Minted:              legacyDenom.Minted,
 			CanChangeMintingCap: legacyDenom.CanChangeMaxSupply,
 		}
-		k.SetDenom(ctx, denom)
+		store.Set(types.DenomKey(denom.Denom), k.cdc.MustMarshal(&denom))
 	}
 
-	// Migrate existing denom auth to admin index
-	// #nosec G104 -- migration logic, error handling not critical
-	k.MigrateAdminDenomAuthList(ctx)
-
 	return nil
 }
@@ -76,13 +76,7 @@ func TestV2Migration(t *testing.T) {
 
 	// Set legacy denoms
 	for _, legacyDenom := range legacyDenoms {
-		k.SetDenom(ctx, types.Denom{
-			Creator:             legacyDenom.Creator,
-			Denom:               legacyDenom.Denom,
-			MintingCap:          legacyDenom.MaxSupply,
-			Minted:              legacyDenom.Minted,
-			CanChangeMintingCap: legacyDenom.CanChangeMaxSupply,
-		})
+		k.SetLegacyDenom(ctx, legacyDenom)
 	}
 
 	// Create some existing denom auths to migrate
@@ -103,9 +97,10 @@ func TestV2Migration(t *testing.T) {
 		k.SetDenomAuth(ctx, auth)
 	}
 
-	// Execute migration
+	// Execute migration, the v4 migration then moves the denoms to collections
 	err := k.V2Migration(ctx)
 	require.NoError(t, err)
+	require.NoError(t, k.V4Migration(ctx))
 
 	// Verify legacy denoms were migrated to new denom structure
 	for _, legacyDenom := range legacyDenoms {
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -25,6 +26,54 @@ type (
 		bankKeeper    types.BankKeeper
 		//lint:ignore U1000 - will be used in the future
 		stakingKeeper types.StakingKeeper // nolint:unused
+
+		// wasm is shared by every copy of the keeper, as the wasm keeper is built after this module
+		wasm *wasmKeepers
+		// launchpad is shared by every copy of the keeper, as the dex keeper is set once the app is built
+		launchpad *launchpadKeepers
+
+		Schema             collections.Schema
+		Denoms             collections.Map[string, types.Denom]
+		DenomAuths         *collections.IndexedMap[string, types.DenomAuth, DenomAuthIndexes]
+		ProposedDenomAuths collections.Map[string, types.DenomAdminProposal]
+		BeforeSendHooks    collections.Map[string, types.BeforeSendHook]
+		Freezes            collections.KeySet[collections.Pair[string, string]]
+		GlobalFreezes      collections.KeySet[string]
+		MinterAllowances   collections.Map[collections.Pair[string, string], types.Minter]
+		MintRateLimits     collections.Map[string, types.MintRateLimit]
+		MintRateBuckets    collections.Map[collections.Pair[string, int64], types.MintRateBucket]
+		Grants             collections.Map[collections.Pair[string, uint64], types.VestingGrant]
+		VestingGrantSeq    collections.Sequence
+		OpenAirdrops       collections.Map[uint64, types.Airdrop]
+		AirdropsByExpiry   collections.KeySet[collections.Pair[int64, uint64]]
+		AirdropClaims      collections.KeySet[collections.Pair[uint64, string]]
+		AirdropSeq         collections.Sequence
+		ClosedAirdrops     collections.KeySet[uint64]
+		DenomSnapshots     collections.Map[collections.Pair[string, uint64], types.Snapshot]
+		SnapshotBalances   collections.Map[collections.Triple[string, string, uint64], types.SnapshotBalance]
+		SnapshotCounts     collections.Map[string, uint64]
+		DenomRewardPools   collections.Map[collections.Pair[string, string], types.RewardPool]
+		HolderRewards      collections.Map[collections.Triple[string, string, string], types.HolderReward]
+		ModuleHolders      collections.KeySet[collections.Pair[string, string]]
+		CW20BridgeStore    *collections.IndexedMap[string, types.CW20Bridge, CW20BridgeIndexes]
+		DenomRoleGrants    collections.KeySet[collections.Triple[string, string, string]]
+		AdminTimelocks     collections.Map[string, types.DenomAdminTimelock]
+		VerifiedDenomStore *collections.IndexedMap[string, types.VerifiedDenom, VerifiedDenomIndexes]
+		TransferTaxes      collections.Map[string, types.TransferTax]
+		TaxExemptions      collections.KeySet[collections.Pair[string, string]]
+		Launches           collections.Map[string, types.TokenLaunch]
+	}
+
+	// wasmKeepers holds the keepers of the CosmWasm module used by the before send hooks and the CW20 bridges
+	wasmKeepers struct {
+		contractKeeper     types.ContractKeeper
+		contractOpsKeeper  types.ContractOpsKeeper
+		contractViewKeeper types.ContractViewKeeper
+	}
+
+	// launchpadKeepers holds the keeper of the dex seeding the pools of the graduated launches
+	launchpadKeepers struct {
+		dexKeeper types.DexKeeper
 	}
 )
 
@@ -41,7 +90,9 @@ func NewKeeper(
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
 	}
 
-	return Keeper{
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
 		cdc:          cdc,
 		storeService: storeService,
 		authority:    authority,
@@ -49,7 +100,212 @@ func NewKeeper(
 
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
+		wasm:          &wasmKeepers{},
+		launchpad:     &launchpadKeepers{},
+
+		Denoms: collections.NewMap(
+			sb,
+			types.DenomsPrefix,
+			"denoms",
+			collections.StringKey,
+			codec.CollValue[types.Denom](cdc),
+		),
+		DenomAuths: collections.NewIndexedMap(
+			sb,
+			types.DenomAuthsPrefix,
+			"denom_auths",
+			collections.StringKey,
+			codec.CollValue[types.DenomAuth](cdc),
+			NewDenomAuthIndexes(sb),
+		),
+		ProposedDenomAuths: collections.NewMap(
+			sb,
+			types.ProposedDenomAuthsPrefix,
+			"proposed_denom_auths",
+			collections.StringKey,
+			codec.CollValue[types.DenomAdminProposal](cdc),
+		),
+		BeforeSendHooks: collections.NewMap(
+			sb,
+			types.BeforeSendHooksPrefix,
+			"before_send_hooks",
+			collections.StringKey,
+			codec.CollValue[types.BeforeSendHook](cdc),
+		),
+		Freezes: collections.NewKeySet(
+			sb,
+			types.FrozenAccountsPrefix,
+			"frozen_accounts",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+		),
+		GlobalFreezes: collections.NewKeySet(
+			sb,
+			types.GlobalFrozenDenomsPrefix,
+			"global_frozen_denoms",
+			collections.StringKey,
+		),
+		MinterAllowances: collections.NewMap(
+			sb,
+			types.MintersPrefix,
+			"minters",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.Minter](cdc),
+		),
+		MintRateLimits: collections.NewMap(
+			sb,
+			types.MintRateLimitsPrefix,
+			"mint_rate_limits",
+			collections.StringKey,
+			codec.CollValue[types.MintRateLimit](cdc),
+		),
+		MintRateBuckets: collections.NewMap(
+			sb,
+			types.MintRateBucketsPrefix,
+			"mint_rate_buckets",
+			collections.PairKeyCodec(collections.StringKey, collections.Int64Key),
+			codec.CollValue[types.MintRateBucket](cdc),
+		),
+		Grants: collections.NewMap(
+			sb,
+			types.VestingGrantsPrefix,
+			"vesting_grants",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.VestingGrant](cdc),
+		),
+		VestingGrantSeq: collections.NewSequence(
+			sb,
+			types.VestingGrantSeqPrefix,
+			"vesting_grant_seq",
+		),
+		OpenAirdrops: collections.NewMap(
+			sb,
+			types.AirdropsPrefix,
+			"airdrops",
+			collections.Uint64Key,
+			codec.CollValue[types.Airdrop](cdc),
+		),
+		AirdropsByExpiry: collections.NewKeySet(
+			sb,
+			types.AirdropsByExpiryPrefix,
+			"airdrops_by_expiry",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key),
+		),
+		AirdropClaims: collections.NewKeySet(
+			sb,
+			types.AirdropClaimsPrefix,
+			"airdrop_claims",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+		),
+		AirdropSeq: collections.NewSequence(
+			sb,
+			types.AirdropSeqPrefix,
+			"airdrop_seq",
+		),
+		ClosedAirdrops: collections.NewKeySet(
+			sb,
+			types.ClosedAirdropsPrefix,
+			"closed_airdrops",
+			collections.Uint64Key,
+		),
+		DenomSnapshots: collections.NewMap(
+			sb,
+			types.SnapshotsPrefix,
+			"snapshots",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.Snapshot](cdc),
+		),
+		SnapshotBalances: collections.NewMap(
+			sb,
+			types.SnapshotBalancesPrefix,
+			"snapshot_balances",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.SnapshotBalance](cdc),
+		),
+		SnapshotCounts: collections.NewMap(
+			sb,
+			types.SnapshotCountsPrefix,
+			"snapshot_counts",
+			collections.StringKey,
+			collections.Uint64Value,
+		),
+		DenomRewardPools: collections.NewMap(
+			sb,
+			types.RewardPoolsPrefix,
+			"reward_pools",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.RewardPool](cdc),
+		),
+		HolderRewards: collections.NewMap(
+			sb,
+			types.HolderRewardsPrefix,
+			"holder_rewards",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey),
+			codec.CollValue[types.HolderReward](cdc),
+		),
+		ModuleHolders: collections.NewKeySet(
+			sb,
+			types.ModuleAccountHoldersPrefix,
+			"module_account_holders",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+		),
+		CW20BridgeStore: collections.NewIndexedMap(
+			sb,
+			types.CW20BridgesPrefix,
+			"cw20_bridges",
+			collections.StringKey,
+			codec.CollValue[types.CW20Bridge](cdc),
+			NewCW20BridgeIndexes(sb),
+		),
+		DenomRoleGrants: collections.NewKeySet(
+			sb,
+			types.DenomRolesPrefix,
+			"denom_roles",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey),
+		),
+		AdminTimelocks: collections.NewMap(
+			sb,
+			types.DenomAdminTimelocksPrefix,
+			"denom_admin_timelocks",
+			collections.StringKey,
+			codec.CollValue[types.DenomAdminTimelock](cdc),
+		),
+		VerifiedDenomStore: collections.NewIndexedMap(
+			sb,
+			types.VerifiedDenomsPrefix,
+			"verified_denoms",
+			collections.StringKey,
+			codec.CollValue[types.VerifiedDenom](cdc),
+			NewVerifiedDenomIndexes(sb),
+		),
+		TransferTaxes: collections.NewMap(
+			sb,
+			types.TransferTaxesPrefix,
+			"transfer_taxes",
+			collections.StringKey,
+			codec.CollValue[types.TransferTax](cdc),
+		),
+		TaxExemptions: collections.NewKeySet(
+			sb,
+			types.TransferTaxExemptionsPrefix,
+			"transfer_tax_exemptions",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+		),
+		Launches: collections.NewMap(
+			sb,
+			types.TokenLaunchesPrefix,
+			"token_launches",
+			collections.StringKey,
+			codec.CollValue[types.TokenLaunch](cdc),
+		),
 	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
 }
 
 // GetAuthority returns the module's authority.
@@ -61,3 +317,22 @@ func (k Keeper) GetAuthority() string {
 func (k Keeper) Logger() log.Logger {
 	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// SetContractKeeper sets the contract keeper called by the before send hooks,
+// until it is set the hooks are not called
+func (k Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
+	k.wasm.contractKeeper = contractKeeper
+}
+
+// SetCW20Keepers sets the keepers executing and querying the CW20 contracts of the bridges,
+// until they are set the bridges can not be registered or converted
+func (k Keeper) SetCW20Keepers(contractOpsKeeper types.ContractOpsKeeper, contractViewKeeper types.ContractViewKeeper) {
+	k.wasm.contractOpsKeeper = contractOpsKeeper
+	k.wasm.contractViewKeeper = contractViewKeeper
+}
+
+// SetDexKeeper sets the keeper of the dex seeding the pools of the graduated launches,
+// until it is set the launches can not graduate
+func (k Keeper) SetDexKeeper(dexKeeper types.DexKeeper) {
+	k.launchpad.dexKeeper = dexKeeper
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+	"zigchain/zutils/constants"
+)
+
+// SetTokenLaunch set a specific token launch in the store from its denom
+func (k Keeper) SetTokenLaunch(ctx context.Context, launch types.TokenLaunch) {
+	if err := k.Launches.Set(ctx, launch.Denom, launch); err != nil {
+		panic(err)
+	}
+}
+
+// GetTokenLaunch returns the token launch of a denom
+func (k Keeper) GetTokenLaunch(ctx context.Context, denom string) (val types.TokenLaunch, found bool) {
+	val, err := k.Launches.Get(ctx, denom)
+	if err != nil {
+		return val, false
+	}
+
+	return val, true
+}
+
+// GetAllTokenLaunch returns all tokenLaunch
+func (k Keeper) GetAllTokenLaunch(ctx context.Context) (list []types.TokenLaunch) {
+	iterator, err := k.Launches.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// getOpenLaunch returns the launch of a denom still trading on its curve
+func (k Keeper) getOpenLaunch(ctx context.Context, denom string) (types.TokenLaunch, error) {
+	launch, found := k.GetTokenLaunch(ctx, denom)
+	if !found {
+		return launch, errorsmod.Wrapf(types.ErrTokenLaunchNotFound, "denom: %s", denom)
+	}
+
+	if launch.Graduated() {
+		return launch, errorsmod.Wrapf(
+			types.ErrTokenLaunchGraduated,
+			"denom: %s trades in pool: %s",
+			denom,
+			launch.PoolId,
+		)
+	}
+
+	return launch, nil
+}
+
+// graduateLaunch seeds the dex pool of a launch with its raised uzig, less the pool creation fee, and with
+// the tokens they buy at the price the curve closed at. The LP tokens are burned, locking the liquidity
+// for good, and so are the tokens left in the escrow.
+func (k Keeper) graduateLaunch(ctx sdk.Context, launch types.TokenLaunch) (types.TokenLaunch, error) {
+	dexKeeper := k.launchpad.dexKeeper
+	if dexKeeper == nil {
+		return launch, errorsmod.Wrap(types.ErrLaunchpadDisabled, "no dex to seed the pool of the launch")
+	}
+
+	quote := launch.Raised
+	if fee := dexKeeper.GetCreationFee(ctx); fee.IsPositive() {
+		if fee.Denom != constants.BondDenom {
+			return launch, errorsmod.Wrapf(
+				types.ErrInvalidTokenLaunch,
+				"pool creation fee %s can not be paid with the raised %s",
+				fee,
+				constants.BondDenom,
+			)
+		}
+		quote = quote.Sub(fee.Amount)
+	}
+
+	base := launch.PoolSeed(quote)
+	if !quote.IsPositive() || !base.IsPositive() {
+		return launch, errorsmod.Wrapf(
+			types.ErrInvalidTokenLaunch,
+			"raised %s%s of denom (%s) can not seed a pool",
+			launch.Raised,
+			constants.BondDenom,
+			launch.Denom,
+		)
+	}
+
+	// the LP tokens go to the module account, the escrow being blocked from receiving funds
+	poolID, lpToken, err := dexKeeper.CreatePoolWithCoins(
+		ctx,
+		authtypes.NewModuleAddress(types.LaunchpadEscrowName).String(),
+		sdk.NewCoin(launch.Denom, base),
+		sdk.NewCoin(constants.BondDenom, quote),
+		authtypes.NewModuleAddress(types.ModuleName).String(),
+	)
+	if err != nil {
+		return launch, err
+	}
+
+	// the escrow holds the tokens not sold on the curve, those not pooled are burned with the LP tokens
+	unpooled := sdk.NewCoins(sdk.NewCoin(launch.Denom, launch.Supply.Sub(launch.Sold).Sub(base)))
+	if err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.LaunchpadEscrowName, types.ModuleName, unpooled); err != nil {
+		return launch, err
+	}
+	if err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, unpooled.Add(lpToken)); err != nil {
+		return launch, err
+	}
+
+	launch.PoolId = poolID
+	k.SetTokenLaunch(ctx, launch)
+
+	events.EmitTokenLaunchGraduated(ctx, launch, sdk.NewCoin(launch.Denom, base), sdk.NewCoin(constants.BondDenom, quote), lpToken)
+
+	return launch, nil
+}
+
+// LaunchRestriction is the bank send restriction keeping the denoms of the open launches out of the
+// module accounts, such as the dex pools, until their launch graduates and seeds its own pool.
+// The factory module accounts can still receive them, so the holders can burn them.
+func (k Keeper) LaunchRestriction(
+	ctx context.Context,
+	fromAddr sdk.AccAddress,
+	toAddr sdk.AccAddress,
+	amt sdk.Coins,
+) (sdk.AccAddress, error) {
+	// the launchpad escrow seeds the pool of the graduating launches, and the transfer tax
+	// payments follow a transfer that was already checked
+	if isFactoryModuleAccount(fromAddr) || isFactoryModuleAccount(toAddr) || isTransferTax(ctx) {
+		return toAddr, nil
+	}
+
+	for _, coin := range amt {
+		// only factory denoms can be launched, skip the store lookups for the others
+		if !isFactoryDenom(coin.Denom) {
+			continue
+		}
+
+		launch, found := k.GetTokenLaunch(ctx, coin.Denom)
+		if !found || launch.Graduated() {
+			continue
+		}
+
+		if _, ok := k.accountKeeper.GetAccount(ctx, toAddr).(sdk.ModuleAccountI); ok {
+			return toAddr, errorsmod.Wrapf(
+				types.ErrLaunchNotGraduated,
+				"denom (%s) can not be sent to module account (%s) before its launch graduates",
+				coin.Denom,
+				toAddr,
+			)
+		}
+	}
+
+	return toAddr, nil
+}
@@ -0,0 +1,344 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	keepertest "zigchain/testutil/keeper"
+	"zigchain/testutil/sample"
+	"zigchain/x/factory/keeper"
+	"zigchain/x/factory/testutil"
+	"zigchain/x/factory/types"
+	"zigchain/zutils/constants"
+)
+
+// setupLaunchTest launches a denom of 1B tokens, 800M of them sold on the curve, with the default launchpad params
+func setupLaunchTest(t *testing.T) (keeper.Keeper, sdk.Context, *testutil.MockBankKeeper, *testutil.MockAccountKeeper, *testutil.MockDexKeeper, types.TokenLaunch) {
+	ctrl := gomock.NewController(t)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	dexKeeper := testutil.NewMockDexKeeper(ctrl)
+
+	factoryKeeper, ctx := keepertest.FactoryKeeper(t, bankKeeper, accountKeeper)
+	factoryKeeper.SetDexKeeper(dexKeeper)
+
+	creator := sample.AccAddress()
+	denom := "coin." + creator + ".abc"
+	supply := sdk.NewCoins(sdk.NewCoin(denom, cosmosmath.NewInt(1_000_000_000)))
+
+	bankKeeper.EXPECT().HasSupply(ctx, "abc").Return(false)
+	bankKeeper.EXPECT().HasBalance(ctx, sdk.MustAccAddressFromBech32(creator), types.DefaultCreateFee).Return(true)
+	bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(ctx, sdk.MustAccAddressFromBech32(creator), types.ModuleName, sdk.NewCoins(types.DefaultCreateFee)).
+		Return(nil)
+	bankKeeper.EXPECT().GetDenomMetaData(ctx, denom).Return(banktypes.Metadata{}, false)
+	bankKeeper.EXPECT().SetDenomMetaData(ctx, gomock.Any())
+	bankKeeper.EXPECT().MintCoins(ctx, types.ModuleName, supply).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LaunchpadEscrowName, supply).Return(nil)
+
+	resp, err := keeper.NewMsgServerImpl(factoryKeeper).LaunchToken(ctx, types.NewMsgLaunchToken(
+		creator,
+		"abc",
+		cosmosmath.NewInt(1_000_000_000),
+		cosmosmath.NewInt(800_000_000),
+		"",
+		"",
+	))
+	require.NoError(t, err)
+
+	return factoryKeeper, ctx, bankKeeper, accountKeeper, dexKeeper, resp.Launch
+}
+
+// expectLaunchBuy expects the bank transfers of a buy on the curve
+func expectLaunchBuy(bankKeeper *testutil.MockBankKeeper, ctx sdk.Context, signer sdk.AccAddress, incoming sdk.Coin, outgoing sdk.Coin) {
+	bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(ctx, signer, types.LaunchpadEscrowName, sdk.NewCoins(incoming)).
+		Return(nil)
+	bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(ctx, types.LaunchpadEscrowName, signer, sdk.NewCoins(outgoing)).
+		Return(nil)
+}
+
+// Positive test cases
+
+func TestMsgServer_LaunchToken_Positive(t *testing.T) {
+	// Test case: the launch creates the denom without a bank admin, mints its fixed supply to the escrow
+	// and opens the curve at the starting market cap
+
+	factoryKeeper, ctx, _, _, _, launch := setupLaunchTest(t)
+
+	require.Equal(t, types.DefaultLaunchVirtualReserve, launch.VirtualReserve)
+	require.Equal(t, types.DefaultLaunchGraduationMarketCap, launch.GraduationMarketCap)
+	require.True(t, launch.Sold.IsZero())
+	require.False(t, launch.Graduated())
+
+	stored, found := factoryKeeper.GetTokenLaunch(ctx, launch.Denom)
+	require.True(t, found)
+	require.Equal(t, launch, stored)
+
+	denom, found := factoryKeeper.GetDenom(ctx, launch.Denom)
+	require.True(t, found)
+	require.Equal(t, cosmosmath.NewUint(1_000_000_000), denom.MintingCap)
+	require.Equal(t, cosmosmath.NewUint(1_000_000_000), denom.Minted)
+	require.False(t, denom.CanChangeMintingCap)
+
+	denomAuth, found := factoryKeeper.GetDenomAuth(ctx, launch.Denom)
+	require.True(t, found)
+	require.Empty(t, denomAuth.BankAdmin)
+	require.Equal(t, launch.Creator, denomAuth.MetadataAdmin)
+
+	// 30B uzig of virtual reserve for 800M tokens on the curve, over the 1B supply
+	query, err := factoryKeeper.TokenLaunch(ctx, &types.QueryTokenLaunchRequest{Denom: launch.Denom})
+	require.NoError(t, err)
+	require.Equal(t, cosmosmath.LegacyMustNewDecFromStr("37.5"), query.Price)
+	require.Equal(t, cosmosmath.NewInt(37_500_000_000), query.MarketCap)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTokenLaunched, events[len(events)-1].Type)
+}
+
+func TestMsgServer_BuyAndSellLaunchToken(t *testing.T) {
+	// Test case: a buy below the graduation market cap moves the curve, selling the tokens back returns
+	// the uzig paid, the buy having rounded the tokens down in favour of the curve
+
+	factoryKeeper, ctx, bankKeeper, _, _, launch := setupLaunchTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	incoming := sdk.NewInt64Coin(constants.BondDenom, 1_000_000_000)
+	bought := sdk.NewInt64Coin(launch.Denom, 25_806_451)
+	expectLaunchBuy(bankKeeper, ctx, signer, incoming, bought)
+
+	buy, err := server.BuyLaunchToken(ctx, types.NewMsgBuyLaunchToken(signer.String(), launch.Denom, incoming, bought.Amount))
+	require.NoError(t, err)
+	require.Equal(t, bought, buy.Outgoing)
+	require.Empty(t, buy.PoolId)
+
+	stored, found := factoryKeeper.GetTokenLaunch(ctx, launch.Denom)
+	require.True(t, found)
+	require.Equal(t, bought.Amount, stored.Sold)
+	require.Equal(t, incoming.Amount, stored.Raised)
+	require.False(t, stored.Graduated())
+
+	proceeds := incoming
+	bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(ctx, signer, types.LaunchpadEscrowName, sdk.NewCoins(bought)).
+		Return(nil)
+	bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(ctx, types.LaunchpadEscrowName, signer, sdk.NewCoins(proceeds)).
+		Return(nil)
+
+	sell, err := server.SellLaunchToken(ctx, types.NewMsgSellLaunchToken(signer.String(), bought, cosmosmath.Int{}))
+	require.NoError(t, err)
+	require.Equal(t, proceeds, sell.Outgoing)
+
+	stored, _ = factoryKeeper.GetTokenLaunch(ctx, launch.Denom)
+	require.True(t, stored.Sold.IsZero())
+	require.True(t, stored.Raised.IsZero())
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventLaunchTokenSold, events[len(events)-1].Type)
+}
+
+func TestMsgServer_BuyLaunchToken_Graduates(t *testing.T) {
+	// Test case: the buy reaching the graduation market cap seeds the dex pool at the closing price
+	// of the curve, then burns the LP tokens and the tokens left in the escrow
+
+	factoryKeeper, ctx, bankKeeper, _, dexKeeper, launch := setupLaunchTest(t)
+
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	incoming := sdk.NewInt64Coin(constants.BondDenom, 100_000_000_000)
+	bought := sdk.NewCoin(launch.Denom, launch.BuyAmount(incoming.Amount))
+	expectLaunchBuy(bankKeeper, ctx, signer, incoming, bought)
+
+	closed := launch
+	closed.Sold = bought.Amount
+	closed.Raised = incoming.Amount
+	require.True(t, closed.MarketCap().GTE(closed.GraduationMarketCap))
+
+	fee := sdk.NewInt64Coin(constants.BondDenom, 1_000)
+	quote := sdk.NewCoin(constants.BondDenom, incoming.Amount.Sub(fee.Amount))
+	base := sdk.NewCoin(launch.Denom, closed.PoolSeed(quote.Amount))
+	unpooled := sdk.NewCoin(launch.Denom, launch.Supply.Sub(bought.Amount).Sub(base.Amount))
+	lpToken := sdk.NewInt64Coin("zp1", 1_000_000)
+
+	dexKeeper.EXPECT().GetCreationFee(gomock.Any()).Return(fee)
+	dexKeeper.EXPECT().
+		CreatePoolWithCoins(
+			gomock.Any(),
+			authtypes.NewModuleAddress(types.LaunchpadEscrowName).String(),
+			base,
+			quote,
+			authtypes.NewModuleAddress(types.ModuleName).String(),
+		).
+		Return("zp1", lpToken, nil)
+	bankKeeper.EXPECT().
+		SendCoinsFromModuleToModule(gomock.Any(), types.LaunchpadEscrowName, types.ModuleName, sdk.NewCoins(unpooled)).
+		Return(nil)
+	bankKeeper.EXPECT().
+		BurnCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(unpooled, lpToken)).
+		Return(nil)
+
+	resp, err := keeper.NewMsgServerImpl(factoryKeeper).BuyLaunchToken(ctx, types.NewMsgBuyLaunchToken(signer.String(), launch.Denom, incoming, cosmosmath.Int{}))
+	require.NoError(t, err)
+	require.Equal(t, "zp1", resp.PoolId)
+
+	stored, _ := factoryKeeper.GetTokenLaunch(ctx, launch.Denom)
+	require.True(t, stored.Graduated())
+	require.Equal(t, "zp1", stored.PoolId)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventLaunchGraduated, events[len(events)-1].Type)
+
+	// the curve is closed
+	_, err = keeper.NewMsgServerImpl(factoryKeeper).BuyLaunchToken(ctx, types.NewMsgBuyLaunchToken(signer.String(), launch.Denom, incoming, cosmosmath.Int{}))
+	require.ErrorIs(t, err, types.ErrTokenLaunchGraduated)
+}
+
+func TestMsgServer_BuyLaunchToken_GraduationFails(t *testing.T) {
+	// Test case: the buy goes through when the pool can not be seeded, the curve stays open
+
+	factoryKeeper, ctx, bankKeeper, _, dexKeeper, launch := setupLaunchTest(t)
+
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	incoming := sdk.NewInt64Coin(constants.BondDenom, 100_000_000_000)
+	bought := sdk.NewCoin(launch.Denom, launch.BuyAmount(incoming.Amount))
+	expectLaunchBuy(bankKeeper, ctx, signer, incoming, bought)
+
+	dexKeeper.EXPECT().GetCreationFee(gomock.Any()).Return(sdk.NewInt64Coin(constants.BondDenom, 0))
+	dexKeeper.EXPECT().
+		CreatePoolWithCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return("", sdk.Coin{}, errors.New("pool creation failed"))
+
+	resp, err := keeper.NewMsgServerImpl(factoryKeeper).BuyLaunchToken(ctx, types.NewMsgBuyLaunchToken(signer.String(), launch.Denom, incoming, cosmosmath.Int{}))
+	require.NoError(t, err)
+	require.Equal(t, bought, resp.Outgoing)
+	require.Empty(t, resp.PoolId)
+
+	stored, _ := factoryKeeper.GetTokenLaunch(ctx, launch.Denom)
+	require.False(t, stored.Graduated())
+	require.Equal(t, bought.Amount, stored.Sold)
+}
+
+func TestLaunchRestriction(t *testing.T) {
+	// Test case: the denom of an open launch can not be sent to a module account, except the factory ones,
+	// until its launch graduates
+
+	factoryKeeper, ctx, _, accountKeeper, _, launch := setupLaunchTest(t)
+
+	pool := authtypes.NewModuleAddress("pool1")
+	accountKeeper.EXPECT().
+		GetAccount(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, address sdk.AccAddress) sdk.AccountI {
+			if address.Equals(pool) {
+				return authtypes.NewEmptyModuleAccount("pool1")
+			}
+			return nil
+		}).
+		AnyTimes()
+
+	holder := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(launch.Denom, 100))
+
+	_, err := factoryKeeper.LaunchRestriction(ctx, holder, pool, coins)
+	require.ErrorIs(t, err, types.ErrLaunchNotGraduated)
+
+	// the holders can send it to each other and burn it
+	_, err = factoryKeeper.LaunchRestriction(ctx, holder, sdk.MustAccAddressFromBech32(sample.AccAddress()), coins)
+	require.NoError(t, err)
+	_, err = factoryKeeper.LaunchRestriction(ctx, holder, authtypes.NewModuleAddress(types.ModuleName), coins)
+	require.NoError(t, err)
+
+	launch.PoolId = "zp1"
+	factoryKeeper.SetTokenLaunch(ctx, launch)
+
+	_, err = factoryKeeper.LaunchRestriction(ctx, holder, pool, coins)
+	require.NoError(t, err)
+}
+
+// Negative test cases
+
+func TestMsgServer_LaunchToken_Disabled(t *testing.T) {
+	// Test case: no launch while the launchpad params are unset
+
+	factoryKeeper, ctx := keepertest.FactoryKeeper(t, nil, nil)
+
+	params := factoryKeeper.GetParams(ctx)
+	params.LaunchGraduationMarketCap = cosmosmath.ZeroInt()
+	require.NoError(t, factoryKeeper.SetParams(ctx, params))
+
+	_, err := keeper.NewMsgServerImpl(factoryKeeper).LaunchToken(ctx, types.NewMsgLaunchToken(
+		sample.AccAddress(),
+		"abc",
+		cosmosmath.NewInt(1_000_000_000),
+		cosmosmath.NewInt(800_000_000),
+		"",
+		"",
+	))
+	require.ErrorIs(t, err, types.ErrLaunchpadDisabled)
+}
+
+func TestMsgServer_LaunchToken_GraduationBelowStart(t *testing.T) {
+	// Test case: the launch fails when the curve would start above the graduation market cap
+
+	factoryKeeper, ctx := keepertest.FactoryKeeper(t, nil, nil)
+
+	// 30B uzig of virtual reserve for 1M tokens on the curve is a 30T uzig starting market cap
+	_, err := keeper.NewMsgServerImpl(factoryKeeper).LaunchToken(ctx, types.NewMsgLaunchToken(
+		sample.AccAddress(),
+		"abc",
+		cosmosmath.NewInt(1_000_000_000),
+		cosmosmath.NewInt(1_000_000),
+		"",
+		"",
+	))
+	require.ErrorIs(t, err, types.ErrInvalidTokenLaunch)
+}
+
+func TestMsgServer_BuyLaunchToken_Slippage(t *testing.T) {
+	// Test case: the buy fails when it gets less than the minimum outgoing tokens
+
+	factoryKeeper, ctx, _, _, _, launch := setupLaunchTest(t)
+
+	_, err := keeper.NewMsgServerImpl(factoryKeeper).BuyLaunchToken(ctx, types.NewMsgBuyLaunchToken(
+		sample.AccAddress(),
+		launch.Denom,
+		sdk.NewInt64Coin(constants.BondDenom, 1_000_000_000),
+		cosmosmath.NewInt(25_806_452),
+	))
+	require.ErrorIs(t, err, types.ErrLaunchSlippage)
+}
+
+func TestMsgServer_BuyLaunchToken_NotFound(t *testing.T) {
+	// Test case: the buy fails for a denom without launch
+
+	factoryKeeper, ctx := keepertest.FactoryKeeper(t, nil, nil)
+
+	_, err := keeper.NewMsgServerImpl(factoryKeeper).BuyLaunchToken(ctx, types.NewMsgBuyLaunchToken(
+		sample.AccAddress(),
+		"coin."+sample.AccAddress()+".abc",
+		sdk.NewInt64Coin(constants.BondDenom, 1_000),
+		cosmosmath.Int{},
+	))
+	require.ErrorIs(t, err, types.ErrTokenLaunchNotFound)
+}
+
+func TestMsgServer_SellLaunchToken_ExceedsSold(t *testing.T) {
+	// Test case: only the tokens sold on the curve can be sold back to it
+
+	factoryKeeper, ctx, _, _, _, launch := setupLaunchTest(t)
+
+	_, err := keeper.NewMsgServerImpl(factoryKeeper).SellLaunchToken(ctx, types.NewMsgSellLaunchToken(
+		sample.AccAddress(),
+		sdk.NewInt64Coin(launch.Denom, 1),
+		cosmosmath.Int{},
+	))
+	require.ErrorIs(t, err, types.ErrInvalidTokenLaunch)
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zigchain/x/factory/types"
+)
+
+// authorizeMint checks that the signer can mint the token within the minting cap and the mint
+// rate limit of its denom. It returns the denom and, when the signer mints as a minter, the
+// minter whose allowance has to be decreased by recordMint once the tokens are minted.
+func (k Keeper) authorizeMint(ctx context.Context, signer string, token sdk.Coin) (types.Denom, *types.Minter, error) {
+	// Check if the value exists
+	currentDenom, isFound := k.GetDenom(
+		ctx,
+		token.Denom,
+	)
+	if !isFound {
+		return currentDenom, nil,
+			errorsmod.Wrap(
+				sdkerrors.ErrKeyNotFound,
+				fmt.Sprintf("Token: %s does not exist", token.Denom),
+			)
+	}
+
+	// Checks if signer has permission to mint tokens, as the bank admin
+	// or as a minter within its allowance
+	minter, err := k.AuthMint(ctx, token.Denom, signer, math.NewUintFromBigInt(token.Amount.BigInt()))
+	if err != nil {
+		return currentDenom, nil, err
+	}
+
+	if err = k.checkMintLimits(ctx, currentDenom, token); err != nil {
+		return currentDenom, nil, err
+	}
+
+	return currentDenom, minter, nil
+}
+
+// checkMintLimits checks that the token can be minted within the minting cap and the mint rate
+// limit of its denom, and records the amount against the rate limit
+func (k Keeper) checkMintLimits(ctx context.Context, currentDenom types.Denom, token sdk.Coin) error {
+	// calculate new supply
+	totalMinted := currentDenom.Minted.Add(math.NewUintFromBigInt(token.Amount.BigInt()))
+	if totalMinted.GT(currentDenom.MintingCap) {
+		return errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf(
+				"Minting %s would exceed Minting Cap of %s",
+				token.String(),
+				currentDenom.MintingCap.String(),
+			),
+		)
+	}
+
+	// Check and record the amount against the rolling window mint rate limit, if any
+	return k.ConsumeMintRateLimit(ctx, token.Denom, math.NewUintFromBigInt(token.Amount.BigInt()))
+}
+
+// recordMint adds the minted amount to the denom and takes it from the allowance of the minter
+func (k Keeper) recordMint(ctx context.Context, currentDenom types.Denom, minter *types.Minter, amount math.Uint) types.Denom {
+	var denom = types.Denom{
+		Creator:             currentDenom.Creator,
+		Denom:               currentDenom.Denom,
+		MintingCap:          currentDenom.MintingCap,
+		Minted:              currentDenom.Minted.Add(amount),
+		CanChangeMintingCap: currentDenom.CanChangeMintingCap,
+		CanForceTransfer:    currentDenom.CanForceTransfer,
+	}
+
+	k.SetDenom(
+		ctx,
+		denom,
+	)
+
+	if minter != nil {
+		minter.Allowance = minter.Allowance.Sub(amount)
+		k.SetMinter(ctx, *minter)
+	}
+
+	return denom
+}
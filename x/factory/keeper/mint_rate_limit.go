@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/types"
+)
+
+// SetMintRateLimit set a specific mintRateLimit in the store from its denom
+func (k Keeper) SetMintRateLimit(ctx context.Context, rateLimit types.MintRateLimit) {
+	if err := k.MintRateLimits.Set(ctx, rateLimit.Denom, rateLimit); err != nil {
+		panic(err)
+	}
+}
+
+// GetMintRateLimit returns a mintRateLimit from its denom
+func (k Keeper) GetMintRateLimit(
+	ctx context.Context,
+	denom string,
+) (val types.MintRateLimit, found bool) {
+	val, err := k.MintRateLimits.Get(ctx, denom)
+	if err != nil {
+		return val, false
+	}
+
+	return val, true
+}
+
+// GetAllMintRateLimit returns all mintRateLimit
+func (k Keeper) GetAllMintRateLimit(ctx context.Context) (list []types.MintRateLimit) {
+	iterator, err := k.MintRateLimits.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// SetMintRateBucket set a specific mintRateBucket in the store from its denom and start time
+func (k Keeper) SetMintRateBucket(ctx context.Context, bucket types.MintRateBucket) {
+	if err := k.MintRateBuckets.Set(ctx, collections.Join(bucket.Denom, bucket.StartTime), bucket); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllMintRateBucket returns all mintRateBucket
+func (k Keeper) GetAllMintRateBucket(ctx context.Context) (list []types.MintRateBucket) {
+	iterator, err := k.MintRateBuckets.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// MintedInWindow returns the amount of a denom minted within the window of the config ending at
+// the unix time now, along with the keys of the buckets that have left the window.
+// A bucket is counted until it has entirely left the window, so the limit is never undercounted.
+func (k Keeper) MintedInWindow(
+	ctx context.Context,
+	denom string,
+	config types.MintRateLimitConfig,
+	now int64,
+) (math.Uint, []collections.Pair[string, int64]) {
+	// #nosec G115 -- the window is bounded by MaxMintRateLimitDuration
+	oldest := now - int64(config.Window) - config.BucketLength()
+
+	iterator, err := k.MintRateBuckets.Iterate(ctx, collections.NewPrefixedPairRange[string, int64](denom))
+	if err != nil {
+		panic(err)
+	}
+	defer iterator.Close()
+
+	minted := math.ZeroUint()
+	var expired []collections.Pair[string, int64]
+	for ; iterator.Valid(); iterator.Next() {
+		kv, err := iterator.KeyValue()
+		if err != nil {
+			panic(err)
+		}
+
+		if kv.Value.StartTime <= oldest {
+			expired = append(expired, kv.Key)
+			continue
+		}
+		minted = minted.Add(kv.Value.Amount)
+	}
+
+	return minted, expired
+}
+
+// ConsumeMintRateLimit checks that minting the amount of a denom stays within its mint rate
+// limit and records it in the current bucket of the window. Denoms without a limit are not
+// restricted.
+func (k Keeper) ConsumeMintRateLimit(ctx context.Context, denom string, amount math.Uint) error {
+	rateLimit, found := k.GetMintRateLimit(ctx, denom)
+	if !found {
+		return nil
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	// A pending raise whose delay has passed takes effect with this mint
+	if effective := rateLimit.Effective(now); effective.PendingConfig == nil && rateLimit.PendingConfig != nil {
+		rateLimit = effective
+		k.SetMintRateLimit(ctx, rateLimit)
+	}
+
+	minted, expired := k.MintedInWindow(ctx, denom, rateLimit.Config, now)
+	for _, key := range expired {
+		if err := k.MintRateBuckets.Remove(ctx, key); err != nil {
+			panic(err)
+		}
+	}
+
+	if minted.Add(amount).GT(rateLimit.Config.Limit) {
+		return errorsmod.Wrapf(
+			types.ErrMintRateLimitExceeded,
+			"denom (%s) minted %s of %s in the last %d seconds, attempted to mint %s",
+			denom,
+			minted,
+			rateLimit.Config.Limit,
+			rateLimit.Config.Window,
+			amount,
+		)
+	}
+
+	bucketLength := rateLimit.Config.BucketLength()
+	startTime := now - now%bucketLength
+
+	bucket, err := k.MintRateBuckets.Get(ctx, collections.Join(denom, startTime))
+	if err != nil {
+		bucket = types.MintRateBucket{
+			Denom:     denom,
+			StartTime: startTime,
+			Amount:    math.ZeroUint(),
+		}
+	}
+	bucket.Amount = bucket.Amount.Add(amount)
+	k.SetMintRateBucket(ctx, bucket)
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"zigchain/x/factory/types"
+)
+
+// SetMinter set a specific minter in the store from its index
+func (k Keeper) SetMinter(ctx context.Context, minter types.Minter) {
+	if err := k.MinterAllowances.Set(ctx, collections.Join(minter.Denom, minter.Address), minter); err != nil {
+		panic(err)
+	}
+}
+
+// GetMinter returns a minter from its index
+func (k Keeper) GetMinter(
+	ctx context.Context,
+	denom string,
+	address string,
+) (val types.Minter, found bool) {
+	val, err := k.MinterAllowances.Get(ctx, collections.Join(denom, address))
+	if err != nil {
+		return val, false
+	}
+
+	return val, true
+}
+
+// DeleteMinter revokes a minter of a denom
+func (k Keeper) DeleteMinter(ctx context.Context, denom string, address string) {
+	if err := k.MinterAllowances.Remove(ctx, collections.Join(denom, address)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllMinter returns all minter
+func (k Keeper) GetAllMinter(ctx context.Context) (list []types.Minter) {
+	iterator, err := k.MinterAllowances.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// GetDenomMinters returns the minters of a denom
+func (k Keeper) GetDenomMinters(ctx context.Context, denom string) (list []types.Minter) {
+	iterator, err := k.MinterAllowances.Iterate(ctx, collections.NewPrefixedPairRange[string, string](denom))
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// AuthMint checks if the signer can mint the amount of a denom. The bank admin can always mint,
+// a minter only up to its remaining allowance, in which case the minter is returned so the
+// allowance can be decreased once the tokens are minted.
+func (k Keeper) AuthMint(ctx context.Context, denom string, signer string, amount math.Uint) (*types.Minter, error) {
+	err := k.Auth(ctx, denom, types.RoleAdmin, signer)
+	if err == nil {
+		return nil, nil
+	}
+
+	// When the bank admin is disabled, the minters can not mint either
+	if errors.Is(err, types.ErrDenomLocked) {
+		return nil, err
+	}
+
+	minter, found := k.GetMinter(ctx, denom, signer)
+	if !found {
+		return nil, err
+	}
+
+	if amount.GT(minter.Allowance) {
+		return nil, errorsmod.Wrapf(
+			types.ErrMinterAllowanceExceeded,
+			"minter (%s) allowance %s, attempted to mint %s",
+			signer,
+			minter.Allowance,
+			amount,
+		)
+	}
+
+	return &minter, nil
+}
@@ -0,0 +1,282 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"zigchain/testutil/sample"
+	"zigchain/x/factory/keeper"
+	"zigchain/x/factory/testutil"
+	"zigchain/x/factory/types"
+)
+
+// buildAirdropTree returns the hex encoded root of a three leaves airdrop tree and the proof of each leaf
+func buildAirdropTree(outputs []types.MintOutput) (string, [][]string) {
+	leaves := make([][]byte, len(outputs))
+	for i, output := range outputs {
+		leaves[i] = types.AirdropLeaf(output.Recipient, output.Amount)
+	}
+
+	// the odd leaf is promoted to the next level
+	left := types.HashAirdropPair(leaves[0], leaves[1])
+	root := types.HashAirdropPair(left, leaves[2])
+
+	proofs := [][]string{
+		{hex.EncodeToString(leaves[1]), hex.EncodeToString(leaves[2])},
+		{hex.EncodeToString(leaves[0]), hex.EncodeToString(leaves[2])},
+		{hex.EncodeToString(left)},
+	}
+
+	return hex.EncodeToString(root), proofs
+}
+
+func setupAirdropTest(t *testing.T) (keeper.Keeper, sdk.Context, *testutil.MockBankKeeper, types.Airdrop, []types.MintOutput, [][]string) {
+	factoryKeeper, ctx, bankKeeper, _, denom, denomAuth := setupMintVestedTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	outputs := []types.MintOutput{
+		{Recipient: sample.AccAddress(), Amount: cosmosmath.NewUint(100)},
+		{Recipient: sample.AccAddress(), Amount: cosmosmath.NewUint(200)},
+		{Recipient: sample.AccAddress(), Amount: cosmosmath.NewUint(300)},
+	}
+	root, proofs := buildAirdropTree(outputs)
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(600))
+
+	bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(token)).Return(nil).Times(1)
+	bankKeeper.EXPECT().
+		SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, types.AirdropEscrowName, sdk.NewCoins(token)).
+		Return(nil).
+		Times(1)
+
+	resp, err := server.CreateAirdrop(ctx, types.NewMsgCreateAirdrop(denomAuth.BankAdmin, token, root, 1_000_600, false))
+	require.NoError(t, err)
+
+	return factoryKeeper, ctx, bankKeeper, resp.Airdrop, outputs, proofs
+}
+
+// Positive test cases
+
+func TestMsgServer_CreateAirdrop(t *testing.T) {
+	// Test case: the airdrop is minted into the escrow and counts against the minting cap
+
+	factoryKeeper, ctx, _, airdrop, _, _ := setupAirdropTest(t)
+
+	require.Equal(t, uint64(0), airdrop.Id)
+	require.Equal(t, cosmosmath.NewUint(600), airdrop.Amount)
+	require.Equal(t, cosmosmath.ZeroUint(), airdrop.Claimed)
+	require.Equal(t, int64(1_000_600), airdrop.ExpiryTime)
+
+	gotAirdrop, found := factoryKeeper.GetAirdrop(ctx, airdrop.Id)
+	require.True(t, found)
+	require.Equal(t, airdrop, gotAirdrop)
+	require.Equal(t, uint64(1), factoryKeeper.GetAirdropCount(ctx))
+
+	gotDenom, found := factoryKeeper.GetDenom(ctx, airdrop.Denom)
+	require.True(t, found)
+	require.Equal(t, cosmosmath.NewUint(600), gotDenom.Minted)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventAirdropCreated, events[len(events)-1].Type)
+}
+
+func TestMsgServer_ClaimAirdrop(t *testing.T) {
+	// Test case: each recipient claims its amount with its proof, once
+
+	factoryKeeper, ctx, bankKeeper, airdrop, outputs, proofs := setupAirdropTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	for i, output := range outputs {
+		claimed := sdk.NewCoin(airdrop.Denom, cosmosmath.Int(output.Amount))
+		bankKeeper.EXPECT().
+			SendCoinsFromModuleToAccount(
+				gomock.Any(),
+				types.AirdropEscrowName,
+				sdk.MustAccAddressFromBech32(output.Recipient),
+				sdk.NewCoins(claimed),
+			).
+			Return(nil).
+			Times(1)
+
+		resp, err := server.ClaimAirdrop(ctx, types.NewMsgClaimAirdrop(output.Recipient, airdrop.Id, output.Amount, proofs[i]))
+		require.NoError(t, err)
+		require.Equal(t, claimed, resp.Claimed)
+		require.True(t, factoryKeeper.IsAirdropClaimed(ctx, airdrop.Id, output.Recipient))
+
+		events := ctx.EventManager().Events()
+		require.Equal(t, types.EventAirdropClaimed, events[len(events)-1].Type)
+	}
+
+	gotAirdrop, found := factoryKeeper.GetAirdrop(ctx, airdrop.Id)
+	require.True(t, found)
+	require.Equal(t, cosmosmath.NewUint(600), gotAirdrop.Claimed)
+	require.True(t, gotAirdrop.Remaining().IsZero())
+
+	// a second claim is rejected
+	_, err := server.ClaimAirdrop(ctx, types.NewMsgClaimAirdrop(outputs[0].Recipient, airdrop.Id, outputs[0].Amount, proofs[0]))
+	require.ErrorIs(t, err, types.ErrAirdropAlreadyClaimed)
+
+	query, err := factoryKeeper.AirdropClaimed(ctx, &types.QueryAirdropClaimedRequest{Id: airdrop.Id, Address: outputs[1].Recipient})
+	require.NoError(t, err)
+	require.True(t, query.Claimed)
+}
+
+func TestAirdropEndBlocker_ReturnUnclaimed(t *testing.T) {
+	// Test case: the unclaimed amount goes back to the creator once the airdrop expires
+
+	factoryKeeper, ctx, bankKeeper, airdrop, outputs, proofs := setupAirdropTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.AirdropEscrowName, gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+	_, err := server.ClaimAirdrop(ctx, types.NewMsgClaimAirdrop(outputs[2].Recipient, airdrop.Id, outputs[2].Amount, proofs[2]))
+	require.NoError(t, err)
+
+	// nothing happens before the expiry time
+	factoryKeeper.AirdropEndBlocker(ctx.WithBlockTime(time.Unix(1_000_599, 0)))
+	_, found := factoryKeeper.GetAirdrop(ctx, airdrop.Id)
+	require.True(t, found)
+
+	unclaimed := sdk.NewCoins(sdk.NewCoin(airdrop.Denom, cosmosmath.NewInt(300)))
+	bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.AirdropEscrowName, sdk.MustAccAddressFromBech32(airdrop.Creator), unclaimed).
+		Return(nil).
+		Times(1)
+
+	expiredCtx := ctx.WithBlockTime(time.Unix(1_000_600, 0))
+	factoryKeeper.AirdropEndBlocker(expiredCtx)
+
+	_, found = factoryKeeper.GetAirdrop(ctx, airdrop.Id)
+	require.False(t, found)
+	require.False(t, factoryKeeper.IsAirdropClaimed(ctx, airdrop.Id, outputs[2].Recipient))
+	require.Empty(t, factoryKeeper.GetAllAirdrop(ctx))
+
+	events := expiredCtx.EventManager().Events()
+	event := events[len(events)-1]
+	require.Equal(t, types.EventAirdropClosed, event.Type)
+	for _, attribute := range event.Attributes {
+		if attribute.Key == types.AttributeKeyReturned {
+			require.Equal(t, unclaimed.String(), attribute.Value)
+		}
+	}
+}
+
+func TestAirdropEndBlocker_BurnWhenReturnFails(t *testing.T) {
+	// Test case: the unclaimed amount is burned when the creator can not receive it
+
+	factoryKeeper, ctx, bankKeeper, airdrop, _, _ := setupAirdropTest(t)
+
+	unclaimed := sdk.NewCoins(sdk.NewCoin(airdrop.Denom, cosmosmath.NewInt(600)))
+	bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.AirdropEscrowName, gomock.Any(), unclaimed).
+		Return(errors.New("frozen")).
+		Times(1)
+	bankKeeper.EXPECT().
+		SendCoinsFromModuleToModule(gomock.Any(), types.AirdropEscrowName, types.ModuleName, unclaimed).
+		Return(nil).
+		Times(1)
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, unclaimed).Return(nil).Times(1)
+
+	factoryKeeper.AirdropEndBlocker(ctx.WithBlockTime(time.Unix(1_000_700, 0)))
+
+	_, found := factoryKeeper.GetAirdrop(ctx, airdrop.Id)
+	require.False(t, found)
+}
+
+func TestAirdropEndBlocker_PrunesClaimsOverBlocks(t *testing.T) {
+	// Test case: the claims of an expired airdrop are pruned at most MaxAirdropClaimsPrunedPerBlock
+	// per block, the airdrop itself is closed at once
+
+	factoryKeeper, ctx, bankKeeper, airdrop, _, _ := setupAirdropTest(t)
+
+	claims := 2*types.MaxAirdropClaimsPrunedPerBlock + 50
+	for i := 0; i < claims; i++ {
+		factoryKeeper.SetAirdropClaimed(ctx, airdrop.Id, sample.AccAddress())
+	}
+
+	countClaims := func() int {
+		iterator, err := factoryKeeper.AirdropClaims.Iterate(ctx, nil)
+		require.NoError(t, err)
+		keys, err := iterator.Keys()
+		require.NoError(t, err)
+		return len(keys)
+	}
+
+	bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.AirdropEscrowName, sdk.MustAccAddressFromBech32(airdrop.Creator), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	expiredCtx := ctx.WithBlockTime(time.Unix(1_000_600, 0))
+	factoryKeeper.AirdropEndBlocker(expiredCtx)
+
+	_, found := factoryKeeper.GetAirdrop(ctx, airdrop.Id)
+	require.False(t, found)
+	require.Equal(t, claims-types.MaxAirdropClaimsPrunedPerBlock, countClaims())
+
+	// the claims left are not exported, they belong to no airdrop
+	require.Empty(t, factoryKeeper.GetAllAirdropClaim(ctx))
+
+	factoryKeeper.AirdropEndBlocker(expiredCtx)
+	require.Equal(t, 50, countClaims())
+
+	factoryKeeper.AirdropEndBlocker(expiredCtx)
+	require.Zero(t, countClaims())
+
+	closed, err := factoryKeeper.ClosedAirdrops.Has(ctx, airdrop.Id)
+	require.NoError(t, err)
+	require.False(t, closed)
+}
+
+// Negative test cases
+
+func TestMsgServer_CreateAirdrop_PastExpiry(t *testing.T) {
+	// Test case: the expiry time must be after the block time
+
+	factoryKeeper, ctx, _, _, denom, denomAuth := setupMintVestedTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	root, _ := buildAirdropTree([]types.MintOutput{
+		{Recipient: sample.AccAddress(), Amount: cosmosmath.NewUint(1)},
+		{Recipient: sample.AccAddress(), Amount: cosmosmath.NewUint(1)},
+		{Recipient: sample.AccAddress(), Amount: cosmosmath.NewUint(1)},
+	})
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(3))
+
+	_, err := server.CreateAirdrop(ctx, types.NewMsgCreateAirdrop(denomAuth.BankAdmin, token, root, 1_000_000, false))
+	require.ErrorIs(t, err, types.ErrInvalidAirdrop)
+}
+
+func TestMsgServer_ClaimAirdrop_Invalid(t *testing.T) {
+	// Test case: claims with a wrong proof or amount, after the expiry time or of a missing airdrop fail
+
+	factoryKeeper, ctx, _, airdrop, outputs, proofs := setupAirdropTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	// the proof of another recipient
+	_, err := server.ClaimAirdrop(ctx, types.NewMsgClaimAirdrop(outputs[0].Recipient, airdrop.Id, outputs[0].Amount, proofs[1]))
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof)
+
+	// a larger amount than in the tree
+	_, err = server.ClaimAirdrop(ctx, types.NewMsgClaimAirdrop(outputs[0].Recipient, airdrop.Id, cosmosmath.NewUint(101), proofs[0]))
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof)
+
+	_, err = server.ClaimAirdrop(
+		ctx.WithBlockTime(time.Unix(1_000_600, 0)),
+		types.NewMsgClaimAirdrop(outputs[0].Recipient, airdrop.Id, outputs[0].Amount, proofs[0]),
+	)
+	require.ErrorIs(t, err, types.ErrAirdropExpired)
+
+	_, err = server.ClaimAirdrop(ctx, types.NewMsgClaimAirdrop(outputs[0].Recipient, 7, outputs[0].Amount, proofs[0]))
+	require.ErrorIs(t, err, types.ErrAirdropNotFound)
+
+	require.False(t, factoryKeeper.IsAirdropClaimed(ctx, airdrop.Id, outputs[0].Recipient))
+}
@@ -22,10 +22,14 @@ func (k msgServer) BurnTokens(
 		return nil, err
 	}
 
+	// Any holder burns its own tokens
+	burnFrom := signer
+	sendCtx := ctx
+
 	_, _, notFactoryDenomErr := types.DeconstructDenom(msg.Token.Denom)
 
 	if notFactoryDenomErr == nil {
-		_, isFound := k.GetDenom(
+		denom, isFound := k.GetDenom(
 			ctx,
 			msg.Token.Denom,
 		)
@@ -37,24 +41,40 @@ func (k msgServer) BurnTokens(
 					fmt.Sprintf("Token: %s does not exist", msg.Token.Denom),
 				)
 		}
+
+		// Only the holders of the burner role can burn a factory denom from another account
+		if msg.FromAddress != "" && msg.FromAddress != msg.Signer {
+			if burnFrom, err = k.authBurnFrom(ctx, denom, msg.Signer, msg.FromAddress); err != nil {
+				return nil, err
+			}
+
+			// The freezes and the before send hooks do not apply, as for the force transfers
+			sendCtx = ctx.WithValue(forceTransferContextKey{}, struct{}{})
+		}
+	} else if msg.FromAddress != "" && msg.FromAddress != msg.Signer {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"only factory denoms can be burned from another account (%s)",
+			msg.Token.Denom,
+		)
 	}
 
-	// Check if the signer has enough balances to burn
-	if !k.bankKeeper.HasBalance(ctx, signer, msg.Token) {
+	// Check if the account has enough balances to burn
+	if !k.bankKeeper.HasBalance(ctx, burnFrom, msg.Token) {
 
-		bankerBalance := k.bankKeeper.GetBalance(ctx, signer, msg.Token.Denom)
+		bankerBalance := k.bankKeeper.GetBalance(ctx, burnFrom, msg.Token.Denom)
 
 		return nil, errorsmod.Wrapf(
 			types.ErrInsufficientFunds,
 			"Signer: %s does not have enough funds, burn amount: %s, existing balance: %s",
-			msg.Signer,
+			burnFrom.String(),
 			msg.Token,
 			bankerBalance,
 		)
 	}
 
-	// Moving tokens from signer to a module account so module can burn them in the next step
-	if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, signer, types.ModuleName, sdk.NewCoins(msg.Token)); err != nil {
+	// Moving tokens from the account to a module account so module can burn them in the next step
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(sendCtx, burnFrom, types.ModuleName, sdk.NewCoins(msg.Token)); err != nil {
 		return nil, err
 	}
 
@@ -71,3 +91,35 @@ func (k msgServer) BurnTokens(
 		AmountBurned: msg.Token,
 	}, nil
 }
+
+// authBurnFrom checks the signer can burn a denom from another account. It needs the burner role
+// and, as it takes tokens away from their holder, the force transfer opt-in of the denom.
+func (k msgServer) authBurnFrom(ctx sdk.Context, denom types.Denom, signer string, from string) (sdk.AccAddress, error) {
+	if err := k.Auth(ctx, denom.Denom, types.RoleBurner, signer); err != nil {
+		return nil, err
+	}
+
+	if !denom.CanForceTransfer {
+		return nil, errorsmod.Wrapf(
+			types.ErrForceTransferDisabled,
+			"Denom: %s",
+			denom.Denom,
+		)
+	}
+
+	fromAddress, err := sdk.AccAddressFromBech32(from)
+	if err != nil {
+		return nil, err
+	}
+
+	// Module accounts such as the dex pools keep their own accounting of the balances
+	if _, ok := k.accountKeeper.GetAccount(ctx, fromAddress).(sdk.ModuleAccountI); ok {
+		return nil, errorsmod.Wrapf(
+			types.ErrForceTransferModuleAccount,
+			"account (%s)",
+			from,
+		)
+	}
+
+	return fromAddress, nil
+}
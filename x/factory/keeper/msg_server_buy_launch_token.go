@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) BuyLaunchToken(goCtx context.Context, msg *types.MsgBuyLaunchToken) (*types.MsgBuyLaunchTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	launch, err := k.getOpenLaunch(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	bought := launch.BuyAmount(msg.Incoming.Amount)
+	if !bought.IsPositive() {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidTokenLaunch,
+			"%s buys no token of denom (%s)",
+			msg.Incoming,
+			msg.Denom,
+		)
+	}
+
+	if !msg.OutgoingMin.IsNil() && bought.LT(msg.OutgoingMin) {
+		return nil, errorsmod.Wrapf(
+			types.ErrLaunchSlippage,
+			"%s buys %s tokens, expected at least %s",
+			msg.Incoming,
+			bought,
+			msg.OutgoingMin,
+		)
+	}
+
+	outgoing := sdk.NewCoin(msg.Denom, bought)
+
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, signer, types.LaunchpadEscrowName, sdk.NewCoins(msg.Incoming)); err != nil {
+		return nil, err
+	}
+
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.LaunchpadEscrowName, signer, sdk.NewCoins(outgoing)); err != nil {
+		return nil, err
+	}
+
+	launch.Sold = launch.Sold.Add(bought)
+	launch.Raised = launch.Raised.Add(msg.Incoming.Amount)
+	k.SetTokenLaunch(ctx, launch)
+
+	events.EmitLaunchTokenBought(ctx, launch, msg.Signer, msg.Incoming, outgoing)
+
+	// The buy reaching the graduation market cap seeds the dex pool, when the pool can not be
+	// seeded the curve stays open and the next buy tries again
+	poolID := ""
+	if launch.MarketCap().GTE(launch.GraduationMarketCap) {
+		graduateCtx, write := ctx.CacheContext()
+		graduated, err := k.graduateLaunch(graduateCtx, launch)
+		if err != nil {
+			k.Logger().Error("launch graduation failed, the curve stays open", "denom", launch.Denom, "error", err)
+		} else {
+			write()
+			poolID = graduated.PoolId
+		}
+	}
+
+	return &types.MsgBuyLaunchTokenResponse{
+		Outgoing: outgoing,
+		PoolId:   poolID,
+	}, nil
+}
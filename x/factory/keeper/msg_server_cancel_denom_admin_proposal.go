@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+// CancelDenomAdminProposal cancels the pending admin proposal of a denom before it is claimed
+func (k msgServer) CancelDenomAdminProposal(
+	goCtx context.Context,
+	msg *types.MsgCancelDenomAdminProposal,
+) (*types.MsgCancelDenomAdminProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the signer has permission to update the denom auth
+	if err := k.Auth(ctx, msg.Denom, types.RoleAdmin, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	proposal, found := k.GetProposedDenomAuth(ctx, msg.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrNoAdminProposal,
+			"no admin has been proposed for denom %s",
+			msg.Denom,
+		)
+	}
+
+	k.DeleteProposedDenomAuth(ctx, msg.Denom)
+
+	events.EmitAdminProposalCancelled(ctx, msg.Signer, proposal)
+
+	return &types.MsgCancelDenomAdminProposalResponse{
+		Denom: msg.Denom,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) ClaimAirdrop(goCtx context.Context, msg *types.MsgClaimAirdrop) (*types.MsgClaimAirdropResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	airdrop, found := k.GetAirdrop(ctx, msg.AirdropId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrAirdropNotFound, "airdrop: %d", msg.AirdropId)
+	}
+
+	if ctx.BlockTime().Unix() >= airdrop.ExpiryTime {
+		return nil, errorsmod.Wrapf(
+			types.ErrAirdropExpired,
+			"airdrop %d expired at %d",
+			airdrop.Id,
+			airdrop.ExpiryTime,
+		)
+	}
+
+	if k.IsAirdropClaimed(ctx, airdrop.Id, msg.Signer) {
+		return nil, errorsmod.Wrapf(
+			types.ErrAirdropAlreadyClaimed,
+			"airdrop %d already claimed by %s",
+			airdrop.Id,
+			msg.Signer,
+		)
+	}
+
+	valid, err := types.VerifyAirdropProof(airdrop.MerkleRoot, msg.Signer, msg.Amount, msg.Proof)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidMerkleProof,
+			"proof of %s for %s does not match the root of airdrop %d",
+			msg.Signer,
+			msg.Amount,
+			airdrop.Id,
+		)
+	}
+
+	// A tree summing to more than the escrowed amount can not drain the other airdrops
+	if msg.Amount.GT(airdrop.Remaining()) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidAirdrop,
+			"airdrop %d has %s left, claimed %s",
+			airdrop.Id,
+			airdrop.Remaining(),
+			msg.Amount,
+		)
+	}
+
+	signerAddress, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := sdk.NewCoin(airdrop.Denom, math.NewIntFromBigInt(msg.Amount.BigInt()))
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.AirdropEscrowName, signerAddress, sdk.NewCoins(claimed)); err != nil {
+		return nil, err
+	}
+
+	airdrop.Claimed = airdrop.Claimed.Add(msg.Amount)
+	k.SetAirdrop(ctx, airdrop)
+	k.SetAirdropClaimed(ctx, airdrop.Id, msg.Signer)
+
+	events.EmitAirdropClaimed(ctx, airdrop, msg.Signer, claimed)
+
+	return &types.MsgClaimAirdropResponse{
+		Claimed: claimed,
+	}, nil
+}
@@ -54,26 +54,23 @@ func (k msgServer) ClaimDenomAdmin(goCtx context.Context, msg *types.MsgClaimDen
 		)
 	}
 
+	// Check the timelock of the proposal has passed and it has not expired
+	if err := proposedAdmin.IsClaimable(ctx.BlockTime().Unix()); err != nil {
+		return nil, err
+	}
+
 	claimedDenomAuth := types.DenomAuth{
 		Denom:         msg.Denom,
 		BankAdmin:     proposedAdmin.BankAdmin,
 		MetadataAdmin: proposedAdmin.MetadataAdmin,
 	}
 
-	// Update the denom admin
+	// Update the denom admin, the admin index follows the claimed admins
 	k.SetDenomAuth(ctx, claimedDenomAuth)
 
 	// Clear the proposed denom admin
 	k.DeleteProposedDenomAuth(ctx, msg.Denom)
 
-	// Remove the previous bank and metadata admin from the admin denom auth list
-	k.RemoveDenomFromAdminDenomAuthList(ctx, currentDenomAuth.BankAdmin, msg.Denom)
-	k.RemoveDenomFromAdminDenomAuthList(ctx, currentDenomAuth.MetadataAdmin, msg.Denom)
-
-	// Add the new bank and metadata admin to the admin denom auth list
-	k.AddDenomToAdminDenomAuthList(ctx, claimedDenomAuth.BankAdmin, msg.Denom)
-	k.AddDenomToAdminDenomAuthList(ctx, claimedDenomAuth.MetadataAdmin, msg.Denom)
-
 	// Emit event for denom admin claim
 	events.EmitDenomAuthClaimed(ctx, msg.Signer, &claimedDenomAuth)
 
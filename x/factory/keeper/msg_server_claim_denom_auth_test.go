@@ -202,6 +202,8 @@ func TestMsgServer_ClaimDenomAdmin_Positive(t *testing.T) {
 		Denom:         f.fullDenom,
 		BankAdmin:     newBankAdmin,
 		MetadataAdmin: newMetaAdmin,
+		ClaimableTime: f.ctx.BlockTime().Unix(),
+		ExpiryTime:    f.ctx.BlockTime().Unix() + int64(types.DefaultDenomAdminProposalExpiry),
 	}
 
 	// make sure that response is not nil
@@ -356,6 +358,8 @@ func TestMsgServer_ClaimDenomAdmin_OtherDenom(t *testing.T) {
 		Denom:         f.fullDenom,
 		BankAdmin:     newBankAdmin,
 		MetadataAdmin: newMetaAdmin,
+		ClaimableTime: f.ctx.BlockTime().Unix(),
+		ExpiryTime:    f.ctx.BlockTime().Unix() + int64(types.DefaultDenomAdminProposalExpiry),
 	}
 
 	// make sure that response is not nil
@@ -497,6 +501,8 @@ func TestMsgServer_ClaimDenomAdmin_DisabledDenom(t *testing.T) {
 		Denom:         f.fullDenom,
 		BankAdmin:     newBankAdmin,
 		MetadataAdmin: newMetaAdmin,
+		ClaimableTime: f.ctx.BlockTime().Unix(),
+		ExpiryTime:    f.ctx.BlockTime().Unix() + int64(types.DefaultDenomAdminProposalExpiry),
 	}
 
 	// make sure that response is not nil
@@ -747,6 +753,8 @@ func TestMsgServer_ClaimDenomAdmin_ClaimerNotProposed(t *testing.T) {
 		Denom:         f.fullDenom,
 		BankAdmin:     newBankAdmin,
 		MetadataAdmin: newMetaAdmin,
+		ClaimableTime: f.ctx.BlockTime().Unix(),
+		ExpiryTime:    f.ctx.BlockTime().Unix() + int64(types.DefaultDenomAdminProposalExpiry),
 	}
 
 	// make sure that response is not nil
@@ -888,6 +896,8 @@ func TestMsgServer_ClaimDenomAdmin_NewMetaAdminTryClaim(t *testing.T) {
 		Denom:         f.fullDenom,
 		BankAdmin:     newBankAdmin,
 		MetadataAdmin: newMetaAdmin,
+		ClaimableTime: f.ctx.BlockTime().Unix(),
+		ExpiryTime:    f.ctx.BlockTime().Unix() + int64(types.DefaultDenomAdminProposalExpiry),
 	}
 
 	// make sure that response is not nil
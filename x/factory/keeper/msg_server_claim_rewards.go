@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) ClaimRewards(goCtx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signerAddress, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	k.settleHolderRewards(ctx, msg.Denom, signerAddress)
+
+	claimed := sdk.NewCoins()
+	for _, pool := range k.GetDenomRewardPools(ctx, msg.Denom) {
+		reward := k.GetHolderReward(ctx, msg.Denom, msg.Signer, pool.RewardDenom)
+		if reward.Pending.IsZero() {
+			continue
+		}
+
+		claimed = claimed.Add(sdk.NewCoin(pool.RewardDenom, math.NewIntFromBigInt(reward.Pending.BigInt())))
+
+		pool.Claimed = pool.Claimed.Add(reward.Pending)
+		k.SetRewardPool(ctx, pool)
+
+		reward.Pending = math.ZeroUint()
+		k.SetHolderReward(ctx, reward)
+	}
+
+	if claimed.IsZero() {
+		return nil, errorsmod.Wrapf(
+			types.ErrNothingToClaim,
+			"no rewards for %s as a holder of %s",
+			msg.Signer,
+			msg.Denom,
+		)
+	}
+
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.DistributionEscrowName, signerAddress, claimed); err != nil {
+		return nil, err
+	}
+
+	events.EmitRewardsClaimed(ctx, msg.Signer, msg.Denom, claimed)
+
+	return &types.MsgClaimRewardsResponse{
+		Claimed: claimed,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) ClaimVested(goCtx context.Context, msg *types.MsgClaimVested) (*types.MsgClaimVestedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grant, found := k.GetVestingGrant(ctx, msg.Denom, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrVestingGrantNotFound,
+			"denom: %s, id: %d",
+			msg.Denom,
+			msg.Id,
+		)
+	}
+
+	if grant.Recipient != msg.Signer {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"only the recipient (%s) can claim the vesting grant",
+			grant.Recipient,
+		)
+	}
+
+	// The tokens of a vesting account are already in the account, only the escrowed ones are claimed
+	if !grant.Escrow {
+		return nil, errorsmod.Wrapf(
+			types.ErrNothingToClaim,
+			"vesting grant %d vests in the recipient vesting account",
+			grant.Id,
+		)
+	}
+
+	claimable := grant.Claimable(ctx.BlockTime().Unix())
+	if claimable.IsZero() {
+		return nil, errorsmod.Wrapf(
+			types.ErrNothingToClaim,
+			"vesting grant %d has no vested amount left to claim",
+			grant.Id,
+		)
+	}
+
+	recipientAddress, err := sdk.AccAddressFromBech32(grant.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := sdk.NewCoin(grant.Denom, math.NewIntFromBigInt(claimable.BigInt()))
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.VestingEscrowName, recipientAddress, sdk.NewCoins(claimed)); err != nil {
+		return nil, err
+	}
+
+	grant.Claimed = grant.Claimed.Add(claimable)
+	k.SetVestingGrant(ctx, grant)
+
+	events.EmitVestedClaimed(ctx, grant, claimed)
+
+	return &types.MsgClaimVestedResponse{
+		Claimed: claimed,
+		Grant:   grant,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) ConvertCoinToCW20(goCtx context.Context, msg *types.MsgConvertCoinToCW20) (*types.MsgConvertCoinToCW20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bridge, found := k.GetCW20BridgeByDenom(ctx, msg.Coin.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrCW20BridgeNotFound,
+			"denom: %s",
+			msg.Coin.Denom,
+		)
+	}
+
+	amount := math.NewUintFromBigInt(msg.Coin.Amount.BigInt())
+	if bridge.Escrowed.LT(amount) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInsufficientFunds,
+			"escrow of contract %s holds %s, converting %s",
+			bridge.ContractAddress,
+			bridge.Escrowed,
+			amount,
+		)
+	}
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	// The denom is burned before the CW20 tokens are released
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, signer, types.ModuleName, sdk.NewCoins(msg.Coin)); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(msg.Coin)); err != nil {
+		return nil, err
+	}
+
+	// The minted amount stays a lifetime total like for any denom, the bridge tracks what is escrowed
+	bridge.Escrowed = bridge.Escrowed.Sub(amount)
+	k.SetCW20Bridge(ctx, bridge)
+
+	if err := k.transferCW20(ctx, bridge.ContractAddress, k.GetCW20EscrowAddress(), signer, amount); err != nil {
+		return nil, err
+	}
+
+	events.EmitCoinConvertedToCW20(ctx, msg.Signer, bridge, msg.Coin)
+
+	return &types.MsgConvertCoinToCW20Response{
+		ContractAddress: bridge.ContractAddress,
+		Amount:          amount,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) ConvertCW20ToCoin(goCtx context.Context, msg *types.MsgConvertCW20ToCoin) (*types.MsgConvertCW20ToCoinResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bridge, found := k.GetCW20Bridge(ctx, msg.ContractAddress)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrCW20BridgeNotFound,
+			"contract: %s",
+			msg.ContractAddress,
+		)
+	}
+
+	denom, found := k.GetDenom(ctx, bridge.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrDenomDoesNotExist,
+			"Denom: %s",
+			bridge.Denom,
+		)
+	}
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	// The bridge mints without a signer authorization, the CW20 tokens locked in the escrow are
+	// the only backing of the denom, but it stays within the limits of the denom
+	coin := sdk.NewCoin(bridge.Denom, math.NewIntFromBigInt(msg.Amount.BigInt()))
+	if err := k.checkMintLimits(ctx, denom, coin); err != nil {
+		return nil, err
+	}
+
+	escrowAddress := k.GetCW20EscrowAddress()
+	escrowedBefore, err := k.GetCW20Balance(ctx, bridge.ContractAddress, escrowAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// The CW20 tokens are locked before the denom is minted
+	if err := k.transferCW20(ctx, bridge.ContractAddress, signer, escrowAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// Only the tokens the escrow actually received are minted, a contract taking a fee on its
+	// transfers or not moving the balances can not back the denom
+	escrowedAfter, err := k.GetCW20Balance(ctx, bridge.ContractAddress, escrowAddress)
+	if err != nil {
+		return nil, err
+	}
+	if !escrowedAfter.Equal(escrowedBefore.Add(msg.Amount)) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidCW20,
+			"escrow balance of contract (%s) went from %s to %s on a transfer of %s",
+			bridge.ContractAddress,
+			escrowedBefore,
+			escrowedAfter,
+			msg.Amount,
+		)
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, signer, sdk.NewCoins(coin)); err != nil {
+		return nil, err
+	}
+
+	k.recordMint(ctx, denom, nil, msg.Amount)
+
+	bridge.Escrowed = bridge.Escrowed.Add(msg.Amount)
+	k.SetCW20Bridge(ctx, bridge)
+
+	events.EmitCW20ConvertedToCoin(ctx, msg.Signer, bridge, coin)
+
+	return &types.MsgConvertCW20ToCoinResponse{
+		Coin: coin,
+	}, nil
+}
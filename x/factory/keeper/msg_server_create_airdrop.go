@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) CreateAirdrop(goCtx context.Context, msg *types.MsgCreateAirdrop) (*types.MsgCreateAirdropResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.ExpiryTime <= ctx.BlockTime().Unix() {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidAirdrop,
+			"expiry time %d must be after the block time %d",
+			msg.ExpiryTime,
+			ctx.BlockTime().Unix(),
+		)
+	}
+
+	// The airdrop is minted up front into the escrow, so it counts against the minting cap,
+	// the allowance of a minter and the mint rate limit when it is created
+	currentDenom, minter, err := k.authorizeMint(ctx, msg.Signer, msg.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	mintCoins := sdk.NewCoins(msg.Token)
+
+	if err = k.bankKeeper.MintCoins(ctx, types.ModuleName, mintCoins); err != nil {
+		return nil, err
+	}
+
+	if err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.AirdropEscrowName, mintCoins); err != nil {
+		return nil, err
+	}
+
+	k.recordMint(ctx, currentDenom, minter, math.NewUintFromBigInt(msg.Token.Amount.BigInt()))
+
+	id, err := k.AirdropSeq.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	airdrop := types.Airdrop{
+		Id:            id,
+		Denom:         msg.Token.Denom,
+		Creator:       msg.Signer,
+		MerkleRoot:    msg.MerkleRoot,
+		Amount:        math.NewUintFromBigInt(msg.Token.Amount.BigInt()),
+		Claimed:       math.ZeroUint(),
+		ExpiryTime:    msg.ExpiryTime,
+		BurnUnclaimed: msg.BurnUnclaimed,
+	}
+	k.SetAirdrop(ctx, airdrop)
+
+	events.EmitAirdropCreated(ctx, airdrop)
+
+	return &types.MsgCreateAirdropResponse{
+		Airdrop: airdrop,
+	}, nil
+}
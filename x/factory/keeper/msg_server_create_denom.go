@@ -21,15 +21,22 @@ func (k msgServer) CreateDenom(
 	*types.MsgCreateDenomResponse,
 	error,
 ) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	return k.createDenom(sdk.UnwrapSDKContext(goCtx), msg)
+}
 
+// createDenom creates a denom and charges its creation fee to the creator,
+// the launches of the launchpad create their denom with it too
+func (k Keeper) createDenom(
+	ctx sdk.Context,
+	msg *types.MsgCreateDenom,
+) (
+	*types.MsgCreateDenomResponse,
+	error,
+) {
 	// Get keeper params
 	params := k.GetParams(ctx)
 	// Check if the sender has enough funds to pay the fee
-	feeAmount := sdk.NewCoin(
-		params.CreateFeeDenom,
-		cosmosmath.NewInt(int64(params.CreateFeeAmount)),
-	) // Adjust the "token" and amount as needed
+	feeAmount := params.CreateFee
 
 	// Native denom names are protected uzig, or bridged stables, etc.
 	if k.bankKeeper.HasSupply(ctx, msg.SubDenom) {
@@ -117,9 +124,6 @@ func (k msgServer) CreateDenom(
 	}
 	k.SetDenomAuth(ctx, denomAuth)
 
-	// Add the denom to the admin denom auth list
-	k.AddDenomToAdminDenomAuthList(ctx, denomAuth.BankAdmin, fullDenom)
-
 	// Proceed to create the denom
 	var denom = types.Denom{
 		Creator:             msg.Creator,
@@ -127,6 +131,7 @@ func (k msgServer) CreateDenom(
 		Minted:              cosmosmath.ZeroUint(),
 		MintingCap:          msg.MintingCap,
 		CanChangeMintingCap: msg.CanChangeMintingCap,
+		CanForceTransfer:    msg.CanForceTransfer,
 	}
 
 	k.SetDenom(ctx, denom)
@@ -141,5 +146,6 @@ func (k msgServer) CreateDenom(
 		CanChangeMintingCap: denom.CanChangeMintingCap,
 		URI:                 denomMetaData.URI,
 		URIHash:             denomMetaData.URIHash,
+		CanForceTransfer:    denom.CanForceTransfer,
 	}, nil
 }
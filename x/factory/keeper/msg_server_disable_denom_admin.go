@@ -27,7 +27,7 @@ func (k msgServer) DisableDenomAdmin(goCtx context.Context, msg *types.MsgDisabl
 	}
 
 	// Check if the signer has permission to update the denom auth
-	if err := k.Auth(ctx, msg.Denom, "bank", msg.Signer); err != nil {
+	if err := k.Auth(ctx, msg.Denom, types.RoleAdmin, msg.Signer); err != nil {
 		return nil, err
 	}
 
@@ -36,9 +36,6 @@ func (k msgServer) DisableDenomAdmin(goCtx context.Context, msg *types.MsgDisabl
 		return nil, err
 	}
 
-	// Remove the denom from the admin denom auth list
-	k.RemoveDenomFromAdminDenomAuthList(ctx, denomAuth.BankAdmin, msg.Denom)
-
 	// Emit event for denom admin disabled
 	events.EmitDenomAuthDisabled(ctx, msg.Signer, &denomAuth)
 
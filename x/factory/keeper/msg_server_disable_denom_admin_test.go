@@ -82,7 +82,7 @@ func TestMsgServer_DisableDenomAdmin_Positive(t *testing.T) {
 	// Check if there is any pending Admin proposal for the denom is empty or not existing
 	pendingAuth, found := f.k.GetProposedDenomAuth(f.ctx, f.fullDenom)
 	require.False(t, found, "Expected no pending denom auth proposal for the denom")
-	require.Equal(t, types.DenomAuth{
+	require.Equal(t, types.DenomAdminProposal{
 		Denom:         "",
 		BankAdmin:     "",
 		MetadataAdmin: "",
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) DisableForceTransfer(goCtx context.Context, msg *types.MsgDisableForceTransfer) (*types.MsgDisableForceTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the signer has permission to disable the force transfers
+	if err := k.Auth(ctx, msg.Denom, types.RoleAdmin, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	denom, found := k.GetDenom(ctx, msg.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrDenomDoesNotExist,
+			"Denom: %s",
+			msg.Denom,
+		)
+	}
+
+	// The flag can only be turned off, once off it stays off
+	if !denom.CanForceTransfer {
+		return nil, errorsmod.Wrapf(
+			types.ErrForceTransferDisabled,
+			"Denom: %s",
+			msg.Denom,
+		)
+	}
+
+	denom.CanForceTransfer = false
+	k.SetDenom(ctx, denom)
+
+	events.EmitForceTransferDisabled(ctx, msg.Signer, msg.Denom)
+
+	return &types.MsgDisableForceTransferResponse{
+		Denom:            denom.Denom,
+		CanForceTransfer: denom.CanForceTransfer,
+	}, nil
+}
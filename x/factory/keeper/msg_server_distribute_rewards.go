@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) DistributeRewards(goCtx context.Context, msg *types.MsgDistributeRewards) (*types.MsgDistributeRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetDenom(ctx, msg.Denom); !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrDenomDoesNotExist,
+			"Denom: %s",
+			msg.Denom,
+		)
+	}
+
+	pool, found := k.GetRewardPool(ctx, msg.Denom, msg.Reward.Denom)
+	if !found {
+		// Only the admin of the denom opens a new reward denom, so the limit on the reward
+		// denoms can not be filled with dust
+		if err := k.Auth(ctx, msg.Denom, types.RoleAdmin, msg.Signer); err != nil {
+			return nil, err
+		}
+
+		if len(k.GetDenomRewardPools(ctx, msg.Denom)) >= types.MaxRewardDenoms {
+			return nil, errorsmod.Wrapf(
+				types.ErrInvalidDistribution,
+				"denom %s already has the maximum of %d reward denoms",
+				msg.Denom,
+				types.MaxRewardDenoms,
+			)
+		}
+
+		pool = types.RewardPool{
+			Denom:          msg.Denom,
+			RewardDenom:    msg.Reward.Denom,
+			RewardPerToken: math.LegacyZeroDec(),
+			Distributed:    math.ZeroUint(),
+			Claimed:        math.ZeroUint(),
+		}
+	}
+
+	signerAddress, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	// The reward is escrowed first, so when it is paid in the denom itself the balance of the
+	// signer is settled before the reward per token increases
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, signerAddress, types.DistributionEscrowName, sdk.NewCoins(msg.Reward)); err != nil {
+		return nil, err
+	}
+
+	eligibleSupply := k.EligibleSupply(ctx, msg.Denom)
+	if eligibleSupply.IsZero() {
+		return nil, errorsmod.Wrapf(
+			types.ErrNoEligibleSupply,
+			"denom %s",
+			msg.Denom,
+		)
+	}
+
+	// The reward per token is truncated, the holders never claim more than was distributed
+	increase := math.LegacyNewDecFromInt(msg.Reward.Amount).QuoInt(math.NewIntFromBigInt(eligibleSupply.BigInt()))
+	if increase.IsZero() {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidDistribution,
+			"reward %s is too small for the eligible supply %s of %s",
+			msg.Reward,
+			eligibleSupply,
+			msg.Denom,
+		)
+	}
+
+	pool.RewardPerToken = pool.RewardPerToken.Add(increase)
+	pool.Distributed = pool.Distributed.Add(math.NewUintFromBigInt(msg.Reward.Amount.BigInt()))
+	k.SetRewardPool(ctx, pool)
+
+	events.EmitRewardsDistributed(ctx, msg.Signer, pool, msg.Reward, eligibleSupply)
+
+	return &types.MsgDistributeRewardsResponse{
+		Pool:           pool,
+		EligibleSupply: eligibleSupply,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+// forceTransferContextKey marks the context of a force transfer,
+// the freezes and the before send hooks do not apply to it
+type forceTransferContextKey struct{}
+
+// isForceTransfer tells if the context is the one of a force transfer
+func isForceTransfer(ctx context.Context) bool {
+	return sdk.UnwrapSDKContext(ctx).Value(forceTransferContextKey{}) != nil
+}
+
+func (k msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the signer has permission to force transfers
+	if err := k.Auth(ctx, msg.Token.Denom, types.RoleAdmin, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	denom, found := k.GetDenom(ctx, msg.Token.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrDenomDoesNotExist,
+			"Denom: %s",
+			msg.Token.Denom,
+		)
+	}
+
+	if !denom.CanForceTransfer {
+		return nil, errorsmod.Wrapf(
+			types.ErrForceTransferDisabled,
+			"Denom: %s",
+			msg.Token.Denom,
+		)
+	}
+
+	fromAddress, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	toAddress, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Module accounts such as the dex pools keep their own accounting of the
+	// balances, moving funds out of or into them would break it
+	if _, ok := k.accountKeeper.GetAccount(ctx, fromAddress).(sdk.ModuleAccountI); ok {
+		return nil, errorsmod.Wrapf(
+			types.ErrForceTransferModuleAccount,
+			"account (%s)",
+			msg.FromAddress,
+		)
+	}
+	if _, ok := k.accountKeeper.GetAccount(ctx, toAddress).(sdk.ModuleAccountI); ok {
+		return nil, errorsmod.Wrapf(
+			types.ErrForceTransferModuleAccount,
+			"account (%s)",
+			msg.ToAddress,
+		)
+	}
+
+	// The force transfers skip the send restrictions, the blocked addresses are checked here
+	if k.bankKeeper.BlockedAddr(toAddress) {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"%s is not allowed to receive funds",
+			msg.ToAddress,
+		)
+	}
+
+	// The freezes and the before send hooks do not apply to the force transfers,
+	// so the funds of a frozen account can be recovered
+	transferCtx := ctx.WithValue(forceTransferContextKey{}, struct{}{})
+	if err := k.bankKeeper.SendCoins(transferCtx, fromAddress, toAddress, sdk.NewCoins(msg.Token)); err != nil {
+		return nil, err
+	}
+
+	events.EmitForceTransfer(ctx, msg.Signer, msg.Token, msg.FromAddress, msg.ToAddress)
+
+	return &types.MsgForceTransferResponse{
+		Token:       msg.Token,
+		FromAddress: msg.FromAddress,
+		ToAddress:   msg.ToAddress,
+	}, nil
+}
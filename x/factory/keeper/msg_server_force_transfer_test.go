@@ -0,0 +1,222 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	keepertest "zigchain/testutil/keeper"
+	"zigchain/testutil/sample"
+	"zigchain/x/factory/keeper"
+	"zigchain/x/factory/testutil"
+	"zigchain/x/factory/types"
+)
+
+func setupForceTransferTest(t *testing.T, canForceTransfer bool) (
+	keeper.Keeper,
+	sdk.Context,
+	*testutil.MockBankKeeper,
+	*testutil.MockAccountKeeper,
+	types.Denom,
+	types.DenomAuth,
+) {
+	ctrl := gomock.NewController(t)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+
+	factoryKeeper, ctx := keepertest.FactoryKeeper(t, bankKeeper, accountKeeper)
+
+	creator := sample.AccAddress()
+	denom := types.Denom{
+		Creator:             creator,
+		Denom:               "coin." + creator + ".abc",
+		MintingCap:          cosmosmath.NewUint(1000),
+		Minted:              cosmosmath.NewUint(100),
+		CanChangeMintingCap: true,
+		CanForceTransfer:    canForceTransfer,
+	}
+	factoryKeeper.SetDenom(ctx, denom)
+
+	denomAuth := types.DenomAuth{
+		Denom:         denom.Denom,
+		BankAdmin:     creator,
+		MetadataAdmin: creator,
+	}
+	factoryKeeper.SetDenomAuth(ctx, denomAuth)
+
+	return factoryKeeper, ctx, bankKeeper, accountKeeper, denom, denomAuth
+}
+
+// Positive test cases
+
+func TestMsgServer_ForceTransfer_Positive(t *testing.T) {
+	// Test case: the bank admin moves the denom out of a frozen account
+
+	factoryKeeper, ctx, bankKeeper, accountKeeper, denom, denomAuth := setupForceTransferTest(t, true)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	from := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	to := sdk.MustAccAddressFromBech32(denomAuth.BankAdmin)
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(50))
+
+	// the freezes do not apply to the force transfers
+	factoryKeeper.SetFrozenAccount(ctx, denom.Denom, from.String())
+	factoryKeeper.SetDenomGlobalFrozen(ctx, denom.Denom, true)
+
+	accountKeeper.EXPECT().
+		GetAccount(gomock.Any(), from).
+		Return(authtypes.NewBaseAccountWithAddress(from)).
+		Times(1)
+	accountKeeper.EXPECT().
+		GetAccount(gomock.Any(), to).
+		Return(authtypes.NewBaseAccountWithAddress(to)).
+		Times(1)
+	bankKeeper.EXPECT().BlockedAddr(to).Return(false).Times(1)
+	bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), from, to, sdk.NewCoins(token)).
+		DoAndReturn(func(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+			_, err := factoryKeeper.FreezeRestriction(ctx, fromAddr, toAddr, amt)
+			return err
+		}).
+		Times(1)
+
+	resp, err := server.ForceTransfer(ctx, types.NewMsgForceTransfer(denomAuth.BankAdmin, token, from.String(), to.String()))
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgForceTransferResponse{Token: token, FromAddress: from.String(), ToAddress: to.String()}, resp)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventForceTransfer, events[len(events)-1].Type)
+
+	// the freezes still apply to the other transfers
+	_, err = factoryKeeper.FreezeRestriction(ctx, from, to, sdk.NewCoins(token))
+	require.Error(t, err)
+}
+
+func TestMsgServer_DisableForceTransfer_Positive(t *testing.T) {
+	// Test case: the bank admin turns off the force transfers, the flag then stays off
+
+	factoryKeeper, ctx, _, _, denom, denomAuth := setupForceTransferTest(t, true)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	resp, err := server.DisableForceTransfer(ctx, types.NewMsgDisableForceTransfer(denomAuth.BankAdmin, denom.Denom))
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgDisableForceTransferResponse{Denom: denom.Denom, CanForceTransfer: false}, resp)
+
+	got, found := factoryKeeper.GetDenom(ctx, denom.Denom)
+	require.True(t, found)
+	require.False(t, got.CanForceTransfer)
+
+	_, err = server.DisableForceTransfer(ctx, types.NewMsgDisableForceTransfer(denomAuth.BankAdmin, denom.Denom))
+	require.ErrorIs(t, err, types.ErrForceTransferDisabled)
+}
+
+func TestMsgServer_UpdateDenomMintingCap_KeepsCanForceTransfer(t *testing.T) {
+	// Test case: updating the minting cap does not change the force transfer flag
+
+	factoryKeeper, ctx, _, _, denom, denomAuth := setupForceTransferTest(t, true)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	_, err := server.UpdateDenomMintingCap(ctx, &types.MsgUpdateDenomMintingCap{
+		Signer:              denomAuth.BankAdmin,
+		Denom:               denom.Denom,
+		MintingCap:          cosmosmath.NewUint(2000),
+		CanChangeMintingCap: true,
+	})
+	require.NoError(t, err)
+
+	got, found := factoryKeeper.GetDenom(ctx, denom.Denom)
+	require.True(t, found)
+	require.True(t, got.CanForceTransfer)
+}
+
+// Negative test cases
+
+func TestMsgServer_ForceTransfer_Disabled(t *testing.T) {
+	// Test case: denoms created without can_force_transfer can not be force transferred
+
+	factoryKeeper, ctx, _, _, denom, denomAuth := setupForceTransferTest(t, false)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(50))
+	_, err := server.ForceTransfer(ctx, types.NewMsgForceTransfer(denomAuth.BankAdmin, token, sample.AccAddress(), sample.AccAddress()))
+	require.ErrorIs(t, err, types.ErrForceTransferDisabled)
+
+	_, err = server.DisableForceTransfer(ctx, types.NewMsgDisableForceTransfer(denomAuth.BankAdmin, denom.Denom))
+	require.ErrorIs(t, err, types.ErrForceTransferDisabled)
+}
+
+func TestMsgServer_ForceTransfer_Unauthorized(t *testing.T) {
+	// Test case: only the bank admin can force transfer
+
+	factoryKeeper, ctx, _, _, denom, _ := setupForceTransferTest(t, true)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(50))
+	_, err := server.ForceTransfer(ctx, types.NewMsgForceTransfer(sample.AccAddress(), token, sample.AccAddress(), sample.AccAddress()))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = server.DisableForceTransfer(ctx, types.NewMsgDisableForceTransfer(sample.AccAddress(), denom.Denom))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestMsgServer_ForceTransfer_ModuleAccount(t *testing.T) {
+	// Test case: the funds of module accounts such as the dex pools can not be force transferred
+
+	factoryKeeper, ctx, _, accountKeeper, denom, denomAuth := setupForceTransferTest(t, true)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	poolAccount := authtypes.NewEmptyModuleAccount("zp1")
+	accountKeeper.EXPECT().
+		GetAccount(gomock.Any(), poolAccount.GetAddress()).
+		Return(poolAccount).
+		Times(1)
+
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(50))
+	_, err := server.ForceTransfer(ctx, types.NewMsgForceTransfer(denomAuth.BankAdmin, token, poolAccount.GetAddress().String(), sample.AccAddress()))
+	require.ErrorIs(t, err, types.ErrForceTransferModuleAccount)
+}
+
+func TestMsgServer_ForceTransfer_ToModuleAccount(t *testing.T) {
+	// Test case: the funds can not be force transferred into module accounts such as the dex pools
+
+	factoryKeeper, ctx, _, accountKeeper, denom, denomAuth := setupForceTransferTest(t, true)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	from := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	poolAccount := authtypes.NewEmptyModuleAccount("zp1")
+	accountKeeper.EXPECT().
+		GetAccount(gomock.Any(), from).
+		Return(authtypes.NewBaseAccountWithAddress(from)).
+		Times(1)
+	accountKeeper.EXPECT().
+		GetAccount(gomock.Any(), poolAccount.GetAddress()).
+		Return(poolAccount).
+		Times(1)
+
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(50))
+	_, err := server.ForceTransfer(ctx, types.NewMsgForceTransfer(denomAuth.BankAdmin, token, from.String(), poolAccount.GetAddress().String()))
+	require.ErrorIs(t, err, types.ErrForceTransferModuleAccount)
+}
+
+func TestMsgServer_ForceTransfer_BlockedAddress(t *testing.T) {
+	// Test case: the funds can not be force transferred to a blocked address
+
+	factoryKeeper, ctx, bankKeeper, accountKeeper, denom, denomAuth := setupForceTransferTest(t, true)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	from := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	to := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	accountKeeper.EXPECT().GetAccount(gomock.Any(), from).Return(authtypes.NewBaseAccountWithAddress(from)).Times(1)
+	accountKeeper.EXPECT().GetAccount(gomock.Any(), to).Return(nil).Times(1)
+	bankKeeper.EXPECT().BlockedAddr(to).Return(true).Times(1)
+
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(50))
+	_, err := server.ForceTransfer(ctx, types.NewMsgForceTransfer(denomAuth.BankAdmin, token, from.String(), to.String()))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) FreezeAccount(goCtx context.Context, msg *types.MsgFreezeAccount) (*types.MsgFreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the signer has permission to freeze accounts
+	if err := k.Auth(ctx, msg.Denom, types.RolePauser, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// Module accounts such as the dex pools hold the funds of many users,
+	// freezing one would lock them all
+	if _, ok := k.accountKeeper.GetAccount(ctx, address).(sdk.ModuleAccountI); ok {
+		return nil, errorsmod.Wrapf(
+			types.ErrCannotFreezeModuleAccount,
+			"account (%s)",
+			msg.Address,
+		)
+	}
+
+	k.SetFrozenAccount(ctx, msg.Denom, msg.Address)
+
+	events.EmitAccountFrozen(ctx, msg.Signer, msg.Denom, msg.Address)
+
+	return &types.MsgFreezeAccountResponse{
+		Denom:   msg.Denom,
+		Address: msg.Address,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+// GrantDenomRole grants a role of a denom to an address, only the denom admin can grant roles
+func (k msgServer) GrantDenomRole(goCtx context.Context, msg *types.MsgGrantDenomRole) (*types.MsgGrantDenomRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := types.ValidateGrantableRole(msg.Role); err != nil {
+		return nil, err
+	}
+
+	// Check if the signer has permission to manage the roles
+	if err := k.Auth(ctx, msg.Denom, types.RoleAdmin, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	k.SetDenomRole(ctx, msg.Denom, msg.Role, msg.Address)
+
+	events.EmitDenomRoleGranted(ctx, msg.Signer, types.DenomRole{
+		Denom:   msg.Denom,
+		Role:    msg.Role,
+		Address: msg.Address,
+	})
+
+	return &types.MsgGrantDenomRoleResponse{}, nil
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) LaunchToken(goCtx context.Context, msg *types.MsgLaunchToken) (*types.MsgLaunchTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.LaunchpadEnabled() {
+		return nil, errorsmod.Wrap(types.ErrLaunchpadDisabled, "the launchpad params are not set")
+	}
+
+	fullDenom, err := types.GetTokenDenom(msg.Creator, msg.SubDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	// The curve of the launch is set by the current params, check it before creating the denom
+	launch := types.NewTokenLaunch(fullDenom, msg.Creator, msg.Supply, msg.CurveSupply, params)
+	if err = launch.Validate(); err != nil {
+		return nil, err
+	}
+
+	// The denom is created as any other, charging the creation fee to the creator,
+	// with the fixed supply as minting cap
+	if _, err = k.createDenom(ctx, msg.CreateDenomMsg()); err != nil {
+		return nil, err
+	}
+
+	// The whole supply is sold on the curve or pooled, so the denom has no bank admin
+	// to mint, freeze or tax it, the creator keeps the metadata
+	k.SetDenomAuth(ctx, types.DenomAuth{
+		Denom:         fullDenom,
+		BankAdmin:     "",
+		MetadataAdmin: msg.Creator,
+	})
+
+	denom, _ := k.GetDenom(ctx, fullDenom)
+	supply := sdk.NewCoins(sdk.NewCoin(fullDenom, msg.Supply))
+
+	if err = k.bankKeeper.MintCoins(ctx, types.ModuleName, supply); err != nil {
+		return nil, err
+	}
+
+	if err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LaunchpadEscrowName, supply); err != nil {
+		return nil, err
+	}
+
+	k.recordMint(ctx, denom, nil, math.NewUintFromBigInt(msg.Supply.BigInt()))
+
+	k.SetTokenLaunch(ctx, launch)
+
+	events.EmitTokenLaunched(ctx, launch)
+
+	return &types.MsgLaunchTokenResponse{
+		Launch: launch,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) MintAndSendBatch(goCtx context.Context, msg *types.MsgMintAndSendBatch) (*types.MsgMintAndSendBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// The whole batch is checked once against the minting cap, the allowance of a minter
+	// and the mint rate limit, then minted at once
+	token := sdk.NewCoin(msg.Denom, math.NewIntFromBigInt(msg.Total().BigInt()))
+
+	currentDenom, minter, err := k.authorizeMint(ctx, msg.Signer, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(token)); err != nil {
+		return nil, err
+	}
+
+	for _, output := range msg.Outputs {
+		recipientAddress, err := sdk.AccAddressFromBech32(output.Recipient)
+		if err != nil {
+			return nil, err
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(msg.Denom, math.NewIntFromBigInt(output.Amount.BigInt())))
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddress, coins); err != nil {
+			return nil, err
+		}
+	}
+
+	denom := k.recordMint(ctx, currentDenom, minter, math.NewUintFromBigInt(token.Amount.BigInt()))
+
+	minted := sdk.NewCoin(denom.Denom, math.NewIntFromBigInt(denom.Minted.BigInt()))
+
+	// Get total supply from the bank keeper
+	totalSupply := k.bankKeeper.GetSupply(ctx, denom.Denom)
+
+	// A single event for the whole batch rather than one per recipient
+	events.EmitDenomBatchMinted(ctx, msg.Signer, token, msg.Outputs, minted, totalSupply)
+
+	return &types.MsgMintAndSendBatchResponse{
+		TokenMinted:    token,
+		RecipientCount: uint64(len(msg.Outputs)),
+		TotalMinted:    minted,
+		TotalSupply:    totalSupply,
+	}, nil
+}
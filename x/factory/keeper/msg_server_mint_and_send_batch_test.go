@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"zigchain/testutil/sample"
+	"zigchain/x/factory/keeper"
+	"zigchain/x/factory/types"
+)
+
+// Positive test cases
+
+func TestMsgServer_MintAndSendBatch(t *testing.T) {
+	// Test case: mint once for the whole batch and send each output to its recipient
+
+	factoryKeeper, ctx, bankKeeper, _, denom, denomAuth := setupMintVestedTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	outputs := []types.MintOutput{
+		{Recipient: sample.AccAddress(), Amount: cosmosmath.NewUint(100)},
+		{Recipient: sample.AccAddress(), Amount: cosmosmath.NewUint(200)},
+		{Recipient: sample.AccAddress(), Amount: cosmosmath.NewUint(300)},
+	}
+	total := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(600))
+
+	bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(total)).Return(nil).Times(1)
+	for _, output := range outputs {
+		bankKeeper.EXPECT().
+			SendCoinsFromModuleToAccount(
+				gomock.Any(),
+				types.ModuleName,
+				sdk.MustAccAddressFromBech32(output.Recipient),
+				sdk.NewCoins(sdk.NewCoin(denom.Denom, cosmosmath.Int(output.Amount))),
+			).
+			Return(nil).
+			Times(1)
+	}
+	bankKeeper.EXPECT().GetSupply(gomock.Any(), denom.Denom).Return(total).Times(1)
+
+	resp, err := server.MintAndSendBatch(ctx, types.NewMsgMintAndSendBatch(denomAuth.BankAdmin, denom.Denom, outputs))
+	require.NoError(t, err)
+	require.Equal(t, total, resp.TokenMinted)
+	require.Equal(t, uint64(3), resp.RecipientCount)
+	require.Equal(t, total, resp.TotalMinted)
+	require.Equal(t, total, resp.TotalSupply)
+
+	gotDenom, found := factoryKeeper.GetDenom(ctx, denom.Denom)
+	require.True(t, found)
+	require.Equal(t, cosmosmath.NewUint(600), gotDenom.Minted)
+
+	// a single compact event for the whole batch
+	events := ctx.EventManager().Events()
+	event := events[len(events)-1]
+	require.Equal(t, types.EventDenomBatchMinted, event.Type)
+
+	attributes := make(map[string]string)
+	for _, attribute := range event.Attributes {
+		attributes[attribute.Key] = attribute.Value
+	}
+	require.Equal(t, "3", attributes[types.AttributeKeyRecipientCount])
+	require.Equal(t, "600", attributes[types.AttributeKeyAmount])
+	require.Equal(t, outputs[0].Recipient+":100", strings.Split(attributes[types.AttributeKeyOutputs], ",")[0])
+}
+
+// Negative test cases
+
+func TestMsgServer_MintAndSendBatch_ExceedsMintingCap(t *testing.T) {
+	// Test case: the minting cap is checked against the total of the batch
+
+	factoryKeeper, ctx, _, _, denom, denomAuth := setupMintVestedTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	outputs := []types.MintOutput{
+		{Recipient: sample.AccAddress(), Amount: cosmosmath.NewUint(600)},
+		{Recipient: sample.AccAddress(), Amount: cosmosmath.NewUint(600)},
+	}
+
+	_, err := server.MintAndSendBatch(ctx, types.NewMsgMintAndSendBatch(denomAuth.BankAdmin, denom.Denom, outputs))
+	require.Error(t, err)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Contains(t, err.Error(), "would exceed Minting Cap")
+
+	gotDenom, found := factoryKeeper.GetDenom(ctx, denom.Denom)
+	require.True(t, found)
+	require.Equal(t, cosmosmath.ZeroUint(), gotDenom.Minted)
+}
+
+func TestMsgServer_MintAndSendBatch_Unauthorized(t *testing.T) {
+	// Test case: only the bank admin or a minter can mint a batch
+
+	factoryKeeper, ctx, _, _, denom, _ := setupMintVestedTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	outputs := []types.MintOutput{
+		{Recipient: sample.AccAddress(), Amount: cosmosmath.NewUint(100)},
+	}
+
+	_, err := server.MintAndSendBatch(ctx, types.NewMsgMintAndSendBatch(sample.AccAddress(), denom.Denom, outputs))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestMsgServer_MintAndSendBatch_SendFails(t *testing.T) {
+	// Test case: the minted amount is not recorded when a send fails
+
+	factoryKeeper, ctx, bankKeeper, _, denom, denomAuth := setupMintVestedTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	outputs := []types.MintOutput{
+		{Recipient: sample.AccAddress(), Amount: cosmosmath.NewUint(100)},
+	}
+
+	bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).
+		Return(errors.New("send failed")).
+		Times(1)
+
+	_, err := server.MintAndSendBatch(ctx, types.NewMsgMintAndSendBatch(denomAuth.BankAdmin, denom.Denom, outputs))
+	require.EqualError(t, err, "send failed")
+
+	gotDenom, found := factoryKeeper.GetDenom(ctx, denom.Denom)
+	require.True(t, found)
+	require.Equal(t, cosmosmath.ZeroUint(), gotDenom.Minted)
+}
@@ -2,11 +2,8 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
-	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"zigchain/x/factory/events"
 	"zigchain/x/factory/types"
@@ -17,38 +14,12 @@ import (
 func (k msgServer) MintAndSendTokens(goCtx context.Context, msg *types.MsgMintAndSendTokens) (*types.MsgMintAndSendTokensResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// Check if the value exists
-	currentDenom, isFound := k.GetDenom(
-		ctx,
-		msg.Token.Denom,
-	)
-	if !isFound {
-		return nil,
-			errorsmod.Wrap(
-				sdkerrors.ErrKeyNotFound,
-				fmt.Sprintf("Token: %s does not exist", msg.Token.Denom),
-			)
-	}
-
-	// Checks if signer has permission to mint tokens
-	if err := k.Auth(ctx, msg.Token.Denom, "bank", msg.Signer); err != nil {
+	// Check the denom, the permission of the signer, the minting cap and the mint rate limit
+	currentDenom, minter, err := k.authorizeMint(ctx, msg.Signer, msg.Token)
+	if err != nil {
 		return nil, err
 	}
 
-	// calculate new supply
-	totalMinted := currentDenom.Minted.Add(math.Uint(msg.Token.Amount))
-	if totalMinted.GT(currentDenom.MintingCap) {
-		return nil,
-			errorsmod.Wrap(
-				sdkerrors.ErrInvalidRequest,
-				fmt.Sprintf(
-					"Minting %s would exceed Minting Cap of %s",
-					msg.Token.String(),
-					currentDenom.MintingCap.String(),
-				),
-			)
-	}
-
 	recipientAddress, err := sdk.AccAddressFromBech32(msg.Recipient)
 	if err != nil {
 		return nil, err
@@ -64,19 +35,9 @@ func (k msgServer) MintAndSendTokens(goCtx context.Context, msg *types.MsgMintAn
 		return nil, err
 	}
 
-	var denom = types.Denom{
-		Creator:             currentDenom.Creator,
-		Denom:               currentDenom.Denom,
-		MintingCap:          currentDenom.MintingCap,
-		Minted:              currentDenom.Minted.Add(math.Uint(msg.Token.Amount)),
-		CanChangeMintingCap: currentDenom.CanChangeMintingCap,
-	}
+	denom := k.recordMint(ctx, currentDenom, minter, math.NewUintFromBigInt(msg.Token.Amount.BigInt()))
 
-	k.SetDenom(
-		ctx,
-		denom,
-	)
-	minted := sdk.NewCoin(denom.Denom, math.Int(denom.Minted))
+	minted := sdk.NewCoin(denom.Denom, math.NewIntFromBigInt(denom.Minted.BigInt()))
 
 	// Get total supply from the bank keeper
 	totalSupply := k.bankKeeper.GetSupply(ctx, denom.Denom)
@@ -0,0 +1,235 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zigchain/testutil/sample"
+	"zigchain/x/factory/keeper"
+	"zigchain/x/factory/types"
+)
+
+func mintRateLimitConfig(limit uint64, window uint64, raiseDelay uint64) types.MintRateLimitConfig {
+	return types.MintRateLimitConfig{
+		Limit:      cosmosmath.NewUint(limit),
+		Window:     window,
+		RaiseDelay: raiseDelay,
+	}
+}
+
+// Positive test cases
+
+func TestMsgServer_MintRateLimit_Positive(t *testing.T) {
+	// Test case: mints are limited within the rolling window and the capacity
+	// comes back once the mints have left the window
+
+	factoryKeeper, ctx, bankKeeper, denom, denomAuth := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+
+	recipient := sample.AccAddress()
+	config := mintRateLimitConfig(300, 3600, 600)
+
+	resp, err := server.SetMintRateLimit(ctx, types.NewMsgSetMintRateLimit(denomAuth.BankAdmin, denom.Denom, config))
+	require.NoError(t, err)
+	require.Equal(t, types.MintRateLimit{Denom: denom.Denom, Config: config}, resp.MintRateLimit)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventMintRateLimitSet, events[len(events)-1].Type)
+
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(200))
+	expectMint(bankKeeper, token, recipient)
+
+	_, err = server.MintAndSendTokens(ctx, types.NewMsgMintAndSendTokens(denomAuth.BankAdmin, token, recipient))
+	require.NoError(t, err)
+
+	// the window is used by the bank admin mints too
+	_, err = server.MintAndSendTokens(ctx, types.NewMsgMintAndSendTokens(denomAuth.BankAdmin, sdk.NewCoin(denom.Denom, cosmosmath.NewInt(150)), recipient))
+	require.ErrorIs(t, err, types.ErrMintRateLimitExceeded)
+
+	query, err := factoryKeeper.MintRateLimit(ctx, &types.QueryMintRateLimitRequest{Denom: denom.Denom})
+	require.NoError(t, err)
+	require.Equal(t, cosmosmath.NewUint(200), query.Minted)
+	require.Equal(t, cosmosmath.NewUint(100), query.Remaining)
+
+	// still within the window
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(3000 * time.Second))
+	_, err = server.MintAndSendTokens(ctx, types.NewMsgMintAndSendTokens(denomAuth.BankAdmin, sdk.NewCoin(denom.Denom, cosmosmath.NewInt(150)), recipient))
+	require.ErrorIs(t, err, types.ErrMintRateLimitExceeded)
+
+	// the bucket of the first mint has left the window
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(800 * time.Second))
+	token = sdk.NewCoin(denom.Denom, cosmosmath.NewInt(300))
+	expectMint(bankKeeper, token, recipient)
+
+	_, err = server.MintAndSendTokens(ctx, types.NewMsgMintAndSendTokens(denomAuth.BankAdmin, token, recipient))
+	require.NoError(t, err)
+
+	// the expired bucket is pruned
+	buckets := factoryKeeper.GetAllMintRateBucket(ctx)
+	require.Len(t, buckets, 1)
+	require.Equal(t, cosmosmath.NewUint(300), buckets[0].Amount)
+
+	query, err = factoryKeeper.MintRateLimit(ctx, &types.QueryMintRateLimitRequest{Denom: denom.Denom})
+	require.NoError(t, err)
+	require.Equal(t, cosmosmath.ZeroUint(), query.Remaining)
+}
+
+func TestMsgServer_MintRateLimit_RaiseDelayed(t *testing.T) {
+	// Test case: a raised limit is pending until the raise delay has passed
+
+	factoryKeeper, ctx, bankKeeper, denom, denomAuth := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+
+	recipient := sample.AccAddress()
+	current := mintRateLimitConfig(100, 3600, 600)
+	raised := mintRateLimitConfig(500, 3600, 600)
+
+	_, err := server.SetMintRateLimit(ctx, types.NewMsgSetMintRateLimit(denomAuth.BankAdmin, denom.Denom, current))
+	require.NoError(t, err)
+
+	resp, err := server.SetMintRateLimit(ctx, types.NewMsgSetMintRateLimit(denomAuth.BankAdmin, denom.Denom, raised))
+	require.NoError(t, err)
+	require.Equal(t, current, resp.MintRateLimit.Config)
+	require.Equal(t, &raised, resp.MintRateLimit.PendingConfig)
+	require.Equal(t, ctx.BlockTime().Unix()+600, resp.MintRateLimit.PendingTime)
+
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(200))
+	_, err = server.MintAndSendTokens(ctx, types.NewMsgMintAndSendTokens(denomAuth.BankAdmin, token, recipient))
+	require.ErrorIs(t, err, types.ErrMintRateLimitExceeded)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(600 * time.Second))
+
+	// the query shows the raised limit once it is due
+	query, err := factoryKeeper.MintRateLimit(ctx, &types.QueryMintRateLimitRequest{Denom: denom.Denom})
+	require.NoError(t, err)
+	require.Equal(t, raised, query.MintRateLimit.Config)
+	require.Equal(t, cosmosmath.NewUint(500), query.Remaining)
+
+	expectMint(bankKeeper, token, recipient)
+	_, err = server.MintAndSendTokens(ctx, types.NewMsgMintAndSendTokens(denomAuth.BankAdmin, token, recipient))
+	require.NoError(t, err)
+
+	rateLimit, found := factoryKeeper.GetMintRateLimit(ctx, denom.Denom)
+	require.True(t, found)
+	require.Equal(t, types.MintRateLimit{Denom: denom.Denom, Config: raised}, rateLimit)
+}
+
+func TestMsgServer_MintRateLimit_LooserChanges(t *testing.T) {
+	// Test case: a shorter window or a shorter raise delay is a raise too,
+	// a zero raise delay lets a raise take effect right away
+
+	tests := []struct {
+		name    string
+		current types.MintRateLimitConfig
+		next    types.MintRateLimitConfig
+		pending bool
+	}{
+		{
+			name:    "shorter window",
+			current: mintRateLimitConfig(100, 3600, 600),
+			next:    mintRateLimitConfig(100, 60, 600),
+			pending: true,
+		},
+		{
+			name:    "shorter raise delay",
+			current: mintRateLimitConfig(100, 3600, 600),
+			next:    mintRateLimitConfig(100, 3600, 0),
+			pending: true,
+		},
+		{
+			name:    "longer raise delay",
+			current: mintRateLimitConfig(100, 3600, 600),
+			next:    mintRateLimitConfig(100, 3600, 6000),
+			pending: false,
+		},
+		{
+			name:    "zero raise delay",
+			current: mintRateLimitConfig(100, 3600, 0),
+			next:    mintRateLimitConfig(500, 3600, 0),
+			pending: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			factoryKeeper, ctx, _, denom, denomAuth := setupMinterTest(t)
+			server := keeper.NewMsgServerImpl(factoryKeeper)
+			ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+
+			_, err := server.SetMintRateLimit(ctx, types.NewMsgSetMintRateLimit(denomAuth.BankAdmin, denom.Denom, test.current))
+			require.NoError(t, err)
+
+			resp, err := server.SetMintRateLimit(ctx, types.NewMsgSetMintRateLimit(denomAuth.BankAdmin, denom.Denom, test.next))
+			require.NoError(t, err)
+
+			if test.pending {
+				require.Equal(t, test.current, resp.MintRateLimit.Config)
+				require.Equal(t, &test.next, resp.MintRateLimit.PendingConfig)
+			} else {
+				require.Equal(t, test.next, resp.MintRateLimit.Config)
+				require.Nil(t, resp.MintRateLimit.PendingConfig)
+			}
+		})
+	}
+}
+
+func TestMsgServer_MintRateLimit_LowerCancelsPendingRaise(t *testing.T) {
+	// Test case: a lower limit takes effect right away and drops the pending raise
+
+	factoryKeeper, ctx, _, denom, denomAuth := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+
+	_, err := server.SetMintRateLimit(ctx, types.NewMsgSetMintRateLimit(denomAuth.BankAdmin, denom.Denom, mintRateLimitConfig(100, 3600, 600)))
+	require.NoError(t, err)
+
+	_, err = server.SetMintRateLimit(ctx, types.NewMsgSetMintRateLimit(denomAuth.BankAdmin, denom.Denom, mintRateLimitConfig(500, 3600, 600)))
+	require.NoError(t, err)
+
+	lower := mintRateLimitConfig(50, 3600, 600)
+	resp, err := server.SetMintRateLimit(ctx, types.NewMsgSetMintRateLimit(denomAuth.BankAdmin, denom.Denom, lower))
+	require.NoError(t, err)
+	require.Equal(t, types.MintRateLimit{Denom: denom.Denom, Config: lower}, resp.MintRateLimit)
+}
+
+// Negative test cases
+
+func TestMsgServer_MintRateLimit_Unauthorized(t *testing.T) {
+	// Test case: only the bank admin can set the mint rate limit, minters included
+
+	factoryKeeper, ctx, _, denom, _ := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	minter := sample.AccAddress()
+	factoryKeeper.SetMinter(ctx, types.Minter{Denom: denom.Denom, Address: minter, Allowance: cosmosmath.NewUint(10)})
+
+	_, err := server.SetMintRateLimit(ctx, types.NewMsgSetMintRateLimit(minter, denom.Denom, mintRateLimitConfig(100, 3600, 600)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, found := factoryKeeper.GetMintRateLimit(ctx, denom.Denom)
+	require.False(t, found)
+}
+
+func TestQueryMintRateLimit_Negative(t *testing.T) {
+	// Test case: invalid requests and denoms without a mint rate limit
+
+	factoryKeeper, ctx, _, denom, _ := setupMinterTest(t)
+
+	_, err := factoryKeeper.MintRateLimit(ctx, nil)
+	require.Equal(t, status.Error(codes.InvalidArgument, "invalid request"), err)
+
+	_, err = factoryKeeper.MintRateLimit(ctx, &types.QueryMintRateLimitRequest{Denom: "!"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = factoryKeeper.MintRateLimit(ctx, &types.QueryMintRateLimitRequest{Denom: denom.Denom})
+	require.Equal(t, status.Error(codes.NotFound, "not found"), err)
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) MintVested(goCtx context.Context, msg *types.MsgMintVested) (*types.MsgMintVestedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// The vested mint counts like any other mint against the minting cap,
+	// the allowance of a minter and the mint rate limit
+	currentDenom, minter, err := k.authorizeMint(ctx, msg.Signer, msg.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	recipientAddress, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	mintCoins := sdk.NewCoins(msg.Token)
+
+	if err = k.bankKeeper.MintCoins(ctx, types.ModuleName, mintCoins); err != nil {
+		return nil, err
+	}
+
+	grant := types.VestingGrant{
+		Denom:     msg.Token.Denom,
+		Recipient: msg.Recipient,
+		Amount:    math.NewUintFromBigInt(msg.Token.Amount.BigInt()),
+		Schedule:  msg.Schedule,
+		Claimed:   math.ZeroUint(),
+	}
+
+	// A new account becomes a vesting account of the auth module, an existing one can not
+	// be turned into a vesting account so its tokens are held by the vesting escrow instead
+	if k.accountKeeper.GetAccount(ctx, recipientAddress) == nil {
+		if err = k.createVestingAccount(ctx, recipientAddress, mintCoins, msg.Schedule); err != nil {
+			return nil, err
+		}
+
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddress, mintCoins); err != nil {
+			return nil, err
+		}
+	} else {
+		grant.Escrow = true
+
+		if err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.VestingEscrowName, mintCoins); err != nil {
+			return nil, err
+		}
+	}
+
+	k.recordMint(ctx, currentDenom, minter, math.NewUintFromBigInt(msg.Token.Amount.BigInt()))
+
+	grant.Id = k.nextVestingGrantID(ctx)
+	k.SetVestingGrant(ctx, grant)
+
+	events.EmitVestedMinted(ctx, msg.Signer, grant)
+
+	return &types.MsgMintVestedResponse{
+		Grant: grant,
+	}, nil
+}
+
+// createVestingAccount creates a continuous or periodic vesting account of the auth module for
+// the coins, they still have to be sent to the account
+func (k Keeper) createVestingAccount(
+	ctx context.Context,
+	address sdk.AccAddress,
+	coins sdk.Coins,
+	schedule types.VestingSchedule,
+) error {
+	baseAccount, ok := k.accountKeeper.NewAccount(ctx, authtypes.NewBaseAccountWithAddress(address)).(*authtypes.BaseAccount)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidVestingSchedule, "unexpected account type for %s", address)
+	}
+
+	var vestingAccount sdk.AccountI
+	var err error
+	if schedule.IsPeriodic() {
+		periods := make(vestingtypes.Periods, len(schedule.Periods))
+		for i, period := range schedule.Periods {
+			periods[i] = vestingtypes.Period{
+				Length: period.Length,
+				Amount: sdk.NewCoins(sdk.NewCoin(coins[0].Denom, math.NewIntFromBigInt(period.Amount.BigInt()))),
+			}
+		}
+		vestingAccount, err = vestingtypes.NewPeriodicVestingAccount(baseAccount, coins, schedule.StartTime, periods)
+	} else {
+		vestingAccount, err = vestingtypes.NewContinuousVestingAccount(baseAccount, coins, schedule.StartTime, schedule.EndTime)
+	}
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidVestingSchedule, err.Error())
+	}
+
+	k.accountKeeper.SetAccount(ctx, vestingAccount)
+
+	return nil
+}
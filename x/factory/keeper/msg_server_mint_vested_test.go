@@ -0,0 +1,322 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "zigchain/testutil/keeper"
+	"zigchain/testutil/sample"
+	"zigchain/x/factory/keeper"
+	"zigchain/x/factory/testutil"
+	"zigchain/x/factory/types"
+)
+
+func setupMintVestedTest(t *testing.T) (keeper.Keeper, sdk.Context, *testutil.MockBankKeeper, *testutil.MockAccountKeeper, types.Denom, types.DenomAuth) {
+	ctrl := gomock.NewController(t)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+
+	factoryKeeper, ctx := keepertest.FactoryKeeper(t, bankKeeper, accountKeeper)
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+
+	creator := sample.AccAddress()
+	denom := types.Denom{
+		Creator:             creator,
+		Denom:               "coin." + creator + ".abc",
+		MintingCap:          cosmosmath.NewUint(1000),
+		Minted:              cosmosmath.NewUint(0),
+		CanChangeMintingCap: true,
+	}
+	factoryKeeper.SetDenom(ctx, denom)
+
+	denomAuth := types.DenomAuth{
+		Denom:         denom.Denom,
+		BankAdmin:     creator,
+		MetadataAdmin: creator,
+	}
+	factoryKeeper.SetDenomAuth(ctx, denomAuth)
+
+	return factoryKeeper, ctx, bankKeeper, accountKeeper, denom, denomAuth
+}
+
+// Positive test cases
+
+func TestMsgServer_MintVested_NewAccount(t *testing.T) {
+	// Test case: minting to a new account creates a continuous vesting account
+
+	factoryKeeper, ctx, bankKeeper, accountKeeper, denom, denomAuth := setupMintVestedTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	recipient := sample.AccAddress()
+	recipientAddress := sdk.MustAccAddressFromBech32(recipient)
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(600))
+	schedule := types.VestingSchedule{StartTime: 1_000_000, EndTime: 1_000_600}
+
+	accountKeeper.EXPECT().GetAccount(gomock.Any(), recipientAddress).Return(nil).Times(1)
+	accountKeeper.EXPECT().
+		NewAccount(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, account sdk.AccountI) sdk.AccountI {
+			require.NoError(t, account.SetAccountNumber(7))
+			return account
+		}).
+		Times(1)
+
+	var created sdk.AccountI
+	accountKeeper.EXPECT().
+		SetAccount(gomock.Any(), gomock.Any()).
+		Do(func(_ sdk.Context, account sdk.AccountI) { created = account }).
+		Times(1)
+
+	bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(token)).Return(nil).Times(1)
+	bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipientAddress, sdk.NewCoins(token)).
+		Return(nil).
+		Times(1)
+
+	resp, err := server.MintVested(ctx, types.NewMsgMintVested(denomAuth.BankAdmin, token, recipient, schedule))
+	require.NoError(t, err)
+	require.Equal(t, types.VestingGrant{
+		Denom:     denom.Denom,
+		Id:        0,
+		Recipient: recipient,
+		Amount:    cosmosmath.NewUint(600),
+		Schedule:  schedule,
+		Escrow:    false,
+		Claimed:   cosmosmath.ZeroUint(),
+	}, resp.Grant)
+
+	vestingAccount, ok := created.(*vestingtypes.ContinuousVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, recipientAddress, vestingAccount.GetAddress())
+	require.Equal(t, uint64(7), vestingAccount.GetAccountNumber())
+	require.Equal(t, sdk.NewCoins(token), vestingAccount.OriginalVesting)
+	require.Equal(t, schedule.StartTime, vestingAccount.StartTime)
+	require.Equal(t, schedule.EndTime, vestingAccount.EndTime)
+
+	gotDenom, found := factoryKeeper.GetDenom(ctx, denom.Denom)
+	require.True(t, found)
+	require.Equal(t, cosmosmath.NewUint(600), gotDenom.Minted)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventVestedMinted, events[len(events)-1].Type)
+
+	// the grant vests in the account, there is nothing to claim
+	_, err = server.ClaimVested(ctx.WithBlockTime(time.Unix(1_000_600, 0)), types.NewMsgClaimVested(recipient, denom.Denom, 0))
+	require.ErrorIs(t, err, types.ErrNothingToClaim)
+
+	query, err := factoryKeeper.OutstandingVesting(ctx.WithBlockTime(time.Unix(1_000_150, 0)), &types.QueryOutstandingVestingRequest{Denom: denom.Denom})
+	require.NoError(t, err)
+	require.Equal(t, cosmosmath.NewUint(600), query.Total)
+	require.Equal(t, cosmosmath.NewUint(450), query.Outstanding)
+	require.Equal(t, cosmosmath.ZeroUint(), query.Escrowed)
+}
+
+func TestMsgServer_MintVested_NewAccountPeriodic(t *testing.T) {
+	// Test case: minting to a new account with periods creates a periodic vesting account
+
+	factoryKeeper, ctx, bankKeeper, accountKeeper, denom, denomAuth := setupMintVestedTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	recipient := sample.AccAddress()
+	recipientAddress := sdk.MustAccAddressFromBech32(recipient)
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(300))
+	schedule := types.VestingSchedule{
+		StartTime: 1_000_000,
+		Periods: []types.VestingPeriod{
+			{Length: 100, Amount: cosmosmath.NewUint(100)},
+			{Length: 50, Amount: cosmosmath.NewUint(200)},
+		},
+	}
+
+	accountKeeper.EXPECT().GetAccount(gomock.Any(), recipientAddress).Return(nil).Times(1)
+	accountKeeper.EXPECT().
+		NewAccount(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, account sdk.AccountI) sdk.AccountI { return account }).
+		Times(1)
+
+	var created sdk.AccountI
+	accountKeeper.EXPECT().
+		SetAccount(gomock.Any(), gomock.Any()).
+		Do(func(_ sdk.Context, account sdk.AccountI) { created = account }).
+		Times(1)
+
+	bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(token)).Return(nil).Times(1)
+	bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipientAddress, sdk.NewCoins(token)).
+		Return(nil).
+		Times(1)
+
+	_, err := server.MintVested(ctx, types.NewMsgMintVested(denomAuth.BankAdmin, token, recipient, schedule))
+	require.NoError(t, err)
+
+	vestingAccount, ok := created.(*vestingtypes.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, []vestingtypes.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin(denom.Denom, cosmosmath.NewInt(100)))},
+		{Length: 50, Amount: sdk.NewCoins(sdk.NewCoin(denom.Denom, cosmosmath.NewInt(200)))},
+	}, vestingAccount.VestingPeriods)
+	require.Equal(t, int64(1_000_150), vestingAccount.EndTime)
+}
+
+func TestMsgServer_MintVested_Escrow(t *testing.T) {
+	// Test case: minting to an existing account escrows the grant, the recipient claims
+	// the vested amount after the cliff
+
+	factoryKeeper, ctx, bankKeeper, accountKeeper, denom, denomAuth := setupMintVestedTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	recipient := sample.AccAddress()
+	recipientAddress := sdk.MustAccAddressFromBech32(recipient)
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(300))
+	schedule := types.VestingSchedule{
+		StartTime: 1_000_000,
+		Periods: []types.VestingPeriod{
+			{Length: 100, Amount: cosmosmath.NewUint(100)},
+			{Length: 50, Amount: cosmosmath.NewUint(200)},
+		},
+	}
+
+	accountKeeper.EXPECT().
+		GetAccount(gomock.Any(), recipientAddress).
+		Return(authtypes.NewBaseAccountWithAddress(recipientAddress)).
+		Times(1)
+
+	bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(token)).Return(nil).Times(1)
+	bankKeeper.EXPECT().
+		SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, types.VestingEscrowName, sdk.NewCoins(token)).
+		Return(nil).
+		Times(1)
+
+	resp, err := server.MintVested(ctx, types.NewMsgMintVested(denomAuth.BankAdmin, token, recipient, schedule))
+	require.NoError(t, err)
+	require.True(t, resp.Grant.Escrow)
+
+	// before the cliff
+	ctx = ctx.WithBlockTime(time.Unix(1_000_099, 0))
+	_, err = server.ClaimVested(ctx, types.NewMsgClaimVested(recipient, denom.Denom, resp.Grant.Id))
+	require.ErrorIs(t, err, types.ErrNothingToClaim)
+
+	// only the recipient can claim
+	ctx = ctx.WithBlockTime(time.Unix(1_000_100, 0))
+	_, err = server.ClaimVested(ctx, types.NewMsgClaimVested(denomAuth.BankAdmin, denom.Denom, resp.Grant.Id))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	claimed := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(100))
+	bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.VestingEscrowName, recipientAddress, sdk.NewCoins(claimed)).
+		Return(nil).
+		Times(1)
+
+	claimResp, err := server.ClaimVested(ctx, types.NewMsgClaimVested(recipient, denom.Denom, resp.Grant.Id))
+	require.NoError(t, err)
+	require.Equal(t, claimed, claimResp.Claimed)
+	require.Equal(t, cosmosmath.NewUint(100), claimResp.Grant.Claimed)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventVestedClaimed, events[len(events)-1].Type)
+
+	// the vested amount is already claimed
+	_, err = server.ClaimVested(ctx, types.NewMsgClaimVested(recipient, denom.Denom, resp.Grant.Id))
+	require.ErrorIs(t, err, types.ErrNothingToClaim)
+
+	grantQuery, err := factoryKeeper.VestingGrant(ctx, &types.QueryVestingGrantRequest{Denom: denom.Denom, Id: resp.Grant.Id})
+	require.NoError(t, err)
+	require.Equal(t, cosmosmath.NewUint(100), grantQuery.Vested)
+	require.Equal(t, cosmosmath.ZeroUint(), grantQuery.Claimable)
+
+	outstanding, err := factoryKeeper.OutstandingVesting(ctx, &types.QueryOutstandingVestingRequest{Denom: denom.Denom})
+	require.NoError(t, err)
+	require.Equal(t, cosmosmath.NewUint(300), outstanding.Total)
+	require.Equal(t, cosmosmath.NewUint(200), outstanding.Outstanding)
+	require.Equal(t, cosmosmath.NewUint(200), outstanding.Escrowed)
+
+	grants, err := factoryKeeper.VestingGrants(ctx, &types.QueryVestingGrantsRequest{Denom: denom.Denom})
+	require.NoError(t, err)
+	require.Equal(t, []types.VestingGrant{claimResp.Grant}, grants.Grants)
+}
+
+func TestMsgServer_MintVested_Minter(t *testing.T) {
+	// Test case: a minter mints on a vesting schedule within its allowance
+
+	factoryKeeper, ctx, bankKeeper, accountKeeper, denom, _ := setupMintVestedTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	minter := sample.AccAddress()
+	factoryKeeper.SetMinter(ctx, types.Minter{Denom: denom.Denom, Address: minter, Allowance: cosmosmath.NewUint(500)})
+
+	recipient := sample.AccAddress()
+	recipientAddress := sdk.MustAccAddressFromBech32(recipient)
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(200))
+	schedule := types.VestingSchedule{StartTime: 1_000_000, EndTime: 1_000_600}
+
+	accountKeeper.EXPECT().
+		GetAccount(gomock.Any(), recipientAddress).
+		Return(authtypes.NewBaseAccountWithAddress(recipientAddress)).
+		Times(1)
+	bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(token)).Return(nil).Times(1)
+	bankKeeper.EXPECT().
+		SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, types.VestingEscrowName, sdk.NewCoins(token)).
+		Return(nil).
+		Times(1)
+
+	_, err := server.MintVested(ctx, types.NewMsgMintVested(minter, token, recipient, schedule))
+	require.NoError(t, err)
+
+	got, found := factoryKeeper.GetMinter(ctx, denom.Denom, minter)
+	require.True(t, found)
+	require.Equal(t, cosmosmath.NewUint(300), got.Allowance)
+}
+
+// Negative test cases
+
+func TestMsgServer_MintVested_ExceedsMintingCap(t *testing.T) {
+	// Test case: the vested mint counts against the minting cap
+
+	factoryKeeper, ctx, _, _, denom, denomAuth := setupMintVestedTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(1001))
+	schedule := types.VestingSchedule{StartTime: 1_000_000, EndTime: 1_000_600}
+
+	_, err := server.MintVested(ctx, types.NewMsgMintVested(denomAuth.BankAdmin, token, sample.AccAddress(), schedule))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(t, err, "would exceed Minting Cap")
+
+	require.Empty(t, factoryKeeper.GetAllVestingGrant(ctx))
+}
+
+func TestMsgServer_MintVested_Unauthorized(t *testing.T) {
+	// Test case: only the bank admin or a minter can mint on a vesting schedule
+
+	factoryKeeper, ctx, _, _, denom, _ := setupMintVestedTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(100))
+	schedule := types.VestingSchedule{StartTime: 1_000_000, EndTime: 1_000_600}
+
+	_, err := server.MintVested(ctx, types.NewMsgMintVested(sample.AccAddress(), token, sample.AccAddress(), schedule))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestMsgServer_ClaimVested_NotFound(t *testing.T) {
+	// Test case: claim a vesting grant that does not exist
+
+	factoryKeeper, ctx, _, _, denom, _ := setupMintVestedTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	_, err := server.ClaimVested(ctx, types.NewMsgClaimVested(sample.AccAddress(), denom.Denom, 3))
+	require.ErrorIs(t, err, types.ErrVestingGrantNotFound)
+
+	_, err = factoryKeeper.VestingGrant(ctx, &types.QueryVestingGrantRequest{Denom: denom.Denom, Id: 3})
+	require.Equal(t, status.Error(codes.NotFound, "not found"), err)
+}
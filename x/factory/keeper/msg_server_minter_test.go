@@ -0,0 +1,244 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "zigchain/testutil/keeper"
+	"zigchain/testutil/sample"
+	"zigchain/x/factory/keeper"
+	"zigchain/x/factory/testutil"
+	"zigchain/x/factory/types"
+)
+
+func setupMinterTest(t *testing.T) (keeper.Keeper, sdk.Context, *testutil.MockBankKeeper, types.Denom, types.DenomAuth) {
+	ctrl := gomock.NewController(t)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+
+	factoryKeeper, ctx := keepertest.FactoryKeeper(t, bankKeeper, nil)
+
+	creator := sample.AccAddress()
+	denom := types.Denom{
+		Creator:             creator,
+		Denom:               "coin." + creator + ".abc",
+		MintingCap:          cosmosmath.NewUint(1000),
+		Minted:              cosmosmath.NewUint(0),
+		CanChangeMintingCap: true,
+	}
+	factoryKeeper.SetDenom(ctx, denom)
+
+	denomAuth := types.DenomAuth{
+		Denom:         denom.Denom,
+		BankAdmin:     creator,
+		MetadataAdmin: creator,
+	}
+	factoryKeeper.SetDenomAuth(ctx, denomAuth)
+
+	return factoryKeeper, ctx, bankKeeper, denom, denomAuth
+}
+
+// expectMint sets the bank calls of a successful mint
+func expectMint(bankKeeper *testutil.MockBankKeeper, token sdk.Coin, recipient string) {
+	bankKeeper.EXPECT().
+		MintCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(token)).
+		Return(nil).
+		Times(1)
+	bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(recipient), sdk.NewCoins(token)).
+		Return(nil).
+		Times(1)
+	bankKeeper.EXPECT().
+		GetSupply(gomock.Any(), token.Denom).
+		Return(token).
+		Times(1)
+}
+
+// Positive test cases
+
+func TestMsgServer_Minter_Positive(t *testing.T) {
+	// Test case: the bank admin adds a minter, the minter mints within its allowance,
+	// the admin lowers the allowance and then revokes the minter
+
+	factoryKeeper, ctx, bankKeeper, denom, denomAuth := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	minter := sample.AccAddress()
+	recipient := sample.AccAddress()
+
+	resp, err := server.SetMinterAllowance(ctx, types.NewMsgSetMinterAllowance(denomAuth.BankAdmin, denom.Denom, minter, cosmosmath.NewUint(300)))
+	require.NoError(t, err)
+	require.Equal(t, types.Minter{Denom: denom.Denom, Address: minter, Allowance: cosmosmath.NewUint(300)}, resp.Minter)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventMinterAllowanceSet, events[len(events)-1].Type)
+
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(100))
+	expectMint(bankKeeper, token, recipient)
+
+	_, err = server.MintAndSendTokens(ctx, types.NewMsgMintAndSendTokens(minter, token, recipient))
+	require.NoError(t, err)
+
+	got, found := factoryKeeper.GetMinter(ctx, denom.Denom, minter)
+	require.True(t, found)
+	require.Equal(t, cosmosmath.NewUint(200), got.Allowance)
+
+	gotDenom, found := factoryKeeper.GetDenom(ctx, denom.Denom)
+	require.True(t, found)
+	require.Equal(t, cosmosmath.NewUint(100), gotDenom.Minted)
+
+	_, err = server.SetMinterAllowance(ctx, types.NewMsgSetMinterAllowance(denomAuth.BankAdmin, denom.Denom, minter, cosmosmath.NewUint(50)))
+	require.NoError(t, err)
+
+	got, found = factoryKeeper.GetMinter(ctx, denom.Denom, minter)
+	require.True(t, found)
+	require.Equal(t, cosmosmath.NewUint(50), got.Allowance)
+
+	_, err = server.RemoveMinter(ctx, types.NewMsgRemoveMinter(denomAuth.BankAdmin, denom.Denom, minter))
+	require.NoError(t, err)
+
+	_, found = factoryKeeper.GetMinter(ctx, denom.Denom, minter)
+	require.False(t, found)
+
+	// a revoked minter can not mint anymore
+	_, err = server.MintAndSendTokens(ctx, types.NewMsgMintAndSendTokens(minter, sdk.NewCoin(denom.Denom, cosmosmath.NewInt(1)), recipient))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestMsgServer_Minter_BankAdminNotLimited(t *testing.T) {
+	// Test case: the allowance of the bank admin registered as a minter is not used
+
+	factoryKeeper, ctx, bankKeeper, denom, denomAuth := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	factoryKeeper.SetMinter(ctx, types.Minter{Denom: denom.Denom, Address: denomAuth.BankAdmin, Allowance: cosmosmath.NewUint(10)})
+
+	recipient := sample.AccAddress()
+	token := sdk.NewCoin(denom.Denom, cosmosmath.NewInt(100))
+	expectMint(bankKeeper, token, recipient)
+
+	_, err := server.MintAndSendTokens(ctx, types.NewMsgMintAndSendTokens(denomAuth.BankAdmin, token, recipient))
+	require.NoError(t, err)
+
+	got, found := factoryKeeper.GetMinter(ctx, denom.Denom, denomAuth.BankAdmin)
+	require.True(t, found)
+	require.Equal(t, cosmosmath.NewUint(10), got.Allowance)
+}
+
+func TestQueryMinters_Positive(t *testing.T) {
+	// Test case: list the minters of a denom and get a single minter
+
+	factoryKeeper, ctx, _, denom, _ := setupMinterTest(t)
+
+	minters := []types.Minter{
+		{Denom: denom.Denom, Address: sample.AccAddress(), Allowance: cosmosmath.NewUint(1)},
+		{Denom: denom.Denom, Address: sample.AccAddress(), Allowance: cosmosmath.NewUint(2)},
+	}
+	for _, minter := range minters {
+		factoryKeeper.SetMinter(ctx, minter)
+	}
+	// a minter of another denom is not listed
+	factoryKeeper.SetMinter(ctx, types.Minter{Denom: "coin." + sample.AccAddress() + ".def", Address: sample.AccAddress(), Allowance: cosmosmath.NewUint(3)})
+
+	resp, err := factoryKeeper.Minters(ctx, &types.QueryMintersRequest{Denom: denom.Denom})
+	require.NoError(t, err)
+	require.ElementsMatch(t, minters, resp.Minters)
+
+	minter, err := factoryKeeper.Minter(ctx, &types.QueryMinterRequest{Denom: denom.Denom, Address: minters[1].Address})
+	require.NoError(t, err)
+	require.Equal(t, minters[1], minter.Minter)
+}
+
+// Negative test cases
+
+func TestMsgServer_Minter_AllowanceExceeded(t *testing.T) {
+	// Test case: a minter can not mint more than its allowance
+
+	factoryKeeper, ctx, _, denom, _ := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	minter := sample.AccAddress()
+	factoryKeeper.SetMinter(ctx, types.Minter{Denom: denom.Denom, Address: minter, Allowance: cosmosmath.NewUint(50)})
+
+	_, err := server.MintAndSendTokens(ctx, types.NewMsgMintAndSendTokens(minter, sdk.NewCoin(denom.Denom, cosmosmath.NewInt(51)), sample.AccAddress()))
+	require.ErrorIs(t, err, types.ErrMinterAllowanceExceeded)
+
+	got, found := factoryKeeper.GetMinter(ctx, denom.Denom, minter)
+	require.True(t, found)
+	require.Equal(t, cosmosmath.NewUint(50), got.Allowance)
+}
+
+func TestMsgServer_Minter_MintingCapExceeded(t *testing.T) {
+	// Test case: the minting cap of the denom applies to the minters
+
+	factoryKeeper, ctx, _, denom, _ := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	minter := sample.AccAddress()
+	factoryKeeper.SetMinter(ctx, types.Minter{Denom: denom.Denom, Address: minter, Allowance: cosmosmath.NewUint(5000)})
+
+	_, err := server.MintAndSendTokens(ctx, types.NewMsgMintAndSendTokens(minter, sdk.NewCoin(denom.Denom, cosmosmath.NewInt(1001)), sample.AccAddress()))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(t, err, "exceed Minting Cap")
+}
+
+func TestMsgServer_Minter_DenomLocked(t *testing.T) {
+	// Test case: the minters can not mint once the bank admin is disabled
+
+	factoryKeeper, ctx, _, denom, _ := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	minter := sample.AccAddress()
+	factoryKeeper.SetMinter(ctx, types.Minter{Denom: denom.Denom, Address: minter, Allowance: cosmosmath.NewUint(50)})
+	require.NoError(t, factoryKeeper.DisableDenomAuth(ctx, denom.Denom))
+
+	_, err := server.MintAndSendTokens(ctx, types.NewMsgMintAndSendTokens(minter, sdk.NewCoin(denom.Denom, cosmosmath.NewInt(1)), sample.AccAddress()))
+	require.ErrorIs(t, err, types.ErrDenomLocked)
+}
+
+func TestMsgServer_Minter_Unauthorized(t *testing.T) {
+	// Test case: only the bank admin can manage the minters, not even a minter
+
+	factoryKeeper, ctx, _, denom, _ := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	minter := sample.AccAddress()
+	factoryKeeper.SetMinter(ctx, types.Minter{Denom: denom.Denom, Address: minter, Allowance: cosmosmath.NewUint(50)})
+
+	_, err := server.SetMinterAllowance(ctx, types.NewMsgSetMinterAllowance(minter, denom.Denom, minter, cosmosmath.NewUint(500)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = server.RemoveMinter(ctx, types.NewMsgRemoveMinter(minter, denom.Denom, minter))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestMsgServer_RemoveMinter_NotFound(t *testing.T) {
+	// Test case: an address that is not a minter can not be removed
+
+	factoryKeeper, ctx, _, denom, denomAuth := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	_, err := server.RemoveMinter(ctx, types.NewMsgRemoveMinter(denomAuth.BankAdmin, denom.Denom, sample.AccAddress()))
+	require.ErrorIs(t, err, types.ErrMinterNotFound)
+}
+
+func TestQueryMinters_Invalid(t *testing.T) {
+	// Test case: query with invalid requests
+
+	factoryKeeper, ctx, _, denom, _ := setupMinterTest(t)
+
+	_, err := factoryKeeper.Minters(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = factoryKeeper.Minter(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = factoryKeeper.Minter(ctx, &types.QueryMinterRequest{Denom: denom.Denom, Address: sample.AccAddress()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -11,7 +11,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// ProposeDenomAdmin proposes a new denom bank admin and metadata admin.
+// ProposeDenomAdmin proposes a new denom bank admin and metadata admin,
+// claimable once the admin timelock of the denom has passed and until the proposal expires.
 func (k msgServer) ProposeDenomAdmin(goCtx context.Context, msg *types.MsgProposeDenomAdmin) (*types.MsgProposeDenomAdminResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -29,7 +30,7 @@ func (k msgServer) ProposeDenomAdmin(goCtx context.Context, msg *types.MsgPropos
 	}
 
 	// Check if the signer has permission to update the denom auth
-	if err := k.Auth(ctx, msg.Denom, "bank", msg.Signer); err != nil {
+	if err := k.Auth(ctx, msg.Denom, types.RoleAdmin, msg.Signer); err != nil {
 		return nil, err
 	}
 
@@ -48,13 +49,30 @@ func (k msgServer) ProposeDenomAdmin(goCtx context.Context, msg *types.MsgPropos
 		)
 	}
 
-	proposedDenomAuth := types.DenomAuth{
+	now := ctx.BlockTime().Unix()
+
+	var delay uint64
+	if timelock, found := k.GetDenomAdminTimelock(ctx, msg.Denom); found {
+		delay = timelock.Effective(now).Delay
+	}
+
+	expiry := msg.Expiry
+	if expiry == 0 {
+		expiry = types.DefaultDenomAdminProposalExpiry
+	}
+
+	// #nosec G115 -- the delay and the expiry are bounded by MaxDenomAdminDelay
+	claimableTime := now + int64(delay)
+	proposedDenomAuth := types.DenomAdminProposal{
 		Denom:         msg.Denom,
 		BankAdmin:     msg.BankAdmin,
 		MetadataAdmin: msg.MetadataAdmin,
+		ClaimableTime: claimableTime,
+		// #nosec G115 -- the expiry is bounded by MaxDenomAdminDelay
+		ExpiryTime: claimableTime + int64(expiry),
 	}
 
-	// Store the proposed denom admin
+	// Store the proposed denom admin, replacing any pending proposal
 	k.SetProposedDenomAuth(ctx, proposedDenomAuth)
 
 	// Emit event for denom admin proposal
@@ -64,5 +82,7 @@ func (k msgServer) ProposeDenomAdmin(goCtx context.Context, msg *types.MsgPropos
 		Denom:         msg.Denom,
 		BankAdmin:     msg.BankAdmin,
 		MetadataAdmin: msg.MetadataAdmin,
+		ClaimableTime: proposedDenomAuth.ClaimableTime,
+		ExpiryTime:    proposedDenomAuth.ExpiryTime,
 	}, nil
 }
@@ -178,6 +178,8 @@ func TestMsgServer_ProposeDenomAdmin_Positive(t *testing.T) {
 		Denom:         fullDenom,
 		BankAdmin:     newBankAdmin,
 		MetadataAdmin: newMetaAdmin,
+		ClaimableTime: ctx.BlockTime().Unix(),
+		ExpiryTime:    ctx.BlockTime().Unix() + int64(types.DefaultDenomAdminProposalExpiry),
 	}
 
 	// make sure that response is not nil
@@ -391,6 +393,8 @@ func TestMsgServer_ProposeDenomAdmin_Positive2_OnlyBankAdmin(t *testing.T) {
 		Denom:         fullDenom,
 		BankAdmin:     newBankAdmin,
 		MetadataAdmin: "",
+		ClaimableTime: ctx.BlockTime().Unix(),
+		ExpiryTime:    ctx.BlockTime().Unix() + int64(types.DefaultDenomAdminProposalExpiry),
 	}
 
 	// make sure that response is not nil
@@ -605,6 +609,8 @@ func TestMsgServer_ProposeDenomAdmin_Positive3_DoubleProposal(t *testing.T) {
 		Denom:         fullDenom,
 		BankAdmin:     newBankAdmin,
 		MetadataAdmin: newMetaAdmin,
+		ClaimableTime: ctx.BlockTime().Unix(),
+		ExpiryTime:    ctx.BlockTime().Unix() + int64(types.DefaultDenomAdminProposalExpiry),
 	}
 
 	// make sure that response is not nil
@@ -656,6 +662,8 @@ func TestMsgServer_ProposeDenomAdmin_Positive3_DoubleProposal(t *testing.T) {
 		Denom:         fullDenom,
 		BankAdmin:     newBankAdmin2,
 		MetadataAdmin: newMetaAdmin2,
+		ClaimableTime: ctx.BlockTime().Unix(),
+		ExpiryTime:    ctx.BlockTime().Unix() + int64(types.DefaultDenomAdminProposalExpiry),
 	}
 
 	// make sure that response is not nil
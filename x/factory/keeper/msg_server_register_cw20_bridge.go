@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) RegisterCW20Bridge(goCtx context.Context, msg *types.MsgRegisterCW20Bridge) (*types.MsgRegisterCW20BridgeResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSignerAccount, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetCW20Bridge(ctx, msg.ContractAddress); found {
+		return nil, errorsmod.Wrapf(
+			types.ErrCW20BridgeExists,
+			"contract: %s",
+			msg.ContractAddress,
+		)
+	}
+
+	// The token info makes sure the contract is a CW20 and gives the metadata of the denom
+	info, err := k.GetCW20TokenInfo(ctx, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	creator := types.CW20BridgeCreator()
+	fullDenom, err := types.GetTokenDenom(creator, msg.SubDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetDenom(ctx, fullDenom); found {
+		return nil, errorsmod.Wrapf(
+			types.ErrDenomExists,
+			"Denom: %s already exists",
+			fullDenom,
+		)
+	}
+
+	denomMetaData := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{
+			Denom:    fullDenom,
+			Exponent: 0,
+		}},
+		Base:    fullDenom,
+		Name:    info.Name,
+		Symbol:  info.Symbol,
+		Display: fullDenom,
+	}
+	if info.Decimals > 0 && info.Symbol != "" {
+		denomMetaData.DenomUnits = append(denomMetaData.DenomUnits, &banktypes.DenomUnit{
+			Denom:    info.Symbol,
+			Exponent: info.Decimals,
+		})
+		denomMetaData.Display = info.Symbol
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, denomMetaData)
+
+	// Only the bridge mints the denom, so the bank admin is disabled from the start
+	k.SetDenomAuth(ctx, types.DenomAuth{
+		Denom:         fullDenom,
+		BankAdmin:     "",
+		MetadataAdmin: msg.Authority,
+	})
+
+	k.SetDenom(ctx, types.Denom{
+		Creator:             creator,
+		Denom:               fullDenom,
+		Minted:              math.ZeroUint(),
+		MintingCap:          types.CW20MaxSupply,
+		CanChangeMintingCap: false,
+	})
+
+	bridge := types.CW20Bridge{
+		ContractAddress: msg.ContractAddress,
+		Denom:           fullDenom,
+		Escrowed:        math.ZeroUint(),
+	}
+	k.SetCW20Bridge(ctx, bridge)
+
+	events.EmitCW20BridgeRegistered(ctx, bridge)
+
+	return &types.MsgRegisterCW20BridgeResponse{
+		Bridge: bridge,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) RemoveMinter(goCtx context.Context, msg *types.MsgRemoveMinter) (*types.MsgRemoveMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the signer has permission to manage the minters
+	if err := k.Auth(ctx, msg.Denom, types.RoleAdmin, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetMinter(ctx, msg.Denom, msg.Minter); !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrMinterNotFound,
+			"minter (%s) denom (%s)",
+			msg.Minter,
+			msg.Denom,
+		)
+	}
+
+	k.DeleteMinter(ctx, msg.Denom, msg.Minter)
+
+	events.EmitMinterRemoved(ctx, msg.Signer, msg.Denom, msg.Minter)
+
+	return &types.MsgRemoveMinterResponse{
+		Denom:  msg.Denom,
+		Minter: msg.Minter,
+	}, nil
+}
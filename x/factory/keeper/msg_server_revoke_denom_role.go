@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+// RevokeDenomRole revokes a role of a denom from an address,
+// only the denom admin or the holder renouncing its role can revoke it
+func (k msgServer) RevokeDenomRole(goCtx context.Context, msg *types.MsgRevokeDenomRole) (*types.MsgRevokeDenomRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := types.ValidateGrantableRole(msg.Role); err != nil {
+		return nil, err
+	}
+
+	// Check if the signer has permission to manage the roles
+	if msg.Signer != msg.Address {
+		if err := k.Auth(ctx, msg.Denom, types.RoleAdmin, msg.Signer); err != nil {
+			return nil, err
+		}
+	}
+
+	if !k.HasDenomRole(ctx, msg.Denom, msg.Role, msg.Address) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidRole,
+			"address (%s) does not hold the %s role of denom (%s)",
+			msg.Address,
+			msg.Role,
+			msg.Denom,
+		)
+	}
+
+	// The metadata admin loses its metadata editor role with its admin field
+	denomAuth, found := k.GetDenomAuth(ctx, msg.Denom)
+	if found && msg.Role == types.RoleMetadataEditor && denomAuth.MetadataAdmin == msg.Address {
+		denomAuth.MetadataAdmin = ""
+		k.SetDenomAuth(ctx, denomAuth)
+	} else {
+		k.RemoveDenomRole(ctx, msg.Denom, msg.Role, msg.Address)
+	}
+
+	events.EmitDenomRoleRevoked(ctx, msg.Signer, types.DenomRole{
+		Denom:   msg.Denom,
+		Role:    msg.Role,
+		Address: msg.Address,
+	})
+
+	return &types.MsgRevokeDenomRoleResponse{}, nil
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+	"zigchain/zutils/constants"
+)
+
+func (k msgServer) SellLaunchToken(goCtx context.Context, msg *types.MsgSellLaunchToken) (*types.MsgSellLaunchTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	launch, err := k.getOpenLaunch(ctx, msg.Incoming.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the tokens bought on the curve can be sold back to it
+	if msg.Incoming.Amount.GT(launch.Sold) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidTokenLaunch,
+			"%s exceeds the %s tokens sold on the curve",
+			msg.Incoming,
+			launch.Sold,
+		)
+	}
+
+	proceeds := launch.SellAmount(msg.Incoming.Amount)
+	if !proceeds.IsPositive() {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidTokenLaunch,
+			"%s sells for no %s",
+			msg.Incoming,
+			constants.BondDenom,
+		)
+	}
+
+	if !msg.OutgoingMin.IsNil() && proceeds.LT(msg.OutgoingMin) {
+		return nil, errorsmod.Wrapf(
+			types.ErrLaunchSlippage,
+			"%s sells for %s%s, expected at least %s",
+			msg.Incoming,
+			proceeds,
+			constants.BondDenom,
+			msg.OutgoingMin,
+		)
+	}
+
+	outgoing := sdk.NewCoin(constants.BondDenom, proceeds)
+
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, signer, types.LaunchpadEscrowName, sdk.NewCoins(msg.Incoming)); err != nil {
+		return nil, err
+	}
+
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.LaunchpadEscrowName, signer, sdk.NewCoins(outgoing)); err != nil {
+		return nil, err
+	}
+
+	launch.Sold = launch.Sold.Sub(msg.Incoming.Amount)
+	launch.Raised = launch.Raised.Sub(proceeds)
+	k.SetTokenLaunch(ctx, launch)
+
+	events.EmitLaunchTokenSold(ctx, launch, msg.Signer, msg.Incoming, outgoing)
+
+	return &types.MsgSellLaunchTokenResponse{
+		Outgoing: outgoing,
+	}, nil
+}
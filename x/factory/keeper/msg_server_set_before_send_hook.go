@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgSetBeforeSendHook) (*types.MsgSetBeforeSendHookResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the signer has permission to set the hook
+	if err := k.Auth(ctx, msg.Denom, types.RoleAdmin, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if msg.ContractAddress == "" {
+		// An empty contract address removes the hook
+		k.DeleteBeforeSendHook(ctx, msg.Denom)
+	} else {
+		if k.wasm.contractKeeper == nil {
+			return nil, errorsmod.Wrapf(
+				types.ErrContractNotFound,
+				"contract (%s) : CosmWasm is not available",
+				msg.ContractAddress,
+			)
+		}
+
+		contractAddr, err := sdk.AccAddressFromBech32(msg.ContractAddress)
+		if err != nil {
+			return nil, errorsmod.Wrapf(
+				types.ErrInvalidContractAddress,
+				"(%s) : %s",
+				msg.ContractAddress,
+				err,
+			)
+		}
+
+		if !k.wasm.contractKeeper.HasContractInfo(ctx, contractAddr) {
+			return nil, errorsmod.Wrapf(
+				types.ErrContractNotFound,
+				"contract (%s)",
+				msg.ContractAddress,
+			)
+		}
+
+		k.Keeper.SetBeforeSendHook(ctx, types.BeforeSendHook{
+			Denom:           msg.Denom,
+			ContractAddress: msg.ContractAddress,
+		})
+	}
+
+	events.EmitBeforeSendHookSet(ctx, msg.Signer, msg.Denom, msg.ContractAddress)
+
+	return &types.MsgSetBeforeSendHookResponse{
+		Denom:           msg.Denom,
+		ContractAddress: msg.ContractAddress,
+	}, nil
+}
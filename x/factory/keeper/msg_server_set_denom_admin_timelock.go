@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+// SetDenomAdminTimelock sets the delay between the proposal of new admins of a denom and their claim
+func (k msgServer) SetDenomAdminTimelock(
+	goCtx context.Context,
+	msg *types.MsgSetDenomAdminTimelock,
+) (*types.MsgSetDenomAdminTimelockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the signer has permission to update the denom auth
+	if err := k.Auth(ctx, msg.Denom, types.RoleAdmin, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	now := ctx.BlockTime().Unix()
+
+	timelock := types.DenomAdminTimelock{
+		Denom: msg.Denom,
+		Delay: msg.Delay,
+	}
+
+	// A shorter delay only takes effect after the delay in effect,
+	// so a compromised admin key can not lift the timelock and hand the denom over right away.
+	// A longer delay takes effect right away and drops any pending shorter delay.
+	if current, found := k.GetDenomAdminTimelock(ctx, msg.Denom); found {
+		current = current.Effective(now)
+		if msg.Delay < current.Delay {
+			timelock.Delay = current.Delay
+			timelock.PendingDelay = msg.Delay
+			// #nosec G115 -- the delay is bounded by MaxDenomAdminDelay
+			timelock.PendingTime = now + int64(current.Delay)
+		}
+	}
+
+	k.Keeper.SetDenomAdminTimelock(ctx, timelock)
+
+	events.EmitAdminTimelockSet(ctx, msg.Signer, timelock)
+
+	return &types.MsgSetDenomAdminTimelockResponse{
+		Timelock: timelock,
+	}, nil
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) SetDenomGlobalFreeze(goCtx context.Context, msg *types.MsgSetDenomGlobalFreeze) (*types.MsgSetDenomGlobalFreezeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the signer has permission to freeze the denom
+	if err := k.Auth(ctx, msg.Denom, types.RolePauser, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	k.SetDenomGlobalFrozen(ctx, msg.Denom, msg.Frozen)
+
+	events.EmitDenomGlobalFreezeSet(ctx, msg.Signer, msg.Denom, msg.Frozen)
+
+	return &types.MsgSetDenomGlobalFreezeResponse{
+		Denom:  msg.Denom,
+		Frozen: msg.Frozen,
+	}, nil
+}
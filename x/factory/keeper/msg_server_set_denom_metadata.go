@@ -13,7 +13,7 @@ func (k msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDeno
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// check if a signer has metadata admin rights
-	if err := k.Auth(ctx, msg.Metadata.Base, "metadata", msg.Signer); err != nil {
+	if err := k.Auth(ctx, msg.Metadata.Base, types.RoleMetadataEditor, msg.Signer); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) SetMintRateLimit(goCtx context.Context, msg *types.MsgSetMintRateLimit) (*types.MsgSetMintRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the signer has permission to manage the mint rate limit
+	if err := k.Auth(ctx, msg.Denom, types.RoleCapManager, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetDenom(ctx, msg.Denom); !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrDenomDoesNotExist,
+			"Denom: %s",
+			msg.Denom,
+		)
+	}
+
+	now := ctx.BlockTime().Unix()
+
+	rateLimit := types.MintRateLimit{
+		Denom:  msg.Denom,
+		Config: msg.Config,
+	}
+
+	// A looser config only takes effect after the raise delay of the config in effect,
+	// so a compromised admin key can not lift the limit and mint in the same window.
+	// A tighter config takes effect right away and drops any pending raise.
+	if current, found := k.GetMintRateLimit(ctx, msg.Denom); found {
+		current = current.Effective(now)
+		if msg.Config.IsLooserThan(current.Config) {
+			config := msg.Config
+			rateLimit.Config = current.Config
+			rateLimit.PendingConfig = &config
+			// #nosec G115 -- the raise delay is bounded by MaxMintRateLimitDuration
+			rateLimit.PendingTime = now + int64(current.Config.RaiseDelay)
+		}
+	}
+
+	rateLimit = rateLimit.Effective(now)
+	k.Keeper.SetMintRateLimit(ctx, rateLimit)
+
+	events.EmitMintRateLimitSet(ctx, msg.Signer, rateLimit)
+
+	return &types.MsgSetMintRateLimitResponse{
+		MintRateLimit: rateLimit,
+	}, nil
+}
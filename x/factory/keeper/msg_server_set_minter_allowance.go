@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) SetMinterAllowance(goCtx context.Context, msg *types.MsgSetMinterAllowance) (*types.MsgSetMinterAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the signer has permission to manage the minters
+	if err := k.Auth(ctx, msg.Denom, types.RoleAdmin, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetDenom(ctx, msg.Denom); !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrDenomDoesNotExist,
+			"Denom: %s",
+			msg.Denom,
+		)
+	}
+
+	// The allowance replaces the remaining one, so it can be raised or lowered,
+	// the minting cap of the denom still applies to every mint
+	minter := types.Minter{
+		Denom:     msg.Denom,
+		Address:   msg.Minter,
+		Allowance: msg.Allowance,
+	}
+	k.SetMinter(ctx, minter)
+
+	events.EmitMinterAllowanceSet(ctx, msg.Signer, minter)
+
+	return &types.MsgSetMinterAllowanceResponse{
+		Minter: minter,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+// SetTransferTax sets the rate and the recipient of the transfer tax of a denom, a zero rate removes the tax
+func (k msgServer) SetTransferTax(goCtx context.Context, msg *types.MsgSetTransferTax) (*types.MsgSetTransferTaxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the signer has permission to update the denom auth
+	if err := k.Auth(ctx, msg.Denom, types.RoleAdmin, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	maxRate := k.GetParams(ctx).GetMaxTransferTaxRate()
+	if msg.Rate.GT(maxRate) {
+		return nil, errorsmod.Wrapf(
+			types.ErrTransferTaxRateTooHigh,
+			"rate (%s) of denom (%s) exceeds the max transfer tax rate (%s)",
+			msg.Rate,
+			msg.Denom,
+			maxRate,
+		)
+	}
+
+	tax := types.TransferTax{
+		Denom:     msg.Denom,
+		Rate:      msg.Rate,
+		Recipient: msg.Recipient,
+	}
+
+	if msg.Rate.IsZero() {
+		k.RemoveTransferTax(ctx, msg.Denom)
+	} else {
+		k.Keeper.SetTransferTax(ctx, tax)
+	}
+
+	events.EmitTransferTaxSet(ctx, msg.Signer, tax)
+
+	return &types.MsgSetTransferTaxResponse{
+		TransferTax: tax,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+// SetTransferTaxExemption exempts an address from the transfer tax of a denom or lifts its exemption
+func (k msgServer) SetTransferTaxExemption(
+	goCtx context.Context,
+	msg *types.MsgSetTransferTaxExemption,
+) (*types.MsgSetTransferTaxExemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the signer has permission to update the denom auth
+	if err := k.Auth(ctx, msg.Denom, types.RoleAdmin, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if msg.Exempt {
+		k.Keeper.SetTransferTaxExemption(ctx, msg.Denom, msg.Address)
+	} else {
+		k.RemoveTransferTaxExemption(ctx, msg.Denom, msg.Address)
+	}
+
+	events.EmitTransferTaxExemptionSet(ctx, msg.Signer, msg.Denom, msg.Address, msg.Exempt)
+
+	return &types.MsgSetTransferTaxExemptionResponse{}, nil
+}
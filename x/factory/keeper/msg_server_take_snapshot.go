@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) TakeSnapshot(goCtx context.Context, msg *types.MsgTakeSnapshot) (*types.MsgTakeSnapshotResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the signer has permission to take a snapshot
+	if err := k.Auth(ctx, msg.Denom, types.RoleAdmin, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetDenom(ctx, msg.Denom); !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrDenomDoesNotExist,
+			"Denom: %s",
+			msg.Denom,
+		)
+	}
+
+	snapshot := k.CreateSnapshot(ctx, msg.Denom)
+
+	events.EmitSnapshotTaken(ctx, msg.Signer, snapshot)
+
+	return &types.MsgTakeSnapshotResponse{
+		Snapshot: snapshot,
+	}, nil
+}
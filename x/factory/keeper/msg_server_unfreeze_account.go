@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+func (k msgServer) UnfreezeAccount(goCtx context.Context, msg *types.MsgUnfreezeAccount) (*types.MsgUnfreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the signer has permission to unfreeze accounts
+	if err := k.Auth(ctx, msg.Denom, types.RolePauser, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if !k.IsAccountFrozen(ctx, msg.Denom, msg.Address) {
+		return nil, errorsmod.Wrapf(
+			types.ErrAccountNotFrozen,
+			"account (%s) denom (%s)",
+			msg.Address,
+			msg.Denom,
+		)
+	}
+
+	k.DeleteFrozenAccount(ctx, msg.Denom, msg.Address)
+
+	events.EmitAccountUnfrozen(ctx, msg.Signer, msg.Denom, msg.Address)
+
+	return &types.MsgUnfreezeAccountResponse{
+		Denom:   msg.Denom,
+		Address: msg.Address,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+// UnverifyDenom removes a denom from the verified denom registry, freeing its ticker
+func (k msgServer) UnverifyDenom(goCtx context.Context, msg *types.MsgUnverifyDenom) (*types.MsgUnverifyDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.CheckVerifiedDenomCurator(ctx, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	verified, found := k.GetVerifiedDenom(ctx, msg.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrDenomNotVerified,
+			"denom (%s)",
+			msg.Denom,
+		)
+	}
+
+	k.RemoveVerifiedDenom(ctx, msg.Denom)
+
+	events.EmitDenomUnverified(ctx, msg.Signer, verified)
+
+	return &types.MsgUnverifyDenomResponse{
+		Denom: msg.Denom,
+	}, nil
+}
@@ -54,17 +54,9 @@ func (k msgServer) UpdateDenomMetadataAuth(goCtx context.Context, msg *types.Msg
 		MetadataAdmin: msg.MetadataAdmin,
 	}
 
+	// Update the denom auth, the admin index follows the new metadata admin
 	k.SetDenomAuth(ctx, denomAuth)
 
-	// Remove the previous metadata admin from the admin denom auth list
-	k.RemoveDenomFromAdminDenomAuthList(ctx, currentDenomAuth.MetadataAdmin, msg.Denom)
-
-	// Add the new metadata admin to the admin denom auth list
-	k.AddDenomToAdminDenomAuthList(ctx, denomAuth.MetadataAdmin, msg.Denom)
-
-	// Make sure the bank admin is also in the admin denom auth list
-	k.AddDenomToAdminDenomAuthList(ctx, denomAuth.BankAdmin, msg.Denom)
-
 	events.EmitDenomAuthUpdated(ctx, msg.Signer, &denomAuth)
 
 	return &types.MsgUpdateDenomMetadataAuthResponse{
@@ -45,7 +45,7 @@ func (k msgServer) UpdateDenomMintingCap(goCtx context.Context, msg *types.MsgUp
 		)
 	}
 
-	if err := k.Auth(ctx, msg.Denom, "bank", msg.Signer); err != nil {
+	if err := k.Auth(ctx, msg.Denom, types.RoleCapManager, msg.Signer); err != nil {
 		return nil, err
 	}
 
@@ -55,6 +55,7 @@ func (k msgServer) UpdateDenomMintingCap(goCtx context.Context, msg *types.MsgUp
 		MintingCap:          msg.MintingCap,
 		Minted:              currentDenom.Minted,
 		CanChangeMintingCap: msg.CanChangeMintingCap,
+		CanForceTransfer:    currentDenom.CanForceTransfer,
 	}
 
 	k.SetDenom(
@@ -357,7 +357,7 @@ func TestNewMsgServer_UpdateDenomMintingCap_Unauthorized(t *testing.T) {
 	require.Equal(
 		t,
 		fmt.Sprintf(
-			"Auth: incorrect admin for denom (%s) only the current bank admin (%s) can perform this action (attempted with: %s): unauthorized",
+			"Auth: incorrect admin for denom (%s) only the current bank admin (%s) or a holder of the cap_manager role can perform this action (attempted with: %s): unauthorized",
 			fullDenom,
 			creator,
 			badSigner,
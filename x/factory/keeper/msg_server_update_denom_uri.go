@@ -51,7 +51,7 @@ func (k msgServer) UpdateDenomURI(goCtx context.Context, msg *types.MsgUpdateDen
 	if err := k.Auth(
 		ctx,
 		msg.Denom,
-		"metadata",
+		types.RoleMetadataEditor,
 		msg.Signer,
 	); err != nil {
 		return nil, err
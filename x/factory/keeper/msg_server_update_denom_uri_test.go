@@ -1006,10 +1006,10 @@ func TestMsgServer_UpdateDenomURI_InvalidSignerAddress(t *testing.T) {
 	require.Equal(
 		t,
 		fmt.Sprintf(
-			"Auth: incorrect admin for denom (%s) only the current admins bank (%s) or metadata (%s) can update the denom metadata: unauthorized",
+			"Auth: incorrect admin for denom (%s) only the current bank admin (%s) or a holder of the metadata_editor role can perform this action (attempted with: %s): unauthorized",
 			fullDenom,
 			creator,
-			creator,
+			updateMsg.Signer,
 		),
 		err.Error(),
 	)
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+// VerifyDenom adds a denom to the verified denom registry, or updates its entry
+func (k msgServer) VerifyDenom(goCtx context.Context, msg *types.MsgVerifyDenom) (*types.MsgVerifyDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.CheckVerifiedDenomCurator(ctx, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetDenom(ctx, msg.Denom); !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrDenomNotFound,
+			"denom (%s) not found",
+			msg.Denom,
+		)
+	}
+
+	// A ticker is the canonical ticker of one denom only
+	if other, found := k.GetVerifiedDenomByTicker(ctx, msg.Ticker); found && other.Denom != msg.Denom {
+		return nil, errorsmod.Wrapf(
+			types.ErrTickerTaken,
+			"ticker (%s) is verified for denom (%s)",
+			msg.Ticker,
+			other.Denom,
+		)
+	}
+
+	verified := msg.VerifiedDenom()
+	k.SetVerifiedDenom(ctx, verified)
+
+	events.EmitDenomVerified(ctx, msg.Signer, verified)
+
+	return &types.MsgVerifyDenomResponse{
+		VerifiedDenom: verified,
+	}, nil
+}
@@ -17,17 +17,22 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// Check if the CreateFeeDenom exists in the factory module or is a native denom with supply
-	if _, found := k.GetDenom(ctx, req.Params.CreateFeeDenom); !found {
+	if _, found := k.GetDenom(ctx, req.Params.CreateFee.Denom); !found {
 		// If not found in factory module, check if it's a native denom with supply
-		if !k.bankKeeper.HasSupply(ctx, req.Params.CreateFeeDenom) {
+		if !k.bankKeeper.HasSupply(ctx, req.Params.CreateFee.Denom) {
 			return nil, errorsmod.Wrapf(
 				types.ErrorInvalidParamValue,
 				"Denom for create fee denom %s does not exist in the factory module and is not a native denom with supply",
-				req.Params.CreateFeeDenom,
+				req.Params.CreateFee.Denom,
 			)
 		}
 	}
 
+	// The module accounts holding the denoms start or stop earning rewards from now on
+	if req.Params.DistributeToModuleAccounts != k.GetParams(ctx).DistributeToModuleAccounts {
+		k.checkpointModuleHolders(ctx)
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
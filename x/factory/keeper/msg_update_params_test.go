@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"testing"
 
+	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
@@ -62,7 +64,7 @@ func TestMsgUpdateParams_Positive(t *testing.T) {
 			input: &types.MsgUpdateParams{
 				Authority: k.GetAuthority(),
 				Params: types.Params{
-					CreateFeeDenom: "coin.zig1wz7n45yh4cptr27yf7g59pfhc28z6jcyax85ng.pandacebbdcc",
+					CreateFee: sdk.NewInt64Coin("coin.zig1wz7n45yh4cptr27yf7g59pfhc28z6jcyax85ng.pandacebbdcc", 1000),
 				},
 			},
 			expErr: false,
@@ -72,7 +74,7 @@ func TestMsgUpdateParams_Positive(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			k.SetDenom(ctx, types.Denom{
-				Denom: tc.input.Params.CreateFeeDenom,
+				Denom: tc.input.Params.CreateFee.Denom,
 			})
 
 			_, err := ms.UpdateParams(ctx, tc.input)
@@ -178,7 +180,7 @@ func TestMsgUpdateParams_InvalidCreateFeeDenom(t *testing.T) {
 			_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{
 				Authority: k.GetAuthority(),
 				Params: types.Params{
-					CreateFeeDenom: tc.createFeeDenom,
+					CreateFee: sdk.Coin{Denom: tc.createFeeDenom, Amount: cosmosmath.ZeroInt()},
 				},
 			})
 			require.Error(t, err)
@@ -241,7 +243,7 @@ func TestMsgUpdateParams_NativeDenomWithSupply(t *testing.T) {
 			_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{
 				Authority: k.GetAuthority(),
 				Params: types.Params{
-					CreateFeeDenom: tc.createFeeDenom,
+					CreateFee: sdk.Coin{Denom: tc.createFeeDenom, Amount: cosmosmath.ZeroInt()},
 				},
 			})
 
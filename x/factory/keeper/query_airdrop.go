@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zigchain/x/factory/types"
+	"zigchain/zutils/pagination"
+)
+
+func (k Keeper) Airdrops(ctx context.Context, req *types.QueryAirdropsRequest) (*types.QueryAirdropsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// Apply pagination to the open airdrops
+	airdrops, pageRes, err := pagination.CollectionPaginate(
+		ctx,
+		k.OpenAirdrops,
+		req.Pagination,
+		func(_ uint64, airdrop types.Airdrop) (types.Airdrop, error) {
+			return airdrop, nil
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAirdropsResponse{
+		Airdrops:   airdrops,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) Airdrop(ctx context.Context, req *types.QueryAirdropRequest) (*types.QueryAirdropResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	airdrop, found := k.GetAirdrop(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryAirdropResponse{
+		Airdrop: airdrop,
+	}, nil
+}
+
+func (k Keeper) AirdropClaimed(ctx context.Context, req *types.QueryAirdropClaimedRequest) (*types.QueryAirdropClaimedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil,
+			status.Errorf(
+				codes.InvalidArgument,
+				"invalid address (%s): %s",
+				req.Address,
+				err,
+			)
+	}
+
+	return &types.QueryAirdropClaimedResponse{
+		Claimed: k.IsAirdropClaimed(ctx, req.Id, req.Address),
+	}, nil
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zigchain/x/factory/types"
+	"zigchain/zutils/validators"
+)
+
+func (k Keeper) BeforeSendHook(ctx context.Context, req *types.QueryBeforeSendHookRequest) (*types.QueryBeforeSendHookResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := validators.CheckDenomString(req.Denom); err != nil {
+		return nil,
+			status.Errorf(
+				codes.InvalidArgument,
+				"invalid denom (%s): %s",
+				req.Denom,
+				err,
+			)
+	}
+
+	val, found := k.GetBeforeSendHook(ctx, req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryBeforeSendHookResponse{BeforeSendHook: val}, nil
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zigchain/x/factory/types"
+	"zigchain/zutils/pagination"
+)
+
+func (k Keeper) CW20Bridges(ctx context.Context, req *types.QueryCW20BridgesRequest) (*types.QueryCW20BridgesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// Apply pagination to the bridges
+	bridges, pageRes, err := pagination.CollectionPaginate(
+		ctx,
+		k.CW20BridgeStore,
+		req.Pagination,
+		func(_ string, bridge types.CW20Bridge) (types.CW20Bridge, error) {
+			return bridge, nil
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCW20BridgesResponse{
+		Bridges:    bridges,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) CW20Bridge(ctx context.Context, req *types.QueryCW20BridgeRequest) (*types.QueryCW20BridgeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	bridge, found := k.GetCW20Bridge(ctx, req.ContractAddress)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryCW20BridgeResponse{
+		Bridge: bridge,
+	}, nil
+}
+
+func (k Keeper) CW20BridgeByDenom(ctx context.Context, req *types.QueryCW20BridgeByDenomRequest) (*types.QueryCW20BridgeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	bridge, found := k.GetCW20BridgeByDenom(ctx, req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryCW20BridgeResponse{
+		Bridge: bridge,
+	}, nil
+}
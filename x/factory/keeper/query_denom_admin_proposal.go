@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zigchain/x/factory/types"
+	"zigchain/zutils/pagination"
+)
+
+func (k Keeper) DenomAdminProposals(
+	ctx context.Context,
+	req *types.QueryDenomAdminProposalsRequest,
+) (*types.QueryDenomAdminProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// Apply pagination to the pending proposals
+	proposals, pageRes, err := pagination.CollectionPaginate(
+		ctx,
+		k.ProposedDenomAuths,
+		req.Pagination,
+		func(_ string, proposal types.DenomAdminProposal) (types.DenomAdminProposal, error) {
+			return proposal, nil
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomAdminProposalsResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) DenomAdminProposal(
+	ctx context.Context,
+	req *types.QueryDenomAdminProposalRequest,
+) (*types.QueryDenomAdminProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	proposal, found := k.GetProposedDenomAuth(ctx, req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryDenomAdminProposalResponse{
+		Proposal: proposal,
+	}, nil
+}
+
+// DenomAdminTimelock returns the admin timelock of a denom as in effect at the block time,
+// a denom without timelock has a zero delay
+func (k Keeper) DenomAdminTimelock(
+	ctx context.Context,
+	req *types.QueryDenomAdminTimelockRequest,
+) (*types.QueryDenomAdminTimelockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, found := k.GetDenomAuth(ctx, req.Denom); !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	timelock, found := k.GetDenomAdminTimelock(ctx, req.Denom)
+	if !found {
+		timelock = types.DenomAdminTimelock{Denom: req.Denom}
+	}
+
+	return &types.QueryDenomAdminTimelockResponse{
+		Timelock: timelock.Effective(sdk.UnwrapSDKContext(ctx).BlockTime().Unix()),
+	}, nil
+}
@@ -7,10 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"zigchain/x/factory/types"
+	"zigchain/zutils/pagination"
 
-	"cosmossdk.io/store/prefix"
-	"github.com/cosmos/cosmos-sdk/runtime"
-	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -42,20 +40,14 @@ func (k Keeper) DenomAll(ctx context.Context, req *types.QueryAllDenomRequest) (
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var denoms []types.Denom
-
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	denomStore := prefix.NewStore(store, types.KeyPrefix(types.DenomKeyPrefix))
-
-	pageRes, err := query.Paginate(denomStore, req.Pagination, func(key []byte, value []byte) error {
-		var denom types.Denom
-		if err := k.cdc.Unmarshal(value, &denom); err != nil {
-			return err
-		}
-
-		denoms = append(denoms, denom)
-		return nil
-	})
+	denoms, pageRes, err := pagination.CollectionPaginate(
+		ctx,
+		k.Denoms,
+		req.Pagination,
+		func(_ string, denom types.Denom) (types.Denom, error) {
+			return denom, nil
+		},
+	)
 
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -75,6 +67,7 @@ func (k Keeper) DenomAll(ctx context.Context, req *types.QueryAllDenomRequest) (
 			TotalMinted:         denom.Minted,
 			TotalSupply:         cosmosmath.Uint(totalSupply.Amount),
 			TotalBurned:         totalBurned,
+			CanForceTransfer:    denom.CanForceTransfer,
 		})
 	}
 
@@ -3,13 +3,11 @@ package keeper
 import (
 	"context"
 
-	"cosmossdk.io/store/prefix"
-	"github.com/cosmos/cosmos-sdk/runtime"
-	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"zigchain/x/factory/types"
+	"zigchain/zutils/pagination"
 	"zigchain/zutils/validators"
 )
 
@@ -18,20 +16,14 @@ func (k Keeper) ListDenomAuth(ctx context.Context, req *types.QueryAllDenomAuthR
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var denomAuths []types.DenomAuth
-
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	denomAuthStore := prefix.NewStore(store, types.KeyPrefix(types.DenomAuthKeyPrefix))
-
-	pageRes, err := query.Paginate(denomAuthStore, req.Pagination, func(key []byte, value []byte) error {
-		var denomAuth types.DenomAuth
-		if err := k.cdc.Unmarshal(value, &denomAuth); err != nil {
-			return err
-		}
-
-		denomAuths = append(denomAuths, denomAuth)
-		return nil
-	})
+	denomAuths, pageRes, err := pagination.CollectionPaginate(
+		ctx,
+		k.DenomAuths,
+		req.Pagination,
+		func(_ string, denomAuth types.DenomAuth) (types.DenomAuth, error) {
+			return denomAuth, nil
+		},
+	)
 
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
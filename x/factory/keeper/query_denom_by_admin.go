@@ -4,9 +4,9 @@ import (
 	"context"
 
 	"zigchain/x/factory/types"
+	"zigchain/zutils/pagination"
 
-	"cosmossdk.io/store/prefix"
-	"github.com/cosmos/cosmos-sdk/runtime"
+	"cosmossdk.io/collections"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,16 +17,16 @@ func (k Keeper) DenomsByAdmin(ctx context.Context, req *types.QueryDenomByAdminR
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	// Apply pagination to the denoms list
-	var paginatedDenoms []string
-
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.AdminDenomAuthListKey(req.Admin))
-
-	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
-		paginatedDenoms = append(paginatedDenoms, string(key))
-		return nil
-	})
+	// Apply pagination to the denoms referenced by the admin index
+	paginatedDenoms, pageRes, err := pagination.CollectionPaginate(
+		ctx,
+		k.DenomAuths.Indexes.Admin,
+		req.Pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (string, error) {
+			return key.K2(), nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Admin),
+	)
 
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
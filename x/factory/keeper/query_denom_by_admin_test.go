@@ -54,7 +54,6 @@ func TestQueryDenomByAdmin_Positive(t *testing.T) {
 		MetadataAdmin: admin1,
 	}
 	k.SetDenomAuth(ctx, denomAuth1)
-	k.AddDenomToAdminDenomAuthList(ctx, admin1, fullDenom1)
 
 	// Create second denom (admin1)
 	denomAuth2 := types.DenomAuth{
@@ -63,7 +62,6 @@ func TestQueryDenomByAdmin_Positive(t *testing.T) {
 		MetadataAdmin: admin1,
 	}
 	k.SetDenomAuth(ctx, denomAuth2)
-	k.AddDenomToAdminDenomAuthList(ctx, admin1, fullDenom2)
 
 	// Create third denom (admin2)
 	denomAuth3 := types.DenomAuth{
@@ -72,7 +70,6 @@ func TestQueryDenomByAdmin_Positive(t *testing.T) {
 		MetadataAdmin: admin2,
 	}
 	k.SetDenomAuth(ctx, denomAuth3)
-	k.AddDenomToAdminDenomAuthList(ctx, admin2, fullDenom3)
 
 	// Query denoms owned by admin1
 	req := &types.QueryDenomByAdminRequest{
@@ -320,7 +317,6 @@ func TestQueryDenomByAdmin_PaginationLimit(t *testing.T) {
 			MetadataAdmin: admin,
 		}
 		k.SetDenomAuth(ctx, denomAuth)
-		k.AddDenomToAdminDenomAuthList(ctx, admin, fullDenom)
 		createdDenoms[i] = fullDenom
 	}
 
@@ -368,7 +364,6 @@ func TestQueryDenomByAdmin_PaginationOffset(t *testing.T) {
 			MetadataAdmin: admin,
 		}
 		k.SetDenomAuth(ctx, denomAuth)
-		k.AddDenomToAdminDenomAuthList(ctx, admin, fullDenom)
 		createdDenoms[i] = fullDenom
 	}
 
@@ -424,7 +419,6 @@ func TestQueryDenomByAdmin_PaginationWithNextKey(t *testing.T) {
 			MetadataAdmin: admin,
 		}
 		k.SetDenomAuth(ctx, denomAuth)
-		k.AddDenomToAdminDenomAuthList(ctx, admin, fullDenom)
 		createdDenoms[i] = fullDenom
 	}
 
@@ -511,7 +505,6 @@ func TestQueryDenomByAdmin_PaginationLimitExceedsTotal(t *testing.T) {
 			MetadataAdmin: admin,
 		}
 		k.SetDenomAuth(ctx, denomAuth)
-		k.AddDenomToAdminDenomAuthList(ctx, admin, fullDenom)
 		createdDenoms[i] = fullDenom
 	}
 
@@ -559,7 +552,6 @@ func TestQueryDenomByAdmin_PaginationOffsetExceedsTotal(t *testing.T) {
 			MetadataAdmin: admin,
 		}
 		k.SetDenomAuth(ctx, denomAuth)
-		k.AddDenomToAdminDenomAuthList(ctx, admin, fullDenom)
 		createdDenoms[i] = fullDenom
 	}
 
@@ -608,7 +600,6 @@ func TestQueryDenomByAdmin_PaginationCountTotal(t *testing.T) {
 			MetadataAdmin: admin,
 		}
 		k.SetDenomAuth(ctx, denomAuth)
-		k.AddDenomToAdminDenomAuthList(ctx, admin, fullDenom)
 		createdDenoms[i] = fullDenom
 	}
 
@@ -655,7 +646,6 @@ func TestQueryDenomByAdmin_PaginationReverse(t *testing.T) {
 			MetadataAdmin: admin,
 		}
 		k.SetDenomAuth(ctx, denomAuth)
-		k.AddDenomToAdminDenomAuthList(ctx, admin, fullDenom)
 		createdDenoms[i] = fullDenom
 	}
 
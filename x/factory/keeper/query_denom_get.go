@@ -65,6 +65,11 @@ func (k Keeper) Denom(ctx context.Context, req *types.QueryGetDenomRequest) (*ty
 
 	totalBurned, maxSupply, totalSupply := k.calculateDenomStats(ctx, denom)
 
+	var verifiedDenom *types.VerifiedDenom
+	if verified, found := k.GetVerifiedDenom(ctx, denom.Denom); found {
+		verifiedDenom = &verified
+	}
+
 	return &types.QueryDenomResponse{
 		Denom:               denom.Denom,
 		TotalMinted:         denom.Minted,
@@ -76,5 +81,8 @@ func (k Keeper) Denom(ctx context.Context, req *types.QueryGetDenomRequest) (*ty
 		Creator:             denom.Creator,
 		BankAdmin:           denomAuth.BankAdmin,
 		MetadataAdmin:       denomAuth.MetadataAdmin,
+		CanForceTransfer:    denom.CanForceTransfer,
+		Verified:            verifiedDenom != nil,
+		VerifiedDenom:       verifiedDenom,
 	}, nil
 }
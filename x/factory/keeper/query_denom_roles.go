@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zigchain/x/factory/types"
+)
+
+// DenomRoles lists the holders of each role of a denom, starting with its admin and its minters
+func (k Keeper) DenomRoles(ctx context.Context, req *types.QueryDenomRolesRequest) (*types.QueryDenomRolesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	denomAuth, found := k.GetDenomAuth(ctx, req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	admin := types.DenomRoleHolders{Role: types.RoleAdmin}
+	if denomAuth.BankAdmin != "" {
+		admin.Addresses = []string{denomAuth.BankAdmin}
+	}
+
+	minters := types.DenomRoleHolders{Role: types.RoleMinter}
+	for _, minter := range k.GetDenomMinters(ctx, req.Denom) {
+		minters.Addresses = append(minters.Addresses, minter.Address)
+	}
+
+	roles := []types.DenomRoleHolders{admin, minters}
+	for _, role := range types.GrantableRoles {
+		roles = append(roles, types.DenomRoleHolders{
+			Role:      role,
+			Addresses: k.GetDenomRoleHolders(ctx, req.Denom, role),
+		})
+	}
+
+	return &types.QueryDenomRolesResponse{
+		Roles: roles,
+	}, nil
+}
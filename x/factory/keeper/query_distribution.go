@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zigchain/x/factory/types"
+	"zigchain/zutils/pagination"
+	"zigchain/zutils/validators"
+)
+
+func (k Keeper) RewardPools(ctx context.Context, req *types.QueryRewardPoolsRequest) (*types.QueryRewardPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := validators.CheckDenomString(req.Denom); err != nil {
+		return nil,
+			status.Errorf(
+				codes.InvalidArgument,
+				"invalid denom (%s): %s",
+				req.Denom,
+				err,
+			)
+	}
+
+	// Apply pagination to the reward pools of the denom
+	pools, pageRes, err := pagination.CollectionPaginate(
+		ctx,
+		k.DenomRewardPools,
+		req.Pagination,
+		func(_ collections.Pair[string, string], pool types.RewardPool) (types.RewardPool, error) {
+			return pool, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Denom),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRewardPoolsResponse{
+		Pools:      pools,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) PendingRewards(ctx context.Context, req *types.QueryPendingRewardsRequest) (*types.QueryPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := validators.CheckDenomString(req.Denom); err != nil {
+		return nil,
+			status.Errorf(
+				codes.InvalidArgument,
+				"invalid denom (%s): %s",
+				req.Denom,
+				err,
+			)
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil,
+			status.Errorf(
+				codes.InvalidArgument,
+				"invalid address (%s): %s",
+				req.Address,
+				err,
+			)
+	}
+
+	return &types.QueryPendingRewardsResponse{
+		Rewards: k.GetPendingRewards(ctx, req.Denom, address),
+	}, nil
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	"zigchain/x/factory/types"
+	"zigchain/zutils/pagination"
+	"zigchain/zutils/validators"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) FrozenAccounts(ctx context.Context, req *types.QueryFrozenAccountsRequest) (*types.QueryFrozenAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := validators.CheckDenomString(req.Denom); err != nil {
+		return nil,
+			status.Errorf(
+				codes.InvalidArgument,
+				"invalid denom (%s): %s",
+				req.Denom,
+				err,
+			)
+	}
+
+	// Apply pagination to the frozen accounts of the denom
+	addresses, pageRes, err := pagination.CollectionPaginate(
+		ctx,
+		k.Freezes,
+		req.Pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (string, error) {
+			return key.K2(), nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Denom),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFrozenAccountsResponse{
+		Addresses:    addresses,
+		GlobalFrozen: k.IsDenomGlobalFrozen(ctx, req.Denom),
+		Pagination:   pageRes,
+	}, nil
+}
+
+func (k Keeper) IsFrozen(ctx context.Context, req *types.QueryIsFrozenRequest) (*types.QueryIsFrozenResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := validators.CheckDenomString(req.Denom); err != nil {
+		return nil,
+			status.Errorf(
+				codes.InvalidArgument,
+				"invalid denom (%s): %s",
+				req.Denom,
+				err,
+			)
+	}
+
+	if err := validators.AddressCheck("Account", req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accountFrozen := k.IsAccountFrozen(ctx, req.Denom, req.Address)
+	globalFrozen := k.IsDenomGlobalFrozen(ctx, req.Denom)
+
+	return &types.QueryIsFrozenResponse{
+		Frozen:        accountFrozen || globalFrozen,
+		AccountFrozen: accountFrozen,
+		GlobalFrozen:  globalFrozen,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zigchain/x/factory/types"
+	"zigchain/zutils/validators"
+)
+
+func (k Keeper) MintRateLimit(ctx context.Context, req *types.QueryMintRateLimitRequest) (*types.QueryMintRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := validators.CheckDenomString(req.Denom); err != nil {
+		return nil,
+			status.Errorf(
+				codes.InvalidArgument,
+				"invalid denom (%s): %s",
+				req.Denom,
+				err,
+			)
+	}
+
+	rateLimit, found := k.GetMintRateLimit(ctx, req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	// Show the limit as it applies to the next mint, with a due pending raise applied
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	rateLimit = rateLimit.Effective(now)
+
+	minted, _ := k.MintedInWindow(ctx, req.Denom, rateLimit.Config, now)
+
+	remaining := math.ZeroUint()
+	if rateLimit.Config.Limit.GT(minted) {
+		remaining = rateLimit.Config.Limit.Sub(minted)
+	}
+
+	return &types.QueryMintRateLimitResponse{
+		MintRateLimit: rateLimit,
+		Minted:        minted,
+		Remaining:     remaining,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"zigchain/x/factory/types"
+	"zigchain/zutils/pagination"
+	"zigchain/zutils/validators"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Minters(ctx context.Context, req *types.QueryMintersRequest) (*types.QueryMintersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := validators.CheckDenomString(req.Denom); err != nil {
+		return nil,
+			status.Errorf(
+				codes.InvalidArgument,
+				"invalid denom (%s): %s",
+				req.Denom,
+				err,
+			)
+	}
+
+	// Apply pagination to the minters of the denom
+	minters, pageRes, err := pagination.CollectionPaginate(
+		ctx,
+		k.MinterAllowances,
+		req.Pagination,
+		func(_ collections.Pair[string, string], minter types.Minter) (types.Minter, error) {
+			return minter, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Denom),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintersResponse{
+		Minters:    minters,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) Minter(ctx context.Context, req *types.QueryMinterRequest) (*types.QueryMinterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := validators.CheckDenomString(req.Denom); err != nil {
+		return nil,
+			status.Errorf(
+				codes.InvalidArgument,
+				"invalid denom (%s): %s",
+				req.Denom,
+				err,
+			)
+	}
+
+	minter, found := k.GetMinter(ctx, req.Denom, req.Address)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryMinterResponse{Minter: minter}, nil
+}
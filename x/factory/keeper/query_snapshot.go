@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zigchain/x/factory/types"
+	"zigchain/zutils/pagination"
+	"zigchain/zutils/validators"
+)
+
+func (k Keeper) Snapshots(ctx context.Context, req *types.QuerySnapshotsRequest) (*types.QuerySnapshotsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := validators.CheckDenomString(req.Denom); err != nil {
+		return nil,
+			status.Errorf(
+				codes.InvalidArgument,
+				"invalid denom (%s): %s",
+				req.Denom,
+				err,
+			)
+	}
+
+	// Apply pagination to the snapshots of the denom
+	snapshots, pageRes, err := pagination.CollectionPaginate(
+		ctx,
+		k.DenomSnapshots,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], snapshot types.Snapshot) (types.Snapshot, error) {
+			return snapshot, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Denom),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySnapshotsResponse{
+		Snapshots:  snapshots,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) BalanceAtSnapshot(ctx context.Context, req *types.QueryBalanceAtSnapshotRequest) (*types.QueryBalanceAtSnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil,
+			status.Errorf(
+				codes.InvalidArgument,
+				"invalid address (%s): %s",
+				req.Address,
+				err,
+			)
+	}
+
+	if _, found := k.GetSnapshot(ctx, req.Denom, req.SnapshotId); !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	balance := k.GetBalanceAtSnapshot(ctx, req.Denom, req.SnapshotId, address)
+
+	return &types.QueryBalanceAtSnapshotResponse{
+		Balance: sdk.NewCoin(req.Denom, math.NewIntFromBigInt(balance.BigInt())),
+	}, nil
+}
+
+func (k Keeper) SupplyAtSnapshot(ctx context.Context, req *types.QuerySupplyAtSnapshotRequest) (*types.QuerySupplyAtSnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	snapshot, found := k.GetSnapshot(ctx, req.Denom, req.SnapshotId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QuerySupplyAtSnapshotResponse{
+		Supply:   sdk.NewCoin(snapshot.Denom, math.NewIntFromBigInt(snapshot.Supply.BigInt())),
+		Snapshot: snapshot,
+	}, nil
+}
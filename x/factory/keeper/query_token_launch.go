@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zigchain/x/factory/types"
+	"zigchain/zutils/pagination"
+)
+
+func (k Keeper) TokenLaunches(ctx context.Context, req *types.QueryTokenLaunchesRequest) (*types.QueryTokenLaunchesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// Apply pagination to the launches
+	launches, pageRes, err := pagination.CollectionPaginate(
+		ctx,
+		k.Launches,
+		req.Pagination,
+		func(_ string, launch types.TokenLaunch) (types.TokenLaunch, error) {
+			return launch, nil
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenLaunchesResponse{
+		Launches:   launches,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) TokenLaunch(ctx context.Context, req *types.QueryTokenLaunchRequest) (*types.QueryTokenLaunchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	launch, found := k.GetTokenLaunch(ctx, req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryTokenLaunchResponse{
+		Launch:    launch,
+		Price:     launch.Price(),
+		MarketCap: launch.MarketCap(),
+	}, nil
+}
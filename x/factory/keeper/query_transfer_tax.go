@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zigchain/x/factory/types"
+	"zigchain/zutils/pagination"
+	"zigchain/zutils/validators"
+)
+
+func (k Keeper) TransferTax(ctx context.Context, req *types.QueryTransferTaxRequest) (*types.QueryTransferTaxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tax, found := k.GetTransferTax(ctx, req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryTransferTaxResponse{
+		TransferTax: tax,
+	}, nil
+}
+
+func (k Keeper) TransferTaxExemptions(
+	ctx context.Context,
+	req *types.QueryTransferTaxExemptionsRequest,
+) (*types.QueryTransferTaxExemptionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := validators.CheckDenomString(req.Denom); err != nil {
+		return nil,
+			status.Errorf(
+				codes.InvalidArgument,
+				"invalid denom (%s): %s",
+				req.Denom,
+				err,
+			)
+	}
+
+	// Apply pagination to the exempted addresses of the denom
+	addresses, pageRes, err := pagination.CollectionPaginate(
+		ctx,
+		k.TaxExemptions,
+		req.Pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (string, error) {
+			return key.K2(), nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Denom),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTransferTaxExemptionsResponse{
+		Addresses:  addresses,
+		Pagination: pageRes,
+	}, nil
+}
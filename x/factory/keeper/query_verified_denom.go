@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zigchain/x/factory/types"
+	"zigchain/zutils/pagination"
+)
+
+func (k Keeper) VerifiedDenoms(ctx context.Context, req *types.QueryVerifiedDenomsRequest) (*types.QueryVerifiedDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// Apply pagination to the verified denoms
+	verifiedDenoms, pageRes, err := pagination.CollectionPaginate(
+		ctx,
+		k.VerifiedDenomStore,
+		req.Pagination,
+		func(_ string, verified types.VerifiedDenom) (types.VerifiedDenom, error) {
+			return verified, nil
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVerifiedDenomsResponse{
+		VerifiedDenoms: verifiedDenoms,
+		Pagination:     pageRes,
+	}, nil
+}
+
+func (k Keeper) VerifiedDenomByTicker(ctx context.Context, req *types.QueryVerifiedDenomByTickerRequest) (*types.QueryVerifiedDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	verified, found := k.GetVerifiedDenomByTicker(ctx, req.Ticker)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryVerifiedDenomResponse{
+		VerifiedDenom: verified,
+	}, nil
+}
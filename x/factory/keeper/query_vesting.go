@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zigchain/x/factory/types"
+	"zigchain/zutils/pagination"
+	"zigchain/zutils/validators"
+)
+
+func (k Keeper) VestingGrants(ctx context.Context, req *types.QueryVestingGrantsRequest) (*types.QueryVestingGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := validators.CheckDenomString(req.Denom); err != nil {
+		return nil,
+			status.Errorf(
+				codes.InvalidArgument,
+				"invalid denom (%s): %s",
+				req.Denom,
+				err,
+			)
+	}
+
+	// Apply pagination to the vesting grants of the denom
+	grants, pageRes, err := pagination.CollectionPaginate(
+		ctx,
+		k.Grants,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], grant types.VestingGrant) (types.VestingGrant, error) {
+			return grant, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Denom),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVestingGrantsResponse{
+		Grants:     grants,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) VestingGrant(ctx context.Context, req *types.QueryVestingGrantRequest) (*types.QueryVestingGrantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := validators.CheckDenomString(req.Denom); err != nil {
+		return nil,
+			status.Errorf(
+				codes.InvalidArgument,
+				"invalid denom (%s): %s",
+				req.Denom,
+				err,
+			)
+	}
+
+	grant, found := k.GetVestingGrant(ctx, req.Denom, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	return &types.QueryVestingGrantResponse{
+		Grant:     grant,
+		Vested:    grant.Vested(now),
+		Claimable: grant.Claimable(now),
+	}, nil
+}
+
+func (k Keeper) OutstandingVesting(ctx context.Context, req *types.QueryOutstandingVestingRequest) (*types.QueryOutstandingVestingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := validators.CheckDenomString(req.Denom); err != nil {
+		return nil,
+			status.Errorf(
+				codes.InvalidArgument,
+				"invalid denom (%s): %s",
+				req.Denom,
+				err,
+			)
+	}
+
+	iterator, err := k.Grants.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](req.Denom))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	grants, err := iterator.Values()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	total, outstanding, escrowed := math.ZeroUint(), math.ZeroUint(), math.ZeroUint()
+	for _, grant := range grants {
+		total = total.Add(grant.Amount)
+		outstanding = outstanding.Add(grant.Amount.Sub(grant.Vested(now)))
+		if grant.Escrow {
+			escrowed = escrowed.Add(grant.Amount.Sub(grant.Claimed))
+		}
+	}
+
+	return &types.QueryOutstandingVestingResponse{
+		Total:       total,
+		Outstanding: outstanding,
+		Escrowed:    escrowed,
+	}, nil
+}
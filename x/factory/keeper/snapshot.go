@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"zigchain/x/factory/types"
+)
+
+var _ banktypes.SendRestrictionFn = Keeper{}.SnapshotRestriction
+
+// SetSnapshot set a specific snapshot in the store from its index
+func (k Keeper) SetSnapshot(ctx context.Context, snapshot types.Snapshot) {
+	if err := k.DenomSnapshots.Set(ctx, collections.Join(snapshot.Denom, snapshot.Id), snapshot); err != nil {
+		panic(err)
+	}
+}
+
+// GetSnapshot returns a snapshot from its index
+func (k Keeper) GetSnapshot(ctx context.Context, denom string, id uint64) (val types.Snapshot, found bool) {
+	val, err := k.DenomSnapshots.Get(ctx, collections.Join(denom, id))
+	if err != nil {
+		return val, false
+	}
+
+	return val, true
+}
+
+// GetAllSnapshot returns all snapshot
+func (k Keeper) GetAllSnapshot(ctx context.Context) (list []types.Snapshot) {
+	iterator, err := k.DenomSnapshots.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// SetSnapshotBalance set the balance of an account at a snapshot
+func (k Keeper) SetSnapshotBalance(ctx context.Context, balance types.SnapshotBalance) {
+	key := collections.Join3(balance.Denom, balance.Address, balance.SnapshotId)
+	if err := k.SnapshotBalances.Set(ctx, key, balance); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllSnapshotBalance returns all snapshotBalance
+func (k Keeper) GetAllSnapshotBalance(ctx context.Context) (list []types.SnapshotBalance) {
+	iterator, err := k.SnapshotBalances.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// GetSnapshotCount returns the number of snapshots of a denom, which is the id of the next one
+func (k Keeper) GetSnapshotCount(ctx context.Context, denom string) uint64 {
+	count, err := k.SnapshotCounts.Get(ctx, denom)
+	if err != nil {
+		return 0
+	}
+
+	return count
+}
+
+// SetSnapshotCount sets the number of snapshots of a denom
+func (k Keeper) SetSnapshotCount(ctx context.Context, denom string, count uint64) {
+	if err := k.SnapshotCounts.Set(ctx, denom, count); err != nil {
+		panic(err)
+	}
+}
+
+// CreateSnapshot records the supply of a denom as a new snapshot, the balances are then copied
+// on their first change by the SnapshotRestriction
+func (k Keeper) CreateSnapshot(ctx context.Context, denom string) types.Snapshot {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	id := k.GetSnapshotCount(ctx, denom)
+
+	snapshot := types.Snapshot{
+		Denom:  denom,
+		Id:     id,
+		Height: sdkCtx.BlockHeight(),
+		Time:   sdkCtx.BlockTime().Unix(),
+		Supply: math.NewUintFromBigInt(k.bankKeeper.GetSupply(ctx, denom).Amount.BigInt()),
+	}
+	k.SetSnapshot(ctx, snapshot)
+	k.SetSnapshotCount(ctx, denom, id+1)
+
+	return snapshot
+}
+
+// GetBalanceAtSnapshot returns the balance of an account at a snapshot. The balance copied at the
+// first later snapshot is the one of the snapshot, as the account did not change in between.
+// Without any copy, the account did not change since the snapshot.
+func (k Keeper) GetBalanceAtSnapshot(ctx context.Context, denom string, id uint64, address sdk.AccAddress) math.Uint {
+	laterSnapshots := new(collections.Range[collections.Triple[string, string, uint64]]).
+		StartInclusive(collections.Join3(denom, address.String(), id)).
+		EndInclusive(collections.Join3(denom, address.String(), ^uint64(0)))
+
+	iterator, err := k.SnapshotBalances.Iterate(ctx, laterSnapshots)
+	if err != nil {
+		panic(err)
+	}
+	defer iterator.Close()
+
+	if iterator.Valid() {
+		balance, err := iterator.Value()
+		if err != nil {
+			panic(err)
+		}
+
+		return balance.Amount
+	}
+
+	return math.NewUintFromBigInt(k.bankKeeper.GetBalance(ctx, address, denom).Amount.BigInt())
+}
+
+// recordSnapshotBalance copies the balance of an account before its first change after the
+// latest snapshot of the denom
+func (k Keeper) recordSnapshotBalance(ctx context.Context, denom string, snapshotID uint64, address sdk.AccAddress) {
+	key := collections.Join3(denom, address.String(), snapshotID)
+
+	recorded, err := k.SnapshotBalances.Has(ctx, key)
+	if err != nil {
+		panic(err)
+	}
+	if recorded {
+		return
+	}
+
+	k.SetSnapshotBalance(ctx, types.SnapshotBalance{
+		Denom:      denom,
+		SnapshotId: snapshotID,
+		Address:    address.String(),
+		Amount:     math.NewUintFromBigInt(k.bankKeeper.GetBalance(ctx, address, denom).Amount.BigInt()),
+	})
+}
+
+// SnapshotRestriction is the bank send restriction copying the balances of the sender and the
+// recipient of a factory denom before their first change after the latest snapshot of the denom.
+// It never rejects a transfer. The factory module account is skipped, as it only holds factory
+// denoms while minting or burning them.
+func (k Keeper) SnapshotRestriction(
+	ctx context.Context,
+	fromAddr sdk.AccAddress,
+	toAddr sdk.AccAddress,
+	amt sdk.Coins,
+) (sdk.AccAddress, error) {
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+
+	for _, coin := range amt {
+		// only factory denoms have snapshots, skip the store lookups for the others
+		if !isFactoryDenom(coin.Denom) {
+			continue
+		}
+
+		count := k.GetSnapshotCount(ctx, coin.Denom)
+		if count == 0 {
+			continue
+		}
+
+		for _, address := range []sdk.AccAddress{fromAddr, toAddr} {
+			if !address.Equals(moduleAddress) {
+				k.recordSnapshotBalance(ctx, coin.Denom, count-1, address)
+			}
+		}
+	}
+
+	return toAddr, nil
+}
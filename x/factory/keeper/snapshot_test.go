@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zigchain/testutil/sample"
+	"zigchain/x/factory/keeper"
+	"zigchain/x/factory/types"
+)
+
+// Positive test cases
+
+func TestSnapshot_BalancesCopiedOnFirstChange(t *testing.T) {
+	// Test case: the balances are copied once per snapshot, before their first change
+
+	factoryKeeper, ctx, bankKeeper, _, denom, denomAuth := setupMintVestedTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	carol := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	coins := sdk.NewCoins(sdk.NewCoin(denom.Denom, cosmosmath.NewInt(30)))
+	balance := func(amount int64) sdk.Coin { return sdk.NewCoin(denom.Denom, cosmosmath.NewInt(amount)) }
+
+	// no snapshot yet, nothing is copied
+	_, err := factoryKeeper.SnapshotRestriction(ctx, alice, bob, coins)
+	require.NoError(t, err)
+
+	bankKeeper.EXPECT().GetSupply(ctx, denom.Denom).Return(balance(1000)).Times(1)
+	resp, err := server.TakeSnapshot(ctx, types.NewMsgTakeSnapshot(denomAuth.BankAdmin, denom.Denom))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), resp.Snapshot.Id)
+	require.Equal(t, cosmosmath.NewUint(1000), resp.Snapshot.Supply)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventSnapshotTaken, events[len(events)-1].Type)
+
+	// the first transfer copies both balances, the next ones do not
+	bankKeeper.EXPECT().GetBalance(ctx, alice, denom.Denom).Return(balance(100)).Times(1)
+	bankKeeper.EXPECT().GetBalance(ctx, bob, denom.Denom).Return(balance(0)).Times(1)
+	for i := 0; i < 2; i++ {
+		to, err := factoryKeeper.SnapshotRestriction(ctx, alice, bob, coins)
+		require.NoError(t, err)
+		require.Equal(t, bob, to)
+	}
+
+	bankKeeper.EXPECT().GetSupply(ctx, denom.Denom).Return(balance(1000)).Times(1)
+	_, err = server.TakeSnapshot(ctx, types.NewMsgTakeSnapshot(denomAuth.BankAdmin, denom.Denom))
+	require.NoError(t, err)
+
+	bankKeeper.EXPECT().GetBalance(ctx, alice, denom.Denom).Return(balance(40)).Times(1)
+	bankKeeper.EXPECT().GetBalance(ctx, carol, denom.Denom).Return(balance(5)).Times(1)
+	_, err = factoryKeeper.SnapshotRestriction(ctx, alice, carol, coins)
+	require.NoError(t, err)
+
+	// alice changed after both snapshots
+	require.Equal(t, cosmosmath.NewUint(100), factoryKeeper.GetBalanceAtSnapshot(ctx, denom.Denom, 0, alice))
+	require.Equal(t, cosmosmath.NewUint(40), factoryKeeper.GetBalanceAtSnapshot(ctx, denom.Denom, 1, alice))
+
+	// carol only changed after the second snapshot, which holds her balance at the first one
+	require.Equal(t, cosmosmath.NewUint(5), factoryKeeper.GetBalanceAtSnapshot(ctx, denom.Denom, 0, carol))
+
+	// bob did not change after the second snapshot, his balance at it is the current one
+	bankKeeper.EXPECT().GetBalance(ctx, bob, denom.Denom).Return(balance(60)).Times(1)
+	query, err := factoryKeeper.BalanceAtSnapshot(ctx, &types.QueryBalanceAtSnapshotRequest{
+		Denom:      denom.Denom,
+		SnapshotId: 1,
+		Address:    bob.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, balance(60), query.Balance)
+
+	supply, err := factoryKeeper.SupplyAtSnapshot(ctx, &types.QuerySupplyAtSnapshotRequest{Denom: denom.Denom, SnapshotId: 1})
+	require.NoError(t, err)
+	require.Equal(t, balance(1000), supply.Supply)
+
+	snapshots, err := factoryKeeper.Snapshots(ctx, &types.QuerySnapshotsRequest{Denom: denom.Denom})
+	require.NoError(t, err)
+	require.Len(t, snapshots.Snapshots, 2)
+}
+
+func TestSnapshotRestriction_SkipsFactoryModuleAndOtherDenoms(t *testing.T) {
+	// Test case: the factory module account and the denoms without snapshots are not copied
+
+	factoryKeeper, ctx, bankKeeper, _, denom, _ := setupMintVestedTest(t)
+
+	bankKeeper.EXPECT().GetSupply(ctx, denom.Denom).Return(sdk.NewCoin(denom.Denom, cosmosmath.ZeroInt())).Times(1)
+	factoryKeeper.CreateSnapshot(ctx, denom.Denom)
+
+	recipient := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bankKeeper.EXPECT().GetBalance(ctx, recipient, denom.Denom).Return(sdk.NewCoin(denom.Denom, cosmosmath.ZeroInt())).Times(1)
+
+	coins := sdk.NewCoins(
+		sdk.NewCoin(denom.Denom, cosmosmath.NewInt(10)),
+		sdk.NewCoin("uzig", cosmosmath.NewInt(10)),
+	)
+	_, err := factoryKeeper.SnapshotRestriction(ctx, authtypes.NewModuleAddress(types.ModuleName), recipient, coins)
+	require.NoError(t, err)
+
+	require.Len(t, factoryKeeper.GetAllSnapshotBalance(ctx), 1)
+}
+
+// Negative test cases
+
+func TestMsgServer_TakeSnapshot_Unauthorized(t *testing.T) {
+	// Test case: only the bank admin can take a snapshot
+
+	factoryKeeper, ctx, _, _, denom, _ := setupMintVestedTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	_, err := server.TakeSnapshot(ctx, types.NewMsgTakeSnapshot(sample.AccAddress(), denom.Denom))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Zero(t, factoryKeeper.GetSnapshotCount(ctx, denom.Denom))
+}
+
+func TestQuery_BalanceAtSnapshot_NotFound(t *testing.T) {
+	// Test case: querying a missing snapshot fails
+
+	factoryKeeper, ctx, _, _, denom, _ := setupMintVestedTest(t)
+
+	_, err := factoryKeeper.BalanceAtSnapshot(ctx, &types.QueryBalanceAtSnapshotRequest{
+		Denom:      denom.Denom,
+		SnapshotId: 0,
+		Address:    sample.AccAddress(),
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = factoryKeeper.SupplyAtSnapshot(ctx, &types.QuerySupplyAtSnapshotRequest{Denom: denom.Denom, SnapshotId: 0})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
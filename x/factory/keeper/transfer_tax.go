@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"zigchain/x/factory/events"
+	"zigchain/x/factory/types"
+)
+
+var _ banktypes.SendRestrictionFn = Keeper{}.TransferTaxRestriction
+
+// factoryModuleAccounts are the accounts of the factory module and its escrows, the transfers
+// from and to them are never taxed
+var factoryModuleAccounts = []string{
+	types.ModuleName,
+	types.VestingEscrowName,
+	types.AirdropEscrowName,
+	types.DistributionEscrowName,
+	types.CW20EscrowName,
+	types.LaunchpadEscrowName,
+}
+
+// transferTaxContextKey marks the context of the payment of a transfer tax to its recipient. The
+// transfer it is paid for already went through the send restrictions, so only the snapshots and the
+// distributions, which account for the balances, apply to the payment.
+type transferTaxContextKey struct{}
+
+// isTransferTax tells if the context is the one of a transfer tax payment
+func isTransferTax(ctx context.Context) bool {
+	return sdk.UnwrapSDKContext(ctx).Value(transferTaxContextKey{}) != nil
+}
+
+// SetTransferTax set the transfer tax of a denom in the store
+func (k Keeper) SetTransferTax(ctx context.Context, tax types.TransferTax) {
+	if err := k.TransferTaxes.Set(ctx, tax.Denom, tax); err != nil {
+		panic(err)
+	}
+}
+
+// GetTransferTax returns the transfer tax of a denom
+func (k Keeper) GetTransferTax(ctx context.Context, denom string) (val types.TransferTax, found bool) {
+	val, err := k.TransferTaxes.Get(ctx, denom)
+	if err != nil {
+		return val, false
+	}
+
+	return val, true
+}
+
+// RemoveTransferTax removes the transfer tax of a denom from the store
+func (k Keeper) RemoveTransferTax(ctx context.Context, denom string) {
+	if err := k.TransferTaxes.Remove(ctx, denom); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllTransferTax returns all transferTax
+func (k Keeper) GetAllTransferTax(ctx context.Context) (list []types.TransferTax) {
+	iterator, err := k.TransferTaxes.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// SetTransferTaxExemption exempts an address from the transfer tax of a denom
+func (k Keeper) SetTransferTaxExemption(ctx context.Context, denom string, address string) {
+	if err := k.TaxExemptions.Set(ctx, collections.Join(denom, address)); err != nil {
+		panic(err)
+	}
+}
+
+// RemoveTransferTaxExemption lifts the transfer tax exemption of an address for a denom
+func (k Keeper) RemoveTransferTaxExemption(ctx context.Context, denom string, address string) {
+	if err := k.TaxExemptions.Remove(ctx, collections.Join(denom, address)); err != nil {
+		panic(err)
+	}
+}
+
+// IsTransferTaxExempt tells if an address is exempted from the transfer tax of a denom
+func (k Keeper) IsTransferTaxExempt(ctx context.Context, denom string, address string) bool {
+	exempt, err := k.TaxExemptions.Has(ctx, collections.Join(denom, address))
+	if err != nil {
+		return false
+	}
+
+	return exempt
+}
+
+// GetAllTransferTaxExemption returns all transferTaxExemption
+func (k Keeper) GetAllTransferTaxExemption(ctx context.Context) (list []types.TransferTaxExemption) {
+	iterator, err := k.TaxExemptions.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	keys, err := iterator.Keys()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, key := range keys {
+		list = append(list, types.TransferTaxExemption{Denom: key.K1(), Address: key.K2()})
+	}
+
+	return
+}
+
+// TransferTaxRate returns the transfer tax rate of a denom and its recipient when the transfers
+// between two addresses are taxed. The dex uses it to take the tax out of the amounts leaving
+// its pools, which the send restriction does not tax as they are module accounts.
+func (k Keeper) TransferTaxRate(
+	ctx context.Context,
+	denom string,
+	fromAddr sdk.AccAddress,
+	toAddr sdk.AccAddress,
+) (rate math.LegacyDec, recipient sdk.AccAddress, taxed bool) {
+	if !isFactoryDenom(denom) {
+		return math.LegacyZeroDec(), nil, false
+	}
+
+	tax, found := k.GetTransferTax(ctx, denom)
+	if !found || !k.isTransferTaxed(ctx, tax, fromAddr, toAddr) {
+		return math.LegacyZeroDec(), nil, false
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(tax.Recipient)
+	if err != nil {
+		return math.LegacyZeroDec(), nil, false
+	}
+
+	return tax.Rate, recipient, true
+}
+
+// isTransferTaxed tells if a transfer of a taxed denom is taxed, the transfers from and to
+// the tax recipient, the factory module accounts and the exempted addresses are not
+func (k Keeper) isTransferTaxed(ctx context.Context, tax types.TransferTax, fromAddr, toAddr sdk.AccAddress) bool {
+	for _, address := range []sdk.AccAddress{fromAddr, toAddr} {
+		if address.String() == tax.Recipient || isFactoryModuleAccount(address) {
+			return false
+		}
+
+		if k.IsTransferTaxExempt(ctx, tax.Denom, address.String()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isFactoryModuleAccount tells if an address is the account of the factory module or of one of its escrows
+func isFactoryModuleAccount(address sdk.AccAddress) bool {
+	for _, name := range factoryModuleAccounts {
+		if address.Equals(authtypes.NewModuleAddress(name)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TransferTaxRestriction is the bank send restriction collecting the transfer taxes of the
+// factory denoms. The sender pays the tax on top of the amount sent, so the recipient receives
+// the full amount. The module accounts, such as the dex pools and the IBC escrows, never pay
+// the tax as their balances are accounted for by their modules. The tax is paid apart from
+// the amount, in a context the freezes, the before send hooks, the transfer taxes and the
+// launches do not apply to, so its payment does not go through the restrictions again.
+func (k Keeper) TransferTaxRestriction(
+	ctx context.Context,
+	fromAddr sdk.AccAddress,
+	toAddr sdk.AccAddress,
+	amt sdk.Coins,
+) (sdk.AccAddress, error) {
+	if isForceTransfer(ctx) || isTransferTax(ctx) {
+		return toAddr, nil
+	}
+
+	// the tax is paid in its own context, which the other restrictions recognise
+	taxCtx := sdk.UnwrapSDKContext(ctx).WithValue(transferTaxContextKey{}, struct{}{})
+
+	for _, coin := range amt {
+		// only factory denoms can be taxed, skip the store lookups for the others
+		if !isFactoryDenom(coin.Denom) {
+			continue
+		}
+
+		tax, found := k.GetTransferTax(ctx, coin.Denom)
+		if !found || !k.isTransferTaxed(ctx, tax, fromAddr, toAddr) {
+			continue
+		}
+
+		if _, ok := k.accountKeeper.GetAccount(ctx, fromAddr).(sdk.ModuleAccountI); ok {
+			continue
+		}
+
+		amount := tax.Tax(coin.Amount)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		paid := sdk.NewCoin(coin.Denom, amount)
+		if err := k.bankKeeper.SendCoins(taxCtx, fromAddr, sdk.MustAccAddressFromBech32(tax.Recipient), sdk.NewCoins(paid)); err != nil {
+			return toAddr, errorsmod.Wrapf(
+				err,
+				"transfer tax (%s) of sender (%s)",
+				paid,
+				fromAddr,
+			)
+		}
+
+		events.EmitTransferTaxPaid(sdk.UnwrapSDKContext(ctx), fromAddr.String(), toAddr.String(), tax.Recipient, paid)
+	}
+
+	return toAddr, nil
+}
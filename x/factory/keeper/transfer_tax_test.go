@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"zigchain/testutil/sample"
+	"zigchain/x/factory/keeper"
+	"zigchain/x/factory/types"
+)
+
+// Positive test cases
+
+func TestMsgServer_SetTransferTax_Positive(t *testing.T) {
+	// Test case: the bank admin sets the transfer tax of a denom and exempts an address,
+	// the queries return them and a zero rate removes the tax
+
+	factoryKeeper, ctx, _, denom, denomAuth := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	treasury := sample.AccAddress()
+	resp, err := server.SetTransferTax(ctx, types.NewMsgSetTransferTax(denomAuth.BankAdmin, denom.Denom, cosmosmath.LegacyNewDecWithPrec(2, 2), treasury))
+	require.NoError(t, err)
+	expected := types.TransferTax{Denom: denom.Denom, Rate: cosmosmath.LegacyNewDecWithPrec(2, 2), Recipient: treasury}
+	require.Equal(t, expected, resp.TransferTax)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTransferTaxSet, events[len(events)-1].Type)
+
+	query, err := factoryKeeper.TransferTax(ctx, &types.QueryTransferTaxRequest{Denom: denom.Denom})
+	require.NoError(t, err)
+	require.Equal(t, expected, query.TransferTax)
+
+	pool := sample.AccAddress()
+	_, err = server.SetTransferTaxExemption(ctx, types.NewMsgSetTransferTaxExemption(denomAuth.BankAdmin, denom.Denom, pool, true))
+	require.NoError(t, err)
+
+	exemptions, err := factoryKeeper.TransferTaxExemptions(ctx, &types.QueryTransferTaxExemptionsRequest{Denom: denom.Denom})
+	require.NoError(t, err)
+	require.Equal(t, []string{pool}, exemptions.Addresses)
+
+	_, err = server.SetTransferTaxExemption(ctx, types.NewMsgSetTransferTaxExemption(denomAuth.BankAdmin, denom.Denom, pool, false))
+	require.NoError(t, err)
+	require.False(t, factoryKeeper.IsTransferTaxExempt(ctx, denom.Denom, pool))
+
+	_, err = server.SetTransferTax(ctx, types.NewMsgSetTransferTax(denomAuth.BankAdmin, denom.Denom, cosmosmath.LegacyZeroDec(), ""))
+	require.NoError(t, err)
+
+	_, found := factoryKeeper.GetTransferTax(ctx, denom.Denom)
+	require.False(t, found)
+}
+
+func TestTransferTaxRestriction(t *testing.T) {
+	// Test case: the sender pays the tax on top of a transfer, the transfers of the exempted addresses,
+	// the tax recipient and the module accounts are not taxed
+
+	factoryKeeper, ctx, bankKeeper, accountKeeper, denom, _ := setupMintVestedTest(t)
+
+	pool := authtypes.NewModuleAddress("pool1")
+	accountKeeper.EXPECT().
+		GetAccount(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, address sdk.AccAddress) sdk.AccountI {
+			if address.Equals(pool) {
+				return authtypes.NewEmptyModuleAccount("pool1")
+			}
+			return nil
+		}).
+		AnyTimes()
+
+	treasury := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	factoryKeeper.SetTransferTax(ctx, types.TransferTax{
+		Denom:     denom.Denom,
+		Rate:      cosmosmath.LegacyNewDecWithPrec(25, 3),
+		Recipient: treasury.String(),
+	})
+
+	from := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	to := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	amt := sdk.NewCoins(sdk.NewCoin(denom.Denom, cosmosmath.NewInt(1000)), sdk.NewInt64Coin("uzig", 1000))
+
+	// 2.5% of 1000, the other denoms are not taxed. The restrictions do not apply to the payment
+	// again, a frozen treasury still receives it and it is not taxed a second time.
+	factoryKeeper.SetFrozenAccount(ctx, denom.Denom, treasury.String())
+	bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), from, treasury, sdk.NewCoins(sdk.NewCoin(denom.Denom, cosmosmath.NewInt(25)))).
+		DoAndReturn(func(taxCtx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, paid sdk.Coins) error {
+			if _, err := factoryKeeper.FreezeRestriction(taxCtx, fromAddr, toAddr, paid); err != nil {
+				return err
+			}
+			_, err := factoryKeeper.TransferTaxRestriction(taxCtx, fromAddr, to, paid)
+			return err
+		}).
+		Times(1)
+
+	newTo, err := factoryKeeper.TransferTaxRestriction(ctx, from, to, amt)
+	require.NoError(t, err)
+	require.Equal(t, to, newTo)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTransferTaxPaid, events[len(events)-1].Type)
+	factoryKeeper.DeleteFrozenAccount(ctx, denom.Denom, treasury.String())
+
+	rate, recipient, taxed := factoryKeeper.TransferTaxRate(ctx, denom.Denom, pool, to)
+	require.True(t, taxed)
+	require.Equal(t, cosmosmath.LegacyNewDecWithPrec(25, 3), rate)
+	require.Equal(t, treasury, recipient)
+
+	// the bank keeper mock fails the test on any other tax payment
+	for _, transfer := range [][2]sdk.AccAddress{
+		{from, treasury},
+		{pool, to},
+		{from, authtypes.NewModuleAddress(types.ModuleName)},
+	} {
+		_, err = factoryKeeper.TransferTaxRestriction(ctx, transfer[0], transfer[1], amt)
+		require.NoError(t, err)
+	}
+
+	factoryKeeper.SetTransferTaxExemption(ctx, denom.Denom, to.String())
+	_, err = factoryKeeper.TransferTaxRestriction(ctx, from, to, amt)
+	require.NoError(t, err)
+
+	_, _, taxed = factoryKeeper.TransferTaxRate(ctx, denom.Denom, pool, to)
+	require.False(t, taxed)
+
+	// a tax rounded down to zero is not collected
+	factoryKeeper.RemoveTransferTaxExemption(ctx, denom.Denom, to.String())
+	_, err = factoryKeeper.TransferTaxRestriction(ctx, from, to, sdk.NewCoins(sdk.NewCoin(denom.Denom, cosmosmath.NewInt(39))))
+	require.NoError(t, err)
+}
+
+// Negative test cases
+
+func TestMsgServer_SetTransferTax_Invalid(t *testing.T) {
+	// Test case: only the bank admin sets the tax and exemptions, the rate is capped by the params,
+	// and a failed tax payment fails the transfer
+
+	factoryKeeper, ctx, bankKeeper, accountKeeper, denom, denomAuth := setupMintVestedTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+	treasury := sample.AccAddress()
+
+	_, err := server.SetTransferTax(ctx, types.NewMsgSetTransferTax(sample.AccAddress(), denom.Denom, cosmosmath.LegacyNewDecWithPrec(1, 2), treasury))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = server.SetTransferTaxExemption(ctx, types.NewMsgSetTransferTaxExemption(sample.AccAddress(), denom.Denom, treasury, true))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = server.SetTransferTax(ctx, types.NewMsgSetTransferTax(denomAuth.BankAdmin, denom.Denom, cosmosmath.LegacyNewDecWithPrec(11, 2), treasury))
+	require.ErrorIs(t, err, types.ErrTransferTaxRateTooHigh)
+
+	_, err = factoryKeeper.TransferTax(ctx, &types.QueryTransferTaxRequest{Denom: denom.Denom})
+	require.Error(t, err)
+
+	_, err = server.SetTransferTax(ctx, types.NewMsgSetTransferTax(denomAuth.BankAdmin, denom.Denom, cosmosmath.LegacyNewDecWithPrec(1, 1), treasury))
+	require.NoError(t, err)
+
+	from := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	accountKeeper.EXPECT().
+		GetAccount(gomock.Any(), from).
+		Return(nil).
+		Times(1)
+	bankKeeper.EXPECT().
+		SendCoins(gomock.Any(), from, gomock.Any(), gomock.Any()).
+		Return(sdkerrors.ErrInsufficientFunds).
+		Times(1)
+
+	_, err = factoryKeeper.TransferTaxRestriction(ctx, from, sdk.MustAccAddressFromBech32(sample.AccAddress()), sdk.NewCoins(sdk.NewCoin(denom.Denom, cosmosmath.NewInt(100))))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"zigchain/x/factory/types"
+)
+
+// SetVerifiedDenom set a specific verifiedDenom in the store from its index
+func (k Keeper) SetVerifiedDenom(ctx context.Context, verified types.VerifiedDenom) {
+	if err := k.VerifiedDenomStore.Set(ctx, verified.Denom, verified); err != nil {
+		panic(err)
+	}
+}
+
+// GetVerifiedDenom returns the verifiedDenom of a denom
+func (k Keeper) GetVerifiedDenom(ctx context.Context, denom string) (val types.VerifiedDenom, found bool) {
+	val, err := k.VerifiedDenomStore.Get(ctx, denom)
+	if err != nil {
+		return val, false
+	}
+
+	return val, true
+}
+
+// GetVerifiedDenomByTicker returns the verifiedDenom of a ticker
+func (k Keeper) GetVerifiedDenomByTicker(ctx context.Context, ticker string) (val types.VerifiedDenom, found bool) {
+	denom, err := k.VerifiedDenomStore.Indexes.Ticker.MatchExact(ctx, ticker)
+	if err != nil {
+		return val, false
+	}
+
+	return k.GetVerifiedDenom(ctx, denom)
+}
+
+// RemoveVerifiedDenom removes a verifiedDenom from the store
+func (k Keeper) RemoveVerifiedDenom(ctx context.Context, denom string) {
+	if err := k.VerifiedDenomStore.Remove(ctx, denom); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllVerifiedDenom returns all verifiedDenom
+func (k Keeper) GetAllVerifiedDenom(ctx context.Context) (list []types.VerifiedDenom) {
+	iterator, err := k.VerifiedDenomStore.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// CheckVerifiedDenomCurator returns an error if the signer is neither the authority
+// nor one of the verified denom curators
+func (k Keeper) CheckVerifiedDenomCurator(ctx context.Context, signer string) error {
+	if signer == k.GetAuthority() || k.GetParams(ctx).IsVerifiedDenomCurator(signer) {
+		return nil
+	}
+
+	return errorsmod.Wrapf(
+		types.ErrInvalidSignerAccount,
+		"only the authority (%s) or a verified denom curator can change the verified denom registry (attempted with: %s)",
+		k.GetAuthority(),
+		signer,
+	)
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+
+	"zigchain/x/factory/types"
+)
+
+// VerifiedDenomIndexes are the secondary indexes of the verified denom registry
+type VerifiedDenomIndexes struct {
+	// Ticker indexes the denom of each verified ticker, keeping the tickers unique
+	Ticker *indexes.Unique[string, string, types.VerifiedDenom]
+}
+
+// IndexesList returns the indexes maintained by the verified denom registry
+func (i VerifiedDenomIndexes) IndexesList() []collections.Index[string, types.VerifiedDenom] {
+	return []collections.Index[string, types.VerifiedDenom]{i.Ticker}
+}
+
+// NewVerifiedDenomIndexes creates the secondary indexes of the verified denom registry
+func NewVerifiedDenomIndexes(sb *collections.SchemaBuilder) VerifiedDenomIndexes {
+	return VerifiedDenomIndexes{
+		Ticker: indexes.NewUnique(
+			sb,
+			types.VerifiedDenomsByTickerPrefix,
+			"verified_denoms_by_ticker",
+			collections.StringKey,
+			collections.StringKey,
+			func(_ string, verified types.VerifiedDenom) (string, error) {
+				return verified.Ticker, nil
+			},
+		),
+	}
+}
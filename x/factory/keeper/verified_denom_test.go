@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"zigchain/testutil/sample"
+	"zigchain/x/factory/keeper"
+	"zigchain/x/factory/types"
+)
+
+var logoHash = strings.Repeat("ab", 32)
+
+// Positive test cases
+
+func TestMsgServer_VerifyDenom_Positive(t *testing.T) {
+	// Test case: a curator verifies a denom, the denom query and the registry queries show it,
+	// and the ticker is freed once the denom is unverified
+
+	factoryKeeper, ctx, bankKeeper, denom, _ := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+
+	curator := sample.AccAddress()
+	params := factoryKeeper.GetParams(ctx)
+	params.VerifiedDenomCurators = []string{curator}
+	require.NoError(t, factoryKeeper.SetParams(ctx, params))
+
+	resp, err := server.VerifyDenom(ctx, types.NewMsgVerifyDenom(curator, denom.Denom, "USDC", logoHash, 6))
+	require.NoError(t, err)
+	verified := types.VerifiedDenom{Denom: denom.Denom, Ticker: "USDC", UriHash: logoHash, Decimals: 6}
+	require.Equal(t, verified, resp.VerifiedDenom)
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventDenomVerified, events[len(events)-1].Type)
+
+	bankKeeper.EXPECT().GetSupply(gomock.Any(), denom.Denom).Return(sdk.NewInt64Coin(denom.Denom, 0)).AnyTimes()
+	denomResp, err := factoryKeeper.Denom(ctx, &types.QueryGetDenomRequest{Denom: denom.Denom})
+	require.NoError(t, err)
+	require.True(t, denomResp.Verified)
+	require.Equal(t, &verified, denomResp.VerifiedDenom)
+
+	all, err := factoryKeeper.VerifiedDenoms(ctx, &types.QueryVerifiedDenomsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.VerifiedDenom{verified}, all.VerifiedDenoms)
+
+	byTicker, err := factoryKeeper.VerifiedDenomByTicker(ctx, &types.QueryVerifiedDenomByTickerRequest{Ticker: "USDC"})
+	require.NoError(t, err)
+	require.Equal(t, verified, byTicker.VerifiedDenom)
+
+	// the authority updates the ticker of the denom, the old ticker is freed
+	_, err = server.VerifyDenom(ctx, types.NewMsgVerifyDenom(factoryKeeper.GetAuthority(), denom.Denom, "USDC2", logoHash, 6))
+	require.NoError(t, err)
+
+	_, found := factoryKeeper.GetVerifiedDenomByTicker(ctx, "USDC")
+	require.False(t, found)
+
+	_, err = server.UnverifyDenom(ctx, types.NewMsgUnverifyDenom(curator, denom.Denom))
+	require.NoError(t, err)
+
+	denomResp, err = factoryKeeper.Denom(ctx, &types.QueryGetDenomRequest{Denom: denom.Denom})
+	require.NoError(t, err)
+	require.False(t, denomResp.Verified)
+	require.Nil(t, denomResp.VerifiedDenom)
+
+	_, found = factoryKeeper.GetVerifiedDenomByTicker(ctx, "USDC2")
+	require.False(t, found)
+}
+
+// Negative test cases
+
+func TestMsgServer_VerifyDenom_Invalid(t *testing.T) {
+	// Test case: only the authority and the curators change the registry, the denom must exist,
+	// a ticker belongs to one denom and only verified denoms are unverified
+
+	factoryKeeper, ctx, _, denom, denomAuth := setupMinterTest(t)
+	server := keeper.NewMsgServerImpl(factoryKeeper)
+	authority := factoryKeeper.GetAuthority()
+
+	// the bank admin of the denom is not a curator
+	_, err := server.VerifyDenom(ctx, types.NewMsgVerifyDenom(denomAuth.BankAdmin, denom.Denom, "USDC", logoHash, 6))
+	require.ErrorIs(t, err, types.ErrInvalidSignerAccount)
+
+	_, err = server.VerifyDenom(ctx, types.NewMsgVerifyDenom(authority, "coin."+sample.AccAddress()+".usdc", "USDC", logoHash, 6))
+	require.ErrorIs(t, err, types.ErrDenomNotFound)
+
+	_, err = server.UnverifyDenom(ctx, types.NewMsgUnverifyDenom(authority, denom.Denom))
+	require.ErrorIs(t, err, types.ErrDenomNotVerified)
+
+	_, err = server.VerifyDenom(ctx, types.NewMsgVerifyDenom(authority, denom.Denom, "USDC", logoHash, 6))
+	require.NoError(t, err)
+
+	_, err = server.UnverifyDenom(ctx, types.NewMsgUnverifyDenom(denomAuth.BankAdmin, denom.Denom))
+	require.ErrorIs(t, err, types.ErrInvalidSignerAccount)
+
+	// a copycat denom can not take the ticker
+	copycat := types.Denom{
+		Creator:    sample.AccAddress(),
+		Minted:     denom.Minted,
+		MintingCap: denom.MintingCap,
+	}
+	copycat.Denom = "coin." + copycat.Creator + ".usdc"
+	factoryKeeper.SetDenom(ctx, copycat)
+
+	_, err = server.VerifyDenom(ctx, types.NewMsgVerifyDenom(authority, copycat.Denom, "USDC", logoHash, 6))
+	require.ErrorIs(t, err, types.ErrTickerTaken)
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"zigchain/x/factory/types"
+)
+
+// SetVestingGrant set a specific vestingGrant in the store from its denom and id
+func (k Keeper) SetVestingGrant(ctx context.Context, grant types.VestingGrant) {
+	if err := k.Grants.Set(ctx, collections.Join(grant.Denom, grant.Id), grant); err != nil {
+		panic(err)
+	}
+}
+
+// GetVestingGrant returns a vestingGrant from its denom and id
+func (k Keeper) GetVestingGrant(
+	ctx context.Context,
+	denom string,
+	id uint64,
+) (val types.VestingGrant, found bool) {
+	val, err := k.Grants.Get(ctx, collections.Join(denom, id))
+	if err != nil {
+		return val, false
+	}
+
+	return val, true
+}
+
+// GetAllVestingGrant returns all vestingGrant
+func (k Keeper) GetAllVestingGrant(ctx context.Context) (list []types.VestingGrant) {
+	iterator, err := k.Grants.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// GetVestingGrantCount returns the number of vesting grants created, the id of the next grant
+func (k Keeper) GetVestingGrantCount(ctx context.Context) uint64 {
+	count, err := k.VestingGrantSeq.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return count
+}
+
+// SetVestingGrantCount sets the number of vesting grants created
+func (k Keeper) SetVestingGrantCount(ctx context.Context, count uint64) {
+	if err := k.VestingGrantSeq.Set(ctx, count); err != nil {
+		panic(err)
+	}
+}
+
+// nextVestingGrantID returns the id of a new vesting grant
+func (k Keeper) nextVestingGrantID(ctx context.Context) uint64 {
+	id, err := k.VestingGrantSeq.Next(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
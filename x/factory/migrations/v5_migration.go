@@ -0,0 +1,9 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (m Migrator) V5Migration(ctx sdk.Context) error {
+	return m.keeper.V5Migration(ctx)
+}
@@ -56,6 +56,269 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					Example: "  zigchaind query factory denom-auth coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --chain-id zigchain",
 				},
+				{
+					RpcMethod: "BeforeSendHook",
+					Use:       "before-send-hook [denom]",
+					Short:     "Get the before send hook contract of a denom.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind query factory before-send-hook coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --chain-id zigchain",
+				},
+				{
+					RpcMethod: "FrozenAccounts",
+					Use:       "frozen-accounts [denom]",
+					Short:     "List the frozen accounts of a denom and tell if the denom is globally frozen.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind query factory frozen-accounts coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --chain-id zigchain",
+				},
+				{
+					RpcMethod: "IsFrozen",
+					Use:       "is-frozen [denom] [address]",
+					Short:     "Tell if an account can not send nor receive a denom.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "address"},
+					},
+					Example: "  zigchaind query factory is-frozen coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049 --chain-id zigchain",
+				},
+				{
+					RpcMethod: "Minters",
+					Use:       "minters [denom]",
+					Short:     "List the minters of a denom with their remaining allowance.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind query factory minters coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --chain-id zigchain",
+				},
+				{
+					RpcMethod: "Minter",
+					Use:       "minter [denom] [address]",
+					Short:     "Get the remaining allowance of a minter of a denom.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "address"},
+					},
+					Example: "  zigchaind query factory minter coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd zig1m4k6l4ymkf2ur3xlnpcm0ysktsfzzfhmng9m9a --chain-id zigchain",
+				},
+				{
+					RpcMethod: "MintRateLimit",
+					Use:       "mint-rate-limit [denom]",
+					Short:     "Get the mint rate limit of a denom with the amount that can still be minted in the current window.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind query factory mint-rate-limit coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --chain-id zigchain",
+				},
+				{
+					RpcMethod: "VestingGrants",
+					Use:       "vesting-grants [denom]",
+					Short:     "List the vesting grants of a denom.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind query factory vesting-grants coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --chain-id zigchain",
+				},
+				{
+					RpcMethod: "VestingGrant",
+					Use:       "vesting-grant [denom] [id]",
+					Short:     "Get a vesting grant of a denom with its vested and claimable amounts.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "id"},
+					},
+					Example: "  zigchaind query factory vesting-grant coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd 0 --chain-id zigchain",
+				},
+				{
+					RpcMethod: "OutstandingVesting",
+					Use:       "outstanding-vesting [denom]",
+					Short:     "Get the amount of a denom that has not vested yet across all its vesting grants.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind query factory outstanding-vesting coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --chain-id zigchain",
+				},
+				{
+					RpcMethod: "Airdrops",
+					Use:       "airdrops",
+					Short:     "List all the open airdrops.",
+					Example:   "  zigchaind query factory airdrops --chain-id zigchain",
+				},
+				{
+					RpcMethod: "Airdrop",
+					Use:       "airdrop [id]",
+					Short:     "Get an open airdrop by its id.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+					Example: "  zigchaind query factory airdrop 0 --chain-id zigchain",
+				},
+				{
+					RpcMethod: "AirdropClaimed",
+					Use:       "airdrop-claimed [id] [address]",
+					Short:     "Check if an address has claimed its share of an airdrop.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+						{ProtoField: "address"},
+					},
+					Example: "  zigchaind query factory airdrop-claimed 0 zig1m4k6l4ymkf2ur3xlnpcm0ysktsfzzfhmng9m9a --chain-id zigchain",
+				},
+				{
+					RpcMethod: "Snapshots",
+					Use:       "snapshots [denom]",
+					Short:     "List the snapshots of a denom.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind query factory snapshots coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --chain-id zigchain",
+				},
+				{
+					RpcMethod: "BalanceAtSnapshot",
+					Use:       "balance-at-snapshot [denom] [snapshot-id] [address]",
+					Short:     "Get the balance of an account at a snapshot of a denom.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "snapshot_id"},
+						{ProtoField: "address"},
+					},
+					Example: "  zigchaind query factory balance-at-snapshot coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd 0 zig1m4k6l4ymkf2ur3xlnpcm0ysktsfzzfhmng9m9a --chain-id zigchain",
+				},
+				{
+					RpcMethod: "SupplyAtSnapshot",
+					Use:       "supply-at-snapshot [denom] [snapshot-id]",
+					Short:     "Get the supply of a denom at a snapshot.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "snapshot_id"},
+					},
+					Example: "  zigchaind query factory supply-at-snapshot coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd 0 --chain-id zigchain",
+				},
+				{
+					RpcMethod: "RewardPools",
+					Use:       "reward-pools [denom]",
+					Short:     "List the reward pools distributed to the holders of a denom.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind query factory reward-pools coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --chain-id zigchain",
+				},
+				{
+					RpcMethod: "PendingRewards",
+					Use:       "pending-rewards [denom] [address]",
+					Short:     "Get the rewards a holder of a denom can claim.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "address"},
+					},
+					Example: "  zigchaind query factory pending-rewards coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd zig1m4k6l4ymkf2ur3xlnpcm0ysktsfzzfhmng9m9a --chain-id zigchain",
+				},
+				{
+					RpcMethod: "CW20Bridges",
+					Use:       "cw20-bridges",
+					Short:     "List the CW20 contracts bridged to factory denoms.",
+					Example:   "  zigchaind query factory cw20-bridges --chain-id zigchain",
+				},
+				{
+					RpcMethod: "CW20Bridge",
+					Use:       "cw20-bridge [contract-address]",
+					Short:     "Get the bridge of a CW20 contract.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract_address"},
+					},
+					Example: "  zigchaind query factory cw20-bridge zig14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sq2r9g9 --chain-id zigchain",
+				},
+				{
+					RpcMethod: "CW20BridgeByDenom",
+					Use:       "cw20-bridge-by-denom [denom]",
+					Short:     "Get the bridge of a bridged factory denom.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind query factory cw20-bridge-by-denom coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --chain-id zigchain",
+				},
+				{
+					RpcMethod: "DenomRoles",
+					Use:       "denom-roles [denom]",
+					Short:     "List the holders of each role of a denom.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind query factory denom-roles coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --chain-id zigchain",
+				},
+				{
+					RpcMethod: "DenomAdminProposals",
+					Use:       "denom-admin-proposals",
+					Short:     "List the pending admin proposals of the denoms.",
+					Example:   "  zigchaind query factory denom-admin-proposals --chain-id zigchain",
+				},
+				{
+					RpcMethod: "DenomAdminProposal",
+					Use:       "denom-admin-proposal [denom]",
+					Short:     "Get the pending admin proposal of a denom.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind query factory denom-admin-proposal coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --chain-id zigchain",
+				},
+				{
+					RpcMethod: "DenomAdminTimelock",
+					Use:       "denom-admin-timelock [denom]",
+					Short:     "Get the admin timelock of a denom.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind query factory denom-admin-timelock coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --chain-id zigchain",
+				},
+				{
+					RpcMethod: "VerifiedDenoms",
+					Use:       "verified-denoms",
+					Short:     "List the denoms of the verified denom registry.",
+					Example:   "  zigchaind query factory verified-denoms --chain-id zigchain",
+				},
+				{
+					RpcMethod: "VerifiedDenomByTicker",
+					Use:       "verified-denom-by-ticker [ticker]",
+					Short:     "Get the verified denom of a ticker.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "ticker"},
+					},
+					Example: "  zigchaind query factory verified-denom-by-ticker USDC --chain-id zigchain",
+				},
+				{
+					RpcMethod: "TransferTax",
+					Use:       "transfer-tax [denom]",
+					Short:     "Get the rate and the recipient of the transfer tax of a denom.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind query factory transfer-tax coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --chain-id zigchain",
+				},
+				{
+					RpcMethod: "TransferTaxExemptions",
+					Use:       "transfer-tax-exemptions [denom]",
+					Short:     "List the addresses exempted from the transfer tax of a denom.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind query factory transfer-tax-exemptions coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --chain-id zigchain",
+				},
+				{
+					RpcMethod: "TokenLaunches",
+					Use:       "token-launches",
+					Short:     "List the token launches of the launchpad.",
+					Example:   "  zigchaind query factory token-launches --chain-id zigchain",
+				},
+				{
+					RpcMethod: "TokenLaunch",
+					Use:       "token-launch [denom]",
+					Short:     "Get the token launch of a denom with its current price and market cap in uzig.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind query factory token-launch coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --chain-id zigchain",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -79,7 +342,8 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "URI"},
 						{ProtoField: "URI_hash"},
 					},
-					Example: "  zigchaind tx factory create-denom abcd 1000000000 true 'ipfs://ipfs.io/XXX' 'hrekwjhrewhjkrhew321312klj' --from z --chain-id zigchain --gas-prices 0.0025uzig  --gas auto --gas-adjustment 1.3",
+					Example: "  zigchaind tx factory create-denom abcd 1000000000 true 'ipfs://ipfs.io/XXX' 'hrekwjhrewhjkrhew321312klj' --from z --chain-id zigchain --gas-prices 0.0025uzig  --gas auto --gas-adjustment 1.3\n" +
+						"  zigchaind tx factory create-denom abcd 1000000000 true 'ipfs://ipfs.io/XXX' 'hrekwjhrewhjkrhew321312klj' --can-force-transfer --from z --chain-id zigchain --gas-prices 0.0025uzig  --gas auto --gas-adjustment 1.3",
 				},
 				{
 					RpcMethod: "MintAndSendTokens",
@@ -121,7 +385,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{
 					RpcMethod: "ProposeDenomAdmin",
 					Use:       "propose-denom-admin [denom] [bank-admin-address] [metadata-admin-address]",
-					Short:     "Propose new admin addresses for a denom",
+					Short:     "Propose new admin addresses for a denom, claimable once the admin timelock has passed until the --expiry in seconds (7 days by default)",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "denom"},
 						{ProtoField: "bank_admin"},
@@ -190,6 +454,319 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					Example: "  zigchaind tx factory withdraw-module-fees zig1q2w3e4r5t6y7u8i9o0p1a2s3d4f5g6h7j8k9l0 --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
 				},
+				{
+					RpcMethod: "SetBeforeSendHook",
+					Use:       "set-before-send-hook [denom] [contract-address]",
+					Short:     "Set the CosmWasm contract called before every transfer of a denom, without a contract address the hook is removed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "contract_address", Optional: true},
+					},
+					Example: "  zigchaind tx factory set-before-send-hook coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd zig14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sxkczqy --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "FreezeAccount",
+					Use:       "freeze-account [denom] [address]",
+					Short:     "Stop an account from sending and receiving a denom, module accounts can not be frozen",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "address"},
+					},
+					Example: "  zigchaind tx factory freeze-account coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd zig1m4k6l4ymkf2ur3xlnpcm0ysktsfzzfhmng9m9a --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "UnfreezeAccount",
+					Use:       "unfreeze-account [denom] [address]",
+					Short:     "Lift the freeze of an account for a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "address"},
+					},
+					Example: "  zigchaind tx factory unfreeze-account coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd zig1m4k6l4ymkf2ur3xlnpcm0ysktsfzzfhmng9m9a --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "SetDenomGlobalFreeze",
+					Use:       "set-denom-global-freeze [denom] [frozen]",
+					Short:     "Freeze or unfreeze all the transfers of a denom, the bank admin can still send and receive it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "frozen"},
+					},
+					Example: "  zigchaind tx factory set-denom-global-freeze coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd true --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "ForceTransfer",
+					Use:       "force-transfer [amount-token] [from-address] [to-address]",
+					Short:     "Move a denom from any holder to another address, only for denoms created with --can-force-transfer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "token"},
+						{ProtoField: "from_address"},
+						{ProtoField: "to_address"},
+					},
+					Example: "  zigchaind tx factory force-transfer 100coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd zig1m4k6l4ymkf2ur3xlnpcm0ysktsfzzfhmng9m9a zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049 --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "DisableForceTransfer",
+					Use:       "disable-force-transfer [denom]",
+					Short:     "Turn off the force transfers of a denom, this can not be undone",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind tx factory disable-force-transfer coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "SetMinterAllowance",
+					Use:       "set-minter-allowance [denom] [minter-address] [allowance]",
+					Short:     "Add a minter to a denom or set its remaining allowance, the minting cap still applies",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "minter"},
+						{ProtoField: "allowance"},
+					},
+					Example: "  zigchaind tx factory set-minter-allowance coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd zig1m4k6l4ymkf2ur3xlnpcm0ysktsfzzfhmng9m9a 1000000 --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "RemoveMinter",
+					Use:       "remove-minter [denom] [minter-address]",
+					Short:     "Revoke a minter of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "minter"},
+					},
+					Example: "  zigchaind tx factory remove-minter coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd zig1m4k6l4ymkf2ur3xlnpcm0ysktsfzzfhmng9m9a --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "SetMintRateLimit",
+					Use:       "set-mint-rate-limit [denom] [config]",
+					Short:     "Set the rolling window mint rate limit of a denom, a looser limit takes effect after the raise delay of the current one",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "config"},
+					},
+					Example: "  zigchaind tx factory set-mint-rate-limit coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd '{\"limit\":\"1000000\",\"window\":\"86400\",\"raise_delay\":\"172800\"}' --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "MintVested",
+					Use:       "mint-vested [amount-token] [recipient-address] [schedule]",
+					Short:     "Mint a denom on a vesting schedule, into a new vesting account or into the vesting escrow for an existing account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "token"},
+						{ProtoField: "recipient"},
+						{ProtoField: "schedule"},
+					},
+					Example: "  zigchaind tx factory mint-vested 1000000coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd zig1m4k6l4ymkf2ur3xlnpcm0ysktsfzzfhmng9m9a '{\"start_time\":\"1767225600\",\"end_time\":\"1798761600\"}' --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "ClaimVested",
+					Use:       "claim-vested [denom] [id]",
+					Short:     "Claim the vested amount of a vesting grant held by the vesting escrow",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "id"},
+					},
+					Example: "  zigchaind tx factory claim-vested coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd 0 --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "MintAndSendBatch",
+					Use:       "mint-and-send-batch [denom] [output]...",
+					Short:     "Mint a denom and send it to many recipients at once, each output being a JSON recipient and amount",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "outputs", Varargs: true},
+					},
+					Example: "  zigchaind tx factory mint-and-send-batch coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd '{\"recipient\":\"zig1m4k6l4ymkf2ur3xlnpcm0ysktsfzzfhmng9m9a\",\"amount\":\"1000\"}' '{\"recipient\":\"zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049\",\"amount\":\"2000\"}' --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "CreateAirdrop",
+					Use:       "create-airdrop [amount-token] [merkle-root] [expiry-time]",
+					Short:     "Mint a denom into the airdrop escrow, claimable with Merkle proofs until the expiry time (unix seconds)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "token"},
+						{ProtoField: "merkle_root"},
+						{ProtoField: "expiry_time"},
+					},
+					Example: "  zigchaind tx factory create-airdrop 1000000coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd 8f434346648f6b96df89dda901c5176b10a6d83961dd3c1ac88b59b2dc327aa4 1798761600 --burn-unclaimed --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "ClaimAirdrop",
+					Use:       "claim-airdrop [airdrop-id] [amount] [proof]...",
+					Short:     "Claim an amount of an airdrop with the Merkle proof of the signer and amount",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "airdrop_id"},
+						{ProtoField: "amount"},
+						{ProtoField: "proof", Varargs: true},
+					},
+					Example: "  zigchaind tx factory claim-airdrop 0 1000 2e7d2c03a9507ae265ecf5b5356885a53393a2029d241394997265a1a25aefc6 18ac3e7343f016890c510e93f935261169d9e3f565436429830faf0934f4f8e4 --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "TakeSnapshot",
+					Use:       "take-snapshot [denom]",
+					Short:     "Take a snapshot of the supply and the balances of a denom, to be queried later",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind tx factory take-snapshot coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "DistributeRewards",
+					Use:       "distribute-rewards [denom] [reward]",
+					Short:     "Escrow a reward to be shared pro-rata by the holders of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "reward"},
+					},
+					Example: "  zigchaind tx factory distribute-rewards coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd 1000000uzig --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "ClaimRewards",
+					Use:       "claim-rewards [denom]",
+					Short:     "Claim the rewards of the signer as a holder of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind tx factory claim-rewards coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "RegisterCW20Bridge",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "ConvertCW20ToCoin",
+					Use:       "convert-cw20-to-coin [contract-address] [amount]",
+					Short:     "Lock CW20 tokens in the CW20 escrow and mint their factory denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract_address"},
+						{ProtoField: "amount"},
+					},
+					Example: "  zigchaind tx factory convert-cw20-to-coin zig14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sq2r9g9 1000000 --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "ConvertCoinToCW20",
+					Use:       "convert-coin-to-cw20 [coin]",
+					Short:     "Burn a bridged factory denom and release its CW20 tokens from the CW20 escrow",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "coin"},
+					},
+					Example: "  zigchaind tx factory convert-coin-to-cw20 1000000coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "GrantDenomRole",
+					Use:       "grant-denom-role [denom] [role] [address]",
+					Short:     "Grant a role of a denom: minter, burner, pauser, metadata_editor or cap_manager",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "role"},
+						{ProtoField: "address"},
+					},
+					Example: "  zigchaind tx factory grant-denom-role coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd minter zig1m4k6l4ymkf2ur3xlnpcm0ysktsfzzfhmng9m9a --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "RevokeDenomRole",
+					Use:       "revoke-denom-role [denom] [role] [address]",
+					Short:     "Revoke a role of a denom, a holder can renounce its own role",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "role"},
+						{ProtoField: "address"},
+					},
+					Example: "  zigchaind tx factory revoke-denom-role coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd minter zig1m4k6l4ymkf2ur3xlnpcm0ysktsfzzfhmng9m9a --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "CancelDenomAdminProposal",
+					Use:       "cancel-denom-admin-proposal [denom]",
+					Short:     "Cancel the pending admin proposal of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind tx factory cancel-denom-admin-proposal coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "SetDenomAdminTimelock",
+					Use:       "set-denom-admin-timelock [denom] [delay]",
+					Short:     "Set the delay in seconds between an admin proposal and its claim, a shorter delay only takes effect once the current delay has passed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "delay"},
+					},
+					Example: "  zigchaind tx factory set-denom-admin-timelock coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd 172800 --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "VerifyDenom",
+					Use:       "verify-denom [denom] [ticker] [uri-hash] [decimals]",
+					Short:     "Add a denom to the verified denom registry with its canonical ticker, logo URI hash and decimals, only the verified denom curators can do it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "ticker"},
+						{ProtoField: "uri_hash"},
+						{ProtoField: "decimals"},
+					},
+					Example: "  zigchaind tx factory verify-denom coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.usdc USDC 2b3ba0d5f2bed5a5d0f8e8b6d6c5e4f3a2b1c0d9e8f7a6b5c4d3e2f1a0b9c8d7 6 --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "UnverifyDenom",
+					Use:       "unverify-denom [denom]",
+					Short:     "Remove a denom from the verified denom registry, only the verified denom curators can do it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+					Example: "  zigchaind tx factory unverify-denom coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.usdc --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "SetTransferTax",
+					Use:       "set-transfer-tax [denom] [rate] [recipient]",
+					Short:     "Set the rate and the recipient of the tax collected on every transfer of a denom, a zero rate removes the tax, only bank admin can do it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "rate"},
+						{ProtoField: "recipient"},
+					},
+					Example: "  zigchaind tx factory set-transfer-tax coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd 0.01 zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049 --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "SetTransferTaxExemption",
+					Use:       "set-transfer-tax-exemption [denom] [address] [exempt]",
+					Short:     "Exempt an address, such as a dex pool, from the transfer tax of a denom or lift its exemption, only bank admin can do it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "address"},
+						{ProtoField: "exempt"},
+					},
+					Example: "  zigchaind tx factory set-transfer-tax-exemption coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049 true --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "LaunchToken",
+					Use:       "launch-token [sub-denom] [supply] [curve-supply] [uri] [uri-hash]",
+					Short:     "Create a denom with a fixed supply and sell the curve supply against uzig on a bonding curve, the rest seeds a dex pool once the launch graduates",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sub_denom"},
+						{ProtoField: "supply"},
+						{ProtoField: "curve_supply"},
+						{ProtoField: "URI"},
+						{ProtoField: "URI_hash"},
+					},
+					Example: "  zigchaind tx factory launch-token abcd 1000000000000000 800000000000000 'ipfs://ipfs.io/XXX' '' --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "BuyLaunchToken",
+					Use:       "buy-launch-token [denom] [incoming] [outgoing-min]",
+					Short:     "Buy the tokens of a launch on its bonding curve with uzig, at least the outgoing min of them",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "incoming"},
+						{ProtoField: "outgoing_min"},
+					},
+					Example: "  zigchaind tx factory buy-launch-token coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd 1000000uzig 0 --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
+				{
+					RpcMethod: "SellLaunchToken",
+					Use:       "sell-launch-token [incoming] [outgoing-min]",
+					Short:     "Sell the tokens of a launch back to its bonding curve, for at least the outgoing min of uzig",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "incoming"},
+						{ProtoField: "outgoing_min"},
+					},
+					Example: "  zigchaind tx factory sell-launch-token 1000000coin.zig1vhn9xt0tnmve9nr9dtqt4emcsae40a3cs4v049.abcd 0 --from z --chain-id zigchain --gas-prices 0.25uzig --gas auto --gas-adjustment 1.3",
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -13,15 +13,99 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.DenomList {
 		k.SetDenom(ctx, elem)
 	}
-	// Set all the denomAuth
+	// Set all the denomAuth, which also builds the admin index
 	for _, elem := range genState.DenomAuthList {
 		k.SetDenomAuth(ctx, elem)
-
-		// Add the metadata admin to the admin denom auth list
-		k.AddDenomToAdminDenomAuthList(ctx, elem.MetadataAdmin, elem.Denom)
-
-		// Add the bank admin to the admin denom auth list
-		k.AddDenomToAdminDenomAuthList(ctx, elem.BankAdmin, elem.Denom)
+	}
+	// Set all the beforeSendHook
+	for _, elem := range genState.BeforeSendHookList {
+		k.SetBeforeSendHook(ctx, elem)
+	}
+	// Set all the frozenAccount
+	for _, elem := range genState.FrozenAccountList {
+		k.SetFrozenAccount(ctx, elem.Denom, elem.Address)
+	}
+	// Set all the globally frozen denoms
+	for _, denom := range genState.GlobalFrozenDenoms {
+		k.SetDenomGlobalFrozen(ctx, denom, true)
+	}
+	// Set all the minter
+	for _, elem := range genState.MinterList {
+		k.SetMinter(ctx, elem)
+	}
+	// Set all the mintRateLimit
+	for _, elem := range genState.MintRateLimitList {
+		k.SetMintRateLimit(ctx, elem)
+	}
+	// Set all the mintRateBucket
+	for _, elem := range genState.MintRateBucketList {
+		k.SetMintRateBucket(ctx, elem)
+	}
+	// Set all the vestingGrant and the vestingGrant count
+	for _, elem := range genState.VestingGrantList {
+		k.SetVestingGrant(ctx, elem)
+	}
+	k.SetVestingGrantCount(ctx, genState.VestingGrantCount)
+	// Set all the airdrop, the airdropClaim and the airdrop count
+	for _, elem := range genState.AirdropList {
+		k.SetAirdrop(ctx, elem)
+	}
+	for _, elem := range genState.AirdropClaimList {
+		k.SetAirdropClaimed(ctx, elem.AirdropId, elem.Address)
+	}
+	k.SetAirdropCount(ctx, genState.AirdropCount)
+	// Set all the snapshot, the snapshot count of each denom follows its last snapshot
+	for _, elem := range genState.SnapshotList {
+		k.SetSnapshot(ctx, elem)
+		if elem.Id >= k.GetSnapshotCount(ctx, elem.Denom) {
+			k.SetSnapshotCount(ctx, elem.Denom, elem.Id+1)
+		}
+	}
+	// Set all the snapshotBalance
+	for _, elem := range genState.SnapshotBalanceList {
+		k.SetSnapshotBalance(ctx, elem)
+	}
+	// Set all the rewardPool, the holderReward and the moduleAccountHolder
+	for _, elem := range genState.RewardPoolList {
+		k.SetRewardPool(ctx, elem)
+	}
+	for _, elem := range genState.HolderRewardList {
+		k.SetHolderReward(ctx, elem)
+	}
+	for _, elem := range genState.ModuleAccountHolderList {
+		k.SetModuleAccountHolder(ctx, elem.Denom, elem.Address)
+	}
+	// Set all the cw20Bridge
+	for _, elem := range genState.Cw20BridgeList {
+		k.SetCW20Bridge(ctx, elem)
+	}
+	// Set all the granted denomRole
+	for _, elem := range genState.DenomRoleList {
+		k.SetDenomRole(ctx, elem.Denom, elem.Role, elem.Address)
+	}
+	// Set all the pending denomAdminProposal
+	for _, elem := range genState.DenomAdminProposalList {
+		k.SetProposedDenomAuth(ctx, elem)
+	}
+	// Set all the denomAdminTimelock
+	for _, elem := range genState.DenomAdminTimelockList {
+		k.SetDenomAdminTimelock(ctx, elem)
+	}
+	// Set all the verifiedDenom
+	for _, elem := range genState.VerifiedDenomList {
+		k.SetVerifiedDenom(ctx, elem)
+	}
+	// Set all the transferTax
+	for _, elem := range genState.TransferTaxList {
+		k.SetTransferTax(ctx, elem)
+	}
+	// Set all the transferTaxExemption
+	for _, elem := range genState.TaxExemptionList {
+		k.SetTransferTaxExemption(ctx, elem.Denom, elem.Address)
+	}
+	// Set all the tokenLaunch
+	for _, elem := range genState.TokenLaunchList {
+		k.SetTokenLaunch(ctx, elem)
 	}
 	// this line is used by starport scaffolding # genesis/module/init
 	err := k.SetParams(ctx, genState.Params)
@@ -37,6 +121,30 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.DenomList = k.GetAllDenom(ctx)
 	genesis.DenomAuthList = k.GetAllDenomAuth(ctx)
+	genesis.BeforeSendHookList = k.GetAllBeforeSendHook(ctx)
+	genesis.FrozenAccountList = k.GetAllFrozenAccount(ctx)
+	genesis.GlobalFrozenDenoms = k.GetAllGlobalFrozenDenom(ctx)
+	genesis.MinterList = k.GetAllMinter(ctx)
+	genesis.MintRateLimitList = k.GetAllMintRateLimit(ctx)
+	genesis.MintRateBucketList = k.GetAllMintRateBucket(ctx)
+	genesis.VestingGrantList = k.GetAllVestingGrant(ctx)
+	genesis.VestingGrantCount = k.GetVestingGrantCount(ctx)
+	genesis.AirdropList = k.GetAllAirdrop(ctx)
+	genesis.AirdropClaimList = k.GetAllAirdropClaim(ctx)
+	genesis.AirdropCount = k.GetAirdropCount(ctx)
+	genesis.SnapshotList = k.GetAllSnapshot(ctx)
+	genesis.SnapshotBalanceList = k.GetAllSnapshotBalance(ctx)
+	genesis.RewardPoolList = k.GetAllRewardPool(ctx)
+	genesis.HolderRewardList = k.GetAllHolderReward(ctx)
+	genesis.ModuleAccountHolderList = k.GetAllModuleAccountHolder(ctx)
+	genesis.Cw20BridgeList = k.GetAllCW20Bridge(ctx)
+	genesis.DenomRoleList = k.GetAllDenomRole(ctx)
+	genesis.DenomAdminProposalList = k.GetAllProposedDenomAuth(ctx)
+	genesis.DenomAdminTimelockList = k.GetAllDenomAdminTimelock(ctx)
+	genesis.VerifiedDenomList = k.GetAllVerifiedDenom(ctx)
+	genesis.TransferTaxList = k.GetAllTransferTax(ctx)
+	genesis.TaxExemptionList = k.GetAllTransferTaxExemption(ctx)
+	genesis.TokenLaunchList = k.GetAllTokenLaunch(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
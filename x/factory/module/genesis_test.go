@@ -3,6 +3,8 @@ package factory_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+
 	keepertest "zigchain/testutil/keeper"
 	"zigchain/testutil/nullify"
 	factory "zigchain/x/factory/module"
@@ -31,6 +33,176 @@ func TestGenesis(t *testing.T) {
 				Denom: "1",
 			},
 		},
+		BeforeSendHookList: []types.BeforeSendHook{
+			{
+				Denom:           "0",
+				ContractAddress: "contract",
+			},
+		},
+		FrozenAccountList: []types.FrozenAccount{
+			{
+				Denom:   "0",
+				Address: "account",
+			},
+		},
+		GlobalFrozenDenoms: []string{"1"},
+		MinterList: []types.Minter{
+			{
+				Denom:     "0",
+				Address:   "minter",
+				Allowance: math.NewUint(10),
+			},
+		},
+		MintRateLimitList: []types.MintRateLimit{
+			{
+				Denom: "0",
+				Config: types.MintRateLimitConfig{
+					Limit:      math.NewUint(100),
+					Window:     3600,
+					RaiseDelay: 600,
+				},
+			},
+		},
+		MintRateBucketList: []types.MintRateBucket{
+			{
+				Denom:     "0",
+				StartTime: 3600,
+				Amount:    math.NewUint(10),
+			},
+		},
+		VestingGrantList: []types.VestingGrant{
+			{
+				Denom:     "0",
+				Id:        0,
+				Recipient: "recipient",
+				Amount:    math.NewUint(100),
+				Schedule:  types.VestingSchedule{StartTime: 1000, EndTime: 2000},
+				Escrow:    true,
+				Claimed:   math.NewUint(10),
+			},
+		},
+		VestingGrantCount: 1,
+		AirdropList: []types.Airdrop{
+			{
+				Id:         0,
+				Denom:      "0",
+				Creator:    "creator",
+				MerkleRoot: "root",
+				Amount:     math.NewUint(100),
+				Claimed:    math.NewUint(10),
+				ExpiryTime: 2000,
+			},
+		},
+		AirdropClaimList: []types.AirdropClaim{
+			{
+				AirdropId: 0,
+				Address:   "claimer",
+			},
+		},
+		AirdropCount: 1,
+		SnapshotList: []types.Snapshot{
+			{
+				Denom:  "0",
+				Id:     0,
+				Height: 10,
+				Time:   1000,
+				Supply: math.NewUint(100),
+			},
+		},
+		SnapshotBalanceList: []types.SnapshotBalance{
+			{
+				Denom:      "0",
+				SnapshotId: 0,
+				Address:    "holder",
+				Amount:     math.NewUint(10),
+			},
+		},
+		RewardPoolList: []types.RewardPool{
+			{
+				Denom:          "0",
+				RewardDenom:    "uzig",
+				RewardPerToken: math.LegacyMustNewDecFromStr("2.5"),
+				Distributed:    math.NewUint(1000),
+				Claimed:        math.NewUint(250),
+			},
+		},
+		HolderRewardList: []types.HolderReward{
+			{
+				Denom:              "0",
+				RewardDenom:        "uzig",
+				Address:            "holder",
+				RewardPerTokenPaid: math.LegacyOneDec(),
+				Pending:            math.NewUint(10),
+			},
+		},
+		ModuleAccountHolderList: []types.ModuleAccountHolder{
+			{
+				Denom:   "0",
+				Address: "pool",
+			},
+		},
+		Cw20BridgeList: []types.CW20Bridge{
+			{
+				ContractAddress: "contract",
+				Denom:           "0",
+				Escrowed:        math.NewUint(100),
+			},
+		},
+		DenomRoleList: []types.DenomRole{
+			{
+				Denom:   "0",
+				Role:    types.RolePauser,
+				Address: "pauser",
+			},
+		},
+		DenomAdminProposalList: []types.DenomAdminProposal{
+			{
+				Denom:         "0",
+				BankAdmin:     "admin",
+				ClaimableTime: 1000,
+				ExpiryTime:    2000,
+			},
+		},
+		DenomAdminTimelockList: []types.DenomAdminTimelock{
+			{
+				Denom:        "0",
+				Delay:        3600,
+				PendingDelay: 60,
+				PendingTime:  1000,
+			},
+		},
+		VerifiedDenomList: []types.VerifiedDenom{
+			{
+				Denom:    "0",
+				Ticker:   "USDC",
+				UriHash:  "hash",
+				Decimals: 6,
+			},
+		},
+		TransferTaxList: []types.TransferTax{
+			{
+				Denom:     "0",
+				Rate:      math.LegacyNewDecWithPrec(1, 2),
+				Recipient: "treasury",
+			},
+		},
+		TaxExemptionList: []types.TransferTaxExemption{
+			{
+				Denom:   "0",
+				Address: "pool",
+			},
+		},
+		TokenLaunchList: []types.TokenLaunch{
+			{
+				Denom:               "0",
+				Supply:              math.NewInt(1_000_000_000),
+				CurveSupply:         math.NewInt(800_000_000),
+				VirtualReserve:      math.NewInt(30_000_000_000),
+				GraduationMarketCap: math.NewInt(400_000_000_000),
+				Sold:                math.NewInt(1_000),
+				Raised:              math.NewInt(40_000),
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -44,5 +216,30 @@ func TestGenesis(t *testing.T) {
 
 	require.ElementsMatch(t, genesisState.DenomList, got.DenomList)
 	require.ElementsMatch(t, genesisState.DenomAuthList, got.DenomAuthList)
+	require.ElementsMatch(t, genesisState.BeforeSendHookList, got.BeforeSendHookList)
+	require.ElementsMatch(t, genesisState.FrozenAccountList, got.FrozenAccountList)
+	require.ElementsMatch(t, genesisState.GlobalFrozenDenoms, got.GlobalFrozenDenoms)
+	require.ElementsMatch(t, genesisState.MinterList, got.MinterList)
+	require.ElementsMatch(t, genesisState.MintRateLimitList, got.MintRateLimitList)
+	require.ElementsMatch(t, genesisState.MintRateBucketList, got.MintRateBucketList)
+	require.ElementsMatch(t, genesisState.VestingGrantList, got.VestingGrantList)
+	require.Equal(t, genesisState.VestingGrantCount, got.VestingGrantCount)
+	require.ElementsMatch(t, genesisState.AirdropList, got.AirdropList)
+	require.ElementsMatch(t, genesisState.AirdropClaimList, got.AirdropClaimList)
+	require.Equal(t, genesisState.AirdropCount, got.AirdropCount)
+	require.ElementsMatch(t, genesisState.SnapshotList, got.SnapshotList)
+	require.ElementsMatch(t, genesisState.SnapshotBalanceList, got.SnapshotBalanceList)
+	require.Equal(t, uint64(1), k.GetSnapshotCount(ctx, "0"))
+	require.ElementsMatch(t, genesisState.RewardPoolList, got.RewardPoolList)
+	require.ElementsMatch(t, genesisState.HolderRewardList, got.HolderRewardList)
+	require.ElementsMatch(t, genesisState.ModuleAccountHolderList, got.ModuleAccountHolderList)
+	require.ElementsMatch(t, genesisState.Cw20BridgeList, got.Cw20BridgeList)
+	require.ElementsMatch(t, genesisState.DenomRoleList, got.DenomRoleList)
+	require.ElementsMatch(t, genesisState.DenomAdminProposalList, got.DenomAdminProposalList)
+	require.ElementsMatch(t, genesisState.DenomAdminTimelockList, got.DenomAdminTimelockList)
+	require.ElementsMatch(t, genesisState.VerifiedDenomList, got.VerifiedDenomList)
+	require.ElementsMatch(t, genesisState.TransferTaxList, got.TransferTaxList)
+	require.ElementsMatch(t, genesisState.TaxExemptionList, got.TaxExemptionList)
+	require.ElementsMatch(t, genesisState.TokenLaunchList, got.TokenLaunchList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -139,8 +139,7 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	factoryGenesis := types.GenesisState{
 		// Params are used to store the fee denom and fee amount
 		Params: types.Params{
-			CreateFeeDenom:  feeDenom,
-			CreateFeeAmount: feeAmount,
+			CreateFee: sdk.NewInt64Coin(feeDenom, int64(feeAmount)),
 		},
 		// DenomList is used to store the denoms
 		DenomList: []types.Denom{
@@ -91,7 +91,7 @@ func SimulateMsgCreateDenom(
 		// Get params
 		params := fk.GetParams(ctx)
 
-		feeAmount := sdk.NewInt64Coin(params.CreateFeeDenom, int64(params.CreateFeeAmount))
+		feeAmount := params.CreateFee
 		hasBalance := bk.HasBalance(ctx, simAccount.Address, feeAmount)
 
 		if !hasBalance {
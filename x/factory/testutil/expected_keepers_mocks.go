@@ -84,6 +84,32 @@ func (mr *MockAccountKeeperMockRecorder) GetModuleAddress(name any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModuleAddress", reflect.TypeOf((*MockAccountKeeper)(nil).GetModuleAddress), name)
 }
 
+// NewAccount mocks base method.
+func (m *MockAccountKeeper) NewAccount(arg0 context.Context, arg1 types.AccountI) types.AccountI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAccount", arg0, arg1)
+	ret0, _ := ret[0].(types.AccountI)
+	return ret0
+}
+
+// NewAccount indicates an expected call of NewAccount.
+func (mr *MockAccountKeeperMockRecorder) NewAccount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAccount", reflect.TypeOf((*MockAccountKeeper)(nil).NewAccount), arg0, arg1)
+}
+
+// SetAccount mocks base method.
+func (m *MockAccountKeeper) SetAccount(arg0 context.Context, arg1 types.AccountI) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAccount", arg0, arg1)
+}
+
+// SetAccount indicates an expected call of SetAccount.
+func (mr *MockAccountKeeperMockRecorder) SetAccount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccount", reflect.TypeOf((*MockAccountKeeper)(nil).SetAccount), arg0, arg1)
+}
+
 // SetModuleAccount mocks base method.
 func (m *MockAccountKeeper) SetModuleAccount(arg0 context.Context, arg1 types.ModuleAccountI) {
 	m.ctrl.T.Helper()
@@ -120,6 +146,20 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// BlockedAddr mocks base method.
+func (m *MockBankKeeper) BlockedAddr(addr types.AccAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockedAddr", addr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// BlockedAddr indicates an expected call of BlockedAddr.
+func (mr *MockBankKeeperMockRecorder) BlockedAddr(addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockedAddr", reflect.TypeOf((*MockBankKeeper)(nil).BlockedAddr), addr)
+}
+
 // BurnCoins mocks base method.
 func (m *MockBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -301,6 +341,20 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SendCoinsFromModuleToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToModule", ctx, senderModule, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToModule indicates an expected call of SendCoinsFromModuleToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToModule), ctx, senderModule, recipientModule, amt)
+}
+
 // SetDenomMetaData mocks base method.
 func (m *MockBankKeeper) SetDenomMetaData(ctx context.Context, denomMetaData types0.Metadata) {
 	m.ctrl.T.Helper()
@@ -327,6 +381,191 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), arg0, arg1)
 }
 
+// MockContractKeeper is a mock of ContractKeeper interface.
+type MockContractKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockContractKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockContractKeeperMockRecorder is the mock recorder for MockContractKeeper.
+type MockContractKeeperMockRecorder struct {
+	mock *MockContractKeeper
+}
+
+// NewMockContractKeeper creates a new mock instance.
+func NewMockContractKeeper(ctrl *gomock.Controller) *MockContractKeeper {
+	mock := &MockContractKeeper{ctrl: ctrl}
+	mock.recorder = &MockContractKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContractKeeper) EXPECT() *MockContractKeeperMockRecorder {
+	return m.recorder
+}
+
+// HasContractInfo mocks base method.
+func (m *MockContractKeeper) HasContractInfo(ctx context.Context, contractAddress types.AccAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasContractInfo", ctx, contractAddress)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasContractInfo indicates an expected call of HasContractInfo.
+func (mr *MockContractKeeperMockRecorder) HasContractInfo(ctx, contractAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasContractInfo", reflect.TypeOf((*MockContractKeeper)(nil).HasContractInfo), ctx, contractAddress)
+}
+
+// Sudo mocks base method.
+func (m *MockContractKeeper) Sudo(ctx context.Context, contractAddress types.AccAddress, msg []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sudo", ctx, contractAddress, msg)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sudo indicates an expected call of Sudo.
+func (mr *MockContractKeeperMockRecorder) Sudo(ctx, contractAddress, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sudo", reflect.TypeOf((*MockContractKeeper)(nil).Sudo), ctx, contractAddress, msg)
+}
+
+// MockContractOpsKeeper is a mock of ContractOpsKeeper interface.
+type MockContractOpsKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockContractOpsKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockContractOpsKeeperMockRecorder is the mock recorder for MockContractOpsKeeper.
+type MockContractOpsKeeperMockRecorder struct {
+	mock *MockContractOpsKeeper
+}
+
+// NewMockContractOpsKeeper creates a new mock instance.
+func NewMockContractOpsKeeper(ctrl *gomock.Controller) *MockContractOpsKeeper {
+	mock := &MockContractOpsKeeper{ctrl: ctrl}
+	mock.recorder = &MockContractOpsKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContractOpsKeeper) EXPECT() *MockContractOpsKeeperMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockContractOpsKeeper) Execute(ctx types.Context, contractAddress, caller types.AccAddress, msg []byte, coins types.Coins) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, contractAddress, caller, msg, coins)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockContractOpsKeeperMockRecorder) Execute(ctx, contractAddress, caller, msg, coins any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockContractOpsKeeper)(nil).Execute), ctx, contractAddress, caller, msg, coins)
+}
+
+// MockContractViewKeeper is a mock of ContractViewKeeper interface.
+type MockContractViewKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockContractViewKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockContractViewKeeperMockRecorder is the mock recorder for MockContractViewKeeper.
+type MockContractViewKeeperMockRecorder struct {
+	mock *MockContractViewKeeper
+}
+
+// NewMockContractViewKeeper creates a new mock instance.
+func NewMockContractViewKeeper(ctrl *gomock.Controller) *MockContractViewKeeper {
+	mock := &MockContractViewKeeper{ctrl: ctrl}
+	mock.recorder = &MockContractViewKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContractViewKeeper) EXPECT() *MockContractViewKeeperMockRecorder {
+	return m.recorder
+}
+
+// QuerySmart mocks base method.
+func (m *MockContractViewKeeper) QuerySmart(ctx context.Context, contractAddress types.AccAddress, req []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuerySmart", ctx, contractAddress, req)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuerySmart indicates an expected call of QuerySmart.
+func (mr *MockContractViewKeeperMockRecorder) QuerySmart(ctx, contractAddress, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySmart", reflect.TypeOf((*MockContractViewKeeper)(nil).QuerySmart), ctx, contractAddress, req)
+}
+
+// MockDexKeeper is a mock of DexKeeper interface.
+type MockDexKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDexKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockDexKeeperMockRecorder is the mock recorder for MockDexKeeper.
+type MockDexKeeperMockRecorder struct {
+	mock *MockDexKeeper
+}
+
+// NewMockDexKeeper creates a new mock instance.
+func NewMockDexKeeper(ctrl *gomock.Controller) *MockDexKeeper {
+	mock := &MockDexKeeper{ctrl: ctrl}
+	mock.recorder = &MockDexKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDexKeeper) EXPECT() *MockDexKeeperMockRecorder {
+	return m.recorder
+}
+
+// CreatePoolWithCoins mocks base method.
+func (m *MockDexKeeper) CreatePoolWithCoins(ctx context.Context, creator string, base, quote types.Coin, receiver string) (string, types.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePoolWithCoins", ctx, creator, base, quote, receiver)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(types.Coin)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreatePoolWithCoins indicates an expected call of CreatePoolWithCoins.
+func (mr *MockDexKeeperMockRecorder) CreatePoolWithCoins(ctx, creator, base, quote, receiver any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePoolWithCoins", reflect.TypeOf((*MockDexKeeper)(nil).CreatePoolWithCoins), ctx, creator, base, quote, receiver)
+}
+
+// GetCreationFee mocks base method.
+func (m *MockDexKeeper) GetCreationFee(ctx context.Context) types.Coin {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCreationFee", ctx)
+	ret0, _ := ret[0].(types.Coin)
+	return ret0
+}
+
+// GetCreationFee indicates an expected call of GetCreationFee.
+func (mr *MockDexKeeperMockRecorder) GetCreationFee(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCreationFee", reflect.TypeOf((*MockDexKeeper)(nil).GetCreationFee), ctx)
+}
+
 // MockStakingKeeper is a mock of StakingKeeper interface.
 type MockStakingKeeper struct {
 	ctrl     *gomock.Controller
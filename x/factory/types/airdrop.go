@@ -0,0 +1,120 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zigchain/zutils/validators"
+)
+
+// MaxMerkleProofLength is the maximum number of hashes of an airdrop proof
+const MaxMerkleProofLength = 32
+
+// MaxAirdropClaimsPrunedPerBlock bounds the number of claims of closed airdrops the end blocker prunes per block
+const MaxAirdropClaimsPrunedPerBlock = 100
+
+// AirdropLeaf returns the leaf of the airdrop Merkle tree for the amount of an address,
+// the sha256 hash of the address followed by the amount, as the cw20 merkle airdrops
+func AirdropLeaf(address string, amount math.Uint) []byte {
+	leaf := sha256.Sum256([]byte(address + amount.String()))
+	return leaf[:]
+}
+
+// HashAirdropPair returns the parent of two nodes of the airdrop Merkle tree, the nodes are
+// sorted so the proofs do not need the position of the leaf
+func HashAirdropPair(a []byte, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+
+	parent := sha256.Sum256(append(append([]byte{}, a...), b...))
+	return parent[:]
+}
+
+// VerifyAirdropProof returns true if the proof links the amount of the address to the root
+func VerifyAirdropProof(root string, address string, amount math.Uint, proof []string) (bool, error) {
+	expected, err := decodeMerkleHash(root)
+	if err != nil {
+		return false, err
+	}
+
+	hash := AirdropLeaf(address, amount)
+	for _, node := range proof {
+		sibling, err := decodeMerkleHash(node)
+		if err != nil {
+			return false, err
+		}
+		hash = HashAirdropPair(hash, sibling)
+	}
+
+	return bytes.Equal(hash, expected), nil
+}
+
+// decodeMerkleHash decodes a hex encoded sha256 hash
+func decodeMerkleHash(hash string) ([]byte, error) {
+	decoded, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidMerkleProof, "hash %s is not hex encoded: %s", hash, err)
+	}
+
+	if len(decoded) != sha256.Size {
+		return nil, errorsmod.Wrapf(ErrInvalidMerkleProof, "hash %s is not %d bytes long", hash, sha256.Size)
+	}
+
+	return decoded, nil
+}
+
+// ValidateMerkleRoot validates the hex encoded root of an airdrop
+func ValidateMerkleRoot(root string) error {
+	if _, err := decodeMerkleHash(root); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAirdrop, "merkle root: %s", err)
+	}
+
+	return nil
+}
+
+// Validate validates an airdrop
+func (a Airdrop) Validate() error {
+	_, _, err := DeconstructDenom(a.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			a.Denom,
+			err,
+		)
+	}
+
+	if err := validators.AddressCheck("Creator", a.Creator); err != nil {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidAddress,
+			"Airdrop creator invalid (%d) : %s",
+			a.Id,
+			err,
+		)
+	}
+
+	if err := ValidateMerkleRoot(a.MerkleRoot); err != nil {
+		return err
+	}
+
+	if a.Amount.IsNil() || a.Amount.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidAirdrop, "airdrop %d amount must be greater than 0", a.Id)
+	}
+
+	if a.Claimed.IsNil() || a.Claimed.GT(a.Amount) {
+		return errorsmod.Wrapf(ErrInvalidAirdrop, "airdrop %d claimed amount is nil or exceeds the amount", a.Id)
+	}
+
+	return nil
+}
+
+// Remaining returns the amount of the airdrop not claimed yet
+func (a Airdrop) Remaining() math.Uint {
+	return a.Amount.Sub(a.Claimed)
+}
@@ -0,0 +1,856 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zigchain/factory/airdrop.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Airdrop is an amount of a denom minted into the airdrop escrow, claimable by
+// the recipients of a Merkle tree until expiry_time
+type Airdrop struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// merkle_root is the hex encoded sha256 root of the tree of
+	// sha256(address + amount) leaves
+	MerkleRoot string `protobuf:"bytes,4,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// amount is the amount minted into the airdrop escrow
+	Amount  cosmossdk_io_math.Uint `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Uint" json:"amount"`
+	Claimed cosmossdk_io_math.Uint `protobuf:"bytes,6,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Uint" json:"claimed"`
+	// expiry_time is the unix time (in seconds) after which the airdrop can not
+	// be claimed anymore and the unclaimed amount is returned or burned
+	ExpiryTime int64 `protobuf:"varint,7,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	// burn_unclaimed burns the unclaimed amount at expiry instead of returning
+	// it to the creator
+	BurnUnclaimed bool `protobuf:"varint,8,opt,name=burn_unclaimed,json=burnUnclaimed,proto3" json:"burn_unclaimed,omitempty"`
+}
+
+func (m *Airdrop) Reset()         { *m = Airdrop{} }
+func (m *Airdrop) String() string { return proto.CompactTextString(m) }
+func (*Airdrop) ProtoMessage()    {}
+func (*Airdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7e7392de72402ca, []int{0}
+}
+func (m *Airdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Airdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Airdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Airdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Airdrop.Merge(m, src)
+}
+func (m *Airdrop) XXX_Size() int {
+	return m.Size()
+}
+func (m *Airdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_Airdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Airdrop proto.InternalMessageInfo
+
+func (m *Airdrop) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Airdrop) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Airdrop) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Airdrop) GetMerkleRoot() string {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return ""
+}
+
+func (m *Airdrop) GetExpiryTime() int64 {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return 0
+}
+
+func (m *Airdrop) GetBurnUnclaimed() bool {
+	if m != nil {
+		return m.BurnUnclaimed
+	}
+	return false
+}
+
+// AirdropClaim records that an address has claimed an airdrop
+type AirdropClaim struct {
+	AirdropId uint64 `protobuf:"varint,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *AirdropClaim) Reset()         { *m = AirdropClaim{} }
+func (m *AirdropClaim) String() string { return proto.CompactTextString(m) }
+func (*AirdropClaim) ProtoMessage()    {}
+func (*AirdropClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7e7392de72402ca, []int{1}
+}
+func (m *AirdropClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AirdropClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AirdropClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AirdropClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AirdropClaim.Merge(m, src)
+}
+func (m *AirdropClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *AirdropClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_AirdropClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AirdropClaim proto.InternalMessageInfo
+
+func (m *AirdropClaim) GetAirdropId() uint64 {
+	if m != nil {
+		return m.AirdropId
+	}
+	return 0
+}
+
+func (m *AirdropClaim) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Airdrop)(nil), "zigchain.factory.Airdrop")
+	proto.RegisterType((*AirdropClaim)(nil), "zigchain.factory.AirdropClaim")
+}
+
+func init() { proto.RegisterFile("zigchain/factory/airdrop.proto", fileDescriptor_e7e7392de72402ca) }
+
+var fileDescriptor_e7e7392de72402ca = []byte{
+	// 401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6a, 0xd4, 0x40,
+	0x18, 0xc7, 0x33, 0x69, 0xbb, 0x69, 0xa7, 0x5a, 0x74, 0x58, 0x64, 0x2c, 0x98, 0x0d, 0x05, 0x21,
+	0x08, 0x4d, 0x60, 0x7d, 0x82, 0xd6, 0x93, 0xe2, 0x29, 0xda, 0x8b, 0x97, 0x30, 0xcd, 0x8c, 0xe9,
+	0xd0, 0xce, 0x7c, 0x61, 0x32, 0x0b, 0x5d, 0x9f, 0xc2, 0xc7, 0xf0, 0xe8, 0xa1, 0x0f, 0xb1, 0xc7,
+	0x65, 0x4f, 0xe2, 0x61, 0x91, 0x5d, 0xd0, 0xd7, 0x90, 0x64, 0x26, 0x7b, 0x15, 0xbc, 0x84, 0x7c,
+	0xbf, 0xff, 0xc7, 0xff, 0x1f, 0xfe, 0xf9, 0x70, 0xfc, 0x45, 0xd6, 0xd5, 0x0d, 0x93, 0x3a, 0xff,
+	0xcc, 0x2a, 0x0b, 0x66, 0x9e, 0x33, 0x69, 0xb8, 0x81, 0x26, 0x6b, 0x0c, 0x58, 0x20, 0x4f, 0x06,
+	0x3d, 0xf3, 0xfa, 0xe9, 0xb8, 0x86, 0x1a, 0x7a, 0x31, 0xef, 0xde, 0xdc, 0xde, 0xe9, 0xf3, 0x0a,
+	0x5a, 0x05, 0x6d, 0xe9, 0x04, 0x37, 0x78, 0xe9, 0x29, 0x53, 0x52, 0x43, 0xde, 0x3f, 0x1d, 0x3a,
+	0xfb, 0x1d, 0xe2, 0xe8, 0xc2, 0xe5, 0x90, 0x13, 0x1c, 0x4a, 0x4e, 0x51, 0x82, 0xd2, 0xfd, 0x22,
+	0x94, 0x9c, 0x8c, 0xf1, 0x01, 0x17, 0x1a, 0x14, 0x0d, 0x13, 0x94, 0x1e, 0x15, 0x6e, 0x20, 0x53,
+	0x1c, 0x55, 0x46, 0x30, 0x0b, 0x86, 0xee, 0x75, 0xfc, 0x92, 0xae, 0x1e, 0xce, 0xc7, 0x3e, 0xe7,
+	0x82, 0x73, 0x23, 0xda, 0xf6, 0x83, 0x35, 0x52, 0xd7, 0xc5, 0xb0, 0x48, 0x26, 0xf8, 0x58, 0x09,
+	0x73, 0x7b, 0x27, 0x4a, 0x03, 0x60, 0xe9, 0x7e, 0xef, 0x87, 0x1d, 0x2a, 0x00, 0x2c, 0x79, 0x87,
+	0x47, 0x4c, 0xc1, 0x4c, 0x5b, 0x7a, 0xd0, 0x7b, 0x4e, 0x17, 0xeb, 0x49, 0xf0, 0x73, 0x3d, 0x79,
+	0xe6, 0x7c, 0x5b, 0x7e, 0x9b, 0x49, 0xc8, 0x15, 0xb3, 0x37, 0xd9, 0x95, 0xd4, 0x76, 0xf5, 0x70,
+	0x7e, 0xec, 0x13, 0xbb, 0xf1, 0xdb, 0x9f, 0xef, 0xaf, 0x50, 0xe1, 0x1d, 0xc8, 0x7b, 0x1c, 0x55,
+	0x77, 0x4c, 0x2a, 0xc1, 0xe9, 0xe8, 0xbf, 0xcd, 0x06, 0x8b, 0xee, 0xd3, 0xc5, 0x7d, 0x23, 0xcd,
+	0xbc, 0xb4, 0x52, 0x09, 0x1a, 0x25, 0x28, 0xdd, 0x2b, 0xb0, 0x43, 0x1f, 0xa5, 0x12, 0xe4, 0x25,
+	0x3e, 0xb9, 0x9e, 0x19, 0x5d, 0xce, 0xf4, 0x90, 0x7a, 0x98, 0xa0, 0xf4, 0xb0, 0x78, 0xdc, 0xd1,
+	0xab, 0x01, 0x9e, 0x31, 0xfc, 0xc8, 0xf7, 0xfc, 0xa6, 0x23, 0xe4, 0x05, 0xc6, 0xfe, 0xff, 0x96,
+	0xbb, 0xd2, 0x8f, 0x3c, 0x79, 0xcb, 0xbb, 0x96, 0x99, 0xeb, 0x92, 0x86, 0xff, 0x6a, 0xd9, 0x2f,
+	0x5e, 0x4e, 0x17, 0x9b, 0x18, 0x2d, 0x37, 0x31, 0xfa, 0xb5, 0x89, 0xd1, 0xd7, 0x6d, 0x1c, 0x2c,
+	0xb7, 0x71, 0xf0, 0x63, 0x1b, 0x07, 0x9f, 0xe8, 0xee, 0xb6, 0xee, 0x77, 0xd7, 0x65, 0xe7, 0x8d,
+	0x68, 0xaf, 0x47, 0xfd, 0x19, 0xbc, 0xfe, 0x3b, 0x00, 0x7f, 0xeb, 0x6e, 0xea, 0x7e, 0x02, 0x00,
+	0x00,
+}
+
+func (m *Airdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Airdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Airdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BurnUnclaimed {
+		i--
+		if m.BurnUnclaimed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpiryTime != 0 {
+		i = encodeVarintAirdrop(dAtA, i, uint64(m.ExpiryTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAirdrop(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AirdropClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AirdropClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AirdropClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAirdrop(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AirdropId != 0 {
+		i = encodeVarintAirdrop(dAtA, i, uint64(m.AirdropId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAirdrop(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAirdrop(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Airdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAirdrop(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAirdrop(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovAirdrop(uint64(l))
+	if m.ExpiryTime != 0 {
+		n += 1 + sovAirdrop(uint64(m.ExpiryTime))
+	}
+	if m.BurnUnclaimed {
+		n += 2
+	}
+	return n
+}
+
+func (m *AirdropClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AirdropId != 0 {
+		n += 1 + sovAirdrop(uint64(m.AirdropId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAirdrop(uint64(l))
+	}
+	return n
+}
+
+func sovAirdrop(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAirdrop(x uint64) (n int) {
+	return sovAirdrop(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Airdrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAirdrop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Airdrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Airdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			m.ExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnUnclaimed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnUnclaimed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAirdrop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AirdropClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAirdrop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AirdropClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AirdropClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
+			}
+			m.AirdropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AirdropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAirdrop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAirdrop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAirdrop(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAirdrop
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAirdrop
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAirdrop
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAirdrop
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAirdrop
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAirdrop        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAirdrop          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAirdrop = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,49 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+// BeforeSendHookGasLimit is the gas a before send hook contract can consume for a single transfer,
+// so an expensive contract fails the transfer instead of draining the transaction gas
+const BeforeSendHookGasLimit uint64 = 500_000
+
+// BeforeSendSudoMsg is the sudo message sent to a before send hook contract,
+// the contract rejects the transfer by returning an error
+type BeforeSendSudoMsg struct {
+	BeforeSend BeforeSendMsg `json:"before_send"`
+}
+
+// BeforeSendMsg describes the transfer of a denom that has a before send hook
+type BeforeSendMsg struct {
+	From   string   `json:"from"`
+	To     string   `json:"to"`
+	Amount sdk.Coin `json:"amount"`
+}
+
+// Validate validates a beforeSendHook
+func (h BeforeSendHook) Validate() error {
+	_, _, err := DeconstructDenom(h.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			h.Denom,
+			err,
+		)
+	}
+
+	if err := validators.AddressCheck("contract", h.ContractAddress); err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidContractAddress,
+			"BeforeSendHook contract invalid (%s) : %s",
+			h.Denom,
+			err,
+		)
+	}
+
+	return nil
+}
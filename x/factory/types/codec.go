@@ -21,6 +21,37 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgProposeDenomAdmin{}, "zigchain/factory/propose-denom-admin")
 	legacy.RegisterAminoMsg(cdc, &MsgClaimDenomAdmin{}, "zigchain/factory/claim-denom-admin")
 	legacy.RegisterAminoMsg(cdc, &MsgDisableDenomAdmin{}, "zigchain/factory/disable-denom-admin")
+	legacy.RegisterAminoMsg(cdc, &MsgSetBeforeSendHook{}, "zigchain/factory/set-before-send-hook")
+	legacy.RegisterAminoMsg(cdc, &MsgFreezeAccount{}, "zigchain/factory/freeze-account")
+	legacy.RegisterAminoMsg(cdc, &MsgUnfreezeAccount{}, "zigchain/factory/unfreeze-account")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomGlobalFreeze{}, "zigchain/factory/set-global-freeze")
+	legacy.RegisterAminoMsg(cdc, &MsgForceTransfer{}, "zigchain/factory/force-transfer")
+	legacy.RegisterAminoMsg(cdc, &MsgDisableForceTransfer{}, "zigchain/factory/disable-force-transfer")
+	legacy.RegisterAminoMsg(cdc, &MsgSetMinterAllowance{}, "zigchain/factory/set-minter-allowance")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveMinter{}, "zigchain/factory/remove-minter")
+	legacy.RegisterAminoMsg(cdc, &MsgSetMintRateLimit{}, "zigchain/factory/set-mint-rate-limit")
+	legacy.RegisterAminoMsg(cdc, &MsgMintVested{}, "zigchain/factory/mint-vested")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimVested{}, "zigchain/factory/claim-vested")
+	legacy.RegisterAminoMsg(cdc, &MsgMintAndSendBatch{}, "zigchain/factory/mint-and-send-batch")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateAirdrop{}, "zigchain/factory/create-airdrop")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimAirdrop{}, "zigchain/factory/claim-airdrop")
+	legacy.RegisterAminoMsg(cdc, &MsgTakeSnapshot{}, "zigchain/factory/take-snapshot")
+	legacy.RegisterAminoMsg(cdc, &MsgDistributeRewards{}, "zigchain/factory/distribute-rewards")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimRewards{}, "zigchain/factory/claim-rewards")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterCW20Bridge{}, "zigchain/factory/register-cw20-bridge")
+	legacy.RegisterAminoMsg(cdc, &MsgConvertCW20ToCoin{}, "zigchain/factory/convert-cw20-to-coin")
+	legacy.RegisterAminoMsg(cdc, &MsgConvertCoinToCW20{}, "zigchain/factory/convert-coin-to-cw20")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantDenomRole{}, "zigchain/factory/grant-denom-role")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeDenomRole{}, "zigchain/factory/revoke-denom-role")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelDenomAdminProposal{}, "zigchain/factory/cancel-admin-proposal")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomAdminTimelock{}, "zigchain/factory/set-admin-timelock")
+	legacy.RegisterAminoMsg(cdc, &MsgVerifyDenom{}, "zigchain/factory/verify-denom")
+	legacy.RegisterAminoMsg(cdc, &MsgUnverifyDenom{}, "zigchain/factory/unverify-denom")
+	legacy.RegisterAminoMsg(cdc, &MsgSetTransferTax{}, "zigchain/factory/set-transfer-tax")
+	legacy.RegisterAminoMsg(cdc, &MsgSetTransferTaxExemption{}, "zigchain/factory/set-tax-exemption")
+	legacy.RegisterAminoMsg(cdc, &MsgLaunchToken{}, "zigchain/factory/launch-token")
+	legacy.RegisterAminoMsg(cdc, &MsgBuyLaunchToken{}, "zigchain/factory/buy-launch-token")
+	legacy.RegisterAminoMsg(cdc, &MsgSellLaunchToken{}, "zigchain/factory/sell-launch-token")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -37,6 +68,37 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgProposeDenomAdmin{},
 		&MsgClaimDenomAdmin{},
 		&MsgDisableDenomAdmin{},
+		&MsgSetBeforeSendHook{},
+		&MsgFreezeAccount{},
+		&MsgUnfreezeAccount{},
+		&MsgSetDenomGlobalFreeze{},
+		&MsgForceTransfer{},
+		&MsgDisableForceTransfer{},
+		&MsgSetMinterAllowance{},
+		&MsgRemoveMinter{},
+		&MsgSetMintRateLimit{},
+		&MsgMintVested{},
+		&MsgClaimVested{},
+		&MsgMintAndSendBatch{},
+		&MsgCreateAirdrop{},
+		&MsgClaimAirdrop{},
+		&MsgTakeSnapshot{},
+		&MsgDistributeRewards{},
+		&MsgClaimRewards{},
+		&MsgRegisterCW20Bridge{},
+		&MsgConvertCW20ToCoin{},
+		&MsgConvertCoinToCW20{},
+		&MsgGrantDenomRole{},
+		&MsgRevokeDenomRole{},
+		&MsgCancelDenomAdminProposal{},
+		&MsgSetDenomAdminTimelock{},
+		&MsgVerifyDenom{},
+		&MsgUnverifyDenom{},
+		&MsgSetTransferTax{},
+		&MsgSetTransferTaxExemption{},
+		&MsgLaunchToken{},
+		&MsgBuyLaunchToken{},
+		&MsgSellLaunchToken{},
 	)
 	// this line is used by starport scaffolding # 3
 
@@ -0,0 +1,82 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"zigchain/zutils/validators"
+)
+
+// CW20MaxSupply is the minting cap of the bridged denoms, the largest supply of a CW20 token (Uint128)
+var CW20MaxSupply = math.NewUintFromString("340282366920938463463374607431768211455")
+
+// CW20BridgeCreator returns the creator of the bridged denoms, the factory module address,
+// so that no account can be the bank admin of a bridged denom
+func CW20BridgeCreator() string {
+	return authtypes.NewModuleAddress(ModuleName).String()
+}
+
+// CW20ExecuteMsg is the execute message sent to the CW20 contracts
+type CW20ExecuteMsg struct {
+	Transfer *CW20Transfer `json:"transfer,omitempty"`
+}
+
+// CW20Transfer transfers CW20 tokens from the caller to the recipient
+type CW20Transfer struct {
+	Recipient string    `json:"recipient"`
+	Amount    math.Uint `json:"amount"`
+}
+
+// CW20QueryMsg is the query message sent to the CW20 contracts
+type CW20QueryMsg struct {
+	TokenInfo *struct{}         `json:"token_info,omitempty"`
+	Balance   *CW20BalanceQuery `json:"balance,omitempty"`
+}
+
+// CW20BalanceQuery queries the CW20 balance of an address
+type CW20BalanceQuery struct {
+	Address string `json:"address"`
+}
+
+// CW20TokenInfoResponse is the token info of a CW20 contract
+type CW20TokenInfoResponse struct {
+	Name        string    `json:"name"`
+	Symbol      string    `json:"symbol"`
+	Decimals    uint32    `json:"decimals"`
+	TotalSupply math.Uint `json:"total_supply"`
+}
+
+// CW20BalanceResponse is the CW20 balance of an address
+type CW20BalanceResponse struct {
+	Balance math.Uint `json:"balance"`
+}
+
+// Validate validates a CW20 bridge
+func (b CW20Bridge) Validate() error {
+	if err := validators.AddressCheck("contract", b.ContractAddress); err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidContractAddress,
+			"CW20Bridge (%s) : %s",
+			b.ContractAddress,
+			err,
+		)
+	}
+
+	_, _, err := DeconstructDenom(b.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			b.Denom,
+			err,
+		)
+	}
+
+	if b.Escrowed.IsNil() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "escrowed amount of CW20Bridge (%s) is nil", b.ContractAddress)
+	}
+
+	return nil
+}
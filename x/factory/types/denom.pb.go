@@ -35,6 +35,9 @@ type Denom struct {
 	MintingCap          cosmossdk_io_math.Uint `protobuf:"bytes,3,opt,name=minting_cap,json=mintingCap,proto3,customtype=cosmossdk.io/math.Uint" json:"minting_cap"`
 	Minted              cosmossdk_io_math.Uint `protobuf:"bytes,4,opt,name=minted,proto3,customtype=cosmossdk.io/math.Uint" json:"minted"`
 	CanChangeMintingCap bool                   `protobuf:"varint,5,opt,name=can_change_minting_cap,json=canChangeMintingCap,proto3" json:"can_change_minting_cap,omitempty"`
+	// can_force_transfer lets the bank admin move the denom from any holder, it
+	// is set at creation and can only be turned off
+	CanForceTransfer bool `protobuf:"varint,6,opt,name=can_force_transfer,json=canForceTransfer,proto3" json:"can_force_transfer,omitempty"`
 }
 
 func (m *Denom) Reset()         { *m = Denom{} }
@@ -91,6 +94,13 @@ func (m *Denom) GetCanChangeMintingCap() bool {
 	return false
 }
 
+func (m *Denom) GetCanForceTransfer() bool {
+	if m != nil {
+		return m.CanForceTransfer
+	}
+	return false
+}
+
 type LegacyDenom struct {
 	Creator            string                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Denom              string                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -162,6 +172,7 @@ type DenomResponse struct {
 	CanChangeMintingCap bool                   `protobuf:"varint,6,opt,name=can_change_minting_cap,json=canChangeMintingCap,proto3" json:"can_change_minting_cap,omitempty"`
 	TotalBurned         cosmossdk_io_math.Uint `protobuf:"bytes,7,opt,name=total_burned,json=totalBurned,proto3,customtype=cosmossdk.io/math.Uint" json:"total_burned"`
 	TotalSupply         cosmossdk_io_math.Uint `protobuf:"bytes,8,opt,name=total_supply,json=totalSupply,proto3,customtype=cosmossdk.io/math.Uint" json:"total_supply"`
+	CanForceTransfer    bool                   `protobuf:"varint,9,opt,name=can_force_transfer,json=canForceTransfer,proto3" json:"can_force_transfer,omitempty"`
 }
 
 func (m *DenomResponse) Reset()         { *m = DenomResponse{} }
@@ -218,6 +229,13 @@ func (m *DenomResponse) GetCanChangeMintingCap() bool {
 	return false
 }
 
+func (m *DenomResponse) GetCanForceTransfer() bool {
+	if m != nil {
+		return m.CanForceTransfer
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Denom)(nil), "zigchain.factory.Denom")
 	proto.RegisterType((*LegacyDenom)(nil), "zigchain.factory.LegacyDenom")
@@ -227,35 +245,37 @@ func init() {
 func init() { proto.RegisterFile("zigchain/factory/denom.proto", fileDescriptor_f5c3f88830fcc75c) }
 
 var fileDescriptor_f5c3f88830fcc75c = []byte{
-	// 434 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x41, 0xca, 0xd3, 0x40,
-	0x1c, 0xc5, 0x93, 0xda, 0xf4, 0x6b, 0x27, 0x0a, 0x1a, 0x4b, 0x89, 0x45, 0xd2, 0xd2, 0x55, 0x11,
-	0x4c, 0xa0, 0xbd, 0x41, 0xeb, 0x46, 0xb1, 0xa0, 0x29, 0xdd, 0xb8, 0x09, 0xd3, 0xc9, 0x98, 0x0e,
-	0x36, 0x33, 0x21, 0x99, 0x42, 0xe3, 0x15, 0x44, 0xf0, 0x0a, 0xee, 0x5c, 0xba, 0xf0, 0x10, 0x5d,
-	0x16, 0x57, 0xe2, 0xa2, 0x48, 0xbb, 0xf0, 0x1a, 0x92, 0x99, 0x34, 0x71, 0x51, 0x11, 0xda, 0xf2,
-	0x6d, 0x42, 0xfe, 0xf3, 0xc2, 0x9b, 0x97, 0x1f, 0x8f, 0x3f, 0x78, 0xfc, 0x9e, 0x04, 0x68, 0x01,
-	0x09, 0x75, 0xde, 0x42, 0xc4, 0x59, 0x9c, 0x3a, 0x3e, 0xa6, 0x2c, 0xb4, 0xa3, 0x98, 0x71, 0x66,
-	0xdc, 0x3f, 0xaa, 0x76, 0xae, 0xb6, 0x9b, 0x01, 0x0b, 0x98, 0x10, 0x9d, 0xec, 0x4d, 0x7e, 0xd7,
-	0x7e, 0x84, 0x58, 0x12, 0xb2, 0xc4, 0x93, 0x82, 0x1c, 0x72, 0xe9, 0x01, 0x0c, 0x09, 0x65, 0x8e,
-	0x78, 0xca, 0xa3, 0xde, 0xc7, 0x0a, 0xd0, 0x9e, 0x65, 0xb7, 0x18, 0x26, 0xb8, 0x41, 0x31, 0x86,
-	0x9c, 0xc5, 0xa6, 0xda, 0x55, 0xfb, 0x0d, 0xf7, 0x38, 0x1a, 0x4d, 0xa0, 0x89, 0x20, 0x66, 0x45,
-	0x9c, 0xcb, 0xc1, 0x98, 0x02, 0x3d, 0x24, 0x94, 0x13, 0x1a, 0x78, 0x08, 0x46, 0xe6, 0x9d, 0x4c,
-	0x1b, 0x0d, 0x36, 0xbb, 0x8e, 0xf2, 0x73, 0xd7, 0x69, 0xc9, 0x7b, 0x13, 0xff, 0x9d, 0x4d, 0x98,
-	0x13, 0x42, 0xbe, 0xb0, 0x67, 0x84, 0xf2, 0xef, 0xdf, 0x9e, 0xea, 0x79, 0xa2, 0xd9, 0x73, 0xca,
-	0xbf, 0xfc, 0xfe, 0xfa, 0x44, 0x75, 0x41, 0x6e, 0x33, 0x86, 0x91, 0xf1, 0x02, 0xd4, 0xb2, 0x09,
-	0xfb, 0x66, 0xf5, 0x6c, 0xbf, 0xdc, 0xc1, 0x18, 0x82, 0x16, 0x82, 0xd4, 0x43, 0x0b, 0x48, 0x03,
-	0xec, 0xfd, 0x9d, 0x55, 0xeb, 0xaa, 0xfd, 0xba, 0xfb, 0x10, 0x41, 0x3a, 0x16, 0xe2, 0xa4, 0x08,
-	0xd0, 0xfb, 0x50, 0x01, 0xfa, 0x4b, 0x1c, 0x40, 0x94, 0x9e, 0x47, 0xe5, 0x15, 0x68, 0x84, 0x70,
-	0x3d, 0x5d, 0x45, 0xd1, 0x32, 0xbd, 0x80, 0x49, 0x69, 0x72, 0x55, 0x24, 0x36, 0x30, 0xca, 0x9f,
-	0x2e, 0x62, 0x4a, 0x1c, 0x27, 0x94, 0xde, 0xe7, 0x2a, 0xb8, 0x27, 0x38, 0xb8, 0x38, 0x89, 0x18,
-	0x4d, 0xf0, 0xad, 0xb7, 0x84, 0x9c, 0x6a, 0xc9, 0x6b, 0x00, 0x42, 0xb8, 0xf6, 0x12, 0x19, 0xbf,
-	0x7a, 0x0d, 0xca, 0x33, 0x70, 0x97, 0x33, 0x0e, 0x97, 0x5e, 0xce, 0x5a, 0x3b, 0xdb, 0x54, 0x17,
-	0x3e, 0x93, 0xff, 0x75, 0xb0, 0xf6, 0xcf, 0x0e, 0x96, 0x59, 0xe6, 0xab, 0x98, 0x62, 0xdf, 0xbc,
-	0xb9, 0x30, 0xcb, 0x48, 0xd8, 0x94, 0xb6, 0x39, 0xb7, 0xfa, 0x85, 0xb6, 0x92, 0xdc, 0x68, 0xb0,
-	0xd9, 0x5b, 0xea, 0x76, 0x6f, 0xa9, 0xbf, 0xf6, 0x96, 0xfa, 0xe9, 0x60, 0x29, 0xdb, 0x83, 0xa5,
-	0xfc, 0x38, 0x58, 0xca, 0x1b, 0xb3, 0xd8, 0x67, 0xeb, 0x62, 0xa3, 0xf1, 0x34, 0xc2, 0xc9, 0xbc,
-	0x26, 0x96, 0xcf, 0xf0, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x35, 0x1a, 0x53, 0xb1, 0xf2, 0x04,
-	0x00, 0x00,
+	// 467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0xb3, 0x31, 0x49, 0x9b, 0x17, 0x85, 0xba, 0x96, 0x32, 0x16, 0xd9, 0x96, 0x9c, 0x8a,
+	0xe8, 0x2e, 0xb4, 0xdf, 0x20, 0x15, 0x41, 0xb1, 0xa0, 0xa9, 0xb9, 0x78, 0x59, 0x5e, 0x27, 0x93,
+	0xcd, 0x60, 0x77, 0x66, 0x99, 0x99, 0x42, 0xe2, 0x57, 0xf0, 0xe2, 0xc7, 0xf0, 0xe8, 0xc1, 0x9b,
+	0x5f, 0xa0, 0x37, 0x8b, 0x27, 0xf1, 0x50, 0x24, 0x39, 0xf8, 0x35, 0x64, 0x67, 0xa6, 0x89, 0x87,
+	0x88, 0x90, 0x04, 0x2f, 0x21, 0xef, 0xfd, 0x77, 0xfe, 0xf3, 0xe7, 0xc7, 0x9b, 0x07, 0x0f, 0xde,
+	0xf1, 0x8c, 0x0e, 0x91, 0x8b, 0x64, 0x80, 0xd4, 0x48, 0x35, 0x4e, 0xfa, 0x4c, 0xc8, 0x3c, 0x2e,
+	0x94, 0x34, 0x32, 0xdc, 0xba, 0x51, 0x63, 0xaf, 0xee, 0x6e, 0x67, 0x32, 0x93, 0x56, 0x4c, 0xca,
+	0x7f, 0xee, 0xbb, 0xdd, 0xfb, 0x54, 0xea, 0x5c, 0xea, 0xd4, 0x09, 0xae, 0xf0, 0xd2, 0x5d, 0xcc,
+	0xb9, 0x90, 0x89, 0xfd, 0x75, 0xad, 0xf6, 0x97, 0x2a, 0xd4, 0x9f, 0x94, 0xb7, 0x84, 0x04, 0x36,
+	0xa8, 0x62, 0x68, 0xa4, 0x22, 0xc1, 0x7e, 0x70, 0xd0, 0xec, 0xde, 0x94, 0xe1, 0x36, 0xd4, 0x6d,
+	0x10, 0x52, 0xb5, 0x7d, 0x57, 0x84, 0xa7, 0xd0, 0xca, 0xb9, 0x30, 0x5c, 0x64, 0x29, 0xc5, 0x82,
+	0xdc, 0x2a, 0xb5, 0xce, 0xe1, 0xe5, 0xf5, 0x5e, 0xe5, 0xc7, 0xf5, 0xde, 0x8e, 0xbb, 0x57, 0xf7,
+	0xdf, 0xc6, 0x5c, 0x26, 0x39, 0x9a, 0x61, 0xdc, 0xe3, 0xc2, 0x7c, 0xfb, 0xfc, 0xb8, 0xe5, 0x13,
+	0xf5, 0x9e, 0x09, 0xf3, 0xf1, 0xd7, 0xa7, 0x87, 0x41, 0x17, 0xbc, 0xcd, 0x31, 0x16, 0xe1, 0x73,
+	0x68, 0x94, 0x15, 0xeb, 0x93, 0xda, 0xd2, 0x7e, 0xde, 0x21, 0x3c, 0x82, 0x1d, 0x8a, 0x22, 0xa5,
+	0x43, 0x14, 0x19, 0x4b, 0xff, 0xcc, 0x5a, 0xdf, 0x0f, 0x0e, 0x36, 0xbb, 0xf7, 0x28, 0x8a, 0x63,
+	0x2b, 0x9e, 0xcc, 0x03, 0x3c, 0x82, 0xb0, 0x3c, 0x34, 0x90, 0x8a, 0xb2, 0xd4, 0x28, 0x14, 0x7a,
+	0xc0, 0x14, 0x69, 0xd8, 0x03, 0x5b, 0x14, 0xc5, 0xd3, 0x52, 0x78, 0xed, 0xfb, 0xed, 0xf7, 0x55,
+	0x68, 0xbd, 0x60, 0x19, 0xd2, 0xf1, 0x72, 0x0c, 0x5f, 0x42, 0x33, 0xc7, 0xd1, 0xe9, 0x45, 0x51,
+	0x9c, 0x8f, 0x57, 0x20, 0x38, 0x37, 0x59, 0x2b, 0xc0, 0x18, 0xc2, 0x39, 0xa2, 0x59, 0x4c, 0x07,
+	0x6f, 0x81, 0xd2, 0xfe, 0x5a, 0x83, 0x3b, 0x96, 0x43, 0x97, 0xe9, 0x42, 0x0a, 0xcd, 0xfe, 0xfb,
+	0x4c, 0xf1, 0x45, 0x33, 0xf5, 0x0a, 0x20, 0xc7, 0x51, 0xaa, 0x5d, 0xfc, 0xda, 0x3a, 0x28, 0xf7,
+	0xe0, 0xb6, 0x91, 0x06, 0xcf, 0x53, 0xcf, 0xba, 0xbe, 0xb4, 0x69, 0xcb, 0xfa, 0x9c, 0xfc, 0x6b,
+	0x62, 0x1b, 0x7f, 0x9f, 0xd8, 0x59, 0x96, 0xb3, 0x0b, 0x25, 0x58, 0x9f, 0x6c, 0xac, 0x98, 0xa5,
+	0x63, 0x6d, 0xe6, 0xb6, 0x9e, 0xdb, 0xe6, 0x8a, 0xb6, 0x9e, 0xdc, 0xe2, 0xf7, 0xd5, 0x5c, 0xfc,
+	0xbe, 0x3a, 0x87, 0x97, 0x93, 0x28, 0xb8, 0x9a, 0x44, 0xc1, 0xcf, 0x49, 0x14, 0x7c, 0x98, 0x46,
+	0x95, 0xab, 0x69, 0x54, 0xf9, 0x3e, 0x8d, 0x2a, 0x6f, 0xc8, 0x6c, 0x57, 0x8e, 0x66, 0xdb, 0xd2,
+	0x8c, 0x0b, 0xa6, 0xcf, 0x1a, 0x76, 0xb1, 0x1d, 0xfd, 0x1e, 0x00, 0x54, 0x97, 0x13, 0x66, 0x4e,
+	0x05, 0x00, 0x00,
 }
 
 func (m *Denom) Marshal() (dAtA []byte, err error) {
@@ -278,6 +298,16 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CanForceTransfer {
+		i--
+		if m.CanForceTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.CanChangeMintingCap {
 		i--
 		if m.CanChangeMintingCap {
@@ -412,6 +442,16 @@ func (m *DenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CanForceTransfer {
+		i--
+		if m.CanForceTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.TotalSupply.Size()
 		i -= size
@@ -521,6 +561,9 @@ func (m *Denom) Size() (n int) {
 	if m.CanChangeMintingCap {
 		n += 2
 	}
+	if m.CanForceTransfer {
+		n += 2
+	}
 	return n
 }
 
@@ -575,6 +618,9 @@ func (m *DenomResponse) Size() (n int) {
 	n += 1 + l + sovDenom(uint64(l))
 	l = m.TotalSupply.Size()
 	n += 1 + l + sovDenom(uint64(l))
+	if m.CanForceTransfer {
+		n += 2
+	}
 	return n
 }
 
@@ -765,6 +811,26 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.CanChangeMintingCap = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanForceTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanForceTransfer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDenom(dAtA[iNdEx:])
@@ -1271,6 +1337,26 @@ func (m *DenomResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanForceTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanForceTransfer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDenom(dAtA[iNdEx:])
@@ -0,0 +1,124 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"zigchain/zutils/validators"
+)
+
+// DefaultDenomAdminProposalExpiry is the number of seconds an admin proposal can be claimed
+// once its timelock has passed, when the proposal does not set its own expiry
+const DefaultDenomAdminProposalExpiry uint64 = 7 * 24 * 60 * 60
+
+// MaxDenomAdminDelay is the longest admin timelock and admin proposal expiry, in seconds
+const MaxDenomAdminDelay uint64 = 365 * 24 * 60 * 60
+
+// IsClaimable returns nil if the proposal can be claimed at the unix time now
+func (p DenomAdminProposal) IsClaimable(now int64) error {
+	if now < p.ClaimableTime {
+		return errorsmod.Wrapf(
+			ErrAdminProposalTimelocked,
+			"proposal of denom (%s) can be claimed from %d",
+			p.Denom,
+			p.ClaimableTime,
+		)
+	}
+
+	if p.ExpiryTime != 0 && now >= p.ExpiryTime {
+		return errorsmod.Wrapf(
+			ErrAdminProposalExpired,
+			"proposal of denom (%s) expired at %d",
+			p.Denom,
+			p.ExpiryTime,
+		)
+	}
+
+	return nil
+}
+
+// Validate validates a denom admin proposal
+func (p DenomAdminProposal) Validate() error {
+	if _, _, err := DeconstructDenom(p.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenom, "(%s) : %s", p.Denom, err)
+	}
+
+	if err := validators.AddressCheck("BankAdmin", p.BankAdmin); err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidBankAdminAddress,
+			"Bank admin address (%s)",
+			p.BankAdmin,
+		)
+	}
+
+	if p.MetadataAdmin != "" {
+		if err := validators.AddressCheck("MetadataAdmin", p.MetadataAdmin); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidMetadataAdminAddress,
+				"Metadata admin address (%s)",
+				p.MetadataAdmin,
+			)
+		}
+	}
+
+	if p.ClaimableTime < 0 || p.ExpiryTime < 0 || (p.ExpiryTime != 0 && p.ExpiryTime < p.ClaimableTime) {
+		return errorsmod.Wrapf(
+			ErrInvalidAdminProposal,
+			"proposal of denom (%s) claimable at %d expires at %d",
+			p.Denom,
+			p.ClaimableTime,
+			p.ExpiryTime,
+		)
+	}
+
+	return nil
+}
+
+// ValidateDenomAdminDelay validates the delay of an admin timelock or the expiry of an admin proposal
+func ValidateDenomAdminDelay(name string, delay uint64) error {
+	if delay > MaxDenomAdminDelay {
+		return errorsmod.Wrapf(
+			ErrInvalidAdminTimelock,
+			"%s %d exceeds the maximum of %d seconds",
+			name,
+			delay,
+			MaxDenomAdminDelay,
+		)
+	}
+
+	return nil
+}
+
+// Validate validates a denom admin timelock
+func (t DenomAdminTimelock) Validate() error {
+	if _, _, err := DeconstructDenom(t.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenom, "(%s) : %s", t.Denom, err)
+	}
+
+	if err := ValidateDenomAdminDelay("delay", t.Delay); err != nil {
+		return err
+	}
+
+	if t.PendingTime != 0 && t.PendingDelay >= t.Delay {
+		return errorsmod.Wrapf(
+			ErrInvalidAdminTimelock,
+			"pending delay %d of denom (%s) must be shorter than the delay %d",
+			t.PendingDelay,
+			t.Denom,
+			t.Delay,
+		)
+	}
+
+	return nil
+}
+
+// Effective returns the timelock in effect at the unix time now, with the pending
+// delay applied once its time is reached
+func (t DenomAdminTimelock) Effective(now int64) DenomAdminTimelock {
+	if t.PendingTime != 0 && now >= t.PendingTime {
+		t.Delay = t.PendingDelay
+		t.PendingDelay = 0
+		t.PendingTime = 0
+	}
+
+	return t
+}
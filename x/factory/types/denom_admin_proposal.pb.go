@@ -0,0 +1,786 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zigchain/factory/denom_admin_proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DenomAdminProposal is a pending transfer of the admins of a denom, its first
+// fields match DenomAuth so the proposals stored before the timelock decode
+// as claimable proposals without expiry
+type DenomAdminProposal struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	BankAdmin     string `protobuf:"bytes,2,opt,name=bank_admin,json=bankAdmin,proto3" json:"bank_admin,omitempty"`
+	MetadataAdmin string `protobuf:"bytes,3,opt,name=metadata_admin,json=metadataAdmin,proto3" json:"metadata_admin,omitempty"`
+	// claimable_time is the unix time (in seconds) from which the proposed bank
+	// admin can claim the proposal
+	ClaimableTime int64 `protobuf:"varint,4,opt,name=claimable_time,json=claimableTime,proto3" json:"claimable_time,omitempty"`
+	// expiry_time is the unix time (in seconds) from which the proposal can no
+	// longer be claimed, 0 if it does not expire
+	ExpiryTime int64 `protobuf:"varint,5,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+}
+
+func (m *DenomAdminProposal) Reset()         { *m = DenomAdminProposal{} }
+func (m *DenomAdminProposal) String() string { return proto.CompactTextString(m) }
+func (*DenomAdminProposal) ProtoMessage()    {}
+func (*DenomAdminProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a29d6f5dad3cb41c, []int{0}
+}
+func (m *DenomAdminProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomAdminProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomAdminProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomAdminProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomAdminProposal.Merge(m, src)
+}
+func (m *DenomAdminProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomAdminProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomAdminProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomAdminProposal proto.InternalMessageInfo
+
+func (m *DenomAdminProposal) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomAdminProposal) GetBankAdmin() string {
+	if m != nil {
+		return m.BankAdmin
+	}
+	return ""
+}
+
+func (m *DenomAdminProposal) GetMetadataAdmin() string {
+	if m != nil {
+		return m.MetadataAdmin
+	}
+	return ""
+}
+
+func (m *DenomAdminProposal) GetClaimableTime() int64 {
+	if m != nil {
+		return m.ClaimableTime
+	}
+	return 0
+}
+
+func (m *DenomAdminProposal) GetExpiryTime() int64 {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return 0
+}
+
+// DenomAdminTimelock is the delay between the proposal of new admins of a
+// denom and their claim, a shorter delay set by the bank admin is kept as
+// pending until pending_time
+type DenomAdminTimelock struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// delay is the number of seconds a proposal waits before it can be claimed
+	Delay uint64 `protobuf:"varint,2,opt,name=delay,proto3" json:"delay,omitempty"`
+	// pending_delay replaces delay once the block time reaches pending_time
+	PendingDelay uint64 `protobuf:"varint,3,opt,name=pending_delay,json=pendingDelay,proto3" json:"pending_delay,omitempty"`
+	// pending_time is the unix time (in seconds) at which pending_delay takes
+	// effect, 0 without pending delay
+	PendingTime int64 `protobuf:"varint,4,opt,name=pending_time,json=pendingTime,proto3" json:"pending_time,omitempty"`
+}
+
+func (m *DenomAdminTimelock) Reset()         { *m = DenomAdminTimelock{} }
+func (m *DenomAdminTimelock) String() string { return proto.CompactTextString(m) }
+func (*DenomAdminTimelock) ProtoMessage()    {}
+func (*DenomAdminTimelock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a29d6f5dad3cb41c, []int{1}
+}
+func (m *DenomAdminTimelock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomAdminTimelock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomAdminTimelock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomAdminTimelock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomAdminTimelock.Merge(m, src)
+}
+func (m *DenomAdminTimelock) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomAdminTimelock) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomAdminTimelock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomAdminTimelock proto.InternalMessageInfo
+
+func (m *DenomAdminTimelock) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomAdminTimelock) GetDelay() uint64 {
+	if m != nil {
+		return m.Delay
+	}
+	return 0
+}
+
+func (m *DenomAdminTimelock) GetPendingDelay() uint64 {
+	if m != nil {
+		return m.PendingDelay
+	}
+	return 0
+}
+
+func (m *DenomAdminTimelock) GetPendingTime() int64 {
+	if m != nil {
+		return m.PendingTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DenomAdminProposal)(nil), "zigchain.factory.DenomAdminProposal")
+	proto.RegisterType((*DenomAdminTimelock)(nil), "zigchain.factory.DenomAdminTimelock")
+}
+
+func init() {
+	proto.RegisterFile("zigchain/factory/denom_admin_proposal.proto", fileDescriptor_a29d6f5dad3cb41c)
+}
+
+var fileDescriptor_a29d6f5dad3cb41c = []byte{
+	// 290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x4f, 0x4a, 0xc3, 0x40,
+	0x14, 0xc6, 0x3b, 0xa6, 0x15, 0x3a, 0x35, 0x22, 0x83, 0x8b, 0x6c, 0x1c, 0x6b, 0x45, 0x28, 0x08,
+	0x2d, 0xe8, 0x09, 0x94, 0x1e, 0x40, 0x82, 0x2b, 0x37, 0xe1, 0x25, 0x19, 0xeb, 0xd0, 0xcc, 0x1f,
+	0xd2, 0x59, 0x34, 0x9e, 0xc0, 0xa5, 0xc7, 0xf1, 0x08, 0x2e, 0xbb, 0x74, 0x29, 0xc9, 0x45, 0x64,
+	0x66, 0x92, 0xa0, 0x0b, 0x97, 0xef, 0xf7, 0x7e, 0xf3, 0xe6, 0x83, 0x0f, 0x5f, 0xbf, 0xf2, 0x75,
+	0xf6, 0x02, 0x5c, 0x2e, 0x9f, 0x21, 0x33, 0xaa, 0xac, 0x96, 0x39, 0x93, 0x4a, 0x24, 0x90, 0x0b,
+	0x2e, 0x13, 0x5d, 0x2a, 0xad, 0xb6, 0x50, 0x2c, 0x74, 0xa9, 0x8c, 0x22, 0x27, 0x9d, 0xbc, 0x68,
+	0xe5, 0xd9, 0x07, 0xc2, 0x64, 0x65, 0x1f, 0xdc, 0x59, 0xff, 0xa1, 0xd5, 0xc9, 0x29, 0x1e, 0xb9,
+	0x33, 0x11, 0x9a, 0xa2, 0xf9, 0x38, 0xf6, 0x03, 0x39, 0xc3, 0x38, 0x05, 0xb9, 0xf1, 0xb7, 0xa3,
+	0x03, 0xb7, 0x1a, 0x5b, 0xe2, 0x1e, 0x93, 0x2b, 0x7c, 0x2c, 0x98, 0x81, 0x1c, 0x0c, 0xb4, 0x4a,
+	0xe0, 0x94, 0xb0, 0xa3, 0xbd, 0x96, 0x15, 0xc0, 0x05, 0xa4, 0x05, 0x4b, 0x0c, 0x17, 0x2c, 0x1a,
+	0x4e, 0xd1, 0x3c, 0x88, 0xc3, 0x9e, 0x3e, 0x72, 0xc1, 0xc8, 0x39, 0x9e, 0xb0, 0x9d, 0xe6, 0x65,
+	0xe5, 0x9d, 0x91, 0x73, 0xb0, 0x47, 0x56, 0x98, 0xbd, 0xfd, 0x89, 0x6e, 0x51, 0xa1, 0xb2, 0xcd,
+	0x3f, 0xd1, 0x1d, 0x2d, 0xa0, 0x72, 0xa9, 0x87, 0xb1, 0x1f, 0xc8, 0x25, 0x0e, 0x35, 0x93, 0x39,
+	0x97, 0xeb, 0xc4, 0x6f, 0x03, 0xb7, 0x3d, 0x6a, 0xe1, 0xca, 0x49, 0x17, 0xb8, 0x9b, 0x7f, 0xa7,
+	0x9d, 0xb4, 0xcc, 0xfe, 0x7b, 0x7f, 0xf3, 0x59, 0x53, 0xb4, 0xaf, 0x29, 0xfa, 0xae, 0x29, 0x7a,
+	0x6f, 0xe8, 0x60, 0xdf, 0xd0, 0xc1, 0x57, 0x43, 0x07, 0x4f, 0x51, 0x5f, 0xcf, 0xae, 0x2f, 0xc8,
+	0x54, 0x9a, 0x6d, 0xd3, 0x43, 0x57, 0xc9, 0xed, 0xcf, 0x00, 0x98, 0x57, 0x75, 0x10, 0xc1, 0x01,
+	0x00, 0x00,
+}
+
+func (m *DenomAdminProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomAdminProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomAdminProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTime != 0 {
+		i = encodeVarintDenomAdminProposal(dAtA, i, uint64(m.ExpiryTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ClaimableTime != 0 {
+		i = encodeVarintDenomAdminProposal(dAtA, i, uint64(m.ClaimableTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MetadataAdmin) > 0 {
+		i -= len(m.MetadataAdmin)
+		copy(dAtA[i:], m.MetadataAdmin)
+		i = encodeVarintDenomAdminProposal(dAtA, i, uint64(len(m.MetadataAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BankAdmin) > 0 {
+		i -= len(m.BankAdmin)
+		copy(dAtA[i:], m.BankAdmin)
+		i = encodeVarintDenomAdminProposal(dAtA, i, uint64(len(m.BankAdmin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDenomAdminProposal(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomAdminTimelock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomAdminTimelock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomAdminTimelock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingTime != 0 {
+		i = encodeVarintDenomAdminProposal(dAtA, i, uint64(m.PendingTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PendingDelay != 0 {
+		i = encodeVarintDenomAdminProposal(dAtA, i, uint64(m.PendingDelay))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Delay != 0 {
+		i = encodeVarintDenomAdminProposal(dAtA, i, uint64(m.Delay))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDenomAdminProposal(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDenomAdminProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDenomAdminProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomAdminProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDenomAdminProposal(uint64(l))
+	}
+	l = len(m.BankAdmin)
+	if l > 0 {
+		n += 1 + l + sovDenomAdminProposal(uint64(l))
+	}
+	l = len(m.MetadataAdmin)
+	if l > 0 {
+		n += 1 + l + sovDenomAdminProposal(uint64(l))
+	}
+	if m.ClaimableTime != 0 {
+		n += 1 + sovDenomAdminProposal(uint64(m.ClaimableTime))
+	}
+	if m.ExpiryTime != 0 {
+		n += 1 + sovDenomAdminProposal(uint64(m.ExpiryTime))
+	}
+	return n
+}
+
+func (m *DenomAdminTimelock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDenomAdminProposal(uint64(l))
+	}
+	if m.Delay != 0 {
+		n += 1 + sovDenomAdminProposal(uint64(m.Delay))
+	}
+	if m.PendingDelay != 0 {
+		n += 1 + sovDenomAdminProposal(uint64(m.PendingDelay))
+	}
+	if m.PendingTime != 0 {
+		n += 1 + sovDenomAdminProposal(uint64(m.PendingTime))
+	}
+	return n
+}
+
+func sovDenomAdminProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDenomAdminProposal(x uint64) (n int) {
+	return sovDenomAdminProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomAdminProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDenomAdminProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomAdminProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomAdminProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomAdminProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomAdminProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomAdminProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomAdminProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomAdminProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomAdminProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomAdminProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomAdminProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomAdminProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableTime", wireType)
+			}
+			m.ClaimableTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomAdminProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimableTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			m.ExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomAdminProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDenomAdminProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDenomAdminProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomAdminTimelock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDenomAdminProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomAdminTimelock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomAdminTimelock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomAdminProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomAdminProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomAdminProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			m.Delay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomAdminProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDelay", wireType)
+			}
+			m.PendingDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomAdminProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTime", wireType)
+			}
+			m.PendingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomAdminProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDenomAdminProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDenomAdminProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDenomAdminProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDenomAdminProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDenomAdminProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDenomAdminProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDenomAdminProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDenomAdminProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDenomAdminProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDenomAdminProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDenomAdminProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDenomAdminProposal = fmt.Errorf("proto: unexpected end of group")
+)
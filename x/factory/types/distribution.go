@@ -0,0 +1,69 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zigchain/zutils/validators"
+)
+
+// MaxRewardDenoms is the maximum number of reward denoms of a denom, as every transfer of
+// the denom settles the rewards of the sender and the recipient in each of them
+const MaxRewardDenoms = 10
+
+// Earned returns the reward of a holder with the balance since the reward was last settled
+func (h HolderReward) Earned(pool RewardPool, balance math.Int) math.Uint {
+	accrued := pool.RewardPerToken.Sub(h.RewardPerTokenPaid).MulInt(balance).TruncateInt()
+
+	return h.Pending.Add(math.NewUintFromBigInt(accrued.BigInt()))
+}
+
+// Validate validates a reward pool
+func (p RewardPool) Validate() error {
+	_, _, err := DeconstructDenom(p.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			p.Denom,
+			err,
+		)
+	}
+
+	if err := validators.CheckDenomString(p.RewardDenom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDistribution, "reward denom (%s) : %s", p.RewardDenom, err)
+	}
+
+	if p.RewardPerToken.IsNil() || p.RewardPerToken.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidDistribution, "reward per token of %s in %s is nil or negative", p.Denom, p.RewardDenom)
+	}
+
+	if p.Distributed.IsNil() || p.Claimed.IsNil() || p.Claimed.GT(p.Distributed) {
+		return errorsmod.Wrapf(ErrInvalidDistribution, "claimed amount of %s in %s is nil or exceeds the distributed amount", p.Denom, p.RewardDenom)
+	}
+
+	return nil
+}
+
+// Validate validates the reward of a holder
+func (h HolderReward) Validate() error {
+	if err := validators.AddressCheck("Address", h.Address); err != nil {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidAddress,
+			"HolderReward address invalid (%s) : %s",
+			h.Denom,
+			err,
+		)
+	}
+
+	if h.RewardPerTokenPaid.IsNil() || h.RewardPerTokenPaid.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidDistribution, "reward per token paid to %s is nil or negative", h.Address)
+	}
+
+	if h.Pending.IsNil() {
+		return errorsmod.Wrapf(ErrInvalidDistribution, "pending reward of %s is nil", h.Address)
+	}
+
+	return nil
+}
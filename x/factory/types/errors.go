@@ -28,4 +28,50 @@ var (
 	ErrDuplicateBankAdminProposal  = sdkerrors.Register(ModuleName, 1115, "cannot propose the same bank admin address")
 	ErrNoAdminProposal             = sdkerrors.Register(ModuleName, 1116, "no admin has been proposed for denom")
 	ErrUnauthorizedAdminClaim      = sdkerrors.Register(ModuleName, 1117, "only the proposed admin can claim the role")
+	ErrInvalidContractAddress      = sdkerrors.Register(ModuleName, 1118, "contract address is not valid")
+	ErrContractNotFound            = sdkerrors.Register(ModuleName, 1119, "contract not found")
+	ErrBeforeSendHookNotFound      = sdkerrors.Register(ModuleName, 1120, "before send hook not found")
+	ErrBeforeSendHookRejected      = sdkerrors.Register(ModuleName, 1121, "transfer rejected by the before send hook")
+	ErrAccountFrozen               = sdkerrors.Register(ModuleName, 1122, "account is frozen for denom")
+	ErrDenomGlobalFrozen           = sdkerrors.Register(ModuleName, 1123, "denom is globally frozen")
+	ErrCannotFreezeModuleAccount   = sdkerrors.Register(ModuleName, 1124, "module accounts can not be frozen")
+	ErrAccountNotFrozen            = sdkerrors.Register(ModuleName, 1125, "account is not frozen for denom")
+	ErrForceTransferDisabled       = sdkerrors.Register(ModuleName, 1126, "force transfer is disabled for denom")
+	ErrForceTransferModuleAccount  = sdkerrors.Register(ModuleName, 1127, "can not force transfer from or to a module account")
+	ErrMinterNotFound              = sdkerrors.Register(ModuleName, 1128, "minter not found")
+	ErrMinterAllowanceExceeded     = sdkerrors.Register(ModuleName, 1129, "minter allowance exceeded")
+	ErrInvalidMintRateLimit        = sdkerrors.Register(ModuleName, 1130, "invalid mint rate limit")
+	ErrMintRateLimitExceeded       = sdkerrors.Register(ModuleName, 1131, "mint rate limit exceeded")
+	ErrInvalidVestingSchedule      = sdkerrors.Register(ModuleName, 1132, "invalid vesting schedule")
+	ErrVestingGrantNotFound        = sdkerrors.Register(ModuleName, 1133, "vesting grant not found")
+	ErrNothingToClaim              = sdkerrors.Register(ModuleName, 1134, "nothing to claim")
+	ErrInvalidBatch                = sdkerrors.Register(ModuleName, 1135, "invalid mint batch")
+	ErrInvalidAirdrop              = sdkerrors.Register(ModuleName, 1136, "invalid airdrop")
+	ErrAirdropNotFound             = sdkerrors.Register(ModuleName, 1137, "airdrop not found")
+	ErrAirdropExpired              = sdkerrors.Register(ModuleName, 1138, "airdrop expired")
+	ErrAirdropAlreadyClaimed       = sdkerrors.Register(ModuleName, 1139, "airdrop already claimed")
+	ErrInvalidMerkleProof          = sdkerrors.Register(ModuleName, 1140, "invalid merkle proof")
+	ErrSnapshotNotFound            = sdkerrors.Register(ModuleName, 1141, "snapshot not found")
+	ErrInvalidDistribution         = sdkerrors.Register(ModuleName, 1142, "invalid reward distribution")
+	ErrNoEligibleSupply            = sdkerrors.Register(ModuleName, 1143, "no eligible supply to distribute to")
+	ErrCW20BridgeNotFound          = sdkerrors.Register(ModuleName, 1144, "cw20 bridge not found")
+	ErrCW20BridgeExists            = sdkerrors.Register(ModuleName, 1145, "cw20 bridge already exists")
+	ErrInvalidCW20                 = sdkerrors.Register(ModuleName, 1146, "invalid cw20 contract")
+	ErrInvalidRole                 = sdkerrors.Register(ModuleName, 1147, "invalid denom role")
+	ErrAdminProposalTimelocked     = sdkerrors.Register(ModuleName, 1148, "denom admin proposal is still timelocked")
+	ErrAdminProposalExpired        = sdkerrors.Register(ModuleName, 1149, "denom admin proposal expired")
+	ErrInvalidAdminTimelock        = sdkerrors.Register(ModuleName, 1150, "invalid denom admin timelock")
+	ErrInvalidAdminProposal        = sdkerrors.Register(ModuleName, 1151, "invalid denom admin proposal")
+	ErrInvalidVerifiedDenom        = sdkerrors.Register(ModuleName, 1152, "invalid verified denom")
+	ErrTickerTaken                 = sdkerrors.Register(ModuleName, 1153, "ticker already verified for another denom")
+	ErrDenomNotVerified            = sdkerrors.Register(ModuleName, 1154, "denom is not verified")
+	ErrInvalidTransferTax          = sdkerrors.Register(ModuleName, 1155, "invalid transfer tax")
+	ErrTransferTaxRateTooHigh      = sdkerrors.Register(ModuleName, 1156, "transfer tax rate exceeds the maximum")
+	ErrTransferTaxNotFound         = sdkerrors.Register(ModuleName, 1157, "transfer tax not found")
+	ErrInvalidTokenLaunch          = sdkerrors.Register(ModuleName, 1158, "invalid token launch")
+	ErrTokenLaunchNotFound         = sdkerrors.Register(ModuleName, 1159, "token launch not found")
+	ErrTokenLaunchGraduated        = sdkerrors.Register(ModuleName, 1160, "token launch has graduated")
+	ErrLaunchpadDisabled           = sdkerrors.Register(ModuleName, 1161, "launchpad disabled")
+	ErrLaunchSlippage              = sdkerrors.Register(ModuleName, 1162, "launch trade below the minimum outgoing amount")
+	ErrLaunchNotGraduated          = sdkerrors.Register(ModuleName, 1163, "token launch has not graduated")
 )
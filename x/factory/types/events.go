@@ -12,6 +12,40 @@ const (
 	EventDenomAuthClaimed       = "denom_auth_claimed"
 	EventDenomAuthDisabled      = "denom_auth_disabled"
 	EventTypeWithdrawModuleFees = "withdraw_module_fees"
+	EventBeforeSendHookSet      = "before_send_hook_set"
+	EventAccountFrozen          = "account_frozen"
+	EventAccountUnfrozen        = "account_unfrozen"
+	EventDenomGlobalFreezeSet   = "denom_global_freeze_set"
+	EventForceTransfer          = "force_transfer"
+	EventForceTransferDisabled  = "force_transfer_disabled"
+	EventMinterAllowanceSet     = "minter_allowance_set"
+	EventMinterRemoved          = "minter_removed"
+	EventMintRateLimitSet       = "mint_rate_limit_set"
+	EventVestedMinted           = "vested_minted"
+	EventVestedClaimed          = "vested_claimed"
+	EventDenomBatchMinted       = "denom_batch_minted"
+	EventAirdropCreated         = "airdrop_created"
+	EventAirdropClaimed         = "airdrop_claimed"
+	EventAirdropClosed          = "airdrop_closed"
+	EventSnapshotTaken          = "snapshot_taken"
+	EventRewardsDistributed     = "rewards_distributed"
+	EventRewardsClaimed         = "rewards_claimed"
+	EventCW20BridgeRegistered   = "cw20_bridge_registered"
+	EventCW20ConvertedToCoin    = "cw20_converted_to_coin"
+	EventCoinConvertedToCW20    = "coin_converted_to_cw20"
+	EventDenomRoleGranted       = "denom_role_granted"
+	EventDenomRoleRevoked       = "denom_role_revoked"
+	EventAdminProposalCancelled = "denom_auth_proposal_cancelled"
+	EventAdminTimelockSet       = "denom_admin_timelock_set"
+	EventDenomVerified          = "denom_verified"
+	EventDenomUnverified        = "denom_unverified"
+	EventTransferTaxSet         = "transfer_tax_set"
+	EventTaxExemptionSet        = "transfer_tax_exemption_set"
+	EventTransferTaxPaid        = "transfer_tax_paid"
+	EventTokenLaunched          = "token_launched"
+	EventLaunchTokenBought      = "launch_token_bought"
+	EventLaunchTokenSold        = "launch_token_sold"
+	EventLaunchGraduated        = "token_launch_graduated"
 
 	AttributeValueCategory          = ModuleName
 	AttributeKeySigner              = "signer"
@@ -32,4 +66,53 @@ const (
 	AttributeKeyModule              = "module"
 	AttributeKeyReceiver            = "receiver"
 	AttributeKeyFee                 = "fee"
+	AttributeKeyContractAddress     = "contract_address"
+	AttributeKeyAddress             = "address"
+	AttributeKeyFrozen              = "frozen"
+	AttributeKeyCanForceTransfer    = "can_force_transfer"
+	AttributeKeyFromAddress         = "from_address"
+	AttributeKeyToAddress           = "to_address"
+	AttributeKeyMinter              = "minter"
+	AttributeKeyAllowance           = "allowance"
+	AttributeKeyLimit               = "limit"
+	AttributeKeyWindow              = "window"
+	AttributeKeyRaiseDelay          = "raise_delay"
+	AttributeKeyPendingLimit        = "pending_limit"
+	AttributeKeyPendingWindow       = "pending_window"
+	AttributeKeyPendingRaiseDelay   = "pending_raise_delay"
+	AttributeKeyPendingTime         = "pending_time"
+	AttributeKeyGrantID             = "grant_id"
+	AttributeKeyEscrow              = "escrow"
+	AttributeKeyStartTime           = "start_time"
+	AttributeKeyEndTime             = "end_time"
+	AttributeKeyRecipientCount      = "recipient_count"
+	AttributeKeyOutputs             = "outputs"
+	AttributeKeyAirdropID           = "airdrop_id"
+	AttributeKeyMerkleRoot          = "merkle_root"
+	AttributeKeyExpiryTime          = "expiry_time"
+	AttributeKeyBurned              = "burned"
+	AttributeKeyReturned            = "returned"
+	AttributeKeySnapshotID          = "snapshot_id"
+	AttributeKeyHeight              = "height"
+	AttributeKeySupply              = "supply"
+	AttributeKeyReward              = "reward"
+	AttributeKeyEligibleSupply      = "eligible_supply"
+	AttributeKeyRewardPerToken      = "reward_per_token"
+	AttributeKeyEscrowed            = "escrowed"
+	AttributeKeyRole                = "role"
+	AttributeKeyClaimableTime       = "claimable_time"
+	AttributeKeyDelay               = "delay"
+	AttributeKeyPendingDelay        = "pending_delay"
+	AttributeKeyTicker              = "ticker"
+	AttributeKeyDecimals            = "decimals"
+	AttributeKeyRate                = "rate"
+	AttributeKeyExempt              = "exempt"
+	AttributeKeyTax                 = "tax"
+	AttributeKeyCurveSupply         = "curve_supply"
+	AttributeKeyIncoming            = "incoming"
+	AttributeKeyOutgoing            = "outgoing"
+	AttributeKeyRaised              = "raised"
+	AttributeKeyMarketCap           = "market_cap"
+	AttributeKeyPoolID              = "pool_id"
+	AttributeKeyLPToken             = "lp_token"
 )
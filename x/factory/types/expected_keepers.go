@@ -9,10 +9,12 @@ import (
 
 // AccountKeeper defines the expected interface for the Account module.
 type AccountKeeper interface {
-	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI // only used for simulation
+	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI
 	GetModuleAddress(name string) sdk.AccAddress
 	SetModuleAccount(context.Context, sdk.ModuleAccountI)
 	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
+	NewAccount(context.Context, sdk.AccountI) sdk.AccountI
+	SetAccount(context.Context, sdk.AccountI)
 	// Methods imported from an account should be defined here
 }
 
@@ -20,8 +22,10 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx context.Context, name string, amt sdk.Coins) error
 	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
+	BlockedAddr(addr sdk.AccAddress) bool
 
 	HasBalance(ctx context.Context, addr sdk.AccAddress, amt sdk.Coin) bool
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
@@ -39,6 +43,30 @@ type BankKeeper interface {
 	GetAllBalances(context.Context, sdk.AccAddress) sdk.Coins
 }
 
+// ContractKeeper defines the expected interface for the CosmWasm module, used by the before send hooks.
+type ContractKeeper interface {
+	HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool
+	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// ContractOpsKeeper defines the expected interface for the CosmWasm module, used to execute the CW20 contracts of the bridges.
+type ContractOpsKeeper interface {
+	Execute(ctx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
+}
+
+// ContractViewKeeper defines the expected interface for the CosmWasm module, used to query the CW20 contracts of the bridges.
+type ContractViewKeeper interface {
+	QuerySmart(ctx context.Context, contractAddress sdk.AccAddress, req []byte) ([]byte, error)
+}
+
+// DexKeeper defines the expected interface for the dex module, used to seed the pools of the graduated launches.
+type DexKeeper interface {
+	// GetCreationFee returns the fee charged to create a pool
+	GetCreationFee(ctx context.Context) sdk.Coin
+	// CreatePoolWithCoins creates a pool with the coins of the creator and sends its LP tokens to the receiver
+	CreatePoolWithCoins(ctx context.Context, creator string, base sdk.Coin, quote sdk.Coin, receiver string) (string, sdk.Coin, error)
+}
+
 type StakingKeeper interface {
 	BondDenom(ctx context.Context) (string, error)
 }
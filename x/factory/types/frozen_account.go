@@ -0,0 +1,32 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zigchain/zutils/validators"
+)
+
+// Validate validates a frozenAccount
+func (f FrozenAccount) Validate() error {
+	_, _, err := DeconstructDenom(f.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			f.Denom,
+			err,
+		)
+	}
+
+	if err := validators.AddressCheck("address", f.Address); err != nil {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidAddress,
+			"FrozenAccount address invalid (%s) : %s",
+			f.Denom,
+			err,
+		)
+	}
+
+	return nil
+}
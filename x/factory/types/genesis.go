@@ -15,8 +15,30 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		DenomList:     []Denom{},
-		DenomAuthList: []DenomAuth{},
+		DenomList:               []Denom{},
+		DenomAuthList:           []DenomAuth{},
+		BeforeSendHookList:      []BeforeSendHook{},
+		FrozenAccountList:       []FrozenAccount{},
+		GlobalFrozenDenoms:      []string{},
+		MinterList:              []Minter{},
+		MintRateLimitList:       []MintRateLimit{},
+		MintRateBucketList:      []MintRateBucket{},
+		VestingGrantList:        []VestingGrant{},
+		AirdropList:             []Airdrop{},
+		AirdropClaimList:        []AirdropClaim{},
+		SnapshotList:            []Snapshot{},
+		SnapshotBalanceList:     []SnapshotBalance{},
+		RewardPoolList:          []RewardPool{},
+		HolderRewardList:        []HolderReward{},
+		ModuleAccountHolderList: []ModuleAccountHolder{},
+		Cw20BridgeList:          []CW20Bridge{},
+		DenomRoleList:           []DenomRole{},
+		DenomAdminProposalList:  []DenomAdminProposal{},
+		DenomAdminTimelockList:  []DenomAdminTimelock{},
+		VerifiedDenomList:       []VerifiedDenom{},
+		TransferTaxList:         []TransferTax{},
+		TaxExemptionList:        []TransferTaxExemption{},
+		TokenLaunchList:         []TokenLaunch{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -69,6 +91,440 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// Check for duplicated denoms in beforeSendHook, each hook needs a Denom
+	beforeSendHookIndexMap := make(map[string]struct{})
+
+	for _, hook := range gs.BeforeSendHookList {
+		if _, ok := beforeSendHookIndexMap[hook.Denom]; ok {
+			return fmt.Errorf("duplicated index for beforeSendHook")
+		}
+		beforeSendHookIndexMap[hook.Denom] = struct{}{}
+
+		if err := hook.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := denomIndexMap[string(DenomKey(hook.Denom))]; !exists {
+			return fmt.Errorf("missing Denom for BeforeSendHook '%s'", hook.Denom)
+		}
+	}
+
+	// Check for duplicated frozen accounts, each frozen account needs a Denom
+	frozenAccountIndexMap := make(map[string]struct{})
+
+	for _, frozenAccount := range gs.FrozenAccountList {
+		index := frozenAccount.Denom + "/" + frozenAccount.Address
+		if _, ok := frozenAccountIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for frozenAccount")
+		}
+		frozenAccountIndexMap[index] = struct{}{}
+
+		if err := frozenAccount.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := denomIndexMap[string(DenomKey(frozenAccount.Denom))]; !exists {
+			return fmt.Errorf("missing Denom for FrozenAccount '%s'", frozenAccount.Denom)
+		}
+	}
+
+	// Check for duplicated globally frozen denoms, each one needs a Denom
+	globalFrozenDenomIndexMap := make(map[string]struct{})
+
+	for _, denom := range gs.GlobalFrozenDenoms {
+		if _, ok := globalFrozenDenomIndexMap[denom]; ok {
+			return fmt.Errorf("duplicated index for globalFrozenDenom")
+		}
+		globalFrozenDenomIndexMap[denom] = struct{}{}
+
+		if _, exists := denomIndexMap[string(DenomKey(denom))]; !exists {
+			return fmt.Errorf("missing Denom for GlobalFrozenDenom '%s'", denom)
+		}
+	}
+
+	// Check for duplicated minters, each minter needs a Denom
+	minterIndexMap := make(map[string]struct{})
+
+	for _, minter := range gs.MinterList {
+		index := minter.Denom + "/" + minter.Address
+		if _, ok := minterIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for minter")
+		}
+		minterIndexMap[index] = struct{}{}
+
+		if err := minter.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := denomIndexMap[string(DenomKey(minter.Denom))]; !exists {
+			return fmt.Errorf("missing Denom for Minter '%s'", minter.Denom)
+		}
+	}
+
+	// Check for duplicated mint rate limits, each one needs a Denom
+	mintRateLimitIndexMap := make(map[string]struct{})
+
+	for _, rateLimit := range gs.MintRateLimitList {
+		if _, ok := mintRateLimitIndexMap[rateLimit.Denom]; ok {
+			return fmt.Errorf("duplicated index for mintRateLimit")
+		}
+		mintRateLimitIndexMap[rateLimit.Denom] = struct{}{}
+
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := denomIndexMap[string(DenomKey(rateLimit.Denom))]; !exists {
+			return fmt.Errorf("missing Denom for MintRateLimit '%s'", rateLimit.Denom)
+		}
+	}
+
+	// Check for duplicated mint rate buckets, each one needs a MintRateLimit
+	mintRateBucketIndexMap := make(map[string]struct{})
+
+	for _, bucket := range gs.MintRateBucketList {
+		index := fmt.Sprintf("%s/%d", bucket.Denom, bucket.StartTime)
+		if _, ok := mintRateBucketIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for mintRateBucket")
+		}
+		mintRateBucketIndexMap[index] = struct{}{}
+
+		if err := bucket.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := mintRateLimitIndexMap[bucket.Denom]; !exists {
+			return fmt.Errorf("missing MintRateLimit for MintRateBucket '%s'", bucket.Denom)
+		}
+	}
+
+	// Check for duplicated vesting grants, each one needs a Denom and an id below the count
+	vestingGrantIndexMap := make(map[string]struct{})
+
+	for _, grant := range gs.VestingGrantList {
+		index := fmt.Sprintf("%s/%d", grant.Denom, grant.Id)
+		if _, ok := vestingGrantIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for vestingGrant")
+		}
+		vestingGrantIndexMap[index] = struct{}{}
+
+		if err := grant.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := denomIndexMap[string(DenomKey(grant.Denom))]; !exists {
+			return fmt.Errorf("missing Denom for VestingGrant '%s'", grant.Denom)
+		}
+
+		if grant.Id >= gs.VestingGrantCount {
+			return fmt.Errorf("vestingGrant id %d should be lower than the count %d", grant.Id, gs.VestingGrantCount)
+		}
+	}
+
+	// Check for duplicated airdrops, each one needs a Denom and an id below the count
+	airdropIndexMap := make(map[uint64]struct{})
+
+	for _, airdrop := range gs.AirdropList {
+		if _, ok := airdropIndexMap[airdrop.Id]; ok {
+			return fmt.Errorf("duplicated index for airdrop")
+		}
+		airdropIndexMap[airdrop.Id] = struct{}{}
+
+		if err := airdrop.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := denomIndexMap[string(DenomKey(airdrop.Denom))]; !exists {
+			return fmt.Errorf("missing Denom for Airdrop '%s'", airdrop.Denom)
+		}
+
+		if airdrop.Id >= gs.AirdropCount {
+			return fmt.Errorf("airdrop id %d should be lower than the count %d", airdrop.Id, gs.AirdropCount)
+		}
+	}
+
+	// Check for duplicated airdrop claims, each one needs an Airdrop
+	airdropClaimIndexMap := make(map[string]struct{})
+
+	for _, claim := range gs.AirdropClaimList {
+		index := fmt.Sprintf("%d/%s", claim.AirdropId, claim.Address)
+		if _, ok := airdropClaimIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for airdropClaim")
+		}
+		airdropClaimIndexMap[index] = struct{}{}
+
+		if err := validators.AddressCheck("address", claim.Address); err != nil {
+			return err
+		}
+
+		if _, exists := airdropIndexMap[claim.AirdropId]; !exists {
+			return fmt.Errorf("missing Airdrop for AirdropClaim %d", claim.AirdropId)
+		}
+	}
+
+	// Check for duplicated snapshots, each one needs a Denom
+	snapshotIndexMap := make(map[string]struct{})
+
+	for _, snapshot := range gs.SnapshotList {
+		index := fmt.Sprintf("%s/%d", snapshot.Denom, snapshot.Id)
+		if _, ok := snapshotIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for snapshot")
+		}
+		snapshotIndexMap[index] = struct{}{}
+
+		if err := snapshot.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := denomIndexMap[string(DenomKey(snapshot.Denom))]; !exists {
+			return fmt.Errorf("missing Denom for Snapshot '%s'", snapshot.Denom)
+		}
+	}
+
+	// Check for duplicated snapshot balances, each one needs a Snapshot
+	snapshotBalanceIndexMap := make(map[string]struct{})
+
+	for _, balance := range gs.SnapshotBalanceList {
+		index := fmt.Sprintf("%s/%s/%d", balance.Denom, balance.Address, balance.SnapshotId)
+		if _, ok := snapshotBalanceIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for snapshotBalance")
+		}
+		snapshotBalanceIndexMap[index] = struct{}{}
+
+		if err := balance.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := snapshotIndexMap[fmt.Sprintf("%s/%d", balance.Denom, balance.SnapshotId)]; !exists {
+			return fmt.Errorf("missing Snapshot %d for SnapshotBalance '%s'", balance.SnapshotId, balance.Denom)
+		}
+	}
+
+	// Check for duplicated reward pools, each one needs a Denom and a denom has a bounded number of them
+	rewardPoolIndexMap := make(map[string]struct{})
+	rewardDenomCount := make(map[string]int)
+
+	for _, pool := range gs.RewardPoolList {
+		index := pool.Denom + "/" + pool.RewardDenom
+		if _, ok := rewardPoolIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for rewardPool")
+		}
+		rewardPoolIndexMap[index] = struct{}{}
+
+		if err := pool.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := denomIndexMap[string(DenomKey(pool.Denom))]; !exists {
+			return fmt.Errorf("missing Denom for RewardPool '%s'", pool.Denom)
+		}
+
+		rewardDenomCount[pool.Denom]++
+		if rewardDenomCount[pool.Denom] > MaxRewardDenoms {
+			return fmt.Errorf("denom '%s' has more than %d reward pools", pool.Denom, MaxRewardDenoms)
+		}
+	}
+
+	// Check for duplicated holder rewards, each one needs a RewardPool
+	holderRewardIndexMap := make(map[string]struct{})
+
+	for _, reward := range gs.HolderRewardList {
+		index := reward.Denom + "/" + reward.Address + "/" + reward.RewardDenom
+		if _, ok := holderRewardIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for holderReward")
+		}
+		holderRewardIndexMap[index] = struct{}{}
+
+		if err := reward.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := rewardPoolIndexMap[reward.Denom+"/"+reward.RewardDenom]; !exists {
+			return fmt.Errorf("missing RewardPool for HolderReward '%s/%s'", reward.Denom, reward.RewardDenom)
+		}
+	}
+
+	// Check for duplicated module account holders, each one needs a Denom
+	moduleAccountHolderIndexMap := make(map[string]struct{})
+
+	for _, holder := range gs.ModuleAccountHolderList {
+		index := holder.Denom + "/" + holder.Address
+		if _, ok := moduleAccountHolderIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for moduleAccountHolder")
+		}
+		moduleAccountHolderIndexMap[index] = struct{}{}
+
+		if err := validators.AddressCheck("address", holder.Address); err != nil {
+			return err
+		}
+
+		if _, exists := denomIndexMap[string(DenomKey(holder.Denom))]; !exists {
+			return fmt.Errorf("missing Denom for ModuleAccountHolder '%s'", holder.Denom)
+		}
+	}
+
+	// Check for duplicated CW20 bridges, each contract and each Denom has at most one
+	cw20BridgeIndexMap := make(map[string]struct{})
+	cw20BridgeDenomMap := make(map[string]struct{})
+
+	for _, bridge := range gs.Cw20BridgeList {
+		if _, ok := cw20BridgeIndexMap[bridge.ContractAddress]; ok {
+			return fmt.Errorf("duplicated index for cw20Bridge")
+		}
+		cw20BridgeIndexMap[bridge.ContractAddress] = struct{}{}
+
+		if _, ok := cw20BridgeDenomMap[bridge.Denom]; ok {
+			return fmt.Errorf("duplicated denom '%s' for cw20Bridge", bridge.Denom)
+		}
+		cw20BridgeDenomMap[bridge.Denom] = struct{}{}
+
+		if err := bridge.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := denomIndexMap[string(DenomKey(bridge.Denom))]; !exists {
+			return fmt.Errorf("missing Denom for CW20Bridge '%s'", bridge.Denom)
+		}
+	}
+
+	// Check for duplicated denom roles, each one needs a DenomAuth
+	denomRoleIndexMap := make(map[string]struct{})
+
+	for _, role := range gs.DenomRoleList {
+		index := role.Denom + "/" + role.Role + "/" + role.Address
+		if _, ok := denomRoleIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for denomRole")
+		}
+		denomRoleIndexMap[index] = struct{}{}
+
+		if err := role.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := denomAuthIndexMap[string(DenomAuthKey(role.Denom))]; !exists {
+			return fmt.Errorf("missing DenomAuth for DenomRole '%s'", role.Denom)
+		}
+	}
+
+	// Check for duplicated admin proposals, each one needs a DenomAuth
+	denomAdminProposalIndexMap := make(map[string]struct{})
+
+	for _, proposal := range gs.DenomAdminProposalList {
+		if _, ok := denomAdminProposalIndexMap[proposal.Denom]; ok {
+			return fmt.Errorf("duplicated index for denomAdminProposal")
+		}
+		denomAdminProposalIndexMap[proposal.Denom] = struct{}{}
+
+		if err := proposal.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := denomAuthIndexMap[string(DenomAuthKey(proposal.Denom))]; !exists {
+			return fmt.Errorf("missing DenomAuth for DenomAdminProposal '%s'", proposal.Denom)
+		}
+	}
+
+	// Check for duplicated admin timelocks, each one needs a DenomAuth
+	denomAdminTimelockIndexMap := make(map[string]struct{})
+
+	for _, timelock := range gs.DenomAdminTimelockList {
+		if _, ok := denomAdminTimelockIndexMap[timelock.Denom]; ok {
+			return fmt.Errorf("duplicated index for denomAdminTimelock")
+		}
+		denomAdminTimelockIndexMap[timelock.Denom] = struct{}{}
+
+		if err := timelock.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := denomAuthIndexMap[string(DenomAuthKey(timelock.Denom))]; !exists {
+			return fmt.Errorf("missing DenomAuth for DenomAdminTimelock '%s'", timelock.Denom)
+		}
+	}
+
+	// Check for duplicated verified denoms and tickers, each one needs a Denom
+	verifiedDenomIndexMap := make(map[string]struct{})
+	verifiedDenomTickerMap := make(map[string]struct{})
+
+	for _, verified := range gs.VerifiedDenomList {
+		if _, ok := verifiedDenomIndexMap[verified.Denom]; ok {
+			return fmt.Errorf("duplicated index for verifiedDenom")
+		}
+		verifiedDenomIndexMap[verified.Denom] = struct{}{}
+
+		if _, ok := verifiedDenomTickerMap[verified.Ticker]; ok {
+			return fmt.Errorf("duplicated ticker '%s' for verifiedDenom", verified.Ticker)
+		}
+		verifiedDenomTickerMap[verified.Ticker] = struct{}{}
+
+		if err := verified.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := denomIndexMap[string(DenomKey(verified.Denom))]; !exists {
+			return fmt.Errorf("missing Denom for VerifiedDenom '%s'", verified.Denom)
+		}
+	}
+
+	// Check for duplicated transfer taxes, each one needs a Denom and a rate within the params cap
+	transferTaxIndexMap := make(map[string]struct{})
+
+	for _, tax := range gs.TransferTaxList {
+		if _, ok := transferTaxIndexMap[tax.Denom]; ok {
+			return fmt.Errorf("duplicated index for transferTax")
+		}
+		transferTaxIndexMap[tax.Denom] = struct{}{}
+
+		if err := tax.Validate(); err != nil {
+			return err
+		}
+
+		if tax.Rate.GT(gs.Params.GetMaxTransferTaxRate()) {
+			return fmt.Errorf("rate %s of TransferTax '%s' exceeds the max transfer tax rate", tax.Rate, tax.Denom)
+		}
+
+		if _, exists := denomIndexMap[string(DenomKey(tax.Denom))]; !exists {
+			return fmt.Errorf("missing Denom for TransferTax '%s'", tax.Denom)
+		}
+	}
+
+	// Check for duplicated transfer tax exemptions, each one needs a Denom
+	taxExemptionIndexMap := make(map[string]struct{})
+
+	for _, exemption := range gs.TaxExemptionList {
+		index := exemption.Denom + "/" + exemption.Address
+		if _, ok := taxExemptionIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for transferTaxExemption")
+		}
+		taxExemptionIndexMap[index] = struct{}{}
+
+		if err := validators.AddressCheck("exempted", exemption.Address); err != nil {
+			return err
+		}
+
+		if _, exists := denomIndexMap[string(DenomKey(exemption.Denom))]; !exists {
+			return fmt.Errorf("missing Denom for TransferTaxExemption '%s'", exemption.Denom)
+		}
+	}
+
+	// Check for duplicated token launches, each one needs a Denom
+	tokenLaunchIndexMap := make(map[string]struct{})
+
+	for _, launch := range gs.TokenLaunchList {
+		if _, ok := tokenLaunchIndexMap[launch.Denom]; ok {
+			return fmt.Errorf("duplicated index for tokenLaunch")
+		}
+		tokenLaunchIndexMap[launch.Denom] = struct{}{}
+
+		if err := launch.Validate(); err != nil {
+			return err
+		}
+
+		if _, exists := denomIndexMap[string(DenomKey(launch.Denom))]; !exists {
+			return fmt.Errorf("missing Denom for TokenLaunch '%s'", launch.Denom)
+		}
+	}
+
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
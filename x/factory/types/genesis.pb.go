@@ -27,9 +27,33 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the factory module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params        Params      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	DenomList     []Denom     `protobuf:"bytes,2,rep,name=denom_list,json=denomList,proto3" json:"denom_list"`
-	DenomAuthList []DenomAuth `protobuf:"bytes,3,rep,name=denom_auth_list,json=denomAuthList,proto3" json:"denom_auth_list"`
+	Params                  Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	DenomList               []Denom                `protobuf:"bytes,2,rep,name=denom_list,json=denomList,proto3" json:"denom_list"`
+	DenomAuthList           []DenomAuth            `protobuf:"bytes,3,rep,name=denom_auth_list,json=denomAuthList,proto3" json:"denom_auth_list"`
+	BeforeSendHookList      []BeforeSendHook       `protobuf:"bytes,4,rep,name=before_send_hook_list,json=beforeSendHookList,proto3" json:"before_send_hook_list"`
+	FrozenAccountList       []FrozenAccount        `protobuf:"bytes,5,rep,name=frozen_account_list,json=frozenAccountList,proto3" json:"frozen_account_list"`
+	GlobalFrozenDenoms      []string               `protobuf:"bytes,6,rep,name=global_frozen_denoms,json=globalFrozenDenoms,proto3" json:"global_frozen_denoms,omitempty"`
+	MinterList              []Minter               `protobuf:"bytes,7,rep,name=minter_list,json=minterList,proto3" json:"minter_list"`
+	MintRateLimitList       []MintRateLimit        `protobuf:"bytes,8,rep,name=mint_rate_limit_list,json=mintRateLimitList,proto3" json:"mint_rate_limit_list"`
+	MintRateBucketList      []MintRateBucket       `protobuf:"bytes,9,rep,name=mint_rate_bucket_list,json=mintRateBucketList,proto3" json:"mint_rate_bucket_list"`
+	VestingGrantList        []VestingGrant         `protobuf:"bytes,10,rep,name=vesting_grant_list,json=vestingGrantList,proto3" json:"vesting_grant_list"`
+	VestingGrantCount       uint64                 `protobuf:"varint,11,opt,name=vesting_grant_count,json=vestingGrantCount,proto3" json:"vesting_grant_count,omitempty"`
+	AirdropList             []Airdrop              `protobuf:"bytes,12,rep,name=airdrop_list,json=airdropList,proto3" json:"airdrop_list"`
+	AirdropClaimList        []AirdropClaim         `protobuf:"bytes,13,rep,name=airdrop_claim_list,json=airdropClaimList,proto3" json:"airdrop_claim_list"`
+	AirdropCount            uint64                 `protobuf:"varint,14,opt,name=airdrop_count,json=airdropCount,proto3" json:"airdrop_count,omitempty"`
+	SnapshotList            []Snapshot             `protobuf:"bytes,15,rep,name=snapshot_list,json=snapshotList,proto3" json:"snapshot_list"`
+	SnapshotBalanceList     []SnapshotBalance      `protobuf:"bytes,16,rep,name=snapshot_balance_list,json=snapshotBalanceList,proto3" json:"snapshot_balance_list"`
+	RewardPoolList          []RewardPool           `protobuf:"bytes,17,rep,name=reward_pool_list,json=rewardPoolList,proto3" json:"reward_pool_list"`
+	HolderRewardList        []HolderReward         `protobuf:"bytes,18,rep,name=holder_reward_list,json=holderRewardList,proto3" json:"holder_reward_list"`
+	ModuleAccountHolderList []ModuleAccountHolder  `protobuf:"bytes,19,rep,name=module_account_holder_list,json=moduleAccountHolderList,proto3" json:"module_account_holder_list"`
+	Cw20BridgeList          []CW20Bridge           `protobuf:"bytes,20,rep,name=cw20_bridge_list,json=cw20BridgeList,proto3" json:"cw20_bridge_list"`
+	DenomRoleList           []DenomRole            `protobuf:"bytes,21,rep,name=denom_role_list,json=denomRoleList,proto3" json:"denom_role_list"`
+	DenomAdminProposalList  []DenomAdminProposal   `protobuf:"bytes,22,rep,name=denom_admin_proposal_list,json=denomAdminProposalList,proto3" json:"denom_admin_proposal_list"`
+	DenomAdminTimelockList  []DenomAdminTimelock   `protobuf:"bytes,23,rep,name=denom_admin_timelock_list,json=denomAdminTimelockList,proto3" json:"denom_admin_timelock_list"`
+	VerifiedDenomList       []VerifiedDenom        `protobuf:"bytes,24,rep,name=verified_denom_list,json=verifiedDenomList,proto3" json:"verified_denom_list"`
+	TransferTaxList         []TransferTax          `protobuf:"bytes,25,rep,name=transfer_tax_list,json=transferTaxList,proto3" json:"transfer_tax_list"`
+	TaxExemptionList        []TransferTaxExemption `protobuf:"bytes,26,rep,name=tax_exemption_list,json=taxExemptionList,proto3" json:"tax_exemption_list"`
+	TokenLaunchList         []TokenLaunch          `protobuf:"bytes,27,rep,name=token_launch_list,json=tokenLaunchList,proto3" json:"token_launch_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -86,6 +110,174 @@ func (m *GenesisState) GetDenomAuthList() []DenomAuth {
 	return nil
 }
 
+func (m *GenesisState) GetBeforeSendHookList() []BeforeSendHook {
+	if m != nil {
+		return m.BeforeSendHookList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenAccountList() []FrozenAccount {
+	if m != nil {
+		return m.FrozenAccountList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGlobalFrozenDenoms() []string {
+	if m != nil {
+		return m.GlobalFrozenDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMinterList() []Minter {
+	if m != nil {
+		return m.MinterList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintRateLimitList() []MintRateLimit {
+	if m != nil {
+		return m.MintRateLimitList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintRateBucketList() []MintRateBucket {
+	if m != nil {
+		return m.MintRateBucketList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVestingGrantList() []VestingGrant {
+	if m != nil {
+		return m.VestingGrantList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVestingGrantCount() uint64 {
+	if m != nil {
+		return m.VestingGrantCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAirdropList() []Airdrop {
+	if m != nil {
+		return m.AirdropList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAirdropClaimList() []AirdropClaim {
+	if m != nil {
+		return m.AirdropClaimList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAirdropCount() uint64 {
+	if m != nil {
+		return m.AirdropCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSnapshotList() []Snapshot {
+	if m != nil {
+		return m.SnapshotList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSnapshotBalanceList() []SnapshotBalance {
+	if m != nil {
+		return m.SnapshotBalanceList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardPoolList() []RewardPool {
+	if m != nil {
+		return m.RewardPoolList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHolderRewardList() []HolderReward {
+	if m != nil {
+		return m.HolderRewardList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetModuleAccountHolderList() []ModuleAccountHolder {
+	if m != nil {
+		return m.ModuleAccountHolderList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCw20BridgeList() []CW20Bridge {
+	if m != nil {
+		return m.Cw20BridgeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomRoleList() []DenomRole {
+	if m != nil {
+		return m.DenomRoleList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomAdminProposalList() []DenomAdminProposal {
+	if m != nil {
+		return m.DenomAdminProposalList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomAdminTimelockList() []DenomAdminTimelock {
+	if m != nil {
+		return m.DenomAdminTimelockList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVerifiedDenomList() []VerifiedDenom {
+	if m != nil {
+		return m.VerifiedDenomList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferTaxList() []TransferTax {
+	if m != nil {
+		return m.TransferTaxList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTaxExemptionList() []TransferTaxExemption {
+	if m != nil {
+		return m.TaxExemptionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenLaunchList() []TokenLaunch {
+	if m != nil {
+		return m.TokenLaunchList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zigchain.factory.GenesisState")
 }
@@ -93,25 +285,70 @@ func init() {
 func init() { proto.RegisterFile("zigchain/factory/genesis.proto", fileDescriptor_79836a1a54a43f95) }
 
 var fileDescriptor_79836a1a54a43f95 = []byte{
-	// 276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xab, 0xca, 0x4c, 0x4f,
-	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x4f, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xeb, 0x41,
-	0xe5, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x91, 0x94, 0x48, 0x7a,
-	0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0x65, 0x31, 0x8c, 0x2e, 0x48, 0x2c, 0x4a,
-	0xcc, 0x85, 0x9a, 0x2c, 0x25, 0x83, 0x21, 0x9d, 0x92, 0x9a, 0x97, 0x9f, 0x0b, 0x95, 0x55, 0xc4,
-	0x2e, 0x1b, 0x9f, 0x58, 0x5a, 0x92, 0x01, 0x51, 0xa2, 0x74, 0x85, 0x91, 0x8b, 0xc7, 0x1d, 0xe2,
-	0xd8, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x6b, 0x2e, 0x36, 0x88, 0x0d, 0x12, 0x8c, 0x0a, 0x8c,
-	0x1a, 0xdc, 0x46, 0x12, 0x7a, 0xe8, 0x8e, 0xd7, 0x0b, 0x00, 0xcb, 0x3b, 0x71, 0x9e, 0xb8, 0x27,
-	0xcf, 0xb0, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xa8, 0x16, 0x21, 0x1b, 0x2e, 0x2e, 0x88, 0x0d,
-	0x39, 0x99, 0xc5, 0x25, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0xe2, 0x98, 0x06, 0xb8, 0x80,
-	0xd4, 0x38, 0xb1, 0x80, 0xf4, 0x07, 0x71, 0x82, 0x35, 0xf8, 0x64, 0x16, 0x97, 0x08, 0x79, 0x72,
-	0xf1, 0x23, 0xdc, 0x07, 0x31, 0x82, 0x19, 0x6c, 0x84, 0x34, 0x0e, 0x23, 0x1c, 0x4b, 0x4b, 0x32,
-	0xa0, 0xc6, 0xf0, 0xa6, 0xc0, 0x04, 0x40, 0x46, 0x39, 0x19, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x04, 0x3c, 0x4c, 0x2a, 0xe0, 0xa1, 0x52, 0x52, 0x59, 0x90, 0x5a,
-	0x9c, 0xc4, 0x06, 0x0e, 0x11, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x78, 0xef, 0xbd, 0x3a,
-	0xce, 0x01, 0x00, 0x00,
+	// 999 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x96, 0x51, 0x4f, 0x1b, 0x47,
+	0x10, 0xc7, 0x71, 0xa1, 0xb4, 0xac, 0x21, 0xd8, 0x07, 0x04, 0xe3, 0x24, 0xc6, 0x49, 0x9a, 0x14,
+	0xb5, 0x92, 0x41, 0xee, 0x63, 0x2b, 0x55, 0x98, 0xa6, 0x49, 0x25, 0xaa, 0x22, 0x43, 0xa9, 0x9a,
+	0x3e, 0x9c, 0xd6, 0x77, 0x6b, 0x7b, 0xc5, 0xdd, 0xee, 0x69, 0x6f, 0x0d, 0x4e, 0x3e, 0x45, 0x3f,
+	0x46, 0x1f, 0xfb, 0x31, 0xf2, 0x98, 0xc7, 0xf6, 0xa5, 0xaa, 0xe0, 0xa1, 0x5f, 0xa3, 0xba, 0xd9,
+	0x59, 0xfb, 0xcc, 0xfa, 0xc8, 0x8b, 0x65, 0xcd, 0xfc, 0xe7, 0x37, 0x3b, 0xb3, 0x7b, 0xb3, 0x4b,
+	0x1a, 0x6f, 0xf9, 0x20, 0x18, 0x52, 0x2e, 0xf6, 0xfb, 0x34, 0xd0, 0x52, 0xbd, 0xd9, 0x1f, 0x30,
+	0xc1, 0x52, 0x9e, 0xb6, 0x12, 0x25, 0xb5, 0xf4, 0x2a, 0xd6, 0xdf, 0x42, 0x7f, 0xbd, 0x4a, 0x63,
+	0x2e, 0xe4, 0x3e, 0xfc, 0x1a, 0x51, 0x7d, 0x73, 0x20, 0x07, 0x12, 0xfe, 0xee, 0x67, 0xff, 0xd0,
+	0xfa, 0xc8, 0x41, 0x27, 0x54, 0xd1, 0x18, 0xc9, 0xf5, 0x87, 0x8e, 0x3b, 0x64, 0x42, 0xc6, 0xe8,
+	0x7d, 0x3c, 0xdf, 0xeb, 0xd3, 0x91, 0x1e, 0xa2, 0xe4, 0x73, 0x47, 0xd2, 0x63, 0x7d, 0xa9, 0x98,
+	0x9f, 0x32, 0x11, 0xfa, 0x43, 0x29, 0x2f, 0x50, 0xf8, 0xcc, 0x11, 0xf6, 0x95, 0x7c, 0xcb, 0x84,
+	0x4f, 0x83, 0x40, 0x8e, 0x84, 0x2e, 0x5c, 0x6f, 0xcc, 0x85, 0x66, 0x0a, 0xdd, 0xcf, 0xe7, 0xba,
+	0x7d, 0x45, 0x35, 0xf3, 0x23, 0x1e, 0x73, 0x8b, 0x71, 0x3b, 0x7a, 0xc9, 0x52, 0xcd, 0xc5, 0xa0,
+	0xd0, 0x4f, 0xb9, 0x0a, 0x95, 0x4c, 0xd0, 0xbf, 0xeb, 0xf8, 0x53, 0x41, 0x93, 0x74, 0x28, 0x6d,
+	0x82, 0xa7, 0x6e, 0x6b, 0x78, 0xaa, 0x15, 0xef, 0x8d, 0x34, 0x97, 0x02, 0x45, 0x4f, 0x1c, 0x51,
+	0x70, 0xd5, 0x3e, 0xf0, 0x7b, 0x8a, 0x87, 0x03, 0xf6, 0x81, 0x1e, 0x2b, 0x19, 0x59, 0xc9, 0x97,
+	0x45, 0xdb, 0x10, 0xc6, 0x5c, 0xf8, 0x89, 0x92, 0x89, 0x4c, 0x69, 0x54, 0xd8, 0xe7, 0x4b, 0xa6,
+	0x78, 0x9f, 0xb3, 0xd0, 0xcf, 0x6f, 0xad, 0xbb, 0x7e, 0xad, 0xa8, 0x48, 0xfb, 0x4c, 0xf9, 0x9a,
+	0x8e, 0x0b, 0x37, 0x23, 0xa2, 0x23, 0x11, 0xe0, 0xde, 0x3f, 0xf9, 0xbb, 0x42, 0x56, 0x5f, 0x9a,
+	0x83, 0x7a, 0xaa, 0xa9, 0x66, 0xde, 0xd7, 0x64, 0xd9, 0x9c, 0xae, 0x5a, 0xa9, 0x59, 0xda, 0x2b,
+	0xb7, 0x6b, 0xad, 0xdb, 0x07, 0xb7, 0x75, 0x02, 0xfe, 0xce, 0xca, 0xbb, 0x7f, 0x76, 0x17, 0xfe,
+	0xf8, 0xef, 0xcf, 0x2f, 0x4a, 0x5d, 0x0c, 0xf1, 0xbe, 0x21, 0xc4, 0x94, 0x15, 0xf1, 0x54, 0xd7,
+	0x3e, 0x6a, 0x2e, 0xee, 0x95, 0xdb, 0xdb, 0x2e, 0xe0, 0xbb, 0x4c, 0xd3, 0x59, 0xca, 0xe2, 0xbb,
+	0x2b, 0x10, 0x70, 0xcc, 0x53, 0xed, 0xfd, 0x40, 0xd6, 0xa7, 0x67, 0xd3, 0x20, 0x16, 0x01, 0xf1,
+	0xa0, 0x00, 0x71, 0x38, 0xd2, 0x43, 0xc4, 0xac, 0x85, 0xd6, 0x00, 0xa8, 0x5f, 0xc9, 0xd6, 0xed,
+	0x33, 0x6c, 0x80, 0x4b, 0x00, 0x6c, 0xba, 0xc0, 0x0e, 0xc8, 0x4f, 0x99, 0x08, 0x5f, 0x49, 0x79,
+	0x81, 0x54, 0xaf, 0x37, 0x63, 0x05, 0xf4, 0xcf, 0x64, 0x63, 0xf6, 0xd4, 0x1b, 0xf0, 0xc7, 0x00,
+	0xde, 0x75, 0xc1, 0xdf, 0x83, 0xf8, 0xd0, 0x68, 0x91, 0x5b, 0xed, 0xe7, 0x8d, 0x80, 0x3d, 0x20,
+	0x9b, 0x83, 0x48, 0xf6, 0x68, 0xe4, 0x23, 0x1d, 0x0a, 0x4a, 0x6b, 0xcb, 0xcd, 0xc5, 0xbd, 0x95,
+	0xae, 0x67, 0x7c, 0x86, 0x05, 0xb5, 0xa7, 0xde, 0xb7, 0xa4, 0x6c, 0xbe, 0x2b, 0xb3, 0x80, 0x4f,
+	0x9a, 0x8b, 0xf3, 0xb7, 0xeb, 0x47, 0x10, 0x61, 0x66, 0x62, 0x42, 0x20, 0xe5, 0x39, 0xd9, 0xbc,
+	0xf5, 0xe5, 0x19, 0xd2, 0xa7, 0x45, 0xa5, 0x64, 0xa4, 0x2e, 0xd5, 0xec, 0x38, 0xd3, 0xda, 0x52,
+	0xe2, 0xbc, 0xd1, 0x36, 0x7f, 0xca, 0xed, 0x8d, 0x82, 0x0b, 0x86, 0xe0, 0x95, 0xa2, 0xe6, 0x5b,
+	0x70, 0x07, 0xc4, 0xb6, 0xf9, 0xf1, 0x8c, 0x15, 0xd0, 0x5d, 0xe2, 0xe1, 0x10, 0xf0, 0x07, 0x8a,
+	0xda, 0xde, 0x13, 0xe0, 0x36, 0x5c, 0xee, 0xb9, 0xd1, 0xbe, 0xcc, 0xa4, 0x48, 0xad, 0x5c, 0xe6,
+	0x6c, 0xc0, 0x6c, 0x91, 0x8d, 0x59, 0x26, 0x6c, 0x4a, 0xad, 0xdc, 0x2c, 0xed, 0x2d, 0x75, 0xab,
+	0x79, 0xf9, 0x51, 0xe6, 0xf0, 0x3a, 0x64, 0x15, 0x07, 0x8d, 0xc9, 0xbe, 0x0a, 0xd9, 0x77, 0xdc,
+	0xec, 0x87, 0x46, 0x85, 0x89, 0xcb, 0x18, 0x64, 0xeb, 0xb0, 0x8c, 0x20, 0xa2, 0x1c, 0x3f, 0x98,
+	0xb5, 0xa2, 0x3a, 0x90, 0x74, 0x94, 0x49, 0x6d, 0x1d, 0x34, 0x67, 0x03, 0xe6, 0x53, 0xb2, 0x36,
+	0x61, 0x42, 0x05, 0xf7, 0xa0, 0x02, 0xbb, 0x58, 0xb3, 0xf8, 0x17, 0x64, 0xcd, 0x4e, 0x41, 0x93,
+	0x73, 0x1d, 0x72, 0xd6, 0xdd, 0x9c, 0xa7, 0x28, 0xc3, 0x7c, 0xab, 0x36, 0x0c, 0x72, 0xfd, 0x46,
+	0xb6, 0x26, 0x98, 0x1e, 0x8d, 0xa8, 0x08, 0x98, 0xc1, 0x55, 0x00, 0xf7, 0xf8, 0x0e, 0x9c, 0x51,
+	0x23, 0x75, 0x23, 0x9d, 0x35, 0x03, 0xfc, 0x98, 0x54, 0x14, 0xbb, 0xa2, 0x2a, 0xf4, 0x13, 0x29,
+	0x23, 0xc3, 0xad, 0x02, 0xf7, 0xa1, 0xcb, 0xed, 0x82, 0xf2, 0x44, 0xca, 0x08, 0x91, 0xf7, 0xd4,
+	0xc4, 0x62, 0x5b, 0x3d, 0x94, 0x51, 0xc8, 0x94, 0x8f, 0x50, 0xe0, 0x79, 0x45, 0xad, 0x7e, 0x05,
+	0x5a, 0x43, 0xb5, 0xad, 0x1e, 0xe6, 0x6c, 0xc0, 0x1c, 0x92, 0x7a, 0x2c, 0xc3, 0x51, 0xc4, 0x26,
+	0x33, 0x00, 0x53, 0x00, 0x7b, 0x03, 0xd8, 0xcf, 0xe6, 0x1c, 0x73, 0x88, 0xc1, 0xaf, 0xde, 0x24,
+	0xc2, 0x14, 0xdb, 0xb1, 0xeb, 0xb2, 0xbd, 0xc8, 0xdd, 0x37, 0x86, 0xbf, 0x59, 0xd4, 0x8b, 0xa3,
+	0x5f, 0xda, 0x07, 0x1d, 0x10, 0xda, 0x5e, 0x04, 0x57, 0xd6, 0x32, 0x3b, 0x61, 0xb3, 0x9b, 0xc9,
+	0xc0, 0xb6, 0xee, 0x9c, 0xb0, 0x5d, 0x19, 0xb1, 0x99, 0x09, 0x9b, 0x19, 0x00, 0xc5, 0xc8, 0xce,
+	0xbc, 0x1b, 0xcc, 0x40, 0xef, 0x03, 0xf4, 0xb3, 0xa2, 0xb1, 0x9d, 0x45, 0x9c, 0x60, 0x00, 0xd2,
+	0xef, 0x87, 0x8e, 0x67, 0x5e, 0x1a, 0xcd, 0x63, 0x16, 0xc9, 0x00, 0x87, 0xf9, 0xf6, 0x87, 0xd3,
+	0x9c, 0x61, 0x80, 0x9b, 0xc6, 0x7a, 0xec, 0x50, 0x9f, 0xbd, 0x62, 0x4d, 0x82, 0x5a, 0xd1, 0x24,
+	0x3c, 0x47, 0x71, 0xfe, 0x26, 0xab, 0x5e, 0xe6, 0x8d, 0x80, 0xfd, 0x89, 0x54, 0xf3, 0x57, 0xb2,
+	0x81, 0xee, 0x00, 0xf4, 0x91, 0x0b, 0x3d, 0x43, 0xe9, 0x19, 0x1d, 0x23, 0x72, 0x5d, 0x4f, 0x4d,
+	0x00, 0x7c, 0x4d, 0xbc, 0x8c, 0xc3, 0xc6, 0x2c, 0x4e, 0x34, 0x97, 0xc2, 0x10, 0xeb, 0x40, 0x7c,
+	0x7e, 0x27, 0xf1, 0x85, 0x0d, 0xb1, 0x87, 0x5a, 0xe7, 0x6c, 0x93, 0xc5, 0xca, 0x0b, 0x26, 0x7c,
+	0xf3, 0x40, 0x30, 0xe8, 0x07, 0x85, 0x8b, 0xcd, 0xa4, 0xc7, 0xa0, 0x9c, 0x2c, 0x76, 0x6a, 0xca,
+	0x80, 0x9d, 0xf6, 0xbb, 0xeb, 0x46, 0xe9, 0xfd, 0x75, 0xa3, 0xf4, 0xef, 0x75, 0xa3, 0xf4, 0xfb,
+	0x4d, 0x63, 0xe1, 0xfd, 0x4d, 0x63, 0xe1, 0xaf, 0x9b, 0xc6, 0xc2, 0xeb, 0xda, 0xe4, 0x51, 0x32,
+	0x9e, 0xbe, 0x5d, 0xde, 0x24, 0x2c, 0xed, 0x2d, 0xc3, 0xb3, 0xe4, 0xab, 0xff, 0x07, 0x00, 0xaf,
+	0xcf, 0x55, 0x69, 0x4f, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -134,119 +371,1275 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.DenomAuthList) > 0 {
-		for iNdEx := len(m.DenomAuthList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TokenLaunchList) > 0 {
+		for iNdEx := len(m.TokenLaunchList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DenomAuthList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TokenLaunchList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.TaxExemptionList) > 0 {
+		for iNdEx := len(m.TaxExemptionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxExemptionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.TransferTaxList) > 0 {
+		for iNdEx := len(m.TransferTaxList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferTaxList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.VerifiedDenomList) > 0 {
+		for iNdEx := len(m.VerifiedDenomList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerifiedDenomList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.DenomAdminTimelockList) > 0 {
+		for iNdEx := len(m.DenomAdminTimelockList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomAdminTimelockList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.DenomAdminProposalList) > 0 {
+		for iNdEx := len(m.DenomAdminProposalList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomAdminProposalList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.DenomRoleList) > 0 {
+		for iNdEx := len(m.DenomRoleList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRoleList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.Cw20BridgeList) > 0 {
+		for iNdEx := len(m.Cw20BridgeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cw20BridgeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.ModuleAccountHolderList) > 0 {
+		for iNdEx := len(m.ModuleAccountHolderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleAccountHolderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.HolderRewardList) > 0 {
+		for iNdEx := len(m.HolderRewardList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HolderRewardList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.RewardPoolList) > 0 {
+		for iNdEx := len(m.RewardPoolList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPoolList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.SnapshotBalanceList) > 0 {
+		for iNdEx := len(m.SnapshotBalanceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SnapshotBalanceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.SnapshotList) > 0 {
+		for iNdEx := len(m.SnapshotList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SnapshotList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.AirdropCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AirdropCount))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.AirdropClaimList) > 0 {
+		for iNdEx := len(m.AirdropClaimList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AirdropClaimList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AirdropList) > 0 {
+		for iNdEx := len(m.AirdropList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AirdropList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.VestingGrantCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VestingGrantCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.VestingGrantList) > 0 {
+		for iNdEx := len(m.VestingGrantList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingGrantList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.MintRateBucketList) > 0 {
+		for iNdEx := len(m.MintRateBucketList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintRateBucketList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.MintRateLimitList) > 0 {
+		for iNdEx := len(m.MintRateLimitList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintRateLimitList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MinterList) > 0 {
+		for iNdEx := len(m.MinterList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.GlobalFrozenDenoms) > 0 {
+		for iNdEx := len(m.GlobalFrozenDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GlobalFrozenDenoms[iNdEx])
+			copy(dAtA[i:], m.GlobalFrozenDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.GlobalFrozenDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FrozenAccountList) > 0 {
+		for iNdEx := len(m.FrozenAccountList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenAccountList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BeforeSendHookList) > 0 {
+		for iNdEx := len(m.BeforeSendHookList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeforeSendHookList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DenomAuthList) > 0 {
+		for iNdEx := len(m.DenomAuthList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomAuthList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DenomList) > 0 {
+		for iNdEx := len(m.DenomList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DenomList) > 0 {
+		for _, e := range m.DenomList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomAuthList) > 0 {
+		for _, e := range m.DenomAuthList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BeforeSendHookList) > 0 {
+		for _, e := range m.BeforeSendHookList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenAccountList) > 0 {
+		for _, e := range m.FrozenAccountList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GlobalFrozenDenoms) > 0 {
+		for _, s := range m.GlobalFrozenDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MinterList) > 0 {
+		for _, e := range m.MinterList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintRateLimitList) > 0 {
+		for _, e := range m.MintRateLimitList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintRateBucketList) > 0 {
+		for _, e := range m.MintRateBucketList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VestingGrantList) > 0 {
+		for _, e := range m.VestingGrantList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.VestingGrantCount != 0 {
+		n += 1 + sovGenesis(uint64(m.VestingGrantCount))
+	}
+	if len(m.AirdropList) > 0 {
+		for _, e := range m.AirdropList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AirdropClaimList) > 0 {
+		for _, e := range m.AirdropClaimList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AirdropCount != 0 {
+		n += 1 + sovGenesis(uint64(m.AirdropCount))
+	}
+	if len(m.SnapshotList) > 0 {
+		for _, e := range m.SnapshotList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SnapshotBalanceList) > 0 {
+		for _, e := range m.SnapshotBalanceList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardPoolList) > 0 {
+		for _, e := range m.RewardPoolList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HolderRewardList) > 0 {
+		for _, e := range m.HolderRewardList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ModuleAccountHolderList) > 0 {
+		for _, e := range m.ModuleAccountHolderList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Cw20BridgeList) > 0 {
+		for _, e := range m.Cw20BridgeList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomRoleList) > 0 {
+		for _, e := range m.DenomRoleList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomAdminProposalList) > 0 {
+		for _, e := range m.DenomAdminProposalList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomAdminTimelockList) > 0 {
+		for _, e := range m.DenomAdminTimelockList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VerifiedDenomList) > 0 {
+		for _, e := range m.VerifiedDenomList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferTaxList) > 0 {
+		for _, e := range m.TransferTaxList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TaxExemptionList) > 0 {
+		for _, e := range m.TaxExemptionList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenLaunchList) > 0 {
+		for _, e := range m.TokenLaunchList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomList = append(m.DenomList, Denom{})
+			if err := m.DenomList[len(m.DenomList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomAuthList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomAuthList = append(m.DenomAuthList, DenomAuth{})
+			if err := m.DenomAuthList[len(m.DenomAuthList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeSendHookList = append(m.BeforeSendHookList, BeforeSendHook{})
+			if err := m.BeforeSendHookList[len(m.BeforeSendHookList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccountList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccountList = append(m.FrozenAccountList, FrozenAccount{})
+			if err := m.FrozenAccountList[len(m.FrozenAccountList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalFrozenDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalFrozenDenoms = append(m.GlobalFrozenDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterList = append(m.MinterList, Minter{})
+			if err := m.MinterList[len(m.MinterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRateLimitList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRateLimitList = append(m.MintRateLimitList, MintRateLimit{})
+			if err := m.MintRateLimitList[len(m.MintRateLimitList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRateBucketList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRateBucketList = append(m.MintRateBucketList, MintRateBucket{})
+			if err := m.MintRateBucketList[len(m.MintRateBucketList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingGrantList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingGrantList = append(m.VestingGrantList, VestingGrant{})
+			if err := m.VestingGrantList[len(m.VestingGrantList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingGrantCount", wireType)
+			}
+			m.VestingGrantCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VestingGrantCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AirdropList = append(m.AirdropList, Airdrop{})
+			if err := m.AirdropList[len(m.AirdropList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropClaimList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AirdropClaimList = append(m.AirdropClaimList, AirdropClaim{})
+			if err := m.AirdropClaimList[len(m.AirdropClaimList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropCount", wireType)
+			}
+			m.AirdropCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AirdropCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SnapshotList = append(m.SnapshotList, Snapshot{})
+			if err := m.SnapshotList[len(m.SnapshotList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotBalanceList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SnapshotBalanceList = append(m.SnapshotBalanceList, SnapshotBalance{})
+			if err := m.SnapshotBalanceList[len(m.SnapshotBalanceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPoolList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPoolList = append(m.RewardPoolList, RewardPool{})
+			if err := m.RewardPoolList[len(m.RewardPoolList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderRewardList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HolderRewardList = append(m.HolderRewardList, HolderReward{})
+			if err := m.HolderRewardList[len(m.HolderRewardList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccountHolderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccountHolderList = append(m.ModuleAccountHolderList, ModuleAccountHolder{})
+			if err := m.ModuleAccountHolderList[len(m.ModuleAccountHolderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cw20BridgeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.DenomList) > 0 {
-		for iNdEx := len(m.DenomList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DenomList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cw20BridgeList = append(m.Cw20BridgeList, CW20Bridge{})
+			if err := m.Cw20BridgeList[len(m.Cw20BridgeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRoleList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.DenomList) > 0 {
-		for _, e := range m.DenomList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.DenomAuthList) > 0 {
-		for _, e := range m.DenomAuthList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DenomRoleList = append(m.DenomRoleList, DenomRole{})
+			if err := m.DenomRoleList[len(m.DenomRoleList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomAdminProposalList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -273,13 +1666,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomAdminProposalList = append(m.DenomAdminProposalList, DenomAdminProposal{})
+			if err := m.DenomAdminProposalList[len(m.DenomAdminProposalList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomAdminTimelockList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -306,14 +1700,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomList = append(m.DenomList, Denom{})
-			if err := m.DenomList[len(m.DenomList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomAdminTimelockList = append(m.DenomAdminTimelockList, DenomAdminTimelock{})
+			if err := m.DenomAdminTimelockList[len(m.DenomAdminTimelockList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomAuthList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedDenomList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -340,8 +1734,110 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomAuthList = append(m.DenomAuthList, DenomAuth{})
-			if err := m.DenomAuthList[len(m.DenomAuthList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VerifiedDenomList = append(m.VerifiedDenomList, VerifiedDenom{})
+			if err := m.VerifiedDenomList[len(m.VerifiedDenomList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTaxList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferTaxList = append(m.TransferTaxList, TransferTax{})
+			if err := m.TransferTaxList[len(m.TransferTaxList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxExemptionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxExemptionList = append(m.TaxExemptionList, TransferTaxExemption{})
+			if err := m.TaxExemptionList[len(m.TaxExemptionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenLaunchList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenLaunchList = append(m.TokenLaunchList, TokenLaunch{})
+			if err := m.TokenLaunchList[len(m.TokenLaunchList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2,9 +2,11 @@ package types_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"zigchain/testutil/sample"
@@ -54,8 +56,7 @@ func TestGenesisState_Validate_Positive(t *testing.T) {
 					{Denom: fullDenomUsdt, BankAdmin: creator, MetadataAdmin: creator},
 				},
 				Params: types.Params{
-					CreateFeeDenom:  "uzig",
-					CreateFeeAmount: 1000,
+					CreateFee: sdk.NewInt64Coin("uzig", 1000),
 				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
@@ -70,8 +71,7 @@ func TestGenesisState_Validate_Positive(t *testing.T) {
 				DenomList:     nil,
 				DenomAuthList: nil,
 				Params: types.Params{
-					CreateFeeDenom:  "uzig",
-					CreateFeeAmount: 1000,
+					CreateFee: sdk.NewInt64Coin("uzig", 1000),
 				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
@@ -86,8 +86,7 @@ func TestGenesisState_Validate_Positive(t *testing.T) {
 				DenomList:     []types.Denom{},
 				DenomAuthList: []types.DenomAuth{},
 				Params: types.Params{
-					CreateFeeDenom:  "uzig",
-					CreateFeeAmount: 1000,
+					CreateFee: sdk.NewInt64Coin("uzig", 1000),
 				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
@@ -100,8 +99,7 @@ func TestGenesisState_Validate_Positive(t *testing.T) {
 			genState: &types.GenesisState{
 				// initializing the DenomList field of the GenesisState struct
 				Params: types.Params{
-					CreateFeeDenom:  "uzig",
-					CreateFeeAmount: 1000,
+					CreateFee: sdk.NewInt64Coin("uzig", 1000),
 				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
@@ -717,3 +715,1119 @@ func TestDenom_Validate_NilMintingCap(t *testing.T) {
 		"Minting Cap <nil> is nil: Factory Denom name is not valid",
 	)
 }
+
+func TestGenesisState_Validate_BeforeSendHook(t *testing.T) {
+	// Test case: validate the BeforeSendHookList of a GenesisState
+
+	creator := sample.AccAddress()
+	contract := sample.AccAddress()
+	fullDenomAbc := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "abc"
+	fullDenomUsdt := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "usdt"
+
+	tests := []struct {
+		desc         string
+		hooks        []types.BeforeSendHook
+		errorMessage string
+	}{
+		{
+			desc:  "valid",
+			hooks: []types.BeforeSendHook{{Denom: fullDenomAbc, ContractAddress: contract}},
+		},
+		{
+			desc: "duplicated",
+			hooks: []types.BeforeSendHook{
+				{Denom: fullDenomAbc, ContractAddress: contract},
+				{Denom: fullDenomAbc, ContractAddress: contract},
+			},
+			errorMessage: "duplicated index for beforeSendHook",
+		},
+		{
+			desc:         "missing denom",
+			hooks:        []types.BeforeSendHook{{Denom: fullDenomUsdt, ContractAddress: contract}},
+			errorMessage: fmt.Sprintf("missing Denom for BeforeSendHook '%s'", fullDenomUsdt),
+		},
+		{
+			desc:         "invalid contract address",
+			hooks:        []types.BeforeSendHook{{Denom: fullDenomAbc, ContractAddress: "invalid"}},
+			errorMessage: "invalid",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			genState := &types.GenesisState{
+				DenomList: []types.Denom{
+					{Creator: creator, Denom: fullDenomAbc, MintingCap: cosmosmath.NewUint(1000), Minted: cosmosmath.NewUint(0)},
+				},
+				DenomAuthList: []types.DenomAuth{
+					{Denom: fullDenomAbc, BankAdmin: creator, MetadataAdmin: creator},
+				},
+				BeforeSendHookList: tc.hooks,
+				Params:             types.DefaultParams(),
+			}
+
+			err := genState.Validate()
+			if tc.errorMessage == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.ErrorContains(t, err, tc.errorMessage)
+		})
+	}
+}
+
+func TestGenesisState_Validate_Freezes(t *testing.T) {
+	// Test case: validate the FrozenAccountList and GlobalFrozenDenoms of a GenesisState
+
+	creator := sample.AccAddress()
+	account := sample.AccAddress()
+	fullDenomAbc := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "abc"
+	fullDenomUsdt := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "usdt"
+
+	tests := []struct {
+		desc               string
+		frozenAccounts     []types.FrozenAccount
+		globalFrozenDenoms []string
+		errorMessage       string
+	}{
+		{
+			desc:               "valid",
+			frozenAccounts:     []types.FrozenAccount{{Denom: fullDenomAbc, Address: account}},
+			globalFrozenDenoms: []string{fullDenomAbc},
+		},
+		{
+			desc: "duplicated frozen account",
+			frozenAccounts: []types.FrozenAccount{
+				{Denom: fullDenomAbc, Address: account},
+				{Denom: fullDenomAbc, Address: account},
+			},
+			errorMessage: "duplicated index for frozenAccount",
+		},
+		{
+			desc:           "frozen account missing denom",
+			frozenAccounts: []types.FrozenAccount{{Denom: fullDenomUsdt, Address: account}},
+			errorMessage:   fmt.Sprintf("missing Denom for FrozenAccount '%s'", fullDenomUsdt),
+		},
+		{
+			desc:           "frozen account invalid address",
+			frozenAccounts: []types.FrozenAccount{{Denom: fullDenomAbc, Address: "invalid"}},
+			errorMessage:   "FrozenAccount address invalid",
+		},
+		{
+			desc:               "duplicated global frozen denom",
+			globalFrozenDenoms: []string{fullDenomAbc, fullDenomAbc},
+			errorMessage:       "duplicated index for globalFrozenDenom",
+		},
+		{
+			desc:               "global frozen denom missing denom",
+			globalFrozenDenoms: []string{fullDenomUsdt},
+			errorMessage:       fmt.Sprintf("missing Denom for GlobalFrozenDenom '%s'", fullDenomUsdt),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			genState := &types.GenesisState{
+				DenomList: []types.Denom{
+					{Creator: creator, Denom: fullDenomAbc, MintingCap: cosmosmath.NewUint(1000), Minted: cosmosmath.NewUint(0)},
+				},
+				DenomAuthList: []types.DenomAuth{
+					{Denom: fullDenomAbc, BankAdmin: creator, MetadataAdmin: creator},
+				},
+				FrozenAccountList:  tc.frozenAccounts,
+				GlobalFrozenDenoms: tc.globalFrozenDenoms,
+				Params:             types.DefaultParams(),
+			}
+
+			err := genState.Validate()
+			if tc.errorMessage == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errorMessage)
+		})
+	}
+}
+
+func TestGenesisState_Validate_Minters(t *testing.T) {
+	// Test case: validate the MinterList of a GenesisState
+
+	creator := sample.AccAddress()
+	minter := sample.AccAddress()
+	fullDenomAbc := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "abc"
+	fullDenomUsdt := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "usdt"
+
+	tests := []struct {
+		desc         string
+		minters      []types.Minter
+		errorMessage string
+	}{
+		{
+			desc:    "valid",
+			minters: []types.Minter{{Denom: fullDenomAbc, Address: minter, Allowance: cosmosmath.NewUint(10)}},
+		},
+		{
+			desc: "duplicated",
+			minters: []types.Minter{
+				{Denom: fullDenomAbc, Address: minter, Allowance: cosmosmath.NewUint(10)},
+				{Denom: fullDenomAbc, Address: minter, Allowance: cosmosmath.NewUint(20)},
+			},
+			errorMessage: "duplicated index for minter",
+		},
+		{
+			desc:         "missing denom",
+			minters:      []types.Minter{{Denom: fullDenomUsdt, Address: minter, Allowance: cosmosmath.NewUint(10)}},
+			errorMessage: fmt.Sprintf("missing Denom for Minter '%s'", fullDenomUsdt),
+		},
+		{
+			desc:         "invalid address",
+			minters:      []types.Minter{{Denom: fullDenomAbc, Address: "invalid", Allowance: cosmosmath.NewUint(10)}},
+			errorMessage: "Minter address invalid",
+		},
+		{
+			desc:         "nil allowance",
+			minters:      []types.Minter{{Denom: fullDenomAbc, Address: minter}},
+			errorMessage: "Minter allowance is nil",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			genState := &types.GenesisState{
+				DenomList: []types.Denom{
+					{Creator: creator, Denom: fullDenomAbc, MintingCap: cosmosmath.NewUint(1000), Minted: cosmosmath.NewUint(0)},
+				},
+				DenomAuthList: []types.DenomAuth{
+					{Denom: fullDenomAbc, BankAdmin: creator, MetadataAdmin: creator},
+				},
+				MinterList: tc.minters,
+				Params:     types.DefaultParams(),
+			}
+
+			err := genState.Validate()
+			if tc.errorMessage == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errorMessage)
+		})
+	}
+}
+
+func TestGenesisState_Validate_MintRateLimits(t *testing.T) {
+	// Test case: validate the MintRateLimitList and MintRateBucketList of a GenesisState
+
+	creator := sample.AccAddress()
+	fullDenomAbc := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "abc"
+	fullDenomUsdt := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "usdt"
+
+	config := types.MintRateLimitConfig{Limit: cosmosmath.NewUint(100), Window: 3600, RaiseDelay: 600}
+
+	tests := []struct {
+		desc         string
+		rateLimits   []types.MintRateLimit
+		buckets      []types.MintRateBucket
+		errorMessage string
+	}{
+		{
+			desc:       "valid",
+			rateLimits: []types.MintRateLimit{{Denom: fullDenomAbc, Config: config, PendingConfig: &config, PendingTime: 600}},
+			buckets:    []types.MintRateBucket{{Denom: fullDenomAbc, StartTime: 150, Amount: cosmosmath.NewUint(10)}},
+		},
+		{
+			desc: "duplicated rate limit",
+			rateLimits: []types.MintRateLimit{
+				{Denom: fullDenomAbc, Config: config},
+				{Denom: fullDenomAbc, Config: config},
+			},
+			errorMessage: "duplicated index for mintRateLimit",
+		},
+		{
+			desc:         "missing denom",
+			rateLimits:   []types.MintRateLimit{{Denom: fullDenomUsdt, Config: config}},
+			errorMessage: fmt.Sprintf("missing Denom for MintRateLimit '%s'", fullDenomUsdt),
+		},
+		{
+			desc:         "invalid config",
+			rateLimits:   []types.MintRateLimit{{Denom: fullDenomAbc, Config: types.MintRateLimitConfig{Limit: cosmosmath.NewUint(100)}}},
+			errorMessage: "window must be greater than 0",
+		},
+		{
+			desc:       "duplicated bucket",
+			rateLimits: []types.MintRateLimit{{Denom: fullDenomAbc, Config: config}},
+			buckets: []types.MintRateBucket{
+				{Denom: fullDenomAbc, StartTime: 150, Amount: cosmosmath.NewUint(10)},
+				{Denom: fullDenomAbc, StartTime: 150, Amount: cosmosmath.NewUint(20)},
+			},
+			errorMessage: "duplicated index for mintRateBucket",
+		},
+		{
+			desc:         "bucket without rate limit",
+			buckets:      []types.MintRateBucket{{Denom: fullDenomAbc, StartTime: 150, Amount: cosmosmath.NewUint(10)}},
+			errorMessage: fmt.Sprintf("missing MintRateLimit for MintRateBucket '%s'", fullDenomAbc),
+		},
+		{
+			desc:         "nil bucket amount",
+			rateLimits:   []types.MintRateLimit{{Denom: fullDenomAbc, Config: config}},
+			buckets:      []types.MintRateBucket{{Denom: fullDenomAbc, StartTime: 150}},
+			errorMessage: "bucket amount is nil",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			genState := &types.GenesisState{
+				DenomList: []types.Denom{
+					{Creator: creator, Denom: fullDenomAbc, MintingCap: cosmosmath.NewUint(1000), Minted: cosmosmath.NewUint(0)},
+				},
+				DenomAuthList: []types.DenomAuth{
+					{Denom: fullDenomAbc, BankAdmin: creator, MetadataAdmin: creator},
+				},
+				MintRateLimitList:  tc.rateLimits,
+				MintRateBucketList: tc.buckets,
+				Params:             types.DefaultParams(),
+			}
+
+			err := genState.Validate()
+			if tc.errorMessage == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errorMessage)
+		})
+	}
+}
+
+func TestGenesisState_Validate_VestingGrants(t *testing.T) {
+	// Test case: validate the VestingGrantList of a GenesisState
+
+	creator := sample.AccAddress()
+	recipient := sample.AccAddress()
+	fullDenomAbc := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "abc"
+	fullDenomUsdt := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "usdt"
+
+	grant := func(denom string, id uint64) types.VestingGrant {
+		return types.VestingGrant{
+			Denom:     denom,
+			Id:        id,
+			Recipient: recipient,
+			Amount:    cosmosmath.NewUint(100),
+			Schedule:  types.VestingSchedule{StartTime: 1_000, EndTime: 2_000},
+			Escrow:    true,
+			Claimed:   cosmosmath.NewUint(10),
+		}
+	}
+
+	overClaimed := grant(fullDenomAbc, 0)
+	overClaimed.Claimed = cosmosmath.NewUint(101)
+
+	tests := []struct {
+		desc         string
+		grants       []types.VestingGrant
+		count        uint64
+		errorMessage string
+	}{
+		{
+			desc:   "valid",
+			grants: []types.VestingGrant{grant(fullDenomAbc, 0), grant(fullDenomAbc, 1)},
+			count:  2,
+		},
+		{
+			desc:         "duplicated",
+			grants:       []types.VestingGrant{grant(fullDenomAbc, 0), grant(fullDenomAbc, 0)},
+			count:        2,
+			errorMessage: "duplicated index for vestingGrant",
+		},
+		{
+			desc:         "missing denom",
+			grants:       []types.VestingGrant{grant(fullDenomUsdt, 0)},
+			count:        1,
+			errorMessage: fmt.Sprintf("missing Denom for VestingGrant '%s'", fullDenomUsdt),
+		},
+		{
+			desc:         "id above count",
+			grants:       []types.VestingGrant{grant(fullDenomAbc, 1)},
+			count:        1,
+			errorMessage: "vestingGrant id 1 should be lower than the count 1",
+		},
+		{
+			desc:         "claimed above amount",
+			grants:       []types.VestingGrant{overClaimed},
+			count:        1,
+			errorMessage: "claimed amount is nil or exceeds the amount",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			genState := &types.GenesisState{
+				DenomList: []types.Denom{
+					{Creator: creator, Denom: fullDenomAbc, MintingCap: cosmosmath.NewUint(1000), Minted: cosmosmath.NewUint(0)},
+				},
+				DenomAuthList: []types.DenomAuth{
+					{Denom: fullDenomAbc, BankAdmin: creator, MetadataAdmin: creator},
+				},
+				VestingGrantList:  tc.grants,
+				VestingGrantCount: tc.count,
+				Params:            types.DefaultParams(),
+			}
+
+			err := genState.Validate()
+			if tc.errorMessage == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errorMessage)
+		})
+	}
+}
+
+func TestGenesisState_Validate_Airdrops(t *testing.T) {
+	// Test case: validate the AirdropList and AirdropClaimList of a GenesisState
+
+	creator := sample.AccAddress()
+	claimer := sample.AccAddress()
+	fullDenomAbc := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "abc"
+	fullDenomUsdt := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "usdt"
+
+	airdrop := func(denom string, id uint64) types.Airdrop {
+		return types.Airdrop{
+			Id:         id,
+			Denom:      denom,
+			Creator:    creator,
+			MerkleRoot: strings.Repeat("ab", 32),
+			Amount:     cosmosmath.NewUint(100),
+			Claimed:    cosmosmath.NewUint(10),
+			ExpiryTime: 2_000,
+		}
+	}
+
+	invalidRoot := airdrop(fullDenomAbc, 0)
+	invalidRoot.MerkleRoot = "root"
+
+	tests := []struct {
+		desc         string
+		airdrops     []types.Airdrop
+		claims       []types.AirdropClaim
+		count        uint64
+		errorMessage string
+	}{
+		{
+			desc:     "valid",
+			airdrops: []types.Airdrop{airdrop(fullDenomAbc, 0), airdrop(fullDenomAbc, 1)},
+			claims:   []types.AirdropClaim{{AirdropId: 1, Address: claimer}},
+			count:    2,
+		},
+		{
+			desc:         "duplicated",
+			airdrops:     []types.Airdrop{airdrop(fullDenomAbc, 0), airdrop(fullDenomAbc, 0)},
+			count:        2,
+			errorMessage: "duplicated index for airdrop",
+		},
+		{
+			desc:         "missing denom",
+			airdrops:     []types.Airdrop{airdrop(fullDenomUsdt, 0)},
+			count:        1,
+			errorMessage: fmt.Sprintf("missing Denom for Airdrop '%s'", fullDenomUsdt),
+		},
+		{
+			desc:         "id above count",
+			airdrops:     []types.Airdrop{airdrop(fullDenomAbc, 1)},
+			count:        1,
+			errorMessage: "airdrop id 1 should be lower than the count 1",
+		},
+		{
+			desc:         "invalid merkle root",
+			airdrops:     []types.Airdrop{invalidRoot},
+			count:        1,
+			errorMessage: "merkle root",
+		},
+		{
+			desc:         "duplicated claim",
+			airdrops:     []types.Airdrop{airdrop(fullDenomAbc, 0)},
+			claims:       []types.AirdropClaim{{AirdropId: 0, Address: claimer}, {AirdropId: 0, Address: claimer}},
+			count:        1,
+			errorMessage: "duplicated index for airdropClaim",
+		},
+		{
+			desc:         "claim of a missing airdrop",
+			airdrops:     []types.Airdrop{airdrop(fullDenomAbc, 0)},
+			claims:       []types.AirdropClaim{{AirdropId: 1, Address: claimer}},
+			count:        2,
+			errorMessage: "missing Airdrop for AirdropClaim 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			genState := &types.GenesisState{
+				DenomList: []types.Denom{
+					{Creator: creator, Denom: fullDenomAbc, MintingCap: cosmosmath.NewUint(1000), Minted: cosmosmath.NewUint(0)},
+				},
+				DenomAuthList: []types.DenomAuth{
+					{Denom: fullDenomAbc, BankAdmin: creator, MetadataAdmin: creator},
+				},
+				AirdropList:      tc.airdrops,
+				AirdropClaimList: tc.claims,
+				AirdropCount:     tc.count,
+				Params:           types.DefaultParams(),
+			}
+
+			err := genState.Validate()
+			if tc.errorMessage == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errorMessage)
+		})
+	}
+}
+
+func TestGenesisState_Validate_Snapshots(t *testing.T) {
+	// Test case: validate the SnapshotList and SnapshotBalanceList of a GenesisState
+
+	creator := sample.AccAddress()
+	holder := sample.AccAddress()
+	fullDenomAbc := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "abc"
+	fullDenomUsdt := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "usdt"
+
+	snapshot := func(denom string, id uint64) types.Snapshot {
+		return types.Snapshot{Denom: denom, Id: id, Height: 10, Time: 1_000, Supply: cosmosmath.NewUint(100)}
+	}
+	balance := func(id uint64, address string) types.SnapshotBalance {
+		return types.SnapshotBalance{Denom: fullDenomAbc, SnapshotId: id, Address: address, Amount: cosmosmath.NewUint(10)}
+	}
+
+	tests := []struct {
+		desc         string
+		snapshots    []types.Snapshot
+		balances     []types.SnapshotBalance
+		errorMessage string
+	}{
+		{
+			desc:      "valid",
+			snapshots: []types.Snapshot{snapshot(fullDenomAbc, 0), snapshot(fullDenomAbc, 1)},
+			balances:  []types.SnapshotBalance{balance(0, holder), balance(1, holder)},
+		},
+		{
+			desc:         "duplicated",
+			snapshots:    []types.Snapshot{snapshot(fullDenomAbc, 0), snapshot(fullDenomAbc, 0)},
+			errorMessage: "duplicated index for snapshot",
+		},
+		{
+			desc:         "missing denom",
+			snapshots:    []types.Snapshot{snapshot(fullDenomUsdt, 0)},
+			errorMessage: fmt.Sprintf("missing Denom for Snapshot '%s'", fullDenomUsdt),
+		},
+		{
+			desc:         "duplicated balance",
+			snapshots:    []types.Snapshot{snapshot(fullDenomAbc, 0)},
+			balances:     []types.SnapshotBalance{balance(0, holder), balance(0, holder)},
+			errorMessage: "duplicated index for snapshotBalance",
+		},
+		{
+			desc:         "balance of a missing snapshot",
+			snapshots:    []types.Snapshot{snapshot(fullDenomAbc, 0)},
+			balances:     []types.SnapshotBalance{balance(1, holder)},
+			errorMessage: fmt.Sprintf("missing Snapshot 1 for SnapshotBalance '%s'", fullDenomAbc),
+		},
+		{
+			desc:         "invalid balance address",
+			snapshots:    []types.Snapshot{snapshot(fullDenomAbc, 0)},
+			balances:     []types.SnapshotBalance{balance(0, "invalid_address")},
+			errorMessage: "SnapshotBalance address invalid",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			genState := &types.GenesisState{
+				DenomList: []types.Denom{
+					{Creator: creator, Denom: fullDenomAbc, MintingCap: cosmosmath.NewUint(1000), Minted: cosmosmath.NewUint(0)},
+				},
+				DenomAuthList: []types.DenomAuth{
+					{Denom: fullDenomAbc, BankAdmin: creator, MetadataAdmin: creator},
+				},
+				SnapshotList:        tc.snapshots,
+				SnapshotBalanceList: tc.balances,
+				Params:              types.DefaultParams(),
+			}
+
+			err := genState.Validate()
+			if tc.errorMessage == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errorMessage)
+		})
+	}
+}
+
+func TestGenesisState_Validate_Distributions(t *testing.T) {
+	// Test case: validate the RewardPoolList, HolderRewardList and ModuleAccountHolderList of a GenesisState
+
+	creator := sample.AccAddress()
+	holder := sample.AccAddress()
+	fullDenomAbc := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "abc"
+	fullDenomUsdt := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "usdt"
+
+	pool := func(denom string, rewardDenom string) types.RewardPool {
+		return types.RewardPool{
+			Denom:          denom,
+			RewardDenom:    rewardDenom,
+			RewardPerToken: cosmosmath.LegacyMustNewDecFromStr("2.5"),
+			Distributed:    cosmosmath.NewUint(1000),
+			Claimed:        cosmosmath.NewUint(250),
+		}
+	}
+	reward := func(rewardDenom string, address string) types.HolderReward {
+		return types.HolderReward{
+			Denom:              fullDenomAbc,
+			RewardDenom:        rewardDenom,
+			Address:            address,
+			RewardPerTokenPaid: cosmosmath.LegacyOneDec(),
+			Pending:            cosmosmath.NewUint(10),
+		}
+	}
+
+	tooManyPools := make([]types.RewardPool, 0, types.MaxRewardDenoms+1)
+	for i := 0; i <= types.MaxRewardDenoms; i++ {
+		tooManyPools = append(tooManyPools, pool(fullDenomAbc, fmt.Sprintf("reward%c", 'a'+i)))
+	}
+
+	overclaimed := pool(fullDenomAbc, "uzig")
+	overclaimed.Claimed = cosmosmath.NewUint(1001)
+
+	tests := []struct {
+		desc          string
+		pools         []types.RewardPool
+		rewards       []types.HolderReward
+		moduleHolders []types.ModuleAccountHolder
+		errorMessage  string
+	}{
+		{
+			desc:          "valid",
+			pools:         []types.RewardPool{pool(fullDenomAbc, "uzig"), pool(fullDenomAbc, "uatom")},
+			rewards:       []types.HolderReward{reward("uzig", holder), reward("uatom", holder)},
+			moduleHolders: []types.ModuleAccountHolder{{Denom: fullDenomAbc, Address: holder}},
+		},
+		{
+			desc:         "duplicated pool",
+			pools:        []types.RewardPool{pool(fullDenomAbc, "uzig"), pool(fullDenomAbc, "uzig")},
+			errorMessage: "duplicated index for rewardPool",
+		},
+		{
+			desc:         "claimed over distributed",
+			pools:        []types.RewardPool{overclaimed},
+			errorMessage: "exceeds the distributed amount",
+		},
+		{
+			desc:         "pool of a missing denom",
+			pools:        []types.RewardPool{pool(fullDenomUsdt, "uzig")},
+			errorMessage: fmt.Sprintf("missing Denom for RewardPool '%s'", fullDenomUsdt),
+		},
+		{
+			desc:         "too many pools",
+			pools:        tooManyPools,
+			errorMessage: fmt.Sprintf("denom '%s' has more than %d reward pools", fullDenomAbc, types.MaxRewardDenoms),
+		},
+		{
+			desc:         "duplicated holder reward",
+			pools:        []types.RewardPool{pool(fullDenomAbc, "uzig")},
+			rewards:      []types.HolderReward{reward("uzig", holder), reward("uzig", holder)},
+			errorMessage: "duplicated index for holderReward",
+		},
+		{
+			desc:         "holder reward of a missing pool",
+			pools:        []types.RewardPool{pool(fullDenomAbc, "uzig")},
+			rewards:      []types.HolderReward{reward("uatom", holder)},
+			errorMessage: fmt.Sprintf("missing RewardPool for HolderReward '%s/uatom'", fullDenomAbc),
+		},
+		{
+			desc:         "invalid holder address",
+			pools:        []types.RewardPool{pool(fullDenomAbc, "uzig")},
+			rewards:      []types.HolderReward{reward("uzig", "invalid_address")},
+			errorMessage: "HolderReward address invalid",
+		},
+		{
+			desc:          "duplicated module account holder",
+			moduleHolders: []types.ModuleAccountHolder{{Denom: fullDenomAbc, Address: holder}, {Denom: fullDenomAbc, Address: holder}},
+			errorMessage:  "duplicated index for moduleAccountHolder",
+		},
+		{
+			desc:          "module account holder of a missing denom",
+			moduleHolders: []types.ModuleAccountHolder{{Denom: fullDenomUsdt, Address: holder}},
+			errorMessage:  fmt.Sprintf("missing Denom for ModuleAccountHolder '%s'", fullDenomUsdt),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			genState := &types.GenesisState{
+				DenomList: []types.Denom{
+					{Creator: creator, Denom: fullDenomAbc, MintingCap: cosmosmath.NewUint(1000), Minted: cosmosmath.NewUint(0)},
+				},
+				DenomAuthList: []types.DenomAuth{
+					{Denom: fullDenomAbc, BankAdmin: creator, MetadataAdmin: creator},
+				},
+				RewardPoolList:          tc.pools,
+				HolderRewardList:        tc.rewards,
+				ModuleAccountHolderList: tc.moduleHolders,
+				Params:                  types.DefaultParams(),
+			}
+
+			err := genState.Validate()
+			if tc.errorMessage == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errorMessage)
+		})
+	}
+}
+
+func TestGenesisState_Validate_CW20Bridges(t *testing.T) {
+	// Test case: validate the Cw20BridgeList of a GenesisState
+
+	creator := sample.AccAddress()
+	contract := sample.AccAddress()
+	fullDenomAbc := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "abc"
+	fullDenomUsdt := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "usdt"
+
+	bridge := func(contract string, denom string) types.CW20Bridge {
+		return types.CW20Bridge{ContractAddress: contract, Denom: denom, Escrowed: cosmosmath.NewUint(100)}
+	}
+
+	tests := []struct {
+		desc         string
+		bridges      []types.CW20Bridge
+		errorMessage string
+	}{
+		{
+			desc:    "valid",
+			bridges: []types.CW20Bridge{bridge(contract, fullDenomAbc)},
+		},
+		{
+			desc:         "duplicated contract",
+			bridges:      []types.CW20Bridge{bridge(contract, fullDenomAbc), bridge(contract, fullDenomAbc)},
+			errorMessage: "duplicated index for cw20Bridge",
+		},
+		{
+			desc:         "duplicated denom",
+			bridges:      []types.CW20Bridge{bridge(contract, fullDenomAbc), bridge(sample.AccAddress(), fullDenomAbc)},
+			errorMessage: fmt.Sprintf("duplicated denom '%s' for cw20Bridge", fullDenomAbc),
+		},
+		{
+			desc:         "invalid contract",
+			bridges:      []types.CW20Bridge{bridge("invalid_address", fullDenomAbc)},
+			errorMessage: "CW20Bridge (invalid_address)",
+		},
+		{
+			desc:         "missing denom",
+			bridges:      []types.CW20Bridge{bridge(contract, fullDenomUsdt)},
+			errorMessage: fmt.Sprintf("missing Denom for CW20Bridge '%s'", fullDenomUsdt),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			genState := &types.GenesisState{
+				DenomList: []types.Denom{
+					{Creator: creator, Denom: fullDenomAbc, MintingCap: cosmosmath.NewUint(1000), Minted: cosmosmath.NewUint(100)},
+				},
+				DenomAuthList: []types.DenomAuth{
+					{Denom: fullDenomAbc, BankAdmin: "", MetadataAdmin: creator},
+				},
+				Cw20BridgeList: tc.bridges,
+				Params:         types.DefaultParams(),
+			}
+
+			err := genState.Validate()
+			if tc.errorMessage == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errorMessage)
+		})
+	}
+}
+
+func TestGenesisState_Validate_DenomRoles(t *testing.T) {
+	// Test case: validate the DenomRoleList of a GenesisState
+
+	creator := sample.AccAddress()
+	holder := sample.AccAddress()
+	fullDenomAbc := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "abc"
+	fullDenomUsdt := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "usdt"
+
+	tests := []struct {
+		desc         string
+		roles        []types.DenomRole
+		errorMessage string
+	}{
+		{
+			desc: "valid",
+			roles: []types.DenomRole{
+				{Denom: fullDenomAbc, Role: types.RolePauser, Address: holder},
+				{Denom: fullDenomAbc, Role: types.RoleBurner, Address: holder},
+			},
+		},
+		{
+			desc: "duplicated role",
+			roles: []types.DenomRole{
+				{Denom: fullDenomAbc, Role: types.RolePauser, Address: holder},
+				{Denom: fullDenomAbc, Role: types.RolePauser, Address: holder},
+			},
+			errorMessage: "duplicated index for denomRole",
+		},
+		{
+			desc:         "admin role",
+			roles:        []types.DenomRole{{Denom: fullDenomAbc, Role: types.RoleAdmin, Address: holder}},
+			errorMessage: "invalid denom role",
+		},
+		{
+			desc:         "invalid holder",
+			roles:        []types.DenomRole{{Denom: fullDenomAbc, Role: types.RolePauser, Address: "invalid_address"}},
+			errorMessage: "Role holder",
+		},
+		{
+			desc:         "missing denom auth",
+			roles:        []types.DenomRole{{Denom: fullDenomUsdt, Role: types.RolePauser, Address: holder}},
+			errorMessage: fmt.Sprintf("missing DenomAuth for DenomRole '%s'", fullDenomUsdt),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			genState := &types.GenesisState{
+				DenomList: []types.Denom{
+					{Creator: creator, Denom: fullDenomAbc, MintingCap: cosmosmath.NewUint(1000), Minted: cosmosmath.NewUint(100)},
+				},
+				DenomAuthList: []types.DenomAuth{
+					{Denom: fullDenomAbc, BankAdmin: creator, MetadataAdmin: creator},
+				},
+				DenomRoleList: tc.roles,
+				Params:        types.DefaultParams(),
+			}
+
+			err := genState.Validate()
+			if tc.errorMessage == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errorMessage)
+		})
+	}
+}
+
+func TestGenesisState_Validate_DenomAdminProposals(t *testing.T) {
+	// Test case: validate the DenomAdminProposalList and DenomAdminTimelockList of a GenesisState
+
+	creator := sample.AccAddress()
+	newAdmin := sample.AccAddress()
+	fullDenomAbc := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "abc"
+	fullDenomUsdt := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "usdt"
+
+	tests := []struct {
+		desc         string
+		proposals    []types.DenomAdminProposal
+		timelocks    []types.DenomAdminTimelock
+		errorMessage string
+	}{
+		{
+			desc:      "valid",
+			proposals: []types.DenomAdminProposal{{Denom: fullDenomAbc, BankAdmin: newAdmin, ClaimableTime: 1000, ExpiryTime: 2000}},
+			timelocks: []types.DenomAdminTimelock{{Denom: fullDenomAbc, Delay: 3600, PendingDelay: 60, PendingTime: 1000}},
+		},
+		{
+			desc: "duplicated proposal",
+			proposals: []types.DenomAdminProposal{
+				{Denom: fullDenomAbc, BankAdmin: newAdmin},
+				{Denom: fullDenomAbc, BankAdmin: newAdmin},
+			},
+			errorMessage: "duplicated index for denomAdminProposal",
+		},
+		{
+			desc:         "proposal expiring before it is claimable",
+			proposals:    []types.DenomAdminProposal{{Denom: fullDenomAbc, BankAdmin: newAdmin, ClaimableTime: 2000, ExpiryTime: 1000}},
+			errorMessage: "expires at 1000",
+		},
+		{
+			desc:         "proposal of a missing denom auth",
+			proposals:    []types.DenomAdminProposal{{Denom: fullDenomUsdt, BankAdmin: newAdmin}},
+			errorMessage: fmt.Sprintf("missing DenomAuth for DenomAdminProposal '%s'", fullDenomUsdt),
+		},
+		{
+			desc: "duplicated timelock",
+			timelocks: []types.DenomAdminTimelock{
+				{Denom: fullDenomAbc, Delay: 3600},
+				{Denom: fullDenomAbc, Delay: 60},
+			},
+			errorMessage: "duplicated index for denomAdminTimelock",
+		},
+		{
+			desc:         "pending delay longer than the delay",
+			timelocks:    []types.DenomAdminTimelock{{Denom: fullDenomAbc, Delay: 60, PendingDelay: 3600, PendingTime: 1000}},
+			errorMessage: "must be shorter than the delay",
+		},
+		{
+			desc:         "timelock of a missing denom auth",
+			timelocks:    []types.DenomAdminTimelock{{Denom: fullDenomUsdt, Delay: 3600}},
+			errorMessage: fmt.Sprintf("missing DenomAuth for DenomAdminTimelock '%s'", fullDenomUsdt),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			genState := &types.GenesisState{
+				DenomList: []types.Denom{
+					{Creator: creator, Denom: fullDenomAbc, MintingCap: cosmosmath.NewUint(1000), Minted: cosmosmath.NewUint(100)},
+				},
+				DenomAuthList: []types.DenomAuth{
+					{Denom: fullDenomAbc, BankAdmin: creator, MetadataAdmin: creator},
+				},
+				DenomAdminProposalList: tc.proposals,
+				DenomAdminTimelockList: tc.timelocks,
+				Params:                 types.DefaultParams(),
+			}
+
+			err := genState.Validate()
+			if tc.errorMessage == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errorMessage)
+		})
+	}
+}
+
+func TestGenesisState_Validate_VerifiedDenoms(t *testing.T) {
+	// Test case: validate the VerifiedDenomList of a GenesisState
+
+	creator := sample.AccAddress()
+	logoHash := strings.Repeat("ab", 32)
+	fullDenomAbc := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "abc"
+	fullDenomUsdt := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "usdt"
+
+	tests := []struct {
+		desc         string
+		verified     []types.VerifiedDenom
+		errorMessage string
+	}{
+		{
+			desc: "valid",
+			verified: []types.VerifiedDenom{
+				{Denom: fullDenomAbc, Ticker: "ABC", UriHash: logoHash, Decimals: 6},
+				{Denom: fullDenomUsdt, Ticker: "USDT", UriHash: logoHash, Decimals: 6},
+			},
+		},
+		{
+			desc: "duplicated denom",
+			verified: []types.VerifiedDenom{
+				{Denom: fullDenomAbc, Ticker: "ABC", UriHash: logoHash},
+				{Denom: fullDenomAbc, Ticker: "ABC2", UriHash: logoHash},
+			},
+			errorMessage: "duplicated index for verifiedDenom",
+		},
+		{
+			desc: "duplicated ticker",
+			verified: []types.VerifiedDenom{
+				{Denom: fullDenomAbc, Ticker: "USDT", UriHash: logoHash},
+				{Denom: fullDenomUsdt, Ticker: "USDT", UriHash: logoHash},
+			},
+			errorMessage: "duplicated ticker 'USDT' for verifiedDenom",
+		},
+		{
+			desc:         "invalid ticker",
+			verified:     []types.VerifiedDenom{{Denom: fullDenomAbc, Ticker: "abc", UriHash: logoHash}},
+			errorMessage: "invalid verified denom",
+		},
+		{
+			desc:         "missing denom",
+			verified:     []types.VerifiedDenom{{Denom: "coin." + sample.AccAddress() + ".abc", Ticker: "ABC", UriHash: logoHash}},
+			errorMessage: "missing Denom for VerifiedDenom",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			genState := &types.GenesisState{
+				DenomList: []types.Denom{
+					{Creator: creator, Denom: fullDenomAbc, MintingCap: cosmosmath.NewUint(1000), Minted: cosmosmath.NewUint(100)},
+					{Creator: creator, Denom: fullDenomUsdt, MintingCap: cosmosmath.NewUint(1000), Minted: cosmosmath.NewUint(100)},
+				},
+				DenomAuthList: []types.DenomAuth{
+					{Denom: fullDenomAbc, BankAdmin: creator, MetadataAdmin: creator},
+					{Denom: fullDenomUsdt, BankAdmin: creator, MetadataAdmin: creator},
+				},
+				VerifiedDenomList: tc.verified,
+				Params:            types.DefaultParams(),
+			}
+
+			err := genState.Validate()
+			if tc.errorMessage == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errorMessage)
+		})
+	}
+}
+
+func TestGenesisState_Validate_TransferTaxes(t *testing.T) {
+	// Test case: validate the TransferTaxList and TaxExemptionList of a GenesisState
+
+	creator := sample.AccAddress()
+	fullDenomAbc := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "abc"
+	missingDenom := "coin." + sample.AccAddress() + ".abc"
+	rate := cosmosmath.LegacyNewDecWithPrec(1, 2)
+
+	tests := []struct {
+		desc         string
+		taxes        []types.TransferTax
+		exemptions   []types.TransferTaxExemption
+		errorMessage string
+	}{
+		{
+			desc:       "valid",
+			taxes:      []types.TransferTax{{Denom: fullDenomAbc, Rate: rate, Recipient: creator}},
+			exemptions: []types.TransferTaxExemption{{Denom: fullDenomAbc, Address: creator}},
+		},
+		{
+			desc: "duplicated tax",
+			taxes: []types.TransferTax{
+				{Denom: fullDenomAbc, Rate: rate, Recipient: creator},
+				{Denom: fullDenomAbc, Rate: rate, Recipient: creator},
+			},
+			errorMessage: "duplicated index for transferTax",
+		},
+		{
+			desc:         "zero rate",
+			taxes:        []types.TransferTax{{Denom: fullDenomAbc, Rate: cosmosmath.LegacyZeroDec(), Recipient: creator}},
+			errorMessage: "invalid transfer tax",
+		},
+		{
+			desc:         "rate above the max",
+			taxes:        []types.TransferTax{{Denom: fullDenomAbc, Rate: cosmosmath.LegacyNewDecWithPrec(5, 1), Recipient: creator}},
+			errorMessage: "exceeds the max transfer tax rate",
+		},
+		{
+			desc:         "invalid recipient",
+			taxes:        []types.TransferTax{{Denom: fullDenomAbc, Rate: rate, Recipient: "invalid"}},
+			errorMessage: "recipient",
+		},
+		{
+			desc:         "missing tax denom",
+			taxes:        []types.TransferTax{{Denom: missingDenom, Rate: rate, Recipient: creator}},
+			errorMessage: "missing Denom for TransferTax",
+		},
+		{
+			desc: "duplicated exemption",
+			exemptions: []types.TransferTaxExemption{
+				{Denom: fullDenomAbc, Address: creator},
+				{Denom: fullDenomAbc, Address: creator},
+			},
+			errorMessage: "duplicated index for transferTaxExemption",
+		},
+		{
+			desc:         "missing exemption denom",
+			exemptions:   []types.TransferTaxExemption{{Denom: missingDenom, Address: creator}},
+			errorMessage: "missing Denom for TransferTaxExemption",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			genState := &types.GenesisState{
+				DenomList: []types.Denom{
+					{Creator: creator, Denom: fullDenomAbc, MintingCap: cosmosmath.NewUint(1000), Minted: cosmosmath.NewUint(100)},
+				},
+				DenomAuthList: []types.DenomAuth{
+					{Denom: fullDenomAbc, BankAdmin: creator, MetadataAdmin: creator},
+				},
+				TransferTaxList:  tc.taxes,
+				TaxExemptionList: tc.exemptions,
+				Params:           types.DefaultParams(),
+			}
+
+			err := genState.Validate()
+			if tc.errorMessage == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errorMessage)
+		})
+	}
+}
+
+func TestGenesisState_Validate_TokenLaunches(t *testing.T) {
+	// Test case: validate the TokenLaunchList of a GenesisState
+
+	creator := sample.AccAddress()
+	fullDenomAbc := "coin" + types.FactoryDenomDelimiterChar + creator + types.FactoryDenomDelimiterChar + "abc"
+	missingDenom := "coin." + sample.AccAddress() + ".abc"
+	launch := types.NewTokenLaunch(fullDenomAbc, creator, cosmosmath.NewInt(1_000_000_000), cosmosmath.NewInt(800_000_000), types.DefaultParams())
+
+	soldOut := launch
+	soldOut.Sold = launch.CurveSupply
+
+	lowGraduation := launch
+	lowGraduation.GraduationMarketCap = cosmosmath.NewInt(1_000)
+
+	missing := launch
+	missing.Denom = missingDenom
+
+	tests := []struct {
+		desc         string
+		launches     []types.TokenLaunch
+		errorMessage string
+	}{
+		{
+			desc:     "valid",
+			launches: []types.TokenLaunch{launch},
+		},
+		{
+			desc:         "duplicated launch",
+			launches:     []types.TokenLaunch{launch, launch},
+			errorMessage: "duplicated index for tokenLaunch",
+		},
+		{
+			desc:         "curve sold out",
+			launches:     []types.TokenLaunch{soldOut},
+			errorMessage: "lower than the curve supply",
+		},
+		{
+			desc:         "graduation below the starting market cap",
+			launches:     []types.TokenLaunch{lowGraduation},
+			errorMessage: "must be above the starting market cap",
+		},
+		{
+			desc:         "missing launch denom",
+			launches:     []types.TokenLaunch{missing},
+			errorMessage: "missing Denom for TokenLaunch",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			genState := &types.GenesisState{
+				DenomList: []types.Denom{
+					{Creator: creator, Denom: fullDenomAbc, MintingCap: cosmosmath.NewUint(1_000_000_000), Minted: cosmosmath.NewUint(1_000_000_000)},
+				},
+				DenomAuthList: []types.DenomAuth{
+					{Denom: fullDenomAbc, MetadataAdmin: creator},
+				},
+				TokenLaunchList: tc.launches,
+				Params:          types.DefaultParams(),
+			}
+
+			err := genState.Validate()
+			if tc.errorMessage == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errorMessage)
+		})
+	}
+}
@@ -5,7 +5,7 @@ import "encoding/binary"
 var _ binary.ByteOrder
 
 const (
-	// DenomKeyPrefix is the prefix to retrieve all Denom
+	// DenomKeyPrefix is the prefix of the Denom in the legacy store layout, only used by the migrations
 	DenomKeyPrefix = "Denom/value/"
 )
 
@@ -5,13 +5,13 @@ import "encoding/binary"
 var _ binary.ByteOrder
 
 const (
-	// DenomAuthKeyPrefix is the prefix to retrieve all DenomAuth
+	// DenomAuthKeyPrefix is the prefix of the DenomAuth in the legacy store layout, only used by the v4 migration
 	DenomAuthKeyPrefix = "DenomAuth/value/"
 
-	// ProposedDenomAuthKeyPrefix is the prefix to retrieve all proposed DenomAuth
+	// ProposedDenomAuthKeyPrefix is the prefix of the proposed DenomAuth in the legacy store layout, only used by the v4 migration
 	ProposedDenomAuthKeyPrefix = "ProposedDenomAuth/value/"
 
-	// AdminDenomAuthKeyPrefix is the prefix to retrieve all admin denom auth indexes
+	// AdminDenomAuthKeyPrefix is the prefix of the legacy admin denom auth index, removed by the v4 migration
 	AdminDenomAuthKeyPrefix = "AdminDenomAuth/value/"
 )
 
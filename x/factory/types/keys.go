@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "factory"
@@ -12,12 +14,94 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	//MemStoreKey = "mem_factory"
+
+	// VestingEscrowName is the name of the module account holding the escrowed vesting grants
+	VestingEscrowName = "factory_vesting"
+
+	// AirdropEscrowName is the name of the module account holding the unclaimed airdrops
+	AirdropEscrowName = "factory_airdrop"
+
+	// DistributionEscrowName is the name of the module account holding the unclaimed rewards
+	DistributionEscrowName = "factory_distribution"
+
+	// CW20EscrowName is the name of the module account holding the CW20 tokens of the bridged denoms
+	CW20EscrowName = "factory_cw20_escrow"
+
+	// LaunchpadEscrowName is the name of the module account holding the tokens and the uzig of the launches
+	LaunchpadEscrowName = "factory_launchpad"
 )
 
 var (
 	ParamsKey = []byte("p_factory")
 )
 
+var (
+	// DenomsPrefix is the prefix of the denoms collection
+	DenomsPrefix = collections.NewPrefix(1)
+	// DenomAuthsPrefix is the prefix of the denom auths collection
+	DenomAuthsPrefix = collections.NewPrefix(2)
+	// DenomAuthsByAdminPrefix is the prefix of the denom auths index by admin
+	DenomAuthsByAdminPrefix = collections.NewPrefix(3)
+	// ProposedDenomAuthsPrefix is the prefix of the proposed denom auths collection
+	ProposedDenomAuthsPrefix = collections.NewPrefix(4)
+	// BeforeSendHooksPrefix is the prefix of the before send hooks collection
+	BeforeSendHooksPrefix = collections.NewPrefix(5)
+	// FrozenAccountsPrefix is the prefix of the frozen (denom, address) pairs
+	FrozenAccountsPrefix = collections.NewPrefix(6)
+	// GlobalFrozenDenomsPrefix is the prefix of the globally frozen denoms
+	GlobalFrozenDenomsPrefix = collections.NewPrefix(7)
+	// MintersPrefix is the prefix of the minters collection
+	MintersPrefix = collections.NewPrefix(8)
+	// MintRateLimitsPrefix is the prefix of the mint rate limits collection
+	MintRateLimitsPrefix = collections.NewPrefix(9)
+	// MintRateBucketsPrefix is the prefix of the minted amounts per (denom, bucket start)
+	MintRateBucketsPrefix = collections.NewPrefix(10)
+	// VestingGrantsPrefix is the prefix of the vesting grants collection
+	VestingGrantsPrefix = collections.NewPrefix(11)
+	// VestingGrantSeqPrefix is the prefix of the vesting grant id sequence
+	VestingGrantSeqPrefix = collections.NewPrefix(12)
+	// AirdropsPrefix is the prefix of the open airdrops collection
+	AirdropsPrefix = collections.NewPrefix(13)
+	// AirdropsByExpiryPrefix is the prefix of the (expiry time, airdrop id) index
+	AirdropsByExpiryPrefix = collections.NewPrefix(14)
+	// AirdropClaimsPrefix is the prefix of the claimed (airdrop id, address) pairs
+	AirdropClaimsPrefix = collections.NewPrefix(15)
+	// AirdropSeqPrefix is the prefix of the airdrop id sequence
+	AirdropSeqPrefix = collections.NewPrefix(16)
+	// SnapshotsPrefix is the prefix of the snapshots collection
+	SnapshotsPrefix = collections.NewPrefix(17)
+	// SnapshotBalancesPrefix is the prefix of the (denom, address, snapshot id) balances
+	SnapshotBalancesPrefix = collections.NewPrefix(18)
+	// SnapshotCountsPrefix is the prefix of the number of snapshots per denom
+	SnapshotCountsPrefix = collections.NewPrefix(19)
+	// RewardPoolsPrefix is the prefix of the (denom, reward denom) reward pools
+	RewardPoolsPrefix = collections.NewPrefix(20)
+	// HolderRewardsPrefix is the prefix of the (denom, address, reward denom) holder rewards
+	HolderRewardsPrefix = collections.NewPrefix(21)
+	// ModuleAccountHoldersPrefix is the prefix of the (denom, address) module account holders
+	ModuleAccountHoldersPrefix = collections.NewPrefix(22)
+	// CW20BridgesPrefix is the prefix of the CW20 bridges by contract address
+	CW20BridgesPrefix = collections.NewPrefix(23)
+	// CW20BridgesByDenomPrefix is the prefix of the CW20 bridges index by denom
+	CW20BridgesByDenomPrefix = collections.NewPrefix(24)
+	// DenomRolesPrefix is the prefix of the (denom, role, address) granted denom roles
+	DenomRolesPrefix = collections.NewPrefix(25)
+	// DenomAdminTimelocksPrefix is the prefix of the admin timelocks by denom
+	DenomAdminTimelocksPrefix = collections.NewPrefix(26)
+	// VerifiedDenomsPrefix is the prefix of the verified denom registry by denom
+	VerifiedDenomsPrefix = collections.NewPrefix(27)
+	// VerifiedDenomsByTickerPrefix is the prefix of the verified denom registry index by ticker
+	VerifiedDenomsByTickerPrefix = collections.NewPrefix(28)
+	// TransferTaxesPrefix is the prefix of the transfer taxes by denom
+	TransferTaxesPrefix = collections.NewPrefix(29)
+	// TransferTaxExemptionsPrefix is the prefix of the (denom, address) transfer tax exemptions
+	TransferTaxExemptionsPrefix = collections.NewPrefix(30)
+	// TokenLaunchesPrefix is the prefix of the token launches by denom
+	TokenLaunchesPrefix = collections.NewPrefix(31)
+	// ClosedAirdropsPrefix is the prefix of the ids of the closed airdrops whose claims are not pruned yet
+	ClosedAirdropsPrefix = collections.NewPrefix(32)
+)
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
@@ -0,0 +1,142 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"zigchain/zutils/validators"
+)
+
+// NewTokenLaunch returns the launch of a denom on a bonding curve set by the launchpad params
+func NewTokenLaunch(denom string, creator string, supply math.Int, curveSupply math.Int, params Params) TokenLaunch {
+	return TokenLaunch{
+		Denom:               denom,
+		Creator:             creator,
+		Supply:              supply,
+		CurveSupply:         curveSupply,
+		VirtualReserve:      params.LaunchVirtualReserve,
+		GraduationMarketCap: params.LaunchGraduationMarketCap,
+		Sold:                math.ZeroInt(),
+		Raised:              math.ZeroInt(),
+	}
+}
+
+// ValidateLaunchSupply validates the supply of a launch and the part of it sold on the curve,
+// which has to leave tokens to seed the dex pool
+func ValidateLaunchSupply(supply math.Int, curveSupply math.Int) error {
+	if supply.IsNil() || curveSupply.IsNil() || !curveSupply.IsPositive() || curveSupply.GTE(supply) {
+		return errorsmod.Wrapf(
+			ErrInvalidTokenLaunch,
+			"curve supply (%s) must be positive and lower than the supply (%s)",
+			curveSupply,
+			supply,
+		)
+	}
+
+	return nil
+}
+
+// Validate validates a launch
+func (l TokenLaunch) Validate() error {
+	if _, _, err := DeconstructDenom(l.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenom, "(%s) : %s", l.Denom, err)
+	}
+
+	if err := validators.AddressCheck("creator", l.Creator); err != nil {
+		return err
+	}
+
+	if err := ValidateLaunchSupply(l.Supply, l.CurveSupply); err != nil {
+		return err
+	}
+
+	if l.VirtualReserve.IsNil() || !l.VirtualReserve.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidTokenLaunch, "virtual reserve of denom (%s) must be positive", l.Denom)
+	}
+
+	if l.Sold.IsNil() || l.Sold.IsNegative() || l.Sold.GTE(l.CurveSupply) {
+		return errorsmod.Wrapf(
+			ErrInvalidTokenLaunch,
+			"sold (%s) of denom (%s) must be at least 0 and lower than the curve supply",
+			l.Sold,
+			l.Denom,
+		)
+	}
+
+	if l.Raised.IsNil() || l.Raised.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidTokenLaunch, "raised of denom (%s) can not be negative", l.Denom)
+	}
+
+	if l.GraduationMarketCap.IsNil() || l.GraduationMarketCap.LTE(l.startMarketCap()) {
+		return errorsmod.Wrapf(
+			ErrInvalidTokenLaunch,
+			"graduation market cap (%s) of denom (%s) must be above the starting market cap (%s)",
+			l.GraduationMarketCap,
+			l.Denom,
+			l.startMarketCap(),
+		)
+	}
+
+	return nil
+}
+
+// Graduated tells if the launch has seeded its dex pool, which closes the curve
+func (l TokenLaunch) Graduated() bool {
+	return l.PoolId != ""
+}
+
+// curveReserves returns the uzig reserve of the curve, virtual reserve included, and its token reserve
+func (l TokenLaunch) curveReserves() (math.Int, math.Int) {
+	return l.VirtualReserve.Add(l.Raised), l.CurveSupply.Sub(l.Sold)
+}
+
+// curveProduct returns the constant product of the curve, the product of its starting reserves
+func (l TokenLaunch) curveProduct() math.Int {
+	return l.VirtualReserve.Mul(l.CurveSupply)
+}
+
+// startMarketCap returns the market cap of the launch before any buy
+func (l TokenLaunch) startMarketCap() math.Int {
+	return l.VirtualReserve.Mul(l.Supply).Quo(l.CurveSupply)
+}
+
+// BuyAmount returns the tokens bought with an amount of uzig, rounded down in favour of the curve
+func (l TokenLaunch) BuyAmount(incoming math.Int) math.Int {
+	zig, tokens := l.curveReserves()
+	return tokens.Sub(quoCeil(l.curveProduct(), zig.Add(incoming)))
+}
+
+// SellAmount returns the uzig received for an amount of tokens, rounded down in favour of the curve
+func (l TokenLaunch) SellAmount(incoming math.Int) math.Int {
+	zig, tokens := l.curveReserves()
+	return zig.Sub(quoCeil(l.curveProduct(), tokens.Add(incoming)))
+}
+
+// Price returns the current price of a token on the curve in uzig
+func (l TokenLaunch) Price() math.LegacyDec {
+	zig, tokens := l.curveReserves()
+	return math.LegacyNewDecFromInt(zig).QuoInt(tokens)
+}
+
+// MarketCap returns the current market cap of the launch in uzig, the price of the whole supply
+func (l TokenLaunch) MarketCap() math.Int {
+	zig, tokens := l.curveReserves()
+	return zig.Mul(l.Supply).Quo(tokens)
+}
+
+// PoolSeed returns the tokens pooled with an amount of uzig at the current price of the curve, rounded down,
+// so the dex pool opens at the price the curve closed at
+func (l TokenLaunch) PoolSeed(zigAmount math.Int) math.Int {
+	zig, tokens := l.curveReserves()
+	return zigAmount.Mul(tokens).Quo(zig)
+}
+
+// quoCeil divides a by b rounding up
+func quoCeil(a math.Int, b math.Int) math.Int {
+	quo := a.Quo(b)
+	if !quo.Mul(b).Equal(a) {
+		quo = quo.AddRaw(1)
+	}
+
+	return quo
+}
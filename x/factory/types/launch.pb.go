@@ -0,0 +1,734 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zigchain/factory/launch.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TokenLaunch is a denom sold against uzig on a bonding curve by the
+// launchpad, until its market cap reaches the graduation market cap and the
+// raised uzig seeds a dex pool. The curve is a constant product of the uzig
+// raised, on top of a virtual uzig reserve, and of the tokens left on the
+// curve.
+type TokenLaunch struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// supply is the fixed supply of the denom, minted into the launchpad escrow
+	Supply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+	// curve_supply is the part of the supply sold on the curve, the rest seeds
+	// the dex pool
+	CurveSupply cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=curve_supply,json=curveSupply,proto3,customtype=cosmossdk.io/math.Int" json:"curve_supply"`
+	// virtual_reserve is the virtual uzig reserve of the curve, setting its
+	// starting price
+	VirtualReserve cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=virtual_reserve,json=virtualReserve,proto3,customtype=cosmossdk.io/math.Int" json:"virtual_reserve"`
+	// graduation_market_cap is the market cap in uzig at which the launch
+	// graduates into a dex pool
+	GraduationMarketCap cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=graduation_market_cap,json=graduationMarketCap,proto3,customtype=cosmossdk.io/math.Int" json:"graduation_market_cap"`
+	// sold is the amount of tokens held by the buyers
+	Sold cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=sold,proto3,customtype=cosmossdk.io/math.Int" json:"sold"`
+	// raised is the amount of uzig paid by the buyers, held by the launchpad
+	// escrow
+	Raised cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=raised,proto3,customtype=cosmossdk.io/math.Int" json:"raised"`
+	// pool_id is the dex pool seeded by the launch once it has graduated, the
+	// curve is closed from then on
+	PoolId string `protobuf:"bytes,9,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *TokenLaunch) Reset()         { *m = TokenLaunch{} }
+func (m *TokenLaunch) String() string { return proto.CompactTextString(m) }
+func (*TokenLaunch) ProtoMessage()    {}
+func (*TokenLaunch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03e91fd36d3eda8a, []int{0}
+}
+func (m *TokenLaunch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenLaunch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenLaunch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenLaunch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenLaunch.Merge(m, src)
+}
+func (m *TokenLaunch) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenLaunch) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenLaunch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenLaunch proto.InternalMessageInfo
+
+func (m *TokenLaunch) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TokenLaunch) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *TokenLaunch) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*TokenLaunch)(nil), "zigchain.factory.TokenLaunch")
+}
+
+func init() { proto.RegisterFile("zigchain/factory/launch.proto", fileDescriptor_03e91fd36d3eda8a) }
+
+var fileDescriptor_03e91fd36d3eda8a = []byte{
+	// 396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0x8e, 0xd3, 0x30,
+	0x10, 0xc6, 0x13, 0xe8, 0xa6, 0xac, 0x17, 0xf1, 0xc7, 0x74, 0x85, 0x59, 0x89, 0x2c, 0xe2, 0x84,
+	0x90, 0x36, 0x41, 0xcb, 0x13, 0x50, 0x38, 0x50, 0x09, 0x2e, 0x29, 0x17, 0xb8, 0x44, 0x26, 0x36,
+	0xa9, 0xd5, 0xc4, 0x13, 0xd9, 0x4e, 0x45, 0x79, 0x0a, 0x1e, 0x83, 0x23, 0x87, 0x3e, 0x44, 0x8f,
+	0x55, 0x4f, 0x88, 0x43, 0x55, 0xb5, 0x07, 0x5e, 0x03, 0xc5, 0x4e, 0xbb, 0xf7, 0x5c, 0x2c, 0xcf,
+	0x7c, 0xf3, 0xfd, 0xe6, 0x30, 0x1f, 0x7a, 0xfa, 0x43, 0xe4, 0xd9, 0x84, 0x0a, 0x19, 0x7f, 0xa3,
+	0x99, 0x01, 0x35, 0x8f, 0x0b, 0x5a, 0xcb, 0x6c, 0x12, 0x55, 0x0a, 0x0c, 0xe0, 0x07, 0x07, 0x39,
+	0x6a, 0xe5, 0x8b, 0x41, 0x0e, 0x39, 0x58, 0x31, 0x6e, 0x7e, 0x6e, 0xee, 0xe2, 0x49, 0x06, 0xba,
+	0x04, 0x9d, 0x3a, 0xc1, 0x15, 0xad, 0xf4, 0x90, 0x96, 0x42, 0x42, 0x6c, 0x5f, 0xd7, 0x7a, 0xbe,
+	0xed, 0xa1, 0xb3, 0x4f, 0x30, 0xe5, 0xf2, 0x83, 0xdd, 0x85, 0x07, 0xe8, 0x84, 0x71, 0x09, 0x25,
+	0xf1, 0x9f, 0xf9, 0x2f, 0x4e, 0x13, 0x57, 0xe0, 0x6b, 0xd4, 0xcf, 0x14, 0xa7, 0x06, 0x14, 0xb9,
+	0xd5, 0xf4, 0x87, 0x64, 0xbd, 0xb8, 0x1a, 0xb4, 0xec, 0x37, 0x8c, 0x29, 0xae, 0xf5, 0xd8, 0x28,
+	0x21, 0xf3, 0xe4, 0x30, 0x88, 0xdf, 0xa3, 0x40, 0xd7, 0x55, 0x55, 0xcc, 0xc9, 0x6d, 0x6b, 0x79,
+	0xb5, 0xdc, 0x5c, 0x7a, 0x7f, 0x37, 0x97, 0xe7, 0xce, 0xa6, 0xd9, 0x34, 0x12, 0x10, 0x97, 0xd4,
+	0x4c, 0xa2, 0x91, 0x34, 0xeb, 0xc5, 0x15, 0x6a, 0x79, 0x23, 0x69, 0x7e, 0xfd, 0xfb, 0xfd, 0xd2,
+	0x4f, 0x5a, 0x3f, 0x1e, 0xa3, 0xbb, 0x59, 0xad, 0x66, 0x3c, 0x6d, 0x79, 0xbd, 0x8e, 0xbc, 0x33,
+	0x4b, 0x19, 0x3b, 0xe8, 0x67, 0x74, 0x7f, 0x26, 0x94, 0xa9, 0x69, 0x91, 0x2a, 0xae, 0xb9, 0x9a,
+	0x71, 0x72, 0xd2, 0x91, 0x7b, 0xaf, 0x05, 0x25, 0x8e, 0x83, 0x19, 0x3a, 0xcf, 0x15, 0x65, 0x35,
+	0x35, 0x02, 0x64, 0x5a, 0x52, 0x35, 0xe5, 0x26, 0xcd, 0x68, 0x45, 0x82, 0x8e, 0x0b, 0x1e, 0xdd,
+	0xe0, 0x3e, 0x5a, 0xda, 0x5b, 0x5a, 0xe1, 0x77, 0xa8, 0xa7, 0xa1, 0x60, 0xa4, 0xdf, 0x11, 0x6a,
+	0xdd, 0xcd, 0x95, 0x14, 0x15, 0x9a, 0x33, 0x72, 0xa7, 0xeb, 0x95, 0x9c, 0x1f, 0x3f, 0x46, 0xfd,
+	0x0a, 0xa0, 0x48, 0x05, 0x23, 0xa7, 0x36, 0x3b, 0x41, 0x53, 0x8e, 0xd8, 0xf0, 0x7a, 0xb9, 0x0b,
+	0xfd, 0xd5, 0x2e, 0xf4, 0xb7, 0xbb, 0xd0, 0xff, 0xb9, 0x0f, 0xbd, 0xd5, 0x3e, 0xf4, 0xfe, 0xec,
+	0x43, 0xef, 0x0b, 0x39, 0x26, 0xfe, 0xfb, 0x31, 0xf3, 0x66, 0x5e, 0x71, 0xfd, 0x35, 0xb0, 0xe9,
+	0x7c, 0xfd, 0x7f, 0x00, 0xe2, 0x46, 0xe6, 0xa7, 0x14, 0x03, 0x00, 0x00,
+}
+
+func (m *TokenLaunch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenLaunch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenLaunch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolId) > 0 {
+		i -= len(m.PoolId)
+		copy(dAtA[i:], m.PoolId)
+		i = encodeVarintLaunch(dAtA, i, uint64(len(m.PoolId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size := m.Raised.Size()
+		i -= size
+		if _, err := m.Raised.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLaunch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Sold.Size()
+		i -= size
+		if _, err := m.Sold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLaunch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.GraduationMarketCap.Size()
+		i -= size
+		if _, err := m.GraduationMarketCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLaunch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.VirtualReserve.Size()
+		i -= size
+		if _, err := m.VirtualReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLaunch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CurveSupply.Size()
+		i -= size
+		if _, err := m.CurveSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLaunch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLaunch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintLaunch(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLaunch(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLaunch(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLaunch(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenLaunch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLaunch(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovLaunch(uint64(l))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovLaunch(uint64(l))
+	l = m.CurveSupply.Size()
+	n += 1 + l + sovLaunch(uint64(l))
+	l = m.VirtualReserve.Size()
+	n += 1 + l + sovLaunch(uint64(l))
+	l = m.GraduationMarketCap.Size()
+	n += 1 + l + sovLaunch(uint64(l))
+	l = m.Sold.Size()
+	n += 1 + l + sovLaunch(uint64(l))
+	l = m.Raised.Size()
+	n += 1 + l + sovLaunch(uint64(l))
+	l = len(m.PoolId)
+	if l > 0 {
+		n += 1 + l + sovLaunch(uint64(l))
+	}
+	return n
+}
+
+func sovLaunch(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLaunch(x uint64) (n int) {
+	return sovLaunch(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenLaunch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLaunch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenLaunch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenLaunch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurveSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurveSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VirtualReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraduationMarketCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GraduationMarketCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raised", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Raised.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLaunch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLaunch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLaunch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLaunch(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLaunch
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLaunch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLaunch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLaunch
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLaunch
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLaunch
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLaunch        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLaunch          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLaunch = fmt.Errorf("proto: unexpected end of group")
+)
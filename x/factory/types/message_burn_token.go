@@ -22,6 +22,7 @@ func NewMsgBurnTokens(signer string, token sdk.Coin) *MsgBurnTokens {
 // - The signer's address format
 // - The token's denomination
 // - The token's amount (ensuring it's positive)
+// - The address the tokens are burned from, when it is not the signer
 //
 // Returns an error if any validation check fails, otherwise returns nil.
 func (msg *MsgBurnTokens) ValidateBasic() error {
@@ -37,5 +38,11 @@ func (msg *MsgBurnTokens) ValidateBasic() error {
 		return err
 	}
 
+	if msg.FromAddress != "" {
+		if err := validators.AddressCheck("From", msg.FromAddress); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
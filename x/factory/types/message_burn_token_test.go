@@ -80,3 +80,13 @@ func TestMsgBurnTokens_ValidateBasic_InvalidToken(t *testing.T) {
 		sdkerrors.ErrInvalidCoins,
 	)
 }
+
+func TestMsgBurnTokens_ValidateBasic_InvalidFromAddress(t *testing.T) {
+	// Test case: invalid address to burn the tokens from
+
+	// make a copy of sample message
+	msg := MsgBurnTokensSample
+	msg.FromAddress = "zig1invalid"
+
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+}
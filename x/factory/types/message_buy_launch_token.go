@@ -0,0 +1,55 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/constants"
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgBuyLaunchToken{}
+
+func NewMsgBuyLaunchToken(signer string, denom string, incoming sdk.Coin, outgoingMin math.Int) *MsgBuyLaunchToken {
+	return &MsgBuyLaunchToken{
+		Signer:      signer,
+		Denom:       denom,
+		Incoming:    incoming,
+		OutgoingMin: outgoingMin,
+	}
+}
+
+func (msg *MsgBuyLaunchToken) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenom, "(%s) : %s", msg.Denom, err)
+	}
+
+	if msg.Incoming.Denom != constants.BondDenom {
+		return errorsmod.Wrapf(
+			ErrInvalidTokenLaunch,
+			"launches are bought with %s, got %s",
+			constants.BondDenom,
+			msg.Incoming.Denom,
+		)
+	}
+
+	if err := validators.CheckCoinAmount(msg.Incoming, false); err != nil {
+		return err
+	}
+
+	return validateOutgoingMin(msg.OutgoingMin)
+}
+
+// validateOutgoingMin validates the minimum outgoing amount of a launch trade, it can be left unset
+func validateOutgoingMin(outgoingMin math.Int) error {
+	if !outgoingMin.IsNil() && outgoingMin.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidTokenLaunch, "outgoing min (%s) can not be negative", outgoingMin)
+	}
+
+	return nil
+}
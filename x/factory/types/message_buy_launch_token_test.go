@@ -0,0 +1,101 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+	"zigchain/zutils/constants"
+)
+
+var launchDenomSample = "coin" + FactoryDenomDelimiterChar + sample.AccAddress() + FactoryDenomDelimiterChar + "abc"
+
+var MsgBuyLaunchTokenSample = MsgBuyLaunchToken{
+	Signer:      sample.AccAddress(),
+	Denom:       launchDenomSample,
+	Incoming:    sdk.NewInt64Coin(constants.BondDenom, 1000),
+	OutgoingMin: math.NewInt(10),
+}
+
+// Positive test cases
+
+func TestMsgBuyLaunchToken_NewMsgBuyLaunchToken_Positive(t *testing.T) {
+	// Test case: buy a launch with valid input data
+
+	signer := sample.AccAddress()
+	incoming := sdk.NewInt64Coin(constants.BondDenom, 1000)
+
+	msg := NewMsgBuyLaunchToken(signer, launchDenomSample, incoming, math.NewInt(10))
+
+	require.Equal(t, signer, msg.Signer)
+	require.Equal(t, launchDenomSample, msg.Denom)
+	require.Equal(t, incoming, msg.Incoming)
+	require.Equal(t, math.NewInt(10), msg.OutgoingMin)
+}
+
+func TestMsgBuyLaunchToken_ValidateBasic_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgBuyLaunchToken, the outgoing min can be left unset
+
+	msg := MsgBuyLaunchTokenSample
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.OutgoingMin = math.Int{}
+	require.NoError(t, msg.ValidateBasic())
+}
+
+// Negative test cases
+
+func TestMsgBuyLaunchToken_ValidateBasic_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgBuyLaunchTokenSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgBuyLaunchToken_ValidateBasic_InvalidDenom(t *testing.T) {
+	// Test case: only factory denoms are launched
+
+	for _, denom := range []string{"", constants.BondDenom, "coin.abc", "coin." + sample.AccAddress() + "."} {
+		msg := MsgBuyLaunchTokenSample
+		msg.Denom = denom
+
+		require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidDenom, denom)
+	}
+}
+
+func TestMsgBuyLaunchToken_ValidateBasic_InvalidIncoming(t *testing.T) {
+	// Test case: the launch is bought with a positive amount of uzig
+
+	msg := MsgBuyLaunchTokenSample
+	msg.Incoming = sdk.NewInt64Coin("uatom", 1000)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidTokenLaunch)
+
+	for _, amount := range []math.Int{math.ZeroInt(), math.NewInt(-1), {}} {
+		msg := MsgBuyLaunchTokenSample
+		msg.Incoming = sdk.Coin{Denom: constants.BondDenom, Amount: amount}
+
+		require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidCoins)
+	}
+}
+
+func TestMsgBuyLaunchToken_ValidateBasic_NegativeOutgoingMin(t *testing.T) {
+	// Test case: the outgoing min can not be negative
+
+	msg := MsgBuyLaunchTokenSample
+	msg.OutgoingMin = math.NewInt(-1)
+
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidTokenLaunch)
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgCancelDenomAdminProposal{}
+
+func NewMsgCancelDenomAdminProposal(signer string, denom string) *MsgCancelDenomAdminProposal {
+	return &MsgCancelDenomAdminProposal{
+		Signer: signer,
+		Denom:  denom,
+	}
+}
+
+func (msg *MsgCancelDenomAdminProposal) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			msg.Denom,
+			err,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgClaimAirdrop{}
+
+func NewMsgClaimAirdrop(signer string, airdropID uint64, amount math.Uint, proof []string) *MsgClaimAirdrop {
+	return &MsgClaimAirdrop{
+		Signer:    signer,
+		AirdropId: airdropID,
+		Amount:    amount,
+		Proof:     proof,
+	}
+}
+
+func (msg *MsgClaimAirdrop) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	if msg.Amount.IsNil() || msg.Amount.IsZero() {
+		return errorsmod.Wrap(ErrInvalidAirdrop, "claim amount must be greater than 0")
+	}
+
+	if len(msg.Proof) > MaxMerkleProofLength {
+		return errorsmod.Wrapf(
+			ErrInvalidMerkleProof,
+			"proof has %d hashes, the maximum is %d",
+			len(msg.Proof),
+			MaxMerkleProofLength,
+		)
+	}
+
+	for _, node := range msg.Proof {
+		if _, err := decodeMerkleHash(node); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
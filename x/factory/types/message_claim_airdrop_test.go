@@ -0,0 +1,116 @@
+package types
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+)
+
+var MsgClaimAirdropSample = MsgClaimAirdrop{
+	Signer:    sample.AccAddress(),
+	AirdropId: 0,
+	Amount:    math.NewUint(100),
+	Proof:     []string{strings.Repeat("ab", 32), strings.Repeat("cd", 32)},
+}
+
+// Positive test cases
+
+func TestMsgClaimAirdrop_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgClaimAirdrop
+
+	msg := MsgClaimAirdropSample
+
+	require.NoError(t, msg.ValidateBasic())
+}
+
+func TestVerifyAirdropProof(t *testing.T) {
+	// Test case: a two leaves tree, the proof of each leaf is the other leaf
+
+	first, second := sample.AccAddress(), sample.AccAddress()
+	firstLeaf := AirdropLeaf(first, math.NewUint(100))
+	secondLeaf := AirdropLeaf(second, math.NewUint(200))
+	root := hex.EncodeToString(HashAirdropPair(firstLeaf, secondLeaf))
+
+	valid, err := VerifyAirdropProof(root, first, math.NewUint(100), []string{hex.EncodeToString(secondLeaf)})
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	valid, err = VerifyAirdropProof(root, second, math.NewUint(200), []string{hex.EncodeToString(firstLeaf)})
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	// another amount does not match the root
+	valid, err = VerifyAirdropProof(root, first, math.NewUint(200), []string{hex.EncodeToString(secondLeaf)})
+	require.NoError(t, err)
+	require.False(t, valid)
+
+	_, err = VerifyAirdropProof(root, first, math.NewUint(100), []string{"invalid"})
+	require.ErrorIs(t, err, ErrInvalidMerkleProof)
+}
+
+// Negative test cases
+
+func TestMsgClaimAirdrop_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgClaimAirdropSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgClaimAirdrop_ZeroAmount(t *testing.T) {
+	// Test case: the claimed amount must be positive
+
+	msg := MsgClaimAirdropSample
+	msg.Amount = math.ZeroUint()
+
+	err := msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidAirdrop)
+}
+
+func TestMsgClaimAirdrop_InvalidProof(t *testing.T) {
+	// Test case: the proof must be a bounded list of hex encoded sha256 hashes
+
+	tests := []struct {
+		name  string
+		proof []string
+	}{
+		{
+			name:  "not hex",
+			proof: []string{strings.Repeat("zz", 32)},
+		},
+		{
+			name:  "too short hash",
+			proof: []string{strings.Repeat("ab", 16)},
+		},
+		{
+			name:  "too long proof",
+			proof: make([]string, MaxMerkleProofLength+1),
+		},
+	}
+
+	msg := MsgClaimAirdropSample
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg.Proof = test.proof
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, ErrInvalidMerkleProof)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgClaimRewards{}
+
+func NewMsgClaimRewards(signer string, denom string) *MsgClaimRewards {
+	return &MsgClaimRewards{
+		Signer: signer,
+		Denom:  denom,
+	}
+}
+
+func (msg *MsgClaimRewards) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			msg.Denom,
+			err,
+		)
+	}
+
+	return nil
+}
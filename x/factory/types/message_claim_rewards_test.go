@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+)
+
+var MsgClaimRewardsSample = MsgClaimRewards{
+	Signer: sample.AccAddress(),
+	Denom:  "coin" + FactoryDenomDelimiterChar + sample.AccAddress() + FactoryDenomDelimiterChar + "abc",
+}
+
+// Positive test cases
+
+func TestMsgClaimRewards_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgClaimRewards
+
+	msg := MsgClaimRewardsSample
+
+	require.NoError(t, msg.ValidateBasic())
+}
+
+// Negative test cases
+
+func TestMsgClaimRewards_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgClaimRewardsSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgClaimRewards_InvalidDenom(t *testing.T) {
+	// Test case: invalid denom
+
+	msg := MsgClaimRewardsSample
+	msg.Denom = "uzig"
+
+	err := msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidDenom)
+}
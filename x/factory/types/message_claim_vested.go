@@ -0,0 +1,36 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgClaimVested{}
+
+func NewMsgClaimVested(signer string, denom string, id uint64) *MsgClaimVested {
+	return &MsgClaimVested{
+		Signer: signer,
+		Denom:  denom,
+		Id:     id,
+	}
+}
+
+func (msg *MsgClaimVested) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			msg.Denom,
+			err,
+		)
+	}
+
+	return nil
+}
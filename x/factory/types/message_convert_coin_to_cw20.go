@@ -0,0 +1,39 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgConvertCoinToCW20{}
+
+func NewMsgConvertCoinToCW20(signer string, coin sdk.Coin) *MsgConvertCoinToCW20 {
+	return &MsgConvertCoinToCW20{
+		Signer: signer,
+		Coin:   coin,
+	}
+}
+
+func (msg *MsgConvertCoinToCW20) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(msg.Coin.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			msg.Coin.Denom,
+			err,
+		)
+	}
+
+	if err := validators.CheckCoinAmount(msg.Coin, false); err != nil {
+		return err
+	}
+
+	return nil
+}
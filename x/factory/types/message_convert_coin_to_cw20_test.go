@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+)
+
+var MsgConvertCoinToCW20Sample = MsgConvertCoinToCW20{
+	Signer: sample.AccAddress(),
+	Coin:   sdk.NewCoin("coin"+FactoryDenomDelimiterChar+sample.AccAddress()+FactoryDenomDelimiterChar+"tkn", math.NewInt(1000)),
+}
+
+// Positive test cases
+
+func TestMsgConvertCoinToCW20_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgConvertCoinToCW20
+
+	msg := MsgConvertCoinToCW20Sample
+
+	require.NoError(t, msg.ValidateBasic())
+}
+
+// Negative test cases
+
+func TestMsgConvertCoinToCW20_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgConvertCoinToCW20Sample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgConvertCoinToCW20_InvalidDenom(t *testing.T) {
+	// Test case: only factory denoms can be converted
+
+	msg := MsgConvertCoinToCW20Sample
+	msg.Coin = sdk.NewCoin("uzig", math.NewInt(1000))
+
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidDenom)
+}
+
+func TestMsgConvertCoinToCW20_InvalidAmount(t *testing.T) {
+	// Test case: the amount must be positive
+
+	msg := MsgConvertCoinToCW20Sample
+	msg.Coin.Amount = math.ZeroInt()
+
+	require.Error(t, msg.ValidateBasic())
+}
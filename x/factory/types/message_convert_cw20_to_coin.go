@@ -0,0 +1,41 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgConvertCW20ToCoin{}
+
+func NewMsgConvertCW20ToCoin(signer string, contractAddress string, amount sdkmath.Uint) *MsgConvertCW20ToCoin {
+	return &MsgConvertCW20ToCoin{
+		Signer:          signer,
+		ContractAddress: contractAddress,
+		Amount:          amount,
+	}
+}
+
+func (msg *MsgConvertCW20ToCoin) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	if err := validators.AddressCheck("contract", msg.ContractAddress); err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidContractAddress,
+			"(%s) : %s",
+			msg.ContractAddress,
+			err,
+		)
+	}
+
+	if msg.Amount.IsNil() || msg.Amount.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+
+	return nil
+}
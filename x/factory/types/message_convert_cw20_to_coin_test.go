@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+)
+
+var MsgConvertCW20ToCoinSample = MsgConvertCW20ToCoin{
+	Signer:          sample.AccAddress(),
+	ContractAddress: sample.AccAddress(),
+	Amount:          math.NewUint(1000),
+}
+
+// Positive test cases
+
+func TestMsgConvertCW20ToCoin_NewMsgConvertCW20ToCoin_Positive(t *testing.T) {
+	// Test case: create a new MsgConvertCW20ToCoin with valid input data
+
+	signer := sample.AccAddress()
+	contractAddress := sample.AccAddress()
+
+	msg := NewMsgConvertCW20ToCoin(signer, contractAddress, math.NewUint(1000))
+
+	require.Equal(t, signer, msg.Signer)
+	require.Equal(t, contractAddress, msg.ContractAddress)
+	require.Equal(t, math.NewUint(1000), msg.Amount)
+}
+
+func TestMsgConvertCW20ToCoin_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgConvertCW20ToCoin
+
+	msg := MsgConvertCW20ToCoinSample
+
+	require.NoError(t, msg.ValidateBasic())
+}
+
+// Negative test cases
+
+func TestMsgConvertCW20ToCoin_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgConvertCW20ToCoinSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgConvertCW20ToCoin_InvalidContractAddress(t *testing.T) {
+	// Test case: invalid contract address
+
+	msg := MsgConvertCW20ToCoinSample
+	msg.ContractAddress = "invalid_address"
+
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidContractAddress)
+}
+
+func TestMsgConvertCW20ToCoin_InvalidAmount(t *testing.T) {
+	// Test case: the amount must be positive
+
+	msg := MsgConvertCW20ToCoinSample
+	msg.Amount = math.ZeroUint()
+
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidCoins)
+
+	msg.Amount = math.Uint{}
+
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidCoins)
+}
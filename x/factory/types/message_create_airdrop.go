@@ -0,0 +1,50 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgCreateAirdrop{}
+
+func NewMsgCreateAirdrop(
+	signer string,
+	token sdk.Coin,
+	merkleRoot string,
+	expiryTime int64,
+	burnUnclaimed bool,
+) *MsgCreateAirdrop {
+	return &MsgCreateAirdrop{
+		Signer:        signer,
+		Token:         token,
+		MerkleRoot:    merkleRoot,
+		ExpiryTime:    expiryTime,
+		BurnUnclaimed: burnUnclaimed,
+	}
+}
+
+func (msg *MsgCreateAirdrop) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	if err := validators.CheckCoinDenom(msg.Token); err != nil {
+		return err
+	}
+
+	if err := validators.CheckCoinAmount(msg.Token, false); err != nil {
+		return err
+	}
+
+	if err := ValidateMerkleRoot(msg.MerkleRoot); err != nil {
+		return err
+	}
+
+	if msg.ExpiryTime <= 0 {
+		return errorsmod.Wrapf(ErrInvalidAirdrop, "expiry time %d must be positive", msg.ExpiryTime)
+	}
+
+	return nil
+}
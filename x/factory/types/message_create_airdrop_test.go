@@ -0,0 +1,91 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+)
+
+var MsgCreateAirdropSample = MsgCreateAirdrop{
+	Signer:     sample.AccAddress(),
+	Token:      sdk.NewCoin("coin"+FactoryDenomDelimiterChar+sample.AccAddress()+FactoryDenomDelimiterChar+"abc", math.NewInt(1000)),
+	MerkleRoot: strings.Repeat("ab", 32),
+	ExpiryTime: 1_000_000,
+}
+
+// Positive test cases
+
+func TestMsgCreateAirdrop_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgCreateAirdrop
+
+	msg := MsgCreateAirdropSample
+
+	require.NoError(t, msg.ValidateBasic())
+}
+
+// Negative test cases
+
+func TestMsgCreateAirdrop_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgCreateAirdropSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgCreateAirdrop_InvalidMerkleRoot(t *testing.T) {
+	// Test case: the merkle root must be a hex encoded sha256 hash
+
+	tests := []struct {
+		name       string
+		merkleRoot string
+	}{
+		{
+			name:       "empty",
+			merkleRoot: "",
+		},
+		{
+			name:       "not hex",
+			merkleRoot: strings.Repeat("zz", 32),
+		},
+		{
+			name:       "too short",
+			merkleRoot: strings.Repeat("ab", 31),
+		},
+	}
+
+	msg := MsgCreateAirdropSample
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg.MerkleRoot = test.merkleRoot
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, ErrInvalidAirdrop)
+		})
+	}
+}
+
+func TestMsgCreateAirdrop_InvalidExpiryTime(t *testing.T) {
+	// Test case: the expiry time must be positive
+
+	msg := MsgCreateAirdropSample
+	msg.ExpiryTime = 0
+
+	err := msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidAirdrop)
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgDistributeRewards{}
+
+func NewMsgDistributeRewards(signer string, denom string, reward sdk.Coin) *MsgDistributeRewards {
+	return &MsgDistributeRewards{
+		Signer: signer,
+		Denom:  denom,
+		Reward: reward,
+	}
+}
+
+func (msg *MsgDistributeRewards) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			msg.Denom,
+			err,
+		)
+	}
+
+	if err := validators.CheckCoinDenom(msg.Reward); err != nil {
+		return err
+	}
+
+	if err := validators.CheckCoinAmount(msg.Reward, false); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+)
+
+var MsgDistributeRewardsSample = MsgDistributeRewards{
+	Signer: sample.AccAddress(),
+	Denom:  "coin" + FactoryDenomDelimiterChar + sample.AccAddress() + FactoryDenomDelimiterChar + "abc",
+	Reward: sdk.NewCoin("uzig", math.NewInt(1000)),
+}
+
+// Positive test cases
+
+func TestMsgDistributeRewards_NewMsgDistributeRewards_Positive(t *testing.T) {
+	// Test case: create a new MsgDistributeRewards with valid input data
+
+	signer := sample.AccAddress()
+	denom := "coin" + FactoryDenomDelimiterChar + sample.AccAddress() + FactoryDenomDelimiterChar + "abc"
+	reward := sdk.NewCoin("uzig", math.NewInt(1000))
+
+	msg := NewMsgDistributeRewards(signer, denom, reward)
+
+	require.Equal(t, signer, msg.Signer)
+	require.Equal(t, denom, msg.Denom)
+	require.Equal(t, reward, msg.Reward)
+}
+
+func TestMsgDistributeRewards_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgDistributeRewards
+
+	msg := MsgDistributeRewardsSample
+
+	require.NoError(t, msg.ValidateBasic())
+}
+
+// Negative test cases
+
+func TestMsgDistributeRewards_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgDistributeRewardsSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgDistributeRewards_InvalidDenom(t *testing.T) {
+	// Test case: invalid denom
+
+	msg := MsgDistributeRewardsSample
+	msg.Denom = "uzig"
+
+	err := msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidDenom)
+}
+
+func TestMsgDistributeRewards_InvalidReward(t *testing.T) {
+	// Test case: the reward needs a valid denom and a positive amount
+
+	tests := []struct {
+		name   string
+		reward sdk.Coin
+	}{
+		{
+			name:   "invalid denom",
+			reward: sdk.Coin{Denom: "1", Amount: math.NewInt(1000)},
+		},
+		{
+			name:   "zero amount",
+			reward: sdk.Coin{Denom: "uzig", Amount: math.ZeroInt()},
+		},
+		{
+			name:   "nil amount",
+			reward: sdk.Coin{Denom: "uzig"},
+		},
+	}
+
+	msg := MsgDistributeRewardsSample
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg.Reward = test.reward
+
+			require.Error(t, msg.ValidateBasic())
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgForceTransfer{}
+
+func NewMsgForceTransfer(
+	signer string,
+	token sdk.Coin,
+	fromAddress string,
+	toAddress string,
+) *MsgForceTransfer {
+	return &MsgForceTransfer{
+		Signer:      signer,
+		Token:       token,
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+	}
+}
+
+func (msg *MsgForceTransfer) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	if err := validators.CheckCoinDenom(msg.Token); err != nil {
+		return err
+	}
+
+	if err := validators.CheckCoinAmount(msg.Token, false); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(msg.Token.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			msg.Token.Denom,
+			err,
+		)
+	}
+
+	if err := validators.AddressCheck("From", msg.FromAddress); err != nil {
+		return err
+	}
+
+	if err := validators.AddressCheck("To", msg.ToAddress); err != nil {
+		return err
+	}
+
+	if msg.FromAddress == msg.ToAddress {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"from and to addresses are the same (%s)",
+			msg.FromAddress,
+		)
+	}
+
+	return nil
+}
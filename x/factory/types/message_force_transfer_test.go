@@ -0,0 +1,93 @@
+package types
+
+import (
+	"testing"
+
+	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+)
+
+var MsgForceTransferSample = MsgForceTransfer{
+	Signer:      sample.AccAddress(),
+	Token:       sdk.NewCoin("coin"+FactoryDenomDelimiterChar+sample.AccAddress()+FactoryDenomDelimiterChar+"abc", cosmosmath.NewInt(10)),
+	FromAddress: sample.AccAddress(),
+	ToAddress:   sample.AccAddress(),
+}
+
+// Positive test cases
+
+func TestMsgForceTransfer_NewMsgForceTransfer_Positive(t *testing.T) {
+	// Test case: create a new MsgForceTransfer with valid input data
+
+	msg := NewMsgForceTransfer(
+		MsgForceTransferSample.Signer,
+		MsgForceTransferSample.Token,
+		MsgForceTransferSample.FromAddress,
+		MsgForceTransferSample.ToAddress,
+	)
+
+	require.Equal(t, &MsgForceTransferSample, msg)
+}
+
+func TestMsgForceTransfer_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgForceTransfer and MsgDisableForceTransfer
+
+	msg := MsgForceTransferSample
+	require.NoError(t, msg.ValidateBasic())
+
+	disable := NewMsgDisableForceTransfer(msg.Signer, msg.Token.Denom)
+	require.NoError(t, disable.ValidateBasic())
+}
+
+// Negative test cases
+
+func TestMsgForceTransfer_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgForceTransferSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgForceTransfer_InvalidAddresses(t *testing.T) {
+	// Test case: invalid from and to addresses
+
+	msg := MsgForceTransferSample
+	msg.FromAddress = "invalid_address"
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+
+	msg = MsgForceTransferSample
+	msg.ToAddress = ""
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+
+	msg = MsgForceTransferSample
+	msg.ToAddress = msg.FromAddress
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidRequest)
+}
+
+func TestMsgForceTransfer_InvalidToken(t *testing.T) {
+	// Test case: only positive amounts of factory denoms can be force transferred
+
+	msg := MsgForceTransferSample
+	msg.Token = sdk.NewCoin("uzig", cosmosmath.NewInt(10))
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidDenom)
+
+	msg = MsgForceTransferSample
+	msg.Token.Amount = cosmosmath.ZeroInt()
+	require.Error(t, msg.ValidateBasic())
+
+	disable := NewMsgDisableForceTransfer(msg.Signer, "uzig")
+	require.ErrorIs(t, disable.ValidateBasic(), ErrInvalidDenom)
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+)
+
+var MsgFreezeAccountSample = MsgFreezeAccount{
+	Signer:  sample.AccAddress(),
+	Denom:   "coin" + FactoryDenomDelimiterChar + sample.AccAddress() + FactoryDenomDelimiterChar + "abc",
+	Address: sample.AccAddress(),
+}
+
+// Positive test cases
+
+func TestMsgFreezeAccount_NewMsgFreezeAccount_Positive(t *testing.T) {
+	// Test case: create a new MsgFreezeAccount with valid input data
+
+	signer := sample.AccAddress()
+	denom := "coin" + FactoryDenomDelimiterChar + sample.AccAddress() + FactoryDenomDelimiterChar + "abc"
+	address := sample.AccAddress()
+
+	msg := NewMsgFreezeAccount(signer, denom, address)
+
+	require.Equal(t, signer, msg.Signer)
+	require.Equal(t, denom, msg.Denom)
+	require.Equal(t, address, msg.Address)
+}
+
+func TestMsgFreezeAccount_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgFreezeAccount and MsgUnfreezeAccount
+
+	msg := MsgFreezeAccountSample
+	require.NoError(t, msg.ValidateBasic())
+
+	unfreeze := NewMsgUnfreezeAccount(msg.Signer, msg.Denom, msg.Address)
+	require.NoError(t, unfreeze.ValidateBasic())
+}
+
+func TestMsgSetDenomGlobalFreeze_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgSetDenomGlobalFreeze
+
+	msg := NewMsgSetDenomGlobalFreeze(MsgFreezeAccountSample.Signer, MsgFreezeAccountSample.Denom, true)
+	require.NoError(t, msg.ValidateBasic())
+}
+
+// Negative test cases
+
+func TestMsgFreezeAccount_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgFreezeAccountSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgFreezeAccount_InvalidAddress(t *testing.T) {
+	// Test case: invalid address of the account to freeze
+
+	msg := MsgFreezeAccountSample
+
+	msg.Address = ""
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+
+	msg.Address = "invalid_address"
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+}
+
+func TestMsgFreezeAccount_InvalidDenom(t *testing.T) {
+	// Test case: only factory denoms can be frozen
+
+	msg := MsgFreezeAccountSample
+	msg.Denom = "uzig"
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidDenom)
+
+	unfreeze := NewMsgUnfreezeAccount(msg.Signer, msg.Denom, msg.Address)
+	require.ErrorIs(t, unfreeze.ValidateBasic(), ErrInvalidDenom)
+
+	globalFreeze := NewMsgSetDenomGlobalFreeze(msg.Signer, msg.Denom, true)
+	require.ErrorIs(t, globalFreeze.ValidateBasic(), ErrInvalidDenom)
+}
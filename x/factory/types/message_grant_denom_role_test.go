@@ -0,0 +1,100 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+)
+
+var MsgGrantDenomRoleSample = MsgGrantDenomRole{
+	Signer:  sample.AccAddress(),
+	Denom:   "coin" + FactoryDenomDelimiterChar + sample.AccAddress() + FactoryDenomDelimiterChar + "abc",
+	Role:    RolePauser,
+	Address: sample.AccAddress(),
+}
+
+// Positive test cases
+
+func TestMsgGrantDenomRole_NewMsgGrantDenomRole_Positive(t *testing.T) {
+	// Test case: create a new MsgGrantDenomRole with valid input data
+
+	signer := sample.AccAddress()
+	denom := "coin" + FactoryDenomDelimiterChar + sample.AccAddress() + FactoryDenomDelimiterChar + "abc"
+	address := sample.AccAddress()
+
+	msg := NewMsgGrantDenomRole(signer, denom, RoleBurner, address)
+
+	require.Equal(t, signer, msg.Signer)
+	require.Equal(t, denom, msg.Denom)
+	require.Equal(t, RoleBurner, msg.Role)
+	require.Equal(t, address, msg.Address)
+}
+
+func TestMsgGrantDenomRole_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgGrantDenomRole and MsgRevokeDenomRole for every grantable role
+
+	msg := MsgGrantDenomRoleSample
+	for _, role := range GrantableRoles {
+		msg.Role = role
+		require.NoError(t, msg.ValidateBasic())
+
+		revoke := NewMsgRevokeDenomRole(msg.Signer, msg.Denom, role, msg.Address)
+		require.NoError(t, revoke.ValidateBasic())
+	}
+}
+
+// Negative test cases
+
+func TestMsgGrantDenomRole_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgGrantDenomRoleSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgGrantDenomRole_InvalidRole(t *testing.T) {
+	// Test case: the admin role and unknown roles can not be granted nor revoked
+
+	msg := MsgGrantDenomRoleSample
+
+	for _, role := range []string{RoleAdmin, RoleMinter, "", "bank", "Minter"} {
+		msg.Role = role
+		require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidRole)
+
+		revoke := NewMsgRevokeDenomRole(msg.Signer, msg.Denom, role, msg.Address)
+		require.ErrorIs(t, revoke.ValidateBasic(), ErrInvalidRole)
+	}
+}
+
+func TestMsgGrantDenomRole_InvalidAddress(t *testing.T) {
+	// Test case: invalid address of the role holder
+
+	msg := MsgGrantDenomRoleSample
+
+	msg.Address = ""
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+
+	msg.Address = "invalid_address"
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+}
+
+func TestMsgGrantDenomRole_InvalidDenom(t *testing.T) {
+	// Test case: the denom must be a factory denom
+
+	msg := MsgGrantDenomRoleSample
+
+	msg.Denom = "uzig"
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidDenom)
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgLaunchToken{}
+
+func NewMsgLaunchToken(
+	creator string,
+	subDenom string,
+	supply math.Int,
+	curveSupply math.Int,
+	uri string,
+	uriHash string,
+) *MsgLaunchToken {
+	return &MsgLaunchToken{
+		Creator:     creator,
+		SubDenom:    subDenom,
+		Supply:      supply,
+		CurveSupply: curveSupply,
+		URI:         uri,
+		URIHash:     uriHash,
+	}
+}
+
+// CreateDenomMsg returns the message creating the denom of the launch, its minting cap is the fixed supply
+func (msg *MsgLaunchToken) CreateDenomMsg() *MsgCreateDenom {
+	mintingCap := math.ZeroUint()
+	if !msg.Supply.IsNil() && msg.Supply.IsPositive() {
+		mintingCap = math.NewUintFromBigInt(msg.Supply.BigInt())
+	}
+
+	return NewMsgCreateDenom(msg.Creator, msg.SubDenom, mintingCap, false, msg.URI, msg.URIHash)
+}
+
+func (msg *MsgLaunchToken) ValidateBasic() error {
+	// the denom is checked as the one of MsgCreateDenom, its minting cap being the supply
+	if err := msg.CreateDenomMsg().ValidateBasic(); err != nil {
+		return err
+	}
+
+	return ValidateLaunchSupply(msg.Supply, msg.CurveSupply)
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+	"zigchain/zutils/validators"
+)
+
+var MsgLaunchTokenSample = MsgLaunchToken{
+	Creator:     sample.AccAddress(),
+	SubDenom:    "abc",
+	Supply:      math.NewInt(1_000_000_000),
+	CurveSupply: math.NewInt(800_000_000),
+	URI:         "https://example.com",
+	URIHash:     validators.SHA256HashOfURL("https://example.com"),
+}
+
+// Positive test cases
+
+func TestMsgLaunchToken_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgLaunchToken
+
+	msg := MsgLaunchTokenSample
+	require.NoError(t, msg.ValidateBasic())
+
+	createDenom := msg.CreateDenomMsg()
+	require.Equal(t, math.NewUint(1_000_000_000), createDenom.MintingCap)
+	require.False(t, createDenom.CanChangeMintingCap)
+}
+
+// Negative test cases
+
+func TestMsgLaunchToken_InvalidCreatorAddress(t *testing.T) {
+	// Test case: invalid creator address
+
+	msg := MsgLaunchTokenSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Creator,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgLaunchToken_InvalidSupply(t *testing.T) {
+	// Test case: the curve supply must be positive and lower than the supply
+
+	msg := MsgLaunchTokenSample
+	msg.CurveSupply = math.ZeroInt()
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidTokenLaunch)
+
+	msg.CurveSupply = msg.Supply
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidTokenLaunch)
+
+	msg.CurveSupply = math.Int{}
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidTokenLaunch)
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+// MaxMintBatchSize is the maximum number of recipients of a batch mint
+const MaxMintBatchSize = 1000
+
+var _ sdk.Msg = &MsgMintAndSendBatch{}
+
+func NewMsgMintAndSendBatch(signer string, denom string, outputs []MintOutput) *MsgMintAndSendBatch {
+	return &MsgMintAndSendBatch{
+		Signer:  signer,
+		Denom:   denom,
+		Outputs: outputs,
+	}
+}
+
+func (msg *MsgMintAndSendBatch) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			msg.Denom,
+			err,
+		)
+	}
+
+	if len(msg.Outputs) == 0 || len(msg.Outputs) > MaxMintBatchSize {
+		return errorsmod.Wrapf(
+			ErrInvalidBatch,
+			"batch must have between 1 and %d recipients, got %d",
+			MaxMintBatchSize,
+			len(msg.Outputs),
+		)
+	}
+
+	total := math.ZeroInt()
+	for i, output := range msg.Outputs {
+		if err := validators.AddressCheck("Recipient", output.Recipient); err != nil {
+			return err
+		}
+
+		if output.Amount.IsNil() || output.Amount.IsZero() {
+			return errorsmod.Wrapf(
+				ErrInvalidBatch,
+				"output %d amount must be greater than 0",
+				i,
+			)
+		}
+
+		if total, err = total.SafeAdd(math.NewIntFromBigInt(output.Amount.BigInt())); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidBatch,
+				"batch total overflows at output %d",
+				i,
+			)
+		}
+	}
+
+	return nil
+}
+
+// Total returns the sum of the amounts of the batch
+func (msg *MsgMintAndSendBatch) Total() math.Uint {
+	total := math.ZeroUint()
+	for _, output := range msg.Outputs {
+		total = total.Add(output.Amount)
+	}
+
+	return total
+}
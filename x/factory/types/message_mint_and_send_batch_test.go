@@ -0,0 +1,107 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+)
+
+var MsgMintAndSendBatchSample = MsgMintAndSendBatch{
+	Signer: sample.AccAddress(),
+	Denom:  "coin" + FactoryDenomDelimiterChar + sample.AccAddress() + FactoryDenomDelimiterChar + "abc",
+	Outputs: []MintOutput{
+		{Recipient: sample.AccAddress(), Amount: math.NewUint(100)},
+		{Recipient: sample.AccAddress(), Amount: math.NewUint(200)},
+	},
+}
+
+// Positive test cases
+
+func TestMsgMintAndSendBatch_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgMintAndSendBatch
+
+	msg := MsgMintAndSendBatchSample
+
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, math.NewUint(300), msg.Total())
+}
+
+// Negative test cases
+
+func TestMsgMintAndSendBatch_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgMintAndSendBatchSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgMintAndSendBatch_InvalidOutputs(t *testing.T) {
+	// Test case: empty, oversized, zero amount, bad recipient and overflowing batches
+
+	// 2^256 - 1, the largest amount
+	maxAmount := math.NewUintFromString("115792089237316195423570985008687907853269984665640564039457584007913129639935")
+
+	oversized := make([]MintOutput, MaxMintBatchSize+1)
+	for i := range oversized {
+		oversized[i] = MintOutput{Recipient: sample.AccAddress(), Amount: math.NewUint(1)}
+	}
+
+	tests := []struct {
+		name    string
+		outputs []MintOutput
+		err     error
+	}{
+		{
+			name:    "empty",
+			outputs: []MintOutput{},
+			err:     ErrInvalidBatch,
+		},
+		{
+			name:    "oversized",
+			outputs: oversized,
+			err:     ErrInvalidBatch,
+		},
+		{
+			name:    "zero amount",
+			outputs: []MintOutput{{Recipient: sample.AccAddress(), Amount: math.ZeroUint()}},
+			err:     ErrInvalidBatch,
+		},
+		{
+			name:    "invalid recipient",
+			outputs: []MintOutput{{Recipient: "invalid_address", Amount: math.NewUint(1)}},
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "overflow",
+			outputs: []MintOutput{
+				{Recipient: sample.AccAddress(), Amount: maxAmount},
+				{Recipient: sample.AccAddress(), Amount: maxAmount},
+			},
+			err: ErrInvalidBatch,
+		},
+	}
+
+	msg := MsgMintAndSendBatchSample
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg.Outputs = test.outputs
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, test.err)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgMintVested{}
+
+func NewMsgMintVested(signer string, token sdk.Coin, recipient string, schedule VestingSchedule) *MsgMintVested {
+	return &MsgMintVested{
+		Signer:    signer,
+		Token:     token,
+		Recipient: recipient,
+		Schedule:  schedule,
+	}
+}
+
+func (msg *MsgMintVested) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	if err := validators.CheckCoinDenom(msg.Token); err != nil {
+		return err
+	}
+
+	if err := validators.CheckCoinAmount(msg.Token, false); err != nil {
+		return err
+	}
+
+	if err := validators.AddressCheck("Recipient", msg.Recipient); err != nil {
+		return err
+	}
+
+	return msg.Schedule.Validate(math.NewUintFromBigInt(msg.Token.Amount.BigInt()))
+}
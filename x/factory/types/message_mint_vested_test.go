@@ -0,0 +1,178 @@
+package types
+
+import (
+	"testing"
+
+	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+)
+
+var MsgMintVestedSample = MsgMintVested{
+	Signer:    sample.AccAddress(),
+	Token:     sdk.NewCoin("coin"+FactoryDenomDelimiterChar+sample.AccAddress()+FactoryDenomDelimiterChar+"abc", cosmosmath.NewInt(300)),
+	Recipient: sample.AccAddress(),
+	Schedule: VestingSchedule{
+		StartTime: 1_000,
+		EndTime:   2_000,
+	},
+}
+
+// Positive test cases
+
+func TestMsgMintVested_NewMsgMintVested_Positive(t *testing.T) {
+	// Test case: create a new MsgMintVested with valid input data
+
+	msg := NewMsgMintVested(
+		MsgMintVestedSample.Signer,
+		MsgMintVestedSample.Token,
+		MsgMintVestedSample.Recipient,
+		MsgMintVestedSample.Schedule,
+	)
+
+	require.Equal(t, &MsgMintVestedSample, msg)
+}
+
+func TestMsgMintVested_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgMintVested and MsgClaimVested
+
+	msg := MsgMintVestedSample
+	require.NoError(t, msg.ValidateBasic())
+
+	// a periodic schedule with a cliff
+	msg.Schedule = VestingSchedule{
+		StartTime: 1_000,
+		Periods: []VestingPeriod{
+			{Length: 500, Amount: cosmosmath.NewUint(100)},
+			{Length: 100, Amount: cosmosmath.NewUint(200)},
+		},
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	claim := NewMsgClaimVested(msg.Recipient, msg.Token.Denom, 1)
+	require.NoError(t, claim.ValidateBasic())
+}
+
+func TestVestingSchedule_VestedAmount(t *testing.T) {
+	// Test case: the vested amount of continuous and periodic schedules
+
+	amount := cosmosmath.NewUint(300)
+
+	continuous := VestingSchedule{StartTime: 1_000, EndTime: 1_300}
+	require.Equal(t, cosmosmath.ZeroUint(), continuous.VestedAmount(amount, 1_000))
+	require.Equal(t, cosmosmath.NewUint(100), continuous.VestedAmount(amount, 1_100))
+	require.Equal(t, amount, continuous.VestedAmount(amount, 1_300))
+	require.Equal(t, amount, continuous.VestedAmount(amount, 5_000))
+
+	periodic := VestingSchedule{
+		StartTime: 1_000,
+		Periods: []VestingPeriod{
+			{Length: 100, Amount: cosmosmath.NewUint(100)},
+			{Length: 50, Amount: cosmosmath.NewUint(200)},
+		},
+	}
+	require.Equal(t, cosmosmath.ZeroUint(), periodic.VestedAmount(amount, 1_099))
+	require.Equal(t, cosmosmath.NewUint(100), periodic.VestedAmount(amount, 1_100))
+	require.Equal(t, cosmosmath.NewUint(100), periodic.VestedAmount(amount, 1_149))
+	require.Equal(t, amount, periodic.VestedAmount(amount, 1_150))
+}
+
+// Negative test cases
+
+func TestMsgMintVested_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgMintVestedSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgMintVested_InvalidRecipient(t *testing.T) {
+	// Test case: invalid recipient address
+
+	msg := MsgMintVestedSample
+	msg.Recipient = "invalid_address"
+
+	err := msg.ValidateBasic()
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+}
+
+func TestMsgMintVested_ZeroAmount(t *testing.T) {
+	// Test case: the amount has to be positive
+
+	msg := MsgMintVestedSample
+	msg.Token.Amount = cosmosmath.ZeroInt()
+
+	err := msg.ValidateBasic()
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+}
+
+func TestMsgMintVested_InvalidSchedule(t *testing.T) {
+	// Test case: invalid vesting schedules
+
+	tests := []struct {
+		name     string
+		schedule VestingSchedule
+	}{
+		{
+			name:     "negative start time",
+			schedule: VestingSchedule{StartTime: -1, EndTime: 2_000},
+		},
+		{
+			name:     "end before start",
+			schedule: VestingSchedule{StartTime: 2_000, EndTime: 1_000},
+		},
+		{
+			name: "end time with periods",
+			schedule: VestingSchedule{
+				StartTime: 1_000,
+				EndTime:   2_000,
+				Periods:   []VestingPeriod{{Length: 100, Amount: cosmosmath.NewUint(300)}},
+			},
+		},
+		{
+			name: "zero period length",
+			schedule: VestingSchedule{
+				StartTime: 1_000,
+				Periods:   []VestingPeriod{{Length: 0, Amount: cosmosmath.NewUint(300)}},
+			},
+		},
+		{
+			name: "periods total mismatch",
+			schedule: VestingSchedule{
+				StartTime: 1_000,
+				Periods:   []VestingPeriod{{Length: 100, Amount: cosmosmath.NewUint(299)}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg := MsgMintVestedSample
+			msg.Schedule = test.schedule
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, ErrInvalidVestingSchedule)
+		})
+	}
+}
+
+func TestMsgClaimVested_InvalidDenom(t *testing.T) {
+	// Test case: invalid denom
+
+	msg := NewMsgClaimVested(sample.AccAddress(), "uzig", 1)
+
+	err := msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidDenom)
+}
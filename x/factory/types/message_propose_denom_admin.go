@@ -55,5 +55,9 @@ func (msg *MsgProposeDenomAdmin) ValidateBasic() error {
 		}
 	}
 
+	if err := ValidateDenomAdminDelay("expiry", msg.Expiry); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,40 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgRegisterCW20Bridge{}
+
+func NewMsgRegisterCW20Bridge(authority string, contractAddress string, subDenom string) *MsgRegisterCW20Bridge {
+	return &MsgRegisterCW20Bridge{
+		Authority:       authority,
+		ContractAddress: contractAddress,
+		SubDenom:        subDenom,
+	}
+}
+
+func (msg *MsgRegisterCW20Bridge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := validators.AddressCheck("contract", msg.ContractAddress); err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidContractAddress,
+			"(%s) : %s",
+			msg.ContractAddress,
+			err,
+		)
+	}
+
+	// builds full denom and checks if sizes are within limits
+	if _, err := GetTokenDenom(CW20BridgeCreator(), msg.SubDenom); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"zigchain/testutil/sample"
+)
+
+var MsgRegisterCW20BridgeSample = MsgRegisterCW20Bridge{
+	Authority:       sample.AccAddress(),
+	ContractAddress: sample.AccAddress(),
+	SubDenom:        "tkn",
+}
+
+// Positive test cases
+
+func TestMsgRegisterCW20Bridge_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgRegisterCW20Bridge
+
+	msg := MsgRegisterCW20BridgeSample
+
+	require.NoError(t, msg.ValidateBasic())
+}
+
+// Negative test cases
+
+func TestMsgRegisterCW20Bridge_InvalidAuthority(t *testing.T) {
+	// Test case: invalid authority address
+
+	msg := MsgRegisterCW20BridgeSample
+	msg.Authority = "invalid_address"
+
+	require.ErrorContains(t, msg.ValidateBasic(), "invalid authority address")
+}
+
+func TestMsgRegisterCW20Bridge_InvalidContractAddress(t *testing.T) {
+	// Test case: invalid contract address
+
+	msg := MsgRegisterCW20BridgeSample
+	msg.ContractAddress = "invalid_address"
+
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidContractAddress)
+}
+
+func TestMsgRegisterCW20Bridge_InvalidSubDenom(t *testing.T) {
+	// Test case: invalid sub denom
+
+	msg := MsgRegisterCW20BridgeSample
+	msg.SubDenom = "1a"
+
+	require.Error(t, msg.ValidateBasic())
+}
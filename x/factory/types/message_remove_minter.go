@@ -0,0 +1,44 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgRemoveMinter{}
+
+func NewMsgRemoveMinter(
+	signer string,
+	denom string,
+	minter string,
+) *MsgRemoveMinter {
+	return &MsgRemoveMinter{
+		Signer: signer,
+		Denom:  denom,
+		Minter: minter,
+	}
+}
+
+func (msg *MsgRemoveMinter) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			msg.Denom,
+			err,
+		)
+	}
+
+	if err := validators.AddressCheck("Minter", msg.Minter); err != nil {
+		return err
+	}
+
+	return nil
+}
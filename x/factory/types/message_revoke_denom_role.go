@@ -0,0 +1,50 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgRevokeDenomRole{}
+
+func NewMsgRevokeDenomRole(
+	signer string,
+	denom string,
+	role string,
+	address string,
+) *MsgRevokeDenomRole {
+	return &MsgRevokeDenomRole{
+		Signer:  signer,
+		Denom:   denom,
+		Role:    role,
+		Address: address,
+	}
+}
+
+func (msg *MsgRevokeDenomRole) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			msg.Denom,
+			err,
+		)
+	}
+
+	if err := ValidateGrantableRole(msg.Role); err != nil {
+		return err
+	}
+
+	if err := validators.AddressCheck("Role holder", msg.Address); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgSellLaunchToken{}
+
+func NewMsgSellLaunchToken(signer string, incoming sdk.Coin, outgoingMin math.Int) *MsgSellLaunchToken {
+	return &MsgSellLaunchToken{
+		Signer:      signer,
+		Incoming:    incoming,
+		OutgoingMin: outgoingMin,
+	}
+}
+
+func (msg *MsgSellLaunchToken) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	if _, _, err := DeconstructDenom(msg.Incoming.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenom, "(%s) : %s", msg.Incoming.Denom, err)
+	}
+
+	if err := validators.CheckCoinAmount(msg.Incoming, false); err != nil {
+		return err
+	}
+
+	return validateOutgoingMin(msg.OutgoingMin)
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+	"zigchain/zutils/constants"
+)
+
+var MsgSellLaunchTokenSample = MsgSellLaunchToken{
+	Signer:      sample.AccAddress(),
+	Incoming:    sdk.NewInt64Coin(launchDenomSample, 1000),
+	OutgoingMin: math.NewInt(10),
+}
+
+// Positive test cases
+
+func TestMsgSellLaunchToken_NewMsgSellLaunchToken_Positive(t *testing.T) {
+	// Test case: sell a launch with valid input data
+
+	signer := sample.AccAddress()
+	incoming := sdk.NewInt64Coin(launchDenomSample, 1000)
+
+	msg := NewMsgSellLaunchToken(signer, incoming, math.NewInt(10))
+
+	require.Equal(t, signer, msg.Signer)
+	require.Equal(t, incoming, msg.Incoming)
+	require.Equal(t, math.NewInt(10), msg.OutgoingMin)
+}
+
+func TestMsgSellLaunchToken_ValidateBasic_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgSellLaunchToken, the outgoing min can be left unset
+
+	msg := MsgSellLaunchTokenSample
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.OutgoingMin = math.Int{}
+	require.NoError(t, msg.ValidateBasic())
+}
+
+// Negative test cases
+
+func TestMsgSellLaunchToken_ValidateBasic_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgSellLaunchTokenSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgSellLaunchToken_ValidateBasic_InvalidDenom(t *testing.T) {
+	// Test case: only factory denoms are sold
+
+	for _, denom := range []string{"", constants.BondDenom, "coin.abc", "coin." + sample.AccAddress() + "."} {
+		msg := MsgSellLaunchTokenSample
+		msg.Incoming = sdk.Coin{Denom: denom, Amount: math.NewInt(1000)}
+
+		require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidDenom, denom)
+	}
+}
+
+func TestMsgSellLaunchToken_ValidateBasic_InvalidAmount(t *testing.T) {
+	// Test case: the amount sold must be positive
+
+	for _, amount := range []math.Int{math.ZeroInt(), math.NewInt(-1), {}} {
+		msg := MsgSellLaunchTokenSample
+		msg.Incoming = sdk.Coin{Denom: launchDenomSample, Amount: amount}
+
+		require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidCoins)
+	}
+}
+
+func TestMsgSellLaunchToken_ValidateBasic_NegativeOutgoingMin(t *testing.T) {
+	// Test case: the outgoing min can not be negative
+
+	msg := MsgSellLaunchTokenSample
+	msg.OutgoingMin = math.NewInt(-1)
+
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidTokenLaunch)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgSetBeforeSendHook{}
+
+func NewMsgSetBeforeSendHook(
+	signer string,
+	denom string,
+	contractAddress string,
+) *MsgSetBeforeSendHook {
+	return &MsgSetBeforeSendHook{
+		Signer:          signer,
+		Denom:           denom,
+		ContractAddress: contractAddress,
+	}
+}
+
+func (msg *MsgSetBeforeSendHook) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			msg.Denom,
+			err,
+		)
+	}
+
+	// An empty contract address removes the hook
+	if msg.ContractAddress != "" {
+		if err := validators.AddressCheck("contract", msg.ContractAddress); err != nil {
+			return errorsmod.Wrapf(
+				ErrInvalidContractAddress,
+				"(%s) : %s",
+				msg.ContractAddress,
+				err,
+			)
+		}
+	}
+
+	return nil
+}
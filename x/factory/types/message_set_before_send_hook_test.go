@@ -0,0 +1,106 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+)
+
+var MsgSetBeforeSendHookSample = MsgSetBeforeSendHook{
+	Signer:          sample.AccAddress(),
+	Denom:           "coin" + FactoryDenomDelimiterChar + sample.AccAddress() + FactoryDenomDelimiterChar + "abc",
+	ContractAddress: sample.AccAddress(),
+}
+
+// Positive test cases
+
+func TestMsgSetBeforeSendHook_NewMsgSetBeforeSendHook_Positive(t *testing.T) {
+	// Test case: create a new MsgSetBeforeSendHook with valid input data
+
+	signer := sample.AccAddress()
+	denom := "coin" + FactoryDenomDelimiterChar + sample.AccAddress() + FactoryDenomDelimiterChar + "abc"
+	contractAddress := sample.AccAddress()
+
+	msg := NewMsgSetBeforeSendHook(signer, denom, contractAddress)
+
+	require.Equal(t, signer, msg.Signer)
+	require.Equal(t, denom, msg.Denom)
+	require.Equal(t, contractAddress, msg.ContractAddress)
+}
+
+func TestMsgSetBeforeSendHook_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgSetBeforeSendHook
+
+	msg := MsgSetBeforeSendHookSample
+
+	require.NoError(t, msg.ValidateBasic())
+}
+
+func TestMsgSetBeforeSendHook_EmptyContractAddress(t *testing.T) {
+	// Test case: an empty contract address is valid, it removes the hook
+
+	msg := MsgSetBeforeSendHookSample
+	msg.ContractAddress = ""
+
+	require.NoError(t, msg.ValidateBasic())
+}
+
+// Negative test cases
+
+func TestMsgSetBeforeSendHook_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgSetBeforeSendHookSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgSetBeforeSendHook_InvalidDenom(t *testing.T) {
+	// Test case: invalid denom
+
+	msg := MsgSetBeforeSendHookSample
+	msg.Denom = "uzig"
+
+	err := msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidDenom)
+}
+
+func TestMsgSetBeforeSendHook_InvalidContractAddress(t *testing.T) {
+	// Test case: invalid contract address
+
+	tests := []struct {
+		name            string
+		contractAddress string
+	}{
+		{
+			name:            "not bech32",
+			contractAddress: "invalid_address",
+		},
+		{
+			name:            "wrong prefix",
+			contractAddress: "cosmos1lqhrr0kczjjl2e9jukzfjygp2jvtq3qvnjurh6",
+		},
+	}
+
+	msg := MsgSetBeforeSendHookSample
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg.ContractAddress = test.contractAddress
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, ErrInvalidContractAddress)
+		})
+	}
+}
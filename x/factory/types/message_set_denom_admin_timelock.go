@@ -0,0 +1,36 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgSetDenomAdminTimelock{}
+
+func NewMsgSetDenomAdminTimelock(signer string, denom string, delay uint64) *MsgSetDenomAdminTimelock {
+	return &MsgSetDenomAdminTimelock{
+		Signer: signer,
+		Denom:  denom,
+		Delay:  delay,
+	}
+}
+
+func (msg *MsgSetDenomAdminTimelock) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			msg.Denom,
+			err,
+		)
+	}
+
+	return ValidateDenomAdminDelay("delay", msg.Delay)
+}
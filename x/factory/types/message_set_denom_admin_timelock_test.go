@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+)
+
+var MsgSetDenomAdminTimelockSample = MsgSetDenomAdminTimelock{
+	Signer: sample.AccAddress(),
+	Denom:  "coin" + FactoryDenomDelimiterChar + sample.AccAddress() + FactoryDenomDelimiterChar + "abc",
+	Delay:  3600,
+}
+
+// Positive test cases
+
+func TestMsgSetDenomAdminTimelock_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgSetDenomAdminTimelock and MsgCancelDenomAdminProposal
+
+	msg := MsgSetDenomAdminTimelockSample
+	require.NoError(t, msg.ValidateBasic())
+
+	// a zero delay removes the timelock
+	msg.Delay = 0
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Delay = MaxDenomAdminDelay
+	require.NoError(t, msg.ValidateBasic())
+
+	cancel := NewMsgCancelDenomAdminProposal(msg.Signer, msg.Denom)
+	require.NoError(t, cancel.ValidateBasic())
+}
+
+// Negative test cases
+
+func TestMsgSetDenomAdminTimelock_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgSetDenomAdminTimelockSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgSetDenomAdminTimelock_InvalidDelay(t *testing.T) {
+	// Test case: the delay and the proposal expiry are bounded
+
+	msg := MsgSetDenomAdminTimelockSample
+	msg.Delay = MaxDenomAdminDelay + 1
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidAdminTimelock)
+
+	propose := NewMsgProposeDenomAdmin(msg.Signer, msg.Denom, sample.AccAddress(), "")
+	propose.Expiry = MaxDenomAdminDelay + 1
+	require.ErrorIs(t, propose.ValidateBasic(), ErrInvalidAdminTimelock)
+}
+
+func TestMsgSetDenomAdminTimelock_InvalidDenom(t *testing.T) {
+	// Test case: the denom must be a factory denom
+
+	msg := MsgSetDenomAdminTimelockSample
+	msg.Denom = "uzig"
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidDenom)
+
+	cancel := NewMsgCancelDenomAdminProposal(msg.Signer, msg.Denom)
+	require.ErrorIs(t, cancel.ValidateBasic(), ErrInvalidDenom)
+}
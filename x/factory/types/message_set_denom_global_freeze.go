@@ -0,0 +1,40 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgSetDenomGlobalFreeze{}
+
+func NewMsgSetDenomGlobalFreeze(
+	signer string,
+	denom string,
+	frozen bool,
+) *MsgSetDenomGlobalFreeze {
+	return &MsgSetDenomGlobalFreeze{
+		Signer: signer,
+		Denom:  denom,
+		Frozen: frozen,
+	}
+}
+
+func (msg *MsgSetDenomGlobalFreeze) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			msg.Denom,
+			err,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgSetMintRateLimit{}
+
+func NewMsgSetMintRateLimit(
+	signer string,
+	denom string,
+	config MintRateLimitConfig,
+) *MsgSetMintRateLimit {
+	return &MsgSetMintRateLimit{
+		Signer: signer,
+		Denom:  denom,
+		Config: config,
+	}
+}
+
+func (msg *MsgSetMintRateLimit) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			msg.Denom,
+			err,
+		)
+	}
+
+	return msg.Config.Validate()
+}
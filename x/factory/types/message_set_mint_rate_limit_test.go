@@ -0,0 +1,118 @@
+package types
+
+import (
+	"testing"
+
+	cosmosmath "cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+)
+
+var MsgSetMintRateLimitSample = MsgSetMintRateLimit{
+	Signer: sample.AccAddress(),
+	Denom:  "coin" + FactoryDenomDelimiterChar + sample.AccAddress() + FactoryDenomDelimiterChar + "abc",
+	Config: MintRateLimitConfig{
+		Limit:      cosmosmath.NewUint(1000),
+		Window:     86400,
+		RaiseDelay: 172800,
+	},
+}
+
+// Positive test cases
+
+func TestMsgSetMintRateLimit_NewMsgSetMintRateLimit_Positive(t *testing.T) {
+	// Test case: create a new MsgSetMintRateLimit with valid input data
+
+	msg := NewMsgSetMintRateLimit(
+		MsgSetMintRateLimitSample.Signer,
+		MsgSetMintRateLimitSample.Denom,
+		MsgSetMintRateLimitSample.Config,
+	)
+
+	require.Equal(t, &MsgSetMintRateLimitSample, msg)
+}
+
+func TestMsgSetMintRateLimit_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgSetMintRateLimit
+
+	msg := MsgSetMintRateLimitSample
+	require.NoError(t, msg.ValidateBasic())
+
+	// a zero limit stops the minting, a zero raise delay applies raises right away
+	msg.Config.Limit = cosmosmath.ZeroUint()
+	msg.Config.RaiseDelay = 0
+	require.NoError(t, msg.ValidateBasic())
+}
+
+func TestMintRateLimitConfig_BucketLength(t *testing.T) {
+	// Test case: the window is split into MintRateBuckets buckets of at least one second
+
+	require.Equal(t, int64(3600), MintRateLimitConfig{Window: 86400}.BucketLength())
+	require.Equal(t, int64(1), MintRateLimitConfig{Window: 10}.BucketLength())
+}
+
+// Negative test cases
+
+func TestMsgSetMintRateLimit_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgSetMintRateLimitSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgSetMintRateLimit_InvalidDenom(t *testing.T) {
+	// Test case: invalid denom
+
+	msg := MsgSetMintRateLimitSample
+	msg.Denom = "uzig"
+
+	err := msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidDenom)
+}
+
+func TestMsgSetMintRateLimit_InvalidConfig(t *testing.T) {
+	// Test case: invalid mint rate limit config
+
+	tests := []struct {
+		name   string
+		config MintRateLimitConfig
+	}{
+		{
+			name:   "nil limit",
+			config: MintRateLimitConfig{Window: 86400},
+		},
+		{
+			name:   "zero window",
+			config: MintRateLimitConfig{Limit: cosmosmath.NewUint(1000)},
+		},
+		{
+			name:   "window too long",
+			config: MintRateLimitConfig{Limit: cosmosmath.NewUint(1000), Window: MaxMintRateLimitDuration + 1},
+		},
+		{
+			name:   "raise delay too long",
+			config: MintRateLimitConfig{Limit: cosmosmath.NewUint(1000), Window: 86400, RaiseDelay: MaxMintRateLimitDuration + 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg := MsgSetMintRateLimitSample
+			msg.Config = test.config
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, ErrInvalidMintRateLimit)
+		})
+	}
+}
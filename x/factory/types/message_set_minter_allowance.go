@@ -0,0 +1,56 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgSetMinterAllowance{}
+
+func NewMsgSetMinterAllowance(
+	signer string,
+	denom string,
+	minter string,
+	allowance cosmosmath.Uint,
+) *MsgSetMinterAllowance {
+	return &MsgSetMinterAllowance{
+		Signer:    signer,
+		Denom:     denom,
+		Minter:    minter,
+		Allowance: allowance,
+	}
+}
+
+func (msg *MsgSetMinterAllowance) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			msg.Denom,
+			err,
+		)
+	}
+
+	if err := validators.AddressCheck("Minter", msg.Minter); err != nil {
+		return err
+	}
+
+	// A zero allowance keeps the minter registered without letting it mint
+	if msg.Allowance.IsNil() {
+		return errorsmod.Wrapf(
+			ErrInvalidMintingCap,
+			"Minter allowance is nil (%s)",
+			msg.Minter,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"testing"
+
+	cosmosmath "cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+)
+
+var MsgSetMinterAllowanceSample = MsgSetMinterAllowance{
+	Signer:    sample.AccAddress(),
+	Denom:     "coin" + FactoryDenomDelimiterChar + sample.AccAddress() + FactoryDenomDelimiterChar + "abc",
+	Minter:    sample.AccAddress(),
+	Allowance: cosmosmath.NewUint(1000),
+}
+
+// Positive test cases
+
+func TestMsgSetMinterAllowance_NewMsgSetMinterAllowance_Positive(t *testing.T) {
+	// Test case: create a new MsgSetMinterAllowance with valid input data
+
+	msg := NewMsgSetMinterAllowance(
+		MsgSetMinterAllowanceSample.Signer,
+		MsgSetMinterAllowanceSample.Denom,
+		MsgSetMinterAllowanceSample.Minter,
+		MsgSetMinterAllowanceSample.Allowance,
+	)
+
+	require.Equal(t, &MsgSetMinterAllowanceSample, msg)
+}
+
+func TestMsgSetMinterAllowance_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgSetMinterAllowance and MsgRemoveMinter
+
+	msg := MsgSetMinterAllowanceSample
+	require.NoError(t, msg.ValidateBasic())
+
+	// a zero allowance keeps the minter without letting it mint
+	msg.Allowance = cosmosmath.ZeroUint()
+	require.NoError(t, msg.ValidateBasic())
+
+	remove := NewMsgRemoveMinter(msg.Signer, msg.Denom, msg.Minter)
+	require.NoError(t, remove.ValidateBasic())
+}
+
+// Negative test cases
+
+func TestMsgSetMinterAllowance_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgSetMinterAllowanceSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgSetMinterAllowance_Invalid(t *testing.T) {
+	// Test case: invalid denom, minter address and allowance
+
+	msg := MsgSetMinterAllowanceSample
+	msg.Denom = "uzig"
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidDenom)
+
+	msg = MsgSetMinterAllowanceSample
+	msg.Minter = "invalid_address"
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+
+	msg = MsgSetMinterAllowanceSample
+	msg.Allowance = cosmosmath.Uint{}
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidMintingCap)
+
+	remove := NewMsgRemoveMinter(msg.Signer, "uzig", msg.Minter)
+	require.ErrorIs(t, remove.ValidateBasic(), ErrInvalidDenom)
+
+	remove = NewMsgRemoveMinter(msg.Signer, msg.Denom, "")
+	require.ErrorIs(t, remove.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgSetTransferTax{}
+
+func NewMsgSetTransferTax(signer string, denom string, rate math.LegacyDec, recipient string) *MsgSetTransferTax {
+	return &MsgSetTransferTax{
+		Signer:    signer,
+		Denom:     denom,
+		Rate:      rate,
+		Recipient: recipient,
+	}
+}
+
+func (msg *MsgSetTransferTax) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			msg.Denom,
+			err,
+		)
+	}
+
+	if err := ValidateTransferTaxRate(msg.Rate); err != nil {
+		return err
+	}
+
+	// a zero rate removes the tax, so it needs no recipient
+	if msg.Rate.IsZero() && msg.Recipient == "" {
+		return nil
+	}
+
+	return validators.AddressCheck("recipient", msg.Recipient)
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgSetTransferTaxExemption{}
+
+func NewMsgSetTransferTaxExemption(signer string, denom string, address string, exempt bool) *MsgSetTransferTaxExemption {
+	return &MsgSetTransferTaxExemption{
+		Signer:  signer,
+		Denom:   denom,
+		Address: address,
+		Exempt:  exempt,
+	}
+}
+
+func (msg *MsgSetTransferTaxExemption) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			msg.Denom,
+			err,
+		)
+	}
+
+	return validators.AddressCheck("address", msg.Address)
+}
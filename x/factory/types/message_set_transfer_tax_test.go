@@ -0,0 +1,82 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+)
+
+var MsgSetTransferTaxSample = MsgSetTransferTax{
+	Signer:    sample.AccAddress(),
+	Denom:     "coin" + FactoryDenomDelimiterChar + sample.AccAddress() + FactoryDenomDelimiterChar + "abc",
+	Rate:      math.LegacyNewDecWithPrec(1, 2),
+	Recipient: sample.AccAddress(),
+}
+
+// Positive test cases
+
+func TestMsgSetTransferTax_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgSetTransferTax and MsgSetTransferTaxExemption
+
+	msg := MsgSetTransferTaxSample
+	require.NoError(t, msg.ValidateBasic())
+
+	// a zero rate removes the tax and needs no recipient
+	msg.Rate = math.LegacyZeroDec()
+	msg.Recipient = ""
+	require.NoError(t, msg.ValidateBasic())
+
+	exemption := NewMsgSetTransferTaxExemption(msg.Signer, msg.Denom, sample.AccAddress(), true)
+	require.NoError(t, exemption.ValidateBasic())
+}
+
+// Negative test cases
+
+func TestMsgSetTransferTax_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgSetTransferTaxSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgSetTransferTax_InvalidRate(t *testing.T) {
+	// Test case: the rate must be at least 0 and lower than 1, a positive rate needs a recipient
+
+	msg := MsgSetTransferTaxSample
+	msg.Rate = math.LegacyOneDec()
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidTransferTax)
+
+	msg.Rate = math.LegacyNewDec(-1)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidTransferTax)
+
+	msg.Rate = math.LegacyNewDecWithPrec(1, 2)
+	msg.Recipient = ""
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+}
+
+func TestMsgSetTransferTax_InvalidDenom(t *testing.T) {
+	// Test case: the denom must be a factory denom and the exempted address valid
+
+	msg := MsgSetTransferTaxSample
+	msg.Denom = "uzig"
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidDenom)
+
+	exemption := NewMsgSetTransferTaxExemption(msg.Signer, msg.Denom, sample.AccAddress(), true)
+	require.ErrorIs(t, exemption.ValidateBasic(), ErrInvalidDenom)
+
+	exemption = NewMsgSetTransferTaxExemption(msg.Signer, MsgSetTransferTaxSample.Denom, "invalid", true)
+	require.ErrorIs(t, exemption.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+}
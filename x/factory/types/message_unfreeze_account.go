@@ -0,0 +1,44 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgUnfreezeAccount{}
+
+func NewMsgUnfreezeAccount(
+	signer string,
+	denom string,
+	address string,
+) *MsgUnfreezeAccount {
+	return &MsgUnfreezeAccount{
+		Signer:  signer,
+		Denom:   denom,
+		Address: address,
+	}
+}
+
+func (msg *MsgUnfreezeAccount) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(msg.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			msg.Denom,
+			err,
+		)
+	}
+
+	if err := validators.AddressCheck("Account", msg.Address); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgVerifyDenom{}
+
+func NewMsgVerifyDenom(
+	signer string,
+	denom string,
+	ticker string,
+	uriHash string,
+	decimals uint32,
+) *MsgVerifyDenom {
+	return &MsgVerifyDenom{
+		Signer:   signer,
+		Denom:    denom,
+		Ticker:   ticker,
+		UriHash:  uriHash,
+		Decimals: decimals,
+	}
+}
+
+func (msg *MsgVerifyDenom) ValidateBasic() error {
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	return msg.VerifiedDenom().Validate()
+}
+
+// VerifiedDenom returns the registry entry set by the message
+func (msg *MsgVerifyDenom) VerifiedDenom() VerifiedDenom {
+	return VerifiedDenom{
+		Denom:    msg.Denom,
+		Ticker:   msg.Ticker,
+		UriHash:  msg.UriHash,
+		Decimals: msg.Decimals,
+	}
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+)
+
+var MsgVerifyDenomSample = MsgVerifyDenom{
+	Signer:   sample.AccAddress(),
+	Denom:    "coin" + FactoryDenomDelimiterChar + sample.AccAddress() + FactoryDenomDelimiterChar + "usdc",
+	Ticker:   "USDC",
+	UriHash:  strings.Repeat("ab", 32),
+	Decimals: 6,
+}
+
+// Positive test cases
+
+func TestMsgVerifyDenom_Positive(t *testing.T) {
+	// Test case: validate basic properties of MsgVerifyDenom and MsgUnverifyDenom
+
+	msg := MsgVerifyDenomSample
+	require.NoError(t, msg.ValidateBasic())
+
+	for _, ticker := range []string{"ZG", "WBTC", "USDC2", "ABCDEFGHIJKL"} {
+		msg.Ticker = ticker
+		require.NoError(t, msg.ValidateBasic())
+	}
+
+	msg.Decimals = MaxVerifiedDenomDecimals
+	require.NoError(t, msg.ValidateBasic())
+
+	unverify := NewMsgUnverifyDenom(msg.Signer, msg.Denom)
+	require.NoError(t, unverify.ValidateBasic())
+}
+
+// Negative test cases
+
+func TestMsgVerifyDenom_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid signer address
+
+	msg := MsgVerifyDenomSample
+
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgVerifyDenom_InvalidTicker(t *testing.T) {
+	// Test case: the ticker is 2 to 12 upper case letters or digits starting with a letter
+
+	msg := MsgVerifyDenomSample
+
+	for _, ticker := range []string{"", "U", "usdc", "1INCH", "USD-C", "ABCDEFGHIJKLM"} {
+		msg.Ticker = ticker
+		require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidVerifiedDenom)
+	}
+}
+
+func TestMsgVerifyDenom_InvalidEntry(t *testing.T) {
+	// Test case: the uri hash must be a sha256 hash, the decimals are bounded
+	// and the denom must be a factory denom
+
+	msg := MsgVerifyDenomSample
+	msg.UriHash = "abc"
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidVerifiedDenom)
+
+	msg = MsgVerifyDenomSample
+	msg.Decimals = MaxVerifiedDenomDecimals + 1
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidVerifiedDenom)
+
+	msg = MsgVerifyDenomSample
+	msg.Denom = "uzig"
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidDenom)
+
+	unverify := NewMsgUnverifyDenom(msg.Signer, msg.Denom)
+	require.ErrorIs(t, unverify.ValidateBasic(), ErrInvalidDenom)
+}
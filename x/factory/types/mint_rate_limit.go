@@ -0,0 +1,124 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// MintRateBuckets is the number of buckets the rolling window of a mint rate
+// limit is split into, the mints of a bucket are counted until the whole
+// bucket has left the window
+const MintRateBuckets = 24
+
+// MaxMintRateLimitDuration is the longest window and raise delay, in seconds,
+// of a mint rate limit
+const MaxMintRateLimitDuration uint64 = 365 * 24 * 60 * 60
+
+// BucketLength returns the length in seconds of the buckets of the window
+func (c MintRateLimitConfig) BucketLength() int64 {
+	// #nosec G115 -- the window is bounded by MaxMintRateLimitDuration
+	length := int64(c.Window / MintRateBuckets)
+	if length == 0 {
+		return 1
+	}
+
+	return length
+}
+
+// IsLooserThan returns true if the config lets more be minted, or lets a later
+// change take effect sooner, than the other config
+func (c MintRateLimitConfig) IsLooserThan(other MintRateLimitConfig) bool {
+	return c.Limit.GT(other.Limit) ||
+		c.Window < other.Window ||
+		c.RaiseDelay < other.RaiseDelay
+}
+
+// Validate validates a mint rate limit config
+func (c MintRateLimitConfig) Validate() error {
+	if c.Limit.IsNil() {
+		return errorsmod.Wrap(ErrInvalidMintRateLimit, "limit is nil")
+	}
+
+	if c.Window == 0 {
+		return errorsmod.Wrap(ErrInvalidMintRateLimit, "window must be greater than 0")
+	}
+
+	if c.Window > MaxMintRateLimitDuration {
+		return errorsmod.Wrapf(
+			ErrInvalidMintRateLimit,
+			"window %d exceeds the maximum of %d seconds",
+			c.Window,
+			MaxMintRateLimitDuration,
+		)
+	}
+
+	if c.RaiseDelay > MaxMintRateLimitDuration {
+		return errorsmod.Wrapf(
+			ErrInvalidMintRateLimit,
+			"raise delay %d exceeds the maximum of %d seconds",
+			c.RaiseDelay,
+			MaxMintRateLimitDuration,
+		)
+	}
+
+	return nil
+}
+
+// Validate validates a mint rate limit
+func (m MintRateLimit) Validate() error {
+	_, _, err := DeconstructDenom(m.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			m.Denom,
+			err,
+		)
+	}
+
+	if err := m.Config.Validate(); err != nil {
+		return err
+	}
+
+	if m.PendingConfig != nil {
+		if err := m.PendingConfig.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Effective returns the config in effect at the unix time now, with the pending
+// config applied once its time is reached
+func (m MintRateLimit) Effective(now int64) MintRateLimit {
+	if m.PendingConfig != nil && now >= m.PendingTime {
+		m.Config = *m.PendingConfig
+		m.PendingConfig = nil
+		m.PendingTime = 0
+	}
+
+	return m
+}
+
+// Validate validates a mint rate bucket
+func (b MintRateBucket) Validate() error {
+	_, _, err := DeconstructDenom(b.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			b.Denom,
+			err,
+		)
+	}
+
+	if b.Amount.IsNil() {
+		return errorsmod.Wrapf(
+			ErrInvalidMintRateLimit,
+			"bucket amount is nil (%s)",
+			b.Denom,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,986 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zigchain/factory/mint_rate_limit.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MintRateLimitConfig bounds the amount of a denom that can be minted within
+// any rolling window
+type MintRateLimitConfig struct {
+	// limit is the maximum amount minted within the window
+	Limit cosmossdk_io_math.Uint `protobuf:"bytes,1,opt,name=limit,proto3,customtype=cosmossdk.io/math.Uint" json:"limit"`
+	// window is the length of the rolling window in seconds
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// raise_delay is the number of seconds a looser config waits before it
+	// takes effect
+	RaiseDelay uint64 `protobuf:"varint,3,opt,name=raise_delay,json=raiseDelay,proto3" json:"raise_delay,omitempty"`
+}
+
+func (m *MintRateLimitConfig) Reset()         { *m = MintRateLimitConfig{} }
+func (m *MintRateLimitConfig) String() string { return proto.CompactTextString(m) }
+func (*MintRateLimitConfig) ProtoMessage()    {}
+func (*MintRateLimitConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96f873665d232265, []int{0}
+}
+func (m *MintRateLimitConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintRateLimitConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintRateLimitConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintRateLimitConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRateLimitConfig.Merge(m, src)
+}
+func (m *MintRateLimitConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintRateLimitConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRateLimitConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintRateLimitConfig proto.InternalMessageInfo
+
+func (m *MintRateLimitConfig) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *MintRateLimitConfig) GetRaiseDelay() uint64 {
+	if m != nil {
+		return m.RaiseDelay
+	}
+	return 0
+}
+
+// MintRateLimit is the mint rate limit of a denom, a looser config set by the
+// bank admin is kept as pending until pending_time
+type MintRateLimit struct {
+	Denom  string              `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Config MintRateLimitConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+	// pending_config replaces config once the block time reaches pending_time
+	PendingConfig *MintRateLimitConfig `protobuf:"bytes,3,opt,name=pending_config,json=pendingConfig,proto3" json:"pending_config,omitempty"`
+	// pending_time is the unix time (in seconds) at which pending_config takes
+	// effect
+	PendingTime int64 `protobuf:"varint,4,opt,name=pending_time,json=pendingTime,proto3" json:"pending_time,omitempty"`
+}
+
+func (m *MintRateLimit) Reset()         { *m = MintRateLimit{} }
+func (m *MintRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MintRateLimit) ProtoMessage()    {}
+func (*MintRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96f873665d232265, []int{1}
+}
+func (m *MintRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRateLimit.Merge(m, src)
+}
+func (m *MintRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintRateLimit proto.InternalMessageInfo
+
+func (m *MintRateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MintRateLimit) GetConfig() MintRateLimitConfig {
+	if m != nil {
+		return m.Config
+	}
+	return MintRateLimitConfig{}
+}
+
+func (m *MintRateLimit) GetPendingConfig() *MintRateLimitConfig {
+	if m != nil {
+		return m.PendingConfig
+	}
+	return nil
+}
+
+func (m *MintRateLimit) GetPendingTime() int64 {
+	if m != nil {
+		return m.PendingTime
+	}
+	return 0
+}
+
+// MintRateBucket is the amount of a denom minted in the bucket of the rolling
+// window starting at start_time
+type MintRateBucket struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// start_time is the unix time (in seconds) at which the bucket starts
+	StartTime int64                  `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Amount    cosmossdk_io_math.Uint `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Uint" json:"amount"`
+}
+
+func (m *MintRateBucket) Reset()         { *m = MintRateBucket{} }
+func (m *MintRateBucket) String() string { return proto.CompactTextString(m) }
+func (*MintRateBucket) ProtoMessage()    {}
+func (*MintRateBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96f873665d232265, []int{2}
+}
+func (m *MintRateBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintRateBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintRateBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintRateBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRateBucket.Merge(m, src)
+}
+func (m *MintRateBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintRateBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRateBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintRateBucket proto.InternalMessageInfo
+
+func (m *MintRateBucket) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MintRateBucket) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MintRateLimitConfig)(nil), "zigchain.factory.MintRateLimitConfig")
+	proto.RegisterType((*MintRateLimit)(nil), "zigchain.factory.MintRateLimit")
+	proto.RegisterType((*MintRateBucket)(nil), "zigchain.factory.MintRateBucket")
+}
+
+func init() {
+	proto.RegisterFile("zigchain/factory/mint_rate_limit.proto", fileDescriptor_96f873665d232265)
+}
+
+var fileDescriptor_96f873665d232265 = []byte{
+	// 412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0xee, 0xd2, 0x40,
+	0x10, 0xc6, 0xbb, 0x14, 0x9a, 0xb0, 0x15, 0xa2, 0x2b, 0x21, 0x95, 0xc4, 0x82, 0x24, 0x1a, 0x62,
+	0x62, 0x9b, 0xe0, 0x1b, 0x54, 0x0f, 0xc4, 0xe0, 0xa5, 0xd1, 0x8b, 0x97, 0x66, 0x6d, 0x97, 0xb2,
+	0x81, 0xdd, 0x25, 0xed, 0x12, 0xc4, 0xa7, 0xd0, 0x9b, 0x8f, 0xe0, 0xd1, 0x83, 0x0f, 0xc1, 0x91,
+	0x78, 0x32, 0x1e, 0x08, 0x81, 0x83, 0xaf, 0x61, 0xba, 0xbb, 0x90, 0x68, 0xf4, 0xe0, 0xff, 0xd2,
+	0x74, 0xbe, 0x99, 0xfd, 0xcd, 0x37, 0xbb, 0x03, 0x1f, 0xbd, 0xa7, 0x79, 0x3a, 0xc7, 0x94, 0x87,
+	0x33, 0x9c, 0x4a, 0x51, 0x6c, 0x43, 0x46, 0xb9, 0x4c, 0x0a, 0x2c, 0x49, 0xb2, 0xa4, 0x8c, 0xca,
+	0x60, 0x55, 0x08, 0x29, 0xd0, 0xed, 0x4b, 0x5d, 0x60, 0xea, 0x7a, 0x9d, 0x5c, 0xe4, 0x42, 0x25,
+	0xc3, 0xea, 0x4f, 0xd7, 0xf5, 0xee, 0xa5, 0xa2, 0x64, 0xa2, 0x4c, 0x74, 0x42, 0x07, 0x26, 0x75,
+	0x07, 0x33, 0xca, 0x45, 0xa8, 0xbe, 0x5a, 0x1a, 0x7e, 0x02, 0xf0, 0xee, 0x4b, 0xca, 0x65, 0x8c,
+	0x25, 0x99, 0x56, 0xdd, 0x9e, 0x09, 0x3e, 0xa3, 0x39, 0x9a, 0xc0, 0x86, 0x6a, 0xee, 0x81, 0x01,
+	0x18, 0x35, 0xa3, 0xf1, 0xee, 0xd0, 0xb7, 0x7e, 0x1c, 0xfa, 0x5d, 0xcd, 0x2b, 0xb3, 0x45, 0x40,
+	0x45, 0xc8, 0xb0, 0x9c, 0x07, 0xaf, 0x29, 0x97, 0xdf, 0xbe, 0x3e, 0x71, 0x4d, 0xa7, 0x2a, 0xfc,
+	0xfc, 0xf3, 0xcb, 0x63, 0x10, 0x6b, 0x00, 0xea, 0x42, 0x67, 0x43, 0x79, 0x26, 0x36, 0x5e, 0x6d,
+	0x00, 0x46, 0xf5, 0xd8, 0x44, 0xa8, 0x0f, 0xdd, 0x02, 0xd3, 0x92, 0x24, 0x19, 0x59, 0xe2, 0xad,
+	0x67, 0xab, 0x24, 0x54, 0xd2, 0xf3, 0x4a, 0x19, 0x1e, 0x01, 0x6c, 0xfd, 0x66, 0x0d, 0x75, 0x60,
+	0x23, 0x23, 0x5c, 0x30, 0x6d, 0x2a, 0xd6, 0x01, 0x9a, 0x40, 0x27, 0x55, 0xa6, 0x55, 0x03, 0x77,
+	0xfc, 0x30, 0xf8, 0xf3, 0xa6, 0x82, 0xbf, 0x4c, 0x18, 0x35, 0xab, 0x91, 0xb4, 0x53, 0x73, 0x1e,
+	0x4d, 0x61, 0x7b, 0x45, 0x78, 0x46, 0x79, 0x9e, 0x18, 0xa2, 0xfd, 0x1f, 0xc4, 0xb8, 0x65, 0x0e,
+	0x9b, 0x2b, 0x7c, 0x00, 0x6f, 0x5d, 0x68, 0x92, 0x32, 0xe2, 0xd5, 0x07, 0x60, 0x64, 0xc7, 0xae,
+	0xd1, 0x5e, 0x51, 0x46, 0x86, 0x1f, 0x01, 0x6c, 0x5f, 0x48, 0xd1, 0x3a, 0x5d, 0x90, 0x7f, 0xcd,
+	0x78, 0x1f, 0xc2, 0x52, 0xe2, 0x42, 0x6a, 0x52, 0x4d, 0x91, 0x9a, 0x4a, 0xa9, 0x38, 0xe8, 0x05,
+	0x74, 0x30, 0x13, 0x6b, 0x2e, 0x3d, 0xfb, 0xc6, 0xcf, 0x65, 0x08, 0xd1, 0x78, 0x77, 0xf2, 0xc1,
+	0xfe, 0xe4, 0x83, 0xe3, 0xc9, 0x07, 0x1f, 0xce, 0xbe, 0xb5, 0x3f, 0xfb, 0xd6, 0xf7, 0xb3, 0x6f,
+	0xbd, 0xf1, 0xae, 0x9b, 0xfa, 0xee, 0xba, 0xab, 0x72, 0xbb, 0x22, 0xe5, 0x5b, 0x47, 0x2d, 0xd3,
+	0xd3, 0x5f, 0x03, 0x00, 0x41, 0x12, 0xae, 0x2e, 0xcc, 0x02, 0x00, 0x00,
+}
+
+func (m *MintRateLimitConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRateLimitConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRateLimitConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RaiseDelay != 0 {
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(m.RaiseDelay))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Window != 0 {
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MintRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingTime != 0 {
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(m.PendingTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PendingConfig != nil {
+		{
+			size, err := m.PendingConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMintRateLimit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintRateBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRateBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRateBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.StartTime != 0 {
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMintRateLimit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMintRateLimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMintRateLimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintRateLimitConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovMintRateLimit(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovMintRateLimit(uint64(m.Window))
+	}
+	if m.RaiseDelay != 0 {
+		n += 1 + sovMintRateLimit(uint64(m.RaiseDelay))
+	}
+	return n
+}
+
+func (m *MintRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMintRateLimit(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovMintRateLimit(uint64(l))
+	if m.PendingConfig != nil {
+		l = m.PendingConfig.Size()
+		n += 1 + l + sovMintRateLimit(uint64(l))
+	}
+	if m.PendingTime != 0 {
+		n += 1 + sovMintRateLimit(uint64(m.PendingTime))
+	}
+	return n
+}
+
+func (m *MintRateBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMintRateLimit(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovMintRateLimit(uint64(m.StartTime))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMintRateLimit(uint64(l))
+	return n
+}
+
+func sovMintRateLimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMintRateLimit(x uint64) (n int) {
+	return sovMintRateLimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintRateLimitConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintRateLimitConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintRateLimitConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaiseDelay", wireType)
+			}
+			m.RaiseDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RaiseDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingConfig == nil {
+				m.PendingConfig = &MintRateLimitConfig{}
+			}
+			if err := m.PendingConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTime", wireType)
+			}
+			m.PendingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintRateBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintRateBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintRateBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMintRateLimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMintRateLimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMintRateLimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMintRateLimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMintRateLimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMintRateLimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMintRateLimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMintRateLimit = fmt.Errorf("proto: unexpected end of group")
+)
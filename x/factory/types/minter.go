@@ -0,0 +1,40 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zigchain/zutils/validators"
+)
+
+// Validate validates a minter
+func (m Minter) Validate() error {
+	_, _, err := DeconstructDenom(m.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			m.Denom,
+			err,
+		)
+	}
+
+	if err := validators.AddressCheck("Minter", m.Address); err != nil {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidAddress,
+			"Minter address invalid (%s) : %s",
+			m.Denom,
+			err,
+		)
+	}
+
+	if m.Allowance.IsNil() {
+		return errorsmod.Wrapf(
+			ErrInvalidMintingCap,
+			"Minter allowance is nil (%s)",
+			m.Denom,
+		)
+	}
+
+	return nil
+}
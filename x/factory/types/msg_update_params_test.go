@@ -5,6 +5,7 @@ import (
 
 	"zigchain/zutils/constants"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"zigchain/testutil/sample"
@@ -14,8 +15,7 @@ var msgUpdateParamsSample = MsgUpdateParams{
 	// use sample.AccAddress() to get a valid address
 	Authority: sample.AccAddress(),
 	Params: Params{
-		CreateFeeDenom:  constants.BondDenom,
-		CreateFeeAmount: uint32(3000),
+		CreateFee: sdk.NewInt64Coin(constants.BondDenom, 3000),
 	},
 }
 
@@ -43,8 +43,7 @@ func TestMsgUpdateParams_ValidateBasic_InvalidAuthority(t *testing.T) {
 	msg := MsgUpdateParams{
 		Authority: "invalid_address",
 		Params: Params{
-			CreateFeeDenom:  constants.BondDenom,
-			CreateFeeAmount: uint32(3000),
+			CreateFee: sdk.NewInt64Coin(constants.BondDenom, 3000),
 		},
 	}
 
@@ -63,7 +62,7 @@ func TestMsgUpdateParams_ValidateBasic_InvalidCreateFeeDenom(t *testing.T) {
 
 	// make a copy of sample message
 	msg := msgUpdateParamsSample
-	msg.Params.CreateFeeDenom = "invalid_denom"
+	msg.Params.CreateFee.Denom = "invalid_denom"
 
 	// validate the message
 	err := msg.ValidateBasic()
@@ -5,6 +5,8 @@ import (
 	"zigchain/zutils/validators"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -13,11 +15,23 @@ import (
 var DefaultCreateFeeDenom = constants.BondDenom
 
 // DefaultCreateFeeAmount is the amount of the fee to create a new factory.
-var DefaultCreateFeeAmount = uint32(1000)
+var DefaultCreateFeeAmount = math.NewInt(1000)
+
+// DefaultCreateFee is the fee to create a new factory.
+var DefaultCreateFee = sdk.NewCoin(DefaultCreateFeeDenom, DefaultCreateFeeAmount)
 
 // DefaultBeneficiary is an account that can pull funds from fees captured in the factory
 var DefaultBeneficiary = ""
 
+// DefaultMaxTransferTaxRate is the highest transfer tax rate the denom admins can set, 10%
+var DefaultMaxTransferTaxRate = math.LegacyNewDecWithPrec(1, 1)
+
+// DefaultLaunchVirtualReserve is the virtual uzig reserve of the bonding curves of the launches, 30,000 ZIG
+var DefaultLaunchVirtualReserve = math.NewInt(30_000_000_000)
+
+// DefaultLaunchGraduationMarketCap is the market cap at which the launches graduate into a dex pool, 400,000 ZIG
+var DefaultLaunchGraduationMarketCap = math.NewInt(400_000_000_000)
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable the param key table for launch module
@@ -27,22 +41,22 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // NewParams creates a new Params instance
 func NewParams(
-	createFeeDenom string,
-	createFeeAmount uint32,
+	createFee sdk.Coin,
 	beneficiary string,
 ) Params {
 	return Params{
-		CreateFeeDenom:  createFeeDenom,
-		CreateFeeAmount: createFeeAmount,
-		Beneficiary:     beneficiary,
+		CreateFee:                 createFee,
+		Beneficiary:               beneficiary,
+		MaxTransferTaxRate:        DefaultMaxTransferTaxRate,
+		LaunchVirtualReserve:      DefaultLaunchVirtualReserve,
+		LaunchGraduationMarketCap: DefaultLaunchGraduationMarketCap,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
 	return NewParams(
-		DefaultCreateFeeDenom,
-		DefaultCreateFeeAmount,
+		DefaultCreateFee,
 		DefaultBeneficiary,
 	)
 }
@@ -55,11 +69,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 // Validate validates the set of params
 func (p Params) Validate() error {
 
-	if err := validateCreateFeeDenom(p.CreateFeeDenom); err != nil {
-		return err
-	}
-
-	if err := validateCreateFeeAmount(p.CreateFeeAmount); err != nil {
+	if err := validateCreateFee(p.CreateFee); err != nil {
 		return err
 	}
 
@@ -74,6 +84,125 @@ func (p Params) Validate() error {
 		}
 	}
 
+	if err := validateVerifiedDenomCurators(p.VerifiedDenomCurators); err != nil {
+		return err
+	}
+
+	if err := validateMaxTransferTaxRate(p.MaxTransferTaxRate); err != nil {
+		return err
+	}
+
+	if err := validateLaunchAmount("launch virtual reserve", p.LaunchVirtualReserve); err != nil {
+		return err
+	}
+
+	if err := validateLaunchAmount("launch graduation market cap", p.LaunchGraduationMarketCap); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IsVerifiedDenomCurator returns true if the address is one of the verified denom curators
+func (p Params) IsVerifiedDenomCurator(address string) bool {
+	for _, curator := range p.VerifiedDenomCurators {
+		if curator == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateVerifiedDenomCurators validates the VerifiedDenomCurators parameter.
+func validateVerifiedDenomCurators(curators []string) error {
+	seen := make(map[string]struct{}, len(curators))
+	for _, curator := range curators {
+		if err := validators.AddressCheck("verified denom curator", curator); err != nil {
+			return errorsmod.Wrapf(
+				ErrorInvalidParamValue,
+				"invalid verified denom curator: %s : %s",
+				curator,
+				err,
+			)
+		}
+
+		if _, ok := seen[curator]; ok {
+			return errorsmod.Wrapf(
+				ErrorInvalidParamValue,
+				"duplicated verified denom curator: %s",
+				curator,
+			)
+		}
+		seen[curator] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetMaxTransferTaxRate returns the highest transfer tax rate the denom admins can set,
+// an unset rate allows no transfer tax
+func (p Params) GetMaxTransferTaxRate() math.LegacyDec {
+	if p.MaxTransferTaxRate.IsNil() {
+		return math.LegacyZeroDec()
+	}
+
+	return p.MaxTransferTaxRate
+}
+
+// validateMaxTransferTaxRate validates the MaxTransferTaxRate parameter, it can be left unset.
+func validateMaxTransferTaxRate(rate math.LegacyDec) error {
+	if rate.IsNil() {
+		return nil
+	}
+
+	if err := ValidateTransferTaxRate(rate); err != nil {
+		return errorsmod.Wrapf(
+			ErrorInvalidParamValue,
+			"invalid max transfer tax rate parameter: %s",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// LaunchpadEnabled tells if the launchpad params are set, the launches are disabled until they are
+func (p Params) LaunchpadEnabled() bool {
+	return !p.LaunchVirtualReserve.IsNil() && p.LaunchVirtualReserve.IsPositive() &&
+		!p.LaunchGraduationMarketCap.IsNil() && p.LaunchGraduationMarketCap.IsPositive()
+}
+
+// validateLaunchAmount validates the LaunchVirtualReserve and LaunchGraduationMarketCap parameters,
+// they can be left unset or zero, which disables the launchpad.
+func validateLaunchAmount(name string, amount math.Int) error {
+	if amount.IsNil() {
+		return nil
+	}
+
+	if amount.IsNegative() {
+		return errorsmod.Wrapf(
+			ErrorInvalidParamValue,
+			"invalid %s parameter: %s, can not be negative",
+			name,
+			amount,
+		)
+	}
+
+	return nil
+}
+
+// validateCreateFee validates the CreateFee parameter.
+func validateCreateFee(createFee sdk.Coin) error {
+
+	if err := validateCreateFeeDenom(createFee.Denom); err != nil {
+		return err
+	}
+
+	if err := validateCreateFeeAmount(createFee.Amount); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -94,8 +223,14 @@ func validateCreateFeeDenom(denom string) error {
 }
 
 // validateCreateFeeAmount validates the CreationFeeAmount parameter.
-func validateCreateFeeAmount(createFeeAmount uint32) error {
-	_ = createFeeAmount
-	// No validation needed for uint32 - it can never be negative or exceed MaxUint32
+func validateCreateFeeAmount(createFeeAmount math.Int) error {
+	if createFeeAmount.IsNil() || createFeeAmount.IsNegative() {
+		return errorsmod.Wrapf(
+			ErrorInvalidParamValue,
+			"invalid create fee amount parameter: %s, cannot be nil or negative",
+			createFeeAmount,
+		)
+	}
+
 	return nil
 }
@@ -4,7 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,12 +29,26 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
-	// createFeeDenom is the denomination of the fee to create a new factory
-	CreateFeeDenom string `protobuf:"bytes,1,opt,name=create_fee_denom,json=createFeeDenom,proto3" json:"create_fee_denom,omitempty"`
-	// createFeeAmount is the amount of the fee to create a new factory
-	CreateFeeAmount uint32 `protobuf:"varint,2,opt,name=create_fee_amount,json=createFeeAmount,proto3" json:"create_fee_amount,omitempty"`
 	// beneficiary is the address that receives the fee to create a new factory
 	Beneficiary string `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// createFee is the fee to create a new factory
+	CreateFee types.Coin `protobuf:"bytes,4,opt,name=create_fee,json=createFee,proto3" json:"create_fee"`
+	// distribute_to_module_accounts includes the balances of the module
+	// accounts, such as the dex pools, in the reward distributions. They are
+	// left out by default.
+	DistributeToModuleAccounts bool `protobuf:"varint,5,opt,name=distribute_to_module_accounts,json=distributeToModuleAccounts,proto3" json:"distribute_to_module_accounts,omitempty"`
+	// verified_denom_curators are the addresses appointed by governance to
+	// verify and unverify denoms in the verified denom registry, besides the
+	// authority
+	VerifiedDenomCurators []string `protobuf:"bytes,6,rep,name=verified_denom_curators,json=verifiedDenomCurators,proto3" json:"verified_denom_curators,omitempty"`
+	// max_transfer_tax_rate caps the transfer tax rate the denom admins can set
+	MaxTransferTaxRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=max_transfer_tax_rate,json=maxTransferTaxRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_transfer_tax_rate"`
+	// launch_virtual_reserve is the virtual uzig reserve of the bonding curves
+	// of the new launches
+	LaunchVirtualReserve cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=launch_virtual_reserve,json=launchVirtualReserve,proto3,customtype=cosmossdk.io/math.Int" json:"launch_virtual_reserve"`
+	// launch_graduation_market_cap is the market cap in uzig at which the new
+	// launches graduate into a dex pool
+	LaunchGraduationMarketCap cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=launch_graduation_market_cap,json=launchGraduationMarketCap,proto3,customtype=cosmossdk.io/math.Int" json:"launch_graduation_market_cap"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -67,21 +84,90 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
-func (m *Params) GetCreateFeeDenom() string {
+func (m *Params) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *Params) GetCreateFee() types.Coin {
+	if m != nil {
+		return m.CreateFee
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetDistributeToModuleAccounts() bool {
+	if m != nil {
+		return m.DistributeToModuleAccounts
+	}
+	return false
+}
+
+func (m *Params) GetVerifiedDenomCurators() []string {
+	if m != nil {
+		return m.VerifiedDenomCurators
+	}
+	return nil
+}
+
+// LegacyParams defines the parameters for the module before the create fee
+// was migrated to a coin, used by the v3 migration only
+type LegacyParams struct {
+	CreateFeeDenom  string `protobuf:"bytes,1,opt,name=create_fee_denom,json=createFeeDenom,proto3" json:"create_fee_denom,omitempty"`
+	CreateFeeAmount uint32 `protobuf:"varint,2,opt,name=create_fee_amount,json=createFeeAmount,proto3" json:"create_fee_amount,omitempty"`
+	Beneficiary     string `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+}
+
+func (m *LegacyParams) Reset()         { *m = LegacyParams{} }
+func (m *LegacyParams) String() string { return proto.CompactTextString(m) }
+func (*LegacyParams) ProtoMessage()    {}
+func (*LegacyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d290ca541a8f6e8, []int{1}
+}
+func (m *LegacyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LegacyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LegacyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LegacyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LegacyParams.Merge(m, src)
+}
+func (m *LegacyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *LegacyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_LegacyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LegacyParams proto.InternalMessageInfo
+
+func (m *LegacyParams) GetCreateFeeDenom() string {
 	if m != nil {
 		return m.CreateFeeDenom
 	}
 	return ""
 }
 
-func (m *Params) GetCreateFeeAmount() uint32 {
+func (m *LegacyParams) GetCreateFeeAmount() uint32 {
 	if m != nil {
 		return m.CreateFeeAmount
 	}
 	return 0
 }
 
-func (m *Params) GetBeneficiary() string {
+func (m *LegacyParams) GetBeneficiary() string {
 	if m != nil {
 		return m.Beneficiary
 	}
@@ -90,27 +176,51 @@ func (m *Params) GetBeneficiary() string {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "zigchain.factory.Params")
+	proto.RegisterType((*LegacyParams)(nil), "zigchain.factory.LegacyParams")
 }
 
 func init() { proto.RegisterFile("zigchain/factory/params.proto", fileDescriptor_7d290ca541a8f6e8) }
 
 var fileDescriptor_7d290ca541a8f6e8 = []byte{
-	// 237 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xad, 0xca, 0x4c, 0x4f,
-	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x4f, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x2f, 0x48, 0x2c,
-	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x49, 0xeb, 0x41, 0xa5,
-	0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x91, 0x94, 0x48, 0x7a, 0x7e,
-	0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0x96, 0x30, 0x72, 0xb1, 0x05, 0x80, 0xcd,
-	0x12, 0xd2, 0xe0, 0x12, 0x48, 0x2e, 0x4a, 0x4d, 0x2c, 0x49, 0x8d, 0x4f, 0x4b, 0x4d, 0x8d, 0x4f,
-	0x49, 0xcd, 0xcb, 0xcf, 0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0xe2, 0x83, 0x88, 0xbb, 0xa5,
-	0xa6, 0xba, 0x80, 0x44, 0x85, 0xb4, 0xb8, 0x04, 0x91, 0x54, 0x26, 0xe6, 0xe6, 0x97, 0xe6, 0x95,
-	0x48, 0x30, 0x29, 0x30, 0x6a, 0xf0, 0x06, 0xf1, 0xc3, 0x95, 0x3a, 0x82, 0x85, 0x85, 0x14, 0xb8,
-	0xb8, 0x93, 0x52, 0xf3, 0x52, 0xd3, 0x32, 0x93, 0x33, 0x13, 0x8b, 0x2a, 0x25, 0x98, 0xc1, 0x06,
-	0x22, 0x0b, 0x59, 0x29, 0xbd, 0x58, 0x20, 0xcf, 0xd8, 0xf5, 0x7c, 0x83, 0x96, 0x24, 0xdc, 0x97,
-	0x15, 0x70, 0x7f, 0x42, 0xdc, 0xe6, 0x64, 0x74, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c,
-	0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72,
-	0x0c, 0x51, 0x12, 0x58, 0x34, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x7d, 0x68, 0x0c,
-	0x08, 0x00, 0x00, 0xff, 0xff, 0xcd, 0xf8, 0x2c, 0x03, 0x3d, 0x01, 0x00, 0x00,
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0xbb, 0xd0, 0x1f, 0xbf, 0x76, 0xf0, 0x4f, 0xd9, 0x80, 0x6e, 0x51, 0x4a, 0xc3, 0xa9,
+	0x21, 0x61, 0x57, 0x30, 0xf1, 0xe0, 0xad, 0x2d, 0xd1, 0x40, 0x24, 0x21, 0x2b, 0xf1, 0xe0, 0x65,
+	0xf2, 0x74, 0xf6, 0x69, 0x99, 0xc0, 0xce, 0xd4, 0x99, 0xd9, 0xa6, 0xf5, 0x05, 0x78, 0xf0, 0xe4,
+	0x4b, 0xf0, 0xe8, 0x91, 0x03, 0x2f, 0x82, 0x23, 0xe1, 0x64, 0x3c, 0x10, 0x03, 0x07, 0x7c, 0x0d,
+	0x9e, 0x4c, 0x77, 0x86, 0x42, 0xa2, 0x89, 0x89, 0x97, 0xcd, 0xcc, 0x3c, 0xdf, 0xe7, 0xfb, 0x99,
+	0x3f, 0xdf, 0x25, 0x4b, 0xef, 0x79, 0x8f, 0xed, 0x03, 0x17, 0x51, 0x17, 0x98, 0x91, 0x6a, 0x14,
+	0xf5, 0x41, 0x41, 0xaa, 0xc3, 0xbe, 0x92, 0x46, 0xfa, 0x95, 0xeb, 0x72, 0xe8, 0xca, 0x8b, 0x73,
+	0x90, 0x72, 0x21, 0xa3, 0xfc, 0x6b, 0x45, 0x8b, 0xf3, 0x3d, 0xd9, 0x93, 0xf9, 0x30, 0x1a, 0x8f,
+	0xdc, 0x6a, 0x95, 0x49, 0x9d, 0x4a, 0x4d, 0x6d, 0xc1, 0x4e, 0x5c, 0xa9, 0x66, 0x67, 0x51, 0x07,
+	0x34, 0x46, 0x83, 0xf5, 0x0e, 0x1a, 0x58, 0x8f, 0x98, 0xe4, 0xc2, 0xd6, 0x57, 0x7e, 0x16, 0xc9,
+	0xcc, 0x6e, 0xbe, 0x0d, 0xbf, 0x4e, 0x66, 0x3b, 0x28, 0xb0, 0xcb, 0x19, 0x07, 0x35, 0x0a, 0xa6,
+	0xeb, 0x5e, 0xa3, 0x1c, 0xdf, 0x5e, 0xf2, 0xdb, 0x84, 0x30, 0x85, 0x60, 0x90, 0x76, 0x11, 0x83,
+	0x62, 0xdd, 0x6b, 0xcc, 0x6e, 0x54, 0x43, 0xc7, 0x1b, 0x13, 0x42, 0x47, 0x08, 0xdb, 0x92, 0x8b,
+	0x56, 0xf9, 0xe4, 0x7c, 0xb9, 0xf0, 0xe5, 0xea, 0x68, 0xd5, 0x8b, 0xcb, 0xb6, 0xef, 0x05, 0xa2,
+	0xdf, 0x24, 0x4b, 0x09, 0xd7, 0x46, 0xf1, 0x4e, 0x66, 0x90, 0x1a, 0x49, 0x53, 0x99, 0x64, 0x87,
+	0x48, 0x81, 0x31, 0x99, 0x09, 0xa3, 0x83, 0xff, 0xea, 0x5e, 0xa3, 0x14, 0x2f, 0xde, 0x88, 0xf6,
+	0xe4, 0x4e, 0x2e, 0x69, 0x3a, 0x85, 0xbf, 0x4b, 0x1e, 0x0e, 0x50, 0xf1, 0x2e, 0xc7, 0x84, 0x26,
+	0x28, 0x64, 0x4a, 0x59, 0xa6, 0xc0, 0x48, 0xa5, 0x83, 0x99, 0xfa, 0x74, 0xa3, 0xdc, 0x0a, 0xce,
+	0x8e, 0xd7, 0xe6, 0xdd, 0xbe, 0x9a, 0x49, 0xa2, 0x50, 0xeb, 0xd7, 0x46, 0x71, 0xd1, 0x8b, 0x17,
+	0xae, 0x1b, 0x37, 0xc7, 0x7d, 0x6d, 0xd7, 0xe6, 0x73, 0xb2, 0x90, 0xc2, 0x90, 0x1a, 0x05, 0x42,
+	0x77, 0x51, 0x51, 0x03, 0x43, 0xaa, 0xc0, 0x60, 0xf0, 0xff, 0xf8, 0x16, 0x5a, 0xcf, 0xc6, 0x27,
+	0xf9, 0x76, 0xbe, 0xfc, 0xc8, 0x7a, 0xea, 0xe4, 0x20, 0xe4, 0x32, 0x4a, 0xc1, 0xec, 0x87, 0xaf,
+	0xb0, 0x07, 0x6c, 0xb4, 0x89, 0xec, 0xec, 0x78, 0x8d, 0x38, 0xe4, 0x26, 0x32, 0x7b, 0x6c, 0x3f,
+	0x85, 0xe1, 0x9e, 0xf3, 0xdc, 0x83, 0x61, 0x0c, 0x06, 0xfd, 0x2e, 0x79, 0x70, 0x08, 0x99, 0x60,
+	0xfb, 0x74, 0xc0, 0x95, 0xc9, 0xe0, 0x90, 0x2a, 0xd4, 0xa8, 0x06, 0x18, 0x94, 0x72, 0xd6, 0x13,
+	0xc7, 0x5a, 0xf8, 0x9d, 0xb5, 0x25, 0xcc, 0x2d, 0xca, 0x96, 0x30, 0x96, 0x32, 0x6f, 0xfd, 0xde,
+	0x58, 0xbb, 0xd8, 0xba, 0xf9, 0xef, 0xc8, 0x63, 0xc7, 0xe9, 0x29, 0x48, 0x32, 0x30, 0x5c, 0x0a,
+	0x9a, 0x82, 0x3a, 0x40, 0x43, 0x19, 0xf4, 0x83, 0xf2, 0x3f, 0xd2, 0xaa, 0xd6, 0xf5, 0xe5, 0xc4,
+	0x74, 0x27, 0xf7, 0x6c, 0x43, 0xff, 0xf9, 0xca, 0x8f, 0xcf, 0xcb, 0xde, 0xc7, 0xab, 0xa3, 0xd5,
+	0xea, 0x24, 0xea, 0xc3, 0x49, 0xd8, 0x6d, 0xca, 0xb6, 0x8b, 0x25, 0xaf, 0x32, 0xb5, 0x5d, 0x2c,
+	0x4d, 0x55, 0xa6, 0x57, 0x3e, 0x78, 0xe4, 0x8e, 0xbd, 0x3f, 0x17, 0xc1, 0x06, 0xa9, 0xdc, 0x04,
+	0xcc, 0x3e, 0x6d, 0xe0, 0xe5, 0x39, 0xbc, 0x37, 0x09, 0x50, 0xfe, 0x70, 0xfe, 0x2a, 0x99, 0xbb,
+	0xa5, 0x84, 0x74, 0x1c, 0x8c, 0x60, 0xaa, 0xee, 0x35, 0xee, 0xc6, 0xf7, 0x27, 0xd2, 0x66, 0xbe,
+	0xfc, 0xf7, 0x60, 0xb7, 0x36, 0x4e, 0x2e, 0x6a, 0xde, 0xe9, 0x45, 0xcd, 0xfb, 0x7e, 0x51, 0xf3,
+	0x3e, 0x5d, 0xd6, 0x0a, 0xa7, 0x97, 0xb5, 0xc2, 0xd7, 0xcb, 0x5a, 0xe1, 0x6d, 0xf0, 0x87, 0x93,
+	0x98, 0x51, 0x1f, 0x75, 0x67, 0x26, 0xff, 0x81, 0x9e, 0xfe, 0x1a, 0x00, 0x4d, 0xf2, 0xd9, 0x3d,
+	0xd7, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -132,13 +242,30 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.CreateFeeDenom != that1.CreateFeeDenom {
+	if this.Beneficiary != that1.Beneficiary {
+		return false
+	}
+	if !this.CreateFee.Equal(&that1.CreateFee) {
 		return false
 	}
-	if this.CreateFeeAmount != that1.CreateFeeAmount {
+	if this.DistributeToModuleAccounts != that1.DistributeToModuleAccounts {
 		return false
 	}
-	if this.Beneficiary != that1.Beneficiary {
+	if len(this.VerifiedDenomCurators) != len(that1.VerifiedDenomCurators) {
+		return false
+	}
+	for i := range this.VerifiedDenomCurators {
+		if this.VerifiedDenomCurators[i] != that1.VerifiedDenomCurators[i] {
+			return false
+		}
+	}
+	if !this.MaxTransferTaxRate.Equal(that1.MaxTransferTaxRate) {
+		return false
+	}
+	if !this.LaunchVirtualReserve.Equal(that1.LaunchVirtualReserve) {
+		return false
+	}
+	if !this.LaunchGraduationMarketCap.Equal(that1.LaunchGraduationMarketCap) {
 		return false
 	}
 	return true
@@ -159,6 +286,95 @@ func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 }
 
 func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LaunchGraduationMarketCap.Size()
+		i -= size
+		if _, err := m.LaunchGraduationMarketCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.LaunchVirtualReserve.Size()
+		i -= size
+		if _, err := m.LaunchVirtualReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaxTransferTaxRate.Size()
+		i -= size
+		if _, err := m.MaxTransferTaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.VerifiedDenomCurators) > 0 {
+		for iNdEx := len(m.VerifiedDenomCurators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerifiedDenomCurators[iNdEx])
+			copy(dAtA[i:], m.VerifiedDenomCurators[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.VerifiedDenomCurators[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.DistributeToModuleAccounts {
+		i--
+		if m.DistributeToModuleAccounts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.CreateFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LegacyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LegacyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LegacyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -197,6 +413,36 @@ func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	return base
 }
 func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.CreateFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DistributeToModuleAccounts {
+		n += 2
+	}
+	if len(m.VerifiedDenomCurators) > 0 {
+		for _, s := range m.VerifiedDenomCurators {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.MaxTransferTaxRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.LaunchVirtualReserve.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.LaunchGraduationMarketCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *LegacyParams) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -251,6 +497,275 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreateFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributeToModuleAccounts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DistributeToModuleAccounts = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedDenomCurators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifiedDenomCurators = append(m.VerifiedDenomCurators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransferTaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTransferTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchVirtualReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LaunchVirtualReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchGraduationMarketCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LaunchGraduationMarketCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LegacyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LegacyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LegacyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CreateFeeDenom", wireType)
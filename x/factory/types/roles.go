@@ -0,0 +1,75 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"zigchain/zutils/validators"
+)
+
+// Roles of a denom, the admin holds every role of its denom and grants the other ones. The minters
+// are not granted a role, they are registered with their allowance through MsgSetMinterAllowance.
+const (
+	RoleAdmin          = "admin"
+	RoleMinter         = "minter"
+	RoleBurner         = "burner"
+	RolePauser         = "pauser"
+	RoleMetadataEditor = "metadata_editor"
+	RoleCapManager     = "cap_manager"
+)
+
+// GrantableRoles are the roles the admin of a denom can grant, in the order they are listed
+var GrantableRoles = []string{
+	RoleBurner,
+	RolePauser,
+	RoleMetadataEditor,
+	RoleCapManager,
+}
+
+// ValidateRole checks a role is one of the roles of a denom
+func ValidateRole(role string) error {
+	if role == RoleAdmin {
+		return nil
+	}
+
+	return ValidateGrantableRole(role)
+}
+
+// ValidateGrantableRole checks a role can be granted by the admin of a denom,
+// the admin role is only transferred by proposing and claiming it
+func ValidateGrantableRole(role string) error {
+	for _, grantable := range GrantableRoles {
+		if role == grantable {
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(
+		ErrInvalidRole,
+		"(%s) only %v can be granted",
+		role,
+		GrantableRoles,
+	)
+}
+
+// RoleLockedWithAdmin tells whether a role stops working once the admin of its denom is disabled,
+// the metadata editors keep their role as they can not change the minting guarantees
+func RoleLockedWithAdmin(role string) bool {
+	return role != RoleMetadataEditor
+}
+
+// Validate checks a granted denom role
+func (r DenomRole) Validate() error {
+	if _, _, err := DeconstructDenom(r.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenom, "(%s) : %s", r.Denom, err)
+	}
+
+	if err := ValidateGrantableRole(r.Role); err != nil {
+		return err
+	}
+
+	if err := validators.AddressCheck("Role holder", r.Address); err != nil {
+		return err
+	}
+
+	return nil
+}
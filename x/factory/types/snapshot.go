@@ -0,0 +1,45 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zigchain/zutils/validators"
+)
+
+// Validate validates a snapshot
+func (s Snapshot) Validate() error {
+	_, _, err := DeconstructDenom(s.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			s.Denom,
+			err,
+		)
+	}
+
+	if s.Supply.IsNil() {
+		return errorsmod.Wrapf(ErrInvalidDenom, "snapshot %d of %s has a nil supply", s.Id, s.Denom)
+	}
+
+	return nil
+}
+
+// Validate validates the balance of an account at a snapshot
+func (b SnapshotBalance) Validate() error {
+	if err := validators.AddressCheck("Address", b.Address); err != nil {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidAddress,
+			"SnapshotBalance address invalid (%s) : %s",
+			b.Denom,
+			err,
+		)
+	}
+
+	if b.Amount.IsNil() {
+		return errorsmod.Wrapf(ErrInvalidDenom, "snapshot %d balance of %s is nil", b.SnapshotId, b.Address)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"zigchain/zutils/validators"
+)
+
+// ValidateTransferTaxRate validates a transfer tax rate, which has to be lower than 1 so the tax
+// never takes the whole amount sent
+func ValidateTransferTaxRate(rate math.LegacyDec) error {
+	if rate.IsNil() || rate.IsNegative() || rate.GTE(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(
+			ErrInvalidTransferTax,
+			"rate (%s) must be at least 0 and lower than 1",
+			rate,
+		)
+	}
+
+	return nil
+}
+
+// Validate validates a transfer tax
+func (t TransferTax) Validate() error {
+	if _, _, err := DeconstructDenom(t.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenom, "(%s) : %s", t.Denom, err)
+	}
+
+	if err := ValidateTransferTaxRate(t.Rate); err != nil {
+		return err
+	}
+
+	if !t.Rate.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidTransferTax, "rate of denom (%s) must be positive", t.Denom)
+	}
+
+	return validators.AddressCheck("recipient", t.Recipient)
+}
+
+// Tax returns the tax collected on an amount of the denom, rounded down
+func (t TransferTax) Tax(amount math.Int) math.Int {
+	return t.Rate.MulInt(amount).TruncateInt()
+}
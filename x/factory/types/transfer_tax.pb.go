@@ -0,0 +1,656 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zigchain/factory/transfer_tax.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransferTax is the tax collected on every transfer of a factory denom, paid
+// by the sender on top of the amount sent to the tax recipient
+type TransferTax struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the share of the amount sent collected as tax, capped by the
+	// max_transfer_tax_rate param
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// recipient is the treasury address receiving the tax
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *TransferTax) Reset()         { *m = TransferTax{} }
+func (m *TransferTax) String() string { return proto.CompactTextString(m) }
+func (*TransferTax) ProtoMessage()    {}
+func (*TransferTax) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd7ff385f01cc06c, []int{0}
+}
+func (m *TransferTax) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferTax) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferTax.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferTax) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferTax.Merge(m, src)
+}
+func (m *TransferTax) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferTax) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferTax.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferTax proto.InternalMessageInfo
+
+func (m *TransferTax) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TransferTax) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// TransferTaxExemption exempts the transfers of a denom from and to an address
+// from its transfer tax
+type TransferTaxExemption struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *TransferTaxExemption) Reset()         { *m = TransferTaxExemption{} }
+func (m *TransferTaxExemption) String() string { return proto.CompactTextString(m) }
+func (*TransferTaxExemption) ProtoMessage()    {}
+func (*TransferTaxExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd7ff385f01cc06c, []int{1}
+}
+func (m *TransferTaxExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferTaxExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferTaxExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferTaxExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferTaxExemption.Merge(m, src)
+}
+func (m *TransferTaxExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferTaxExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferTaxExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferTaxExemption proto.InternalMessageInfo
+
+func (m *TransferTaxExemption) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TransferTaxExemption) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*TransferTax)(nil), "zigchain.factory.TransferTax")
+	proto.RegisterType((*TransferTaxExemption)(nil), "zigchain.factory.TransferTaxExemption")
+}
+
+func init() {
+	proto.RegisterFile("zigchain/factory/transfer_tax.proto", fileDescriptor_dd7ff385f01cc06c)
+}
+
+var fileDescriptor_dd7ff385f01cc06c = []byte{
+	// 313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xbf, 0x4e, 0xc3, 0x30,
+	0x10, 0xc6, 0xe3, 0xf2, 0x4f, 0x35, 0x0b, 0x44, 0x19, 0x4c, 0x91, 0x5c, 0x54, 0x16, 0x84, 0xd4,
+	0x44, 0x2a, 0x52, 0x77, 0xaa, 0xb2, 0x20, 0xa6, 0xd2, 0x89, 0xa5, 0x18, 0xc7, 0x4d, 0x2d, 0x14,
+	0x3b, 0xb2, 0x3d, 0x24, 0x3c, 0x05, 0x6f, 0x01, 0x23, 0x43, 0x1f, 0xa2, 0x63, 0xd5, 0x09, 0x31,
+	0x54, 0x28, 0x19, 0x78, 0x0d, 0xd4, 0x38, 0xa5, 0x2c, 0x88, 0xc5, 0xf2, 0xdd, 0xf7, 0xd3, 0xf7,
+	0x9d, 0xee, 0xe0, 0xe9, 0x13, 0x8f, 0xe8, 0x84, 0x70, 0x11, 0x8c, 0x09, 0x35, 0x52, 0x65, 0x81,
+	0x51, 0x44, 0xe8, 0x31, 0x53, 0x23, 0x43, 0x52, 0x3f, 0x51, 0xd2, 0x48, 0xf7, 0x60, 0x0d, 0xf9,
+	0x15, 0xd4, 0xf0, 0x22, 0x19, 0xc9, 0x52, 0x0c, 0x56, 0x3f, 0xcb, 0x35, 0x8e, 0xa8, 0xd4, 0xb1,
+	0xd4, 0x23, 0x2b, 0xd8, 0xa2, 0x92, 0x0e, 0x49, 0xcc, 0x85, 0x0c, 0xca, 0xd7, 0xb6, 0x5a, 0x2f,
+	0x00, 0xee, 0x0f, 0xab, 0xb0, 0x21, 0x49, 0x5d, 0x0f, 0xee, 0x84, 0x4c, 0xc8, 0x18, 0x81, 0x13,
+	0x70, 0x56, 0x1f, 0xd8, 0xc2, 0xbd, 0x86, 0xdb, 0x8a, 0x18, 0x86, 0x6a, 0xab, 0x66, 0xaf, 0x3b,
+	0x5b, 0x36, 0x9d, 0x8f, 0x65, 0xf3, 0xd8, 0x9a, 0xeb, 0xf0, 0xd1, 0xe7, 0x32, 0x88, 0x89, 0x99,
+	0xf8, 0x37, 0x2c, 0x22, 0x34, 0xeb, 0x33, 0xba, 0x98, 0xb6, 0x61, 0x95, 0xdd, 0x67, 0xf4, 0xf5,
+	0xeb, 0xed, 0x1c, 0x0c, 0x4a, 0x0f, 0xb7, 0x0b, 0xeb, 0x8a, 0x51, 0x9e, 0x70, 0x26, 0x0c, 0xda,
+	0x2a, 0x0d, 0xd1, 0x62, 0xda, 0xf6, 0x2a, 0xfa, 0x32, 0x0c, 0x15, 0xd3, 0xfa, 0xd6, 0x28, 0x2e,
+	0xa2, 0xc1, 0x06, 0x6d, 0xdd, 0x43, 0xef, 0xd7, 0xa0, 0x57, 0x29, 0x8b, 0x13, 0xc3, 0xa5, 0xf8,
+	0x63, 0xe2, 0x0e, 0xdc, 0x23, 0xd6, 0x09, 0xd5, 0xfe, 0xc9, 0x58, 0x83, 0xbd, 0xce, 0x2c, 0xc7,
+	0x60, 0x9e, 0x63, 0xf0, 0x99, 0x63, 0xf0, 0x5c, 0x60, 0x67, 0x5e, 0x60, 0xe7, 0xbd, 0xc0, 0xce,
+	0x1d, 0xfa, 0x39, 0x50, 0xba, 0x39, 0x51, 0x96, 0x30, 0xfd, 0xb0, 0x5b, 0xae, 0xf1, 0xe2, 0x7b,
+	0x00, 0xc8, 0x48, 0x59, 0xd2, 0xc3, 0x01, 0x00, 0x00,
+}
+
+func (m *TransferTax) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferTax) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferTax) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTransferTax(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTransferTax(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTransferTax(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferTaxExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferTaxExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferTaxExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTransferTax(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTransferTax(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransferTax(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransferTax(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransferTax) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTransferTax(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTransferTax(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTransferTax(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferTaxExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTransferTax(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTransferTax(uint64(l))
+	}
+	return n
+}
+
+func sovTransferTax(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransferTax(x uint64) (n int) {
+	return sovTransferTax(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TransferTax) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransferTax
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferTax: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferTax: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferTax
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferTax
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferTax
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransferTax(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransferTax
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferTaxExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransferTax
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferTaxExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferTaxExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferTax
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferTax
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferTax
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferTax
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransferTax(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransferTax
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTransferTax(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTransferTax
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransferTax
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransferTax
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTransferTax
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTransferTax
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTransferTax
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTransferTax        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTransferTax          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTransferTax = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"regexp"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"zigchain/zutils/validators"
+)
+
+// MaxVerifiedDenomDecimals is the largest number of decimals of a verified denom
+const MaxVerifiedDenomDecimals uint32 = 18
+
+// tickerRegex matches the canonical tickers: an upper case letter followed by
+// 1 to 11 upper case letters or digits
+var tickerRegex = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,11}$`)
+
+// ValidateTicker validates the canonical ticker of a verified denom
+func ValidateTicker(ticker string) error {
+	if !tickerRegex.MatchString(ticker) {
+		return errorsmod.Wrapf(
+			ErrInvalidVerifiedDenom,
+			"ticker (%s) must be 2 to 12 upper case letters or digits starting with a letter",
+			ticker,
+		)
+	}
+
+	return nil
+}
+
+// Validate validates a verified denom
+func (v VerifiedDenom) Validate() error {
+	if _, _, err := DeconstructDenom(v.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenom, "(%s) : %s", v.Denom, err)
+	}
+
+	if err := ValidateTicker(v.Ticker); err != nil {
+		return err
+	}
+
+	if !validators.IsSHA256Hash(v.UriHash) {
+		return errorsmod.Wrapf(
+			ErrInvalidVerifiedDenom,
+			"uri hash (%s) of denom (%s) must be a valid SHA256 hash string of 64 alpha numeric characters",
+			v.UriHash,
+			v.Denom,
+		)
+	}
+
+	if v.Decimals > MaxVerifiedDenomDecimals {
+		return errorsmod.Wrapf(
+			ErrInvalidVerifiedDenom,
+			"decimals %d of denom (%s) exceed the maximum of %d",
+			v.Decimals,
+			v.Denom,
+			MaxVerifiedDenomDecimals,
+		)
+	}
+
+	return nil
+}
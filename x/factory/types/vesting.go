@@ -0,0 +1,148 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zigchain/zutils/validators"
+)
+
+// IsPeriodic returns true if the schedule vests by periods rather than continuously
+func (s VestingSchedule) IsPeriodic() bool {
+	return len(s.Periods) > 0
+}
+
+// Validate validates a vesting schedule of the amount
+func (s VestingSchedule) Validate(amount math.Uint) error {
+	if s.StartTime < 0 {
+		return errorsmod.Wrapf(ErrInvalidVestingSchedule, "start time %d is negative", s.StartTime)
+	}
+
+	if !s.IsPeriodic() {
+		if s.EndTime <= s.StartTime {
+			return errorsmod.Wrapf(
+				ErrInvalidVestingSchedule,
+				"end time %d must be after start time %d",
+				s.EndTime,
+				s.StartTime,
+			)
+		}
+
+		return nil
+	}
+
+	if s.EndTime != 0 {
+		return errorsmod.Wrap(ErrInvalidVestingSchedule, "end time must not be set with periods")
+	}
+
+	total := math.ZeroUint()
+	for i, period := range s.Periods {
+		if period.Length <= 0 {
+			return errorsmod.Wrapf(ErrInvalidVestingSchedule, "period %d length must be greater than 0", i)
+		}
+
+		if period.Amount.IsNil() {
+			return errorsmod.Wrapf(ErrInvalidVestingSchedule, "period %d amount is nil", i)
+		}
+
+		total = total.Add(period.Amount)
+	}
+
+	if !total.Equal(amount) {
+		return errorsmod.Wrapf(
+			ErrInvalidVestingSchedule,
+			"periods total %s does not match the amount %s",
+			total,
+			amount,
+		)
+	}
+
+	return nil
+}
+
+// VestedAmount returns the part of the amount vested by the schedule at the unix time now,
+// the same way as the vesting accounts of the auth module
+func (s VestingSchedule) VestedAmount(amount math.Uint, now int64) math.Uint {
+	if now <= s.StartTime {
+		return math.ZeroUint()
+	}
+
+	if s.IsPeriodic() {
+		vested := math.ZeroUint()
+		end := s.StartTime
+		for _, period := range s.Periods {
+			end += period.Length
+			if now < end {
+				break
+			}
+			vested = vested.Add(period.Amount)
+		}
+
+		return vested
+	}
+
+	if now >= s.EndTime {
+		return amount
+	}
+
+	// #nosec G115 -- now is between the start and the end time here, both are positive
+	elapsed, length := math.NewUint(uint64(now-s.StartTime)), math.NewUint(uint64(s.EndTime-s.StartTime))
+
+	return amount.Mul(elapsed).Quo(length)
+}
+
+// Vested returns the amount of the grant vested at the unix time now
+func (g VestingGrant) Vested(now int64) math.Uint {
+	return g.Schedule.VestedAmount(g.Amount, now)
+}
+
+// Claimable returns the amount of an escrowed grant its recipient can claim at the unix time now
+func (g VestingGrant) Claimable(now int64) math.Uint {
+	vested := g.Vested(now)
+	if !g.Escrow || !vested.GT(g.Claimed) {
+		return math.ZeroUint()
+	}
+
+	return vested.Sub(g.Claimed)
+}
+
+// Validate validates a vesting grant
+func (g VestingGrant) Validate() error {
+	_, _, err := DeconstructDenom(g.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(
+			ErrInvalidDenom,
+			"(%s) : %s",
+			g.Denom,
+			err,
+		)
+	}
+
+	if err := validators.AddressCheck("Recipient", g.Recipient); err != nil {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidAddress,
+			"VestingGrant recipient invalid (%s) : %s",
+			g.Denom,
+			err,
+		)
+	}
+
+	if g.Amount.IsNil() || g.Amount.IsZero() {
+		return errorsmod.Wrapf(
+			ErrInvalidVestingSchedule,
+			"VestingGrant amount must be greater than 0 (%s)",
+			g.Denom,
+		)
+	}
+
+	if g.Claimed.IsNil() || g.Claimed.GT(g.Amount) {
+		return errorsmod.Wrapf(
+			ErrInvalidVestingSchedule,
+			"VestingGrant claimed amount is nil or exceeds the amount (%s)",
+			g.Denom,
+		)
+	}
+
+	return g.Schedule.Validate(g.Amount)
+}
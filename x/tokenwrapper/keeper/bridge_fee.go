@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	"zigchain/x/tokenwrapper/types"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetBridgeFee returns the bridge fee of the params charged on a native amount
+func (k Keeper) GetBridgeFee(ctx sdk.Context, amount sdkmath.Int) sdkmath.Int {
+	return k.GetParams(ctx).BridgeFee(amount)
+}
+
+// GetTotalBridgeFeesIn returns the total bridge fees paid on the incoming transfers
+func (k Keeper) GetTotalBridgeFeesIn(ctx sdk.Context) sdkmath.Int {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.TotalBridgeFeesInKey)
+	if bz == nil {
+		return sdkmath.ZeroInt()
+	}
+	return sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(bz))
+}
+
+// SetTotalBridgeFeesIn sets the total bridge fees paid on the incoming transfers
+func (k Keeper) SetTotalBridgeFeesIn(ctx sdk.Context, amount sdkmath.Int) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.TotalBridgeFeesInKey, amount.BigInt().Bytes())
+}
+
+// GetTotalBridgeFeesOut returns the total bridge fees paid on the acknowledged outgoing transfers
+func (k Keeper) GetTotalBridgeFeesOut(ctx sdk.Context) sdkmath.Int {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.TotalBridgeFeesOutKey)
+	if bz == nil {
+		return sdkmath.ZeroInt()
+	}
+	return sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(bz))
+}
+
+// SetTotalBridgeFeesOut sets the total bridge fees paid on the acknowledged outgoing transfers
+func (k Keeper) SetTotalBridgeFeesOut(ctx sdk.Context, amount sdkmath.Int) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.TotalBridgeFeesOutKey, amount.BigInt().Bytes())
+}
+
+// SetPendingBridgeFee sets the bridge fee escrowed for a sent packet
+func (k Keeper) SetPendingBridgeFee(ctx sdk.Context, pendingFee types.PendingBridgeFee) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PendingBridgeFeeKeyPrefix)
+	store.Set(types.PendingBridgeFeeKey(pendingFee.NativePort, pendingFee.NativeChannel, pendingFee.Sequence), k.cdc.MustMarshal(&pendingFee))
+}
+
+// GetPendingBridgeFee returns the bridge fee escrowed for a packet sent on a native port and channel
+func (k Keeper) GetPendingBridgeFee(ctx sdk.Context, nativePort string, nativeChannel string, sequence uint64) (pendingFee types.PendingBridgeFee, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PendingBridgeFeeKeyPrefix)
+	bz := store.Get(types.PendingBridgeFeeKey(nativePort, nativeChannel, sequence))
+	if bz == nil {
+		return pendingFee, false
+	}
+	k.cdc.MustUnmarshal(bz, &pendingFee)
+	return pendingFee, true
+}
+
+// RemovePendingBridgeFee removes the bridge fee escrowed for a packet sent on a native port and channel
+func (k Keeper) RemovePendingBridgeFee(ctx sdk.Context, nativePort string, nativeChannel string, sequence uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PendingBridgeFeeKeyPrefix)
+	store.Delete(types.PendingBridgeFeeKey(nativePort, nativeChannel, sequence))
+}
+
+// GetAllPendingBridgeFees returns all escrowed bridge fees
+func (k Keeper) GetAllPendingBridgeFees(ctx sdk.Context) (list []types.PendingBridgeFee) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PendingBridgeFeeKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingFee types.PendingBridgeFee
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingFee)
+		list = append(list, pendingFee)
+	}
+
+	return
+}
+
+// CollectInboundBridgeFee pays the bridge fee of an incoming transfer from the module wallet to the
+// fee recipient, out of the native tokens unlocked for the transfer
+func (k Keeper) CollectInboundBridgeFee(ctx sdk.Context, route types.BridgeRoute, fee sdkmath.Int) error {
+	if !fee.IsPositive() {
+		return nil
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(k.GetParams(ctx).BridgeFeeRecipient)
+	if err != nil {
+		return fmt.Errorf("invalid bridge fee recipient: %w", err)
+	}
+
+	if err := k.UnlockTokens(ctx, recipient, sdk.NewCoins(sdk.NewCoin(route.NativeDenom, fee))); err != nil {
+		return fmt.Errorf("failed to pay bridge fee: %w", err)
+	}
+
+	k.SetTotalBridgeFeesIn(ctx, k.GetTotalBridgeFeesIn(ctx).Add(fee))
+
+	return nil
+}
+
+// EscrowOutboundBridgeFee keeps the bridge fee of a sent packet, locked in the module wallet along with
+// the native amount, until the packet is acknowledged or timed out
+func (k Keeper) EscrowOutboundBridgeFee(ctx sdk.Context, route types.BridgeRoute, sender sdk.AccAddress, sequence uint64, fee sdkmath.Int) {
+	if !fee.IsPositive() {
+		return
+	}
+
+	k.SetPendingBridgeFee(ctx, types.PendingBridgeFee{
+		NativePort:    route.NativePort,
+		NativeChannel: route.NativeChannel,
+		Sequence:      sequence,
+		Sender:        sender.String(),
+		Recipient:     k.GetParams(ctx).BridgeFeeRecipient,
+		NativeDenom:   route.NativeDenom,
+		Amount:        fee,
+	})
+}
+
+// PayOutboundBridgeFee pays the bridge fee escrowed for an acknowledged packet to the fee recipient
+// of the params when the packet was sent
+func (k Keeper) PayOutboundBridgeFee(ctx sdk.Context, nativePort string, nativeChannel string, sequence uint64) error {
+	pendingFee, found := k.GetPendingBridgeFee(ctx, nativePort, nativeChannel, sequence)
+	if !found {
+		return nil
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(pendingFee.Recipient)
+	if err != nil {
+		return fmt.Errorf("invalid bridge fee recipient: %w", err)
+	}
+
+	if err := k.UnlockTokens(ctx, recipient, sdk.NewCoins(sdk.NewCoin(pendingFee.NativeDenom, pendingFee.Amount))); err != nil {
+		return fmt.Errorf("failed to pay bridge fee: %w", err)
+	}
+
+	k.RemovePendingBridgeFee(ctx, nativePort, nativeChannel, sequence)
+	k.SetTotalBridgeFeesOut(ctx, k.GetTotalBridgeFeesOut(ctx).Add(pendingFee.Amount))
+
+	return nil
+}
+
+// RefundOutboundBridgeFee returns the bridge fee escrowed for a packet refunded on an error
+// acknowledgement or a timeout to its sender, and returns the refunded fee
+func (k Keeper) RefundOutboundBridgeFee(ctx sdk.Context, nativePort string, nativeChannel string, sequence uint64) (sdkmath.Int, error) {
+	pendingFee, found := k.GetPendingBridgeFee(ctx, nativePort, nativeChannel, sequence)
+	if !found {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	sender, err := sdk.AccAddressFromBech32(pendingFee.Sender)
+	if err != nil {
+		return sdkmath.ZeroInt(), fmt.Errorf("invalid bridge fee sender: %w", err)
+	}
+
+	if err := k.UnlockTokens(ctx, sender, sdk.NewCoins(sdk.NewCoin(pendingFee.NativeDenom, pendingFee.Amount))); err != nil {
+		return sdkmath.ZeroInt(), fmt.Errorf("failed to refund bridge fee: %w", err)
+	}
+
+	k.RemovePendingBridgeFee(ctx, nativePort, nativeChannel, sequence)
+
+	return pendingFee.Amount, nil
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"zigchain/app"
+	"zigchain/testutil/sample"
+	"zigchain/zutils/constants"
+)
+
+// setBridgeFee enables a bridge fee of 1% with a minimum of 100 paid to a new recipient
+func setBridgeFee(t *testing.T, testApp *app.App, ctx sdk.Context) sdk.AccAddress {
+	recipient := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	params := testApp.TokenwrapperKeeper.GetParams(ctx)
+	params.BridgeFeeBps = 100
+	params.BridgeFeeMin = sdkmath.NewInt(100)
+	params.BridgeFeeRecipient = recipient.String()
+	require.NoError(t, testApp.TokenwrapperKeeper.SetParams(ctx, params))
+
+	return recipient
+}
+
+// Positive test cases
+
+func TestGetBridgeFee(t *testing.T) {
+	// Test case: no fee is charged by default, then the fee of the params is charged
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	require.Equal(t, sdkmath.ZeroInt(), k.GetBridgeFee(ctx, sdkmath.NewInt(1_000_000)))
+
+	setBridgeFee(t, testApp, ctx)
+	require.Equal(t, sdkmath.NewInt(10_000), k.GetBridgeFee(ctx, sdkmath.NewInt(1_000_000)))
+	require.Equal(t, sdkmath.NewInt(100), k.GetBridgeFee(ctx, sdkmath.NewInt(1_000)))
+}
+
+func TestCollectInboundBridgeFee(t *testing.T) {
+	// Test case: the fee of an incoming transfer is paid from the module wallet to the recipient
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	recipient := setBridgeFee(t, testApp, ctx)
+	fundModuleWallet(t, testApp, ctx, 1_000)
+
+	route := bridgeRouteSample("channel-0")
+	require.NoError(t, k.CollectInboundBridgeFee(ctx, route, sdkmath.NewInt(300)))
+	require.NoError(t, k.CollectInboundBridgeFee(ctx, route, sdkmath.ZeroInt()))
+
+	require.Equal(t, sdkmath.NewInt(300), testApp.BankKeeper.GetBalance(ctx, recipient, constants.BondDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(300), k.GetTotalBridgeFeesIn(ctx))
+	require.Equal(t, sdkmath.ZeroInt(), k.GetTotalBridgeFeesOut(ctx))
+}
+
+func TestOutboundBridgeFee_Pay(t *testing.T) {
+	// Test case: the escrowed fee of an acknowledged packet is paid to the recipient of the params when it was sent
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	recipient := setBridgeFee(t, testApp, ctx)
+	fundModuleWallet(t, testApp, ctx, 1_000)
+
+	route := bridgeRouteSample("channel-0")
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	k.EscrowOutboundBridgeFee(ctx, route, sender, 7, sdkmath.NewInt(400))
+	k.EscrowOutboundBridgeFee(ctx, route, sender, 8, sdkmath.ZeroInt())
+
+	pendingFee, found := k.GetPendingBridgeFee(ctx, "transfer", "channel-0", 7)
+	require.True(t, found)
+	require.Equal(t, recipient.String(), pendingFee.Recipient)
+	require.Len(t, k.GetAllPendingBridgeFees(ctx), 1)
+
+	// a later change of the recipient does not affect the escrowed fee
+	params := k.GetParams(ctx)
+	params.BridgeFeeRecipient = sample.AccAddress()
+	require.NoError(t, k.SetParams(ctx, params))
+
+	require.NoError(t, k.PayOutboundBridgeFee(ctx, "transfer", "channel-0", 7))
+	require.Equal(t, sdkmath.NewInt(400), testApp.BankKeeper.GetBalance(ctx, recipient, constants.BondDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(400), k.GetTotalBridgeFeesOut(ctx))
+	require.Empty(t, k.GetAllPendingBridgeFees(ctx))
+
+	// a packet without escrowed fee pays nothing
+	require.NoError(t, k.PayOutboundBridgeFee(ctx, "transfer", "channel-0", 8))
+	require.Equal(t, sdkmath.NewInt(400), k.GetTotalBridgeFeesOut(ctx))
+}
+
+func TestOutboundBridgeFee_Refund(t *testing.T) {
+	// Test case: the escrowed fee of a refunded packet returns to the sender and is not counted as paid
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	setBridgeFee(t, testApp, ctx)
+	fundModuleWallet(t, testApp, ctx, 1_000)
+
+	route := bridgeRouteSample("channel-0")
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	k.EscrowOutboundBridgeFee(ctx, route, sender, 7, sdkmath.NewInt(400))
+
+	fee, err := k.RefundOutboundBridgeFee(ctx, "transfer", "channel-0", 7)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(400), fee)
+	require.Equal(t, sdkmath.NewInt(400), testApp.BankKeeper.GetBalance(ctx, sender, constants.BondDenom).Amount)
+	require.Equal(t, sdkmath.ZeroInt(), k.GetTotalBridgeFeesOut(ctx))
+	require.Empty(t, k.GetAllPendingBridgeFees(ctx))
+
+	fee, err = k.RefundOutboundBridgeFee(ctx, "transfer", "channel-0", 7)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.ZeroInt(), fee)
+}
+
+// Negative test cases
+
+func TestOutboundBridgeFee_InsufficientModuleBalance(t *testing.T) {
+	// Test case: the escrowed fee is kept when the module wallet cannot pay it
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	setBridgeFee(t, testApp, ctx)
+
+	route := bridgeRouteSample("channel-0")
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	k.EscrowOutboundBridgeFee(ctx, route, sender, 7, sdkmath.NewInt(400))
+
+	require.ErrorContains(t, k.PayOutboundBridgeFee(ctx, "transfer", "channel-0", 7), "failed to pay bridge fee")
+	_, err := k.RefundOutboundBridgeFee(ctx, "transfer", "channel-0", 7)
+	require.ErrorContains(t, err, "failed to refund bridge fee")
+
+	_, found := k.GetPendingBridgeFee(ctx, "transfer", "channel-0", 7)
+	require.True(t, found)
+	require.Equal(t, sdkmath.ZeroInt(), k.GetTotalBridgeFeesOut(ctx))
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"zigchain/x/tokenwrapper/types"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetBridgeRoute sets a bridge route, keyed by its native port and channel
+func (k Keeper) SetBridgeRoute(ctx sdk.Context, route types.BridgeRoute) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.BridgeRouteKeyPrefix)
+	store.Set(types.BridgeRouteKey(route.NativePort, route.NativeChannel), k.cdc.MustMarshal(&route))
+}
+
+// GetBridgeRoute returns the bridge route of a native port and channel
+func (k Keeper) GetBridgeRoute(ctx sdk.Context, nativePort string, nativeChannel string) (route types.BridgeRoute, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.BridgeRouteKeyPrefix)
+	bz := store.Get(types.BridgeRouteKey(nativePort, nativeChannel))
+	if bz == nil {
+		return route, false
+	}
+	k.cdc.MustUnmarshal(bz, &route)
+	return route, true
+}
+
+// GetAllBridgeRoutes returns all bridge routes
+func (k Keeper) GetAllBridgeRoutes(ctx sdk.Context) (list []types.BridgeRoute) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.BridgeRouteKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var route types.BridgeRoute
+		k.cdc.MustUnmarshal(iterator.Value(), &route)
+		list = append(list, route)
+	}
+
+	return
+}
+
+// AddToRouteTransferredIn adds an amount to the native tokens transferred in over a route
+// and to the module total
+func (k Keeper) AddToRouteTransferredIn(ctx sdk.Context, route types.BridgeRoute, amount sdkmath.Int) {
+	if route.TotalTransferredIn.IsNil() {
+		route.TotalTransferredIn = sdkmath.ZeroInt()
+	}
+	route.TotalTransferredIn = route.TotalTransferredIn.Add(amount)
+	k.SetBridgeRoute(ctx, route)
+	k.AddToTotalTransferredIn(ctx, amount)
+}
+
+// AddToRouteTransferredOut adds an amount to the native tokens transferred out over a route
+// and to the module total
+func (k Keeper) AddToRouteTransferredOut(ctx sdk.Context, route types.BridgeRoute, amount sdkmath.Int) {
+	if route.TotalTransferredOut.IsNil() {
+		route.TotalTransferredOut = sdkmath.ZeroInt()
+	}
+	route.TotalTransferredOut = route.TotalTransferredOut.Add(amount)
+	k.SetBridgeRoute(ctx, route)
+	k.AddToTotalTransferredOut(ctx, amount)
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"zigchain/x/tokenwrapper/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The IBC settings of the single route the module bridged before the bridge routes, they are only
+// kept for the store migrations that set them and convert them into the first bridge route.
+
+// GetNativeClientId returns the native client ID for IBC transfers
+func (k Keeper) GetNativeClientId(ctx sdk.Context) string {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.NativeClientIdKey)
+	if bz == nil {
+		return ""
+	}
+	return string(bz)
+}
+
+// SetNativeClientId sets the native client ID for IBC transfers
+func (k Keeper) SetNativeClientId(ctx sdk.Context, clientId string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.NativeClientIdKey, []byte(clientId))
+}
+
+// GetCounterpartyClientId returns the counterparty client ID for IBC transfers
+func (k Keeper) GetCounterpartyClientId(ctx sdk.Context) string {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.CounterpartyClientIdKey)
+	if bz == nil {
+		return ""
+	}
+	return string(bz)
+}
+
+// SetCounterpartyClientId sets the counterparty client ID for IBC transfers
+func (k Keeper) SetCounterpartyClientId(ctx sdk.Context, clientId string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.CounterpartyClientIdKey, []byte(clientId))
+}
+
+// GetNativePort returns the native port for IBC transfers
+func (k Keeper) GetNativePort(ctx sdk.Context) string {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.NativePortKey)
+	if bz == nil {
+		return ""
+	}
+	return string(bz)
+}
+
+// SetNativePort sets the native port for IBC transfers
+func (k Keeper) SetNativePort(ctx sdk.Context, port string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.NativePortKey, []byte(port))
+}
+
+// GetCounterpartyPort returns the counterparty port for IBC transfers
+func (k Keeper) GetCounterpartyPort(ctx sdk.Context) string {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.CounterpartyPortKey)
+	if bz == nil {
+		return ""
+	}
+	return string(bz)
+}
+
+// SetCounterpartyPort sets the counterparty port for IBC transfers
+func (k Keeper) SetCounterpartyPort(ctx sdk.Context, port string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.CounterpartyPortKey, []byte(port))
+}
+
+// GetNativeChannel returns the native channel for IBC transfers
+func (k Keeper) GetNativeChannel(ctx sdk.Context) string {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.NativeChannelKey)
+	if bz == nil {
+		return ""
+	}
+	return string(bz)
+}
+
+// SetNativeChannel sets the native channel for IBC transfers
+func (k Keeper) SetNativeChannel(ctx sdk.Context, channel string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.NativeChannelKey, []byte(channel))
+}
+
+// GetCounterpartyChannel returns the counterparty channel for IBC transfers
+func (k Keeper) GetCounterpartyChannel(ctx sdk.Context) string {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.CounterpartyChannelKey)
+	if bz == nil {
+		return ""
+	}
+	return string(bz)
+}
+
+// SetCounterpartyChannel sets the counterparty channel for IBC transfers
+func (k Keeper) SetCounterpartyChannel(ctx sdk.Context, channel string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.CounterpartyChannelKey, []byte(channel))
+}
+
+// GetDenom returns the denom for wrapped tokens
+func (k Keeper) GetDenom(ctx sdk.Context) string {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.DenomKey)
+	if bz == nil {
+		return ""
+	}
+	return string(bz)
+}
+
+// SetDenom sets the denom for wrapped tokens
+func (k Keeper) SetDenom(ctx sdk.Context, denom string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.DenomKey, []byte(denom))
+}
+
+// GetDecimalDifference returns the decimal difference for IBC transfers
+func (k Keeper) GetDecimalDifference(ctx sdk.Context) uint32 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.DecimalDifferenceKey)
+	if bz == nil {
+		return 0
+	}
+	value, err := strconv.ParseUint(string(bz), 10, 32)
+	if err != nil {
+		k.Logger().Error("failed to parse decimal difference", "error", err)
+		return 0
+	}
+	return uint32(value)
+}
+
+// SetDecimalDifference sets the decimal difference for IBC transfers
+func (k Keeper) SetDecimalDifference(ctx sdk.Context, decimalDifference uint32) error {
+	if decimalDifference > 18 {
+		return fmt.Errorf("decimal difference must be between 0 and 18, got %d", decimalDifference)
+	}
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.DecimalDifferenceKey, []byte(strconv.FormatUint(uint64(decimalDifference), 10)))
+	return nil
+}
+
+// DeleteIbcSettings deletes the IBC settings of the single route once converted into a bridge route
+func (k Keeper) DeleteIbcSettings(ctx sdk.Context) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	for _, key := range [][]byte{
+		types.NativeClientIdKey,
+		types.CounterpartyClientIdKey,
+		types.NativePortKey,
+		types.CounterpartyPortKey,
+		types.NativeChannelKey,
+		types.CounterpartyChannelKey,
+		types.DenomKey,
+		types.DecimalDifferenceKey,
+	} {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"zigchain/app"
+)
+
+// Positive test cases
+
+func TestSetGetNativeClientId(t *testing.T) {
+	// Test case: set and get the native client ID
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	clientId := "ibc-native-client-1"
+	k.SetNativeClientId(ctx, clientId)
+	require.Equal(t, clientId, k.GetNativeClientId(ctx))
+}
+
+func TestSetGetCounterpartyClientId(t *testing.T) {
+	// Test case: set and get the counterparty client ID
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	clientId := "ibc-counterparty-client-1"
+	k.SetCounterpartyClientId(ctx, clientId)
+	require.Equal(t, clientId, k.GetCounterpartyClientId(ctx))
+}
+
+func TestSetGetNativePort(t *testing.T) {
+	// Test case: set and get the native port
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	port := "transfer"
+	k.SetNativePort(ctx, port)
+	require.Equal(t, port, k.GetNativePort(ctx))
+}
+
+func TestSetGetCounterpartyPort(t *testing.T) {
+	// Test case: set and get the counterparty port
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	port := "transfer"
+	k.SetCounterpartyPort(ctx, port)
+	require.Equal(t, port, k.GetCounterpartyPort(ctx))
+}
+
+func TestSetGetNativeChannel(t *testing.T) {
+	// Test case: set and get the native channel
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	channel := "channel-0"
+	k.SetNativeChannel(ctx, channel)
+	require.Equal(t, channel, k.GetNativeChannel(ctx))
+}
+
+func TestSetGetCounterpartyChannel(t *testing.T) {
+	// Test case: set and get the counterparty channel
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	channel := "channel-0"
+	k.SetCounterpartyChannel(ctx, channel)
+	require.Equal(t, channel, k.GetCounterpartyChannel(ctx))
+}
+
+func TestSetGetDecimalDifference(t *testing.T) {
+	// Test case: set and get the decimal difference
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	_ = k.SetDecimalDifference(ctx, 18)
+	require.Equal(t, uint32(18), k.GetDecimalDifference(ctx))
+}
+
+func TestSetGetDenom(t *testing.T) {
+	// Test case: set and get the denom
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	denom := "uzig"
+	k.SetDenom(ctx, denom)
+	require.Equal(t, denom, k.GetDenom(ctx))
+}
+
+// Negative test cases
+
+func TestSetDecimalDifference_Validation(t *testing.T) {
+	// Test case: validate decimal difference range (0-18)
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	// Test valid cases
+	validCases := []uint32{0, 9, 18}
+	for _, validCase := range validCases {
+		err := k.SetDecimalDifference(ctx, validCase)
+		require.NoError(t, err)
+		require.Equal(t, validCase, k.GetDecimalDifference(ctx))
+	}
+
+	// Test invalid case
+	err := k.SetDecimalDifference(ctx, 19)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "decimal difference must be between 0 and 18")
+}
@@ -3,10 +3,8 @@ package keeper
 import (
 	"fmt"
 	"math/big"
-	"strconv"
 
 	"zigchain/x/tokenwrapper/types"
-	"zigchain/zutils/constants"
 
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
@@ -15,7 +13,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 )
 
 type (
@@ -213,149 +210,6 @@ func (k Keeper) SetEnabled(ctx sdk.Context, enabled bool) {
 	}
 }
 
-// GetNativeClientId returns the native client ID for IBC transfers
-func (k Keeper) GetNativeClientId(ctx sdk.Context) string {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	bz := store.Get(types.NativeClientIdKey)
-	if bz == nil {
-		return ""
-	}
-	return string(bz)
-}
-
-// SetNativeClientId sets the native client ID for IBC transfers
-func (k Keeper) SetNativeClientId(ctx sdk.Context, clientId string) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store.Set(types.NativeClientIdKey, []byte(clientId))
-}
-
-// GetCounterpartyClientId returns the counterparty client ID for IBC transfers
-func (k Keeper) GetCounterpartyClientId(ctx sdk.Context) string {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	bz := store.Get(types.CounterpartyClientIdKey)
-	if bz == nil {
-		return ""
-	}
-	return string(bz)
-}
-
-// SetCounterpartyClientId sets the counterparty client ID for IBC transfers
-func (k Keeper) SetCounterpartyClientId(ctx sdk.Context, clientId string) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store.Set(types.CounterpartyClientIdKey, []byte(clientId))
-}
-
-// GetNativePort returns the native port for IBC transfers
-func (k Keeper) GetNativePort(ctx sdk.Context) string {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	bz := store.Get(types.NativePortKey)
-	if bz == nil {
-		return ""
-	}
-	return string(bz)
-}
-
-// SetNativePort sets the native port for IBC transfers
-func (k Keeper) SetNativePort(ctx sdk.Context, port string) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store.Set(types.NativePortKey, []byte(port))
-}
-
-// GetCounterpartyPort returns the counterparty port for IBC transfers
-func (k Keeper) GetCounterpartyPort(ctx sdk.Context) string {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	bz := store.Get(types.CounterpartyPortKey)
-	if bz == nil {
-		return ""
-	}
-	return string(bz)
-}
-
-// SetCounterpartyPort sets the counterparty port for IBC transfers
-func (k Keeper) SetCounterpartyPort(ctx sdk.Context, port string) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store.Set(types.CounterpartyPortKey, []byte(port))
-}
-
-// GetNativeChannel returns the native channel for IBC transfers
-func (k Keeper) GetNativeChannel(ctx sdk.Context) string {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	bz := store.Get(types.NativeChannelKey)
-	if bz == nil {
-		return ""
-	}
-	return string(bz)
-}
-
-// SetNativeChannel sets the native channel for IBC transfers
-func (k Keeper) SetNativeChannel(ctx sdk.Context, channel string) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store.Set(types.NativeChannelKey, []byte(channel))
-}
-
-// GetCounterpartyChannel returns the counterparty channel for IBC transfers
-func (k Keeper) GetCounterpartyChannel(ctx sdk.Context) string {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	bz := store.Get(types.CounterpartyChannelKey)
-	if bz == nil {
-		return ""
-	}
-	return string(bz)
-}
-
-// SetCounterpartyChannel sets the counterparty channel for IBC transfers
-func (k Keeper) SetCounterpartyChannel(ctx sdk.Context, channel string) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store.Set(types.CounterpartyChannelKey, []byte(channel))
-}
-
-// GetDenom returns the denom for wrapped tokens
-func (k Keeper) GetDenom(ctx sdk.Context) string {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	bz := store.Get(types.DenomKey)
-	if bz == nil {
-		return ""
-	}
-	return string(bz)
-}
-
-// SetDenom sets the denom for wrapped tokens
-func (k Keeper) SetDenom(ctx sdk.Context, denom string) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store.Set(types.DenomKey, []byte(denom))
-}
-
-// GetDecimalDifference returns the decimal difference for IBC transfers
-func (k Keeper) GetDecimalDifference(ctx sdk.Context) uint32 {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	bz := store.Get(types.DecimalDifferenceKey)
-	if bz == nil {
-		return 0
-	}
-	value, err := strconv.ParseUint(string(bz), 10, 32)
-	if err != nil {
-		k.Logger().Error("failed to parse decimal difference", "error", err)
-		return 0
-	}
-	return uint32(value)
-}
-
-// SetDecimalDifference sets the decimal difference for IBC transfers
-func (k Keeper) SetDecimalDifference(ctx sdk.Context, decimalDifference uint32) error {
-	if decimalDifference > 18 {
-		return fmt.Errorf("decimal difference must be between 0 and 18, got %d", decimalDifference)
-	}
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store.Set(types.DecimalDifferenceKey, []byte(strconv.FormatUint(uint64(decimalDifference), 10)))
-	return nil
-}
-
-// GetDecimalConversionFactor returns the conversion factor based on the decimal difference
-func (k Keeper) GetDecimalConversionFactor(ctx sdk.Context) sdkmath.Int {
-	decimalDifference := k.GetDecimalDifference(ctx)
-	return sdkmath.NewIntFromBigInt(big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(decimalDifference)), nil))
-}
-
 // GetPauserAddresses returns the list of pauser addresses
 func (k Keeper) GetPauserAddresses(ctx sdk.Context) []string {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
@@ -431,56 +285,13 @@ func (k Keeper) SetProposedOperatorAddress(ctx sdk.Context, address string) {
 	store.Set(types.ProposedOperatorAddressKey, []byte(address))
 }
 
-// GetIBCRecvDenom gets the IBC denom for the received token
-func (k Keeper) GetIBCRecvDenom(ctx sdk.Context, denom string) string {
-	// Get module IBC settings
-	moduleNativePort := k.GetNativePort(ctx)
-	moduleNativeChannel := k.GetNativeChannel(ctx)
-
-	// Prepare denom for IBC token
-	sourcePrefix := transfertypes.NewHop(moduleNativePort, moduleNativeChannel)
-	prefixedDenom := fmt.Sprintf("%s/%s", sourcePrefix.String(), denom)
-	return transfertypes.ExtractDenomFromPath(prefixedDenom).IBCDenom()
-}
-
-// ScaleDownTokenPrecision scales down the token precision from 18 to 6 decimals
-func (k Keeper) ScaleDownTokenPrecision(ctx sdk.Context, amount sdkmath.Int) (sdkmath.Int, error) {
-	// Convert from 18 decimals to 6 decimals
-	// Divide by conversion factor to convert from 18 decimals to 6 decimals
-	// Note: Quo performs integer division which rounds down
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
-	convertedAmount := amount.Quo(conversionFactor)
-
-	// Check if the converted amount is zero or negative
-	if convertedAmount.IsZero() || convertedAmount.IsNegative() {
-		return sdkmath.Int{}, fmt.Errorf("converted amount is zero or negative: %s", convertedAmount.String())
-	}
-
-	return convertedAmount, nil
-}
-
-// ScaleUpTokenPrecision scales up the token precision from 6 to 18 decimals
-func (k Keeper) ScaleUpTokenPrecision(ctx sdk.Context, amount sdkmath.Int) (sdkmath.Int, error) {
-	// Convert from 6 decimals to 18 decimals
-	// Multiply by conversion factor to convert from 6 decimals to 18 decimals
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
-	convertedAmount := amount.Mul(conversionFactor)
-
-	// Check if the converted amount is zero or negative
-	if convertedAmount.IsZero() || convertedAmount.IsNegative() {
-		return sdkmath.Int{}, fmt.Errorf("converted amount is zero or negative: %s", convertedAmount.String())
-	}
-
-	return convertedAmount, nil
-}
-
 // CheckBalances checks if the account and module have enough balance
-func (k Keeper) CheckBalances(ctx sdk.Context, receiver sdk.AccAddress, amount sdkmath.Int, recvDenom string, convertedAmount sdkmath.Int) error {
+func (k Keeper) CheckBalances(ctx sdk.Context, receiver sdk.AccAddress, amount sdkmath.Int, recvDenom string, convertedAmount sdkmath.Int, nativeDenom string) error {
 	ibcCoins := sdk.NewCoins(sdk.NewCoin(recvDenom, amount))
 	if err := k.CheckAccountBalance(ctx, receiver, ibcCoins); err != nil {
 		return err
 	}
-	nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, convertedAmount))
+	nativeCoins := sdk.NewCoins(sdk.NewCoin(nativeDenom, convertedAmount))
 	if err := k.CheckModuleBalance(ctx, nativeCoins); err != nil {
 		return err
 	}
@@ -497,8 +308,8 @@ func (k Keeper) LockIBCTokens(ctx sdk.Context, receiver sdk.AccAddress, amount s
 }
 
 // UnlockNativeTokens unlocks the native tokens
-func (k Keeper) UnlockNativeTokens(ctx sdk.Context, receiver sdk.AccAddress, amount sdkmath.Int, ibcCoins sdk.Coins) (sdk.Coins, error) {
-	nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, amount))
+func (k Keeper) UnlockNativeTokens(ctx sdk.Context, receiver sdk.AccAddress, amount sdkmath.Int, ibcCoins sdk.Coins, nativeDenom string) (sdk.Coins, error) {
+	nativeCoins := sdk.NewCoins(sdk.NewCoin(nativeDenom, amount))
 	if err := k.UnlockTokens(ctx, receiver, nativeCoins); err != nil {
 		// Unlock previously locked IBC tokens on error
 		if unlockErr := k.UnlockTokens(ctx, receiver, ibcCoins); unlockErr != nil {
@@ -527,17 +338,23 @@ func (k Keeper) RecoverZig(ctx sdk.Context, address sdk.AccAddress) (sdk.AccAddr
 		return sdk.AccAddress{}, sdk.Coin{}, sdk.Coin{}, err
 	}
 
-	// Get module denom
-	moduleDenom := k.GetDenom(ctx)
-
-	// Get IBC denom for the received token
-	recvDenom := k.GetIBCRecvDenom(ctx, moduleDenom)
-
-	// Get IBC vouchers amount available in address
-	amount := k.bankKeeper.GetBalance(ctx, address, recvDenom)
+	// Find the first enabled route the address holds IBC vouchers of
+	var route types.BridgeRoute
+	var recvDenom string
+	var amount sdk.Coin
+	for _, r := range k.GetAllBridgeRoutes(ctx) {
+		if !r.Enabled {
+			continue
+		}
+		balance := k.bankKeeper.GetBalance(ctx, address, r.IBCRecvDenom())
+		if balance.IsPositive() {
+			route, recvDenom, amount = r, r.IBCRecvDenom(), balance
+			break
+		}
+	}
 
-	// Amount not positive, return an error
-	if !amount.IsPositive() {
+	// No vouchers of any route, return an error
+	if recvDenom == "" {
 		err := fmt.Errorf("no IBC vouchers available in address: %v", types.ErrNoIBCVouchersAvailableInAddress)
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		k.Logger().Error(err.Error())
@@ -545,20 +362,37 @@ func (k Keeper) RecoverZig(ctx sdk.Context, address sdk.AccAddress) (sdk.AccAddr
 	}
 
 	// Handle token conversion
-	convertedAmount, err := k.ScaleDownTokenPrecision(ctx, amount.Amount)
+	convertedAmount, err := route.ScaleDownTokenPrecision(amount.Amount)
 	if err != nil {
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		k.Logger().Error(fmt.Sprintf("token conversion failed: %v", err))
 		return sdk.AccAddress{}, sdk.Coin{}, sdk.Coin{}, err
 	}
 
+	// Deduct the bridge fee from the native amount unlocked to the address, as on receive
+	fee := k.GetBridgeFee(ctx, convertedAmount)
+	if fee.GTE(convertedAmount) {
+		err := fmt.Errorf("%w: fee %s, amount %s", types.ErrBridgeFeeExceedsAmount, fee, convertedAmount)
+		types.EmitTokenWrapperErrorEvent(ctx, err)
+		k.Logger().Error(err.Error())
+		return sdk.AccAddress{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+	netAmount := convertedAmount.Sub(fee)
+
 	// Check balances
-	if err := k.CheckBalances(ctx, address, amount.Amount, recvDenom, convertedAmount); err != nil {
+	if err := k.CheckBalances(ctx, address, amount.Amount, recvDenom, convertedAmount, route.NativeDenom); err != nil {
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		k.Logger().Error(fmt.Sprintf("balances check failed: %v", err))
 		return sdk.AccAddress{}, sdk.Coin{}, sdk.Coin{}, err
 	}
 
+	// Check and record the inbound volume of the route
+	if err := k.ConsumeInboundVolume(ctx, route, convertedAmount); err != nil {
+		types.EmitTokenWrapperErrorEvent(ctx, err)
+		k.Logger().Error(fmt.Sprintf("inbound volume cap exceeded: %v", err))
+		return sdk.AccAddress{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
 	// Lock IBC tokens
 	ibcCoins, err := k.LockIBCTokens(ctx, address, amount.Amount, recvDenom)
 	if err != nil {
@@ -568,15 +402,25 @@ func (k Keeper) RecoverZig(ctx sdk.Context, address sdk.AccAddress) (sdk.AccAddr
 	}
 
 	// Unlock native tokens
-	nativeCoins, err := k.UnlockNativeTokens(ctx, address, convertedAmount, ibcCoins)
+	nativeCoins, err := k.UnlockNativeTokens(ctx, address, netAmount, ibcCoins, route.NativeDenom)
 	if err != nil {
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		k.Logger().Error(fmt.Sprintf("failed to unlock native tokens: %v", err))
 		return sdk.AccAddress{}, sdk.Coin{}, sdk.Coin{}, err
 	}
 
+	// Pay the bridge fee, it stays in the module wallet if it cannot be paid
+	if err := k.CollectInboundBridgeFee(ctx, route, fee); err != nil {
+		types.EmitTokenWrapperErrorEvent(ctx, err)
+		k.Logger().Error(fmt.Sprintf("failed to collect bridge fee: %v", err))
+		fee = sdkmath.ZeroInt()
+	}
+
 	// Track transferred amount
-	k.AddToTotalTransferredIn(ctx, convertedAmount)
+	k.AddToRouteTransferredIn(ctx, route, convertedAmount)
+
+	// The recovered IBC vouchers leave the pending unwrap queue
+	k.RemoveRecoveredPendingUnwraps(ctx, address, route, convertedAmount, fee)
 
 	// Return address and token coins
 	return address, ibcCoins[0], nativeCoins[0], nil
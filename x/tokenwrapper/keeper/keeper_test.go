@@ -12,13 +12,32 @@ import (
 
 	"zigchain/app"
 	"zigchain/testutil/sample"
+	"zigchain/x/tokenwrapper/migrations"
 	"zigchain/x/tokenwrapper/types"
+	"zigchain/zutils/constants"
 )
 
 const (
 	initChain = true
 )
 
+func bridgeRouteSample(nativeChannel string) types.BridgeRoute {
+	return types.BridgeRoute{
+		NativePort:           "transfer",
+		NativeChannel:        nativeChannel,
+		NativeClientId:       "07-tendermint-0",
+		CounterpartyClientId: "07-tendermint-1",
+		CounterpartyPort:     "transfer",
+		CounterpartyChannel:  "channel-100",
+		Denom:                "uaxl",
+		NativeDenom:          "uzig",
+		DecimalDifference:    12,
+		Enabled:              true,
+		TotalTransferredIn:   sdkmath.ZeroInt(),
+		TotalTransferredOut:  sdkmath.ZeroInt(),
+	}
+}
+
 // Positive test cases
 
 func TestLockTokens_Valid(t *testing.T) {
@@ -217,110 +236,129 @@ func TestSetGetEnabled(t *testing.T) {
 	require.False(t, k.IsEnabled(ctx))
 }
 
-func TestSetGetNativeClientId(t *testing.T) {
-	// Test case: set and get the native client ID
+func TestSetGetBridgeRoute(t *testing.T) {
+	// Test case: set and get a bridge route by its native port and channel
 
 	testApp := app.InitTestApp(initChain, t)
 	k := testApp.TokenwrapperKeeper
 	ctx := testApp.BaseApp.NewContext(initChain)
 
-	clientId := "ibc-native-client-1"
-	k.SetNativeClientId(ctx, clientId)
-	require.Equal(t, clientId, k.GetNativeClientId(ctx))
-}
+	route := bridgeRouteSample("channel-0")
+	k.SetBridgeRoute(ctx, route)
 
-func TestSetGetCounterpartyClientId(t *testing.T) {
-	// Test case: set and get the counterparty client ID
+	got, found := k.GetBridgeRoute(ctx, "transfer", "channel-0")
+	require.True(t, found)
+	require.Equal(t, route, got)
 
-	testApp := app.InitTestApp(initChain, t)
-	k := testApp.TokenwrapperKeeper
-	ctx := testApp.BaseApp.NewContext(initChain)
-
-	clientId := "ibc-counterparty-client-1"
-	k.SetCounterpartyClientId(ctx, clientId)
-	require.Equal(t, clientId, k.GetCounterpartyClientId(ctx))
+	_, found = k.GetBridgeRoute(ctx, "transfer", "channel-1")
+	require.False(t, found)
 }
 
-func TestSetGetNativePort(t *testing.T) {
-	// Test case: set and get the native port
+func TestGetAllBridgeRoutes(t *testing.T) {
+	// Test case: get all bridge routes
 
 	testApp := app.InitTestApp(initChain, t)
 	k := testApp.TokenwrapperKeeper
 	ctx := testApp.BaseApp.NewContext(initChain)
 
-	port := "transfer"
-	k.SetNativePort(ctx, port)
-	require.Equal(t, port, k.GetNativePort(ctx))
+	require.Empty(t, k.GetAllBridgeRoutes(ctx))
+
+	k.SetBridgeRoute(ctx, bridgeRouteSample("channel-0"))
+	k.SetBridgeRoute(ctx, bridgeRouteSample("channel-1"))
+
+	routes := k.GetAllBridgeRoutes(ctx)
+	require.Len(t, routes, 2)
+	require.Equal(t, "channel-0", routes[0].NativeChannel)
+	require.Equal(t, "channel-1", routes[1].NativeChannel)
 }
 
-func TestSetGetCounterpartyPort(t *testing.T) {
-	// Test case: set and get the counterparty port
+func TestAddToRouteTransferred(t *testing.T) {
+	// Test case: the route totals and the module totals are tracked together
 
 	testApp := app.InitTestApp(initChain, t)
 	k := testApp.TokenwrapperKeeper
 	ctx := testApp.BaseApp.NewContext(initChain)
 
-	port := "transfer"
-	k.SetCounterpartyPort(ctx, port)
-	require.Equal(t, port, k.GetCounterpartyPort(ctx))
-}
+	k.SetBridgeRoute(ctx, bridgeRouteSample("channel-0"))
+	k.SetBridgeRoute(ctx, bridgeRouteSample("channel-1"))
 
-func TestSetGetNativeChannel(t *testing.T) {
-	// Test case: set and get the native channel
+	route, _ := k.GetBridgeRoute(ctx, "transfer", "channel-0")
+	k.AddToRouteTransferredIn(ctx, route, sdkmath.NewInt(100))
+	route, _ = k.GetBridgeRoute(ctx, "transfer", "channel-0")
+	k.AddToRouteTransferredOut(ctx, route, sdkmath.NewInt(40))
 
-	testApp := app.InitTestApp(initChain, t)
-	k := testApp.TokenwrapperKeeper
-	ctx := testApp.BaseApp.NewContext(initChain)
+	other, _ := k.GetBridgeRoute(ctx, "transfer", "channel-1")
+	k.AddToRouteTransferredIn(ctx, other, sdkmath.NewInt(5))
 
-	channel := "channel-0"
-	k.SetNativeChannel(ctx, channel)
-	require.Equal(t, channel, k.GetNativeChannel(ctx))
+	route, _ = k.GetBridgeRoute(ctx, "transfer", "channel-0")
+	require.Equal(t, sdkmath.NewInt(100), route.TotalTransferredIn)
+	require.Equal(t, sdkmath.NewInt(40), route.TotalTransferredOut)
+
+	other, _ = k.GetBridgeRoute(ctx, "transfer", "channel-1")
+	require.Equal(t, sdkmath.NewInt(5), other.TotalTransferredIn)
+	require.True(t, other.TotalTransferredOut.IsZero())
+
+	require.Equal(t, sdkmath.NewInt(105), k.GetTotalTransferredIn(ctx))
+	require.Equal(t, sdkmath.NewInt(40), k.GetTotalTransferredOut(ctx))
 }
 
-func TestSetGetCounterpartyChannel(t *testing.T) {
-	// Test case: set and get the counterparty channel
+func TestV3Migration_ConvertsIbcSettingsIntoBridgeRoute(t *testing.T) {
+	// Test case: the IBC settings of the single route become the first bridge route
 
 	testApp := app.InitTestApp(initChain, t)
 	k := testApp.TokenwrapperKeeper
 	ctx := testApp.BaseApp.NewContext(initChain)
 
-	channel := "channel-0"
-	k.SetCounterpartyChannel(ctx, channel)
-	require.Equal(t, channel, k.GetCounterpartyChannel(ctx))
-}
+	m := migrations.NewMigrator(k)
+	require.NoError(t, m.V2Migration(ctx))
+	k.SetTotalTransferredIn(ctx, sdkmath.NewInt(70))
+	k.SetTotalTransferredOut(ctx, sdkmath.NewInt(30))
 
-func TestSetGetDecimalDifference(t *testing.T) {
-	// Test case: set and get the decimal difference
+	require.NoError(t, m.V3Migration(ctx))
 
-	testApp := app.InitTestApp(initChain, t)
-	k := testApp.TokenwrapperKeeper
-	ctx := testApp.BaseApp.NewContext(initChain)
+	routes := k.GetAllBridgeRoutes(ctx)
+	require.Len(t, routes, 1)
+	require.Equal(t, types.BridgeRoute{
+		NativePort:           "transfer",
+		NativeChannel:        "channel-0",
+		NativeClientId:       "07-tendermint-0",
+		CounterpartyClientId: "07-tendermint-1163",
+		CounterpartyPort:     "transfer",
+		CounterpartyChannel:  "channel-612",
+		Denom:                "uaxl",
+		NativeDenom:          "uzig",
+		DecimalDifference:    0,
+		Enabled:              true,
+		TotalTransferredIn:   sdkmath.NewInt(70),
+		TotalTransferredOut:  sdkmath.NewInt(30),
+	}, routes[0])
+
+	// the module totals are kept
+	require.Equal(t, sdkmath.NewInt(70), k.GetTotalTransferredIn(ctx))
+	require.Equal(t, sdkmath.NewInt(30), k.GetTotalTransferredOut(ctx))
 
-	_ = k.SetDecimalDifference(ctx, 18)
-	require.Equal(t, uint32(18), k.GetDecimalDifference(ctx))
+	// the IBC settings of the single route are deleted
+	require.Empty(t, k.GetNativePort(ctx))
+	require.Empty(t, k.GetNativeChannel(ctx))
+	require.Empty(t, k.GetDenom(ctx))
 }
 
-func TestSetGetDenom(t *testing.T) {
-	// Test case: set and get the denom
+func TestV3Migration_NoIbcSettings(t *testing.T) {
+	// Test case: no bridge route is created when the IBC settings were never set
 
 	testApp := app.InitTestApp(initChain, t)
 	k := testApp.TokenwrapperKeeper
 	ctx := testApp.BaseApp.NewContext(initChain)
 
-	denom := "uzig"
-	k.SetDenom(ctx, denom)
-	require.Equal(t, denom, k.GetDenom(ctx))
+	require.NoError(t, migrations.NewMigrator(k).V3Migration(ctx))
+	require.Empty(t, k.GetAllBridgeRoutes(ctx))
 }
 
 func TestGetDecimalConversionFactor(t *testing.T) {
 	// Test case: returns correct 10^decimalDifference value
 
-	testApp := app.InitTestApp(initChain, t)
-	k := testApp.TokenwrapperKeeper
-	ctx := testApp.BaseApp.NewContext(initChain)
-
-	_ = k.SetDecimalDifference(ctx, 6)
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
+	route := types.BridgeRoute{DecimalDifference: 6}
+	conversionFactor := route.DecimalConversionFactor()
 
 	expected := sdkmath.NewIntFromUint64(1_000_000)
 	require.Equal(t, expected, conversionFactor)
@@ -329,10 +367,6 @@ func TestGetDecimalConversionFactor(t *testing.T) {
 func TestDecimalConversionRounding_ScaleDown(t *testing.T) {
 	// Test case: verify that decimal conversion using Quo rounds down
 
-	testApp := app.InitTestApp(initChain, t)
-	k := testApp.TokenwrapperKeeper
-	ctx := testApp.BaseApp.NewContext(initChain)
-
 	// Set up test cases with amounts that would have decimal places
 	testCases := []struct {
 		name           string
@@ -362,11 +396,11 @@ func TestDecimalConversionRounding_ScaleDown(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			// Set the decimal difference in the keeper
-			_ = k.SetDecimalDifference(ctx, tc.decimalDiff)
+			// Set the decimal difference of the route
+			route := types.BridgeRoute{DecimalDifference: tc.decimalDiff}
 
 			// Get the conversion factor
-			conversionFactor := k.GetDecimalConversionFactor(ctx)
+			conversionFactor := route.DecimalConversionFactor()
 
 			// Perform the division
 			result := tc.amount.Quo(conversionFactor)
@@ -382,18 +416,14 @@ func TestDecimalConversionRounding_ScaleDown(t *testing.T) {
 func TestScaleUpTokenPrecision_Valid(t *testing.T) {
 	// Test case: scale up token precision successfully
 
-	testApp := app.InitTestApp(initChain, t)
-	k := testApp.TokenwrapperKeeper
-	ctx := testApp.BaseApp.NewContext(initChain)
-
 	// Set decimal difference to 12 (converting from 6 to 18 decimals)
-	_ = k.SetDecimalDifference(ctx, 12)
+	route := types.BridgeRoute{DecimalDifference: 12}
 
 	// Test scaling up 1.0 with 6 decimals to 1.0 with 18 decimals
 	amount := sdkmath.NewInt(1_000_000)                   // 1.0 with 6 decimals
 	expected := sdkmath.NewInt(1_000_000_000_000_000_000) // 1.0 with 18 decimals
 
-	result, err := k.ScaleUpTokenPrecision(ctx, amount)
+	result, err := route.ScaleUpTokenPrecision(amount)
 	require.NoError(t, err)
 	require.Equal(t, expected, result)
 }
@@ -401,18 +431,14 @@ func TestScaleUpTokenPrecision_Valid(t *testing.T) {
 func TestScaleUpTokenPrecision_WithRemainder(t *testing.T) {
 	// Test case: scale up token precision with fractional amount
 
-	testApp := app.InitTestApp(initChain, t)
-	k := testApp.TokenwrapperKeeper
-	ctx := testApp.BaseApp.NewContext(initChain)
-
 	// Set decimal difference to 12 (converting from 6 to 18 decimals)
-	_ = k.SetDecimalDifference(ctx, 12)
+	route := types.BridgeRoute{DecimalDifference: 12}
 
 	// Test scaling up 1.234567 with 6 decimals
 	amount := sdkmath.NewInt(1_234_567)                   // 1.234567 with 6 decimals
 	expected := sdkmath.NewInt(1_234_567_000_000_000_000) // 1.234567 with 18 decimals
 
-	result, err := k.ScaleUpTokenPrecision(ctx, amount)
+	result, err := route.ScaleUpTokenPrecision(amount)
 	require.NoError(t, err)
 	require.Equal(t, expected, result)
 }
@@ -420,17 +446,13 @@ func TestScaleUpTokenPrecision_WithRemainder(t *testing.T) {
 func TestScaleUpTokenPrecision_ZeroDecimalDifference(t *testing.T) {
 	// Test case: scale up with zero decimal difference (no conversion needed)
 
-	testApp := app.InitTestApp(initChain, t)
-	k := testApp.TokenwrapperKeeper
-	ctx := testApp.BaseApp.NewContext(initChain)
-
 	// Set decimal difference to 0 (same decimals)
-	_ = k.SetDecimalDifference(ctx, 0)
+	route := types.BridgeRoute{DecimalDifference: 0}
 
 	amount := sdkmath.NewInt(1_000_000)
 	expected := sdkmath.NewInt(1_000_000) // Should remain the same
 
-	result, err := k.ScaleUpTokenPrecision(ctx, amount)
+	result, err := route.ScaleUpTokenPrecision(amount)
 	require.NoError(t, err)
 	require.Equal(t, expected, result)
 }
@@ -438,17 +460,13 @@ func TestScaleUpTokenPrecision_ZeroDecimalDifference(t *testing.T) {
 func TestScaleUpTokenPrecision_MaxDecimalDifference(t *testing.T) {
 	// Test case: scale up with maximum decimal difference (18)
 
-	testApp := app.InitTestApp(initChain, t)
-	k := testApp.TokenwrapperKeeper
-	ctx := testApp.BaseApp.NewContext(initChain)
-
 	// Set decimal difference to 18 (converting from 0 to 18 decimals)
-	_ = k.SetDecimalDifference(ctx, 18)
+	route := types.BridgeRoute{DecimalDifference: 18}
 
 	amount := sdkmath.NewInt(1)                           // 1 with 0 decimals
 	expected := sdkmath.NewInt(1_000_000_000_000_000_000) // 1 with 18 decimals
 
-	result, err := k.ScaleUpTokenPrecision(ctx, amount)
+	result, err := route.ScaleUpTokenPrecision(amount)
 	require.NoError(t, err)
 	require.Equal(t, expected, result)
 }
@@ -799,39 +817,14 @@ func TestBurnIbcTokens_InvalidBalance(t *testing.T) {
 	require.Contains(t, err.Error(), "module does not have enough balance of 1000uzig")
 }
 
-func TestSetDecimalDifference_Validation(t *testing.T) {
-	// Test case: validate decimal difference range (0-18)
-
-	testApp := app.InitTestApp(initChain, t)
-	k := testApp.TokenwrapperKeeper
-	ctx := testApp.BaseApp.NewContext(initChain)
-
-	// Test valid cases
-	validCases := []uint32{0, 9, 18}
-	for _, validCase := range validCases {
-		err := k.SetDecimalDifference(ctx, validCase)
-		require.NoError(t, err)
-		require.Equal(t, validCase, k.GetDecimalDifference(ctx))
-	}
-
-	// Test invalid case
-	err := k.SetDecimalDifference(ctx, 19)
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "decimal difference must be between 0 and 18")
-}
-
 func TestScaleUpTokenPrecision_ZeroAmount(t *testing.T) {
 	// Test case: scale up zero amount (should return error)
 
-	testApp := app.InitTestApp(initChain, t)
-	k := testApp.TokenwrapperKeeper
-	ctx := testApp.BaseApp.NewContext(initChain)
-
-	_ = k.SetDecimalDifference(ctx, 12)
+	route := types.BridgeRoute{DecimalDifference: 12}
 
 	amount := sdkmath.NewInt(0)
 
-	result, err := k.ScaleUpTokenPrecision(ctx, amount)
+	result, err := route.ScaleUpTokenPrecision(amount)
 	require.Error(t, err)
 	require.True(t, result.IsNil() || result.IsZero())
 	require.Contains(t, err.Error(), "converted amount is zero or negative")
@@ -840,15 +833,11 @@ func TestScaleUpTokenPrecision_ZeroAmount(t *testing.T) {
 func TestScaleUpTokenPrecision_NegativeAmount(t *testing.T) {
 	// Test case: scale up negative amount (should return error)
 
-	testApp := app.InitTestApp(initChain, t)
-	k := testApp.TokenwrapperKeeper
-	ctx := testApp.BaseApp.NewContext(initChain)
-
-	_ = k.SetDecimalDifference(ctx, 12)
+	route := types.BridgeRoute{DecimalDifference: 12}
 
 	amount := sdkmath.NewInt(-1000)
 
-	result, err := k.ScaleUpTokenPrecision(ctx, amount)
+	result, err := route.ScaleUpTokenPrecision(amount)
 	require.Error(t, err)
 	require.True(t, result.IsNil() || result.IsNegative())
 	require.Contains(t, err.Error(), "converted amount is zero or negative")
@@ -857,15 +846,11 @@ func TestScaleUpTokenPrecision_NegativeAmount(t *testing.T) {
 func TestScaleDownTokenPrecision_ZeroAmount(t *testing.T) {
 	// Test case: scale down zero amount (should return error)
 
-	testApp := app.InitTestApp(initChain, t)
-	k := testApp.TokenwrapperKeeper
-	ctx := testApp.BaseApp.NewContext(initChain)
-
-	_ = k.SetDecimalDifference(ctx, 12)
+	route := types.BridgeRoute{DecimalDifference: 12}
 
 	amount := sdkmath.NewInt(0)
 
-	result, err := k.ScaleDownTokenPrecision(ctx, amount)
+	result, err := route.ScaleDownTokenPrecision(amount)
 	require.Error(t, err)
 	require.True(t, result.IsNil() || result.IsZero())
 	require.Contains(t, err.Error(), "converted amount is zero or negative")
@@ -874,15 +859,11 @@ func TestScaleDownTokenPrecision_ZeroAmount(t *testing.T) {
 func TestScaleDownTokenPrecision_NegativeAmount(t *testing.T) {
 	// Test case: scale down negative amount (should return error)
 
-	testApp := app.InitTestApp(initChain, t)
-	k := testApp.TokenwrapperKeeper
-	ctx := testApp.BaseApp.NewContext(initChain)
-
-	_ = k.SetDecimalDifference(ctx, 12)
+	route := types.BridgeRoute{DecimalDifference: 12}
 
 	amount := sdkmath.NewInt(-1000)
 
-	result, err := k.ScaleDownTokenPrecision(ctx, amount)
+	result, err := route.ScaleDownTokenPrecision(amount)
 	require.Error(t, err)
 	require.True(t, result.IsNil() || result.IsNegative())
 	require.Contains(t, err.Error(), "converted amount is zero or negative")
@@ -910,7 +891,7 @@ func TestUnlockNativeTokens_UnlockFailsWithRecovery(t *testing.T) {
 	amount := sdkmath.NewInt(1000)
 
 	// The UnlockTokens should fail because the module doesn't have enough native tokens
-	result, err := k.UnlockNativeTokens(ctx, receiverAddr, amount, ibcCoins)
+	result, err := k.UnlockNativeTokens(ctx, receiverAddr, amount, ibcCoins, constants.BondDenom)
 
 	// Should return the original error
 	require.Error(t, err)
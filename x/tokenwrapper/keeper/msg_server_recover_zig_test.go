@@ -16,6 +16,12 @@ import (
 	"zigchain/zutils/constants"
 )
 
+func recoverZigRouteSample() types.BridgeRoute {
+	route := bridgeRouteSample("channel-0")
+	route.Denom = "unit-zig"
+	return route
+}
+
 // Positive test cases
 
 func TestMsgRecoverZig_Valid(t *testing.T) {
@@ -37,12 +43,12 @@ func TestMsgRecoverZig_Valid(t *testing.T) {
 	signer := sdk.MustAccAddressFromBech32(creator)
 
 	// set the operator address
-	k.SetDenom(ctx, "unit-zig")
-	_ = k.SetDecimalDifference(ctx, 12)
+	route := recoverZigRouteSample()
+	k.SetBridgeRoute(ctx, route)
 	k.SetEnabled(ctx, true)
 
 	// get the recv IBC denom
-	ibcDenom := k.GetIBCRecvDenom(ctx, k.GetDenom(ctx))
+	ibcDenom := route.IBCRecvDenom()
 
 	ibcAmount, _ := sdkmath.NewIntFromString("1000000000000000000")
 	nativeAmount, _ := sdkmath.NewIntFromString("1000000")
@@ -164,12 +170,12 @@ func TestMsgRecoverZig_ZeroAmount(t *testing.T) {
 	signer := sdk.MustAccAddressFromBech32(creator)
 
 	// set the operator address
-	k.SetDenom(ctx, "unit-zig")
-	_ = k.SetDecimalDifference(ctx, 12)
+	route := recoverZigRouteSample()
+	k.SetBridgeRoute(ctx, route)
 	k.SetEnabled(ctx, true)
 
 	// get the recv IBC denom
-	ibcDenom := k.GetIBCRecvDenom(ctx, k.GetDenom(ctx))
+	ibcDenom := route.IBCRecvDenom()
 
 	ibcAmount, _ := sdkmath.NewIntFromString("0")
 
@@ -205,12 +211,12 @@ func TestMsgRecoverZig_NegativeAmount(t *testing.T) {
 	ctx := testApp.BaseApp.NewContext(initChain)
 
 	// set the operator address
-	k.SetDenom(ctx, "unit-zig")
-	_ = k.SetDecimalDifference(ctx, 12)
+	route := recoverZigRouteSample()
+	k.SetBridgeRoute(ctx, route)
 	k.SetEnabled(ctx, true)
 
 	// get the recv IBC denom
-	ibcDenom := k.GetIBCRecvDenom(ctx, k.GetDenom(ctx))
+	ibcDenom := route.IBCRecvDenom()
 
 	ibcAmount, _ := sdkmath.NewIntFromString("-1000000000000000000")
 
@@ -234,8 +240,8 @@ func TestMsgRecoverZig_InvalidSigner(t *testing.T) {
 	ctx := testApp.BaseApp.NewContext(initChain)
 
 	// set the operator address
-	k.SetDenom(ctx, "unit-zig")
-	_ = k.SetDecimalDifference(ctx, 12)
+	route := recoverZigRouteSample()
+	k.SetBridgeRoute(ctx, route)
 	k.SetEnabled(ctx, true)
 
 	// create a message with an invalid Bech32 signer address
@@ -268,8 +274,8 @@ func TestMsgRecoverZig_InvalidAddress(t *testing.T) {
 	ctx := testApp.BaseApp.NewContext(initChain)
 
 	// set the operator address
-	k.SetDenom(ctx, "unit-zig")
-	_ = k.SetDecimalDifference(ctx, 12)
+	route := recoverZigRouteSample()
+	k.SetBridgeRoute(ctx, route)
 	k.SetEnabled(ctx, true)
 
 	// generate a valid test account for the signer
@@ -307,8 +313,8 @@ func TestMsgRecoverZig_ModuleDisabled(t *testing.T) {
 	signer := sample.AccAddress()
 
 	// set module parameters, but keep module disabled
-	k.SetDenom(ctx, "unit-zig")
-	_ = k.SetDecimalDifference(ctx, 12)
+	route := recoverZigRouteSample()
+	k.SetBridgeRoute(ctx, route)
 	k.SetEnabled(ctx, false) // Module is disabled
 
 	// create a message to recover ZIG
@@ -341,8 +347,7 @@ func TestMsgRecoverZig_UnsetDenom(t *testing.T) {
 	// generate a test account with some coins
 	signer := sample.AccAddress()
 
-	// set module parameters, but do not set denom (leave it empty)
-	_ = k.SetDecimalDifference(ctx, 12)
+	// set module parameters, but do not set any bridge route
 	k.SetEnabled(ctx, true)
 
 	// create a message to recover ZIG
@@ -355,7 +360,7 @@ func TestMsgRecoverZig_UnsetDenom(t *testing.T) {
 	resp, err := ms.RecoverZig(ctx, msg)
 
 	// check that an error is returned and the response is nil
-	require.Error(t, err, "expected an error due to unset denom")
+	require.Error(t, err, "expected an error due to no bridge route")
 	require.Contains(t, err.Error(), "no IBC vouchers available in address", "expected error to indicate unset denom")
 	require.Nil(t, resp, "expected nil response due to unset denom")
 }
@@ -376,12 +381,12 @@ func TestMsgRecoverZig_InsufficientNativeTokens(t *testing.T) {
 	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
 
 	// set module parameters
-	k.SetDenom(ctx, "unit-zig")
-	_ = k.SetDecimalDifference(ctx, 12)
+	route := recoverZigRouteSample()
+	k.SetBridgeRoute(ctx, route)
 	k.SetEnabled(ctx, true)
 
 	// get the recv IBC denom
-	ibcDenom := k.GetIBCRecvDenom(ctx, k.GetDenom(ctx))
+	ibcDenom := route.IBCRecvDenom()
 
 	// mint IBC coins to the test account
 	ibcAmount, _ := sdkmath.NewIntFromString("1000000000000000000")
@@ -425,12 +430,12 @@ func TestMsgRecoverZig_OperatorAddress(t *testing.T) {
 
 	// set the operator address
 	k.SetOperatorAddress(ctx, operator)
-	k.SetDenom(ctx, "unit-zig")
-	_ = k.SetDecimalDifference(ctx, 12)
+	route := recoverZigRouteSample()
+	k.SetBridgeRoute(ctx, route)
 	k.SetEnabled(ctx, true)
 
 	// get the recv IBC denom
-	ibcDenom := k.GetIBCRecvDenom(ctx, k.GetDenom(ctx))
+	ibcDenom := route.IBCRecvDenom()
 
 	ibcAmount, _ := sdkmath.NewIntFromString("1000000000000000000")
 	nativeAmount, _ := sdkmath.NewIntFromString("1000000")
@@ -486,3 +491,85 @@ func TestMsgRecoverZig_OperatorAddress(t *testing.T) {
 	}
 	require.True(t, foundErrorEvent, "Expected EventTypeTokenWrapperError event to be emitted")
 }
+
+func TestMsgRecoverZig_ResolvesBridgeRoute(t *testing.T) {
+	// Test case: the vouchers of an enabled route are recovered into its native denom,
+	// the vouchers of a disabled route are not recovered
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ms := keeper.NewMsgServerImpl(k)
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	k.SetEnabled(ctx, true)
+
+	disabled := recoverZigRouteSample()
+	disabled.Enabled = false
+	k.SetBridgeRoute(ctx, disabled)
+
+	route := bridgeRouteSample("channel-1")
+	route.Denom = "uusdc"
+	route.NativeDenom = "uusdcn"
+	route.DecimalDifference = 0
+	k.SetBridgeRoute(ctx, route)
+
+	disabledCoins := sdk.NewCoins(sdk.NewInt64Coin(disabled.IBCRecvDenom(), 1_000_000_000_000))
+	ibcCoins := sdk.NewCoins(sdk.NewInt64Coin(route.IBCRecvDenom(), 500))
+	nativeCoins := sdk.NewCoins(sdk.NewInt64Coin("uusdcn", 500))
+
+	require.NoError(t, testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, disabledCoins.Add(ibcCoins...).Add(nativeCoins...)))
+	require.NoError(t, testApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, signer, disabledCoins.Add(ibcCoins...)))
+	require.NoError(t, testApp.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, nativeCoins))
+
+	resp, err := ms.RecoverZig(ctx, types.NewMsgRecoverZig(signer.String(), signer.String()))
+	require.NoError(t, err)
+	require.Equal(t, ibcCoins[0], resp.LockedIbcAmount)
+	require.Equal(t, nativeCoins[0], resp.UnlockedNativeAmount)
+
+	require.Equal(t, sdkmath.NewInt(500), testApp.BankKeeper.GetBalance(ctx, signer, "uusdcn").Amount)
+	require.Equal(t, disabledCoins[0], testApp.BankKeeper.GetBalance(ctx, signer, disabled.IBCRecvDenom()))
+
+	// the transfer is tracked on the route
+	route, _ = k.GetBridgeRoute(ctx, "transfer", "channel-1")
+	require.Equal(t, sdkmath.NewInt(500), route.TotalTransferredIn)
+
+	// only the vouchers of the disabled route are left
+	_, err = ms.RecoverZig(ctx, types.NewMsgRecoverZig(signer.String(), signer.String()))
+	require.ErrorContains(t, err, types.ErrNoIBCVouchersAvailableInAddress.Error())
+}
+
+func TestMsgRecoverZig_BridgeFee(t *testing.T) {
+	// Test case: the bridge fee is deducted from the recovered native amount and paid to the
+	// fee recipient, and vouchers the fee would take entirely are not recovered
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ms := keeper.NewMsgServerImpl(k)
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	route := bridgeRouteSample("channel-0")
+	k.SetBridgeRoute(ctx, route)
+	k.SetEnabled(ctx, true)
+	recipient := setBridgeFee(t, testApp, ctx)
+
+	receiver := fundPendingUnwrap(t, testApp, ctx, route, sdkmath.NewInt(20_000_000_000_000_000))
+	fundModuleWallet(t, testApp, ctx, 20_000)
+
+	resp, err := ms.RecoverZig(ctx, types.NewMsgRecoverZig(sample.AccAddress(), receiver.String()))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(constants.BondDenom, 19_800), resp.UnlockedNativeAmount)
+
+	require.Equal(t, sdkmath.NewInt(19_800), testApp.BankKeeper.GetBalance(ctx, receiver, constants.BondDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(200), testApp.BankKeeper.GetBalance(ctx, recipient, constants.BondDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(200), k.GetTotalBridgeFeesIn(ctx))
+	require.True(t, testApp.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), constants.BondDenom).IsZero())
+
+	// the fee takes the whole amount of the vouchers
+	dust := fundPendingUnwrap(t, testApp, ctx, route, sdkmath.NewInt(100_000_000_000_000))
+	fundModuleWallet(t, testApp, ctx, 100)
+
+	_, err = ms.RecoverZig(ctx, types.NewMsgRecoverZig(sample.AccAddress(), dust.String()))
+	require.ErrorIs(t, err, types.ErrBridgeFeeExceedsAmount)
+	require.True(t, testApp.BankKeeper.GetBalance(ctx, dust, constants.BondDenom).IsZero())
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"zigchain/x/tokenwrapper/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetBridgeRouteEnabled enables or disables a single bridge route,
+// the operator can do both while pausers can only disable it
+func (k msgServer) SetBridgeRouteEnabled(goCtx context.Context, msg *types.MsgSetBridgeRouteEnabled) (*types.MsgSetBridgeRouteEnabledResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	currentOperator := k.GetOperatorAddress(ctx)
+	if msg.Enabled && msg.Signer != currentOperator {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("only the current operator can enable a bridge route")
+	}
+	if msg.Signer != currentOperator && !k.IsPauserAddress(ctx, signer.String()) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("signer is neither a pauser nor the operator")
+	}
+
+	route, found := k.GetBridgeRoute(ctx, msg.NativePort, msg.NativeChannel)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrBridgeRouteNotFound, "%s/%s", msg.NativePort, msg.NativeChannel)
+	}
+
+	route.Enabled = msg.Enabled
+	k.SetBridgeRoute(ctx, route)
+
+	types.EmitBridgeRouteEnabledEvent(ctx, msg.Signer, route)
+
+	return &types.MsgSetBridgeRouteEnabledResponse{
+		Signer:        msg.Signer,
+		NativePort:    msg.NativePort,
+		NativeChannel: msg.NativeChannel,
+		Enabled:       msg.Enabled,
+	}, nil
+}
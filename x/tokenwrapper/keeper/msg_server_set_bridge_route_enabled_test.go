@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"zigchain/app"
+	"zigchain/testutil/sample"
+	"zigchain/x/tokenwrapper/keeper"
+	"zigchain/x/tokenwrapper/types"
+)
+
+// Positive test cases
+
+func TestMsgSetBridgeRouteEnabled_Operator(t *testing.T) {
+	// Test case: the operator disables and enables a bridge route
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ms := keeper.NewMsgServerImpl(k)
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	operator := sample.AccAddress()
+	k.SetOperatorAddress(ctx, operator)
+	k.SetEnabled(ctx, true)
+	k.SetBridgeRoute(ctx, bridgeRouteSample("channel-0"))
+	k.SetBridgeRoute(ctx, bridgeRouteSample("channel-1"))
+
+	resp, err := ms.SetBridgeRouteEnabled(ctx, types.NewMsgSetBridgeRouteEnabled(operator, "transfer", "channel-0", false))
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgSetBridgeRouteEnabledResponse{
+		Signer:        operator,
+		NativePort:    "transfer",
+		NativeChannel: "channel-0",
+		Enabled:       false,
+	}, resp)
+
+	route, _ := k.GetBridgeRoute(ctx, "transfer", "channel-0")
+	require.False(t, route.Enabled)
+
+	// the other route and the module are untouched
+	other, _ := k.GetBridgeRoute(ctx, "transfer", "channel-1")
+	require.True(t, other.Enabled)
+	require.True(t, k.IsEnabled(ctx))
+
+	var foundEvent bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBridgeRouteEnabled {
+			foundEvent = true
+			for _, attr := range event.Attributes {
+				if attr.Key == types.AttributeKeyEnabled {
+					require.Equal(t, "false", attr.Value)
+				}
+			}
+		}
+	}
+	require.True(t, foundEvent, "Expected EventTypeBridgeRouteEnabled event to be emitted")
+
+	_, err = ms.SetBridgeRouteEnabled(ctx, types.NewMsgSetBridgeRouteEnabled(operator, "transfer", "channel-0", true))
+	require.NoError(t, err)
+	route, _ = k.GetBridgeRoute(ctx, "transfer", "channel-0")
+	require.True(t, route.Enabled)
+}
+
+func TestMsgSetBridgeRouteEnabled_PauserDisables(t *testing.T) {
+	// Test case: a pauser disables a bridge route but can not enable it again
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ms := keeper.NewMsgServerImpl(k)
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	k.SetOperatorAddress(ctx, sample.AccAddress())
+	pauser := sample.AccAddress()
+	k.AddPauserAddress(ctx, pauser)
+	k.SetBridgeRoute(ctx, bridgeRouteSample("channel-0"))
+
+	_, err := ms.SetBridgeRouteEnabled(ctx, types.NewMsgSetBridgeRouteEnabled(pauser, "transfer", "channel-0", false))
+	require.NoError(t, err)
+	route, _ := k.GetBridgeRoute(ctx, "transfer", "channel-0")
+	require.False(t, route.Enabled)
+
+	_, err = ms.SetBridgeRouteEnabled(ctx, types.NewMsgSetBridgeRouteEnabled(pauser, "transfer", "channel-0", true))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Contains(t, err.Error(), "only the current operator can enable a bridge route")
+}
+
+// Negative test cases
+
+func TestMsgSetBridgeRouteEnabled_Unauthorized(t *testing.T) {
+	// Test case: a signer neither operator nor pauser can not disable a bridge route
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ms := keeper.NewMsgServerImpl(k)
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	k.SetOperatorAddress(ctx, sample.AccAddress())
+	k.SetBridgeRoute(ctx, bridgeRouteSample("channel-0"))
+
+	_, err := ms.SetBridgeRouteEnabled(ctx, types.NewMsgSetBridgeRouteEnabled(sample.AccAddress(), "transfer", "channel-0", false))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Contains(t, err.Error(), "signer is neither a pauser nor the operator")
+
+	route, _ := k.GetBridgeRoute(ctx, "transfer", "channel-0")
+	require.True(t, route.Enabled)
+}
+
+func TestMsgSetBridgeRouteEnabled_RouteNotFound(t *testing.T) {
+	// Test case: the bridge route does not exist
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ms := keeper.NewMsgServerImpl(k)
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	operator := sample.AccAddress()
+	k.SetOperatorAddress(ctx, operator)
+
+	_, err := ms.SetBridgeRouteEnabled(ctx, types.NewMsgSetBridgeRouteEnabled(operator, "transfer", "channel-9", false))
+	require.ErrorIs(t, err, types.ErrBridgeRouteNotFound)
+}
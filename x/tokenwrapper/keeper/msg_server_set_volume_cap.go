@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"zigchain/x/tokenwrapper/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetVolumeCap sets the inbound and outbound volume caps of a bridge route within the bounds
+// of the params, zero caps remove the volume cap of the route
+func (k msgServer) SetVolumeCap(goCtx context.Context, msg *types.MsgSetVolumeCap) (*types.MsgSetVolumeCapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Signer != k.GetOperatorAddress(ctx) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("only the current operator can set volume caps")
+	}
+
+	if _, found := k.GetBridgeRoute(ctx, msg.NativePort, msg.NativeChannel); !found {
+		return nil, errorsmod.Wrapf(types.ErrBridgeRouteNotFound, "%s/%s", msg.NativePort, msg.NativeChannel)
+	}
+
+	volumeCap := msg.VolumeCap()
+	if msg.IsRemoval() {
+		k.RemoveVolumeCap(ctx, msg.NativePort, msg.NativeChannel)
+	} else {
+		if err := volumeCap.ValidateBounds(k.GetParams(ctx)); err != nil {
+			return nil, err
+		}
+		k.Keeper.SetVolumeCap(ctx, volumeCap)
+	}
+
+	types.EmitVolumeCapSetEvent(ctx, msg.Signer, volumeCap)
+
+	return &types.MsgSetVolumeCapResponse{
+		Signer:        msg.Signer,
+		NativePort:    msg.NativePort,
+		NativeChannel: msg.NativeChannel,
+		InboundCap:    msg.InboundCap,
+		OutboundCap:   msg.OutboundCap,
+		Window:        msg.Window,
+	}, nil
+}
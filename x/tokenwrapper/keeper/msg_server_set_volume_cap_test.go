@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"zigchain/app"
+	"zigchain/testutil/sample"
+	"zigchain/x/tokenwrapper/keeper"
+	"zigchain/x/tokenwrapper/types"
+)
+
+// Positive test cases
+
+func TestMsgSetVolumeCap_Operator(t *testing.T) {
+	// Test case: the operator sets and removes the volume cap of a bridge route
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ms := keeper.NewMsgServerImpl(k)
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	operator := sample.AccAddress()
+	k.SetOperatorAddress(ctx, operator)
+	k.SetBridgeRoute(ctx, bridgeRouteSample("channel-0"))
+
+	resp, err := ms.SetVolumeCap(ctx, types.NewMsgSetVolumeCap(operator, "transfer", "channel-0", sdkmath.NewInt(1000), sdkmath.NewInt(500), 3600))
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgSetVolumeCapResponse{
+		Signer:        operator,
+		NativePort:    "transfer",
+		NativeChannel: "channel-0",
+		InboundCap:    sdkmath.NewInt(1000),
+		OutboundCap:   sdkmath.NewInt(500),
+		Window:        3600,
+	}, resp)
+
+	volumeCap, found := k.GetVolumeCap(ctx, "transfer", "channel-0")
+	require.True(t, found)
+	require.Equal(t, volumeCapSample("channel-0", 1000, 500, 3600), volumeCap)
+
+	var foundEvent bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeVolumeCapSet {
+			foundEvent = true
+			for _, attr := range event.Attributes {
+				if attr.Key == types.AttributeKeyInboundCap {
+					require.Equal(t, "1000", attr.Value)
+				}
+			}
+		}
+	}
+	require.True(t, foundEvent, "Expected EventTypeVolumeCapSet event to be emitted")
+
+	_, err = ms.SetVolumeCap(ctx, types.NewMsgSetVolumeCap(operator, "transfer", "channel-0", sdkmath.ZeroInt(), sdkmath.ZeroInt(), 0))
+	require.NoError(t, err)
+	_, found = k.GetVolumeCap(ctx, "transfer", "channel-0")
+	require.False(t, found)
+}
+
+// Negative test cases
+
+func TestMsgSetVolumeCap_Unauthorized(t *testing.T) {
+	// Test case: only the operator can set volume caps
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ms := keeper.NewMsgServerImpl(k)
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	k.SetOperatorAddress(ctx, sample.AccAddress())
+	k.SetBridgeRoute(ctx, bridgeRouteSample("channel-0"))
+
+	_, err := ms.SetVolumeCap(ctx, types.NewMsgSetVolumeCap(sample.AccAddress(), "transfer", "channel-0", sdkmath.NewInt(1000), sdkmath.NewInt(500), 3600))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestMsgSetVolumeCap_RouteNotFound(t *testing.T) {
+	// Test case: the volume cap can only be set on an existing bridge route
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ms := keeper.NewMsgServerImpl(k)
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	operator := sample.AccAddress()
+	k.SetOperatorAddress(ctx, operator)
+
+	_, err := ms.SetVolumeCap(ctx, types.NewMsgSetVolumeCap(operator, "transfer", "channel-0", sdkmath.NewInt(1000), sdkmath.NewInt(500), 3600))
+	require.ErrorIs(t, err, types.ErrBridgeRouteNotFound)
+}
+
+func TestMsgSetVolumeCap_OutOfBounds(t *testing.T) {
+	// Test case: the operator can not set a volume cap outside of the bounds of the params
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ms := keeper.NewMsgServerImpl(k)
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	operator := sample.AccAddress()
+	k.SetOperatorAddress(ctx, operator)
+	k.SetBridgeRoute(ctx, bridgeRouteSample("channel-0"))
+	require.NoError(t, k.SetParams(ctx, types.NewParams(sdkmath.NewInt(1000), sdkmath.NewInt(1000), 3600, types.DefaultPendingUnwrapGasBudget, 0, 0, sdkmath.ZeroInt(), "")))
+
+	_, err := ms.SetVolumeCap(ctx, types.NewMsgSetVolumeCap(operator, "transfer", "channel-0", sdkmath.NewInt(1001), sdkmath.NewInt(500), 3600))
+	require.ErrorIs(t, err, types.ErrInvalidVolumeCap)
+
+	_, err = ms.SetVolumeCap(ctx, types.NewMsgSetVolumeCap(operator, "transfer", "channel-0", sdkmath.NewInt(1000), sdkmath.NewInt(500), 60))
+	require.ErrorIs(t, err, types.ErrInvalidVolumeCap)
+
+	_, found := k.GetVolumeCap(ctx, "transfer", "channel-0")
+	require.False(t, found)
+}
@@ -5,11 +5,14 @@ import (
 	"fmt"
 
 	"zigchain/x/tokenwrapper/types"
+	"zigchain/zutils/constants"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// UpdateIbcSettings updates the IBC settings
+// UpdateIbcSettings updates the IBC settings of the bridge route of the native port and channel,
+// registering the route when it does not exist yet
 func (k msgServer) UpdateIbcSettings(goCtx context.Context, msg *types.MsgUpdateIbcSettings) (*types.MsgUpdateIbcSettingsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -19,18 +22,34 @@ func (k msgServer) UpdateIbcSettings(goCtx context.Context, msg *types.MsgUpdate
 		return nil, fmt.Errorf("only the current operator can update the IBC settings")
 	}
 
-	// Update the IBC settings
-	k.SetNativeClientId(ctx, msg.NativeClientId)
-	k.SetCounterpartyClientId(ctx, msg.CounterpartyClientId)
-	k.SetNativePort(ctx, msg.NativePort)
-	k.SetCounterpartyPort(ctx, msg.CounterpartyPort)
-	k.SetNativeChannel(ctx, msg.NativeChannel)
-	k.SetCounterpartyChannel(ctx, msg.CounterpartyChannel)
-	k.SetDenom(ctx, msg.Denom)
+	nativeDenom := msg.NativeDenom
+	if nativeDenom == "" {
+		nativeDenom = constants.BondDenom
+	}
+
+	// Upsert the bridge route of the native port and channel, keeping its totals and enabled flag
+	route, found := k.GetBridgeRoute(ctx, msg.NativePort, msg.NativeChannel)
+	if !found {
+		route = types.BridgeRoute{
+			NativePort:          msg.NativePort,
+			NativeChannel:       msg.NativeChannel,
+			Enabled:             true,
+			TotalTransferredIn:  sdkmath.ZeroInt(),
+			TotalTransferredOut: sdkmath.ZeroInt(),
+		}
+	}
+	route.NativeClientId = msg.NativeClientId
+	route.CounterpartyClientId = msg.CounterpartyClientId
+	route.CounterpartyPort = msg.CounterpartyPort
+	route.CounterpartyChannel = msg.CounterpartyChannel
+	route.Denom = msg.Denom
+	route.NativeDenom = nativeDenom
+	route.DecimalDifference = msg.DecimalDifference
 
-	if err := k.SetDecimalDifference(ctx, msg.DecimalDifference); err != nil {
+	if err := route.Validate(); err != nil {
 		return nil, err
 	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Emit event for IBC settings update
 	settings := map[string]string{
@@ -42,6 +61,7 @@ func (k msgServer) UpdateIbcSettings(goCtx context.Context, msg *types.MsgUpdate
 		types.AttributeKeyCounterpartyChannel:  msg.CounterpartyChannel,
 		types.AttributeKeyDenom:                msg.Denom,
 		types.AttributeKeyDecimalDifference:    fmt.Sprintf("%d", msg.DecimalDifference),
+		types.AttributeKeyNativeDenom:          nativeDenom,
 	}
 	types.EmitIbcSettingsUpdatedEvent(ctx, msg.Signer, settings)
 
@@ -55,5 +75,6 @@ func (k msgServer) UpdateIbcSettings(goCtx context.Context, msg *types.MsgUpdate
 		CounterpartyChannel:  msg.CounterpartyChannel,
 		Denom:                msg.Denom,
 		DecimalDifference:    msg.DecimalDifference,
+		NativeDenom:          nativeDenom,
 	}, nil
 }
@@ -3,12 +3,15 @@ package keeper_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/stretchr/testify/require"
 
 	"zigchain/app"
 	"zigchain/testutil/sample"
 	"zigchain/x/tokenwrapper/keeper"
 	"zigchain/x/tokenwrapper/types"
+	"zigchain/zutils/constants"
 )
 
 // Positive tests cases
@@ -50,14 +53,18 @@ func TestMsgUpdateIbcSettings_Valid(t *testing.T) {
 	require.NotNil(t, resp)
 
 	// verify state
-	require.Equal(t, msg.NativeClientId, k.GetNativeClientId(ctx))
-	require.Equal(t, msg.CounterpartyClientId, k.GetCounterpartyClientId(ctx))
-	require.Equal(t, msg.NativePort, k.GetNativePort(ctx))
-	require.Equal(t, msg.CounterpartyPort, k.GetCounterpartyPort(ctx))
-	require.Equal(t, msg.NativeChannel, k.GetNativeChannel(ctx))
-	require.Equal(t, msg.CounterpartyChannel, k.GetCounterpartyChannel(ctx))
-	require.Equal(t, msg.Denom, k.GetDenom(ctx))
-	require.Equal(t, msg.DecimalDifference, k.GetDecimalDifference(ctx))
+	route, found := k.GetBridgeRoute(ctx, msg.NativePort, msg.NativeChannel)
+	require.True(t, found)
+	require.Equal(t, msg.NativeClientId, route.NativeClientId)
+	require.Equal(t, msg.CounterpartyClientId, route.CounterpartyClientId)
+	require.Equal(t, msg.NativePort, route.NativePort)
+	require.Equal(t, msg.CounterpartyPort, route.CounterpartyPort)
+	require.Equal(t, msg.NativeChannel, route.NativeChannel)
+	require.Equal(t, msg.CounterpartyChannel, route.CounterpartyChannel)
+	require.Equal(t, msg.Denom, route.Denom)
+	require.Equal(t, msg.DecimalDifference, route.DecimalDifference)
+	require.Equal(t, constants.BondDenom, route.NativeDenom)
+	require.True(t, route.Enabled)
 
 	// CHECK EVENTS
 	// ---------------------------------
@@ -142,14 +149,18 @@ func TestMsgUpdateIbcSettings_Valid(t *testing.T) {
 	require.NotNil(t, updateResp)
 
 	// verify state
-	require.Equal(t, updateMsg.NativeClientId, k.GetNativeClientId(ctx))
-	require.Equal(t, updateMsg.CounterpartyClientId, k.GetCounterpartyClientId(ctx))
-	require.Equal(t, updateMsg.NativePort, k.GetNativePort(ctx))
-	require.Equal(t, updateMsg.CounterpartyPort, k.GetCounterpartyPort(ctx))
-	require.Equal(t, updateMsg.NativeChannel, k.GetNativeChannel(ctx))
-	require.Equal(t, updateMsg.CounterpartyChannel, k.GetCounterpartyChannel(ctx))
-	require.Equal(t, updateMsg.Denom, k.GetDenom(ctx))
-	require.Equal(t, updateMsg.DecimalDifference, k.GetDecimalDifference(ctx))
+	updatedRoute, found := k.GetBridgeRoute(ctx, updateMsg.NativePort, updateMsg.NativeChannel)
+	require.True(t, found)
+	require.Equal(t, updateMsg.NativeClientId, updatedRoute.NativeClientId)
+	require.Equal(t, updateMsg.CounterpartyClientId, updatedRoute.CounterpartyClientId)
+	require.Equal(t, updateMsg.NativePort, updatedRoute.NativePort)
+	require.Equal(t, updateMsg.CounterpartyPort, updatedRoute.CounterpartyPort)
+	require.Equal(t, updateMsg.NativeChannel, updatedRoute.NativeChannel)
+	require.Equal(t, updateMsg.CounterpartyChannel, updatedRoute.CounterpartyChannel)
+	require.Equal(t, updateMsg.Denom, updatedRoute.Denom)
+	require.Equal(t, updateMsg.DecimalDifference, updatedRoute.DecimalDifference)
+	require.Equal(t, constants.BondDenom, updatedRoute.NativeDenom)
+	require.True(t, updatedRoute.Enabled)
 
 	// check the response
 	require.Equal(t, updateResp, &types.MsgUpdateIbcSettingsResponse{
@@ -162,6 +173,7 @@ func TestMsgUpdateIbcSettings_Valid(t *testing.T) {
 		CounterpartyChannel:  updateMsg.CounterpartyChannel,
 		Denom:                updateMsg.Denom,
 		DecimalDifference:    updateMsg.DecimalDifference,
+		NativeDenom:          constants.BondDenom,
 	})
 }
 
@@ -202,14 +214,18 @@ func TestMsgUpdateIbcSettings_ValidWithHyphen(t *testing.T) {
 	require.NotNil(t, resp)
 
 	// verify state
-	require.Equal(t, msg.NativeClientId, k.GetNativeClientId(ctx))
-	require.Equal(t, msg.CounterpartyClientId, k.GetCounterpartyClientId(ctx))
-	require.Equal(t, msg.NativePort, k.GetNativePort(ctx))
-	require.Equal(t, msg.CounterpartyPort, k.GetCounterpartyPort(ctx))
-	require.Equal(t, msg.NativeChannel, k.GetNativeChannel(ctx))
-	require.Equal(t, msg.CounterpartyChannel, k.GetCounterpartyChannel(ctx))
-	require.Equal(t, msg.Denom, k.GetDenom(ctx))
-	require.Equal(t, msg.DecimalDifference, k.GetDecimalDifference(ctx))
+	route, found := k.GetBridgeRoute(ctx, msg.NativePort, msg.NativeChannel)
+	require.True(t, found)
+	require.Equal(t, msg.NativeClientId, route.NativeClientId)
+	require.Equal(t, msg.CounterpartyClientId, route.CounterpartyClientId)
+	require.Equal(t, msg.NativePort, route.NativePort)
+	require.Equal(t, msg.CounterpartyPort, route.CounterpartyPort)
+	require.Equal(t, msg.NativeChannel, route.NativeChannel)
+	require.Equal(t, msg.CounterpartyChannel, route.CounterpartyChannel)
+	require.Equal(t, msg.Denom, route.Denom)
+	require.Equal(t, msg.DecimalDifference, route.DecimalDifference)
+	require.Equal(t, constants.BondDenom, route.NativeDenom)
+	require.True(t, route.Enabled)
 
 	// CHECK EVENTS
 	// ---------------------------------
@@ -294,14 +310,18 @@ func TestMsgUpdateIbcSettings_ValidWithHyphen(t *testing.T) {
 	require.NotNil(t, updateResp)
 
 	// verify state
-	require.Equal(t, updateMsg.NativeClientId, k.GetNativeClientId(ctx))
-	require.Equal(t, updateMsg.CounterpartyClientId, k.GetCounterpartyClientId(ctx))
-	require.Equal(t, updateMsg.NativePort, k.GetNativePort(ctx))
-	require.Equal(t, updateMsg.CounterpartyPort, k.GetCounterpartyPort(ctx))
-	require.Equal(t, updateMsg.NativeChannel, k.GetNativeChannel(ctx))
-	require.Equal(t, updateMsg.CounterpartyChannel, k.GetCounterpartyChannel(ctx))
-	require.Equal(t, updateMsg.Denom, k.GetDenom(ctx))
-	require.Equal(t, updateMsg.DecimalDifference, k.GetDecimalDifference(ctx))
+	updatedRoute, found := k.GetBridgeRoute(ctx, updateMsg.NativePort, updateMsg.NativeChannel)
+	require.True(t, found)
+	require.Equal(t, updateMsg.NativeClientId, updatedRoute.NativeClientId)
+	require.Equal(t, updateMsg.CounterpartyClientId, updatedRoute.CounterpartyClientId)
+	require.Equal(t, updateMsg.NativePort, updatedRoute.NativePort)
+	require.Equal(t, updateMsg.CounterpartyPort, updatedRoute.CounterpartyPort)
+	require.Equal(t, updateMsg.NativeChannel, updatedRoute.NativeChannel)
+	require.Equal(t, updateMsg.CounterpartyChannel, updatedRoute.CounterpartyChannel)
+	require.Equal(t, updateMsg.Denom, updatedRoute.Denom)
+	require.Equal(t, updateMsg.DecimalDifference, updatedRoute.DecimalDifference)
+	require.Equal(t, constants.BondDenom, updatedRoute.NativeDenom)
+	require.True(t, updatedRoute.Enabled)
 
 	// check the response
 	require.Equal(t, updateResp, &types.MsgUpdateIbcSettingsResponse{
@@ -314,6 +334,7 @@ func TestMsgUpdateIbcSettings_ValidWithHyphen(t *testing.T) {
 		CounterpartyChannel:  updateMsg.CounterpartyChannel,
 		Denom:                updateMsg.Denom,
 		DecimalDifference:    updateMsg.DecimalDifference,
+		NativeDenom:          constants.BondDenom,
 	})
 }
 
@@ -436,7 +457,7 @@ func TestMsgUpdateIbcSettings_InvalidDecimalDifference(t *testing.T) {
 	require.Nil(t, resp)
 
 	// Verify error
-	require.Contains(t, err.Error(), "decimal difference must be between 0 and 18, got 19")
+	require.Contains(t, err.Error(), "decimal difference cannot be greater than 18")
 }
 
 func TestMsgUpdateIbcSettings_InvalidSignerFormat(t *testing.T) {
@@ -505,3 +526,55 @@ func TestMsgUpdateIbcSettings_NoOperatorSet(t *testing.T) {
 	// Verify error
 	require.Equal(t, "only the current operator can update the IBC settings", err.Error())
 }
+
+func TestMsgUpdateIbcSettings_UpdatesExistingRoute(t *testing.T) {
+	// Test case: updating the IBC settings of an existing route keeps its totals and enabled flag,
+	// settings on another native channel register a second route
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ms := keeper.NewMsgServerImpl(k)
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	signer := sample.AccAddress()
+	k.SetOperatorAddress(ctx, signer)
+
+	existing := bridgeRouteSample("channel-0")
+	existing.Enabled = false
+	existing.TotalTransferredIn = sdkmath.NewInt(500)
+	existing.TotalTransferredOut = sdkmath.NewInt(200)
+	k.SetBridgeRoute(ctx, existing)
+
+	msg := types.NewMsgUpdateIbcSettings(signer, "07-tendermint-5", "07-tendermint-6", "transfer", "transfer", "channel-0", "channel-7", "uaxl", 6, "uusdc")
+	resp, err := ms.UpdateIbcSettings(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, "uusdc", resp.NativeDenom)
+
+	route, found := k.GetBridgeRoute(ctx, "transfer", "channel-0")
+	require.True(t, found)
+	require.Equal(t, types.BridgeRoute{
+		NativePort:           "transfer",
+		NativeChannel:        "channel-0",
+		NativeClientId:       "07-tendermint-5",
+		CounterpartyClientId: "07-tendermint-6",
+		CounterpartyPort:     "transfer",
+		CounterpartyChannel:  "channel-7",
+		Denom:                "uaxl",
+		NativeDenom:          "uusdc",
+		DecimalDifference:    6,
+		Enabled:              false,
+		TotalTransferredIn:   sdkmath.NewInt(500),
+		TotalTransferredOut:  sdkmath.NewInt(200),
+	}, route)
+
+	msg = types.NewMsgUpdateIbcSettings(signer, "07-tendermint-8", "07-tendermint-9", "transfer", "transfer", "channel-1", "channel-10", "uaxl", 12, "")
+	_, err = ms.UpdateIbcSettings(ctx, msg)
+	require.NoError(t, err)
+
+	require.Len(t, k.GetAllBridgeRoutes(ctx), 2)
+	route, found = k.GetBridgeRoute(ctx, "transfer", "channel-1")
+	require.True(t, found)
+	require.True(t, route.Enabled)
+	require.Equal(t, constants.BondDenom, route.NativeDenom)
+	require.True(t, route.TotalTransferredIn.IsZero())
+}
@@ -0,0 +1,328 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"zigchain/x/tokenwrapper/types"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetNextPendingUnwrapId returns the id of the next pending unwrap
+func (k Keeper) GetNextPendingUnwrapId(ctx sdk.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.NextPendingUnwrapIdKey)
+	if bz == nil {
+		return types.DefaultIndex
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextPendingUnwrapId sets the id of the next pending unwrap
+func (k Keeper) SetNextPendingUnwrapId(ctx sdk.Context, id uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.NextPendingUnwrapIdKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetPendingUnwrapCursor returns the id of the pending unwrap the begin blocker resumes the queue from
+func (k Keeper) GetPendingUnwrapCursor(ctx sdk.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.PendingUnwrapCursorKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetPendingUnwrapCursor sets the id of the pending unwrap the begin blocker resumes the queue from
+func (k Keeper) SetPendingUnwrapCursor(ctx sdk.Context, id uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.PendingUnwrapCursorKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetPendingUnwrap sets a pending unwrap and its entry in the index by address
+func (k Keeper) SetPendingUnwrap(ctx sdk.Context, pendingUnwrap types.PendingUnwrap) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PendingUnwrapKeyPrefix)
+	store.Set(types.PendingUnwrapKey(pendingUnwrap.Id), k.cdc.MustMarshal(&pendingUnwrap))
+
+	addressStore := prefix.NewStore(storeAdapter, types.PendingUnwrapAddressKeyPrefix)
+	addressStore.Set(types.PendingUnwrapAddressKey(pendingUnwrap.Address, pendingUnwrap.Id), []byte{})
+}
+
+// GetPendingUnwrap returns a pending unwrap by id
+func (k Keeper) GetPendingUnwrap(ctx sdk.Context, id uint64) (pendingUnwrap types.PendingUnwrap, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PendingUnwrapKeyPrefix)
+	bz := store.Get(types.PendingUnwrapKey(id))
+	if bz == nil {
+		return pendingUnwrap, false
+	}
+	k.cdc.MustUnmarshal(bz, &pendingUnwrap)
+	return pendingUnwrap, true
+}
+
+// RemovePendingUnwrap removes a pending unwrap and its entry in the index by address
+func (k Keeper) RemovePendingUnwrap(ctx sdk.Context, pendingUnwrap types.PendingUnwrap) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PendingUnwrapKeyPrefix)
+	store.Delete(types.PendingUnwrapKey(pendingUnwrap.Id))
+
+	addressStore := prefix.NewStore(storeAdapter, types.PendingUnwrapAddressKeyPrefix)
+	addressStore.Delete(types.PendingUnwrapAddressKey(pendingUnwrap.Address, pendingUnwrap.Id))
+}
+
+// GetAllPendingUnwraps returns all pending unwraps in queue order
+func (k Keeper) GetAllPendingUnwraps(ctx sdk.Context) (list []types.PendingUnwrap) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PendingUnwrapKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingUnwrap types.PendingUnwrap
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingUnwrap)
+		list = append(list, pendingUnwrap)
+	}
+
+	return
+}
+
+// GetPendingUnwrapsByAddress returns the pending unwraps of an address in queue order
+func (k Keeper) GetPendingUnwrapsByAddress(ctx sdk.Context, address string) (list []types.PendingUnwrap) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	addressStore := prefix.NewStore(storeAdapter, types.PendingUnwrapAddressKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(addressStore, types.PendingUnwrapAddressPrefix(address))
+
+	defer iterator.Close()
+
+	prefixLength := len(types.PendingUnwrapAddressPrefix(address))
+	for ; iterator.Valid(); iterator.Next() {
+		pendingUnwrap, found := k.GetPendingUnwrap(ctx, sdk.BigEndianToUint64(iterator.Key()[prefixLength:]))
+		if found {
+			list = append(list, pendingUnwrap)
+		}
+	}
+
+	return
+}
+
+// QueuePendingUnwrap queues an incoming transfer the module wallet could not cover, the IBC
+// vouchers kept by the receiver are converted by the begin blocker once the wallet is funded again
+func (k Keeper) QueuePendingUnwrap(ctx sdk.Context, receiver sdk.AccAddress, route types.BridgeRoute, amount sdkmath.Int, sequence uint64) {
+	id := k.GetNextPendingUnwrapId(ctx)
+	pendingUnwrap := types.PendingUnwrap{
+		Id:            id,
+		Address:       receiver.String(),
+		NativePort:    route.NativePort,
+		NativeChannel: route.NativeChannel,
+		Amount:        amount,
+		Height:        ctx.BlockHeight(),
+		Sequence:      sequence,
+	}
+
+	k.SetPendingUnwrap(ctx, pendingUnwrap)
+	k.SetNextPendingUnwrapId(ctx, id+1)
+
+	types.EmitPendingUnwrapQueuedEvent(ctx, pendingUnwrap)
+}
+
+// RemoveRecoveredPendingUnwraps removes the pending unwraps of an address over a bridge route
+// once its IBC vouchers have been recovered with MsgRecoverZig, and marks their transfer records
+// as acked. The records are settled in queue order up to the recovered native amount, and the bridge
+// fee paid on it is split over them pro rata.
+func (k Keeper) RemoveRecoveredPendingUnwraps(ctx sdk.Context, address sdk.AccAddress, route types.BridgeRoute, recoveredAmount sdkmath.Int, fee sdkmath.Int) {
+	remaining := recoveredAmount
+	for _, pendingUnwrap := range k.GetPendingUnwrapsByAddress(ctx, address.String()) {
+		if pendingUnwrap.NativePort != route.NativePort || pendingUnwrap.NativeChannel != route.NativeChannel {
+			continue
+		}
+
+		k.RemovePendingUnwrap(ctx, pendingUnwrap)
+		types.EmitPendingUnwrapRemovedEvent(ctx, pendingUnwrap, types.PendingUnwrapRemovedRecovered)
+
+		convertedAmount, err := route.ScaleDownTokenPrecision(pendingUnwrap.Amount)
+		if err != nil {
+			convertedAmount = sdkmath.ZeroInt()
+		}
+		convertedAmount = sdkmath.MinInt(convertedAmount, remaining)
+		remaining = remaining.Sub(convertedAmount)
+
+		recordFee := sdkmath.ZeroInt()
+		if recoveredAmount.IsPositive() {
+			recordFee = fee.Mul(convertedAmount).Quo(recoveredAmount)
+		}
+		k.settleTransferRecord(ctx, pendingUnwrap, convertedAmount.Sub(recordFee), recordFee)
+	}
+}
+
+// ProcessPendingUnwraps converts the pending unwraps in queue order within the gas budget of the
+// params. The pending unwraps that can not be converted yet are skipped, and the queue resumes from
+// the cursor on the next block so the ones at its head do not spend the whole budget every block.
+func (k Keeper) ProcessPendingUnwraps(ctx sdk.Context) {
+	budget := k.GetParams(ctx).PendingUnwrapGasBudget
+	if budget == 0 || !k.IsEnabled(ctx) {
+		return
+	}
+
+	budgetCtx := ctx.WithGasMeter(storetypes.NewGasMeter(budget))
+
+	cursor := k.GetPendingUnwrapCursor(ctx)
+	next := cursor
+	wrapped := false
+
+	// the budget running out stops the queue, the pending unwrap being converted is discarded and
+	// the next block resumes from it
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+		}
+		k.SetPendingUnwrapCursor(ctx, next)
+	}()
+
+	for {
+		pendingUnwrap, found := k.nextPendingUnwrap(budgetCtx, next)
+		if !found || (wrapped && pendingUnwrap.Id >= cursor) {
+			// the whole queue has been visited
+			if wrapped || cursor == 0 {
+				next = 0
+				return
+			}
+			wrapped = true
+			next = 0
+			continue
+		}
+		next = pendingUnwrap.Id
+
+		unwrapCtx, write := budgetCtx.CacheContext()
+		if err := k.processPendingUnwrap(unwrapCtx, pendingUnwrap); err != nil {
+			k.Logger().Error(fmt.Sprintf("failed to process pending unwrap %d: %v", pendingUnwrap.Id, err))
+		} else {
+			write()
+		}
+		next = pendingUnwrap.Id + 1
+	}
+}
+
+// nextPendingUnwrap returns the first pending unwrap with an id from the given id
+func (k Keeper) nextPendingUnwrap(ctx sdk.Context, from uint64) (pendingUnwrap types.PendingUnwrap, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.PendingUnwrapKeyPrefix)
+	iterator := store.Iterator(types.PendingUnwrapKey(from), nil)
+
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return pendingUnwrap, false
+	}
+	k.cdc.MustUnmarshal(iterator.Value(), &pendingUnwrap)
+	return pendingUnwrap, true
+}
+
+// processPendingUnwrap locks the IBC vouchers of a pending unwrap and unlocks the native tokens.
+// Pending unwraps that can never be converted are removed, the ones the module wallet can not cover
+// or over a disabled route or a full volume cap stay queued.
+func (k Keeper) processPendingUnwrap(ctx sdk.Context, pendingUnwrap types.PendingUnwrap) error {
+	route, found := k.GetBridgeRoute(ctx, pendingUnwrap.NativePort, pendingUnwrap.NativeChannel)
+	if !found {
+		k.RemovePendingUnwrap(ctx, pendingUnwrap)
+		types.EmitPendingUnwrapRemovedEvent(ctx, pendingUnwrap, types.PendingUnwrapRemovedRouteNotFound)
+		return nil
+	}
+	if !route.Enabled {
+		return nil
+	}
+
+	address := sdk.MustAccAddressFromBech32(pendingUnwrap.Address)
+	recvDenom := route.IBCRecvDenom()
+
+	// the receiver may have moved or recovered the IBC vouchers
+	if err := k.CheckAccountBalance(ctx, address, sdk.NewCoins(sdk.NewCoin(recvDenom, pendingUnwrap.Amount))); err != nil {
+		k.RemovePendingUnwrap(ctx, pendingUnwrap)
+		types.EmitPendingUnwrapRemovedEvent(ctx, pendingUnwrap, types.PendingUnwrapRemovedNoVouchers)
+		return nil
+	}
+
+	convertedAmount, err := route.ScaleDownTokenPrecision(pendingUnwrap.Amount)
+	if err != nil {
+		k.RemovePendingUnwrap(ctx, pendingUnwrap)
+		types.EmitPendingUnwrapRemovedEvent(ctx, pendingUnwrap, types.PendingUnwrapRemovedZeroAmount)
+		return nil
+	}
+
+	// the bridge fee is deducted from the native amount unlocked to the receiver, as on receive
+	fee := k.GetBridgeFee(ctx, convertedAmount)
+	if fee.GTE(convertedAmount) {
+		k.RemovePendingUnwrap(ctx, pendingUnwrap)
+		types.EmitPendingUnwrapRemovedEvent(ctx, pendingUnwrap, types.PendingUnwrapRemovedFeeExceeds)
+		return nil
+	}
+	netAmount := convertedAmount.Sub(fee)
+
+	// the module wallet can not cover it yet, the later pending unwraps may still be covered
+	if err := k.CheckModuleBalance(ctx, sdk.NewCoins(sdk.NewCoin(route.NativeDenom, convertedAmount))); err != nil {
+		return nil
+	}
+
+	// the inbound volume is only recorded once the native tokens are unlocked
+	if err := k.CheckInboundVolume(ctx, route, convertedAmount); err != nil {
+		if errors.Is(err, types.ErrVolumeCapExceeded) {
+			return nil
+		}
+		return err
+	}
+
+	ibcCoins, err := k.LockIBCTokens(ctx, address, pendingUnwrap.Amount, recvDenom)
+	if err != nil {
+		return err
+	}
+
+	nativeCoins, err := k.UnlockNativeTokens(ctx, address, netAmount, ibcCoins, route.NativeDenom)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ConsumeInboundVolume(ctx, route, convertedAmount); err != nil {
+		return err
+	}
+
+	// the fee stays in the module wallet if it cannot be paid
+	if err := k.CollectInboundBridgeFee(ctx, route, fee); err != nil {
+		types.EmitTokenWrapperErrorEvent(ctx, err)
+		k.Logger().Error(fmt.Sprintf("failed to collect bridge fee of pending unwrap %d: %v", pendingUnwrap.Id, err))
+		fee = sdkmath.ZeroInt()
+	}
+
+	k.AddToRouteTransferredIn(ctx, route, convertedAmount)
+	k.RemovePendingUnwrap(ctx, pendingUnwrap)
+	k.settleTransferRecord(ctx, pendingUnwrap, netAmount, fee)
+
+	types.EmitPendingUnwrapProcessedEvent(ctx, pendingUnwrap, ibcCoins[0], nativeCoins[0], sdk.NewCoin(route.NativeDenom, fee))
+
+	return nil
+}
+
+// settleTransferRecord marks the transfer record of the packet of a converted pending unwrap as acked,
+// with the native amount unlocked and the fee paid. Records already pruned are not updated.
+func (k Keeper) settleTransferRecord(ctx sdk.Context, pendingUnwrap types.PendingUnwrap, nativeAmount sdkmath.Int, fee sdkmath.Int) {
+	record, found := k.GetTransferRecordByPacket(ctx, pendingUnwrap.NativePort, pendingUnwrap.NativeChannel, true, pendingUnwrap.Sequence)
+	if !found {
+		k.Logger().Info(fmt.Sprintf("no transfer record for pending unwrap %d", pendingUnwrap.Id))
+		return
+	}
+
+	record.NativeAmount = nativeAmount
+	record.Fee = fee
+	record.Status = types.TRANSFER_STATUS_ACKED
+	record.Reason = ""
+	record.UpdatedHeight = ctx.BlockHeight()
+	k.SetTransferRecord(ctx, record)
+}
@@ -0,0 +1,404 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	"zigchain/app"
+	"zigchain/testutil/sample"
+	"zigchain/x/tokenwrapper/keeper"
+	"zigchain/x/tokenwrapper/types"
+	"zigchain/zutils/constants"
+)
+
+// fundPendingUnwrap mints the IBC vouchers of a pending unwrap to the receiver and queues it
+func fundPendingUnwrap(t *testing.T, testApp *app.App, ctx sdk.Context, route types.BridgeRoute, ibcAmount sdkmath.Int) sdk.AccAddress {
+	receiver := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	ibcCoins := sdk.NewCoins(sdk.NewCoin(route.IBCRecvDenom(), ibcAmount))
+	require.NoError(t, testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, ibcCoins))
+	require.NoError(t, testApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, receiver, ibcCoins))
+
+	testApp.TokenwrapperKeeper.QueuePendingUnwrap(ctx, receiver, route, ibcAmount, 0)
+	return receiver
+}
+
+// fundModuleWallet mints native tokens to the module wallet
+func fundModuleWallet(t *testing.T, testApp *app.App, ctx sdk.Context, amount int64) {
+	nativeCoins := sdk.NewCoins(sdk.NewInt64Coin(constants.BondDenom, amount))
+	require.NoError(t, testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, nativeCoins))
+	require.NoError(t, testApp.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, nativeCoins))
+}
+
+// Positive test cases
+
+func TestPendingUnwrap_Queue(t *testing.T) {
+	// Test case: pending unwraps are queued in order and indexed by address
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	route := bridgeRouteSample("channel-0")
+	receiver := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	other := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	k.QueuePendingUnwrap(ctx, receiver, route, sdkmath.NewInt(100), 0)
+	k.QueuePendingUnwrap(ctx, other, route, sdkmath.NewInt(200), 0)
+	k.QueuePendingUnwrap(ctx, receiver, route, sdkmath.NewInt(300), 0)
+
+	require.Equal(t, uint64(4), k.GetNextPendingUnwrapId(ctx))
+
+	all := k.GetAllPendingUnwraps(ctx)
+	require.Len(t, all, 3)
+	require.Equal(t, []uint64{1, 2, 3}, []uint64{all[0].Id, all[1].Id, all[2].Id})
+
+	byAddress := k.GetPendingUnwrapsByAddress(ctx, receiver.String())
+	require.Len(t, byAddress, 2)
+	require.Equal(t, sdkmath.NewInt(100), byAddress[0].Amount)
+	require.Equal(t, sdkmath.NewInt(300), byAddress[1].Amount)
+
+	k.RemovePendingUnwrap(ctx, byAddress[0])
+	require.Len(t, k.GetAllPendingUnwraps(ctx), 2)
+	require.Len(t, k.GetPendingUnwrapsByAddress(ctx, receiver.String()), 1)
+}
+
+func TestProcessPendingUnwraps_SkipsUnderfunded(t *testing.T) {
+	// Test case: the pending unwraps are converted in order and the ones the module wallet can not
+	// cover are skipped until it is funded again
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	route := bridgeRouteSample("channel-0")
+	k.SetBridgeRoute(ctx, route)
+	k.SetEnabled(ctx, true)
+
+	first := fundPendingUnwrap(t, testApp, ctx, route, sdkmath.NewInt(1_000_000_000_000_000))
+	second := fundPendingUnwrap(t, testApp, ctx, route, sdkmath.NewInt(5_000_000_000_000_000))
+	third := fundPendingUnwrap(t, testApp, ctx, route, sdkmath.NewInt(1_000_000_000_000_000))
+
+	fundModuleWallet(t, testApp, ctx, 3000)
+	k.ProcessPendingUnwraps(ctx)
+
+	require.Equal(t, sdkmath.NewInt(1000), testApp.BankKeeper.GetBalance(ctx, first, constants.BondDenom).Amount)
+	require.True(t, testApp.BankKeeper.GetBalance(ctx, second, constants.BondDenom).IsZero())
+	require.Equal(t, sdkmath.NewInt(1000), testApp.BankKeeper.GetBalance(ctx, third, constants.BondDenom).Amount)
+
+	remaining := k.GetAllPendingUnwraps(ctx)
+	require.Len(t, remaining, 1)
+	require.Equal(t, uint64(2), remaining[0].Id)
+
+	stored, _ := k.GetBridgeRoute(ctx, "transfer", "channel-0")
+	require.Equal(t, sdkmath.NewInt(2000), stored.TotalTransferredIn)
+
+	fundModuleWallet(t, testApp, ctx, 4000)
+	k.ProcessPendingUnwraps(ctx)
+
+	require.Equal(t, sdkmath.NewInt(5000), testApp.BankKeeper.GetBalance(ctx, second, constants.BondDenom).Amount)
+	require.Empty(t, k.GetAllPendingUnwraps(ctx))
+}
+
+func TestProcessPendingUnwraps_Cursor(t *testing.T) {
+	// Test case: the pending unwraps that stay queued at the head of the queue do not spend the
+	// budget of every block, the next block resumes from the one the budget ran out on
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	route := bridgeRouteSample("channel-0")
+	disabled := bridgeRouteSample("channel-1")
+	disabled.Enabled = false
+	k.SetBridgeRoute(ctx, route)
+	k.SetBridgeRoute(ctx, disabled)
+	k.SetEnabled(ctx, true)
+	fundModuleWallet(t, testApp, ctx, 1_000_000)
+
+	for i := 0; i < 5; i++ {
+		fundPendingUnwrap(t, testApp, ctx, disabled, sdkmath.NewInt(1_000_000_000_000_000))
+	}
+	receiver := fundPendingUnwrap(t, testApp, ctx, route, sdkmath.NewInt(1_000_000_000_000_000))
+
+	// the budget covers the conversion but not the conversion and the skipped pending unwraps
+	params := types.DefaultParams()
+	params.PendingUnwrapGasBudget = 62_000
+	require.NoError(t, k.SetParams(ctx, params))
+
+	k.ProcessPendingUnwraps(ctx)
+	require.Len(t, k.GetAllPendingUnwraps(ctx), 6)
+	require.Equal(t, uint64(6), k.GetPendingUnwrapCursor(ctx))
+
+	k.ProcessPendingUnwraps(ctx)
+	require.Equal(t, sdkmath.NewInt(1000), testApp.BankKeeper.GetBalance(ctx, receiver, constants.BondDenom).Amount)
+	require.Len(t, k.GetAllPendingUnwraps(ctx), 5)
+
+	// a pass over the whole queue within the budget starts the next block from the head
+	params.PendingUnwrapGasBudget = types.DefaultPendingUnwrapGasBudget
+	require.NoError(t, k.SetParams(ctx, params))
+	k.ProcessPendingUnwraps(ctx)
+	require.Equal(t, uint64(0), k.GetPendingUnwrapCursor(ctx))
+	require.Len(t, k.GetAllPendingUnwraps(ctx), 5)
+}
+
+func TestProcessPendingUnwraps_SettlesTransferRecord(t *testing.T) {
+	// Test case: the transfer record of the packet of a converted pending unwrap is marked as acked
+	// with the native amount unlocked
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	route := bridgeRouteSample("channel-0")
+	k.SetBridgeRoute(ctx, route)
+	k.SetEnabled(ctx, true)
+
+	receiver := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	ibcAmount := sdkmath.NewInt(1_000_000_000_000_000)
+	ibcCoins := sdk.NewCoins(sdk.NewCoin(route.IBCRecvDenom(), ibcAmount))
+	require.NoError(t, testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, ibcCoins))
+	require.NoError(t, testApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, receiver, ibcCoins))
+
+	// the incoming transfer the module wallet could not cover
+	k.RecordTransfer(ctx, types.TransferRecord{
+		NativePort:    route.NativePort,
+		NativeChannel: route.NativeChannel,
+		Sequence:      7,
+		Inbound:       true,
+		Sender:        sample.AccAddress(),
+		Receiver:      receiver.String(),
+		IbcAmount:     ibcAmount,
+		NativeAmount:  sdkmath.NewInt(1000),
+		Fee:           sdkmath.ZeroInt(),
+		Status:        types.TRANSFER_STATUS_SKIPPED,
+		Reason:        "insufficient module balance",
+	})
+	k.QueuePendingUnwrap(ctx, receiver, route, ibcAmount, 7)
+
+	fundModuleWallet(t, testApp, ctx, 1000)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	k.ProcessPendingUnwraps(ctx)
+	require.Empty(t, k.GetAllPendingUnwraps(ctx))
+
+	record, found := k.GetTransferRecordByPacket(ctx, route.NativePort, route.NativeChannel, true, 7)
+	require.True(t, found)
+	require.Equal(t, types.TRANSFER_STATUS_ACKED, record.Status)
+	require.Empty(t, record.Reason)
+	require.Equal(t, sdkmath.NewInt(1000), record.NativeAmount)
+	require.True(t, record.Fee.IsZero())
+	require.Equal(t, ctx.BlockHeight(), record.UpdatedHeight)
+}
+
+func TestProcessPendingUnwraps_BridgeFee(t *testing.T) {
+	// Test case: the bridge fee is deducted from the native amount of a pending unwrap and paid to
+	// the fee recipient, and a pending unwrap the fee would take entirely is removed
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	route := bridgeRouteSample("channel-0")
+	k.SetBridgeRoute(ctx, route)
+	k.SetEnabled(ctx, true)
+	recipient := setBridgeFee(t, testApp, ctx)
+
+	receiver := fundPendingUnwrap(t, testApp, ctx, route, sdkmath.NewInt(20_000_000_000_000_000))
+	fundPendingUnwrap(t, testApp, ctx, route, sdkmath.NewInt(100_000_000_000_000))
+
+	fundModuleWallet(t, testApp, ctx, 20_000)
+	k.ProcessPendingUnwraps(ctx)
+	require.Empty(t, k.GetAllPendingUnwraps(ctx))
+
+	require.Equal(t, sdkmath.NewInt(19_800), testApp.BankKeeper.GetBalance(ctx, receiver, constants.BondDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(200), testApp.BankKeeper.GetBalance(ctx, recipient, constants.BondDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(200), k.GetTotalBridgeFeesIn(ctx))
+
+	stored, _ := k.GetBridgeRoute(ctx, "transfer", "channel-0")
+	require.Equal(t, sdkmath.NewInt(20_000), stored.TotalTransferredIn)
+
+	var fees []string
+	reasons := map[string]bool{}
+	for _, event := range ctx.EventManager().Events() {
+		for _, attr := range event.Attributes {
+			if event.Type == types.EventTypePendingUnwrapProcessed && attr.Key == types.AttributeKeyFee {
+				fees = append(fees, attr.Value)
+			}
+			if event.Type == types.EventTypePendingUnwrapRemoved && attr.Key == types.AttributeKeyReason {
+				reasons[attr.Value] = true
+			}
+		}
+	}
+	require.Equal(t, []string{"200" + constants.BondDenom}, fees)
+	require.Equal(t, map[string]bool{types.PendingUnwrapRemovedFeeExceeds: true}, reasons)
+}
+
+func TestProcessPendingUnwraps_Removed(t *testing.T) {
+	// Test case: the pending unwraps that can never be converted leave the queue, the ones over
+	// a disabled route stay queued
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	route := bridgeRouteSample("channel-0")
+	disabled := bridgeRouteSample("channel-1")
+	disabled.Enabled = false
+	k.SetBridgeRoute(ctx, route)
+	k.SetBridgeRoute(ctx, disabled)
+	k.SetEnabled(ctx, true)
+	fundModuleWallet(t, testApp, ctx, 1_000_000)
+
+	// the receiver no longer holds the IBC vouchers
+	receiver := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	k.QueuePendingUnwrap(ctx, receiver, route, sdkmath.NewInt(1_000_000_000_000_000), 0)
+
+	// the route was removed
+	removed := bridgeRouteSample("channel-2")
+	k.QueuePendingUnwrap(ctx, receiver, removed, sdkmath.NewInt(1_000_000_000_000_000), 0)
+
+	// the amount scales down to zero native tokens
+	fundPendingUnwrap(t, testApp, ctx, route, sdkmath.NewInt(1))
+
+	// the route is disabled
+	fundPendingUnwrap(t, testApp, ctx, disabled, sdkmath.NewInt(1_000_000_000_000_000))
+
+	k.ProcessPendingUnwraps(ctx)
+
+	remaining := k.GetAllPendingUnwraps(ctx)
+	require.Len(t, remaining, 1)
+	require.Equal(t, "channel-1", remaining[0].NativeChannel)
+
+	reasons := map[string]bool{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypePendingUnwrapRemoved {
+			for _, attr := range event.Attributes {
+				if attr.Key == types.AttributeKeyReason {
+					reasons[attr.Value] = true
+				}
+			}
+		}
+	}
+	require.Equal(t, map[string]bool{
+		types.PendingUnwrapRemovedNoVouchers:    true,
+		types.PendingUnwrapRemovedRouteNotFound: true,
+		types.PendingUnwrapRemovedZeroAmount:    true,
+	}, reasons)
+}
+
+func TestProcessPendingUnwraps_GasBudget(t *testing.T) {
+	// Test case: the queue stops once the gas budget of the params is spent, and is not processed
+	// when the budget is zero
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	route := bridgeRouteSample("channel-0")
+	k.SetBridgeRoute(ctx, route)
+	k.SetEnabled(ctx, true)
+	fundModuleWallet(t, testApp, ctx, 1_000_000)
+
+	for i := 0; i < 3; i++ {
+		fundPendingUnwrap(t, testApp, ctx, route, sdkmath.NewInt(1_000_000_000_000_000))
+	}
+
+	params := types.DefaultParams()
+	params.PendingUnwrapGasBudget = 0
+	require.NoError(t, k.SetParams(ctx, params))
+	k.ProcessPendingUnwraps(ctx)
+	require.Len(t, k.GetAllPendingUnwraps(ctx), 3)
+
+	// a budget too small for a single conversion leaves the queue untouched
+	params.PendingUnwrapGasBudget = 1000
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NotPanics(t, func() { k.ProcessPendingUnwraps(ctx) })
+	require.Len(t, k.GetAllPendingUnwraps(ctx), 3)
+
+	params.PendingUnwrapGasBudget = types.DefaultPendingUnwrapGasBudget
+	require.NoError(t, k.SetParams(ctx, params))
+	k.ProcessPendingUnwraps(ctx)
+	require.Empty(t, k.GetAllPendingUnwraps(ctx))
+}
+
+func TestRecoverZig_RemovesPendingUnwraps(t *testing.T) {
+	// Test case: a manual recovery removes the pending unwraps of the address over the route
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ms := keeper.NewMsgServerImpl(k)
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	route := bridgeRouteSample("channel-0")
+	k.SetBridgeRoute(ctx, route)
+	k.SetEnabled(ctx, true)
+
+	receiver := fundPendingUnwrap(t, testApp, ctx, route, sdkmath.NewInt(1_000_000_000_000_000))
+	other := fundPendingUnwrap(t, testApp, ctx, route, sdkmath.NewInt(1_000_000_000_000_000))
+	fundModuleWallet(t, testApp, ctx, 1000)
+
+	_, err := ms.RecoverZig(ctx, types.NewMsgRecoverZig(sample.AccAddress(), receiver.String()))
+	require.NoError(t, err)
+
+	require.Empty(t, k.GetPendingUnwrapsByAddress(ctx, receiver.String()))
+	require.Len(t, k.GetPendingUnwrapsByAddress(ctx, other.String()), 1)
+}
+
+func TestRecoverZig_SettlesTransferRecords(t *testing.T) {
+	// Test case: a manual recovery marks the transfer records of the recovered pending unwraps as acked,
+	// with the native amount unlocked and their share of the bridge fee
+
+	testApp := app.InitTestApp(initChain, t)
+	k := testApp.TokenwrapperKeeper
+	ms := keeper.NewMsgServerImpl(k)
+	ctx := testApp.BaseApp.NewContext(initChain)
+
+	route := bridgeRouteSample("channel-0")
+	k.SetBridgeRoute(ctx, route)
+	k.SetEnabled(ctx, true)
+	setBridgeFee(t, testApp, ctx)
+
+	receiver := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	ibcAmount := sdkmath.NewInt(10_000_000_000_000_000)
+	ibcCoins := sdk.NewCoins(sdk.NewCoin(route.IBCRecvDenom(), ibcAmount.MulRaw(2)))
+	require.NoError(t, testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, ibcCoins))
+	require.NoError(t, testApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, receiver, ibcCoins))
+
+	// the incoming transfers the module wallet could not cover
+	for _, sequence := range []uint64{7, 8} {
+		k.RecordTransfer(ctx, types.TransferRecord{
+			NativePort:    route.NativePort,
+			NativeChannel: route.NativeChannel,
+			Sequence:      sequence,
+			Inbound:       true,
+			Sender:        sample.AccAddress(),
+			Receiver:      receiver.String(),
+			IbcAmount:     ibcAmount,
+			NativeAmount:  sdkmath.NewInt(10_000),
+			Fee:           sdkmath.ZeroInt(),
+			Status:        types.TRANSFER_STATUS_SKIPPED,
+			Reason:        "insufficient module balance",
+		})
+		k.QueuePendingUnwrap(ctx, receiver, route, ibcAmount, sequence)
+	}
+
+	fundModuleWallet(t, testApp, ctx, 20_000)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+
+	_, err := ms.RecoverZig(ctx, types.NewMsgRecoverZig(sample.AccAddress(), receiver.String()))
+	require.NoError(t, err)
+	require.Empty(t, k.GetAllPendingUnwraps(ctx))
+
+	for _, sequence := range []uint64{7, 8} {
+		record, found := k.GetTransferRecordByPacket(ctx, route.NativePort, route.NativeChannel, true, sequence)
+		require.True(t, found)
+		require.Equal(t, types.TRANSFER_STATUS_ACKED, record.Status)
+		require.Empty(t, record.Reason)
+		require.Equal(t, sdkmath.NewInt(9_900), record.NativeAmount)
+		require.Equal(t, sdkmath.NewInt(100), record.Fee)
+		require.Equal(t, ctx.BlockHeight(), record.UpdatedHeight)
+	}
+}
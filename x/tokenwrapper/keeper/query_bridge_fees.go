@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"zigchain/x/tokenwrapper/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) BridgeFees(goCtx context.Context, req *types.QueryBridgeFeesRequest) (*types.QueryBridgeFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+
+	return &types.QueryBridgeFeesResponse{
+		BridgeFeeBps:       params.BridgeFeeBps,
+		BridgeFeeMin:       params.BridgeFeeMin,
+		BridgeFeeRecipient: params.BridgeFeeRecipient,
+		TotalBridgeFeesIn:  k.GetTotalBridgeFeesIn(ctx),
+		TotalBridgeFeesOut: k.GetTotalBridgeFeesOut(ctx),
+	}, nil
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "zigchain/testutil/keeper"
+	"zigchain/testutil/sample"
+	"zigchain/x/tokenwrapper/types"
+)
+
+// Positive test cases
+
+func TestBridgeFeesQuery(t *testing.T) {
+	// Test case: the query returns the bridge fee of the params and the total bridge fees paid
+
+	k, ctx := keepertest.TokenwrapperKeeper(t, nil)
+
+	params := types.DefaultParams()
+	params.BridgeFeeBps = 25
+	params.BridgeFeeMin = sdkmath.NewInt(1_000)
+	params.BridgeFeeRecipient = sample.AccAddress()
+	require.NoError(t, k.SetParams(ctx, params))
+
+	k.SetTotalBridgeFeesIn(ctx, sdkmath.NewInt(300))
+	k.SetTotalBridgeFeesOut(ctx, sdkmath.NewInt(400))
+
+	response, err := k.BridgeFees(ctx, &types.QueryBridgeFeesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryBridgeFeesResponse{
+		BridgeFeeBps:       25,
+		BridgeFeeMin:       sdkmath.NewInt(1_000),
+		BridgeFeeRecipient: params.BridgeFeeRecipient,
+		TotalBridgeFeesIn:  sdkmath.NewInt(300),
+		TotalBridgeFeesOut: sdkmath.NewInt(400),
+	}, response)
+}
+
+// Negative test cases
+
+func TestBridgeFeesQuery_InvalidRequest(t *testing.T) {
+	// Test case: a nil request is rejected
+
+	k, ctx := keepertest.TokenwrapperKeeper(t, nil)
+
+	_, err := k.BridgeFees(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
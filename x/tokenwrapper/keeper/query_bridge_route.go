@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"zigchain/x/tokenwrapper/types"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) BridgeRoutes(goCtx context.Context, req *types.QueryBridgeRoutesRequest) (*types.QueryBridgeRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var routes []types.BridgeRoute
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	routeStore := prefix.NewStore(storeAdapter, types.BridgeRouteKeyPrefix)
+
+	pageRes, err := query.Paginate(routeStore, req.Pagination, func(key []byte, value []byte) error {
+		var route types.BridgeRoute
+		if err := k.cdc.Unmarshal(value, &route); err != nil {
+			return err
+		}
+
+		routes = append(routes, route)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBridgeRoutesResponse{Routes: routes, Pagination: pageRes}, nil
+}
+
+func (k Keeper) BridgeRoute(goCtx context.Context, req *types.QueryBridgeRouteRequest) (*types.QueryBridgeRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	route, found := k.GetBridgeRoute(ctx, req.NativePort, req.NativeChannel)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryBridgeRouteResponse{Route: route}, nil
+}
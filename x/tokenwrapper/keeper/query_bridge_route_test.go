@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "zigchain/testutil/keeper"
+	"zigchain/x/tokenwrapper/types"
+)
+
+// Positive test cases
+
+func TestBridgeRoutesQuery_Paginated(t *testing.T) {
+	// Test case: list the bridge routes page by page
+
+	keeper, ctx := keepertest.TokenwrapperKeeper(t, nil)
+
+	routes := []types.BridgeRoute{
+		bridgeRouteSample("channel-0"),
+		bridgeRouteSample("channel-1"),
+		bridgeRouteSample("channel-2"),
+	}
+	for _, route := range routes {
+		keeper.SetBridgeRoute(ctx, route)
+	}
+
+	response, err := keeper.BridgeRoutes(ctx, &types.QueryBridgeRoutesRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, routes[:2], response.Routes)
+	require.Equal(t, uint64(3), response.Pagination.Total)
+
+	response, err = keeper.BridgeRoutes(ctx, &types.QueryBridgeRoutesRequest{
+		Pagination: &query.PageRequest{Key: response.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Equal(t, routes[2:], response.Routes)
+}
+
+func TestBridgeRouteQuery_Valid(t *testing.T) {
+	// Test case: get a bridge route by its native port and channel
+
+	keeper, ctx := keepertest.TokenwrapperKeeper(t, nil)
+
+	route := bridgeRouteSample("channel-0")
+	keeper.SetBridgeRoute(ctx, route)
+
+	response, err := keeper.BridgeRoute(ctx, &types.QueryBridgeRouteRequest{NativePort: "transfer", NativeChannel: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryBridgeRouteResponse{Route: route}, response)
+}
+
+// Negative test cases
+
+func TestBridgeRouteQuery_NotFound(t *testing.T) {
+	// Test case: no bridge route on the native port and channel
+
+	keeper, ctx := keepertest.TokenwrapperKeeper(t, nil)
+
+	resp, err := keeper.BridgeRoute(ctx, &types.QueryBridgeRouteRequest{NativePort: "transfer", NativeChannel: "channel-0"})
+	require.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestBridgeRouteQuery_InvalidRequest(t *testing.T) {
+	// Test case: request is nil
+
+	keeper, ctx := keepertest.TokenwrapperKeeper(t, nil)
+
+	_, err := keeper.BridgeRoutes(ctx, nil)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+
+	_, err = keeper.BridgeRoute(ctx, nil)
+	st, ok = status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+}
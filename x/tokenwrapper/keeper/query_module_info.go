@@ -28,13 +28,5 @@ func (k Keeper) ModuleInfo(goCtx context.Context, req *types.QueryModuleInfoRequ
 		ProposedOperatorAddress: k.GetProposedOperatorAddress(ctx),
 		PauserAddresses:         k.GetPauserAddresses(ctx),
 		TokenWrapperEnabled:     tokenWrapperEnabled,
-		NativeClientId:          k.GetNativeClientId(ctx),
-		CounterpartyClientId:    k.GetCounterpartyClientId(ctx),
-		NativePort:              k.GetNativePort(ctx),
-		CounterpartyPort:        k.GetCounterpartyPort(ctx),
-		NativeChannel:           k.GetNativeChannel(ctx),
-		CounterpartyChannel:     k.GetCounterpartyChannel(ctx),
-		Denom:                   k.GetDenom(ctx),
-		DecimalDifference:       k.GetDecimalDifference(ctx),
 	}, nil
 }
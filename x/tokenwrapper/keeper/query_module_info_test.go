@@ -100,14 +100,9 @@ func TestModuleWalletBalancesQuery_Valid(t *testing.T) {
 	require.NotNil(t, updateResp)
 
 	// verify state
-	require.Equal(t, updateMsg.NativeClientId, k.GetNativeClientId(ctx))
-	require.Equal(t, updateMsg.CounterpartyClientId, k.GetCounterpartyClientId(ctx))
-	require.Equal(t, updateMsg.NativePort, k.GetNativePort(ctx))
-	require.Equal(t, updateMsg.CounterpartyPort, k.GetCounterpartyPort(ctx))
-	require.Equal(t, updateMsg.NativeChannel, k.GetNativeChannel(ctx))
-	require.Equal(t, updateMsg.CounterpartyChannel, k.GetCounterpartyChannel(ctx))
-	require.Equal(t, updateMsg.Denom, k.GetDenom(ctx))
-	require.Equal(t, updateMsg.DecimalDifference, k.GetDecimalDifference(ctx))
+	route, found := k.GetBridgeRoute(ctx, updateMsg.NativePort, updateMsg.NativeChannel)
+	require.True(t, found)
+	require.Equal(t, updateMsg.Denom, route.Denom)
 
 	// call the ModuleInfo method
 	response, err := k.ModuleInfo(ctx, &types.QueryModuleInfoRequest{})
@@ -119,14 +114,6 @@ func TestModuleWalletBalancesQuery_Valid(t *testing.T) {
 		OperatorAddress:         signer.String(),
 		ProposedOperatorAddress: sample.ZeroAccAddress(),
 		TokenWrapperEnabled:     false,
-		NativeClientId:          "client-01",
-		CounterpartyClientId:    "client-02",
-		NativePort:              "transfer_01",
-		CounterpartyPort:        "transfer_02",
-		NativeChannel:           "channel-1",
-		CounterpartyChannel:     "channel-2",
-		Denom:                   "uzig",
-		DecimalDifference:       12,
 		PauserAddresses:         []string{pauser},
 	}, response)
 }
@@ -188,14 +175,9 @@ func TestModuleWalletBalancesQuery_TokenWrapperEnabled(t *testing.T) {
 	require.NotNil(t, updateResp)
 
 	// verify state
-	require.Equal(t, updateMsg.NativeClientId, k.GetNativeClientId(ctx))
-	require.Equal(t, updateMsg.CounterpartyClientId, k.GetCounterpartyClientId(ctx))
-	require.Equal(t, updateMsg.NativePort, k.GetNativePort(ctx))
-	require.Equal(t, updateMsg.CounterpartyPort, k.GetCounterpartyPort(ctx))
-	require.Equal(t, updateMsg.NativeChannel, k.GetNativeChannel(ctx))
-	require.Equal(t, updateMsg.CounterpartyChannel, k.GetCounterpartyChannel(ctx))
-	require.Equal(t, updateMsg.Denom, k.GetDenom(ctx))
-	require.Equal(t, updateMsg.DecimalDifference, k.GetDecimalDifference(ctx))
+	route, found := k.GetBridgeRoute(ctx, updateMsg.NativePort, updateMsg.NativeChannel)
+	require.True(t, found)
+	require.Equal(t, updateMsg.Denom, route.Denom)
 
 	// create a message to enable the token wrapper
 	// with the correct signer
@@ -225,14 +207,6 @@ func TestModuleWalletBalancesQuery_TokenWrapperEnabled(t *testing.T) {
 		ProposedOperatorAddress: sample.ZeroAccAddress(),
 		PauserAddresses:         []string{},
 		TokenWrapperEnabled:     true,
-		NativeClientId:          "client-01",
-		CounterpartyClientId:    "client-02",
-		NativePort:              "transfer_01",
-		CounterpartyPort:        "transfer_02",
-		NativeChannel:           "channel-1",
-		CounterpartyChannel:     "channel-2",
-		Denom:                   "uzig",
-		DecimalDifference:       12,
 	}, response)
 }
 
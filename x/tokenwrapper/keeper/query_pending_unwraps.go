@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"zigchain/x/tokenwrapper/types"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PendingUnwraps(goCtx context.Context, req *types.QueryPendingUnwrapsRequest) (*types.QueryPendingUnwrapsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var pendingUnwraps []types.PendingUnwrap
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	pendingUnwrapStore := prefix.NewStore(storeAdapter, types.PendingUnwrapKeyPrefix)
+
+	pageRes, err := query.Paginate(pendingUnwrapStore, req.Pagination, func(key []byte, value []byte) error {
+		var pendingUnwrap types.PendingUnwrap
+		if err := k.cdc.Unmarshal(value, &pendingUnwrap); err != nil {
+			return err
+		}
+
+		pendingUnwraps = append(pendingUnwraps, pendingUnwrap)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingUnwrapsResponse{PendingUnwraps: pendingUnwraps, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PendingUnwrapsByAddress(goCtx context.Context, req *types.QueryPendingUnwrapsByAddressRequest) (*types.QueryPendingUnwrapsByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var pendingUnwraps []types.PendingUnwrap
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	addressStore := prefix.NewStore(
+		prefix.NewStore(storeAdapter, types.PendingUnwrapAddressKeyPrefix),
+		types.PendingUnwrapAddressPrefix(req.Address),
+	)
+
+	pageRes, err := query.Paginate(addressStore, req.Pagination, func(key []byte, _ []byte) error {
+		pendingUnwrap, found := k.GetPendingUnwrap(ctx, sdk.BigEndianToUint64(key))
+		if found {
+			pendingUnwraps = append(pendingUnwraps, pendingUnwrap)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingUnwrapsByAddressResponse{PendingUnwraps: pendingUnwraps, Pagination: pageRes}, nil
+}
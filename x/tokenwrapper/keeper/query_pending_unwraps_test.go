@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "zigchain/testutil/keeper"
+	"zigchain/testutil/sample"
+	"zigchain/x/tokenwrapper/types"
+)
+
+// Positive test cases
+
+func TestPendingUnwrapsQuery_Paginated(t *testing.T) {
+	// Test case: list the pending unwraps page by page, and the pending unwraps of an address
+
+	k, ctx := keepertest.TokenwrapperKeeper(t, nil)
+
+	route := bridgeRouteSample("channel-0")
+	receiver := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	k.QueuePendingUnwrap(ctx, receiver, route, sdkmath.NewInt(100), 0)
+	k.QueuePendingUnwrap(ctx, sdk.MustAccAddressFromBech32(sample.AccAddress()), route, sdkmath.NewInt(200), 0)
+	k.QueuePendingUnwrap(ctx, receiver, route, sdkmath.NewInt(300), 0)
+	all := k.GetAllPendingUnwraps(ctx)
+
+	response, err := k.PendingUnwraps(ctx, &types.QueryPendingUnwrapsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, all[:2], response.PendingUnwraps)
+	require.Equal(t, uint64(3), response.Pagination.Total)
+
+	response, err = k.PendingUnwraps(ctx, &types.QueryPendingUnwrapsRequest{
+		Pagination: &query.PageRequest{Key: response.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Equal(t, all[2:], response.PendingUnwraps)
+
+	byAddress, err := k.PendingUnwrapsByAddress(ctx, &types.QueryPendingUnwrapsByAddressRequest{
+		Address:    receiver.String(),
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.PendingUnwrap{all[0], all[2]}, byAddress.PendingUnwraps)
+	require.Equal(t, uint64(2), byAddress.Pagination.Total)
+}
+
+// Negative test cases
+
+func TestPendingUnwrapsQuery_Invalid(t *testing.T) {
+	// Test case: the request must be set and the address valid
+
+	k, ctx := keepertest.TokenwrapperKeeper(t, nil)
+
+	_, err := k.PendingUnwraps(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	_, err = k.PendingUnwrapsByAddress(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	_, err = k.PendingUnwrapsByAddress(ctx, &types.QueryPendingUnwrapsByAddressRequest{Address: "invalid"})
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid address"))
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+
+	"zigchain/x/tokenwrapper/types"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) TransferRecords(goCtx context.Context, req *types.QueryTransferRecordsRequest) (*types.QueryTransferRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var transferRecords []types.TransferRecord
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	transferRecordStore := prefix.NewStore(storeAdapter, types.TransferRecordKeyPrefix)
+
+	pageRes, err := query.Paginate(transferRecordStore, req.Pagination, func(key []byte, value []byte) error {
+		var record types.TransferRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		transferRecords = append(transferRecords, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTransferRecordsResponse{TransferRecords: transferRecords, Pagination: pageRes}, nil
+}
+
+func (k Keeper) TransferRecordsByAddress(goCtx context.Context, req *types.QueryTransferRecordsByAddressRequest) (*types.QueryTransferRecordsByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	// the counterparty addresses of the transfers are not bech32 encoded with the chain prefix
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	addressStore := prefix.NewStore(
+		prefix.NewStore(storeAdapter, types.TransferRecordAddressKeyPrefix),
+		types.TransferRecordAddressPrefix(req.Address),
+	)
+
+	transferRecords, pageRes, err := k.paginateTransferRecordIndex(ctx, addressStore, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTransferRecordsByAddressResponse{TransferRecords: transferRecords, Pagination: pageRes}, nil
+}
+
+func (k Keeper) TransferRecordsByStatus(goCtx context.Context, req *types.QueryTransferRecordsByStatusRequest) (*types.QueryTransferRecordsByStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, ok := types.TransferStatus_name[int32(req.Status)]; !ok || req.Status == types.TRANSFER_STATUS_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "invalid status")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	statusStore := prefix.NewStore(
+		prefix.NewStore(storeAdapter, types.TransferRecordStatusKeyPrefix),
+		types.TransferRecordStatusPrefix(req.Status),
+	)
+
+	transferRecords, pageRes, err := k.paginateTransferRecordIndex(ctx, statusStore, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTransferRecordsByStatusResponse{TransferRecords: transferRecords, Pagination: pageRes}, nil
+}
+
+// paginateTransferRecordIndex paginates an index of the transfer records keyed by id
+func (k Keeper) paginateTransferRecordIndex(
+	ctx sdk.Context,
+	indexStore prefix.Store,
+	pagination *query.PageRequest,
+) ([]types.TransferRecord, *query.PageResponse, error) {
+	var transferRecords []types.TransferRecord
+
+	pageRes, err := query.Paginate(indexStore, pagination, func(key []byte, _ []byte) error {
+		record, found := k.GetTransferRecord(ctx, sdk.BigEndianToUint64(key))
+		if found {
+			transferRecords = append(transferRecords, record)
+		}
+		return nil
+	})
+
+	return transferRecords, pageRes, err
+}
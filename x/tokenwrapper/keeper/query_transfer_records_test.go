@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "zigchain/testutil/keeper"
+	"zigchain/testutil/sample"
+	"zigchain/x/tokenwrapper/types"
+)
+
+// Positive test cases
+
+func TestTransferRecordsQuery_Paginated(t *testing.T) {
+	// Test case: list the transfer records page by page, and the transfer records of an address and of a status
+
+	k, ctx := keepertest.TokenwrapperKeeper(t, nil)
+
+	sender := sample.AccAddress()
+	k.RecordTransfer(ctx, transferRecordSample("channel-0", 1, sender))
+	k.RecordTransfer(ctx, transferRecordSample("channel-0", 2, sample.AccAddress()))
+	k.RecordTransfer(ctx, transferRecordSample("channel-0", 3, sender))
+	k.UpdateTransferStatus(ctx, "transfer", "channel-0", 3, types.TRANSFER_STATUS_TIMED_OUT, "")
+	all := k.GetAllTransferRecords(ctx)
+
+	response, err := k.TransferRecords(ctx, &types.QueryTransferRecordsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, all[:2], response.TransferRecords)
+	require.Equal(t, uint64(3), response.Pagination.Total)
+
+	response, err = k.TransferRecords(ctx, &types.QueryTransferRecordsRequest{
+		Pagination: &query.PageRequest{Key: response.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Equal(t, all[2:], response.TransferRecords)
+
+	byAddress, err := k.TransferRecordsByAddress(ctx, &types.QueryTransferRecordsByAddressRequest{
+		Address:    sender,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.TransferRecord{all[0], all[2]}, byAddress.TransferRecords)
+	require.Equal(t, uint64(2), byAddress.Pagination.Total)
+
+	// the counterparty receiver is indexed too
+	byReceiver, err := k.TransferRecordsByAddress(ctx, &types.QueryTransferRecordsByAddressRequest{Address: "axelar1receiver"})
+	require.NoError(t, err)
+	require.Len(t, byReceiver.TransferRecords, 3)
+
+	byStatus, err := k.TransferRecordsByStatus(ctx, &types.QueryTransferRecordsByStatusRequest{
+		Status:     types.TRANSFER_STATUS_PENDING,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, all[:1], byStatus.TransferRecords)
+	require.Equal(t, uint64(2), byStatus.Pagination.Total)
+
+	timedOut, err := k.TransferRecordsByStatus(ctx, &types.QueryTransferRecordsByStatusRequest{Status: types.TRANSFER_STATUS_TIMED_OUT})
+	require.NoError(t, err)
+	require.Equal(t, all[2:], timedOut.TransferRecords)
+}
+
+// Negative test cases
+
+func TestTransferRecordsQuery_Invalid(t *testing.T) {
+	// Test case: the request must be set, the address not empty and the status known
+
+	k, ctx := keepertest.TokenwrapperKeeper(t, nil)
+
+	_, err := k.TransferRecords(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	_, err = k.TransferRecordsByAddress(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	_, err = k.TransferRecordsByAddress(ctx, &types.QueryTransferRecordsByAddressRequest{})
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid address"))
+
+	_, err = k.TransferRecordsByStatus(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	_, err = k.TransferRecordsByStatus(ctx, &types.QueryTransferRecordsByStatusRequest{Status: types.TRANSFER_STATUS_UNSPECIFIED})
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid status"))
+
+	_, err = k.TransferRecordsByStatus(ctx, &types.QueryTransferRecordsByStatusRequest{Status: types.TransferStatus(42)})
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid status"))
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"zigchain/x/tokenwrapper/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) VolumeCapacity(goCtx context.Context, req *types.QueryVolumeCapacityRequest) (*types.QueryVolumeCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetBridgeRoute(ctx, req.NativePort, req.NativeChannel); !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	volumeCap := k.GetBoundedVolumeCap(ctx, req.NativePort, req.NativeChannel)
+	now := ctx.BlockTime().Unix()
+
+	inboundUsed, _ := k.VolumeInWindow(ctx, volumeCap, true, now)
+	outboundUsed, _ := k.VolumeInWindow(ctx, volumeCap, false, now)
+
+	return &types.QueryVolumeCapacityResponse{
+		VolumeCap:         volumeCap,
+		InboundUsed:       inboundUsed,
+		OutboundUsed:      outboundUsed,
+		InboundRemaining:  remainingVolume(volumeCap.Cap(true), inboundUsed),
+		OutboundRemaining: remainingVolume(volumeCap.Cap(false), outboundUsed),
+	}, nil
+}
+
+// remainingVolume returns the volume left under a cap, zero once the cap is reached
+func remainingVolume(limit sdkmath.Int, used sdkmath.Int) sdkmath.Int {
+	if used.GTE(limit) {
+		return sdkmath.ZeroInt()
+	}
+
+	return limit.Sub(used)
+}
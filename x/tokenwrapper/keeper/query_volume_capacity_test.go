@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "zigchain/testutil/keeper"
+	"zigchain/x/tokenwrapper/types"
+)
+
+// Positive test cases
+
+func TestVolumeCapacityQuery_Valid(t *testing.T) {
+	// Test case: the remaining capacity is the cap minus the volume in the window
+
+	k, ctx := keepertest.TokenwrapperKeeper(t, nil)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	route := bridgeRouteSample("channel-0")
+	k.SetBridgeRoute(ctx, route)
+	volumeCap := volumeCapSample("channel-0", 1000, 0, 3600)
+	k.SetVolumeCap(ctx, volumeCap)
+	require.NoError(t, k.ConsumeInboundVolume(ctx, route, sdkmath.NewInt(300)))
+	require.NoError(t, k.ConsumeOutboundVolume(ctx, route, sdkmath.NewInt(50)))
+
+	response, err := k.VolumeCapacity(ctx, &types.QueryVolumeCapacityRequest{NativePort: "transfer", NativeChannel: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryVolumeCapacityResponse{
+		VolumeCap:         volumeCap,
+		InboundUsed:       sdkmath.NewInt(300),
+		OutboundUsed:      sdkmath.ZeroInt(),
+		InboundRemaining:  sdkmath.NewInt(700),
+		OutboundRemaining: sdkmath.ZeroInt(),
+	}, response)
+}
+
+// Negative test cases
+
+func TestVolumeCapacityQuery_Invalid(t *testing.T) {
+	// Test case: the request must be set and the bridge route exist
+
+	k, ctx := keepertest.TokenwrapperKeeper(t, nil)
+
+	_, err := k.VolumeCapacity(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	_, err = k.VolumeCapacity(ctx, &types.QueryVolumeCapacityRequest{NativePort: "transfer", NativeChannel: "channel-0"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+}
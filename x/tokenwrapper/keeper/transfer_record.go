@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"fmt"
+
+	"zigchain/x/tokenwrapper/types"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetNextTransferRecordId returns the id of the next transfer record
+func (k Keeper) GetNextTransferRecordId(ctx sdk.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.NextTransferRecordIdKey)
+	if bz == nil {
+		return types.DefaultIndex
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextTransferRecordId sets the id of the next transfer record
+func (k Keeper) SetNextTransferRecordId(ctx sdk.Context, id uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.NextTransferRecordIdKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetTransferRecord sets a transfer record and its entries in the indexes by packet, address and status
+func (k Keeper) SetTransferRecord(ctx sdk.Context, record types.TransferRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	statusStore := prefix.NewStore(storeAdapter, types.TransferRecordStatusKeyPrefix)
+
+	// the status of an existing record moves to its new entry in the index by status
+	if previous, found := k.GetTransferRecord(ctx, record.Id); found {
+		statusStore.Delete(types.TransferRecordStatusKey(previous.Status, previous.Id))
+	}
+
+	store := prefix.NewStore(storeAdapter, types.TransferRecordKeyPrefix)
+	store.Set(types.TransferRecordKey(record.Id), k.cdc.MustMarshal(&record))
+
+	packetStore := prefix.NewStore(storeAdapter, types.TransferRecordPacketKeyPrefix)
+	packetStore.Set(types.TransferRecordPacketKey(record.NativePort, record.NativeChannel, record.Inbound, record.Sequence), types.TransferRecordKey(record.Id))
+
+	addressStore := prefix.NewStore(storeAdapter, types.TransferRecordAddressKeyPrefix)
+	addressStore.Set(types.TransferRecordAddressKey(record.Sender, record.Id), []byte{})
+	addressStore.Set(types.TransferRecordAddressKey(record.Receiver, record.Id), []byte{})
+
+	statusStore.Set(types.TransferRecordStatusKey(record.Status, record.Id), []byte{})
+}
+
+// GetTransferRecord returns a transfer record by id
+func (k Keeper) GetTransferRecord(ctx sdk.Context, id uint64) (record types.TransferRecord, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TransferRecordKeyPrefix)
+	bz := store.Get(types.TransferRecordKey(id))
+	if bz == nil {
+		return record, false
+	}
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetTransferRecordByPacket returns the transfer record of a packet received or sent on a native port and channel
+func (k Keeper) GetTransferRecordByPacket(
+	ctx sdk.Context,
+	nativePort string,
+	nativeChannel string,
+	inbound bool,
+	sequence uint64,
+) (record types.TransferRecord, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	packetStore := prefix.NewStore(storeAdapter, types.TransferRecordPacketKeyPrefix)
+	bz := packetStore.Get(types.TransferRecordPacketKey(nativePort, nativeChannel, inbound, sequence))
+	if bz == nil {
+		return record, false
+	}
+	return k.GetTransferRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// RemoveTransferRecord removes a transfer record and its entries in the indexes
+func (k Keeper) RemoveTransferRecord(ctx sdk.Context, record types.TransferRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TransferRecordKeyPrefix)
+	store.Delete(types.TransferRecordKey(record.Id))
+
+	packetStore := prefix.NewStore(storeAdapter, types.TransferRecordPacketKeyPrefix)
+	packetStore.Delete(types.TransferRecordPacketKey(record.NativePort, record.NativeChannel, record.Inbound, record.Sequence))
+
+	addressStore := prefix.NewStore(storeAdapter, types.TransferRecordAddressKeyPrefix)
+	addressStore.Delete(types.TransferRecordAddressKey(record.Sender, record.Id))
+	addressStore.Delete(types.TransferRecordAddressKey(record.Receiver, record.Id))
+
+	statusStore := prefix.NewStore(storeAdapter, types.TransferRecordStatusKeyPrefix)
+	statusStore.Delete(types.TransferRecordStatusKey(record.Status, record.Id))
+}
+
+// GetAllTransferRecords returns all transfer records in ledger order
+func (k Keeper) GetAllTransferRecords(ctx sdk.Context) (list []types.TransferRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TransferRecordKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TransferRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		list = append(list, record)
+	}
+
+	return
+}
+
+// RecordTransfer writes a new transfer record of a packet wrapped over a bridge route at the current height
+func (k Keeper) RecordTransfer(ctx sdk.Context, record types.TransferRecord) {
+	record.Id = k.GetNextTransferRecordId(ctx)
+	record.Height = ctx.BlockHeight()
+	record.UpdatedHeight = ctx.BlockHeight()
+
+	k.SetTransferRecord(ctx, record)
+	k.SetNextTransferRecordId(ctx, record.Id+1)
+}
+
+// UpdateTransferStatus sets the status of the transfer record of a packet sent on a native port and channel
+// once it is acknowledged or timed out. Packets sent before the ledger existed or already pruned have no record.
+func (k Keeper) UpdateTransferStatus(
+	ctx sdk.Context,
+	nativePort string,
+	nativeChannel string,
+	sequence uint64,
+	status types.TransferStatus,
+	reason string,
+) {
+	record, found := k.GetTransferRecordByPacket(ctx, nativePort, nativeChannel, false, sequence)
+	if !found {
+		k.Logger().Info(fmt.Sprintf("no transfer record for packet %s/%s sequence %d", nativePort, nativeChannel, sequence))
+		return
+	}
+
+	record.Status = status
+	record.Reason = reason
+	record.UpdatedHeight = ctx.BlockHeight()
+	k.SetTransferRecord(ctx, record)
+}
+
+// PruneTransferRecords removes the transfer records older than the retention of the params in ledger order,
+// at most MaxTransferRecordsPrunedPerBlock per block so a large backlog is pruned over several blocks
+func (k Keeper) PruneTransferRecords(ctx sdk.Context) {
+	retention := k.GetParams(ctx).TransferRecordRetention
+	if retention == 0 {
+		return
+	}
+
+	// #nosec G115 -- block heights are positive
+	cutoff := ctx.BlockHeight() - int64(retention)
+	if cutoff <= 0 {
+		return
+	}
+
+	var expired []types.TransferRecord
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.TransferRecordKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	for ; iterator.Valid() && len(expired) < types.MaxTransferRecordsPrunedPerBlock; iterator.Next() {
+		var record types.TransferRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		// records are written in height order, the first record within the retention ends the pruning
+		if record.Height >= cutoff {
+			break
+		}
+		expired = append(expired, record)
+	}
+	iterator.Close()
+
+	for _, record := range expired {
+		k.RemoveTransferRecord(ctx, record)
+	}
+}
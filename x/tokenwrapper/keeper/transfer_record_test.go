@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "zigchain/testutil/keeper"
+	"zigchain/testutil/sample"
+	"zigchain/x/tokenwrapper/types"
+)
+
+// transferRecordSample returns an outgoing transfer record of a packet sent over the route of a native channel
+func transferRecordSample(nativeChannel string, sequence uint64, sender string) types.TransferRecord {
+	return types.TransferRecord{
+		NativePort:    "transfer",
+		NativeChannel: nativeChannel,
+		Sequence:      sequence,
+		Sender:        sender,
+		Receiver:      "axelar1receiver",
+		IbcAmount:     sdkmath.NewInt(1_000_000_000_000_000_000),
+		NativeAmount:  sdkmath.NewInt(1_000_000),
+		Status:        types.TRANSFER_STATUS_PENDING,
+	}
+}
+
+// Positive test cases
+
+func TestTransferRecord_RecordAndUpdate(t *testing.T) {
+	// Test case: records are written in ledger order, found by packet and their status moves between the indexes
+
+	k, ctx := keepertest.TokenwrapperKeeper(t, nil)
+	sender := sample.AccAddress()
+
+	k.RecordTransfer(ctx.WithBlockHeight(10), transferRecordSample("channel-0", 1, sender))
+	k.RecordTransfer(ctx.WithBlockHeight(11), transferRecordSample("channel-0", 2, sender))
+
+	// a received packet of the same sequence is a distinct record
+	inbound := transferRecordSample("channel-0", 1, "axelar1sender")
+	inbound.Inbound = true
+	inbound.Receiver = sender
+	inbound.Status = types.TRANSFER_STATUS_ACKED
+	k.RecordTransfer(ctx.WithBlockHeight(11), inbound)
+
+	require.Equal(t, uint64(4), k.GetNextTransferRecordId(ctx))
+
+	all := k.GetAllTransferRecords(ctx)
+	require.Len(t, all, 3)
+	require.Equal(t, []uint64{1, 2, 3}, []uint64{all[0].Id, all[1].Id, all[2].Id})
+	require.Equal(t, int64(10), all[0].Height)
+
+	record, found := k.GetTransferRecordByPacket(ctx, "transfer", "channel-0", true, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(3), record.Id)
+
+	k.UpdateTransferStatus(ctx.WithBlockHeight(20), "transfer", "channel-0", 1, types.TRANSFER_STATUS_REFUNDED, "ack error")
+
+	record, found = k.GetTransferRecordByPacket(ctx, "transfer", "channel-0", false, 1)
+	require.True(t, found)
+	require.Equal(t, types.TRANSFER_STATUS_REFUNDED, record.Status)
+	require.Equal(t, "ack error", record.Reason)
+	require.Equal(t, int64(10), record.Height)
+	require.Equal(t, int64(20), record.UpdatedHeight)
+
+	pending, err := k.TransferRecordsByStatus(ctx, &types.QueryTransferRecordsByStatusRequest{Status: types.TRANSFER_STATUS_PENDING})
+	require.NoError(t, err)
+	require.Len(t, pending.TransferRecords, 1)
+	require.Equal(t, uint64(2), pending.TransferRecords[0].Id)
+
+	// the status of a packet without a record is not updated
+	k.UpdateTransferStatus(ctx, "transfer", "channel-0", 5, types.TRANSFER_STATUS_ACKED, "")
+	require.Len(t, k.GetAllTransferRecords(ctx), 3)
+}
+
+func TestPruneTransferRecords(t *testing.T) {
+	// Test case: the records older than the retention are pruned in ledger order, at most a bounded number per block
+
+	k, ctx := keepertest.TokenwrapperKeeper(t, nil)
+	sender := sample.AccAddress()
+
+	for sequence := uint64(1); sequence <= types.MaxTransferRecordsPrunedPerBlock+10; sequence++ {
+		k.RecordTransfer(ctx.WithBlockHeight(10), transferRecordSample("channel-0", sequence, sender))
+	}
+	k.RecordTransfer(ctx.WithBlockHeight(50), transferRecordSample("channel-0", 1000, sender))
+
+	// no retention keeps the records forever
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+	k.PruneTransferRecords(ctx.WithBlockHeight(1000))
+	require.Len(t, k.GetAllTransferRecords(ctx), types.MaxTransferRecordsPrunedPerBlock+11)
+
+	params := types.DefaultParams()
+	params.TransferRecordRetention = 20
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// the records are still within the retention
+	k.PruneTransferRecords(ctx.WithBlockHeight(30))
+	require.Len(t, k.GetAllTransferRecords(ctx), types.MaxTransferRecordsPrunedPerBlock+11)
+
+	k.PruneTransferRecords(ctx.WithBlockHeight(31))
+	require.Len(t, k.GetAllTransferRecords(ctx), 11)
+
+	k.PruneTransferRecords(ctx.WithBlockHeight(32))
+	all := k.GetAllTransferRecords(ctx)
+	require.Len(t, all, 1)
+	require.Equal(t, uint64(1000), all[0].Sequence)
+
+	// the indexes of the pruned records are removed
+	_, found := k.GetTransferRecordByPacket(ctx, "transfer", "channel-0", false, 1)
+	require.False(t, found)
+
+	byAddress, err := k.TransferRecordsByAddress(ctx, &types.QueryTransferRecordsByAddressRequest{Address: sender})
+	require.NoError(t, err)
+	require.Len(t, byAddress.TransferRecords, 1)
+}
@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"fmt"
+
+	"zigchain/x/tokenwrapper/types"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetVolumeCap sets the volume cap of a bridge route, keyed by its native port and channel
+func (k Keeper) SetVolumeCap(ctx sdk.Context, volumeCap types.VolumeCap) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.VolumeCapKeyPrefix)
+	store.Set(types.BridgeRouteKey(volumeCap.NativePort, volumeCap.NativeChannel), k.cdc.MustMarshal(&volumeCap))
+}
+
+// GetVolumeCap returns the volume cap of the bridge route of a native port and channel
+func (k Keeper) GetVolumeCap(ctx sdk.Context, nativePort string, nativeChannel string) (volumeCap types.VolumeCap, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.VolumeCapKeyPrefix)
+	bz := store.Get(types.BridgeRouteKey(nativePort, nativeChannel))
+	if bz == nil {
+		return volumeCap, false
+	}
+	k.cdc.MustUnmarshal(bz, &volumeCap)
+	return volumeCap, true
+}
+
+// RemoveVolumeCap removes the volume cap of the bridge route of a native port and channel
+func (k Keeper) RemoveVolumeCap(ctx sdk.Context, nativePort string, nativeChannel string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.VolumeCapKeyPrefix)
+	store.Delete(types.BridgeRouteKey(nativePort, nativeChannel))
+}
+
+// GetAllVolumeCaps returns all volume caps
+func (k Keeper) GetAllVolumeCaps(ctx sdk.Context) (list []types.VolumeCap) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.VolumeCapKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var volumeCap types.VolumeCap
+		k.cdc.MustUnmarshal(iterator.Value(), &volumeCap)
+		list = append(list, volumeCap)
+	}
+
+	return
+}
+
+// SetVolumeBucket sets a volume bucket, keyed by its route, direction and start time
+func (k Keeper) SetVolumeBucket(ctx sdk.Context, bucket types.VolumeBucket) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.VolumeBucketKeyPrefix)
+	store.Set(types.VolumeBucketKey(bucket.NativePort, bucket.NativeChannel, bucket.Inbound, bucket.StartTime), k.cdc.MustMarshal(&bucket))
+}
+
+// GetAllVolumeBuckets returns all volume buckets
+func (k Keeper) GetAllVolumeBuckets(ctx sdk.Context) (list []types.VolumeBucket) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.VolumeBucketKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var bucket types.VolumeBucket
+		k.cdc.MustUnmarshal(iterator.Value(), &bucket)
+		list = append(list, bucket)
+	}
+
+	return
+}
+
+// GetBoundedVolumeCap returns the volume cap of a bridge route once bounded by the params.
+// A route without a volume cap is only capped by the params.
+func (k Keeper) GetBoundedVolumeCap(ctx sdk.Context, nativePort string, nativeChannel string) types.VolumeCap {
+	volumeCap, found := k.GetVolumeCap(ctx, nativePort, nativeChannel)
+	if !found {
+		volumeCap = types.VolumeCap{
+			NativePort:    nativePort,
+			NativeChannel: nativeChannel,
+			InboundCap:    sdkmath.ZeroInt(),
+			OutboundCap:   sdkmath.ZeroInt(),
+		}
+	}
+
+	volumeCap = volumeCap.Bounded(k.GetParams(ctx))
+	if volumeCap.Window == 0 {
+		volumeCap.Window = types.DefaultVolumeCapWindow
+	}
+
+	return volumeCap
+}
+
+// VolumeInWindow returns the volume of a bridge route in one direction within the window of the
+// volume cap ending at the unix time now, along with the keys of the buckets that have left the window.
+// A bucket is counted until it has entirely left the window, so the volume is never undercounted.
+func (k Keeper) VolumeInWindow(ctx sdk.Context, volumeCap types.VolumeCap, inbound bool, now int64) (sdkmath.Int, [][]byte) {
+	// #nosec G115 -- the window is bounded by MaxVolumeCapWindow
+	oldest := now - int64(volumeCap.Window) - volumeCap.BucketLength()
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.VolumeBucketKeyPrefix)
+	bucketsPrefix := types.VolumeBucketsPrefix(volumeCap.NativePort, volumeCap.NativeChannel, inbound)
+	iterator := storetypes.KVStorePrefixIterator(store, bucketsPrefix)
+
+	defer iterator.Close()
+
+	volume := sdkmath.ZeroInt()
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var bucket types.VolumeBucket
+		k.cdc.MustUnmarshal(iterator.Value(), &bucket)
+
+		if bucket.StartTime <= oldest {
+			expired = append(expired, append([]byte{}, iterator.Key()...))
+			continue
+		}
+		volume = volume.Add(bucket.Amount)
+	}
+
+	return volume, expired
+}
+
+// CheckInboundVolume checks that unlocking an amount of native tokens for an incoming transfer
+// stays within the inbound volume cap of the route, without recording it
+func (k Keeper) CheckInboundVolume(ctx sdk.Context, route types.BridgeRoute, amount sdkmath.Int) error {
+	_, _, err := k.checkVolume(ctx, route, true, amount)
+	return err
+}
+
+// ConsumeInboundVolume checks that unlocking an amount of native tokens for an incoming transfer
+// stays within the inbound volume cap of the route and records it in the current bucket of the window
+func (k Keeper) ConsumeInboundVolume(ctx sdk.Context, route types.BridgeRoute, amount sdkmath.Int) error {
+	return k.consumeVolume(ctx, route, true, amount)
+}
+
+// ConsumeOutboundVolume checks that locking an amount of native tokens for an outgoing transfer
+// stays within the outbound volume cap of the route and records it in the current bucket of the window
+func (k Keeper) ConsumeOutboundVolume(ctx sdk.Context, route types.BridgeRoute, amount sdkmath.Int) error {
+	return k.consumeVolume(ctx, route, false, amount)
+}
+
+// ReleaseOutboundVolume removes an amount of native tokens refunded on an outgoing transfer from the
+// current bucket of the outbound volume of the route, the bucket never goes below zero
+func (k Keeper) ReleaseOutboundVolume(ctx sdk.Context, route types.BridgeRoute, amount sdkmath.Int) {
+	volumeCap := k.GetBoundedVolumeCap(ctx, route.NativePort, route.NativeChannel)
+
+	now := ctx.BlockTime().Unix()
+	startTime := now - now%volumeCap.BucketLength()
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.VolumeBucketKeyPrefix)
+	bz := store.Get(types.VolumeBucketKey(route.NativePort, route.NativeChannel, false, startTime))
+	if bz == nil {
+		return
+	}
+
+	var bucket types.VolumeBucket
+	k.cdc.MustUnmarshal(bz, &bucket)
+	bucket.Amount = bucket.Amount.Sub(sdkmath.MinInt(amount, bucket.Amount))
+	k.SetVolumeBucket(ctx, bucket)
+}
+
+// checkVolume returns the volume cap of a route and the keys of the buckets that have left its window,
+// with an error when the amount does not fit in the window
+func (k Keeper) checkVolume(ctx sdk.Context, route types.BridgeRoute, inbound bool, amount sdkmath.Int) (types.VolumeCap, [][]byte, error) {
+	volumeCap := k.GetBoundedVolumeCap(ctx, route.NativePort, route.NativeChannel)
+	limit := volumeCap.Cap(inbound)
+	if limit.IsZero() {
+		return volumeCap, nil, nil
+	}
+
+	volume, expired := k.VolumeInWindow(ctx, volumeCap, inbound, ctx.BlockTime().Unix())
+	if volume.Add(amount).GT(limit) {
+		direction := "outbound"
+		if inbound {
+			direction = "inbound"
+		}
+		return volumeCap, expired, fmt.Errorf(
+			"%w: route %s/%s %s volume is %s of %s in the last %d seconds, attempted %s",
+			types.ErrVolumeCapExceeded,
+			route.NativePort,
+			route.NativeChannel,
+			direction,
+			volume,
+			limit,
+			volumeCap.Window,
+			amount,
+		)
+	}
+
+	return volumeCap, expired, nil
+}
+
+func (k Keeper) consumeVolume(ctx sdk.Context, route types.BridgeRoute, inbound bool, amount sdkmath.Int) error {
+	volumeCap, expired, err := k.checkVolume(ctx, route, inbound, amount)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.VolumeBucketKeyPrefix)
+	for _, key := range expired {
+		store.Delete(key)
+	}
+
+	if err != nil || volumeCap.Cap(inbound).IsZero() {
+		return err
+	}
+
+	now := ctx.BlockTime().Unix()
+	bucketLength := volumeCap.BucketLength()
+	startTime := now - now%bucketLength
+
+	bucket := types.VolumeBucket{
+		NativePort:    route.NativePort,
+		NativeChannel: route.NativeChannel,
+		Inbound:       inbound,
+		StartTime:     startTime,
+		Amount:        sdkmath.ZeroInt(),
+	}
+	if bz := store.Get(types.VolumeBucketKey(route.NativePort, route.NativeChannel, inbound, startTime)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &bucket)
+	}
+	bucket.Amount = bucket.Amount.Add(amount)
+	k.SetVolumeBucket(ctx, bucket)
+
+	return nil
+}
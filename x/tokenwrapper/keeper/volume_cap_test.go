@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "zigchain/testutil/keeper"
+	"zigchain/x/tokenwrapper/types"
+)
+
+func volumeCapSample(nativeChannel string, inboundCap int64, outboundCap int64, window uint64) types.VolumeCap {
+	return types.VolumeCap{
+		NativePort:    "transfer",
+		NativeChannel: nativeChannel,
+		InboundCap:    sdkmath.NewInt(inboundCap),
+		OutboundCap:   sdkmath.NewInt(outboundCap),
+		Window:        window,
+	}
+}
+
+// Positive test cases
+
+func TestVolumeCap_SetGetRemove(t *testing.T) {
+	// Test case: volume caps are stored per route
+
+	k, ctx := keepertest.TokenwrapperKeeper(t, nil)
+
+	_, found := k.GetVolumeCap(ctx, "transfer", "channel-0")
+	require.False(t, found)
+
+	volumeCap := volumeCapSample("channel-0", 1000, 500, 3600)
+	k.SetVolumeCap(ctx, volumeCap)
+	k.SetVolumeCap(ctx, volumeCapSample("channel-1", 10, 10, 60))
+
+	got, found := k.GetVolumeCap(ctx, "transfer", "channel-0")
+	require.True(t, found)
+	require.Equal(t, volumeCap, got)
+	require.Len(t, k.GetAllVolumeCaps(ctx), 2)
+
+	k.RemoveVolumeCap(ctx, "transfer", "channel-0")
+	_, found = k.GetVolumeCap(ctx, "transfer", "channel-0")
+	require.False(t, found)
+	require.Len(t, k.GetAllVolumeCaps(ctx), 1)
+}
+
+func TestConsumeVolume_WithinCap(t *testing.T) {
+	// Test case: the volume of each direction is consumed up to its own cap
+
+	k, ctx := keepertest.TokenwrapperKeeper(t, nil)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	route := bridgeRouteSample("channel-0")
+	k.SetVolumeCap(ctx, volumeCapSample("channel-0", 1000, 500, 3600))
+
+	require.NoError(t, k.ConsumeInboundVolume(ctx, route, sdkmath.NewInt(600)))
+	require.NoError(t, k.ConsumeInboundVolume(ctx, route, sdkmath.NewInt(400)))
+	require.NoError(t, k.ConsumeOutboundVolume(ctx, route, sdkmath.NewInt(500)))
+
+	// both directions are full
+	require.ErrorIs(t, k.ConsumeInboundVolume(ctx, route, sdkmath.NewInt(1)), types.ErrVolumeCapExceeded)
+	require.ErrorIs(t, k.ConsumeOutboundVolume(ctx, route, sdkmath.NewInt(1)), types.ErrVolumeCapExceeded)
+
+	// other routes are not capped
+	require.NoError(t, k.ConsumeInboundVolume(ctx, bridgeRouteSample("channel-1"), sdkmath.NewInt(1_000_000)))
+	require.Len(t, k.GetAllVolumeBuckets(ctx), 2)
+}
+
+func TestCheckInboundVolume_DoesNotRecord(t *testing.T) {
+	// Test case: checking the inbound volume leaves the window untouched
+
+	k, ctx := keepertest.TokenwrapperKeeper(t, nil)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	route := bridgeRouteSample("channel-0")
+	k.SetVolumeCap(ctx, volumeCapSample("channel-0", 1000, 500, 3600))
+
+	require.NoError(t, k.CheckInboundVolume(ctx, route, sdkmath.NewInt(1000)))
+	require.NoError(t, k.CheckInboundVolume(ctx, route, sdkmath.NewInt(1000)))
+	require.ErrorIs(t, k.CheckInboundVolume(ctx, route, sdkmath.NewInt(1001)), types.ErrVolumeCapExceeded)
+	require.Empty(t, k.GetAllVolumeBuckets(ctx))
+
+	// the check sees the recorded volume
+	require.NoError(t, k.ConsumeInboundVolume(ctx, route, sdkmath.NewInt(600)))
+	require.ErrorIs(t, k.CheckInboundVolume(ctx, route, sdkmath.NewInt(401)), types.ErrVolumeCapExceeded)
+	require.NoError(t, k.CheckInboundVolume(ctx, route, sdkmath.NewInt(400)))
+}
+
+func TestReleaseOutboundVolume(t *testing.T) {
+	// Test case: the outbound volume of a refunded transfer is available again, down to zero
+
+	k, ctx := keepertest.TokenwrapperKeeper(t, nil)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	route := bridgeRouteSample("channel-0")
+	k.SetVolumeCap(ctx, volumeCapSample("channel-0", 1000, 500, 3600))
+
+	// nothing to release yet
+	k.ReleaseOutboundVolume(ctx, route, sdkmath.NewInt(100))
+	require.Empty(t, k.GetAllVolumeBuckets(ctx))
+
+	require.NoError(t, k.ConsumeInboundVolume(ctx, route, sdkmath.NewInt(300)))
+	require.NoError(t, k.ConsumeOutboundVolume(ctx, route, sdkmath.NewInt(500)))
+	require.ErrorIs(t, k.ConsumeOutboundVolume(ctx, route, sdkmath.NewInt(1)), types.ErrVolumeCapExceeded)
+
+	k.ReleaseOutboundVolume(ctx, route, sdkmath.NewInt(200))
+	require.NoError(t, k.ConsumeOutboundVolume(ctx, route, sdkmath.NewInt(200)))
+	require.ErrorIs(t, k.ConsumeOutboundVolume(ctx, route, sdkmath.NewInt(1)), types.ErrVolumeCapExceeded)
+
+	k.ReleaseOutboundVolume(ctx, route, sdkmath.NewInt(1000))
+	for _, bucket := range k.GetAllVolumeBuckets(ctx) {
+		if bucket.Inbound {
+			// the inbound volume is left untouched
+			require.Equal(t, sdkmath.NewInt(300), bucket.Amount)
+			continue
+		}
+		require.True(t, bucket.Amount.IsZero())
+	}
+}
+
+func TestConsumeVolume_RollingWindow(t *testing.T) {
+	// Test case: the volume is available again once its bucket has left the window
+
+	k, ctx := keepertest.TokenwrapperKeeper(t, nil)
+	start := time.Unix(1_700_006_400, 0)
+	ctx = ctx.WithBlockTime(start)
+
+	route := bridgeRouteSample("channel-0")
+	volumeCap := volumeCapSample("channel-0", 1000, 0, 2400)
+	k.SetVolumeCap(ctx, volumeCap)
+
+	require.NoError(t, k.ConsumeInboundVolume(ctx, route, sdkmath.NewInt(1000)))
+
+	// still within the window
+	ctx = ctx.WithBlockTime(start.Add(2400 * time.Second))
+	require.ErrorIs(t, k.ConsumeInboundVolume(ctx, route, sdkmath.NewInt(1)), types.ErrVolumeCapExceeded)
+
+	// the bucket has entirely left the window and is deleted
+	ctx = ctx.WithBlockTime(start.Add(2500 * time.Second))
+	require.NoError(t, k.ConsumeInboundVolume(ctx, route, sdkmath.NewInt(1000)))
+
+	buckets := k.GetAllVolumeBuckets(ctx)
+	require.Len(t, buckets, 1)
+	require.Equal(t, sdkmath.NewInt(1000), buckets[0].Amount)
+}
+
+func TestConsumeVolume_BoundedByParams(t *testing.T) {
+	// Test case: the params cap routes without a volume cap and lower the caps above the maximums
+
+	k, ctx := keepertest.TokenwrapperKeeper(t, nil)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	require.NoError(t, k.SetParams(ctx, types.NewParams(sdkmath.NewInt(100), sdkmath.ZeroInt(), 0, types.DefaultPendingUnwrapGasBudget, 0, 0, sdkmath.ZeroInt(), "")))
+
+	route := bridgeRouteSample("channel-0")
+	volumeCap := k.GetBoundedVolumeCap(ctx, "transfer", "channel-0")
+	require.Equal(t, sdkmath.NewInt(100), volumeCap.InboundCap)
+	require.True(t, volumeCap.OutboundCap.IsZero())
+	require.Equal(t, types.DefaultVolumeCapWindow, volumeCap.Window)
+
+	require.NoError(t, k.ConsumeInboundVolume(ctx, route, sdkmath.NewInt(100)))
+	require.ErrorIs(t, k.ConsumeInboundVolume(ctx, route, sdkmath.NewInt(1)), types.ErrVolumeCapExceeded)
+	require.NoError(t, k.ConsumeOutboundVolume(ctx, route, sdkmath.NewInt(1_000_000)))
+
+	// a cap above the maximum stored before the params changed is lowered
+	other := bridgeRouteSample("channel-1")
+	k.SetVolumeCap(ctx, volumeCapSample("channel-1", 1000, 0, 3600))
+	require.ErrorIs(t, k.ConsumeInboundVolume(ctx, other, sdkmath.NewInt(101)), types.ErrVolumeCapExceeded)
+}
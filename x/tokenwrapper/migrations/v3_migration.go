@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"zigchain/x/tokenwrapper/types"
+	"zigchain/zutils/constants"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// V3Migration converts the IBC settings of the single route into the first bridge route,
+// wrapping uzig and carrying the module totals, deletes the single route settings and sets the
+// default params of the volume caps and the pending unwrap queue
+func (m Migrator) V3Migration(ctx sdk.Context) error {
+	nativePort := m.keeper.GetNativePort(ctx)
+	nativeChannel := m.keeper.GetNativeChannel(ctx)
+	if nativePort != "" && nativeChannel != "" {
+		m.keeper.SetBridgeRoute(ctx, types.BridgeRoute{
+			NativePort:           nativePort,
+			NativeChannel:        nativeChannel,
+			NativeClientId:       m.keeper.GetNativeClientId(ctx),
+			CounterpartyClientId: m.keeper.GetCounterpartyClientId(ctx),
+			CounterpartyPort:     m.keeper.GetCounterpartyPort(ctx),
+			CounterpartyChannel:  m.keeper.GetCounterpartyChannel(ctx),
+			Denom:                m.keeper.GetDenom(ctx),
+			NativeDenom:          constants.BondDenom,
+			DecimalDifference:    m.keeper.GetDecimalDifference(ctx),
+			Enabled:              true,
+			TotalTransferredIn:   m.keeper.GetTotalTransferredIn(ctx),
+			TotalTransferredOut:  m.keeper.GetTotalTransferredOut(ctx),
+		})
+	}
+
+	m.keeper.DeleteIbcSettings(ctx)
+
+	return m.keeper.SetParams(ctx, types.DefaultParams())
+}
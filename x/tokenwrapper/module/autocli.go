@@ -32,6 +32,65 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
 					Example:        "zigchaind q tokenwrapper total-transfers",
 				},
+				{
+					RpcMethod: "BridgeRoutes",
+					Use:       "bridge-routes",
+					Short:     "List the bridge routes",
+					Example:   "zigchaind q tokenwrapper bridge-routes",
+				},
+				{
+					RpcMethod:      "BridgeRoute",
+					Use:            "bridge-route [native-port] [native-channel]",
+					Short:          "Show the bridge route of a native port and channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "native_port"}, {ProtoField: "native_channel"}},
+					Example:        "zigchaind q tokenwrapper bridge-route transfer channel-0",
+				},
+				{
+					RpcMethod:      "VolumeCapacity",
+					Use:            "volume-capacity [native-port] [native-channel]",
+					Short:          "Show the volume cap of a bridge route and its remaining inbound and outbound capacity",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "native_port"}, {ProtoField: "native_channel"}},
+					Example:        "zigchaind q tokenwrapper volume-capacity transfer channel-0",
+				},
+				{
+					RpcMethod: "PendingUnwraps",
+					Use:       "pending-unwraps",
+					Short:     "List the pending unwraps in the order they are converted",
+					Example:   "zigchaind q tokenwrapper pending-unwraps",
+				},
+				{
+					RpcMethod:      "PendingUnwrapsByAddress",
+					Use:            "pending-unwraps-by-address [address]",
+					Short:          "List the pending unwraps of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+					Example:        "zigchaind q tokenwrapper pending-unwraps-by-address zig1...",
+				},
+				{
+					RpcMethod: "BridgeFees",
+					Use:       "bridge-fees",
+					Short:     "Show the bridge fee settings and the total bridge fees paid",
+					Example:   "zigchaind q tokenwrapper bridge-fees",
+				},
+				{
+					RpcMethod: "TransferRecords",
+					Use:       "transfer-records",
+					Short:     "List the transfer records of the ledger in the order they were written",
+					Example:   "zigchaind q tokenwrapper transfer-records",
+				},
+				{
+					RpcMethod:      "TransferRecordsByAddress",
+					Use:            "transfer-records-by-address [address]",
+					Short:          "List the transfer records sent or received by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+					Example:        "zigchaind q tokenwrapper transfer-records-by-address zig1...",
+				},
+				{
+					RpcMethod:      "TransferRecordsByStatus",
+					Use:            "transfer-records-by-status [status]",
+					Short:          "List the transfer records of a status",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "status"}},
+					Example:        "zigchaind q tokenwrapper transfer-records-by-status TRANSFER_STATUS_PENDING",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -85,7 +144,8 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{
 					RpcMethod: "UpdateIbcSettings",
 					Use:       "update-ibc-settings [native-client-id] [counterparty-client-id] [native-port] [counterparty-port] [native-channel] [counterparty-channel] [denom] [decimal-difference]",
-					Short:     "Update the IBC settings",
+					Short:     "Update the IBC settings of the bridge route of the native port and channel",
+					Long:      "Update the IBC settings of the bridge route of the native port and channel, registering the route when it does not exist yet. The native denom set with --native-denom defaults to uzig.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "native_client_id"},
 						{ProtoField: "counterparty_client_id"},
@@ -119,6 +179,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        "zigchaind tx tokenwrapper recover-zig zig15yk64u7zc9g9k2yr2wmzeva5qgwxps6y8c2amk --from bob -y",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 				},
+				{
+					RpcMethod: "SetBridgeRouteEnabled",
+					Use:       "set-bridge-route-enabled [native-port] [native-channel] [enabled]",
+					Short:     "Enable or disable a bridge route",
+					Example:   "zigchaind tx tokenwrapper set-bridge-route-enabled transfer channel-0 false --from bob -y",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "native_port"},
+						{ProtoField: "native_channel"},
+						{ProtoField: "enabled"},
+					},
+				},
+				{
+					RpcMethod: "SetVolumeCap",
+					Use:       "set-volume-cap [native-port] [native-channel] [inbound-cap] [outbound-cap] [window]",
+					Short:     "Set the inbound and outbound volume caps of a bridge route over a rolling window in seconds, zero caps remove them",
+					Example:   "zigchaind tx tokenwrapper set-volume-cap transfer channel-0 1000000000 1000000000 86400 --from bob -y",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "native_port"},
+						{ProtoField: "native_channel"},
+						{ProtoField: "inbound_cap"},
+						{ProtoField: "outbound_cap"},
+						{ProtoField: "window"},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -28,17 +28,40 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Initialize enabled status
 	k.SetEnabled(ctx, genState.Enabled)
 
-	// Initialize IBC settings
-	k.SetNativeClientId(ctx, genState.NativeClientId)
-	k.SetCounterpartyClientId(ctx, genState.CounterpartyClientId)
-	k.SetNativePort(ctx, genState.NativePort)
-	k.SetCounterpartyPort(ctx, genState.CounterpartyPort)
-	k.SetNativeChannel(ctx, genState.NativeChannel)
-	k.SetCounterpartyChannel(ctx, genState.CounterpartyChannel)
-	k.SetDenom(ctx, genState.Denom)
-
-	if err := k.SetDecimalDifference(ctx, genState.DecimalDifference); err != nil {
-		panic(err)
+	// Initialize bridge routes
+	for _, route := range genState.Routes {
+		k.SetBridgeRoute(ctx, route)
+	}
+
+	// Initialize volume caps and the buckets of their rolling windows
+	for _, volumeCap := range genState.VolumeCaps {
+		k.SetVolumeCap(ctx, volumeCap)
+	}
+	for _, bucket := range genState.VolumeBuckets {
+		k.SetVolumeBucket(ctx, bucket)
+	}
+
+	// Initialize the pending unwrap queue
+	for _, pendingUnwrap := range genState.PendingUnwraps {
+		k.SetPendingUnwrap(ctx, pendingUnwrap)
+	}
+	k.SetNextPendingUnwrapId(ctx, genState.NextPendingUnwrapId)
+
+	// Initialize the transfer ledger
+	for _, record := range genState.TransferRecords {
+		k.SetTransferRecord(ctx, record)
+	}
+	k.SetNextTransferRecordId(ctx, genState.NextTransferRecordId)
+
+	// Initialize the bridge fees
+	if !genState.TotalBridgeFeesIn.IsNil() {
+		k.SetTotalBridgeFeesIn(ctx, genState.TotalBridgeFeesIn)
+	}
+	if !genState.TotalBridgeFeesOut.IsNil() {
+		k.SetTotalBridgeFeesOut(ctx, genState.TotalBridgeFeesOut)
+	}
+	for _, pendingFee := range genState.PendingBridgeFees {
+		k.SetPendingBridgeFee(ctx, pendingFee)
 	}
 }
 
@@ -56,14 +79,16 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.PauserAddresses = k.GetPauserAddresses(ctx)
 	genesis.Enabled = k.IsEnabled(ctx)
 
-	genesis.NativeClientId = k.GetNativeClientId(ctx)
-	genesis.CounterpartyClientId = k.GetCounterpartyClientId(ctx)
-	genesis.NativePort = k.GetNativePort(ctx)
-	genesis.CounterpartyPort = k.GetCounterpartyPort(ctx)
-	genesis.NativeChannel = k.GetNativeChannel(ctx)
-	genesis.CounterpartyChannel = k.GetCounterpartyChannel(ctx)
-	genesis.Denom = k.GetDenom(ctx)
-	genesis.DecimalDifference = k.GetDecimalDifference(ctx)
+	genesis.Routes = k.GetAllBridgeRoutes(ctx)
+	genesis.VolumeCaps = k.GetAllVolumeCaps(ctx)
+	genesis.VolumeBuckets = k.GetAllVolumeBuckets(ctx)
+	genesis.PendingUnwraps = k.GetAllPendingUnwraps(ctx)
+	genesis.NextPendingUnwrapId = k.GetNextPendingUnwrapId(ctx)
+	genesis.TransferRecords = k.GetAllTransferRecords(ctx)
+	genesis.NextTransferRecordId = k.GetNextTransferRecordId(ctx)
+	genesis.TotalBridgeFeesIn = k.GetTotalBridgeFeesIn(ctx)
+	genesis.TotalBridgeFeesOut = k.GetTotalBridgeFeesOut(ctx)
+	genesis.PendingBridgeFees = k.GetAllPendingBridgeFees(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
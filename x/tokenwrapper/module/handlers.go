@@ -3,14 +3,13 @@ package tokenwrapper
 import (
 	"fmt"
 
-	"zigchain/zutils/constants"
-
 	"zigchain/x/tokenwrapper/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 )
 
 // handleUnescrowToken handles the unescrow process when a token is sent
@@ -27,8 +26,9 @@ func (w ICS4Wrapper) handleUnescrowToken(ctx sdk.Context, escrowAddress, receive
 	return nil
 }
 
-// handleRefund handles the refund process when an IBC transfer fails
-func (im IBCModule) handleRefund(ctx sdk.Context, sender sdk.AccAddress, amount sdkmath.Int, denom string) error {
+// handleRefund handles the refund process when an IBC transfer fails, the bridge fee refunded
+// along with the transfer is reported in the refund event
+func (im IBCModule) handleRefund(ctx sdk.Context, route types.BridgeRoute, sender sdk.AccAddress, amount sdkmath.Int, denom string, fee sdkmath.Int) error {
 	im.keeper.Logger().Info(fmt.Sprintf("Handling refund for sender: %s, amount: %s, denom: %s", sender.String(), amount.String(), denom))
 
 	// Get the IBC denom for the refunded tokens
@@ -37,21 +37,24 @@ func (im IBCModule) handleRefund(ctx sdk.Context, sender sdk.AccAddress, amount
 	// Create IBC coins with the refunded amount
 	ibcCoins := sdk.NewCoins(sdk.NewCoin(ibcDenom, amount))
 
-	// Convert the amount back to uzig decimals (from 18 to 6 decimals)
-	convertedAmount, err := im.keeper.ScaleDownTokenPrecision(ctx, amount)
+	// Convert the amount back to the native denom decimals
+	convertedAmount, err := route.ScaleDownTokenPrecision(amount)
 	if err != nil {
 		return err
 	}
 
+	// The transfer did not leave the chain, release its outbound volume
+	im.keeper.ReleaseOutboundVolume(ctx, route, convertedAmount)
+
 	// Check if account has enough balance to lock the IBC tokens
 	if err := im.keeper.CheckAccountBalance(ctx, sender, ibcCoins); err != nil {
 		return fmt.Errorf("failed to check account balance: %w", err)
 	}
 
 	// Create native coins with the converted amount
-	nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, convertedAmount))
+	nativeCoins := sdk.NewCoins(sdk.NewCoin(route.NativeDenom, convertedAmount))
 
-	// Check if module has enough balance to unlock the uzig tokens
+	// Check if module has enough balance to unlock the native tokens
 	if err := im.keeper.CheckModuleBalance(ctx, nativeCoins); err != nil {
 		return fmt.Errorf("failed to check module balance: %w", err)
 	}
@@ -63,7 +66,7 @@ func (im IBCModule) handleRefund(ctx sdk.Context, sender sdk.AccAddress, amount
 
 	im.keeper.Logger().Info(fmt.Sprintf("Locked refunded IBC tokens: %s for sender: %s", ibcCoins.String(), sender.String()))
 
-	// Unlock uzig tokens to the user
+	// Unlock native tokens to the user
 	if err := im.keeper.UnlockTokens(ctx, sender, nativeCoins); err != nil {
 		// If unlocking fails, we need to unlock the previously locked IBC tokens
 		if unlockErr := im.keeper.UnlockTokens(ctx, sender, ibcCoins); unlockErr != nil {
@@ -81,8 +84,65 @@ func (im IBCModule) handleRefund(ctx sdk.Context, sender sdk.AccAddress, amount
 		amount,
 		ibcDenom,
 		convertedAmount,
-		constants.BondDenom,
+		route.NativeDenom,
+		sdk.NewCoin(route.NativeDenom, fee),
 	)
 
 	return nil
 }
+
+// refundPendingBridgeFee returns the bridge fee escrowed for a packet to its sender when the packet is
+// refunded without converting the vouchers back, so the fee does not depend on the route state
+func (im IBCModule) refundPendingBridgeFee(ctx sdk.Context, packet channeltypes.Packet) {
+	if _, err := im.keeper.RefundOutboundBridgeFee(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()); err != nil {
+		types.EmitTokenWrapperErrorEvent(ctx, err)
+		im.keeper.Logger().Error(fmt.Sprintf("failed to refund bridge fee: %v", err))
+	}
+}
+
+// settlePendingBridgeFee pays the bridge fee escrowed for an acknowledged packet the module does not
+// track, or returns it to the sender on an error acknowledgement
+func (im IBCModule) settlePendingBridgeFee(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		types.EmitTokenWrapperErrorEvent(ctx, err)
+		im.keeper.Logger().Error(fmt.Sprintf("failed to unmarshal acknowledgement: %v", err))
+		return
+	}
+
+	if !ack.Success() {
+		im.refundPendingBridgeFee(ctx, packet)
+		return
+	}
+
+	if err := im.keeper.PayOutboundBridgeFee(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()); err != nil {
+		types.EmitTokenWrapperErrorEvent(ctx, err)
+		im.keeper.Logger().Error(fmt.Sprintf("failed to pay bridge fee: %v", err))
+	}
+}
+
+// recordInboundTransfer writes the transfer record of a received packet of the route denom
+func (im IBCModule) recordInboundTransfer(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	ibcAmount sdkmath.Int,
+	nativeAmount sdkmath.Int,
+	fee sdkmath.Int,
+	status types.TransferStatus,
+	reason string,
+) {
+	im.keeper.RecordTransfer(ctx, types.TransferRecord{
+		NativePort:    packet.GetDestPort(),
+		NativeChannel: packet.GetDestChannel(),
+		Sequence:      packet.GetSequence(),
+		Inbound:       true,
+		Sender:        data.Sender,
+		Receiver:      data.Receiver,
+		IbcAmount:     ibcAmount,
+		NativeAmount:  nativeAmount,
+		Fee:           fee,
+		Status:        status,
+		Reason:        reason,
+	})
+}
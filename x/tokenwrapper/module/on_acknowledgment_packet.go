@@ -37,11 +37,13 @@ func (im IBCModule) OnAcknowledgementPacket(
 		return err
 	}
 
-	// Validate IBC settings exist, if not, skip wrapping
-	if !im.validateIBCSettingsExist(ctx) {
+	// Resolve the bridge route of the source port and channel and validate its IBC settings exist, if not, skip wrapping
+	route, found := im.keeper.GetBridgeRoute(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found || !im.validateIBCSettingsExist(route) {
 		info := fmt.Sprintf("IBC settings validation failed: %v, skipping wrapping", types.ErrIBCSettingsNotSet)
 		types.EmitTokenWrapperInfoEvent(ctx, info)
 		im.keeper.Logger().Info(info)
+		im.settlePendingBridgeFee(ctx, packet, acknowledgement)
 		return im.app.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer)
 	}
 
@@ -54,17 +56,18 @@ func (im IBCModule) OnAcknowledgementPacket(
 	baseDenom := transfertypes.ExtractDenomFromPath(data.Denom).Base
 
 	// Skip tracking if packet denom is not the module denom
-	if !im.validateIBCDenomIsModuleDenom(ctx, baseDenom) {
+	if !im.validateIBCDenomIsModuleDenom(route, baseDenom) {
 		info := fmt.Sprintf("packet denom is not the module denom, skipping tracking: %s", baseDenom)
 		types.EmitTokenWrapperInfoEvent(ctx, info)
 		im.keeper.Logger().Info(info)
+		im.settlePendingBridgeFee(ctx, packet, acknowledgement)
 		return im.app.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer)
 	}
 
 	// Packet denom is the module denom, we need to track the transfer
 
 	// Check if IBC settings match the expected values
-	if !im.validateIBCSettingsMatchOnSendPacket(ctx, packet) {
+	if !im.validateIBCSettingsMatchOnSendPacket(route, packet) {
 		err := fmt.Errorf("%v, failed with packet: %v", types.ErrIBCSettingsMismatch, packet)
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		im.keeper.Logger().Error(err.Error())
@@ -73,7 +76,7 @@ func (im IBCModule) OnAcknowledgementPacket(
 	}
 
 	// Check if the module functionality is enabled only if IBC settings match
-	if !im.keeper.IsEnabled(ctx) {
+	if !im.keeper.IsEnabled(ctx) || !route.Enabled {
 		err := fmt.Errorf("module functionality is not enabled")
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		im.keeper.Logger().Error(fmt.Sprintf("module functionality is not enabled: %v", err))
@@ -117,14 +120,24 @@ func (im IBCModule) OnAcknowledgementPacket(
 	// If the acknowledgement does not indicate success, handle the refund
 	if !ack.Success() {
 		im.keeper.Logger().Info("acknowledgement failed, handling refund")
+		// Return the escrowed bridge fee to the sender along with the refund
+		fee, err := im.keeper.RefundOutboundBridgeFee(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		if err != nil {
+			types.EmitTokenWrapperErrorEvent(ctx, err)
+			im.keeper.Logger().Error(fmt.Sprintf("failed to refund bridge fee: %v", err))
+		}
+
 		// Handle refund for failed acknowledgement
-		if err := im.handleRefund(ctx, sender, amount, data.Denom); err != nil {
+		if err := im.handleRefund(ctx, route, sender, amount, data.Denom, fee); err != nil {
 			types.EmitTokenWrapperErrorEvent(ctx, err)
 			im.keeper.Logger().Error(fmt.Sprintf("failed to handle refund: %v", err))
+			im.keeper.UpdateTransferStatus(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), types.TRANSFER_STATUS_REFUNDED, err.Error())
 			// return nil to apply the default behavior of the underlying OnAcknowledgementPacket
 			return nil
 		}
 
+		im.keeper.UpdateTransferStatus(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), types.TRANSFER_STATUS_REFUNDED, ack.GetError())
+
 		// Return nil to indicate that the refund was successful, no need to track the transfer
 		return nil
 	}
@@ -133,7 +146,7 @@ func (im IBCModule) OnAcknowledgementPacket(
 	// Convert from 18 decimals to 6 decimals
 	// Note 2: we do not handle refund here because handleRefund will fail as it depends on scaling down the token precision,
 	// and this logic must only be done when the acknowledgement is not successful.
-	convertedAmount, err := im.keeper.ScaleDownTokenPrecision(ctx, amount)
+	convertedAmount, err := route.ScaleDownTokenPrecision(amount)
 	if err != nil {
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		im.keeper.Logger().Error(fmt.Sprintf("converted amount is zero or negative: %v", err))
@@ -141,7 +154,15 @@ func (im IBCModule) OnAcknowledgementPacket(
 	}
 
 	// Track the amount of ZIG tokens transferred out (using the original amount with 6 decimals)
-	im.keeper.AddToTotalTransferredOut(ctx, convertedAmount)
+	im.keeper.AddToRouteTransferredOut(ctx, route, convertedAmount)
+
+	// Pay the escrowed bridge fee to the fee recipient
+	if err := im.keeper.PayOutboundBridgeFee(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()); err != nil {
+		types.EmitTokenWrapperErrorEvent(ctx, err)
+		im.keeper.Logger().Error(fmt.Sprintf("failed to pay bridge fee: %v", err))
+	}
+
+	im.keeper.UpdateTransferStatus(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), types.TRANSFER_STATUS_ACKED, "")
 
 	return nil
 }
@@ -19,6 +19,7 @@ import (
 	tokenwrapper "zigchain/x/tokenwrapper/module"
 	mocks "zigchain/x/tokenwrapper/testutil"
 	"zigchain/x/tokenwrapper/types"
+	"zigchain/zutils/constants"
 )
 
 // createSuccessAcknowledgement creates a proper JSON-encoded success acknowledgement
@@ -102,16 +103,21 @@ func TestOnAcknowledgementPacket(t *testing.T) {
 				connectionKeeperMock.EXPECT().GetConnection(ctx, nativeConnectionId).Return(connectiontypes.ConnectionEnd{
 					ClientId: nativeClientId,
 				}, true).Times(0)
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).Times(0)
+				route := types.BridgeRoute{
+					NativePort:          nativePort,
+					NativeChannel:       nativeChannel,
+					NativeClientId:      nativeClientId,
+					CounterpartyPort:    counterpartyPort,
+					CounterpartyChannel: counterpartyChannel,
+					Denom:               moduleDenom,
+					DecimalDifference:   12,
+					NativeDenom:         constants.BondDenom,
+					Enabled:             true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).Times(2)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).Times(2)
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).Times(2)
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).Times(2)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).Times(2)
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-				keeperMock.EXPECT().AddToTotalTransferredOut(ctx, sdkmath.NewInt(1000000)).Return()
-				keeperMock.EXPECT().ScaleDownTokenPrecision(ctx, sdkmath.NewInt(1000000000000000000)).Return(sdkmath.NewInt(1000000), nil)
+				keeperMock.EXPECT().AddToRouteTransferredOut(ctx, gomock.Any(), sdkmath.NewInt(1000000)).Return()
 				appMock.EXPECT().OnAcknowledgementPacket(
 					gomock.Any(),
 					"ics20-1",
@@ -138,16 +144,21 @@ func TestOnAcknowledgementPacket(t *testing.T) {
 				connectionKeeperMock.EXPECT().GetConnection(ctx, nativeConnectionId).Return(connectiontypes.ConnectionEnd{
 					ClientId: nativeClientId,
 				}, true).Times(0)
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).Times(0)
+				route := types.BridgeRoute{
+					NativePort:          nativePort,
+					NativeChannel:       nativeChannel,
+					NativeClientId:      nativeClientId,
+					CounterpartyPort:    counterpartyPort,
+					CounterpartyChannel: counterpartyChannel,
+					Denom:               moduleDenom,
+					DecimalDifference:   12,
+					NativeDenom:         constants.BondDenom,
+					Enabled:             true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().IsEnabled(ctx).Return(false).Times(1)
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).Times(2)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).Times(2)
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).Times(2)
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).Times(2)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).Times(2)
-				keeperMock.EXPECT().GetDecimalConversionFactor(ctx).Return(sdkmath.NewInt(1000000000000)).Times(0)
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-				keeperMock.EXPECT().AddToTotalTransferredOut(ctx, sdkmath.NewInt(1000000)).Return().Times(0)
+				keeperMock.EXPECT().AddToRouteTransferredOut(ctx, gomock.Any(), sdkmath.NewInt(1000000)).Return().Times(0)
 				appMock.EXPECT().OnAcknowledgementPacket(
 					gomock.Any(),
 					"ics20-1",
@@ -173,14 +184,19 @@ func TestOnAcknowledgementPacket(t *testing.T) {
 				connectionKeeperMock.EXPECT().GetConnection(ctx, nativeConnectionId).Return(connectiontypes.ConnectionEnd{
 					ClientId: nativeClientId,
 				}, true).Times(0)
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).Times(0)
+				route := types.BridgeRoute{
+					NativePort:          nativePort,
+					NativeChannel:       nativeChannel,
+					NativeClientId:      nativeClientId,
+					CounterpartyPort:    counterpartyPort,
+					CounterpartyChannel: counterpartyChannel,
+					Denom:               moduleDenom,
+					DecimalDifference:   12,
+					NativeDenom:         constants.BondDenom,
+					Enabled:             true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes().Times(0)
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).Times(0)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).Times(0)
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).Times(0)
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).Times(0)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).Times(0)
-				keeperMock.EXPECT().GetDecimalConversionFactor(ctx).Return(sdkmath.NewInt(1000000000000)).Times(0)
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				appMock.EXPECT().OnAcknowledgementPacket(
 					gomock.Any(),
@@ -207,13 +223,19 @@ func TestOnAcknowledgementPacket(t *testing.T) {
 				connectionKeeperMock.EXPECT().GetConnection(ctx, nativeConnectionId).Return(connectiontypes.ConnectionEnd{
 					ClientId: nativeClientId,
 				}, true).Times(0)
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).Times(0)
+				route := types.BridgeRoute{
+					NativePort:          nativePort,
+					NativeChannel:       nativeChannel,
+					NativeClientId:      nativeClientId,
+					CounterpartyPort:    counterpartyPort,
+					CounterpartyChannel: counterpartyChannel,
+					Denom:               moduleDenom,
+					DecimalDifference:   12,
+					NativeDenom:         constants.BondDenom,
+					Enabled:             true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).Times(1)
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).Times(2)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).Times(2)
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).Times(2)
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).Times(2)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).Times(2)
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				appMock.EXPECT().OnAcknowledgementPacket(
 					gomock.Any(),
@@ -234,6 +256,14 @@ func TestOnAcknowledgementPacket(t *testing.T) {
 
 			// Create mocks
 			keeperMock := mocks.NewMockTokenwrapperKeeper(ctrl)
+			keeperMock.EXPECT().RecordTransfer(gomock.Any(), gomock.Any()).AnyTimes()
+			keeperMock.EXPECT().GetBridgeFee(gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt()).AnyTimes()
+			keeperMock.EXPECT().CollectInboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			keeperMock.EXPECT().EscrowOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			keeperMock.EXPECT().PayOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			keeperMock.EXPECT().RefundOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt(), nil).AnyTimes()
+			keeperMock.EXPECT().ReleaseOutboundVolume(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			keeperMock.EXPECT().UpdateTransferStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 			transferKeeperMock := mocks.NewMockTransferKeeper(ctrl)
 			bankKeeperMock := mocks.NewMockBankKeeper(ctrl)
 			appMock := mocks.NewMockCallbacksCompatibleModule(ctrl)
@@ -326,14 +356,19 @@ func TestOnAcknowledgementPacket_RealKeepers_Positive(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -402,16 +437,33 @@ func TestOnAcknowledgementPacket_RealKeepers_Positive(t *testing.T) {
 	totalTransferredOutBefore := k.GetTotalTransferredOut(ctx)
 	require.Equal(t, sdkmath.ZeroInt(), totalTransferredOutBefore)
 
+	// Record the transfer as SendPacket does
+	k.RecordTransfer(ctx, types.TransferRecord{
+		NativePort:    fixture.nativePort,
+		NativeChannel: fixture.nativeChannel,
+		Sequence:      packet.Sequence,
+		Sender:        fixture.sender,
+		Receiver:      fixture.receiver,
+		IbcAmount:     sdkmath.NewInt(1000000000000000000),
+		NativeAmount:  sdkmath.NewInt(1000000),
+		Status:        types.TRANSFER_STATUS_PENDING,
+	})
+
 	// Execute OnAcknowledgementPacket
 	err = ibcModule.OnAcknowledgementPacket(ctx, "ics20-1", packet, createSuccessAcknowledgement(), sdk.AccAddress{})
 
 	// Expect no error
 	require.NoError(t, err)
 
+	// The transfer record is acknowledged
+	record, found := k.GetTransferRecordByPacket(ctx, fixture.nativePort, fixture.nativeChannel, false, packet.Sequence)
+	require.True(t, found)
+	require.Equal(t, types.TRANSFER_STATUS_ACKED, record.Status)
+
 	// Calculate expected converted amount
 	amountInt, ok := sdkmath.NewIntFromString(fixture.amount)
 	require.True(t, ok)
-	conversionFactor := k.GetDecimalConversionFactor(ctx)      // 1e12
+	conversionFactor := route.DecimalConversionFactor()        // 1e12
 	expectedConvertedAmount := amountInt.Quo(conversionFactor) // 1e18 / 1e12 = 1e6
 
 	// Check final TotalTransferredOut
@@ -419,6 +471,118 @@ func TestOnAcknowledgementPacket_RealKeepers_Positive(t *testing.T) {
 	require.Equal(t, expectedConvertedAmount, totalTransferredOutAfter, "TotalTransferredOut should reflect converted amount")
 }
 
+func TestOnAcknowledgementPacket_RouteNotFound_SettlesBridgeFee(t *testing.T) {
+	// Test case: OnAcknowledgementPacket without the bridge route of the packet skips tracking, but
+	// still refunds the escrowed bridge fee on an error acknowledgement and pays it on a success one
+
+	// Set up positive fixture
+	fixture := getAckPacketPositiveFixture()
+
+	// Create the TokenwrapperKeeper with a real bank keeper
+	k, ctx, bankKeeper := keepertest.TokenwrapperKeeperWithBank(t)
+	k.SetEnabled(ctx, true)
+
+	// The fee recipient of the params when the packets were sent
+	recipientAddr, err := sdk.AccAddressFromBech32(fixture.receiver)
+	require.NoError(t, err)
+	params := k.GetParams(ctx)
+	params.BridgeFeeRecipient = recipientAddr.String()
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// Escrow the bridge fees of two packets of a route that has since been removed
+	route := types.BridgeRoute{
+		NativePort:    fixture.nativePort,
+		NativeChannel: fixture.nativeChannel,
+		NativeDenom:   constants.BondDenom,
+	}
+	senderAddr, err := sdk.AccAddressFromBech32(fixture.sender)
+	require.NoError(t, err)
+	feeCoins := sdk.NewCoins(sdk.NewInt64Coin(constants.BondDenom, 300))
+	require.NoError(t, bankKeeper.MintCoins(ctx, "mint", feeCoins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(ctx, "mint", types.ModuleName, feeCoins))
+	k.EscrowOutboundBridgeFee(ctx, route, senderAddr, 1, sdkmath.NewInt(100))
+	k.EscrowOutboundBridgeFee(ctx, route, senderAddr, 2, sdkmath.NewInt(200))
+
+	// Create mocks for other dependencies
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	transferKeeperMock := mocks.NewMockTransferKeeper(ctrl)
+	appMock := mocks.NewMockCallbacksCompatibleModule(ctrl)
+	channelKeeperMock := mocks.NewMockChannelKeeper(ctrl)
+	connectionKeeperMock := mocks.NewMockConnectionKeeper(ctrl)
+
+	// Create IBCModule
+	ibcModule := tokenwrapper.NewIBCModule(
+		k,
+		transferKeeperMock,
+		bankKeeper,
+		appMock,
+		channelKeeperMock,
+		connectionKeeperMock,
+	)
+
+	// Create the packets
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    fixture.moduleDenom,
+		Amount:   fixture.amount,
+		Sender:   fixture.sender,
+		Receiver: fixture.receiver,
+	}
+	dataBz, err := transfertypes.ModuleCdc.MarshalJSON(&data)
+	require.NoError(t, err)
+
+	refunded := channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         fixture.nativePort,
+		SourceChannel:      fixture.nativeChannel,
+		DestinationPort:    fixture.counterpartyPort,
+		DestinationChannel: fixture.counterpartyChannel,
+		Data:               dataBz,
+	}
+	acked := refunded
+	acked.Sequence = 2
+
+	// Set up channel mock
+	channelKeeperMock.EXPECT().
+		GetChannel(ctx, fixture.nativePort, fixture.nativeChannel).
+		Return(
+			channeltypes.Channel{
+				State:          channeltypes.OPEN,
+				ConnectionHops: []string{fixture.nativeConnectionId},
+				Counterparty: channeltypes.Counterparty{
+					PortId:    fixture.counterpartyPort,
+					ChannelId: fixture.counterpartyChannel,
+				},
+			}, true).
+		Times(2)
+
+	errorAck := createErrorAcknowledgement(fmt.Errorf("transfer failed"))
+
+	// Set up app mock
+	appMock.EXPECT().
+		OnAcknowledgementPacket(ctx, "ics20-1", refunded, errorAck, sdk.AccAddress{}).
+		Return(nil).
+		Times(1)
+	appMock.EXPECT().
+		OnAcknowledgementPacket(ctx, "ics20-1", acked, createSuccessAcknowledgement(), sdk.AccAddress{}).
+		Return(nil).
+		Times(1)
+
+	// The bridge fee of the error acknowledgement is returned to the sender
+	err = ibcModule.OnAcknowledgementPacket(ctx, "ics20-1", refunded, errorAck, sdk.AccAddress{})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(100), bankKeeper.GetBalance(ctx, senderAddr, constants.BondDenom).Amount)
+
+	// The bridge fee of the success acknowledgement is paid to the fee recipient
+	err = ibcModule.OnAcknowledgementPacket(ctx, "ics20-1", acked, createSuccessAcknowledgement(), sdk.AccAddress{})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(200), bankKeeper.GetBalance(ctx, recipientAddr, constants.BondDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(200), k.GetTotalBridgeFeesOut(ctx))
+
+	require.Empty(t, k.GetAllPendingBridgeFees(ctx))
+}
+
 func TestOnAcknowledgementPacket_MissingNativePortChannelDenom(t *testing.T) {
 	// Test case: OnAcknowledgementPacket with missing native port, channel and denom, expecting no error and no state change
 
@@ -430,13 +594,18 @@ func TestOnAcknowledgementPacket_MissingNativePortChannelDenom(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper with empty nativePort
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, "")
-	k.SetNativeChannel(ctx, "")
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, "")
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           "",
+		NativeChannel:        "",
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                "",
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -512,7 +681,7 @@ func TestOnAcknowledgementPacket_MissingNativePortChannelDenom(t *testing.T) {
 }
 
 func TestOnAcknowledgementPacket_MismatchedSourcePort(t *testing.T) {
-	// Test case: OnAcknowledgementPacket with mismatched source port, expecting no error and no state change
+	// Test case: OnAcknowledgementPacket with a source port without a bridge route, expecting no error and no state change
 
 	// Set up positive fixture
 	fixture := getAckPacketPositiveFixture()
@@ -522,13 +691,18 @@ func TestOnAcknowledgementPacket_MismatchedSourcePort(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -586,7 +760,7 @@ func TestOnAcknowledgementPacket_MismatchedSourcePort(t *testing.T) {
 	appMock.EXPECT().
 		OnAcknowledgementPacket(ctx, "ics20-1", packet, createSuccessAcknowledgement(), sdk.AccAddress{}).
 		Return(nil).
-		Times(0)
+		Times(1)
 
 	// Check initial TotalTransferredOut
 	totalTransferredOutBefore := k.GetTotalTransferredOut(ctx)
@@ -595,9 +769,8 @@ func TestOnAcknowledgementPacket_MismatchedSourcePort(t *testing.T) {
 	// Execute OnAcknowledgementPacket
 	err = ibcModule.OnAcknowledgementPacket(ctx, "ics20-1", packet, createSuccessAcknowledgement(), sdk.AccAddress{})
 
-	// Expect error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "ibc settings do not match the expected values, failed with packet")
+	// Expect no error, the packet has no bridge route and is passed through
+	require.NoError(t, err)
 
 	// Check final TotalTransferredOut (no change)
 	totalTransferredOutAfter := k.GetTotalTransferredOut(ctx)
@@ -605,7 +778,7 @@ func TestOnAcknowledgementPacket_MismatchedSourcePort(t *testing.T) {
 }
 
 func TestOnAcknowledgementPacket_MismatchedSourceChannel(t *testing.T) {
-	// Test case: OnAcknowledgementPacket with a mismatched source channel, expecting no error and no state change
+	// Test case: OnAcknowledgementPacket with a source channel without a bridge route, expecting no error and no state change
 
 	// Set up positive fixture
 	fixture := getAckPacketPositiveFixture()
@@ -615,13 +788,18 @@ func TestOnAcknowledgementPacket_MismatchedSourceChannel(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -679,7 +857,7 @@ func TestOnAcknowledgementPacket_MismatchedSourceChannel(t *testing.T) {
 	appMock.EXPECT().
 		OnAcknowledgementPacket(ctx, "ics20-1", packet, createSuccessAcknowledgement(), sdk.AccAddress{}).
 		Return(nil).
-		Times(0)
+		Times(1)
 
 	// Check initial TotalTransferredOut
 	totalTransferredOutBefore := k.GetTotalTransferredOut(ctx)
@@ -688,9 +866,8 @@ func TestOnAcknowledgementPacket_MismatchedSourceChannel(t *testing.T) {
 	// Execute OnAcknowledgementPacket
 	err = ibcModule.OnAcknowledgementPacket(ctx, "ics20-1", packet, createSuccessAcknowledgement(), sdk.AccAddress{})
 
-	// Expect error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "ibc settings do not match the expected values, failed with packet")
+	// Expect no error, the packet has no bridge route and is passed through
+	require.NoError(t, err)
 
 	// Check final TotalTransferredOut (no change)
 	totalTransferredOutAfter := k.GetTotalTransferredOut(ctx)
@@ -708,13 +885,18 @@ func TestOnAcknowledgementPacket_MismatchedDestinationPort(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -801,13 +983,18 @@ func TestOnAcknowledgementPacket_MismatchedDestinationChannel(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -898,13 +1085,18 @@ func TestOnAcknowledgementPacket_NonModuleDenom(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -990,14 +1182,19 @@ func TestOnAcknowledgementPacket_StateUpdateConsistency(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1065,7 +1262,7 @@ func TestOnAcknowledgementPacket_StateUpdateConsistency(t *testing.T) {
 	// Calculate expected converted amount
 	amountInt, ok := sdkmath.NewIntFromString(fixture.amount)
 	require.True(t, ok)
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
+	conversionFactor := route.DecimalConversionFactor()
 	expectedConvertedAmount := amountInt.Quo(conversionFactor)
 
 	// Check initial TotalTransferredOut
@@ -1103,14 +1300,19 @@ func TestOnAcknowledgementPacket_ZeroAmount(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1202,14 +1404,19 @@ func TestOnAcknowledgementPacket_NegativeAmount(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1301,14 +1508,19 @@ func TestOnAcknowledgementPacket_InvalidAmountFormat(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1400,14 +1612,19 @@ func TestOnAcknowledgementPacket_ChannelNotFound(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1491,14 +1708,19 @@ func TestOnAcknowledgementPacket_ChannelNotOpen(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1590,14 +1812,19 @@ func TestOnAcknowledgementPacket_InvalidSender(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1689,14 +1916,19 @@ func TestOnAcknowledgementPacket_EmptySender(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1788,14 +2020,19 @@ func TestOnAcknowledgementPacket_FailedAckZeroConvertedAmount(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1888,14 +2125,19 @@ func TestOnAcknowledgementPacket_FailedAckNegativeConvertedAmount(t *testing.T)
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1987,14 +2229,19 @@ func TestOnAcknowledgementPacket_FailedAckInvalidAmountFormat(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -2086,14 +2333,19 @@ func TestOnAcknowledgementPacket_EmitsUnmarshalAckErrorEvent(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -2210,14 +2462,19 @@ func TestOnAcknowledgementPacket_EmitsHandleRefundErrorEvent(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper with a large decimal difference to cause scaling failures
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, 18)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    18,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	types "zigchain/x/tokenwrapper/types"
-	"zigchain/zutils/constants"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -89,8 +88,17 @@ func (im IBCModule) OnRecvPacket(
 
 	// Note: by the time we reach here given no errors occured, the IBC vouchers are received in the receiver address
 
+	// Resolve the bridge route of the destination port and channel, if there is none, skip wrapping
+	route, found := im.keeper.GetBridgeRoute(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if !found {
+		info := fmt.Sprintf("no bridge route for %s/%s, skipping wrapping", packet.GetDestPort(), packet.GetDestChannel())
+		types.EmitTokenWrapperInfoEvent(ctx, info)
+		im.keeper.Logger().Info(info)
+		return ack
+	}
+
 	// Check if the counterparty channel matches the expected IBC settings, if not, skip wrapping
-	if err := im.checkCounterypartyChannelMatchesIBCSettings(ctx, packet.GetDestPort(), packet.GetDestChannel()); err != nil {
+	if err := im.checkCounterypartyChannelMatchesIBCSettings(ctx, route, packet.GetDestPort(), packet.GetDestChannel()); err != nil {
 		err := fmt.Errorf("counterpartychannel matches IBC settings failed: %v", err)
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		im.keeper.Logger().Error(err.Error())
@@ -98,7 +106,7 @@ func (im IBCModule) OnRecvPacket(
 	}
 
 	// Check if native and counterparty client ids are valid, if not, skip wrapping
-	if err := im.validateConnectionClientId(ctx, packet.GetDestPort(), packet.GetDestChannel()); err != nil {
+	if err := im.validateConnectionClientId(ctx, route, packet.GetDestPort(), packet.GetDestChannel()); err != nil {
 		err := fmt.Errorf("client state validation failed: %v", err)
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		im.keeper.Logger().Error(err.Error())
@@ -106,15 +114,15 @@ func (im IBCModule) OnRecvPacket(
 	}
 
 	// Validate IBC settings exist, if not, skip wrapping
-	if !im.validateIBCSettingsExist(ctx) {
+	if !im.validateIBCSettingsExist(route) {
 		info := fmt.Sprintf("IBC settings validation failed: %v, skipping wrapping", types.ErrIBCSettingsNotSet)
 		types.EmitTokenWrapperInfoEvent(ctx, info)
 		im.keeper.Logger().Info(info)
 		return ack
 	}
 
-	// Skip wrapping if packet denom is not the module denom
-	if !im.validateIBCDenomIsModuleDenom(ctx, data.Denom) {
+	// Skip wrapping if packet denom is not the route denom
+	if !im.validateIBCDenomIsModuleDenom(route, data.Denom) {
 		info := fmt.Sprintf("packet denom is not the module denom, skipping wrapping: %s", data.Denom)
 		types.EmitTokenWrapperInfoEvent(ctx, info)
 		im.keeper.Logger().Info(info)
@@ -129,39 +137,66 @@ func (im IBCModule) OnRecvPacket(
 		return ack
 	}
 
-	// Check if module is enabled
-	if !im.keeper.IsEnabled(ctx) {
+	// Check if module and bridge route are enabled
+	if !im.keeper.IsEnabled(ctx) || !route.Enabled {
 		err := fmt.Errorf("module disabled: %v", types.ErrModuleDisabled)
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		im.keeper.Logger().Error(err.Error())
+		im.recordInboundTransfer(ctx, packet, data, amount, sdkmath.ZeroInt(), sdkmath.ZeroInt(), types.TRANSFER_STATUS_SKIPPED, err.Error())
 		return ack
 	}
 
 	// Check if IBC settings match the expected values
-	if !im.validateIBCSettingsMatchOnRecvPacket(ctx, packet) {
+	if !im.validateIBCSettingsMatchOnRecvPacket(route, packet) {
 		err := fmt.Errorf("%v, failed with packet: %v", types.ErrIBCSettingsMismatch, packet)
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		im.keeper.Logger().Error(err.Error())
+		im.recordInboundTransfer(ctx, packet, data, amount, sdkmath.ZeroInt(), sdkmath.ZeroInt(), types.TRANSFER_STATUS_SKIPPED, types.ErrIBCSettingsMismatch.Error())
 		return ack
 	}
 
 	// Get IBC denom for the received token
-	recvDenom := im.keeper.GetIBCRecvDenom(ctx, data.Denom)
+	recvDenom := route.IBCRecvDenom()
 
 	// Handle token conversion
-	convertedAmount, err := im.keeper.ScaleDownTokenPrecision(ctx, amount)
+	convertedAmount, err := route.ScaleDownTokenPrecision(amount)
 	if err != nil {
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		im.keeper.Logger().Error(fmt.Sprintf("token conversion failed: %v", err))
+		im.recordInboundTransfer(ctx, packet, data, amount, sdkmath.ZeroInt(), sdkmath.ZeroInt(), types.TRANSFER_STATUS_SKIPPED, err.Error())
 		return ack
 	}
 
+	// Deduct the bridge fee from the native amount unlocked to the receiver
+	fee := im.keeper.GetBridgeFee(ctx, convertedAmount)
+	if fee.GTE(convertedAmount) {
+		err := fmt.Errorf("%w: fee %s, amount %s", types.ErrBridgeFeeExceedsAmount, fee, convertedAmount)
+		types.EmitTokenWrapperErrorEvent(ctx, err)
+		im.keeper.Logger().Error(err.Error())
+		im.recordInboundTransfer(ctx, packet, data, amount, sdkmath.ZeroInt(), sdkmath.ZeroInt(), types.TRANSFER_STATUS_SKIPPED, err.Error())
+		return ack
+	}
+	netAmount := convertedAmount.Sub(fee)
+
 	// Check balances
-	if err := im.keeper.CheckBalances(ctx, receiver, amount, recvDenom, convertedAmount); err != nil {
+	if err := im.keeper.CheckBalances(ctx, receiver, amount, recvDenom, convertedAmount, route.NativeDenom); err != nil {
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		im.keeper.Logger().Error(fmt.Sprintf("balances check failed: %v", err))
 		// Note: return a successful acknowlegment in order to keep the IBC vouchers in the receiver address
-		// the receiver address will be able to convert the IBC vouchers with native ZIG through the recovery process
+		// the IBC vouchers are queued to be converted once the module wallet is funded again, or can be
+		// converted with native ZIG through the recovery process
+		im.keeper.QueuePendingUnwrap(ctx, receiver, route, amount, packet.GetSequence())
+		im.recordInboundTransfer(ctx, packet, data, amount, convertedAmount, sdkmath.ZeroInt(), types.TRANSFER_STATUS_SKIPPED, err.Error())
+		return ack
+	}
+
+	// Check the inbound volume of the route, it is only recorded once the native tokens are unlocked
+	if err := im.keeper.CheckInboundVolume(ctx, route, convertedAmount); err != nil {
+		types.EmitTokenWrapperErrorEvent(ctx, err)
+		im.keeper.Logger().Error(fmt.Sprintf("inbound volume cap exceeded: %v", err))
+		// Note: return a successful acknowlegment in order to keep the IBC vouchers in the receiver address
+		// the receiver address will be able to recover them once the rolling window has room again
+		im.recordInboundTransfer(ctx, packet, data, amount, convertedAmount, sdkmath.ZeroInt(), types.TRANSFER_STATUS_SKIPPED, err.Error())
 		return ack
 	}
 
@@ -170,19 +205,35 @@ func (im IBCModule) OnRecvPacket(
 	if err != nil {
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		im.keeper.Logger().Error(fmt.Sprintf("failed to lock IBC tokens: %v", err))
+		im.recordInboundTransfer(ctx, packet, data, amount, convertedAmount, sdkmath.ZeroInt(), types.TRANSFER_STATUS_SKIPPED, err.Error())
 		return ack
 	}
 
 	// Unlock native tokens
-	_, err = im.keeper.UnlockNativeTokens(ctx, receiver, convertedAmount, ibcCoins)
+	_, err = im.keeper.UnlockNativeTokens(ctx, receiver, netAmount, ibcCoins, route.NativeDenom)
 	if err != nil {
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		im.keeper.Logger().Error(fmt.Sprintf("failed to unlock native tokens: %v", err))
+		im.recordInboundTransfer(ctx, packet, data, amount, convertedAmount, sdkmath.ZeroInt(), types.TRANSFER_STATUS_SKIPPED, err.Error())
 		return ack
 	}
 
+	// Record the inbound volume of the route, it was checked before the IBC tokens were locked
+	if err := im.keeper.ConsumeInboundVolume(ctx, route, convertedAmount); err != nil {
+		types.EmitTokenWrapperErrorEvent(ctx, err)
+		im.keeper.Logger().Error(fmt.Sprintf("failed to record the inbound volume: %v", err))
+	}
+
+	// Pay the bridge fee to the fee recipient, the fee stays in the module wallet if it cannot be paid
+	if err := im.keeper.CollectInboundBridgeFee(ctx, route, fee); err != nil {
+		types.EmitTokenWrapperErrorEvent(ctx, err)
+		im.keeper.Logger().Error(fmt.Sprintf("failed to collect bridge fee: %v", err))
+		fee = sdkmath.ZeroInt()
+	}
+
 	// Track transferred amount
-	im.keeper.AddToTotalTransferredIn(ctx, convertedAmount)
+	im.keeper.AddToRouteTransferredIn(ctx, route, convertedAmount)
+	im.recordInboundTransfer(ctx, packet, data, amount, netAmount, fee, types.TRANSFER_STATUS_ACKED, "")
 
 	// Emit success event
 	types.EmitTokenWrapperPacketEvent(
@@ -190,12 +241,13 @@ func (im IBCModule) OnRecvPacket(
 		types.EventTypeTokenWrapperOnRecvPacket,
 		sender,
 		receiver.String(),
-		convertedAmount,
-		constants.BondDenom,
+		netAmount,
+		route.NativeDenom,
 		packet.GetSourcePort(),
 		packet.GetSourceChannel(),
 		packet.GetDestPort(),
 		packet.GetDestChannel(),
+		sdk.NewCoin(route.NativeDenom, fee),
 	)
 
 	return ack
@@ -95,14 +95,19 @@ func TestOnRecvPacket_Positive(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create IBCModule
 	ibcModule := tokenwrapper.NewIBCModule(
@@ -192,7 +197,7 @@ func TestOnRecvPacket_Positive(t *testing.T) {
 	amount, _ := sdkmath.NewIntFromString(fixture.amount)
 
 	// Converted amount to native tokens
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
+	conversionFactor := route.DecimalConversionFactor()
 	convertedAmount := amount.Quo(conversionFactor)
 	require.True(t, convertedAmount.IsPositive(), "converted amount should be positive")
 
@@ -278,14 +283,19 @@ func TestOnRecvPacket_ChannelOff(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create IBCModule
 	ibcModule := tokenwrapper.NewIBCModule(
@@ -393,14 +403,19 @@ func TestOnRecvPacket_PacketNotMatchChain(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create IBCModule
 	ibcModule := tokenwrapper.NewIBCModule(
@@ -499,14 +514,19 @@ func TestOnRecvPacket_RealKeepers_Positive(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -584,7 +604,7 @@ func TestOnRecvPacket_RealKeepers_Positive(t *testing.T) {
 	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, receiverAddr, ibcCoins))
 
 	// Mint native tokens to module
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
+	conversionFactor := route.DecimalConversionFactor()
 	convertedAmount := amountInt.Quo(conversionFactor)
 	nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, convertedAmount))
 	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, nativeCoins))
@@ -630,6 +650,15 @@ func TestOnRecvPacket_RealKeepers_Positive(t *testing.T) {
 	totalTransferredOut := k.GetTotalTransferredOut(ctx)
 	require.Equal(t, sdkmath.ZeroInt(), totalTransferredOut, "TotalTransferredOut should be zero after successful OnRecvPacket")
 
+	// Check the transfer record of the packet
+	record, found := k.GetTransferRecordByPacket(ctx, fixture.nativePort, fixture.nativeChannel, true, packet.Sequence)
+	require.True(t, found, "OnRecvPacket should record the wrapped transfer")
+	require.Equal(t, types.TRANSFER_STATUS_ACKED, record.Status)
+	require.Equal(t, fixture.sender, record.Sender)
+	require.Equal(t, fixture.receiver, record.Receiver)
+	require.Equal(t, amountInt, record.IbcAmount)
+	require.Equal(t, convertedAmount, record.NativeAmount)
+
 	// Check the OnRecvPacket event
 	events := ctx.EventManager().Events()
 	require.Greater(t, len(events), 0, "Expected at least one event to be emitted")
@@ -667,6 +696,159 @@ func TestOnRecvPacket_RealKeepers_Positive(t *testing.T) {
 	require.True(t, foundEvent, "Expected EventTypeTokenWrapperOnRecvPacket event to be emitted")
 }
 
+func TestOnRecvPacket_RealKeepers_Positive_BridgeFee(t *testing.T) {
+	// Test case: OnRecvPacket with real keepers deducts the bridge fee from the unlocked native tokens and pays it to the recipient
+
+	// set up a positive fixture
+	fixture := getRecvPacketPositiveFixture()
+
+	// Create the TokenwrapperKeeper with a real bank keeper
+	k, ctx, bankKeeper := keepertest.TokenwrapperKeeperWithBank(t)
+
+	// Set up the TokenwrapperKeeper
+	k.SetEnabled(ctx, true)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
+
+	// Charge a bridge fee of 1%
+	feeRecipient := sdk.AccAddress([]byte("bridge_fee_recipient"))
+	params := k.GetParams(ctx)
+	params.BridgeFeeBps = 100
+	params.BridgeFeeRecipient = feeRecipient.String()
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// Create mocks for other dependencies
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	transferKeeperMock := testutil.NewMockTransferKeeper(ctrl)
+	appMock := testutil.NewMockCallbacksCompatibleModule(ctrl)
+	channelKeeperMock := testutil.NewMockChannelKeeper(ctrl)
+	connectionKeeperMock := testutil.NewMockConnectionKeeper(ctrl)
+
+	// Create IBCModule
+	ibcModule := tokenwrapper.NewIBCModule(
+		k, transferKeeperMock, nil, appMock, channelKeeperMock, connectionKeeperMock,
+	)
+
+	// Create the data and the packet
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    fixture.moduleDenom,
+		Amount:   fixture.amount,
+		Sender:   fixture.sender,
+		Receiver: fixture.receiver,
+	}
+	dataBz, err := transfertypes.ModuleCdc.MarshalJSON(&data)
+	require.NoError(t, err)
+
+	packet := channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         fixture.counterpartyPort,
+		SourceChannel:      fixture.counterpartyChannel,
+		DestinationPort:    fixture.nativePort,
+		DestinationChannel: fixture.nativeChannel,
+		Data:               dataBz,
+	}
+
+	// Set up channel and connection mocks
+	channelKeeperMock.
+		EXPECT().
+		GetChannel(ctx, fixture.nativePort, fixture.nativeChannel).
+		Return(
+			channeltypes.Channel{
+				State:          channeltypes.OPEN,
+				ConnectionHops: []string{fixture.nativeConnectionId},
+				Counterparty: channeltypes.Counterparty{
+					PortId:    fixture.counterpartyPort,
+					ChannelId: fixture.counterpartyChannel,
+				},
+			}, true).
+		Times(3)
+
+	connectionKeeperMock.
+		EXPECT().
+		GetConnection(ctx, fixture.nativeConnectionId).
+		Return(
+			connectiontypes.ConnectionEnd{
+				ClientId: fixture.nativeClientId,
+				Counterparty: connectiontypes.Counterparty{
+					ClientId: fixture.counterpartyClientId,
+				},
+			}, true).
+		Times(1)
+
+	appMock.
+		EXPECT().
+		OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{}).
+		Return(channeltypes.NewResultAcknowledgement([]byte("success")))
+
+	// Set up initial balances
+	receiverAddr := sdk.MustAccAddressFromBech32(fixture.receiver)
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	// Mint IBC tokens to receiver
+	amountInt, _ := sdkmath.NewIntFromString(fixture.amount)
+	ibcCoins := sdk.NewCoins(sdk.NewCoin(fixture.ibcDenom, amountInt))
+	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, ibcCoins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, receiverAddr, ibcCoins))
+
+	// Mint native tokens to module
+	conversionFactor := route.DecimalConversionFactor()
+	convertedAmount := amountInt.Quo(conversionFactor)
+	nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, convertedAmount))
+	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, nativeCoins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, nativeCoins))
+
+	// Execute OnRecvPacket
+	respOnRecvPacket := ibcModule.OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{})
+	require.True(t, respOnRecvPacket.Success())
+
+	// The receiver gets the converted amount minus the fee, the recipient gets the fee
+	fee := convertedAmount.QuoRaw(100)
+	netAmount := convertedAmount.Sub(fee)
+	require.Equal(t, netAmount, bankKeeper.GetBalance(ctx, receiverAddr, constants.BondDenom).Amount)
+	require.Equal(t, fee, bankKeeper.GetBalance(ctx, feeRecipient, constants.BondDenom).Amount)
+	require.Equal(t, sdkmath.ZeroInt(), bankKeeper.GetBalance(ctx, moduleAddr, constants.BondDenom).Amount)
+	require.Equal(t, amountInt, bankKeeper.GetBalance(ctx, moduleAddr, fixture.ibcDenom).Amount)
+
+	require.Equal(t, fee, k.GetTotalBridgeFeesIn(ctx))
+	require.Equal(t, convertedAmount, k.GetTotalTransferredIn(ctx))
+
+	// The transfer record and the event report the net amount and the fee
+	record, found := k.GetTransferRecordByPacket(ctx, fixture.nativePort, fixture.nativeChannel, true, packet.Sequence)
+	require.True(t, found)
+	require.Equal(t, netAmount, record.NativeAmount)
+	require.Equal(t, fee, record.Fee)
+
+	var foundEvent bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeTokenWrapperOnRecvPacket {
+			continue
+		}
+		foundEvent = true
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case types.AttributeKeyAmount:
+				require.Equal(t, netAmount.String(), attr.Value)
+			case types.AttributeKeyFee:
+				require.Equal(t, sdk.NewCoin(constants.BondDenom, fee).String(), attr.Value)
+			}
+		}
+	}
+	require.True(t, foundEvent, "Expected EventTypeTokenWrapperOnRecvPacket event to be emitted")
+}
+
 func TestOnRecvPacket_RealKeepers_Positive_NoIBCSettings(t *testing.T) {
 	// Test case: OnRecvPacket with real keepers to verify balance no change when one of the IBC settings are set
 
@@ -678,15 +860,19 @@ func TestOnRecvPacket_RealKeepers_Positive_NoIBCSettings(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	// Native channel is not set, so it should not be used
-	// k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	// Route denom is not set, so the route should not be used
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -764,7 +950,7 @@ func TestOnRecvPacket_RealKeepers_Positive_NoIBCSettings(t *testing.T) {
 	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, receiverAddr, ibcCoins))
 
 	// Mint native tokens to module
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
+	conversionFactor := route.DecimalConversionFactor()
 	convertedAmount := amountInt.Quo(conversionFactor)
 	nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, convertedAmount))
 	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, nativeCoins))
@@ -817,14 +1003,19 @@ func TestOnRecvPacket_RealKeepers_Positive_DifferentDenom(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, "diff-denom")
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                "diff-denom",
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -902,7 +1093,7 @@ func TestOnRecvPacket_RealKeepers_Positive_DifferentDenom(t *testing.T) {
 	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, receiverAddr, ibcCoins))
 
 	// Mint native tokens to module
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
+	conversionFactor := route.DecimalConversionFactor()
 	convertedAmount := amountInt.Quo(conversionFactor)
 	nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, convertedAmount))
 	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, nativeCoins))
@@ -956,14 +1147,19 @@ func TestOnRecvPacket_RealKeepers_Positive_DisabledTW_DifferentDenom(t *testing.
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, false)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, "diff-denom")
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                "diff-denom",
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1041,7 +1237,7 @@ func TestOnRecvPacket_RealKeepers_Positive_DisabledTW_DifferentDenom(t *testing.
 	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, receiverAddr, ibcCoins))
 
 	// Mint native tokens to module
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
+	conversionFactor := route.DecimalConversionFactor()
 	convertedAmount := amountInt.Quo(conversionFactor)
 	nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, convertedAmount))
 	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, nativeCoins))
@@ -1095,14 +1291,19 @@ func TestOnRecvPacket_RealKeepers_Negative_DisabledTW(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, false)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1184,7 +1385,7 @@ func TestOnRecvPacket_RealKeepers_Negative_DisabledTW(t *testing.T) {
 	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, faucetAddr, ibcCoins))
 
 	// Mint native tokens to module
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
+	conversionFactor := route.DecimalConversionFactor()
 	convertedAmount := amountInt.Quo(conversionFactor)
 	nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, convertedAmount))
 	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, nativeCoins))
@@ -1259,7 +1460,7 @@ func TestOnRecvPacket_RealKeepers_Negative_DisabledTW(t *testing.T) {
 
 func TestOnRecvPacket_RealKeepers_Negative_DiffCounterpartyChannel(t *testing.T) {
 	// Test case: OnRecvPacket with real keepers
-	// The counterpartyChannel is different from the one set in the TokenwrapperKeeper
+	// The destination channel has no bridge route
 	// so it shouldn't be processed by the TokenwrapperKeeper
 
 	// set up positive fixture
@@ -1270,14 +1471,19 @@ func TestOnRecvPacket_RealKeepers_Negative_DiffCounterpartyChannel(t *testing.T)
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1308,7 +1514,7 @@ func TestOnRecvPacket_RealKeepers_Negative_DiffCounterpartyChannel(t *testing.T)
 		SourcePort:         fixture.counterpartyPort,
 		SourceChannel:      fixture.counterpartyChannel,
 		DestinationPort:    fixture.nativePort,
-		DestinationChannel: "channel-2", // Channel on ZIGChain that is not the one set in the TokenwrapperKeeper
+		DestinationChannel: "channel-2", // Channel on ZIGChain that has no bridge route
 		Data:               dataBz,
 	}
 
@@ -1318,11 +1524,10 @@ func TestOnRecvPacket_RealKeepers_Negative_DiffCounterpartyChannel(t *testing.T)
 		OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{}).
 		Return(channeltypes.NewResultAcknowledgement([]byte("success")))
 
-	// Set up channel and connection mocks
+	// Set up channel mock
 	// ------------------------------------------------------
 
-	// code will check that the channel is valid for the nativePort and nativeChannel (open and connected)
-	// but the channel returned is not the same that the destinationChannel in the packet
+	// code will check that the channel is valid for the nativePort and destination channel (open and connected)
 	channelKeeperMock.
 		EXPECT().
 		GetChannel(ctx, fixture.nativePort, "channel-2").
@@ -1335,16 +1540,6 @@ func TestOnRecvPacket_RealKeepers_Negative_DiffCounterpartyChannel(t *testing.T)
 					ChannelId: fixture.counterpartyChannel,
 				},
 			}, true).
-		Times(3)
-
-	connectionKeeperMock.
-		EXPECT().
-		GetConnection(ctx, fixture.nativeConnectionId).
-		Return(connectiontypes.ConnectionEnd{ClientId: fixture.nativeClientId,
-			Counterparty: connectiontypes.Counterparty{
-				ClientId: fixture.counterpartyClientId,
-			},
-		}, true).
 		Times(1)
 
 	// Set up initial balances
@@ -1361,7 +1556,7 @@ func TestOnRecvPacket_RealKeepers_Negative_DiffCounterpartyChannel(t *testing.T)
 	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, faucetAddr, ibcCoins))
 
 	// Mint native tokens to module
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
+	conversionFactor := route.DecimalConversionFactor()
 	convertedAmount := amountInt.Quo(conversionFactor)
 	nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, convertedAmount))
 	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, nativeCoins))
@@ -1386,7 +1581,7 @@ func TestOnRecvPacket_RealKeepers_Negative_DiffCounterpartyChannel(t *testing.T)
 
 	// Validate that no error occurred in the IBC OnRecvPacket middlestack
 	require.NotNil(t, ack)
-	require.True(t, ack.Success(), "OnRecvPacket should not fail when the IBC settings mismatch")
+	require.True(t, ack.Success(), "OnRecvPacket should not fail when there is no bridge route")
 
 	// Check stats
 	// ------------------------------------------------------
@@ -1404,11 +1599,11 @@ func TestOnRecvPacket_RealKeepers_Negative_DiffCounterpartyChannel(t *testing.T)
 	// ------------------------------------------------------
 	events := ctx.EventManager().Events()
 
-	// Check that the last event is the error event
-	require.Equal(t, types.EventTypeTokenWrapperError, events[len(events)-1].Type)
+	// Check that the last event is the info event
+	require.Equal(t, types.EventTypeTokenWrapperInfo, events[len(events)-1].Type)
 
-	// Check the error message in the event
-	require.Contains(t, events[len(events)-1].Attributes[0].Value, fmt.Sprintf("ibc settings do not match the expected values, failed with packet: {1 %s %s %s %s", fixture.counterpartyPort, fixture.counterpartyChannel, fixture.nativePort, "channel-2"))
+	// Check the info message in the event
+	require.Contains(t, events[len(events)-1].Attributes[0].Value, fmt.Sprintf("no bridge route for %s/%s, skipping wrapping", fixture.nativePort, "channel-2"))
 
 	// Check final balances
 	// ------------------------------------------------------
@@ -1437,14 +1632,19 @@ func TestOnRecvPacket_RealKeepers_Negative_InsufficientFunds(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1520,7 +1720,7 @@ func TestOnRecvPacket_RealKeepers_Negative_InsufficientFunds(t *testing.T) {
 	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, receiverAddr, ibcCoins))
 
 	// Mint native tokens to module
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
+	conversionFactor := route.DecimalConversionFactor()
 	convertedAmount := amountInt.Quo(conversionFactor)
 	insufficientAmount := convertedAmount.Quo(sdkmath.NewInt(2))
 	nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, insufficientAmount))
@@ -1598,6 +1798,35 @@ func TestOnRecvPacket_RealKeepers_Negative_InsufficientFunds(t *testing.T) {
 	}
 
 	require.False(t, foundSuccessEvent, "Should NOT emit success event when transaction fails")
+
+	// The transfer is queued to be converted once the module wallet is funded
+	pendingUnwraps := k.GetPendingUnwrapsByAddress(ctx, fixture.receiver)
+	require.Len(t, pendingUnwraps, 1)
+	require.Equal(t, amountInt, pendingUnwraps[0].Amount)
+	require.Equal(t, fixture.nativeChannel, pendingUnwraps[0].NativeChannel)
+
+	// The queue waits while the module wallet can not cover it
+	k.ProcessPendingUnwraps(ctx)
+	require.Len(t, k.GetAllPendingUnwraps(ctx), 1)
+
+	// Top up the module wallet, the next begin blocker converts the IBC vouchers
+	topUp := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, convertedAmount.Sub(insufficientAmount)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, topUp))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, topUp))
+
+	k.ProcessPendingUnwraps(ctx)
+	require.Empty(t, k.GetAllPendingUnwraps(ctx))
+	require.Equal(t, convertedAmount, bankKeeper.GetBalance(ctx, receiverAddr, constants.BondDenom).Amount)
+	require.Equal(t, sdkmath.ZeroInt(), bankKeeper.GetBalance(ctx, receiverAddr, fixture.ibcDenom).Amount)
+	require.Equal(t, amountInt, bankKeeper.GetBalance(ctx, moduleAddr, fixture.ibcDenom).Amount)
+
+	var foundProcessedEvent bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypePendingUnwrapProcessed {
+			foundProcessedEvent = true
+		}
+	}
+	require.True(t, foundProcessedEvent, "Expected EventTypePendingUnwrapProcessed event to be emitted")
 }
 
 func TestOnRecvPacket(t *testing.T) {
@@ -1678,24 +1907,31 @@ func TestOnRecvPacket(t *testing.T) {
 
 				//
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).AnyTimes()
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).AnyTimes()
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).AnyTimes()
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId).AnyTimes()
-				keeperMock.EXPECT().AddToTotalTransferredIn(ctx, sdkmath.NewInt(1000000)).Return()
+				route := types.BridgeRoute{
+					NativePort:           nativePort,
+					NativeChannel:        nativeChannel,
+					NativeClientId:       nativeClientId,
+					CounterpartyClientId: counterpartyClientId,
+					CounterpartyPort:     counterpartyPort,
+					CounterpartyChannel:  counterpartyChannel,
+					Denom:                moduleDenom,
+					DecimalDifference:    12,
+					NativeDenom:          constants.BondDenom,
+					Enabled:              true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
+				keeperMock.EXPECT().AddToRouteTransferredIn(ctx, gomock.Any(), sdkmath.NewInt(1000000)).Return()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-				keeperMock.EXPECT().GetIBCRecvDenom(ctx, moduleDenom).Return(ibcDenom)
-				keeperMock.EXPECT().ScaleDownTokenPrecision(ctx, sdkmath.NewInt(1000000000000000000)).Return(sdkmath.NewInt(1000000), nil)
-				keeperMock.EXPECT().CheckBalances(ctx, sdk.MustAccAddressFromBech32(receiver), sdkmath.NewInt(1000000000000000000), ibcDenom, sdkmath.NewInt(1000000)).Return(nil)
+				keeperMock.EXPECT().CheckBalances(ctx, sdk.MustAccAddressFromBech32(receiver), sdkmath.NewInt(1000000000000000000), ibcDenom, sdkmath.NewInt(1000000), constants.BondDenom).Return(nil)
+				keeperMock.EXPECT().CheckInboundVolume(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				keeperMock.EXPECT().ConsumeInboundVolume(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				keeperMock.EXPECT().LockIBCTokens(ctx, sdk.MustAccAddressFromBech32(receiver), sdkmath.NewInt(1000000000000000000), ibcDenom).Return(sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000000000000000000))), nil)
 				keeperMock.EXPECT().UnlockNativeTokens(
 					ctx,
 					sdk.MustAccAddressFromBech32(receiver),
 					sdkmath.NewInt(1000000),
-					sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000000000000000000)))).
+					sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000000000000000000))),
+					constants.BondDenom).
 					Return(sdk.NewCoins(sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000))), nil)
 				appMock.EXPECT().OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{}).Return(channeltypes.NewResultAcknowledgement([]byte("success")))
 			},
@@ -1773,12 +2009,18 @@ func TestOnRecvPacket(t *testing.T) {
 					ClientId: nativeClientId,
 				}, true).Times(0)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).AnyTimes()
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).AnyTimes()
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).AnyTimes()
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).AnyTimes()
+				route := types.BridgeRoute{
+					NativePort:          nativePort,
+					NativeChannel:       nativeChannel,
+					NativeClientId:      nativeClientId,
+					CounterpartyPort:    counterpartyPort,
+					CounterpartyChannel: counterpartyChannel,
+					Denom:               moduleDenom,
+					DecimalDifference:   12,
+					NativeDenom:         constants.BondDenom,
+					Enabled:             true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				appMock.EXPECT().OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{}).Return(channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot unmarshal ICS-20 transfer packet data: invalid character 'i' looking for beginning of value"))).Times(0)
 			},
@@ -1806,12 +2048,18 @@ func TestOnRecvPacket(t *testing.T) {
 					ClientId: nativeClientId,
 				}, true).Times(0)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).AnyTimes()
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).AnyTimes()
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).AnyTimes()
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).AnyTimes()
+				route := types.BridgeRoute{
+					NativePort:          nativePort,
+					NativeChannel:       nativeChannel,
+					NativeClientId:      nativeClientId,
+					CounterpartyPort:    counterpartyPort,
+					CounterpartyChannel: counterpartyChannel,
+					Denom:               moduleDenom,
+					DecimalDifference:   12,
+					NativeDenom:         constants.BondDenom,
+					Enabled:             true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				appMock.EXPECT().OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{}).Return(channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot unmarshal ICS-20 transfer packet data: missing required fields"))).Times(0)
 			},
@@ -1844,12 +2092,18 @@ func TestOnRecvPacket(t *testing.T) {
 					ClientId: nativeClientId,
 				}, true).Times(0)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).AnyTimes()
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).AnyTimes()
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).AnyTimes()
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).AnyTimes()
+				route := types.BridgeRoute{
+					NativePort:          nativePort,
+					NativeChannel:       nativeChannel,
+					NativeClientId:      nativeClientId,
+					CounterpartyPort:    counterpartyPort,
+					CounterpartyChannel: counterpartyChannel,
+					Denom:               moduleDenom,
+					DecimalDifference:   12,
+					NativeDenom:         constants.BondDenom,
+					Enabled:             true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				appMock.EXPECT().OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{}).Return(channeltypes.NewResultAcknowledgement([]byte("success"))).Times(0)
 			},
@@ -1882,12 +2136,18 @@ func TestOnRecvPacket(t *testing.T) {
 					ClientId: nativeClientId,
 				}, true).Times(0)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).AnyTimes()
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).AnyTimes()
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).AnyTimes()
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).AnyTimes()
+				route := types.BridgeRoute{
+					NativePort:          nativePort,
+					NativeChannel:       nativeChannel,
+					NativeClientId:      nativeClientId,
+					CounterpartyPort:    counterpartyPort,
+					CounterpartyChannel: counterpartyChannel,
+					Denom:               moduleDenom,
+					DecimalDifference:   12,
+					NativeDenom:         constants.BondDenom,
+					Enabled:             true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				appMock.EXPECT().OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{}).Return(channeltypes.NewResultAcknowledgement([]byte("success"))).Times(0)
 			},
@@ -1920,22 +2180,29 @@ func TestOnRecvPacket(t *testing.T) {
 					ClientId: nativeClientId,
 				}, true).Times(0)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).AnyTimes()
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).AnyTimes()
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).AnyTimes()
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).AnyTimes()
+				route := types.BridgeRoute{
+					NativePort:          nativePort,
+					NativeChannel:       nativeChannel,
+					NativeClientId:      nativeClientId,
+					CounterpartyPort:    counterpartyPort,
+					CounterpartyChannel: counterpartyChannel,
+					Denom:               moduleDenom,
+					DecimalDifference:   12,
+					NativeDenom:         constants.BondDenom,
+					Enabled:             true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-				keeperMock.EXPECT().GetIBCRecvDenom(ctx, moduleDenom).Return(ibcDenom).Times(0)
-				keeperMock.EXPECT().ScaleDownTokenPrecision(ctx, sdkmath.ZeroInt()).Return(sdkmath.Int{}, fmt.Errorf("converted amount is zero or negative: %s", sdkmath.ZeroInt().String())).Times(0)
-				keeperMock.EXPECT().CheckBalances(ctx, sdk.MustAccAddressFromBech32(receiver), sdkmath.ZeroInt(), ibcDenom, sdkmath.ZeroInt()).Return(nil).Times(0)
+				keeperMock.EXPECT().CheckBalances(ctx, sdk.MustAccAddressFromBech32(receiver), sdkmath.ZeroInt(), ibcDenom, sdkmath.ZeroInt(), constants.BondDenom).Return(nil).Times(0)
+				keeperMock.EXPECT().CheckInboundVolume(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				keeperMock.EXPECT().ConsumeInboundVolume(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				keeperMock.EXPECT().LockIBCTokens(ctx, sdk.MustAccAddressFromBech32(receiver), sdkmath.ZeroInt(), ibcDenom).Return(sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.ZeroInt())), nil).Times(0)
 				keeperMock.EXPECT().UnlockNativeTokens(
 					ctx,
 					sdk.MustAccAddressFromBech32(receiver),
 					sdkmath.ZeroInt(),
-					sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.ZeroInt()))).
+					sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.ZeroInt())),
+					constants.BondDenom).
 					Return(sdk.NewCoins(sdk.NewCoin(constants.BondDenom, sdkmath.ZeroInt())), nil).Times(0)
 				appMock.EXPECT().OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{}).Return(channeltypes.NewResultAcknowledgement([]byte("success"))).Times(0)
 			},
@@ -1975,13 +2242,19 @@ func TestOnRecvPacket(t *testing.T) {
 					},
 				}, true).Times(1)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).AnyTimes()
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).AnyTimes()
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).AnyTimes()
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId).AnyTimes()
+				route := types.BridgeRoute{
+					NativePort:           nativePort,
+					NativeChannel:        nativeChannel,
+					NativeClientId:       nativeClientId,
+					CounterpartyClientId: counterpartyClientId,
+					CounterpartyPort:     counterpartyPort,
+					CounterpartyChannel:  counterpartyChannel,
+					Denom:                moduleDenom,
+					DecimalDifference:    12,
+					NativeDenom:          constants.BondDenom,
+					Enabled:              true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				appMock.EXPECT().OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{}).Return(channeltypes.NewResultAcknowledgement([]byte("success")))
 			},
@@ -2021,13 +2294,18 @@ func TestOnRecvPacket(t *testing.T) {
 					},
 				}, true).Times(1)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).AnyTimes()
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).AnyTimes()
-				keeperMock.EXPECT().GetDenom(ctx).Return("").AnyTimes()
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId).AnyTimes()
+				route := types.BridgeRoute{
+					NativePort:           nativePort,
+					NativeChannel:        nativeChannel,
+					NativeClientId:       nativeClientId,
+					CounterpartyClientId: counterpartyClientId,
+					CounterpartyPort:     counterpartyPort,
+					CounterpartyChannel:  counterpartyChannel,
+					DecimalDifference:    12,
+					NativeDenom:          constants.BondDenom,
+					Enabled:              true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				appMock.EXPECT().OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{}).Return(channeltypes.NewResultAcknowledgement([]byte("success")))
 			},
@@ -2067,13 +2345,19 @@ func TestOnRecvPacket(t *testing.T) {
 					},
 				}, true).Times(1)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).AnyTimes()
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).AnyTimes()
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).AnyTimes()
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId).AnyTimes()
+				route := types.BridgeRoute{
+					NativePort:           nativePort,
+					NativeChannel:        nativeChannel,
+					NativeClientId:       nativeClientId,
+					CounterpartyClientId: counterpartyClientId,
+					CounterpartyPort:     counterpartyPort,
+					CounterpartyChannel:  counterpartyChannel,
+					Denom:                moduleDenom,
+					DecimalDifference:    12,
+					NativeDenom:          constants.BondDenom,
+					Enabled:              true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				appMock.EXPECT().OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{}).Return(channeltypes.NewResultAcknowledgement([]byte("success")))
 			},
@@ -2113,23 +2397,30 @@ func TestOnRecvPacket(t *testing.T) {
 					},
 				}, true).Times(1)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).AnyTimes()
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).AnyTimes()
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).AnyTimes()
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId).AnyTimes()
+				route := types.BridgeRoute{
+					NativePort:           nativePort,
+					NativeChannel:        nativeChannel,
+					NativeClientId:       nativeClientId,
+					CounterpartyClientId: counterpartyClientId,
+					CounterpartyPort:     counterpartyPort,
+					CounterpartyChannel:  counterpartyChannel,
+					Denom:                moduleDenom,
+					DecimalDifference:    12,
+					NativeDenom:          constants.BondDenom,
+					Enabled:              true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-				keeperMock.EXPECT().GetIBCRecvDenom(ctx, moduleDenom).Return(ibcDenom)
-				keeperMock.EXPECT().ScaleDownTokenPrecision(ctx, sdkmath.NewInt(1000000000000000000)).Return(sdkmath.NewInt(1000000), nil)
-				keeperMock.EXPECT().CheckBalances(ctx, sdk.MustAccAddressFromBech32(receiver), sdkmath.NewInt(1000000000000000000), ibcDenom, sdkmath.NewInt(1000000)).Return(nil)
+				keeperMock.EXPECT().CheckBalances(ctx, sdk.MustAccAddressFromBech32(receiver), sdkmath.NewInt(1000000000000000000), ibcDenom, sdkmath.NewInt(1000000), constants.BondDenom).Return(nil)
+				keeperMock.EXPECT().CheckInboundVolume(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				keeperMock.EXPECT().ConsumeInboundVolume(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				keeperMock.EXPECT().LockIBCTokens(ctx, sdk.MustAccAddressFromBech32(receiver), sdkmath.NewInt(1000000000000000000), ibcDenom).Return(sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000000000000000000))), fmt.Errorf("failed to lock tokens:"))
 				keeperMock.EXPECT().UnlockNativeTokens(
 					ctx,
 					sdk.MustAccAddressFromBech32(receiver),
 					sdkmath.NewInt(1000000),
-					sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000000000000000000)))).
+					sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000000000000000000))),
+					constants.BondDenom).
 					Return(sdk.NewCoins(sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000))), nil).Times(0)
 				appMock.EXPECT().OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{}).Return(channeltypes.NewResultAcknowledgement([]byte("success")))
 			},
@@ -2169,23 +2460,30 @@ func TestOnRecvPacket(t *testing.T) {
 					},
 				}, true).Times(1)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).AnyTimes()
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).AnyTimes()
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).AnyTimes()
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId).AnyTimes()
+				route := types.BridgeRoute{
+					NativePort:           nativePort,
+					NativeChannel:        nativeChannel,
+					NativeClientId:       nativeClientId,
+					CounterpartyClientId: counterpartyClientId,
+					CounterpartyPort:     counterpartyPort,
+					CounterpartyChannel:  counterpartyChannel,
+					Denom:                moduleDenom,
+					DecimalDifference:    12,
+					NativeDenom:          constants.BondDenom,
+					Enabled:              true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-				keeperMock.EXPECT().GetIBCRecvDenom(ctx, moduleDenom).Return(ibcDenom)
-				keeperMock.EXPECT().ScaleDownTokenPrecision(ctx, sdkmath.NewInt(1000000000000000000)).Return(sdkmath.NewInt(1000000), nil)
-				keeperMock.EXPECT().CheckBalances(ctx, sdk.MustAccAddressFromBech32(receiver), sdkmath.NewInt(1000000000000000000), ibcDenom, sdkmath.NewInt(1000000)).Return(nil)
+				keeperMock.EXPECT().CheckBalances(ctx, sdk.MustAccAddressFromBech32(receiver), sdkmath.NewInt(1000000000000000000), ibcDenom, sdkmath.NewInt(1000000), constants.BondDenom).Return(nil)
+				keeperMock.EXPECT().CheckInboundVolume(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				keeperMock.EXPECT().ConsumeInboundVolume(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				keeperMock.EXPECT().LockIBCTokens(ctx, sdk.MustAccAddressFromBech32(receiver), sdkmath.NewInt(1000000000000000000), ibcDenom).Return(sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000000000000000000))), nil)
 				keeperMock.EXPECT().UnlockNativeTokens(
 					ctx,
 					sdk.MustAccAddressFromBech32(receiver),
 					sdkmath.NewInt(1000000),
-					sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000000000000000000)))).
+					sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000000000000000000))),
+					constants.BondDenom).
 					Return(sdk.Coins{}, fmt.Errorf("failed to unlock tokens:"))
 				appMock.EXPECT().OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{}).Return(channeltypes.NewResultAcknowledgement([]byte("success")))
 			},
@@ -2225,13 +2523,19 @@ func TestOnRecvPacket(t *testing.T) {
 					},
 				}, true).Times(0)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).AnyTimes()
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).AnyTimes()
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).AnyTimes()
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId).AnyTimes()
+				route := types.BridgeRoute{
+					NativePort:           nativePort,
+					NativeChannel:        nativeChannel,
+					NativeClientId:       nativeClientId,
+					CounterpartyClientId: counterpartyClientId,
+					CounterpartyPort:     counterpartyPort,
+					CounterpartyChannel:  counterpartyChannel,
+					Denom:                moduleDenom,
+					DecimalDifference:    12,
+					NativeDenom:          constants.BondDenom,
+					Enabled:              true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				appMock.EXPECT().OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{}).Return(channeltypes.NewErrorAcknowledgement(fmt.Errorf("app callback failed")))
 			},
@@ -2271,25 +2575,32 @@ func TestOnRecvPacket(t *testing.T) {
 					},
 				}, true).Times(1)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).AnyTimes()
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).AnyTimes()
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).AnyTimes()
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId).AnyTimes()
+				route := types.BridgeRoute{
+					NativePort:           nativePort,
+					NativeChannel:        nativeChannel,
+					NativeClientId:       nativeClientId,
+					CounterpartyClientId: counterpartyClientId,
+					CounterpartyPort:     counterpartyPort,
+					CounterpartyChannel:  counterpartyChannel,
+					Denom:                moduleDenom,
+					DecimalDifference:    12,
+					NativeDenom:          constants.BondDenom,
+					Enabled:              true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				amount, _ := sdkmath.NewIntFromString("1000000000000000000000000000000")
-				keeperMock.EXPECT().AddToTotalTransferredIn(ctx, sdkmath.NewInt(1000000000000000000)).Return()
+				keeperMock.EXPECT().AddToRouteTransferredIn(ctx, gomock.Any(), sdkmath.NewInt(1000000000000000000)).Return()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-				keeperMock.EXPECT().GetIBCRecvDenom(ctx, moduleDenom).Return(ibcDenom)
-				keeperMock.EXPECT().ScaleDownTokenPrecision(ctx, amount).Return(sdkmath.NewInt(1000000000000000000), nil)
-				keeperMock.EXPECT().CheckBalances(ctx, sdk.MustAccAddressFromBech32(receiver), amount, ibcDenom, sdkmath.NewInt(1000000000000000000)).Return(nil)
+				keeperMock.EXPECT().CheckBalances(ctx, sdk.MustAccAddressFromBech32(receiver), amount, ibcDenom, sdkmath.NewInt(1000000000000000000), constants.BondDenom).Return(nil)
+				keeperMock.EXPECT().CheckInboundVolume(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				keeperMock.EXPECT().ConsumeInboundVolume(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				keeperMock.EXPECT().LockIBCTokens(ctx, sdk.MustAccAddressFromBech32(receiver), amount, ibcDenom).Return(sdk.NewCoins(sdk.NewCoin(ibcDenom, amount)), nil)
 				keeperMock.EXPECT().UnlockNativeTokens(
 					ctx,
 					sdk.MustAccAddressFromBech32(receiver),
 					sdkmath.NewInt(1000000000000000000),
-					sdk.NewCoins(sdk.NewCoin(ibcDenom, amount))).
+					sdk.NewCoins(sdk.NewCoin(ibcDenom, amount)),
+					constants.BondDenom).
 					Return(sdk.NewCoins(sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000000000000000))), nil)
 				appMock.EXPECT().OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{}).Return(channeltypes.NewResultAcknowledgement([]byte("success")))
 			},
@@ -2329,13 +2640,19 @@ func TestOnRecvPacket(t *testing.T) {
 					},
 				}, true).Times(1)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).AnyTimes()
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).AnyTimes()
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).AnyTimes()
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId).AnyTimes()
+				route := types.BridgeRoute{
+					NativePort:           nativePort,
+					NativeChannel:        nativeChannel,
+					NativeClientId:       nativeClientId,
+					CounterpartyClientId: counterpartyClientId,
+					CounterpartyPort:     counterpartyPort,
+					CounterpartyChannel:  counterpartyChannel,
+					Denom:                moduleDenom,
+					DecimalDifference:    12,
+					NativeDenom:          constants.BondDenom,
+					Enabled:              true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				appMock.EXPECT().OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{}).Return(channeltypes.NewResultAcknowledgement([]byte("success")))
 			},
@@ -2375,17 +2692,22 @@ func TestOnRecvPacket(t *testing.T) {
 					},
 				}, true).Times(1)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).AnyTimes()
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).AnyTimes()
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).AnyTimes()
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId).AnyTimes()
-				keeperMock.EXPECT().GetDecimalConversionFactor(ctx).Times(0)
+				route := types.BridgeRoute{
+					NativePort:           nativePort,
+					NativeChannel:        nativeChannel,
+					NativeClientId:       nativeClientId,
+					CounterpartyClientId: counterpartyClientId,
+					CounterpartyPort:     counterpartyPort,
+					CounterpartyChannel:  counterpartyChannel,
+					Denom:                moduleDenom,
+					DecimalDifference:    12,
+					NativeDenom:          constants.BondDenom,
+					Enabled:              true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().LockTokens(ctx, sdk.MustAccAddressFromBech32(receiver), sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000000000000000000)))).Times(0)
 				keeperMock.EXPECT().UnlockTokens(ctx, sdk.MustAccAddressFromBech32(receiver), sdk.NewCoins(sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000)))).Times(0)
-				keeperMock.EXPECT().AddToTotalTransferredIn(ctx, sdkmath.NewInt(1000000)).Times(0)
+				keeperMock.EXPECT().AddToRouteTransferredIn(ctx, gomock.Any(), sdkmath.NewInt(1000000)).Times(0)
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				appMock.EXPECT().OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{}).Return(channeltypes.NewResultAcknowledgement([]byte("success"))).Times(1)
 			},
@@ -2425,13 +2747,18 @@ func TestOnRecvPacket(t *testing.T) {
 					},
 				}, true).Times(0)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).AnyTimes()
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).AnyTimes()
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).AnyTimes()
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return("").AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId).AnyTimes()
+				route := types.BridgeRoute{
+					NativePort:           nativePort,
+					NativeChannel:        nativeChannel,
+					CounterpartyClientId: counterpartyClientId,
+					CounterpartyPort:     counterpartyPort,
+					CounterpartyChannel:  counterpartyChannel,
+					Denom:                moduleDenom,
+					DecimalDifference:    12,
+					NativeDenom:          constants.BondDenom,
+					Enabled:              true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 
 				// App callback should not be called
@@ -2467,12 +2794,18 @@ func TestOnRecvPacket(t *testing.T) {
 				}, true).Times(0)
 				connectionKeeperMock.EXPECT().GetConnection(ctx, counterpartyConnectionId).Times(0)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).AnyTimes()
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).AnyTimes()
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).AnyTimes()
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).AnyTimes()
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId).AnyTimes()
+				route := types.BridgeRoute{
+					NativePort:          nativePort,
+					NativeChannel:       nativeChannel,
+					NativeClientId:      nativeClientId,
+					CounterpartyPort:    counterpartyPort,
+					CounterpartyChannel: counterpartyChannel,
+					Denom:               moduleDenom,
+					DecimalDifference:   12,
+					NativeDenom:         constants.BondDenom,
+					Enabled:             true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 
 				// App callback should not be called
@@ -2492,6 +2825,14 @@ func TestOnRecvPacket(t *testing.T) {
 
 			// Create fresh mocks for each test case
 			keeperMock := testutil.NewMockTokenwrapperKeeper(ctrl)
+			keeperMock.EXPECT().RecordTransfer(gomock.Any(), gomock.Any()).AnyTimes()
+			keeperMock.EXPECT().GetBridgeFee(gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt()).AnyTimes()
+			keeperMock.EXPECT().CollectInboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			keeperMock.EXPECT().EscrowOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			keeperMock.EXPECT().PayOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			keeperMock.EXPECT().RefundOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt(), nil).AnyTimes()
+			keeperMock.EXPECT().ReleaseOutboundVolume(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			keeperMock.EXPECT().UpdateTransferStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 			transferKeeperMock := testutil.NewMockTransferKeeper(ctrl)
 			bankKeeperMock := testutil.NewMockBankKeeper(ctrl)
 			appMock := testutil.NewMockCallbacksCompatibleModule(ctrl)
@@ -2569,14 +2910,19 @@ func TestOnRecvPacket_InsufficientAccountBalance(t *testing.T) {
 	// keeper setup
 	k, ctx := keepertest.TokenwrapperKeeper(t, bankKeeperMock)
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	ibcModule := tokenwrapper.NewIBCModule(
 		k, transferKeeperMock, bankKeeperMock, appMock, channelKeeperMock, connectionKeeperMock,
@@ -2644,9 +2990,11 @@ func TestOnRecvPacket_InsufficientAccountBalance(t *testing.T) {
 	ack := ibcModule.OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{})
 	require.True(t, ack.Success())
 
+	// the transfer is queued to be converted later
 	events := ctx.EventManager().Events()
-	require.Len(t, events, 1)
+	require.Len(t, events, 2)
 	require.Equal(t, types.EventTypeTokenWrapperError, events[0].Type)
+	require.Equal(t, types.EventTypePendingUnwrapQueued, events[1].Type)
 	require.Equal(t, events[0].Attributes[0].Value,
 		fmt.Sprintf("account %s does not have enough balance of %s",
 			fixture.receiver,
@@ -2672,14 +3020,19 @@ func TestOnRecvPacket_InsufficientModuleBalance(t *testing.T) {
 	// keeper setup
 	k, ctx := keepertest.TokenwrapperKeeper(t, bankKeeperMock)
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	ibcModule := tokenwrapper.NewIBCModule(
 		k, transferKeeperMock, bankKeeperMock, appMock, channelKeeperMock, connectionKeeperMock,
@@ -2744,7 +3097,7 @@ func TestOnRecvPacket_InsufficientModuleBalance(t *testing.T) {
 		Return(true)
 
 	// module lacks bond-denom
-	converted := amountInt.Quo(k.GetDecimalConversionFactor(ctx))
+	converted := amountInt.Quo(route.DecimalConversionFactor())
 	bankKeeperMock.
 		EXPECT().
 		HasBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), sdk.NewCoin(constants.BondDenom, converted)).
@@ -2753,9 +3106,11 @@ func TestOnRecvPacket_InsufficientModuleBalance(t *testing.T) {
 	ack := ibcModule.OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{})
 	require.True(t, ack.Success())
 
+	// the transfer is queued to be converted once the module wallet is funded
 	events := ctx.EventManager().Events()
-	require.Len(t, events, 1)
+	require.Len(t, events, 2)
 	require.Equal(t, types.EventTypeTokenWrapperError, events[0].Type)
+	require.Equal(t, types.EventTypePendingUnwrapQueued, events[1].Type)
 	require.Equal(t, events[0].Attributes[0].Value,
 		fmt.Sprintf("module does not have enough balance of %s%s",
 			converted.String(),
@@ -2782,14 +3137,19 @@ func TestOnRecvPacket_ConnectionNotFound(t *testing.T) {
 	// keeper setup
 	k, ctx := keepertest.TokenwrapperKeeper(t, bankKeeperMock)
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	ibcModule := tokenwrapper.NewIBCModule(
 		k, transferKeeperMock, bankKeeperMock, appMock, channelKeeperMock, connectionKeeperMock,
@@ -3112,14 +3472,19 @@ func TestOnRecvPacket_RealKeepers_Negative_InvalidDataFields(t *testing.T) {
 
 			// Configure keeper
 			k.SetEnabled(ctx, true)
-			k.SetNativePort(ctx, fixture.nativePort)
-			k.SetNativeChannel(ctx, fixture.nativeChannel)
-			k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-			k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-			k.SetDenom(ctx, fixture.moduleDenom) // "axl"
-			k.SetNativeClientId(ctx, fixture.nativeClientId)
-			k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-			_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+			route := types.BridgeRoute{
+				Denom:                fixture.moduleDenom,
+				NativePort:           fixture.nativePort,
+				NativeChannel:        fixture.nativeChannel,
+				NativeClientId:       fixture.nativeClientId,
+				CounterpartyClientId: fixture.counterpartyClientId,
+				CounterpartyPort:     fixture.counterpartyPort,
+				CounterpartyChannel:  fixture.counterpartyChannel,
+				DecimalDifference:    fixture.decimalDifference,
+				NativeDenom:          constants.BondDenom,
+				Enabled:              true,
+			}
+			k.SetBridgeRoute(ctx, route)
 
 			// Create IBC mocks
 			transferKeeperMock := testutil.NewMockTransferKeeper(ctrl)
@@ -3136,7 +3501,7 @@ func TestOnRecvPacket_RealKeepers_Negative_InvalidDataFields(t *testing.T) {
 			moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
 
 			amountInt, _ := sdkmath.NewIntFromString(fixture.amount)
-			conversionFactor := k.GetDecimalConversionFactor(ctx)
+			conversionFactor := route.DecimalConversionFactor()
 			convertedAmount := amountInt.Quo(conversionFactor)
 
 			// Give receiver IBC tokens
@@ -3250,6 +3615,16 @@ func TestOnRecvPacket_UnmarshalError_InvalidJSON(t *testing.T) {
 	defer ctrl.Finish()
 
 	keeperMock := testutil.NewMockTokenwrapperKeeper(ctrl)
+
+	keeperMock.EXPECT().RecordTransfer(gomock.Any(), gomock.Any()).AnyTimes()
+	keeperMock.EXPECT().GetBridgeFee(gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt()).AnyTimes()
+	keeperMock.EXPECT().CollectInboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	keeperMock.EXPECT().EscrowOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	keeperMock.EXPECT().PayOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	keeperMock.EXPECT().RefundOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt(), nil).AnyTimes()
+	keeperMock.EXPECT().ReleaseOutboundVolume(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	keeperMock.EXPECT().UpdateTransferStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	transferKeeperMock := testutil.NewMockTransferKeeper(ctrl)
 	bankKeeperMock := testutil.NewMockBankKeeper(ctrl)
 	appMock := testutil.NewMockCallbacksCompatibleModule(ctrl)
@@ -3297,6 +3672,16 @@ func TestOnRecvPacket_UnmarshalError_EmptyData(t *testing.T) {
 	defer ctrl.Finish()
 
 	keeperMock := testutil.NewMockTokenwrapperKeeper(ctrl)
+
+	keeperMock.EXPECT().RecordTransfer(gomock.Any(), gomock.Any()).AnyTimes()
+	keeperMock.EXPECT().GetBridgeFee(gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt()).AnyTimes()
+	keeperMock.EXPECT().CollectInboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	keeperMock.EXPECT().EscrowOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	keeperMock.EXPECT().PayOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	keeperMock.EXPECT().RefundOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt(), nil).AnyTimes()
+	keeperMock.EXPECT().ReleaseOutboundVolume(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	keeperMock.EXPECT().UpdateTransferStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	transferKeeperMock := testutil.NewMockTransferKeeper(ctrl)
 	bankKeeperMock := testutil.NewMockBankKeeper(ctrl)
 	appMock := testutil.NewMockCallbacksCompatibleModule(ctrl)
@@ -3344,6 +3729,16 @@ func TestOnRecvPacket_UnmarshalError_NonJSONData(t *testing.T) {
 	defer ctrl.Finish()
 
 	keeperMock := testutil.NewMockTokenwrapperKeeper(ctrl)
+
+	keeperMock.EXPECT().RecordTransfer(gomock.Any(), gomock.Any()).AnyTimes()
+	keeperMock.EXPECT().GetBridgeFee(gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt()).AnyTimes()
+	keeperMock.EXPECT().CollectInboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	keeperMock.EXPECT().EscrowOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	keeperMock.EXPECT().PayOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	keeperMock.EXPECT().RefundOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt(), nil).AnyTimes()
+	keeperMock.EXPECT().ReleaseOutboundVolume(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	keeperMock.EXPECT().UpdateTransferStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	transferKeeperMock := testutil.NewMockTransferKeeper(ctrl)
 	bankKeeperMock := testutil.NewMockBankKeeper(ctrl)
 	appMock := testutil.NewMockCallbacksCompatibleModule(ctrl)
@@ -3396,14 +3791,19 @@ func TestOnRecvPacket_RealKeepers_PacketDenomNotModuleDenom(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -3480,7 +3880,7 @@ func TestOnRecvPacket_RealKeepers_PacketDenomNotModuleDenom(t *testing.T) {
 	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, receiverAddr, ibcCoins))
 
 	// Mint native tokens to module
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
+	conversionFactor := route.DecimalConversionFactor()
 	convertedAmount := amountInt.Quo(conversionFactor)
 	nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, convertedAmount))
 	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, nativeCoins))
@@ -3557,17 +3957,22 @@ func TestOnRecvPacket_RealKeepers_Positive_SenderNotSourceChain(t *testing.T) {
 
 	// Set module denom to prefixed to trigger the condition
 	prefixedDenom := fmt.Sprintf("%s/%s/%s", fixture.counterpartyPort, fixture.counterpartyChannel, fixture.moduleDenom)
-	k.SetDenom(ctx, prefixedDenom)
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                prefixedDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -3649,7 +4054,7 @@ func TestOnRecvPacket_RealKeepers_Positive_SenderNotSourceChain(t *testing.T) {
 	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, receiverAddr, ibcCoins))
 
 	// Mint native tokens to module
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
+	conversionFactor := route.DecimalConversionFactor()
 	convertedAmount := amountInt.Quo(conversionFactor)
 	nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, convertedAmount))
 	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, nativeCoins))
@@ -3720,14 +4125,19 @@ func TestOnRecvPacket_EmitsTokenConversionErrorEvent(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper with a large decimal difference to cause scaling issues
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, 18) // Large decimal difference to cause scaling to zero
+	route := types.BridgeRoute{
+		DecimalDifference:    18, // Large decimal difference to cause scaling to zero
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -3800,7 +4210,7 @@ func TestOnRecvPacket_EmitsTokenConversionErrorEvent(t *testing.T) {
 	receiverAddr := sdk.MustAccAddressFromBech32(fixture.receiver)
 	// Mint IBC tokens to receiver
 	amountInt, _ := sdkmath.NewIntFromString(smallAmount)
-	recvDenom := k.GetIBCRecvDenom(ctx, fixture.moduleDenom)
+	recvDenom := route.IBCRecvDenom()
 	ibcCoins := sdk.NewCoins(sdk.NewCoin(recvDenom, amountInt))
 	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, ibcCoins))
 	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, receiverAddr, ibcCoins))
@@ -3856,3 +4266,151 @@ func TestOnRecvPacket_EmitsTokenConversionErrorEvent(t *testing.T) {
 
 	t.Log("SUCCESS: Verified that OnRecvPacket emits error event when token conversion fails")
 }
+
+func TestOnRecvPacket_RealKeepers_Negative_InboundVolumeCapExceeded(t *testing.T) {
+	// Test case: OnRecvPacket with real keepers when the wrapped amount exceeds the inbound volume cap of the route,
+	// the IBC vouchers are kept in the receiver address for a later recovery
+
+	// set up a positive fixture
+	fixture := getRecvPacketPositiveFixture()
+
+	// Create the TokenwrapperKeeper with a real bank keeper
+	k, ctx, bankKeeper := keepertest.TokenwrapperKeeperWithBank(t)
+
+	// Set up the TokenwrapperKeeper with an inbound cap of half the wrapped amount
+	k.SetEnabled(ctx, true)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
+
+	amountInt, _ := sdkmath.NewIntFromString(fixture.amount)
+	convertedAmount := amountInt.Quo(route.DecimalConversionFactor())
+	k.SetVolumeCap(ctx, types.VolumeCap{
+		NativePort:    fixture.nativePort,
+		NativeChannel: fixture.nativeChannel,
+		InboundCap:    convertedAmount.QuoRaw(2),
+		OutboundCap:   sdkmath.ZeroInt(),
+		Window:        3600,
+	})
+
+	// Create mocks for other dependencies
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	transferKeeperMock := testutil.NewMockTransferKeeper(ctrl)
+	appMock := testutil.NewMockCallbacksCompatibleModule(ctrl)
+	channelKeeperMock := testutil.NewMockChannelKeeper(ctrl)
+	connectionKeeperMock := testutil.NewMockConnectionKeeper(ctrl)
+
+	// Create IBCModule
+	ibcModule := tokenwrapper.NewIBCModule(
+		k, transferKeeperMock, nil, appMock, channelKeeperMock, connectionKeeperMock,
+	)
+
+	// Create the data and the packet
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    fixture.moduleDenom,
+		Amount:   fixture.amount,
+		Sender:   fixture.sender,
+		Receiver: fixture.receiver,
+	}
+	dataBz, err := transfertypes.ModuleCdc.MarshalJSON(&data)
+	require.NoError(t, err)
+
+	packet := channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         fixture.counterpartyPort,
+		SourceChannel:      fixture.counterpartyChannel,
+		DestinationPort:    fixture.nativePort,
+		DestinationChannel: fixture.nativeChannel,
+		Data:               dataBz,
+	}
+
+	// Set up channel and connection mocks
+	channelKeeperMock.
+		EXPECT().
+		GetChannel(ctx, fixture.nativePort, fixture.nativeChannel).
+		Return(
+			channeltypes.Channel{
+				State:          channeltypes.OPEN,
+				ConnectionHops: []string{fixture.nativeConnectionId},
+				Counterparty: channeltypes.Counterparty{
+					PortId:    fixture.counterpartyPort,
+					ChannelId: fixture.counterpartyChannel,
+				},
+			}, true).
+		Times(3)
+
+	connectionKeeperMock.
+		EXPECT().
+		GetConnection(ctx, fixture.nativeConnectionId).
+		Return(connectiontypes.ConnectionEnd{ClientId: fixture.nativeClientId,
+			Counterparty: connectiontypes.Counterparty{
+				ClientId: fixture.counterpartyClientId,
+			},
+		}, true).
+		Times(1)
+
+	appMock.
+		EXPECT().
+		OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{}).
+		Return(channeltypes.NewResultAcknowledgement([]byte("success")))
+
+	// Mint IBC tokens to the receiver and enough native tokens to the module
+	receiverAddr := sdk.MustAccAddressFromBech32(fixture.receiver)
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	ibcCoins := sdk.NewCoins(sdk.NewCoin(fixture.ibcDenom, amountInt))
+	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, ibcCoins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, receiverAddr, ibcCoins))
+
+	nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, convertedAmount))
+	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, nativeCoins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, nativeCoins))
+
+	// Execute OnRecvPacket
+	respOnRecvPacket := ibcModule.OnRecvPacket(ctx, "channel-version", packet, sdk.AccAddress{})
+
+	// The acknowledgement is successful so the receiver keeps the IBC vouchers
+	require.NotNil(t, respOnRecvPacket)
+	require.True(t, respOnRecvPacket.Success(), "OnRecvPacket should not fail when the inbound volume cap is exceeded")
+
+	require.Equal(t, sdkmath.ZeroInt(), bankKeeper.GetBalance(ctx, receiverAddr, constants.BondDenom).Amount)
+	require.Equal(t, amountInt, bankKeeper.GetBalance(ctx, receiverAddr, fixture.ibcDenom).Amount)
+	require.Equal(t, convertedAmount, bankKeeper.GetBalance(ctx, moduleAddr, constants.BondDenom).Amount)
+	require.Equal(t, sdkmath.ZeroInt(), bankKeeper.GetBalance(ctx, moduleAddr, fixture.ibcDenom).Amount)
+
+	// No volume was recorded in the window
+	require.Empty(t, k.GetAllVolumeBuckets(ctx))
+
+	// The transfer is recorded as skipped with the volume cap as the reason
+	record, found := k.GetTransferRecordByPacket(ctx, fixture.nativePort, fixture.nativeChannel, true, packet.Sequence)
+	require.True(t, found)
+	require.Equal(t, types.TRANSFER_STATUS_SKIPPED, record.Status)
+	require.Contains(t, record.Reason, types.ErrVolumeCapExceeded.Error())
+	require.Equal(t, convertedAmount, record.NativeAmount)
+
+	var foundErrorEvent bool
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, types.EventTypeTokenWrapperOnRecvPacket, event.Type, "Should NOT emit success event when the cap is exceeded")
+		if event.Type == types.EventTypeTokenWrapperError {
+			foundErrorEvent = true
+			for _, attr := range event.Attributes {
+				if attr.Key == "error" {
+					require.Contains(t, attr.Value, types.ErrVolumeCapExceeded.Error())
+				}
+			}
+		}
+	}
+	require.True(t, foundErrorEvent, "Expected EventTypeTokenWrapperError event to be emitted")
+}
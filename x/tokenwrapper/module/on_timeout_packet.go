@@ -24,6 +24,7 @@ func (im IBCModule) OnTimeoutPacket(
 		errMsg := fmt.Errorf("channel validation failed: %v", err)
 		types.EmitTokenWrapperErrorEvent(ctx, errMsg)
 		im.keeper.Logger().Error(errMsg.Error())
+		im.refundPendingBridgeFee(ctx, packet)
 		return im.app.OnTimeoutPacket(ctx, channelVersion, packet, relayer)
 	}
 
@@ -32,6 +33,7 @@ func (im IBCModule) OnTimeoutPacket(
 	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		im.keeper.Logger().Error(fmt.Sprintf("failed to unmarshal packet data: %v", err))
+		im.refundPendingBridgeFee(ctx, packet)
 		return im.app.OnTimeoutPacket(ctx, channelVersion, packet, relayer)
 	}
 
@@ -40,6 +42,7 @@ func (im IBCModule) OnTimeoutPacket(
 	if err != nil {
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		im.keeper.Logger().Error(fmt.Sprintf("invalid sender address: %v", err))
+		im.refundPendingBridgeFee(ctx, packet)
 		return im.app.OnTimeoutPacket(ctx, channelVersion, packet, relayer)
 	}
 
@@ -49,6 +52,7 @@ func (im IBCModule) OnTimeoutPacket(
 		err := fmt.Errorf("invalid amount: %s", data.Amount)
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		im.keeper.Logger().Error(fmt.Sprintf("invalid amount: %v", err))
+		im.refundPendingBridgeFee(ctx, packet)
 		return im.app.OnTimeoutPacket(ctx, channelVersion, packet, relayer)
 	}
 
@@ -57,14 +61,17 @@ func (im IBCModule) OnTimeoutPacket(
 		err := fmt.Errorf("amount is zero or negative: %s", data.Amount)
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		im.keeper.Logger().Error(fmt.Sprintf("amount is zero or negative: %v", err))
+		im.refundPendingBridgeFee(ctx, packet)
 		return im.app.OnTimeoutPacket(ctx, channelVersion, packet, relayer)
 	}
 
-	// Validate IBC settings exist, if not, skip refunding
-	if !im.validateIBCSettingsExist(ctx) {
+	// Resolve the bridge route of the source port and channel and validate its IBC settings exist, if not, skip refunding
+	route, found := im.keeper.GetBridgeRoute(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found || !im.validateIBCSettingsExist(route) {
 		info := fmt.Sprintf("IBC settings validation failed: %v, skipping refunding", types.ErrIBCSettingsNotSet)
 		types.EmitTokenWrapperInfoEvent(ctx, info)
 		im.keeper.Logger().Info(info)
+		im.refundPendingBridgeFee(ctx, packet)
 		return im.app.OnTimeoutPacket(ctx, channelVersion, packet, relayer)
 	}
 
@@ -72,26 +79,29 @@ func (im IBCModule) OnTimeoutPacket(
 	baseDenom := transfertypes.ExtractDenomFromPath(data.Denom).Base
 
 	// Skip refunding if packet denom is not the module denom
-	if !im.validateIBCDenomIsModuleDenom(ctx, baseDenom) {
+	if !im.validateIBCDenomIsModuleDenom(route, baseDenom) {
 		info := fmt.Sprintf("packet denom is not the module denom, skipping refunding: %s", baseDenom)
 		types.EmitTokenWrapperInfoEvent(ctx, info)
 		im.keeper.Logger().Info(info)
+		im.refundPendingBridgeFee(ctx, packet)
 		return im.app.OnTimeoutPacket(ctx, channelVersion, packet, relayer)
 	}
 
 	// Check if IBC settings match the expected values
-	if !im.validateIBCSettingsMatchOnSendPacket(ctx, packet) {
+	if !im.validateIBCSettingsMatchOnSendPacket(route, packet) {
 		err := fmt.Errorf("%v, failed with packet: %v", types.ErrIBCSettingsMismatch, packet)
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		im.keeper.Logger().Error(err.Error())
+		im.refundPendingBridgeFee(ctx, packet)
 		return im.app.OnTimeoutPacket(ctx, channelVersion, packet, relayer)
 	}
 
 	// Check if the module functionality is enabled
-	if !im.keeper.IsEnabled(ctx) {
+	if !im.keeper.IsEnabled(ctx) || !route.Enabled {
 		err := fmt.Errorf("module functionality is not enabled")
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		im.keeper.Logger().Error(fmt.Sprintf("module functionality is not enabled: %v", err))
+		im.refundPendingBridgeFee(ctx, packet)
 		return im.app.OnTimeoutPacket(ctx, channelVersion, packet, relayer)
 	}
 
@@ -102,14 +112,24 @@ func (im IBCModule) OnTimeoutPacket(
 		return err
 	}
 
+	// Return the escrowed bridge fee to the sender along with the refund
+	fee, err := im.keeper.RefundOutboundBridgeFee(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if err != nil {
+		types.EmitTokenWrapperErrorEvent(ctx, err)
+		im.keeper.Logger().Error(fmt.Sprintf("failed to refund bridge fee: %v", err))
+	}
+
 	// Handle refund for timeout
-	if err := im.handleRefund(ctx, sender, amount, data.Denom); err != nil {
+	if err := im.handleRefund(ctx, route, sender, amount, data.Denom, fee); err != nil {
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		im.keeper.Logger().Error(fmt.Sprintf("failed to handle refund: %v", err))
+		im.keeper.UpdateTransferStatus(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), types.TRANSFER_STATUS_TIMED_OUT, err.Error())
 		// return nil to apply the default behavior of the underlying OnTimeoutPacket
 		return nil
 	}
 
+	im.keeper.UpdateTransferStatus(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), types.TRANSFER_STATUS_TIMED_OUT, "")
+
 	// Emit error event for timeout
 	types.EmitTokenWrapperErrorEvent(ctx, fmt.Errorf("IBC packet timeout: %s/%s sequence %d", packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
 
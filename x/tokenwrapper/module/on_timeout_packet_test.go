@@ -10,6 +10,8 @@ import (
 	keepertest "zigchain/testutil/keeper"
 	tokenwrapper "zigchain/x/tokenwrapper/module"
 	mocks "zigchain/x/tokenwrapper/testutil"
+	"zigchain/x/tokenwrapper/types"
+	"zigchain/zutils/constants"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -73,17 +75,22 @@ func TestOnTimeoutPacket(t *testing.T) {
 					State: channeltypes.OPEN,
 				}, true)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).Times(1)
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).Times(2)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).Times(2)
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).Times(2)
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).Times(2)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).Times(2)
+				route := types.BridgeRoute{
+					NativePort:          nativePort,
+					NativeChannel:       nativeChannel,
+					CounterpartyPort:    counterpartyPort,
+					CounterpartyChannel: counterpartyChannel,
+					Denom:               moduleDenom,
+					DecimalDifference:   12,
+					NativeDenom:         constants.BondDenom,
+					Enabled:             true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				keeperMock.EXPECT().CheckAccountBalance(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 				keeperMock.EXPECT().CheckModuleBalance(ctx, gomock.Any()).Return(nil).Times(1)
 				keeperMock.EXPECT().LockTokens(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 				keeperMock.EXPECT().UnlockTokens(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1)
-				keeperMock.EXPECT().ScaleDownTokenPrecision(ctx, sdkmath.NewInt(1000000000000000000)).Return(sdkmath.NewInt(1000000), nil)
 				appMock.EXPECT().OnTimeoutPacket(
 					gomock.Any(),
 					"ics20-1",
@@ -104,11 +111,17 @@ func TestOnTimeoutPacket(t *testing.T) {
 					State: channeltypes.OPEN,
 				}, true)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(false).Times(1)
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).Times(2)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).Times(2)
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).Times(2)
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).Times(2)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).Times(2)
+				route := types.BridgeRoute{
+					NativePort:          nativePort,
+					NativeChannel:       nativeChannel,
+					CounterpartyPort:    counterpartyPort,
+					CounterpartyChannel: counterpartyChannel,
+					Denom:               moduleDenom,
+					DecimalDifference:   12,
+					NativeDenom:         constants.BondDenom,
+					Enabled:             true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				appMock.EXPECT().OnTimeoutPacket(
 					gomock.Any(),
@@ -150,11 +163,17 @@ func TestOnTimeoutPacket(t *testing.T) {
 					State: channeltypes.OPEN,
 				}, true).Times(1)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).Times(1)
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort).Times(2)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel).Times(2)
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort).Times(2)
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel).Times(2)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom).Times(2)
+				route := types.BridgeRoute{
+					NativePort:          nativePort,
+					NativeChannel:       nativeChannel,
+					CounterpartyPort:    counterpartyPort,
+					CounterpartyChannel: counterpartyChannel,
+					Denom:               moduleDenom,
+					DecimalDifference:   12,
+					NativeDenom:         constants.BondDenom,
+					Enabled:             true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				appMock.EXPECT().OnTimeoutPacket(
 					gomock.Any(),
@@ -174,6 +193,14 @@ func TestOnTimeoutPacket(t *testing.T) {
 
 			// Create mocks
 			keeperMock := mocks.NewMockTokenwrapperKeeper(ctrl)
+			keeperMock.EXPECT().RecordTransfer(gomock.Any(), gomock.Any()).AnyTimes()
+			keeperMock.EXPECT().GetBridgeFee(gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt()).AnyTimes()
+			keeperMock.EXPECT().CollectInboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			keeperMock.EXPECT().EscrowOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			keeperMock.EXPECT().PayOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			keeperMock.EXPECT().RefundOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt(), nil).AnyTimes()
+			keeperMock.EXPECT().ReleaseOutboundVolume(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			keeperMock.EXPECT().UpdateTransferStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 			transferKeeperMock := mocks.NewMockTransferKeeper(ctrl)
 			bankKeeperMock := mocks.NewMockBankKeeper(ctrl)
 			appMock := mocks.NewMockCallbacksCompatibleModule(ctrl)
@@ -263,13 +290,18 @@ func TestOnTimeoutPacket_RealKeepers_Positive(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -348,11 +380,129 @@ func TestOnTimeoutPacket_RealKeepers_Positive(t *testing.T) {
 		Return(nil).
 		Times(1)
 
+	// Record the transfer as SendPacket does
+	k.RecordTransfer(ctx, types.TransferRecord{
+		NativePort:    fixture.nativePort,
+		NativeChannel: fixture.nativeChannel,
+		Sequence:      packet.Sequence,
+		Sender:        fixture.sender,
+		Receiver:      fixture.receiver,
+		IbcAmount:     sdkmath.NewInt(1000000000000000000),
+		NativeAmount:  sdkmath.NewInt(1000000),
+		Status:        types.TRANSFER_STATUS_PENDING,
+	})
+
 	// Execute OnTimeoutPacket
 	err = ibcModule.OnTimeoutPacket(ctx, "ics20-1", packet, sdk.AccAddress{})
 
 	// Expect no error
 	require.NoError(t, err)
+
+	// The transfer record is timed out
+	record, found := k.GetTransferRecordByPacket(ctx, fixture.nativePort, fixture.nativeChannel, false, packet.Sequence)
+	require.True(t, found)
+	require.Equal(t, types.TRANSFER_STATUS_TIMED_OUT, record.Status)
+}
+
+func TestOnTimeoutPacket_RouteDisabled_RefundsBridgeFee(t *testing.T) {
+	// Test case: OnTimeoutPacket over a disabled route skips converting the refund back, but still
+	// returns the escrowed bridge fee to the sender
+
+	// Set up positive fixture
+	fixture := getTimeoutPacketPositiveFixture()
+
+	// Create the TokenwrapperKeeper with a real bank keeper
+	k, ctx, bankKeeper := keepertest.TokenwrapperKeeperWithBank(t)
+
+	// Set up the TokenwrapperKeeper with a disabled route
+	k.SetEnabled(ctx, true)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              false,
+	}
+	k.SetBridgeRoute(ctx, route)
+
+	// Escrow the bridge fee of the packet in the module wallet as SendPacket does
+	senderAddr, err := sdk.AccAddressFromBech32(fixture.sender)
+	require.NoError(t, err)
+	feeCoins := sdk.NewCoins(sdk.NewInt64Coin(constants.BondDenom, 100))
+	require.NoError(t, bankKeeper.MintCoins(ctx, "mint", feeCoins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(ctx, "mint", types.ModuleName, feeCoins))
+	k.EscrowOutboundBridgeFee(ctx, route, senderAddr, 1, sdkmath.NewInt(100))
+
+	// Create mocks for other dependencies
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	transferKeeperMock := mocks.NewMockTransferKeeper(ctrl)
+	appMock := mocks.NewMockCallbacksCompatibleModule(ctrl)
+	channelKeeperMock := mocks.NewMockChannelKeeper(ctrl)
+	connectionKeeperMock := mocks.NewMockConnectionKeeper(ctrl)
+
+	// Create IBCModule
+	ibcModule := tokenwrapper.NewIBCModule(
+		k,
+		transferKeeperMock,
+		bankKeeper,
+		appMock,
+		channelKeeperMock,
+		connectionKeeperMock,
+	)
+
+	// Create packet
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    fixture.moduleDenom,
+		Amount:   fixture.amount,
+		Sender:   fixture.sender,
+		Receiver: fixture.receiver,
+	}
+	dataBz, err := transfertypes.ModuleCdc.MarshalJSON(&data)
+	require.NoError(t, err)
+
+	packet := channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         fixture.nativePort,
+		SourceChannel:      fixture.nativeChannel,
+		DestinationPort:    fixture.counterpartyPort,
+		DestinationChannel: fixture.counterpartyChannel,
+		Data:               dataBz,
+	}
+
+	// Set up channel mock
+	channelKeeperMock.EXPECT().
+		GetChannel(ctx, fixture.nativePort, fixture.nativeChannel).
+		Return(
+			channeltypes.Channel{
+				State:          channeltypes.OPEN,
+				ConnectionHops: []string{fixture.nativeConnectionId},
+				Counterparty: channeltypes.Counterparty{
+					PortId:    fixture.counterpartyPort,
+					ChannelId: fixture.counterpartyChannel,
+				},
+			}, true).
+		Times(1)
+
+	// Set up app mock
+	appMock.EXPECT().
+		OnTimeoutPacket(ctx, "ics20-1", packet, sdk.AccAddress{}).
+		Return(nil).
+		Times(1)
+
+	// Execute OnTimeoutPacket
+	err = ibcModule.OnTimeoutPacket(ctx, "ics20-1", packet, sdk.AccAddress{})
+	require.NoError(t, err)
+
+	// The escrowed bridge fee is returned to the sender
+	require.Equal(t, sdkmath.NewInt(100), bankKeeper.GetBalance(ctx, senderAddr, constants.BondDenom).Amount)
+	_, found := k.GetPendingBridgeFee(ctx, fixture.nativePort, fixture.nativeChannel, 1)
+	require.False(t, found)
 }
 
 func TestOnTimeoutPacket_MissingNativePortChannelDenom(t *testing.T) {
@@ -366,13 +516,18 @@ func TestOnTimeoutPacket_MissingNativePortChannelDenom(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper with empty nativePort
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, "")
-	k.SetNativeChannel(ctx, "")
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, "")
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           "",
+		NativeChannel:        "",
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                "",
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -450,13 +605,18 @@ func TestOnTimeoutPacket_MismatchedSourcePort(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -534,13 +694,18 @@ func TestOnTimeoutPacket_MismatchedSourceChannel(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -620,13 +785,18 @@ func TestOnTimeoutPacket_ZeroAmount(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -704,13 +874,18 @@ func TestOnTimeoutPacket_NegativeAmount(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -788,13 +963,18 @@ func TestOnTimeoutPacket_InvalidAmountFormat(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -872,13 +1052,18 @@ func TestOnTimeoutPacket_ChannelNotFound(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -948,13 +1133,18 @@ func TestOnTimeoutPacket_ChannelNotOpen(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1033,13 +1223,18 @@ func TestOnTimeoutPacket_InvalidSenderAddress(t *testing.T) {
 	k, ctx, bankKeeper := keepertest.TokenwrapperKeeperWithBank(t)
 
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1125,13 +1320,18 @@ func TestOnTimeoutPacket_PacketDenomNotModuleDenom(t *testing.T) {
 	k, ctx, bankKeeper := keepertest.TokenwrapperKeeperWithBank(t)
 
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.counterpartyPort)
-	k.SetCounterpartyChannel(ctx, fixture.counterpartyChannel)
-	k.SetDenom(ctx, fixture.moduleDenom) // Different from baseDenom
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		Denom:                fixture.moduleDenom,
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.counterpartyPort,
+		CounterpartyChannel:  fixture.counterpartyChannel,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	types "zigchain/x/tokenwrapper/types"
-	"zigchain/zutils/constants"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -44,22 +43,21 @@ func (w *ICS4Wrapper) SendPacket(
 		return 0, err
 	}
 
-	// Get module params
-	moduleNativePort := w.keeper.GetNativePort(ctx)
-	moduleNativeChannel := w.keeper.GetNativeChannel(ctx)
-	moduleDenom := w.keeper.GetDenom(ctx)
-
-	// If the denom is the constants.BondDenom, then override the denom to the module denom
-	if data.Denom == constants.BondDenom {
-		data.Denom = moduleDenom
+	// Resolve the bridge route of the source port and channel, if there is none, no wrapping is needed
+	route, found := w.keeper.GetBridgeRoute(ctx, sourcePort, sourceChannel)
+	if !found {
+		types.EmitTokenWrapperInfoEvent(ctx, "no bridge route for the source port and channel")
+		w.keeper.Logger().Info("no bridge route for the source port and channel")
+		return w.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData)
 	}
 
-	// If the module functionality is disabled and the denom, source port and source channel are the ibc settings then stop the packet from being processed
-	if !w.keeper.IsEnabled(ctx) &&
-		data.Denom == moduleDenom &&
-		sourcePort == moduleNativePort &&
-		sourceChannel == moduleNativeChannel {
+	// If the denom is the native denom of the route, then override the denom to the route denom
+	if data.Denom == route.NativeDenom {
+		data.Denom = route.Denom
+	}
 
+	// If the module functionality or the route is disabled and the denom is the route denom then stop the packet from being processed
+	if (!w.keeper.IsEnabled(ctx) || !route.Enabled) && data.Denom == route.Denom {
 		types.EmitTokenWrapperErrorEvent(ctx, fmt.Errorf("module functionality is not enabled"))
 		w.keeper.Logger().Error("module functionality is not enabled")
 		return 0, types.ErrModuleDisabled
@@ -72,24 +70,17 @@ func (w *ICS4Wrapper) SendPacket(
 		return w.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData)
 	}
 
-	// If any of the module params are not set, no wrapping is needed
-	if moduleNativePort == "" || moduleNativeChannel == "" || moduleDenom == "" {
-		types.EmitTokenWrapperInfoEvent(ctx, "module params are not set")
-		w.keeper.Logger().Info("module params are not set")
-		return w.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData)
-	}
-
-	// If the denom, native port and native channel don't match the module IBC settings, no wrapping is needed
-	if data.Denom != moduleDenom || sourcePort != moduleNativePort || sourceChannel != moduleNativeChannel {
-		types.EmitTokenWrapperInfoEvent(ctx, "denom, native port and native channel don't match the module IBC settings, no wrapping is needed")
-		w.keeper.Logger().Info("denom, native port and native channel don't match the module IBC settings, no wrapping is needed")
+	// If the denom doesn't match the route denom, no wrapping is needed
+	if data.Denom != route.Denom {
+		types.EmitTokenWrapperInfoEvent(ctx, "denom doesn't match the bridge route denom, no wrapping is needed")
+		w.keeper.Logger().Info("denom doesn't match the bridge route denom, no wrapping is needed")
 		return w.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData)
 	}
 
 	// User wants to send native tokens to Axelar
 
 	// Check if the counterparty channel matches the expected IBC settings, if not, return an error
-	if err := w.checkCounterypartyChannelMatchesIBCSettings(ctx, sourcePort, sourceChannel); err != nil {
+	if err := w.checkCounterypartyChannelMatchesIBCSettings(ctx, route, sourcePort, sourceChannel); err != nil {
 		err := fmt.Errorf("counterparty channel matches IBC settings failed: %v", err)
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		w.keeper.Logger().Error(err.Error())
@@ -97,7 +88,7 @@ func (w *ICS4Wrapper) SendPacket(
 	}
 
 	// Check if native and counterparty client ids are valid, if not, return an error
-	if err := w.validateConnectionClientId(ctx, sourcePort, sourceChannel); err != nil {
+	if err := w.validateConnectionClientId(ctx, route, sourcePort, sourceChannel); err != nil {
 		err := fmt.Errorf("client state validation failed: %v", err)
 		types.EmitTokenWrapperErrorEvent(ctx, err)
 		w.keeper.Logger().Error(err.Error())
@@ -139,7 +130,7 @@ func (w *ICS4Wrapper) SendPacket(
 	}
 
 	// Create native coins
-	nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, amount))
+	nativeCoins := sdk.NewCoins(sdk.NewCoin(route.NativeDenom, amount))
 
 	// Note: escrow is not needed since we're dealing with IBC tokens rather than native ones
 
@@ -162,16 +153,27 @@ func (w *ICS4Wrapper) SendPacket(
 	}
 
 	// Get the sender denom
-	sourcePrefix := transfertypes.NewHop(moduleNativePort, moduleNativeChannel)
+	sourcePrefix := transfertypes.NewHop(route.NativePort, route.NativeChannel)
 	prefixedDenom := fmt.Sprintf("%s/%s", sourcePrefix.String(), data.Denom)
-	sendDenom := transfertypes.ExtractDenomFromPath(prefixedDenom).IBCDenom()
+	sendDenom := route.IBCRecvDenom()
 
 	// module address
 	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
 
-	// Convert from 6 decimals to 18 decimals
-	// Multiply by conversion factor to convert from 6 decimals to 18 decimals
-	convertedAmount, err := w.keeper.ScaleUpTokenPrecision(ctx, amount)
+	// Take the bridge fee out of the amount before scaling it up, the fee stays locked in the module
+	// wallet along with the net amount until the packet is acknowledged or refunded
+	fee := w.keeper.GetBridgeFee(ctx, amount)
+	if fee.GTE(amount) {
+		err := fmt.Errorf("%w: fee %s, amount %s", types.ErrBridgeFeeExceedsAmount, fee, amount)
+		types.EmitTokenWrapperErrorEvent(ctx, err)
+		w.keeper.Logger().Error(err.Error())
+		return 0, err
+	}
+	netAmount := amount.Sub(fee)
+
+	// Convert from the native denom decimals to the IBC voucher decimals
+	// Multiply by the conversion factor of the route
+	convertedAmount, err := route.ScaleUpTokenPrecision(netAmount)
 
 	// Check if the converted amount is zero or negative
 	if err != nil {
@@ -197,6 +199,13 @@ func (w *ICS4Wrapper) SendPacket(
 		return 0, err
 	}
 
+	// Check and record the outbound volume of the route
+	if err := w.keeper.ConsumeOutboundVolume(ctx, route, netAmount); err != nil {
+		types.EmitTokenWrapperErrorEvent(ctx, err)
+		w.keeper.Logger().Error(fmt.Sprintf("outbound volume cap exceeded: %v", err))
+		return 0, err
+	}
+
 	// Modify the packet data by replacing the denom with an IBC denom and updating the amount
 	data.Denom = prefixedDenom
 	data.Amount = convertedAmount.String()
@@ -239,6 +248,23 @@ func (w *ICS4Wrapper) SendPacket(
 		return 0, err
 	}
 
+	// Escrow the bridge fee until the packet is acknowledged or timed out
+	w.keeper.EscrowOutboundBridgeFee(ctx, route, sender, sequence, fee)
+
+	// Record the transfer in the ledger until it is acknowledged or timed out
+	w.keeper.RecordTransfer(ctx, types.TransferRecord{
+		NativePort:    sourcePort,
+		NativeChannel: sourceChannel,
+		Sequence:      sequence,
+		Inbound:       false,
+		Sender:        sender.String(),
+		Receiver:      receiver,
+		IbcAmount:     convertedAmount,
+		NativeAmount:  netAmount,
+		Fee:           fee,
+		Status:        types.TRANSFER_STATUS_PENDING,
+	})
+
 	// Emit success event for wrapped token packet
 	types.EmitTokenWrapperPacketEvent(
 		ctx,
@@ -251,6 +277,7 @@ func (w *ICS4Wrapper) SendPacket(
 		sourceChannel,
 		"",
 		"",
+		sdk.NewCoin(route.NativeDenom, fee),
 	)
 
 	return sequence, nil
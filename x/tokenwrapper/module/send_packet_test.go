@@ -84,20 +84,26 @@ func TestSendPacket(t *testing.T) {
 					},
 				}, true).Times(1)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel)
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort)
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel)
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId)
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom)
+				route := types.BridgeRoute{
+					NativePort:           nativePort,
+					NativeChannel:        nativeChannel,
+					NativeClientId:       nativeClientId,
+					CounterpartyClientId: counterpartyClientId,
+					CounterpartyPort:     counterpartyPort,
+					CounterpartyChannel:  counterpartyChannel,
+					Denom:                moduleDenom,
+					DecimalDifference:    12,
+					NativeDenom:          constants.BondDenom,
+					Enabled:              true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().CheckAccountBalance(ctx, sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000)))).Return(nil)
 				keeperMock.EXPECT().CheckModuleBalance(ctx, sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000000000000000000)))).Return(nil)
+				keeperMock.EXPECT().ConsumeOutboundVolume(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				keeperMock.EXPECT().LockTokens(ctx, sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000)))).Return(nil)
 				keeperMock.EXPECT().BurnIbcTokens(ctx, sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000000000000000000)))).Return(nil)
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				ics4WrapperMock.EXPECT().SendPacket(ctx, nativePort, nativeChannel, clienttypes.Height{}, uint64(0), gomock.Any()).Return(uint64(1), nil)
-				keeperMock.EXPECT().ScaleUpTokenPrecision(ctx, sdkmath.NewInt(1000000)).Return(sdkmath.NewInt(1000000000000000000), nil)
 				escrowAddress := transfertypes.GetEscrowAddress(nativePort, nativeChannel)
 				token := sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000))
 				bankKeeperMock.EXPECT().SendCoins(ctx, escrowAddress, sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(token)).Return(nil)
@@ -155,9 +161,15 @@ func TestSendPacket(t *testing.T) {
 				channelKeeperMock.EXPECT().GetChannel(ctx, nativePort, nativeChannel).Return(channeltypes.Channel{
 					State: channeltypes.OPEN,
 				}, true)
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom)
+				route := types.BridgeRoute{
+					NativePort:        nativePort,
+					NativeChannel:     nativeChannel,
+					Denom:             moduleDenom,
+					DecimalDifference: 12,
+					NativeDenom:       constants.BondDenom,
+					Enabled:           true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().IsEnabled(ctx).Return(false)
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 			},
@@ -189,13 +201,19 @@ func TestSendPacket(t *testing.T) {
 				}, true).Times(1)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel)
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort)
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel)
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId)
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom)
+				route := types.BridgeRoute{
+					NativePort:           nativePort,
+					NativeChannel:        nativeChannel,
+					NativeClientId:       nativeClientId,
+					CounterpartyClientId: counterpartyClientId,
+					CounterpartyPort:     counterpartyPort,
+					CounterpartyChannel:  counterpartyChannel,
+					Denom:                moduleDenom,
+					DecimalDifference:    12,
+					NativeDenom:          constants.BondDenom,
+					Enabled:              true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 			},
 			expectError: true,
 		},
@@ -225,13 +243,19 @@ func TestSendPacket(t *testing.T) {
 				}, true).Times(1)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel)
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort)
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel)
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId)
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom)
+				route := types.BridgeRoute{
+					NativePort:           nativePort,
+					NativeChannel:        nativeChannel,
+					NativeClientId:       nativeClientId,
+					CounterpartyClientId: counterpartyClientId,
+					CounterpartyPort:     counterpartyPort,
+					CounterpartyChannel:  counterpartyChannel,
+					Denom:                moduleDenom,
+					DecimalDifference:    12,
+					NativeDenom:          constants.BondDenom,
+					Enabled:              true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 			},
 			expectError: true,
 		},
@@ -260,18 +284,24 @@ func TestSendPacket(t *testing.T) {
 					},
 				}, true).Times(1)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel)
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort)
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel)
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId)
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom)
+				route := types.BridgeRoute{
+					NativePort:           nativePort,
+					NativeChannel:        nativeChannel,
+					NativeClientId:       nativeClientId,
+					CounterpartyClientId: counterpartyClientId,
+					CounterpartyPort:     counterpartyPort,
+					CounterpartyChannel:  counterpartyChannel,
+					Denom:                moduleDenom,
+					DecimalDifference:    12,
+					NativeDenom:          constants.BondDenom,
+					Enabled:              true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().CheckAccountBalance(ctx, sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000)))).Return(nil)
 				keeperMock.EXPECT().CheckModuleBalance(ctx, sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000000000000000000)))).Return(nil)
+				keeperMock.EXPECT().ConsumeOutboundVolume(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				keeperMock.EXPECT().LockTokens(ctx, sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000)))).Return(fmt.Errorf("lock tokens failed"))
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-				keeperMock.EXPECT().ScaleUpTokenPrecision(ctx, sdkmath.NewInt(1000000)).Return(sdkmath.NewInt(1000000000000000000), nil)
 				escrowAddress := transfertypes.GetEscrowAddress(nativePort, nativeChannel)
 				token := sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000))
 				bankKeeperMock.EXPECT().SendCoins(ctx, escrowAddress, sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(token)).Return(nil)
@@ -308,20 +338,26 @@ func TestSendPacket(t *testing.T) {
 					},
 				}, true).Times(1)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel)
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort)
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel)
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId)
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom)
+				route := types.BridgeRoute{
+					NativePort:           nativePort,
+					NativeChannel:        nativeChannel,
+					NativeClientId:       nativeClientId,
+					CounterpartyClientId: counterpartyClientId,
+					CounterpartyPort:     counterpartyPort,
+					CounterpartyChannel:  counterpartyChannel,
+					Denom:                moduleDenom,
+					DecimalDifference:    12,
+					NativeDenom:          constants.BondDenom,
+					Enabled:              true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().CheckAccountBalance(ctx, sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000)))).Return(nil)
 				keeperMock.EXPECT().CheckModuleBalance(ctx, sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000000000000000000)))).Return(nil)
+				keeperMock.EXPECT().ConsumeOutboundVolume(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				keeperMock.EXPECT().LockTokens(ctx, sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000)))).Return(nil)
 				keeperMock.EXPECT().BurnIbcTokens(ctx, sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000000000000000000)))).Return(fmt.Errorf("burn tokens failed"))
 				keeperMock.EXPECT().UnlockTokens(ctx, sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000)))).Return(nil)
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-				keeperMock.EXPECT().ScaleUpTokenPrecision(ctx, sdkmath.NewInt(1000000)).Return(sdkmath.NewInt(1000000000000000000), nil)
 				escrowAddress := transfertypes.GetEscrowAddress(nativePort, nativeChannel)
 				token := sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000))
 				bankKeeperMock.EXPECT().SendCoins(ctx, escrowAddress, sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(token)).Return(nil)
@@ -358,19 +394,25 @@ func TestSendPacket(t *testing.T) {
 					},
 				}, true).Times(1)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel)
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort)
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel)
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId)
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom)
+				route := types.BridgeRoute{
+					NativePort:           nativePort,
+					NativeChannel:        nativeChannel,
+					NativeClientId:       nativeClientId,
+					CounterpartyClientId: counterpartyClientId,
+					CounterpartyPort:     counterpartyPort,
+					CounterpartyChannel:  counterpartyChannel,
+					Denom:                moduleDenom,
+					DecimalDifference:    12,
+					NativeDenom:          constants.BondDenom,
+					Enabled:              true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().CheckAccountBalance(ctx, sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000)))).Return(nil)
 				keeperMock.EXPECT().CheckModuleBalance(ctx, sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000000000000000000)))).Return(nil)
+				keeperMock.EXPECT().ConsumeOutboundVolume(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				keeperMock.EXPECT().LockTokens(ctx, sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000)))).Return(nil)
 				keeperMock.EXPECT().BurnIbcTokens(ctx, sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000000000000000000)))).Return(nil)
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-				keeperMock.EXPECT().ScaleUpTokenPrecision(ctx, sdkmath.NewInt(1000000)).Return(sdkmath.NewInt(1000000000000000000), nil)
 				ics4WrapperMock.EXPECT().SendPacket(ctx, nativePort, nativeChannel, clienttypes.Height{}, uint64(0), gomock.Any()).Return(uint64(0), fmt.Errorf("send packet failed"))
 				escrowAddress := transfertypes.GetEscrowAddress(nativePort, nativeChannel)
 				token := sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000))
@@ -408,9 +450,15 @@ func TestSendPacket(t *testing.T) {
 					},
 				}, true).Times(0)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom)
+				route := types.BridgeRoute{
+					NativePort:        nativePort,
+					NativeChannel:     nativeChannel,
+					Denom:             moduleDenom,
+					DecimalDifference: 12,
+					NativeDenom:       constants.BondDenom,
+					Enabled:           true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				ics4WrapperMock.EXPECT().SendPacket(ctx, nativePort, nativeChannel, clienttypes.Height{}, uint64(0), gomock.Any()).Return(uint64(1), nil)
 			},
@@ -418,7 +466,7 @@ func TestSendPacket(t *testing.T) {
 			expectError: false,
 		},
 		{
-			name:    "No wrapping - empty IBC settings",
+			name:    "No wrapping - no bridge route",
 			enabled: true,
 			packetData: transfertypes.FungibleTokenPacketData{
 				Denom:    constants.BondDenom,
@@ -431,9 +479,7 @@ func TestSendPacket(t *testing.T) {
 					State: channeltypes.OPEN,
 				}, true)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return("")
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return("")
-				keeperMock.EXPECT().GetDenom(ctx).Return("")
+				keeperMock.EXPECT().GetBridgeRoute(ctx, nativePort, nativeChannel).Return(types.BridgeRoute{}, false)
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				ics4WrapperMock.EXPECT().SendPacket(ctx, nativePort, nativeChannel, clienttypes.Height{}, uint64(0), gomock.Any()).Return(uint64(1), nil)
 			},
@@ -454,9 +500,15 @@ func TestSendPacket(t *testing.T) {
 					State: channeltypes.OPEN,
 				}, true)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom)
+				route := types.BridgeRoute{
+					NativePort:        nativePort,
+					NativeChannel:     nativeChannel,
+					Denom:             moduleDenom,
+					DecimalDifference: 12,
+					NativeDenom:       constants.BondDenom,
+					Enabled:           true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
 				ics4WrapperMock.EXPECT().SendPacket(ctx, nativePort, nativeChannel, clienttypes.Height{}, uint64(0), gomock.Any()).Return(uint64(1), nil)
 			},
@@ -488,21 +540,26 @@ func TestSendPacket(t *testing.T) {
 					},
 				}, true).Times(1)
 				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel)
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort)
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel)
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId)
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom)
+				route := types.BridgeRoute{
+					NativePort:           nativePort,
+					NativeChannel:        nativeChannel,
+					NativeClientId:       nativeClientId,
+					CounterpartyClientId: counterpartyClientId,
+					CounterpartyPort:     counterpartyPort,
+					CounterpartyChannel:  counterpartyChannel,
+					Denom:                moduleDenom,
+					DecimalDifference:    12,
+					NativeDenom:          constants.BondDenom,
+					Enabled:              true,
+				}
+				keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 				amount, _ := sdkmath.NewIntFromString("1000000000000")
 				keeperMock.EXPECT().CheckAccountBalance(ctx, sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(sdk.NewCoin(constants.BondDenom, amount))).Return(nil)
 				keeperMock.EXPECT().CheckModuleBalance(ctx, sdk.NewCoins(sdk.NewCoin(ibcDenom, amount.Mul(sdkmath.NewInt(1000000000000))))).Return(nil)
+				keeperMock.EXPECT().ConsumeOutboundVolume(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 				keeperMock.EXPECT().LockTokens(ctx, sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(sdk.NewCoin(constants.BondDenom, amount))).Return(nil)
 				keeperMock.EXPECT().BurnIbcTokens(ctx, sdk.NewCoins(sdk.NewCoin(ibcDenom, amount.Mul(sdkmath.NewInt(1000000000000))))).Return(nil)
 				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-				convertedAmount, _ := sdkmath.NewIntFromString("1000000000000000000000000")
-				keeperMock.EXPECT().ScaleUpTokenPrecision(ctx, amount).Return(convertedAmount, nil)
 				ics4WrapperMock.EXPECT().SendPacket(ctx, nativePort, nativeChannel, clienttypes.Height{}, uint64(0), gomock.Any()).Return(uint64(1), nil)
 				escrowAddress := transfertypes.GetEscrowAddress(nativePort, nativeChannel)
 				token := sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000000000))
@@ -516,96 +573,6 @@ func TestSendPacket(t *testing.T) {
 			expectedSeq: 1,
 			expectError: false,
 		},
-		{
-			name:    "Error - zero converted amount",
-			enabled: true,
-			packetData: transfertypes.FungibleTokenPacketData{
-				Denom:    constants.BondDenom,
-				Amount:   "1000000",
-				Sender:   sender,
-				Receiver: receiver,
-			},
-			setupMocks: func(ctx sdk.Context, keeperMock *mocks.MockTokenwrapperKeeper, transferKeeperMock *mocks.MockTransferKeeper, bankKeeperMock *mocks.MockBankKeeper, ics4WrapperMock *mocks.MockICS4Wrapper, channelKeeperMock *mocks.MockChannelKeeper, connectionKeeperMock *mocks.MockConnectionKeeper) {
-				channelKeeperMock.EXPECT().GetChannel(ctx, nativePort, nativeChannel).Return(channeltypes.Channel{
-					State:          channeltypes.OPEN,
-					ConnectionHops: []string{nativeConnectionId},
-					Counterparty: channeltypes.Counterparty{
-						PortId:    counterpartyPort,
-						ChannelId: counterpartyChannel,
-					},
-				}, true).Times(3)
-				connectionKeeperMock.EXPECT().GetConnection(ctx, nativeConnectionId).Return(connectiontypes.ConnectionEnd{
-					ClientId: nativeClientId,
-					Counterparty: connectiontypes.Counterparty{
-						ClientId: counterpartyClientId,
-					},
-				}, true).Times(1)
-				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel)
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort)
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel)
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId)
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom)
-				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-				escrowAddress := transfertypes.GetEscrowAddress(nativePort, nativeChannel)
-				token := sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000))
-				bankKeeperMock.EXPECT().SendCoins(ctx, escrowAddress, sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(token)).Return(nil)
-				keeperMock.EXPECT().ScaleUpTokenPrecision(ctx, sdkmath.NewInt(1000000)).Return(sdkmath.ZeroInt(), fmt.Errorf("converted amount is zero or negative: %s", sdkmath.ZeroInt().String()))
-				bankKeeperMock.EXPECT().HasBalance(ctx, sdk.MustAccAddressFromBech32(sender), token).Return(true)
-				currentTotalEscrow := sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000))
-				transferKeeperMock.EXPECT().GetTotalEscrowForDenom(ctx, constants.BondDenom).Return(currentTotalEscrow)
-				newTotalEscrow := currentTotalEscrow.Sub(token)
-				transferKeeperMock.EXPECT().SetTotalEscrowForDenom(ctx, newTotalEscrow).Return()
-			},
-			expectError: true,
-		},
-		{
-			name:    "Error - negative converted amount",
-			enabled: true,
-			packetData: transfertypes.FungibleTokenPacketData{
-				Denom:    constants.BondDenom,
-				Amount:   "1000000",
-				Sender:   sender,
-				Receiver: receiver,
-			},
-			setupMocks: func(ctx sdk.Context, keeperMock *mocks.MockTokenwrapperKeeper, transferKeeperMock *mocks.MockTransferKeeper, bankKeeperMock *mocks.MockBankKeeper, ics4WrapperMock *mocks.MockICS4Wrapper, channelKeeperMock *mocks.MockChannelKeeper, connectionKeeperMock *mocks.MockConnectionKeeper) {
-				channelKeeperMock.EXPECT().GetChannel(ctx, nativePort, nativeChannel).Return(channeltypes.Channel{
-					State:          channeltypes.OPEN,
-					ConnectionHops: []string{nativeConnectionId},
-					Counterparty: channeltypes.Counterparty{
-						PortId:    counterpartyPort,
-						ChannelId: counterpartyChannel,
-					},
-				}, true).Times(3)
-				connectionKeeperMock.EXPECT().GetConnection(ctx, nativeConnectionId).Return(connectiontypes.ConnectionEnd{
-					ClientId: nativeClientId,
-					Counterparty: connectiontypes.Counterparty{
-						ClientId: counterpartyClientId,
-					},
-				}, true).Times(1)
-				keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-				keeperMock.EXPECT().GetNativePort(ctx).Return(nativePort)
-				keeperMock.EXPECT().GetNativeChannel(ctx).Return(nativeChannel)
-				keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(counterpartyPort)
-				keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(counterpartyChannel)
-				keeperMock.EXPECT().GetNativeClientId(ctx).Return(nativeClientId)
-				keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(counterpartyClientId)
-				keeperMock.EXPECT().GetDenom(ctx).Return(moduleDenom)
-				keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-				escrowAddress := transfertypes.GetEscrowAddress(nativePort, nativeChannel)
-				keeperMock.EXPECT().ScaleUpTokenPrecision(ctx, sdkmath.NewInt(1000000)).Return(sdkmath.ZeroInt(), fmt.Errorf("converted amount is zero or negative: %s", sdkmath.ZeroInt().String()))
-				token := sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000))
-				bankKeeperMock.EXPECT().SendCoins(ctx, escrowAddress, sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(token)).Return(nil)
-				bankKeeperMock.EXPECT().HasBalance(ctx, sdk.MustAccAddressFromBech32(sender), token).Return(true)
-				currentTotalEscrow := sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000))
-				transferKeeperMock.EXPECT().GetTotalEscrowForDenom(ctx, constants.BondDenom).Return(currentTotalEscrow)
-				newTotalEscrow := currentTotalEscrow.Sub(token)
-				transferKeeperMock.EXPECT().SetTotalEscrowForDenom(ctx, newTotalEscrow).Return()
-			},
-			expectError: true,
-		},
 	}
 
 	for _, tt := range tests {
@@ -617,6 +584,14 @@ func TestSendPacket(t *testing.T) {
 
 			// Create fresh mocks for each test case
 			keeperMock := mocks.NewMockTokenwrapperKeeper(ctrl)
+			keeperMock.EXPECT().RecordTransfer(gomock.Any(), gomock.Any()).AnyTimes()
+			keeperMock.EXPECT().GetBridgeFee(gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt()).AnyTimes()
+			keeperMock.EXPECT().CollectInboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			keeperMock.EXPECT().EscrowOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			keeperMock.EXPECT().PayOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			keeperMock.EXPECT().RefundOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt(), nil).AnyTimes()
+			keeperMock.EXPECT().ReleaseOutboundVolume(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			keeperMock.EXPECT().UpdateTransferStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 			transferKeeperMock := mocks.NewMockTransferKeeper(ctrl)
 			bankKeeperMock := mocks.NewMockBankKeeper(ctrl)
 			ics4WrapperMock := mocks.NewMockICS4Wrapper(ctrl)
@@ -708,14 +683,19 @@ func TestSendPacket_RealKeepers(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.destinationPort)
-	k.SetCounterpartyChannel(ctx, fixture.destinationChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.destinationPort,
+		CounterpartyChannel:  fixture.destinationChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -782,7 +762,7 @@ func TestSendPacket_RealKeepers(t *testing.T) {
 	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, senderAddr, nativeCoins))
 
 	// Mint IBC tokens to module
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
+	conversionFactor := route.DecimalConversionFactor()
 	convertedAmount := amountInt.Mul(conversionFactor)
 	ibcCoins := sdk.NewCoins(sdk.NewCoin(fixture.ibcDenom, convertedAmount))
 	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, ibcCoins))
@@ -834,6 +814,168 @@ func TestSendPacket_RealKeepers(t *testing.T) {
 
 	moduleBalanceAfter := bankKeeper.GetBalance(ctx, moduleAddr, fixture.ibcDenom)
 	require.Equal(t, sdkmath.ZeroInt(), moduleBalanceAfter.Amount, "Module should have no IBC tokens left")
+
+	// The transfer is recorded as pending until it is acknowledged or timed out
+	record, found := k.GetTransferRecordByPacket(ctx, fixture.nativePort, fixture.nativeChannel, false, seq)
+	require.True(t, found)
+	require.Equal(t, types.TRANSFER_STATUS_PENDING, record.Status)
+	require.Equal(t, nativeCoins[0].Amount, record.NativeAmount)
+	require.Equal(t, nativeCoins[0].Amount.Mul(route.DecimalConversionFactor()), record.IbcAmount)
+}
+
+func TestSendPacket_RealKeepers_BridgeFee(t *testing.T) {
+	// Test case: SendPacket with real keepers takes the bridge fee before scaling up and escrows it in the module wallet
+
+	// set up positive fixture
+	fixture := getSendPacketPositiveFixture()
+
+	// Create the TokenwrapperKeeper with a real bank keeper
+	k, ctx, bankKeeper := keepertest.TokenwrapperKeeperWithBank(t)
+
+	// Set up the TokenwrapperKeeper
+	k.SetEnabled(ctx, true)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.destinationPort,
+		CounterpartyChannel:  fixture.destinationChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
+
+	// Charge a bridge fee of 1%
+	feeRecipient := sdk.AccAddress([]byte("bridge_fee_recipient"))
+	params := k.GetParams(ctx)
+	params.BridgeFeeBps = 100
+	params.BridgeFeeRecipient = feeRecipient.String()
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// Create mocks for other dependencies
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	transferKeeperMock := mocks.NewMockTransferKeeper(ctrl)
+	ics4WrapperMock := mocks.NewMockICS4Wrapper(ctrl)
+	channelKeeperMock := mocks.NewMockChannelKeeper(ctrl)
+	connectionKeeperMock := mocks.NewMockConnectionKeeper(ctrl)
+
+	// Create ICS4Wrapper instance
+	ics4Wrapper := tokenwrapper.NewICS4Wrapper(
+		ics4WrapperMock,
+		bankKeeper,
+		transferKeeperMock,
+		channelKeeperMock,
+		connectionKeeperMock,
+		k,
+	)
+
+	// Create the data and the packet
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    fixture.moduleDenom,
+		Amount:   fixture.amount,
+		Sender:   fixture.sender,
+		Receiver: fixture.receiver,
+	}
+	dataBz, err := transfertypes.ModuleCdc.MarshalJSON(&data)
+	require.NoError(t, err)
+
+	// Set up channel and connection mocks
+	channelKeeperMock.
+		EXPECT().
+		GetChannel(ctx, fixture.nativePort, fixture.nativeChannel).
+		Return(
+			channeltypes.Channel{
+				State:          channeltypes.OPEN,
+				ConnectionHops: []string{fixture.nativeConnectionId},
+				Counterparty: channeltypes.Counterparty{
+					PortId:    fixture.destinationPort,
+					ChannelId: fixture.destinationChannel,
+				},
+			}, true).
+		Times(3)
+
+	connectionKeeperMock.EXPECT().
+		GetConnection(ctx, fixture.nativeConnectionId).
+		Return(connectiontypes.ConnectionEnd{
+			ClientId: fixture.nativeClientId,
+			Counterparty: connectiontypes.Counterparty{
+				ClientId: fixture.counterpartyClientId,
+			},
+		}, true).
+		Times(1)
+
+	// Set up initial balances
+	senderAddr := sdk.MustAccAddressFromBech32(fixture.sender)
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	// Mint native tokens to sender
+	amountInt, _ := sdkmath.NewIntFromString(fixture.amount)
+	nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, amountInt))
+	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, nativeCoins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, senderAddr, nativeCoins))
+
+	// Mint IBC tokens to module
+	conversionFactor := route.DecimalConversionFactor()
+	convertedAmount := amountInt.Mul(conversionFactor)
+	ibcCoins := sdk.NewCoins(sdk.NewCoin(fixture.ibcDenom, convertedAmount))
+	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, ibcCoins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, ibcCoins))
+
+	// Check initial balances
+	senderBalanceBefore := bankKeeper.GetBalance(ctx, senderAddr, constants.BondDenom)
+	require.Equal(t, amountInt, senderBalanceBefore.Amount)
+
+	moduleBalanceBefore := bankKeeper.GetBalance(ctx, moduleAddr, fixture.ibcDenom)
+	require.Equal(t, convertedAmount, moduleBalanceBefore.Amount)
+
+	// Set up ICS4Wrapper mock for SendPacket
+	ics4WrapperMock.
+		EXPECT().
+		SendPacket(ctx, fixture.nativePort, fixture.nativeChannel, clienttypes.Height{}, uint64(0), gomock.Any()).
+		Return(uint64(1), nil)
+
+	// Mock unescrow token
+	currentTotalEscrow := sdk.NewCoin(constants.BondDenom, sdkmath.NewInt(1000000))
+	transferKeeperMock.EXPECT().GetTotalEscrowForDenom(ctx, constants.BondDenom).Return(currentTotalEscrow)
+	newTotalEscrow := currentTotalEscrow.Sub(nativeCoins[0])
+	transferKeeperMock.EXPECT().SetTotalEscrowForDenom(ctx, newTotalEscrow).Return()
+
+	// Apply the escrow logic (SendTransfer)
+	escrowAddress := transfertypes.GetEscrowAddress(fixture.nativePort, fixture.nativeChannel)
+	require.NoError(t, bankKeeper.SendCoins(ctx, senderAddr, escrowAddress, nativeCoins))
+
+	// check escrow and sender balances
+	escrowBalance := bankKeeper.GetBalance(ctx, escrowAddress, constants.BondDenom)
+	require.Equal(t, nativeCoins[0], escrowBalance)
+	senderBalancePostEscrow := bankKeeper.GetBalance(ctx, senderAddr, constants.BondDenom)
+	require.Equal(t, sdkmath.ZeroInt(), senderBalancePostEscrow.Amount)
+
+	// Execute SendPacket
+	seq, err := ics4Wrapper.SendPacket(ctx, fixture.nativePort, fixture.nativeChannel, clienttypes.Height{}, 0, dataBz)
+	require.NoError(t, err)
+
+	// Only the net amount is scaled up and burned, the fee stays locked in the module wallet
+	fee := amountInt.QuoRaw(100)
+	netAmount := amountInt.Sub(fee)
+	require.Equal(t, amountInt, bankKeeper.GetBalance(ctx, moduleAddr, constants.BondDenom).Amount)
+	require.Equal(t, fee.Mul(conversionFactor), bankKeeper.GetBalance(ctx, moduleAddr, fixture.ibcDenom).Amount)
+
+	pendingFee, found := k.GetPendingBridgeFee(ctx, fixture.nativePort, fixture.nativeChannel, seq)
+	require.True(t, found)
+	require.Equal(t, fee, pendingFee.Amount)
+	require.Equal(t, senderAddr.String(), pendingFee.Sender)
+	require.Equal(t, feeRecipient.String(), pendingFee.Recipient)
+
+	record, found := k.GetTransferRecordByPacket(ctx, fixture.nativePort, fixture.nativeChannel, false, seq)
+	require.True(t, found)
+	require.Equal(t, netAmount, record.NativeAmount)
+	require.Equal(t, fee, record.Fee)
+	require.Equal(t, netAmount.Mul(conversionFactor), record.IbcAmount)
 }
 
 func TestSendPacket_MismatchedPortChannel(t *testing.T) {
@@ -847,13 +989,18 @@ func TestSendPacket_MismatchedPortChannel(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper with different port/channel
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, "other-port")
-	k.SetNativeChannel(ctx, "other-channel")
-	k.SetCounterpartyPort(ctx, fixture.destinationPort)
-	k.SetCounterpartyChannel(ctx, fixture.destinationChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           "other-port",
+		NativeChannel:        "other-channel",
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.destinationPort,
+		CounterpartyChannel:  fixture.destinationChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -912,7 +1059,7 @@ func TestSendPacket_MismatchedPortChannel(t *testing.T) {
 	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, senderAddr, nativeCoins))
 
 	// Mint IBC tokens to module
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
+	conversionFactor := route.DecimalConversionFactor()
 	convertedAmount := amountInt.Mul(conversionFactor)
 	ibcCoins := sdk.NewCoins(sdk.NewCoin(fixture.ibcDenom, convertedAmount))
 	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, ibcCoins))
@@ -958,13 +1105,18 @@ func TestSendPacket_InvalidDenomFormat(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.destinationPort)
-	k.SetCounterpartyChannel(ctx, fixture.destinationChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.destinationPort,
+		CounterpartyChannel:  fixture.destinationChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1022,7 +1174,7 @@ func TestSendPacket_InvalidDenomFormat(t *testing.T) {
 	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, senderAddr, nativeCoins))
 
 	// Mint IBC tokens to module
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
+	conversionFactor := route.DecimalConversionFactor()
 	convertedAmount := amountInt.Mul(conversionFactor)
 	ibcCoins := sdk.NewCoins(sdk.NewCoin(fixture.ibcDenom, convertedAmount))
 	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, ibcCoins))
@@ -1068,6 +1220,14 @@ func TestSendPacket_InvalidPacketJSON(t *testing.T) {
 	ics4WrapperMock := mocks.NewMockICS4Wrapper(ctrl)
 	channelKeeperMock := mocks.NewMockChannelKeeper(ctrl)
 	keeperMock := mocks.NewMockTokenwrapperKeeper(ctrl)
+	keeperMock.EXPECT().RecordTransfer(gomock.Any(), gomock.Any()).AnyTimes()
+	keeperMock.EXPECT().GetBridgeFee(gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt()).AnyTimes()
+	keeperMock.EXPECT().CollectInboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	keeperMock.EXPECT().EscrowOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	keeperMock.EXPECT().PayOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	keeperMock.EXPECT().RefundOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt(), nil).AnyTimes()
+	keeperMock.EXPECT().ReleaseOutboundVolume(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	keeperMock.EXPECT().UpdateTransferStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	transferKeeperMock := mocks.NewMockTransferKeeper(ctrl)
 	bankKeeperMock := mocks.NewMockBankKeeper(ctrl)
 	connectionKeeperMock := mocks.NewMockConnectionKeeper(ctrl)
@@ -1113,14 +1273,19 @@ func TestSendPacket_InsufficientSenderBalance(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.destinationPort)
-	k.SetCounterpartyChannel(ctx, fixture.destinationChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.destinationPort,
+		CounterpartyChannel:  fixture.destinationChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1206,14 +1371,19 @@ func TestSendPacket_InsufficientModuleBalance(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.destinationPort)
-	k.SetCounterpartyChannel(ctx, fixture.destinationChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.destinationPort,
+		CounterpartyChannel:  fixture.destinationChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1282,7 +1452,7 @@ func TestSendPacket_InsufficientModuleBalance(t *testing.T) {
 
 	// Set up module with insufficient IBC balance
 	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
+	conversionFactor := route.DecimalConversionFactor()
 	convertedAmount := amountInt.Mul(conversionFactor)
 	ibcCoins := sdk.NewCoins(sdk.NewCoin(fixture.ibcDenom, convertedAmount.Sub(sdkmath.NewInt(0))))
 	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, ibcCoins))
@@ -1311,14 +1481,19 @@ func TestSendPacket_UnescrowTokenFailure(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.destinationPort)
-	k.SetCounterpartyChannel(ctx, fixture.destinationChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.destinationPort,
+		CounterpartyChannel:  fixture.destinationChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1404,14 +1579,19 @@ func TestSendPacket_ZeroAmount(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.destinationPort)
-	k.SetCounterpartyChannel(ctx, fixture.destinationChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.destinationPort,
+		CounterpartyChannel:  fixture.destinationChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1445,7 +1625,7 @@ func TestSendPacket_ZeroAmount(t *testing.T) {
 	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, senderAddr, nativeCoins))
 
 	// Mint IBC tokens to module
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
+	conversionFactor := route.DecimalConversionFactor()
 	convertedAmount := amountInt.Mul(conversionFactor)
 	ibcCoins := sdk.NewCoins(sdk.NewCoin(fixture.ibcDenom, convertedAmount))
 	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, ibcCoins))
@@ -1536,13 +1716,18 @@ func TestSendPacket_NewTokenFromZChain(t *testing.T) {
 
 			// Set up the TokenwrapperKeeper
 			k.SetEnabled(ctx, tt.enabled)
-			k.SetNativePort(ctx, fixture.nativePort)
-			k.SetNativeChannel(ctx, fixture.nativeChannel)
-			k.SetCounterpartyPort(ctx, fixture.destinationPort)
-			k.SetCounterpartyChannel(ctx, fixture.destinationChannel)
-			k.SetDenom(ctx, fixture.moduleDenom)
-			k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-			_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+			route := types.BridgeRoute{
+				NativePort:           fixture.nativePort,
+				NativeChannel:        fixture.nativeChannel,
+				CounterpartyClientId: fixture.counterpartyClientId,
+				CounterpartyPort:     fixture.destinationPort,
+				CounterpartyChannel:  fixture.destinationChannel,
+				Denom:                fixture.moduleDenom,
+				DecimalDifference:    fixture.decimalDifference,
+				NativeDenom:          constants.BondDenom,
+				Enabled:              true,
+			}
+			k.SetBridgeRoute(ctx, route)
 
 			// Create mocks for other dependencies
 			ctrl := gomock.NewController(t)
@@ -1607,7 +1792,7 @@ func TestSendPacket_NewTokenFromZChain(t *testing.T) {
 			require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, escrowAddr, zigCoins))
 
 			// Mint IBC tokens to module (for consistency)
-			conversionFactor := k.GetDecimalConversionFactor(ctx)
+			conversionFactor := route.DecimalConversionFactor()
 			convertedAmount := amountInt.Mul(conversionFactor)
 			ibcCoins := sdk.NewCoins(sdk.NewCoin(fixture.ibcDenom, convertedAmount))
 			require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, ibcCoins))
@@ -1679,13 +1864,18 @@ func TestSendPacket_ReturnIBCTokenToAxelar(t *testing.T) {
 
 			// Set up the TokenwrapperKeeper
 			k.SetEnabled(ctx, tt.enabled)
-			k.SetNativePort(ctx, fixture.nativePort)
-			k.SetNativeChannel(ctx, fixture.nativeChannel)
-			k.SetCounterpartyPort(ctx, fixture.destinationPort)
-			k.SetCounterpartyChannel(ctx, fixture.destinationChannel)
-			k.SetDenom(ctx, fixture.moduleDenom)
-			k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-			_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+			route := types.BridgeRoute{
+				NativePort:           fixture.nativePort,
+				NativeChannel:        fixture.nativeChannel,
+				CounterpartyClientId: fixture.counterpartyClientId,
+				CounterpartyPort:     fixture.destinationPort,
+				CounterpartyChannel:  fixture.destinationChannel,
+				Denom:                fixture.moduleDenom,
+				DecimalDifference:    fixture.decimalDifference,
+				NativeDenom:          constants.BondDenom,
+				Enabled:              true,
+			}
+			k.SetBridgeRoute(ctx, route)
 
 			// Create mocks for other dependencies
 			ctrl := gomock.NewController(t)
@@ -1750,7 +1940,7 @@ func TestSendPacket_ReturnIBCTokenToAxelar(t *testing.T) {
 			require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, escrowAddr, zigCoins))
 
 			// Mint module's IBC tokens (for consistency)
-			conversionFactor := k.GetDecimalConversionFactor(ctx)
+			conversionFactor := route.DecimalConversionFactor()
 			convertedAmount := amountInt.Mul(conversionFactor)
 			moduleIbcCoins := sdk.NewCoins(sdk.NewCoin(fixture.ibcDenom, convertedAmount))
 			require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, moduleIbcCoins))
@@ -1811,13 +2001,18 @@ func TestSendPacket_ChannelNotOpen(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper with different port/channel
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.destinationPort)
-	k.SetCounterpartyChannel(ctx, fixture.destinationChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.destinationPort,
+		CounterpartyChannel:  fixture.destinationChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -1888,13 +2083,18 @@ func TestSendPacket_SenderChainNotSourceChain(t *testing.T) {
 
 	// Set module settings
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.destinationPort)
-	k.SetCounterpartyChannel(ctx, fixture.destinationChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.destinationPort,
+		CounterpartyChannel:  fixture.destinationChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks
 	ctrl := gomock.NewController(t)
@@ -1973,14 +2173,19 @@ func TestSendPacket_EmptyReceiver(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper config
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.destinationPort)
-	k.SetCounterpartyChannel(ctx, fixture.destinationChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.destinationPort,
+		CounterpartyChannel:  fixture.destinationChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks
 	ctrl := gomock.NewController(t)
@@ -2056,261 +2261,6 @@ func TestSendPacket_EmptyReceiver(t *testing.T) {
 	require.Contains(t, string(events[0].Attributes[0].Value), "receiver address is empty")
 }
 
-func TestSendPacket_ZeroConvertedAmount(t *testing.T) {
-	// Test case: SendPacket with zero converted amount to verify error handling
-
-	// Set up positive fixture
-	fixture := getSendPacketPositiveFixture()
-
-	// Create real bank keeper
-	_, ctx, bankKeeper := keepertest.TokenwrapperKeeperWithBank(t)
-
-	// Create mocks for dependencies
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	keeperMock := mocks.NewMockTokenwrapperKeeper(ctrl)
-	transferKeeperMock := mocks.NewMockTransferKeeper(ctrl)
-	ics4WrapperMock := mocks.NewMockICS4Wrapper(ctrl)
-	channelKeeperMock := mocks.NewMockChannelKeeper(ctrl)
-	connectionKeeperMock := mocks.NewMockConnectionKeeper(ctrl)
-
-	// Create ICS4Wrapper instance
-	ics4Wrapper := tokenwrapper.NewICS4Wrapper(
-		ics4WrapperMock,
-		bankKeeper,
-		transferKeeperMock,
-		channelKeeperMock,
-		connectionKeeperMock,
-		keeperMock,
-	)
-
-	// Create packet data
-	data := transfertypes.FungibleTokenPacketData{
-		Denom:    constants.BondDenom,
-		Amount:   fixture.amount,
-		Sender:   fixture.sender,
-		Receiver: fixture.receiver,
-	}
-	dataBz, err := transfertypes.ModuleCdc.MarshalJSON(&data)
-	require.NoError(t, err)
-
-	// Set up channel mock
-	channelKeeperMock.EXPECT().
-		GetChannel(ctx, fixture.nativePort, fixture.nativeChannel).
-		Return(
-			channeltypes.Channel{
-				State:          channeltypes.OPEN,
-				ConnectionHops: []string{fixture.nativeConnectionId},
-				Counterparty: channeltypes.Counterparty{
-					PortId:    fixture.destinationPort,
-					ChannelId: fixture.destinationChannel,
-				},
-			}, true).
-		Times(3)
-
-	connectionKeeperMock.EXPECT().
-		GetConnection(ctx, fixture.nativeConnectionId).
-		Return(connectiontypes.ConnectionEnd{
-			ClientId: fixture.nativeClientId,
-			Counterparty: connectiontypes.Counterparty{
-				ClientId: fixture.counterpartyClientId,
-			},
-		}, true).
-		Times(1)
-
-	// Mock tokenwrapper keeper methods
-	keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-	keeperMock.EXPECT().GetNativePort(ctx).Return(fixture.nativePort).AnyTimes()
-	keeperMock.EXPECT().GetNativeChannel(ctx).Return(fixture.nativeChannel).AnyTimes()
-	keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(fixture.destinationPort).AnyTimes()
-	keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(fixture.destinationChannel).AnyTimes()
-	keeperMock.EXPECT().GetNativeClientId(ctx).Return(fixture.nativeClientId).AnyTimes()
-	keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(fixture.counterpartyClientId).AnyTimes()
-	keeperMock.EXPECT().GetDenom(ctx).Return(fixture.moduleDenom).AnyTimes()
-	keeperMock.EXPECT().GetDecimalConversionFactor(ctx).Return(sdkmath.ZeroInt()).AnyTimes()
-	keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-	convertedAmount, _ := sdkmath.NewIntFromString("0")
-	keeperMock.EXPECT().ScaleUpTokenPrecision(ctx, sdkmath.NewInt(1000000)).Return(convertedAmount, fmt.Errorf("converted amount is zero or negative: %s", convertedAmount.String()))
-
-	// Set up escrow address
-	escrowAddr := transfertypes.GetEscrowAddress(fixture.nativePort, fixture.nativeChannel)
-	senderAddr := sdk.MustAccAddressFromBech32(fixture.sender)
-
-	// Parse amount
-	amountInt, ok := sdkmath.NewIntFromString(fixture.amount)
-	require.True(t, ok)
-	nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, amountInt))
-
-	// Mint native tokens to escrow (simulate escrowed tokens)
-	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, nativeCoins))
-	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, escrowAddr, nativeCoins))
-
-	// Check initial balances
-	senderBalanceBefore := bankKeeper.GetBalance(ctx, senderAddr, constants.BondDenom)
-	require.Equal(t, sdkmath.ZeroInt(), senderBalanceBefore.Amount)
-	escrowBalanceBefore := bankKeeper.GetBalance(ctx, escrowAddr, constants.BondDenom)
-	require.Equal(t, amountInt, escrowBalanceBefore.Amount)
-
-	// Mock transfer keeper for unescrow
-	currentTotalEscrow := sdk.NewCoin(constants.BondDenom, amountInt)
-	transferKeeperMock.EXPECT().GetTotalEscrowForDenom(ctx, constants.BondDenom).Return(currentTotalEscrow).Times(1)
-	newTotalEscrow := currentTotalEscrow.Sub(nativeCoins[0])
-	transferKeeperMock.EXPECT().SetTotalEscrowForDenom(ctx, newTotalEscrow).Times(1)
-
-	// Execute SendPacket
-	_, err = ics4Wrapper.SendPacket(ctx, fixture.nativePort, fixture.nativeChannel, clienttypes.Height{}, 0, dataBz)
-
-	// Expect error due to zero converted amount
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "converted amount is zero or negative: 0")
-
-	// Check balances after (unescrow happened, sender has tokens, escrow empty)
-	senderBalanceAfter := bankKeeper.GetBalance(ctx, senderAddr, constants.BondDenom)
-	require.Equal(t, amountInt, senderBalanceAfter.Amount)
-	escrowBalanceAfter := bankKeeper.GetBalance(ctx, escrowAddr, constants.BondDenom)
-	require.Equal(t, sdkmath.ZeroInt(), escrowBalanceAfter.Amount)
-
-	// Verify error event emitted
-	events := ctx.EventManager().Events()
-	foundErrorEvent := false
-	for _, event := range events {
-		if event.Type == "tokenwrapper_error" {
-			foundErrorEvent = true
-			require.Len(t, event.Attributes, 1)
-			require.Contains(t, string(event.Attributes[0].Value), "converted amount is zero or negative: 0")
-		}
-	}
-	require.True(t, foundErrorEvent, "tokenwrapper_error event not emitted")
-}
-
-func TestSendPacket_NegativeConvertedAmount(t *testing.T) {
-	// Test case: SendPacket with negative converted amount to verify error handling
-
-	// Set up positive fixture
-	fixture := getSendPacketPositiveFixture()
-
-	// Create real bank keeper
-	_, ctx, bankKeeper := keepertest.TokenwrapperKeeperWithBank(t)
-
-	// Create mocks for dependencies
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	keeperMock := mocks.NewMockTokenwrapperKeeper(ctrl)
-	transferKeeperMock := mocks.NewMockTransferKeeper(ctrl)
-	ics4WrapperMock := mocks.NewMockICS4Wrapper(ctrl)
-	channelKeeperMock := mocks.NewMockChannelKeeper(ctrl)
-	connectionKeeperMock := mocks.NewMockConnectionKeeper(ctrl)
-
-	// Create ICS4Wrapper instance
-	ics4Wrapper := tokenwrapper.NewICS4Wrapper(
-		ics4WrapperMock,
-		bankKeeper,
-		transferKeeperMock,
-		channelKeeperMock,
-		connectionKeeperMock,
-		keeperMock,
-	)
-
-	// Create packet data
-	data := transfertypes.FungibleTokenPacketData{
-		Denom:    constants.BondDenom,
-		Amount:   fixture.amount,
-		Sender:   fixture.sender,
-		Receiver: fixture.receiver,
-	}
-	dataBz, err := transfertypes.ModuleCdc.MarshalJSON(&data)
-	require.NoError(t, err)
-
-	// Set up channel mock
-	channelKeeperMock.EXPECT().
-		GetChannel(ctx, fixture.nativePort, fixture.nativeChannel).
-		Return(
-			channeltypes.Channel{
-				State:          channeltypes.OPEN,
-				ConnectionHops: []string{fixture.nativeConnectionId},
-				Counterparty: channeltypes.Counterparty{
-					PortId:    fixture.destinationPort,
-					ChannelId: fixture.destinationChannel,
-				},
-			}, true).
-		Times(3)
-
-	connectionKeeperMock.EXPECT().
-		GetConnection(ctx, fixture.nativeConnectionId).
-		Return(connectiontypes.ConnectionEnd{
-			ClientId: fixture.nativeClientId,
-			Counterparty: connectiontypes.Counterparty{
-				ClientId: fixture.counterpartyClientId,
-			},
-		}, true).
-		Times(1)
-
-	// Mock tokenwrapper keeper methods
-	keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-	keeperMock.EXPECT().GetNativePort(ctx).Return(fixture.nativePort).AnyTimes()
-	keeperMock.EXPECT().GetNativeChannel(ctx).Return(fixture.nativeChannel).AnyTimes()
-	keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(fixture.destinationPort).AnyTimes()
-	keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(fixture.destinationChannel).AnyTimes()
-	keeperMock.EXPECT().GetNativeClientId(ctx).Return(fixture.nativeClientId).AnyTimes()
-	keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(fixture.counterpartyClientId).AnyTimes()
-	keeperMock.EXPECT().GetDenom(ctx).Return(fixture.moduleDenom).AnyTimes()
-	keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-	negativeConvertedAmount, _ := sdkmath.NewIntFromString("-1000000000000000000")
-	keeperMock.EXPECT().ScaleUpTokenPrecision(ctx, sdkmath.NewInt(1000000)).Return(negativeConvertedAmount, fmt.Errorf("converted amount is zero or negative: %s", negativeConvertedAmount.String()))
-
-	// Set up escrow address
-	escrowAddr := transfertypes.GetEscrowAddress(fixture.nativePort, fixture.nativeChannel)
-	senderAddr := sdk.MustAccAddressFromBech32(fixture.sender)
-
-	// Parse amount
-	amountInt, ok := sdkmath.NewIntFromString(fixture.amount)
-	require.True(t, ok)
-	nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, amountInt))
-
-	// Mint native tokens to escrow (simulate escrowed tokens)
-	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, nativeCoins))
-	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, escrowAddr, nativeCoins))
-
-	// Check initial balances
-	senderBalanceBefore := bankKeeper.GetBalance(ctx, senderAddr, constants.BondDenom)
-	require.Equal(t, sdkmath.ZeroInt(), senderBalanceBefore.Amount)
-	escrowBalanceBefore := bankKeeper.GetBalance(ctx, escrowAddr, constants.BondDenom)
-	require.Equal(t, amountInt, escrowBalanceBefore.Amount)
-
-	// Mock transfer keeper for unescrow
-	currentTotalEscrow := sdk.NewCoin(constants.BondDenom, amountInt)
-	transferKeeperMock.EXPECT().GetTotalEscrowForDenom(ctx, constants.BondDenom).Return(currentTotalEscrow).Times(1)
-	newTotalEscrow := currentTotalEscrow.Sub(nativeCoins[0])
-	transferKeeperMock.EXPECT().SetTotalEscrowForDenom(ctx, newTotalEscrow).Times(1)
-
-	// Execute SendPacket
-	_, err = ics4Wrapper.SendPacket(ctx, fixture.nativePort, fixture.nativeChannel, clienttypes.Height{}, 0, dataBz)
-
-	// Expect error due to negative converted amount
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "converted amount is zero or negative: -1000000000000000000")
-
-	// Check balances after (unescrow happened, sender has tokens, escrow empty)
-	senderBalanceAfter := bankKeeper.GetBalance(ctx, senderAddr, constants.BondDenom)
-	require.Equal(t, amountInt, senderBalanceAfter.Amount)
-	escrowBalanceAfter := bankKeeper.GetBalance(ctx, escrowAddr, constants.BondDenom)
-	require.Equal(t, sdkmath.ZeroInt(), escrowBalanceAfter.Amount)
-
-	// Verify error event emitted
-	events := ctx.EventManager().Events()
-	foundErrorEvent := false
-	for _, event := range events {
-		if event.Type == "tokenwrapper_error" {
-			foundErrorEvent = true
-			require.Len(t, event.Attributes, 1)
-			require.Contains(t, string(event.Attributes[0].Value), "converted amount is zero or negative: -1000000000000000000")
-		}
-	}
-	require.True(t, foundErrorEvent, "tokenwrapper_error event not emitted")
-}
-
 func TestSendPacket_BurnFailureUnlockSuccess(t *testing.T) {
 	// Test case: SendPacket where burn fails but unlock succeeds, verifying rollback attempt and error message
 
@@ -2325,6 +2275,16 @@ func TestSendPacket_BurnFailureUnlockSuccess(t *testing.T) {
 	defer ctrl.Finish()
 
 	keeperMock := mocks.NewMockTokenwrapperKeeper(ctrl)
+
+	keeperMock.EXPECT().RecordTransfer(gomock.Any(), gomock.Any()).AnyTimes()
+	keeperMock.EXPECT().GetBridgeFee(gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt()).AnyTimes()
+	keeperMock.EXPECT().CollectInboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	keeperMock.EXPECT().EscrowOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	keeperMock.EXPECT().PayOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	keeperMock.EXPECT().RefundOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt(), nil).AnyTimes()
+	keeperMock.EXPECT().ReleaseOutboundVolume(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	keeperMock.EXPECT().UpdateTransferStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	transferKeeperMock := mocks.NewMockTransferKeeper(ctrl)
 	ics4WrapperMock := mocks.NewMockICS4Wrapper(ctrl)
 	channelKeeperMock := mocks.NewMockChannelKeeper(ctrl)
@@ -2376,20 +2336,26 @@ func TestSendPacket_BurnFailureUnlockSuccess(t *testing.T) {
 
 	// Mock keeper methods for happy path up to burn
 	keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-	keeperMock.EXPECT().GetNativePort(ctx).Return(fixture.nativePort).AnyTimes()
-	keeperMock.EXPECT().GetNativeChannel(ctx).Return(fixture.nativeChannel).AnyTimes()
-	keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(fixture.destinationPort).AnyTimes()
-	keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(fixture.destinationChannel).AnyTimes()
-	keeperMock.EXPECT().GetNativeClientId(ctx).Return(fixture.nativeClientId).AnyTimes()
-	keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(fixture.counterpartyClientId).AnyTimes()
-	keeperMock.EXPECT().GetDenom(ctx).Return(fixture.moduleDenom).AnyTimes()
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.destinationPort,
+		CounterpartyChannel:  fixture.destinationChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    12,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 	keeperMock.EXPECT().CheckAccountBalance(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	keeperMock.EXPECT().CheckModuleBalance(ctx, gomock.Any()).Return(nil).AnyTimes()
+	keeperMock.EXPECT().ConsumeOutboundVolume(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	keeperMock.EXPECT().LockTokens(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	keeperMock.EXPECT().BurnIbcTokens(ctx, gomock.Any()).Return(fmt.Errorf("burn tokens failed")).Times(1)
 	keeperMock.EXPECT().UnlockTokens(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-	keeperMock.EXPECT().ScaleUpTokenPrecision(ctx, sdkmath.NewInt(1000000)).Return(sdkmath.NewInt(1000000000000000000), nil)
 
 	// Set up for unescrow
 	escrowAddr := transfertypes.GetEscrowAddress(fixture.nativePort, fixture.nativeChannel)
@@ -2439,6 +2405,16 @@ func TestSendPacket_BurnFailureUnlockFailure(t *testing.T) {
 	defer ctrl.Finish()
 
 	keeperMock := mocks.NewMockTokenwrapperKeeper(ctrl)
+
+	keeperMock.EXPECT().RecordTransfer(gomock.Any(), gomock.Any()).AnyTimes()
+	keeperMock.EXPECT().GetBridgeFee(gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt()).AnyTimes()
+	keeperMock.EXPECT().CollectInboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	keeperMock.EXPECT().EscrowOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	keeperMock.EXPECT().PayOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	keeperMock.EXPECT().RefundOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt(), nil).AnyTimes()
+	keeperMock.EXPECT().ReleaseOutboundVolume(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	keeperMock.EXPECT().UpdateTransferStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	transferKeeperMock := mocks.NewMockTransferKeeper(ctrl)
 	ics4WrapperMock := mocks.NewMockICS4Wrapper(ctrl)
 	channelKeeperMock := mocks.NewMockChannelKeeper(ctrl)
@@ -2490,21 +2466,26 @@ func TestSendPacket_BurnFailureUnlockFailure(t *testing.T) {
 
 	// Mock keeper methods for happy path up to burn
 	keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-	keeperMock.EXPECT().GetNativePort(ctx).Return(fixture.nativePort).AnyTimes()
-	keeperMock.EXPECT().GetNativeChannel(ctx).Return(fixture.nativeChannel).AnyTimes()
-	keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(fixture.destinationPort).AnyTimes()
-	keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(fixture.destinationChannel).AnyTimes()
-	keeperMock.EXPECT().GetNativeClientId(ctx).Return(fixture.nativeClientId).AnyTimes()
-	keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(fixture.counterpartyClientId).AnyTimes()
-	keeperMock.EXPECT().GetDenom(ctx).Return(fixture.moduleDenom).AnyTimes()
-	keeperMock.EXPECT().GetDecimalConversionFactor(ctx).Return(sdkmath.NewInt(1000000000000)).AnyTimes()
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.destinationPort,
+		CounterpartyChannel:  fixture.destinationChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    12,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 	keeperMock.EXPECT().CheckAccountBalance(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	keeperMock.EXPECT().CheckModuleBalance(ctx, gomock.Any()).Return(nil).AnyTimes()
+	keeperMock.EXPECT().ConsumeOutboundVolume(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	keeperMock.EXPECT().LockTokens(ctx, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	keeperMock.EXPECT().BurnIbcTokens(ctx, gomock.Any()).Return(fmt.Errorf("burn tokens failed")).Times(1)
 	keeperMock.EXPECT().UnlockTokens(ctx, gomock.Any(), gomock.Any()).Return(fmt.Errorf("unlock tokens failed")).Times(1)
 	keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-	keeperMock.EXPECT().ScaleUpTokenPrecision(ctx, sdkmath.NewInt(1000000)).Return(sdkmath.NewInt(1000000000000000000), nil)
 
 	// Set up for unescrow
 	escrowAddr := transfertypes.GetEscrowAddress(fixture.nativePort, fixture.nativeChannel)
@@ -2555,6 +2536,16 @@ func TestSendPacket_InsufficientSenderBalanceForLock(t *testing.T) {
 	defer ctrl.Finish()
 
 	keeperMock := mocks.NewMockTokenwrapperKeeper(ctrl)
+
+	keeperMock.EXPECT().RecordTransfer(gomock.Any(), gomock.Any()).AnyTimes()
+	keeperMock.EXPECT().GetBridgeFee(gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt()).AnyTimes()
+	keeperMock.EXPECT().CollectInboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	keeperMock.EXPECT().EscrowOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	keeperMock.EXPECT().PayOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	keeperMock.EXPECT().RefundOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt(), nil).AnyTimes()
+	keeperMock.EXPECT().ReleaseOutboundVolume(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	keeperMock.EXPECT().UpdateTransferStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	transferKeeperMock := mocks.NewMockTransferKeeper(ctrl)
 	ics4WrapperMock := mocks.NewMockICS4Wrapper(ctrl)
 	channelKeeperMock := mocks.NewMockChannelKeeper(ctrl)
@@ -2606,17 +2597,21 @@ func TestSendPacket_InsufficientSenderBalanceForLock(t *testing.T) {
 
 	// Mock keeper methods to pass up to CheckAccountBalance, then fail it
 	keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-	keeperMock.EXPECT().GetNativePort(ctx).Return(fixture.nativePort).AnyTimes()
-	keeperMock.EXPECT().GetNativeChannel(ctx).Return(fixture.nativeChannel).AnyTimes()
-	keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(fixture.destinationPort).AnyTimes()
-	keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(fixture.destinationChannel).AnyTimes()
-	keeperMock.EXPECT().GetNativeClientId(ctx).Return(fixture.nativeClientId).AnyTimes()
-	keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(fixture.counterpartyClientId).AnyTimes()
-	keeperMock.EXPECT().GetDenom(ctx).Return(fixture.moduleDenom).AnyTimes()
-	keeperMock.EXPECT().GetDecimalConversionFactor(ctx).Return(sdkmath.NewInt(1000000000000)).AnyTimes()
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.destinationPort,
+		CounterpartyChannel:  fixture.destinationChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    12,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 	keeperMock.EXPECT().CheckAccountBalance(ctx, gomock.Any(), gomock.Any()).Return(fmt.Errorf("insufficient balance for lock")).Times(1)
 	keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-	keeperMock.EXPECT().ScaleUpTokenPrecision(ctx, sdkmath.NewInt(1000000)).Return(sdkmath.NewInt(1000000000000000000), nil)
 
 	// Set up escrow address
 	escrowAddr := transfertypes.GetEscrowAddress(fixture.nativePort, fixture.nativeChannel)
@@ -2680,14 +2675,19 @@ func TestSendPacket_InsufficientModuleBalanceForBurn(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.destinationPort)
-	k.SetCounterpartyChannel(ctx, fixture.destinationChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.destinationPort,
+		CounterpartyChannel:  fixture.destinationChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -2754,7 +2754,7 @@ func TestSendPacket_InsufficientModuleBalanceForBurn(t *testing.T) {
 	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, senderAddr, nativeCoins))
 
 	// Mint insufficient IBC tokens to module (one less than required)
-	conversionFactor := k.GetDecimalConversionFactor(ctx)
+	conversionFactor := route.DecimalConversionFactor()
 	convertedAmount := amountInt.Mul(conversionFactor)
 	insufficientIBC := convertedAmount.Sub(sdkmath.NewInt(1))
 	ibcCoins := sdk.NewCoins(sdk.NewCoin(fixture.ibcDenom, insufficientIBC))
@@ -2826,14 +2826,19 @@ func TestSendPacket_CounterpartyChannelMismatch_EmitsErrorEvent(t *testing.T) {
 
 	// Set up the TokenwrapperKeeper with specific IBC settings
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, "different-port")       // This will cause mismatch
-	k.SetCounterpartyChannel(ctx, "different-channel") // This will cause mismatch
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		CounterpartyPort:     "different-port",
+		CounterpartyChannel:  "different-channel",
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -2942,14 +2947,19 @@ func TestSendPacket_ConnectionClientIdValidationFails_EmitsErrorEvent(t *testing
 
 	// Set up the TokenwrapperKeeper with specific client ID expectations
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.destinationPort)
-	k.SetCounterpartyChannel(ctx, fixture.destinationChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, "expected-native-client")             // This will cause mismatch
-	k.SetCounterpartyClientId(ctx, "expected-counterparty-client") // This will cause mismatch
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativeClientId:       "expected-native-client",
+		CounterpartyClientId: "expected-counterparty-client",
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		CounterpartyPort:     fixture.destinationPort,
+		CounterpartyChannel:  fixture.destinationChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -3077,14 +3087,19 @@ func TestSendPacket_SenderMissingUnescrowedTokens_EmitsErrorEvent(t *testing.T)
 
 	// Set up the TokenwrapperKeeper
 	k.SetEnabled(ctx, true)
-	k.SetNativePort(ctx, fixture.nativePort)
-	k.SetNativeChannel(ctx, fixture.nativeChannel)
-	k.SetCounterpartyPort(ctx, fixture.destinationPort)
-	k.SetCounterpartyChannel(ctx, fixture.destinationChannel)
-	k.SetDenom(ctx, fixture.moduleDenom)
-	k.SetNativeClientId(ctx, fixture.nativeClientId)
-	k.SetCounterpartyClientId(ctx, fixture.counterpartyClientId)
-	_ = k.SetDecimalDifference(ctx, fixture.decimalDifference)
+	route := types.BridgeRoute{
+		NativePort:           fixture.nativePort,
+		NativeChannel:        fixture.nativeChannel,
+		NativeClientId:       fixture.nativeClientId,
+		CounterpartyClientId: fixture.counterpartyClientId,
+		CounterpartyPort:     fixture.destinationPort,
+		CounterpartyChannel:  fixture.destinationChannel,
+		Denom:                fixture.moduleDenom,
+		DecimalDifference:    fixture.decimalDifference,
+		NativeDenom:          constants.BondDenom,
+		Enabled:              true,
+	}
+	k.SetBridgeRoute(ctx, route)
 
 	// Create mocks for other dependencies
 	ctrl := gomock.NewController(t)
@@ -3133,6 +3148,14 @@ func TestSendPacket_SenderMissingUnescrowedTokens_EmitsErrorEvent(t *testing.T)
 	// Mock keeper methods for the happy path up to the balance check
 	k.Logger()
 	keeperMock := mocks.NewMockTokenwrapperKeeper(ctrl)
+	keeperMock.EXPECT().RecordTransfer(gomock.Any(), gomock.Any()).AnyTimes()
+	keeperMock.EXPECT().GetBridgeFee(gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt()).AnyTimes()
+	keeperMock.EXPECT().CollectInboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	keeperMock.EXPECT().EscrowOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	keeperMock.EXPECT().PayOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	keeperMock.EXPECT().RefundOutboundBridgeFee(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(sdkmath.ZeroInt(), nil).AnyTimes()
+	keeperMock.EXPECT().ReleaseOutboundVolume(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	keeperMock.EXPECT().UpdateTransferStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	// Replace the keeper in ICS4Wrapper with our mock for this specific test
 	ics4WrapperWithMockKeeper := tokenwrapper.NewICS4Wrapper(
 		ics4WrapperMock,
@@ -3145,15 +3168,8 @@ func TestSendPacket_SenderMissingUnescrowedTokens_EmitsErrorEvent(t *testing.T)
 
 	// Mock keeper methods
 	keeperMock.EXPECT().IsEnabled(ctx).Return(true).AnyTimes()
-	keeperMock.EXPECT().GetNativePort(ctx).Return(fixture.nativePort).AnyTimes()
-	keeperMock.EXPECT().GetNativeChannel(ctx).Return(fixture.nativeChannel).AnyTimes()
-	keeperMock.EXPECT().GetCounterpartyPort(ctx).Return(fixture.destinationPort).AnyTimes()
-	keeperMock.EXPECT().GetCounterpartyChannel(ctx).Return(fixture.destinationChannel).AnyTimes()
-	keeperMock.EXPECT().GetNativeClientId(ctx).Return(fixture.nativeClientId).AnyTimes()
-	keeperMock.EXPECT().GetCounterpartyClientId(ctx).Return(fixture.counterpartyClientId).AnyTimes()
-	keeperMock.EXPECT().GetDenom(ctx).Return(fixture.moduleDenom).AnyTimes()
+	keeperMock.EXPECT().GetBridgeRoute(ctx, gomock.Any(), gomock.Any()).Return(route, true).AnyTimes()
 	keeperMock.EXPECT().Logger().Return(log.NewNopLogger()).AnyTimes()
-	keeperMock.EXPECT().ScaleUpTokenPrecision(ctx, gomock.Any()).Return(sdkmath.NewInt(1000000000000000000), nil).AnyTimes()
 
 	// Set up sender address
 	senderAddr := sdk.MustAccAddressFromBech32(fixture.sender)
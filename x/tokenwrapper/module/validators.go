@@ -35,11 +35,11 @@ func (w ICS4Wrapper) validateChannel(ctx sdk.Context, portID, channelID string)
 }
 
 // checkCounterypartyChannelMatchesIBCSettings checks if the counterparty channel matches the expected IBC settings
-func (im IBCModule) checkCounterypartyChannelMatchesIBCSettings(ctx sdk.Context, portID, channelID string) error {
-	// Get the expected counterparty port ID from the module keeper
-	expectedCounterpartyPortId := im.keeper.GetCounterpartyPort(ctx)
-	// Get the expected counterparty channel ID from the module keeper
-	expectedCounterpartyChannelId := im.keeper.GetCounterpartyChannel(ctx)
+func (im IBCModule) checkCounterypartyChannelMatchesIBCSettings(ctx sdk.Context, route types.BridgeRoute, portID, channelID string) error {
+	// Get the expected counterparty port ID from the bridge route
+	expectedCounterpartyPortId := route.CounterpartyPort
+	// Get the expected counterparty channel ID from the bridge route
+	expectedCounterpartyChannelId := route.CounterpartyChannel
 
 	// If the expected counterparty port ID is not set, return an error
 	if expectedCounterpartyPortId == "" {
@@ -67,11 +67,11 @@ func (im IBCModule) checkCounterypartyChannelMatchesIBCSettings(ctx sdk.Context,
 }
 
 // checkCounterypartyChannelMatchesIBCSettings checks if the counterparty channel matches the expected IBC settings
-func (w ICS4Wrapper) checkCounterypartyChannelMatchesIBCSettings(ctx sdk.Context, portID, channelID string) error {
-	// Get the expected counterparty port ID from the module keeper
-	expectedCounterpartyPortId := w.keeper.GetCounterpartyPort(ctx)
-	// Get the expected counterparty channel ID from the module keeper
-	expectedCounterpartyChannelId := w.keeper.GetCounterpartyChannel(ctx)
+func (w ICS4Wrapper) checkCounterypartyChannelMatchesIBCSettings(ctx sdk.Context, route types.BridgeRoute, portID, channelID string) error {
+	// Get the expected counterparty port ID from the bridge route
+	expectedCounterpartyPortId := route.CounterpartyPort
+	// Get the expected counterparty channel ID from the bridge route
+	expectedCounterpartyChannelId := route.CounterpartyChannel
 
 	// If the expected counterparty port ID is not set, return an error
 	if expectedCounterpartyPortId == "" {
@@ -99,10 +99,10 @@ func (w ICS4Wrapper) checkCounterypartyChannelMatchesIBCSettings(ctx sdk.Context
 }
 
 // validateClientId verifies the connection matches the expected native and counterparty client ID
-func (im IBCModule) validateConnectionClientId(ctx sdk.Context, portID, channelID string) error {
-	// Get the expected client ID from the module keeper
-	expectedNativeClientId := im.keeper.GetNativeClientId(ctx)
-	expectedCounterpartyClientId := im.keeper.GetCounterpartyClientId(ctx)
+func (im IBCModule) validateConnectionClientId(ctx sdk.Context, route types.BridgeRoute, portID, channelID string) error {
+	// Get the expected client IDs from the bridge route
+	expectedNativeClientId := route.NativeClientId
+	expectedCounterpartyClientId := route.CounterpartyClientId
 
 	// If the expected native client ID is not set, return an error
 	if expectedNativeClientId == "" {
@@ -138,10 +138,10 @@ func (im IBCModule) validateConnectionClientId(ctx sdk.Context, portID, channelI
 }
 
 // validateClientId verifies the connection matches the expected native and counterparty client ID
-func (w ICS4Wrapper) validateConnectionClientId(ctx sdk.Context, portID, channelID string) error {
-	// Get the expected client ID from the module keeper
-	expectedNativeClientId := w.keeper.GetNativeClientId(ctx)
-	expectedCounterpartyClientId := w.keeper.GetCounterpartyClientId(ctx)
+func (w ICS4Wrapper) validateConnectionClientId(ctx sdk.Context, route types.BridgeRoute, portID, channelID string) error {
+	// Get the expected client IDs from the bridge route
+	expectedNativeClientId := route.NativeClientId
+	expectedCounterpartyClientId := route.CounterpartyClientId
 
 	// If the expected native client ID is not set, return an error
 	if expectedNativeClientId == "" {
@@ -176,26 +176,19 @@ func (w ICS4Wrapper) validateConnectionClientId(ctx sdk.Context, portID, channel
 	return nil
 }
 
-// validateIBCSettingsExist checks if the IBC settings are properly configured
-func (im IBCModule) validateIBCSettingsExist(ctx sdk.Context) bool {
-	moduleNativePort := im.keeper.GetNativePort(ctx)
-	moduleNativeChannel := im.keeper.GetNativeChannel(ctx)
-	moduleCounterpartyPort := im.keeper.GetCounterpartyPort(ctx)
-	moduleCounterpartyChannel := im.keeper.GetCounterpartyChannel(ctx)
-	moduleDenom := im.keeper.GetDenom(ctx)
-
-	if moduleCounterpartyPort == "" || moduleCounterpartyChannel == "" ||
-		moduleNativePort == "" || moduleNativeChannel == "" || moduleDenom == "" {
+// validateIBCSettingsExist checks if the IBC settings of the bridge route are properly configured
+func (im IBCModule) validateIBCSettingsExist(route types.BridgeRoute) bool {
+	if route.CounterpartyPort == "" || route.CounterpartyChannel == "" ||
+		route.NativePort == "" || route.NativeChannel == "" || route.Denom == "" || route.NativeDenom == "" {
 		return false
 	}
 
 	return true
 }
 
-// validateIBCDenomIsModuleDenom checks if the IBC denom is the module denom
-func (im IBCModule) validateIBCDenomIsModuleDenom(ctx sdk.Context, denom string) bool {
-	moduleDenom := im.keeper.GetDenom(ctx)
-	return denom == moduleDenom
+// validateIBCDenomIsModuleDenom checks if the IBC denom is the denom of the bridge route
+func (im IBCModule) validateIBCDenomIsModuleDenom(route types.BridgeRoute, denom string) bool {
+	return denom == route.Denom
 }
 
 // validateReceiverChainIsNotSourceChain verifies that the token's denom path does not include the source chain's port/channel prefix
@@ -204,34 +197,24 @@ func (im IBCModule) validateReceiverChainIsNotSourceChain(denom string, packet c
 	return !transfertypes.ExtractDenomFromPath(denom).HasPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
 }
 
-// validateIBCSettingsMatchOnRecvPacket checks if the IBC settings match the expected values for OnRecvPacket
-func (im IBCModule) validateIBCSettingsMatchOnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) bool {
-	moduleNativePort := im.keeper.GetNativePort(ctx)
-	moduleNativeChannel := im.keeper.GetNativeChannel(ctx)
-	moduleCounterpartyPort := im.keeper.GetCounterpartyPort(ctx)
-	moduleCounterpartyChannel := im.keeper.GetCounterpartyChannel(ctx)
-
-	if packet.GetSourcePort() == moduleCounterpartyPort &&
-		packet.GetSourceChannel() == moduleCounterpartyChannel &&
-		packet.GetDestPort() == moduleNativePort &&
-		packet.GetDestChannel() == moduleNativeChannel {
+// validateIBCSettingsMatchOnRecvPacket checks if the IBC settings of the bridge route match the packet for OnRecvPacket
+func (im IBCModule) validateIBCSettingsMatchOnRecvPacket(route types.BridgeRoute, packet channeltypes.Packet) bool {
+	if packet.GetSourcePort() == route.CounterpartyPort &&
+		packet.GetSourceChannel() == route.CounterpartyChannel &&
+		packet.GetDestPort() == route.NativePort &&
+		packet.GetDestChannel() == route.NativeChannel {
 		return true
 	}
 
 	return false
 }
 
-// validateIBCSettingsMatchOnSendPacket checks if the IBC settings match the expected values for SendPacket, OnAcknowledgementPacket and OnTimeoutPacket
-func (im IBCModule) validateIBCSettingsMatchOnSendPacket(ctx sdk.Context, packet channeltypes.Packet) bool {
-	moduleNativePort := im.keeper.GetNativePort(ctx)
-	moduleNativeChannel := im.keeper.GetNativeChannel(ctx)
-	moduleCounterpartyPort := im.keeper.GetCounterpartyPort(ctx)
-	moduleCounterpartyChannel := im.keeper.GetCounterpartyChannel(ctx)
-
-	if packet.GetSourcePort() == moduleNativePort &&
-		packet.GetSourceChannel() == moduleNativeChannel &&
-		packet.GetDestPort() == moduleCounterpartyPort &&
-		packet.GetDestChannel() == moduleCounterpartyChannel {
+// validateIBCSettingsMatchOnSendPacket checks if the IBC settings of the bridge route match the packet for SendPacket, OnAcknowledgementPacket and OnTimeoutPacket
+func (im IBCModule) validateIBCSettingsMatchOnSendPacket(route types.BridgeRoute, packet channeltypes.Packet) bool {
+	if packet.GetSourcePort() == route.NativePort &&
+		packet.GetSourceChannel() == route.NativeChannel &&
+		packet.GetDestPort() == route.CounterpartyPort &&
+		packet.GetDestChannel() == route.CounterpartyChannel {
 		return true
 	}
 
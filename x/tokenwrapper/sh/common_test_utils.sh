@@ -105,6 +105,16 @@ get_module_info() {
     zigchaind q tokenwrapper module-info -o json
 }
 
+# Get a bridge route, the first one when no native port and channel are given
+# Usage: get_bridge_route ["$native_port" "$native_channel"]
+get_bridge_route() {
+    if [[ -n "$2" ]]; then
+        zigchaind q tokenwrapper bridge-route "$1" "$2" -o json | jq '.route'
+    else
+        zigchaind q tokenwrapper bridge-routes -o json | jq '.routes[0]'
+    fi
+}
+
 # Get account balance from zigchain
 # Usage: get_zigchain_balance "$account_name"
 get_zigchain_balance() {
@@ -189,12 +199,12 @@ get_module_ibc_balance() {
     extract_balance_amount "$module_info" "$ibc_hash"
 }
 
-# Get IBC hash from module info
+# Get IBC hash from the first bridge route
 # Usage: get_ibc_hash
 get_ibc_hash() {
-    local module_info=$(get_module_info)
-    local native_port=$(echo "$module_info" | jq -r '.native_port')
-    local native_channel=$(echo "$module_info" | jq -r '.native_channel')
+    local bridge_route=$(get_bridge_route)
+    local native_port=$(echo "$bridge_route" | jq -r '.native_port')
+    local native_channel=$(echo "$bridge_route" | jq -r '.native_channel')
     echo "ibc/$(calculate_ibc_hash "$native_port" "$native_channel" "unit-zig")"
 }
 
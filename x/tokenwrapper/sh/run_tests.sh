@@ -79,6 +79,7 @@ test_001_module_info() {
 
     # Get module info using common utility
     MODULE_INFO_OUTPUT=$(get_module_info)
+    BRIDGE_ROUTE_OUTPUT=$(get_bridge_route)
 
     # Extract expected values (with defaults)
     EXPECTED_DECIMAL_DIFFERENCE=${EXPECTED_DECIMAL_DIFFERENCE:-'12'}
@@ -95,14 +96,14 @@ test_001_module_info() {
     EXPECTED_MODULE_ADDRESS=${EXPECTED_MODULE_ADDRESS:-'zig1hdq87rzf327fwz8rw9rnmchj7qa3uxrpxds2fw'}
 
     # Extract actual values using common utility functions
-    ACTUAL_DECIMAL_DIFFERENCE=$(echo $MODULE_INFO_OUTPUT | jq -r '.decimal_difference')
-    ACTUAL_DENOM=$(echo $MODULE_INFO_OUTPUT | jq -r '.denom')
-    ACTUAL_NATIVE_CHANNEL=$(echo $MODULE_INFO_OUTPUT | jq -r '.native_channel')
-    ACTUAL_NATIVE_PORT=$(echo $MODULE_INFO_OUTPUT | jq -r '.native_port')
-    ACTUAL_NATIVE_CLIENT_ID=$(echo $MODULE_INFO_OUTPUT | jq -r '.native_client_id')
-    ACTUAL_COUNTERPARTY_CHANNEL=$(echo $MODULE_INFO_OUTPUT | jq -r '.counterparty_channel')
-    ACTUAL_COUNTERPARTY_PORT=$(echo $MODULE_INFO_OUTPUT | jq -r '.counterparty_port')
-    ACTUAL_COUNTERPARTY_CLIENT_ID=$(echo $MODULE_INFO_OUTPUT | jq -r '.counterparty_client_id')
+    ACTUAL_DECIMAL_DIFFERENCE=$(echo $BRIDGE_ROUTE_OUTPUT | jq -r '.decimal_difference')
+    ACTUAL_DENOM=$(echo $BRIDGE_ROUTE_OUTPUT | jq -r '.denom')
+    ACTUAL_NATIVE_CHANNEL=$(echo $BRIDGE_ROUTE_OUTPUT | jq -r '.native_channel')
+    ACTUAL_NATIVE_PORT=$(echo $BRIDGE_ROUTE_OUTPUT | jq -r '.native_port')
+    ACTUAL_NATIVE_CLIENT_ID=$(echo $BRIDGE_ROUTE_OUTPUT | jq -r '.native_client_id')
+    ACTUAL_COUNTERPARTY_CHANNEL=$(echo $BRIDGE_ROUTE_OUTPUT | jq -r '.counterparty_channel')
+    ACTUAL_COUNTERPARTY_PORT=$(echo $BRIDGE_ROUTE_OUTPUT | jq -r '.counterparty_port')
+    ACTUAL_COUNTERPARTY_CLIENT_ID=$(echo $BRIDGE_ROUTE_OUTPUT | jq -r '.counterparty_client_id')
     ACTUAL_ENABLE=$(echo $MODULE_INFO_OUTPUT | jq -r '.token_wrapper_enabled // "false"')
     ACTUAL_OPERATOR_ADDRESS=$(echo $MODULE_INFO_OUTPUT | jq -r '.operator_address')
     ACTUAL_PROPOSED_OPERATOR_ADDRESS=$(echo $MODULE_INFO_OUTPUT | jq -r '.proposed_operator_address // "zig1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqk9whvl"')
@@ -828,7 +829,7 @@ test_008_wrong_ibc_settings_wrong_native_client_id() {
     wait_for_tx "$TRANSACTION_TIMEOUT"
 
     # Verify IBC settings were updated with wrong client id
-    UPDATED_NATIVE_CLIENT_ID=$(echo "$(get_module_info)" | jq -r '.native_client_id')
+    UPDATED_NATIVE_CLIENT_ID=$(echo "$(get_bridge_route)" | jq -r '.native_client_id')
     if [[ "$UPDATED_NATIVE_CLIENT_ID" != "$WRONG_NATIVE_CLIENT_ID" ]]; then
         echo "❌ Native client ID should be $WRONG_NATIVE_CLIENT_ID but got $UPDATED_NATIVE_CLIENT_ID"
         return 1
@@ -892,7 +893,7 @@ test_008_wrong_ibc_settings_wrong_native_client_id() {
     wait_for_tx "$TRANSACTION_TIMEOUT"
 
     # Verify IBC settings were restored
-    FINAL_NATIVE_CLIENT_ID=$(echo "$(get_module_info)" | jq -r '.native_client_id')
+    FINAL_NATIVE_CLIENT_ID=$(echo "$(get_bridge_route)" | jq -r '.native_client_id')
     if [[ "$FINAL_NATIVE_CLIENT_ID" != "$EXPECTED_NATIVE_CLIENT_ID" ]]; then
         echo "❌ Native client ID should be restored to $EXPECTED_NATIVE_CLIENT_ID but got $FINAL_NATIVE_CLIENT_ID"
         return 1
@@ -952,13 +953,18 @@ test_009_wrong_ibc_settings_wrong_native_channel_id() {
     wait_for_tx "$TRANSACTION_TIMEOUT"
 
     # Verify IBC settings were updated with wrong native channel id
-    UPDATED_NATIVE_CHANNEL_ID=$(echo "$(get_module_info)" | jq -r '.native_channel')
+    UPDATED_NATIVE_CHANNEL_ID=$(echo "$(get_bridge_route "$EXPECTED_NATIVE_PORT" "$WRONG_NATIVE_CHANNEL_ID")" | jq -r '.native_channel')
     if [[ "$UPDATED_NATIVE_CHANNEL_ID" != "$WRONG_NATIVE_CHANNEL_ID" ]]; then
         echo "❌ Native channel ID should be $WRONG_NATIVE_CHANNEL_ID but got $UPDATED_NATIVE_CHANNEL_ID"
         return 1
     fi
     echo "✅ IBC settings updated with wrong native channel id"
 
+    # The packets still arrive on the original native channel, disable its bridge route so no wrapping occurs
+    DISABLE_CMD="zigchaind tx tokenwrapper set-bridge-route-enabled $EXPECTED_NATIVE_PORT $EXPECTED_NATIVE_CHANNEL false --from $OP_ACCOUNT_NAME --fees $ZIGCHAIN_FEES --chain-id $ZIGCHAIN_CHAIN_ID -y -o json"
+    OUTPUT=$(execute_tx "$DISABLE_CMD" "Disable the original bridge route")
+    wait_for_tx "$TRANSACTION_TIMEOUT"
+
     # Get balances before transfer using common utilities
     echo '📊 Getting balances before IBC transfer...'
     MODULE_UZIG_BEFORE=$(get_module_uzig_balance)
@@ -1013,8 +1019,13 @@ test_009_wrong_ibc_settings_wrong_native_channel_id() {
     # Wait for restoration
     wait_for_tx "$TRANSACTION_TIMEOUT"
 
+    # Enable the original bridge route again
+    ENABLE_CMD="zigchaind tx tokenwrapper set-bridge-route-enabled $EXPECTED_NATIVE_PORT $EXPECTED_NATIVE_CHANNEL true --from $OP_ACCOUNT_NAME --fees $ZIGCHAIN_FEES --chain-id $ZIGCHAIN_CHAIN_ID -y -o json"
+    OUTPUT=$(execute_tx "$ENABLE_CMD" "Enable the original bridge route")
+    wait_for_tx "$TRANSACTION_TIMEOUT"
+
     # Verify IBC settings were restored
-    FINAL_NATIVE_CHANNEL_ID=$(echo "$(get_module_info)" | jq -r '.native_channel')
+    FINAL_NATIVE_CHANNEL_ID=$(echo "$(get_bridge_route "$EXPECTED_NATIVE_PORT" "$EXPECTED_NATIVE_CHANNEL")" | jq -r '.native_channel')
     if [[ "$FINAL_NATIVE_CHANNEL_ID" != "$EXPECTED_NATIVE_CHANNEL" ]]; then
         echo "❌ Native channel ID should be restored to $EXPECTED_NATIVE_CHANNEL but got $FINAL_NATIVE_CHANNEL_ID"
         return 1
@@ -1074,7 +1085,7 @@ test_010_wrong_ibc_settings_wrong_counterparty_channel_id() {
     wait_for_tx "$TRANSACTION_TIMEOUT"
 
     # Verify IBC settings were updated with wrong counterparty channel id
-    UPDATED_COUNTERPARTY_CHANNEL_ID=$(echo "$(get_module_info)" | jq -r '.counterparty_channel')
+    UPDATED_COUNTERPARTY_CHANNEL_ID=$(echo "$(get_bridge_route)" | jq -r '.counterparty_channel')
     if [[ "$UPDATED_COUNTERPARTY_CHANNEL_ID" != "$WRONG_COUNTERPARTY_CHANNEL_ID" ]]; then
         echo "❌ Counterparty channel ID should be $WRONG_COUNTERPARTY_CHANNEL_ID but got $UPDATED_COUNTERPARTY_CHANNEL_ID"
         return 1
@@ -1136,7 +1147,7 @@ test_010_wrong_ibc_settings_wrong_counterparty_channel_id() {
     wait_for_tx "$TRANSACTION_TIMEOUT"
 
     # Verify IBC settings were restored
-    FINAL_COUNTERPARTY_CHANNEL_ID=$(echo "$(get_module_info)" | jq -r '.counterparty_channel')
+    FINAL_COUNTERPARTY_CHANNEL_ID=$(echo "$(get_bridge_route)" | jq -r '.counterparty_channel')
     if [[ "$FINAL_COUNTERPARTY_CHANNEL_ID" != "$EXPECTED_COUNTERPARTY_CHANNEL" ]]; then
         echo "❌ Counterparty channel ID should be restored to $EXPECTED_COUNTERPARTY_CHANNEL but got $FINAL_COUNTERPARTY_CHANNEL_ID"
         return 1
@@ -1590,7 +1601,7 @@ test_013_return_transfer_wrong_native_client_id() {
     wait_for_tx "$TRANSACTION_TIMEOUT"
 
     # Verify IBC settings were updated with wrong native client id
-    UPDATED_NATIVE_CLIENT_ID=$(echo "$(get_module_info)" | jq -r '.native_client_id')
+    UPDATED_NATIVE_CLIENT_ID=$(echo "$(get_bridge_route)" | jq -r '.native_client_id')
     if [[ "$UPDATED_NATIVE_CLIENT_ID" != "$WRONG_NATIVE_CLIENT_ID" ]]; then
         echo "❌ Native client ID should be $WRONG_NATIVE_CLIENT_ID but got $UPDATED_NATIVE_CLIENT_ID"
         return 1
@@ -1683,7 +1694,7 @@ test_013_return_transfer_wrong_native_client_id() {
     wait_for_tx "$TRANSACTION_TIMEOUT"
 
     # Verify IBC settings were restored
-    FINAL_NATIVE_CLIENT_ID=$(echo "$(get_module_info)" | jq -r '.native_client_id')
+    FINAL_NATIVE_CLIENT_ID=$(echo "$(get_bridge_route)" | jq -r '.native_client_id')
     if [[ "$FINAL_NATIVE_CLIENT_ID" != "$EXPECTED_NATIVE_CLIENT_ID" ]]; then
         echo "❌ Native client ID should be restored to $EXPECTED_NATIVE_CLIENT_ID but got $FINAL_NATIVE_CLIENT_ID"
         return 1
@@ -1786,13 +1797,18 @@ test_014_return_transfer_wrong_native_channel_id() {
     wait_for_tx "$TRANSACTION_TIMEOUT"
 
     # Verify IBC settings were updated with wrong native channel id
-    UPDATED_NATIVE_CHANNEL_ID=$(echo "$(get_module_info)" | jq -r '.native_channel')
+    UPDATED_NATIVE_CHANNEL_ID=$(echo "$(get_bridge_route "$EXPECTED_NATIVE_PORT" "$WRONG_NATIVE_CHANNEL_ID")" | jq -r '.native_channel')
     if [[ "$UPDATED_NATIVE_CHANNEL_ID" != "$WRONG_NATIVE_CHANNEL_ID" ]]; then
         echo "❌ Native channel ID should be $WRONG_NATIVE_CHANNEL_ID but got $UPDATED_NATIVE_CHANNEL_ID"
         return 1
     fi
     echo "✅ IBC settings updated with wrong native channel id"
 
+    # The packets still arrive on the original native channel, disable its bridge route so no wrapping occurs
+    DISABLE_CMD="zigchaind tx tokenwrapper set-bridge-route-enabled $EXPECTED_NATIVE_PORT $EXPECTED_NATIVE_CHANNEL false --from $OP_ACCOUNT_NAME --fees $ZIGCHAIN_FEES --chain-id $ZIGCHAIN_CHAIN_ID -y -o json"
+    OUTPUT=$(execute_tx "$DISABLE_CMD" "Disable the original bridge route")
+    wait_for_tx "$TRANSACTION_TIMEOUT"
+
     # Attempt to send tokens back from ZIGChain to Axelar with wrong native channel id (should succeed as regular IBC transfer)
     echo '✅ Attempting return transfer from ZIGChain to Axelar with wrong native channel id (should succeed as regular IBC transfer)...'
     AXELAR_TEST_ADDRESS=$(axelard keys show $TEST_ACCOUNT_NAME -a 2>/dev/null)
@@ -1866,8 +1882,13 @@ test_014_return_transfer_wrong_native_channel_id() {
     # Wait for restoration
     wait_for_tx "$TRANSACTION_TIMEOUT"
 
+    # Enable the original bridge route again
+    ENABLE_CMD="zigchaind tx tokenwrapper set-bridge-route-enabled $EXPECTED_NATIVE_PORT $EXPECTED_NATIVE_CHANNEL true --from $OP_ACCOUNT_NAME --fees $ZIGCHAIN_FEES --chain-id $ZIGCHAIN_CHAIN_ID -y -o json"
+    OUTPUT=$(execute_tx "$ENABLE_CMD" "Enable the original bridge route")
+    wait_for_tx "$TRANSACTION_TIMEOUT"
+
     # Verify IBC settings were restored
-    FINAL_NATIVE_CHANNEL_ID=$(echo "$(get_module_info)" | jq -r '.native_channel')
+    FINAL_NATIVE_CHANNEL_ID=$(echo "$(get_bridge_route "$EXPECTED_NATIVE_PORT" "$EXPECTED_NATIVE_CHANNEL")" | jq -r '.native_channel')
     if [[ "$FINAL_NATIVE_CHANNEL_ID" != "$EXPECTED_NATIVE_CHANNEL" ]]; then
         echo "❌ Native channel ID should be restored to $EXPECTED_NATIVE_CHANNEL but got $FINAL_NATIVE_CHANNEL_ID"
         return 1
@@ -1967,7 +1988,7 @@ test_015_return_transfer_wrong_counterparty_channel() {
     wait_for_tx "$TRANSACTION_TIMEOUT"
 
     # Verify IBC settings were updated with wrong counterparty channel
-    UPDATED_COUNTERPARTY_CHANNEL=$(echo "$(get_module_info)" | jq -r '.counterparty_channel')
+    UPDATED_COUNTERPARTY_CHANNEL=$(echo "$(get_bridge_route)" | jq -r '.counterparty_channel')
     if [[ "$UPDATED_COUNTERPARTY_CHANNEL" != "$WRONG_COUNTERPARTY_CHANNEL" ]]; then
         echo "❌ Counterparty channel should be $WRONG_COUNTERPARTY_CHANNEL but got $UPDATED_COUNTERPARTY_CHANNEL"
         return 1
@@ -2046,7 +2067,7 @@ test_015_return_transfer_wrong_counterparty_channel() {
     wait_for_tx "$TRANSACTION_TIMEOUT"
 
     # Verify IBC settings were restored
-    FINAL_COUNTERPARTY_CHANNEL=$(echo "$(get_module_info)" | jq -r '.counterparty_channel')
+    FINAL_COUNTERPARTY_CHANNEL=$(echo "$(get_bridge_route)" | jq -r '.counterparty_channel')
     if [[ "$FINAL_COUNTERPARTY_CHANNEL" != "$EXPECTED_COUNTERPARTY_CHANNEL" ]]; then
         echo "❌ Counterparty channel should be restored to $EXPECTED_COUNTERPARTY_CHANNEL but got $FINAL_COUNTERPARTY_CHANNEL"
         return 1
@@ -2149,7 +2170,7 @@ test_016_return_transfer_wrong_module_denom() {
     wait_for_tx "$TRANSACTION_TIMEOUT"
 
     # Verify IBC settings were updated with wrong module denom
-    UPDATED_MODULE_DENOM=$(echo "$(get_module_info)" | jq -r '.denom')
+    UPDATED_MODULE_DENOM=$(echo "$(get_bridge_route)" | jq -r '.denom')
     if [[ "$UPDATED_MODULE_DENOM" != "$WRONG_MODULE_DENOM" ]]; then
         echo "❌ Module denom should be $WRONG_MODULE_DENOM but got $UPDATED_MODULE_DENOM"
         return 1
@@ -2236,7 +2257,7 @@ test_016_return_transfer_wrong_module_denom() {
     wait_for_tx "$TRANSACTION_TIMEOUT"
 
     # Verify IBC settings were restored
-    FINAL_MODULE_DENOM=$(echo "$(get_module_info)" | jq -r '.denom')
+    FINAL_MODULE_DENOM=$(echo "$(get_bridge_route)" | jq -r '.denom')
     if [[ "$FINAL_MODULE_DENOM" != "$EXPECTED_DENOM" ]]; then
         echo "❌ Module denom should be restored to $EXPECTED_DENOM but got $FINAL_MODULE_DENOM"
         return 1
@@ -2871,16 +2892,16 @@ test_998_set_original_ibc_settings() {
     wait_for_tx "$TRANSACTION_TIMEOUT"
 
     # Verify IBC settings were set to original values
-    MODULE_INFO=$(get_module_info)
-
-    ACTUAL_NATIVE_CLIENT_ID=$(echo "$MODULE_INFO" | jq -r '.native_client_id')
-    ACTUAL_COUNTERPARTY_CLIENT_ID=$(echo "$MODULE_INFO" | jq -r '.counterparty_client_id')
-    ACTUAL_NATIVE_PORT=$(echo "$MODULE_INFO" | jq -r '.native_port')
-    ACTUAL_COUNTERPARTY_PORT=$(echo "$MODULE_INFO" | jq -r '.counterparty_port')
-    ACTUAL_NATIVE_CHANNEL=$(echo "$MODULE_INFO" | jq -r '.native_channel')
-    ACTUAL_COUNTERPARTY_CHANNEL=$(echo "$MODULE_INFO" | jq -r '.counterparty_channel')
-    ACTUAL_DENOM=$(echo "$MODULE_INFO" | jq -r '.denom')
-    ACTUAL_DECIMAL_DIFFERENCE=$(echo "$MODULE_INFO" | jq -r '.decimal_difference')
+    BRIDGE_ROUTE=$(get_bridge_route "$EXPECTED_NATIVE_PORT" "$EXPECTED_NATIVE_CHANNEL")
+
+    ACTUAL_NATIVE_CLIENT_ID=$(echo "$BRIDGE_ROUTE" | jq -r '.native_client_id')
+    ACTUAL_COUNTERPARTY_CLIENT_ID=$(echo "$BRIDGE_ROUTE" | jq -r '.counterparty_client_id')
+    ACTUAL_NATIVE_PORT=$(echo "$BRIDGE_ROUTE" | jq -r '.native_port')
+    ACTUAL_COUNTERPARTY_PORT=$(echo "$BRIDGE_ROUTE" | jq -r '.counterparty_port')
+    ACTUAL_NATIVE_CHANNEL=$(echo "$BRIDGE_ROUTE" | jq -r '.native_channel')
+    ACTUAL_COUNTERPARTY_CHANNEL=$(echo "$BRIDGE_ROUTE" | jq -r '.counterparty_channel')
+    ACTUAL_DENOM=$(echo "$BRIDGE_ROUTE" | jq -r '.denom')
+    ACTUAL_DECIMAL_DIFFERENCE=$(echo "$BRIDGE_ROUTE" | jq -r '.decimal_difference')
 
     # Validate all settings match expected configuration
     [ "$ACTUAL_NATIVE_CLIENT_ID" = "$EXPECTED_NATIVE_CLIENT_ID" ] && \
@@ -305,14 +305,19 @@ genesis:
     tokenwrapper:
       operator_address: "$TOKEN_WRAPPER_OPERATOR_ADDRESS"
       enabled: $TOKEN_WRAPPER_ENABLED
-      native_client_id: "07-tendermint-1"
-      counterparty_client_id: "07-tendermint-0"
-      native_port: "transfer"
-      counterparty_port: "transfer"
-      native_channel: "channel-1"
-      counterparty_channel: "channel-0"
-      denom: $AXELAR_DENOM
-      decimal_difference: 12
+      routes:
+        - native_client_id: "07-tendermint-1"
+          counterparty_client_id: "07-tendermint-0"
+          native_port: "transfer"
+          counterparty_port: "transfer"
+          native_channel: "channel-1"
+          counterparty_channel: "channel-0"
+          denom: $AXELAR_DENOM
+          native_denom: $ZIGCHAIN_DENOM
+          decimal_difference: 12
+          enabled: true
+          total_transferred_in: "0"
+          total_transferred_out: "0"
 
 validators:
   - name: alice
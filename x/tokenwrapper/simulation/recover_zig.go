@@ -13,7 +13,6 @@ import (
 
 	"zigchain/x/tokenwrapper/keeper"
 	"zigchain/x/tokenwrapper/types"
-	"zigchain/zutils/constants"
 )
 
 func SimulateMsgRecoverZig(
@@ -29,14 +28,15 @@ func SimulateMsgRecoverZig(
 			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgRecoverZig{}), "module is disabled"), nil, nil
 		}
 
-		// Get module denom and IBC settings - if not configured, skip this operation
-		moduleDenom := k.GetDenom(ctx)
-		if moduleDenom == "" {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgRecoverZig{}), "module denom not configured"), nil, nil
+		// Get a random bridge route - if none is configured, skip this operation
+		routes := k.GetAllBridgeRoutes(ctx)
+		if len(routes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgRecoverZig{}), "no bridge route configured"), nil, nil
 		}
+		route := routes[r.Intn(len(routes))]
 
 		// Get IBC receive denom
-		recvDenom := k.GetIBCRecvDenom(ctx, moduleDenom)
+		recvDenom := route.IBCRecvDenom()
 
 		// Get a random account for the signer
 		simAccount, _ := simtypes.RandomAcc(r, accs)
@@ -48,14 +48,14 @@ func SimulateMsgRecoverZig(
 
 		// Generate a random IBC amount that will produce a positive native amount after scaling
 		// The amount should be at least the conversion factor to ensure positive native tokens
-		conversionFactor := k.GetDecimalConversionFactor(ctx)
+		conversionFactor := route.DecimalConversionFactor()
 		// Generate IBC amount between 1x and 10x the conversion factor to ensure positive native amount
 		minIBCAmount := conversionFactor
 		maxIBCAmount := conversionFactor.Mul(sdkmath.NewInt(10))
 		ibcAmount := sdkmath.NewInt(int64(simtypes.RandIntBetween(r, int(minIBCAmount.Int64()), int(maxIBCAmount.Int64()))))
 
 		// Calculate the converted native amount
-		convertedAmount, err := k.ScaleDownTokenPrecision(ctx, ibcAmount)
+		convertedAmount, err := route.ScaleDownTokenPrecision(ibcAmount)
 		if err != nil || convertedAmount.IsZero() {
 			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgRecoverZig{}), "failed to calculate converted amount"), nil, nil
 		}
@@ -92,17 +92,17 @@ func SimulateMsgRecoverZig(
 			// This will exercise the failure path, which is also valuable for simulation
 		}
 
-		// Ensure module wallet has enough native ZIG tokens for the conversion
+		// Ensure module wallet has enough native tokens of the route for the conversion
 		// We check the balance and if insufficient, we skip (can't mint through interface)
 		moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
-		moduleBalance := bk.GetBalance(ctx, moduleAddr, constants.BondDenom)
+		moduleBalance := bk.GetBalance(ctx, moduleAddr, route.NativeDenom)
 		if moduleBalance.Amount.LT(convertedAmount) {
 			// Try to find an account with enough native tokens to fund the module
 			foundNativeSource := false
 			for _, acc := range accs {
-				balance := bk.GetBalance(ctx, acc.Address, constants.BondDenom)
+				balance := bk.GetBalance(ctx, acc.Address, route.NativeDenom)
 				if balance.Amount.GTE(convertedAmount) {
-					nativeCoins := sdk.NewCoins(sdk.NewCoin(constants.BondDenom, convertedAmount))
+					nativeCoins := sdk.NewCoins(sdk.NewCoin(route.NativeDenom, convertedAmount))
 					// Transfer to module via SendCoinsFromAccountToModule
 					if err := bk.SendCoinsFromAccountToModule(ctx, acc.Address, types.ModuleName, nativeCoins); err == nil {
 						foundNativeSource = true
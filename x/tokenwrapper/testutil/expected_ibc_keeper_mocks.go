@@ -11,6 +11,7 @@ package testutil
 
 import (
 	reflect "reflect"
+	types3 "zigchain/x/tokenwrapper/types"
 
 	log "cosmossdk.io/log"
 	math "cosmossdk.io/math"
@@ -410,28 +411,28 @@ func (m *MockTokenwrapperKeeper) EXPECT() *MockTokenwrapperKeeperMockRecorder {
 	return m.recorder
 }
 
-// AddToTotalTransferredIn mocks base method.
-func (m *MockTokenwrapperKeeper) AddToTotalTransferredIn(ctx types.Context, amount math.Int) {
+// AddToRouteTransferredIn mocks base method.
+func (m *MockTokenwrapperKeeper) AddToRouteTransferredIn(ctx types.Context, route types3.BridgeRoute, amount math.Int) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "AddToTotalTransferredIn", ctx, amount)
+	m.ctrl.Call(m, "AddToRouteTransferredIn", ctx, route, amount)
 }
 
-// AddToTotalTransferredIn indicates an expected call of AddToTotalTransferredIn.
-func (mr *MockTokenwrapperKeeperMockRecorder) AddToTotalTransferredIn(ctx, amount any) *gomock.Call {
+// AddToRouteTransferredIn indicates an expected call of AddToRouteTransferredIn.
+func (mr *MockTokenwrapperKeeperMockRecorder) AddToRouteTransferredIn(ctx, route, amount any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToTotalTransferredIn", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).AddToTotalTransferredIn), ctx, amount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToRouteTransferredIn", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).AddToRouteTransferredIn), ctx, route, amount)
 }
 
-// AddToTotalTransferredOut mocks base method.
-func (m *MockTokenwrapperKeeper) AddToTotalTransferredOut(ctx types.Context, amount math.Int) {
+// AddToRouteTransferredOut mocks base method.
+func (m *MockTokenwrapperKeeper) AddToRouteTransferredOut(ctx types.Context, route types3.BridgeRoute, amount math.Int) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "AddToTotalTransferredOut", ctx, amount)
+	m.ctrl.Call(m, "AddToRouteTransferredOut", ctx, route, amount)
 }
 
-// AddToTotalTransferredOut indicates an expected call of AddToTotalTransferredOut.
-func (mr *MockTokenwrapperKeeperMockRecorder) AddToTotalTransferredOut(ctx, amount any) *gomock.Call {
+// AddToRouteTransferredOut indicates an expected call of AddToRouteTransferredOut.
+func (mr *MockTokenwrapperKeeperMockRecorder) AddToRouteTransferredOut(ctx, route, amount any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToTotalTransferredOut", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).AddToTotalTransferredOut), ctx, amount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToRouteTransferredOut", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).AddToRouteTransferredOut), ctx, route, amount)
 }
 
 // BurnIbcTokens mocks base method.
@@ -463,171 +464,128 @@ func (mr *MockTokenwrapperKeeperMockRecorder) CheckAccountBalance(ctx, addr, coi
 }
 
 // CheckBalances mocks base method.
-func (m *MockTokenwrapperKeeper) CheckBalances(ctx types.Context, receiver types.AccAddress, amount math.Int, recvDenom string, convertedAmount math.Int) error {
+func (m *MockTokenwrapperKeeper) CheckBalances(ctx types.Context, receiver types.AccAddress, amount math.Int, recvDenom string, convertedAmount math.Int, nativeDenom string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckBalances", ctx, receiver, amount, recvDenom, convertedAmount)
+	ret := m.ctrl.Call(m, "CheckBalances", ctx, receiver, amount, recvDenom, convertedAmount, nativeDenom)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CheckBalances indicates an expected call of CheckBalances.
-func (mr *MockTokenwrapperKeeperMockRecorder) CheckBalances(ctx, receiver, amount, recvDenom, convertedAmount any) *gomock.Call {
+func (mr *MockTokenwrapperKeeperMockRecorder) CheckBalances(ctx, receiver, amount, recvDenom, convertedAmount, nativeDenom any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBalances", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).CheckBalances), ctx, receiver, amount, recvDenom, convertedAmount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBalances", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).CheckBalances), ctx, receiver, amount, recvDenom, convertedAmount, nativeDenom)
 }
 
-// CheckModuleBalance mocks base method.
-func (m *MockTokenwrapperKeeper) CheckModuleBalance(ctx types.Context, coins types.Coins) error {
+// CheckInboundVolume mocks base method.
+func (m *MockTokenwrapperKeeper) CheckInboundVolume(ctx types.Context, route types3.BridgeRoute, amount math.Int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckModuleBalance", ctx, coins)
+	ret := m.ctrl.Call(m, "CheckInboundVolume", ctx, route, amount)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CheckModuleBalance indicates an expected call of CheckModuleBalance.
-func (mr *MockTokenwrapperKeeperMockRecorder) CheckModuleBalance(ctx, coins any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckModuleBalance", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).CheckModuleBalance), ctx, coins)
-}
-
-// GetCounterpartyChannel mocks base method.
-func (m *MockTokenwrapperKeeper) GetCounterpartyChannel(ctx types.Context) string {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCounterpartyChannel", ctx)
-	ret0, _ := ret[0].(string)
-	return ret0
-}
-
-// GetCounterpartyChannel indicates an expected call of GetCounterpartyChannel.
-func (mr *MockTokenwrapperKeeperMockRecorder) GetCounterpartyChannel(ctx any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounterpartyChannel", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).GetCounterpartyChannel), ctx)
-}
-
-// GetCounterpartyClientId mocks base method.
-func (m *MockTokenwrapperKeeper) GetCounterpartyClientId(ctx types.Context) string {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCounterpartyClientId", ctx)
-	ret0, _ := ret[0].(string)
-	return ret0
-}
-
-// GetCounterpartyClientId indicates an expected call of GetCounterpartyClientId.
-func (mr *MockTokenwrapperKeeperMockRecorder) GetCounterpartyClientId(ctx any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounterpartyClientId", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).GetCounterpartyClientId), ctx)
-}
-
-// GetCounterpartyPort mocks base method.
-func (m *MockTokenwrapperKeeper) GetCounterpartyPort(ctx types.Context) string {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCounterpartyPort", ctx)
-	ret0, _ := ret[0].(string)
-	return ret0
-}
-
-// GetCounterpartyPort indicates an expected call of GetCounterpartyPort.
-func (mr *MockTokenwrapperKeeperMockRecorder) GetCounterpartyPort(ctx any) *gomock.Call {
+// CheckInboundVolume indicates an expected call of CheckInboundVolume.
+func (mr *MockTokenwrapperKeeperMockRecorder) CheckInboundVolume(ctx, route, amount any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounterpartyPort", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).GetCounterpartyPort), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckInboundVolume", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).CheckInboundVolume), ctx, route, amount)
 }
 
-// GetDecimalConversionFactor mocks base method.
-func (m *MockTokenwrapperKeeper) GetDecimalConversionFactor(ctx types.Context) math.Int {
+// CheckModuleBalance mocks base method.
+func (m *MockTokenwrapperKeeper) CheckModuleBalance(ctx types.Context, coins types.Coins) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDecimalConversionFactor", ctx)
-	ret0, _ := ret[0].(math.Int)
+	ret := m.ctrl.Call(m, "CheckModuleBalance", ctx, coins)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// GetDecimalConversionFactor indicates an expected call of GetDecimalConversionFactor.
-func (mr *MockTokenwrapperKeeperMockRecorder) GetDecimalConversionFactor(ctx any) *gomock.Call {
+// CheckModuleBalance indicates an expected call of CheckModuleBalance.
+func (mr *MockTokenwrapperKeeperMockRecorder) CheckModuleBalance(ctx, coins any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDecimalConversionFactor", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).GetDecimalConversionFactor), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckModuleBalance", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).CheckModuleBalance), ctx, coins)
 }
 
-// GetDecimalDifference mocks base method.
-func (m *MockTokenwrapperKeeper) GetDecimalDifference(ctx types.Context) uint32 {
+// CollectInboundBridgeFee mocks base method.
+func (m *MockTokenwrapperKeeper) CollectInboundBridgeFee(ctx types.Context, route types3.BridgeRoute, fee math.Int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDecimalDifference", ctx)
-	ret0, _ := ret[0].(uint32)
+	ret := m.ctrl.Call(m, "CollectInboundBridgeFee", ctx, route, fee)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// GetDecimalDifference indicates an expected call of GetDecimalDifference.
-func (mr *MockTokenwrapperKeeperMockRecorder) GetDecimalDifference(ctx any) *gomock.Call {
+// CollectInboundBridgeFee indicates an expected call of CollectInboundBridgeFee.
+func (mr *MockTokenwrapperKeeperMockRecorder) CollectInboundBridgeFee(ctx, route, fee any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDecimalDifference", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).GetDecimalDifference), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectInboundBridgeFee", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).CollectInboundBridgeFee), ctx, route, fee)
 }
 
-// GetDenom mocks base method.
-func (m *MockTokenwrapperKeeper) GetDenom(ctx types.Context) string {
+// ConsumeInboundVolume mocks base method.
+func (m *MockTokenwrapperKeeper) ConsumeInboundVolume(ctx types.Context, route types3.BridgeRoute, amount math.Int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDenom", ctx)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ConsumeInboundVolume", ctx, route, amount)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// GetDenom indicates an expected call of GetDenom.
-func (mr *MockTokenwrapperKeeperMockRecorder) GetDenom(ctx any) *gomock.Call {
+// ConsumeInboundVolume indicates an expected call of ConsumeInboundVolume.
+func (mr *MockTokenwrapperKeeperMockRecorder) ConsumeInboundVolume(ctx, route, amount any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenom", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).GetDenom), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeInboundVolume", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).ConsumeInboundVolume), ctx, route, amount)
 }
 
-// GetIBCRecvDenom mocks base method.
-func (m *MockTokenwrapperKeeper) GetIBCRecvDenom(ctx types.Context, denom string) string {
+// ConsumeOutboundVolume mocks base method.
+func (m *MockTokenwrapperKeeper) ConsumeOutboundVolume(ctx types.Context, route types3.BridgeRoute, amount math.Int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetIBCRecvDenom", ctx, denom)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ConsumeOutboundVolume", ctx, route, amount)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// GetIBCRecvDenom indicates an expected call of GetIBCRecvDenom.
-func (mr *MockTokenwrapperKeeperMockRecorder) GetIBCRecvDenom(ctx, denom any) *gomock.Call {
+// ConsumeOutboundVolume indicates an expected call of ConsumeOutboundVolume.
+func (mr *MockTokenwrapperKeeperMockRecorder) ConsumeOutboundVolume(ctx, route, amount any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIBCRecvDenom", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).GetIBCRecvDenom), ctx, denom)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeOutboundVolume", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).ConsumeOutboundVolume), ctx, route, amount)
 }
 
-// GetNativeChannel mocks base method.
-func (m *MockTokenwrapperKeeper) GetNativeChannel(ctx types.Context) string {
+// EscrowOutboundBridgeFee mocks base method.
+func (m *MockTokenwrapperKeeper) EscrowOutboundBridgeFee(ctx types.Context, route types3.BridgeRoute, sender types.AccAddress, sequence uint64, fee math.Int) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNativeChannel", ctx)
-	ret0, _ := ret[0].(string)
-	return ret0
+	m.ctrl.Call(m, "EscrowOutboundBridgeFee", ctx, route, sender, sequence, fee)
 }
 
-// GetNativeChannel indicates an expected call of GetNativeChannel.
-func (mr *MockTokenwrapperKeeperMockRecorder) GetNativeChannel(ctx any) *gomock.Call {
+// EscrowOutboundBridgeFee indicates an expected call of EscrowOutboundBridgeFee.
+func (mr *MockTokenwrapperKeeperMockRecorder) EscrowOutboundBridgeFee(ctx, route, sender, sequence, fee any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNativeChannel", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).GetNativeChannel), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EscrowOutboundBridgeFee", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).EscrowOutboundBridgeFee), ctx, route, sender, sequence, fee)
 }
 
-// GetNativeClientId mocks base method.
-func (m *MockTokenwrapperKeeper) GetNativeClientId(ctx types.Context) string {
+// GetBridgeFee mocks base method.
+func (m *MockTokenwrapperKeeper) GetBridgeFee(ctx types.Context, amount math.Int) math.Int {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNativeClientId", ctx)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetBridgeFee", ctx, amount)
+	ret0, _ := ret[0].(math.Int)
 	return ret0
 }
 
-// GetNativeClientId indicates an expected call of GetNativeClientId.
-func (mr *MockTokenwrapperKeeperMockRecorder) GetNativeClientId(ctx any) *gomock.Call {
+// GetBridgeFee indicates an expected call of GetBridgeFee.
+func (mr *MockTokenwrapperKeeperMockRecorder) GetBridgeFee(ctx, amount any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNativeClientId", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).GetNativeClientId), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBridgeFee", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).GetBridgeFee), ctx, amount)
 }
 
-// GetNativePort mocks base method.
-func (m *MockTokenwrapperKeeper) GetNativePort(ctx types.Context) string {
+// GetBridgeRoute mocks base method.
+func (m *MockTokenwrapperKeeper) GetBridgeRoute(ctx types.Context, nativePort, nativeChannel string) (types3.BridgeRoute, bool) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNativePort", ctx)
-	ret0, _ := ret[0].(string)
-	return ret0
+	ret := m.ctrl.Call(m, "GetBridgeRoute", ctx, nativePort, nativeChannel)
+	ret0, _ := ret[0].(types3.BridgeRoute)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
 }
 
-// GetNativePort indicates an expected call of GetNativePort.
-func (mr *MockTokenwrapperKeeperMockRecorder) GetNativePort(ctx any) *gomock.Call {
+// GetBridgeRoute indicates an expected call of GetBridgeRoute.
+func (mr *MockTokenwrapperKeeperMockRecorder) GetBridgeRoute(ctx, nativePort, nativeChannel any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNativePort", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).GetNativePort), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBridgeRoute", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).GetBridgeRoute), ctx, nativePort, nativeChannel)
 }
 
 // IsEnabled mocks base method.
@@ -687,49 +645,84 @@ func (mr *MockTokenwrapperKeeperMockRecorder) Logger() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logger", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).Logger))
 }
 
-// ScaleDownTokenPrecision mocks base method.
-func (m *MockTokenwrapperKeeper) ScaleDownTokenPrecision(ctx types.Context, amount math.Int) (math.Int, error) {
+// PayOutboundBridgeFee mocks base method.
+func (m *MockTokenwrapperKeeper) PayOutboundBridgeFee(ctx types.Context, nativePort, nativeChannel string, sequence uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ScaleDownTokenPrecision", ctx, amount)
-	ret0, _ := ret[0].(math.Int)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "PayOutboundBridgeFee", ctx, nativePort, nativeChannel, sequence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PayOutboundBridgeFee indicates an expected call of PayOutboundBridgeFee.
+func (mr *MockTokenwrapperKeeperMockRecorder) PayOutboundBridgeFee(ctx, nativePort, nativeChannel, sequence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayOutboundBridgeFee", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).PayOutboundBridgeFee), ctx, nativePort, nativeChannel, sequence)
+}
+
+// QueuePendingUnwrap mocks base method.
+func (m *MockTokenwrapperKeeper) QueuePendingUnwrap(ctx types.Context, receiver types.AccAddress, route types3.BridgeRoute, amount math.Int, sequence uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "QueuePendingUnwrap", ctx, receiver, route, amount, sequence)
+}
+
+// QueuePendingUnwrap indicates an expected call of QueuePendingUnwrap.
+func (mr *MockTokenwrapperKeeperMockRecorder) QueuePendingUnwrap(ctx, receiver, route, amount, sequence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueuePendingUnwrap", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).QueuePendingUnwrap), ctx, receiver, route, amount, sequence)
 }
 
-// ScaleDownTokenPrecision indicates an expected call of ScaleDownTokenPrecision.
-func (mr *MockTokenwrapperKeeperMockRecorder) ScaleDownTokenPrecision(ctx, amount any) *gomock.Call {
+// RecordTransfer mocks base method.
+func (m *MockTokenwrapperKeeper) RecordTransfer(ctx types.Context, record types3.TransferRecord) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordTransfer", ctx, record)
+}
+
+// RecordTransfer indicates an expected call of RecordTransfer.
+func (mr *MockTokenwrapperKeeperMockRecorder) RecordTransfer(ctx, record any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleDownTokenPrecision", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).ScaleDownTokenPrecision), ctx, amount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordTransfer", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).RecordTransfer), ctx, record)
 }
 
-// ScaleUpTokenPrecision mocks base method.
-func (m *MockTokenwrapperKeeper) ScaleUpTokenPrecision(ctx types.Context, amount math.Int) (math.Int, error) {
+// RefundOutboundBridgeFee mocks base method.
+func (m *MockTokenwrapperKeeper) RefundOutboundBridgeFee(ctx types.Context, nativePort, nativeChannel string, sequence uint64) (math.Int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ScaleUpTokenPrecision", ctx, amount)
+	ret := m.ctrl.Call(m, "RefundOutboundBridgeFee", ctx, nativePort, nativeChannel, sequence)
 	ret0, _ := ret[0].(math.Int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ScaleUpTokenPrecision indicates an expected call of ScaleUpTokenPrecision.
-func (mr *MockTokenwrapperKeeperMockRecorder) ScaleUpTokenPrecision(ctx, amount any) *gomock.Call {
+// RefundOutboundBridgeFee indicates an expected call of RefundOutboundBridgeFee.
+func (mr *MockTokenwrapperKeeperMockRecorder) RefundOutboundBridgeFee(ctx, nativePort, nativeChannel, sequence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundOutboundBridgeFee", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).RefundOutboundBridgeFee), ctx, nativePort, nativeChannel, sequence)
+}
+
+// ReleaseOutboundVolume mocks base method.
+func (m *MockTokenwrapperKeeper) ReleaseOutboundVolume(ctx types.Context, route types3.BridgeRoute, amount math.Int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReleaseOutboundVolume", ctx, route, amount)
+}
+
+// ReleaseOutboundVolume indicates an expected call of ReleaseOutboundVolume.
+func (mr *MockTokenwrapperKeeperMockRecorder) ReleaseOutboundVolume(ctx, route, amount any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleUpTokenPrecision", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).ScaleUpTokenPrecision), ctx, amount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseOutboundVolume", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).ReleaseOutboundVolume), ctx, route, amount)
 }
 
 // UnlockNativeTokens mocks base method.
-func (m *MockTokenwrapperKeeper) UnlockNativeTokens(ctx types.Context, receiver types.AccAddress, amount math.Int, ibcCoins types.Coins) (types.Coins, error) {
+func (m *MockTokenwrapperKeeper) UnlockNativeTokens(ctx types.Context, receiver types.AccAddress, amount math.Int, ibcCoins types.Coins, nativeDenom string) (types.Coins, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UnlockNativeTokens", ctx, receiver, amount, ibcCoins)
+	ret := m.ctrl.Call(m, "UnlockNativeTokens", ctx, receiver, amount, ibcCoins, nativeDenom)
 	ret0, _ := ret[0].(types.Coins)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UnlockNativeTokens indicates an expected call of UnlockNativeTokens.
-func (mr *MockTokenwrapperKeeperMockRecorder) UnlockNativeTokens(ctx, receiver, amount, ibcCoins any) *gomock.Call {
+func (mr *MockTokenwrapperKeeperMockRecorder) UnlockNativeTokens(ctx, receiver, amount, ibcCoins, nativeDenom any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockNativeTokens", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).UnlockNativeTokens), ctx, receiver, amount, ibcCoins)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockNativeTokens", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).UnlockNativeTokens), ctx, receiver, amount, ibcCoins, nativeDenom)
 }
 
 // UnlockTokens mocks base method.
@@ -745,3 +738,15 @@ func (mr *MockTokenwrapperKeeperMockRecorder) UnlockTokens(ctx, addr, amt any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockTokens", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).UnlockTokens), ctx, addr, amt)
 }
+
+// UpdateTransferStatus mocks base method.
+func (m *MockTokenwrapperKeeper) UpdateTransferStatus(ctx types.Context, nativePort, nativeChannel string, sequence uint64, status types3.TransferStatus, reason string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateTransferStatus", ctx, nativePort, nativeChannel, sequence, status, reason)
+}
+
+// UpdateTransferStatus indicates an expected call of UpdateTransferStatus.
+func (mr *MockTokenwrapperKeeperMockRecorder) UpdateTransferStatus(ctx, nativePort, nativeChannel, sequence, status, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransferStatus", reflect.TypeOf((*MockTokenwrapperKeeper)(nil).UpdateTransferStatus), ctx, nativePort, nativeChannel, sequence, status, reason)
+}
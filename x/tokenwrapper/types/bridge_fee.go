@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	"zigchain/zutils/validators"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// MaxBridgeFeeBps bounds the bridge fee in basis points at 10%
+const MaxBridgeFeeBps uint32 = 1_000
+
+// BridgeFeeEnabled returns whether a bridge fee is charged on the wrapped transfers
+func (p Params) BridgeFeeEnabled() bool {
+	return p.BridgeFeeBps > 0 || (!p.BridgeFeeMin.IsNil() && p.BridgeFeeMin.IsPositive())
+}
+
+// BridgeFee returns the bridge fee charged on a native amount, its share in basis points
+// raised to the minimum fee. The fee may exceed the amount, which does not cover it then.
+func (p Params) BridgeFee(amount sdkmath.Int) sdkmath.Int {
+	if !p.BridgeFeeEnabled() {
+		return sdkmath.ZeroInt()
+	}
+
+	fee := amount.MulRaw(int64(p.BridgeFeeBps)).QuoRaw(10_000)
+	if !p.BridgeFeeMin.IsNil() && fee.LT(p.BridgeFeeMin) {
+		fee = p.BridgeFeeMin
+	}
+
+	return fee
+}
+
+// Validate validates a pending bridge fee
+func (f PendingBridgeFee) Validate() error {
+	if err := validators.ValidatePort(f.NativePort); err != nil {
+		return err
+	}
+	if err := validators.ValidateChannel(f.NativeChannel); err != nil {
+		return err
+	}
+	if err := validators.AddressCheck("bridge fee sender", f.Sender); err != nil {
+		return err
+	}
+	if err := validators.AddressCheck("bridge fee recipient", f.Recipient); err != nil {
+		return err
+	}
+
+	if f.NativeDenom == "" {
+		return fmt.Errorf("%w: native denom of the bridge fee of packet %d must be set", ErrInvalidPendingBridgeFee, f.Sequence)
+	}
+	if f.Amount.IsNil() || !f.Amount.IsPositive() {
+		return fmt.Errorf("%w: bridge fee of packet %d must be positive", ErrInvalidPendingBridgeFee, f.Sequence)
+	}
+
+	return nil
+}
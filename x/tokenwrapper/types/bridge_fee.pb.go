@@ -0,0 +1,631 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zigchain/tokenwrapper/bridge_fee.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingBridgeFee is the bridge fee of a packet sent over a bridge route,
+// escrowed in the module wallet until the packet is acknowledged, when it is
+// paid to the recipient, or refunded to the sender on an error
+// acknowledgement or a timeout
+type PendingBridgeFee struct {
+	// native_port defines the native port of the bridge route
+	NativePort string `protobuf:"bytes,1,opt,name=native_port,json=nativePort,proto3" json:"native_port,omitempty"`
+	// native_channel defines the native channel of the bridge route
+	NativeChannel string `protobuf:"bytes,2,opt,name=native_channel,json=nativeChannel,proto3" json:"native_channel,omitempty"`
+	// sequence defines the sequence of the packet on the native channel
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// sender defines the sender of the packet the fee is refunded to
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient defines the fee recipient of the params when the packet was
+	// sent
+	Recipient string `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// native_denom defines the native denom of the bridge route
+	NativeDenom string `protobuf:"bytes,6,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty"`
+	// amount defines the amount of native tokens of the fee
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *PendingBridgeFee) Reset()         { *m = PendingBridgeFee{} }
+func (m *PendingBridgeFee) String() string { return proto.CompactTextString(m) }
+func (*PendingBridgeFee) ProtoMessage()    {}
+func (*PendingBridgeFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_007d1a3c81733d2b, []int{0}
+}
+func (m *PendingBridgeFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingBridgeFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingBridgeFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingBridgeFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBridgeFee.Merge(m, src)
+}
+func (m *PendingBridgeFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingBridgeFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBridgeFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingBridgeFee proto.InternalMessageInfo
+
+func (m *PendingBridgeFee) GetNativePort() string {
+	if m != nil {
+		return m.NativePort
+	}
+	return ""
+}
+
+func (m *PendingBridgeFee) GetNativeChannel() string {
+	if m != nil {
+		return m.NativeChannel
+	}
+	return ""
+}
+
+func (m *PendingBridgeFee) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingBridgeFee) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PendingBridgeFee) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *PendingBridgeFee) GetNativeDenom() string {
+	if m != nil {
+		return m.NativeDenom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*PendingBridgeFee)(nil), "zigchain.tokenwrapper.PendingBridgeFee")
+}
+
+func init() {
+	proto.RegisterFile("zigchain/tokenwrapper/bridge_fee.proto", fileDescriptor_007d1a3c81733d2b)
+}
+
+var fileDescriptor_007d1a3c81733d2b = []byte{
+	// 363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x4d, 0x4b, 0xfb, 0x40,
+	0x10, 0xc6, 0x93, 0xfe, 0xfb, 0x8f, 0x76, 0x7d, 0x41, 0x97, 0x16, 0xd6, 0x82, 0x69, 0x2d, 0x28,
+	0x45, 0x30, 0x11, 0x04, 0x05, 0x6f, 0x56, 0x11, 0xbc, 0x95, 0x78, 0xf3, 0x52, 0xd2, 0x64, 0x4c,
+	0x97, 0x9a, 0xdd, 0xb8, 0xbb, 0xf5, 0xed, 0x53, 0xf8, 0x31, 0x3c, 0x7a, 0xf0, 0xee, 0xb5, 0xc7,
+	0xe2, 0x49, 0x3c, 0x14, 0x69, 0x0f, 0x7e, 0x0d, 0x69, 0x36, 0x56, 0x3d, 0x79, 0x09, 0x33, 0xcf,
+	0xfc, 0x66, 0xf2, 0x2c, 0x0f, 0xda, 0xb8, 0xa3, 0x51, 0xd0, 0xf1, 0x29, 0x73, 0x15, 0xef, 0x02,
+	0xbb, 0x16, 0x7e, 0x92, 0x80, 0x70, 0xdb, 0x82, 0x86, 0x11, 0xb4, 0xce, 0x01, 0x9c, 0x44, 0x70,
+	0xc5, 0x71, 0xe9, 0x8b, 0x73, 0x7e, 0x72, 0xe5, 0x65, 0x3f, 0xa6, 0x8c, 0xbb, 0xe9, 0x57, 0x93,
+	0xe5, 0x62, 0xc4, 0x23, 0x9e, 0x96, 0xee, 0xa4, 0xca, 0xd4, 0x95, 0x80, 0xcb, 0x98, 0xcb, 0x96,
+	0x1e, 0xe8, 0x46, 0x8f, 0x6a, 0xcf, 0x39, 0xb4, 0xd4, 0x04, 0x16, 0x52, 0x16, 0x35, 0xd2, 0xdf,
+	0x1e, 0x03, 0xe0, 0x0a, 0x9a, 0x63, 0xbe, 0xa2, 0x57, 0xd0, 0x4a, 0xb8, 0x50, 0xc4, 0xac, 0x9a,
+	0xf5, 0x82, 0x87, 0xb4, 0xd4, 0xe4, 0x42, 0xe1, 0x75, 0xb4, 0x98, 0x01, 0x41, 0xc7, 0x67, 0x0c,
+	0x2e, 0x48, 0x2e, 0x65, 0x16, 0xb4, 0x7a, 0xa8, 0x45, 0x5c, 0x46, 0xb3, 0x12, 0x2e, 0x7b, 0xc0,
+	0x02, 0x20, 0xff, 0xaa, 0x66, 0x3d, 0xef, 0x4d, 0x7b, 0xbc, 0x8d, 0x2c, 0x09, 0x2c, 0x04, 0x41,
+	0xf2, 0x93, 0xd5, 0x06, 0x79, 0x79, 0xda, 0x2a, 0x66, 0xd6, 0x0e, 0xc2, 0x50, 0x80, 0x94, 0xa7,
+	0x4a, 0x50, 0x16, 0x79, 0x19, 0x87, 0x77, 0x51, 0x41, 0x40, 0x40, 0x13, 0x0a, 0x4c, 0x91, 0xff,
+	0x7f, 0x2c, 0x7d, 0xa3, 0x78, 0x0d, 0xcd, 0x67, 0x66, 0x43, 0x60, 0x3c, 0x26, 0x56, 0x6a, 0x35,
+	0x7b, 0xe1, 0xd1, 0x44, 0xc2, 0xfb, 0xc8, 0xf2, 0x63, 0xde, 0x63, 0x8a, 0xcc, 0xa4, 0x77, 0x6b,
+	0xfd, 0x61, 0xc5, 0x78, 0x1b, 0x56, 0x4a, 0xfa, 0xb6, 0x0c, 0xbb, 0x0e, 0xe5, 0x6e, 0xec, 0xab,
+	0x8e, 0x73, 0xc2, 0xd4, 0xc3, 0xc7, 0xe3, 0xa6, 0xe9, 0x65, 0x1b, 0x8d, 0xbd, 0xfe, 0xc8, 0x36,
+	0x07, 0x23, 0xdb, 0x7c, 0x1f, 0xd9, 0xe6, 0xfd, 0xd8, 0x36, 0x06, 0x63, 0xdb, 0x78, 0x1d, 0xdb,
+	0xc6, 0xd9, 0xea, 0x34, 0xde, 0x9b, 0xdf, 0x01, 0xab, 0xdb, 0x04, 0x64, 0xdb, 0x4a, 0x13, 0xd8,
+	0xf9, 0x1c, 0x00, 0xbc, 0xb9, 0x15, 0x7b, 0x06, 0x02, 0x00, 0x00,
+}
+
+func (m *PendingBridgeFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingBridgeFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingBridgeFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridgeFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintBridgeFee(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBridgeFee(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBridgeFee(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintBridgeFee(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NativeChannel) > 0 {
+		i -= len(m.NativeChannel)
+		copy(dAtA[i:], m.NativeChannel)
+		i = encodeVarintBridgeFee(dAtA, i, uint64(len(m.NativeChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NativePort) > 0 {
+		i -= len(m.NativePort)
+		copy(dAtA[i:], m.NativePort)
+		i = encodeVarintBridgeFee(dAtA, i, uint64(len(m.NativePort)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBridgeFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBridgeFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingBridgeFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NativePort)
+	if l > 0 {
+		n += 1 + l + sovBridgeFee(uint64(l))
+	}
+	l = len(m.NativeChannel)
+	if l > 0 {
+		n += 1 + l + sovBridgeFee(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovBridgeFee(uint64(m.Sequence))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBridgeFee(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBridgeFee(uint64(l))
+	}
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovBridgeFee(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBridgeFee(uint64(l))
+	return n
+}
+
+func sovBridgeFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBridgeFee(x uint64) (n int) {
+	return sovBridgeFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingBridgeFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridgeFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingBridgeFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingBridgeFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridgeFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridgeFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBridgeFee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBridgeFee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBridgeFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBridgeFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBridgeFee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBridgeFee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBridgeFee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBridgeFee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBridgeFee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBridgeFee = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"testing"
+
+	"zigchain/testutil/sample"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+var pendingBridgeFeeSample = PendingBridgeFee{
+	NativePort:    "transfer",
+	NativeChannel: "channel-0",
+	Sequence:      1,
+	Sender:        sample.AccAddress(),
+	Recipient:     sample.AccAddress(),
+	NativeDenom:   "uzig",
+	Amount:        sdkmath.NewInt(1_000),
+}
+
+// Positive test cases
+
+func TestParams_BridgeFee(t *testing.T) {
+	// Test case: the fee is the basis points of the amount raised to the flat minimum, zero when disabled
+
+	params := DefaultParams()
+	require.False(t, params.BridgeFeeEnabled())
+	require.Equal(t, sdkmath.ZeroInt(), params.BridgeFee(sdkmath.NewInt(1_000_000)))
+
+	params.BridgeFeeBps = 25
+	params.BridgeFeeMin = sdkmath.NewInt(1_000)
+	params.BridgeFeeRecipient = sample.AccAddress()
+	require.True(t, params.BridgeFeeEnabled())
+	require.NoError(t, params.Validate())
+
+	require.Equal(t, sdkmath.NewInt(2_500), params.BridgeFee(sdkmath.NewInt(1_000_000)))
+	require.Equal(t, sdkmath.NewInt(1_000), params.BridgeFee(sdkmath.NewInt(100_000)))
+	// the minimum may exceed a small amount, the callers reject such transfers
+	require.Equal(t, sdkmath.NewInt(1_000), params.BridgeFee(sdkmath.NewInt(500)))
+
+	params.BridgeFeeBps = 0
+	require.Equal(t, sdkmath.NewInt(1_000), params.BridgeFee(sdkmath.NewInt(1_000_000)))
+}
+
+func TestPendingBridgeFee_Validate_Positive(t *testing.T) {
+	require.NoError(t, pendingBridgeFeeSample.Validate())
+}
+
+// Negative test cases
+
+func TestParams_Validate_BridgeFee(t *testing.T) {
+	// Test case: the basis points are bounded, the minimum not negative and an enabled fee needs a valid recipient
+
+	params := DefaultParams()
+	params.BridgeFeeBps = MaxBridgeFeeBps + 1
+	params.BridgeFeeRecipient = sample.AccAddress()
+	require.ErrorContains(t, params.Validate(), "bridge fee")
+
+	params = DefaultParams()
+	params.BridgeFeeMin = sdkmath.NewInt(-1)
+	require.ErrorContains(t, params.Validate(), "bridge fee")
+
+	params = DefaultParams()
+	params.BridgeFeeBps = 25
+	require.Error(t, params.Validate())
+
+	params.BridgeFeeRecipient = "invalid_address"
+	require.Error(t, params.Validate())
+}
+
+func TestPendingBridgeFee_Validate_Invalid(t *testing.T) {
+	pendingFee := pendingBridgeFeeSample
+	pendingFee.NativeChannel = "invalid-channel"
+	require.Error(t, pendingFee.Validate())
+
+	pendingFee = pendingBridgeFeeSample
+	pendingFee.Sender = "invalid_address"
+	require.Error(t, pendingFee.Validate())
+
+	pendingFee = pendingBridgeFeeSample
+	pendingFee.Amount = sdkmath.ZeroInt()
+	require.ErrorIs(t, pendingFee.Validate(), ErrInvalidPendingBridgeFee)
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"zigchain/zutils/validators"
+
+	sdkmath "cosmossdk.io/math"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+// Validate checks the IBC settings of the route
+func (r BridgeRoute) Validate() error {
+	if err := validators.ValidateClientId(r.NativeClientId); err != nil {
+		return err
+	}
+	if err := validators.ValidateClientId(r.CounterpartyClientId); err != nil {
+		return err
+	}
+	if err := validators.ValidatePort(r.NativePort); err != nil {
+		return err
+	}
+	if err := validators.ValidatePort(r.CounterpartyPort); err != nil {
+		return err
+	}
+	if err := validators.ValidateChannel(r.NativeChannel); err != nil {
+		return err
+	}
+	if err := validators.ValidateChannel(r.CounterpartyChannel); err != nil {
+		return err
+	}
+	if err := validators.ValidateDenom(r.Denom); err != nil {
+		return err
+	}
+	if err := validators.ValidateDenom(r.NativeDenom); err != nil {
+		return err
+	}
+	if err := validators.ValidateDecimalDifference(r.DecimalDifference); err != nil {
+		return err
+	}
+
+	if !r.TotalTransferredIn.IsNil() && r.TotalTransferredIn.IsNegative() {
+		return fmt.Errorf("total transferred in of route %s/%s cannot be negative: %s", r.NativePort, r.NativeChannel, r.TotalTransferredIn)
+	}
+	if !r.TotalTransferredOut.IsNil() && r.TotalTransferredOut.IsNegative() {
+		return fmt.Errorf("total transferred out of route %s/%s cannot be negative: %s", r.NativePort, r.NativeChannel, r.TotalTransferredOut)
+	}
+
+	return nil
+}
+
+// IBCRecvDenom returns the IBC denom of the vouchers received over the route
+func (r BridgeRoute) IBCRecvDenom() string {
+	sourcePrefix := transfertypes.NewHop(r.NativePort, r.NativeChannel)
+	prefixedDenom := fmt.Sprintf("%s/%s", sourcePrefix.String(), r.Denom)
+	return transfertypes.ExtractDenomFromPath(prefixedDenom).IBCDenom()
+}
+
+// DecimalConversionFactor returns the conversion factor based on the decimal difference of the route
+func (r BridgeRoute) DecimalConversionFactor() sdkmath.Int {
+	return sdkmath.NewIntFromBigInt(big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(r.DecimalDifference)), nil))
+}
+
+// ScaleDownTokenPrecision scales an amount of IBC vouchers down to the precision of the native denom,
+// rounding down
+func (r BridgeRoute) ScaleDownTokenPrecision(amount sdkmath.Int) (sdkmath.Int, error) {
+	convertedAmount := amount.Quo(r.DecimalConversionFactor())
+
+	// Check if the converted amount is zero or negative
+	if convertedAmount.IsZero() || convertedAmount.IsNegative() {
+		return sdkmath.Int{}, fmt.Errorf("converted amount is zero or negative: %s", convertedAmount.String())
+	}
+
+	return convertedAmount, nil
+}
+
+// ScaleUpTokenPrecision scales an amount of the native denom up to the precision of the IBC vouchers
+func (r BridgeRoute) ScaleUpTokenPrecision(amount sdkmath.Int) (sdkmath.Int, error) {
+	convertedAmount := amount.Mul(r.DecimalConversionFactor())
+
+	// Check if the converted amount is zero or negative
+	if convertedAmount.IsZero() || convertedAmount.IsNegative() {
+		return sdkmath.Int{}, fmt.Errorf("converted amount is zero or negative: %s", convertedAmount.String())
+	}
+
+	return convertedAmount, nil
+}
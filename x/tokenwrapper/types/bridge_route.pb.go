@@ -0,0 +1,880 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zigchain/tokenwrapper/bridge_route.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BridgeRoute defines the IBC settings of a channel the module wraps a native
+// denom over, keyed by its native port and channel
+type BridgeRoute struct {
+	// native_port defines the port ID of the source chain
+	NativePort string `protobuf:"bytes,1,opt,name=native_port,json=nativePort,proto3" json:"native_port,omitempty"`
+	// native_channel defines the channel ID of the source chain
+	NativeChannel string `protobuf:"bytes,2,opt,name=native_channel,json=nativeChannel,proto3" json:"native_channel,omitempty"`
+	// native_client_id defines the client ID of the source chain
+	NativeClientId string `protobuf:"bytes,3,opt,name=native_client_id,json=nativeClientId,proto3" json:"native_client_id,omitempty"`
+	// counterparty_client_id defines the client ID of the destination chain
+	CounterpartyClientId string `protobuf:"bytes,4,opt,name=counterparty_client_id,json=counterpartyClientId,proto3" json:"counterparty_client_id,omitempty"`
+	// counterparty_port defines the port ID of the destination chain
+	CounterpartyPort string `protobuf:"bytes,5,opt,name=counterparty_port,json=counterpartyPort,proto3" json:"counterparty_port,omitempty"`
+	// counterparty_channel defines the channel ID of the destination chain
+	CounterpartyChannel string `protobuf:"bytes,6,opt,name=counterparty_channel,json=counterpartyChannel,proto3" json:"counterparty_channel,omitempty"`
+	// denom defines the denomination of the token on the counterparty chain
+	Denom string `protobuf:"bytes,7,opt,name=denom,proto3" json:"denom,omitempty"`
+	// native_denom defines the denomination the IBC vouchers of the route are
+	// wrapped into (e.g. uzig)
+	NativeDenom string `protobuf:"bytes,8,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty"`
+	// decimal difference between the counterparty token (e.g. 18 decimals) and
+	// the native denom (e.g. 6 decimals)
+	DecimalDifference uint32 `protobuf:"varint,9,opt,name=decimal_difference,json=decimalDifference,proto3" json:"decimal_difference,omitempty"`
+	// enabled defines whether the route wraps its transfers
+	Enabled bool `protobuf:"varint,10,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// total_transferred_in defines the total amount of native tokens transferred
+	// in over the route
+	TotalTransferredIn cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=total_transferred_in,json=totalTransferredIn,proto3,customtype=cosmossdk.io/math.Int" json:"total_transferred_in"`
+	// total_transferred_out defines the total amount of native tokens
+	// transferred out over the route
+	TotalTransferredOut cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=total_transferred_out,json=totalTransferredOut,proto3,customtype=cosmossdk.io/math.Int" json:"total_transferred_out"`
+}
+
+func (m *BridgeRoute) Reset()         { *m = BridgeRoute{} }
+func (m *BridgeRoute) String() string { return proto.CompactTextString(m) }
+func (*BridgeRoute) ProtoMessage()    {}
+func (*BridgeRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac50a5c4c0d5f250, []int{0}
+}
+func (m *BridgeRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeRoute.Merge(m, src)
+}
+func (m *BridgeRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeRoute proto.InternalMessageInfo
+
+func (m *BridgeRoute) GetNativePort() string {
+	if m != nil {
+		return m.NativePort
+	}
+	return ""
+}
+
+func (m *BridgeRoute) GetNativeChannel() string {
+	if m != nil {
+		return m.NativeChannel
+	}
+	return ""
+}
+
+func (m *BridgeRoute) GetNativeClientId() string {
+	if m != nil {
+		return m.NativeClientId
+	}
+	return ""
+}
+
+func (m *BridgeRoute) GetCounterpartyClientId() string {
+	if m != nil {
+		return m.CounterpartyClientId
+	}
+	return ""
+}
+
+func (m *BridgeRoute) GetCounterpartyPort() string {
+	if m != nil {
+		return m.CounterpartyPort
+	}
+	return ""
+}
+
+func (m *BridgeRoute) GetCounterpartyChannel() string {
+	if m != nil {
+		return m.CounterpartyChannel
+	}
+	return ""
+}
+
+func (m *BridgeRoute) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *BridgeRoute) GetNativeDenom() string {
+	if m != nil {
+		return m.NativeDenom
+	}
+	return ""
+}
+
+func (m *BridgeRoute) GetDecimalDifference() uint32 {
+	if m != nil {
+		return m.DecimalDifference
+	}
+	return 0
+}
+
+func (m *BridgeRoute) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*BridgeRoute)(nil), "zigchain.tokenwrapper.BridgeRoute")
+}
+
+func init() {
+	proto.RegisterFile("zigchain/tokenwrapper/bridge_route.proto", fileDescriptor_ac50a5c4c0d5f250)
+}
+
+var fileDescriptor_ac50a5c4c0d5f250 = []byte{
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x63, 0xe8, 0xdf, 0x49, 0x8b, 0xda, 0xad, 0x83, 0x56, 0x48, 0x75, 0x02, 0x12, 0x92,
+	0x25, 0x44, 0x22, 0x04, 0x12, 0xf7, 0xb4, 0x97, 0x9c, 0x0a, 0x16, 0x27, 0x2e, 0xd6, 0xc6, 0x3b,
+	0x49, 0x56, 0xb5, 0x77, 0xad, 0xf5, 0x18, 0x28, 0x4f, 0xc1, 0xfb, 0xf0, 0x02, 0x3d, 0xf6, 0x88,
+	0x38, 0x54, 0x28, 0x79, 0x11, 0x94, 0xb5, 0x13, 0x6c, 0xb8, 0x70, 0xf3, 0xcc, 0xf7, 0xfb, 0xbe,
+	0x19, 0xaf, 0x06, 0xc2, 0xaf, 0x6a, 0x9e, 0x2c, 0x84, 0xd2, 0x23, 0x32, 0xd7, 0xa8, 0x3f, 0x5b,
+	0x91, 0xe7, 0x68, 0x47, 0x53, 0xab, 0xe4, 0x1c, 0x63, 0x6b, 0x4a, 0xc2, 0x61, 0x6e, 0x0d, 0x19,
+	0xd6, 0xdb, 0x90, 0xc3, 0x26, 0xf9, 0xc4, 0x9f, 0x9b, 0xb9, 0x71, 0xc4, 0x68, 0xfd, 0x55, 0xc1,
+	0xcf, 0xbe, 0xef, 0x40, 0x77, 0xec, 0x32, 0xa2, 0x75, 0x04, 0xeb, 0x43, 0x57, 0x0b, 0x52, 0x9f,
+	0x30, 0xce, 0x8d, 0x25, 0xee, 0x0d, 0xbc, 0xf0, 0x30, 0x82, 0xaa, 0xf5, 0xce, 0x58, 0x62, 0xcf,
+	0xe1, 0x51, 0x0d, 0x24, 0x0b, 0xa1, 0x35, 0xa6, 0xfc, 0x81, 0x63, 0x8e, 0xab, 0xee, 0x45, 0xd5,
+	0x64, 0x21, 0x9c, 0x6c, 0xb0, 0x54, 0xa1, 0xa6, 0x58, 0x49, 0xfe, 0xd0, 0x81, 0xb5, 0xfd, 0xc2,
+	0xb5, 0x27, 0x92, 0xbd, 0x81, 0xc7, 0x89, 0x29, 0x35, 0xa1, 0xcd, 0x85, 0xa5, 0x9b, 0x06, 0xbf,
+	0xe3, 0x78, 0xbf, 0xa9, 0x6e, 0x5d, 0x2f, 0xe0, 0xb4, 0xe5, 0x72, 0xdb, 0xee, 0x3a, 0xc3, 0x49,
+	0x53, 0x70, 0x3b, 0xbf, 0x02, 0xbf, 0x3d, 0xa2, 0xde, 0x7c, 0xcf, 0xf1, 0x67, 0xad, 0x01, 0xf5,
+	0xfe, 0x3e, 0xec, 0x4a, 0xd4, 0x26, 0xe3, 0xfb, 0x8e, 0xa9, 0x0a, 0xf6, 0x14, 0x8e, 0xea, 0xbf,
+	0xaa, 0xc4, 0x03, 0x27, 0xd6, 0x2f, 0x76, 0xe9, 0x90, 0x97, 0xc0, 0x24, 0x26, 0x2a, 0x13, 0x69,
+	0x2c, 0xd5, 0x6c, 0x86, 0x16, 0x75, 0x82, 0xfc, 0x70, 0xe0, 0x85, 0xc7, 0xd1, 0x69, 0xad, 0x5c,
+	0x6e, 0x05, 0xc6, 0x61, 0x1f, 0xb5, 0x98, 0xa6, 0x28, 0x39, 0x0c, 0xbc, 0xf0, 0x20, 0xda, 0x94,
+	0xec, 0x0a, 0x7c, 0x32, 0x24, 0xd2, 0x98, 0xac, 0xd0, 0xc5, 0x0c, 0xad, 0x45, 0x19, 0x2b, 0xcd,
+	0xbb, 0xeb, 0x99, 0xe3, 0xf3, 0xdb, 0xfb, 0x7e, 0xe7, 0xe7, 0x7d, 0xbf, 0x97, 0x98, 0x22, 0x33,
+	0x45, 0x21, 0xaf, 0x87, 0xca, 0x8c, 0x32, 0x41, 0x8b, 0xe1, 0x44, 0x53, 0xc4, 0x9c, 0xf5, 0xc3,
+	0x1f, 0xe7, 0x44, 0xb3, 0xf7, 0xd0, 0xfb, 0x37, 0xd0, 0x94, 0xc4, 0x8f, 0xfe, 0x27, 0xf1, 0xec,
+	0xef, 0xc4, 0xab, 0x92, 0xc6, 0x6f, 0x6f, 0x97, 0x81, 0x77, 0xb7, 0x0c, 0xbc, 0x5f, 0xcb, 0xc0,
+	0xfb, 0xb6, 0x0a, 0x3a, 0x77, 0xab, 0xa0, 0xf3, 0x63, 0x15, 0x74, 0x3e, 0x9e, 0x6f, 0xcf, 0xf5,
+	0x4b, 0xfb, 0x60, 0xe9, 0x26, 0xc7, 0x62, 0xba, 0xe7, 0xae, 0xef, 0xf5, 0xef, 0x01, 0x00, 0x94,
+	0x68, 0xcf, 0x1e, 0xd6, 0x02, 0x00, 0x00,
+}
+
+func (m *BridgeRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalTransferredOut.Size()
+		i -= size
+		if _, err := m.TotalTransferredOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.TotalTransferredIn.Size()
+		i -= size
+		if _, err := m.TotalTransferredIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DecimalDifference != 0 {
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(m.DecimalDifference))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CounterpartyChannel) > 0 {
+		i -= len(m.CounterpartyChannel)
+		copy(dAtA[i:], m.CounterpartyChannel)
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(len(m.CounterpartyChannel)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CounterpartyPort) > 0 {
+		i -= len(m.CounterpartyPort)
+		copy(dAtA[i:], m.CounterpartyPort)
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(len(m.CounterpartyPort)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CounterpartyClientId) > 0 {
+		i -= len(m.CounterpartyClientId)
+		copy(dAtA[i:], m.CounterpartyClientId)
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(len(m.CounterpartyClientId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NativeClientId) > 0 {
+		i -= len(m.NativeClientId)
+		copy(dAtA[i:], m.NativeClientId)
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(len(m.NativeClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NativeChannel) > 0 {
+		i -= len(m.NativeChannel)
+		copy(dAtA[i:], m.NativeChannel)
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(len(m.NativeChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NativePort) > 0 {
+		i -= len(m.NativePort)
+		copy(dAtA[i:], m.NativePort)
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(len(m.NativePort)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBridgeRoute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBridgeRoute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BridgeRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NativePort)
+	if l > 0 {
+		n += 1 + l + sovBridgeRoute(uint64(l))
+	}
+	l = len(m.NativeChannel)
+	if l > 0 {
+		n += 1 + l + sovBridgeRoute(uint64(l))
+	}
+	l = len(m.NativeClientId)
+	if l > 0 {
+		n += 1 + l + sovBridgeRoute(uint64(l))
+	}
+	l = len(m.CounterpartyClientId)
+	if l > 0 {
+		n += 1 + l + sovBridgeRoute(uint64(l))
+	}
+	l = len(m.CounterpartyPort)
+	if l > 0 {
+		n += 1 + l + sovBridgeRoute(uint64(l))
+	}
+	l = len(m.CounterpartyChannel)
+	if l > 0 {
+		n += 1 + l + sovBridgeRoute(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBridgeRoute(uint64(l))
+	}
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovBridgeRoute(uint64(l))
+	}
+	if m.DecimalDifference != 0 {
+		n += 1 + sovBridgeRoute(uint64(m.DecimalDifference))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = m.TotalTransferredIn.Size()
+	n += 1 + l + sovBridgeRoute(uint64(l))
+	l = m.TotalTransferredOut.Size()
+	n += 1 + l + sovBridgeRoute(uint64(l))
+	return n
+}
+
+func sovBridgeRoute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBridgeRoute(x uint64) (n int) {
+	return sovBridgeRoute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BridgeRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridgeRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecimalDifference", wireType)
+			}
+			m.DecimalDifference = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecimalDifference |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTransferredIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalTransferredIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTransferredOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalTransferredOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridgeRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBridgeRoute(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBridgeRoute
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBridgeRoute
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBridgeRoute
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBridgeRoute
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBridgeRoute        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBridgeRoute          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBridgeRoute = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	"zigchain/x/tokenwrapper/types"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleBridgeRoute() types.BridgeRoute {
+	return types.BridgeRoute{
+		NativeClientId:       "07-tendermint-12",
+		CounterpartyClientId: "07-tendermint-24",
+		NativePort:           "transfer",
+		CounterpartyPort:     "transfer",
+		NativeChannel:        "channel-12",
+		CounterpartyChannel:  "channel-24",
+		Denom:                "axl",
+		NativeDenom:          "uzig",
+		DecimalDifference:    12,
+		Enabled:              true,
+		TotalTransferredIn:   math.ZeroInt(),
+		TotalTransferredOut:  math.ZeroInt(),
+	}
+}
+
+func TestBridgeRoute_IBCRecvDenom(t *testing.T) {
+	// Test case: the IBC denom of the vouchers is the hash of the native port, native channel and route denom
+	route := sampleBridgeRoute()
+	require.Equal(t, "ibc/148AEF32AA7274DC6AFD912A5C1478AC10246B8AEE1C8DEA6D831B752000E89F", route.IBCRecvDenom())
+
+	route.NativeChannel = "channel-13"
+	require.NotEqual(t, "ibc/148AEF32AA7274DC6AFD912A5C1478AC10246B8AEE1C8DEA6D831B752000E89F", route.IBCRecvDenom())
+}
+
+func TestBridgeRoute_ScaleTokenPrecision(t *testing.T) {
+	// Test case: amounts are scaled with the decimal difference of the route
+	route := sampleBridgeRoute()
+	require.Equal(t, math.NewInt(1_000_000_000_000), route.DecimalConversionFactor())
+
+	down, err := route.ScaleDownTokenPrecision(math.NewInt(1_500_000_000_000))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1), down)
+
+	up, err := route.ScaleUpTokenPrecision(math.NewInt(2))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(2_000_000_000_000), up)
+
+	_, err = route.ScaleDownTokenPrecision(math.NewInt(999_999_999_999))
+	require.ErrorContains(t, err, "converted amount is zero or negative")
+
+	_, err = route.ScaleUpTokenPrecision(math.ZeroInt())
+	require.ErrorContains(t, err, "converted amount is zero or negative")
+
+	route.DecimalDifference = 0
+	require.Equal(t, math.OneInt(), route.DecimalConversionFactor())
+}
+
+func TestBridgeRoute_Validate(t *testing.T) {
+	// Test case: the route validates its IBC settings, native denom and totals
+	route := sampleBridgeRoute()
+	require.NoError(t, route.Validate())
+
+	invalid := route
+	invalid.NativeDenom = ""
+	require.ErrorContains(t, invalid.Validate(), "denom cannot be empty")
+
+	invalid = route
+	invalid.DecimalDifference = 19
+	require.ErrorContains(t, invalid.Validate(), "decimal difference cannot be greater than 18")
+
+	invalid = route
+	invalid.TotalTransferredOut = math.NewInt(-1)
+	require.ErrorContains(t, invalid.Validate(), "total transferred out of route transfer/channel-12 cannot be negative")
+}
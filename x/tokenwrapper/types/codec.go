@@ -21,6 +21,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgAddPauserAddress{},
 		&MsgRemovePauserAddress{},
 		&MsgRecoverZig{},
+		&MsgSetBridgeRouteEnabled{},
+		&MsgSetVolumeCap{},
 	)
 	// this line is used by starport scaffolding # 3
 
@@ -19,4 +19,11 @@ var (
 	ErrIBCSettingsMismatch                 = sdkerrors.Register(ModuleName, 1506, "ibc settings do not match the expected values")
 	ErrNoIBCVouchersAvailableInAddress     = sdkerrors.Register(ModuleName, 1507, "no ibc vouchers available in address")
 	ErrRecoveryNotAllowedOnOperatorAddress = sdkerrors.Register(ModuleName, 1508, "recovery not allowed on operator address")
+	ErrBridgeRouteNotFound                 = sdkerrors.Register(ModuleName, 1509, "bridge route not found")
+	ErrInvalidVolumeCap                    = sdkerrors.Register(ModuleName, 1510, "invalid volume cap")
+	ErrVolumeCapExceeded                   = sdkerrors.Register(ModuleName, 1511, "volume cap exceeded")
+	ErrInvalidPendingUnwrap                = sdkerrors.Register(ModuleName, 1512, "invalid pending unwrap")
+	ErrInvalidTransferRecord               = sdkerrors.Register(ModuleName, 1513, "invalid transfer record")
+	ErrBridgeFeeExceedsAmount              = sdkerrors.Register(ModuleName, 1514, "amount does not cover the bridge fee")
+	ErrInvalidPendingBridgeFee             = sdkerrors.Register(ModuleName, 1515, "invalid pending bridge fee")
 )
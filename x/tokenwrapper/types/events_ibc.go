@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strconv"
+
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -31,6 +33,11 @@ const (
 	EventTypeModuleWalletWithdrawn    = "module_wallet_withdrawn"
 	EventTypeParamsUpdated            = "params_updated"
 	EventTypeAddressZigRecovered      = "address_zig_recovered"
+	EventTypeBridgeRouteEnabled       = "bridge_route_enabled"
+	EventTypeVolumeCapSet             = "volume_cap_set"
+	EventTypePendingUnwrapQueued      = "pending_unwrap_queued"
+	EventTypePendingUnwrapProcessed   = "pending_unwrap_processed"
+	EventTypePendingUnwrapRemoved     = "pending_unwrap_removed"
 
 	// Tokenwrapper attributes
 	AttributeKeySender               = "sender"
@@ -64,6 +71,14 @@ const (
 	AttributeKeyAddress              = "address"
 	AttributeKeyLockedIbcAmount      = "locked_ibc_amount"
 	AttributeKeyUnlockedNativeAmount = "unlocked_native_amount"
+	AttributeKeyNativeDenom          = "native_denom"
+	AttributeKeyEnabled              = "enabled"
+	AttributeKeyInboundCap           = "inbound_cap"
+	AttributeKeyOutboundCap          = "outbound_cap"
+	AttributeKeyWindow               = "window"
+	AttributeKeyPendingUnwrapId      = "pending_unwrap_id"
+	AttributeKeyReason               = "reason"
+	AttributeKeyFee                  = "fee"
 )
 
 // EmitTokenWrapperErrorEvent emits an error event for token wrapper operations
@@ -121,6 +136,7 @@ func EmitTokenWrapperPacketEvent(
 	sourceChannel string,
 	destPort string,
 	destChannel string,
+	fee sdk.Coin,
 ) {
 	// skip if event manager not available
 	if ctx.EventManager() == nil {
@@ -142,6 +158,7 @@ func EmitTokenWrapperPacketEvent(
 			sdk.NewAttribute(AttributeKeySourceChannel, sourceChannel),
 			sdk.NewAttribute(AttributeKeyDestPort, destPort),
 			sdk.NewAttribute(AttributeKeyDestChannel, destChannel),
+			sdk.NewAttribute(AttributeKeyFee, fee.String()),
 		),
 	)
 }
@@ -154,6 +171,7 @@ func EmitTokenWrapperRefundEvent(
 	refundDenom string,
 	convertedAmount sdkmath.Int,
 	convertedDenom string,
+	fee sdk.Coin,
 ) {
 	// skip if event manager not available
 	if ctx.EventManager() == nil {
@@ -168,6 +186,7 @@ func EmitTokenWrapperRefundEvent(
 			sdk.NewAttribute(AttributeKeyRefundDenom, refundDenom),
 			sdk.NewAttribute(AttributeKeyConvertedAmount, convertedAmount.String()),
 			sdk.NewAttribute(AttributeKeyConvertedDenom, convertedDenom),
+			sdk.NewAttribute(AttributeKeyFee, fee.String()),
 		),
 	)
 }
@@ -253,6 +272,7 @@ func EmitIbcSettingsUpdatedEvent(ctx sdk.Context, signer string, msg interface{}
 			sdk.NewAttribute(AttributeKeyCounterpartyChannel, settings[AttributeKeyCounterpartyChannel]),
 			sdk.NewAttribute(AttributeKeyDenom, settings[AttributeKeyDenom]),
 			sdk.NewAttribute(AttributeKeyDecimalDifference, settings[AttributeKeyDecimalDifference]),
+			sdk.NewAttribute(AttributeKeyNativeDenom, settings[AttributeKeyNativeDenom]),
 		),
 	)
 }
@@ -283,6 +303,40 @@ func EmitTokenWrapperDisabledEvent(ctx sdk.Context, signer string) {
 	)
 }
 
+// EmitBridgeRouteEnabledEvent emits an event when a bridge route is enabled or disabled
+func EmitBridgeRouteEnabledEvent(ctx sdk.Context, signer string, route BridgeRoute) {
+	if ctx.EventManager() == nil {
+		return
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeBridgeRouteEnabled,
+			sdk.NewAttribute(AttributeKeySigner, signer),
+			sdk.NewAttribute(AttributeKeyNativePort, route.NativePort),
+			sdk.NewAttribute(AttributeKeyNativeChannel, route.NativeChannel),
+			sdk.NewAttribute(AttributeKeyEnabled, strconv.FormatBool(route.Enabled)),
+		),
+	)
+}
+
+// EmitVolumeCapSetEvent emits an event when the volume cap of a bridge route is set or removed
+func EmitVolumeCapSetEvent(ctx sdk.Context, signer string, volumeCap VolumeCap) {
+	if ctx.EventManager() == nil {
+		return
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeVolumeCapSet,
+			sdk.NewAttribute(AttributeKeySigner, signer),
+			sdk.NewAttribute(AttributeKeyNativePort, volumeCap.NativePort),
+			sdk.NewAttribute(AttributeKeyNativeChannel, volumeCap.NativeChannel),
+			sdk.NewAttribute(AttributeKeyInboundCap, volumeCap.InboundCap.String()),
+			sdk.NewAttribute(AttributeKeyOutboundCap, volumeCap.OutboundCap.String()),
+			sdk.NewAttribute(AttributeKeyWindow, strconv.FormatUint(volumeCap.Window, 10)),
+		),
+	)
+}
+
 // EmitModuleWalletFundedEvent emits an event when the module wallet is funded
 func EmitModuleWalletFundedEvent(ctx sdk.Context, signer, moduleAddress string, amount sdk.Coins, balances sdk.Coins) {
 	if ctx.EventManager() == nil {
@@ -345,3 +399,56 @@ func EmitAddressZigRecovered(ctx sdk.Context, signer, address sdk.AccAddress, lo
 		),
 	)
 }
+
+// EmitPendingUnwrapQueuedEvent emits an event when an incoming transfer the module wallet
+// could not cover is queued for a later conversion
+func EmitPendingUnwrapQueuedEvent(ctx sdk.Context, pendingUnwrap PendingUnwrap) {
+	if ctx.EventManager() == nil {
+		return
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypePendingUnwrapQueued,
+			sdk.NewAttribute(AttributeKeyPendingUnwrapId, strconv.FormatUint(pendingUnwrap.Id, 10)),
+			sdk.NewAttribute(AttributeKeyAddress, pendingUnwrap.Address),
+			sdk.NewAttribute(AttributeKeyNativePort, pendingUnwrap.NativePort),
+			sdk.NewAttribute(AttributeKeyNativeChannel, pendingUnwrap.NativeChannel),
+			sdk.NewAttribute(AttributeKeyAmount, pendingUnwrap.Amount.String()),
+		),
+	)
+}
+
+// EmitPendingUnwrapProcessedEvent emits an event when a pending unwrap is converted by the begin blocker
+func EmitPendingUnwrapProcessedEvent(ctx sdk.Context, pendingUnwrap PendingUnwrap, lockedIbcCoin sdk.Coin, unlockedNativeCoin sdk.Coin, fee sdk.Coin) {
+	if ctx.EventManager() == nil {
+		return
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypePendingUnwrapProcessed,
+			sdk.NewAttribute(AttributeKeyPendingUnwrapId, strconv.FormatUint(pendingUnwrap.Id, 10)),
+			sdk.NewAttribute(AttributeKeyAddress, pendingUnwrap.Address),
+			sdk.NewAttribute(AttributeKeyNativePort, pendingUnwrap.NativePort),
+			sdk.NewAttribute(AttributeKeyNativeChannel, pendingUnwrap.NativeChannel),
+			sdk.NewAttribute(AttributeKeyLockedIbcAmount, lockedIbcCoin.String()),
+			sdk.NewAttribute(AttributeKeyUnlockedNativeAmount, unlockedNativeCoin.String()),
+			sdk.NewAttribute(AttributeKeyFee, fee.String()),
+		),
+	)
+}
+
+// EmitPendingUnwrapRemovedEvent emits an event when a pending unwrap leaves the queue without
+// being converted by the begin blocker
+func EmitPendingUnwrapRemovedEvent(ctx sdk.Context, pendingUnwrap PendingUnwrap, reason string) {
+	if ctx.EventManager() == nil {
+		return
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypePendingUnwrapRemoved,
+			sdk.NewAttribute(AttributeKeyPendingUnwrapId, strconv.FormatUint(pendingUnwrap.Id, 10)),
+			sdk.NewAttribute(AttributeKeyAddress, pendingUnwrap.Address),
+			sdk.NewAttribute(AttributeKeyReason, reason),
+		),
+	)
+}
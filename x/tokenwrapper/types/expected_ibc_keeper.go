@@ -125,26 +125,27 @@ type ICS4Wrapper interface {
 type TokenwrapperKeeper interface {
 	Logger() log.Logger
 	IsEnabled(ctx sdk.Context) bool
-	GetNativePort(ctx sdk.Context) string
-	GetCounterpartyPort(ctx sdk.Context) string
-	GetNativeChannel(ctx sdk.Context) string
-	GetCounterpartyChannel(ctx sdk.Context) string
-	GetDenom(ctx sdk.Context) string
-	GetCounterpartyClientId(ctx sdk.Context) string
-	GetNativeClientId(ctx sdk.Context) string
-	GetDecimalDifference(ctx sdk.Context) uint32
-	GetDecimalConversionFactor(ctx sdk.Context) sdkmath.Int
+	GetBridgeRoute(ctx sdk.Context, nativePort string, nativeChannel string) (BridgeRoute, bool)
 	LockTokens(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error
 	UnlockTokens(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error
-	AddToTotalTransferredIn(ctx sdk.Context, amount sdkmath.Int)
-	AddToTotalTransferredOut(ctx sdk.Context, amount sdkmath.Int)
+	AddToRouteTransferredIn(ctx sdk.Context, route BridgeRoute, amount sdkmath.Int)
+	AddToRouteTransferredOut(ctx sdk.Context, route BridgeRoute, amount sdkmath.Int)
 	BurnIbcTokens(ctx sdk.Context, amt sdk.Coins) error
 	CheckAccountBalance(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) error
 	CheckModuleBalance(ctx sdk.Context, coins sdk.Coins) error
-	GetIBCRecvDenom(ctx sdk.Context, denom string) string
-	ScaleDownTokenPrecision(ctx sdk.Context, amount sdkmath.Int) (sdkmath.Int, error)
-	ScaleUpTokenPrecision(ctx sdk.Context, amount sdkmath.Int) (sdkmath.Int, error)
-	CheckBalances(ctx sdk.Context, receiver sdk.AccAddress, amount sdkmath.Int, recvDenom string, convertedAmount sdkmath.Int) error
+	CheckBalances(ctx sdk.Context, receiver sdk.AccAddress, amount sdkmath.Int, recvDenom string, convertedAmount sdkmath.Int, nativeDenom string) error
 	LockIBCTokens(ctx sdk.Context, receiver sdk.AccAddress, amount sdkmath.Int, recvDenom string) (sdk.Coins, error)
-	UnlockNativeTokens(ctx sdk.Context, receiver sdk.AccAddress, amount sdkmath.Int, ibcCoins sdk.Coins) (sdk.Coins, error)
+	UnlockNativeTokens(ctx sdk.Context, receiver sdk.AccAddress, amount sdkmath.Int, ibcCoins sdk.Coins, nativeDenom string) (sdk.Coins, error)
+	CheckInboundVolume(ctx sdk.Context, route BridgeRoute, amount sdkmath.Int) error
+	ConsumeInboundVolume(ctx sdk.Context, route BridgeRoute, amount sdkmath.Int) error
+	ConsumeOutboundVolume(ctx sdk.Context, route BridgeRoute, amount sdkmath.Int) error
+	ReleaseOutboundVolume(ctx sdk.Context, route BridgeRoute, amount sdkmath.Int)
+	QueuePendingUnwrap(ctx sdk.Context, receiver sdk.AccAddress, route BridgeRoute, amount sdkmath.Int, sequence uint64)
+	RecordTransfer(ctx sdk.Context, record TransferRecord)
+	UpdateTransferStatus(ctx sdk.Context, nativePort string, nativeChannel string, sequence uint64, status TransferStatus, reason string)
+	GetBridgeFee(ctx sdk.Context, amount sdkmath.Int) sdkmath.Int
+	CollectInboundBridgeFee(ctx sdk.Context, route BridgeRoute, fee sdkmath.Int) error
+	EscrowOutboundBridgeFee(ctx sdk.Context, route BridgeRoute, sender sdk.AccAddress, sequence uint64, fee sdkmath.Int)
+	PayOutboundBridgeFee(ctx sdk.Context, nativePort string, nativeChannel string, sequence uint64) error
+	RefundOutboundBridgeFee(ctx sdk.Context, nativePort string, nativeChannel string, sequence uint64) (sdkmath.Int, error)
 }
@@ -24,14 +24,16 @@ func DefaultGenesis() *GenesisState {
 		ProposedOperatorAddress: DefaultOperatorAddress(),
 		PauserAddresses:         DefaultPauserAddresses(),
 		Enabled:                 DefaultEnabled(),
-		NativeClientId:          DefaultNativeClientId(),
-		CounterpartyClientId:    DefaultCounterpartyClientId(),
-		NativePort:              DefaultNativePort(),
-		CounterpartyPort:        DefaultCounterpartyPort(),
-		NativeChannel:           DefaultNativeChannel(),
-		CounterpartyChannel:     DefaultCounterpartyChannel(),
-		Denom:                   DefaultDenom(),
-		DecimalDifference:       DefaultDecimalDifference(),
+		Routes:                  []BridgeRoute{},
+		VolumeCaps:              []VolumeCap{},
+		VolumeBuckets:           []VolumeBucket{},
+		PendingUnwraps:          []PendingUnwrap{},
+		NextPendingUnwrapId:     DefaultIndex,
+		TransferRecords:         []TransferRecord{},
+		NextTransferRecordId:    DefaultIndex,
+		TotalBridgeFeesIn:       math.ZeroInt(),
+		TotalBridgeFeesOut:      math.ZeroInt(),
+		PendingBridgeFees:       []PendingBridgeFee{},
 	}
 }
 
@@ -66,38 +68,107 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
-	// Validate Client IDs
-	if err := validators.ValidateClientId(gs.NativeClientId); err != nil {
-		return err
-	}
-	if err := validators.ValidateClientId(gs.CounterpartyClientId); err != nil {
-		return err
+	// Validate bridge routes, keyed by native port and channel
+	routeIndexMap := make(map[string]struct{})
+	for _, route := range gs.Routes {
+		if err := route.Validate(); err != nil {
+			return err
+		}
+
+		index := string(BridgeRouteKey(route.NativePort, route.NativeChannel))
+		if _, ok := routeIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for bridgeRoute")
+		}
+		routeIndexMap[index] = struct{}{}
 	}
 
-	// Validate Ports
-	if err := validators.ValidatePort(gs.NativePort); err != nil {
-		return err
+	// Validate volume caps, keyed like the bridge routes
+	volumeCapIndexMap := make(map[string]struct{})
+	for _, volumeCap := range gs.VolumeCaps {
+		if err := volumeCap.Validate(); err != nil {
+			return err
+		}
+
+		index := string(BridgeRouteKey(volumeCap.NativePort, volumeCap.NativeChannel))
+		if _, ok := volumeCapIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for volumeCap")
+		}
+		volumeCapIndexMap[index] = struct{}{}
 	}
-	if err := validators.ValidatePort(gs.CounterpartyPort); err != nil {
-		return err
+
+	// Validate volume buckets, keyed by route, direction and start time
+	volumeBucketIndexMap := make(map[string]struct{})
+	for _, bucket := range gs.VolumeBuckets {
+		if err := bucket.Validate(); err != nil {
+			return err
+		}
+
+		index := string(VolumeBucketKey(bucket.NativePort, bucket.NativeChannel, bucket.Inbound, bucket.StartTime))
+		if _, ok := volumeBucketIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for volumeBucket")
+		}
+		volumeBucketIndexMap[index] = struct{}{}
 	}
 
-	// Validate Channels
-	if err := validators.ValidateChannel(gs.NativeChannel); err != nil {
-		return err
+	// Validate pending unwraps, keyed by id below the next id
+	pendingUnwrapIndexMap := make(map[uint64]struct{})
+	for _, pendingUnwrap := range gs.PendingUnwraps {
+		if err := pendingUnwrap.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := pendingUnwrapIndexMap[pendingUnwrap.Id]; ok {
+			return fmt.Errorf("duplicated index for pendingUnwrap")
+		}
+		if pendingUnwrap.Id >= gs.NextPendingUnwrapId {
+			return fmt.Errorf("pending unwrap id %d should be lower than the next id %d", pendingUnwrap.Id, gs.NextPendingUnwrapId)
+		}
+		pendingUnwrapIndexMap[pendingUnwrap.Id] = struct{}{}
 	}
-	if err := validators.ValidateChannel(gs.CounterpartyChannel); err != nil {
-		return err
+
+	// Validate transfer records, keyed by id below the next id and indexed by packet
+	transferRecordIndexMap := make(map[uint64]struct{})
+	transferRecordPacketIndexMap := make(map[string]struct{})
+	for _, record := range gs.TransferRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := transferRecordIndexMap[record.Id]; ok {
+			return fmt.Errorf("duplicated index for transferRecord")
+		}
+		if record.Id >= gs.NextTransferRecordId {
+			return fmt.Errorf("transfer record id %d should be lower than the next id %d", record.Id, gs.NextTransferRecordId)
+		}
+		transferRecordIndexMap[record.Id] = struct{}{}
+
+		packetIndex := string(TransferRecordPacketKey(record.NativePort, record.NativeChannel, record.Inbound, record.Sequence))
+		if _, ok := transferRecordPacketIndexMap[packetIndex]; ok {
+			return fmt.Errorf("duplicated packet for transferRecord")
+		}
+		transferRecordPacketIndexMap[packetIndex] = struct{}{}
 	}
 
-	// Validate Denom
-	if err := validators.ValidateDenom(gs.Denom); err != nil {
-		return err
+	// Validate the bridge fee totals are not negative
+	if !gs.TotalBridgeFeesIn.IsNil() && gs.TotalBridgeFeesIn.IsNegative() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("total bridge fees in cannot be negative: %s", gs.TotalBridgeFeesIn.String())
+	}
+	if !gs.TotalBridgeFeesOut.IsNil() && gs.TotalBridgeFeesOut.IsNegative() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("total bridge fees out cannot be negative: %s", gs.TotalBridgeFeesOut.String())
 	}
 
-	// Validate DecimalDifference
-	if err := validators.ValidateDecimalDifference(gs.DecimalDifference); err != nil {
-		return err
+	// Validate pending bridge fees, keyed by packet
+	pendingBridgeFeeIndexMap := make(map[string]struct{})
+	for _, pendingFee := range gs.PendingBridgeFees {
+		if err := pendingFee.Validate(); err != nil {
+			return err
+		}
+
+		index := string(PendingBridgeFeeKey(pendingFee.NativePort, pendingFee.NativeChannel, pendingFee.Sequence))
+		if _, ok := pendingBridgeFeeIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for pendingBridgeFee")
+		}
+		pendingBridgeFeeIndexMap[index] = struct{}{}
 	}
 
 	return nil
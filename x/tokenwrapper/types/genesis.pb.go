@@ -92,23 +92,30 @@ type GenesisState struct {
 	PauserAddresses []string `protobuf:"bytes,6,rep,name=pauser_addresses,json=pauserAddresses,proto3" json:"pauser_addresses,omitempty"`
 	// enabled defines whether the token wrapper functionality is enabled
 	Enabled bool `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	// native_client_id defines the client ID of the source chain
-	NativeClientId string `protobuf:"bytes,8,opt,name=native_client_id,json=nativeClientId,proto3" json:"native_client_id,omitempty"`
-	// native_port defines the port ID of the source chain
-	NativePort string `protobuf:"bytes,9,opt,name=native_port,json=nativePort,proto3" json:"native_port,omitempty"`
-	// native_channel defines the channel ID of the source chain
-	NativeChannel string `protobuf:"bytes,10,opt,name=native_channel,json=nativeChannel,proto3" json:"native_channel,omitempty"`
-	// denom defines the denomination of the token
-	Denom string `protobuf:"bytes,11,opt,name=denom,proto3" json:"denom,omitempty"`
-	// decimal difference between Axelar ZIG (18 decimals) and ZIGChain native ZIG
-	// (6 decimals)
-	DecimalDifference uint32 `protobuf:"varint,12,opt,name=decimal_difference,json=decimalDifference,proto3" json:"decimal_difference,omitempty"`
-	// counterparty_client_id defines the client ID of the destination chain
-	CounterpartyClientId string `protobuf:"bytes,13,opt,name=counterparty_client_id,json=counterpartyClientId,proto3" json:"counterparty_client_id,omitempty"`
-	// counterparty_port defines the port ID of the destination chain
-	CounterpartyPort string `protobuf:"bytes,14,opt,name=counterparty_port,json=counterpartyPort,proto3" json:"counterparty_port,omitempty"`
-	// counterparty_channel defines the channel ID of the destination chain
-	CounterpartyChannel string `protobuf:"bytes,15,opt,name=counterparty_channel,json=counterpartyChannel,proto3" json:"counterparty_channel,omitempty"`
+	// routes defines the bridge routes the module wraps transfers over
+	Routes []BridgeRoute `protobuf:"bytes,16,rep,name=routes,proto3" json:"routes"`
+	// volume_caps defines the volume caps of the bridge routes
+	VolumeCaps []VolumeCap `protobuf:"bytes,17,rep,name=volume_caps,json=volumeCaps,proto3" json:"volume_caps"`
+	// volume_buckets defines the buckets of the rolling windows of the volume
+	// caps
+	VolumeBuckets []VolumeBucket `protobuf:"bytes,18,rep,name=volume_buckets,json=volumeBuckets,proto3" json:"volume_buckets"`
+	// pending_unwraps defines the queue of pending unwraps
+	PendingUnwraps []PendingUnwrap `protobuf:"bytes,19,rep,name=pending_unwraps,json=pendingUnwraps,proto3" json:"pending_unwraps"`
+	// next_pending_unwrap_id defines the id of the next pending unwrap
+	NextPendingUnwrapId uint64 `protobuf:"varint,20,opt,name=next_pending_unwrap_id,json=nextPendingUnwrapId,proto3" json:"next_pending_unwrap_id,omitempty"`
+	// transfer_records defines the ledger of the wrapped transfers
+	TransferRecords []TransferRecord `protobuf:"bytes,21,rep,name=transfer_records,json=transferRecords,proto3" json:"transfer_records"`
+	// next_transfer_record_id defines the id of the next transfer record
+	NextTransferRecordId uint64 `protobuf:"varint,22,opt,name=next_transfer_record_id,json=nextTransferRecordId,proto3" json:"next_transfer_record_id,omitempty"`
+	// total_bridge_fees_in defines the total bridge fees paid on the incoming
+	// transfers
+	TotalBridgeFeesIn cosmossdk_io_math.Int `protobuf:"bytes,23,opt,name=total_bridge_fees_in,json=totalBridgeFeesIn,proto3,customtype=cosmossdk.io/math.Int" json:"total_bridge_fees_in"`
+	// total_bridge_fees_out defines the total bridge fees paid on the outgoing
+	// transfers once acknowledged
+	TotalBridgeFeesOut cosmossdk_io_math.Int `protobuf:"bytes,24,opt,name=total_bridge_fees_out,json=totalBridgeFeesOut,proto3,customtype=cosmossdk.io/math.Int" json:"total_bridge_fees_out"`
+	// pending_bridge_fees defines the bridge fees escrowed for the outgoing
+	// transfers waiting for their acknowledgement
+	PendingBridgeFees []PendingBridgeFee `protobuf:"bytes,25,rep,name=pending_bridge_fees,json=pendingBridgeFees,proto3" json:"pending_bridge_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -179,60 +186,60 @@ func (m *GenesisState) GetEnabled() bool {
 	return false
 }
 
-func (m *GenesisState) GetNativeClientId() string {
+func (m *GenesisState) GetRoutes() []BridgeRoute {
 	if m != nil {
-		return m.NativeClientId
+		return m.Routes
 	}
-	return ""
+	return nil
 }
 
-func (m *GenesisState) GetNativePort() string {
+func (m *GenesisState) GetVolumeCaps() []VolumeCap {
 	if m != nil {
-		return m.NativePort
+		return m.VolumeCaps
 	}
-	return ""
+	return nil
 }
 
-func (m *GenesisState) GetNativeChannel() string {
+func (m *GenesisState) GetVolumeBuckets() []VolumeBucket {
 	if m != nil {
-		return m.NativeChannel
+		return m.VolumeBuckets
 	}
-	return ""
+	return nil
 }
 
-func (m *GenesisState) GetDenom() string {
+func (m *GenesisState) GetPendingUnwraps() []PendingUnwrap {
 	if m != nil {
-		return m.Denom
+		return m.PendingUnwraps
 	}
-	return ""
+	return nil
 }
 
-func (m *GenesisState) GetDecimalDifference() uint32 {
+func (m *GenesisState) GetNextPendingUnwrapId() uint64 {
 	if m != nil {
-		return m.DecimalDifference
+		return m.NextPendingUnwrapId
 	}
 	return 0
 }
 
-func (m *GenesisState) GetCounterpartyClientId() string {
+func (m *GenesisState) GetTransferRecords() []TransferRecord {
 	if m != nil {
-		return m.CounterpartyClientId
+		return m.TransferRecords
 	}
-	return ""
+	return nil
 }
 
-func (m *GenesisState) GetCounterpartyPort() string {
+func (m *GenesisState) GetNextTransferRecordId() uint64 {
 	if m != nil {
-		return m.CounterpartyPort
+		return m.NextTransferRecordId
 	}
-	return ""
+	return 0
 }
 
-func (m *GenesisState) GetCounterpartyChannel() string {
+func (m *GenesisState) GetPendingBridgeFees() []PendingBridgeFee {
 	if m != nil {
-		return m.CounterpartyChannel
+		return m.PendingBridgeFees
 	}
-	return ""
+	return nil
 }
 
 func init() {
@@ -245,45 +252,58 @@ func init() {
 }
 
 var fileDescriptor_36e220e08a4f8b9d = []byte{
-	// 594 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x4f, 0x13, 0x41,
-	0x14, 0xef, 0x8a, 0x14, 0x3a, 0xfc, 0x69, 0x19, 0x8a, 0x0e, 0x24, 0x2c, 0x0d, 0xc6, 0xa4, 0xd1,
-	0xb0, 0x1b, 0xd4, 0xe8, 0x55, 0x8b, 0x89, 0xe9, 0x09, 0x5c, 0x38, 0x79, 0xd9, 0x4c, 0x77, 0x1f,
-	0x65, 0x42, 0x77, 0x66, 0x32, 0x33, 0x45, 0xf1, 0x53, 0xf8, 0x31, 0x3c, 0x7a, 0xf0, 0xe6, 0x17,
-	0xe0, 0x48, 0x3c, 0x19, 0x0f, 0xc4, 0xc0, 0xc1, 0xaf, 0x61, 0x3a, 0xb3, 0x4b, 0xff, 0x88, 0xc1,
-	0xcb, 0x66, 0xde, 0xfb, 0xfd, 0x79, 0xef, 0xcd, 0xec, 0x43, 0x0f, 0x3e, 0xb2, 0x6e, 0x72, 0x44,
-	0x19, 0x0f, 0x8d, 0x38, 0x06, 0xfe, 0x5e, 0x51, 0x29, 0x41, 0x85, 0x5d, 0xe0, 0xa0, 0x99, 0x0e,
-	0xa4, 0x12, 0x46, 0xe0, 0x95, 0x82, 0x14, 0x8c, 0x92, 0xd6, 0x96, 0x68, 0xc6, 0xb8, 0x08, 0xed,
-	0xd7, 0x31, 0xd7, 0xea, 0x5d, 0xd1, 0x15, 0xf6, 0x18, 0x0e, 0x4e, 0x79, 0x76, 0x35, 0x11, 0x3a,
-	0x13, 0x3a, 0x76, 0x80, 0x0b, 0x72, 0x68, 0xf3, 0xe6, 0xfa, 0x92, 0x2a, 0x9a, 0x15, 0x1c, 0xdf,
-	0x29, 0xc2, 0x0e, 0xd5, 0x10, 0x9e, 0x6c, 0x77, 0xc0, 0xd0, 0xed, 0x30, 0x11, 0x8c, 0x3b, 0x7c,
-	0xb3, 0x8d, 0xaa, 0x7b, 0xb4, 0xaf, 0x41, 0xbd, 0x4a, 0x53, 0x05, 0x5a, 0x83, 0xc6, 0xcf, 0x51,
-	0x85, 0x16, 0x01, 0xf1, 0x1a, 0x53, 0xcd, 0x4a, 0x8b, 0x7c, 0xff, 0xba, 0x55, 0xcf, 0x6b, 0xe7,
-	0xc4, 0x7d, 0xa3, 0x18, 0xef, 0x46, 0x43, 0xea, 0xe6, 0xb7, 0x32, 0x9a, 0x7f, 0xe3, 0x66, 0xdf,
-	0x37, 0xd4, 0x00, 0x7e, 0x89, 0xca, 0xae, 0x17, 0xe2, 0x35, 0xbc, 0xe6, 0xdc, 0x93, 0xf5, 0xe0,
-	0xc6, 0xbb, 0x08, 0xf6, 0x2c, 0xa9, 0x55, 0x39, 0xbb, 0xd8, 0x28, 0x7d, 0xfe, 0xfd, 0xe5, 0x91,
-	0x17, 0xe5, 0x3a, 0xbc, 0x8b, 0xea, 0x46, 0x18, 0xda, 0x8b, 0x8d, 0xa2, 0x5c, 0x1f, 0x82, 0x52,
-	0x90, 0xc6, 0x8c, 0x93, 0x3b, 0x0d, 0xaf, 0x59, 0x69, 0xad, 0x0f, 0x04, 0x3f, 0x2f, 0x36, 0x56,
-	0x5c, 0x67, 0x3a, 0x3d, 0x0e, 0x98, 0x08, 0x33, 0x6a, 0x8e, 0x82, 0x36, 0x37, 0x11, 0xb6, 0xd2,
-	0x83, 0xa1, 0xb2, 0xcd, 0xf1, 0x5b, 0xb4, 0xf2, 0xb7, 0xa1, 0xe8, 0x1b, 0x32, 0xf5, 0x3f, 0x8e,
-	0xcb, 0x93, 0x8e, 0xbb, 0x7d, 0x83, 0x77, 0x50, 0x4d, 0x48, 0x50, 0xd4, 0x08, 0x15, 0xe7, 0x97,
-	0x41, 0xee, 0x5a, 0xb7, 0x7f, 0xdf, 0x5a, 0xb5, 0x50, 0xe4, 0x69, 0x7c, 0x80, 0x56, 0xa5, 0x12,
-	0x52, 0xe8, 0x41, 0x3b, 0x93, 0x6e, 0xd3, 0xb7, 0xb8, 0xdd, 0x2f, 0xa4, 0xbb, 0x13, 0xae, 0x3b,
-	0xa8, 0x26, 0xed, 0xe3, 0xc6, 0xc3, 0x07, 0x2d, 0xdf, 0xf2, 0xa0, 0x55, 0x39, 0xf1, 0x3b, 0x10,
-	0x34, 0x03, 0x9c, 0x76, 0x7a, 0x90, 0x92, 0x99, 0x86, 0xd7, 0x9c, 0x8d, 0x8a, 0x10, 0x37, 0x51,
-	0x8d, 0x53, 0xc3, 0x4e, 0x20, 0x4e, 0x7a, 0x0c, 0xb8, 0x89, 0x59, 0x4a, 0x66, 0x07, 0xbd, 0x46,
-	0x8b, 0x2e, 0xbf, 0x63, 0xd3, 0xed, 0x14, 0x6f, 0xa0, 0xb9, 0x9c, 0x29, 0x85, 0x32, 0xa4, 0x62,
-	0x49, 0xc8, 0xa5, 0xf6, 0x84, 0x32, 0xf8, 0x21, 0x5a, 0x2c, 0xac, 0x8e, 0x28, 0xe7, 0xd0, 0x23,
-	0xc8, 0x72, 0x16, 0x72, 0x23, 0x97, 0xc4, 0x75, 0x34, 0x9d, 0x02, 0x17, 0x19, 0x99, 0xb3, 0xa8,
-	0x0b, 0xf0, 0x16, 0xc2, 0x29, 0x24, 0x2c, 0xa3, 0xbd, 0x38, 0x65, 0x87, 0x87, 0xa0, 0x80, 0x27,
-	0x40, 0xe6, 0x1b, 0x5e, 0x73, 0x21, 0x5a, 0xca, 0x91, 0xd7, 0xd7, 0x00, 0x7e, 0x86, 0xee, 0x25,
-	0xa2, 0xcf, 0x0d, 0x28, 0x49, 0x95, 0x39, 0x1d, 0x69, 0x7e, 0xc1, 0xba, 0xd6, 0x47, 0xd1, 0xeb,
-	0x11, 0x1e, 0xa3, 0xa5, 0x31, 0x95, 0x1d, 0x64, 0xd1, 0x0a, 0x6a, 0xa3, 0x80, 0x1d, 0x67, 0x1b,
-	0xd5, 0xc7, 0x4b, 0xe4, 0x43, 0x55, 0x2d, 0x7f, 0x79, 0xac, 0x80, 0x83, 0x5a, 0x2f, 0xce, 0x2e,
-	0x7d, 0xef, 0xfc, 0xd2, 0xf7, 0x7e, 0x5d, 0xfa, 0xde, 0xa7, 0x2b, 0xbf, 0x74, 0x7e, 0xe5, 0x97,
-	0x7e, 0x5c, 0xf9, 0xa5, 0x77, 0xeb, 0xd7, 0x6b, 0xfe, 0x61, 0x7c, 0xd1, 0xcd, 0xa9, 0x04, 0xdd,
-	0x29, 0xdb, 0x45, 0x7e, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x72, 0x74, 0xf1, 0xab, 0x8e, 0x04,
-	0x00, 0x00,
+	// 810 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcf, 0x6e, 0xdb, 0x46,
+	0x10, 0xc6, 0xcd, 0xda, 0x71, 0xec, 0x75, 0x6b, 0x49, 0x6b, 0xc9, 0x5e, 0x07, 0xb0, 0x42, 0x28,
+	0xfd, 0x23, 0xa4, 0xa8, 0x88, 0x24, 0x68, 0x7b, 0x6d, 0x65, 0xa0, 0x81, 0x7a, 0xa8, 0x55, 0x3a,
+	0xcd, 0xa1, 0x40, 0x41, 0xac, 0xc8, 0x91, 0xbc, 0xb0, 0xb4, 0xbb, 0xd8, 0x5d, 0xaa, 0x49, 0x9f,
+	0xa2, 0x8f, 0xd1, 0x63, 0x0f, 0x7d, 0x88, 0x1c, 0x83, 0x9e, 0x8a, 0x1e, 0x82, 0xc2, 0x3e, 0xf4,
+	0x0d, 0x7a, 0x2e, 0xb8, 0x5c, 0x46, 0x12, 0x23, 0xc9, 0xbe, 0x08, 0xe2, 0xcc, 0x37, 0x3f, 0x7e,
+	0x98, 0xd9, 0xe1, 0xa2, 0x07, 0xbf, 0xb0, 0x51, 0x7c, 0x41, 0x19, 0x0f, 0x8c, 0xb8, 0x04, 0xfe,
+	0xb3, 0xa2, 0x52, 0x82, 0x0a, 0x46, 0xc0, 0x41, 0x33, 0xdd, 0x91, 0x4a, 0x18, 0x81, 0x1b, 0x85,
+	0xa8, 0x33, 0x2f, 0xba, 0x57, 0xa3, 0x13, 0xc6, 0x45, 0x60, 0x7f, 0x73, 0xe5, 0xbd, 0xfa, 0x48,
+	0x8c, 0x84, 0xfd, 0x1b, 0x64, 0xff, 0x5c, 0xf4, 0x38, 0x16, 0x7a, 0x22, 0x74, 0x94, 0x27, 0xf2,
+	0x07, 0x97, 0x6a, 0x2d, 0x7f, 0xbf, 0xa4, 0x8a, 0x4e, 0x0a, 0x4d, 0x7b, 0xb9, 0x66, 0xa0, 0x58,
+	0x32, 0x82, 0x48, 0x89, 0xd4, 0x80, 0x53, 0x7e, 0xbc, 0x5c, 0x39, 0x15, 0xe3, 0x74, 0x02, 0x51,
+	0x4c, 0xa5, 0xd3, 0x3d, 0x5c, 0xf1, 0x56, 0xe0, 0x09, 0xe3, 0xa3, 0x28, 0xb5, 0x11, 0xa7, 0xfd,
+	0x74, 0xb9, 0xd6, 0x28, 0xca, 0xf5, 0x10, 0x54, 0xa4, 0x20, 0x16, 0x2a, 0x59, 0x6f, 0xc0, 0x59,
+	0x1d, 0x42, 0x61, 0xb4, 0x99, 0x37, 0x21, 0x18, 0x50, 0x0d, 0xc1, 0xf4, 0xd1, 0x00, 0x0c, 0x7d,
+	0x14, 0xc4, 0x82, 0xf1, 0x3c, 0xdf, 0xea, 0xa1, 0x4a, 0x9f, 0xa6, 0x1a, 0xd4, 0xd7, 0x49, 0xa2,
+	0x40, 0x6b, 0xd0, 0xf8, 0x0b, 0xb4, 0x4b, 0x8b, 0x07, 0xe2, 0xf9, 0x9b, 0xed, 0xdd, 0x2e, 0xf9,
+	0xf3, 0x8f, 0xcf, 0xea, 0xae, 0x9d, 0x4e, 0x78, 0x6e, 0x14, 0xe3, 0xa3, 0x70, 0x26, 0x6d, 0xfd,
+	0x87, 0xd0, 0xfb, 0x4f, 0xf3, 0x71, 0x9e, 0x1b, 0x6a, 0x00, 0x7f, 0x85, 0xb6, 0xf3, 0xf6, 0x12,
+	0xcf, 0xf7, 0xda, 0x7b, 0x8f, 0x4f, 0x3a, 0x4b, 0xc7, 0xdb, 0xe9, 0x5b, 0x51, 0x77, 0xf7, 0xd5,
+	0x9b, 0xfb, 0x1b, 0xbf, 0xfd, 0xfb, 0xfb, 0x43, 0x2f, 0x74, 0x75, 0xf8, 0x0c, 0xd5, 0x8d, 0x30,
+	0x74, 0x1c, 0x15, 0x4d, 0x50, 0x90, 0x44, 0x8c, 0x93, 0xf7, 0x7c, 0xaf, 0xbd, 0xdb, 0x3d, 0xc9,
+	0x0a, 0xfe, 0x7e, 0x73, 0xbf, 0x91, 0x3b, 0xd3, 0xc9, 0x65, 0x87, 0x89, 0x60, 0x42, 0xcd, 0x45,
+	0xa7, 0xc7, 0x4d, 0x88, 0x6d, 0xe9, 0xb3, 0x59, 0x65, 0x8f, 0xe3, 0xef, 0x51, 0xe3, 0x5d, 0xa0,
+	0x48, 0x0d, 0xd9, 0xbc, 0x0d, 0xf1, 0xa0, 0x4c, 0x3c, 0x4b, 0x0d, 0x3e, 0x45, 0x55, 0x21, 0x41,
+	0x51, 0x23, 0x54, 0xe4, 0x9a, 0x41, 0xb6, 0x7c, 0x6f, 0x6d, 0xd7, 0x2a, 0x45, 0x85, 0x0b, 0xe3,
+	0x67, 0xe8, 0x58, 0x2a, 0x21, 0x85, 0xce, 0xec, 0x94, 0x69, 0x77, 0x6e, 0xa0, 0x1d, 0x15, 0xa5,
+	0x67, 0x25, 0xea, 0x29, 0xaa, 0x4a, 0x3b, 0xdc, 0x68, 0x36, 0xd0, 0xed, 0x1b, 0x06, 0x5a, 0x91,
+	0xa5, 0xe3, 0x40, 0xd0, 0x5d, 0xe0, 0x74, 0x30, 0x86, 0x84, 0xdc, 0xf5, 0xbd, 0xf6, 0x4e, 0x58,
+	0x3c, 0x66, 0xf3, 0xb5, 0x3b, 0xa1, 0x49, 0xd5, 0xdf, 0x6c, 0xef, 0x3d, 0x6e, 0xad, 0x98, 0x6f,
+	0xd7, 0x1e, 0xca, 0x30, 0x93, 0x76, 0xb7, 0xb2, 0x0e, 0x87, 0xae, 0x0e, 0x3f, 0x45, 0x7b, 0xb3,
+	0x95, 0xd1, 0xa4, 0x66, 0x31, 0xfe, 0x0a, 0xcc, 0x73, 0xab, 0x3c, 0xa5, 0xd2, 0x41, 0xd0, 0xb4,
+	0x08, 0x68, 0xdc, 0x47, 0xfb, 0x0e, 0x34, 0x48, 0xe3, 0x4b, 0x30, 0x9a, 0x60, 0xcb, 0x7a, 0xb0,
+	0x96, 0xd5, 0xb5, 0x5a, 0x87, 0xfb, 0x60, 0x3a, 0x17, 0xd3, 0xf8, 0x1c, 0x55, 0x16, 0xb7, 0x54,
+	0x93, 0x03, 0x8b, 0xfc, 0x70, 0xd5, 0x29, 0xce, 0xd5, 0x3f, 0xd8, 0x80, 0x63, 0xee, 0xcb, 0xf9,
+	0xa0, 0xc6, 0x4f, 0xd0, 0x21, 0x87, 0x17, 0x26, 0x5a, 0x24, 0x47, 0x2c, 0x21, 0x75, 0xdf, 0x6b,
+	0x6f, 0x85, 0x07, 0x59, 0x76, 0x01, 0xd4, 0x4b, 0xf0, 0x73, 0x54, 0x2d, 0x7d, 0x03, 0x34, 0x69,
+	0x58, 0x2b, 0x1f, 0xad, 0xb0, 0x52, 0x9c, 0xd0, 0xd0, 0xaa, 0x9d, 0x97, 0x8a, 0x59, 0x88, 0x6a,
+	0xfc, 0x39, 0x3a, 0xb2, 0x66, 0x4a, 0xf0, 0xcc, 0xcd, 0xa1, 0x75, 0x53, 0xcf, 0xd2, 0x8b, 0xac,
+	0x5e, 0x82, 0xbf, 0x2b, 0x76, 0x72, 0xf6, 0xad, 0xd1, 0xd9, 0x4e, 0x1e, 0xdd, 0x66, 0x83, 0x6a,
+	0xb6, 0x34, 0x3f, 0x10, 0xdf, 0x00, 0xe8, 0x1e, 0xc7, 0x7d, 0xd4, 0x78, 0x97, 0x97, 0xad, 0x24,
+	0xb9, 0xfd, 0x92, 0xcf, 0x80, 0xd9, 0x46, 0xfe, 0x84, 0x0e, 0x8a, 0x06, 0xcf, 0x31, 0xc9, 0xb1,
+	0xed, 0xd9, 0x27, 0xeb, 0xc7, 0xf7, 0x96, 0xe4, 0xba, 0x56, 0x93, 0xa5, 0xb8, 0xfe, 0x76, 0x6b,
+	0x67, 0xa7, 0x5a, 0x0d, 0xab, 0x9c, 0x1a, 0x36, 0x85, 0x28, 0x1e, 0x33, 0xe0, 0x26, 0x62, 0x49,
+	0xb8, 0xe7, 0x22, 0x52, 0x28, 0x13, 0xee, 0x17, 0xe9, 0x0b, 0xca, 0x39, 0x8c, 0xc3, 0x3b, 0x09,
+	0x70, 0x31, 0x09, 0x71, 0x02, 0x31, 0x9b, 0xd0, 0x71, 0x94, 0xb0, 0xe1, 0x10, 0x14, 0xf0, 0x18,
+	0xc2, 0xc3, 0x58, 0xa4, 0xdc, 0x80, 0x92, 0x54, 0x99, 0x97, 0x73, 0xbc, 0xda, 0x42, 0xdc, 0x52,
+	0xeb, 0x8b, 0xd2, 0x9c, 0xdd, 0xfd, 0xf2, 0xd5, 0x55, 0xd3, 0x7b, 0x7d, 0xd5, 0xf4, 0xfe, 0xb9,
+	0x6a, 0x7a, 0xbf, 0x5e, 0x37, 0x37, 0x5e, 0x5f, 0x37, 0x37, 0xfe, 0xba, 0x6e, 0x6e, 0xfc, 0x78,
+	0xf2, 0xf6, 0x96, 0x78, 0x51, 0xba, 0x54, 0x5e, 0x4a, 0xd0, 0x83, 0x6d, 0x7b, 0x07, 0x3c, 0xf9,
+	0x7f, 0x00, 0x0f, 0x5c, 0xa6, 0xd6, 0x9c, 0x07, 0x00, 0x00,
 }
 
 func (m *PauserAddresses) Marshal() (dAtA []byte, err error) {
@@ -338,59 +358,139 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.CounterpartyChannel) > 0 {
-		i -= len(m.CounterpartyChannel)
-		copy(dAtA[i:], m.CounterpartyChannel)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CounterpartyChannel)))
-		i--
-		dAtA[i] = 0x7a
+	if len(m.PendingBridgeFees) > 0 {
+		for iNdEx := len(m.PendingBridgeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingBridgeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
 	}
-	if len(m.CounterpartyPort) > 0 {
-		i -= len(m.CounterpartyPort)
-		copy(dAtA[i:], m.CounterpartyPort)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CounterpartyPort)))
-		i--
-		dAtA[i] = 0x72
+	{
+		size := m.TotalBridgeFeesOut.Size()
+		i -= size
+		if _, err := m.TotalBridgeFeesOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.CounterpartyClientId) > 0 {
-		i -= len(m.CounterpartyClientId)
-		copy(dAtA[i:], m.CounterpartyClientId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CounterpartyClientId)))
-		i--
-		dAtA[i] = 0x6a
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	{
+		size := m.TotalBridgeFeesIn.Size()
+		i -= size
+		if _, err := m.TotalBridgeFeesIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.DecimalDifference != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.DecimalDifference))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if m.NextTransferRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextTransferRecordId))
 		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0xb0
 	}
-	if len(m.NativeChannel) > 0 {
-		i -= len(m.NativeChannel)
-		copy(dAtA[i:], m.NativeChannel)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.NativeChannel)))
-		i--
-		dAtA[i] = 0x52
+	if len(m.TransferRecords) > 0 {
+		for iNdEx := len(m.TransferRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
 	}
-	if len(m.NativePort) > 0 {
-		i -= len(m.NativePort)
-		copy(dAtA[i:], m.NativePort)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.NativePort)))
+	if m.NextPendingUnwrapId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPendingUnwrapId))
 		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.NativeClientId) > 0 {
-		i -= len(m.NativeClientId)
-		copy(dAtA[i:], m.NativeClientId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.NativeClientId)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0xa0
+	}
+	if len(m.PendingUnwraps) > 0 {
+		for iNdEx := len(m.PendingUnwraps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingUnwraps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.VolumeBuckets) > 0 {
+		for iNdEx := len(m.VolumeBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VolumeBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.VolumeCaps) > 0 {
+		for iNdEx := len(m.VolumeCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VolumeCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
 	}
 	if m.Enabled {
 		i--
@@ -513,36 +613,51 @@ func (m *GenesisState) Size() (n int) {
 	if m.Enabled {
 		n += 2
 	}
-	l = len(m.NativeClientId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.NativePort)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.VolumeCaps) > 0 {
+		for _, e := range m.VolumeCaps {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.NativeChannel)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.VolumeBuckets) > 0 {
+		for _, e := range m.VolumeBuckets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingUnwraps) > 0 {
+		for _, e := range m.PendingUnwraps {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.DecimalDifference != 0 {
-		n += 1 + sovGenesis(uint64(m.DecimalDifference))
+	if m.NextPendingUnwrapId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextPendingUnwrapId))
 	}
-	l = len(m.CounterpartyClientId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TransferRecords) > 0 {
+		for _, e := range m.TransferRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.CounterpartyPort)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.NextTransferRecordId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextTransferRecordId))
 	}
-	l = len(m.CounterpartyChannel)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	l = m.TotalBridgeFeesIn.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.TotalBridgeFeesOut.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.PendingBridgeFees) > 0 {
+		for _, e := range m.PendingBridgeFees {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
 	return n
 }
@@ -881,11 +996,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Enabled = bool(v != 0)
-		case 8:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -895,29 +1010,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NativeClientId = string(dAtA[iNdEx:postIndex])
+			m.Routes = append(m.Routes, BridgeRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativePort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeCaps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -927,29 +1044,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NativePort = string(dAtA[iNdEx:postIndex])
+			m.VolumeCaps = append(m.VolumeCaps, VolumeCap{})
+			if err := m.VolumeCaps[len(m.VolumeCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeBuckets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -959,29 +1078,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NativeChannel = string(dAtA[iNdEx:postIndex])
+			m.VolumeBuckets = append(m.VolumeBuckets, VolumeBucket{})
+			if err := m.VolumeBuckets[len(m.VolumeBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUnwraps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -991,29 +1112,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.PendingUnwraps = append(m.PendingUnwraps, PendingUnwrap{})
+			if err := m.PendingUnwraps[len(m.PendingUnwraps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 20:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecimalDifference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPendingUnwrapId", wireType)
 			}
-			m.DecimalDifference = 0
+			m.NextPendingUnwrapId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1023,16 +1146,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DecimalDifference |= uint32(b&0x7F) << shift
+				m.NextPendingUnwrapId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecords", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1042,27 +1165,48 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyClientId = string(dAtA[iNdEx:postIndex])
+			m.TransferRecords = append(m.TransferRecords, TransferRecord{})
+			if err := m.TransferRecords[len(m.TransferRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 14:
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTransferRecordId", wireType)
+			}
+			m.NextTransferRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTransferRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBridgeFeesIn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1090,11 +1234,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyPort = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalBridgeFeesIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBridgeFeesOut", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1122,7 +1268,43 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyChannel = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalBridgeFeesOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBridgeFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingBridgeFees = append(m.PendingBridgeFees, PendingBridgeFee{})
+			if err := m.PendingBridgeFees[len(m.PendingBridgeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
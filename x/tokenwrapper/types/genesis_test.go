@@ -23,21 +23,29 @@ func TestGenesisState_Validate_DefaultIsValid(t *testing.T) {
 func TestGenesisState_Validate_ValidGenesisState(t *testing.T) {
 	// Test case: valid genesis state with all fields set
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.NoError(t, err)
@@ -46,21 +54,29 @@ func TestGenesisState_Validate_ValidGenesisState(t *testing.T) {
 func TestGenesisState_Validate_ValidGenesisStateWithPauserAddresses(t *testing.T) {
 	// Test case: valid genesis state with pauser addresses
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{sample.AccAddress()},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.NoError(t, err)
@@ -69,21 +85,29 @@ func TestGenesisState_Validate_ValidGenesisStateWithPauserAddresses(t *testing.T
 func TestGenesisState_Validate_ValidNilPauserAddresses(t *testing.T) {
 	// Test case: valid genesis state with nil pauser addresses
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         nil,
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.NoError(t, err)
@@ -92,21 +116,29 @@ func TestGenesisState_Validate_ValidNilPauserAddresses(t *testing.T) {
 func TestGenesisState_Validate_ValidMaxDecimalDifference(t *testing.T) {
 	// Test case: valid genesis state with maximum decimal difference
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       18,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    18,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.NoError(t, err)
@@ -117,21 +149,29 @@ func TestGenesisState_Validate_ValidMaxDecimalDifference(t *testing.T) {
 func TestGenesisState_Validate_InvalidNegativeTotalTransferredIn(t *testing.T) {
 	// Test case: invalid genesis state with negative TotalTransferredIn
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.NewInt(-1),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -141,21 +181,29 @@ func TestGenesisState_Validate_InvalidNegativeTotalTransferredIn(t *testing.T) {
 func TestGenesisState_Validate_InvalidNegativeTotalTransferredOut(t *testing.T) {
 	// Test case: invalid genesis state with negative TotalTransferredOut
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.NewInt(-1),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -165,21 +213,29 @@ func TestGenesisState_Validate_InvalidNegativeTotalTransferredOut(t *testing.T)
 func TestGenesisState_Validate_InvalidOperatorAddress(t *testing.T) {
 	// Test case: invalid genesis state with invalid OperatorAddress
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         "invalid-address",
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -189,21 +245,29 @@ func TestGenesisState_Validate_InvalidOperatorAddress(t *testing.T) {
 func TestGenesisState_Validate_InvalidEmptyOperatorAddress(t *testing.T) {
 	// Test case: invalid genesis state with empty OperatorAddress
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         "",
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -213,21 +277,29 @@ func TestGenesisState_Validate_InvalidEmptyOperatorAddress(t *testing.T) {
 func TestGenesisState_Validate_InvalidProposedOperatorAddress(t *testing.T) {
 	// Test case: invalid genesis state with invalid ProposedOperatorAddress
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: "invalid-address",
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -237,21 +309,29 @@ func TestGenesisState_Validate_InvalidProposedOperatorAddress(t *testing.T) {
 func TestGenesisState_Validate_InvalidEmptyProposedOperatorAddress(t *testing.T) {
 	// Test case: invalid genesis state with empty ProposedOperatorAddress
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: "",
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -261,21 +341,29 @@ func TestGenesisState_Validate_InvalidEmptyProposedOperatorAddress(t *testing.T)
 func TestGenesisState_Validate_InvalidPauserAddress(t *testing.T) {
 	// Test case: invalid genesis state with invalid PauserAddresses
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{"invalid-address"},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -285,21 +373,29 @@ func TestGenesisState_Validate_InvalidPauserAddress(t *testing.T) {
 func TestGenesisState_Validate_InvalidPauserAddressesWithEmptyString(t *testing.T) {
 	// Test case: invalid genesis state with PauserAddresses containing an empty string
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{""},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -309,21 +405,29 @@ func TestGenesisState_Validate_InvalidPauserAddressesWithEmptyString(t *testing.
 func TestGenesisState_Validate_InvalidPauserAddressesWithMultipleInvalids(t *testing.T) {
 	// Test case: invalid genesis state with PauserAddresses containing multiple invalid addresses
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{"invalid1", "invalid2"},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -333,21 +437,29 @@ func TestGenesisState_Validate_InvalidPauserAddressesWithMultipleInvalids(t *tes
 func TestGenesisState_Validate_InvalidNativeClientID(t *testing.T) {
 	// Test case: invalid genesis state with invalid NativeClientId
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "invalid-client",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "invalid-client",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -357,21 +469,29 @@ func TestGenesisState_Validate_InvalidNativeClientID(t *testing.T) {
 func TestGenesisState_Validate_InvalidEmptyNativeClientId(t *testing.T) {
 	// Test case: invalid genesis state with empty NativeClientId
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -381,21 +501,29 @@ func TestGenesisState_Validate_InvalidEmptyNativeClientId(t *testing.T) {
 func TestGenesisState_Validate_InvalidNativePort(t *testing.T) {
 	// Test case: invalid genesis state with invalid NativePort
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "invalid/port",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "invalid/port",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -405,21 +533,29 @@ func TestGenesisState_Validate_InvalidNativePort(t *testing.T) {
 func TestGenesisState_Validate_InvalidEmptyNativePort(t *testing.T) {
 	// Test case: invalid genesis state with empty NativePort
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -429,21 +565,29 @@ func TestGenesisState_Validate_InvalidEmptyNativePort(t *testing.T) {
 func TestGenesisState_Validate_InvalidNativeChannel(t *testing.T) {
 	// Test case: invalid genesis state with invalid NativeChannel
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "invalid-channel",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "invalid-channel",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -453,21 +597,29 @@ func TestGenesisState_Validate_InvalidNativeChannel(t *testing.T) {
 func TestGenesisState_Validate_InvalidEmptyNativeChannel(t *testing.T) {
 	// Test case: invalid genesis state with empty NativeChannel
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -477,21 +629,29 @@ func TestGenesisState_Validate_InvalidEmptyNativeChannel(t *testing.T) {
 func TestGenesisState_Validate_InvalidDenom(t *testing.T) {
 	// Test case: invalid genesis state with invalid Denom
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "invalid/denom",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "invalid/denom",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -501,21 +661,29 @@ func TestGenesisState_Validate_InvalidDenom(t *testing.T) {
 func TestGenesisState_Validate_InvalidEmptyDenom(t *testing.T) {
 	// Test case: invalid genesis state with empty Denom
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -525,21 +693,29 @@ func TestGenesisState_Validate_InvalidEmptyDenom(t *testing.T) {
 func TestGenesisState_Validate_InvalidDecimalDifference(t *testing.T) {
 	// Test case: invalid genesis state with invalid DecimalDifference
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       256,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    256,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -549,21 +725,29 @@ func TestGenesisState_Validate_InvalidDecimalDifference(t *testing.T) {
 func TestGenesisState_Validate_InvalidOverMaxDecimalDifference(t *testing.T) {
 	// Test case: invalid genesis state with DecimalDifference over maximum allowed
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       19,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    19,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -573,21 +757,29 @@ func TestGenesisState_Validate_InvalidOverMaxDecimalDifference(t *testing.T) {
 func TestGenesisState_Validate_InvalidCounterpartyClientID(t *testing.T) {
 	// Test case: invalid genesis state with invalid CounterpartyClientId
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "invalid-client",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "invalid-client",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -597,21 +789,29 @@ func TestGenesisState_Validate_InvalidCounterpartyClientID(t *testing.T) {
 func TestGenesisState_Validate_InvalidEmptyCounterpartyClientId(t *testing.T) {
 	// Test case: invalid genesis state with empty CounterpartyClientId
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -621,21 +821,29 @@ func TestGenesisState_Validate_InvalidEmptyCounterpartyClientId(t *testing.T) {
 func TestGenesisState_Validate_InvalidCounterpartyPort(t *testing.T) {
 	// Test case: invalid genesis state with invalid CounterpartyPort
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "invalid/port",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "invalid/port",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -645,21 +853,29 @@ func TestGenesisState_Validate_InvalidCounterpartyPort(t *testing.T) {
 func TestGenesisState_Validate_InvalidEmptyCounterpartyPort(t *testing.T) {
 	// Test case: invalid genesis state with empty CounterpartyPort
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "channel-1",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "channel-1",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -669,21 +885,29 @@ func TestGenesisState_Validate_InvalidEmptyCounterpartyPort(t *testing.T) {
 func TestGenesisState_Validate_InvalidCounterpartyChannel(t *testing.T) {
 	// Test case: invalid genesis state with invalid CounterpartyChannel
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "invalid-channel",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "invalid-channel",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
@@ -693,23 +917,223 @@ func TestGenesisState_Validate_InvalidCounterpartyChannel(t *testing.T) {
 func TestGenesisState_Validate_InvalidEmptyCounterpartyChannel(t *testing.T) {
 	// Test case: invalid genesis state with empty CounterpartyChannel
 	genState := &types.GenesisState{
-		Params:                  types.NewParams(),
+		Params:                  types.DefaultParams(),
 		TotalTransferredIn:      math.ZeroInt(),
 		TotalTransferredOut:     math.ZeroInt(),
 		OperatorAddress:         sample.AccAddress(),
 		ProposedOperatorAddress: sample.AccAddress(),
 		PauserAddresses:         []string{},
 		Enabled:                 true,
-		NativeClientId:          "07-tendermint-0",
-		CounterpartyClientId:    "07-tendermint-1",
-		NativePort:              "transfer",
-		CounterpartyPort:        "transfer",
-		NativeChannel:           "channel-0",
-		CounterpartyChannel:     "",
-		Denom:                   "uzig",
-		DecimalDifference:       0,
+		Routes: []types.BridgeRoute{
+			{
+				NativeClientId:       "07-tendermint-0",
+				CounterpartyClientId: "07-tendermint-1",
+				NativePort:           "transfer",
+				CounterpartyPort:     "transfer",
+				NativeChannel:        "channel-0",
+				CounterpartyChannel:  "",
+				Denom:                "uzig",
+				DecimalDifference:    0,
+				NativeDenom:          "uzig",
+				Enabled:              true,
+				TotalTransferredIn:   math.ZeroInt(),
+				TotalTransferredOut:  math.ZeroInt(),
+			},
+		},
 	}
 	err := genState.Validate()
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "invalid channel identifier: channel cannot be empty")
 }
+
+func TestGenesisState_Validate_DuplicatedBridgeRoute(t *testing.T) {
+	// Test case: invalid genesis state with two bridge routes on the same native port and channel
+	route := types.BridgeRoute{
+		NativeClientId:       "07-tendermint-0",
+		CounterpartyClientId: "07-tendermint-1",
+		NativePort:           "transfer",
+		CounterpartyPort:     "transfer",
+		NativeChannel:        "channel-0",
+		CounterpartyChannel:  "channel-1",
+		Denom:                "uaxl",
+		NativeDenom:          "uzig",
+		DecimalDifference:    0,
+		Enabled:              true,
+		TotalTransferredIn:   math.ZeroInt(),
+		TotalTransferredOut:  math.ZeroInt(),
+	}
+	genState := types.DefaultGenesis()
+	genState.Routes = []types.BridgeRoute{route, route}
+	err := genState.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "duplicated index for bridgeRoute")
+
+	// a second route on another native channel is valid
+	other := route
+	other.NativeChannel = "channel-2"
+	genState.Routes = []types.BridgeRoute{route, other}
+	require.NoError(t, genState.Validate())
+}
+
+func TestGenesisState_Validate_InvalidBridgeRouteNativeDenom(t *testing.T) {
+	// Test case: invalid genesis state with a bridge route without native denom
+	genState := types.DefaultGenesis()
+	genState.Routes = []types.BridgeRoute{{
+		NativeClientId:       "07-tendermint-0",
+		CounterpartyClientId: "07-tendermint-1",
+		NativePort:           "transfer",
+		CounterpartyPort:     "transfer",
+		NativeChannel:        "channel-0",
+		CounterpartyChannel:  "channel-1",
+		Denom:                "uaxl",
+		TotalTransferredIn:   math.ZeroInt(),
+		TotalTransferredOut:  math.ZeroInt(),
+	}}
+	err := genState.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "denom cannot be empty")
+}
+
+func TestGenesisState_Validate_VolumeCaps(t *testing.T) {
+	// Test case: volume caps and buckets are validated and unique per route and direction
+	volumeCap := types.VolumeCap{
+		NativePort:    "transfer",
+		NativeChannel: "channel-0",
+		InboundCap:    math.NewInt(1000),
+		OutboundCap:   math.ZeroInt(),
+		Window:        3600,
+	}
+	bucket := types.VolumeBucket{
+		NativePort:    "transfer",
+		NativeChannel: "channel-0",
+		Inbound:       true,
+		StartTime:     1_700_000_000,
+		Amount:        math.NewInt(10),
+	}
+
+	genState := types.DefaultGenesis()
+	genState.VolumeCaps = []types.VolumeCap{volumeCap}
+	genState.VolumeBuckets = []types.VolumeBucket{bucket}
+	require.NoError(t, genState.Validate())
+
+	genState.VolumeCaps = []types.VolumeCap{volumeCap, volumeCap}
+	require.ErrorContains(t, genState.Validate(), "duplicated index for volumeCap")
+
+	genState.VolumeCaps = nil
+	genState.VolumeBuckets = []types.VolumeBucket{bucket, bucket}
+	require.ErrorContains(t, genState.Validate(), "duplicated index for volumeBucket")
+
+	// the same start time in the other direction is another bucket
+	outbound := bucket
+	outbound.Inbound = false
+	genState.VolumeBuckets = []types.VolumeBucket{bucket, outbound}
+	require.NoError(t, genState.Validate())
+
+	invalid := volumeCap
+	invalid.Window = 0
+	genState.VolumeCaps = []types.VolumeCap{invalid}
+	require.ErrorIs(t, genState.Validate(), types.ErrInvalidVolumeCap)
+}
+
+func TestGenesisState_Validate_PendingUnwraps(t *testing.T) {
+	// Test case: pending unwraps are validated, unique and below the next id
+	pendingUnwrap := types.PendingUnwrap{
+		Id:            1,
+		Address:       sample.AccAddress(),
+		NativePort:    "transfer",
+		NativeChannel: "channel-0",
+		Amount:        math.NewInt(1000),
+	}
+
+	genState := types.DefaultGenesis()
+	genState.PendingUnwraps = []types.PendingUnwrap{pendingUnwrap}
+	genState.NextPendingUnwrapId = 2
+	require.NoError(t, genState.Validate())
+
+	genState.PendingUnwraps = []types.PendingUnwrap{pendingUnwrap, pendingUnwrap}
+	require.ErrorContains(t, genState.Validate(), "duplicated index for pendingUnwrap")
+
+	genState.PendingUnwraps = []types.PendingUnwrap{pendingUnwrap}
+	genState.NextPendingUnwrapId = 1
+	require.ErrorContains(t, genState.Validate(), "should be lower than the next id")
+
+	invalid := pendingUnwrap
+	invalid.Amount = math.ZeroInt()
+	genState.PendingUnwraps = []types.PendingUnwrap{invalid}
+	genState.NextPendingUnwrapId = 2
+	require.ErrorIs(t, genState.Validate(), types.ErrInvalidPendingUnwrap)
+}
+
+func TestGenesisState_Validate_TransferRecords(t *testing.T) {
+	// Test case: transfer records are validated, unique by id and packet, and below the next id
+	record := types.TransferRecord{
+		Id:            1,
+		NativePort:    "transfer",
+		NativeChannel: "channel-0",
+		Sequence:      1,
+		Sender:        sample.AccAddress(),
+		Receiver:      "axelar1receiver",
+		IbcAmount:     math.NewInt(1000),
+		NativeAmount:  math.NewInt(1),
+		Status:        types.TRANSFER_STATUS_ACKED,
+	}
+
+	genState := types.DefaultGenesis()
+	genState.TransferRecords = []types.TransferRecord{record}
+	genState.NextTransferRecordId = 2
+	require.NoError(t, genState.Validate())
+
+	genState.TransferRecords = []types.TransferRecord{record, record}
+	require.ErrorContains(t, genState.Validate(), "duplicated index for transferRecord")
+
+	samePacket := record
+	samePacket.Id = 2
+	genState.TransferRecords = []types.TransferRecord{record, samePacket}
+	genState.NextTransferRecordId = 3
+	require.ErrorContains(t, genState.Validate(), "duplicated packet for transferRecord")
+
+	// a received packet of the same sequence is another packet
+	samePacket.Inbound = true
+	genState.TransferRecords = []types.TransferRecord{record, samePacket}
+	require.NoError(t, genState.Validate())
+
+	genState.TransferRecords = []types.TransferRecord{record}
+	genState.NextTransferRecordId = 1
+	require.ErrorContains(t, genState.Validate(), "should be lower than the next id")
+
+	invalid := record
+	invalid.Status = types.TRANSFER_STATUS_UNSPECIFIED
+	genState.TransferRecords = []types.TransferRecord{invalid}
+	genState.NextTransferRecordId = 2
+	require.ErrorIs(t, genState.Validate(), types.ErrInvalidTransferRecord)
+}
+
+func TestGenesisState_Validate_BridgeFees(t *testing.T) {
+	// Test case: the bridge fee totals are not negative and the escrowed bridge fees are validated and unique by packet
+	pendingFee := types.PendingBridgeFee{
+		NativePort:    "transfer",
+		NativeChannel: "channel-0",
+		Sequence:      1,
+		Sender:        sample.AccAddress(),
+		Recipient:     sample.AccAddress(),
+		NativeDenom:   "uzig",
+		Amount:        math.NewInt(1000),
+	}
+
+	genState := types.DefaultGenesis()
+	genState.TotalBridgeFeesIn = math.NewInt(10)
+	genState.PendingBridgeFees = []types.PendingBridgeFee{pendingFee}
+	require.NoError(t, genState.Validate())
+
+	genState.PendingBridgeFees = []types.PendingBridgeFee{pendingFee, pendingFee}
+	require.ErrorContains(t, genState.Validate(), "duplicated index for pendingBridgeFee")
+
+	invalid := pendingFee
+	invalid.Amount = math.ZeroInt()
+	genState.PendingBridgeFees = []types.PendingBridgeFee{invalid}
+	require.ErrorIs(t, genState.Validate(), types.ErrInvalidPendingBridgeFee)
+
+	genState.PendingBridgeFees = nil
+	genState.TotalBridgeFeesOut = math.NewInt(-1)
+	require.Error(t, genState.Validate())
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "tokenwrapper"
@@ -27,7 +31,8 @@ var (
 	EnabledKey                 = []byte("enabled")
 	PauserAddressesKey         = []byte("pauser_addresses")
 
-	// Store keys for IBC parameters
+	// Store keys of the IBC settings of the single route the module used to wrap,
+	// only read by the migration moving them into the bridge routes
 	NativeClientIdKey       = []byte("native_client_id")
 	CounterpartyClientIdKey = []byte("counterparty_client_id")
 	NativePortKey           = []byte("native_port")
@@ -36,8 +41,129 @@ var (
 	CounterpartyChannelKey  = []byte("counterparty_channel")
 	DenomKey                = []byte("denom")
 	DecimalDifferenceKey    = []byte("decimal_difference")
+
+	// BridgeRouteKeyPrefix is the prefix of the bridge routes, keyed by native port and channel
+	BridgeRouteKeyPrefix = []byte("bridge_route/")
+
+	// VolumeCapKeyPrefix is the prefix of the volume caps, keyed like the bridge routes
+	VolumeCapKeyPrefix = []byte("volume_cap/")
+
+	// VolumeBucketKeyPrefix is the prefix of the buckets of the volume cap windows
+	VolumeBucketKeyPrefix = []byte("volume_bucket/")
+
+	// PendingUnwrapKeyPrefix is the prefix of the pending unwraps, keyed by id in queue order
+	PendingUnwrapKeyPrefix = []byte("pending_unwrap/")
+
+	// PendingUnwrapAddressKeyPrefix is the prefix of the index of the pending unwraps by address
+	PendingUnwrapAddressKeyPrefix = []byte("pending_unwrap_address/")
+
+	// NextPendingUnwrapIdKey is the store key of the id of the next pending unwrap
+	NextPendingUnwrapIdKey = []byte("next_pending_unwrap_id")
+
+	// PendingUnwrapCursorKey is the store key of the id the begin blocker resumes the queue from
+	PendingUnwrapCursorKey = []byte("pending_unwrap_cursor")
+
+	// TransferRecordKeyPrefix is the prefix of the transfer records, keyed by id in ledger order
+	TransferRecordKeyPrefix = []byte("transfer_record/")
+
+	// TransferRecordPacketKeyPrefix is the prefix of the index of the transfer records by packet
+	TransferRecordPacketKeyPrefix = []byte("transfer_record_packet/")
+
+	// TransferRecordAddressKeyPrefix is the prefix of the index of the transfer records by sender and receiver
+	TransferRecordAddressKeyPrefix = []byte("transfer_record_address/")
+
+	// TransferRecordStatusKeyPrefix is the prefix of the index of the transfer records by status
+	TransferRecordStatusKeyPrefix = []byte("transfer_record_status/")
+
+	// NextTransferRecordIdKey is the store key of the id of the next transfer record
+	NextTransferRecordIdKey = []byte("next_transfer_record_id")
+
+	// Store keys for tracking the bridge fees paid
+	TotalBridgeFeesInKey  = []byte("total_bridge_fees_in")
+	TotalBridgeFeesOutKey = []byte("total_bridge_fees_out")
+
+	// PendingBridgeFeeKeyPrefix is the prefix of the bridge fees escrowed for the sent packets, keyed by packet
+	PendingBridgeFeeKeyPrefix = []byte("pending_bridge_fee/")
 )
 
+// BridgeRouteKey returns the store key of the bridge route of a native port and channel,
+// IBC identifiers can not contain a slash
+func BridgeRouteKey(nativePort string, nativeChannel string) []byte {
+	return []byte(nativePort + "/" + nativeChannel)
+}
+
+// VolumeBucketsPrefix returns the store prefix of the volume buckets of a bridge route in one direction
+func VolumeBucketsPrefix(nativePort string, nativeChannel string, inbound bool) []byte {
+	direction := "out"
+	if inbound {
+		direction = "in"
+	}
+	return []byte(nativePort + "/" + nativeChannel + "/" + direction + "/")
+}
+
+// VolumeBucketKey returns the store key of the volume bucket of a bridge route in one direction
+// starting at a unix time
+func VolumeBucketKey(nativePort string, nativeChannel string, inbound bool, startTime int64) []byte {
+	// #nosec G115 -- block times are positive
+	return append(VolumeBucketsPrefix(nativePort, nativeChannel, inbound), sdk.Uint64ToBigEndian(uint64(startTime))...)
+}
+
+// PendingUnwrapKey returns the store key of a pending unwrap
+func PendingUnwrapKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// PendingUnwrapAddressPrefix returns the store prefix of the pending unwraps of an address
+func PendingUnwrapAddressPrefix(address string) []byte {
+	return []byte(address + "/")
+}
+
+// PendingUnwrapAddressKey returns the store key of a pending unwrap in the index by address
+func PendingUnwrapAddressKey(address string, id uint64) []byte {
+	return append(PendingUnwrapAddressPrefix(address), PendingUnwrapKey(id)...)
+}
+
+// TransferRecordKey returns the store key of a transfer record
+func TransferRecordKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// TransferRecordPacketKey returns the store key of a packet in the index of the transfer records,
+// the sequences of the received and sent packets of a channel are distinct
+func TransferRecordPacketKey(nativePort string, nativeChannel string, inbound bool, sequence uint64) []byte {
+	direction := "out"
+	if inbound {
+		direction = "in"
+	}
+	return append([]byte(nativePort+"/"+nativeChannel+"/"+direction+"/"), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// TransferRecordAddressPrefix returns the store prefix of the transfer records of an address
+func TransferRecordAddressPrefix(address string) []byte {
+	return []byte(address + "/")
+}
+
+// TransferRecordAddressKey returns the store key of a transfer record in the index by address
+func TransferRecordAddressKey(address string, id uint64) []byte {
+	return append(TransferRecordAddressPrefix(address), TransferRecordKey(id)...)
+}
+
+// TransferRecordStatusPrefix returns the store prefix of the transfer records of a status
+func TransferRecordStatusPrefix(status TransferStatus) []byte {
+	// #nosec G115 -- the statuses are positive
+	return sdk.Uint64ToBigEndian(uint64(status))
+}
+
+// TransferRecordStatusKey returns the store key of a transfer record in the index by status
+func TransferRecordStatusKey(status TransferStatus, id uint64) []byte {
+	return append(TransferRecordStatusPrefix(status), TransferRecordKey(id)...)
+}
+
+// PendingBridgeFeeKey returns the store key of the bridge fee escrowed for a packet sent on a native port and channel
+func PendingBridgeFeeKey(nativePort string, nativeChannel string, sequence uint64) []byte {
+	return append([]byte(nativePort+"/"+nativeChannel+"/"), sdk.Uint64ToBigEndian(sequence)...)
+}
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgSetBridgeRouteEnabled{}
+
+func NewMsgSetBridgeRouteEnabled(signer string, nativePort string, nativeChannel string, enabled bool) *MsgSetBridgeRouteEnabled {
+	return &MsgSetBridgeRouteEnabled{
+		Signer:        signer,
+		NativePort:    nativePort,
+		NativeChannel: nativeChannel,
+		Enabled:       enabled,
+	}
+}
+
+func (msg *MsgSetBridgeRouteEnabled) ValidateBasic() error {
+
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	if err := validators.ValidatePort(msg.NativePort); err != nil {
+		return err
+	}
+
+	if err := validators.ValidateChannel(msg.NativeChannel); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"testing"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+var MsgSetBridgeRouteEnabledSample = MsgSetBridgeRouteEnabled{
+	Signer:        sample.AccAddress(),
+	NativePort:    "transfer",
+	NativeChannel: "channel-0",
+	Enabled:       false,
+}
+
+// Positive test cases
+
+func TestMsgSetBridgeRouteEnabled_NewMsgSetBridgeRouteEnabled_Positive(t *testing.T) {
+	// Test case: Valid input data
+
+	signer := sample.AccAddress()
+
+	// create a new MsgSetBridgeRouteEnabled instance
+	msg := NewMsgSetBridgeRouteEnabled(signer, "transfer", "channel-0", true)
+
+	// validate the fields
+	require.NotNil(t, msg, "expected the message to be non-nil")
+	require.Equal(t, signer, msg.Signer, "expected the signer to match the input signer")
+	require.Equal(t, "transfer", msg.NativePort)
+	require.Equal(t, "channel-0", msg.NativeChannel)
+	require.True(t, msg.Enabled)
+	require.NoError(t, msg.ValidateBasic())
+}
+
+func TestMsgSetBridgeRouteEnabled_ValidateBasic_Positive(t *testing.T) {
+	// Test case: Validate basic properties of MsgSetBridgeRouteEnabled
+
+	// make a copy of sample message
+	msg := MsgSetBridgeRouteEnabledSample
+
+	// validate the message
+	require.NoError(t, msg.ValidateBasic())
+}
+
+// Negative test cases
+
+func TestMsgSetBridgeRouteEnabled_ValidateBasic_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid Signer address
+
+	// make a copy of sample message
+	msg := MsgSetBridgeRouteEnabledSample
+
+	// set invalid signer address and check for errors
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgSetBridgeRouteEnabled_ValidateBasic_InvalidRoute(t *testing.T) {
+	// Test case: the native port and channel of the route must be valid IBC identifiers
+
+	msg := MsgSetBridgeRouteEnabledSample
+	msg.NativePort = ""
+	require.ErrorContains(t, msg.ValidateBasic(), "port cannot be empty")
+
+	msg = MsgSetBridgeRouteEnabledSample
+	msg.NativeChannel = "invalid-channel"
+	require.ErrorContains(t, msg.ValidateBasic(), "invalid channel ID format")
+}
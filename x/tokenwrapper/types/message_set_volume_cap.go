@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zigchain/zutils/validators"
+)
+
+var _ sdk.Msg = &MsgSetVolumeCap{}
+
+func NewMsgSetVolumeCap(signer string, nativePort string, nativeChannel string, inboundCap sdkmath.Int, outboundCap sdkmath.Int, window uint64) *MsgSetVolumeCap {
+	return &MsgSetVolumeCap{
+		Signer:        signer,
+		NativePort:    nativePort,
+		NativeChannel: nativeChannel,
+		InboundCap:    inboundCap,
+		OutboundCap:   outboundCap,
+		Window:        window,
+	}
+}
+
+// VolumeCap returns the volume cap set by the message
+func (msg *MsgSetVolumeCap) VolumeCap() VolumeCap {
+	return VolumeCap{
+		NativePort:    msg.NativePort,
+		NativeChannel: msg.NativeChannel,
+		InboundCap:    msg.InboundCap,
+		OutboundCap:   msg.OutboundCap,
+		Window:        msg.Window,
+	}
+}
+
+// IsRemoval returns true if the message removes the volume cap of the route
+func (msg *MsgSetVolumeCap) IsRemoval() bool {
+	return msg.InboundCap.IsZero() && msg.OutboundCap.IsZero()
+}
+
+func (msg *MsgSetVolumeCap) ValidateBasic() error {
+
+	if err := validators.SignerCheck(msg.Signer); err != nil {
+		return err
+	}
+
+	if msg.InboundCap.IsNil() || msg.OutboundCap.IsNil() {
+		return ErrInvalidVolumeCap.Wrap("inbound and outbound caps must be set")
+	}
+
+	if msg.IsRemoval() {
+		if err := validators.ValidatePort(msg.NativePort); err != nil {
+			return err
+		}
+		return validators.ValidateChannel(msg.NativeChannel)
+	}
+
+	return msg.VolumeCap().Validate()
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"testing"
+
+	errorPacks "zigchain/testutil/data"
+	types "zigchain/testutil/helpers"
+	"zigchain/testutil/sample"
+
+	sdkmath "cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+var MsgSetVolumeCapSample = MsgSetVolumeCap{
+	Signer:        sample.AccAddress(),
+	NativePort:    "transfer",
+	NativeChannel: "channel-0",
+	InboundCap:    sdkmath.NewInt(1000),
+	OutboundCap:   sdkmath.NewInt(1000),
+	Window:        86400,
+}
+
+// Positive test cases
+
+func TestMsgSetVolumeCap_NewMsgSetVolumeCap_Positive(t *testing.T) {
+	// Test case: Valid input data
+
+	signer := sample.AccAddress()
+
+	// create a new MsgSetVolumeCap instance
+	msg := NewMsgSetVolumeCap(signer, "transfer", "channel-0", sdkmath.NewInt(1000), sdkmath.ZeroInt(), 3600)
+
+	// validate the fields
+	require.NotNil(t, msg, "expected the message to be non-nil")
+	require.Equal(t, signer, msg.Signer, "expected the signer to match the input signer")
+	require.Equal(t, VolumeCap{
+		NativePort:    "transfer",
+		NativeChannel: "channel-0",
+		InboundCap:    sdkmath.NewInt(1000),
+		OutboundCap:   sdkmath.ZeroInt(),
+		Window:        3600,
+	}, msg.VolumeCap())
+	require.False(t, msg.IsRemoval())
+	require.NoError(t, msg.ValidateBasic())
+}
+
+func TestMsgSetVolumeCap_ValidateBasic_Removal(t *testing.T) {
+	// Test case: zero caps remove the volume cap of the route, the window is not checked
+
+	msg := MsgSetVolumeCapSample
+	msg.InboundCap = sdkmath.ZeroInt()
+	msg.OutboundCap = sdkmath.ZeroInt()
+	msg.Window = 0
+
+	require.True(t, msg.IsRemoval())
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.NativeChannel = "invalid-channel"
+	require.ErrorContains(t, msg.ValidateBasic(), "invalid channel ID format")
+}
+
+// Negative test cases
+
+func TestMsgSetVolumeCap_ValidateBasic_InvalidSignerAddress(t *testing.T) {
+	// Test case: invalid Signer address
+
+	// make a copy of sample message
+	msg := MsgSetVolumeCapSample
+
+	// set invalid signer address and check for errors
+	types.CheckMessageStringFieldErrors(
+		t,
+		&msg,
+		&msg.Signer,
+		&errorPacks.InvalidSignerAddress,
+		sdkerrors.ErrInvalidAddress,
+	)
+}
+
+func TestMsgSetVolumeCap_ValidateBasic_InvalidVolumeCap(t *testing.T) {
+	// Test case: the caps must be set and non-negative and the window positive
+
+	msg := MsgSetVolumeCapSample
+	msg.InboundCap = sdkmath.Int{}
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidVolumeCap)
+
+	msg = MsgSetVolumeCapSample
+	msg.OutboundCap = sdkmath.NewInt(-1)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidVolumeCap)
+
+	msg = MsgSetVolumeCapSample
+	msg.Window = 0
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidVolumeCap)
+}
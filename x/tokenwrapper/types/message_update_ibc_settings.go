@@ -8,7 +8,7 @@ import (
 
 var _ sdk.Msg = &MsgFundModuleWallet{}
 
-func NewMsgUpdateIbcSettings(signer string, nativeClientId string, counterpartyClientId string, nativePort string, counterpartyPort string, nativeChannel string, counterpartyChannel string, denom string, decimalDifference uint32) *MsgUpdateIbcSettings {
+func NewMsgUpdateIbcSettings(signer string, nativeClientId string, counterpartyClientId string, nativePort string, counterpartyPort string, nativeChannel string, counterpartyChannel string, denom string, decimalDifference uint32, nativeDenom string) *MsgUpdateIbcSettings {
 	return &MsgUpdateIbcSettings{
 		Signer:               signer,
 		NativeClientId:       nativeClientId,
@@ -19,6 +19,7 @@ func NewMsgUpdateIbcSettings(signer string, nativeClientId string, counterpartyC
 		CounterpartyChannel:  counterpartyChannel,
 		Denom:                denom,
 		DecimalDifference:    decimalDifference,
+		NativeDenom:          nativeDenom,
 	}
 }
 
@@ -60,5 +61,12 @@ func (msg *MsgUpdateIbcSettings) ValidateBasic() error {
 		return err
 	}
 
+	// The native denom defaults to uzig when empty
+	if msg.NativeDenom != "" {
+		if err := validators.ValidateDenom(msg.NativeDenom); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
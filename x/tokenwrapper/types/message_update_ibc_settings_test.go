@@ -42,7 +42,7 @@ func TestMsgUpdateIbcSettings_NewMsgUpdateIbcSettings_Positive(t *testing.T) {
 	decimalDifference := uint32(12)
 
 	// create a new MsgUpdateIbcSettings instance
-	msg := NewMsgUpdateIbcSettings(signer, nativeClientId, counterpartyClientId, nativePort, counterpartyPort, nativeChannel, counterpartyChannel, denom, decimalDifference)
+	msg := NewMsgUpdateIbcSettings(signer, nativeClientId, counterpartyClientId, nativePort, counterpartyPort, nativeChannel, counterpartyChannel, denom, decimalDifference, "")
 
 	// validate the fields
 	require.NotNil(t, msg, "expected the message to be non-nil")
@@ -1,6 +1,11 @@
 package types
 
 import (
+	"fmt"
+
+	"zigchain/zutils/validators"
+
+	sdkmath "cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -12,13 +17,31 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	maxInboundVolumeCap sdkmath.Int,
+	maxOutboundVolumeCap sdkmath.Int,
+	minVolumeCapWindow uint64,
+	pendingUnwrapGasBudget uint64,
+	transferRecordRetention uint64,
+	bridgeFeeBps uint32,
+	bridgeFeeMin sdkmath.Int,
+	bridgeFeeRecipient string,
+) Params {
+	return Params{
+		MaxInboundVolumeCap:     maxInboundVolumeCap,
+		MaxOutboundVolumeCap:    maxOutboundVolumeCap,
+		MinVolumeCapWindow:      minVolumeCapWindow,
+		PendingUnwrapGasBudget:  pendingUnwrapGasBudget,
+		TransferRecordRetention: transferRecordRetention,
+		BridgeFeeBps:            bridgeFeeBps,
+		BridgeFeeMin:            bridgeFeeMin,
+		BridgeFeeRecipient:      bridgeFeeRecipient,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(sdkmath.ZeroInt(), sdkmath.ZeroInt(), 0, DefaultPendingUnwrapGasBudget, 0, 0, sdkmath.ZeroInt(), "")
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -28,5 +51,29 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if !p.MaxInboundVolumeCap.IsNil() && p.MaxInboundVolumeCap.IsNegative() {
+		return fmt.Errorf("max inbound volume cap cannot be negative: %s", p.MaxInboundVolumeCap)
+	}
+	if !p.MaxOutboundVolumeCap.IsNil() && p.MaxOutboundVolumeCap.IsNegative() {
+		return fmt.Errorf("max outbound volume cap cannot be negative: %s", p.MaxOutboundVolumeCap)
+	}
+	if p.MinVolumeCapWindow > MaxVolumeCapWindow {
+		return fmt.Errorf("min volume cap window %d exceeds the maximum of %d seconds", p.MinVolumeCapWindow, MaxVolumeCapWindow)
+	}
+	if p.PendingUnwrapGasBudget > MaxPendingUnwrapGasBudget {
+		return fmt.Errorf("pending unwrap gas budget %d exceeds the maximum of %d", p.PendingUnwrapGasBudget, MaxPendingUnwrapGasBudget)
+	}
+	if p.BridgeFeeBps > MaxBridgeFeeBps {
+		return fmt.Errorf("bridge fee of %d basis points exceeds the maximum of %d", p.BridgeFeeBps, MaxBridgeFeeBps)
+	}
+	if !p.BridgeFeeMin.IsNil() && p.BridgeFeeMin.IsNegative() {
+		return fmt.Errorf("bridge fee minimum cannot be negative: %s", p.BridgeFeeMin)
+	}
+	if p.BridgeFeeRecipient != "" || p.BridgeFeeEnabled() {
+		if err := validators.AddressCheck("bridge fee recipient", p.BridgeFeeRecipient); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,6 +28,30 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// max_inbound_volume_cap bounds the inbound volume caps the operator can set
+	// on the bridge routes, zero means no bound
+	MaxInboundVolumeCap cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_inbound_volume_cap,json=maxInboundVolumeCap,proto3,customtype=cosmossdk.io/math.Int" json:"max_inbound_volume_cap"`
+	// max_outbound_volume_cap bounds the outbound volume caps the operator can
+	// set on the bridge routes, zero means no bound
+	MaxOutboundVolumeCap cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=max_outbound_volume_cap,json=maxOutboundVolumeCap,proto3,customtype=cosmossdk.io/math.Int" json:"max_outbound_volume_cap"`
+	// min_volume_cap_window is the shortest window, in seconds, of the volume
+	// caps the operator can set
+	MinVolumeCapWindow uint64 `protobuf:"varint,3,opt,name=min_volume_cap_window,json=minVolumeCapWindow,proto3" json:"min_volume_cap_window,omitempty"`
+	// pending_unwrap_gas_budget is the gas the begin blocker can spend per block
+	// on converting the pending unwraps, zero disables the automatic conversion
+	PendingUnwrapGasBudget uint64 `protobuf:"varint,4,opt,name=pending_unwrap_gas_budget,json=pendingUnwrapGasBudget,proto3" json:"pending_unwrap_gas_budget,omitempty"`
+	// transfer_record_retention is the number of blocks the transfer records
+	// are kept in the ledger before being pruned, zero keeps them forever
+	TransferRecordRetention uint64 `protobuf:"varint,5,opt,name=transfer_record_retention,json=transferRecordRetention,proto3" json:"transfer_record_retention,omitempty"`
+	// bridge_fee_bps is the fee, in basis points of the native amount, charged
+	// on the transfers wrapped in both directions
+	BridgeFeeBps uint32 `protobuf:"varint,6,opt,name=bridge_fee_bps,json=bridgeFeeBps,proto3" json:"bridge_fee_bps,omitempty"`
+	// bridge_fee_min is the minimum fee in native tokens charged on the
+	// transfers wrapped in both directions, zero with a zero bridge_fee_bps
+	// disables the fee
+	BridgeFeeMin cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=bridge_fee_min,json=bridgeFeeMin,proto3,customtype=cosmossdk.io/math.Int" json:"bridge_fee_min"`
+	// bridge_fee_recipient is the address the bridge fees accrue to
+	BridgeFeeRecipient string `protobuf:"bytes,8,opt,name=bridge_fee_recipient,json=bridgeFeeRecipient,proto3" json:"bridge_fee_recipient,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +87,41 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMinVolumeCapWindow() uint64 {
+	if m != nil {
+		return m.MinVolumeCapWindow
+	}
+	return 0
+}
+
+func (m *Params) GetPendingUnwrapGasBudget() uint64 {
+	if m != nil {
+		return m.PendingUnwrapGasBudget
+	}
+	return 0
+}
+
+func (m *Params) GetTransferRecordRetention() uint64 {
+	if m != nil {
+		return m.TransferRecordRetention
+	}
+	return 0
+}
+
+func (m *Params) GetBridgeFeeBps() uint32 {
+	if m != nil {
+		return m.BridgeFeeBps
+	}
+	return 0
+}
+
+func (m *Params) GetBridgeFeeRecipient() string {
+	if m != nil {
+		return m.BridgeFeeRecipient
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "zigchain.tokenwrapper.Params")
 }
@@ -70,17 +131,37 @@ func init() {
 }
 
 var fileDescriptor_f2431575cb7f1d71 = []byte{
-	// 159 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xaa, 0xca, 0x4c, 0x4f,
-	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x2b, 0x2f, 0x4a, 0x2c, 0x28, 0x48,
-	0x2d, 0xd2, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x85, 0xa9, 0xd1, 0x43, 0x56, 0x23, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21,
-	0x2a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0x64, 0xc8,
-	0xc5, 0x16, 0x00, 0x36, 0xcf, 0x4a, 0xfd, 0xc5, 0x02, 0x79, 0xc6, 0xae, 0xe7, 0x1b, 0xb4, 0xe4,
-	0xe0, 0xd6, 0x56, 0xa0, 0x5a, 0x0c, 0x51, 0xe8, 0x64, 0x7e, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47,
-	0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d,
-	0xc7, 0x72, 0x0c, 0x51, 0xb2, 0xb8, 0x74, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xad,
-	0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x26, 0xbc, 0x0b, 0xf9, 0xd8, 0x00, 0x00, 0x00,
+	// 470 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0x13, 0x4f,
+	0x18, 0xc6, 0xb3, 0xff, 0x7f, 0x8c, 0xba, 0xa8, 0xe0, 0x98, 0xb4, 0x9b, 0x82, 0x9b, 0x10, 0x04,
+	0x43, 0xc1, 0x2c, 0xe2, 0x41, 0xec, 0xcd, 0x08, 0x6a, 0x05, 0x51, 0x56, 0xb4, 0xe8, 0x65, 0x98,
+	0xdd, 0x79, 0xbb, 0x1d, 0xea, 0xbc, 0x33, 0xcc, 0xcc, 0x9a, 0xe8, 0x47, 0xf0, 0xe4, 0x47, 0xf0,
+	0xe8, 0xb1, 0x07, 0x3f, 0x44, 0xc1, 0x4b, 0xf1, 0x24, 0x1e, 0x8a, 0x24, 0x87, 0xfa, 0x31, 0xa4,
+	0xb3, 0x49, 0x4c, 0x15, 0xa1, 0x97, 0xe5, 0xdd, 0xf7, 0x79, 0x9e, 0xdf, 0xc0, 0xcb, 0x13, 0xf6,
+	0xde, 0x89, 0x22, 0xdf, 0x61, 0x02, 0x13, 0xa7, 0x76, 0x01, 0x47, 0x86, 0x69, 0x0d, 0x26, 0xd1,
+	0xcc, 0x30, 0x69, 0x07, 0xda, 0x28, 0xa7, 0x48, 0x6b, 0xee, 0x19, 0x2c, 0x7b, 0xd6, 0x2e, 0x33,
+	0x29, 0x50, 0x25, 0xfe, 0x5b, 0x39, 0xd7, 0x9a, 0x85, 0x2a, 0x94, 0x1f, 0x93, 0xe3, 0x69, 0xb6,
+	0x6d, 0xe7, 0xca, 0x4a, 0x65, 0x69, 0x25, 0x54, 0x3f, 0x95, 0xd4, 0xfb, 0x52, 0x0f, 0x1b, 0x4f,
+	0xfd, 0x5b, 0x64, 0x2b, 0x5c, 0x91, 0x6c, 0x4c, 0x05, 0x66, 0xaa, 0x44, 0x4e, 0xdf, 0xa8, 0xd7,
+	0xa5, 0x04, 0x9a, 0x33, 0x1d, 0x05, 0xdd, 0xa0, 0x7f, 0x7e, 0xd8, 0xdb, 0x3f, 0xec, 0xd4, 0xbe,
+	0x1f, 0x76, 0x5a, 0x15, 0xc0, 0xf2, 0xdd, 0x81, 0x50, 0x89, 0x64, 0x6e, 0x67, 0xb0, 0x89, 0xee,
+	0xd3, 0xd1, 0xde, 0x7a, 0x90, 0x5e, 0x91, 0x6c, 0xbc, 0x59, 0x01, 0x5e, 0xf8, 0xfc, 0x3d, 0xa6,
+	0xc9, 0xcb, 0x70, 0xf5, 0x18, 0xac, 0x4a, 0xf7, 0x17, 0xf9, 0xbf, 0x53, 0x93, 0x9b, 0x92, 0x8d,
+	0x9f, 0x94, 0xee, 0x0f, 0xf4, 0xcd, 0xb0, 0x25, 0x05, 0x2e, 0x11, 0xe9, 0x48, 0x20, 0x57, 0xa3,
+	0xe8, 0xff, 0x6e, 0xd0, 0xaf, 0xa7, 0x44, 0x0a, 0x5c, 0x98, 0xb7, 0xbc, 0x42, 0xee, 0x84, 0x6d,
+	0x0d, 0xc8, 0x05, 0x16, 0xb4, 0xf4, 0xa7, 0xa4, 0x05, 0xb3, 0x34, 0x2b, 0x79, 0x01, 0x2e, 0xaa,
+	0xfb, 0xd8, 0xca, 0xcc, 0xf0, 0xdc, 0xeb, 0x0f, 0x98, 0x1d, 0x7a, 0x95, 0x6c, 0x84, 0x6d, 0x67,
+	0x18, 0xda, 0x6d, 0x30, 0xd4, 0x40, 0xae, 0x0c, 0xa7, 0x06, 0x1c, 0xa0, 0x13, 0x0a, 0xa3, 0x33,
+	0x3e, 0xba, 0x3a, 0x37, 0xa4, 0x5e, 0x4f, 0xe7, 0x32, 0xb9, 0x16, 0x5e, 0xca, 0x8c, 0xe0, 0x05,
+	0xd0, 0x6d, 0x00, 0x9a, 0x69, 0x1b, 0x35, 0xba, 0x41, 0xff, 0x62, 0x7a, 0xa1, 0xda, 0xde, 0x07,
+	0x18, 0x6a, 0x4b, 0x1e, 0x9e, 0x70, 0x49, 0x81, 0xd1, 0xd9, 0x53, 0x5f, 0xe8, 0x37, 0xe9, 0xb1,
+	0x40, 0xf2, 0x28, 0x6c, 0x2e, 0x91, 0x0c, 0xe4, 0x42, 0x0b, 0x40, 0x17, 0x9d, 0xf3, 0xbc, 0xe8,
+	0xeb, 0xe7, 0x1b, 0xcd, 0x59, 0x11, 0xee, 0x72, 0x6e, 0xc0, 0xda, 0x67, 0xce, 0x08, 0x2c, 0x52,
+	0xb2, 0xa0, 0xa4, 0xf3, 0xcc, 0xc6, 0xf5, 0x9f, 0x1f, 0x3b, 0xc1, 0xfb, 0xa3, 0xbd, 0xf5, 0x78,
+	0x51, 0xd6, 0xf1, 0xc9, 0xba, 0x56, 0x15, 0x1a, 0xde, 0xde, 0x9f, 0xc4, 0xc1, 0xc1, 0x24, 0x0e,
+	0x7e, 0x4c, 0xe2, 0xe0, 0xc3, 0x34, 0xae, 0x1d, 0x4c, 0xe3, 0xda, 0xb7, 0x69, 0x5c, 0x7b, 0x75,
+	0xf5, 0x5f, 0x49, 0xf7, 0x56, 0x83, 0xcd, 0x1a, 0xbe, 0x8d, 0xb7, 0x7e, 0x0d, 0x00, 0x6c, 0x08,
+	0x6b, 0xe4, 0x0e, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -102,6 +183,30 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !this.MaxInboundVolumeCap.Equal(that1.MaxInboundVolumeCap) {
+		return false
+	}
+	if !this.MaxOutboundVolumeCap.Equal(that1.MaxOutboundVolumeCap) {
+		return false
+	}
+	if this.MinVolumeCapWindow != that1.MinVolumeCapWindow {
+		return false
+	}
+	if this.PendingUnwrapGasBudget != that1.PendingUnwrapGasBudget {
+		return false
+	}
+	if this.TransferRecordRetention != that1.TransferRecordRetention {
+		return false
+	}
+	if this.BridgeFeeBps != that1.BridgeFeeBps {
+		return false
+	}
+	if !this.BridgeFeeMin.Equal(that1.BridgeFeeMin) {
+		return false
+	}
+	if this.BridgeFeeRecipient != that1.BridgeFeeRecipient {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -124,6 +229,63 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BridgeFeeRecipient) > 0 {
+		i -= len(m.BridgeFeeRecipient)
+		copy(dAtA[i:], m.BridgeFeeRecipient)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BridgeFeeRecipient)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.BridgeFeeMin.Size()
+		i -= size
+		if _, err := m.BridgeFeeMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.BridgeFeeBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BridgeFeeBps))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TransferRecordRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TransferRecordRetention))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PendingUnwrapGasBudget != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PendingUnwrapGasBudget))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinVolumeCapWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinVolumeCapWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxOutboundVolumeCap.Size()
+		i -= size
+		if _, err := m.MaxOutboundVolumeCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxInboundVolumeCap.Size()
+		i -= size
+		if _, err := m.MaxInboundVolumeCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -144,6 +306,28 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.MaxInboundVolumeCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxOutboundVolumeCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MinVolumeCapWindow != 0 {
+		n += 1 + sovParams(uint64(m.MinVolumeCapWindow))
+	}
+	if m.PendingUnwrapGasBudget != 0 {
+		n += 1 + sovParams(uint64(m.PendingUnwrapGasBudget))
+	}
+	if m.TransferRecordRetention != 0 {
+		n += 1 + sovParams(uint64(m.TransferRecordRetention))
+	}
+	if m.BridgeFeeBps != 0 {
+		n += 1 + sovParams(uint64(m.BridgeFeeBps))
+	}
+	l = m.BridgeFeeMin.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.BridgeFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -182,6 +366,216 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInboundVolumeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxInboundVolumeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutboundVolumeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOutboundVolumeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVolumeCapWindow", wireType)
+			}
+			m.MinVolumeCapWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVolumeCapWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUnwrapGasBudget", wireType)
+			}
+			m.PendingUnwrapGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingUnwrapGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecordRetention", wireType)
+			}
+			m.TransferRecordRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferRecordRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFeeBps", wireType)
+			}
+			m.BridgeFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFeeMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFeeMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
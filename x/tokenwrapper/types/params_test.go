@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 )
 
@@ -12,3 +13,23 @@ func TestParams_Validate_Positive(t *testing.T) {
 	err := params.Validate()
 	require.NoError(t, err, "expected default params to pass validation")
 }
+
+func TestParams_Validate_VolumeCapBounds(t *testing.T) {
+	params := NewParams(sdkmath.NewInt(1000), sdkmath.ZeroInt(), 3600, DefaultPendingUnwrapGasBudget, 0, 0, sdkmath.ZeroInt(), "")
+	require.NoError(t, params.Validate())
+
+	params.MaxOutboundVolumeCap = sdkmath.NewInt(-1)
+	require.ErrorContains(t, params.Validate(), "max outbound volume cap cannot be negative")
+
+	params = NewParams(sdkmath.ZeroInt(), sdkmath.ZeroInt(), MaxVolumeCapWindow+1, DefaultPendingUnwrapGasBudget, 0, 0, sdkmath.ZeroInt(), "")
+	require.ErrorContains(t, params.Validate(), "exceeds the maximum")
+}
+
+func TestParams_Validate_PendingUnwrapGasBudget(t *testing.T) {
+	params := DefaultParams()
+	params.PendingUnwrapGasBudget = MaxPendingUnwrapGasBudget
+	require.NoError(t, params.Validate())
+
+	params.PendingUnwrapGasBudget = MaxPendingUnwrapGasBudget + 1
+	require.ErrorContains(t, params.Validate(), "pending unwrap gas budget")
+}
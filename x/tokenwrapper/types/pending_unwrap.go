@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	"zigchain/zutils/validators"
+)
+
+// DefaultPendingUnwrapGasBudget is the default gas the begin blocker can spend per block on
+// converting the pending unwraps, enough for a few dozen conversions
+const DefaultPendingUnwrapGasBudget uint64 = 2_000_000
+
+// MaxPendingUnwrapGasBudget bounds the gas the begin blocker can spend per block on the pending unwraps
+const MaxPendingUnwrapGasBudget uint64 = 50_000_000
+
+// Reasons a pending unwrap leaves the queue without being converted by the begin blocker
+const (
+	PendingUnwrapRemovedRecovered     = "recovered"
+	PendingUnwrapRemovedRouteNotFound = "route_not_found"
+	PendingUnwrapRemovedNoVouchers    = "vouchers_not_held"
+	PendingUnwrapRemovedZeroAmount    = "zero_native_amount"
+	PendingUnwrapRemovedFeeExceeds    = "fee_exceeds_amount"
+)
+
+// Validate validates a pending unwrap
+func (p PendingUnwrap) Validate() error {
+	if err := validators.AddressCheck("pending unwrap", p.Address); err != nil {
+		return err
+	}
+	if err := validators.ValidatePort(p.NativePort); err != nil {
+		return err
+	}
+	if err := validators.ValidateChannel(p.NativeChannel); err != nil {
+		return err
+	}
+
+	if p.Amount.IsNil() || !p.Amount.IsPositive() {
+		return fmt.Errorf("%w: amount of pending unwrap %d must be positive", ErrInvalidPendingUnwrap, p.Id)
+	}
+
+	return nil
+}
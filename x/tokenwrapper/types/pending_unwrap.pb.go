@@ -0,0 +1,597 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zigchain/tokenwrapper/pending_unwrap.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingUnwrap is an incoming transfer the module wallet could not cover,
+// whose IBC vouchers were kept by the receiver and are converted into native
+// tokens automatically once the module wallet is funded again
+type PendingUnwrap struct {
+	// id defines the position of the pending unwrap in the queue
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address defines the receiver holding the IBC vouchers
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// native_port defines the native port of the bridge route
+	NativePort string `protobuf:"bytes,3,opt,name=native_port,json=nativePort,proto3" json:"native_port,omitempty"`
+	// native_channel defines the native channel of the bridge route
+	NativeChannel string `protobuf:"bytes,4,opt,name=native_channel,json=nativeChannel,proto3" json:"native_channel,omitempty"`
+	// amount defines the amount of IBC vouchers received
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// height defines the block height at which the transfer was received
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// sequence defines the sequence of the packet on the native channel, it
+	// links the pending unwrap to the transfer record of the packet
+	Sequence uint64 `protobuf:"varint,7,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *PendingUnwrap) Reset()         { *m = PendingUnwrap{} }
+func (m *PendingUnwrap) String() string { return proto.CompactTextString(m) }
+func (*PendingUnwrap) ProtoMessage()    {}
+func (*PendingUnwrap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6dddd97e705572ba, []int{0}
+}
+func (m *PendingUnwrap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingUnwrap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingUnwrap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingUnwrap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingUnwrap.Merge(m, src)
+}
+func (m *PendingUnwrap) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingUnwrap) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingUnwrap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingUnwrap proto.InternalMessageInfo
+
+func (m *PendingUnwrap) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PendingUnwrap) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PendingUnwrap) GetNativePort() string {
+	if m != nil {
+		return m.NativePort
+	}
+	return ""
+}
+
+func (m *PendingUnwrap) GetNativeChannel() string {
+	if m != nil {
+		return m.NativeChannel
+	}
+	return ""
+}
+
+func (m *PendingUnwrap) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PendingUnwrap) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PendingUnwrap)(nil), "zigchain.tokenwrapper.PendingUnwrap")
+}
+
+func init() {
+	proto.RegisterFile("zigchain/tokenwrapper/pending_unwrap.proto", fileDescriptor_6dddd97e705572ba)
+}
+
+var fileDescriptor_6dddd97e705572ba = []byte{
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xcf, 0x4a, 0xf3, 0x40,
+	0x14, 0xc5, 0x33, 0x69, 0xbf, 0xf4, 0x73, 0xa4, 0x05, 0x87, 0x56, 0xc6, 0x82, 0x69, 0x29, 0x08,
+	0xa5, 0x60, 0x02, 0xba, 0x10, 0xdc, 0x59, 0x57, 0xee, 0x4a, 0xc4, 0x8d, 0x9b, 0x32, 0x26, 0x43,
+	0x32, 0xd4, 0xcc, 0xc4, 0xcc, 0xd4, 0x7f, 0xaf, 0xe0, 0xc6, 0xc7, 0x70, 0xe9, 0xc2, 0x87, 0xe8,
+	0xb2, 0xb8, 0x12, 0x17, 0x45, 0xda, 0x85, 0xaf, 0x21, 0x9d, 0x49, 0x8b, 0x6e, 0xc2, 0xbd, 0xe7,
+	0xfc, 0xee, 0x3d, 0x64, 0x2e, 0xec, 0x3d, 0xb2, 0x38, 0x4c, 0x08, 0xe3, 0xbe, 0x12, 0x23, 0xca,
+	0xef, 0x72, 0x92, 0x65, 0x34, 0xf7, 0x33, 0xca, 0x23, 0xc6, 0xe3, 0xe1, 0x58, 0x2b, 0x5e, 0x96,
+	0x0b, 0x25, 0x50, 0x63, 0xc5, 0x7a, 0xbf, 0xd9, 0xe6, 0x16, 0x49, 0x19, 0x17, 0xbe, 0xfe, 0x1a,
+	0xb2, 0x59, 0x8f, 0x45, 0x2c, 0x74, 0xe9, 0x2f, 0xab, 0x42, 0xdd, 0x09, 0x85, 0x4c, 0x85, 0x1c,
+	0x1a, 0xc3, 0x34, 0xc6, 0xea, 0x3c, 0xd9, 0xb0, 0x3a, 0x30, 0x99, 0x17, 0x7a, 0x31, 0xaa, 0x41,
+	0x9b, 0x45, 0x18, 0xb4, 0x41, 0xb7, 0x1c, 0xd8, 0x2c, 0x42, 0x07, 0xb0, 0x42, 0xa2, 0x28, 0xa7,
+	0x52, 0x62, 0xbb, 0x0d, 0xba, 0x1b, 0x7d, 0xfc, 0xfe, 0xb6, 0x5f, 0x2f, 0x96, 0x9c, 0x18, 0xe7,
+	0x5c, 0xe5, 0x8c, 0xc7, 0xc1, 0x0a, 0x44, 0x2d, 0xb8, 0xc9, 0x89, 0x62, 0xb7, 0x74, 0x98, 0x89,
+	0x5c, 0xe1, 0xd2, 0x72, 0x2e, 0x80, 0x46, 0x1a, 0x88, 0x5c, 0xa1, 0x3d, 0x58, 0x2b, 0x80, 0x30,
+	0x21, 0x9c, 0xd3, 0x6b, 0x5c, 0xd6, 0x4c, 0xd5, 0xa8, 0xa7, 0x46, 0x44, 0xc7, 0xd0, 0x21, 0xa9,
+	0x18, 0x73, 0x85, 0xff, 0xe9, 0xe8, 0xce, 0x64, 0xd6, 0xb2, 0x3e, 0x67, 0xad, 0x86, 0x89, 0x97,
+	0xd1, 0xc8, 0x63, 0xc2, 0x4f, 0x89, 0x4a, 0xbc, 0x33, 0xae, 0x5e, 0xbe, 0x5f, 0x7b, 0x20, 0x28,
+	0x26, 0xd0, 0x36, 0x74, 0x12, 0xca, 0xe2, 0x44, 0x61, 0xa7, 0x0d, 0xba, 0xa5, 0xa0, 0xe8, 0x50,
+	0x13, 0xfe, 0x97, 0xf4, 0x66, 0x4c, 0x79, 0x48, 0x71, 0x45, 0xff, 0xe5, 0xba, 0xef, 0x1f, 0x4d,
+	0xe6, 0x2e, 0x98, 0xce, 0x5d, 0xf0, 0x35, 0x77, 0xc1, 0xf3, 0xc2, 0xb5, 0xa6, 0x0b, 0xd7, 0xfa,
+	0x58, 0xb8, 0xd6, 0xe5, 0xee, 0xfa, 0x5c, 0xf7, 0x7f, 0x0f, 0xa6, 0x1e, 0x32, 0x2a, 0xaf, 0x1c,
+	0xfd, 0x9a, 0x87, 0x3f, 0x03, 0x00, 0xbd, 0xc9, 0xe6, 0x1a, 0xd6, 0x01, 0x00, 0x00,
+}
+
+func (m *PendingUnwrap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingUnwrap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingUnwrap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintPendingUnwrap(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Height != 0 {
+		i = encodeVarintPendingUnwrap(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPendingUnwrap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.NativeChannel) > 0 {
+		i -= len(m.NativeChannel)
+		copy(dAtA[i:], m.NativeChannel)
+		i = encodeVarintPendingUnwrap(dAtA, i, uint64(len(m.NativeChannel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NativePort) > 0 {
+		i -= len(m.NativePort)
+		copy(dAtA[i:], m.NativePort)
+		i = encodeVarintPendingUnwrap(dAtA, i, uint64(len(m.NativePort)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPendingUnwrap(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPendingUnwrap(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPendingUnwrap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPendingUnwrap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingUnwrap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPendingUnwrap(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPendingUnwrap(uint64(l))
+	}
+	l = len(m.NativePort)
+	if l > 0 {
+		n += 1 + l + sovPendingUnwrap(uint64(l))
+	}
+	l = len(m.NativeChannel)
+	if l > 0 {
+		n += 1 + l + sovPendingUnwrap(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPendingUnwrap(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovPendingUnwrap(uint64(m.Height))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovPendingUnwrap(uint64(m.Sequence))
+	}
+	return n
+}
+
+func sovPendingUnwrap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPendingUnwrap(x uint64) (n int) {
+	return sovPendingUnwrap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingUnwrap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPendingUnwrap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingUnwrap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingUnwrap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingUnwrap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingUnwrap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingUnwrap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingUnwrap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingUnwrap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingUnwrap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingUnwrap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingUnwrap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingUnwrap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingUnwrap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingUnwrap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingUnwrap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingUnwrap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingUnwrap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingUnwrap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPendingUnwrap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPendingUnwrap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPendingUnwrap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPendingUnwrap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPendingUnwrap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPendingUnwrap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPendingUnwrap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPendingUnwrap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPendingUnwrap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPendingUnwrap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPendingUnwrap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPendingUnwrap = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	"zigchain/testutil/sample"
+
+	sdkmath "cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+var pendingUnwrapSample = PendingUnwrap{
+	Id:            1,
+	Address:       sample.AccAddress(),
+	NativePort:    "transfer",
+	NativeChannel: "channel-0",
+	Amount:        sdkmath.NewInt(1000),
+	Height:        10,
+}
+
+// Positive test cases
+
+func TestPendingUnwrap_Validate_Positive(t *testing.T) {
+	// Test case: a pending unwrap of a positive amount over a valid route is valid
+
+	require.NoError(t, pendingUnwrapSample.Validate())
+}
+
+// Negative test cases
+
+func TestPendingUnwrap_Validate_Invalid(t *testing.T) {
+	// Test case: the address and the route must be valid and the amount positive
+
+	pendingUnwrap := pendingUnwrapSample
+	pendingUnwrap.Address = "invalid"
+	require.ErrorIs(t, pendingUnwrap.Validate(), sdkerrors.ErrInvalidAddress)
+
+	pendingUnwrap = pendingUnwrapSample
+	pendingUnwrap.NativeChannel = "invalid-channel"
+	require.ErrorContains(t, pendingUnwrap.Validate(), "invalid channel ID format")
+
+	pendingUnwrap = pendingUnwrapSample
+	pendingUnwrap.Amount = sdkmath.ZeroInt()
+	require.ErrorIs(t, pendingUnwrap.Validate(), ErrInvalidPendingUnwrap)
+
+	pendingUnwrap.Amount = sdkmath.Int{}
+	require.ErrorIs(t, pendingUnwrap.Validate(), ErrInvalidPendingUnwrap)
+}
@@ -9,7 +9,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -160,14 +160,6 @@ type QueryModuleInfoResponse struct {
 	ProposedOperatorAddress string       `protobuf:"bytes,4,opt,name=proposed_operator_address,json=proposedOperatorAddress,proto3" json:"proposed_operator_address,omitempty"`
 	PauserAddresses         []string     `protobuf:"bytes,5,rep,name=pauser_addresses,json=pauserAddresses,proto3" json:"pauser_addresses,omitempty"`
 	TokenWrapperEnabled     bool         `protobuf:"varint,6,opt,name=token_wrapper_enabled,json=tokenWrapperEnabled,proto3" json:"token_wrapper_enabled,omitempty"`
-	NativeClientId          string       `protobuf:"bytes,7,opt,name=native_client_id,json=nativeClientId,proto3" json:"native_client_id,omitempty"`
-	CounterpartyClientId    string       `protobuf:"bytes,8,opt,name=counterparty_client_id,json=counterpartyClientId,proto3" json:"counterparty_client_id,omitempty"`
-	NativePort              string       `protobuf:"bytes,9,opt,name=native_port,json=nativePort,proto3" json:"native_port,omitempty"`
-	CounterpartyPort        string       `protobuf:"bytes,10,opt,name=counterparty_port,json=counterpartyPort,proto3" json:"counterparty_port,omitempty"`
-	NativeChannel           string       `protobuf:"bytes,11,opt,name=native_channel,json=nativeChannel,proto3" json:"native_channel,omitempty"`
-	CounterpartyChannel     string       `protobuf:"bytes,12,opt,name=counterparty_channel,json=counterpartyChannel,proto3" json:"counterparty_channel,omitempty"`
-	Denom                   string       `protobuf:"bytes,13,opt,name=denom,proto3" json:"denom,omitempty"`
-	DecimalDifference       uint32       `protobuf:"varint,14,opt,name=decimal_difference,json=decimalDifference,proto3" json:"decimal_difference,omitempty"`
 }
 
 func (m *QueryModuleInfoResponse) Reset()         { *m = QueryModuleInfoResponse{} }
@@ -245,62 +237,6 @@ func (m *QueryModuleInfoResponse) GetTokenWrapperEnabled() bool {
 	return false
 }
 
-func (m *QueryModuleInfoResponse) GetNativeClientId() string {
-	if m != nil {
-		return m.NativeClientId
-	}
-	return ""
-}
-
-func (m *QueryModuleInfoResponse) GetCounterpartyClientId() string {
-	if m != nil {
-		return m.CounterpartyClientId
-	}
-	return ""
-}
-
-func (m *QueryModuleInfoResponse) GetNativePort() string {
-	if m != nil {
-		return m.NativePort
-	}
-	return ""
-}
-
-func (m *QueryModuleInfoResponse) GetCounterpartyPort() string {
-	if m != nil {
-		return m.CounterpartyPort
-	}
-	return ""
-}
-
-func (m *QueryModuleInfoResponse) GetNativeChannel() string {
-	if m != nil {
-		return m.NativeChannel
-	}
-	return ""
-}
-
-func (m *QueryModuleInfoResponse) GetCounterpartyChannel() string {
-	if m != nil {
-		return m.CounterpartyChannel
-	}
-	return ""
-}
-
-func (m *QueryModuleInfoResponse) GetDenom() string {
-	if m != nil {
-		return m.Denom
-	}
-	return ""
-}
-
-func (m *QueryModuleInfoResponse) GetDecimalDifference() uint32 {
-	if m != nil {
-		return m.DecimalDifference
-	}
-	return 0
-}
-
 type QueryTotalTransfersRequest struct {
 }
 
@@ -375,646 +311,4482 @@ func (m *QueryTotalTransfersResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTotalTransfersResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "zigchain.tokenwrapper.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "zigchain.tokenwrapper.QueryParamsResponse")
-	proto.RegisterType((*QueryModuleInfoRequest)(nil), "zigchain.tokenwrapper.QueryModuleInfoRequest")
-	proto.RegisterType((*QueryModuleInfoResponse)(nil), "zigchain.tokenwrapper.QueryModuleInfoResponse")
-	proto.RegisterType((*QueryTotalTransfersRequest)(nil), "zigchain.tokenwrapper.QueryTotalTransfersRequest")
-	proto.RegisterType((*QueryTotalTransfersResponse)(nil), "zigchain.tokenwrapper.QueryTotalTransfersResponse")
+type QueryBridgeRoutesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("zigchain/tokenwrapper/query.proto", fileDescriptor_14f36287eba2d1de) }
-
-var fileDescriptor_14f36287eba2d1de = []byte{
-	// 829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xbf, 0x6f, 0x23, 0x45,
-	0x14, 0xf6, 0xc6, 0x67, 0x93, 0x8c, 0x89, 0x71, 0xc6, 0xce, 0xdd, 0xc6, 0x9c, 0x7f, 0x60, 0x11,
-	0x64, 0x8c, 0xb2, 0x2b, 0x1b, 0x24, 0x0a, 0x1a, 0xce, 0x86, 0xc2, 0x05, 0xca, 0xdd, 0x12, 0xe9,
-	0x24, 0x9a, 0xd5, 0x78, 0x77, 0xec, 0x8c, 0x6e, 0x3d, 0xb3, 0x37, 0x33, 0x3e, 0x08, 0x25, 0x05,
-	0x35, 0x12, 0x05, 0x7f, 0x02, 0x94, 0x14, 0xfc, 0x03, 0x74, 0x57, 0x46, 0x40, 0x81, 0x28, 0x22,
-	0x94, 0x20, 0xf1, 0x6f, 0xa0, 0x9d, 0x99, 0x35, 0xfe, 0x15, 0x13, 0x1a, 0x6b, 0xf7, 0x7d, 0xdf,
-	0xfb, 0xde, 0xb7, 0x6f, 0xde, 0x3c, 0x83, 0x37, 0xbe, 0x24, 0x93, 0xe0, 0x1c, 0x11, 0xea, 0x4a,
-	0xf6, 0x0c, 0xd3, 0xcf, 0x39, 0x8a, 0x63, 0xcc, 0xdd, 0xe7, 0x33, 0xcc, 0x2f, 0x9c, 0x98, 0x33,
-	0xc9, 0xe0, 0x61, 0x4a, 0x71, 0x16, 0x29, 0xd5, 0x03, 0x34, 0x25, 0x94, 0xb9, 0xea, 0x57, 0x33,
-	0xab, 0x95, 0x09, 0x9b, 0x30, 0xf5, 0xe8, 0x26, 0x4f, 0x26, 0xfa, 0x70, 0xc2, 0xd8, 0x24, 0xc2,
-	0x2e, 0x8a, 0x89, 0x8b, 0x28, 0x65, 0x12, 0x49, 0xc2, 0xa8, 0x30, 0x68, 0x27, 0x60, 0x62, 0xca,
-	0x84, 0x3b, 0x42, 0x02, 0xeb, 0xb2, 0xee, 0x8b, 0xee, 0x08, 0x4b, 0xd4, 0x75, 0x63, 0x34, 0x21,
-	0x54, 0x91, 0x0d, 0xb7, 0xb5, 0xd9, 0x6c, 0x8c, 0x38, 0x9a, 0xa6, 0x7a, 0xf5, 0x45, 0xbd, 0x54,
-	0x29, 0x60, 0x24, 0xd5, 0x38, 0xd2, 0xb8, 0xaf, 0x6d, 0xea, 0x17, 0x0d, 0xb5, 0x2a, 0x00, 0x3e,
-	0x49, 0x0c, 0x3c, 0x56, 0x7a, 0x1e, 0x7e, 0x3e, 0xc3, 0x42, 0xb6, 0x9e, 0x82, 0xf2, 0x52, 0x54,
-	0xc4, 0x8c, 0x0a, 0x0c, 0x3f, 0x04, 0x79, 0x5d, 0xd7, 0xb6, 0x9a, 0x56, 0xbb, 0xd0, 0xab, 0x39,
-	0x1b, 0xdb, 0xe4, 0xe8, 0xb4, 0xfe, 0xde, 0xcb, 0xab, 0x46, 0xe6, 0x87, 0xbf, 0x7f, 0xec, 0x58,
-	0x9e, 0xc9, 0x6b, 0xd9, 0xe0, 0xbe, 0x12, 0xfe, 0x84, 0x85, 0xb3, 0x08, 0x0f, 0xe9, 0x98, 0xa5,
-	0x25, 0xaf, 0x72, 0xe0, 0xc1, 0x1a, 0x64, 0xea, 0x1e, 0x83, 0xe2, 0x54, 0x45, 0x7d, 0x14, 0x86,
-	0x1c, 0x0b, 0x5d, 0x7f, 0xcf, 0xdb, 0xd7, 0xd1, 0x47, 0x3a, 0x08, 0x3f, 0x00, 0xbb, 0x23, 0x14,
-	0x21, 0x1a, 0x60, 0x61, 0xef, 0x34, 0xb3, 0xed, 0x42, 0xef, 0xc8, 0x31, 0x1f, 0x9b, 0x74, 0xc6,
-	0x31, 0x9d, 0x71, 0x06, 0x8c, 0xd0, 0xfe, 0xbd, 0xc4, 0x9c, 0x37, 0x4f, 0x80, 0x03, 0x50, 0x62,
-	0x31, 0xe6, 0x48, 0x32, 0x3e, 0xaf, 0x92, 0x4d, 0xaa, 0xf4, 0xed, 0x5f, 0x7e, 0x3a, 0xa9, 0x18,
-	0x1d, 0x53, 0xea, 0x53, 0xc9, 0x09, 0x9d, 0x78, 0xaf, 0xa5, 0x19, 0xa9, 0x83, 0x33, 0x70, 0x14,
-	0x73, 0x16, 0x33, 0x81, 0x43, 0x7f, 0x4d, 0xed, 0xde, 0x7f, 0xa8, 0x3d, 0x48, 0x53, 0x4f, 0x57,
-	0x54, 0x07, 0xa0, 0x14, 0xa3, 0x99, 0xc0, 0x73, 0x29, 0x2c, 0xec, 0x5c, 0x33, 0xbb, 0xdd, 0x9a,
-	0xce, 0x78, 0x94, 0x26, 0xc0, 0x1e, 0x38, 0x54, 0x67, 0xe4, 0x9b, 0x43, 0xf2, 0x31, 0x45, 0xa3,
-	0x08, 0x87, 0x76, 0xbe, 0x69, 0xb5, 0x77, 0xbd, 0xb2, 0x02, 0x9f, 0x6a, 0xec, 0x63, 0x0d, 0xc1,
-	0x36, 0x28, 0x25, 0xb3, 0xf8, 0x02, 0xfb, 0x41, 0x44, 0x30, 0x95, 0x3e, 0x09, 0xed, 0x57, 0x54,
-	0xe7, 0x8b, 0x3a, 0x3e, 0x50, 0xe1, 0x61, 0x08, 0xdf, 0x03, 0xf7, 0x03, 0x36, 0xa3, 0x12, 0xf3,
-	0x18, 0x71, 0x79, 0xb1, 0xc0, 0xdf, 0x55, 0xfc, 0xca, 0x22, 0x3a, 0xcf, 0x6a, 0x80, 0x82, 0xd1,
-	0x8f, 0x19, 0x97, 0xf6, 0x9e, 0xa2, 0x02, 0x1d, 0x7a, 0xcc, 0xb8, 0x84, 0xef, 0x80, 0x83, 0x25,
-	0x59, 0x45, 0x03, 0x8a, 0x56, 0x5a, 0x04, 0x14, 0xf9, 0x18, 0x14, 0x53, 0xb7, 0xe7, 0x88, 0x52,
-	0x1c, 0xd9, 0x05, 0x3d, 0x25, 0xc6, 0xab, 0x0e, 0xc2, 0x2e, 0xa8, 0x2c, 0x5b, 0x35, 0xe4, 0x57,
-	0x15, 0xb9, 0xbc, 0x64, 0xd4, 0xa4, 0x54, 0x40, 0x2e, 0xc4, 0x94, 0x4d, 0xed, 0x7d, 0xc5, 0xd1,
-	0x2f, 0xf0, 0x04, 0xc0, 0x10, 0x07, 0x64, 0x8a, 0x22, 0x3f, 0x24, 0xe3, 0x31, 0xe6, 0x98, 0x06,
-	0xd8, 0x2e, 0x36, 0xad, 0xf6, 0xbe, 0x77, 0x60, 0x90, 0x8f, 0xe6, 0x40, 0xeb, 0x21, 0xa8, 0xaa,
-	0xf9, 0x3e, 0x63, 0x12, 0x45, 0x67, 0x1c, 0x51, 0x31, 0xc6, 0x7c, 0x7e, 0xe3, 0x7e, 0xb6, 0xc0,
-	0xeb, 0x1b, 0x61, 0x73, 0x05, 0x4e, 0x41, 0x45, 0x26, 0x88, 0x2f, 0x0d, 0xc4, 0x71, 0xe8, 0x13,
-	0xaa, 0x2f, 0x42, 0xbf, 0x96, 0x0c, 0xf3, 0x1f, 0x57, 0x8d, 0x43, 0x3d, 0x0b, 0x22, 0x7c, 0xe6,
-	0x10, 0xe6, 0x4e, 0x91, 0x3c, 0x77, 0x86, 0x54, 0x7a, 0x50, 0x2e, 0x8a, 0x72, 0x1c, 0x0e, 0x29,
-	0x7c, 0x92, 0xcc, 0xc3, 0xaa, 0x20, 0x9b, 0x49, 0x7b, 0xe7, 0x2e, 0x8a, 0xe5, 0x55, 0xc5, 0xd3,
-	0x99, 0xec, 0xfd, 0x96, 0x05, 0x39, 0xf5, 0x0d, 0xf0, 0x6b, 0x0b, 0xe4, 0xf5, 0x12, 0x80, 0x6f,
-	0xdf, 0xb2, 0x23, 0xd6, 0xb7, 0x4e, 0xb5, 0x73, 0x17, 0xaa, 0xee, 0x47, 0xeb, 0xf8, 0xab, 0x5f,
-	0xff, 0xfa, 0x76, 0xa7, 0x01, 0x6b, 0xee, 0xb6, 0xfd, 0x08, 0xbf, 0xb3, 0x00, 0xf8, 0x77, 0xa1,
-	0xc0, 0x93, 0x6d, 0x15, 0xd6, 0x76, 0x52, 0xd5, 0xb9, 0x2b, 0xdd, 0x98, 0xea, 0x28, 0x53, 0x6f,
-	0xc2, 0xd6, 0x2d, 0xa6, 0xcc, 0x12, 0x23, 0x89, 0x95, 0xef, 0x2d, 0x50, 0x5c, 0x3e, 0x6b, 0xd8,
-	0xdd, 0x56, 0x6e, 0xe3, 0xd8, 0x54, 0x7b, 0xff, 0x27, 0xc5, 0xb8, 0x74, 0x94, 0xcb, 0x36, 0x7c,
-	0xeb, 0x16, 0x97, 0xcb, 0x63, 0x21, 0xfa, 0xef, 0xbf, 0xbc, 0xae, 0x5b, 0x97, 0xd7, 0x75, 0xeb,
-	0xcf, 0xeb, 0xba, 0xf5, 0xcd, 0x4d, 0x3d, 0x73, 0x79, 0x53, 0xcf, 0xfc, 0x7e, 0x53, 0xcf, 0x7c,
-	0x56, 0x9b, 0x0b, 0x7c, 0xb1, 0x22, 0x71, 0x11, 0x63, 0x31, 0xca, 0xab, 0xbf, 0x98, 0x77, 0xff,
-	0x09, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x78, 0xb3, 0xe3, 0x70, 0x07, 0x00, 0x00,
+func (m *QueryBridgeRoutesRequest) Reset()         { *m = QueryBridgeRoutesRequest{} }
+func (m *QueryBridgeRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeRoutesRequest) ProtoMessage()    {}
+func (*QueryBridgeRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f36287eba2d1de, []int{6}
+}
+func (m *QueryBridgeRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeRoutesRequest.Merge(m, src)
+}
+func (m *QueryBridgeRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeRoutesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryBridgeRoutesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of ModuleInfo items.
-	ModuleInfo(ctx context.Context, in *QueryModuleInfoRequest, opts ...grpc.CallOption) (*QueryModuleInfoResponse, error)
-	// Queries the total amount of ZIG tokens transferred in and out
-	TotalTransfers(ctx context.Context, in *QueryTotalTransfersRequest, opts ...grpc.CallOption) (*QueryTotalTransfersResponse, error)
+func (m *QueryBridgeRoutesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryBridgeRoutesResponse struct {
+	Routes     []BridgeRoute       `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryBridgeRoutesResponse) Reset()         { *m = QueryBridgeRoutesResponse{} }
+func (m *QueryBridgeRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeRoutesResponse) ProtoMessage()    {}
+func (*QueryBridgeRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f36287eba2d1de, []int{7}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/zigchain.tokenwrapper.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBridgeRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBridgeRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeRoutesResponse.Merge(m, src)
+}
+func (m *QueryBridgeRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeRoutesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ModuleInfo(ctx context.Context, in *QueryModuleInfoRequest, opts ...grpc.CallOption) (*QueryModuleInfoResponse, error) {
-	out := new(QueryModuleInfoResponse)
-	err := c.cc.Invoke(ctx, "/zigchain.tokenwrapper.Query/ModuleInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBridgeRoutesResponse proto.InternalMessageInfo
+
+func (m *QueryBridgeRoutesResponse) GetRoutes() []BridgeRoute {
+	if m != nil {
+		return m.Routes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TotalTransfers(ctx context.Context, in *QueryTotalTransfersRequest, opts ...grpc.CallOption) (*QueryTotalTransfersResponse, error) {
-	out := new(QueryTotalTransfersResponse)
-	err := c.cc.Invoke(ctx, "/zigchain.tokenwrapper.Query/TotalTransfers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBridgeRoutesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of ModuleInfo items.
-	ModuleInfo(context.Context, *QueryModuleInfoRequest) (*QueryModuleInfoResponse, error)
-	// Queries the total amount of ZIG tokens transferred in and out
-	TotalTransfers(context.Context, *QueryTotalTransfersRequest) (*QueryTotalTransfersResponse, error)
+type QueryBridgeRouteRequest struct {
+	NativePort    string `protobuf:"bytes,1,opt,name=native_port,json=nativePort,proto3" json:"native_port,omitempty"`
+	NativeChannel string `protobuf:"bytes,2,opt,name=native_channel,json=nativeChannel,proto3" json:"native_channel,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryBridgeRouteRequest) Reset()         { *m = QueryBridgeRouteRequest{} }
+func (m *QueryBridgeRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeRouteRequest) ProtoMessage()    {}
+func (*QueryBridgeRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f36287eba2d1de, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryBridgeRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ModuleInfo(ctx context.Context, req *QueryModuleInfoRequest) (*QueryModuleInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModuleInfo not implemented")
+func (m *QueryBridgeRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) TotalTransfers(ctx context.Context, req *QueryTotalTransfersRequest) (*QueryTotalTransfersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalTransfers not implemented")
+func (m *QueryBridgeRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeRouteRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryBridgeRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeRouteRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zigchain.tokenwrapper.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryBridgeRouteRequest proto.InternalMessageInfo
+
+func (m *QueryBridgeRouteRequest) GetNativePort() string {
+	if m != nil {
+		return m.NativePort
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ModuleInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryModuleInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ModuleInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zigchain.tokenwrapper.Query/ModuleInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ModuleInfo(ctx, req.(*QueryModuleInfoRequest))
+func (m *QueryBridgeRouteRequest) GetNativeChannel() string {
+	if m != nil {
+		return m.NativeChannel
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_TotalTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalTransfersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalTransfers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zigchain.tokenwrapper.Query/TotalTransfers",
+type QueryBridgeRouteResponse struct {
+	Route BridgeRoute `protobuf:"bytes,1,opt,name=route,proto3" json:"route"`
+}
+
+func (m *QueryBridgeRouteResponse) Reset()         { *m = QueryBridgeRouteResponse{} }
+func (m *QueryBridgeRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeRouteResponse) ProtoMessage()    {}
+func (*QueryBridgeRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f36287eba2d1de, []int{9}
+}
+func (m *QueryBridgeRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalTransfers(ctx, req.(*QueryTotalTransfersRequest))
+}
+func (m *QueryBridgeRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeRouteResponse.Merge(m, src)
+}
+func (m *QueryBridgeRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgeRouteResponse proto.InternalMessageInfo
+
+func (m *QueryBridgeRouteResponse) GetRoute() BridgeRoute {
+	if m != nil {
+		return m.Route
 	}
-	return interceptor(ctx, in, info, handler)
+	return BridgeRoute{}
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "zigchain.tokenwrapper.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ModuleInfo",
-			Handler:    _Query_ModuleInfo_Handler,
-		},
-		{
-			MethodName: "TotalTransfers",
-			Handler:    _Query_TotalTransfers_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "zigchain/tokenwrapper/query.proto",
+type QueryVolumeCapacityRequest struct {
+	NativePort    string `protobuf:"bytes,1,opt,name=native_port,json=nativePort,proto3" json:"native_port,omitempty"`
+	NativeChannel string `protobuf:"bytes,2,opt,name=native_channel,json=nativeChannel,proto3" json:"native_channel,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVolumeCapacityRequest) Reset()         { *m = QueryVolumeCapacityRequest{} }
+func (m *QueryVolumeCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVolumeCapacityRequest) ProtoMessage()    {}
+func (*QueryVolumeCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f36287eba2d1de, []int{10}
+}
+func (m *QueryVolumeCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVolumeCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVolumeCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVolumeCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVolumeCapacityRequest.Merge(m, src)
+}
+func (m *QueryVolumeCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVolumeCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVolumeCapacityRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryVolumeCapacityRequest proto.InternalMessageInfo
+
+func (m *QueryVolumeCapacityRequest) GetNativePort() string {
+	if m != nil {
+		return m.NativePort
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVolumeCapacityRequest) GetNativeChannel() string {
+	if m != nil {
+		return m.NativeChannel
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryVolumeCapacityResponse struct {
+	// volume_cap is the volume cap in effect, once bounded by the params
+	VolumeCap VolumeCap `protobuf:"bytes,1,opt,name=volume_cap,json=volumeCap,proto3" json:"volume_cap"`
+	// inbound_used is the amount unlocked for incoming transfers in the window
+	InboundUsed cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=inbound_used,json=inboundUsed,proto3,customtype=cosmossdk.io/math.Int" json:"inbound_used"`
+	// outbound_used is the amount locked for outgoing transfers in the window
+	OutboundUsed cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=outbound_used,json=outboundUsed,proto3,customtype=cosmossdk.io/math.Int" json:"outbound_used"`
+	// inbound_remaining is the amount that can still be unlocked in the window,
+	// only meaningful when the inbound cap is set
+	InboundRemaining cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=inbound_remaining,json=inboundRemaining,proto3,customtype=cosmossdk.io/math.Int" json:"inbound_remaining"`
+	// outbound_remaining is the amount that can still be locked in the window,
+	// only meaningful when the outbound cap is set
+	OutboundRemaining cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=outbound_remaining,json=outboundRemaining,proto3,customtype=cosmossdk.io/math.Int" json:"outbound_remaining"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryVolumeCapacityResponse) Reset()         { *m = QueryVolumeCapacityResponse{} }
+func (m *QueryVolumeCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVolumeCapacityResponse) ProtoMessage()    {}
+func (*QueryVolumeCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f36287eba2d1de, []int{11}
+}
+func (m *QueryVolumeCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVolumeCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVolumeCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryVolumeCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVolumeCapacityResponse.Merge(m, src)
+}
+func (m *QueryVolumeCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVolumeCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVolumeCapacityResponse.DiscardUnknown(m)
 }
 
-func (m *QueryModuleInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVolumeCapacityResponse proto.InternalMessageInfo
+
+func (m *QueryVolumeCapacityResponse) GetVolumeCap() VolumeCap {
+	if m != nil {
+		return m.VolumeCap
 	}
-	return dAtA[:n], nil
+	return VolumeCap{}
 }
 
-func (m *QueryModuleInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPendingUnwrapsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryModuleInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPendingUnwrapsRequest) Reset()         { *m = QueryPendingUnwrapsRequest{} }
+func (m *QueryPendingUnwrapsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingUnwrapsRequest) ProtoMessage()    {}
+func (*QueryPendingUnwrapsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f36287eba2d1de, []int{12}
+}
+func (m *QueryPendingUnwrapsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingUnwrapsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingUnwrapsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingUnwrapsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingUnwrapsRequest.Merge(m, src)
+}
+func (m *QueryPendingUnwrapsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingUnwrapsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingUnwrapsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryModuleInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingUnwrapsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingUnwrapsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryModuleInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPendingUnwrapsResponse struct {
+	PendingUnwraps []PendingUnwrap     `protobuf:"bytes,1,rep,name=pending_unwraps,json=pendingUnwraps,proto3" json:"pending_unwraps"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryModuleInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DecimalDifference != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DecimalDifference))
-		i--
-		dAtA[i] = 0x70
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.CounterpartyChannel) > 0 {
-		i -= len(m.CounterpartyChannel)
-		copy(dAtA[i:], m.CounterpartyChannel)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyChannel)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.NativeChannel) > 0 {
-		i -= len(m.NativeChannel)
-		copy(dAtA[i:], m.NativeChannel)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.NativeChannel)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.CounterpartyPort) > 0 {
-		i -= len(m.CounterpartyPort)
-		copy(dAtA[i:], m.CounterpartyPort)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyPort)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.NativePort) > 0 {
-		i -= len(m.NativePort)
-		copy(dAtA[i:], m.NativePort)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.NativePort)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.CounterpartyClientId) > 0 {
-		i -= len(m.CounterpartyClientId)
-		copy(dAtA[i:], m.CounterpartyClientId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyClientId)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.NativeClientId) > 0 {
-		i -= len(m.NativeClientId)
-		copy(dAtA[i:], m.NativeClientId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.NativeClientId)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.TokenWrapperEnabled {
-		i--
-		if m.TokenWrapperEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.PauserAddresses) > 0 {
-		for iNdEx := len(m.PauserAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PauserAddresses[iNdEx])
-			copy(dAtA[i:], m.PauserAddresses[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.PauserAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
+func (m *QueryPendingUnwrapsResponse) Reset()         { *m = QueryPendingUnwrapsResponse{} }
+func (m *QueryPendingUnwrapsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingUnwrapsResponse) ProtoMessage()    {}
+func (*QueryPendingUnwrapsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f36287eba2d1de, []int{13}
+}
+func (m *QueryPendingUnwrapsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingUnwrapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingUnwrapsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ProposedOperatorAddress) > 0 {
-		i -= len(m.ProposedOperatorAddress)
-		copy(dAtA[i:], m.ProposedOperatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposedOperatorAddress)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *QueryPendingUnwrapsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingUnwrapsResponse.Merge(m, src)
+}
+func (m *QueryPendingUnwrapsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingUnwrapsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingUnwrapsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingUnwrapsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingUnwrapsResponse) GetPendingUnwraps() []PendingUnwrap {
+	if m != nil {
+		return m.PendingUnwraps
 	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *QueryPendingUnwrapsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	if len(m.Balances) > 0 {
-		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	return nil
+}
+
+type QueryPendingUnwrapsByAddressRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingUnwrapsByAddressRequest) Reset()         { *m = QueryPendingUnwrapsByAddressRequest{} }
+func (m *QueryPendingUnwrapsByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingUnwrapsByAddressRequest) ProtoMessage()    {}
+func (*QueryPendingUnwrapsByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f36287eba2d1de, []int{14}
+}
+func (m *QueryPendingUnwrapsByAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingUnwrapsByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingUnwrapsByAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ModuleAddress) > 0 {
-		i -= len(m.ModuleAddress)
-		copy(dAtA[i:], m.ModuleAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryPendingUnwrapsByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingUnwrapsByAddressRequest.Merge(m, src)
+}
+func (m *QueryPendingUnwrapsByAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingUnwrapsByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingUnwrapsByAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingUnwrapsByAddressRequest proto.InternalMessageInfo
+
+func (m *QueryPendingUnwrapsByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryTotalTransfersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingUnwrapsByAddressRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTotalTransfersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPendingUnwrapsByAddressResponse struct {
+	PendingUnwraps []PendingUnwrap     `protobuf:"bytes,1,rep,name=pending_unwraps,json=pendingUnwraps,proto3" json:"pending_unwraps"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTotalTransfersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPendingUnwrapsByAddressResponse) Reset()         { *m = QueryPendingUnwrapsByAddressResponse{} }
+func (m *QueryPendingUnwrapsByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingUnwrapsByAddressResponse) ProtoMessage()    {}
+func (*QueryPendingUnwrapsByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f36287eba2d1de, []int{15}
 }
-
-func (m *QueryTotalTransfersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingUnwrapsByAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingUnwrapsByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingUnwrapsByAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryPendingUnwrapsByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingUnwrapsByAddressResponse.Merge(m, src)
+}
+func (m *QueryPendingUnwrapsByAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingUnwrapsByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingUnwrapsByAddressResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTotalTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPendingUnwrapsByAddressResponse proto.InternalMessageInfo
+
+func (m *QueryPendingUnwrapsByAddressResponse) GetPendingUnwraps() []PendingUnwrap {
+	if m != nil {
+		return m.PendingUnwraps
+	}
+	return nil
 }
 
-func (m *QueryTotalTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TotalTransferredOut.Size()
-		i -= size
-		if _, err := m.TotalTransferredOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPendingUnwrapsByAddressResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TotalTransferredIn.Size()
-		i -= size
-		if _, err := m.TotalTransferredIn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return nil
+}
+
+type QueryTransferRecordsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTransferRecordsRequest) Reset()         { *m = QueryTransferRecordsRequest{} }
+func (m *QueryTransferRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferRecordsRequest) ProtoMessage()    {}
+func (*QueryTransferRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f36287eba2d1de, []int{16}
+}
+func (m *QueryTransferRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryTransferRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferRecordsRequest.Merge(m, src)
+}
+func (m *QueryTransferRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferRecordsRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryTransferRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryTransferRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+type QueryTransferRecordsResponse struct {
+	TransferRecords []TransferRecord    `protobuf:"bytes,1,rep,name=transfer_records,json=transferRecords,proto3" json:"transfer_records"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTransferRecordsResponse) Reset()         { *m = QueryTransferRecordsResponse{} }
+func (m *QueryTransferRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferRecordsResponse) ProtoMessage()    {}
+func (*QueryTransferRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f36287eba2d1de, []int{17}
+}
+func (m *QueryTransferRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryTransferRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferRecordsResponse.Merge(m, src)
+}
+func (m *QueryTransferRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryModuleInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryTransferRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryTransferRecordsResponse) GetTransferRecords() []TransferRecord {
+	if m != nil {
+		return m.TransferRecords
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryModuleInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ModuleAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTransferRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryTransferRecordsByAddressRequest struct {
+	// address is the sender or the receiver of the transfers, the counterparty
+	// addresses are not bech32 encoded with the chain prefix
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTransferRecordsByAddressRequest) Reset()         { *m = QueryTransferRecordsByAddressRequest{} }
+func (m *QueryTransferRecordsByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferRecordsByAddressRequest) ProtoMessage()    {}
+func (*QueryTransferRecordsByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f36287eba2d1de, []int{18}
+}
+func (m *QueryTransferRecordsByAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferRecordsByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferRecordsByAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryTransferRecordsByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferRecordsByAddressRequest.Merge(m, src)
+}
+func (m *QueryTransferRecordsByAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferRecordsByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferRecordsByAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferRecordsByAddressRequest proto.InternalMessageInfo
+
+func (m *QueryTransferRecordsByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	l = len(m.ProposedOperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryTransferRecordsByAddressRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if len(m.PauserAddresses) > 0 {
-		for _, s := range m.PauserAddresses {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryTransferRecordsByAddressResponse struct {
+	TransferRecords []TransferRecord    `protobuf:"bytes,1,rep,name=transfer_records,json=transferRecords,proto3" json:"transfer_records"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTransferRecordsByAddressResponse) Reset()         { *m = QueryTransferRecordsByAddressResponse{} }
+func (m *QueryTransferRecordsByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferRecordsByAddressResponse) ProtoMessage()    {}
+func (*QueryTransferRecordsByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f36287eba2d1de, []int{19}
+}
+func (m *QueryTransferRecordsByAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferRecordsByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferRecordsByAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.TokenWrapperEnabled {
-		n += 2
-	}
-	l = len(m.NativeClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.CounterpartyClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.NativePort)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.CounterpartyPort)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.NativeChannel)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.CounterpartyChannel)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.DecimalDifference != 0 {
-		n += 1 + sovQuery(uint64(m.DecimalDifference))
-	}
-	return n
+}
+func (m *QueryTransferRecordsByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferRecordsByAddressResponse.Merge(m, src)
+}
+func (m *QueryTransferRecordsByAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferRecordsByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferRecordsByAddressResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTotalTransfersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryTransferRecordsByAddressResponse proto.InternalMessageInfo
+
+func (m *QueryTransferRecordsByAddressResponse) GetTransferRecords() []TransferRecord {
+	if m != nil {
+		return m.TransferRecords
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryTotalTransfersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTransferRecordsByAddressResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.TotalTransferredIn.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TotalTransferredOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+type QueryTransferRecordsByStatusRequest struct {
+	Status     TransferStatus     `protobuf:"varint,1,opt,name=status,proto3,enum=zigchain.tokenwrapper.TransferStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryTransferRecordsByStatusRequest) Reset()         { *m = QueryTransferRecordsByStatusRequest{} }
+func (m *QueryTransferRecordsByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferRecordsByStatusRequest) ProtoMessage()    {}
+func (*QueryTransferRecordsByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f36287eba2d1de, []int{20}
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+func (m *QueryTransferRecordsByStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferRecordsByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferRecordsByStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferRecordsByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferRecordsByStatusRequest.Merge(m, src)
+}
+func (m *QueryTransferRecordsByStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferRecordsByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferRecordsByStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferRecordsByStatusRequest proto.InternalMessageInfo
+
+func (m *QueryTransferRecordsByStatusRequest) GetStatus() TransferStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (m *QueryTransferRecordsByStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryTransferRecordsByStatusResponse struct {
+	TransferRecords []TransferRecord    `protobuf:"bytes,1,rep,name=transfer_records,json=transferRecords,proto3" json:"transfer_records"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTransferRecordsByStatusResponse) Reset()         { *m = QueryTransferRecordsByStatusResponse{} }
+func (m *QueryTransferRecordsByStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferRecordsByStatusResponse) ProtoMessage()    {}
+func (*QueryTransferRecordsByStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f36287eba2d1de, []int{21}
+}
+func (m *QueryTransferRecordsByStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferRecordsByStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferRecordsByStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferRecordsByStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferRecordsByStatusResponse.Merge(m, src)
+}
+func (m *QueryTransferRecordsByStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferRecordsByStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferRecordsByStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferRecordsByStatusResponse proto.InternalMessageInfo
+
+func (m *QueryTransferRecordsByStatusResponse) GetTransferRecords() []TransferRecord {
+	if m != nil {
+		return m.TransferRecords
+	}
+	return nil
+}
+
+func (m *QueryTransferRecordsByStatusResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryBridgeFeesRequest struct {
+}
+
+func (m *QueryBridgeFeesRequest) Reset()         { *m = QueryBridgeFeesRequest{} }
+func (m *QueryBridgeFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeFeesRequest) ProtoMessage()    {}
+func (*QueryBridgeFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f36287eba2d1de, []int{22}
+}
+func (m *QueryBridgeFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeFeesRequest.Merge(m, src)
+}
+func (m *QueryBridgeFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgeFeesRequest proto.InternalMessageInfo
+
+type QueryBridgeFeesResponse struct {
+	BridgeFeeBps       uint32                `protobuf:"varint,1,opt,name=bridge_fee_bps,json=bridgeFeeBps,proto3" json:"bridge_fee_bps,omitempty"`
+	BridgeFeeMin       cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=bridge_fee_min,json=bridgeFeeMin,proto3,customtype=cosmossdk.io/math.Int" json:"bridge_fee_min"`
+	BridgeFeeRecipient string                `protobuf:"bytes,3,opt,name=bridge_fee_recipient,json=bridgeFeeRecipient,proto3" json:"bridge_fee_recipient,omitempty"`
+	TotalBridgeFeesIn  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_bridge_fees_in,json=totalBridgeFeesIn,proto3,customtype=cosmossdk.io/math.Int" json:"total_bridge_fees_in"`
+	TotalBridgeFeesOut cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=total_bridge_fees_out,json=totalBridgeFeesOut,proto3,customtype=cosmossdk.io/math.Int" json:"total_bridge_fees_out"`
+}
+
+func (m *QueryBridgeFeesResponse) Reset()         { *m = QueryBridgeFeesResponse{} }
+func (m *QueryBridgeFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeFeesResponse) ProtoMessage()    {}
+func (*QueryBridgeFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f36287eba2d1de, []int{23}
+}
+func (m *QueryBridgeFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeFeesResponse.Merge(m, src)
+}
+func (m *QueryBridgeFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgeFeesResponse proto.InternalMessageInfo
+
+func (m *QueryBridgeFeesResponse) GetBridgeFeeBps() uint32 {
+	if m != nil {
+		return m.BridgeFeeBps
+	}
+	return 0
+}
+
+func (m *QueryBridgeFeesResponse) GetBridgeFeeRecipient() string {
+	if m != nil {
+		return m.BridgeFeeRecipient
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "zigchain.tokenwrapper.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "zigchain.tokenwrapper.QueryParamsResponse")
+	proto.RegisterType((*QueryModuleInfoRequest)(nil), "zigchain.tokenwrapper.QueryModuleInfoRequest")
+	proto.RegisterType((*QueryModuleInfoResponse)(nil), "zigchain.tokenwrapper.QueryModuleInfoResponse")
+	proto.RegisterType((*QueryTotalTransfersRequest)(nil), "zigchain.tokenwrapper.QueryTotalTransfersRequest")
+	proto.RegisterType((*QueryTotalTransfersResponse)(nil), "zigchain.tokenwrapper.QueryTotalTransfersResponse")
+	proto.RegisterType((*QueryBridgeRoutesRequest)(nil), "zigchain.tokenwrapper.QueryBridgeRoutesRequest")
+	proto.RegisterType((*QueryBridgeRoutesResponse)(nil), "zigchain.tokenwrapper.QueryBridgeRoutesResponse")
+	proto.RegisterType((*QueryBridgeRouteRequest)(nil), "zigchain.tokenwrapper.QueryBridgeRouteRequest")
+	proto.RegisterType((*QueryBridgeRouteResponse)(nil), "zigchain.tokenwrapper.QueryBridgeRouteResponse")
+	proto.RegisterType((*QueryVolumeCapacityRequest)(nil), "zigchain.tokenwrapper.QueryVolumeCapacityRequest")
+	proto.RegisterType((*QueryVolumeCapacityResponse)(nil), "zigchain.tokenwrapper.QueryVolumeCapacityResponse")
+	proto.RegisterType((*QueryPendingUnwrapsRequest)(nil), "zigchain.tokenwrapper.QueryPendingUnwrapsRequest")
+	proto.RegisterType((*QueryPendingUnwrapsResponse)(nil), "zigchain.tokenwrapper.QueryPendingUnwrapsResponse")
+	proto.RegisterType((*QueryPendingUnwrapsByAddressRequest)(nil), "zigchain.tokenwrapper.QueryPendingUnwrapsByAddressRequest")
+	proto.RegisterType((*QueryPendingUnwrapsByAddressResponse)(nil), "zigchain.tokenwrapper.QueryPendingUnwrapsByAddressResponse")
+	proto.RegisterType((*QueryTransferRecordsRequest)(nil), "zigchain.tokenwrapper.QueryTransferRecordsRequest")
+	proto.RegisterType((*QueryTransferRecordsResponse)(nil), "zigchain.tokenwrapper.QueryTransferRecordsResponse")
+	proto.RegisterType((*QueryTransferRecordsByAddressRequest)(nil), "zigchain.tokenwrapper.QueryTransferRecordsByAddressRequest")
+	proto.RegisterType((*QueryTransferRecordsByAddressResponse)(nil), "zigchain.tokenwrapper.QueryTransferRecordsByAddressResponse")
+	proto.RegisterType((*QueryTransferRecordsByStatusRequest)(nil), "zigchain.tokenwrapper.QueryTransferRecordsByStatusRequest")
+	proto.RegisterType((*QueryTransferRecordsByStatusResponse)(nil), "zigchain.tokenwrapper.QueryTransferRecordsByStatusResponse")
+	proto.RegisterType((*QueryBridgeFeesRequest)(nil), "zigchain.tokenwrapper.QueryBridgeFeesRequest")
+	proto.RegisterType((*QueryBridgeFeesResponse)(nil), "zigchain.tokenwrapper.QueryBridgeFeesResponse")
+}
+
+func init() { proto.RegisterFile("zigchain/tokenwrapper/query.proto", fileDescriptor_14f36287eba2d1de) }
+
+var fileDescriptor_14f36287eba2d1de = []byte{
+	// 1633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcb, 0x6f, 0x1c, 0x45,
+	0x13, 0xf7, 0xf8, 0x95, 0xa4, 0xfd, 0xee, 0xd8, 0xf1, 0x7a, 0x13, 0x3f, 0xbe, 0xfd, 0x6c, 0xc7,
+	0xf1, 0xf7, 0x65, 0x27, 0xd9, 0x1c, 0xf2, 0xe5, 0x33, 0x8f, 0x64, 0x4d, 0x12, 0xd9, 0x22, 0xd8,
+	0x99, 0xbc, 0xa4, 0x5c, 0x46, 0xbd, 0x3b, 0xed, 0xcd, 0x28, 0xbb, 0xdd, 0x93, 0x99, 0x1e, 0x83,
+	0xb1, 0x7c, 0xe1, 0x80, 0xe0, 0x86, 0xc4, 0x81, 0x23, 0x82, 0x03, 0x20, 0xc1, 0x81, 0x03, 0x27,
+	0x38, 0x20, 0x24, 0x04, 0x39, 0x70, 0x88, 0xc8, 0x25, 0xe2, 0x10, 0xa1, 0x04, 0x89, 0x1b, 0x07,
+	0xfe, 0x02, 0x34, 0xfd, 0xd8, 0x9d, 0xd9, 0x9d, 0xdd, 0x1d, 0x5b, 0x7b, 0xc9, 0x25, 0xd9, 0x74,
+	0x57, 0xfd, 0xea, 0x57, 0xd5, 0xd5, 0xd5, 0x55, 0x13, 0xf0, 0xaf, 0xb7, 0xed, 0x52, 0xf1, 0x1e,
+	0xb2, 0x89, 0xce, 0xe8, 0x7d, 0x4c, 0xde, 0x74, 0x91, 0xe3, 0x60, 0x57, 0x7f, 0xe0, 0x63, 0x77,
+	0x27, 0xeb, 0xb8, 0x94, 0x51, 0x38, 0xa1, 0x44, 0xb2, 0x61, 0x91, 0xf4, 0x18, 0xaa, 0xd8, 0x84,
+	0xea, 0xfc, 0x4f, 0x21, 0x99, 0x1e, 0x2f, 0xd1, 0x12, 0xe5, 0x3f, 0xf5, 0xe0, 0x97, 0x5c, 0x3d,
+	0x51, 0xa2, 0xb4, 0x54, 0xc6, 0x3a, 0x72, 0x6c, 0x1d, 0x11, 0x42, 0x19, 0x62, 0x36, 0x25, 0x9e,
+	0xdc, 0x5d, 0x2e, 0x52, 0xaf, 0x42, 0x3d, 0xbd, 0x80, 0x3c, 0x2c, 0xcc, 0xea, 0xdb, 0x67, 0x0b,
+	0x98, 0xa1, 0xb3, 0xba, 0x83, 0x4a, 0x36, 0xe1, 0xc2, 0x52, 0x36, 0x13, 0x4f, 0xd6, 0x41, 0x2e,
+	0xaa, 0x28, 0xbc, 0xa5, 0x78, 0x99, 0x82, 0x6b, 0x5b, 0x25, 0x6c, 0xba, 0xd4, 0x67, 0x58, 0x4a,
+	0x2e, 0xc6, 0x4b, 0x6e, 0xd3, 0xb2, 0x5f, 0xc1, 0x66, 0x11, 0x39, 0x8a, 0x61, 0x13, 0xab, 0x98,
+	0x58, 0x36, 0x29, 0x99, 0x3e, 0x5f, 0x91, 0xb2, 0xff, 0x89, 0x97, 0x65, 0x2e, 0x22, 0xde, 0x16,
+	0x76, 0x4d, 0x17, 0x17, 0xa9, 0x6b, 0x49, 0xe1, 0x99, 0xb0, 0xeb, 0xca, 0xe9, 0x22, 0xb5, 0x95,
+	0xbb, 0x53, 0x62, 0xdf, 0x14, 0x11, 0x15, 0xff, 0x10, 0x5b, 0x99, 0x71, 0x00, 0xaf, 0x07, 0xb1,
+	0xda, 0xe4, 0xae, 0x1b, 0xf8, 0x81, 0x8f, 0x3d, 0x96, 0xb9, 0x03, 0x8e, 0x46, 0x56, 0x3d, 0x87,
+	0x12, 0x0f, 0xc3, 0x8b, 0xa0, 0x5f, 0x84, 0x28, 0xa5, 0xcd, 0x69, 0x4b, 0x03, 0xb9, 0xe9, 0x6c,
+	0xec, 0x89, 0x66, 0x85, 0x5a, 0xfe, 0xc8, 0xc3, 0xa7, 0xb3, 0x5d, 0x5f, 0xfc, 0xf9, 0xf5, 0xb2,
+	0x66, 0x48, 0xbd, 0x4c, 0x0a, 0x1c, 0xe3, 0xc0, 0xd7, 0xa8, 0xe5, 0x97, 0xf1, 0x1a, 0xd9, 0xa2,
+	0xca, 0xe4, 0xa7, 0xbd, 0x60, 0xb2, 0x61, 0x4b, 0xda, 0x5d, 0x00, 0xc3, 0x15, 0xbe, 0x6a, 0x22,
+	0xcb, 0x72, 0xb1, 0x27, 0xec, 0x1f, 0x31, 0x86, 0xc4, 0xea, 0x25, 0xb1, 0x08, 0x57, 0xc0, 0xe1,
+	0x02, 0x2a, 0x23, 0x52, 0xc4, 0x5e, 0xaa, 0x7b, 0xae, 0x67, 0x69, 0x20, 0x37, 0x95, 0x95, 0xce,
+	0x06, 0x91, 0xc9, 0xca, 0xc8, 0x64, 0x57, 0xa9, 0x4d, 0xf2, 0xbd, 0x01, 0x39, 0xa3, 0xaa, 0x00,
+	0x57, 0xc1, 0x28, 0x75, 0xb0, 0x8b, 0x18, 0x75, 0xab, 0x56, 0x7a, 0x02, 0x2b, 0xf9, 0xd4, 0xaf,
+	0xdf, 0x9c, 0x1e, 0x97, 0x38, 0xd2, 0xd4, 0x0d, 0xe6, 0xda, 0xa4, 0x64, 0x8c, 0x28, 0x0d, 0xc5,
+	0xe0, 0x26, 0x98, 0x72, 0x5c, 0xea, 0x50, 0x0f, 0x5b, 0x66, 0x03, 0x5a, 0x6f, 0x1b, 0xb4, 0x49,
+	0xa5, 0xba, 0x51, 0x87, 0xba, 0x0a, 0x46, 0x1d, 0xe4, 0x7b, 0xb8, 0x0a, 0x85, 0xbd, 0x54, 0xdf,
+	0x5c, 0x4f, 0x6b, 0x6a, 0x42, 0xe3, 0x92, 0x52, 0x80, 0x39, 0x30, 0xc1, 0xcf, 0xc8, 0x94, 0x87,
+	0x64, 0x62, 0x82, 0x0a, 0x65, 0x6c, 0xa5, 0xfa, 0xe7, 0xb4, 0xa5, 0xc3, 0xc6, 0x51, 0xbe, 0x79,
+	0x47, 0xec, 0x5d, 0x16, 0x5b, 0xeb, 0xbd, 0x87, 0x0f, 0x8d, 0x8e, 0x18, 0xa3, 0xc1, 0xd5, 0xd9,
+	0xc6, 0x66, 0xb1, 0x6c, 0x63, 0xc2, 0x4c, 0xdb, 0x32, 0x8e, 0x15, 0xa9, 0x4f, 0x18, 0x76, 0x1d,
+	0xe4, 0xb2, 0x9d, 0xd0, 0xfa, 0x80, 0x94, 0x74, 0xa8, 0xcb, 0x8c, 0xb1, 0x88, 0x10, 0x5f, 0x1a,
+	0x56, 0x48, 0xf7, 0x10, 0x21, 0xb8, 0x6c, 0x8c, 0x47, 0x71, 0xe4, 0x6a, 0x9f, 0x85, 0x09, 0xad,
+	0x18, 0xd0, 0xc2, 0x45, 0xbb, 0x82, 0xca, 0xa6, 0x65, 0x6f, 0x6d, 0x61, 0x17, 0x93, 0x22, 0xce,
+	0x9c, 0x00, 0x69, 0x9e, 0x23, 0x37, 0x29, 0x43, 0xe5, 0x9b, 0xf2, 0x2e, 0x54, 0xb3, 0xf6, 0x07,
+	0x0d, 0x1c, 0x8f, 0xdd, 0x96, 0x69, 0xb4, 0x01, 0xc6, 0x59, 0xb0, 0x63, 0xaa, 0x5b, 0xe4, 0x62,
+	0xcb, 0xb4, 0x89, 0x48, 0xa6, 0xfc, 0x74, 0x90, 0x10, 0xbf, 0x3d, 0x9d, 0x9d, 0x10, 0xf1, 0xf4,
+	0xac, 0xfb, 0x59, 0x9b, 0xea, 0x15, 0xc4, 0xee, 0x65, 0xd7, 0x08, 0x33, 0x20, 0x0b, 0x83, 0xba,
+	0xd8, 0x5a, 0x23, 0xf0, 0x3a, 0x98, 0x68, 0x04, 0xa4, 0x3e, 0x4b, 0x75, 0x27, 0x41, 0x3c, 0x5a,
+	0x8f, 0xb8, 0xe1, 0xb3, 0x4c, 0x01, 0xa4, 0xb8, 0x0b, 0x79, 0x5e, 0x66, 0x8c, 0xa0, 0xca, 0x28,
+	0xff, 0xe0, 0x15, 0x00, 0x6a, 0x95, 0x4c, 0x5e, 0xc1, 0xc5, 0x48, 0x86, 0x8b, 0x6a, 0xab, 0xf2,
+	0x7c, 0x13, 0x95, 0xb0, 0xd4, 0x35, 0x42, 0x9a, 0x99, 0xcf, 0x34, 0x30, 0x15, 0x63, 0xa4, 0x76,
+	0xc9, 0x79, 0x71, 0x0b, 0x2e, 0x59, 0x70, 0x87, 0x32, 0x4d, 0x2e, 0x79, 0x48, 0x59, 0x5e, 0x26,
+	0xa9, 0x07, 0xaf, 0x46, 0x78, 0x76, 0x73, 0x9e, 0x27, 0xdb, 0xf2, 0x14, 0xe6, 0x23, 0x44, 0x91,
+	0x2c, 0x09, 0x21, 0x53, 0x2a, 0x16, 0xb3, 0x20, 0x9c, 0x6c, 0xb2, 0x1e, 0x00, 0xb1, 0xb4, 0x49,
+	0x5d, 0x16, 0xd4, 0x8c, 0x68, 0xb6, 0x89, 0x43, 0x31, 0x86, 0xc4, 0xea, 0xaa, 0x58, 0xcc, 0xdc,
+	0x6d, 0x8c, 0x77, 0x35, 0x12, 0xaf, 0x80, 0x3e, 0xee, 0x91, 0x0c, 0x75, 0xf2, 0x40, 0x08, 0xb5,
+	0x8c, 0x25, 0xb3, 0xf5, 0x36, 0x7f, 0x08, 0x56, 0x91, 0x83, 0x8a, 0x36, 0xdb, 0xe9, 0xb4, 0x07,
+	0xef, 0xf7, 0x80, 0xe3, 0xb1, 0x66, 0xa4, 0x17, 0xeb, 0x00, 0xd4, 0x5e, 0x22, 0xe9, 0xca, 0x5c,
+	0x13, 0x57, 0xaa, 0x10, 0xe1, 0xda, 0x7d, 0x64, 0x5b, 0xad, 0xc2, 0x8b, 0x60, 0xd0, 0x26, 0x05,
+	0xea, 0x13, 0xcb, 0xf4, 0x3d, 0x6c, 0x25, 0xcb, 0xf3, 0x01, 0xa9, 0x72, 0xcb, 0xc3, 0x16, 0xcc,
+	0x83, 0x21, 0xea, 0xb3, 0x10, 0x44, 0x4f, 0x12, 0x88, 0x41, 0xa5, 0xc3, 0x31, 0xd6, 0xc1, 0x98,
+	0x62, 0xe1, 0xe2, 0x0a, 0xb2, 0x89, 0x4d, 0x4a, 0xa9, 0xde, 0x24, 0x38, 0xa3, 0x52, 0xcf, 0x50,
+	0x6a, 0xf0, 0x75, 0x00, 0xab, 0x7c, 0x6a, 0x60, 0x7d, 0x49, 0xc0, 0xc6, 0x94, 0x62, 0x15, 0xad,
+	0x7a, 0xe2, 0x9b, 0xe2, 0x49, 0xbf, 0xc5, 0x03, 0xdb, 0xf1, 0xfb, 0xfb, 0x9d, 0xaa, 0x73, 0xf5,
+	0x66, 0xe4, 0x89, 0xdf, 0x00, 0x23, 0xd1, 0x9e, 0x42, 0x5d, 0xe5, 0xf9, 0x66, 0xef, 0x75, 0x18,
+	0x47, 0xe6, 0xf0, 0xb0, 0x13, 0x01, 0xef, 0xdc, 0xa5, 0xfe, 0x44, 0x03, 0xff, 0x8e, 0x61, 0x9f,
+	0xdf, 0x91, 0x6f, 0x95, 0x8a, 0x56, 0x0e, 0x1c, 0x8a, 0xbc, 0xf6, 0x2d, 0x1e, 0x3b, 0x25, 0x08,
+	0xaf, 0xc4, 0x90, 0x3c, 0x48, 0x84, 0x7f, 0xd4, 0xc0, 0x7c, 0x6b, 0x8e, 0x2f, 0x44, 0xa8, 0xb1,
+	0x7a, 0x0f, 0xe5, 0x1b, 0x63, 0xf0, 0xa6, 0xb1, 0xe3, 0xf9, 0xf8, 0xbd, 0x06, 0x4e, 0xc4, 0xdb,
+	0x91, 0x51, 0xba, 0x0d, 0x46, 0xeb, 0x1a, 0x57, 0x15, 0xa6, 0x85, 0x26, 0x61, 0x8a, 0x22, 0xc9,
+	0x38, 0x8d, 0xb0, 0x28, 0x7e, 0xe7, 0x02, 0xf5, 0x9e, 0x3a, 0xef, 0x3a, 0x0f, 0x1a, 0x92, 0x32,
+	0x55, 0x97, 0x94, 0x9d, 0x4f, 0xbd, 0x87, 0x1a, 0x58, 0x68, 0x43, 0xe5, 0x45, 0x89, 0xea, 0x57,
+	0xea, 0xa6, 0x37, 0xb8, 0x72, 0x83, 0x21, 0xe6, 0x57, 0x83, 0xfa, 0x32, 0xe8, 0xf7, 0xf8, 0x02,
+	0x8f, 0xe9, 0x70, 0x5b, 0xfa, 0x52, 0x5b, 0x2a, 0x75, 0x2c, 0xf2, 0x3f, 0x37, 0x4d, 0x02, 0x45,
+	0xf7, 0x45, 0x09, 0xbc, 0x9a, 0xb2, 0x44, 0x67, 0x72, 0x05, 0x57, 0x5b, 0xc8, 0xcc, 0xdf, 0xdd,
+	0x60, 0xb2, 0x61, 0x4b, 0xba, 0x35, 0x0f, 0x86, 0xe5, 0x70, 0xbb, 0x85, 0xb1, 0x59, 0x70, 0xc4,
+	0x71, 0x0c, 0x19, 0x83, 0x05, 0x25, 0x9b, 0x77, 0x82, 0x61, 0x24, 0x2c, 0x55, 0xb1, 0x49, 0xb2,
+	0x26, 0xa0, 0x06, 0x72, 0xcd, 0x26, 0x70, 0x1d, 0x8c, 0x87, 0x40, 0x5c, 0x5c, 0xb4, 0x1d, 0x1b,
+	0x13, 0xd6, 0x76, 0xe0, 0x82, 0x55, 0x14, 0x43, 0xe9, 0xc0, 0x37, 0x54, 0x57, 0x5f, 0x43, 0xf4,
+	0x82, 0xae, 0x3e, 0x51, 0x43, 0x30, 0xc6, 0x55, 0x6b, 0xc1, 0x58, 0x23, 0x70, 0x13, 0x4c, 0x34,
+	0xe2, 0x05, 0x4d, 0x7d, 0x5f, 0xf2, 0x31, 0xa1, 0x06, 0xb8, 0xe1, 0xb3, 0xdc, 0x5f, 0xa3, 0xa0,
+	0x8f, 0x07, 0x1d, 0xbe, 0xab, 0x81, 0x7e, 0x31, 0x1c, 0xc3, 0x53, 0x4d, 0x52, 0xa5, 0x71, 0x1a,
+	0x4f, 0x2f, 0x27, 0x11, 0x15, 0x87, 0x98, 0x59, 0x78, 0xe7, 0xf1, 0x1f, 0x1f, 0x76, 0xcf, 0xc2,
+	0x69, 0xbd, 0xd5, 0x27, 0x0e, 0xf8, 0x91, 0x06, 0x40, 0x6d, 0xd0, 0x86, 0xa7, 0x5b, 0x59, 0x68,
+	0x98, 0xd5, 0xd3, 0xd9, 0xa4, 0xe2, 0x92, 0xd4, 0x32, 0x27, 0x35, 0x0f, 0x33, 0x4d, 0x48, 0xc9,
+	0xe1, 0xde, 0x0e, 0xa8, 0x7c, 0xae, 0x81, 0xe1, 0xe8, 0xfc, 0x06, 0xcf, 0xb6, 0x32, 0x17, 0x3b,
+	0x0a, 0xa6, 0x73, 0xfb, 0x51, 0x91, 0x2c, 0xb3, 0x9c, 0xe5, 0x12, 0x5c, 0x6c, 0xc2, 0x32, 0x3a,
+	0xea, 0x79, 0xf0, 0x63, 0x0d, 0x0c, 0x86, 0x27, 0x28, 0xa8, 0xb7, 0x32, 0x1a, 0x33, 0xd0, 0xa5,
+	0xcf, 0x24, 0x57, 0x90, 0x1c, 0xff, 0xcb, 0x39, 0x2e, 0xc2, 0x79, 0xbd, 0xfd, 0xd7, 0x29, 0x0f,
+	0x7e, 0xab, 0x81, 0x81, 0x10, 0x0c, 0xcc, 0x26, 0xb4, 0xa7, 0xf8, 0xe9, 0x89, 0xe5, 0x25, 0xbd,
+	0x75, 0x4e, 0xef, 0x35, 0x98, 0x4f, 0x40, 0x4f, 0xdf, 0x0d, 0x8d, 0x3f, 0x7b, 0xfa, 0x6e, 0x74,
+	0xd6, 0xd9, 0x83, 0x3f, 0x69, 0x60, 0x38, 0x3a, 0xd2, 0xb4, 0x4e, 0x84, 0xd8, 0x29, 0x2b, 0x9d,
+	0xdb, 0x8f, 0x8a, 0xf4, 0xe2, 0x1a, 0xf7, 0xe2, 0x2a, 0xbc, 0xac, 0xb7, 0xfb, 0xb0, 0xc7, 0xf5,
+	0xda, 0x39, 0x12, 0x64, 0x74, 0xb4, 0x8f, 0x6c, 0xed, 0x48, 0xec, 0xf0, 0x90, 0xce, 0xed, 0x47,
+	0x25, 0x61, 0x46, 0xd7, 0xb5, 0xae, 0xf0, 0x17, 0x0d, 0x4c, 0x36, 0xe9, 0x78, 0xe1, 0xff, 0x93,
+	0xdb, 0xaf, 0xef, 0x9a, 0xd2, 0x2b, 0x07, 0xd2, 0x95, 0x4e, 0xfc, 0x8f, 0x3b, 0x91, 0x83, 0x67,
+	0x92, 0x39, 0xa1, 0xef, 0xca, 0x8e, 0x6c, 0x8f, 0x17, 0xb9, 0x5a, 0x25, 0x6e, 0x5d, 0xe4, 0x1a,
+	0x9e, 0xca, 0x74, 0x36, 0xa9, 0x78, 0xc2, 0x22, 0x17, 0x7a, 0x4e, 0xe0, 0x97, 0x1a, 0x18, 0xa9,
+	0xeb, 0x32, 0x60, 0xeb, 0x92, 0x15, 0xdb, 0xc1, 0xa7, 0xcf, 0xed, 0x4b, 0x47, 0x12, 0xd5, 0x39,
+	0xd1, 0x53, 0xf0, 0xa4, 0x9e, 0xe8, 0x1b, 0xb3, 0x07, 0x9f, 0x68, 0x20, 0xd5, 0xac, 0x1b, 0x85,
+	0x2b, 0xfb, 0xa0, 0xd0, 0x90, 0x18, 0x2f, 0x1d, 0x4c, 0x59, 0x3a, 0x72, 0x89, 0x3b, 0xb2, 0x02,
+	0x2f, 0x24, 0x74, 0x44, 0x97, 0x99, 0x11, 0x4a, 0x91, 0xc7, 0x1a, 0x98, 0x6c, 0xd2, 0xee, 0xb5,
+	0xce, 0xf8, 0xd6, 0x2d, 0x6d, 0x7a, 0xe5, 0x40, 0xba, 0xd2, 0xaf, 0x57, 0xb9, 0x5f, 0x17, 0xe0,
+	0xf9, 0xa4, 0x7e, 0x89, 0x46, 0x58, 0xdf, 0x15, 0x7f, 0xef, 0xe5, 0xcf, 0x3f, 0x7c, 0x36, 0xa3,
+	0x3d, 0x7a, 0x36, 0xa3, 0xfd, 0xfe, 0x6c, 0x46, 0xfb, 0xe0, 0xf9, 0x4c, 0xd7, 0xa3, 0xe7, 0x33,
+	0x5d, 0x4f, 0x9e, 0xcf, 0x74, 0xdd, 0x9d, 0xae, 0x22, 0xbe, 0x55, 0x87, 0xb9, 0xe3, 0x60, 0xaf,
+	0xd0, 0xcf, 0xff, 0x53, 0xe0, 0xdc, 0x3f, 0x03, 0x00, 0x34, 0x3f, 0x11, 0x16, 0xcd, 0x19, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of ModuleInfo items.
+	ModuleInfo(ctx context.Context, in *QueryModuleInfoRequest, opts ...grpc.CallOption) (*QueryModuleInfoResponse, error)
+	// Queries the total amount of ZIG tokens transferred in and out
+	TotalTransfers(ctx context.Context, in *QueryTotalTransfersRequest, opts ...grpc.CallOption) (*QueryTotalTransfersResponse, error)
+	// Queries the bridge routes the module wraps transfers over
+	BridgeRoutes(ctx context.Context, in *QueryBridgeRoutesRequest, opts ...grpc.CallOption) (*QueryBridgeRoutesResponse, error)
+	// Queries the bridge route of a native port and channel
+	BridgeRoute(ctx context.Context, in *QueryBridgeRouteRequest, opts ...grpc.CallOption) (*QueryBridgeRouteResponse, error)
+	// Queries the volume cap of a bridge route and its remaining capacity
+	VolumeCapacity(ctx context.Context, in *QueryVolumeCapacityRequest, opts ...grpc.CallOption) (*QueryVolumeCapacityResponse, error)
+	// Queries the pending unwraps in the order they are converted
+	PendingUnwraps(ctx context.Context, in *QueryPendingUnwrapsRequest, opts ...grpc.CallOption) (*QueryPendingUnwrapsResponse, error)
+	// Queries the pending unwraps of an address
+	PendingUnwrapsByAddress(ctx context.Context, in *QueryPendingUnwrapsByAddressRequest, opts ...grpc.CallOption) (*QueryPendingUnwrapsByAddressResponse, error)
+	// Queries the bridge fee settings and the total bridge fees paid
+	BridgeFees(ctx context.Context, in *QueryBridgeFeesRequest, opts ...grpc.CallOption) (*QueryBridgeFeesResponse, error)
+	// Queries the transfer records of the ledger in the order they were written
+	TransferRecords(ctx context.Context, in *QueryTransferRecordsRequest, opts ...grpc.CallOption) (*QueryTransferRecordsResponse, error)
+	// Queries the transfer records sent or received by an address
+	TransferRecordsByAddress(ctx context.Context, in *QueryTransferRecordsByAddressRequest, opts ...grpc.CallOption) (*QueryTransferRecordsByAddressResponse, error)
+	// Queries the transfer records of a status
+	TransferRecordsByStatus(ctx context.Context, in *QueryTransferRecordsByStatusRequest, opts ...grpc.CallOption) (*QueryTransferRecordsByStatusResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.tokenwrapper.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleInfo(ctx context.Context, in *QueryModuleInfoRequest, opts ...grpc.CallOption) (*QueryModuleInfoResponse, error) {
+	out := new(QueryModuleInfoResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.tokenwrapper.Query/ModuleInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalTransfers(ctx context.Context, in *QueryTotalTransfersRequest, opts ...grpc.CallOption) (*QueryTotalTransfersResponse, error) {
+	out := new(QueryTotalTransfersResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.tokenwrapper.Query/TotalTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeRoutes(ctx context.Context, in *QueryBridgeRoutesRequest, opts ...grpc.CallOption) (*QueryBridgeRoutesResponse, error) {
+	out := new(QueryBridgeRoutesResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.tokenwrapper.Query/BridgeRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeRoute(ctx context.Context, in *QueryBridgeRouteRequest, opts ...grpc.CallOption) (*QueryBridgeRouteResponse, error) {
+	out := new(QueryBridgeRouteResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.tokenwrapper.Query/BridgeRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VolumeCapacity(ctx context.Context, in *QueryVolumeCapacityRequest, opts ...grpc.CallOption) (*QueryVolumeCapacityResponse, error) {
+	out := new(QueryVolumeCapacityResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.tokenwrapper.Query/VolumeCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingUnwraps(ctx context.Context, in *QueryPendingUnwrapsRequest, opts ...grpc.CallOption) (*QueryPendingUnwrapsResponse, error) {
+	out := new(QueryPendingUnwrapsResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.tokenwrapper.Query/PendingUnwraps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingUnwrapsByAddress(ctx context.Context, in *QueryPendingUnwrapsByAddressRequest, opts ...grpc.CallOption) (*QueryPendingUnwrapsByAddressResponse, error) {
+	out := new(QueryPendingUnwrapsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.tokenwrapper.Query/PendingUnwrapsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeFees(ctx context.Context, in *QueryBridgeFeesRequest, opts ...grpc.CallOption) (*QueryBridgeFeesResponse, error) {
+	out := new(QueryBridgeFeesResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.tokenwrapper.Query/BridgeFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferRecords(ctx context.Context, in *QueryTransferRecordsRequest, opts ...grpc.CallOption) (*QueryTransferRecordsResponse, error) {
+	out := new(QueryTransferRecordsResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.tokenwrapper.Query/TransferRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferRecordsByAddress(ctx context.Context, in *QueryTransferRecordsByAddressRequest, opts ...grpc.CallOption) (*QueryTransferRecordsByAddressResponse, error) {
+	out := new(QueryTransferRecordsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.tokenwrapper.Query/TransferRecordsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferRecordsByStatus(ctx context.Context, in *QueryTransferRecordsByStatusRequest, opts ...grpc.CallOption) (*QueryTransferRecordsByStatusResponse, error) {
+	out := new(QueryTransferRecordsByStatusResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.tokenwrapper.Query/TransferRecordsByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of ModuleInfo items.
+	ModuleInfo(context.Context, *QueryModuleInfoRequest) (*QueryModuleInfoResponse, error)
+	// Queries the total amount of ZIG tokens transferred in and out
+	TotalTransfers(context.Context, *QueryTotalTransfersRequest) (*QueryTotalTransfersResponse, error)
+	// Queries the bridge routes the module wraps transfers over
+	BridgeRoutes(context.Context, *QueryBridgeRoutesRequest) (*QueryBridgeRoutesResponse, error)
+	// Queries the bridge route of a native port and channel
+	BridgeRoute(context.Context, *QueryBridgeRouteRequest) (*QueryBridgeRouteResponse, error)
+	// Queries the volume cap of a bridge route and its remaining capacity
+	VolumeCapacity(context.Context, *QueryVolumeCapacityRequest) (*QueryVolumeCapacityResponse, error)
+	// Queries the pending unwraps in the order they are converted
+	PendingUnwraps(context.Context, *QueryPendingUnwrapsRequest) (*QueryPendingUnwrapsResponse, error)
+	// Queries the pending unwraps of an address
+	PendingUnwrapsByAddress(context.Context, *QueryPendingUnwrapsByAddressRequest) (*QueryPendingUnwrapsByAddressResponse, error)
+	// Queries the bridge fee settings and the total bridge fees paid
+	BridgeFees(context.Context, *QueryBridgeFeesRequest) (*QueryBridgeFeesResponse, error)
+	// Queries the transfer records of the ledger in the order they were written
+	TransferRecords(context.Context, *QueryTransferRecordsRequest) (*QueryTransferRecordsResponse, error)
+	// Queries the transfer records sent or received by an address
+	TransferRecordsByAddress(context.Context, *QueryTransferRecordsByAddressRequest) (*QueryTransferRecordsByAddressResponse, error)
+	// Queries the transfer records of a status
+	TransferRecordsByStatus(context.Context, *QueryTransferRecordsByStatusRequest) (*QueryTransferRecordsByStatusResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ModuleInfo(ctx context.Context, req *QueryModuleInfoRequest) (*QueryModuleInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleInfo not implemented")
+}
+func (*UnimplementedQueryServer) TotalTransfers(ctx context.Context, req *QueryTotalTransfersRequest) (*QueryTotalTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalTransfers not implemented")
+}
+func (*UnimplementedQueryServer) BridgeRoutes(ctx context.Context, req *QueryBridgeRoutesRequest) (*QueryBridgeRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeRoutes not implemented")
+}
+func (*UnimplementedQueryServer) BridgeRoute(ctx context.Context, req *QueryBridgeRouteRequest) (*QueryBridgeRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeRoute not implemented")
+}
+func (*UnimplementedQueryServer) VolumeCapacity(ctx context.Context, req *QueryVolumeCapacityRequest) (*QueryVolumeCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeCapacity not implemented")
+}
+func (*UnimplementedQueryServer) PendingUnwraps(ctx context.Context, req *QueryPendingUnwrapsRequest) (*QueryPendingUnwrapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingUnwraps not implemented")
+}
+func (*UnimplementedQueryServer) PendingUnwrapsByAddress(ctx context.Context, req *QueryPendingUnwrapsByAddressRequest) (*QueryPendingUnwrapsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingUnwrapsByAddress not implemented")
+}
+func (*UnimplementedQueryServer) BridgeFees(ctx context.Context, req *QueryBridgeFeesRequest) (*QueryBridgeFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeFees not implemented")
+}
+func (*UnimplementedQueryServer) TransferRecords(ctx context.Context, req *QueryTransferRecordsRequest) (*QueryTransferRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRecords not implemented")
+}
+func (*UnimplementedQueryServer) TransferRecordsByAddress(ctx context.Context, req *QueryTransferRecordsByAddressRequest) (*QueryTransferRecordsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRecordsByAddress not implemented")
+}
+func (*UnimplementedQueryServer) TransferRecordsByStatus(ctx context.Context, req *QueryTransferRecordsByStatusRequest) (*QueryTransferRecordsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRecordsByStatus not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.tokenwrapper.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.tokenwrapper.Query/ModuleInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleInfo(ctx, req.(*QueryModuleInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.tokenwrapper.Query/TotalTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalTransfers(ctx, req.(*QueryTotalTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.tokenwrapper.Query/BridgeRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeRoutes(ctx, req.(*QueryBridgeRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.tokenwrapper.Query/BridgeRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeRoute(ctx, req.(*QueryBridgeRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VolumeCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVolumeCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VolumeCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.tokenwrapper.Query/VolumeCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VolumeCapacity(ctx, req.(*QueryVolumeCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingUnwraps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingUnwrapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingUnwraps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.tokenwrapper.Query/PendingUnwraps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingUnwraps(ctx, req.(*QueryPendingUnwrapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingUnwrapsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingUnwrapsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingUnwrapsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.tokenwrapper.Query/PendingUnwrapsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingUnwrapsByAddress(ctx, req.(*QueryPendingUnwrapsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.tokenwrapper.Query/BridgeFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeFees(ctx, req.(*QueryBridgeFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.tokenwrapper.Query/TransferRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferRecords(ctx, req.(*QueryTransferRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferRecordsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferRecordsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferRecordsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.tokenwrapper.Query/TransferRecordsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferRecordsByAddress(ctx, req.(*QueryTransferRecordsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferRecordsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferRecordsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferRecordsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.tokenwrapper.Query/TransferRecordsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferRecordsByStatus(ctx, req.(*QueryTransferRecordsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "zigchain.tokenwrapper.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ModuleInfo",
+			Handler:    _Query_ModuleInfo_Handler,
+		},
+		{
+			MethodName: "TotalTransfers",
+			Handler:    _Query_TotalTransfers_Handler,
+		},
+		{
+			MethodName: "BridgeRoutes",
+			Handler:    _Query_BridgeRoutes_Handler,
+		},
+		{
+			MethodName: "BridgeRoute",
+			Handler:    _Query_BridgeRoute_Handler,
+		},
+		{
+			MethodName: "VolumeCapacity",
+			Handler:    _Query_VolumeCapacity_Handler,
+		},
+		{
+			MethodName: "PendingUnwraps",
+			Handler:    _Query_PendingUnwraps_Handler,
+		},
+		{
+			MethodName: "PendingUnwrapsByAddress",
+			Handler:    _Query_PendingUnwrapsByAddress_Handler,
+		},
+		{
+			MethodName: "BridgeFees",
+			Handler:    _Query_BridgeFees_Handler,
+		},
+		{
+			MethodName: "TransferRecords",
+			Handler:    _Query_TransferRecords_Handler,
+		},
+		{
+			MethodName: "TransferRecordsByAddress",
+			Handler:    _Query_TransferRecordsByAddress_Handler,
+		},
+		{
+			MethodName: "TransferRecordsByStatus",
+			Handler:    _Query_TransferRecordsByStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "zigchain/tokenwrapper/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TokenWrapperEnabled {
+		i--
+		if m.TokenWrapperEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PauserAddresses) > 0 {
+		for iNdEx := len(m.PauserAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PauserAddresses[iNdEx])
+			copy(dAtA[i:], m.PauserAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.PauserAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ProposedOperatorAddress) > 0 {
+		i -= len(m.ProposedOperatorAddress)
+		copy(dAtA[i:], m.ProposedOperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposedOperatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalTransfersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalTransfersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalTransfersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalTransfersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalTransferredOut.Size()
+		i -= size
+		if _, err := m.TotalTransferredOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalTransferredIn.Size()
+		i -= size
+		if _, err := m.TotalTransferredIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NativeChannel) > 0 {
+		i -= len(m.NativeChannel)
+		copy(dAtA[i:], m.NativeChannel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NativeChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NativePort) > 0 {
+		i -= len(m.NativePort)
+		copy(dAtA[i:], m.NativePort)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NativePort)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVolumeCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVolumeCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVolumeCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NativeChannel) > 0 {
+		i -= len(m.NativeChannel)
+		copy(dAtA[i:], m.NativeChannel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NativeChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NativePort) > 0 {
+		i -= len(m.NativePort)
+		copy(dAtA[i:], m.NativePort)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NativePort)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVolumeCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVolumeCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVolumeCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OutboundRemaining.Size()
+		i -= size
+		if _, err := m.OutboundRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.InboundRemaining.Size()
+		i -= size
+		if _, err := m.InboundRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.OutboundUsed.Size()
+		i -= size
+		if _, err := m.OutboundUsed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InboundUsed.Size()
+		i -= size
+		if _, err := m.InboundUsed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.VolumeCap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingUnwrapsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingUnwrapsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingUnwrapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingUnwrapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingUnwrapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingUnwrapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingUnwraps) > 0 {
+		for iNdEx := len(m.PendingUnwraps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingUnwraps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingUnwrapsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingUnwrapsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingUnwrapsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingUnwrapsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingUnwrapsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingUnwrapsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingUnwraps) > 0 {
+		for iNdEx := len(m.PendingUnwraps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingUnwraps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TransferRecords) > 0 {
+		for iNdEx := len(m.TransferRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferRecordsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferRecordsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferRecordsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferRecordsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferRecordsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferRecordsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TransferRecords) > 0 {
+		for iNdEx := len(m.TransferRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferRecordsByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferRecordsByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferRecordsByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferRecordsByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferRecordsByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferRecordsByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TransferRecords) > 0 {
+		for iNdEx := len(m.TransferRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalBridgeFeesOut.Size()
+		i -= size
+		if _, err := m.TotalBridgeFeesOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalBridgeFeesIn.Size()
+		i -= size
+		if _, err := m.TotalBridgeFeesIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.BridgeFeeRecipient) > 0 {
+		i -= len(m.BridgeFeeRecipient)
+		copy(dAtA[i:], m.BridgeFeeRecipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BridgeFeeRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.BridgeFeeMin.Size()
+		i -= size
+		if _, err := m.BridgeFeeMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BridgeFeeBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BridgeFeeBps))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryModuleInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProposedOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PauserAddresses) > 0 {
+		for _, s := range m.PauserAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TokenWrapperEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTotalTransfersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalTransferredIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalTransferredOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBridgeRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NativePort)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NativeChannel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Route.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVolumeCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NativePort)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NativeChannel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVolumeCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VolumeCap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InboundUsed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OutboundUsed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InboundRemaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OutboundRemaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingUnwrapsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingUnwrapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingUnwraps) > 0 {
+		for _, e := range m.PendingUnwraps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingUnwrapsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingUnwrapsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingUnwraps) > 0 {
+		for _, e := range m.PendingUnwraps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TransferRecords) > 0 {
+		for _, e := range m.TransferRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferRecordsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferRecordsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TransferRecords) > 0 {
+		for _, e := range m.TransferRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferRecordsByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferRecordsByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TransferRecords) > 0 {
+		for _, e := range m.TransferRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBridgeFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeFeeBps != 0 {
+		n += 1 + sovQuery(uint64(m.BridgeFeeBps))
+	}
+	l = m.BridgeFeeMin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.BridgeFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalBridgeFeesIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalBridgeFeesOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedOperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposedOperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauserAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauserAddresses = append(m.PauserAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenWrapperEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TokenWrapperEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalTransfersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalTransfersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalTransfersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalTransfersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTransferredIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalTransferredIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTransferredOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalTransferredOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeRoutesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, BridgeRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeRouteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeRouteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVolumeCapacityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVolumeCapacityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVolumeCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVolumeCapacityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVolumeCapacityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVolumeCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolumeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundUsed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InboundUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundUsed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutboundUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InboundRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutboundRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingUnwrapsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingUnwrapsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingUnwrapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingUnwrapsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingUnwrapsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingUnwrapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUnwraps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingUnwraps = append(m.PendingUnwraps, PendingUnwrap{})
+			if err := m.PendingUnwraps[len(m.PendingUnwraps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingUnwrapsByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -1035,12 +4807,200 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingUnwrapsByAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingUnwrapsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingUnwrapsByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingUnwrapsByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingUnwrapsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUnwraps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingUnwraps = append(m.PendingUnwraps, PendingUnwrap{})
+			if err := m.PendingUnwraps[len(m.PendingUnwraps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1062,7 +5022,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTransferRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1085,15 +5045,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTransferRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTransferRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1120,7 +5080,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1145,7 +5108,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTransferRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1168,12 +5131,82 @@ func (m *QueryModuleInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTransferRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTransferRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferRecords = append(m.TransferRecords, TransferRecord{})
+			if err := m.TransferRecords[len(m.TransferRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1195,7 +5228,7 @@ func (m *QueryModuleInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTransferRecordsByAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1218,15 +5251,15 @@ func (m *QueryModuleInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTransferRecordsByAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTransferRecordsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1254,11 +5287,11 @@ func (m *QueryModuleInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1285,48 +5318,68 @@ func (m *QueryModuleInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, types.Coin{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferRecordsByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferRecordsByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferRecordsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposedOperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecords", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1336,29 +5389,31 @@ func (m *QueryModuleInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposedOperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.TransferRecords = append(m.TransferRecords, TransferRecord{})
+			if err := m.TransferRecords[len(m.TransferRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PauserAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1368,113 +5423,83 @@ func (m *QueryModuleInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PauserAddresses = append(m.PauserAddresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenWrapperEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TokenWrapperEnabled = bool(v != 0)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeClientId", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NativeClientId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyClientId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferRecordsByStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyClientId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativePort", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferRecordsByStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferRecordsByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1484,29 +5509,16 @@ func (m *QueryModuleInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= TransferStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NativePort = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1516,61 +5528,83 @@ func (m *QueryModuleInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyPort = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeChannel", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NativeChannel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferRecordsByStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferRecordsByStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferRecordsByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRecords", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1580,29 +5614,31 @@ func (m *QueryModuleInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyChannel = string(dAtA[iNdEx:postIndex])
+			m.TransferRecords = append(m.TransferRecords, TransferRecord{})
+			if err := m.TransferRecords[len(m.TransferRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1612,43 +5648,28 @@ func (m *QueryModuleInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecimalDifference", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			m.DecimalDifference = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DecimalDifference |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1670,7 +5691,7 @@ func (m *QueryModuleInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalTransfersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1693,10 +5714,10 @@ func (m *QueryTotalTransfersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalTransfersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1720,7 +5741,7 @@ func (m *QueryTotalTransfersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalTransfersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1743,15 +5764,34 @@ func (m *QueryTotalTransfersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalTransfersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFeeBps", wireType)
+			}
+			m.BridgeFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalTransferredIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFeeMin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1779,13 +5819,13 @@ func (m *QueryTotalTransfersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalTransferredIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BridgeFeeMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalTransferredOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFeeRecipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1813,7 +5853,73 @@ func (m *QueryTotalTransfersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalTransferredOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BridgeFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBridgeFeesIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBridgeFeesIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBridgeFeesOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBridgeFeesOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
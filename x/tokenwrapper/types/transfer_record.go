@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	"zigchain/zutils/validators"
+)
+
+// MaxTransferRecordsPrunedPerBlock bounds the number of transfer records the begin blocker prunes per block
+const MaxTransferRecordsPrunedPerBlock = 100
+
+// Validate validates a transfer record
+func (r TransferRecord) Validate() error {
+	if err := validators.ValidatePort(r.NativePort); err != nil {
+		return err
+	}
+	if err := validators.ValidateChannel(r.NativeChannel); err != nil {
+		return err
+	}
+
+	if r.Sender == "" || r.Receiver == "" {
+		return fmt.Errorf("%w: sender and receiver of transfer record %d must be set", ErrInvalidTransferRecord, r.Id)
+	}
+	if r.IbcAmount.IsNil() || !r.IbcAmount.IsPositive() {
+		return fmt.Errorf("%w: ibc amount of transfer record %d must be positive", ErrInvalidTransferRecord, r.Id)
+	}
+	if r.NativeAmount.IsNil() || r.NativeAmount.IsNegative() {
+		return fmt.Errorf("%w: native amount of transfer record %d cannot be negative", ErrInvalidTransferRecord, r.Id)
+	}
+	if !r.Fee.IsNil() && r.Fee.IsNegative() {
+		return fmt.Errorf("%w: fee of transfer record %d cannot be negative", ErrInvalidTransferRecord, r.Id)
+	}
+
+	if !r.Status.IsValid(r.Inbound) {
+		return fmt.Errorf("%w: status %s is not valid for transfer record %d", ErrInvalidTransferRecord, r.Status, r.Id)
+	}
+
+	return nil
+}
+
+// IsValid returns whether the status can be stored on a transfer record of a direction,
+// the received packets are only converted or skipped
+func (s TransferStatus) IsValid(inbound bool) bool {
+	switch s {
+	case TRANSFER_STATUS_ACKED:
+		return true
+	case TRANSFER_STATUS_SKIPPED:
+		return inbound
+	case TRANSFER_STATUS_PENDING, TRANSFER_STATUS_REFUNDED, TRANSFER_STATUS_TIMED_OUT:
+		return !inbound
+	default:
+		return false
+	}
+}
@@ -0,0 +1,971 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zigchain/tokenwrapper/transfer_record.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransferStatus is the status of a transfer wrapped over a bridge route
+type TransferStatus int32
+
+const (
+	// TRANSFER_STATUS_UNSPECIFIED is never stored
+	TRANSFER_STATUS_UNSPECIFIED TransferStatus = 0
+	// TRANSFER_STATUS_PENDING is an outgoing transfer waiting for its
+	// acknowledgement
+	TRANSFER_STATUS_PENDING TransferStatus = 1
+	// TRANSFER_STATUS_ACKED is an outgoing transfer acknowledged by the
+	// counterparty chain or an incoming transfer converted into native tokens
+	TRANSFER_STATUS_ACKED TransferStatus = 2
+	// TRANSFER_STATUS_REFUNDED is an outgoing transfer refunded on an error
+	// acknowledgement
+	TRANSFER_STATUS_REFUNDED TransferStatus = 3
+	// TRANSFER_STATUS_TIMED_OUT is an outgoing transfer refunded on a timeout
+	TRANSFER_STATUS_TIMED_OUT TransferStatus = 4
+	// TRANSFER_STATUS_SKIPPED is an incoming transfer whose IBC vouchers were
+	// not converted, the reason of the record says why
+	TRANSFER_STATUS_SKIPPED TransferStatus = 5
+)
+
+var TransferStatus_name = map[int32]string{
+	0: "TRANSFER_STATUS_UNSPECIFIED",
+	1: "TRANSFER_STATUS_PENDING",
+	2: "TRANSFER_STATUS_ACKED",
+	3: "TRANSFER_STATUS_REFUNDED",
+	4: "TRANSFER_STATUS_TIMED_OUT",
+	5: "TRANSFER_STATUS_SKIPPED",
+}
+
+var TransferStatus_value = map[string]int32{
+	"TRANSFER_STATUS_UNSPECIFIED": 0,
+	"TRANSFER_STATUS_PENDING":     1,
+	"TRANSFER_STATUS_ACKED":       2,
+	"TRANSFER_STATUS_REFUNDED":    3,
+	"TRANSFER_STATUS_TIMED_OUT":   4,
+	"TRANSFER_STATUS_SKIPPED":     5,
+}
+
+func (x TransferStatus) String() string {
+	return proto.EnumName(TransferStatus_name, int32(x))
+}
+
+func (TransferStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cbbd989539a6fa0d, []int{0}
+}
+
+// TransferRecord is the ledger entry of a packet wrapped over a bridge route
+type TransferRecord struct {
+	// id defines the position of the record in the ledger
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// native_port defines the native port of the bridge route
+	NativePort string `protobuf:"bytes,2,opt,name=native_port,json=nativePort,proto3" json:"native_port,omitempty"`
+	// native_channel defines the native channel of the bridge route
+	NativeChannel string `protobuf:"bytes,3,opt,name=native_channel,json=nativeChannel,proto3" json:"native_channel,omitempty"`
+	// sequence defines the sequence of the packet on the native channel
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// inbound is true for a received packet, false for a sent packet
+	Inbound bool `protobuf:"varint,5,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	// sender defines the sender of the packet
+	Sender string `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+	// receiver defines the receiver of the packet
+	Receiver string `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// ibc_amount defines the amount of IBC vouchers of the packet
+	IbcAmount cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=ibc_amount,json=ibcAmount,proto3,customtype=cosmossdk.io/math.Int" json:"ibc_amount"`
+	// native_amount defines the amount of native tokens locked or unlocked,
+	// zero for an incoming transfer skipped before its conversion
+	NativeAmount cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=native_amount,json=nativeAmount,proto3,customtype=cosmossdk.io/math.Int" json:"native_amount"`
+	// status defines the status of the transfer
+	Status TransferStatus `protobuf:"varint,10,opt,name=status,proto3,enum=zigchain.tokenwrapper.TransferStatus" json:"status,omitempty"`
+	// reason defines why the transfer was skipped or could not be refunded
+	Reason string `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+	// height defines the block height at which the packet was sent or received
+	Height int64 `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty"`
+	// updated_height defines the block height of the last status change
+	UpdatedHeight int64 `protobuf:"varint,13,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+	// fee defines the bridge fee in native tokens charged on the transfer, on
+	// top of the native amount
+	Fee cosmossdk_io_math.Int `protobuf:"bytes,14,opt,name=fee,proto3,customtype=cosmossdk.io/math.Int" json:"fee"`
+}
+
+func (m *TransferRecord) Reset()         { *m = TransferRecord{} }
+func (m *TransferRecord) String() string { return proto.CompactTextString(m) }
+func (*TransferRecord) ProtoMessage()    {}
+func (*TransferRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbbd989539a6fa0d, []int{0}
+}
+func (m *TransferRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRecord.Merge(m, src)
+}
+func (m *TransferRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRecord proto.InternalMessageInfo
+
+func (m *TransferRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TransferRecord) GetNativePort() string {
+	if m != nil {
+		return m.NativePort
+	}
+	return ""
+}
+
+func (m *TransferRecord) GetNativeChannel() string {
+	if m != nil {
+		return m.NativeChannel
+	}
+	return ""
+}
+
+func (m *TransferRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *TransferRecord) GetInbound() bool {
+	if m != nil {
+		return m.Inbound
+	}
+	return false
+}
+
+func (m *TransferRecord) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *TransferRecord) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *TransferRecord) GetStatus() TransferStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (m *TransferRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *TransferRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TransferRecord) GetUpdatedHeight() int64 {
+	if m != nil {
+		return m.UpdatedHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("zigchain.tokenwrapper.TransferStatus", TransferStatus_name, TransferStatus_value)
+	proto.RegisterType((*TransferRecord)(nil), "zigchain.tokenwrapper.TransferRecord")
+}
+
+func init() {
+	proto.RegisterFile("zigchain/tokenwrapper/transfer_record.proto", fileDescriptor_cbbd989539a6fa0d)
+}
+
+var fileDescriptor_cbbd989539a6fa0d = []byte{
+	// 555 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0xf6, 0x24, 0x69, 0xda, 0x4e, 0x5b, 0xcb, 0xff, 0xe8, 0x2f, 0x4c, 0x53, 0xea, 0x44, 0x95,
+	0x2a, 0x45, 0x45, 0x72, 0x24, 0x40, 0x62, 0xc5, 0x22, 0x8d, 0x9d, 0x62, 0x55, 0x84, 0xc8, 0x76,
+	0x36, 0x6c, 0x2c, 0xc7, 0x9e, 0x26, 0xa3, 0x92, 0x19, 0x33, 0x9e, 0x94, 0xcb, 0x13, 0xb0, 0xe4,
+	0x1d, 0xd8, 0x20, 0x56, 0x3c, 0x46, 0xd9, 0x75, 0x89, 0x58, 0x54, 0x28, 0x59, 0xf0, 0x1a, 0xc8,
+	0x97, 0x44, 0x10, 0x75, 0xd1, 0x8d, 0x35, 0xdf, 0xe5, 0x7c, 0x3e, 0x47, 0x47, 0x07, 0x3e, 0xfc,
+	0x40, 0x47, 0xe1, 0x38, 0xa0, 0xac, 0x25, 0xf9, 0x05, 0x61, 0x6f, 0x45, 0x10, 0xc7, 0x44, 0xb4,
+	0xa4, 0x08, 0x58, 0x72, 0x4e, 0x84, 0x2f, 0x48, 0xc8, 0x45, 0x64, 0xc4, 0x82, 0x4b, 0x8e, 0x76,
+	0x17, 0x66, 0xe3, 0x6f, 0x73, 0xed, 0xbf, 0x60, 0x42, 0x19, 0x6f, 0x65, 0xdf, 0xdc, 0x59, 0xfb,
+	0x7f, 0xc4, 0x47, 0x3c, 0x7b, 0xb6, 0xd2, 0x57, 0xce, 0x1e, 0x7e, 0xad, 0x40, 0xd5, 0x2b, 0x92,
+	0x9d, 0x2c, 0x18, 0xa9, 0xb0, 0x44, 0x23, 0x0c, 0x1a, 0xa0, 0x59, 0x71, 0x4a, 0x34, 0x42, 0x75,
+	0xb8, 0xc5, 0x02, 0x49, 0x2f, 0x89, 0x1f, 0x73, 0x21, 0x71, 0xa9, 0x01, 0x9a, 0x9b, 0x0e, 0xcc,
+	0xa9, 0x3e, 0x17, 0x12, 0x1d, 0x41, 0xb5, 0x30, 0x84, 0xe3, 0x80, 0x31, 0xf2, 0x1a, 0x97, 0x33,
+	0xcf, 0x4e, 0xce, 0x76, 0x72, 0x12, 0xd5, 0xe0, 0x46, 0x42, 0xde, 0x4c, 0x09, 0x0b, 0x09, 0xae,
+	0x64, 0xe9, 0x4b, 0x8c, 0x30, 0x5c, 0xa7, 0x6c, 0xc8, 0xa7, 0x2c, 0xc2, 0x6b, 0x0d, 0xd0, 0xdc,
+	0x70, 0x16, 0x10, 0xdd, 0x83, 0xd5, 0x84, 0xb0, 0x88, 0x08, 0x5c, 0xcd, 0x42, 0x0b, 0x94, 0xa6,
+	0x09, 0x12, 0x12, 0x7a, 0x49, 0x04, 0x5e, 0xcf, 0x94, 0x25, 0x46, 0x6d, 0x08, 0xe9, 0x30, 0xf4,
+	0x83, 0x09, 0x9f, 0x32, 0x89, 0x37, 0x52, 0xf5, 0xe4, 0xf0, 0xea, 0xa6, 0xae, 0xfc, 0xbc, 0xa9,
+	0xef, 0x86, 0x3c, 0x99, 0xf0, 0x24, 0x89, 0x2e, 0x0c, 0xca, 0x5b, 0x93, 0x40, 0x8e, 0x0d, 0x9b,
+	0xc9, 0x2f, 0xbf, 0xbf, 0x1d, 0x03, 0x67, 0x93, 0x0e, 0xc3, 0x76, 0x56, 0x84, 0x4e, 0x61, 0xd1,
+	0xfd, 0x22, 0x65, 0xf3, 0xce, 0x29, 0xdb, 0x79, 0x61, 0x11, 0xf4, 0x0c, 0x56, 0x13, 0x19, 0xc8,
+	0x69, 0x82, 0x61, 0x03, 0x34, 0xd5, 0x47, 0x47, 0xc6, 0xad, 0x1b, 0x33, 0x16, 0x4b, 0x70, 0x33,
+	0xb3, 0x53, 0x14, 0xa5, 0xe3, 0x0b, 0x12, 0x24, 0x9c, 0xe1, 0xad, 0x7c, 0xfc, 0x1c, 0xa5, 0xfc,
+	0x98, 0xd0, 0xd1, 0x58, 0xe2, 0xed, 0x06, 0x68, 0x96, 0x9d, 0x02, 0xa5, 0xbb, 0x98, 0xc6, 0x51,
+	0x20, 0x49, 0xe4, 0x17, 0xfa, 0x4e, 0xa6, 0xef, 0x14, 0xec, 0xf3, 0xdc, 0xf6, 0x04, 0x96, 0xcf,
+	0x09, 0xc1, 0xea, 0x9d, 0x87, 0x4a, 0xed, 0xc7, 0xdf, 0x01, 0x54, 0xff, 0xed, 0x13, 0xd5, 0xe1,
+	0xbe, 0xe7, 0xb4, 0x7b, 0x6e, 0xd7, 0x72, 0x7c, 0xd7, 0x6b, 0x7b, 0x03, 0xd7, 0x1f, 0xf4, 0xdc,
+	0xbe, 0xd5, 0xb1, 0xbb, 0xb6, 0x65, 0x6a, 0x0a, 0xda, 0x87, 0xf7, 0x57, 0x0d, 0x7d, 0xab, 0x67,
+	0xda, 0xbd, 0x53, 0x0d, 0xa0, 0x3d, 0xb8, 0xbb, 0x2a, 0xb6, 0x3b, 0x67, 0x96, 0xa9, 0x95, 0xd0,
+	0x03, 0x88, 0x57, 0x25, 0xc7, 0xea, 0x0e, 0x7a, 0xa6, 0x65, 0x6a, 0x65, 0x74, 0x00, 0xf7, 0x56,
+	0x55, 0xcf, 0x7e, 0x61, 0x99, 0xfe, 0xcb, 0x81, 0xa7, 0x55, 0x6e, 0xfb, 0xa9, 0x7b, 0x66, 0xf7,
+	0xfb, 0x96, 0xa9, 0xad, 0xd5, 0x2a, 0x1f, 0x3f, 0xeb, 0xca, 0xc9, 0xd3, 0xab, 0x99, 0x0e, 0xae,
+	0x67, 0x3a, 0xf8, 0x35, 0xd3, 0xc1, 0xa7, 0xb9, 0xae, 0x5c, 0xcf, 0x75, 0xe5, 0xc7, 0x5c, 0x57,
+	0x5e, 0x1d, 0x2c, 0xef, 0xef, 0xdd, 0xca, 0x05, 0xbe, 0x8f, 0x49, 0x32, 0xac, 0x66, 0x87, 0xf3,
+	0xf8, 0xcf, 0x00, 0xe0, 0x35, 0xd7, 0x28, 0xa7, 0x03, 0x00, 0x00,
+}
+
+func (m *TransferRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTransferRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintTransferRecord(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Height != 0 {
+		i = encodeVarintTransferRecord(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTransferRecord(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Status != 0 {
+		i = encodeVarintTransferRecord(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.NativeAmount.Size()
+		i -= size
+		if _, err := m.NativeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTransferRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.IbcAmount.Size()
+		i -= size
+		if _, err := m.IbcAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTransferRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTransferRecord(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTransferRecord(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Inbound {
+		i--
+		if m.Inbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTransferRecord(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NativeChannel) > 0 {
+		i -= len(m.NativeChannel)
+		copy(dAtA[i:], m.NativeChannel)
+		i = encodeVarintTransferRecord(dAtA, i, uint64(len(m.NativeChannel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NativePort) > 0 {
+		i -= len(m.NativePort)
+		copy(dAtA[i:], m.NativePort)
+		i = encodeVarintTransferRecord(dAtA, i, uint64(len(m.NativePort)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTransferRecord(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransferRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransferRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransferRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTransferRecord(uint64(m.Id))
+	}
+	l = len(m.NativePort)
+	if l > 0 {
+		n += 1 + l + sovTransferRecord(uint64(l))
+	}
+	l = len(m.NativeChannel)
+	if l > 0 {
+		n += 1 + l + sovTransferRecord(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTransferRecord(uint64(m.Sequence))
+	}
+	if m.Inbound {
+		n += 2
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTransferRecord(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTransferRecord(uint64(l))
+	}
+	l = m.IbcAmount.Size()
+	n += 1 + l + sovTransferRecord(uint64(l))
+	l = m.NativeAmount.Size()
+	n += 1 + l + sovTransferRecord(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovTransferRecord(uint64(m.Status))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTransferRecord(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTransferRecord(uint64(m.Height))
+	}
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovTransferRecord(uint64(m.UpdatedHeight))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTransferRecord(uint64(l))
+	return n
+}
+
+func sovTransferRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransferRecord(x uint64) (n int) {
+	return sovTransferRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TransferRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransferRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Inbound = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IbcAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TransferStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			}
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransferRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransferRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransferRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransferRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransferRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTransferRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTransferRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransferRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransferRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTransferRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTransferRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTransferRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTransferRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTransferRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTransferRecord = fmt.Errorf("proto: unexpected end of group")
+)
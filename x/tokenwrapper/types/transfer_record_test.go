@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	"zigchain/testutil/sample"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+var transferRecordSample = TransferRecord{
+	Id:            1,
+	NativePort:    "transfer",
+	NativeChannel: "channel-0",
+	Sequence:      1,
+	Sender:        sample.AccAddress(),
+	Receiver:      "axelar1receiver",
+	IbcAmount:     sdkmath.NewInt(1_000_000_000_000_000_000),
+	NativeAmount:  sdkmath.NewInt(1_000_000),
+	Status:        TRANSFER_STATUS_PENDING,
+	Height:        10,
+}
+
+// Positive test cases
+
+func TestTransferRecord_Validate_Positive(t *testing.T) {
+	// Test case: a sent transfer over a valid route is valid, a skipped received transfer may have no native amount
+
+	require.NoError(t, transferRecordSample.Validate())
+
+	record := transferRecordSample
+	record.Inbound = true
+	record.Status = TRANSFER_STATUS_SKIPPED
+	record.NativeAmount = sdkmath.ZeroInt()
+	require.NoError(t, record.Validate())
+}
+
+// Negative test cases
+
+func TestTransferRecord_Validate_Invalid(t *testing.T) {
+	// Test case: the route must be valid, the addresses set, the amounts positive and the status valid for the direction
+
+	record := transferRecordSample
+	record.NativeChannel = "invalid-channel"
+	require.ErrorContains(t, record.Validate(), "invalid channel ID format")
+
+	record = transferRecordSample
+	record.Receiver = ""
+	require.ErrorIs(t, record.Validate(), ErrInvalidTransferRecord)
+
+	record = transferRecordSample
+	record.IbcAmount = sdkmath.ZeroInt()
+	require.ErrorIs(t, record.Validate(), ErrInvalidTransferRecord)
+
+	record = transferRecordSample
+	record.NativeAmount = sdkmath.NewInt(-1)
+	require.ErrorIs(t, record.Validate(), ErrInvalidTransferRecord)
+
+	record = transferRecordSample
+	record.Status = TRANSFER_STATUS_UNSPECIFIED
+	require.ErrorIs(t, record.Validate(), ErrInvalidTransferRecord)
+
+	record.Status = TRANSFER_STATUS_SKIPPED
+	require.ErrorIs(t, record.Validate(), ErrInvalidTransferRecord)
+
+	record.Inbound = true
+	record.Status = TRANSFER_STATUS_TIMED_OUT
+	require.ErrorIs(t, record.Validate(), ErrInvalidTransferRecord)
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -596,6 +597,9 @@ type MsgUpdateIbcSettings struct {
 	CounterpartyChannel  string `protobuf:"bytes,7,opt,name=counterparty_channel,json=counterpartyChannel,proto3" json:"counterparty_channel,omitempty"`
 	Denom                string `protobuf:"bytes,8,opt,name=denom,proto3" json:"denom,omitempty"`
 	DecimalDifference    uint32 `protobuf:"varint,9,opt,name=decimal_difference,json=decimalDifference,proto3" json:"decimal_difference,omitempty"`
+	// native_denom is the denom the IBC vouchers are wrapped into, uzig when
+	// left empty
+	NativeDenom string `protobuf:"bytes,10,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty"`
 }
 
 func (m *MsgUpdateIbcSettings) Reset()         { *m = MsgUpdateIbcSettings{} }
@@ -694,6 +698,13 @@ func (m *MsgUpdateIbcSettings) GetDecimalDifference() uint32 {
 	return 0
 }
 
+func (m *MsgUpdateIbcSettings) GetNativeDenom() string {
+	if m != nil {
+		return m.NativeDenom
+	}
+	return ""
+}
+
 type MsgUpdateIbcSettingsResponse struct {
 	Signer               string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	NativeClientId       string `protobuf:"bytes,2,opt,name=native_client_id,json=nativeClientId,proto3" json:"native_client_id,omitempty"`
@@ -704,6 +715,7 @@ type MsgUpdateIbcSettingsResponse struct {
 	CounterpartyChannel  string `protobuf:"bytes,7,opt,name=counterparty_channel,json=counterpartyChannel,proto3" json:"counterparty_channel,omitempty"`
 	Denom                string `protobuf:"bytes,8,opt,name=denom,proto3" json:"denom,omitempty"`
 	DecimalDifference    uint32 `protobuf:"varint,9,opt,name=decimal_difference,json=decimalDifference,proto3" json:"decimal_difference,omitempty"`
+	NativeDenom          string `protobuf:"bytes,10,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty"`
 }
 
 func (m *MsgUpdateIbcSettingsResponse) Reset()         { *m = MsgUpdateIbcSettingsResponse{} }
@@ -802,6 +814,13 @@ func (m *MsgUpdateIbcSettingsResponse) GetDecimalDifference() uint32 {
 	return 0
 }
 
+func (m *MsgUpdateIbcSettingsResponse) GetNativeDenom() string {
+	if m != nil {
+		return m.NativeDenom
+	}
+	return ""
+}
+
 // MsgAddPauserAddress represents a message to add a new pauser address
 type MsgAddPauserAddress struct {
 	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
@@ -1335,6 +1354,286 @@ func (m *MsgRecoverZigResponse) GetUnlockedNativeAmount() types.Coin {
 	return types.Coin{}
 }
 
+// MsgSetBridgeRouteEnabled enables or disables the wrapping over a bridge
+// route
+type MsgSetBridgeRouteEnabled struct {
+	Signer        string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	NativePort    string `protobuf:"bytes,2,opt,name=native_port,json=nativePort,proto3" json:"native_port,omitempty"`
+	NativeChannel string `protobuf:"bytes,3,opt,name=native_channel,json=nativeChannel,proto3" json:"native_channel,omitempty"`
+	Enabled       bool   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetBridgeRouteEnabled) Reset()         { *m = MsgSetBridgeRouteEnabled{} }
+func (m *MsgSetBridgeRouteEnabled) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBridgeRouteEnabled) ProtoMessage()    {}
+func (*MsgSetBridgeRouteEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd3b39cda7e1aeff, []int{22}
+}
+func (m *MsgSetBridgeRouteEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBridgeRouteEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBridgeRouteEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBridgeRouteEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBridgeRouteEnabled.Merge(m, src)
+}
+func (m *MsgSetBridgeRouteEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBridgeRouteEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBridgeRouteEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBridgeRouteEnabled proto.InternalMessageInfo
+
+func (m *MsgSetBridgeRouteEnabled) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSetBridgeRouteEnabled) GetNativePort() string {
+	if m != nil {
+		return m.NativePort
+	}
+	return ""
+}
+
+func (m *MsgSetBridgeRouteEnabled) GetNativeChannel() string {
+	if m != nil {
+		return m.NativeChannel
+	}
+	return ""
+}
+
+func (m *MsgSetBridgeRouteEnabled) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type MsgSetBridgeRouteEnabledResponse struct {
+	Signer        string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	NativePort    string `protobuf:"bytes,2,opt,name=native_port,json=nativePort,proto3" json:"native_port,omitempty"`
+	NativeChannel string `protobuf:"bytes,3,opt,name=native_channel,json=nativeChannel,proto3" json:"native_channel,omitempty"`
+	Enabled       bool   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetBridgeRouteEnabledResponse) Reset()         { *m = MsgSetBridgeRouteEnabledResponse{} }
+func (m *MsgSetBridgeRouteEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBridgeRouteEnabledResponse) ProtoMessage()    {}
+func (*MsgSetBridgeRouteEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd3b39cda7e1aeff, []int{23}
+}
+func (m *MsgSetBridgeRouteEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBridgeRouteEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBridgeRouteEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBridgeRouteEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBridgeRouteEnabledResponse.Merge(m, src)
+}
+func (m *MsgSetBridgeRouteEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBridgeRouteEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBridgeRouteEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBridgeRouteEnabledResponse proto.InternalMessageInfo
+
+func (m *MsgSetBridgeRouteEnabledResponse) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSetBridgeRouteEnabledResponse) GetNativePort() string {
+	if m != nil {
+		return m.NativePort
+	}
+	return ""
+}
+
+func (m *MsgSetBridgeRouteEnabledResponse) GetNativeChannel() string {
+	if m != nil {
+		return m.NativeChannel
+	}
+	return ""
+}
+
+func (m *MsgSetBridgeRouteEnabledResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// MsgSetVolumeCap sets the inbound and outbound volume caps of a bridge route,
+// zero caps remove them
+type MsgSetVolumeCap struct {
+	Signer        string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	NativePort    string                `protobuf:"bytes,2,opt,name=native_port,json=nativePort,proto3" json:"native_port,omitempty"`
+	NativeChannel string                `protobuf:"bytes,3,opt,name=native_channel,json=nativeChannel,proto3" json:"native_channel,omitempty"`
+	InboundCap    cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=inbound_cap,json=inboundCap,proto3,customtype=cosmossdk.io/math.Int" json:"inbound_cap"`
+	OutboundCap   cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=outbound_cap,json=outboundCap,proto3,customtype=cosmossdk.io/math.Int" json:"outbound_cap"`
+	Window        uint64                `protobuf:"varint,6,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *MsgSetVolumeCap) Reset()         { *m = MsgSetVolumeCap{} }
+func (m *MsgSetVolumeCap) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVolumeCap) ProtoMessage()    {}
+func (*MsgSetVolumeCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd3b39cda7e1aeff, []int{24}
+}
+func (m *MsgSetVolumeCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVolumeCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVolumeCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetVolumeCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVolumeCap.Merge(m, src)
+}
+func (m *MsgSetVolumeCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVolumeCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVolumeCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetVolumeCap proto.InternalMessageInfo
+
+func (m *MsgSetVolumeCap) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSetVolumeCap) GetNativePort() string {
+	if m != nil {
+		return m.NativePort
+	}
+	return ""
+}
+
+func (m *MsgSetVolumeCap) GetNativeChannel() string {
+	if m != nil {
+		return m.NativeChannel
+	}
+	return ""
+}
+
+func (m *MsgSetVolumeCap) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+type MsgSetVolumeCapResponse struct {
+	Signer        string                `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	NativePort    string                `protobuf:"bytes,2,opt,name=native_port,json=nativePort,proto3" json:"native_port,omitempty"`
+	NativeChannel string                `protobuf:"bytes,3,opt,name=native_channel,json=nativeChannel,proto3" json:"native_channel,omitempty"`
+	InboundCap    cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=inbound_cap,json=inboundCap,proto3,customtype=cosmossdk.io/math.Int" json:"inbound_cap"`
+	OutboundCap   cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=outbound_cap,json=outboundCap,proto3,customtype=cosmossdk.io/math.Int" json:"outbound_cap"`
+	Window        uint64                `protobuf:"varint,6,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *MsgSetVolumeCapResponse) Reset()         { *m = MsgSetVolumeCapResponse{} }
+func (m *MsgSetVolumeCapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVolumeCapResponse) ProtoMessage()    {}
+func (*MsgSetVolumeCapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd3b39cda7e1aeff, []int{25}
+}
+func (m *MsgSetVolumeCapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVolumeCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVolumeCapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetVolumeCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVolumeCapResponse.Merge(m, src)
+}
+func (m *MsgSetVolumeCapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVolumeCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVolumeCapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetVolumeCapResponse proto.InternalMessageInfo
+
+func (m *MsgSetVolumeCapResponse) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSetVolumeCapResponse) GetNativePort() string {
+	if m != nil {
+		return m.NativePort
+	}
+	return ""
+}
+
+func (m *MsgSetVolumeCapResponse) GetNativeChannel() string {
+	if m != nil {
+		return m.NativeChannel
+	}
+	return ""
+}
+
+func (m *MsgSetVolumeCapResponse) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "zigchain.tokenwrapper.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "zigchain.tokenwrapper.MsgUpdateParamsResponse")
@@ -1358,95 +1657,113 @@ func init() {
 	proto.RegisterType((*MsgClaimOperatorAddressResponse)(nil), "zigchain.tokenwrapper.MsgClaimOperatorAddressResponse")
 	proto.RegisterType((*MsgRecoverZig)(nil), "zigchain.tokenwrapper.MsgRecoverZig")
 	proto.RegisterType((*MsgRecoverZigResponse)(nil), "zigchain.tokenwrapper.MsgRecoverZigResponse")
+	proto.RegisterType((*MsgSetBridgeRouteEnabled)(nil), "zigchain.tokenwrapper.MsgSetBridgeRouteEnabled")
+	proto.RegisterType((*MsgSetBridgeRouteEnabledResponse)(nil), "zigchain.tokenwrapper.MsgSetBridgeRouteEnabledResponse")
+	proto.RegisterType((*MsgSetVolumeCap)(nil), "zigchain.tokenwrapper.MsgSetVolumeCap")
+	proto.RegisterType((*MsgSetVolumeCapResponse)(nil), "zigchain.tokenwrapper.MsgSetVolumeCapResponse")
 }
 
 func init() { proto.RegisterFile("zigchain/tokenwrapper/tx.proto", fileDescriptor_fd3b39cda7e1aeff) }
 
 var fileDescriptor_fd3b39cda7e1aeff = []byte{
-	// 1327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0xad, 0x1b, 0xbf, 0x34, 0x4d, 0xbc, 0x71, 0xdd, 0xcd, 0x42, 0xdc, 0xb0, 0x7c,
-	0x45, 0x69, 0x63, 0x93, 0xb4, 0xb4, 0xc8, 0x01, 0x4a, 0x92, 0xb6, 0x52, 0x84, 0x5c, 0x22, 0xb7,
-	0x55, 0xa4, 0x5e, 0xcc, 0x7a, 0x77, 0xb2, 0x59, 0xc5, 0x9e, 0x59, 0xed, 0xae, 0x9d, 0x84, 0x4a,
-	0x08, 0x21, 0x84, 0x20, 0xe2, 0x80, 0x04, 0x12, 0x5c, 0xb8, 0x23, 0x71, 0xc9, 0xa1, 0xd7, 0xde,
-	0x90, 0xe8, 0xa5, 0x52, 0x05, 0x17, 0x4e, 0x08, 0x25, 0x87, 0xfc, 0x1b, 0x68, 0x77, 0x66, 0x37,
-	0xf6, 0x66, 0x77, 0x6d, 0xe3, 0x42, 0x0e, 0x70, 0x49, 0x3c, 0xf3, 0x7e, 0xef, 0xeb, 0xf7, 0x66,
-	0x67, 0xde, 0x0c, 0xe4, 0x3e, 0xd2, 0x35, 0x65, 0x43, 0xd6, 0x71, 0xc1, 0x26, 0x9b, 0x08, 0x6f,
-	0x99, 0xb2, 0x61, 0x20, 0xb3, 0x60, 0x6f, 0xe7, 0x0d, 0x93, 0xd8, 0x84, 0x3f, 0xef, 0xc9, 0xf3,
-	0xad, 0x72, 0x31, 0x2d, 0xd7, 0x75, 0x4c, 0x0a, 0xee, 0x5f, 0x8a, 0x14, 0x2f, 0x28, 0xc4, 0xaa,
-	0x13, 0xab, 0x50, 0xb7, 0xb4, 0x42, 0x73, 0xce, 0xf9, 0xc7, 0x04, 0x13, 0x54, 0x50, 0x71, 0x47,
-	0x05, 0x3a, 0x60, 0xa2, 0x8c, 0x46, 0x34, 0x42, 0xe7, 0x9d, 0x5f, 0x6c, 0x56, 0x0a, 0x8f, 0xc9,
-	0x90, 0x4d, 0xb9, 0xee, 0x69, 0xe6, 0x98, 0xb7, 0xaa, 0x6c, 0xa1, 0x42, 0x73, 0xae, 0x8a, 0x6c,
-	0x79, 0xae, 0xa0, 0x10, 0x1d, 0x53, 0xb9, 0xf4, 0x0b, 0x07, 0xa3, 0x25, 0x4b, 0xbb, 0x6f, 0xa8,
-	0xb2, 0x8d, 0x56, 0x5d, 0x4d, 0xfe, 0x1a, 0xa4, 0xe4, 0x86, 0xbd, 0x41, 0x4c, 0xdd, 0xde, 0x11,
-	0xb8, 0x29, 0x6e, 0x3a, 0xb5, 0x24, 0xfc, 0xfa, 0x68, 0x36, 0xc3, 0x42, 0x5a, 0x54, 0x55, 0x13,
-	0x59, 0xd6, 0x5d, 0xdb, 0xd4, 0xb1, 0x56, 0x3e, 0x82, 0xf2, 0xef, 0x41, 0x92, 0xfa, 0x16, 0x06,
-	0xa7, 0xb8, 0xe9, 0xe1, 0xf9, 0xc9, 0x7c, 0x28, 0x29, 0x79, 0xea, 0x66, 0x29, 0xf5, 0xe4, 0x8f,
-	0x8b, 0x03, 0x3f, 0x1e, 0xee, 0xcd, 0x70, 0x65, 0xa6, 0x57, 0x2c, 0x7e, 0x7a, 0xb8, 0x37, 0x73,
-	0x64, 0x71, 0xf7, 0x70, 0x6f, 0xe6, 0x75, 0x3f, 0xc9, 0xed, 0xf6, 0x34, 0x03, 0x51, 0x4b, 0xdf,
-	0x70, 0x70, 0x21, 0x30, 0x57, 0x46, 0x96, 0x41, 0xb0, 0x85, 0x4e, 0x2e, 0x23, 0xe9, 0x31, 0x07,
-	0xe3, 0x25, 0x4b, 0xbb, 0xdd, 0xc0, 0x6a, 0x89, 0xa8, 0x8d, 0x1a, 0x5a, 0x93, 0x6b, 0x35, 0x64,
-	0xf3, 0x6f, 0x40, 0xd2, 0xd2, 0x35, 0x8c, 0xcc, 0x8e, 0xe1, 0x30, 0x1c, 0x7f, 0x1d, 0x92, 0x72,
-	0x9d, 0x34, 0xb0, 0x2d, 0x0c, 0x4e, 0x25, 0xa6, 0x87, 0xe7, 0x27, 0xf2, 0x0c, 0xee, 0x94, 0x36,
-	0xcf, 0x4a, 0x9b, 0x5f, 0x26, 0x3a, 0x5e, 0x3a, 0xe5, 0xc4, 0x51, 0x66, 0xf0, 0xe2, 0x82, 0x43,
-	0x2a, 0xb3, 0xe2, 0x30, 0x7a, 0x29, 0x86, 0xd1, 0x60, 0x9c, 0xd2, 0xe7, 0x83, 0xf0, 0x42, 0xc8,
-	0xbc, 0xcf, 0xec, 0xbf, 0x97, 0x07, 0xbf, 0x00, 0x43, 0x55, 0xb9, 0x26, 0x63, 0x05, 0x59, 0x42,
-	0xa2, 0x3b, 0x55, 0x5f, 0x81, 0xbf, 0x01, 0xe7, 0xea, 0x6e, 0xfc, 0x15, 0x99, 0x46, 0x25, 0x9c,
-	0xea, 0x10, 0xef, 0x08, 0xc5, 0xb3, 0x49, 0xe9, 0x29, 0xe7, 0x12, 0xb1, 0xa6, 0xdb, 0x1b, 0xaa,
-	0x29, 0x6f, 0xdd, 0x36, 0x49, 0xfd, 0xa4, 0x0a, 0xba, 0x1c, 0x28, 0xe8, 0x95, 0x98, 0x82, 0x46,
-	0xc5, 0x2b, 0xfd, 0x3c, 0x08, 0x2f, 0xc7, 0xc8, 0xff, 0x73, 0x05, 0xe6, 0xaf, 0xc2, 0x90, 0x89,
-	0x14, 0xa4, 0x37, 0x91, 0x29, 0x9c, 0xee, 0xa0, 0xea, 0x23, 0xa5, 0x2f, 0x38, 0x38, 0x5f, 0xb2,
-	0xb4, 0x5b, 0x58, 0xae, 0xd6, 0xd0, 0x3d, 0x87, 0xfc, 0x35, 0x4a, 0x7e, 0xef, 0xc4, 0x15, 0xdf,
-	0x09, 0xd4, 0x75, 0x36, 0xa6, 0xae, 0xc7, 0x1d, 0x4a, 0x9b, 0x30, 0x19, 0x2a, 0xe8, 0xa3, 0x94,
-	0x02, 0x9c, 0x41, 0xae, 0x3d, 0xd5, 0xdd, 0x00, 0x87, 0xca, 0xde, 0x50, 0xda, 0xe5, 0x20, 0x5b,
-	0xb2, 0xb4, 0x9b, 0xba, 0xf5, 0x1c, 0x12, 0x7f, 0x37, 0x90, 0x78, 0x3e, 0x26, 0xf1, 0x10, 0x8f,
-	0x52, 0x0d, 0x72, 0xe1, 0x92, 0x7f, 0x24, 0xf5, 0xdf, 0x12, 0x90, 0xf1, 0x0f, 0x9a, 0x95, 0xaa,
-	0x72, 0x17, 0xd9, 0xb6, 0x8e, 0x35, 0xeb, 0x6f, 0x38, 0x99, 0x86, 0x31, 0x2c, 0xdb, 0x7a, 0x13,
-	0x55, 0x94, 0x9a, 0x8e, 0xb0, 0x5d, 0xd1, 0xa9, 0xb7, 0x54, 0xf9, 0x1c, 0x9d, 0x5f, 0x76, 0xa7,
-	0x57, 0x54, 0xfe, 0x2a, 0x64, 0x15, 0xe7, 0x23, 0x41, 0xa6, 0x21, 0x9b, 0xf6, 0x4e, 0x0b, 0x3e,
-	0xe1, 0xe2, 0x33, 0xad, 0x52, 0x5f, 0xeb, 0x22, 0x0c, 0x33, 0xfb, 0x06, 0x31, 0x6d, 0xfa, 0x45,
-	0x94, 0x81, 0x4e, 0xad, 0x12, 0xd3, 0xe6, 0x2f, 0x41, 0xba, 0xcd, 0xac, 0x0b, 0x73, 0x57, 0x7f,
-	0x79, 0xac, 0x55, 0xe0, 0x82, 0x5f, 0x85, 0x73, 0x5e, 0xb4, 0x1b, 0x32, 0xc6, 0xa8, 0x26, 0x24,
-	0x5d, 0xe4, 0x08, 0x8b, 0x95, 0x4e, 0xf2, 0x73, 0x90, 0x69, 0x0f, 0x95, 0x81, 0xcf, 0xb8, 0xe0,
-	0xf1, 0xb6, 0x40, 0x99, 0x4a, 0x06, 0x4e, 0xab, 0x08, 0x93, 0xba, 0x30, 0xe4, 0x62, 0xe8, 0x80,
-	0x9f, 0x05, 0x5e, 0x45, 0x8a, 0x5e, 0x97, 0x6b, 0x15, 0x55, 0x5f, 0x5f, 0x47, 0x26, 0xc2, 0x0a,
-	0x12, 0x52, 0x53, 0xdc, 0xf4, 0x48, 0x39, 0xcd, 0x24, 0x37, 0x7d, 0x41, 0xf1, 0xed, 0xc0, 0x2a,
-	0xba, 0xdc, 0xb1, 0x73, 0x68, 0x29, 0x9e, 0xf4, 0x43, 0x02, 0x5e, 0x0c, 0x13, 0xf4, 0xb1, 0x84,
-	0xfe, 0xaf, 0xee, 0xf3, 0xab, 0xae, 0xf4, 0x25, 0x6d, 0xa4, 0x16, 0x55, 0x75, 0x55, 0x6e, 0x58,
-	0xc8, 0xf4, 0xb6, 0xed, 0x6c, 0x7b, 0x59, 0x7c, 0xf2, 0x27, 0x01, 0x30, 0xda, 0xaa, 0x18, 0x2e,
-	0x98, 0xd1, 0x9e, 0xc2, 0x68, 0x8b, 0x6a, 0xf7, 0xd4, 0x14, 0x05, 0x7d, 0x4a, 0xdf, 0xd2, 0x5e,
-	0x20, 0x38, 0xdf, 0xc7, 0x52, 0x59, 0x86, 0x31, 0x1a, 0xa9, 0x77, 0x7a, 0x21, 0x4b, 0x48, 0x4e,
-	0x25, 0x62, 0x75, 0x47, 0x8d, 0x56, 0xe7, 0xc8, 0x72, 0xce, 0x22, 0x67, 0x4f, 0x2e, 0xa3, 0x3a,
-	0x69, 0xa2, 0xee, 0x58, 0xca, 0x3a, 0x0d, 0x6e, 0x0b, 0x43, 0x6c, 0xd4, 0xd3, 0x8e, 0x1c, 0xe2,
-	0x4f, 0xfa, 0x8e, 0x73, 0xb7, 0xe4, 0x10, 0xd1, 0x49, 0x93, 0xf4, 0x94, 0x83, 0x89, 0x92, 0xa5,
-	0xad, 0x9a, 0xc4, 0x20, 0x16, 0xfa, 0xc0, 0x40, 0xa6, 0x6c, 0x13, 0x9f, 0xa7, 0xde, 0x83, 0x5a,
-	0x80, 0xb3, 0xce, 0x3a, 0x23, 0xcc, 0x10, 0xe5, 0x31, 0x46, 0x6f, 0x18, 0xa3, 0x2d, 0xcf, 0x6b,
-	0x71, 0x31, 0x40, 0xf3, 0x5c, 0x0c, 0xcd, 0xe1, 0x11, 0x4b, 0x3f, 0x71, 0xf0, 0x52, 0xa4, 0xb4,
-	0x0f, 0xb2, 0xef, 0xc1, 0x84, 0x41, 0x6d, 0xaa, 0x7e, 0x72, 0x7e, 0x6b, 0xd5, 0x29, 0xc9, 0x0b,
-	0x9e, 0x6a, 0x30, 0xda, 0xaf, 0xe8, 0x25, 0x6d, 0xb9, 0x26, 0xeb, 0xf5, 0xbe, 0xb9, 0x2f, 0xde,
-	0x08, 0xd0, 0x57, 0x88, 0xa1, 0x2f, 0xcc, 0xa5, 0xf4, 0x3d, 0x07, 0x17, 0x23, 0x64, 0xfd, 0xad,
-	0xd3, 0x9e, 0x19, 0x1b, 0x25, 0x81, 0xd0, 0xee, 0xc0, 0x88, 0xfb, 0x01, 0x29, 0xa4, 0x89, 0xcc,
-	0x07, 0xba, 0x16, 0xf9, 0x09, 0x0b, 0x70, 0xa6, 0xcd, 0x49, 0xd9, 0x1b, 0x16, 0x87, 0x5b, 0xe8,
-	0x91, 0x1e, 0x0d, 0xba, 0x8d, 0xea, 0x91, 0xc1, 0x3e, 0x12, 0xbc, 0x05, 0x69, 0xda, 0x00, 0xeb,
-	0x58, 0xeb, 0x3a, 0xc3, 0x31, 0x5f, 0xc5, 0x2b, 0xf8, 0xfb, 0x90, 0xae, 0x11, 0x65, 0x13, 0xa9,
-	0x15, 0xbd, 0xaa, 0x54, 0xd8, 0x9d, 0x21, 0xe1, 0x5e, 0xb4, 0x3b, 0x36, 0xfe, 0xa3, 0x54, 0x73,
-	0xa5, 0xaa, 0x2c, 0xd2, 0xcb, 0xc3, 0x7d, 0xc8, 0x36, 0x30, 0x33, 0xc7, 0xce, 0x31, 0x66, 0xf1,
-	0x54, 0x77, 0x16, 0x33, 0x9e, 0xfa, 0x1d, 0x57, 0x9b, 0x9a, 0x9d, 0x7f, 0x0c, 0x90, 0x28, 0x59,
-	0x1a, 0xbf, 0x0e, 0x67, 0xdb, 0xde, 0x48, 0x5e, 0x8b, 0x78, 0x09, 0x08, 0xbc, 0x40, 0x88, 0xf9,
-	0xee, 0x70, 0x7e, 0x31, 0x4c, 0x18, 0x3b, 0xf6, 0x56, 0x30, 0x13, 0x6d, 0x23, 0x88, 0x15, 0xe7,
-	0xbb, 0xc7, 0xfa, 0x3e, 0x77, 0x39, 0x10, 0x22, 0xef, 0xb5, 0x31, 0x06, 0xa3, 0x74, 0xc4, 0x62,
-	0xef, 0x3a, 0x7e, 0x30, 0xdb, 0xc0, 0x87, 0x5c, 0xa6, 0x2e, 0x47, 0x5b, 0x3c, 0x8e, 0x16, 0xaf,
-	0xf6, 0x82, 0xf6, 0x3d, 0x3f, 0x84, 0xf1, 0xb0, 0xeb, 0xcc, 0x6c, 0xb4, 0xb1, 0x10, 0xb8, 0xf8,
-	0x66, 0x4f, 0x70, 0xdf, 0x79, 0x03, 0xd2, 0xc7, 0x2f, 0x14, 0x97, 0x3a, 0x2d, 0x9e, 0x16, 0xb0,
-	0x78, 0xa5, 0x07, 0x70, 0xeb, 0x72, 0x3b, 0xd6, 0x51, 0xc5, 0x2c, 0xb7, 0x20, 0x36, 0x6e, 0xb9,
-	0x45, 0x76, 0x47, 0x0f, 0x61, 0x3c, 0xac, 0x45, 0x89, 0xe1, 0x39, 0x04, 0x1e, 0xc7, 0x73, 0x5c,
-	0xd7, 0xf1, 0x19, 0x07, 0xd9, 0xa8, 0xb3, 0x3f, 0xda, 0x62, 0xb8, 0x86, 0xf8, 0x56, 0xaf, 0x1a,
-	0x7e, 0x18, 0x1f, 0x43, 0x26, 0xf4, 0x0c, 0x8c, 0xd9, 0x2e, 0xc2, 0xf0, 0xe2, 0xb5, 0xde, 0xf0,
-	0xbe, 0xff, 0x0f, 0x01, 0x5a, 0x8e, 0x96, 0x57, 0xe2, 0xb8, 0xf4, 0x50, 0xe2, 0xe5, 0x6e, 0x50,
-	0x9e, 0x07, 0xf1, 0xf4, 0x27, 0x87, 0x7b, 0x33, 0xdc, 0xd2, 0xf5, 0x27, 0xfb, 0x39, 0xee, 0xd9,
-	0x7e, 0x8e, 0xfb, 0x73, 0x3f, 0xc7, 0x7d, 0x7d, 0x90, 0x1b, 0x78, 0x76, 0x90, 0x1b, 0xf8, 0xfd,
-	0x20, 0x37, 0xf0, 0x60, 0x32, 0xea, 0xb0, 0xb6, 0x77, 0x0c, 0x64, 0x55, 0x93, 0xee, 0xfb, 0xf4,
-	0x95, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xfe, 0xcf, 0x93, 0x82, 0x79, 0x17, 0x00, 0x00,
+	// 1550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x77, 0x93, 0x34, 0x79, 0x49, 0x9a, 0xc4, 0xdd, 0x24, 0x8e, 0x21, 0x9b, 0xd4, 0x40,
+	0x1b, 0xa5, 0xcd, 0x6e, 0x93, 0x7e, 0x04, 0x6d, 0x81, 0x36, 0x49, 0x5b, 0x29, 0x42, 0x5b, 0xa2,
+	0x4d, 0x4b, 0xa5, 0x5e, 0x16, 0xaf, 0x3d, 0x71, 0xac, 0xec, 0xce, 0x58, 0xb6, 0x37, 0x69, 0x41,
+	0x42, 0x80, 0x10, 0x2a, 0x15, 0x07, 0x24, 0x90, 0xe0, 0x4f, 0x00, 0x71, 0xe9, 0xa1, 0x82, 0x13,
+	0x12, 0x07, 0x24, 0x7a, 0xa9, 0x54, 0x71, 0x42, 0x1c, 0x2a, 0xd4, 0x1e, 0xca, 0x91, 0x13, 0x67,
+	0x64, 0xcf, 0xd8, 0xdd, 0xf5, 0xda, 0xde, 0xdd, 0x2e, 0x6d, 0x40, 0x70, 0x49, 0xe2, 0x79, 0xbf,
+	0xf7, 0xf5, 0x7b, 0xcf, 0x9e, 0x37, 0x13, 0x48, 0xbf, 0xad, 0x6b, 0xca, 0x96, 0xac, 0xe3, 0xac,
+	0x4d, 0xb6, 0x11, 0xde, 0x35, 0x65, 0xc3, 0x40, 0x66, 0xd6, 0xbe, 0x96, 0x31, 0x4c, 0x62, 0x13,
+	0x7e, 0xcc, 0x93, 0x67, 0x6a, 0xe5, 0xe2, 0xa8, 0x5c, 0xd1, 0x31, 0xc9, 0xba, 0x3f, 0x29, 0x52,
+	0x9c, 0x50, 0x88, 0x55, 0x21, 0x56, 0xb6, 0x62, 0x69, 0xd9, 0x9d, 0x05, 0xe7, 0x17, 0x13, 0x4c,
+	0x52, 0x41, 0xd1, 0x7d, 0xca, 0xd2, 0x07, 0x26, 0x4a, 0x69, 0x44, 0x23, 0x74, 0xdd, 0xf9, 0x8b,
+	0xad, 0x4a, 0xe1, 0x31, 0x19, 0xb2, 0x29, 0x57, 0x3c, 0xcd, 0x34, 0xf3, 0x56, 0x92, 0x2d, 0x94,
+	0xdd, 0x59, 0x28, 0x21, 0x5b, 0x5e, 0xc8, 0x2a, 0x44, 0xc7, 0x54, 0x2e, 0xfd, 0xc4, 0xc1, 0x70,
+	0xde, 0xd2, 0x2e, 0x1b, 0xaa, 0x6c, 0xa3, 0x75, 0x57, 0x93, 0x3f, 0x05, 0xfd, 0x72, 0xd5, 0xde,
+	0x22, 0xa6, 0x6e, 0x5f, 0x17, 0xb8, 0x19, 0x6e, 0xb6, 0x7f, 0x45, 0xf8, 0xf9, 0xf6, 0x7c, 0x8a,
+	0x85, 0xb4, 0xac, 0xaa, 0x26, 0xb2, 0xac, 0x0d, 0xdb, 0xd4, 0xb1, 0x56, 0x78, 0x0c, 0xe5, 0xcf,
+	0x42, 0x2f, 0xf5, 0x2d, 0x24, 0x66, 0xb8, 0xd9, 0x81, 0xc5, 0xa9, 0x4c, 0x28, 0x29, 0x19, 0xea,
+	0x66, 0xa5, 0xff, 0xce, 0xfd, 0xe9, 0xae, 0xaf, 0x1e, 0xdd, 0x9a, 0xe3, 0x0a, 0x4c, 0x2f, 0x97,
+	0xfb, 0xe0, 0xd1, 0xad, 0xb9, 0xc7, 0x16, 0x6f, 0x3e, 0xba, 0x35, 0x77, 0xd8, 0x4f, 0xf2, 0x5a,
+	0x7d, 0x9a, 0x81, 0xa8, 0xa5, 0xcf, 0x38, 0x98, 0x08, 0xac, 0x15, 0x90, 0x65, 0x10, 0x6c, 0xa1,
+	0xbd, 0xcb, 0x48, 0xfa, 0x9e, 0x83, 0x03, 0x79, 0x4b, 0xbb, 0x50, 0xc5, 0x6a, 0x9e, 0xa8, 0xd5,
+	0x32, 0xba, 0x22, 0x97, 0xcb, 0xc8, 0xe6, 0x8f, 0x41, 0xaf, 0xa5, 0x6b, 0x18, 0x99, 0x4d, 0xc3,
+	0x61, 0x38, 0x7e, 0x09, 0x7a, 0xe5, 0x0a, 0xa9, 0x62, 0x5b, 0x48, 0xcc, 0x24, 0x67, 0x07, 0x16,
+	0x27, 0x33, 0x0c, 0xee, 0x94, 0x36, 0xc3, 0x4a, 0x9b, 0x59, 0x25, 0x3a, 0x5e, 0xe9, 0x76, 0xe2,
+	0x28, 0x30, 0x78, 0xee, 0xb4, 0x43, 0x2a, 0xb3, 0xe2, 0x30, 0x7a, 0x24, 0x86, 0xd1, 0x60, 0x9c,
+	0xd2, 0x47, 0x09, 0x78, 0x2e, 0x64, 0xdd, 0x67, 0xf6, 0xd9, 0xe5, 0xc1, 0x9f, 0x86, 0xbe, 0x92,
+	0x5c, 0x96, 0xb1, 0x82, 0x2c, 0x21, 0xd9, 0x9a, 0xaa, 0xaf, 0xc0, 0x9f, 0x81, 0xfd, 0x15, 0x37,
+	0xfe, 0xa2, 0x4c, 0xa3, 0x12, 0xba, 0x9b, 0xc4, 0x3b, 0x44, 0xf1, 0x6c, 0x51, 0xba, 0xcb, 0xb9,
+	0x44, 0x5c, 0xd1, 0xed, 0x2d, 0xd5, 0x94, 0x77, 0x2f, 0x98, 0xa4, 0xb2, 0x57, 0x05, 0x5d, 0x0d,
+	0x14, 0xf4, 0x78, 0x4c, 0x41, 0xa3, 0xe2, 0x95, 0x7e, 0x4c, 0xc0, 0x0b, 0x31, 0xf2, 0xff, 0x5c,
+	0x81, 0xf9, 0x13, 0xd0, 0x67, 0x22, 0x05, 0xe9, 0x3b, 0xc8, 0x14, 0x7a, 0x9a, 0xa8, 0xfa, 0x48,
+	0xe9, 0x06, 0x07, 0x63, 0x79, 0x4b, 0x3b, 0x8f, 0xe5, 0x52, 0x19, 0x5d, 0x72, 0xc8, 0xbf, 0x42,
+	0xc9, 0x6f, 0x9f, 0xb8, 0xdc, 0xab, 0x81, 0xba, 0xce, 0xc7, 0xd4, 0xb5, 0xd1, 0xa1, 0xb4, 0x0d,
+	0x53, 0xa1, 0x82, 0x0e, 0x4a, 0x29, 0xc0, 0x3e, 0xe4, 0xda, 0x53, 0xdd, 0x0f, 0x60, 0x5f, 0xc1,
+	0x7b, 0x94, 0x6e, 0x72, 0x30, 0x9e, 0xb7, 0xb4, 0x73, 0xba, 0xf5, 0x37, 0x24, 0xfe, 0x5a, 0x20,
+	0xf1, 0x4c, 0x4c, 0xe2, 0x21, 0x1e, 0xa5, 0x32, 0xa4, 0xc3, 0x25, 0x4f, 0x25, 0xf5, 0x3f, 0x93,
+	0x90, 0xf2, 0x37, 0x9a, 0xb5, 0x92, 0xb2, 0x81, 0x6c, 0x5b, 0xc7, 0x9a, 0xf5, 0x04, 0x4e, 0x66,
+	0x61, 0x04, 0xcb, 0xb6, 0xbe, 0x83, 0x8a, 0x4a, 0x59, 0x47, 0xd8, 0x2e, 0xea, 0xd4, 0x5b, 0x7f,
+	0x61, 0x3f, 0x5d, 0x5f, 0x75, 0x97, 0xd7, 0x54, 0xfe, 0x04, 0x8c, 0x2b, 0xce, 0x4b, 0x82, 0x4c,
+	0x43, 0x36, 0xed, 0xeb, 0x35, 0xf8, 0xa4, 0x8b, 0x4f, 0xd5, 0x4a, 0x7d, 0xad, 0x69, 0x18, 0x60,
+	0xf6, 0x0d, 0x62, 0xda, 0xf4, 0x8d, 0x28, 0x00, 0x5d, 0x5a, 0x27, 0xa6, 0xcd, 0x1f, 0x81, 0xd1,
+	0x3a, 0xb3, 0x2e, 0xcc, 0xed, 0xfe, 0xc2, 0x48, 0xad, 0xc0, 0x05, 0xbf, 0x04, 0xfb, 0xbd, 0x68,
+	0xb7, 0x64, 0x8c, 0x51, 0x59, 0xe8, 0x75, 0x91, 0x43, 0x2c, 0x56, 0xba, 0xc8, 0x2f, 0x40, 0xaa,
+	0x3e, 0x54, 0x06, 0xde, 0xe7, 0x82, 0x0f, 0xd4, 0x05, 0xca, 0x54, 0x52, 0xd0, 0xa3, 0x22, 0x4c,
+	0x2a, 0x42, 0x9f, 0x8b, 0xa1, 0x0f, 0xfc, 0x3c, 0xf0, 0x2a, 0x52, 0xf4, 0x8a, 0x5c, 0x2e, 0xaa,
+	0xfa, 0xe6, 0x26, 0x32, 0x11, 0x56, 0x90, 0xd0, 0x3f, 0xc3, 0xcd, 0x0e, 0x15, 0x46, 0x99, 0xe4,
+	0x9c, 0x2f, 0xe0, 0x0f, 0xc2, 0x20, 0x0b, 0x8f, 0xda, 0x02, 0xd7, 0x16, 0x23, 0xe0, 0x9c, 0xb3,
+	0x94, 0x7b, 0x25, 0xd0, 0x68, 0x47, 0x9b, 0x0e, 0x17, 0x35, 0xf5, 0x95, 0x7e, 0x48, 0xc2, 0xf3,
+	0x61, 0x82, 0x0e, 0xba, 0xec, 0xff, 0x06, 0x78, 0xa6, 0x0d, 0x20, 0x7d, 0x4c, 0xc7, 0xb1, 0x65,
+	0x55, 0x5d, 0x97, 0xab, 0x16, 0x32, 0xbd, 0x8f, 0xff, 0x78, 0x7d, 0xe5, 0xfc, 0xfa, 0x4c, 0x01,
+	0x60, 0xb4, 0x5b, 0x34, 0x5c, 0x30, 0xab, 0x4c, 0x3f, 0x46, 0xbb, 0x54, 0xbb, 0xad, 0xd1, 0x2a,
+	0xe8, 0x53, 0xfa, 0x9c, 0x4e, 0x14, 0xc1, 0xf5, 0x0e, 0xba, 0x69, 0x15, 0x46, 0x68, 0xa4, 0xde,
+	0x1e, 0x88, 0x2c, 0xa1, 0x77, 0x26, 0x19, 0xab, 0x3b, 0x6c, 0xd4, 0x3a, 0x47, 0x96, 0x74, 0x83,
+	0x7e, 0xd9, 0x0b, 0xa8, 0x42, 0x76, 0x50, 0x6b, 0x2c, 0x8d, 0x3b, 0x63, 0x72, 0x0d, 0x43, 0xec,
+	0xa9, 0xad, 0xef, 0x7a, 0x88, 0x3f, 0xe9, 0x0b, 0x0e, 0xd2, 0xe1, 0xa2, 0xbd, 0x26, 0xe9, 0x2e,
+	0x07, 0x93, 0x79, 0x4b, 0x5b, 0x37, 0x89, 0x41, 0x2c, 0xf4, 0x86, 0x81, 0x4c, 0xd9, 0x26, 0x3e,
+	0x4f, 0xed, 0x07, 0x75, 0x1a, 0x06, 0x9d, 0x3e, 0x23, 0xcc, 0x90, 0x90, 0x68, 0xa2, 0x37, 0x80,
+	0xd1, 0xae, 0xe7, 0x35, 0xb7, 0x1c, 0xa0, 0x79, 0x21, 0x86, 0xe6, 0xf0, 0x88, 0xa5, 0x6f, 0x38,
+	0x38, 0x18, 0x29, 0xed, 0x80, 0xec, 0x4b, 0x30, 0x69, 0x50, 0x9b, 0xaa, 0x9f, 0x9c, 0x3f, 0xa0,
+	0x35, 0x4b, 0x72, 0xc2, 0x53, 0x0d, 0x46, 0xfb, 0x09, 0x3d, 0xea, 0xad, 0x96, 0x65, 0xbd, 0xd2,
+	0x31, 0xf7, 0xb9, 0x33, 0x01, 0xfa, 0xb2, 0x31, 0xf4, 0x85, 0xb9, 0x94, 0xbe, 0xe4, 0x60, 0x3a,
+	0x42, 0xd6, 0x59, 0x9f, 0xb6, 0xcd, 0xd8, 0x30, 0x09, 0x84, 0x76, 0x11, 0x86, 0xdc, 0x17, 0x48,
+	0x21, 0x3b, 0xc8, 0xbc, 0xaa, 0x6b, 0x91, 0xaf, 0xb0, 0x00, 0xfb, 0xea, 0x9c, 0x14, 0xbc, 0xc7,
+	0xdc, 0x40, 0x0d, 0x3d, 0xd2, 0xed, 0x04, 0x8c, 0xd5, 0x19, 0xec, 0x20, 0xc1, 0xf3, 0x30, 0x4a,
+	0xc7, 0x68, 0x1d, 0x6b, 0x2d, 0x67, 0x38, 0xe2, 0xab, 0x78, 0x05, 0x7f, 0x1d, 0x46, 0xcb, 0x44,
+	0xd9, 0x46, 0x6a, 0x51, 0x2f, 0x29, 0x45, 0x76, 0xf2, 0x48, 0xce, 0x70, 0xad, 0x1c, 0x1f, 0x86,
+	0xa9, 0xe6, 0x5a, 0x49, 0x59, 0x76, 0xf5, 0xf8, 0xcb, 0x30, 0x5e, 0xc5, 0xcc, 0x1c, 0xdb, 0x4b,
+	0x98, 0xc5, 0xee, 0xd6, 0x2c, 0xa6, 0x3c, 0xf5, 0x8b, 0xae, 0x36, 0x35, 0x2b, 0xfd, 0xc1, 0x81,
+	0x90, 0xb7, 0xb4, 0x0d, 0x64, 0xaf, 0x98, 0xba, 0xaa, 0xa1, 0x02, 0xa9, 0xda, 0x88, 0x4e, 0xea,
+	0xea, 0x13, 0x30, 0x17, 0xd8, 0xd5, 0x13, 0x0d, 0xbb, 0x7a, 0xe3, 0x46, 0x9d, 0x0c, 0xdb, 0xa8,
+	0x6b, 0x66, 0xdc, 0xee, 0xba, 0x19, 0x37, 0x77, 0x36, 0xf0, 0x4e, 0x1c, 0x8b, 0x79, 0x27, 0x42,
+	0xb3, 0x92, 0xbe, 0xe5, 0x60, 0x26, 0x4a, 0xd8, 0x41, 0xd3, 0x3c, 0xf5, 0xd4, 0xa5, 0xdf, 0x13,
+	0xee, 0x8d, 0xd8, 0x06, 0xb2, 0xdf, 0x24, 0xe5, 0x6a, 0x05, 0xad, 0xca, 0xc6, 0x1e, 0xc6, 0xb9,
+	0x0a, 0x03, 0x3a, 0x2e, 0x91, 0x2a, 0x56, 0x8b, 0x8a, 0x6c, 0xb0, 0x33, 0xad, 0xe4, 0xb4, 0xda,
+	0xaf, 0xf7, 0xa7, 0xc7, 0x68, 0x08, 0x96, 0xba, 0x9d, 0xd1, 0x49, 0xb6, 0x22, 0xdb, 0x5b, 0x99,
+	0x35, 0x6c, 0xd3, 0x0b, 0x28, 0x60, 0x6a, 0x4e, 0xf8, 0xe7, 0x61, 0x90, 0x54, 0xed, 0xc7, 0x56,
+	0x7a, 0x5a, 0xb6, 0x32, 0xe0, 0xe9, 0x39, 0x66, 0xc6, 0xa1, 0x77, 0x57, 0xc7, 0x2a, 0xd9, 0x75,
+	0xc7, 0xbe, 0xee, 0x02, 0x7b, 0xca, 0x2d, 0x05, 0x9a, 0xe5, 0x70, 0x7c, 0xb3, 0xf8, 0xb4, 0x4a,
+	0xdf, 0x25, 0x60, 0x22, 0xb0, 0xf6, 0x0f, 0x68, 0x8d, 0x7f, 0x01, 0xe5, 0x8b, 0x5f, 0x0f, 0x42,
+	0x32, 0x6f, 0x69, 0xfc, 0x26, 0x0c, 0xd6, 0x5d, 0xdd, 0x1e, 0x8a, 0xb8, 0xa0, 0x0c, 0x5c, 0x8c,
+	0x8a, 0x99, 0xd6, 0x70, 0x7e, 0x35, 0x4c, 0x18, 0x69, 0xb8, 0xc2, 0x9c, 0x8b, 0xb6, 0x11, 0xc4,
+	0x8a, 0x8b, 0xad, 0x63, 0x7d, 0x9f, 0x37, 0x39, 0x10, 0x22, 0xaf, 0xdb, 0x62, 0x0c, 0x46, 0xe9,
+	0x88, 0xb9, 0xf6, 0x75, 0xfc, 0x60, 0xae, 0x01, 0x1f, 0x72, 0xc7, 0x73, 0x34, 0xda, 0x62, 0x23,
+	0x5a, 0x3c, 0xd1, 0x0e, 0xda, 0xf7, 0xfc, 0x0e, 0x1c, 0x08, 0xbb, 0x65, 0x99, 0x8f, 0x36, 0x16,
+	0x02, 0x17, 0x4f, 0xb6, 0x05, 0xf7, 0x9d, 0x57, 0x61, 0xb4, 0xf1, 0x9e, 0xe3, 0x48, 0xb3, 0xe6,
+	0xa9, 0x01, 0x8b, 0xc7, 0xdb, 0x00, 0xd7, 0xb6, 0x5b, 0xc3, 0x11, 0x2d, 0xa6, 0xdd, 0x82, 0x58,
+	0x71, 0xb1, 0x75, 0x6c, 0x2d, 0xcf, 0x61, 0x67, 0x9e, 0x18, 0x9e, 0x43, 0xe0, 0xe2, 0xc9, 0xb6,
+	0xe0, 0xbe, 0xf3, 0x0f, 0x39, 0x18, 0x8f, 0x3a, 0x4c, 0x44, 0x5b, 0x0c, 0xd7, 0x10, 0x5f, 0x6e,
+	0x57, 0xc3, 0x0f, 0xe3, 0x5d, 0x48, 0x85, 0x0e, 0xd5, 0x31, 0x9f, 0x8b, 0x30, 0xbc, 0x78, 0xaa,
+	0x3d, 0xbc, 0xef, 0xff, 0x2d, 0x80, 0x9a, 0x59, 0xf5, 0xc5, 0x38, 0x2e, 0x3d, 0x94, 0x78, 0xb4,
+	0x15, 0x94, 0xef, 0xe1, 0x7d, 0x0e, 0xc6, 0xc2, 0xc7, 0xb0, 0x6c, 0xb4, 0x9d, 0x50, 0x05, 0x71,
+	0xa9, 0x4d, 0x05, 0x3f, 0x86, 0x4d, 0x18, 0xac, 0x9b, 0x2e, 0x0e, 0xc5, 0x1a, 0xf2, 0x71, 0x62,
+	0xa6, 0x35, 0x9c, 0xe7, 0x47, 0xec, 0x79, 0xcf, 0xd9, 0x50, 0x56, 0x96, 0xee, 0x3c, 0x48, 0x73,
+	0xf7, 0x1e, 0xa4, 0xb9, 0xdf, 0x1e, 0xa4, 0xb9, 0x4f, 0x1f, 0xa6, 0xbb, 0xee, 0x3d, 0x4c, 0x77,
+	0xfd, 0xf2, 0x30, 0xdd, 0x75, 0x75, 0x2a, 0x6a, 0xa3, 0xb6, 0xaf, 0x1b, 0xc8, 0x2a, 0xf5, 0xba,
+	0xff, 0x22, 0x3c, 0xfe, 0xd7, 0x00, 0x48, 0xab, 0x01, 0xf1, 0xfc, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1474,6 +1791,8 @@ type MsgClient interface {
 	ProposeOperatorAddress(ctx context.Context, in *MsgProposeOperatorAddress, opts ...grpc.CallOption) (*MsgProposeOperatorAddressResponse, error)
 	ClaimOperatorAddress(ctx context.Context, in *MsgClaimOperatorAddress, opts ...grpc.CallOption) (*MsgClaimOperatorAddressResponse, error)
 	RecoverZig(ctx context.Context, in *MsgRecoverZig, opts ...grpc.CallOption) (*MsgRecoverZigResponse, error)
+	SetBridgeRouteEnabled(ctx context.Context, in *MsgSetBridgeRouteEnabled, opts ...grpc.CallOption) (*MsgSetBridgeRouteEnabledResponse, error)
+	SetVolumeCap(ctx context.Context, in *MsgSetVolumeCap, opts ...grpc.CallOption) (*MsgSetVolumeCapResponse, error)
 }
 
 type msgClient struct {
@@ -1583,6 +1902,24 @@ func (c *msgClient) RecoverZig(ctx context.Context, in *MsgRecoverZig, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) SetBridgeRouteEnabled(ctx context.Context, in *MsgSetBridgeRouteEnabled, opts ...grpc.CallOption) (*MsgSetBridgeRouteEnabledResponse, error) {
+	out := new(MsgSetBridgeRouteEnabledResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.tokenwrapper.Msg/SetBridgeRouteEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetVolumeCap(ctx context.Context, in *MsgSetVolumeCap, opts ...grpc.CallOption) (*MsgSetVolumeCapResponse, error) {
+	out := new(MsgSetVolumeCapResponse)
+	err := c.cc.Invoke(ctx, "/zigchain.tokenwrapper.Msg/SetVolumeCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -1598,6 +1935,8 @@ type MsgServer interface {
 	ProposeOperatorAddress(context.Context, *MsgProposeOperatorAddress) (*MsgProposeOperatorAddressResponse, error)
 	ClaimOperatorAddress(context.Context, *MsgClaimOperatorAddress) (*MsgClaimOperatorAddressResponse, error)
 	RecoverZig(context.Context, *MsgRecoverZig) (*MsgRecoverZigResponse, error)
+	SetBridgeRouteEnabled(context.Context, *MsgSetBridgeRouteEnabled) (*MsgSetBridgeRouteEnabledResponse, error)
+	SetVolumeCap(context.Context, *MsgSetVolumeCap) (*MsgSetVolumeCapResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1637,6 +1976,12 @@ func (*UnimplementedMsgServer) ClaimOperatorAddress(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) RecoverZig(ctx context.Context, req *MsgRecoverZig) (*MsgRecoverZigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecoverZig not implemented")
 }
+func (*UnimplementedMsgServer) SetBridgeRouteEnabled(ctx context.Context, req *MsgSetBridgeRouteEnabled) (*MsgSetBridgeRouteEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBridgeRouteEnabled not implemented")
+}
+func (*UnimplementedMsgServer) SetVolumeCap(ctx context.Context, req *MsgSetVolumeCap) (*MsgSetVolumeCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVolumeCap not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1840,6 +2185,42 @@ func _Msg_RecoverZig_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetBridgeRouteEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBridgeRouteEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBridgeRouteEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.tokenwrapper.Msg/SetBridgeRouteEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBridgeRouteEnabled(ctx, req.(*MsgSetBridgeRouteEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetVolumeCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetVolumeCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetVolumeCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zigchain.tokenwrapper.Msg/SetVolumeCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetVolumeCap(ctx, req.(*MsgSetVolumeCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zigchain.tokenwrapper.Msg",
@@ -1889,6 +2270,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RecoverZig",
 			Handler:    _Msg_RecoverZig_Handler,
 		},
+		{
+			MethodName: "SetBridgeRouteEnabled",
+			Handler:    _Msg_SetBridgeRouteEnabled_Handler,
+		},
+		{
+			MethodName: "SetVolumeCap",
+			Handler:    _Msg_SetVolumeCap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zigchain/tokenwrapper/tx.proto",
@@ -2359,6 +2748,13 @@ func (m *MsgUpdateIbcSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.DecimalDifference != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.DecimalDifference))
 		i--
@@ -2443,6 +2839,13 @@ func (m *MsgUpdateIbcSettingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.DecimalDifference != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.DecimalDifference))
 		i--
@@ -2894,22 +3297,268 @@ func (m *MsgRecoverZigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetBridgeRouteEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+
+func (m *MsgSetBridgeRouteEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBridgeRouteEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NativeChannel) > 0 {
+		i -= len(m.NativeChannel)
+		copy(dAtA[i:], m.NativeChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NativeChannel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NativePort) > 0 {
+		i -= len(m.NativePort)
+		copy(dAtA[i:], m.NativePort)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NativePort)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBridgeRouteEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBridgeRouteEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBridgeRouteEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NativeChannel) > 0 {
+		i -= len(m.NativeChannel)
+		copy(dAtA[i:], m.NativeChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NativeChannel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NativePort) > 0 {
+		i -= len(m.NativePort)
+		copy(dAtA[i:], m.NativePort)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NativePort)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVolumeCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVolumeCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVolumeCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.OutboundCap.Size()
+		i -= size
+		if _, err := m.OutboundCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.InboundCap.Size()
+		i -= size
+		if _, err := m.InboundCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.NativeChannel) > 0 {
+		i -= len(m.NativeChannel)
+		copy(dAtA[i:], m.NativeChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NativeChannel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NativePort) > 0 {
+		i -= len(m.NativePort)
+		copy(dAtA[i:], m.NativePort)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NativePort)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVolumeCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVolumeCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVolumeCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.OutboundCap.Size()
+		i -= size
+		if _, err := m.OutboundCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.InboundCap.Size()
+		i -= size
+		if _, err := m.InboundCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.NativeChannel) > 0 {
+		i -= len(m.NativeChannel)
+		copy(dAtA[i:], m.NativeChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NativeChannel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NativePort) > 0 {
+		i -= len(m.NativePort)
+		copy(dAtA[i:], m.NativePort)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NativePort)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Authority)
 	if l > 0 {
@@ -3134,6 +3783,10 @@ func (m *MsgUpdateIbcSettings) Size() (n int) {
 	if m.DecimalDifference != 0 {
 		n += 1 + sovTx(uint64(m.DecimalDifference))
 	}
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3178,6 +3831,10 @@ func (m *MsgUpdateIbcSettingsResponse) Size() (n int) {
 	if m.DecimalDifference != 0 {
 		n += 1 + sovTx(uint64(m.DecimalDifference))
 	}
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3355,11 +4012,115 @@ func (m *MsgRecoverZigResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgSetBridgeRouteEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NativePort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NativeChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetBridgeRouteEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NativePort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NativeChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetVolumeCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NativePort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NativeChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.InboundCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.OutboundCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovTx(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *MsgSetVolumeCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NativePort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NativeChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.InboundCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.OutboundCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovTx(uint64(m.Window))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -4891,6 +5652,38 @@ func (m *MsgUpdateIbcSettings) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5216,6 +6009,38 @@ func (m *MsgUpdateIbcSettingsResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6411,6 +7236,804 @@ func (m *MsgRecoverZigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetBridgeRouteEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBridgeRouteEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBridgeRouteEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBridgeRouteEnabledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBridgeRouteEnabledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBridgeRouteEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetVolumeCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVolumeCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVolumeCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InboundCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutboundCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetVolumeCapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVolumeCapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVolumeCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InboundCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutboundCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
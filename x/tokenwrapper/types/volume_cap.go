@@ -0,0 +1,125 @@
+package types
+
+import (
+	"fmt"
+
+	"zigchain/zutils/validators"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// VolumeCapBuckets is the number of buckets the rolling window of a volume cap
+// is split into, the volume of a bucket is counted until the whole bucket has
+// left the window
+const VolumeCapBuckets = 24
+
+// MaxVolumeCapWindow is the longest window, in seconds, of a volume cap
+const MaxVolumeCapWindow uint64 = 365 * 24 * 60 * 60
+
+// DefaultVolumeCapWindow is the window, in seconds, of the routes only capped by the params
+const DefaultVolumeCapWindow uint64 = 24 * 60 * 60
+
+// BucketLength returns the length in seconds of the buckets of the window
+func (c VolumeCap) BucketLength() int64 {
+	// #nosec G115 -- the window is bounded by MaxVolumeCapWindow
+	length := int64(c.Window / VolumeCapBuckets)
+	if length == 0 {
+		return 1
+	}
+
+	return length
+}
+
+// Cap returns the cap of a direction, zero when it is not capped
+func (c VolumeCap) Cap(inbound bool) sdkmath.Int {
+	limit := c.OutboundCap
+	if inbound {
+		limit = c.InboundCap
+	}
+	if limit.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+
+	return limit
+}
+
+// Bounded returns the volume cap once bounded by the params: a cap above the
+// maximum, or not set while there is a maximum, is lowered to the maximum and a
+// window shorter than the minimum is raised to the minimum
+func (c VolumeCap) Bounded(params Params) VolumeCap {
+	c.InboundCap = boundCap(c.Cap(true), params.MaxInboundVolumeCap)
+	c.OutboundCap = boundCap(c.Cap(false), params.MaxOutboundVolumeCap)
+	if c.Window < params.MinVolumeCapWindow {
+		c.Window = params.MinVolumeCapWindow
+	}
+
+	return c
+}
+
+func boundCap(limit sdkmath.Int, maxCap sdkmath.Int) sdkmath.Int {
+	if maxCap.IsNil() || !maxCap.IsPositive() {
+		return limit
+	}
+	if limit.IsZero() || limit.GT(maxCap) {
+		return maxCap
+	}
+
+	return limit
+}
+
+// Validate validates a volume cap
+func (c VolumeCap) Validate() error {
+	if err := validators.ValidatePort(c.NativePort); err != nil {
+		return err
+	}
+	if err := validators.ValidateChannel(c.NativeChannel); err != nil {
+		return err
+	}
+
+	if c.InboundCap.IsNil() || c.InboundCap.IsNegative() {
+		return fmt.Errorf("%w: inbound cap of route %s/%s must be zero or positive", ErrInvalidVolumeCap, c.NativePort, c.NativeChannel)
+	}
+	if c.OutboundCap.IsNil() || c.OutboundCap.IsNegative() {
+		return fmt.Errorf("%w: outbound cap of route %s/%s must be zero or positive", ErrInvalidVolumeCap, c.NativePort, c.NativeChannel)
+	}
+
+	if c.Window == 0 {
+		return fmt.Errorf("%w: window must be greater than 0", ErrInvalidVolumeCap)
+	}
+	if c.Window > MaxVolumeCapWindow {
+		return fmt.Errorf("%w: window %d exceeds the maximum of %d seconds", ErrInvalidVolumeCap, c.Window, MaxVolumeCapWindow)
+	}
+
+	return nil
+}
+
+// ValidateBounds checks that a volume cap set by the operator is within the bounds of the params
+func (c VolumeCap) ValidateBounds(params Params) error {
+	if !c.InboundCap.Equal(boundCap(c.InboundCap, params.MaxInboundVolumeCap)) {
+		return fmt.Errorf("%w: inbound cap must be set and at most %s", ErrInvalidVolumeCap, params.MaxInboundVolumeCap)
+	}
+	if !c.OutboundCap.Equal(boundCap(c.OutboundCap, params.MaxOutboundVolumeCap)) {
+		return fmt.Errorf("%w: outbound cap must be set and at most %s", ErrInvalidVolumeCap, params.MaxOutboundVolumeCap)
+	}
+	if c.Window < params.MinVolumeCapWindow {
+		return fmt.Errorf("%w: window must be at least %d seconds", ErrInvalidVolumeCap, params.MinVolumeCapWindow)
+	}
+
+	return nil
+}
+
+// Validate validates a volume bucket
+func (b VolumeBucket) Validate() error {
+	if err := validators.ValidatePort(b.NativePort); err != nil {
+		return err
+	}
+	if err := validators.ValidateChannel(b.NativeChannel); err != nil {
+		return err
+	}
+
+	if b.Amount.IsNil() || b.Amount.IsNegative() {
+		return fmt.Errorf("%w: bucket amount of route %s/%s must be zero or positive", ErrInvalidVolumeCap, b.NativePort, b.NativeChannel)
+	}
+
+	return nil
+}
@@ -0,0 +1,870 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zigchain/tokenwrapper/volume_cap.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VolumeCap bounds the amount of native tokens wrapped over a bridge route
+// within any rolling window, keyed by the native port and channel of the route
+type VolumeCap struct {
+	// native_port defines the native port of the bridge route
+	NativePort string `protobuf:"bytes,1,opt,name=native_port,json=nativePort,proto3" json:"native_port,omitempty"`
+	// native_channel defines the native channel of the bridge route
+	NativeChannel string `protobuf:"bytes,2,opt,name=native_channel,json=nativeChannel,proto3" json:"native_channel,omitempty"`
+	// inbound_cap is the maximum amount of native tokens unlocked for incoming
+	// transfers within the window, zero means no cap
+	InboundCap cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=inbound_cap,json=inboundCap,proto3,customtype=cosmossdk.io/math.Int" json:"inbound_cap"`
+	// outbound_cap is the maximum amount of native tokens locked for outgoing
+	// transfers within the window, zero means no cap
+	OutboundCap cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=outbound_cap,json=outboundCap,proto3,customtype=cosmossdk.io/math.Int" json:"outbound_cap"`
+	// window is the length of the rolling window in seconds
+	Window uint64 `protobuf:"varint,5,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *VolumeCap) Reset()         { *m = VolumeCap{} }
+func (m *VolumeCap) String() string { return proto.CompactTextString(m) }
+func (*VolumeCap) ProtoMessage()    {}
+func (*VolumeCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d544891d7c6bebd, []int{0}
+}
+func (m *VolumeCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolumeCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VolumeCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VolumeCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeCap.Merge(m, src)
+}
+func (m *VolumeCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolumeCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeCap proto.InternalMessageInfo
+
+func (m *VolumeCap) GetNativePort() string {
+	if m != nil {
+		return m.NativePort
+	}
+	return ""
+}
+
+func (m *VolumeCap) GetNativeChannel() string {
+	if m != nil {
+		return m.NativeChannel
+	}
+	return ""
+}
+
+func (m *VolumeCap) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// VolumeBucket is the amount of native tokens wrapped over a bridge route in
+// one direction in the bucket of the rolling window starting at start_time
+type VolumeBucket struct {
+	NativePort    string `protobuf:"bytes,1,opt,name=native_port,json=nativePort,proto3" json:"native_port,omitempty"`
+	NativeChannel string `protobuf:"bytes,2,opt,name=native_channel,json=nativeChannel,proto3" json:"native_channel,omitempty"`
+	// inbound is true for the bucket of incoming transfers, false for the bucket
+	// of outgoing transfers
+	Inbound bool `protobuf:"varint,3,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	// start_time is the unix time (in seconds) at which the bucket starts
+	StartTime int64                 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Amount    cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *VolumeBucket) Reset()         { *m = VolumeBucket{} }
+func (m *VolumeBucket) String() string { return proto.CompactTextString(m) }
+func (*VolumeBucket) ProtoMessage()    {}
+func (*VolumeBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d544891d7c6bebd, []int{1}
+}
+func (m *VolumeBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolumeBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VolumeBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VolumeBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeBucket.Merge(m, src)
+}
+func (m *VolumeBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolumeBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeBucket proto.InternalMessageInfo
+
+func (m *VolumeBucket) GetNativePort() string {
+	if m != nil {
+		return m.NativePort
+	}
+	return ""
+}
+
+func (m *VolumeBucket) GetNativeChannel() string {
+	if m != nil {
+		return m.NativeChannel
+	}
+	return ""
+}
+
+func (m *VolumeBucket) GetInbound() bool {
+	if m != nil {
+		return m.Inbound
+	}
+	return false
+}
+
+func (m *VolumeBucket) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*VolumeCap)(nil), "zigchain.tokenwrapper.VolumeCap")
+	proto.RegisterType((*VolumeBucket)(nil), "zigchain.tokenwrapper.VolumeBucket")
+}
+
+func init() {
+	proto.RegisterFile("zigchain/tokenwrapper/volume_cap.proto", fileDescriptor_6d544891d7c6bebd)
+}
+
+var fileDescriptor_6d544891d7c6bebd = []byte{
+	// 363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xbf, 0x4a, 0x2b, 0x41,
+	0x14, 0xc6, 0x77, 0x6e, 0xfe, 0xdc, 0x9b, 0x49, 0xae, 0xe0, 0x62, 0x64, 0x09, 0x64, 0x13, 0x02,
+	0x4a, 0xb0, 0xd8, 0x2d, 0x2c, 0x04, 0xcb, 0x04, 0x0b, 0x3b, 0x59, 0xc4, 0xc2, 0x26, 0x4c, 0x36,
+	0x43, 0x32, 0x24, 0x3b, 0x67, 0xd8, 0x3d, 0x9b, 0xa8, 0x4f, 0xe1, 0x63, 0x58, 0xfa, 0x18, 0x01,
+	0x9b, 0x94, 0x62, 0x11, 0x24, 0x29, 0x7c, 0x04, 0x5b, 0xd9, 0xd9, 0x8d, 0x7f, 0xba, 0x14, 0x36,
+	0xc3, 0x9c, 0x8f, 0xef, 0x7c, 0x9c, 0x1f, 0x7c, 0xf4, 0xf0, 0x4e, 0x0c, 0xfd, 0x11, 0x13, 0xd2,
+	0x45, 0x18, 0x73, 0x39, 0x0b, 0x99, 0x52, 0x3c, 0x74, 0xa7, 0x30, 0x89, 0x03, 0xde, 0xf3, 0x99,
+	0x72, 0x54, 0x08, 0x08, 0x66, 0x75, 0xe3, 0x73, 0xbe, 0xfb, 0x6a, 0xbb, 0x2c, 0x10, 0x12, 0x5c,
+	0xfd, 0xa6, 0xce, 0xda, 0xde, 0x10, 0x86, 0xa0, 0xbf, 0x6e, 0xf2, 0x4b, 0xd5, 0xd6, 0x3b, 0xa1,
+	0xa5, 0x2b, 0x1d, 0xda, 0x65, 0xca, 0x6c, 0xd0, 0xb2, 0x64, 0x28, 0xa6, 0xbc, 0xa7, 0x20, 0x44,
+	0x8b, 0x34, 0x49, 0xbb, 0xe4, 0xd1, 0x54, 0xba, 0x80, 0x10, 0xcd, 0x03, 0xba, 0x93, 0x19, 0xfc,
+	0x11, 0x93, 0x92, 0x4f, 0xac, 0x3f, 0xda, 0xf3, 0x3f, 0x55, 0xbb, 0xa9, 0x68, 0x76, 0x69, 0x59,
+	0xc8, 0x3e, 0xc4, 0x72, 0x90, 0x9c, 0x6a, 0xe5, 0x12, 0x4f, 0xa7, 0x35, 0x5f, 0x36, 0x8c, 0x97,
+	0x65, 0xa3, 0xea, 0x43, 0x14, 0x40, 0x14, 0x0d, 0xc6, 0x8e, 0x00, 0x37, 0x60, 0x38, 0x72, 0xce,
+	0x25, 0x3e, 0xbc, 0x3d, 0x1e, 0x11, 0x8f, 0x66, 0x6b, 0xc9, 0x31, 0x67, 0xb4, 0x02, 0x31, 0x7e,
+	0xa5, 0xe4, 0xb7, 0x4e, 0x29, 0x6f, 0xf6, 0x92, 0x98, 0x7d, 0x5a, 0x9c, 0x09, 0x39, 0x80, 0x99,
+	0x55, 0x68, 0x92, 0x76, 0xde, 0xcb, 0xa6, 0xd6, 0x13, 0xa1, 0x95, 0x94, 0xbc, 0x13, 0xfb, 0x63,
+	0x8e, 0xbf, 0x06, 0x6f, 0xd1, 0xbf, 0x19, 0x85, 0x06, 0xff, 0xe7, 0x6d, 0x46, 0xb3, 0x4e, 0x69,
+	0x84, 0x2c, 0xc4, 0x1e, 0x8a, 0x80, 0x6b, 0x9e, 0x9c, 0x57, 0xd2, 0xca, 0xa5, 0x08, 0xb8, 0x79,
+	0x4a, 0x8b, 0x2c, 0x80, 0x58, 0xa2, 0x55, 0xd8, 0x1a, 0x35, 0xdb, 0xe8, 0x9c, 0xcc, 0x57, 0x36,
+	0x59, 0xac, 0x6c, 0xf2, 0xba, 0xb2, 0xc9, 0xfd, 0xda, 0x36, 0x16, 0x6b, 0xdb, 0x78, 0x5e, 0xdb,
+	0xc6, 0x75, 0xfd, 0xb3, 0x49, 0x37, 0x3f, 0xbb, 0x84, 0xb7, 0x8a, 0x47, 0xfd, 0xa2, 0xee, 0xc1,
+	0xf1, 0xc7, 0x00, 0xe8, 0x1a, 0x90, 0xaa, 0x71, 0x02, 0x00, 0x00,
+}
+
+func (m *VolumeCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumeCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintVolumeCap(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.OutboundCap.Size()
+		i -= size
+		if _, err := m.OutboundCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVolumeCap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InboundCap.Size()
+		i -= size
+		if _, err := m.InboundCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVolumeCap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.NativeChannel) > 0 {
+		i -= len(m.NativeChannel)
+		copy(dAtA[i:], m.NativeChannel)
+		i = encodeVarintVolumeCap(dAtA, i, uint64(len(m.NativeChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NativePort) > 0 {
+		i -= len(m.NativePort)
+		copy(dAtA[i:], m.NativePort)
+		i = encodeVarintVolumeCap(dAtA, i, uint64(len(m.NativePort)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VolumeBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumeBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVolumeCap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.StartTime != 0 {
+		i = encodeVarintVolumeCap(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Inbound {
+		i--
+		if m.Inbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NativeChannel) > 0 {
+		i -= len(m.NativeChannel)
+		copy(dAtA[i:], m.NativeChannel)
+		i = encodeVarintVolumeCap(dAtA, i, uint64(len(m.NativeChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NativePort) > 0 {
+		i -= len(m.NativePort)
+		copy(dAtA[i:], m.NativePort)
+		i = encodeVarintVolumeCap(dAtA, i, uint64(len(m.NativePort)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVolumeCap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVolumeCap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VolumeCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NativePort)
+	if l > 0 {
+		n += 1 + l + sovVolumeCap(uint64(l))
+	}
+	l = len(m.NativeChannel)
+	if l > 0 {
+		n += 1 + l + sovVolumeCap(uint64(l))
+	}
+	l = m.InboundCap.Size()
+	n += 1 + l + sovVolumeCap(uint64(l))
+	l = m.OutboundCap.Size()
+	n += 1 + l + sovVolumeCap(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovVolumeCap(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *VolumeBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NativePort)
+	if l > 0 {
+		n += 1 + l + sovVolumeCap(uint64(l))
+	}
+	l = len(m.NativeChannel)
+	if l > 0 {
+		n += 1 + l + sovVolumeCap(uint64(l))
+	}
+	if m.Inbound {
+		n += 2
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVolumeCap(uint64(m.StartTime))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovVolumeCap(uint64(l))
+	return n
+}
+
+func sovVolumeCap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVolumeCap(x uint64) (n int) {
+	return sovVolumeCap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VolumeCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVolumeCap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolumeCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolumeCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolumeCap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVolumeCap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolumeCap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolumeCap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVolumeCap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolumeCap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolumeCap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVolumeCap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolumeCap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InboundCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolumeCap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVolumeCap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolumeCap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutboundCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolumeCap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVolumeCap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVolumeCap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VolumeBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVolumeCap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolumeBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolumeBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolumeCap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVolumeCap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolumeCap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolumeCap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVolumeCap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolumeCap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolumeCap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Inbound = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolumeCap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVolumeCap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVolumeCap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVolumeCap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVolumeCap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVolumeCap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVolumeCap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVolumeCap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVolumeCap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVolumeCap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVolumeCap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVolumeCap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVolumeCap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVolumeCap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVolumeCap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVolumeCap = fmt.Errorf("proto: unexpected end of group")
+)
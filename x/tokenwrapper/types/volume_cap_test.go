@@ -0,0 +1,112 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+var volumeCapSample = VolumeCap{
+	NativePort:    "transfer",
+	NativeChannel: "channel-0",
+	InboundCap:    sdkmath.NewInt(1000),
+	OutboundCap:   sdkmath.NewInt(500),
+	Window:        86400,
+}
+
+// Positive test cases
+
+func TestVolumeCap_Validate_Positive(t *testing.T) {
+	// Test case: a volume cap with one direction not capped is valid
+
+	volumeCap := volumeCapSample
+	require.NoError(t, volumeCap.Validate())
+
+	volumeCap.OutboundCap = sdkmath.ZeroInt()
+	require.NoError(t, volumeCap.Validate())
+	require.True(t, volumeCap.Cap(false).IsZero())
+	require.Equal(t, sdkmath.NewInt(1000), volumeCap.Cap(true))
+}
+
+func TestVolumeCap_BucketLength(t *testing.T) {
+	// Test case: the window is split into VolumeCapBuckets buckets of at least one second
+
+	volumeCap := volumeCapSample
+	require.Equal(t, int64(3600), volumeCap.BucketLength())
+
+	volumeCap.Window = 10
+	require.Equal(t, int64(1), volumeCap.BucketLength())
+}
+
+func TestVolumeCap_Bounded(t *testing.T) {
+	// Test case: the caps are lowered to the maximums of the params and the window raised to the minimum
+
+	params := NewParams(sdkmath.NewInt(800), sdkmath.NewInt(800), 100000, DefaultPendingUnwrapGasBudget, 0, 0, sdkmath.ZeroInt(), "")
+	bounded := volumeCapSample.Bounded(params)
+	require.Equal(t, sdkmath.NewInt(800), bounded.InboundCap)
+	require.Equal(t, sdkmath.NewInt(500), bounded.OutboundCap)
+	require.Equal(t, uint64(100000), bounded.Window)
+
+	// a direction not capped is capped at the maximum
+	volumeCap := volumeCapSample
+	volumeCap.OutboundCap = sdkmath.ZeroInt()
+	require.Equal(t, sdkmath.NewInt(800), volumeCap.Bounded(params).OutboundCap)
+
+	// the default params do not bound anything
+	require.Equal(t, volumeCapSample, volumeCapSample.Bounded(DefaultParams()))
+}
+
+func TestVolumeCap_ValidateBounds(t *testing.T) {
+	// Test case: the operator can only set volume caps within the bounds of the params
+
+	require.NoError(t, volumeCapSample.ValidateBounds(DefaultParams()))
+	require.NoError(t, volumeCapSample.ValidateBounds(NewParams(sdkmath.NewInt(1000), sdkmath.NewInt(500), 86400, DefaultPendingUnwrapGasBudget, 0, 0, sdkmath.ZeroInt(), "")))
+
+	require.ErrorIs(t, volumeCapSample.ValidateBounds(NewParams(sdkmath.NewInt(999), sdkmath.ZeroInt(), 0, DefaultPendingUnwrapGasBudget, 0, 0, sdkmath.ZeroInt(), "")), ErrInvalidVolumeCap)
+	require.ErrorIs(t, volumeCapSample.ValidateBounds(NewParams(sdkmath.ZeroInt(), sdkmath.NewInt(499), 0, DefaultPendingUnwrapGasBudget, 0, 0, sdkmath.ZeroInt(), "")), ErrInvalidVolumeCap)
+	require.ErrorIs(t, volumeCapSample.ValidateBounds(NewParams(sdkmath.ZeroInt(), sdkmath.ZeroInt(), 86401, DefaultPendingUnwrapGasBudget, 0, 0, sdkmath.ZeroInt(), "")), ErrInvalidVolumeCap)
+
+	// a direction can not be left without a cap when the params bound it
+	volumeCap := volumeCapSample
+	volumeCap.InboundCap = sdkmath.ZeroInt()
+	require.ErrorIs(t, volumeCap.ValidateBounds(NewParams(sdkmath.NewInt(1000), sdkmath.ZeroInt(), 0, DefaultPendingUnwrapGasBudget, 0, 0, sdkmath.ZeroInt(), "")), ErrInvalidVolumeCap)
+}
+
+// Negative test cases
+
+func TestVolumeCap_Validate_Invalid(t *testing.T) {
+	// Test case: the route must be valid, the caps set and non-negative and the window bounded
+
+	volumeCap := volumeCapSample
+	volumeCap.NativePort = ""
+	require.ErrorContains(t, volumeCap.Validate(), "port cannot be empty")
+
+	volumeCap = volumeCapSample
+	volumeCap.InboundCap = sdkmath.NewInt(-1)
+	require.ErrorIs(t, volumeCap.Validate(), ErrInvalidVolumeCap)
+
+	volumeCap = volumeCapSample
+	volumeCap.OutboundCap = sdkmath.Int{}
+	require.ErrorIs(t, volumeCap.Validate(), ErrInvalidVolumeCap)
+
+	volumeCap = volumeCapSample
+	volumeCap.Window = 0
+	require.ErrorIs(t, volumeCap.Validate(), ErrInvalidVolumeCap)
+
+	volumeCap.Window = MaxVolumeCapWindow + 1
+	require.ErrorIs(t, volumeCap.Validate(), ErrInvalidVolumeCap)
+}
+
+func TestVolumeBucket_Validate_Invalid(t *testing.T) {
+	// Test case: the bucket amount must be set and non-negative
+
+	bucket := VolumeBucket{NativePort: "transfer", NativeChannel: "channel-0", Amount: sdkmath.NewInt(1)}
+	require.NoError(t, bucket.Validate())
+
+	bucket.Amount = sdkmath.NewInt(-1)
+	require.ErrorIs(t, bucket.Validate(), ErrInvalidVolumeCap)
+
+	bucket.Amount = sdkmath.Int{}
+	require.ErrorIs(t, bucket.Validate(), ErrInvalidVolumeCap)
+}
@@ -0,0 +1,124 @@
+package indexes
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
+)
+
+// MultiValue is a secondary index working like indexes.Multi, except that a value can be
+// referenced by more than one reference key, e.g. a pool by both of its denoms.
+// Duplicated reference keys of a value are stored once.
+type MultiValue[ReferenceKey, PrimaryKey, Value any] struct {
+	getRefKeys func(pk PrimaryKey, value Value) ([]ReferenceKey, error)
+	refKeys    collections.KeySet[collections.Pair[ReferenceKey, PrimaryKey]]
+}
+
+// NewMultiValue instantiates a new MultiValue index given a schema, a prefix, the humanized
+// name of the index, the reference key codec and the primary key codec. The getRefKeysFunc
+// returns all the reference keys of a value.
+func NewMultiValue[ReferenceKey, PrimaryKey, Value any](
+	schema *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	refCodec codec.KeyCodec[ReferenceKey],
+	pkCodec codec.KeyCodec[PrimaryKey],
+	getRefKeysFunc func(pk PrimaryKey, value Value) ([]ReferenceKey, error),
+) *MultiValue[ReferenceKey, PrimaryKey, Value] {
+	return &MultiValue[ReferenceKey, PrimaryKey, Value]{
+		getRefKeys: getRefKeysFunc,
+		refKeys: collections.NewKeySet(
+			schema,
+			prefix,
+			name,
+			collections.PairKeyCodec(refCodec, pkCodec),
+			collections.WithKeySetSecondaryIndex(),
+		),
+	}
+}
+
+// Reference replaces the references of the old value, if any, by the ones of the new value.
+func (m *MultiValue[ReferenceKey, PrimaryKey, Value]) Reference(
+	ctx context.Context,
+	pk PrimaryKey,
+	newValue Value,
+	lazyOldValue func() (Value, error),
+) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	case err == nil:
+		if err := m.unreference(ctx, pk, oldValue); err != nil {
+			return err
+		}
+	case errors.Is(err, collections.ErrNotFound):
+	default:
+		return err
+	}
+
+	refKeys, err := m.getRefKeys(pk, newValue)
+	if err != nil {
+		return err
+	}
+
+	for _, refKey := range refKeys {
+		if err := m.refKeys.Set(ctx, collections.Join(refKey, pk)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Unreference removes all the references of the value.
+func (m *MultiValue[ReferenceKey, PrimaryKey, Value]) Unreference(
+	ctx context.Context,
+	pk PrimaryKey,
+	getValue func() (Value, error),
+) error {
+	value, err := getValue()
+	if err != nil {
+		return err
+	}
+	return m.unreference(ctx, pk, value)
+}
+
+func (m *MultiValue[ReferenceKey, PrimaryKey, Value]) unreference(ctx context.Context, pk PrimaryKey, value Value) error {
+	refKeys, err := m.getRefKeys(pk, value)
+	if err != nil {
+		return err
+	}
+
+	for _, refKey := range refKeys {
+		if err := m.refKeys.Remove(ctx, collections.Join(refKey, pk)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MatchExact returns an iterator over all the primary keys referenced by the reference key.
+func (m *MultiValue[ReferenceKey, PrimaryKey, Value]) MatchExact(
+	ctx context.Context,
+	refKey ReferenceKey,
+) (indexes.MultiIterator[ReferenceKey, PrimaryKey], error) {
+	iter, err := m.refKeys.Iterate(ctx, collections.NewPrefixedPairRange[ReferenceKey, PrimaryKey](refKey))
+	return (indexes.MultiIterator[ReferenceKey, PrimaryKey])(iter), err
+}
+
+// IterateRaw iterates over the raw reference keys, so the index can be paginated with query.CollectionPaginate.
+func (m *MultiValue[ReferenceKey, PrimaryKey, Value]) IterateRaw(
+	ctx context.Context,
+	start, end []byte,
+	order collections.Order,
+) (collections.Iterator[collections.Pair[ReferenceKey, PrimaryKey], collections.NoValue], error) {
+	return m.refKeys.IterateRaw(ctx, start, end, order)
+}
+
+// KeyCodec returns the codec of the reference keys.
+func (m *MultiValue[ReferenceKey, PrimaryKey, Value]) KeyCodec() codec.KeyCodec[collections.Pair[ReferenceKey, PrimaryKey]] {
+	return m.refKeys.KeyCodec()
+}
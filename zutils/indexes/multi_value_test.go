@@ -0,0 +1,95 @@
+package indexes_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+	"github.com/stretchr/testify/require"
+
+	"zigchain/zutils/indexes"
+)
+
+type pair struct {
+	A string
+	B string
+}
+
+type pairIndexes struct {
+	Members *indexes.MultiValue[string, uint64, pair]
+}
+
+func (i pairIndexes) IndexesList() []collections.Index[uint64, pair] {
+	return []collections.Index[uint64, pair]{i.Members}
+}
+
+func setupIndexedMap(t *testing.T) (context.Context, *collections.IndexedMap[uint64, pair, pairIndexes]) {
+	t.Helper()
+
+	storeService, ctx := colltest.MockStore()
+	sb := collections.NewSchemaBuilder(storeService)
+
+	m := collections.NewIndexedMap(
+		sb,
+		collections.NewPrefix(0),
+		"pairs",
+		collections.Uint64Key,
+		colltest.MockValueCodec[pair](),
+		pairIndexes{
+			Members: indexes.NewMultiValue(
+				sb,
+				collections.NewPrefix(1),
+				"pairs_by_member",
+				collections.StringKey,
+				collections.Uint64Key,
+				func(_ uint64, value pair) ([]string, error) {
+					return []string{value.A, value.B}, nil
+				},
+			),
+		},
+	)
+
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	return ctx, m
+}
+
+func matchExact(t *testing.T, ctx context.Context, m *collections.IndexedMap[uint64, pair, pairIndexes], member string) []uint64 {
+	t.Helper()
+
+	iter, err := m.Indexes.Members.MatchExact(ctx, member)
+	require.NoError(t, err)
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	return pks
+}
+
+func TestMultiValue_Reference(t *testing.T) {
+	ctx, m := setupIndexedMap(t)
+
+	require.NoError(t, m.Set(ctx, 1, pair{A: "abc", B: "uzig"}))
+	require.NoError(t, m.Set(ctx, 2, pair{A: "usdt", B: "uzig"}))
+	require.NoError(t, m.Set(ctx, 3, pair{A: "abc", B: "abc"}))
+
+	require.Equal(t, []uint64{1, 3}, matchExact(t, ctx, m, "abc"))
+	require.Equal(t, []uint64{1, 2}, matchExact(t, ctx, m, "uzig"))
+	require.Equal(t, []uint64{2}, matchExact(t, ctx, m, "usdt"))
+}
+
+func TestMultiValue_UpdateAndRemove(t *testing.T) {
+	ctx, m := setupIndexedMap(t)
+
+	require.NoError(t, m.Set(ctx, 1, pair{A: "abc", B: "uzig"}))
+
+	// the old references are replaced
+	require.NoError(t, m.Set(ctx, 1, pair{A: "abc", B: "usdt"}))
+	require.Equal(t, []uint64{1}, matchExact(t, ctx, m, "abc"))
+	require.Equal(t, []uint64{1}, matchExact(t, ctx, m, "usdt"))
+	require.Empty(t, matchExact(t, ctx, m, "uzig"))
+
+	require.NoError(t, m.Remove(ctx, 1))
+	require.Empty(t, matchExact(t, ctx, m, "abc"))
+	require.Empty(t, matchExact(t, ctx, m, "usdt"))
+}
@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// MigrateLegacyStore moves every value of a legacy prefix store with the set function, then deletes the legacy entries
+func MigrateLegacyStore[T any, PT interface {
+	*T
+	proto.Message
+}](store storetypes.KVStore, cdc codec.BinaryCodec, set func(T) error) error {
+	// values are read first, the store is not written while the iterator is open
+	var keys [][]byte
+	var values []T
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var val T
+		if err := cdc.Unmarshal(iterator.Value(), PT(&val)); err != nil {
+			_ = iterator.Close()
+			return err
+		}
+		keys = append(keys, iterator.Key())
+		values = append(values, val)
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for i, val := range values {
+		if err := set(val); err != nil {
+			return err
+		}
+		store.Delete(keys[i])
+	}
+
+	return nil
+}
+
+// DeleteLegacyStore deletes every entry of a legacy prefix store
+func DeleteLegacyStore(store storetypes.KVStore) error {
+	var keys [][]byte
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/prefix"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"zigchain/zutils/migrations"
+)
+
+func TestMigrateLegacyStore(t *testing.T) {
+	// Test case: every value of the legacy prefix store is moved and deleted, other prefixes are kept
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := prefix.NewStore(parent, []byte("legacy/"))
+
+	coins := []sdk.Coin{sdk.NewCoin("uzig", math.NewInt(1)), sdk.NewCoin("uatom", math.NewInt(2))}
+	for _, coin := range coins {
+		store.Set([]byte(coin.Denom), cdc.MustMarshal(&coin))
+	}
+	parent.Set([]byte("other"), []byte{1})
+
+	var moved []sdk.Coin
+	require.NoError(t, migrations.MigrateLegacyStore(store, cdc, func(coin sdk.Coin) error {
+		moved = append(moved, coin)
+		return nil
+	}))
+
+	// the legacy store is iterated in key order
+	require.Equal(t, []sdk.Coin{coins[1], coins[0]}, moved)
+	require.Nil(t, store.Get([]byte("uzig")))
+	require.Nil(t, store.Get([]byte("uatom")))
+	require.Equal(t, []byte{1}, parent.Get([]byte("other")))
+}
+
+func TestMigrateLegacyStore_Invalid(t *testing.T) {
+	// Test case: an undecodable value or a failing set function stops the migration
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := prefix.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}, []byte("legacy/"))
+
+	coin := sdk.NewCoin("uzig", math.NewInt(1))
+	store.Set([]byte("uzig"), cdc.MustMarshal(&coin))
+
+	require.ErrorIs(t, migrations.MigrateLegacyStore(store, cdc, func(sdk.Coin) error {
+		return sdk.ErrInvalidLengthCoin
+	}), sdk.ErrInvalidLengthCoin)
+	require.NotNil(t, store.Get([]byte("uzig")))
+
+	store.Set([]byte("uzig"), []byte{0xff})
+	require.Error(t, migrations.MigrateLegacyStore(store, cdc, func(sdk.Coin) error { return nil }))
+}
+
+func TestDeleteLegacyStore(t *testing.T) {
+	// Test case: every entry of the legacy prefix store is deleted
+
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := prefix.NewStore(parent, []byte("legacy/"))
+	store.Set([]byte("a"), []byte{})
+	store.Set([]byte("b"), []byte{})
+	parent.Set([]byte("other"), []byte{1})
+
+	require.NoError(t, migrations.DeleteLegacyStore(store))
+	require.Nil(t, store.Get([]byte("a")))
+	require.Nil(t, store.Get([]byte("b")))
+	require.Equal(t, []byte{1}, parent.Get([]byte("other")))
+}
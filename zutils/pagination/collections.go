@@ -0,0 +1,31 @@
+package pagination
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// CollectionPaginate works like query.CollectionPaginate, except that an offset past the last entry
+// still reports the total when it is requested, as query.Paginate does for the prefix stores.
+func CollectionPaginate[K, V any, C query.Collection[K, V], T any](
+	ctx context.Context,
+	coll C,
+	pageReq *query.PageRequest,
+	transformFunc func(key K, value V) (T, error),
+	opts ...func(opt *query.CollectionsPaginateOptions[K]),
+) ([]T, *query.PageResponse, error) {
+	results, pageRes, err := query.CollectionPaginate(ctx, coll, pageReq, transformFunc, opts...)
+	if err != nil || pageReq == nil || !pageReq.CountTotal || pageReq.Offset == 0 || len(results) != 0 {
+		return results, pageRes, err
+	}
+
+	// the offset skipped every entry, count them from the start
+	_, countRes, err := query.CollectionPaginate(ctx, coll, &query.PageRequest{Limit: 1, CountTotal: true}, transformFunc, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	pageRes.Total = countRes.Total
+
+	return results, pageRes, nil
+}
@@ -0,0 +1,67 @@
+package pagination_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"zigchain/zutils/pagination"
+)
+
+func TestCollectionPaginate(t *testing.T) {
+	storeService, ctx := colltest.MockStore()
+	sb := collections.NewSchemaBuilder(storeService)
+	m := collections.NewMap(sb, collections.NewPrefix(0), "values", collections.Uint64Key, collections.Uint64Value)
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	for i := uint64(0); i < 3; i++ {
+		require.NoError(t, m.Set(ctx, i, i*10))
+	}
+
+	identity := func(_ uint64, value uint64) (uint64, error) { return value, nil }
+
+	testCases := []struct {
+		name       string
+		pageReq    *query.PageRequest
+		expResults []uint64
+		expTotal   uint64
+	}{
+		{
+			name:       "nil request",
+			pageReq:    nil,
+			expResults: []uint64{0, 10, 20},
+			expTotal:   3,
+		},
+		{
+			name:       "offset within range",
+			pageReq:    &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+			expResults: []uint64{10},
+			expTotal:   3,
+		},
+		{
+			name:       "offset past the last entry",
+			pageReq:    &query.PageRequest{Offset: 10, Limit: 1, CountTotal: true},
+			expResults: nil,
+			expTotal:   3,
+		},
+		{
+			name:       "offset past the last entry without count total",
+			pageReq:    &query.PageRequest{Offset: 10, Limit: 1},
+			expResults: nil,
+			expTotal:   0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			results, pageRes, err := pagination.CollectionPaginate(ctx, m, tc.pageReq, identity)
+			require.NoError(t, err)
+			require.Equal(t, tc.expResults, results)
+			require.Equal(t, tc.expTotal, pageRes.Total)
+		})
+	}
+}